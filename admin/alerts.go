@@ -0,0 +1,423 @@
+package admin
+
+import (
+	"context"
+	"errors"
+	"fmt"
+	"strconv"
+	"strings"
+	"time"
+
+	"github.com/rilldata/rill/admin/database"
+	"github.com/rilldata/rill/admin/email"
+	"github.com/rilldata/rill/admin/pkg/webhook"
+	runtimev1 "github.com/rilldata/rill/proto/gen/rill/runtime/v1"
+	"github.com/rilldata/rill/runtime/pkg/observability"
+	"go.uber.org/zap"
+	"google.golang.org/protobuf/encoding/protojson"
+	"google.golang.org/protobuf/types/known/structpb"
+	"google.golang.org/protobuf/types/known/timestamppb"
+)
+
+// alertRunTimeout is the maximum time to spend evaluating and notifying a single alert.
+const alertRunTimeout = 5 * time.Minute
+
+// alertMaxBreaches caps the number of dimension values reported in a single alert notification.
+const alertMaxBreaches = 100
+
+// AlertBreach is a measure value that crossed an alert's threshold.
+// For alerts split by a dimension, DimensionValue identifies the dimension value the measure was evaluated for.
+type AlertBreach struct {
+	DimensionValue string  `json:"dimension_value,omitempty"`
+	Value          float64 `json:"value"`
+}
+
+// postWebhook posts a JSON payload to a webhook URL. It's a variable so tests can intercept webhook calls.
+var postWebhook = webhook.Post
+
+// alertWebhookPayload is the JSON body posted to an alert's webhook URL when it is triggered.
+type alertWebhookPayload struct {
+	Organization string        `json:"organization"`
+	Project      string        `json:"project"`
+	Alert        string        `json:"alert"`
+	MetricsView  string        `json:"metrics_view"`
+	Measure      string        `json:"measure"`
+	Comparison   string        `json:"comparison"`
+	Threshold    float64       `json:"threshold"`
+	Dimension    string        `json:"dimension,omitempty"`
+	EvaluatedOn  time.Time     `json:"evaluated_on"`
+	Breaches     []AlertBreach `json:"breaches"`
+}
+
+// ValidateAlertQuery checks that an alert's comparison, filter and time range can be parsed.
+func ValidateAlertQuery(comparison database.AlertComparison, whereJSON, timeRange string) error {
+	_, _, err := alertOperation(comparison)
+	if err != nil {
+		return err
+	}
+
+	_, err = parseAlertWhere(whereJSON)
+	if err != nil {
+		return err
+	}
+
+	if timeRange != "" {
+		_, err := relativeTimeStart(timeRange, time.Now())
+		if err != nil {
+			return err
+		}
+	}
+
+	return nil
+}
+
+// RunAlert evaluates an alert against the project's prod deployment and notifies its recipients and webhook of new breaches.
+// It records the outcome of the evaluation on the alert and schedules its next evaluation, also if the evaluation failed.
+func (s *Service) RunAlert(ctx context.Context, alert *database.Alert) error {
+	now := time.Now()
+
+	runCtx, cancel := context.WithTimeout(ctx, alertRunTimeout)
+	state, runErr := s.runAlert(runCtx, alert, now)
+	cancel()
+
+	next, err := NextReportRunTime(alert.Schedule, alert.TimeZone, now)
+	if err != nil {
+		return err
+	}
+
+	var lastRunError string
+	if runErr != nil {
+		lastRunError = runErr.Error()
+	}
+
+	err = s.DB.UpdateAlertRun(ctx, alert.ID, &database.UpdateAlertRunOptions{
+		LastRunOn:    now,
+		LastRunError: lastRunError,
+		NextRunOn:    next,
+		State:        state,
+	})
+	if err != nil {
+		return err
+	}
+
+	return runErr
+}
+
+// runAlert evaluates and notifies an alert and returns its new state.
+// Once the alert has been evaluated, the new state is returned also if some notifications failed.
+// Each recipient and the webhook is a separate channel in the state, so only the failed channels are notified again on the next evaluation.
+func (s *Service) runAlert(ctx context.Context, alert *database.Alert, now time.Time) (database.AlertState, error) {
+	proj, err := s.DB.FindProject(ctx, alert.ProjectID)
+	if err != nil {
+		return alert.State, err
+	}
+
+	if proj.ProdDeploymentID == nil {
+		return alert.State, fmt.Errorf("project %q does not have a prod deployment", proj.Name)
+	}
+
+	depl, err := s.DB.FindDeployment(ctx, *proj.ProdDeploymentID)
+	if err != nil {
+		return alert.State, err
+	}
+
+	org, err := s.DB.FindOrganization(ctx, proj.OrganizationID)
+	if err != nil {
+		return alert.State, err
+	}
+
+	breaches, err := s.evaluateAlert(ctx, alert, depl, now)
+	if err != nil {
+		return alert.State, fmt.Errorf("failed to evaluate alert: %w", err)
+	}
+
+	state, notified, err := notifyAlertChannels(alert, breaches, now, func(channel string, notify []AlertBreach) error {
+		return s.notifyAlert(ctx, channel, alert, org, proj, notify, now)
+	})
+
+	if notified > 0 {
+		s.logger.Info("triggered alert", zap.String("project_id", proj.ID), zap.String("alert", alert.Name), zap.Int("channels", notified), observability.ZapCtx(ctx))
+	}
+
+	return state, err
+}
+
+// notifyAlertChannels de-duplicates the breaches for each of an alert's channels and calls notify for the channels that have breaches to deliver.
+// It returns the alert's new state, the number of channels that were notified, and an error for each channel that failed.
+func notifyAlertChannels(alert *database.Alert, breaches []AlertBreach, now time.Time, notify func(channel string, breaches []AlertBreach) error) (database.AlertState, int, error) {
+	channels := alertChannels(alert)
+	renotifyAfter := time.Duration(alert.RenotifyAfterSeconds) * time.Second
+
+	state := make(database.AlertState, len(channels))
+	var errs []error
+	var notified int
+	for _, channel := range channels {
+		prev := alert.State[channel]
+		pending, channelState := dedupeAlertBreaches(prev, breaches, renotifyAfter, now)
+		if len(pending) > 0 {
+			err := notify(channel, pending)
+			if err != nil {
+				errs = append(errs, fmt.Errorf("failed to notify %s: %w", channel, err))
+				revertAlertBreaches(prev, channelState, pending)
+			} else {
+				notified++
+			}
+		}
+		if len(channelState) > 0 {
+			state[channel] = channelState
+		}
+	}
+
+	return state, notified, errors.Join(errs...)
+}
+
+// alertChannels returns the AlertState keys of an alert's notification channels.
+func alertChannels(alert *database.Alert) []string {
+	channels := make([]string, 0, len(alert.Recipients)+1)
+	for _, recipient := range alert.Recipients {
+		channels = append(channels, database.AlertChannelEmail(recipient))
+	}
+	if alert.WebhookURL != "" {
+		channels = append(channels, database.AlertChannelWebhook)
+	}
+	return channels
+}
+
+// evaluateAlert queries an alert's measure on a deployment's runtime and returns the values that cross the alert's threshold.
+// Without a dimension split, the measure's total is evaluated. Otherwise, the measure is evaluated for each dimension value.
+func (s *Service) evaluateAlert(ctx context.Context, alert *database.Alert, depl *database.Deployment, now time.Time) ([]AlertBreach, error) {
+	op, _, err := alertOperation(alert.Comparison)
+	if err != nil {
+		return nil, err
+	}
+
+	where, err := parseAlertWhere(alert.WhereJSON)
+	if err != nil {
+		return nil, err
+	}
+
+	var timeStart, timeEnd *timestamppb.Timestamp
+	if alert.TimeRange != "" {
+		timeStart, err = relativeTimeStart(alert.TimeRange, now)
+		if err != nil {
+			return nil, err
+		}
+		timeEnd = timestamppb.New(now)
+	}
+
+	rt, err := s.openRuntimeClientForOwner(ctx, alert.ProjectID, alert.OwnerUserID, depl, alertRunTimeout)
+	if err != nil {
+		return nil, err
+	}
+	defer rt.Close()
+
+	if alert.SplitByDimension == "" {
+		res, err := rt.MetricsViewTotals(ctx, &runtimev1.MetricsViewTotalsRequest{
+			InstanceId:      depl.RuntimeInstanceID,
+			MetricsViewName: alert.MetricsViewName,
+			MeasureNames:    []string{alert.MeasureName},
+			TimeStart:       timeStart,
+			TimeEnd:         timeEnd,
+			Where:           where,
+		})
+		if err != nil {
+			return nil, err
+		}
+
+		v, ok := numberValue(res.Data.GetFields()[alert.MeasureName])
+		if !ok || !compareAlertValue(alert.Comparison, v, alert.Threshold) {
+			return nil, nil
+		}
+		return []AlertBreach{{Value: v}}, nil
+	}
+
+	// Sort the most extreme values first, so they are kept if there are more than alertMaxBreaches breaches
+	ascending := alert.Comparison == database.AlertComparisonLT || alert.Comparison == database.AlertComparisonLTE
+
+	res, err := rt.MetricsViewToplist(ctx, &runtimev1.MetricsViewToplistRequest{
+		InstanceId:      depl.RuntimeInstanceID,
+		MetricsViewName: alert.MetricsViewName,
+		DimensionName:   alert.SplitByDimension,
+		MeasureNames:    []string{alert.MeasureName},
+		TimeStart:       timeStart,
+		TimeEnd:         timeEnd,
+		Limit:           alertMaxBreaches,
+		Sort:            []*runtimev1.MetricsViewSort{{Name: alert.MeasureName, Ascending: ascending}},
+		Where:           where,
+		Having: &runtimev1.Expression{Expression: &runtimev1.Expression_Cond{Cond: &runtimev1.Condition{
+			Op: op,
+			Exprs: []*runtimev1.Expression{
+				{Expression: &runtimev1.Expression_Ident{Ident: alert.MeasureName}},
+				{Expression: &runtimev1.Expression_Val{Val: structpb.NewNumberValue(alert.Threshold)}},
+			},
+		}}},
+	})
+	if err != nil {
+		return nil, err
+	}
+
+	var breaches []AlertBreach
+	for _, row := range res.Data {
+		v, ok := numberValue(row.Fields[alert.MeasureName])
+		if !ok || !compareAlertValue(alert.Comparison, v, alert.Threshold) {
+			continue
+		}
+		breaches = append(breaches, AlertBreach{
+			DimensionValue: dimensionValueString(row.Fields[alert.SplitByDimension]),
+			Value:          v,
+		})
+	}
+
+	return breaches, nil
+}
+
+// notifyAlert delivers the breaches on one of the alert's notification channels (see alertChannels).
+func (s *Service) notifyAlert(ctx context.Context, channel string, alert *database.Alert, org *database.Organization, proj *database.Project, breaches []AlertBreach, now time.Time) error {
+	_, symbol, err := alertOperation(alert.Comparison)
+	if err != nil {
+		return err
+	}
+
+	if channel == database.AlertChannelWebhook {
+		return postWebhook(ctx, alert.WebhookURL, &alertWebhookPayload{
+			Organization: org.Name,
+			Project:      proj.Name,
+			Alert:        alert.Name,
+			MetricsView:  alert.MetricsViewName,
+			Measure:      alert.MeasureName,
+			Comparison:   symbol,
+			Threshold:    alert.Threshold,
+			Dimension:    alert.SplitByDimension,
+			EvaluatedOn:  now,
+			Breaches:     breaches,
+		})
+	}
+
+	recipient, ok := strings.CutPrefix(channel, database.AlertChannelEmail(""))
+	if !ok {
+		return fmt.Errorf("unknown alert channel %q", channel)
+	}
+
+	lines := make([]string, len(breaches))
+	for i, b := range breaches {
+		value := strconv.FormatFloat(b.Value, 'g', -1, 64)
+		if alert.SplitByDimension == "" {
+			lines[i] = fmt.Sprintf("%s: %s", alert.MeasureName, value)
+		} else {
+			lines[i] = fmt.Sprintf("%s = %s: %s", alert.SplitByDimension, b.DimensionValue, value)
+		}
+	}
+
+	return s.Email.SendAlert(&email.Alert{
+		ToEmail:     recipient,
+		OrgName:     org.Name,
+		ProjectName: proj.Name,
+		AlertName:   alert.Name,
+		Condition:   fmt.Sprintf("%s %s %s", alert.MeasureName, symbol, strconv.FormatFloat(alert.Threshold, 'g', -1, 64)),
+		Breaches:    lines,
+		EvaluatedOn: now,
+	})
+}
+
+// dedupeAlertBreaches returns the breaches that should be notified on a channel and the channel's new state.
+// A breach is notified when it is new, or when it was last notified more than renotifyAfter ago (if renotifyAfter is positive).
+// Breaches that are no longer present are dropped from the state, so they are notified again if they reoccur.
+func dedupeAlertBreaches(prev map[string]time.Time, breaches []AlertBreach, renotifyAfter time.Duration, now time.Time) ([]AlertBreach, map[string]time.Time) {
+	var notify []AlertBreach
+	state := make(map[string]time.Time, len(breaches))
+	for _, b := range breaches {
+		last, ok := prev[b.DimensionValue]
+		if ok && (renotifyAfter <= 0 || now.Sub(last) < renotifyAfter) {
+			state[b.DimensionValue] = last
+			continue
+		}
+		notify = append(notify, b)
+		state[b.DimensionValue] = now
+	}
+	return notify, state
+}
+
+// revertAlertBreaches restores the channel state of breaches that failed to be notified, so they are notified again on the next evaluation.
+func revertAlertBreaches(prev, state map[string]time.Time, failed []AlertBreach) {
+	for _, b := range failed {
+		if last, ok := prev[b.DimensionValue]; ok {
+			state[b.DimensionValue] = last
+		} else {
+			delete(state, b.DimensionValue)
+		}
+	}
+}
+
+// alertOperation returns the expression operation and display symbol for an alert comparison.
+func alertOperation(c database.AlertComparison) (runtimev1.Operation, string, error) {
+	switch c {
+	case database.AlertComparisonGT:
+		return runtimev1.Operation_OPERATION_GT, ">", nil
+	case database.AlertComparisonGTE:
+		return runtimev1.Operation_OPERATION_GTE, ">=", nil
+	case database.AlertComparisonLT:
+		return runtimev1.Operation_OPERATION_LT, "<", nil
+	case database.AlertComparisonLTE:
+		return runtimev1.Operation_OPERATION_LTE, "<=", nil
+	default:
+		return runtimev1.Operation_OPERATION_UNSPECIFIED, "", fmt.Errorf("unsupported comparison %d", c)
+	}
+}
+
+// compareAlertValue returns true if the value crosses the threshold using the comparison.
+func compareAlertValue(c database.AlertComparison, v, threshold float64) bool {
+	switch c {
+	case database.AlertComparisonGT:
+		return v > threshold
+	case database.AlertComparisonGTE:
+		return v >= threshold
+	case database.AlertComparisonLT:
+		return v < threshold
+	case database.AlertComparisonLTE:
+		return v <= threshold
+	default:
+		return false
+	}
+}
+
+// parseAlertWhere parses an alert's JSON-encoded filter expression.
+func parseAlertWhere(whereJSON string) (*runtimev1.Expression, error) {
+	if whereJSON == "" {
+		return nil, nil
+	}
+
+	expr := &runtimev1.Expression{}
+	err := protojson.Unmarshal([]byte(whereJSON), expr)
+	if err != nil {
+		return nil, fmt.Errorf("invalid filter: %w", err)
+	}
+
+	return expr, nil
+}
+
+// numberValue returns the value of a numeric measure. It returns false for nulls, which occur when no rows match.
+func numberValue(v *structpb.Value) (float64, bool) {
+	if v == nil {
+		return 0, false
+	}
+	n, ok := v.Kind.(*structpb.Value_NumberValue)
+	if !ok {
+		return 0, false
+	}
+	return n.NumberValue, true
+}
+
+// dimensionValueString formats a dimension value for use in notifications and as a key in the alert state.
+func dimensionValueString(v *structpb.Value) string {
+	if v == nil {
+		return "null"
+	}
+	switch k := v.Kind.(type) {
+	case *structpb.Value_NullValue:
+		return "null"
+	case *structpb.Value_StringValue:
+		return k.StringValue
+	default:
+		return fmt.Sprint(v.AsInterface())
+	}
+}
@@ -0,0 +1,146 @@
+package admin
+
+import (
+	"context"
+	"errors"
+	"testing"
+	"time"
+
+	"github.com/rilldata/rill/admin/database"
+	"github.com/rilldata/rill/admin/email"
+	"github.com/rilldata/rill/admin/pkg/webhook"
+	"github.com/stretchr/testify/require"
+	"go.uber.org/zap"
+)
+
+func TestDedupeAlertBreaches(t *testing.T) {
+	now := time.Date(2023, 10, 1, 12, 0, 0, 0, time.UTC)
+	hourAgo := now.Add(-time.Hour)
+
+	prev := map[string]time.Time{"US": hourAgo, "DK": hourAgo}
+	breaches := []AlertBreach{{DimensionValue: "US", Value: 10}, {DimensionValue: "SE", Value: 20}}
+
+	// Without re-notification, only new breaches are notified and resolved breaches are dropped
+	notify, state := dedupeAlertBreaches(prev, breaches, 0, now)
+	require.Equal(t, []AlertBreach{{DimensionValue: "SE", Value: 20}}, notify)
+	require.Equal(t, map[string]time.Time{"US": hourAgo, "SE": now}, state)
+
+	// Ongoing breaches are notified again after the re-notification interval
+	notify, state = dedupeAlertBreaches(prev, breaches, 30*time.Minute, now)
+	require.Equal(t, breaches, notify)
+	require.Equal(t, map[string]time.Time{"US": now, "SE": now}, state)
+
+	// No breaches clears the state
+	notify, state = dedupeAlertBreaches(prev, nil, 0, now)
+	require.Empty(t, notify)
+	require.Empty(t, state)
+}
+
+func TestNotifyAlertChannels(t *testing.T) {
+	now := time.Date(2023, 10, 1, 12, 0, 0, 0, time.UTC)
+	alert := &database.Alert{
+		Recipients: []string{"a@example.com", "b@example.com"},
+		WebhookURL: "https://hooks.example.com/alerts",
+		State:      database.AlertState{},
+	}
+	breaches := []AlertBreach{{DimensionValue: "US", Value: 10}}
+
+	// The second recipient fails, which doesn't stop the other channels from being notified
+	var notified []string
+	state, n, err := notifyAlertChannels(alert, breaches, now, func(channel string, notify []AlertBreach) error {
+		notified = append(notified, channel)
+		if channel == database.AlertChannelEmail("b@example.com") {
+			return errors.New("mailbox unavailable")
+		}
+		return nil
+	})
+	require.ErrorContains(t, err, "failed to notify email:b@example.com: mailbox unavailable")
+	require.Equal(t, 2, n)
+	require.Equal(t, []string{"email:a@example.com", "email:b@example.com", "webhook"}, notified)
+	require.Equal(t, database.AlertState{
+		database.AlertChannelEmail("a@example.com"): {"US": now},
+		database.AlertChannelWebhook:                {"US": now},
+	}, state)
+
+	// On the next evaluation, only the failed channel is notified
+	alert.State = state
+	notified = nil
+	later := now.Add(time.Hour)
+	state, n, err = notifyAlertChannels(alert, breaches, later, func(channel string, notify []AlertBreach) error {
+		notified = append(notified, channel)
+		return nil
+	})
+	require.NoError(t, err)
+	require.Equal(t, 1, n)
+	require.Equal(t, []string{"email:b@example.com"}, notified)
+	require.Equal(t, database.AlertState{
+		database.AlertChannelEmail("a@example.com"): {"US": now},
+		database.AlertChannelEmail("b@example.com"): {"US": later},
+		database.AlertChannelWebhook:                {"US": now},
+	}, state)
+}
+
+func TestCompareAlertValue(t *testing.T) {
+	require.True(t, compareAlertValue(database.AlertComparisonGT, 2, 1))
+	require.False(t, compareAlertValue(database.AlertComparisonGT, 1, 1))
+	require.True(t, compareAlertValue(database.AlertComparisonGTE, 1, 1))
+	require.True(t, compareAlertValue(database.AlertComparisonLT, 0, 1))
+	require.False(t, compareAlertValue(database.AlertComparisonLT, 1, 1))
+	require.True(t, compareAlertValue(database.AlertComparisonLTE, 1, 1))
+	require.False(t, compareAlertValue(database.AlertComparisonUnspecified, 1, 1))
+}
+
+func TestValidateAlertQuery(t *testing.T) {
+	require.NoError(t, ValidateAlertQuery(database.AlertComparisonGT, "", ""))
+	require.NoError(t, ValidateAlertQuery(database.AlertComparisonLTE, `{"cond":{"op":"OPERATION_EQ","exprs":[{"ident":"country"},{"val":"US"}]}}`, "PT1H"))
+	require.Error(t, ValidateAlertQuery(database.AlertComparisonUnspecified, "", ""))
+	require.Error(t, ValidateAlertQuery(database.AlertComparisonGT, `{"foo":1}`, ""))
+	require.Error(t, ValidateAlertQuery(database.AlertComparisonGT, "", "1 hour"))
+}
+
+func TestNotifyAlertWebhook(t *testing.T) {
+	var gotURL string
+	var got *alertWebhookPayload
+	postWebhook = func(ctx context.Context, url string, payload any) error {
+		gotURL = url
+		got = payload.(*alertWebhookPayload)
+		return nil
+	}
+	defer func() { postWebhook = webhook.Post }()
+
+	sender, err := email.NewConsoleSender(zap.NewNop(), "rill@example.com", "Rill")
+	require.NoError(t, err)
+	s := &Service{Email: email.New(sender, "https://example.com", "")}
+
+	alert := &database.Alert{
+		Name:             "high-errors",
+		MetricsViewName:  "requests",
+		MeasureName:      "error_rate",
+		Comparison:       database.AlertComparisonGT,
+		Threshold:        0.05,
+		SplitByDimension: "country",
+		Recipients:       []string{"alerts@example.com"},
+		WebhookURL:       "https://hooks.example.com/alerts",
+	}
+	org := &database.Organization{Name: "acme"}
+	proj := &database.Project{Name: "web"}
+	breaches := []AlertBreach{{DimensionValue: "US", Value: 0.07}}
+	now := time.Date(2023, 10, 1, 12, 0, 0, 0, time.UTC)
+
+	err = s.notifyAlert(context.Background(), database.AlertChannelWebhook, alert, org, proj, breaches, now)
+	require.NoError(t, err)
+
+	require.Equal(t, "https://hooks.example.com/alerts", gotURL)
+	require.Equal(t, &alertWebhookPayload{
+		Organization: "acme",
+		Project:      "web",
+		Alert:        "high-errors",
+		MetricsView:  "requests",
+		Measure:      "error_rate",
+		Comparison:   ">",
+		Threshold:    0.05,
+		Dimension:    "country",
+		EvaluatedOn:  now,
+		Breaches:     breaches,
+	}, got)
+}
@@ -83,12 +83,15 @@ type DB interface {
 
 	FindExpiredDeployments(ctx context.Context) ([]*Deployment, error)
 	FindDeploymentsForProject(ctx context.Context, projectID string) ([]*Deployment, error)
+	FindPreviewDeploymentsForProject(ctx context.Context, projectID string) ([]*Deployment, error)
+	FindPreviewDeploymentForBranch(ctx context.Context, projectID, branch string) (*Deployment, error)
 	FindDeployment(ctx context.Context, id string) (*Deployment, error)
 	FindDeploymentByInstanceID(ctx context.Context, instanceID string) (*Deployment, error)
 	InsertDeployment(ctx context.Context, opts *InsertDeploymentOptions) (*Deployment, error)
 	DeleteDeployment(ctx context.Context, id string) error
 	UpdateDeploymentStatus(ctx context.Context, id string, status DeploymentStatus, logs string) (*Deployment, error)
 	UpdateDeploymentBranch(ctx context.Context, id, branch string) (*Deployment, error)
+	UpdateDeploymentPullRequestNumber(ctx context.Context, id string, pullRequestNumber *int) (*Deployment, error)
 	UpdateDeploymentUsedOn(ctx context.Context, ids []string) error
 	CountDeploymentsForOrganization(ctx context.Context, orgID string) (*DeploymentsCount, error)
 
@@ -174,10 +177,45 @@ type DB interface {
 	DeleteProjectInvite(ctx context.Context, id string) error
 	UpdateProjectInviteRole(ctx context.Context, id, roleID string) error
 
+	// FindBookmarks returns the bookmarks of a user and the shared bookmarks in a project.
 	FindBookmarks(ctx context.Context, projectID, userID string) ([]*Bookmark, error)
 	FindBookmark(ctx context.Context, bookmarkID string) (*Bookmark, error)
 	InsertBookmark(ctx context.Context, opts *InsertBookmarkOptions) (*Bookmark, error)
+	UpdateBookmark(ctx context.Context, bookmarkID string, opts *UpdateBookmarkOptions) (*Bookmark, error)
+	// UnsetDefaultBookmark clears the current default bookmark of a dashboard (if any).
+	UnsetDefaultBookmark(ctx context.Context, projectID, dashboardName string) error
 	DeleteBookmark(ctx context.Context, bookmarkID string) error
+
+	FindBookmarkShareToken(ctx context.Context, id string) (*BookmarkShareToken, error)
+	InsertBookmarkShareToken(ctx context.Context, opts *InsertBookmarkShareTokenOptions) (*BookmarkShareToken, error)
+	DeleteBookmarkShareToken(ctx context.Context, id string) error
+	DeleteExpiredBookmarkShareTokens(ctx context.Context, retention time.Duration) error
+
+	FindReportsForProject(ctx context.Context, projectID string) ([]*Report, error)
+	FindReportByName(ctx context.Context, projectID, name string) (*Report, error)
+	// FindReportsDue returns the reports with a next run time before the given time.
+	FindReportsDue(ctx context.Context, before time.Time) ([]*Report, error)
+	InsertReport(ctx context.Context, opts *InsertReportOptions) (*Report, error)
+	UpdateReport(ctx context.Context, id string, opts *UpdateReportOptions) (*Report, error)
+	UpdateReportRun(ctx context.Context, id string, lastRunOn time.Time, lastRunError string, nextRunOn time.Time) error
+	DeleteReport(ctx context.Context, id string) error
+
+	FindAlertsForProject(ctx context.Context, projectID string) ([]*Alert, error)
+	FindAlertByName(ctx context.Context, projectID, name string) (*Alert, error)
+	// FindAlertsDue returns the alerts with a next evaluation time before the given time.
+	FindAlertsDue(ctx context.Context, before time.Time) ([]*Alert, error)
+	InsertAlert(ctx context.Context, opts *InsertAlertOptions) (*Alert, error)
+	UpdateAlert(ctx context.Context, id string, opts *UpdateAlertOptions) (*Alert, error)
+	UpdateAlertRun(ctx context.Context, id string, opts *UpdateAlertRunOptions) error
+	DeleteAlert(ctx context.Context, id string) error
+
+	// FindAuditEventsForOrganization returns the audit events of an organization (including its projects) from newest to oldest.
+	// Pagination continues after the event with the given ID.
+	FindAuditEventsForOrganization(ctx context.Context, orgID, afterID string, limit int) ([]*AuditEvent, error)
+	// FindAuditEventsForProject returns the audit events of a project from newest to oldest.
+	// Pagination continues after the event with the given ID.
+	FindAuditEventsForProject(ctx context.Context, projectID, afterID string, limit int) ([]*AuditEvent, error)
+	InsertAuditEvent(ctx context.Context, opts *InsertAuditEventOptions) (*AuditEvent, error)
 }
 
 // Tx represents a database transaction. It can only be used to commit and rollback transactions.
@@ -253,8 +291,10 @@ type Project struct {
 	ProdSlots            int       `db:"prod_slots"`
 	ProdTTLSeconds       *int64    `db:"prod_ttl_seconds"`
 	ProdDeploymentID     *string   `db:"prod_deployment_id"`
-	CreatedOn            time.Time `db:"created_on"`
-	UpdatedOn            time.Time `db:"updated_on"`
+	// PreviewDeployments enables preview deployments of the project's non-prod branches and pull requests.
+	PreviewDeployments bool      `db:"preview_deployments"`
+	CreatedOn          time.Time `db:"created_on"`
+	UpdatedOn          time.Time `db:"updated_on"`
 }
 
 // Variables implements JSON SQL encoding of variables in Project.
@@ -299,6 +339,7 @@ type UpdateProjectOptions struct {
 	ProdSlots            int
 	ProdTTLSeconds       *int64
 	Region               string
+	PreviewDeployments   bool
 }
 
 // DeploymentStatus is an enum representing the state of a deployment
@@ -314,6 +355,7 @@ const (
 
 // Deployment is a single deployment of a git branch.
 // Deployments belong to a project.
+// A project has at most one prod deployment (see Project.ProdDeploymentID) and any number of preview deployments of other branches.
 type Deployment struct {
 	ID                string           `db:"id"`
 	ProjectID         string           `db:"project_id"`
@@ -324,9 +366,13 @@ type Deployment struct {
 	RuntimeAudience   string           `db:"runtime_audience"`
 	Status            DeploymentStatus `db:"status"`
 	Logs              string           `db:"logs"`
-	CreatedOn         time.Time        `db:"created_on"`
-	UpdatedOn         time.Time        `db:"updated_on"`
-	UsedOn            time.Time        `db:"used_on"`
+	Preview           bool             `db:"preview"`
+	PullRequestNumber *int             `db:"pull_request_number"`
+	// TTLSeconds overrides the project's prod TTL. It's set for preview deployments.
+	TTLSeconds *int64    `db:"ttl_seconds"`
+	CreatedOn  time.Time `db:"created_on"`
+	UpdatedOn  time.Time `db:"updated_on"`
+	UsedOn     time.Time `db:"used_on"`
 }
 
 // InsertDeploymentOptions defines options for inserting a new Deployment.
@@ -339,6 +385,9 @@ type InsertDeploymentOptions struct {
 	RuntimeAudience   string
 	Status            DeploymentStatus
 	Logs              string
+	Preview           bool
+	PullRequestNumber *int
+	TTLSeconds        *int64
 }
 
 // RuntimeSlotsUsed is the result of a ResolveRuntimeSlotsUsed query.
@@ -628,6 +677,8 @@ type Bookmark struct {
 	DashboardName string    `db:"dashboard_name"`
 	ProjectID     string    `db:"project_id"`
 	UserID        string    `db:"user_id"`
+	Shared        bool      `db:"shared"`
+	Default       bool      `db:"is_default"`
 	CreatedOn     time.Time `db:"created_on"`
 	UpdatedOn     time.Time `db:"updated_on"`
 }
@@ -639,4 +690,244 @@ type InsertBookmarkOptions struct {
 	DashboardName string `json:"dashboard_name"`
 	ProjectID     string `json:"project_id"`
 	UserID        string `json:"user_id"`
+	Shared        bool   `json:"shared"`
+	Default       bool   `json:"default"`
+}
+
+// UpdateBookmarkOptions defines options for updating a bookmark
+type UpdateBookmarkOptions struct {
+	DisplayName string `json:"display_name"`
+	Data        []byte `json:"data"`
+	Shared      bool   `json:"shared"`
+	Default     bool   `json:"default"`
+}
+
+// BookmarkShareToken grants public, read-only access to a bookmark's dashboard state until it expires.
+type BookmarkShareToken struct {
+	ID              string
+	SecretHash      []byte    `db:"secret_hash"`
+	BookmarkID      string    `db:"bookmark_id"`
+	CreatedByUserID *string   `db:"created_by_user_id"`
+	ExpiresOn       time.Time `db:"expires_on"`
+	CreatedOn       time.Time `db:"created_on"`
+}
+
+// InsertBookmarkShareTokenOptions defines options for creating a BookmarkShareToken.
+type InsertBookmarkShareTokenOptions struct {
+	ID              string `validate:"required"`
+	SecretHash      []byte `validate:"required"`
+	BookmarkID      string `validate:"required"`
+	CreatedByUserID *string
+	ExpiresOn       time.Time `validate:"required"`
+}
+
+// ReportExportFormat is an enum representing the file format of a report's attachment
+type ReportExportFormat int
+
+const (
+	ReportExportFormatUnspecified ReportExportFormat = 0
+	ReportExportFormatCSV         ReportExportFormat = 1
+	ReportExportFormatXLSX        ReportExportFormat = 2
+)
+
+// Report is a scheduled export of a metrics view query that is emailed to a list of recipients.
+// Reports belong to a project and run against the project's prod deployment.
+type Report struct {
+	ID              string
+	ProjectID       string             `db:"project_id"`
+	Name            string             `db:"name"`
+	Schedule        string             `db:"schedule"`
+	TimeZone        string             `db:"time_zone"`
+	Recipients      Recipients         `db:"recipients"`
+	MetricsViewName string             `db:"metrics_view_name"`
+	QueryArgsJSON   string             `db:"query_args_json"`
+	TimeRange       string             `db:"time_range"`
+	ExportFormat    ReportExportFormat `db:"export_format"`
+	ExportLimit     int                `db:"export_limit"`
+	OwnerUserID     *string            `db:"owner_user_id"`
+	NextRunOn       time.Time          `db:"next_run_on"`
+	LastRunOn       *time.Time         `db:"last_run_on"`
+	LastRunError    string             `db:"last_run_error"`
+	CreatedOn       time.Time          `db:"created_on"`
+	UpdatedOn       time.Time          `db:"updated_on"`
+}
+
+// Recipients implements JSON SQL encoding of the email addresses in Report.
+type Recipients []string
+
+func (r *Recipients) Scan(value interface{}) error {
+	b, ok := value.([]byte)
+	if !ok {
+		return errors.New("failed type assertion to []byte")
+	}
+	return json.Unmarshal(b, &r)
+}
+
+// InsertReportOptions defines options for inserting a new Report.
+type InsertReportOptions struct {
+	ProjectID       string   `validate:"required"`
+	Name            string   `validate:"slug"`
+	Schedule        string   `validate:"required"`
+	TimeZone        string   `validate:"required"`
+	Recipients      []string `validate:"min=1,dive,email"`
+	MetricsViewName string   `validate:"required"`
+	QueryArgsJSON   string
+	TimeRange       string
+	ExportFormat    ReportExportFormat `validate:"min=1"`
+	ExportLimit     int                `validate:"min=0"`
+	OwnerUserID     *string
+	NextRunOn       time.Time
+}
+
+// UpdateReportOptions defines options for updating a Report.
+type UpdateReportOptions struct {
+	Schedule        string   `validate:"required"`
+	TimeZone        string   `validate:"required"`
+	Recipients      []string `validate:"min=1,dive,email"`
+	MetricsViewName string   `validate:"required"`
+	QueryArgsJSON   string
+	TimeRange       string
+	ExportFormat    ReportExportFormat `validate:"min=1"`
+	ExportLimit     int                `validate:"min=0"`
+	OwnerUserID     *string
+	NextRunOn       time.Time
+}
+
+// AlertComparison is an enum representing how an alert compares a measure to its threshold
+type AlertComparison int
+
+const (
+	AlertComparisonUnspecified AlertComparison = 0
+	AlertComparisonGT          AlertComparison = 1
+	AlertComparisonGTE         AlertComparison = 2
+	AlertComparisonLT          AlertComparison = 3
+	AlertComparisonLTE         AlertComparison = 4
+)
+
+// Alert notifies recipients when a metrics view measure crosses a threshold.
+// Alerts belong to a project and are evaluated against the project's prod deployment.
+type Alert struct {
+	ID                   string
+	ProjectID            string          `db:"project_id"`
+	Name                 string          `db:"name"`
+	Schedule             string          `db:"schedule"`
+	TimeZone             string          `db:"time_zone"`
+	MetricsViewName      string          `db:"metrics_view_name"`
+	MeasureName          string          `db:"measure_name"`
+	Comparison           AlertComparison `db:"comparison"`
+	Threshold            float64         `db:"threshold"`
+	SplitByDimension     string          `db:"split_by_dimension"`
+	WhereJSON            string          `db:"where_json"`
+	TimeRange            string          `db:"time_range"`
+	Recipients           Recipients      `db:"recipients"`
+	WebhookURL           string          `db:"webhook_url"`
+	RenotifyAfterSeconds int             `db:"renotify_after_seconds"`
+	OwnerUserID          *string         `db:"owner_user_id"`
+	State                AlertState      `db:"state"`
+	NextRunOn            time.Time       `db:"next_run_on"`
+	LastRunOn            *time.Time      `db:"last_run_on"`
+	LastRunError         string          `db:"last_run_error"`
+	CreatedOn            time.Time       `db:"created_on"`
+	UpdatedOn            time.Time       `db:"updated_on"`
+}
+
+// AlertState tracks the ongoing breaches of an alert for de-duplicating notifications.
+// Delivery is tracked per notification channel, so a failed channel is retried without re-notifying the others.
+// It maps each channel (see AlertChannelEmail and AlertChannelWebhook) to the dimension value of each breach delivered on it
+// (empty for alerts without a dimension split) and when it was last delivered.
+type AlertState map[string]map[string]time.Time
+
+// AlertChannelWebhook is the AlertState key of an alert's webhook.
+const AlertChannelWebhook = "webhook"
+
+// AlertChannelEmail returns the AlertState key of an email recipient of an alert.
+func AlertChannelEmail(recipient string) string {
+	return "email:" + recipient
+}
+
+func (a *AlertState) Scan(value interface{}) error {
+	b, ok := value.([]byte)
+	if !ok {
+		return errors.New("failed type assertion to []byte")
+	}
+	return json.Unmarshal(b, &a)
+}
+
+// InsertAlertOptions defines options for inserting a new Alert.
+type InsertAlertOptions struct {
+	ProjectID            string          `validate:"required"`
+	Name                 string          `validate:"slug"`
+	Schedule             string          `validate:"required"`
+	TimeZone             string          `validate:"required"`
+	MetricsViewName      string          `validate:"required"`
+	MeasureName          string          `validate:"required"`
+	Comparison           AlertComparison `validate:"min=1"`
+	Threshold            float64
+	SplitByDimension     string
+	WhereJSON            string
+	TimeRange            string
+	Recipients           []string `validate:"dive,email"`
+	WebhookURL           string   `validate:"omitempty,http_url"`
+	RenotifyAfterSeconds int      `validate:"min=0"`
+	OwnerUserID          *string
+	NextRunOn            time.Time
+}
+
+// UpdateAlertOptions defines options for updating an Alert.
+// Updating an alert resets its state, so ongoing breaches are notified again.
+type UpdateAlertOptions struct {
+	Schedule             string          `validate:"required"`
+	TimeZone             string          `validate:"required"`
+	MetricsViewName      string          `validate:"required"`
+	MeasureName          string          `validate:"required"`
+	Comparison           AlertComparison `validate:"min=1"`
+	Threshold            float64
+	SplitByDimension     string
+	WhereJSON            string
+	TimeRange            string
+	Recipients           []string `validate:"dive,email"`
+	WebhookURL           string   `validate:"omitempty,http_url"`
+	RenotifyAfterSeconds int      `validate:"min=0"`
+	OwnerUserID          *string
+	NextRunOn            time.Time
+}
+
+// UpdateAlertRunOptions defines options for recording the outcome of an alert evaluation.
+type UpdateAlertRunOptions struct {
+	LastRunOn    time.Time
+	LastRunError string
+	NextRunOn    time.Time
+	State        AlertState
+}
+
+// AuditEvent records a mutation of an organization or one of its projects.
+// The actor and project names are captured when the event is recorded, so they remain readable if the actor or project is deleted.
+type AuditEvent struct {
+	ID          string
+	OrgID       string  `db:"org_id"`
+	ProjectID   *string `db:"project_id"`
+	ProjectName string  `db:"project_name"`
+	ActorType   string  `db:"actor_type"`
+	ActorID     string  `db:"actor_id"`
+	ActorName   string  `db:"actor_name"`
+	// Action is the name of the RPC that caused the event, e.g. "UpdateProjectVariables".
+	Action string `db:"action"`
+	// Target identifies the object affected by the action, e.g. a member's email or a service name.
+	Target string `db:"target"`
+	// Summary is a human-readable description of the changes made by the action.
+	Summary   string    `db:"summary"`
+	CreatedOn time.Time `db:"created_on"`
+}
+
+// InsertAuditEventOptions defines options for recording an AuditEvent.
+type InsertAuditEventOptions struct {
+	OrgID       string `validate:"required"`
+	ProjectID   *string
+	ProjectName string
+	ActorType   string `validate:"required"`
+	ActorID     string
+	ActorName   string
+	Action      string `validate:"required"`
+	Target      string
+	Summary     string
 }
@@ -0,0 +1,4 @@
+ALTER TABLE deployments ADD COLUMN preview BOOLEAN DEFAULT false NOT NULL;
+ALTER TABLE deployments ADD COLUMN pull_request_number INTEGER;
+ALTER TABLE deployments ADD COLUMN ttl_seconds INTEGER;
+CREATE UNIQUE INDEX deployments_preview_branch_idx ON deployments (project_id, branch) WHERE preview;
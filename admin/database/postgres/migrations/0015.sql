@@ -0,0 +1,23 @@
+CREATE TABLE reports (
+	id UUID DEFAULT uuid_generate_v4() PRIMARY KEY,
+	project_id UUID NOT NULL REFERENCES projects (id) ON DELETE CASCADE,
+	name TEXT NOT NULL,
+	schedule TEXT NOT NULL,
+	time_zone TEXT NOT NULL,
+	recipients JSONB NOT NULL,
+	metrics_view_name TEXT NOT NULL,
+	query_args_json TEXT NOT NULL,
+	time_range TEXT NOT NULL DEFAULT '',
+	export_format INTEGER NOT NULL,
+	export_limit INTEGER NOT NULL DEFAULT 0,
+	owner_user_id UUID REFERENCES users (id) ON DELETE SET NULL,
+	next_run_on TIMESTAMPTZ NOT NULL,
+	last_run_on TIMESTAMPTZ,
+	last_run_error TEXT NOT NULL DEFAULT '',
+	created_on TIMESTAMPTZ DEFAULT now() NOT NULL,
+	updated_on TIMESTAMPTZ DEFAULT now() NOT NULL
+);
+
+CREATE UNIQUE INDEX reports_name_idx ON reports (project_id, lower(name));
+
+CREATE INDEX reports_next_run_on_idx ON reports (next_run_on);
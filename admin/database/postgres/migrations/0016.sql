@@ -0,0 +1,28 @@
+CREATE TABLE alerts (
+	id UUID DEFAULT uuid_generate_v4() PRIMARY KEY,
+	project_id UUID NOT NULL REFERENCES projects (id) ON DELETE CASCADE,
+	name TEXT NOT NULL,
+	schedule TEXT NOT NULL,
+	time_zone TEXT NOT NULL,
+	metrics_view_name TEXT NOT NULL,
+	measure_name TEXT NOT NULL,
+	comparison INTEGER NOT NULL,
+	threshold DOUBLE PRECISION NOT NULL,
+	split_by_dimension TEXT NOT NULL DEFAULT '',
+	where_json TEXT NOT NULL DEFAULT '',
+	time_range TEXT NOT NULL DEFAULT '',
+	recipients JSONB NOT NULL,
+	webhook_url TEXT NOT NULL DEFAULT '',
+	renotify_after_seconds INTEGER NOT NULL DEFAULT 0,
+	owner_user_id UUID REFERENCES users (id) ON DELETE SET NULL,
+	state JSONB DEFAULT '{}'::JSONB NOT NULL,
+	next_run_on TIMESTAMPTZ NOT NULL,
+	last_run_on TIMESTAMPTZ,
+	last_run_error TEXT NOT NULL DEFAULT '',
+	created_on TIMESTAMPTZ DEFAULT now() NOT NULL,
+	updated_on TIMESTAMPTZ DEFAULT now() NOT NULL
+);
+
+CREATE UNIQUE INDEX alerts_name_idx ON alerts (project_id, lower(name));
+
+CREATE INDEX alerts_next_run_on_idx ON alerts (next_run_on);
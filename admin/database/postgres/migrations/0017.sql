@@ -0,0 +1,17 @@
+ALTER TABLE bookmarks ADD COLUMN shared BOOLEAN NOT NULL DEFAULT false;
+ALTER TABLE bookmarks ADD COLUMN is_default BOOLEAN NOT NULL DEFAULT false;
+
+CREATE INDEX bookmarks_project_idx ON bookmarks (project_id);
+
+CREATE UNIQUE INDEX bookmarks_default_idx ON bookmarks (project_id, lower(dashboard_name)) WHERE is_default;
+
+CREATE TABLE bookmark_share_tokens (
+	id UUID DEFAULT uuid_generate_v4() PRIMARY KEY,
+	secret_hash BYTEA NOT NULL,
+	bookmark_id UUID NOT NULL REFERENCES bookmarks (id) ON DELETE CASCADE,
+	created_by_user_id UUID REFERENCES users (id) ON DELETE SET NULL,
+	expires_on TIMESTAMPTZ NOT NULL,
+	created_on TIMESTAMPTZ DEFAULT now() NOT NULL
+);
+
+CREATE INDEX bookmark_share_tokens_bookmark_idx ON bookmark_share_tokens (bookmark_id);
@@ -0,0 +1,17 @@
+CREATE TABLE audit_events (
+	id UUID DEFAULT uuid_generate_v4() PRIMARY KEY,
+	org_id UUID NOT NULL REFERENCES orgs (id) ON DELETE CASCADE,
+	project_id UUID REFERENCES projects (id) ON DELETE SET NULL,
+	project_name TEXT NOT NULL DEFAULT '',
+	actor_type TEXT NOT NULL,
+	actor_id TEXT NOT NULL DEFAULT '',
+	actor_name TEXT NOT NULL DEFAULT '',
+	action TEXT NOT NULL,
+	target TEXT NOT NULL DEFAULT '',
+	summary TEXT NOT NULL DEFAULT '',
+	created_on TIMESTAMPTZ DEFAULT now() NOT NULL
+);
+
+CREATE INDEX audit_events_org_idx ON audit_events (org_id, created_on DESC, id DESC);
+
+CREATE INDEX audit_events_project_idx ON audit_events (project_id, created_on DESC, id DESC);
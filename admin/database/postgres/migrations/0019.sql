@@ -0,0 +1,2 @@
+-- Alert state is now tracked per notification channel, so the breaches in the old format are notified again
+UPDATE alerts SET state = '{}'::JSONB;
@@ -0,0 +1 @@
+ALTER TABLE projects ADD COLUMN preview_deployments BOOLEAN DEFAULT false NOT NULL;
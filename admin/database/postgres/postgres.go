@@ -345,9 +345,9 @@ func (c *connection) UpdateProject(ctx context.Context, id string, opts *databas
 
 	res := &database.Project{}
 	err := c.getDB(ctx).QueryRowxContext(ctx, `
-		UPDATE projects SET name=$1, description=$2, public=$3, prod_branch=$4, prod_variables=$5, github_url=$6, github_installation_id=$7, prod_deployment_id=$8, region=$9, prod_slots=$10, prod_ttl_seconds=$11, updated_on=now()
-		WHERE id=$12 RETURNING *`,
-		opts.Name, opts.Description, opts.Public, opts.ProdBranch, database.Variables(opts.ProdVariables), opts.GithubURL, opts.GithubInstallationID, opts.ProdDeploymentID, opts.Region, opts.ProdSlots, opts.ProdTTLSeconds, id,
+		UPDATE projects SET name=$1, description=$2, public=$3, prod_branch=$4, prod_variables=$5, github_url=$6, github_installation_id=$7, prod_deployment_id=$8, region=$9, prod_slots=$10, prod_ttl_seconds=$11, preview_deployments=$12, updated_on=now()
+		WHERE id=$13 RETURNING *`,
+		opts.Name, opts.Description, opts.Public, opts.ProdBranch, database.Variables(opts.ProdVariables), opts.GithubURL, opts.GithubInstallationID, opts.ProdDeploymentID, opts.Region, opts.ProdSlots, opts.ProdTTLSeconds, opts.PreviewDeployments, id,
 	).StructScan(res)
 	if err != nil {
 		return nil, parseErr("project", err)
@@ -375,13 +375,13 @@ func (c *connection) CountProjectsForOrganization(ctx context.Context, orgID str
 	return count, nil
 }
 
-// FindExpiredDeployments returns all the deployments which are expired as per prod ttl
+// FindExpiredDeployments returns all the deployments which are expired as per their own ttl (for preview deployments) or the prod ttl
 func (c *connection) FindExpiredDeployments(ctx context.Context) ([]*database.Deployment, error) {
 	var res []*database.Deployment
 	err := c.getDB(ctx).SelectContext(ctx, &res, `
 		SELECT d.* FROM deployments d 
 		JOIN projects p ON d.project_id = p.id
-		WHERE p.prod_ttl_seconds IS NOT NULL AND d.used_on + p.prod_ttl_seconds * interval '1 second' < now()
+		WHERE COALESCE(d.ttl_seconds, p.prod_ttl_seconds) IS NOT NULL AND d.used_on + COALESCE(d.ttl_seconds, p.prod_ttl_seconds) * interval '1 second' < now()
 	`)
 	if err != nil {
 		return nil, parseErr("deployments", err)
@@ -398,6 +398,24 @@ func (c *connection) FindDeploymentsForProject(ctx context.Context, projectID st
 	return res, nil
 }
 
+func (c *connection) FindPreviewDeploymentsForProject(ctx context.Context, projectID string) ([]*database.Deployment, error) {
+	var res []*database.Deployment
+	err := c.getDB(ctx).SelectContext(ctx, &res, "SELECT * FROM deployments d WHERE d.project_id=$1 AND d.preview ORDER BY d.branch", projectID)
+	if err != nil {
+		return nil, parseErr("deployments", err)
+	}
+	return res, nil
+}
+
+func (c *connection) FindPreviewDeploymentForBranch(ctx context.Context, projectID, branch string) (*database.Deployment, error) {
+	res := &database.Deployment{}
+	err := c.getDB(ctx).QueryRowxContext(ctx, "SELECT * FROM deployments d WHERE d.project_id=$1 AND d.branch=$2 AND d.preview", projectID, branch).StructScan(res)
+	if err != nil {
+		return nil, parseErr("deployment", err)
+	}
+	return res, nil
+}
+
 func (c *connection) FindDeployment(ctx context.Context, id string) (*database.Deployment, error) {
 	res := &database.Deployment{}
 	err := c.getDB(ctx).QueryRowxContext(ctx, "SELECT d.* FROM deployments d WHERE d.id=$1", id).StructScan(res)
@@ -423,9 +441,9 @@ func (c *connection) InsertDeployment(ctx context.Context, opts *database.Insert
 
 	res := &database.Deployment{}
 	err := c.getDB(ctx).QueryRowxContext(ctx, `
-		INSERT INTO deployments (project_id, slots, branch, runtime_host, runtime_instance_id, runtime_audience, status, logs)
-		VALUES ($1, $2, $3, $4, $5, $6, $7, $8) RETURNING *`,
-		opts.ProjectID, opts.Slots, opts.Branch, opts.RuntimeHost, opts.RuntimeInstanceID, opts.RuntimeAudience, opts.Status, opts.Logs,
+		INSERT INTO deployments (project_id, slots, branch, runtime_host, runtime_instance_id, runtime_audience, status, logs, preview, pull_request_number, ttl_seconds)
+		VALUES ($1, $2, $3, $4, $5, $6, $7, $8, $9, $10, $11) RETURNING *`,
+		opts.ProjectID, opts.Slots, opts.Branch, opts.RuntimeHost, opts.RuntimeInstanceID, opts.RuntimeAudience, opts.Status, opts.Logs, opts.Preview, opts.PullRequestNumber, opts.TTLSeconds,
 	).StructScan(res)
 	if err != nil {
 		return nil, parseErr("deployment", err)
@@ -464,6 +482,15 @@ func (c *connection) UpdateDeploymentBranch(ctx context.Context, id, branch stri
 	return res, nil
 }
 
+func (c *connection) UpdateDeploymentPullRequestNumber(ctx context.Context, id string, pullRequestNumber *int) (*database.Deployment, error) {
+	res := &database.Deployment{}
+	err := c.getDB(ctx).QueryRowxContext(ctx, "UPDATE deployments SET pull_request_number=$1, updated_on=now() WHERE id=$2 RETURNING *", pullRequestNumber, id).StructScan(res)
+	if err != nil {
+		return nil, parseErr("deployment", err)
+	}
+	return res, nil
+}
+
 func (c *connection) CountDeploymentsForOrganization(ctx context.Context, orgID string) (*database.DeploymentsCount, error) {
 	res := &database.DeploymentsCount{}
 	err := c.getDB(ctx).QueryRowxContext(ctx, `
@@ -1162,10 +1189,10 @@ func (c *connection) UpdateProjectInviteRole(ctx context.Context, id, roleID str
 	return checkUpdateRow("project invite", res, err)
 }
 
-// FindBookmarks returns a list of bookmarks for a user per project
+// FindBookmarks returns a list of bookmarks for a user per project, including the bookmarks shared with the project
 func (c *connection) FindBookmarks(ctx context.Context, projectID, userID string) ([]*database.Bookmark, error) {
 	var res []*database.Bookmark
-	err := c.getDB(ctx).SelectContext(ctx, &res, "SELECT * FROM bookmarks WHERE project_id = $1 and user_id = $2", projectID, userID)
+	err := c.getDB(ctx).SelectContext(ctx, &res, "SELECT * FROM bookmarks WHERE project_id = $1 and (user_id = $2 or shared)", projectID, userID)
 	if err != nil {
 		return nil, parseErr("bookmarks", err)
 	}
@@ -1189,21 +1216,299 @@ func (c *connection) InsertBookmark(ctx context.Context, opts *database.InsertBo
 	}
 
 	res := &database.Bookmark{}
-	err := c.getDB(ctx).QueryRowxContext(ctx, `INSERT INTO bookmarks (display_name, data, dashboard_name, project_id, user_id) 
-		VALUES ($1, $2, $3, $4, $5) RETURNING *`,
-		opts.DisplayName, opts.Data, opts.DashboardName, opts.ProjectID, opts.UserID).StructScan(res)
+	err := c.getDB(ctx).QueryRowxContext(ctx, `INSERT INTO bookmarks (display_name, data, dashboard_name, project_id, user_id, shared, is_default) 
+		VALUES ($1, $2, $3, $4, $5, $6, $7) RETURNING *`,
+		opts.DisplayName, opts.Data, opts.DashboardName, opts.ProjectID, opts.UserID, opts.Shared, opts.Default).StructScan(res)
 	if err != nil {
 		return nil, parseErr("bookmarks", err)
 	}
 	return res, nil
 }
 
+// UpdateBookmark updates a bookmark for a given bookmark id
+func (c *connection) UpdateBookmark(ctx context.Context, bookmarkID string, opts *database.UpdateBookmarkOptions) (*database.Bookmark, error) {
+	if err := database.Validate(opts); err != nil {
+		return nil, err
+	}
+
+	res := &database.Bookmark{}
+	err := c.getDB(ctx).QueryRowxContext(ctx, `UPDATE bookmarks SET display_name = $1, data = $2, shared = $3, is_default = $4, updated_on = now() 
+		WHERE id = $5 RETURNING *`,
+		opts.DisplayName, opts.Data, opts.Shared, opts.Default, bookmarkID).StructScan(res)
+	if err != nil {
+		return nil, parseErr("bookmarks", err)
+	}
+	return res, nil
+}
+
+// UnsetDefaultBookmark clears the default flag of the default bookmark of a dashboard
+func (c *connection) UnsetDefaultBookmark(ctx context.Context, projectID, dashboardName string) error {
+	_, err := c.getDB(ctx).ExecContext(ctx, "UPDATE bookmarks SET is_default = false, updated_on = now() WHERE project_id = $1 AND lower(dashboard_name) = lower($2) AND is_default", projectID, dashboardName)
+	return parseErr("bookmarks", err)
+}
+
 // DeleteBookmark deletes a bookmark for a given bookmark id
 func (c *connection) DeleteBookmark(ctx context.Context, bookmarkID string) error {
 	res, err := c.getDB(ctx).ExecContext(ctx, "DELETE FROM bookmarks WHERE id = $1", bookmarkID)
 	return checkDeleteRow("bookmarks", res, err)
 }
 
+// FindBookmarkShareToken returns a bookmark share token by its ID
+func (c *connection) FindBookmarkShareToken(ctx context.Context, id string) (*database.BookmarkShareToken, error) {
+	res := &database.BookmarkShareToken{}
+	err := c.getDB(ctx).QueryRowxContext(ctx, "SELECT * FROM bookmark_share_tokens WHERE id = $1", id).StructScan(res)
+	if err != nil {
+		return nil, parseErr("share token", err)
+	}
+	return res, nil
+}
+
+// InsertBookmarkShareToken inserts a share token for a bookmark
+func (c *connection) InsertBookmarkShareToken(ctx context.Context, opts *database.InsertBookmarkShareTokenOptions) (*database.BookmarkShareToken, error) {
+	if err := database.Validate(opts); err != nil {
+		return nil, err
+	}
+
+	res := &database.BookmarkShareToken{}
+	err := c.getDB(ctx).QueryRowxContext(ctx, `
+		INSERT INTO bookmark_share_tokens (id, secret_hash, bookmark_id, created_by_user_id, expires_on)
+		VALUES ($1, $2, $3, $4, $5) RETURNING *`,
+		opts.ID, opts.SecretHash, opts.BookmarkID, opts.CreatedByUserID, opts.ExpiresOn,
+	).StructScan(res)
+	if err != nil {
+		return nil, parseErr("share token", err)
+	}
+	return res, nil
+}
+
+// DeleteBookmarkShareToken deletes a bookmark share token
+func (c *connection) DeleteBookmarkShareToken(ctx context.Context, id string) error {
+	res, err := c.getDB(ctx).ExecContext(ctx, "DELETE FROM bookmark_share_tokens WHERE id = $1", id)
+	return checkDeleteRow("share token", res, err)
+}
+
+// DeleteExpiredBookmarkShareTokens deletes the bookmark share tokens that expired more than the retention period ago
+func (c *connection) DeleteExpiredBookmarkShareTokens(ctx context.Context, retention time.Duration) error {
+	_, err := c.getDB(ctx).ExecContext(ctx, "DELETE FROM bookmark_share_tokens WHERE expires_on + $1 < now()", retention)
+	return parseErr("share token", err)
+}
+
+// FindReportsForProject returns the reports of a project
+func (c *connection) FindReportsForProject(ctx context.Context, projectID string) ([]*database.Report, error) {
+	var res []*database.Report
+	err := c.getDB(ctx).SelectContext(ctx, &res, "SELECT * FROM reports WHERE project_id=$1 ORDER BY lower(name)", projectID)
+	if err != nil {
+		return nil, parseErr("reports", err)
+	}
+	return res, nil
+}
+
+// FindReportByName returns a report by its name (case insensitive)
+func (c *connection) FindReportByName(ctx context.Context, projectID, name string) (*database.Report, error) {
+	res := &database.Report{}
+	err := c.getDB(ctx).QueryRowxContext(ctx, "SELECT * FROM reports WHERE project_id=$1 AND lower(name)=lower($2)", projectID, name).StructScan(res)
+	if err != nil {
+		return nil, parseErr("report", err)
+	}
+	return res, nil
+}
+
+// FindReportsDue returns the reports that should have run before the given time
+func (c *connection) FindReportsDue(ctx context.Context, before time.Time) ([]*database.Report, error) {
+	var res []*database.Report
+	err := c.getDB(ctx).SelectContext(ctx, &res, "SELECT * FROM reports WHERE next_run_on <= $1 ORDER BY next_run_on", before)
+	if err != nil {
+		return nil, parseErr("reports", err)
+	}
+	return res, nil
+}
+
+// InsertReport inserts a report for a project
+func (c *connection) InsertReport(ctx context.Context, opts *database.InsertReportOptions) (*database.Report, error) {
+	if err := database.Validate(opts); err != nil {
+		return nil, err
+	}
+
+	res := &database.Report{}
+	err := c.getDB(ctx).QueryRowxContext(ctx, `
+		INSERT INTO reports (project_id, name, schedule, time_zone, recipients, metrics_view_name, query_args_json, time_range, export_format, export_limit, owner_user_id, next_run_on)
+		VALUES ($1, $2, $3, $4, $5, $6, $7, $8, $9, $10, $11, $12) RETURNING *`,
+		opts.ProjectID, opts.Name, opts.Schedule, opts.TimeZone, database.Recipients(opts.Recipients), opts.MetricsViewName, opts.QueryArgsJSON, opts.TimeRange, opts.ExportFormat, opts.ExportLimit, opts.OwnerUserID, opts.NextRunOn,
+	).StructScan(res)
+	if err != nil {
+		return nil, parseErr("report", err)
+	}
+	return res, nil
+}
+
+// UpdateReport updates a report's options
+func (c *connection) UpdateReport(ctx context.Context, id string, opts *database.UpdateReportOptions) (*database.Report, error) {
+	if err := database.Validate(opts); err != nil {
+		return nil, err
+	}
+
+	res := &database.Report{}
+	err := c.getDB(ctx).QueryRowxContext(ctx, `
+		UPDATE reports SET schedule=$1, time_zone=$2, recipients=$3, metrics_view_name=$4, query_args_json=$5, time_range=$6, export_format=$7, export_limit=$8, owner_user_id=$9, next_run_on=$10, updated_on=now()
+		WHERE id=$11 RETURNING *`,
+		opts.Schedule, opts.TimeZone, database.Recipients(opts.Recipients), opts.MetricsViewName, opts.QueryArgsJSON, opts.TimeRange, opts.ExportFormat, opts.ExportLimit, opts.OwnerUserID, opts.NextRunOn, id,
+	).StructScan(res)
+	if err != nil {
+		return nil, parseErr("report", err)
+	}
+	return res, nil
+}
+
+// UpdateReportRun records the outcome of a report run and sets its next run time
+func (c *connection) UpdateReportRun(ctx context.Context, id string, lastRunOn time.Time, lastRunError string, nextRunOn time.Time) error {
+	res, err := c.getDB(ctx).ExecContext(ctx, "UPDATE reports SET last_run_on=$1, last_run_error=$2, next_run_on=$3 WHERE id=$4", lastRunOn, lastRunError, nextRunOn, id)
+	return checkUpdateRow("report", res, err)
+}
+
+// DeleteReport deletes a report
+func (c *connection) DeleteReport(ctx context.Context, id string) error {
+	res, err := c.getDB(ctx).ExecContext(ctx, "DELETE FROM reports WHERE id=$1", id)
+	return checkDeleteRow("report", res, err)
+}
+
+// FindAlertsForProject returns the alerts of a project
+func (c *connection) FindAlertsForProject(ctx context.Context, projectID string) ([]*database.Alert, error) {
+	var res []*database.Alert
+	err := c.getDB(ctx).SelectContext(ctx, &res, "SELECT * FROM alerts WHERE project_id=$1 ORDER BY lower(name)", projectID)
+	if err != nil {
+		return nil, parseErr("alerts", err)
+	}
+	return res, nil
+}
+
+// FindAlertByName returns an alert by its name (case insensitive)
+func (c *connection) FindAlertByName(ctx context.Context, projectID, name string) (*database.Alert, error) {
+	res := &database.Alert{}
+	err := c.getDB(ctx).QueryRowxContext(ctx, "SELECT * FROM alerts WHERE project_id=$1 AND lower(name)=lower($2)", projectID, name).StructScan(res)
+	if err != nil {
+		return nil, parseErr("alert", err)
+	}
+	return res, nil
+}
+
+// FindAlertsDue returns the alerts that should have been evaluated before the given time
+func (c *connection) FindAlertsDue(ctx context.Context, before time.Time) ([]*database.Alert, error) {
+	var res []*database.Alert
+	err := c.getDB(ctx).SelectContext(ctx, &res, "SELECT * FROM alerts WHERE next_run_on <= $1 ORDER BY next_run_on", before)
+	if err != nil {
+		return nil, parseErr("alerts", err)
+	}
+	return res, nil
+}
+
+// InsertAlert inserts an alert for a project
+func (c *connection) InsertAlert(ctx context.Context, opts *database.InsertAlertOptions) (*database.Alert, error) {
+	if err := database.Validate(opts); err != nil {
+		return nil, err
+	}
+
+	// Recipients are optional for alerts, but the column is not nullable
+	recipients := database.Recipients(opts.Recipients)
+	if recipients == nil {
+		recipients = database.Recipients{}
+	}
+
+	res := &database.Alert{}
+	err := c.getDB(ctx).QueryRowxContext(ctx, `
+		INSERT INTO alerts (project_id, name, schedule, time_zone, metrics_view_name, measure_name, comparison, threshold, split_by_dimension, where_json, time_range, recipients, webhook_url, renotify_after_seconds, owner_user_id, next_run_on)
+		VALUES ($1, $2, $3, $4, $5, $6, $7, $8, $9, $10, $11, $12, $13, $14, $15, $16) RETURNING *`,
+		opts.ProjectID, opts.Name, opts.Schedule, opts.TimeZone, opts.MetricsViewName, opts.MeasureName, opts.Comparison, opts.Threshold, opts.SplitByDimension, opts.WhereJSON, opts.TimeRange, recipients, opts.WebhookURL, opts.RenotifyAfterSeconds, opts.OwnerUserID, opts.NextRunOn,
+	).StructScan(res)
+	if err != nil {
+		return nil, parseErr("alert", err)
+	}
+	return res, nil
+}
+
+// UpdateAlert updates an alert's options and resets its state
+func (c *connection) UpdateAlert(ctx context.Context, id string, opts *database.UpdateAlertOptions) (*database.Alert, error) {
+	if err := database.Validate(opts); err != nil {
+		return nil, err
+	}
+
+	// Recipients are optional for alerts, but the column is not nullable
+	recipients := database.Recipients(opts.Recipients)
+	if recipients == nil {
+		recipients = database.Recipients{}
+	}
+
+	res := &database.Alert{}
+	err := c.getDB(ctx).QueryRowxContext(ctx, `
+		UPDATE alerts SET schedule=$1, time_zone=$2, metrics_view_name=$3, measure_name=$4, comparison=$5, threshold=$6, split_by_dimension=$7, where_json=$8, time_range=$9, recipients=$10, webhook_url=$11, renotify_after_seconds=$12, owner_user_id=$13, next_run_on=$14, state='{}'::JSONB, updated_on=now()
+		WHERE id=$15 RETURNING *`,
+		opts.Schedule, opts.TimeZone, opts.MetricsViewName, opts.MeasureName, opts.Comparison, opts.Threshold, opts.SplitByDimension, opts.WhereJSON, opts.TimeRange, recipients, opts.WebhookURL, opts.RenotifyAfterSeconds, opts.OwnerUserID, opts.NextRunOn, id,
+	).StructScan(res)
+	if err != nil {
+		return nil, parseErr("alert", err)
+	}
+	return res, nil
+}
+
+// UpdateAlertRun records the outcome of an alert evaluation, its new state and its next evaluation time
+func (c *connection) UpdateAlertRun(ctx context.Context, id string, opts *database.UpdateAlertRunOptions) error {
+	state := opts.State
+	if state == nil {
+		state = database.AlertState{}
+	}
+
+	res, err := c.getDB(ctx).ExecContext(ctx, "UPDATE alerts SET last_run_on=$1, last_run_error=$2, next_run_on=$3, state=$4 WHERE id=$5", opts.LastRunOn, opts.LastRunError, opts.NextRunOn, state, id)
+	return checkUpdateRow("alert", res, err)
+}
+
+// DeleteAlert deletes an alert
+func (c *connection) DeleteAlert(ctx context.Context, id string) error {
+	res, err := c.getDB(ctx).ExecContext(ctx, "DELETE FROM alerts WHERE id=$1", id)
+	return checkDeleteRow("alert", res, err)
+}
+
+func (c *connection) FindAuditEventsForOrganization(ctx context.Context, orgID, afterID string, limit int) ([]*database.AuditEvent, error) {
+	var res []*database.AuditEvent
+	err := c.getDB(ctx).SelectContext(ctx, &res, `
+		SELECT * FROM audit_events
+		WHERE org_id=$1 AND ($2 = '' OR (created_on, id) < (SELECT a.created_on, a.id FROM audit_events a WHERE a.id::TEXT = $2))
+		ORDER BY created_on DESC, id DESC LIMIT $3
+	`, orgID, afterID, limit)
+	if err != nil {
+		return nil, parseErr("audit events", err)
+	}
+	return res, nil
+}
+
+func (c *connection) FindAuditEventsForProject(ctx context.Context, projectID, afterID string, limit int) ([]*database.AuditEvent, error) {
+	var res []*database.AuditEvent
+	err := c.getDB(ctx).SelectContext(ctx, &res, `
+		SELECT * FROM audit_events
+		WHERE project_id=$1 AND ($2 = '' OR (created_on, id) < (SELECT a.created_on, a.id FROM audit_events a WHERE a.id::TEXT = $2))
+		ORDER BY created_on DESC, id DESC LIMIT $3
+	`, projectID, afterID, limit)
+	if err != nil {
+		return nil, parseErr("audit events", err)
+	}
+	return res, nil
+}
+
+func (c *connection) InsertAuditEvent(ctx context.Context, opts *database.InsertAuditEventOptions) (*database.AuditEvent, error) {
+	if err := database.Validate(opts); err != nil {
+		return nil, err
+	}
+
+	res := &database.AuditEvent{}
+	err := c.getDB(ctx).QueryRowxContext(ctx, `
+		INSERT INTO audit_events (org_id, project_id, project_name, actor_type, actor_id, actor_name, action, target, summary)
+		VALUES ($1, $2, $3, $4, $5, $6, $7, $8, $9) RETURNING *`,
+		opts.OrgID, opts.ProjectID, opts.ProjectName, opts.ActorType, opts.ActorID, opts.ActorName, opts.Action, opts.Target, opts.Summary,
+	).StructScan(res)
+	if err != nil {
+		return nil, parseErr("audit event", err)
+	}
+	return res, nil
+}
+
 func checkUpdateRow(target string, res sql.Result, err error) error {
 	if err != nil {
 		return parseErr(target, err)
@@ -1286,6 +1591,14 @@ func parseErr(target string, err error) error {
 			return newAlreadyExistsErr("domain has already been added for the org")
 		case "service_name_idx":
 			return newAlreadyExistsErr("a service with that name already exists in the org")
+		case "deployments_preview_branch_idx":
+			return newAlreadyExistsErr("a preview deployment already exists for the branch")
+		case "reports_name_idx":
+			return newAlreadyExistsErr("a report with that name already exists in the project")
+		case "alerts_name_idx":
+			return newAlreadyExistsErr("an alert with that name already exists in the project")
+		case "bookmarks_default_idx":
+			return newAlreadyExistsErr("the dashboard already has a default bookmark")
 		default:
 			if target == "" {
 				return database.ErrNotUnique
@@ -2,9 +2,11 @@ package postgres
 
 import (
 	"context"
+	"fmt"
 	"testing"
 	"time"
 
+	"github.com/google/uuid"
 	"github.com/rilldata/rill/admin/database"
 	"github.com/rilldata/rill/admin/pkg/pgtestcontainer"
 	"github.com/stretchr/testify/require"
@@ -26,6 +28,11 @@ func TestPostgres(t *testing.T) {
 
 	t.Run("TestOrganizations", func(t *testing.T) { testOrganizations(t, db) })
 	t.Run("TestProjects", func(t *testing.T) { testProjects(t, db) })
+	t.Run("TestPreviewDeployments", func(t *testing.T) { testPreviewDeployments(t, db) })
+	t.Run("TestReports", func(t *testing.T) { testReports(t, db) })
+	t.Run("TestAlerts", func(t *testing.T) { testAlerts(t, db) })
+	t.Run("TestBookmarks", func(t *testing.T) { testBookmarks(t, db) })
+	t.Run("TestAuditEvents", func(t *testing.T) { testAuditEvents(t, db) })
 	// Add new tests here
 	t.Run("TestProjectsWithVariables", func(t *testing.T) { testProjectsWithVariables(t, db) })
 
@@ -144,6 +151,320 @@ func testProjects(t *testing.T, db database.DB) {
 	require.Nil(t, org)
 }
 
+func testPreviewDeployments(t *testing.T, db database.DB) {
+	ctx := context.Background()
+
+	org, err := db.InsertOrganization(ctx, &database.InsertOrganizationOptions{Name: "preview-org"})
+	require.NoError(t, err)
+
+	proj, err := db.InsertProject(ctx, &database.InsertProjectOptions{OrganizationID: org.ID, Name: "preview-proj"})
+	require.NoError(t, err)
+	require.False(t, proj.PreviewDeployments)
+
+	proj, err = db.UpdateProject(ctx, proj.ID, &database.UpdateProjectOptions{Name: proj.Name, PreviewDeployments: true})
+	require.NoError(t, err)
+	require.True(t, proj.PreviewDeployments)
+
+	_, err = db.FindPreviewDeploymentForBranch(ctx, proj.ID, "feature")
+	require.ErrorIs(t, err, database.ErrNotFound)
+
+	pr := 42
+	ttl := int64(3600)
+	depl, err := db.InsertDeployment(ctx, &database.InsertDeploymentOptions{
+		ProjectID:         proj.ID,
+		Branch:            "feature",
+		RuntimeHost:       "http://localhost:9091",
+		RuntimeInstanceID: "preview",
+		Status:            database.DeploymentStatusPending,
+		Preview:           true,
+		PullRequestNumber: &pr,
+		TTLSeconds:        &ttl,
+	})
+	require.NoError(t, err)
+	require.True(t, depl.Preview)
+	require.Equal(t, pr, *depl.PullRequestNumber)
+	require.Equal(t, ttl, *depl.TTLSeconds)
+
+	// Only one preview deployment is allowed per branch
+	_, err = db.InsertDeployment(ctx, &database.InsertDeploymentOptions{
+		ProjectID:         proj.ID,
+		Branch:            "feature",
+		RuntimeHost:       "http://localhost:9091",
+		RuntimeInstanceID: "preview2",
+		Status:            database.DeploymentStatusPending,
+		Preview:           true,
+	})
+	require.ErrorIs(t, err, database.ErrNotUnique)
+
+	depl, err = db.FindPreviewDeploymentForBranch(ctx, proj.ID, "feature")
+	require.NoError(t, err)
+	require.Equal(t, "preview", depl.RuntimeInstanceID)
+
+	depls, err := db.FindPreviewDeploymentsForProject(ctx, proj.ID)
+	require.NoError(t, err)
+	require.Len(t, depls, 1)
+
+	depl, err = db.UpdateDeploymentPullRequestNumber(ctx, depl.ID, nil)
+	require.NoError(t, err)
+	require.Nil(t, depl.PullRequestNumber)
+
+	require.NoError(t, db.DeleteDeployment(ctx, depl.ID))
+	require.NoError(t, db.DeleteProject(ctx, proj.ID))
+	require.NoError(t, db.DeleteOrganization(ctx, org.Name))
+}
+
+func testReports(t *testing.T, db database.DB) {
+	ctx := context.Background()
+
+	org, err := db.InsertOrganization(ctx, &database.InsertOrganizationOptions{Name: "report-org"})
+	require.NoError(t, err)
+
+	proj, err := db.InsertProject(ctx, &database.InsertProjectOptions{OrganizationID: org.ID, Name: "report-proj"})
+	require.NoError(t, err)
+
+	nextRunOn := time.Now().Add(time.Hour).Truncate(time.Second)
+	report, err := db.InsertReport(ctx, &database.InsertReportOptions{
+		ProjectID:       proj.ID,
+		Name:            "weekly",
+		Schedule:        "0 9 * * 1",
+		TimeZone:        "UTC",
+		Recipients:      []string{"a@example.com", "b@example.com"},
+		MetricsViewName: "mv",
+		TimeRange:       "P7D",
+		ExportFormat:    database.ReportExportFormatCSV,
+		NextRunOn:       nextRunOn,
+	})
+	require.NoError(t, err)
+	require.Equal(t, database.Recipients{"a@example.com", "b@example.com"}, report.Recipients)
+	require.Equal(t, "P7D", report.TimeRange)
+	require.True(t, nextRunOn.Equal(report.NextRunOn))
+	require.Nil(t, report.LastRunOn)
+
+	_, err = db.InsertReport(ctx, &database.InsertReportOptions{
+		ProjectID:       proj.ID,
+		Name:            "Weekly",
+		Schedule:        "0 9 * * 1",
+		TimeZone:        "UTC",
+		Recipients:      []string{"a@example.com"},
+		MetricsViewName: "mv",
+		ExportFormat:    database.ReportExportFormatCSV,
+		NextRunOn:       nextRunOn,
+	})
+	require.ErrorIs(t, err, database.ErrNotUnique)
+
+	report, err = db.FindReportByName(ctx, proj.ID, "WEEKLY")
+	require.NoError(t, err)
+	require.Equal(t, "weekly", report.Name)
+
+	reports, err := db.FindReportsDue(ctx, time.Now())
+	require.NoError(t, err)
+	require.Len(t, reports, 0)
+	reports, err = db.FindReportsDue(ctx, nextRunOn)
+	require.NoError(t, err)
+	require.Len(t, reports, 1)
+
+	// The editor becomes the owner
+	editor, err := db.InsertUser(ctx, &database.InsertUserOptions{Email: "editor@reports.com"})
+	require.NoError(t, err)
+
+	report, err = db.UpdateReport(ctx, report.ID, &database.UpdateReportOptions{
+		Schedule:        "0 9 * * *",
+		TimeZone:        "UTC",
+		Recipients:      []string{"c@example.com"},
+		MetricsViewName: "mv",
+		ExportFormat:    database.ReportExportFormatXLSX,
+		OwnerUserID:     &editor.ID,
+		NextRunOn:       nextRunOn,
+	})
+	require.NoError(t, err)
+	require.Equal(t, database.Recipients{"c@example.com"}, report.Recipients)
+	require.Equal(t, database.ReportExportFormatXLSX, report.ExportFormat)
+	require.Equal(t, &editor.ID, report.OwnerUserID)
+
+	err = db.UpdateReportRun(ctx, report.ID, time.Now(), "failed", nextRunOn.Add(24*time.Hour))
+	require.NoError(t, err)
+	reports, err = db.FindReportsForProject(ctx, proj.ID)
+	require.NoError(t, err)
+	require.Len(t, reports, 1)
+	require.NotNil(t, reports[0].LastRunOn)
+	require.Equal(t, "failed", reports[0].LastRunError)
+
+	require.NoError(t, db.DeleteReport(ctx, report.ID))
+	_, err = db.FindReportByName(ctx, proj.ID, "weekly")
+	require.ErrorIs(t, err, database.ErrNotFound)
+
+	require.NoError(t, db.DeleteProject(ctx, proj.ID))
+	require.NoError(t, db.DeleteOrganization(ctx, org.Name))
+}
+
+func testAlerts(t *testing.T, db database.DB) {
+	ctx := context.Background()
+
+	org, err := db.InsertOrganization(ctx, &database.InsertOrganizationOptions{Name: "alert-org"})
+	require.NoError(t, err)
+
+	proj, err := db.InsertProject(ctx, &database.InsertProjectOptions{OrganizationID: org.ID, Name: "alert-proj"})
+	require.NoError(t, err)
+
+	nextRunOn := time.Now().Add(time.Hour).Truncate(time.Second)
+	alert, err := db.InsertAlert(ctx, &database.InsertAlertOptions{
+		ProjectID:        proj.ID,
+		Name:             "errors",
+		Schedule:         "*/15 * * * *",
+		TimeZone:         "UTC",
+		MetricsViewName:  "mv",
+		MeasureName:      "error_rate",
+		Comparison:       database.AlertComparisonGT,
+		Threshold:        0.05,
+		SplitByDimension: "country",
+		TimeRange:        "PT1H",
+		WebhookURL:       "http://localhost:8080/hook",
+		NextRunOn:        nextRunOn,
+	})
+	require.NoError(t, err)
+	require.Equal(t, database.Recipients{}, alert.Recipients)
+	require.Equal(t, 0.05, alert.Threshold)
+	require.Empty(t, alert.State)
+	require.True(t, nextRunOn.Equal(alert.NextRunOn))
+
+	_, err = db.InsertAlert(ctx, &database.InsertAlertOptions{
+		ProjectID:       proj.ID,
+		Name:            "Errors",
+		Schedule:        "*/15 * * * *",
+		TimeZone:        "UTC",
+		MetricsViewName: "mv",
+		MeasureName:     "error_rate",
+		Comparison:      database.AlertComparisonGT,
+		NextRunOn:       nextRunOn,
+	})
+	require.ErrorIs(t, err, database.ErrNotUnique)
+
+	alerts, err := db.FindAlertsDue(ctx, time.Now())
+	require.NoError(t, err)
+	require.Len(t, alerts, 0)
+	alerts, err = db.FindAlertsDue(ctx, nextRunOn)
+	require.NoError(t, err)
+	require.Len(t, alerts, 1)
+
+	notifiedOn := time.Now().Truncate(time.Second)
+	err = db.UpdateAlertRun(ctx, alert.ID, &database.UpdateAlertRunOptions{
+		LastRunOn: notifiedOn,
+		NextRunOn: nextRunOn.Add(15 * time.Minute),
+		State:     database.AlertState{database.AlertChannelWebhook: {"US": notifiedOn}},
+	})
+	require.NoError(t, err)
+	alert, err = db.FindAlertByName(ctx, proj.ID, "ERRORS")
+	require.NoError(t, err)
+	require.NotNil(t, alert.LastRunOn)
+	require.Len(t, alert.State, 1)
+	require.True(t, notifiedOn.Equal(alert.State[database.AlertChannelWebhook]["US"]))
+
+	// Updating an alert resets its state and sets the editor as the owner
+	editor, err := db.InsertUser(ctx, &database.InsertUserOptions{Email: "editor@alerts.com"})
+	require.NoError(t, err)
+
+	alert, err = db.UpdateAlert(ctx, alert.ID, &database.UpdateAlertOptions{
+		Schedule:        "0 * * * *",
+		TimeZone:        "UTC",
+		MetricsViewName: "mv",
+		MeasureName:     "error_rate",
+		Comparison:      database.AlertComparisonGTE,
+		Threshold:       0.1,
+		Recipients:      []string{"a@example.com"},
+		OwnerUserID:     &editor.ID,
+		NextRunOn:       nextRunOn,
+	})
+	require.NoError(t, err)
+	require.Equal(t, database.Recipients{"a@example.com"}, alert.Recipients)
+	require.Equal(t, database.AlertComparisonGTE, alert.Comparison)
+	require.Empty(t, alert.State)
+	require.Equal(t, &editor.ID, alert.OwnerUserID)
+
+	alerts, err = db.FindAlertsForProject(ctx, proj.ID)
+	require.NoError(t, err)
+	require.Len(t, alerts, 1)
+
+	require.NoError(t, db.DeleteAlert(ctx, alert.ID))
+	_, err = db.FindAlertByName(ctx, proj.ID, "errors")
+	require.ErrorIs(t, err, database.ErrNotFound)
+
+	require.NoError(t, db.DeleteProject(ctx, proj.ID))
+	require.NoError(t, db.DeleteOrganization(ctx, org.Name))
+}
+
+func testBookmarks(t *testing.T, db database.DB) {
+	ctx := context.Background()
+
+	org, err := db.InsertOrganization(ctx, &database.InsertOrganizationOptions{Name: "bookmark-org"})
+	require.NoError(t, err)
+
+	proj, err := db.InsertProject(ctx, &database.InsertProjectOptions{OrganizationID: org.ID, Name: "bookmark-proj"})
+	require.NoError(t, err)
+
+	alice, err := db.InsertUser(ctx, &database.InsertUserOptions{Email: "alice@bookmarks.com"})
+	require.NoError(t, err)
+	bob, err := db.InsertUser(ctx, &database.InsertUserOptions{Email: "bob@bookmarks.com"})
+	require.NoError(t, err)
+
+	private, err := db.InsertBookmark(ctx, &database.InsertBookmarkOptions{DisplayName: "private", Data: []byte("a"), DashboardName: "dash", ProjectID: proj.ID, UserID: alice.ID})
+	require.NoError(t, err)
+	require.False(t, private.Shared)
+
+	def, err := db.InsertBookmark(ctx, &database.InsertBookmarkOptions{DisplayName: "default", Data: []byte("b"), DashboardName: "dash", ProjectID: proj.ID, UserID: alice.ID, Shared: true, Default: true})
+	require.NoError(t, err)
+	require.True(t, def.Default)
+
+	// Bob only sees the shared bookmark
+	bookmarks, err := db.FindBookmarks(ctx, proj.ID, bob.ID)
+	require.NoError(t, err)
+	require.Len(t, bookmarks, 1)
+	require.Equal(t, def.ID, bookmarks[0].ID)
+	bookmarks, err = db.FindBookmarks(ctx, proj.ID, alice.ID)
+	require.NoError(t, err)
+	require.Len(t, bookmarks, 2)
+
+	// A dashboard can only have one default bookmark
+	_, err = db.UpdateBookmark(ctx, private.ID, &database.UpdateBookmarkOptions{DisplayName: "private", Data: []byte("a"), Shared: true, Default: true})
+	require.ErrorIs(t, err, database.ErrNotUnique)
+	require.NoError(t, db.UnsetDefaultBookmark(ctx, proj.ID, "DASH"))
+	private, err = db.UpdateBookmark(ctx, private.ID, &database.UpdateBookmarkOptions{DisplayName: "renamed", Data: []byte("a"), Shared: true, Default: true})
+	require.NoError(t, err)
+	require.Equal(t, "renamed", private.DisplayName)
+	require.True(t, private.Default)
+	def, err = db.FindBookmark(ctx, def.ID)
+	require.NoError(t, err)
+	require.False(t, def.Default)
+
+	// Share tokens
+	expiresOn := time.Now().Add(time.Hour).Truncate(time.Second)
+	tkn, err := db.InsertBookmarkShareToken(ctx, &database.InsertBookmarkShareTokenOptions{
+		ID:              uuid.NewString(),
+		SecretHash:      []byte("hash"),
+		BookmarkID:      def.ID,
+		CreatedByUserID: &alice.ID,
+		ExpiresOn:       expiresOn,
+	})
+	require.NoError(t, err)
+	tkn, err = db.FindBookmarkShareToken(ctx, tkn.ID)
+	require.NoError(t, err)
+	require.Equal(t, def.ID, tkn.BookmarkID)
+	require.True(t, expiresOn.Equal(tkn.ExpiresOn))
+
+	require.NoError(t, db.DeleteExpiredBookmarkShareTokens(ctx, 0))
+	_, err = db.FindBookmarkShareToken(ctx, tkn.ID)
+	require.NoError(t, err)
+
+	// Deleting the bookmark deletes its share tokens
+	require.NoError(t, db.DeleteBookmark(ctx, def.ID))
+	_, err = db.FindBookmarkShareToken(ctx, tkn.ID)
+	require.ErrorIs(t, err, database.ErrNotFound)
+
+	require.NoError(t, db.DeleteProject(ctx, proj.ID))
+	require.NoError(t, db.DeleteOrganization(ctx, org.Name))
+	require.NoError(t, db.DeleteUser(ctx, alice.ID))
+	require.NoError(t, db.DeleteUser(ctx, bob.ID))
+}
+
 func testProjectsWithVariables(t *testing.T, db database.DB) {
 	ctx := context.Background()
 
@@ -367,3 +688,64 @@ func testOrgsMembersPagination(t *testing.T, db database.DB) {
 	//cleanup
 	require.NoError(t, db.DeleteOrganization(ctx, "alpha"))
 }
+
+func testAuditEvents(t *testing.T, db database.DB) {
+	ctx := context.Background()
+
+	org, err := db.InsertOrganization(ctx, &database.InsertOrganizationOptions{Name: "audit-org"})
+	require.NoError(t, err)
+
+	proj, err := db.InsertProject(ctx, &database.InsertProjectOptions{OrganizationID: org.ID, Name: "audit-proj"})
+	require.NoError(t, err)
+
+	_, err = db.InsertAuditEvent(ctx, &database.InsertAuditEventOptions{OrgID: org.ID, ActorType: "user"})
+	require.Error(t, err)
+
+	for i := 0; i < 3; i++ {
+		_, err := db.InsertAuditEvent(ctx, &database.InsertAuditEventOptions{
+			OrgID:       org.ID,
+			ProjectID:   &proj.ID,
+			ProjectName: proj.Name,
+			ActorType:   "user",
+			ActorName:   "admin@example.com",
+			Action:      "UpdateProjectVariables",
+			Summary:     fmt.Sprintf("added KEY_%d", i),
+		})
+		require.NoError(t, err)
+	}
+	orgEvent, err := db.InsertAuditEvent(ctx, &database.InsertAuditEventOptions{
+		OrgID:     org.ID,
+		ActorType: "service",
+		ActorName: "ci",
+		Action:    "CreateWhitelistedDomain",
+		Target:    "example.com",
+	})
+	require.NoError(t, err)
+	require.Nil(t, orgEvent.ProjectID)
+
+	events, err := db.FindAuditEventsForOrganization(ctx, org.ID, "", 10)
+	require.NoError(t, err)
+	require.Len(t, events, 4)
+	require.Equal(t, orgEvent.ID, events[0].ID)
+	require.Equal(t, "added KEY_0", events[3].Summary)
+
+	// Paginate the project's events
+	page, err := db.FindAuditEventsForProject(ctx, proj.ID, "", 2)
+	require.NoError(t, err)
+	require.Len(t, page, 2)
+	require.Equal(t, "added KEY_2", page[0].Summary)
+	page, err = db.FindAuditEventsForProject(ctx, proj.ID, page[1].ID, 2)
+	require.NoError(t, err)
+	require.Len(t, page, 1)
+	require.Equal(t, "added KEY_0", page[0].Summary)
+
+	// Events are kept when the project is deleted
+	require.NoError(t, db.DeleteProject(ctx, proj.ID))
+	events, err = db.FindAuditEventsForOrganization(ctx, org.ID, "", 10)
+	require.NoError(t, err)
+	require.Len(t, events, 4)
+	require.Nil(t, events[3].ProjectID)
+	require.Equal(t, "audit-proj", events[3].ProjectName)
+
+	require.NoError(t, db.DeleteOrganization(ctx, org.Name))
+}
@@ -32,6 +32,10 @@ type createDeploymentOptions struct {
 	ProdOLAPDSN          string
 	ProdSlots            int
 	Annotations          deploymentAnnotations
+	// Preview creates a preview deployment of ProdBranch (which is then not the project's prod branch)
+	Preview           bool
+	PullRequestNumber *int
+	TTLSeconds        *int64
 }
 
 func (s *Service) createDeployment(ctx context.Context, opts *createDeploymentOptions) (*database.Deployment, error) {
@@ -117,6 +121,9 @@ func (s *Service) createDeployment(ctx context.Context, opts *createDeploymentOp
 		RuntimeAudience:   alloc.Audience,
 		Status:            database.DeploymentStatusPending,
 		Logs:              "",
+		Preview:           opts.Preview,
+		PullRequestNumber: opts.PullRequestNumber,
+		TTLSeconds:        opts.TTLSeconds,
 	})
 	if err != nil {
 		_, err2 := rt.DeleteInstance(ctx, &runtimev1.DeleteInstanceRequest{
@@ -233,6 +240,11 @@ func (s *Service) HibernateDeployments(ctx context.Context) error {
 			continue
 		}
 
+		// Preview deployments are not referenced by the project
+		if depl.Preview {
+			continue
+		}
+
 		// Update prod deployment on project
 		_, err = s.DB.UpdateProject(ctx, proj.ID, &database.UpdateProjectOptions{
 			Name:                 proj.Name,
@@ -245,6 +257,7 @@ func (s *Service) HibernateDeployments(ctx context.Context) error {
 			ProdSlots:            proj.ProdSlots,
 			Region:               proj.Region,
 			ProdTTLSeconds:       proj.ProdTTLSeconds,
+			PreviewDeployments:   proj.PreviewDeployments,
 			ProdDeploymentID:     nil,
 		})
 		if err != nil {
@@ -6,6 +6,9 @@ import (
 	"fmt"
 	"html/template"
 	"net/url"
+	"strings"
+	"time"
+	"unicode"
 )
 
 //go:embed templates/gen/*
@@ -33,13 +36,14 @@ func New(sender Sender, frontendURL, externalURL string) *Client {
 }
 
 type CallToAction struct {
-	ToEmail    string
-	ToName     string
-	Subject    string
-	Title      string
-	Body       template.HTML
-	ButtonText string
-	ButtonLink string
+	ToEmail     string
+	ToName      string
+	Subject     string
+	Title       string
+	Body        template.HTML
+	ButtonText  string
+	ButtonLink  string
+	Attachments []*Attachment
 }
 
 func (c *Client) SendCallToAction(opts *CallToAction) error {
@@ -49,7 +53,18 @@ func (c *Client) SendCallToAction(opts *CallToAction) error {
 		return fmt.Errorf("email template error: %w", err)
 	}
 	html := buf.String()
-	return c.sender.Send(opts.ToEmail, opts.ToName, opts.Subject, html)
+	return c.sender.Send(opts.ToEmail, opts.ToName, sanitizeHeader(opts.Subject), html, opts.Attachments...)
+}
+
+// sanitizeHeader replaces control characters in an email header value with spaces.
+// The subject may contain user-provided names, which must not be able to inject headers with CR/LF.
+func sanitizeHeader(s string) string {
+	return strings.Map(func(r rune) rune {
+		if unicode.IsControl(r) {
+			return ' '
+		}
+		return r
+	}, s)
 }
 
 type OrganizationInvite struct {
@@ -160,6 +175,57 @@ func (c *Client) SendProjectAddition(opts *ProjectAddition) error {
 	})
 }
 
+type ScheduledReport struct {
+	ToEmail     string
+	ToName      string
+	OrgName     string
+	ProjectName string
+	ReportName  string
+	ReportTime  time.Time
+	Attachment  *Attachment
+}
+
+func (c *Client) SendScheduledReport(opts *ScheduledReport) error {
+	return c.SendCallToAction(&CallToAction{
+		ToEmail:     opts.ToEmail,
+		ToName:      opts.ToName,
+		Subject:     fmt.Sprintf("%s (%s)", opts.ReportName, opts.ReportTime.Format("January 2, 2006")),
+		Title:       opts.ReportName,
+		Body:        template.HTML(fmt.Sprintf("Attached is the <b>%s</b> report for the <b>%s/%s</b> project, generated on %s. Click the button below to explore the data in Rill.", template.HTMLEscapeString(opts.ReportName), opts.OrgName, opts.ProjectName, opts.ReportTime.Format("Monday, January 2, 2006 at 15:04 MST"))),
+		ButtonText:  "Open project",
+		ButtonLink:  mustJoinURLPath(c.frontendURL, opts.OrgName, opts.ProjectName),
+		Attachments: []*Attachment{opts.Attachment},
+	})
+}
+
+type Alert struct {
+	ToEmail     string
+	ToName      string
+	OrgName     string
+	ProjectName string
+	AlertName   string
+	Condition   string
+	Breaches    []string
+	EvaluatedOn time.Time
+}
+
+func (c *Client) SendAlert(opts *Alert) error {
+	var list string
+	for _, b := range opts.Breaches {
+		list += fmt.Sprintf("<li>%s</li>", template.HTMLEscapeString(b))
+	}
+
+	return c.SendCallToAction(&CallToAction{
+		ToEmail:    opts.ToEmail,
+		ToName:     opts.ToName,
+		Subject:    fmt.Sprintf("Alert: %s", opts.AlertName),
+		Title:      fmt.Sprintf("Alert: %s", opts.AlertName),
+		Body:       template.HTML(fmt.Sprintf("The <b>%s</b> alert for the <b>%s/%s</b> project was triggered on %s because <b>%s</b> for:<ul>%s</ul>Click the button below to explore the data in Rill.", template.HTMLEscapeString(opts.AlertName), opts.OrgName, opts.ProjectName, opts.EvaluatedOn.Format("Monday, January 2, 2006 at 15:04 MST"), template.HTMLEscapeString(opts.Condition), list)),
+		ButtonText: "Open project",
+		ButtonLink: mustJoinURLPath(c.frontendURL, opts.OrgName, opts.ProjectName),
+	})
+}
+
 func mustJoinURLPath(base string, elem ...string) string {
 	res, err := url.JoinPath(base, elem...)
 	if err != nil {
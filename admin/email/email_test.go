@@ -2,25 +2,28 @@ package email
 
 import (
 	"testing"
+	"time"
 
 	"github.com/google/uuid"
 	"github.com/stretchr/testify/require"
 )
 
 type mockSender struct {
-	fromEmail string
-	fromName  string
-	toEmail   string
-	toName    string
-	subject   string
-	body      string
+	fromEmail   string
+	fromName    string
+	toEmail     string
+	toName      string
+	subject     string
+	body        string
+	attachments []*Attachment
 }
 
-func (m *mockSender) Send(toEmail, toName, subject, body string) error {
+func (m *mockSender) Send(toEmail, toName, subject, body string, attachments ...*Attachment) error {
 	m.toEmail = toEmail
 	m.toName = toName
 	m.subject = subject
 	m.body = body
+	m.attachments = attachments
 	return nil
 }
 
@@ -45,3 +48,67 @@ func TestOrganizationInvite(t *testing.T) {
 	require.Contains(t, mock.body, opts.RoleName)
 	require.Contains(t, mock.body, opts.InvitedByName)
 }
+
+func TestScheduledReport(t *testing.T) {
+	mock := &mockSender{}
+	client := New(mock, "https://example.com", "")
+
+	opts := &ScheduledReport{
+		ToEmail:     uuid.New().String(),
+		ToName:      uuid.New().String(),
+		OrgName:     uuid.New().String(),
+		ProjectName: uuid.New().String(),
+		ReportName:  uuid.New().String(),
+		ReportTime:  time.Now(),
+		Attachment:  &Attachment{Filename: "report.csv", ContentType: "text/csv", Data: []byte("a,b\n1,2\n")},
+	}
+	err := client.SendScheduledReport(opts)
+	require.NoError(t, err)
+
+	require.Equal(t, opts.ToEmail, mock.toEmail)
+	require.Contains(t, mock.subject, opts.ReportName)
+	require.Contains(t, mock.body, opts.OrgName)
+	require.Contains(t, mock.body, opts.ProjectName)
+	require.Len(t, mock.attachments, 1)
+	require.Equal(t, opts.Attachment, mock.attachments[0])
+}
+
+func TestScheduledReportSubjectInjection(t *testing.T) {
+	mock := &mockSender{}
+	client := New(mock, "https://example.com", "")
+
+	err := client.SendScheduledReport(&ScheduledReport{
+		ToEmail:     "jane@example.com",
+		OrgName:     "acme",
+		ProjectName: "sales",
+		ReportName:  "Weekly\r\nBcc: mallory@example.com",
+		ReportTime:  time.Date(2023, 10, 2, 0, 0, 0, 0, time.UTC),
+		Attachment:  &Attachment{Filename: "report.csv", ContentType: "text/csv", Data: []byte("a,b\n1,2\n")},
+	})
+	require.NoError(t, err)
+	require.Equal(t, "Weekly  Bcc: mallory@example.com (October 2, 2023)", mock.subject)
+}
+
+func TestAlert(t *testing.T) {
+	mock := &mockSender{}
+	client := New(mock, "https://example.com", "")
+
+	opts := &Alert{
+		ToEmail:     uuid.New().String(),
+		ToName:      uuid.New().String(),
+		OrgName:     uuid.New().String(),
+		ProjectName: uuid.New().String(),
+		AlertName:   uuid.New().String(),
+		Condition:   "error_rate > 0.05",
+		Breaches:    []string{"country = <US>: 0.07"},
+		EvaluatedOn: time.Now(),
+	}
+	err := client.SendAlert(opts)
+	require.NoError(t, err)
+
+	require.Equal(t, opts.ToEmail, mock.toEmail)
+	require.Contains(t, mock.subject, opts.AlertName)
+	require.Contains(t, mock.body, opts.ProjectName)
+	require.Contains(t, mock.body, "country = &lt;US&gt;: 0.07")
+	require.Empty(t, mock.attachments)
+}
@@ -1,16 +1,28 @@
 package email
 
 import (
+	"bytes"
+	"encoding/base64"
 	"fmt"
+	"mime"
+	"mime/multipart"
 	"net/mail"
 	"net/smtp"
+	"net/textproto"
 	"strconv"
 
 	"go.uber.org/zap"
 )
 
 type Sender interface {
-	Send(toEmail, toName, subject, body string) error
+	Send(toEmail, toName, subject, body string, attachments ...*Attachment) error
+}
+
+// Attachment is a file attached to an email.
+type Attachment struct {
+	Filename    string
+	ContentType string
+	Data        []byte
 }
 
 type SMTPOptions struct {
@@ -47,17 +59,26 @@ func NewSMTPSender(opts *SMTPOptions) (Sender, error) {
 	return &smtpSender{opts: opts}, nil
 }
 
-func (s *smtpSender) Send(toEmail, toName, subject, body string) error {
+func (s *smtpSender) Send(toEmail, toName, subject, body string, attachments ...*Attachment) error {
 	// Compose the email message
 	from := mail.Address{Name: s.opts.FromName, Address: s.opts.FromEmail}
 	to := mail.Address{Name: toName, Address: toEmail}
-	message := []byte("From: " + from.String() + "\r\n" +
-		"To: " + to.String() + "\r\n" +
-		"Subject: " + subject + "\r\n" +
-		"Content-Type: text/html; charset=utf-8\r\n" +
-		"\r\n" +
-		body + "\r\n",
-	)
+	var message []byte
+	if len(attachments) == 0 {
+		message = []byte("From: " + from.String() + "\r\n" +
+			"To: " + to.String() + "\r\n" +
+			"Subject: " + subject + "\r\n" +
+			"Content-Type: text/html; charset=utf-8\r\n" +
+			"\r\n" +
+			body + "\r\n",
+		)
+	} else {
+		var err error
+		message, err = composeMultipartMessage(from, to, subject, body, attachments)
+		if err != nil {
+			return err
+		}
+	}
 
 	// Build recipients list
 	recipients := []string{toEmail}
@@ -75,6 +96,61 @@ func (s *smtpSender) Send(toEmail, toName, subject, body string) error {
 	return nil
 }
 
+// composeMultipartMessage composes a multipart/mixed email message with a HTML body and base64 encoded attachments.
+func composeMultipartMessage(from, to mail.Address, subject, body string, attachments []*Attachment) ([]byte, error) {
+	buf := new(bytes.Buffer)
+	mw := multipart.NewWriter(buf)
+
+	buf.WriteString("From: " + from.String() + "\r\n" +
+		"To: " + to.String() + "\r\n" +
+		"Subject: " + subject + "\r\n" +
+		"MIME-Version: 1.0\r\n" +
+		"Content-Type: multipart/mixed; boundary=" + mw.Boundary() + "\r\n" +
+		"\r\n",
+	)
+
+	pw, err := mw.CreatePart(textproto.MIMEHeader{"Content-Type": {"text/html; charset=utf-8"}})
+	if err != nil {
+		return nil, err
+	}
+	_, err = pw.Write([]byte(body))
+	if err != nil {
+		return nil, err
+	}
+
+	for _, a := range attachments {
+		pw, err := mw.CreatePart(textproto.MIMEHeader{
+			"Content-Type":              {a.ContentType},
+			"Content-Transfer-Encoding": {"base64"},
+			"Content-Disposition":       {mime.FormatMediaType("attachment", map[string]string{"filename": a.Filename})},
+		})
+		if err != nil {
+			return nil, err
+		}
+
+		// Base64 encode the data in lines of at most 76 characters (see RFC 2045)
+		enc := base64.StdEncoding.EncodeToString(a.Data)
+		for len(enc) > 76 {
+			_, err = pw.Write([]byte(enc[:76] + "\r\n"))
+			if err != nil {
+				return nil, err
+			}
+			enc = enc[76:]
+		}
+		_, err = pw.Write([]byte(enc + "\r\n"))
+		if err != nil {
+			return nil, err
+		}
+	}
+
+	err = mw.Close()
+	if err != nil {
+		return nil, err
+	}
+
+	return buf.Bytes(), nil
+}
+
 type consoleSender struct {
 	logger    *zap.Logger
 	fromEmail string
@@ -90,7 +166,12 @@ func NewConsoleSender(logger *zap.Logger, fromEmail, fromName string) (Sender, e
 	return &consoleSender{logger: logger, fromEmail: fromEmail, fromName: fromName}, nil
 }
 
-func (s *consoleSender) Send(toEmail, toName, subject, body string) error {
+func (s *consoleSender) Send(toEmail, toName, subject, body string, attachments ...*Attachment) error {
+	filenames := make([]string, len(attachments))
+	for i, a := range attachments {
+		filenames[i] = a.Filename
+	}
+
 	s.logger.Info("email sent",
 		zap.String("from_email", s.fromEmail),
 		zap.String("from_name", s.fromName),
@@ -98,6 +179,7 @@ func (s *consoleSender) Send(toEmail, toName, subject, body string) error {
 		zap.String("to_name", toName),
 		zap.String("subject", subject),
 		zap.String("body", body),
+		zap.Strings("attachments", filenames),
 	)
 	return nil
 }
@@ -176,6 +176,9 @@ func (s *Service) ProcessGithubEvent(ctx context.Context, rawEvent any) error {
 	// Triggered on push to repository
 	case *github.PushEvent:
 		return s.processGithubPush(ctx, event)
+	// Triggered when a pull request is opened, closed, reopened, etc.
+	case *github.PullRequestEvent:
+		return s.processGithubPullRequest(ctx, event)
 	// Triggered during first installation of app to an account (org or user) or one or more repos
 	case *github.InstallationEvent:
 		return s.processGithubInstallationEvent(ctx, event)
@@ -212,7 +215,8 @@ func (s *Service) processGithubPush(ctx context.Context, event *github.PushEvent
 	// Iterate over all projects and trigger reconcile
 	for _, project := range projects {
 		if branch != project.ProdBranch {
-			// Ignore if push was not to production branch
+			// Pushes to other branches update the branch's preview deployment
+			s.processGithubPushToPreviewBranch(ctx, project, branch, event.GetDeleted())
 			continue
 		}
 
@@ -234,6 +238,74 @@ func (s *Service) processGithubPush(ctx context.Context, event *github.PushEvent
 	return nil
 }
 
+// processGithubPushToPreviewBranch creates or reconciles the preview deployment for a non-prod branch, or tears it down if the branch was deleted.
+// Preview deployments are only created for projects that have enabled them.
+// Errors are logged instead of returned, so they don't impact processing of the project's other deployments.
+func (s *Service) processGithubPushToPreviewBranch(ctx context.Context, project *database.Project, branch string, deleted bool) {
+	if deleted {
+		err := s.TeardownPreviewDeployment(ctx, project, branch)
+		if err != nil {
+			s.logger.Error("process github event: could not teardown preview deployment", zap.String("project_id", project.ID), zap.String("branch", branch), zap.Error(err), observability.ZapCtx(ctx))
+		}
+		return
+	}
+
+	if !project.PreviewDeployments {
+		return
+	}
+
+	_, err := s.CreateOrUpdatePreviewDeployment(ctx, project, branch, nil)
+	if err != nil {
+		s.logger.Error("process github event: could not update preview deployment", zap.String("project_id", project.ID), zap.String("branch", branch), zap.Error(err), observability.ZapCtx(ctx))
+	}
+}
+
+func (s *Service) processGithubPullRequest(ctx context.Context, event *github.PullRequestEvent) error {
+	// Find Rill projects matching the repo of the pull request
+	repo := event.GetRepo()
+	githubURL := repo.GetHTMLURL()
+	projects, err := s.DB.FindProjectsByGithubURL(ctx, githubURL)
+	if err != nil {
+		if errors.Is(err, database.ErrNotFound) {
+			// App is installed on repo not currently deployed. Do nothing.
+			return nil
+		}
+		return err
+	}
+
+	// We can only deploy branches of the project's repo, not of forks
+	pr := event.GetPullRequest()
+	if pr.GetHead().GetRepo().GetHTMLURL() != githubURL {
+		return nil
+	}
+	branch := pr.GetHead().GetRef()
+	number := pr.GetNumber()
+
+	for _, project := range projects {
+		if branch == project.ProdBranch {
+			continue
+		}
+
+		switch event.GetAction() {
+		case "opened", "reopened":
+			if !project.PreviewDeployments {
+				continue
+			}
+			_, err := s.CreateOrUpdatePreviewDeployment(ctx, project, branch, &number)
+			if err != nil {
+				s.logger.Error("process github event: could not create preview deployment", zap.String("project_id", project.ID), zap.String("branch", branch), zap.Error(err), observability.ZapCtx(ctx))
+			}
+		case "closed":
+			err := s.TeardownPreviewDeployment(ctx, project, branch)
+			if err != nil {
+				s.logger.Error("process github event: could not teardown preview deployment", zap.String("project_id", project.ID), zap.String("branch", branch), zap.Error(err), observability.ZapCtx(ctx))
+			}
+		}
+	}
+
+	return nil
+}
+
 func (s *Service) processGithubInstallationEvent(ctx context.Context, event *github.InstallationEvent) error {
 	switch event.GetAction() {
 	case "created", "unsuspend", "new_permissions_accepted":
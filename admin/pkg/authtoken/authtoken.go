@@ -23,12 +23,14 @@ type Type string
 const (
 	TypeUser    Type = "usr"
 	TypeService Type = "svc"
+	// TypeShare tokens grant public access to a shared resource. They can't be used to authenticate with the admin API.
+	TypeShare Type = "shr"
 )
 
 // Validate checks that the type is a known enum value.
 func (t Type) Validate() bool {
 	switch t {
-	case TypeUser, TypeService:
+	case TypeUser, TypeService, TypeShare:
 		return true
 	default:
 		return false
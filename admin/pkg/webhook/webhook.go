@@ -0,0 +1,136 @@
+package webhook
+
+import (
+	"bytes"
+	"context"
+	"encoding/json"
+	"errors"
+	"fmt"
+	"io"
+	"net"
+	"net/http"
+	"net/url"
+	"strings"
+	"syscall"
+	"time"
+)
+
+// timeout is the maximum time to wait for a webhook endpoint to respond.
+const timeout = 30 * time.Second
+
+// maxErrorBodyBytes caps how much of an error response is included in the returned error.
+const maxErrorBodyBytes = 1024
+
+// allowInsecure disables the checks that webhooks use HTTPS and target public addresses.
+// It's used in tests, which post to local servers.
+var allowInsecure = false
+
+// client only connects to public addresses, which also covers hostnames that resolve to private addresses.
+// It doesn't follow redirects, since they could point to other hosts.
+var client = &http.Client{
+	Timeout: timeout,
+	Transport: &http.Transport{
+		DialContext:         (&net.Dialer{Timeout: timeout, Control: checkDialAddress}).DialContext,
+		TLSHandshakeTimeout: 10 * time.Second,
+	},
+	CheckRedirect: func(req *http.Request, via []*http.Request) error {
+		return http.ErrUseLastResponse
+	},
+}
+
+// ValidateURL checks that a webhook URL uses HTTPS and doesn't target a private, loopback or link-local address.
+// Since webhooks are called from the admin service, this prevents them from being used to reach internal services.
+func ValidateURL(webhookURL string) error {
+	u, err := url.Parse(webhookURL)
+	if err != nil {
+		return fmt.Errorf("invalid webhook URL: %w", err)
+	}
+	if allowInsecure {
+		return nil
+	}
+
+	if u.Scheme != "https" {
+		return errors.New("webhook URL must use https")
+	}
+
+	host := u.Hostname()
+	if host == "" {
+		return errors.New("webhook URL must have a host")
+	}
+	if strings.EqualFold(host, "localhost") || strings.HasSuffix(strings.ToLower(host), ".localhost") {
+		return errors.New("webhook URL must not target a local address")
+	}
+	if ip := net.ParseIP(host); ip != nil && !isPublicIP(ip) {
+		return errors.New("webhook URL must not target a private address")
+	}
+
+	return nil
+}
+
+// Post sends the payload as JSON in a POST request to the URL.
+// It returns an error if the URL is not valid (see ValidateURL) or if the endpoint does not respond with a 2xx status code.
+func Post(ctx context.Context, url string, payload any) error {
+	err := ValidateURL(url)
+	if err != nil {
+		return err
+	}
+
+	body, err := json.Marshal(payload)
+	if err != nil {
+		return err
+	}
+
+	req, err := http.NewRequestWithContext(ctx, http.MethodPost, url, bytes.NewReader(body))
+	if err != nil {
+		return err
+	}
+	req.Header.Set("Content-Type", "application/json")
+	req.Header.Set("User-Agent", "rill-webhook")
+
+	res, err := client.Do(req)
+	if err != nil {
+		return err
+	}
+	defer res.Body.Close()
+
+	if res.StatusCode < 200 || res.StatusCode >= 300 {
+		msg, _ := io.ReadAll(io.LimitReader(res.Body, maxErrorBodyBytes))
+		return fmt.Errorf("webhook responded with status %d: %s", res.StatusCode, bytes.TrimSpace(msg))
+	}
+
+	return nil
+}
+
+// checkDialAddress is a net.Dialer control function that rejects connections to non-public addresses.
+// It runs after DNS resolution, so it can't be bypassed with a hostname that resolves to a private address.
+func checkDialAddress(network, address string, _ syscall.RawConn) error {
+	if allowInsecure {
+		return nil
+	}
+
+	host, _, err := net.SplitHostPort(address)
+	if err != nil {
+		return err
+	}
+
+	ip := net.ParseIP(host)
+	if ip == nil || !isPublicIP(ip) {
+		return fmt.Errorf("webhook must not connect to non-public address %s", host)
+	}
+
+	return nil
+}
+
+// cgnatRange is the shared address space for carrier-grade NAT, which is also used for internal networks.
+var cgnatRange = &net.IPNet{IP: net.IPv4(100, 64, 0, 0), Mask: net.CIDRMask(10, 32)}
+
+// isPublicIP returns true if the IP is a globally routable unicast address.
+func isPublicIP(ip net.IP) bool {
+	if ip.IsLoopback() || ip.IsPrivate() || ip.IsUnspecified() || ip.IsLinkLocalUnicast() || ip.IsLinkLocalMulticast() || ip.IsInterfaceLocalMulticast() || ip.IsMulticast() {
+		return false
+	}
+	if ip4 := ip.To4(); ip4 != nil && (ip4[0] == 0 || cgnatRange.Contains(ip4)) {
+		return false
+	}
+	return true
+}
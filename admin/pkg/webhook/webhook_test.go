@@ -0,0 +1,76 @@
+package webhook
+
+import (
+	"context"
+	"encoding/json"
+	"net/http"
+	"net/http/httptest"
+	"testing"
+
+	"github.com/stretchr/testify/require"
+)
+
+func TestPost(t *testing.T) {
+	allowInsecure = true
+	defer func() { allowInsecure = false }()
+
+	var got map[string]any
+	srv := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		if r.Method != http.MethodPost || r.Header.Get("Content-Type") != "application/json" {
+			http.Error(w, "unexpected request", http.StatusBadRequest)
+			return
+		}
+		if err := json.NewDecoder(r.Body).Decode(&got); err != nil {
+			http.Error(w, err.Error(), http.StatusInternalServerError)
+			return
+		}
+		w.WriteHeader(http.StatusNoContent)
+	}))
+	defer srv.Close()
+
+	err := Post(context.Background(), srv.URL, map[string]any{"alert": "errors", "value": 1.5})
+	require.NoError(t, err)
+	require.Equal(t, map[string]any{"alert": "errors", "value": 1.5}, got)
+}
+
+func TestPostError(t *testing.T) {
+	allowInsecure = true
+	defer func() { allowInsecure = false }()
+
+	srv := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		http.Error(w, "bad payload", http.StatusBadRequest)
+	}))
+	defer srv.Close()
+
+	err := Post(context.Background(), srv.URL, map[string]any{})
+	require.ErrorContains(t, err, "status 400: bad payload")
+}
+
+func TestPostPrivateAddress(t *testing.T) {
+	srv := httptest.NewTLSServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		w.WriteHeader(http.StatusNoContent)
+	}))
+	defer srv.Close()
+
+	// The test server listens on a loopback address
+	err := Post(context.Background(), srv.URL, map[string]any{})
+	require.ErrorContains(t, err, "must not target a private address")
+
+	// Hostnames that resolve to private addresses are rejected when dialing
+	err = checkDialAddress("tcp", "10.0.0.1:443", nil)
+	require.ErrorContains(t, err, "non-public address")
+}
+
+func TestValidateURL(t *testing.T) {
+	require.NoError(t, ValidateURL("https://hooks.example.com/alerts"))
+	require.NoError(t, ValidateURL("https://8.8.8.8/alerts"))
+	require.ErrorContains(t, ValidateURL("http://hooks.example.com/alerts"), "must use https")
+	require.ErrorContains(t, ValidateURL("file:///etc/passwd"), "must use https")
+	require.ErrorContains(t, ValidateURL("https:///alerts"), "must have a host")
+	require.ErrorContains(t, ValidateURL("https://localhost:8080/alerts"), "local address")
+	require.ErrorContains(t, ValidateURL("https://127.0.0.1/alerts"), "private address")
+	require.ErrorContains(t, ValidateURL("https://169.254.169.254/latest/meta-data"), "private address")
+	require.ErrorContains(t, ValidateURL("https://[::1]/alerts"), "private address")
+	require.ErrorContains(t, ValidateURL("https://10.1.2.3/alerts"), "private address")
+	require.ErrorContains(t, ValidateURL("https://100.64.0.1/alerts"), "private address")
+}
@@ -0,0 +1,143 @@
+package admin
+
+import (
+	"context"
+	"errors"
+	"fmt"
+	"time"
+
+	"github.com/rilldata/rill/admin/database"
+	"github.com/rilldata/rill/runtime/pkg/observability"
+	"go.uber.org/zap"
+)
+
+// previewDeploymentTTL is the time after which an unused preview deployment is hibernated (i.e. torn down).
+const previewDeploymentTTL = 24 * time.Hour
+
+// CreateOrUpdatePreviewDeployment makes sure a project has a preview deployment for a non-prod branch and triggers a reconcile for it.
+// It creates the preview deployment if it doesn't exist (or has been hibernated).
+// If pullRequestNumber is not nil, it's recorded on the deployment.
+// It fails if preview deployments are not enabled for the project.
+func (s *Service) CreateOrUpdatePreviewDeployment(ctx context.Context, proj *database.Project, branch string, pullRequestNumber *int) (*database.Deployment, error) {
+	if !proj.PreviewDeployments {
+		return nil, fmt.Errorf("preview deployments are not enabled for project %q", proj.Name)
+	}
+	if branch == proj.ProdBranch {
+		return nil, fmt.Errorf("cannot create a preview deployment for the prod branch %q", branch)
+	}
+
+	depl, err := s.DB.FindPreviewDeploymentForBranch(ctx, proj.ID, branch)
+	if err != nil && !errors.Is(err, database.ErrNotFound) {
+		return nil, err
+	}
+
+	// Reconcile the existing preview deployment
+	if depl != nil {
+		if pullRequestNumber != nil && (depl.PullRequestNumber == nil || *depl.PullRequestNumber != *pullRequestNumber) {
+			depl, err = s.DB.UpdateDeploymentPullRequestNumber(ctx, depl.ID, pullRequestNumber)
+			if err != nil {
+				return nil, err
+			}
+		}
+
+		err = s.TriggerReconcile(ctx, depl)
+		if err != nil {
+			return nil, err
+		}
+
+		// New commits to the branch keep its preview from being hibernated
+		s.Used.Deployment(depl.ID)
+
+		return depl, nil
+	}
+
+	org, err := s.DB.FindOrganization(ctx, proj.OrganizationID)
+	if err != nil {
+		return nil, err
+	}
+
+	// Preview deployments count towards the org's deployment quotas
+	stats, err := s.DB.CountDeploymentsForOrganization(ctx, org.ID)
+	if err != nil {
+		return nil, err
+	}
+	if org.QuotaDeployments >= 0 && stats.Deployments >= org.QuotaDeployments {
+		return nil, fmt.Errorf("quota exceeded: org %q is limited to %d deployments", org.Name, org.QuotaDeployments)
+	}
+	if org.QuotaSlotsTotal >= 0 && stats.Slots+proj.ProdSlots > org.QuotaSlotsTotal {
+		return nil, fmt.Errorf("quota exceeded: org %q is limited to %d total slots", org.Name, org.QuotaSlotsTotal)
+	}
+
+	ttl := int64(previewDeploymentTTL.Seconds())
+	depl, err = s.createDeployment(ctx, &createDeploymentOptions{
+		ProjectID:            proj.ID,
+		Region:               proj.Region,
+		GithubURL:            proj.GithubURL,
+		GithubInstallationID: proj.GithubInstallationID,
+		Subpath:              proj.Subpath,
+		ProdBranch:           branch,
+		ProdVariables:        proj.ProdVariables,
+		ProdOLAPDriver:       proj.ProdOLAPDriver,
+		ProdOLAPDSN:          proj.ProdOLAPDSN,
+		ProdSlots:            proj.ProdSlots,
+		Annotations:          newDeploymentAnnotations(org, proj),
+		Preview:              true,
+		PullRequestNumber:    pullRequestNumber,
+		TTLSeconds:           &ttl,
+	})
+	if err != nil {
+		// Another Github event for the branch may have created the preview deployment concurrently
+		if errors.Is(err, database.ErrNotUnique) {
+			return s.DB.FindPreviewDeploymentForBranch(ctx, proj.ID, branch)
+		}
+		return nil, err
+	}
+
+	s.logger.Info("created preview deployment", zap.String("project_id", proj.ID), zap.String("deployment_id", depl.ID), zap.String("branch", branch), observability.ZapCtx(ctx))
+
+	err = s.TriggerReconcile(ctx, depl)
+	if err != nil {
+		return nil, err
+	}
+
+	return depl, nil
+}
+
+// TeardownPreviewDeployment tears down a project's preview deployment for a branch. It's a no-op if there is none.
+func (s *Service) TeardownPreviewDeployment(ctx context.Context, proj *database.Project, branch string) error {
+	depl, err := s.DB.FindPreviewDeploymentForBranch(ctx, proj.ID, branch)
+	if err != nil {
+		if errors.Is(err, database.ErrNotFound) {
+			return nil
+		}
+		return err
+	}
+
+	err = s.teardownDeployment(ctx, proj, depl)
+	if err != nil {
+		return err
+	}
+
+	s.logger.Info("deleted preview deployment", zap.String("project_id", proj.ID), zap.String("deployment_id", depl.ID), zap.String("branch", branch), observability.ZapCtx(ctx))
+	return nil
+}
+
+// teardownPreviewDeployments tears down all of a project's preview deployments.
+func (s *Service) teardownPreviewDeployments(ctx context.Context, proj *database.Project) error {
+	depls, err := s.DB.FindPreviewDeploymentsForProject(ctx, proj.ID)
+	if err != nil {
+		return err
+	}
+
+	for _, depl := range depls {
+		err = s.teardownDeployment(ctx, proj, depl)
+		if err != nil {
+			return err
+		}
+	}
+
+	if len(depls) > 0 {
+		s.logger.Info("deleted preview deployments", zap.String("project_id", proj.ID), zap.Int("deployments", len(depls)), observability.ZapCtx(ctx))
+	}
+	return nil
+}
@@ -97,6 +97,7 @@ func (s *Service) CreateProject(ctx context.Context, org *database.Organization,
 		ProdSlots:            proj.ProdSlots,
 		Region:               proj.Region,
 		ProdTTLSeconds:       proj.ProdTTLSeconds,
+		PreviewDeployments:   proj.PreviewDeployments,
 		ProdDeploymentID:     &depl.ID,
 	})
 	if err != nil {
@@ -143,6 +144,13 @@ func (s *Service) TeardownProject(ctx context.Context, p *database.Project) erro
 // UpdateProject updates a project and any impacted deployments.
 // It runs a reconcile if deployment parameters (like branch or variables) have been changed and reconcileDeployment is set.
 func (s *Service) UpdateProject(ctx context.Context, proj *database.Project, opts *database.UpdateProjectOptions) (*database.Project, error) {
+	if proj.PreviewDeployments && !opts.PreviewDeployments { // previews are being disabled
+		err := s.teardownPreviewDeployments(ctx, proj)
+		if err != nil {
+			return nil, err
+		}
+	}
+
 	if proj.Region != opts.Region || proj.ProdSlots != opts.ProdSlots { // require new deployments
 		s.logger.Info("recreating deployment", observability.ZapCtx(ctx))
 		var oldDepl *database.Deployment
@@ -224,14 +232,18 @@ func (s *Service) UpdateProject(ctx context.Context, proj *database.Project, opt
 			return nil, err
 		}
 
-		// NOTE: This assumes every deployment (almost always, there's just one) deploys the prod branch.
-		// It needs to be refactored when implementing preview deploys.
 		for _, d := range ds {
+			// Preview deployments keep deploying their own branch
+			branch := opts.ProdBranch
+			if d.Preview {
+				branch = d.Branch
+			}
+
 			err := s.updateDeployment(ctx, d, &updateDeploymentOptions{
 				GithubURL:            opts.GithubURL,
 				GithubInstallationID: opts.GithubInstallationID,
 				Subpath:              proj.Subpath,
-				Branch:               opts.ProdBranch,
+				Branch:               branch,
 				Variables:            opts.ProdVariables,
 				Annotations:          deplAnnotations,
 			})
@@ -263,8 +275,7 @@ func (s *Service) UpdateProjectVariables(ctx context.Context, proj *database.Pro
 		return nil, err
 	}
 
-	// NOTE: This assumes every deployment (almost always, there's just one) deploys the prod branch.
-	// It needs to be refactored when implementing preview deploys.
+	// NOTE: Preview deployments also use the prod variables.
 	for _, d := range ds {
 		err := s.updateDeplVariables(ctx, d, variables)
 		if err != nil {
@@ -365,6 +376,7 @@ func (s *Service) TriggerRedeploy(ctx context.Context, proj *database.Project, p
 		ProdDeploymentID:     &newDepl.ID,
 		ProdSlots:            proj.ProdSlots,
 		ProdTTLSeconds:       proj.ProdTTLSeconds,
+		PreviewDeployments:   proj.PreviewDeployments,
 		Region:               proj.Region,
 	})
 	if err != nil {
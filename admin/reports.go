@@ -0,0 +1,311 @@
+package admin
+
+import (
+	"context"
+	"errors"
+	"fmt"
+	"io"
+	"strings"
+	"time"
+
+	"github.com/rilldata/rill/admin/database"
+	"github.com/rilldata/rill/admin/email"
+	runtimev1 "github.com/rilldata/rill/proto/gen/rill/runtime/v1"
+	"github.com/rilldata/rill/runtime/client"
+	"github.com/rilldata/rill/runtime/pkg/duration"
+	"github.com/rilldata/rill/runtime/pkg/observability"
+	"github.com/rilldata/rill/runtime/server/auth"
+	"github.com/robfig/cron/v3"
+	"go.uber.org/zap"
+	"google.golang.org/protobuf/encoding/protojson"
+	"google.golang.org/protobuf/types/known/timestamppb"
+)
+
+// reportRunTimeout is the maximum time to spend exporting and sending a single report.
+const reportRunTimeout = 10 * time.Minute
+
+// reportMaxAttachmentBytes caps the size of report attachments since most email providers reject large messages.
+const reportMaxAttachmentBytes = 20 * 1024 * 1024
+
+// errOwnerRevoked is returned when running a report or alert whose owner has been deleted or lost access to the project.
+var errOwnerRevoked = errors.New("the owner no longer has access to the project")
+
+// NextReportRunTime returns the first time after the provided time that a report with the given schedule should run.
+// The schedule is a standard cron expression, which is evaluated in the given IANA time zone (defaults to UTC).
+func NextReportRunTime(schedule, timeZone string, after time.Time) (time.Time, error) {
+	sched, err := cron.ParseStandard(schedule)
+	if err != nil {
+		return time.Time{}, fmt.Errorf("invalid schedule %q: %w", schedule, err)
+	}
+
+	loc := time.UTC
+	if timeZone != "" {
+		loc, err = time.LoadLocation(timeZone)
+		if err != nil {
+			return time.Time{}, fmt.Errorf("invalid time zone %q: %w", timeZone, err)
+		}
+	}
+
+	return sched.Next(after.In(loc)), nil
+}
+
+// ValidateReportQuery checks that a report's query args and time range can be parsed.
+func ValidateReportQuery(queryArgsJSON, timeRange string) error {
+	_, err := parseReportQuery(queryArgsJSON)
+	if err != nil {
+		return err
+	}
+
+	if timeRange != "" {
+		_, err := duration.ParseISO8601(timeRange)
+		if err != nil {
+			return fmt.Errorf("invalid time range: %w", err)
+		}
+	}
+
+	return nil
+}
+
+// RunReport exports a report's query from the project's prod deployment and emails it to the report's recipients.
+// It records the outcome of the run on the report and schedules its next run, also if the run failed.
+func (s *Service) RunReport(ctx context.Context, report *database.Report) error {
+	now := time.Now()
+
+	runCtx, cancel := context.WithTimeout(ctx, reportRunTimeout)
+	runErr := s.runReport(runCtx, report, now)
+	cancel()
+
+	next, err := NextReportRunTime(report.Schedule, report.TimeZone, now)
+	if err != nil {
+		return err
+	}
+
+	var lastRunError string
+	if runErr != nil {
+		lastRunError = runErr.Error()
+	}
+
+	err = s.DB.UpdateReportRun(ctx, report.ID, now, lastRunError, next)
+	if err != nil {
+		return err
+	}
+
+	return runErr
+}
+
+func (s *Service) runReport(ctx context.Context, report *database.Report, now time.Time) error {
+	proj, err := s.DB.FindProject(ctx, report.ProjectID)
+	if err != nil {
+		return err
+	}
+
+	if proj.ProdDeploymentID == nil {
+		return fmt.Errorf("project %q does not have a prod deployment", proj.Name)
+	}
+
+	depl, err := s.DB.FindDeployment(ctx, *proj.ProdDeploymentID)
+	if err != nil {
+		return err
+	}
+
+	org, err := s.DB.FindOrganization(ctx, proj.OrganizationID)
+	if err != nil {
+		return err
+	}
+
+	attachment, err := s.exportReport(ctx, report, depl, now)
+	if err != nil {
+		return fmt.Errorf("failed to export report: %w", err)
+	}
+
+	sent, err := sendToRecipients(report.Recipients, func(recipient string) error {
+		return s.Email.SendScheduledReport(&email.ScheduledReport{
+			ToEmail:     recipient,
+			OrgName:     org.Name,
+			ProjectName: proj.Name,
+			ReportName:  report.Name,
+			ReportTime:  now,
+			Attachment:  attachment,
+		})
+	})
+
+	s.logger.Info("sent report", zap.String("project_id", proj.ID), zap.String("report", report.Name), zap.Int("recipients", sent), observability.ZapCtx(ctx))
+	return err
+}
+
+// sendToRecipients calls send for each recipient and returns the number of successful sends.
+// A failed send doesn't stop delivery to the remaining recipients, so a partial failure returns an error listing the failed recipients.
+func sendToRecipients(recipients []string, send func(recipient string) error) (int, error) {
+	var errs []error
+	for _, recipient := range recipients {
+		err := send(recipient)
+		if err != nil {
+			errs = append(errs, fmt.Errorf("%q: %w", recipient, err))
+		}
+	}
+
+	sent := len(recipients) - len(errs)
+	if len(errs) > 0 {
+		return sent, fmt.Errorf("failed to send to %d of %d recipients: %w", len(errs), len(recipients), errors.Join(errs...))
+	}
+	return sent, nil
+}
+
+// exportReport runs a report's query on a deployment's runtime and returns the result as an email attachment.
+func (s *Service) exportReport(ctx context.Context, report *database.Report, depl *database.Deployment, now time.Time) (*email.Attachment, error) {
+	req, err := parseReportQuery(report.QueryArgsJSON)
+	if err != nil {
+		return nil, err
+	}
+	req.InstanceId = depl.RuntimeInstanceID
+	req.MetricsViewName = report.MetricsViewName
+
+	if report.TimeRange != "" {
+		req.TimeStart, err = relativeTimeStart(report.TimeRange, now)
+		if err != nil {
+			return nil, err
+		}
+		req.TimeEnd = timestamppb.New(now)
+	}
+
+	var format runtimev1.ExportFormat
+	var ext, contentType string
+	switch report.ExportFormat {
+	case database.ReportExportFormatCSV:
+		format, ext, contentType = runtimev1.ExportFormat_EXPORT_FORMAT_CSV, "csv", "text/csv"
+	case database.ReportExportFormatXLSX:
+		format, ext, contentType = runtimev1.ExportFormat_EXPORT_FORMAT_XLSX, "xlsx", "application/vnd.openxmlformats-officedocument.spreadsheetml.sheet"
+	default:
+		return nil, fmt.Errorf("unsupported export format %d", report.ExportFormat)
+	}
+
+	var limit *int64
+	if report.ExportLimit > 0 {
+		l := int64(report.ExportLimit)
+		limit = &l
+	}
+
+	rt, err := s.openRuntimeClientForOwner(ctx, report.ProjectID, report.OwnerUserID, depl, reportRunTimeout)
+	if err != nil {
+		return nil, err
+	}
+	defer rt.Close()
+
+	res, err := rt.Export(ctx, &runtimev1.ExportRequest{
+		InstanceId: depl.RuntimeInstanceID,
+		Limit:      limit,
+		Format:     format,
+		Request:    &runtimev1.ExportRequest_MetricsViewAggregationRequest{MetricsViewAggregationRequest: req},
+	})
+	if err != nil {
+		return nil, err
+	}
+
+	body, err := rt.Download(ctx, depl.RuntimeHost+res.DownloadUrlPath)
+	if err != nil {
+		return nil, err
+	}
+	defer body.Close()
+
+	data, err := io.ReadAll(io.LimitReader(body, reportMaxAttachmentBytes+1))
+	if err != nil {
+		return nil, err
+	}
+	if len(data) > reportMaxAttachmentBytes {
+		return nil, fmt.Errorf("export is larger than %d bytes", reportMaxAttachmentBytes)
+	}
+
+	return &email.Attachment{
+		Filename:    fmt.Sprintf("%s-%s.%s", report.Name, now.Format("20060102"), ext),
+		ContentType: contentType,
+		Data:        data,
+	}, nil
+}
+
+// openRuntimeClientForOwner opens a runtime client that can read metrics on the deployment's instance.
+// It is used for scheduled jobs like reports and alerts, which evaluate security policies using the attributes of their owner.
+// It returns an error if the owner has been deleted or can no longer read the project's prod deployment.
+func (s *Service) openRuntimeClientForOwner(ctx context.Context, projectID string, ownerUserID *string, depl *database.Deployment, ttl time.Duration) (*client.Client, error) {
+	if ownerUserID == nil {
+		return nil, errOwnerRevoked
+	}
+
+	user, err := s.DB.FindUser(ctx, *ownerUserID)
+	if err != nil {
+		if errors.Is(err, database.ErrNotFound) {
+			return nil, errOwnerRevoked
+		}
+		return nil, err
+	}
+
+	roles, err := s.DB.ResolveProjectRolesForUser(ctx, user.ID, projectID)
+	if err != nil {
+		return nil, err
+	}
+
+	var admin, readProd bool
+	for _, r := range roles {
+		admin = admin || r.ManageProject
+		readProd = readProd || r.ReadProd
+	}
+	if !readProd {
+		return nil, errOwnerRevoked
+	}
+
+	var domain string
+	if i := strings.LastIndex(user.Email, "@"); i >= 0 {
+		domain = user.Email[i+1:]
+	}
+
+	attr := map[string]any{
+		"name":   user.DisplayName,
+		"email":  user.Email,
+		"domain": domain,
+		"admin":  admin,
+	}
+
+	jwt, err := s.issuer.NewToken(auth.TokenOptions{
+		AudienceURL: depl.RuntimeAudience,
+		Subject:     user.ID,
+		TTL:         ttl,
+		InstancePermissions: map[string][]auth.Permission{
+			depl.RuntimeInstanceID: {auth.ReadMetrics},
+		},
+		Attributes: attr,
+	})
+	if err != nil {
+		return nil, err
+	}
+
+	return client.New(depl.RuntimeHost, jwt)
+}
+
+// parseReportQuery parses a report's JSON-encoded query args.
+func parseReportQuery(queryArgsJSON string) (*runtimev1.MetricsViewAggregationRequest, error) {
+	req := &runtimev1.MetricsViewAggregationRequest{}
+	if queryArgsJSON == "" {
+		return req, nil
+	}
+
+	err := protojson.Unmarshal([]byte(queryArgsJSON), req)
+	if err != nil {
+		return nil, fmt.Errorf("invalid query args: %w", err)
+	}
+
+	return req, nil
+}
+
+// relativeTimeStart returns the start of a time range given as an ISO 8601 duration ending at the provided time.
+// It returns nil for an infinite duration.
+func relativeTimeStart(timeRange string, end time.Time) (*timestamppb.Timestamp, error) {
+	d, err := duration.ParseISO8601(timeRange)
+	if err != nil {
+		return nil, fmt.Errorf("invalid time range: %w", err)
+	}
+	if d.Inf {
+		return nil, nil
+	}
+	start := end.AddDate(-d.Year, -d.Month, -7*d.Week-d.Day)
+	start = start.Add(-time.Duration(d.Hour)*time.Hour - time.Duration(d.Minute)*time.Minute - time.Duration(d.Second)*time.Second)
+	return timestamppb.New(start), nil
+}
@@ -0,0 +1,56 @@
+package admin
+
+import (
+	"errors"
+	"testing"
+	"time"
+
+	"github.com/stretchr/testify/require"
+)
+
+func TestNextReportRunTime(t *testing.T) {
+	// Sunday
+	after := time.Date(2023, 10, 1, 12, 0, 0, 0, time.UTC)
+
+	// Every Monday at 9am UTC
+	next, err := NextReportRunTime("0 9 * * 1", "", after)
+	require.NoError(t, err)
+	require.Equal(t, time.Date(2023, 10, 2, 9, 0, 0, 0, time.UTC), next.UTC())
+
+	// Every Monday at 9am in New York (UTC-4 in October)
+	next, err = NextReportRunTime("0 9 * * 1", "America/New_York", after)
+	require.NoError(t, err)
+	require.Equal(t, time.Date(2023, 10, 2, 13, 0, 0, 0, time.UTC), next.UTC())
+
+	_, err = NextReportRunTime("every monday", "", after)
+	require.ErrorContains(t, err, "invalid schedule")
+
+	_, err = NextReportRunTime("0 9 * * 1", "Mars/Olympus_Mons", after)
+	require.ErrorContains(t, err, "invalid time zone")
+}
+
+func TestValidateReportQuery(t *testing.T) {
+	require.NoError(t, ValidateReportQuery("", ""))
+	require.NoError(t, ValidateReportQuery(`{"dimensions":[{"name":"publisher"}],"measureNames":["total"],"sort":[{"name":"total","desc":true}],"limit":"10"}`, "P7D"))
+	require.ErrorContains(t, ValidateReportQuery(`{"dimension":"publisher"}`, ""), "invalid query args")
+	require.ErrorContains(t, ValidateReportQuery("", "7 days"), "invalid time range")
+}
+
+func TestSendToRecipients(t *testing.T) {
+	var attempted []string
+	sent, err := sendToRecipients([]string{"a@example.com", "b@example.com", "c@example.com"}, func(recipient string) error {
+		attempted = append(attempted, recipient)
+		if recipient == "b@example.com" {
+			return errors.New("mailbox unavailable")
+		}
+		return nil
+	})
+	require.Equal(t, []string{"a@example.com", "b@example.com", "c@example.com"}, attempted)
+	require.Equal(t, 2, sent)
+	require.ErrorContains(t, err, "failed to send to 1 of 3 recipients")
+	require.ErrorContains(t, err, `"b@example.com": mailbox unavailable`)
+
+	sent, err = sendToRecipients([]string{"a@example.com"}, func(recipient string) error { return nil })
+	require.NoError(t, err)
+	require.Equal(t, 1, sent)
+}
@@ -0,0 +1,275 @@
+package server
+
+import (
+	"context"
+	"errors"
+	"time"
+
+	"github.com/rilldata/rill/admin"
+	"github.com/rilldata/rill/admin/database"
+	"github.com/rilldata/rill/admin/pkg/webhook"
+	adminv1 "github.com/rilldata/rill/proto/gen/rill/admin/v1"
+	"github.com/rilldata/rill/runtime/pkg/observability"
+	"go.opentelemetry.io/otel/attribute"
+	"google.golang.org/grpc/codes"
+	"google.golang.org/grpc/status"
+	"google.golang.org/protobuf/types/known/timestamppb"
+)
+
+func (s *Server) ListAlerts(ctx context.Context, req *adminv1.ListAlertsRequest) (*adminv1.ListAlertsResponse, error) {
+	observability.AddRequestAttributes(ctx,
+		attribute.String("args.org", req.Organization),
+		attribute.String("args.project", req.Project),
+	)
+
+	proj, err := s.findProjectForScheduledResource(ctx, req.Organization, req.Project, "alerts", false)
+	if err != nil {
+		return nil, err
+	}
+
+	alerts, err := s.admin.DB.FindAlertsForProject(ctx, proj.ID)
+	if err != nil {
+		return nil, status.Error(codes.Internal, err.Error())
+	}
+
+	dtos := make([]*adminv1.Alert, len(alerts))
+	for i, a := range alerts {
+		dtos[i] = alertToPB(a)
+	}
+
+	return &adminv1.ListAlertsResponse{Alerts: dtos}, nil
+}
+
+func (s *Server) GetAlert(ctx context.Context, req *adminv1.GetAlertRequest) (*adminv1.GetAlertResponse, error) {
+	observability.AddRequestAttributes(ctx,
+		attribute.String("args.org", req.Organization),
+		attribute.String("args.project", req.Project),
+		attribute.String("args.name", req.Name),
+	)
+
+	proj, err := s.findProjectForScheduledResource(ctx, req.Organization, req.Project, "alerts", false)
+	if err != nil {
+		return nil, err
+	}
+
+	alert, err := s.admin.DB.FindAlertByName(ctx, proj.ID, req.Name)
+	if err != nil {
+		if errors.Is(err, database.ErrNotFound) {
+			return nil, status.Error(codes.NotFound, "alert not found")
+		}
+		return nil, status.Error(codes.Internal, err.Error())
+	}
+
+	return &adminv1.GetAlertResponse{Alert: alertToPB(alert)}, nil
+}
+
+func (s *Server) CreateAlert(ctx context.Context, req *adminv1.CreateAlertRequest) (*adminv1.CreateAlertResponse, error) {
+	observability.AddRequestAttributes(ctx,
+		attribute.String("args.org", req.Organization),
+		attribute.String("args.project", req.Project),
+		attribute.String("args.name", req.Name),
+	)
+
+	err := validateScheduledResourceName(req.Name)
+	if err != nil {
+		return nil, err
+	}
+
+	proj, err := s.findProjectForScheduledResource(ctx, req.Organization, req.Project, "alerts", true)
+	if err != nil {
+		return nil, err
+	}
+
+	nextRunOn, err := validateAlertOptions(req.Options)
+	if err != nil {
+		return nil, err
+	}
+
+	ownerID, err := scheduledResourceOwnerID(ctx, "alerts")
+	if err != nil {
+		return nil, err
+	}
+
+	alert, err := s.admin.DB.InsertAlert(ctx, &database.InsertAlertOptions{
+		ProjectID:            proj.ID,
+		Name:                 req.Name,
+		Schedule:             req.Options.Schedule,
+		TimeZone:             alertTimeZone(req.Options),
+		MetricsViewName:      req.Options.MetricsViewName,
+		MeasureName:          req.Options.MeasureName,
+		Comparison:           database.AlertComparison(req.Options.Comparison),
+		Threshold:            req.Options.Threshold,
+		SplitByDimension:     req.Options.SplitByDimension,
+		WhereJSON:            req.Options.WhereJson,
+		TimeRange:            req.Options.TimeRange,
+		Recipients:           req.Options.Recipients,
+		WebhookURL:           req.Options.WebhookUrl,
+		RenotifyAfterSeconds: int(req.Options.RenotifyAfterSeconds),
+		OwnerUserID:          ownerID,
+		NextRunOn:            nextRunOn,
+	})
+	if err != nil {
+		return nil, status.Error(codes.InvalidArgument, err.Error())
+	}
+
+	s.recordProjectAuditEvent(ctx, proj, "CreateAlert", alert.Name, "")
+
+	return &adminv1.CreateAlertResponse{Alert: alertToPB(alert)}, nil
+}
+
+func (s *Server) EditAlert(ctx context.Context, req *adminv1.EditAlertRequest) (*adminv1.EditAlertResponse, error) {
+	observability.AddRequestAttributes(ctx,
+		attribute.String("args.org", req.Organization),
+		attribute.String("args.project", req.Project),
+		attribute.String("args.name", req.Name),
+	)
+
+	proj, err := s.findProjectForScheduledResource(ctx, req.Organization, req.Project, "alerts", true)
+	if err != nil {
+		return nil, err
+	}
+
+	alert, err := s.admin.DB.FindAlertByName(ctx, proj.ID, req.Name)
+	if err != nil {
+		if errors.Is(err, database.ErrNotFound) {
+			return nil, status.Error(codes.NotFound, "alert not found")
+		}
+		return nil, status.Error(codes.Internal, err.Error())
+	}
+
+	nextRunOn, err := validateAlertOptions(req.Options)
+	if err != nil {
+		return nil, err
+	}
+
+	// The editor becomes the owner, so the alert runs with the access of the user who last configured it
+	ownerID, err := scheduledResourceOwnerID(ctx, "alerts")
+	if err != nil {
+		return nil, err
+	}
+
+	alert, err = s.admin.DB.UpdateAlert(ctx, alert.ID, &database.UpdateAlertOptions{
+		Schedule:             req.Options.Schedule,
+		TimeZone:             alertTimeZone(req.Options),
+		MetricsViewName:      req.Options.MetricsViewName,
+		MeasureName:          req.Options.MeasureName,
+		Comparison:           database.AlertComparison(req.Options.Comparison),
+		Threshold:            req.Options.Threshold,
+		SplitByDimension:     req.Options.SplitByDimension,
+		WhereJSON:            req.Options.WhereJson,
+		TimeRange:            req.Options.TimeRange,
+		Recipients:           req.Options.Recipients,
+		WebhookURL:           req.Options.WebhookUrl,
+		RenotifyAfterSeconds: int(req.Options.RenotifyAfterSeconds),
+		OwnerUserID:          ownerID,
+		NextRunOn:            nextRunOn,
+	})
+	if err != nil {
+		return nil, status.Error(codes.InvalidArgument, err.Error())
+	}
+
+	s.recordProjectAuditEvent(ctx, proj, "EditAlert", alert.Name, "")
+
+	return &adminv1.EditAlertResponse{Alert: alertToPB(alert)}, nil
+}
+
+func (s *Server) DeleteAlert(ctx context.Context, req *adminv1.DeleteAlertRequest) (*adminv1.DeleteAlertResponse, error) {
+	observability.AddRequestAttributes(ctx,
+		attribute.String("args.org", req.Organization),
+		attribute.String("args.project", req.Project),
+		attribute.String("args.name", req.Name),
+	)
+
+	proj, err := s.findProjectForScheduledResource(ctx, req.Organization, req.Project, "alerts", true)
+	if err != nil {
+		return nil, err
+	}
+
+	alert, err := s.admin.DB.FindAlertByName(ctx, proj.ID, req.Name)
+	if err != nil {
+		if errors.Is(err, database.ErrNotFound) {
+			return nil, status.Error(codes.NotFound, "alert not found")
+		}
+		return nil, status.Error(codes.Internal, err.Error())
+	}
+
+	err = s.admin.DB.DeleteAlert(ctx, alert.ID)
+	if err != nil {
+		return nil, status.Error(codes.Internal, err.Error())
+	}
+
+	s.recordProjectAuditEvent(ctx, proj, "DeleteAlert", alert.Name, "")
+
+	return &adminv1.DeleteAlertResponse{}, nil
+}
+
+// validateAlertOptions validates the schedule, query and notification targets of an alert and returns the time of its next evaluation.
+func validateAlertOptions(opts *adminv1.AlertOptions) (time.Time, error) {
+	if len(opts.Recipients) == 0 && opts.WebhookUrl == "" {
+		return time.Time{}, status.Error(codes.InvalidArgument, "an alert must have at least one recipient or a webhook URL")
+	}
+
+	if opts.WebhookUrl != "" {
+		err := webhook.ValidateURL(opts.WebhookUrl)
+		if err != nil {
+			return time.Time{}, status.Error(codes.InvalidArgument, err.Error())
+		}
+	}
+
+	nextRunOn, err := admin.NextReportRunTime(opts.Schedule, opts.TimeZone, time.Now())
+	if err != nil {
+		return time.Time{}, status.Error(codes.InvalidArgument, err.Error())
+	}
+
+	err = admin.ValidateAlertQuery(database.AlertComparison(opts.Comparison), opts.WhereJson, opts.TimeRange)
+	if err != nil {
+		return time.Time{}, status.Error(codes.InvalidArgument, err.Error())
+	}
+
+	return nextRunOn, nil
+}
+
+func alertTimeZone(opts *adminv1.AlertOptions) string {
+	if opts.TimeZone == "" {
+		return "UTC"
+	}
+	return opts.TimeZone
+}
+
+func alertToPB(a *database.Alert) *adminv1.Alert {
+	var ownerUserID string
+	if a.OwnerUserID != nil {
+		ownerUserID = *a.OwnerUserID
+	}
+
+	var lastRunOn *timestamppb.Timestamp
+	if a.LastRunOn != nil {
+		lastRunOn = timestamppb.New(*a.LastRunOn)
+	}
+
+	return &adminv1.Alert{
+		Id:        a.ID,
+		ProjectId: a.ProjectID,
+		Name:      a.Name,
+		Options: &adminv1.AlertOptions{
+			Schedule:             a.Schedule,
+			TimeZone:             a.TimeZone,
+			MetricsViewName:      a.MetricsViewName,
+			MeasureName:          a.MeasureName,
+			Comparison:           adminv1.AlertComparison(a.Comparison),
+			Threshold:            a.Threshold,
+			SplitByDimension:     a.SplitByDimension,
+			WhereJson:            a.WhereJSON,
+			TimeRange:            a.TimeRange,
+			Recipients:           a.Recipients,
+			WebhookUrl:           a.WebhookURL,
+			RenotifyAfterSeconds: int64(a.RenotifyAfterSeconds),
+		},
+		OwnerUserId:  ownerUserID,
+		NextRunOn:    timestamppb.New(a.NextRunOn),
+		LastRunOn:    lastRunOn,
+		LastRunError: a.LastRunError,
+		CreatedOn:    timestamppb.New(a.CreatedOn),
+		UpdatedOn:    timestamppb.New(a.UpdatedOn),
+	}
+}
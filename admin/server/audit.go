@@ -0,0 +1,238 @@
+package server
+
+import (
+	"context"
+	"errors"
+	"fmt"
+	"sort"
+	"strings"
+
+	"github.com/rilldata/rill/admin/database"
+	"github.com/rilldata/rill/admin/server/auth"
+	adminv1 "github.com/rilldata/rill/proto/gen/rill/admin/v1"
+	"github.com/rilldata/rill/runtime/pkg/observability"
+	"go.opentelemetry.io/otel/attribute"
+	"go.uber.org/zap"
+	"google.golang.org/grpc/codes"
+	"google.golang.org/grpc/status"
+	"google.golang.org/protobuf/types/known/timestamppb"
+)
+
+// ListAuditEvents lists the audit log of an organization or, if a project is provided, of a single project.
+// Since audit events describe changes to settings, members and credentials, only admins can read them.
+func (s *Server) ListAuditEvents(ctx context.Context, req *adminv1.ListAuditEventsRequest) (*adminv1.ListAuditEventsResponse, error) {
+	observability.AddRequestAttributes(ctx,
+		attribute.String("args.org", req.Organization),
+		attribute.String("args.project", req.Project),
+	)
+
+	org, err := s.admin.DB.FindOrganizationByName(ctx, req.Organization)
+	if err != nil {
+		if errors.Is(err, database.ErrNotFound) {
+			return nil, status.Error(codes.NotFound, "org not found")
+		}
+		return nil, status.Error(codes.InvalidArgument, err.Error())
+	}
+
+	token, err := unmarshalPageToken(req.PageToken)
+	if err != nil {
+		return nil, err
+	}
+	pageSize := validPageSize(req.PageSize)
+
+	claims := auth.GetClaims(ctx)
+	var events []*database.AuditEvent
+	if req.Project != "" {
+		proj, err := s.admin.DB.FindProjectByName(ctx, req.Organization, req.Project)
+		if err != nil {
+			if errors.Is(err, database.ErrNotFound) {
+				return nil, status.Error(codes.NotFound, "project not found")
+			}
+			return nil, status.Error(codes.InvalidArgument, err.Error())
+		}
+
+		if !claims.ProjectPermissions(ctx, org.ID, proj.ID).ManageProject && !claims.Superuser(ctx) {
+			return nil, status.Error(codes.PermissionDenied, "does not have permission to read the project's audit log")
+		}
+
+		events, err = s.admin.DB.FindAuditEventsForProject(ctx, proj.ID, token.Val, pageSize)
+		if err != nil {
+			return nil, status.Error(codes.Internal, err.Error())
+		}
+	} else {
+		if !claims.OrganizationPermissions(ctx, org.ID).ManageOrg && !claims.Superuser(ctx) {
+			return nil, status.Error(codes.PermissionDenied, "does not have permission to read the org's audit log")
+		}
+
+		events, err = s.admin.DB.FindAuditEventsForOrganization(ctx, org.ID, token.Val, pageSize)
+		if err != nil {
+			return nil, status.Error(codes.Internal, err.Error())
+		}
+	}
+
+	nextToken := ""
+	if len(events) >= pageSize {
+		nextToken = marshalPageToken(events[len(events)-1].ID)
+	}
+
+	dtos := make([]*adminv1.AuditEvent, len(events))
+	for i, e := range events {
+		dtos[i] = auditEventToPB(e)
+	}
+
+	return &adminv1.ListAuditEventsResponse{
+		Events:        dtos,
+		NextPageToken: nextToken,
+	}, nil
+}
+
+// recordOrgAuditEvent records a change to an organization in the audit log.
+// The action is the name of the RPC making the change.
+func (s *Server) recordOrgAuditEvent(ctx context.Context, orgID, action, target, summary string) {
+	s.recordAuditEvent(ctx, &database.InsertAuditEventOptions{
+		OrgID:   orgID,
+		Action:  action,
+		Target:  target,
+		Summary: summary,
+	})
+}
+
+// recordProjectAuditEvent records a change to a project in the audit log.
+// The action is the name of the RPC making the change.
+func (s *Server) recordProjectAuditEvent(ctx context.Context, proj *database.Project, action, target, summary string) {
+	s.recordAuditEvent(ctx, &database.InsertAuditEventOptions{
+		OrgID:       proj.OrganizationID,
+		ProjectID:   &proj.ID,
+		ProjectName: proj.Name,
+		Action:      action,
+		Target:      target,
+		Summary:     summary,
+	})
+}
+
+// recordUserAuditEvent records a superuser action on a user in the audit log of every org the user is a member of.
+// The action is the name of the RPC making the change.
+func (s *Server) recordUserAuditEvent(ctx context.Context, user *database.User, action, summary string) {
+	afterName := ""
+	for {
+		orgs, err := s.admin.DB.FindOrganizationsForUser(ctx, user.ID, afterName, 100)
+		if err != nil {
+			s.logger.Error("failed to record audit event", zap.String("action", action), zap.String("user_id", user.ID), zap.Error(err), observability.ZapCtx(ctx))
+			return
+		}
+		for _, org := range orgs {
+			s.recordOrgAuditEvent(ctx, org.ID, action, user.Email, summary)
+		}
+		if len(orgs) < 100 {
+			return
+		}
+		afterName = orgs[len(orgs)-1].Name
+	}
+}
+
+// recordAuditEvent adds the caller's identity to an audit event and inserts it.
+// It must be called after a change has been committed and outside of a transaction, so the event is never rolled back.
+// Since the change has already been made, failures are logged instead of returned.
+func (s *Server) recordAuditEvent(ctx context.Context, opts *database.InsertAuditEventOptions) {
+	claims := auth.GetClaims(ctx)
+	opts.ActorType = string(claims.OwnerType())
+	opts.ActorID = claims.OwnerID()
+
+	switch claims.OwnerType() {
+	case auth.OwnerTypeUser:
+		user, err := s.admin.DB.FindUser(ctx, opts.ActorID)
+		if err == nil {
+			opts.ActorName = user.Email
+		}
+	case auth.OwnerTypeService:
+		service, err := s.admin.DB.FindService(ctx, opts.ActorID)
+		if err == nil {
+			opts.ActorName = service.Name
+		}
+	}
+
+	_, err := s.admin.DB.InsertAuditEvent(ctx, opts)
+	if err != nil {
+		s.logger.Error("failed to record audit event", zap.String("action", opts.Action), zap.String("org_id", opts.OrgID), zap.Error(err), observability.ZapCtx(ctx))
+	}
+}
+
+// auditDiff accumulates a human-readable summary of the fields changed by an action.
+type auditDiff []string
+
+// add records a field if its value changed.
+func (d *auditDiff) add(field string, from, to any) {
+	f, t := auditValue(from), auditValue(to)
+	if f != t {
+		*d = append(*d, fmt.Sprintf("%s: %s -> %s", field, f, t))
+	}
+}
+
+func (d auditDiff) String() string {
+	return strings.Join(d, ", ")
+}
+
+// auditValue formats a field value for an audit summary.
+func auditValue(v any) string {
+	switch v := v.(type) {
+	case string:
+		return fmt.Sprintf("%q", v)
+	case *string:
+		if v == nil {
+			return "none"
+		}
+		return fmt.Sprintf("%q", *v)
+	default:
+		return fmt.Sprint(v)
+	}
+}
+
+// auditVariablesSummary summarizes changes to project variables.
+// It only includes the variable names since the values may be secrets.
+func auditVariablesSummary(from, to map[string]string) string {
+	var added, changed, removed []string
+	for k, v := range to {
+		old, ok := from[k]
+		if !ok {
+			added = append(added, k)
+		} else if old != v {
+			changed = append(changed, k)
+		}
+	}
+	for k := range from {
+		if _, ok := to[k]; !ok {
+			removed = append(removed, k)
+		}
+	}
+
+	var parts []string
+	for _, p := range []struct {
+		verb string
+		keys []string
+	}{{"added", added}, {"changed", changed}, {"removed", removed}} {
+		if len(p.keys) > 0 {
+			sort.Strings(p.keys)
+			parts = append(parts, fmt.Sprintf("%s %s", p.verb, strings.Join(p.keys, ", ")))
+		}
+	}
+
+	if len(parts) == 0 {
+		return "no changes"
+	}
+	return strings.Join(parts, "; ")
+}
+
+func auditEventToPB(e *database.AuditEvent) *adminv1.AuditEvent {
+	return &adminv1.AuditEvent{
+		Id:          e.ID,
+		ProjectId:   safeStr(e.ProjectID),
+		ProjectName: e.ProjectName,
+		ActorType:   e.ActorType,
+		ActorId:     e.ActorID,
+		ActorName:   e.ActorName,
+		Action:      e.Action,
+		Target:      e.Target,
+		Summary:     e.Summary,
+		CreatedOn:   timestamppb.New(e.CreatedOn),
+	}
+}
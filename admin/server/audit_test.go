@@ -0,0 +1,214 @@
+package server
+
+import (
+	"context"
+	"net"
+	"testing"
+
+	"github.com/rilldata/rill/admin"
+	"github.com/rilldata/rill/admin/database"
+	"github.com/rilldata/rill/admin/email"
+	"github.com/rilldata/rill/admin/pkg/pgtestcontainer"
+	"github.com/rilldata/rill/admin/server/auth"
+	"github.com/rilldata/rill/admin/server/cookies"
+	adminv1 "github.com/rilldata/rill/proto/gen/rill/admin/v1"
+	runtimeauth "github.com/rilldata/rill/runtime/server/auth"
+	"github.com/stretchr/testify/require"
+	"go.uber.org/zap"
+	"google.golang.org/grpc"
+	"google.golang.org/grpc/codes"
+	"google.golang.org/grpc/credentials/insecure"
+	"google.golang.org/grpc/status"
+	"google.golang.org/grpc/test/bufconn"
+
+	_ "github.com/rilldata/rill/admin/database/postgres"
+)
+
+func TestAdmin_AuditEvents(t *testing.T) {
+	//---------Setup-----------//
+	pg := pgtestcontainer.New(t)
+	defer pg.Terminate(t)
+
+	ctx := context.Background()
+	logger := zap.NewNop()
+
+	sender, err := email.NewConsoleSender(logger, "rill-test@rilldata.io", "")
+	require.NoError(t, err)
+	emailClient := email.New(sender, "", "")
+
+	issuer, err := runtimeauth.NewEphemeralIssuer("")
+	require.NoError(t, err)
+
+	provisionerSpec := "{\"runtimes\":[{\"host\":\"http://localhost:9091\",\"slots\":50,\"data_dir\":\"\",\"audience_url\":\"http://localhost:8081\"}]}"
+
+	service, err := admin.New(context.Background(),
+		&admin.Options{
+			DatabaseDriver:  "postgres",
+			DatabaseDSN:     pg.DatabaseURL,
+			ProvisionerSpec: provisionerSpec,
+		},
+		logger,
+		issuer,
+		emailClient,
+		&mockGithub{},
+	)
+	require.NoError(t, err)
+
+	db := service.DB
+
+	// create admin and viewer users
+	adminUser, err := db.InsertUser(ctx, &database.InsertUserOptions{
+		Email:               "admin@test.io",
+		DisplayName:         "admin",
+		QuotaSingleuserOrgs: 3,
+	})
+	require.NoError(t, err)
+
+	viewerUser, err := db.InsertUser(ctx, &database.InsertUserOptions{
+		Email:               "viewer@test.io",
+		DisplayName:         "viewer",
+		QuotaSingleuserOrgs: 3,
+	})
+	require.NoError(t, err)
+
+	// issue admin and viewer tokens
+	adminAuthToken, err := service.IssueUserAuthToken(ctx, adminUser.ID, database.AuthClientIDRillWeb, "test", nil, nil)
+	require.NoError(t, err)
+	adminToken := adminAuthToken.Token().String()
+
+	viewerAuthToken, err := service.IssueUserAuthToken(ctx, viewerUser.ID, database.AuthClientIDRillWeb, "test", nil, nil)
+	require.NoError(t, err)
+	viewerToken := viewerAuthToken.Token().String()
+
+	authenticator, err := auth.NewAuthenticator(logger, service, cookies.New(logger, nil), &auth.AuthenticatorOptions{
+		AuthDomain: "gorillio-stage.auth0.com",
+	})
+	require.NoError(t, err)
+
+	// create a server instance
+	server := Server{
+		admin:         service,
+		authenticator: authenticator,
+		logger:        logger,
+	}
+
+	// create a mock bufconn listener
+	lis := bufconn.Listen(1024 * 1024)
+	// create a server instance listening on the mock listener
+	s := grpc.NewServer(
+		grpc.ChainStreamInterceptor(
+			server.authenticator.StreamServerInterceptor(),
+		),
+		grpc.ChainUnaryInterceptor(
+			server.authenticator.UnaryServerInterceptor(),
+		))
+	adminv1.RegisterAdminServiceServer(s, &server)
+
+	defer s.Stop()
+
+	go func() {
+		err := s.Serve(lis)
+		if err != nil {
+			panic(err)
+		}
+	}()
+
+	// create admin and viewer clients
+	adminConn, err := grpc.DialContext(ctx, "bufnet", grpc.WithContextDialer(func(context.Context, string) (net.Conn, error) {
+		return lis.Dial()
+	}), grpc.WithTransportCredentials(insecure.NewCredentials()), grpc.WithPerRPCCredentials(newBearerTokenCredential(adminToken)))
+	require.NoError(t, err)
+	defer adminConn.Close()
+	adminClient := adminv1.NewAdminServiceClient(adminConn)
+
+	viewerConn, err := grpc.DialContext(ctx, "bufnet", grpc.WithContextDialer(func(context.Context, string) (net.Conn, error) {
+		return lis.Dial()
+	}), grpc.WithTransportCredentials(insecure.NewCredentials()), grpc.WithPerRPCCredentials(newBearerTokenCredential(viewerToken)))
+	require.NoError(t, err)
+	defer viewerConn.Close()
+	viewerClient := adminv1.NewAdminServiceClient(viewerConn)
+
+	//---------Tests-----------//
+
+	// make mutating requests
+	_, err = adminClient.CreateOrganization(ctx, &adminv1.CreateOrganizationRequest{Name: "foo"})
+	require.NoError(t, err)
+
+	_, err = adminClient.AddOrganizationMember(ctx, &adminv1.AddOrganizationMemberRequest{
+		Organization: "foo",
+		Email:        viewerUser.Email,
+		Role:         "viewer",
+	})
+	require.NoError(t, err)
+
+	description := "Sales dashboards"
+	_, err = adminClient.UpdateOrganization(ctx, &adminv1.UpdateOrganizationRequest{
+		Name:        "foo",
+		Description: &description,
+	})
+	require.NoError(t, err)
+
+	// the first page contains the newest events
+	res, err := adminClient.ListAuditEvents(ctx, &adminv1.ListAuditEventsRequest{Organization: "foo", PageSize: 2})
+	require.NoError(t, err)
+	require.Len(t, res.Events, 2)
+	require.NotEmpty(t, res.NextPageToken)
+	require.Equal(t, "UpdateOrganization", res.Events[0].Action)
+	require.Equal(t, `description: "" -> "Sales dashboards"`, res.Events[0].Summary)
+	require.Equal(t, "AddOrganizationMember", res.Events[1].Action)
+	require.Equal(t, viewerUser.Email, res.Events[1].Target)
+	require.Equal(t, "user", res.Events[1].ActorType)
+	require.Equal(t, adminUser.ID, res.Events[1].ActorId)
+	require.Equal(t, adminUser.Email, res.Events[1].ActorName)
+
+	// the next page continues after the last event of the first page
+	res, err = adminClient.ListAuditEvents(ctx, &adminv1.ListAuditEventsRequest{Organization: "foo", PageSize: 2, PageToken: res.NextPageToken})
+	require.NoError(t, err)
+	require.Len(t, res.Events, 1)
+	require.Empty(t, res.NextPageToken)
+	require.Equal(t, "CreateOrganization", res.Events[0].Action)
+
+	// only admins can read the audit log
+	_, err = viewerClient.ListAuditEvents(ctx, &adminv1.ListAuditEventsRequest{Organization: "foo"})
+	require.Error(t, err)
+	require.Equal(t, codes.PermissionDenied, status.Code(err))
+
+	// superuser actions on a user are recorded in the user's orgs
+	err = db.UpdateSuperuser(ctx, adminUser.ID, true)
+	require.NoError(t, err)
+
+	_, err = adminClient.SetSuperuser(ctx, &adminv1.SetSuperuserRequest{Email: viewerUser.Email, Superuser: true})
+	require.NoError(t, err)
+
+	res, err = adminClient.ListAuditEvents(ctx, &adminv1.ListAuditEventsRequest{Organization: "foo", PageSize: 1})
+	require.NoError(t, err)
+	require.Len(t, res.Events, 1)
+	require.Equal(t, "SetSuperuser", res.Events[0].Action)
+	require.Equal(t, viewerUser.Email, res.Events[0].Target)
+	require.Equal(t, "granted superuser", res.Events[0].Summary)
+}
+
+func TestAuditDiff(t *testing.T) {
+	url := "https://github.com/rilldata/example"
+
+	var diff auditDiff
+	diff.add("name", "foo", "foo")
+	diff.add("description", "", "Sales dashboards")
+	diff.add("public", false, true)
+	diff.add("github_url", (*string)(nil), &url)
+	diff.add("prod_slots", 2, 2)
+
+	require.Equal(t, `description: "" -> "Sales dashboards", public: false -> true, github_url: none -> "https://github.com/rilldata/example"`, diff.String())
+
+	var empty auditDiff
+	require.Equal(t, "", empty.String())
+}
+
+func TestAuditVariablesSummary(t *testing.T) {
+	from := map[string]string{"A": "1", "B": "2", "C": "3"}
+	to := map[string]string{"A": "1", "B": "20", "E": "5", "D": "4"}
+	require.Equal(t, "added D, E; changed B; removed C", auditVariablesSummary(from, to))
+
+	require.Equal(t, "added A", auditVariablesSummary(nil, map[string]string{"A": "secret"}))
+	require.Equal(t, "no changes", auditVariablesSummary(from, from))
+}
@@ -4,16 +4,28 @@ import (
 	"context"
 	"errors"
 	"fmt"
+	"strings"
+	"time"
 
 	"github.com/rilldata/rill/admin/database"
 	"github.com/rilldata/rill/admin/server/auth"
 	adminv1 "github.com/rilldata/rill/proto/gen/rill/admin/v1"
+	runtimeauth "github.com/rilldata/rill/runtime/server/auth"
 	"google.golang.org/grpc/codes"
 	"google.golang.org/grpc/status"
 	"google.golang.org/protobuf/types/known/timestamppb"
 )
 
-// Listbookmarks server returns the bookmarks for the user per project
+const (
+	// bookmarkShareTokenDefaultTTL is the lifetime of bookmark share tokens created without an explicit TTL.
+	bookmarkShareTokenDefaultTTL = 7 * 24 * time.Hour
+	// bookmarkShareTokenMaxTTL caps the lifetime of bookmark share tokens.
+	bookmarkShareTokenMaxTTL = 90 * 24 * time.Hour
+	// sharedBookmarkJWTTTL is the maximum lifetime of the runtime JWTs issued for shared bookmarks.
+	sharedBookmarkJWTTTL = time.Hour
+)
+
+// Listbookmarks server returns the bookmarks for the user per project, including the bookmarks shared with the project
 func (s *Server) ListBookmarks(ctx context.Context, req *adminv1.ListBookmarksRequest) (*adminv1.ListBookmarksResponse, error) {
 	claims := auth.GetClaims(ctx)
 	// Error if authenticated as anything other than a user
@@ -21,14 +33,22 @@ func (s *Server) ListBookmarks(ctx context.Context, req *adminv1.ListBookmarksRe
 		return nil, fmt.Errorf("not authenticated as a user")
 	}
 
+	_, _, err := s.findProjectForBookmarks(ctx, req.ProjectId)
+	if err != nil {
+		return nil, err
+	}
+
 	bookmarks, err := s.admin.DB.FindBookmarks(ctx, req.ProjectId, claims.OwnerID())
 	if err != nil {
 		return nil, status.Error(codes.Internal, err.Error())
 	}
 
-	dtos := make([]*adminv1.Bookmark, len(bookmarks))
-	for i, bookmark := range bookmarks {
-		dtos[i] = bookmarkToPB(bookmark)
+	dtos := make([]*adminv1.Bookmark, 0, len(bookmarks))
+	for _, bookmark := range bookmarks {
+		if req.DashboardName != "" && !strings.EqualFold(bookmark.DashboardName, req.DashboardName) {
+			continue
+		}
+		dtos = append(dtos, bookmarkToPB(bookmark))
 	}
 
 	return &adminv1.ListBookmarksResponse{
@@ -45,27 +65,19 @@ func (s *Server) GetBookmark(ctx context.Context, req *adminv1.GetBookmarkReques
 		return nil, fmt.Errorf("not authenticated as a user")
 	}
 
-	bookmark, err := s.admin.DB.FindBookmark(ctx, req.BookmarkId)
+	bookmark, err := s.findBookmark(ctx, req.BookmarkId)
 	if err != nil {
-		return nil, status.Error(codes.Internal, err.Error())
+		return nil, err
 	}
 
-	proj, err := s.admin.DB.FindProject(ctx, bookmark.ProjectID)
+	_, _, err = s.findProjectForBookmarks(ctx, bookmark.ProjectID)
 	if err != nil {
-		if errors.Is(err, database.ErrNotFound) {
-			return nil, status.Error(codes.NotFound, "project not found")
-		}
-		return nil, status.Error(codes.InvalidArgument, err.Error())
-	}
-
-	permissions := claims.ProjectPermissions(ctx, proj.OrganizationID, proj.ID)
-	if proj.Public {
-		permissions.ReadProject = true
-		permissions.ReadProd = true
+		return nil, err
 	}
 
-	if !permissions.ReadProject && !claims.Superuser(ctx) {
-		return nil, status.Error(codes.PermissionDenied, "does not have permission to read the project")
+	// Private bookmarks are only visible to their owner
+	if !bookmark.Shared && bookmark.UserID != claims.OwnerID() && !claims.Superuser(ctx) {
+		return nil, status.Error(codes.PermissionDenied, "does not have permission to read the bookmark")
 	}
 
 	return &adminv1.GetBookmarkResponse{
@@ -82,22 +94,27 @@ func (s *Server) CreateBookmark(ctx context.Context, req *adminv1.CreateBookmark
 		return nil, fmt.Errorf("not authenticated as a user")
 	}
 
-	proj, err := s.admin.DB.FindProject(ctx, req.ProjectId)
+	_, permissions, err := s.findProjectForBookmarks(ctx, req.ProjectId)
 	if err != nil {
-		if errors.Is(err, database.ErrNotFound) {
-			return nil, status.Error(codes.NotFound, "project not found")
-		}
-		return nil, status.Error(codes.InvalidArgument, err.Error())
+		return nil, err
 	}
 
-	permissions := claims.ProjectPermissions(ctx, proj.OrganizationID, proj.ID)
-	if proj.Public {
-		permissions.ReadProject = true
-		permissions.ReadProd = true
+	if req.IsDefault && !permissions.ManageProject && !claims.Superuser(ctx) {
+		return nil, status.Error(codes.PermissionDenied, "does not have permission to set the default bookmark")
 	}
 
-	if !permissions.ReadProject && !claims.Superuser(ctx) {
-		return nil, status.Error(codes.PermissionDenied, "does not have permission to read the project")
+	ctx, tx, err := s.admin.DB.NewTx(ctx)
+	if err != nil {
+		return nil, status.Error(codes.Internal, err.Error())
+	}
+	defer func() { _ = tx.Rollback() }()
+
+	// A dashboard has at most one default bookmark, so the new default replaces the current one
+	if req.IsDefault {
+		err = s.admin.DB.UnsetDefaultBookmark(ctx, req.ProjectId, req.DashboardName)
+		if err != nil {
+			return nil, status.Error(codes.Internal, err.Error())
+		}
 	}
 
 	bookmark, err := s.admin.DB.InsertBookmark(ctx, &database.InsertBookmarkOptions{
@@ -106,16 +123,84 @@ func (s *Server) CreateBookmark(ctx context.Context, req *adminv1.CreateBookmark
 		DashboardName: req.DashboardName,
 		ProjectID:     req.ProjectId,
 		UserID:        claims.OwnerID(),
+		Shared:        req.Shared || req.IsDefault,
+		Default:       req.IsDefault,
 	})
 	if err != nil {
 		return nil, status.Error(codes.Internal, err.Error())
 	}
 
+	err = tx.Commit()
+	if err != nil {
+		return nil, status.Error(codes.Internal, err.Error())
+	}
+
 	return &adminv1.CreateBookmarkResponse{
 		Bookmark: bookmarkToPB(bookmark),
 	}, nil
 }
 
+// UpdateBookmark server updates a bookmark. Shared bookmarks can be updated by their owner and by project admins.
+func (s *Server) UpdateBookmark(ctx context.Context, req *adminv1.UpdateBookmarkRequest) (*adminv1.UpdateBookmarkResponse, error) {
+	claims := auth.GetClaims(ctx)
+
+	// Error if authenticated as anything other than a user
+	if claims.OwnerType() != auth.OwnerTypeUser {
+		return nil, fmt.Errorf("not authenticated as a user")
+	}
+
+	bookmark, err := s.findBookmark(ctx, req.BookmarkId)
+	if err != nil {
+		return nil, err
+	}
+
+	_, permissions, err := s.findProjectForBookmarks(ctx, bookmark.ProjectID)
+	if err != nil {
+		return nil, err
+	}
+
+	admin := permissions.ManageProject || claims.Superuser(ctx)
+	if bookmark.UserID != claims.OwnerID() && !(bookmark.Shared && admin) {
+		return nil, status.Error(codes.PermissionDenied, "does not have permission to update the bookmark")
+	}
+
+	if req.IsDefault != bookmark.Default && !admin {
+		return nil, status.Error(codes.PermissionDenied, "does not have permission to set the default bookmark")
+	}
+
+	ctx, tx, err := s.admin.DB.NewTx(ctx)
+	if err != nil {
+		return nil, status.Error(codes.Internal, err.Error())
+	}
+	defer func() { _ = tx.Rollback() }()
+
+	if req.IsDefault && !bookmark.Default {
+		err = s.admin.DB.UnsetDefaultBookmark(ctx, bookmark.ProjectID, bookmark.DashboardName)
+		if err != nil {
+			return nil, status.Error(codes.Internal, err.Error())
+		}
+	}
+
+	bookmark, err = s.admin.DB.UpdateBookmark(ctx, bookmark.ID, &database.UpdateBookmarkOptions{
+		DisplayName: req.DisplayName,
+		Data:        req.Data,
+		Shared:      req.Shared || req.IsDefault,
+		Default:     req.IsDefault,
+	})
+	if err != nil {
+		return nil, status.Error(codes.Internal, err.Error())
+	}
+
+	err = tx.Commit()
+	if err != nil {
+		return nil, status.Error(codes.Internal, err.Error())
+	}
+
+	return &adminv1.UpdateBookmarkResponse{
+		Bookmark: bookmarkToPB(bookmark),
+	}, nil
+}
+
 // RemoveBookmark server removes a bookmark for bookmark id
 func (s *Server) RemoveBookmark(ctx context.Context, req *adminv1.RemoveBookmarkRequest) (*adminv1.RemoveBookmarkResponse, error) {
 	claims := auth.GetClaims(ctx)
@@ -125,13 +210,20 @@ func (s *Server) RemoveBookmark(ctx context.Context, req *adminv1.RemoveBookmark
 		return nil, fmt.Errorf("not authenticated as a user")
 	}
 
-	bookmark, err := s.admin.DB.FindBookmark(ctx, req.BookmarkId)
+	bookmark, err := s.findBookmark(ctx, req.BookmarkId)
 	if err != nil {
-		return nil, status.Error(codes.Internal, err.Error())
+		return nil, err
 	}
 
 	if bookmark.UserID != claims.OwnerID() {
-		return nil, status.Error(codes.PermissionDenied, "does not have permission to delete the bookmark")
+		// Project admins can also remove shared bookmarks
+		_, permissions, err := s.findProjectForBookmarks(ctx, bookmark.ProjectID)
+		if err != nil {
+			return nil, err
+		}
+		if !bookmark.Shared || !(permissions.ManageProject || claims.Superuser(ctx)) {
+			return nil, status.Error(codes.PermissionDenied, "does not have permission to delete the bookmark")
+		}
 	}
 
 	err = s.admin.DB.DeleteBookmark(ctx, req.BookmarkId)
@@ -142,6 +234,250 @@ func (s *Server) RemoveBookmark(ctx context.Context, req *adminv1.RemoveBookmark
 	return &adminv1.RemoveBookmarkResponse{}, nil
 }
 
+// CreateBookmarkShareToken server creates an expiring token for public, read-only access to a bookmark's dashboard state.
+// Since the token exposes project data to anyone who has it, only project admins can create share tokens.
+func (s *Server) CreateBookmarkShareToken(ctx context.Context, req *adminv1.CreateBookmarkShareTokenRequest) (*adminv1.CreateBookmarkShareTokenResponse, error) {
+	claims := auth.GetClaims(ctx)
+
+	// Error if authenticated as anything other than a user
+	if claims.OwnerType() != auth.OwnerTypeUser {
+		return nil, fmt.Errorf("not authenticated as a user")
+	}
+
+	bookmark, err := s.findBookmark(ctx, req.BookmarkId)
+	if err != nil {
+		return nil, err
+	}
+
+	proj, permissions, err := s.findProjectForBookmarks(ctx, bookmark.ProjectID)
+	if err != nil {
+		return nil, err
+	}
+
+	if !permissions.ManageProject && !claims.Superuser(ctx) {
+		return nil, status.Error(codes.PermissionDenied, "does not have permission to share the bookmark")
+	}
+
+	// Share tokens are only valid for shared bookmarks (see GetSharedBookmark)
+	if !bookmark.Shared {
+		return nil, status.Error(codes.FailedPrecondition, "only shared bookmarks can be shared with a token")
+	}
+
+	ttl := bookmarkShareTokenDefaultTTL
+	if req.TtlSeconds > 0 {
+		ttl = time.Duration(req.TtlSeconds) * time.Second
+	}
+	if ttl > bookmarkShareTokenMaxTTL {
+		return nil, status.Errorf(codes.InvalidArgument, "share tokens can expire at most %d days from now", int(bookmarkShareTokenMaxTTL.Hours()/24))
+	}
+
+	userID := claims.OwnerID()
+	token, model, err := s.admin.IssueBookmarkShareToken(ctx, bookmark.ID, &userID, ttl)
+	if err != nil {
+		return nil, status.Error(codes.Internal, err.Error())
+	}
+
+	s.recordProjectAuditEvent(ctx, proj, "CreateBookmarkShareToken", bookmark.DisplayName, fmt.Sprintf("issued token %s expiring %s", model.ID, model.ExpiresOn.Format(time.RFC3339)))
+
+	return &adminv1.CreateBookmarkShareTokenResponse{
+		Token:     token,
+		TokenId:   model.ID,
+		ExpiresOn: timestamppb.New(model.ExpiresOn),
+	}, nil
+}
+
+// RevokeBookmarkShareToken server deletes a bookmark share token
+func (s *Server) RevokeBookmarkShareToken(ctx context.Context, req *adminv1.RevokeBookmarkShareTokenRequest) (*adminv1.RevokeBookmarkShareTokenResponse, error) {
+	claims := auth.GetClaims(ctx)
+
+	// Error if authenticated as anything other than a user
+	if claims.OwnerType() != auth.OwnerTypeUser {
+		return nil, fmt.Errorf("not authenticated as a user")
+	}
+
+	bookmark, err := s.findBookmark(ctx, req.BookmarkId)
+	if err != nil {
+		return nil, err
+	}
+
+	proj, permissions, err := s.findProjectForBookmarks(ctx, bookmark.ProjectID)
+	if err != nil {
+		return nil, err
+	}
+
+	if !permissions.ManageProject && !claims.Superuser(ctx) {
+		return nil, status.Error(codes.PermissionDenied, "does not have permission to revoke share tokens")
+	}
+
+	tkn, err := s.admin.DB.FindBookmarkShareToken(ctx, req.TokenId)
+	if err != nil {
+		if errors.Is(err, database.ErrNotFound) {
+			return nil, status.Error(codes.NotFound, "share token not found")
+		}
+		return nil, status.Error(codes.Internal, err.Error())
+	}
+
+	if tkn.BookmarkID != bookmark.ID {
+		return nil, status.Error(codes.NotFound, "share token not found")
+	}
+
+	err = s.admin.DB.DeleteBookmarkShareToken(ctx, tkn.ID)
+	if err != nil {
+		return nil, status.Error(codes.Internal, err.Error())
+	}
+
+	s.recordProjectAuditEvent(ctx, proj, "RevokeBookmarkShareToken", bookmark.DisplayName, fmt.Sprintf("revoked token %s", tkn.ID))
+
+	return &adminv1.RevokeBookmarkShareTokenResponse{}, nil
+}
+
+// GetSharedBookmark server returns the bookmark of a share token and a runtime JWT that can only query the bookmark's dashboard.
+// It does not require authentication. Security policies treat the viewer as an anonymous user.
+// The token is treated as revoked if the bookmark is no longer shared or its creator is no longer a project admin.
+func (s *Server) GetSharedBookmark(ctx context.Context, req *adminv1.GetSharedBookmarkRequest) (*adminv1.GetSharedBookmarkResponse, error) {
+	tkn, err := s.admin.ValidateBookmarkShareToken(ctx, req.Token)
+	if err != nil {
+		return nil, status.Error(codes.PermissionDenied, "invalid or expired share token")
+	}
+
+	bookmark, err := s.findBookmark(ctx, tkn.BookmarkID)
+	if err != nil {
+		return nil, err
+	}
+
+	if !bookmark.Shared {
+		return nil, status.Error(codes.PermissionDenied, "invalid or expired share token")
+	}
+
+	ok, err := s.canShareBookmarks(ctx, tkn.CreatedByUserID, bookmark.ProjectID)
+	if err != nil {
+		return nil, status.Error(codes.Internal, err.Error())
+	}
+	if !ok {
+		return nil, status.Error(codes.PermissionDenied, "invalid or expired share token")
+	}
+
+	proj, err := s.admin.DB.FindProject(ctx, bookmark.ProjectID)
+	if err != nil {
+		return nil, status.Error(codes.Internal, err.Error())
+	}
+
+	if proj.ProdDeploymentID == nil {
+		return nil, status.Error(codes.FailedPrecondition, "project does not have a production deployment")
+	}
+
+	org, err := s.admin.DB.FindOrganization(ctx, proj.OrganizationID)
+	if err != nil {
+		return nil, status.Error(codes.Internal, err.Error())
+	}
+
+	depl, err := s.admin.DB.FindDeployment(ctx, *proj.ProdDeploymentID)
+	if err != nil {
+		return nil, status.Error(codes.Internal, err.Error())
+	}
+
+	// The JWT must not outlive the share token
+	ttl := sharedBookmarkJWTTTL
+	if remaining := time.Until(tkn.ExpiresOn); remaining < ttl {
+		ttl = remaining
+	}
+
+	jwt, err := s.issuer.NewToken(runtimeauth.TokenOptions{
+		AudienceURL: depl.RuntimeAudience,
+		TTL:         ttl,
+		InstancePermissions: map[string][]runtimeauth.Permission{
+			depl.RuntimeInstanceID: {
+				runtimeauth.ReadObjects,
+				runtimeauth.ReadMetrics,
+			},
+		},
+		MetricsViews: []string{bookmark.DashboardName},
+		Attributes:   map[string]any{},
+	})
+	if err != nil {
+		return nil, status.Errorf(codes.Internal, "could not issue jwt: %s", err.Error())
+	}
+
+	s.admin.Used.Deployment(depl.ID)
+
+	return &adminv1.GetSharedBookmarkResponse{
+		Bookmark:     bookmarkToPB(bookmark),
+		Organization: org.Name,
+		Project:      proj.Name,
+		RuntimeHost:  depl.RuntimeHost,
+		InstanceId:   depl.RuntimeInstanceID,
+		Jwt:          jwt,
+		ExpiresOn:    timestamppb.New(tkn.ExpiresOn),
+	}, nil
+}
+
+// canShareBookmarks returns true if the user can share the project's bookmarks, i.e. is a project admin or a superuser.
+// It returns false if the user is nil, which is the case when the user has been deleted.
+func (s *Server) canShareBookmarks(ctx context.Context, userID *string, projectID string) (bool, error) {
+	if userID == nil {
+		return false, nil
+	}
+
+	user, err := s.admin.DB.FindUser(ctx, *userID)
+	if err != nil {
+		if errors.Is(err, database.ErrNotFound) {
+			return false, nil
+		}
+		return false, err
+	}
+	if user.Superuser {
+		return true, nil
+	}
+
+	roles, err := s.admin.DB.ResolveProjectRolesForUser(ctx, user.ID, projectID)
+	if err != nil {
+		return false, err
+	}
+	for _, r := range roles {
+		if r.ManageProject {
+			return true, nil
+		}
+	}
+	return false, nil
+}
+
+// findBookmark finds a bookmark by ID and maps database errors to status errors.
+func (s *Server) findBookmark(ctx context.Context, bookmarkID string) (*database.Bookmark, error) {
+	bookmark, err := s.admin.DB.FindBookmark(ctx, bookmarkID)
+	if err != nil {
+		if errors.Is(err, database.ErrNotFound) {
+			return nil, status.Error(codes.NotFound, "bookmark not found")
+		}
+		return nil, status.Error(codes.Internal, err.Error())
+	}
+	return bookmark, nil
+}
+
+// findProjectForBookmarks finds a project and checks that the caller can read it.
+// It returns the caller's permissions on the project, which are used to authorize actions on shared bookmarks.
+func (s *Server) findProjectForBookmarks(ctx context.Context, projectID string) (*database.Project, *adminv1.ProjectPermissions, error) {
+	proj, err := s.admin.DB.FindProject(ctx, projectID)
+	if err != nil {
+		if errors.Is(err, database.ErrNotFound) {
+			return nil, nil, status.Error(codes.NotFound, "project not found")
+		}
+		return nil, nil, status.Error(codes.InvalidArgument, err.Error())
+	}
+
+	claims := auth.GetClaims(ctx)
+	permissions := claims.ProjectPermissions(ctx, proj.OrganizationID, proj.ID)
+	if proj.Public {
+		permissions.ReadProject = true
+		permissions.ReadProd = true
+	}
+
+	if !permissions.ReadProject && !claims.Superuser(ctx) {
+		return nil, nil, status.Error(codes.PermissionDenied, "does not have permission to read the project")
+	}
+
+	return proj, permissions, nil
+}
+
 func bookmarkToPB(u *database.Bookmark) *adminv1.Bookmark {
 	return &adminv1.Bookmark{
 		Id:            u.ID,
@@ -152,5 +488,7 @@ func bookmarkToPB(u *database.Bookmark) *adminv1.Bookmark {
 		UserId:        u.UserID,
 		CreatedOn:     timestamppb.New(u.CreatedOn),
 		UpdatedOn:     timestamppb.New(u.UpdatedOn),
+		Shared:        u.Shared,
+		IsDefault:     u.Default,
 	}
 }
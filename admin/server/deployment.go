@@ -2,7 +2,9 @@ package server
 
 import (
 	"context"
+	"fmt"
 	"net/http"
+	"strings"
 
 	"github.com/rilldata/rill/admin/database"
 	"github.com/rilldata/rill/admin/server/auth"
@@ -38,6 +40,8 @@ func (s *Server) TriggerReconcile(ctx context.Context, req *adminv1.TriggerRecon
 		return nil, status.Error(codes.InvalidArgument, err.Error())
 	}
 
+	s.recordProjectAuditEvent(ctx, proj, "TriggerReconcile", depl.ID, "")
+
 	return &adminv1.TriggerReconcileResponse{}, nil
 }
 
@@ -67,6 +71,12 @@ func (s *Server) TriggerRefreshSources(ctx context.Context, req *adminv1.Trigger
 		return nil, status.Error(codes.InvalidArgument, err.Error())
 	}
 
+	summary := "refreshed all sources"
+	if len(req.Sources) > 0 {
+		summary = fmt.Sprintf("refreshed %s", strings.Join(req.Sources, ", "))
+	}
+	s.recordProjectAuditEvent(ctx, proj, "TriggerRefreshSources", depl.ID, summary)
+
 	return &adminv1.TriggerRefreshSourcesResponse{}, nil
 }
 
@@ -148,5 +158,11 @@ func (s *Server) TriggerRedeploy(ctx context.Context, req *adminv1.TriggerRedepl
 		return nil, status.Error(codes.InvalidArgument, err.Error())
 	}
 
+	var target string
+	if depl != nil {
+		target = depl.ID
+	}
+	s.recordProjectAuditEvent(ctx, proj, "TriggerRedeploy", target, "")
+
 	return &adminv1.TriggerRedeployResponse{}, nil
 }
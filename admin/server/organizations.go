@@ -3,6 +3,7 @@ package server
 import (
 	"context"
 	"errors"
+	"fmt"
 	"math"
 	"strings"
 
@@ -128,6 +129,8 @@ func (s *Server) CreateOrganization(ctx context.Context, req *adminv1.CreateOrga
 		return nil, status.Error(codes.InvalidArgument, err.Error())
 	}
 
+	s.recordOrgAuditEvent(ctx, org.ID, "CreateOrganization", org.Name, "")
+
 	return &adminv1.CreateOrganizationResponse{
 		Organization: organizationToDTO(org),
 	}, nil
@@ -174,6 +177,7 @@ func (s *Server) UpdateOrganization(ctx context.Context, req *adminv1.UpdateOrga
 	}
 
 	orgNewName := valOrDefault(req.NewName, org.Name)
+	orgNewDescription := valOrDefault(req.Description, org.Description)
 
 	if orgNewName != org.Name { // org name changed
 		err := s.admin.UpdateOrgDeploymentAnnotations(ctx, org.ID, orgNewName)
@@ -182,9 +186,13 @@ func (s *Server) UpdateOrganization(ctx context.Context, req *adminv1.UpdateOrga
 		}
 	}
 
+	var diff auditDiff
+	diff.add("name", org.Name, orgNewName)
+	diff.add("description", org.Description, orgNewDescription)
+
 	org, err = s.admin.DB.UpdateOrganization(ctx, org.ID, &database.UpdateOrganizationOptions{
 		Name:                    orgNewName,
-		Description:             valOrDefault(req.Description, org.Description),
+		Description:             orgNewDescription,
 		QuotaProjects:           org.QuotaProjects,
 		QuotaDeployments:        org.QuotaDeployments,
 		QuotaSlotsTotal:         org.QuotaSlotsTotal,
@@ -195,6 +203,8 @@ func (s *Server) UpdateOrganization(ctx context.Context, req *adminv1.UpdateOrga
 		return nil, status.Error(codes.Internal, err.Error())
 	}
 
+	s.recordOrgAuditEvent(ctx, org.ID, "UpdateOrganization", org.Name, diff.String())
+
 	return &adminv1.UpdateOrganizationResponse{
 		Organization: organizationToDTO(org),
 	}, nil
@@ -341,6 +351,8 @@ func (s *Server) AddOrganizationMember(ctx context.Context, req *adminv1.AddOrga
 			return nil, status.Error(codes.Internal, err.Error())
 		}
 
+		s.recordOrgAuditEvent(ctx, org.ID, "AddOrganizationMember", req.Email, fmt.Sprintf("invited with role %q", role.Name))
+
 		// Send invitation email
 		err = s.admin.Email.SendOrganizationInvite(&email.OrganizationInvite{
 			ToEmail:       req.Email,
@@ -358,18 +370,18 @@ func (s *Server) AddOrganizationMember(ctx context.Context, req *adminv1.AddOrga
 		}, nil
 	}
 
-	ctx, tx, err := s.admin.DB.NewTx(ctx)
+	txCtx, tx, err := s.admin.DB.NewTx(ctx)
 	if err != nil {
 		return nil, status.Error(codes.Internal, err.Error())
 	}
 	defer func() { _ = tx.Rollback() }()
 
-	err = s.admin.DB.InsertOrganizationMemberUser(ctx, org.ID, user.ID, role.ID)
+	err = s.admin.DB.InsertOrganizationMemberUser(txCtx, org.ID, user.ID, role.ID)
 	if err != nil {
 		return nil, status.Error(codes.InvalidArgument, err.Error())
 	}
 
-	err = s.admin.DB.InsertUsergroupMember(ctx, *org.AllUsergroupID, user.ID)
+	err = s.admin.DB.InsertUsergroupMember(txCtx, *org.AllUsergroupID, user.ID)
 	if err != nil {
 		if !errors.Is(err, database.ErrNotUnique) {
 			return nil, status.Error(codes.Internal, err.Error())
@@ -382,6 +394,8 @@ func (s *Server) AddOrganizationMember(ctx context.Context, req *adminv1.AddOrga
 		return nil, status.Error(codes.Internal, err.Error())
 	}
 
+	s.recordOrgAuditEvent(ctx, org.ID, "AddOrganizationMember", req.Email, fmt.Sprintf("added with role %q", role.Name))
+
 	err = s.admin.Email.SendOrganizationAddition(&email.OrganizationAddition{
 		ToEmail:       req.Email,
 		ToName:        "",
@@ -431,6 +445,7 @@ func (s *Server) RemoveOrganizationMember(ctx context.Context, req *adminv1.Remo
 		if err != nil {
 			return nil, status.Error(codes.Internal, err.Error())
 		}
+		s.recordOrgAuditEvent(ctx, org.ID, "RemoveOrganizationMember", req.Email, "removed invite")
 		return &adminv1.RemoveOrganizationMemberResponse{}, nil
 	}
 
@@ -450,28 +465,30 @@ func (s *Server) RemoveOrganizationMember(ctx context.Context, req *adminv1.Remo
 		return nil, status.Error(codes.InvalidArgument, "cannot remove the last owner")
 	}
 
-	ctx, tx, err := s.admin.DB.NewTx(ctx)
+	txCtx, tx, err := s.admin.DB.NewTx(ctx)
 	if err != nil {
 		return nil, status.Error(codes.Internal, err.Error())
 	}
 	defer func() { _ = tx.Rollback() }()
-	err = s.admin.DB.DeleteOrganizationMemberUser(ctx, org.ID, user.ID)
+	err = s.admin.DB.DeleteOrganizationMemberUser(txCtx, org.ID, user.ID)
 	if err != nil {
 		return nil, status.Error(codes.InvalidArgument, err.Error())
 	}
 
 	// delete from all user group
-	err = s.admin.DB.DeleteUsergroupMember(ctx, *org.AllUsergroupID, user.ID)
+	err = s.admin.DB.DeleteUsergroupMember(txCtx, *org.AllUsergroupID, user.ID)
 	if err != nil {
 		return nil, status.Error(codes.Internal, err.Error())
 	}
 
 	// delete from projects if KeepProjectRoles flag is set
+	summary := "removed from the org, kept project roles"
 	if !req.KeepProjectRoles {
-		err = s.admin.DB.DeleteAllProjectMemberUserForOrganization(ctx, org.ID, user.ID)
+		err = s.admin.DB.DeleteAllProjectMemberUserForOrganization(txCtx, org.ID, user.ID)
 		if err != nil {
 			return nil, status.Error(codes.Internal, err.Error())
 		}
+		summary = "removed from the org and its projects"
 	}
 
 	err = tx.Commit()
@@ -479,6 +496,8 @@ func (s *Server) RemoveOrganizationMember(ctx context.Context, req *adminv1.Remo
 		return nil, status.Error(codes.Internal, err.Error())
 	}
 
+	s.recordOrgAuditEvent(ctx, org.ID, "RemoveOrganizationMember", req.Email, summary)
+
 	return &adminv1.RemoveOrganizationMemberResponse{}, nil
 }
 
@@ -520,6 +539,7 @@ func (s *Server) SetOrganizationMemberRole(ctx context.Context, req *adminv1.Set
 		if err != nil {
 			return nil, status.Error(codes.Internal, err.Error())
 		}
+		s.recordOrgAuditEvent(ctx, org.ID, "SetOrganizationMemberRole", req.Email, fmt.Sprintf("set invite role to %q", role.Name))
 		return &adminv1.SetOrganizationMemberRoleResponse{}, nil
 	}
 
@@ -545,6 +565,8 @@ func (s *Server) SetOrganizationMemberRole(ctx context.Context, req *adminv1.Set
 		return nil, status.Error(codes.InvalidArgument, err.Error())
 	}
 
+	s.recordOrgAuditEvent(ctx, org.ID, "SetOrganizationMemberRole", req.Email, fmt.Sprintf("set role to %q", role.Name))
+
 	return &adminv1.SetOrganizationMemberRoleResponse{}, nil
 }
 
@@ -585,26 +607,29 @@ func (s *Server) LeaveOrganization(ctx context.Context, req *adminv1.LeaveOrgani
 		return nil, status.Error(codes.InvalidArgument, "cannot remove the last owner")
 	}
 
-	ctx, tx, err := s.admin.DB.NewTx(ctx)
+	txCtx, tx, err := s.admin.DB.NewTx(ctx)
 	if err != nil {
 		return nil, status.Error(codes.Internal, err.Error())
 	}
 	defer func() { _ = tx.Rollback() }()
-	err = s.admin.DB.DeleteOrganizationMemberUser(ctx, org.ID, claims.OwnerID())
+	err = s.admin.DB.DeleteOrganizationMemberUser(txCtx, org.ID, claims.OwnerID())
 	if err != nil {
 		return nil, status.Error(codes.InvalidArgument, err.Error())
 	}
 
 	// delete from all user group
-	err = s.admin.DB.DeleteUsergroupMember(ctx, *org.AllUsergroupID, claims.OwnerID())
+	err = s.admin.DB.DeleteUsergroupMember(txCtx, *org.AllUsergroupID, claims.OwnerID())
 	if err != nil {
 		return nil, status.Error(codes.Internal, err.Error())
 	}
+
 	err = tx.Commit()
 	if err != nil {
 		return nil, status.Error(codes.Internal, err.Error())
 	}
 
+	s.recordOrgAuditEvent(ctx, org.ID, "LeaveOrganization", "", "left the org")
+
 	return &adminv1.LeaveOrganizationResponse{}, nil
 }
 
@@ -673,13 +698,13 @@ func (s *Server) CreateWhitelistedDomain(ctx context.Context, req *adminv1.Creat
 		}
 	}
 
-	ctx, tx, err := s.admin.DB.NewTx(ctx)
+	txCtx, tx, err := s.admin.DB.NewTx(ctx)
 	if err != nil {
 		return nil, err
 	}
 	defer func() { _ = tx.Rollback() }()
 
-	_, err = s.admin.DB.InsertOrganizationWhitelistedDomain(ctx, &database.InsertOrganizationWhitelistedDomainOptions{
+	_, err = s.admin.DB.InsertOrganizationWhitelistedDomain(txCtx, &database.InsertOrganizationWhitelistedDomainOptions{
 		OrgID:     org.ID,
 		OrgRoleID: role.ID,
 		Domain:    req.Domain,
@@ -690,13 +715,13 @@ func (s *Server) CreateWhitelistedDomain(ctx context.Context, req *adminv1.Creat
 	}
 
 	for _, user := range newUsers {
-		err = s.admin.DB.InsertOrganizationMemberUser(ctx, org.ID, user.ID, role.ID)
+		err = s.admin.DB.InsertOrganizationMemberUser(txCtx, org.ID, user.ID, role.ID)
 		if err != nil {
 			return nil, status.Error(codes.Internal, err.Error())
 		}
 
 		// add to all user group
-		err = s.admin.DB.InsertUsergroupMember(ctx, *org.AllUsergroupID, user.ID)
+		err = s.admin.DB.InsertUsergroupMember(txCtx, *org.AllUsergroupID, user.ID)
 		if err != nil {
 			return nil, status.Error(codes.Internal, err.Error())
 		}
@@ -707,6 +732,8 @@ func (s *Server) CreateWhitelistedDomain(ctx context.Context, req *adminv1.Creat
 		return nil, err
 	}
 
+	s.recordOrgAuditEvent(ctx, org.ID, "CreateWhitelistedDomain", req.Domain, fmt.Sprintf("whitelisted with role %q, added %d existing users", role.Name, len(newUsers)))
+
 	return &adminv1.CreateWhitelistedDomainResponse{}, nil
 }
 
@@ -743,6 +770,8 @@ func (s *Server) RemoveWhitelistedDomain(ctx context.Context, req *adminv1.Remov
 		return nil, status.Error(codes.Internal, err.Error())
 	}
 
+	s.recordOrgAuditEvent(ctx, org.ID, "RemoveWhitelistedDomain", req.Domain, "")
+
 	return &adminv1.RemoveWhitelistedDomainResponse{}, nil
 }
 
@@ -823,6 +852,14 @@ func (s *Server) SudoUpdateOrganizationQuotas(ctx context.Context, req *adminv1.
 		return nil, err
 	}
 
+	var diff auditDiff
+	diff.add("projects", org.QuotaProjects, updatedOrg.QuotaProjects)
+	diff.add("deployments", org.QuotaDeployments, updatedOrg.QuotaDeployments)
+	diff.add("slots_total", org.QuotaSlotsTotal, updatedOrg.QuotaSlotsTotal)
+	diff.add("slots_per_deployment", org.QuotaSlotsPerDeployment, updatedOrg.QuotaSlotsPerDeployment)
+	diff.add("outstanding_invites", org.QuotaOutstandingInvites, updatedOrg.QuotaOutstandingInvites)
+	s.recordOrgAuditEvent(ctx, org.ID, "SudoUpdateOrganizationQuotas", org.Name, diff.String())
+
 	return &adminv1.SudoUpdateOrganizationQuotasResponse{
 		Organization: organizationToDTO(updatedOrg),
 	}, nil
@@ -5,6 +5,7 @@ import (
 	"errors"
 	"fmt"
 	"net/url"
+	"strings"
 	"time"
 
 	"github.com/rilldata/rill/admin"
@@ -122,6 +123,14 @@ func (s *Server) GetProject(ctx context.Context, req *adminv1.GetProjectRequest)
 		depl.Logs = ""
 	}
 
+	var attr map[string]any
+	if claims.OwnerType() == auth.OwnerTypeUser {
+		attr, err = s.jwtAttributesForUser(ctx, claims.OwnerID(), permissions)
+		if err != nil {
+			return nil, status.Error(codes.Internal, err.Error())
+		}
+	}
+
 	jwt, err := s.issuer.NewToken(runtimeauth.TokenOptions{
 		AudienceURL: depl.RuntimeAudience,
 		Subject:     claims.OwnerID(),
@@ -135,6 +144,7 @@ func (s *Server) GetProject(ctx context.Context, req *adminv1.GetProjectRequest)
 				runtimeauth.ReadRepo,
 			},
 		},
+		Attributes: attr,
 	})
 	if err != nil {
 		return nil, status.Errorf(codes.Internal, "could not issue jwt: %s", err.Error())
@@ -150,6 +160,26 @@ func (s *Server) GetProject(ctx context.Context, req *adminv1.GetProjectRequest)
 	}, nil
 }
 
+// jwtAttributesForUser returns the attributes of a user that are passed in runtime JWTs, where they are used to evaluate security policies.
+func (s *Server) jwtAttributesForUser(ctx context.Context, userID string, projectPermissions *adminv1.ProjectPermissions) (map[string]any, error) {
+	user, err := s.admin.DB.FindUser(ctx, userID)
+	if err != nil {
+		return nil, err
+	}
+
+	var domain string
+	if i := strings.LastIndex(user.Email, "@"); i >= 0 {
+		domain = user.Email[i+1:]
+	}
+
+	return map[string]any{
+		"name":   user.DisplayName,
+		"email":  user.Email,
+		"domain": domain,
+		"admin":  projectPermissions.ManageProject,
+	}, nil
+}
+
 func (s *Server) SearchProjectNames(ctx context.Context, req *adminv1.SearchProjectNamesRequest) (*adminv1.SearchProjectNamesResponse, error) {
 	observability.AddRequestAttributes(ctx,
 		attribute.String("args.pattern", req.NamePattern),
@@ -273,6 +303,8 @@ func (s *Server) CreateProject(ctx context.Context, req *adminv1.CreateProjectRe
 		return nil, status.Error(codes.InvalidArgument, err.Error())
 	}
 
+	s.recordProjectAuditEvent(ctx, proj, "CreateProject", proj.Name, fmt.Sprintf("deploys branch %q of %s", proj.ProdBranch, req.GithubUrl))
+
 	return &adminv1.CreateProjectResponse{
 		Project: s.projToDTO(proj, org.Name),
 	}, nil
@@ -299,6 +331,14 @@ func (s *Server) DeleteProject(ctx context.Context, req *adminv1.DeleteProjectRe
 		return nil, status.Error(codes.InvalidArgument, err.Error())
 	}
 
+	// The project no longer exists, so the event is only linked to the project by name
+	s.recordAuditEvent(ctx, &database.InsertAuditEventOptions{
+		OrgID:       proj.OrganizationID,
+		ProjectName: proj.Name,
+		Action:      "DeleteProject",
+		Target:      proj.Name,
+	})
+
 	return &adminv1.DeleteProjectResponse{}, nil
 }
 
@@ -331,6 +371,9 @@ func (s *Server) UpdateProject(ctx context.Context, req *adminv1.UpdateProjectRe
 	if req.NewName != nil {
 		observability.AddRequestAttributes(ctx, attribute.String("args.new_name", *req.NewName))
 	}
+	if req.PreviewDeployments != nil {
+		observability.AddRequestAttributes(ctx, attribute.Bool("args.preview_deployments", *req.PreviewDeployments))
+	}
 
 	// Check the request is made by a user
 	claims := auth.GetClaims(ctx)
@@ -373,12 +416,26 @@ func (s *Server) UpdateProject(ctx context.Context, req *adminv1.UpdateProjectRe
 		ProdSlots:            int(valOrDefault(req.ProdSlots, int64(proj.ProdSlots))),
 		ProdTTLSeconds:       &prodTTLSeconds,
 		Region:               valOrDefault(req.Region, proj.Region),
+		PreviewDeployments:   valOrDefault(req.PreviewDeployments, proj.PreviewDeployments),
 	}
+	oldProj := proj
 	proj, err = s.admin.UpdateProject(ctx, proj, opts)
 	if err != nil {
 		return nil, status.Error(codes.Internal, err.Error())
 	}
 
+	var diff auditDiff
+	diff.add("name", oldProj.Name, proj.Name)
+	diff.add("description", oldProj.Description, proj.Description)
+	diff.add("public", oldProj.Public, proj.Public)
+	diff.add("github_url", oldProj.GithubURL, proj.GithubURL)
+	diff.add("prod_branch", oldProj.ProdBranch, proj.ProdBranch)
+	diff.add("prod_slots", oldProj.ProdSlots, proj.ProdSlots)
+	diff.add("prod_ttl_seconds", safeInt64(oldProj.ProdTTLSeconds), safeInt64(proj.ProdTTLSeconds))
+	diff.add("preview_deployments", oldProj.PreviewDeployments, proj.PreviewDeployments)
+	diff.add("region", oldProj.Region, proj.Region)
+	s.recordProjectAuditEvent(ctx, proj, "UpdateProject", proj.Name, diff.String())
+
 	return &adminv1.UpdateProjectResponse{
 		Project: s.projToDTO(proj, req.OrganizationName),
 	}, nil
@@ -403,6 +460,110 @@ func (s *Server) GetProjectVariables(ctx context.Context, req *adminv1.GetProjec
 	return &adminv1.GetProjectVariablesResponse{Variables: proj.ProdVariables}, nil
 }
 
+func (s *Server) ListPreviewDeployments(ctx context.Context, req *adminv1.ListPreviewDeploymentsRequest) (*adminv1.ListPreviewDeploymentsResponse, error) {
+	observability.AddRequestAttributes(ctx,
+		attribute.String("args.org", req.OrganizationName),
+		attribute.String("args.project", req.Name),
+	)
+
+	proj, err := s.admin.DB.FindProjectByName(ctx, req.OrganizationName, req.Name)
+	if err != nil {
+		if errors.Is(err, database.ErrNotFound) {
+			return nil, status.Error(codes.NotFound, "project not found")
+		}
+		return nil, status.Error(codes.InvalidArgument, err.Error())
+	}
+
+	claims := auth.GetClaims(ctx)
+	permissions := claims.ProjectPermissions(ctx, proj.OrganizationID, proj.ID)
+	if !permissions.ReadDev && !claims.Superuser(ctx) {
+		return nil, status.Error(codes.PermissionDenied, "does not have permission to read preview deployments")
+	}
+
+	depls, err := s.admin.DB.FindPreviewDeploymentsForProject(ctx, proj.ID)
+	if err != nil {
+		return nil, status.Error(codes.Internal, err.Error())
+	}
+
+	dtos := make([]*adminv1.Deployment, len(depls))
+	for i, d := range depls {
+		if !permissions.ReadDevStatus {
+			d.Logs = ""
+		}
+		dtos[i] = deploymentToDTO(d)
+	}
+
+	return &adminv1.ListPreviewDeploymentsResponse{Deployments: dtos}, nil
+}
+
+func (s *Server) GetPreviewDeployment(ctx context.Context, req *adminv1.GetPreviewDeploymentRequest) (*adminv1.GetPreviewDeploymentResponse, error) {
+	observability.AddRequestAttributes(ctx,
+		attribute.String("args.org", req.OrganizationName),
+		attribute.String("args.project", req.Name),
+		attribute.String("args.branch", req.Branch),
+	)
+
+	proj, err := s.admin.DB.FindProjectByName(ctx, req.OrganizationName, req.Name)
+	if err != nil {
+		if errors.Is(err, database.ErrNotFound) {
+			return nil, status.Error(codes.NotFound, "project not found")
+		}
+		return nil, status.Error(codes.InvalidArgument, err.Error())
+	}
+
+	claims := auth.GetClaims(ctx)
+	permissions := claims.ProjectPermissions(ctx, proj.OrganizationID, proj.ID)
+	if !permissions.ReadDev && !claims.Superuser(ctx) {
+		return nil, status.Error(codes.PermissionDenied, "does not have permission to read preview deployments")
+	}
+
+	depl, err := s.admin.DB.FindPreviewDeploymentForBranch(ctx, proj.ID, req.Branch)
+	if err != nil {
+		if errors.Is(err, database.ErrNotFound) {
+			return nil, status.Error(codes.NotFound, "preview deployment not found")
+		}
+		return nil, status.Error(codes.Internal, err.Error())
+	}
+
+	if !permissions.ReadDevStatus {
+		depl.Logs = ""
+	}
+
+	var attr map[string]any
+	if claims.OwnerType() == auth.OwnerTypeUser {
+		attr, err = s.jwtAttributesForUser(ctx, claims.OwnerID(), permissions)
+		if err != nil {
+			return nil, status.Error(codes.Internal, err.Error())
+		}
+	}
+
+	jwt, err := s.issuer.NewToken(runtimeauth.TokenOptions{
+		AudienceURL: depl.RuntimeAudience,
+		Subject:     claims.OwnerID(),
+		TTL:         time.Hour,
+		InstancePermissions: map[string][]runtimeauth.Permission{
+			depl.RuntimeInstanceID: {
+				runtimeauth.ReadObjects,
+				runtimeauth.ReadMetrics,
+				runtimeauth.ReadProfiling,
+				runtimeauth.ReadRepo,
+			},
+		},
+		Attributes: attr,
+	})
+	if err != nil {
+		return nil, status.Errorf(codes.Internal, "could not issue jwt: %s", err.Error())
+	}
+
+	// Accessing the preview keeps it from being hibernated
+	s.admin.Used.Deployment(depl.ID)
+
+	return &adminv1.GetPreviewDeploymentResponse{
+		Deployment: deploymentToDTO(depl),
+		Jwt:        jwt,
+	}, nil
+}
+
 func (s *Server) UpdateProjectVariables(ctx context.Context, req *adminv1.UpdateProjectVariablesRequest) (*adminv1.UpdateProjectVariablesResponse, error) {
 	proj, err := s.admin.DB.FindProjectByName(ctx, req.OrganizationName, req.Name)
 	if err != nil {
@@ -419,11 +580,14 @@ func (s *Server) UpdateProjectVariables(ctx context.Context, req *adminv1.Update
 		return nil, status.Error(codes.PermissionDenied, "does not have permission to update project variables")
 	}
 
+	oldVariables := proj.ProdVariables
 	proj, err = s.admin.UpdateProjectVariables(ctx, proj, req.Variables)
 	if err != nil {
 		return nil, status.Errorf(codes.Internal, "variables updated failed with error %s", err.Error())
 	}
 
+	s.recordProjectAuditEvent(ctx, proj, "UpdateProjectVariables", proj.Name, auditVariablesSummary(oldVariables, proj.ProdVariables))
+
 	return &adminv1.UpdateProjectVariablesResponse{Variables: proj.ProdVariables}, nil
 }
 
@@ -576,6 +740,8 @@ func (s *Server) AddProjectMember(ctx context.Context, req *adminv1.AddProjectMe
 			return nil, status.Error(codes.Internal, err.Error())
 		}
 
+		s.recordProjectAuditEvent(ctx, proj, "AddProjectMember", req.Email, fmt.Sprintf("invited with role %q", role.Name))
+
 		// Send invitation email
 		err = s.admin.Email.SendProjectInvite(&email.ProjectInvite{
 			ToEmail:       req.Email,
@@ -599,6 +765,8 @@ func (s *Server) AddProjectMember(ctx context.Context, req *adminv1.AddProjectMe
 		return nil, status.Error(codes.InvalidArgument, err.Error())
 	}
 
+	s.recordProjectAuditEvent(ctx, proj, "AddProjectMember", req.Email, fmt.Sprintf("added with role %q", role.Name))
+
 	err = s.admin.Email.SendProjectAddition(&email.ProjectAddition{
 		ToEmail:       req.Email,
 		ToName:        "",
@@ -649,6 +817,7 @@ func (s *Server) RemoveProjectMember(ctx context.Context, req *adminv1.RemovePro
 		if err != nil {
 			return nil, status.Error(codes.Internal, err.Error())
 		}
+		s.recordProjectAuditEvent(ctx, proj, "RemoveProjectMember", req.Email, "removed invite")
 		return &adminv1.RemoveProjectMemberResponse{}, nil
 	}
 
@@ -657,6 +826,8 @@ func (s *Server) RemoveProjectMember(ctx context.Context, req *adminv1.RemovePro
 		return nil, status.Error(codes.Internal, err.Error())
 	}
 
+	s.recordProjectAuditEvent(ctx, proj, "RemoveProjectMember", req.Email, "removed")
+
 	return &adminv1.RemoveProjectMemberResponse{}, nil
 }
 
@@ -699,6 +870,7 @@ func (s *Server) SetProjectMemberRole(ctx context.Context, req *adminv1.SetProje
 		if err != nil {
 			return nil, status.Error(codes.Internal, err.Error())
 		}
+		s.recordProjectAuditEvent(ctx, proj, "SetProjectMemberRole", req.Email, fmt.Sprintf("set invite role to %q", role.Name))
 		return &adminv1.SetProjectMemberRoleResponse{}, nil
 	}
 
@@ -707,6 +879,8 @@ func (s *Server) SetProjectMemberRole(ctx context.Context, req *adminv1.SetProje
 		return nil, status.Error(codes.Internal, err.Error())
 	}
 
+	s.recordProjectAuditEvent(ctx, proj, "SetProjectMemberRole", req.Email, fmt.Sprintf("set role to %q", role.Name))
+
 	return &adminv1.SetProjectMemberRoleResponse{}, nil
 }
 
@@ -751,24 +925,25 @@ func (s *Server) projToDTO(p *database.Project, orgName string) *adminv1.Project
 	frontendURL, _ := url.JoinPath(s.opts.FrontendURL, orgName, p.Name)
 
 	return &adminv1.Project{
-		Id:               p.ID,
-		Name:             p.Name,
-		Description:      p.Description,
-		Public:           p.Public,
-		OrgId:            p.OrganizationID,
-		OrgName:          orgName,
-		Region:           p.Region,
-		ProdOlapDriver:   p.ProdOLAPDriver,
-		ProdOlapDsn:      p.ProdOLAPDSN,
-		ProdSlots:        int64(p.ProdSlots),
-		ProdBranch:       p.ProdBranch,
-		Subpath:          p.Subpath,
-		GithubUrl:        safeStr(p.GithubURL),
-		ProdDeploymentId: safeStr(p.ProdDeploymentID),
-		ProdTtlSeconds:   safeInt64(p.ProdTTLSeconds),
-		FrontendUrl:      frontendURL,
-		CreatedOn:        timestamppb.New(p.CreatedOn),
-		UpdatedOn:        timestamppb.New(p.UpdatedOn),
+		Id:                 p.ID,
+		Name:               p.Name,
+		Description:        p.Description,
+		Public:             p.Public,
+		OrgId:              p.OrganizationID,
+		OrgName:            orgName,
+		Region:             p.Region,
+		ProdOlapDriver:     p.ProdOLAPDriver,
+		ProdOlapDsn:        p.ProdOLAPDSN,
+		ProdSlots:          int64(p.ProdSlots),
+		ProdBranch:         p.ProdBranch,
+		Subpath:            p.Subpath,
+		GithubUrl:          safeStr(p.GithubURL),
+		ProdDeploymentId:   safeStr(p.ProdDeploymentID),
+		ProdTtlSeconds:     safeInt64(p.ProdTTLSeconds),
+		PreviewDeployments: p.PreviewDeployments,
+		FrontendUrl:        frontendURL,
+		CreatedOn:          timestamppb.New(p.CreatedOn),
+		UpdatedOn:          timestamppb.New(p.UpdatedOn),
 	}
 }
 
@@ -800,6 +975,8 @@ func deploymentToDTO(d *database.Deployment) *adminv1.Deployment {
 		Logs:              d.Logs,
 		CreatedOn:         timestamppb.New(d.CreatedOn),
 		UpdatedOn:         timestamppb.New(d.UpdatedOn),
+		Preview:           d.Preview,
+		PullRequestNumber: int64(safeInt(d.PullRequestNumber)),
 	}
 }
 
@@ -810,6 +987,13 @@ func safeStr(s *string) string {
 	return *s
 }
 
+func safeInt(s *int) int {
+	if s == nil {
+		return 0
+	}
+	return *s
+}
+
 func safeInt64(s *int64) int64 {
 	if s == nil {
 		return 0
@@ -0,0 +1,308 @@
+package server
+
+import (
+	"context"
+	"errors"
+	"time"
+	"unicode"
+
+	"github.com/rilldata/rill/admin"
+	"github.com/rilldata/rill/admin/database"
+	"github.com/rilldata/rill/admin/server/auth"
+	adminv1 "github.com/rilldata/rill/proto/gen/rill/admin/v1"
+	"github.com/rilldata/rill/runtime/pkg/observability"
+	"go.opentelemetry.io/otel/attribute"
+	"google.golang.org/grpc/codes"
+	"google.golang.org/grpc/status"
+	"google.golang.org/protobuf/types/known/timestamppb"
+)
+
+func (s *Server) ListReports(ctx context.Context, req *adminv1.ListReportsRequest) (*adminv1.ListReportsResponse, error) {
+	observability.AddRequestAttributes(ctx,
+		attribute.String("args.org", req.Organization),
+		attribute.String("args.project", req.Project),
+	)
+
+	proj, err := s.findProjectForScheduledResource(ctx, req.Organization, req.Project, "reports", false)
+	if err != nil {
+		return nil, err
+	}
+
+	reports, err := s.admin.DB.FindReportsForProject(ctx, proj.ID)
+	if err != nil {
+		return nil, status.Error(codes.Internal, err.Error())
+	}
+
+	dtos := make([]*adminv1.Report, len(reports))
+	for i, r := range reports {
+		dtos[i] = reportToPB(r)
+	}
+
+	return &adminv1.ListReportsResponse{Reports: dtos}, nil
+}
+
+func (s *Server) GetReport(ctx context.Context, req *adminv1.GetReportRequest) (*adminv1.GetReportResponse, error) {
+	observability.AddRequestAttributes(ctx,
+		attribute.String("args.org", req.Organization),
+		attribute.String("args.project", req.Project),
+		attribute.String("args.name", req.Name),
+	)
+
+	proj, err := s.findProjectForScheduledResource(ctx, req.Organization, req.Project, "reports", false)
+	if err != nil {
+		return nil, err
+	}
+
+	report, err := s.admin.DB.FindReportByName(ctx, proj.ID, req.Name)
+	if err != nil {
+		if errors.Is(err, database.ErrNotFound) {
+			return nil, status.Error(codes.NotFound, "report not found")
+		}
+		return nil, status.Error(codes.Internal, err.Error())
+	}
+
+	return &adminv1.GetReportResponse{Report: reportToPB(report)}, nil
+}
+
+func (s *Server) CreateReport(ctx context.Context, req *adminv1.CreateReportRequest) (*adminv1.CreateReportResponse, error) {
+	observability.AddRequestAttributes(ctx,
+		attribute.String("args.org", req.Organization),
+		attribute.String("args.project", req.Project),
+		attribute.String("args.name", req.Name),
+	)
+
+	err := validateScheduledResourceName(req.Name)
+	if err != nil {
+		return nil, err
+	}
+
+	proj, err := s.findProjectForScheduledResource(ctx, req.Organization, req.Project, "reports", true)
+	if err != nil {
+		return nil, err
+	}
+
+	nextRunOn, err := validateReportOptions(req.Options)
+	if err != nil {
+		return nil, err
+	}
+
+	ownerID, err := scheduledResourceOwnerID(ctx, "reports")
+	if err != nil {
+		return nil, err
+	}
+
+	report, err := s.admin.DB.InsertReport(ctx, &database.InsertReportOptions{
+		ProjectID:       proj.ID,
+		Name:            req.Name,
+		Schedule:        req.Options.Schedule,
+		TimeZone:        reportTimeZone(req.Options),
+		Recipients:      req.Options.Recipients,
+		MetricsViewName: req.Options.MetricsViewName,
+		QueryArgsJSON:   req.Options.QueryArgsJson,
+		TimeRange:       req.Options.TimeRange,
+		ExportFormat:    database.ReportExportFormat(req.Options.ExportFormat),
+		ExportLimit:     int(req.Options.ExportLimit),
+		OwnerUserID:     ownerID,
+		NextRunOn:       nextRunOn,
+	})
+	if err != nil {
+		return nil, status.Error(codes.InvalidArgument, err.Error())
+	}
+
+	s.recordProjectAuditEvent(ctx, proj, "CreateReport", report.Name, "")
+
+	return &adminv1.CreateReportResponse{Report: reportToPB(report)}, nil
+}
+
+func (s *Server) EditReport(ctx context.Context, req *adminv1.EditReportRequest) (*adminv1.EditReportResponse, error) {
+	observability.AddRequestAttributes(ctx,
+		attribute.String("args.org", req.Organization),
+		attribute.String("args.project", req.Project),
+		attribute.String("args.name", req.Name),
+	)
+
+	proj, err := s.findProjectForScheduledResource(ctx, req.Organization, req.Project, "reports", true)
+	if err != nil {
+		return nil, err
+	}
+
+	report, err := s.admin.DB.FindReportByName(ctx, proj.ID, req.Name)
+	if err != nil {
+		if errors.Is(err, database.ErrNotFound) {
+			return nil, status.Error(codes.NotFound, "report not found")
+		}
+		return nil, status.Error(codes.Internal, err.Error())
+	}
+
+	nextRunOn, err := validateReportOptions(req.Options)
+	if err != nil {
+		return nil, err
+	}
+
+	// The editor becomes the owner, so the report runs with the access of the user who last configured it
+	ownerID, err := scheduledResourceOwnerID(ctx, "reports")
+	if err != nil {
+		return nil, err
+	}
+
+	report, err = s.admin.DB.UpdateReport(ctx, report.ID, &database.UpdateReportOptions{
+		Schedule:        req.Options.Schedule,
+		TimeZone:        reportTimeZone(req.Options),
+		Recipients:      req.Options.Recipients,
+		MetricsViewName: req.Options.MetricsViewName,
+		QueryArgsJSON:   req.Options.QueryArgsJson,
+		TimeRange:       req.Options.TimeRange,
+		ExportFormat:    database.ReportExportFormat(req.Options.ExportFormat),
+		ExportLimit:     int(req.Options.ExportLimit),
+		OwnerUserID:     ownerID,
+		NextRunOn:       nextRunOn,
+	})
+	if err != nil {
+		return nil, status.Error(codes.InvalidArgument, err.Error())
+	}
+
+	s.recordProjectAuditEvent(ctx, proj, "EditReport", report.Name, "")
+
+	return &adminv1.EditReportResponse{Report: reportToPB(report)}, nil
+}
+
+func (s *Server) DeleteReport(ctx context.Context, req *adminv1.DeleteReportRequest) (*adminv1.DeleteReportResponse, error) {
+	observability.AddRequestAttributes(ctx,
+		attribute.String("args.org", req.Organization),
+		attribute.String("args.project", req.Project),
+		attribute.String("args.name", req.Name),
+	)
+
+	proj, err := s.findProjectForScheduledResource(ctx, req.Organization, req.Project, "reports", true)
+	if err != nil {
+		return nil, err
+	}
+
+	report, err := s.admin.DB.FindReportByName(ctx, proj.ID, req.Name)
+	if err != nil {
+		if errors.Is(err, database.ErrNotFound) {
+			return nil, status.Error(codes.NotFound, "report not found")
+		}
+		return nil, status.Error(codes.Internal, err.Error())
+	}
+
+	err = s.admin.DB.DeleteReport(ctx, report.ID)
+	if err != nil {
+		return nil, status.Error(codes.Internal, err.Error())
+	}
+
+	s.recordProjectAuditEvent(ctx, proj, "DeleteReport", report.Name, "")
+
+	return &adminv1.DeleteReportResponse{}, nil
+}
+
+// findProjectForScheduledResource finds a project and checks that the caller can read (or, if manage is true, manage) its reports or alerts.
+// Since reports and alerts send project data to arbitrary recipients, only project admins can manage them.
+// The resource argument is the plural name of the resource used in error messages.
+func (s *Server) findProjectForScheduledResource(ctx context.Context, orgName, projectName, resource string, manage bool) (*database.Project, error) {
+	proj, err := s.admin.DB.FindProjectByName(ctx, orgName, projectName)
+	if err != nil {
+		if errors.Is(err, database.ErrNotFound) {
+			return nil, status.Error(codes.NotFound, "project not found")
+		}
+		return nil, status.Error(codes.InvalidArgument, err.Error())
+	}
+
+	claims := auth.GetClaims(ctx)
+	if claims.Superuser(ctx) {
+		return proj, nil
+	}
+
+	permissions := claims.ProjectPermissions(ctx, proj.OrganizationID, proj.ID)
+	if manage && !permissions.ManageProject {
+		return nil, status.Errorf(codes.PermissionDenied, "does not have permission to manage %s", resource)
+	}
+	if !permissions.ReadProject {
+		return nil, status.Errorf(codes.PermissionDenied, "does not have permission to read %s", resource)
+	}
+
+	return proj, nil
+}
+
+// scheduledResourceOwnerID returns the ID of the user creating or editing a report or alert, who becomes its owner.
+// Reports and alerts run with the access of their owner, so they can only be managed by users.
+// The resource argument is the plural name of the resource used in error messages.
+func scheduledResourceOwnerID(ctx context.Context, resource string) (*string, error) {
+	claims := auth.GetClaims(ctx)
+	if claims.OwnerType() != auth.OwnerTypeUser {
+		return nil, status.Errorf(codes.PermissionDenied, "only users can manage %s", resource)
+	}
+	id := claims.OwnerID()
+	return &id, nil
+}
+
+// validateScheduledResourceName checks that the name of a report or alert doesn't contain control characters.
+// The name is used in the subject of notification emails, so it must not be able to inject email headers.
+func validateScheduledResourceName(name string) error {
+	for _, r := range name {
+		if unicode.IsControl(r) {
+			return status.Error(codes.InvalidArgument, "name must not contain control characters")
+		}
+	}
+	return nil
+}
+
+// validateReportOptions validates the schedule and query of a report and returns the time of its next run.
+func validateReportOptions(opts *adminv1.ReportOptions) (time.Time, error) {
+	if opts.ExportFormat == adminv1.ExportFormat_EXPORT_FORMAT_UNSPECIFIED {
+		return time.Time{}, status.Error(codes.InvalidArgument, "export format is required")
+	}
+
+	nextRunOn, err := admin.NextReportRunTime(opts.Schedule, opts.TimeZone, time.Now())
+	if err != nil {
+		return time.Time{}, status.Error(codes.InvalidArgument, err.Error())
+	}
+
+	err = admin.ValidateReportQuery(opts.QueryArgsJson, opts.TimeRange)
+	if err != nil {
+		return time.Time{}, status.Error(codes.InvalidArgument, err.Error())
+	}
+
+	return nextRunOn, nil
+}
+
+func reportTimeZone(opts *adminv1.ReportOptions) string {
+	if opts.TimeZone == "" {
+		return "UTC"
+	}
+	return opts.TimeZone
+}
+
+func reportToPB(r *database.Report) *adminv1.Report {
+	var ownerUserID string
+	if r.OwnerUserID != nil {
+		ownerUserID = *r.OwnerUserID
+	}
+
+	var lastRunOn *timestamppb.Timestamp
+	if r.LastRunOn != nil {
+		lastRunOn = timestamppb.New(*r.LastRunOn)
+	}
+
+	return &adminv1.Report{
+		Id:        r.ID,
+		ProjectId: r.ProjectID,
+		Name:      r.Name,
+		Options: &adminv1.ReportOptions{
+			Schedule:        r.Schedule,
+			TimeZone:        r.TimeZone,
+			Recipients:      r.Recipients,
+			MetricsViewName: r.MetricsViewName,
+			QueryArgsJson:   r.QueryArgsJSON,
+			TimeRange:       r.TimeRange,
+			ExportFormat:    adminv1.ExportFormat(r.ExportFormat),
+			ExportLimit:     int64(r.ExportLimit),
+		},
+		OwnerUserId:  ownerUserID,
+		NextRunOn:    timestamppb.New(r.NextRunOn),
+		LastRunOn:    lastRunOn,
+		LastRunError: r.LastRunError,
+		CreatedOn:    timestamppb.New(r.CreatedOn),
+		UpdatedOn:    timestamppb.New(r.UpdatedOn),
+	}
+}
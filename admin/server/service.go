@@ -2,6 +2,7 @@ package server
 
 import (
 	"context"
+	"fmt"
 	"time"
 
 	"github.com/rilldata/rill/admin/database"
@@ -39,6 +40,8 @@ func (s *Server) CreateService(ctx context.Context, req *adminv1.CreateServiceRe
 		return nil, err
 	}
 
+	s.recordOrgAuditEvent(ctx, org.ID, "CreateService", service.Name, "")
+
 	return &adminv1.CreateServiceResponse{
 		Service: serviceToPB(service, req.OrganizationName),
 	}, nil
@@ -105,6 +108,10 @@ func (s *Server) UpdateService(ctx context.Context, req *adminv1.UpdateServiceRe
 		return nil, status.Error(codes.Internal, err.Error())
 	}
 
+	var diff auditDiff
+	diff.add("name", service.Name, updatedService.Name)
+	s.recordOrgAuditEvent(ctx, org.ID, "UpdateService", service.Name, diff.String())
+
 	return &adminv1.UpdateServiceResponse{
 		Service: serviceToPB(updatedService, req.OrganizationName),
 	}, nil
@@ -137,6 +144,8 @@ func (s *Server) DeleteService(ctx context.Context, req *adminv1.DeleteServiceRe
 		return nil, status.Error(codes.Internal, err.Error())
 	}
 
+	s.recordOrgAuditEvent(ctx, org.ID, "DeleteService", service.Name, "")
+
 	return &adminv1.DeleteServiceResponse{}, nil
 }
 
@@ -208,6 +217,8 @@ func (s *Server) IssueServiceAuthToken(ctx context.Context, req *adminv1.IssueSe
 		return nil, status.Error(codes.Internal, err.Error())
 	}
 
+	s.recordOrgAuditEvent(ctx, org.ID, "IssueServiceAuthToken", service.Name, fmt.Sprintf("issued token %s", token.Token().ID))
+
 	return &adminv1.IssueServiceAuthTokenResponse{
 		Token: token.Token().String(),
 	}, nil
@@ -244,6 +255,8 @@ func (s *Server) RevokeServiceAuthToken(ctx context.Context, req *adminv1.Revoke
 		return nil, status.Error(codes.Internal, err.Error())
 	}
 
+	s.recordOrgAuditEvent(ctx, org.ID, "RevokeServiceAuthToken", service.Name, fmt.Sprintf("revoked token %s", token.ID))
+
 	return &adminv1.RevokeServiceAuthTokenResponse{}, nil
 }
 
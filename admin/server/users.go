@@ -61,6 +61,12 @@ func (s *Server) SetSuperuser(ctx context.Context, req *adminv1.SetSuperuserRequ
 		return nil, status.Error(codes.Internal, err.Error())
 	}
 
+	if req.Superuser {
+		s.recordUserAuditEvent(ctx, user, "SetSuperuser", "granted superuser")
+	} else {
+		s.recordUserAuditEvent(ctx, user, "SetSuperuser", "revoked superuser")
+	}
+
 	return &adminv1.SetSuperuserResponse{}, nil
 }
 
@@ -195,6 +201,8 @@ func (s *Server) IssueRepresentativeAuthToken(ctx context.Context, req *adminv1.
 		return nil, err
 	}
 
+	s.recordUserAuditEvent(ctx, u, "IssueRepresentativeAuthToken", fmt.Sprintf("issued a token representing the user for %s", ttl))
+
 	return &adminv1.IssueRepresentativeAuthTokenResponse{
 		Token: token.Token().String(),
 	}, nil
@@ -314,6 +322,10 @@ func (s *Server) SudoUpdateUserQuotas(ctx context.Context, req *adminv1.SudoUpda
 		return nil, err
 	}
 
+	var diff auditDiff
+	diff.add("singleuser_orgs", user.QuotaSingleuserOrgs, updatedUser.QuotaSingleuserOrgs)
+	s.recordUserAuditEvent(ctx, updatedUser, "SudoUpdateUserQuotas", diff.String())
+
 	return &adminv1.SudoUpdateUserQuotasResponse{User: userToPB(updatedUser)}, nil
 }
 
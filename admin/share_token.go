@@ -0,0 +1,57 @@
+package admin
+
+import (
+	"bytes"
+	"context"
+	"fmt"
+	"time"
+
+	"github.com/rilldata/rill/admin/database"
+	"github.com/rilldata/rill/admin/pkg/authtoken"
+)
+
+// IssueBookmarkShareToken creates a token that grants public, read-only access to a bookmark's dashboard state until it expires.
+// It returns the token's string representation, which is not persisted and can't be recovered later.
+func (s *Service) IssueBookmarkShareToken(ctx context.Context, bookmarkID string, createdByUserID *string, ttl time.Duration) (string, *database.BookmarkShareToken, error) {
+	tkn := authtoken.NewRandom(authtoken.TypeShare)
+
+	model, err := s.DB.InsertBookmarkShareToken(ctx, &database.InsertBookmarkShareTokenOptions{
+		ID:              tkn.ID.String(),
+		SecretHash:      tkn.SecretHash(),
+		BookmarkID:      bookmarkID,
+		CreatedByUserID: createdByUserID,
+		ExpiresOn:       time.Now().Add(ttl),
+	})
+	if err != nil {
+		return "", nil, err
+	}
+
+	return tkn.String(), model, nil
+}
+
+// ValidateBookmarkShareToken validates a bookmark share token against persistent storage.
+func (s *Service) ValidateBookmarkShareToken(ctx context.Context, token string) (*database.BookmarkShareToken, error) {
+	parsed, err := authtoken.FromString(token)
+	if err != nil {
+		return nil, err
+	}
+
+	if parsed.Type != authtoken.TypeShare {
+		return nil, fmt.Errorf("not a share token")
+	}
+
+	model, err := s.DB.FindBookmarkShareToken(ctx, parsed.ID.String())
+	if err != nil {
+		return nil, err
+	}
+
+	if model.ExpiresOn.Before(time.Now()) {
+		return nil, fmt.Errorf("share token is expired")
+	}
+
+	if !bytes.Equal(model.SecretHash, parsed.SecretHash()) {
+		return nil, fmt.Errorf("invalid share token")
+	}
+
+	return model, nil
+}
@@ -0,0 +1,11 @@
+package worker
+
+import (
+	"context"
+	"time"
+)
+
+func (w *Worker) deleteExpiredBookmarkShareTokens(ctx context.Context) error {
+	// Delete bookmark share tokens that have been expired for more than 24 hours.
+	return w.admin.DB.DeleteExpiredBookmarkShareTokens(ctx, 24*time.Hour)
+}
@@ -0,0 +1,29 @@
+package worker
+
+import (
+	"context"
+	"time"
+
+	"github.com/rilldata/rill/runtime/pkg/observability"
+	"go.uber.org/zap"
+)
+
+func (w *Worker) runAlerts(ctx context.Context) error {
+	alerts, err := w.admin.DB.FindAlertsDue(ctx, time.Now())
+	if err != nil {
+		return err
+	}
+
+	for _, alert := range alerts {
+		// A failed alert should not prevent other alerts from being evaluated (and should not stop the worker)
+		err := w.admin.RunAlert(ctx, alert)
+		if err != nil {
+			if ctx.Err() != nil {
+				return nil
+			}
+			w.logger.Warn("failed to run alert", zap.String("project_id", alert.ProjectID), zap.String("alert", alert.Name), zap.Error(err), observability.ZapCtx(ctx))
+		}
+	}
+
+	return nil
+}
@@ -0,0 +1,29 @@
+package worker
+
+import (
+	"context"
+	"time"
+
+	"github.com/rilldata/rill/runtime/pkg/observability"
+	"go.uber.org/zap"
+)
+
+func (w *Worker) runReports(ctx context.Context) error {
+	reports, err := w.admin.DB.FindReportsDue(ctx, time.Now())
+	if err != nil {
+		return err
+	}
+
+	for _, report := range reports {
+		// A failed report should not prevent other reports from running (and should not stop the worker)
+		err := w.admin.RunReport(ctx, report)
+		if err != nil {
+			if ctx.Err() != nil {
+				return nil
+			}
+			w.logger.Warn("failed to run report", zap.String("project_id", report.ProjectID), zap.String("report", report.Name), zap.Error(err), observability.ZapCtx(ctx))
+		}
+	}
+
+	return nil
+}
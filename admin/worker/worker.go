@@ -42,11 +42,22 @@ func (w *Worker) Run(ctx context.Context) error {
 	group.Go(func() error {
 		return w.schedule(ctx, "delete_expired_device_auth_codes", w.deleteExpiredDeviceAuthCodes, 6*time.Hour)
 	})
+	group.Go(func() error {
+		return w.schedule(ctx, "delete_expired_share_tokens", w.deleteExpiredBookmarkShareTokens, 6*time.Hour)
+	})
 
 	group.Go(func() error {
 		return w.schedule(ctx, "hibernate_expired_deployments", w.hibernateExpiredDeployments, 15*time.Minute)
 	})
 
+	group.Go(func() error {
+		return w.schedule(ctx, "run_reports", w.runReports, time.Minute)
+	})
+
+	group.Go(func() error {
+		return w.schedule(ctx, "run_alerts", w.runAlerts, time.Minute)
+	})
+
 	// NOTE: Add new scheduled jobs here
 
 	w.logger.Info("worker started")
@@ -60,6 +71,10 @@ func (w *Worker) RunJob(ctx context.Context, name string) error {
 		return w.runJob(ctx, name, w.checkSlots)
 	case "reset_all_deployments":
 		return w.runJob(ctx, name, w.resetAllDeployments)
+	case "run_reports":
+		return w.runJob(ctx, name, w.runReports)
+	case "run_alerts":
+		return w.runJob(ctx, name, w.runAlerts)
 	// NOTE: Add new ad-hoc jobs here
 	default:
 		return fmt.Errorf("unknown job: %s", name)
@@ -0,0 +1,184 @@
+package alert
+
+import (
+	"fmt"
+	"strconv"
+	"strings"
+	"time"
+
+	"github.com/rilldata/rill/cli/pkg/cmdutil"
+	"github.com/rilldata/rill/cli/pkg/config"
+	adminv1 "github.com/rilldata/rill/proto/gen/rill/admin/v1"
+	"github.com/spf13/cobra"
+)
+
+func AlertCmd(cfg *config.Config) *cobra.Command {
+	alertCmd := &cobra.Command{
+		Use:               "alert",
+		Short:             "Manage threshold alerts on metrics views",
+		PersistentPreRunE: cmdutil.CheckChain(cmdutil.CheckAuth(cfg), cmdutil.CheckOrganization(cfg)),
+	}
+
+	alertCmd.PersistentFlags().StringVar(&cfg.Org, "org", cfg.Org, "Organization Name")
+	alertCmd.AddCommand(ListCmd(cfg))
+	alertCmd.AddCommand(ShowCmd(cfg))
+	alertCmd.AddCommand(CreateCmd(cfg))
+	alertCmd.AddCommand(EditCmd(cfg))
+	alertCmd.AddCommand(DeleteCmd(cfg))
+
+	return alertCmd
+}
+
+var comparisons = []string{"gt", "gte", "lt", "lte"}
+
+func parseComparison(s string) (adminv1.AlertComparison, error) {
+	switch strings.ToLower(s) {
+	case "gt", ">":
+		return adminv1.AlertComparison_ALERT_COMPARISON_GT, nil
+	case "gte", ">=":
+		return adminv1.AlertComparison_ALERT_COMPARISON_GTE, nil
+	case "lt", "<":
+		return adminv1.AlertComparison_ALERT_COMPARISON_LT, nil
+	case "lte", "<=":
+		return adminv1.AlertComparison_ALERT_COMPARISON_LTE, nil
+	default:
+		return adminv1.AlertComparison_ALERT_COMPARISON_UNSPECIFIED, fmt.Errorf("invalid comparison %q (options: %s)", s, strings.Join(comparisons, ", "))
+	}
+}
+
+func comparisonToString(c adminv1.AlertComparison) string {
+	switch c {
+	case adminv1.AlertComparison_ALERT_COMPARISON_GT:
+		return ">"
+	case adminv1.AlertComparison_ALERT_COMPARISON_GTE:
+		return ">="
+	case adminv1.AlertComparison_ALERT_COMPARISON_LT:
+		return "<"
+	case adminv1.AlertComparison_ALERT_COMPARISON_LTE:
+		return "<="
+	default:
+		return ""
+	}
+}
+
+// alertFlags are the flags for the user-configurable options of an alert.
+type alertFlags struct {
+	schedule      string
+	timeZone      string
+	metricsView   string
+	measure       string
+	comparison    string
+	threshold     float64
+	dimension     string
+	where         string
+	timeRange     string
+	recipients    []string
+	webhook       string
+	renotifyAfter time.Duration
+}
+
+func (f *alertFlags) register(cmd *cobra.Command) {
+	cmd.Flags().StringVar(&f.schedule, "schedule", "", `Cron schedule for evaluating the alert (e.g. "*/15 * * * *" for every 15 minutes)`)
+	cmd.Flags().StringVar(&f.timeZone, "time-zone", "", "IANA time zone for the schedule (default UTC)")
+	cmd.Flags().StringVar(&f.metricsView, "metrics-view", "", "Name of the metrics view to query")
+	cmd.Flags().StringVar(&f.measure, "measure", "", "Name of the measure to compare to the threshold")
+	cmd.Flags().StringVar(&f.comparison, "comparison", "gt", fmt.Sprintf("How to compare the measure to the threshold [%s]", strings.Join(comparisons, ", ")))
+	cmd.Flags().Float64Var(&f.threshold, "threshold", 0, "Threshold that triggers the alert")
+	cmd.Flags().StringVar(&f.dimension, "dimension", "", "Dimension to evaluate the measure for separately (default is the measure's total)")
+	cmd.Flags().StringVar(&f.where, "where", "", "JSON-encoded filter expression to apply before evaluating the measure")
+	cmd.Flags().StringVar(&f.timeRange, "time-range", "", `ISO 8601 duration of data to evaluate, ending when the alert runs (e.g. "PT1H")`)
+	cmd.Flags().StringSliceVar(&f.recipients, "recipient", nil, "Email address to notify (can be repeated)")
+	cmd.Flags().StringVar(&f.webhook, "webhook", "", "URL to POST a JSON payload to when the alert is triggered")
+	cmd.Flags().DurationVar(&f.renotifyAfter, "renotify-after", 0, "Notify again about an ongoing breach after this duration (default is to notify once)")
+}
+
+// apply sets the options for the flags that were explicitly passed to the command.
+func (f *alertFlags) apply(cmd *cobra.Command, opts *adminv1.AlertOptions) error {
+	if cmd.Flags().Changed("schedule") {
+		opts.Schedule = f.schedule
+	}
+	if cmd.Flags().Changed("time-zone") {
+		opts.TimeZone = f.timeZone
+	}
+	if cmd.Flags().Changed("metrics-view") {
+		opts.MetricsViewName = f.metricsView
+	}
+	if cmd.Flags().Changed("measure") {
+		opts.MeasureName = f.measure
+	}
+	if cmd.Flags().Changed("comparison") || opts.Comparison == adminv1.AlertComparison_ALERT_COMPARISON_UNSPECIFIED {
+		comparison, err := parseComparison(f.comparison)
+		if err != nil {
+			return err
+		}
+		opts.Comparison = comparison
+	}
+	if cmd.Flags().Changed("threshold") {
+		opts.Threshold = f.threshold
+	}
+	if cmd.Flags().Changed("dimension") {
+		opts.SplitByDimension = f.dimension
+	}
+	if cmd.Flags().Changed("where") {
+		opts.WhereJson = f.where
+	}
+	if cmd.Flags().Changed("time-range") {
+		opts.TimeRange = f.timeRange
+	}
+	if cmd.Flags().Changed("recipient") {
+		opts.Recipients = f.recipients
+	}
+	if cmd.Flags().Changed("webhook") {
+		opts.WebhookUrl = f.webhook
+	}
+	if cmd.Flags().Changed("renotify-after") {
+		opts.RenotifyAfterSeconds = int64(f.renotifyAfter.Seconds())
+	}
+	return nil
+}
+
+func toTable(alerts []*adminv1.Alert) []*alert {
+	res := make([]*alert, 0, len(alerts))
+
+	for _, a := range alerts {
+		res = append(res, toRow(a))
+	}
+
+	return res
+}
+
+func toRow(a *adminv1.Alert) *alert {
+	var lastRun string
+	if a.LastRunOn != nil {
+		lastRun = a.LastRunOn.AsTime().Format(cmdutil.TSFormatLayout)
+	}
+
+	condition := fmt.Sprintf("%s %s %s", a.Options.MeasureName, comparisonToString(a.Options.Comparison), strconv.FormatFloat(a.Options.Threshold, 'g', -1, 64))
+	if a.Options.SplitByDimension != "" {
+		condition += fmt.Sprintf(" by %s", a.Options.SplitByDimension)
+	}
+
+	return &alert{
+		Name:        a.Name,
+		Schedule:    fmt.Sprintf("%s (%s)", a.Options.Schedule, a.Options.TimeZone),
+		MetricsView: a.Options.MetricsViewName,
+		Condition:   condition,
+		Recipients:  strings.Join(a.Options.Recipients, ", "),
+		Webhook:     a.Options.WebhookUrl,
+		NextRun:     a.NextRunOn.AsTime().Format(cmdutil.TSFormatLayout),
+		LastRun:     lastRun,
+		LastError:   a.LastRunError,
+	}
+}
+
+type alert struct {
+	Name        string `header:"name" json:"name"`
+	Schedule    string `header:"schedule" json:"schedule"`
+	MetricsView string `header:"metrics view" json:"metrics_view"`
+	Condition   string `header:"condition" json:"condition"`
+	Recipients  string `header:"recipients" json:"recipients"`
+	Webhook     string `header:"webhook" json:"webhook"`
+	NextRun     string `header:"next run" json:"next_run"`
+	LastRun     string `header:"last run" json:"last_run"`
+	LastError   string `header:"last error" json:"last_error"`
+}
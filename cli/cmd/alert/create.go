@@ -0,0 +1,58 @@
+package alert
+
+import (
+	"fmt"
+
+	"github.com/rilldata/rill/cli/pkg/cmdutil"
+	"github.com/rilldata/rill/cli/pkg/config"
+	adminv1 "github.com/rilldata/rill/proto/gen/rill/admin/v1"
+	"github.com/spf13/cobra"
+)
+
+func CreateCmd(cfg *config.Config) *cobra.Command {
+	var projectName string
+	flags := &alertFlags{}
+
+	createCmd := &cobra.Command{
+		Use:   "create <alert-name>",
+		Args:  cobra.ExactArgs(1),
+		Short: "Create an alert that notifies when a metrics view measure crosses a threshold",
+		RunE: func(cmd *cobra.Command, args []string) error {
+			client, err := cmdutil.Client(cfg)
+			if err != nil {
+				return err
+			}
+			defer client.Close()
+
+			opts := &adminv1.AlertOptions{}
+			err = flags.apply(cmd, opts)
+			if err != nil {
+				return err
+			}
+
+			res, err := client.CreateAlert(cmd.Context(), &adminv1.CreateAlertRequest{
+				Organization: cfg.Org,
+				Project:      projectName,
+				Name:         args[0],
+				Options:      opts,
+			})
+			if err != nil {
+				return err
+			}
+
+			cmdutil.PrintlnSuccess(fmt.Sprintf("Created alert %q. It will next be evaluated at %s.", res.Alert.Name, res.Alert.NextRunOn.AsTime().Format(cmdutil.TSFormatLayout)))
+
+			return nil
+		},
+	}
+
+	createCmd.Flags().StringVar(&projectName, "project", "", "Project Name")
+	flags.register(createCmd)
+	_ = createCmd.MarkFlagRequired("project")
+	_ = createCmd.MarkFlagRequired("schedule")
+	_ = createCmd.MarkFlagRequired("metrics-view")
+	_ = createCmd.MarkFlagRequired("measure")
+	_ = createCmd.MarkFlagRequired("threshold")
+
+	return createCmd
+}
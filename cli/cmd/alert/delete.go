@@ -0,0 +1,45 @@
+package alert
+
+import (
+	"fmt"
+
+	"github.com/rilldata/rill/cli/pkg/cmdutil"
+	"github.com/rilldata/rill/cli/pkg/config"
+	adminv1 "github.com/rilldata/rill/proto/gen/rill/admin/v1"
+	"github.com/spf13/cobra"
+)
+
+func DeleteCmd(cfg *config.Config) *cobra.Command {
+	var projectName string
+
+	deleteCmd := &cobra.Command{
+		Use:   "delete <alert-name>",
+		Args:  cobra.ExactArgs(1),
+		Short: "Delete alert",
+		RunE: func(cmd *cobra.Command, args []string) error {
+			client, err := cmdutil.Client(cfg)
+			if err != nil {
+				return err
+			}
+			defer client.Close()
+
+			_, err = client.DeleteAlert(cmd.Context(), &adminv1.DeleteAlertRequest{
+				Organization: cfg.Org,
+				Project:      projectName,
+				Name:         args[0],
+			})
+			if err != nil {
+				return err
+			}
+
+			cmdutil.PrintlnSuccess(fmt.Sprintf("Deleted alert: %s", args[0]))
+
+			return nil
+		},
+	}
+
+	deleteCmd.Flags().StringVar(&projectName, "project", "", "Project Name")
+	_ = deleteCmd.MarkFlagRequired("project")
+
+	return deleteCmd
+}
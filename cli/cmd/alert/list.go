@@ -0,0 +1,46 @@
+package alert
+
+import (
+	"github.com/rilldata/rill/cli/pkg/cmdutil"
+	"github.com/rilldata/rill/cli/pkg/config"
+	adminv1 "github.com/rilldata/rill/proto/gen/rill/admin/v1"
+	"github.com/spf13/cobra"
+)
+
+func ListCmd(cfg *config.Config) *cobra.Command {
+	var projectName string
+
+	listCmd := &cobra.Command{
+		Use:   "list",
+		Short: "List alerts",
+		RunE: func(cmd *cobra.Command, args []string) error {
+			client, err := cmdutil.Client(cfg)
+			if err != nil {
+				return err
+			}
+			defer client.Close()
+
+			res, err := client.ListAlerts(cmd.Context(), &adminv1.ListAlertsRequest{
+				Organization: cfg.Org,
+				Project:      projectName,
+			})
+			if err != nil {
+				return err
+			}
+
+			if len(res.Alerts) == 0 {
+				cmdutil.PrintlnWarn("No alerts found")
+				return nil
+			}
+
+			cmdutil.TablePrinter(toTable(res.Alerts))
+
+			return nil
+		},
+	}
+
+	listCmd.Flags().StringVar(&projectName, "project", "", "Project Name")
+	_ = listCmd.MarkFlagRequired("project")
+
+	return listCmd
+}
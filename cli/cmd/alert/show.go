@@ -0,0 +1,54 @@
+package alert
+
+import (
+	"fmt"
+
+	"github.com/rilldata/rill/cli/pkg/cmdutil"
+	"github.com/rilldata/rill/cli/pkg/config"
+	adminv1 "github.com/rilldata/rill/proto/gen/rill/admin/v1"
+	"github.com/spf13/cobra"
+)
+
+func ShowCmd(cfg *config.Config) *cobra.Command {
+	var projectName string
+
+	showCmd := &cobra.Command{
+		Use:   "show <alert-name>",
+		Args:  cobra.ExactArgs(1),
+		Short: "Show alert",
+		RunE: func(cmd *cobra.Command, args []string) error {
+			client, err := cmdutil.Client(cfg)
+			if err != nil {
+				return err
+			}
+			defer client.Close()
+
+			res, err := client.GetAlert(cmd.Context(), &adminv1.GetAlertRequest{
+				Organization: cfg.Org,
+				Project:      projectName,
+				Name:         args[0],
+			})
+			if err != nil {
+				return err
+			}
+
+			cmdutil.TablePrinter(toRow(res.Alert))
+			if res.Alert.Options.WhereJson != "" {
+				fmt.Printf("\nFilter: %s\n", res.Alert.Options.WhereJson)
+			}
+			if res.Alert.Options.TimeRange != "" {
+				fmt.Printf("Time range: %s\n", res.Alert.Options.TimeRange)
+			}
+			if res.Alert.Options.RenotifyAfterSeconds != 0 {
+				fmt.Printf("Renotify after: %ds\n", res.Alert.Options.RenotifyAfterSeconds)
+			}
+
+			return nil
+		},
+	}
+
+	showCmd.Flags().StringVar(&projectName, "project", "", "Project Name")
+	_ = showCmd.MarkFlagRequired("project")
+
+	return showCmd
+}
@@ -0,0 +1,95 @@
+package org
+
+import (
+	"fmt"
+	"time"
+
+	"github.com/rilldata/rill/cli/pkg/cmdutil"
+	"github.com/rilldata/rill/cli/pkg/config"
+	adminv1 "github.com/rilldata/rill/proto/gen/rill/admin/v1"
+	"github.com/spf13/cobra"
+)
+
+func AuditCmd(cfg *config.Config) *cobra.Command {
+	var orgName, pageToken string
+	var pageSize uint32
+
+	auditCmd := &cobra.Command{
+		Use:   "audit [<org-name>]",
+		Args:  cobra.MaximumNArgs(1),
+		Short: "Show the audit log of an organization and its projects",
+		RunE: func(cmd *cobra.Command, args []string) error {
+			client, err := cmdutil.Client(cfg)
+			if err != nil {
+				return err
+			}
+			defer client.Close()
+
+			if len(args) > 0 {
+				orgName = args[0]
+			}
+			if orgName == "" {
+				return fmt.Errorf("an org must be specified, or set as default with `rill org switch`")
+			}
+
+			res, err := client.ListAuditEvents(cmd.Context(), &adminv1.ListAuditEventsRequest{
+				Organization: orgName,
+				PageSize:     pageSize,
+				PageToken:    pageToken,
+			})
+			if err != nil {
+				return err
+			}
+
+			if len(res.Events) == 0 {
+				cmdutil.PrintlnWarn("No audit events found")
+				return nil
+			}
+
+			cmdutil.PrintlnSuccess("Audit log")
+			cmdutil.TablePrinter(toAuditTable(res.Events))
+			if res.NextPageToken != "" {
+				cmd.Println()
+				cmd.Printf("Next page token: %s\n", res.NextPageToken)
+			}
+			return nil
+		},
+	}
+
+	auditCmd.Flags().StringVar(&orgName, "org", cfg.Org, "Organization Name")
+	auditCmd.Flags().Uint32Var(&pageSize, "page-size", 50, "Number of events to return per page")
+	auditCmd.Flags().StringVar(&pageToken, "page-token", "", "Pagination token")
+
+	return auditCmd
+}
+
+func toAuditTable(events []*adminv1.AuditEvent) []*auditEvent {
+	rows := make([]*auditEvent, 0, len(events))
+
+	for _, e := range events {
+		actor := e.ActorName
+		if actor == "" {
+			actor = e.ActorType
+		}
+
+		rows = append(rows, &auditEvent{
+			Time:    e.CreatedOn.AsTime().Local().Format(time.RFC3339),
+			Actor:   actor,
+			Project: e.ProjectName,
+			Action:  e.Action,
+			Target:  e.Target,
+			Summary: e.Summary,
+		})
+	}
+
+	return rows
+}
+
+type auditEvent struct {
+	Time    string `header:"time" json:"time"`
+	Actor   string `header:"actor" json:"actor"`
+	Project string `header:"project" json:"project"`
+	Action  string `header:"action" json:"action"`
+	Target  string `header:"target" json:"target"`
+	Summary string `header:"summary" json:"summary"`
+}
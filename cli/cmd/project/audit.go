@@ -0,0 +1,98 @@
+package project
+
+import (
+	"time"
+
+	"github.com/rilldata/rill/cli/pkg/cmdutil"
+	"github.com/rilldata/rill/cli/pkg/config"
+	adminv1 "github.com/rilldata/rill/proto/gen/rill/admin/v1"
+	"github.com/spf13/cobra"
+)
+
+func AuditCmd(cfg *config.Config) *cobra.Command {
+	var name, path, pageToken string
+	var pageSize uint32
+
+	auditCmd := &cobra.Command{
+		Use:   "audit [<project-name>]",
+		Args:  cobra.MaximumNArgs(1),
+		Short: "Show the audit log of a project",
+		RunE: func(cmd *cobra.Command, args []string) error {
+			client, err := cmdutil.Client(cfg)
+			if err != nil {
+				return err
+			}
+			defer client.Close()
+
+			if len(args) > 0 {
+				name = args[0]
+			}
+
+			if !cmd.Flags().Changed("project") && len(args) == 0 && cfg.Interactive {
+				name, err = inferProjectName(cmd.Context(), client, cfg.Org, path)
+				if err != nil {
+					return err
+				}
+			}
+
+			res, err := client.ListAuditEvents(cmd.Context(), &adminv1.ListAuditEventsRequest{
+				Organization: cfg.Org,
+				Project:      name,
+				PageSize:     pageSize,
+				PageToken:    pageToken,
+			})
+			if err != nil {
+				return err
+			}
+
+			if len(res.Events) == 0 {
+				cmdutil.PrintlnWarn("No audit events found")
+				return nil
+			}
+
+			cmdutil.PrintlnSuccess("Audit log")
+			cmdutil.TablePrinter(toAuditTable(res.Events))
+			if res.NextPageToken != "" {
+				cmd.Println()
+				cmd.Printf("Next page token: %s\n", res.NextPageToken)
+			}
+			return nil
+		},
+	}
+
+	auditCmd.Flags().StringVar(&name, "project", "", "Project Name")
+	auditCmd.Flags().StringVar(&path, "path", ".", "Project directory")
+	auditCmd.Flags().Uint32Var(&pageSize, "page-size", 50, "Number of events to return per page")
+	auditCmd.Flags().StringVar(&pageToken, "page-token", "", "Pagination token")
+
+	return auditCmd
+}
+
+func toAuditTable(events []*adminv1.AuditEvent) []*auditEvent {
+	rows := make([]*auditEvent, 0, len(events))
+
+	for _, e := range events {
+		actor := e.ActorName
+		if actor == "" {
+			actor = e.ActorType
+		}
+
+		rows = append(rows, &auditEvent{
+			Time:    e.CreatedOn.AsTime().Local().Format(time.RFC3339),
+			Actor:   actor,
+			Action:  e.Action,
+			Target:  e.Target,
+			Summary: e.Summary,
+		})
+	}
+
+	return rows
+}
+
+type auditEvent struct {
+	Time    string `header:"time" json:"time"`
+	Actor   string `header:"actor" json:"actor"`
+	Action  string `header:"action" json:"action"`
+	Target  string `header:"target" json:"target"`
+	Summary string `header:"summary" json:"summary"`
+}
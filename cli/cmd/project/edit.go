@@ -11,7 +11,7 @@ import (
 
 func EditCmd(cfg *config.Config) *cobra.Command {
 	var name, description, prodBranch, path, region string
-	var public bool
+	var public, previews bool
 	var slots int
 	var prodTTL int64
 
@@ -77,6 +77,11 @@ func EditCmd(cfg *config.Config) *cobra.Command {
 				req.ProdTtlSeconds = &prodTTL
 			}
 
+			if cmd.Flags().Changed("preview-deployments") {
+				promptFlagValues = false
+				req.PreviewDeployments = &previews
+			}
+
 			if promptFlagValues {
 				resp, err := client.GetProject(ctx, &adminv1.GetProjectRequest{OrganizationName: cfg.Org, Name: name})
 				if err != nil {
@@ -122,6 +127,7 @@ func EditCmd(cfg *config.Config) *cobra.Command {
 	editCmd.Flags().IntVar(&slots, "prod-slots", 0, "Slots to allocate for production deployments (default: current slots)")
 	editCmd.Flags().Int64Var(&prodTTL, "prod-ttl-seconds", 0, "Prod deployment TTL in seconds")
 	editCmd.Flags().StringVar(&region, "region", "", "Deployment region (default: current region)")
+	editCmd.Flags().BoolVar(&previews, "preview-deployments", false, "Deploy previews of non-prod branches and pull requests (counts towards the deployments quota)")
 	if !cfg.IsDev() {
 		if err := editCmd.Flags().MarkHidden("prod-slots"); err != nil {
 			panic(err)
@@ -0,0 +1,91 @@
+package project
+
+import (
+	"fmt"
+	"time"
+
+	"github.com/rilldata/rill/cli/pkg/cmdutil"
+	"github.com/rilldata/rill/cli/pkg/config"
+	adminv1 "github.com/rilldata/rill/proto/gen/rill/admin/v1"
+	"github.com/spf13/cobra"
+)
+
+func PreviewsCmd(cfg *config.Config) *cobra.Command {
+	var name, path string
+
+	previewsCmd := &cobra.Command{
+		Use:   "previews [<project-name>]",
+		Args:  cobra.MaximumNArgs(1),
+		Short: "List preview deployments of branches and pull requests",
+		RunE: func(cmd *cobra.Command, args []string) error {
+			client, err := cmdutil.Client(cfg)
+			if err != nil {
+				return err
+			}
+			defer client.Close()
+
+			if len(args) > 0 {
+				name = args[0]
+			}
+
+			if !cmd.Flags().Changed("project") && len(args) == 0 && cfg.Interactive {
+				name, err = inferProjectName(cmd.Context(), client, cfg.Org, path)
+				if err != nil {
+					return err
+				}
+			}
+
+			res, err := client.ListPreviewDeployments(cmd.Context(), &adminv1.ListPreviewDeploymentsRequest{
+				OrganizationName: cfg.Org,
+				Name:             name,
+			})
+			if err != nil {
+				return err
+			}
+
+			if len(res.Deployments) == 0 {
+				cmdutil.PrintlnWarn("No preview deployments found")
+				return nil
+			}
+
+			cmdutil.PrintlnSuccess("Preview deployments")
+			cmdutil.TablePrinter(toPreviewTable(res.Deployments))
+
+			return nil
+		},
+	}
+
+	previewsCmd.Flags().StringVar(&name, "project", "", "Project Name")
+	previewsCmd.Flags().StringVar(&path, "path", ".", "Project directory")
+
+	return previewsCmd
+}
+
+func toPreviewTable(depls []*adminv1.Deployment) []*preview {
+	previews := make([]*preview, 0, len(depls))
+
+	for _, d := range depls {
+		var pr string
+		if d.PullRequestNumber != 0 {
+			pr = fmt.Sprintf("#%d", d.PullRequestNumber)
+		}
+
+		previews = append(previews, &preview{
+			Branch:      d.Branch,
+			PullRequest: pr,
+			Status:      d.Status.String(),
+			Instance:    d.RuntimeInstanceId,
+			Updated:     d.UpdatedOn.AsTime().Local().Format(time.RFC3339),
+		})
+	}
+
+	return previews
+}
+
+type preview struct {
+	Branch      string `header:"branch" json:"branch"`
+	PullRequest string `header:"pull request" json:"pull_request"`
+	Status      string `header:"status" json:"status"`
+	Instance    string `header:"instance" json:"instance"`
+	Updated     string `header:"updated" json:"updated"`
+}
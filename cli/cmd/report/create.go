@@ -0,0 +1,57 @@
+package report
+
+import (
+	"fmt"
+
+	"github.com/rilldata/rill/cli/pkg/cmdutil"
+	"github.com/rilldata/rill/cli/pkg/config"
+	adminv1 "github.com/rilldata/rill/proto/gen/rill/admin/v1"
+	"github.com/spf13/cobra"
+)
+
+func CreateCmd(cfg *config.Config) *cobra.Command {
+	var projectName string
+	flags := &reportFlags{}
+
+	createCmd := &cobra.Command{
+		Use:   "create <report-name>",
+		Args:  cobra.ExactArgs(1),
+		Short: "Create a report that emails a metrics view export on a schedule",
+		RunE: func(cmd *cobra.Command, args []string) error {
+			client, err := cmdutil.Client(cfg)
+			if err != nil {
+				return err
+			}
+			defer client.Close()
+
+			opts := &adminv1.ReportOptions{}
+			err = flags.apply(cmd, opts)
+			if err != nil {
+				return err
+			}
+
+			res, err := client.CreateReport(cmd.Context(), &adminv1.CreateReportRequest{
+				Organization: cfg.Org,
+				Project:      projectName,
+				Name:         args[0],
+				Options:      opts,
+			})
+			if err != nil {
+				return err
+			}
+
+			cmdutil.PrintlnSuccess(fmt.Sprintf("Created report %q. It will next run at %s.", res.Report.Name, res.Report.NextRunOn.AsTime().Format(cmdutil.TSFormatLayout)))
+
+			return nil
+		},
+	}
+
+	createCmd.Flags().StringVar(&projectName, "project", "", "Project Name")
+	flags.register(createCmd)
+	_ = createCmd.MarkFlagRequired("project")
+	_ = createCmd.MarkFlagRequired("schedule")
+	_ = createCmd.MarkFlagRequired("recipient")
+	_ = createCmd.MarkFlagRequired("metrics-view")
+
+	return createCmd
+}
@@ -0,0 +1,64 @@
+package report
+
+import (
+	"fmt"
+
+	"github.com/rilldata/rill/cli/pkg/cmdutil"
+	"github.com/rilldata/rill/cli/pkg/config"
+	adminv1 "github.com/rilldata/rill/proto/gen/rill/admin/v1"
+	"github.com/spf13/cobra"
+)
+
+func EditCmd(cfg *config.Config) *cobra.Command {
+	var projectName string
+	flags := &reportFlags{}
+
+	editCmd := &cobra.Command{
+		Use:   "edit <report-name>",
+		Args:  cobra.ExactArgs(1),
+		Short: "Edit report",
+		RunE: func(cmd *cobra.Command, args []string) error {
+			client, err := cmdutil.Client(cfg)
+			if err != nil {
+				return err
+			}
+			defer client.Close()
+
+			res, err := client.GetReport(cmd.Context(), &adminv1.GetReportRequest{
+				Organization: cfg.Org,
+				Project:      projectName,
+				Name:         args[0],
+			})
+			if err != nil {
+				return err
+			}
+
+			// Only update the options for the flags that were passed
+			opts := res.Report.Options
+			err = flags.apply(cmd, opts)
+			if err != nil {
+				return err
+			}
+
+			_, err = client.EditReport(cmd.Context(), &adminv1.EditReportRequest{
+				Organization: cfg.Org,
+				Project:      projectName,
+				Name:         args[0],
+				Options:      opts,
+			})
+			if err != nil {
+				return err
+			}
+
+			cmdutil.PrintlnSuccess(fmt.Sprintf("Updated report %q", args[0]))
+
+			return nil
+		},
+	}
+
+	editCmd.Flags().StringVar(&projectName, "project", "", "Project Name")
+	flags.register(editCmd)
+	_ = editCmd.MarkFlagRequired("project")
+
+	return editCmd
+}
@@ -0,0 +1,46 @@
+package report
+
+import (
+	"github.com/rilldata/rill/cli/pkg/cmdutil"
+	"github.com/rilldata/rill/cli/pkg/config"
+	adminv1 "github.com/rilldata/rill/proto/gen/rill/admin/v1"
+	"github.com/spf13/cobra"
+)
+
+func ListCmd(cfg *config.Config) *cobra.Command {
+	var projectName string
+
+	listCmd := &cobra.Command{
+		Use:   "list",
+		Short: "List reports",
+		RunE: func(cmd *cobra.Command, args []string) error {
+			client, err := cmdutil.Client(cfg)
+			if err != nil {
+				return err
+			}
+			defer client.Close()
+
+			res, err := client.ListReports(cmd.Context(), &adminv1.ListReportsRequest{
+				Organization: cfg.Org,
+				Project:      projectName,
+			})
+			if err != nil {
+				return err
+			}
+
+			if len(res.Reports) == 0 {
+				cmdutil.PrintlnWarn("No reports found")
+				return nil
+			}
+
+			cmdutil.TablePrinter(toTable(res.Reports))
+
+			return nil
+		},
+	}
+
+	listCmd.Flags().StringVar(&projectName, "project", "", "Project Name")
+	_ = listCmd.MarkFlagRequired("project")
+
+	return listCmd
+}
@@ -0,0 +1,147 @@
+package report
+
+import (
+	"fmt"
+	"strings"
+
+	"github.com/rilldata/rill/cli/pkg/cmdutil"
+	"github.com/rilldata/rill/cli/pkg/config"
+	adminv1 "github.com/rilldata/rill/proto/gen/rill/admin/v1"
+	"github.com/spf13/cobra"
+)
+
+func ReportCmd(cfg *config.Config) *cobra.Command {
+	reportCmd := &cobra.Command{
+		Use:               "report",
+		Short:             "Manage scheduled email reports",
+		PersistentPreRunE: cmdutil.CheckChain(cmdutil.CheckAuth(cfg), cmdutil.CheckOrganization(cfg)),
+	}
+
+	reportCmd.PersistentFlags().StringVar(&cfg.Org, "org", cfg.Org, "Organization Name")
+	reportCmd.AddCommand(ListCmd(cfg))
+	reportCmd.AddCommand(ShowCmd(cfg))
+	reportCmd.AddCommand(CreateCmd(cfg))
+	reportCmd.AddCommand(EditCmd(cfg))
+	reportCmd.AddCommand(DeleteCmd(cfg))
+
+	return reportCmd
+}
+
+var exportFormats = []string{"csv", "xlsx"}
+
+func parseExportFormat(s string) (adminv1.ExportFormat, error) {
+	switch strings.ToLower(s) {
+	case "csv":
+		return adminv1.ExportFormat_EXPORT_FORMAT_CSV, nil
+	case "xlsx":
+		return adminv1.ExportFormat_EXPORT_FORMAT_XLSX, nil
+	default:
+		return adminv1.ExportFormat_EXPORT_FORMAT_UNSPECIFIED, fmt.Errorf("invalid export format %q (options: %s)", s, strings.Join(exportFormats, ", "))
+	}
+}
+
+func exportFormatToString(f adminv1.ExportFormat) string {
+	switch f {
+	case adminv1.ExportFormat_EXPORT_FORMAT_CSV:
+		return "csv"
+	case adminv1.ExportFormat_EXPORT_FORMAT_XLSX:
+		return "xlsx"
+	default:
+		return ""
+	}
+}
+
+// reportFlags are the flags for the user-configurable options of a report.
+type reportFlags struct {
+	schedule    string
+	timeZone    string
+	recipients  []string
+	metricsView string
+	query       string
+	timeRange   string
+	format      string
+	limit       int64
+}
+
+func (f *reportFlags) register(cmd *cobra.Command) {
+	cmd.Flags().StringVar(&f.schedule, "schedule", "", `Cron schedule for sending the report (e.g. "0 9 * * 1" for every Monday at 9am)`)
+	cmd.Flags().StringVar(&f.timeZone, "time-zone", "", "IANA time zone for the schedule (default UTC)")
+	cmd.Flags().StringSliceVar(&f.recipients, "recipient", nil, "Email address to send the report to (can be repeated)")
+	cmd.Flags().StringVar(&f.metricsView, "metrics-view", "", "Name of the metrics view to query")
+	cmd.Flags().StringVar(&f.query, "query", "", "JSON-encoded arguments for the metrics view aggregation (dimensions, measures, filters, sort, etc.)")
+	cmd.Flags().StringVar(&f.timeRange, "time-range", "", `ISO 8601 duration of data to include, ending when the report runs (e.g. "P7D")`)
+	cmd.Flags().StringVar(&f.format, "format", "csv", fmt.Sprintf("Export format of the attachment [%s]", strings.Join(exportFormats, ", ")))
+	cmd.Flags().Int64Var(&f.limit, "limit", 0, "Maximum number of rows to export (default is the runtime's limit)")
+}
+
+// apply sets the options for the flags that were explicitly passed to the command.
+func (f *reportFlags) apply(cmd *cobra.Command, opts *adminv1.ReportOptions) error {
+	if cmd.Flags().Changed("schedule") {
+		opts.Schedule = f.schedule
+	}
+	if cmd.Flags().Changed("time-zone") {
+		opts.TimeZone = f.timeZone
+	}
+	if cmd.Flags().Changed("recipient") {
+		opts.Recipients = f.recipients
+	}
+	if cmd.Flags().Changed("metrics-view") {
+		opts.MetricsViewName = f.metricsView
+	}
+	if cmd.Flags().Changed("query") {
+		opts.QueryArgsJson = f.query
+	}
+	if cmd.Flags().Changed("time-range") {
+		opts.TimeRange = f.timeRange
+	}
+	if cmd.Flags().Changed("format") || opts.ExportFormat == adminv1.ExportFormat_EXPORT_FORMAT_UNSPECIFIED {
+		format, err := parseExportFormat(f.format)
+		if err != nil {
+			return err
+		}
+		opts.ExportFormat = format
+	}
+	if cmd.Flags().Changed("limit") {
+		opts.ExportLimit = f.limit
+	}
+	return nil
+}
+
+func toTable(reports []*adminv1.Report) []*report {
+	res := make([]*report, 0, len(reports))
+
+	for _, r := range reports {
+		res = append(res, toRow(r))
+	}
+
+	return res
+}
+
+func toRow(r *adminv1.Report) *report {
+	var lastRun string
+	if r.LastRunOn != nil {
+		lastRun = r.LastRunOn.AsTime().Format(cmdutil.TSFormatLayout)
+	}
+
+	return &report{
+		Name:        r.Name,
+		Schedule:    fmt.Sprintf("%s (%s)", r.Options.Schedule, r.Options.TimeZone),
+		MetricsView: r.Options.MetricsViewName,
+		Format:      exportFormatToString(r.Options.ExportFormat),
+		Recipients:  strings.Join(r.Options.Recipients, ", "),
+		NextRun:     r.NextRunOn.AsTime().Format(cmdutil.TSFormatLayout),
+		LastRun:     lastRun,
+		LastError:   r.LastRunError,
+	}
+}
+
+type report struct {
+	Name        string `header:"name" json:"name"`
+	Schedule    string `header:"schedule" json:"schedule"`
+	MetricsView string `header:"metrics view" json:"metrics_view"`
+	Format      string `header:"format" json:"format"`
+	Recipients  string `header:"recipients" json:"recipients"`
+	NextRun     string `header:"next run" json:"next_run"`
+	LastRun     string `header:"last run" json:"last_run"`
+	LastError   string `header:"last error" json:"last_error"`
+}
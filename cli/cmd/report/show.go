@@ -0,0 +1,51 @@
+package report
+
+import (
+	"fmt"
+
+	"github.com/rilldata/rill/cli/pkg/cmdutil"
+	"github.com/rilldata/rill/cli/pkg/config"
+	adminv1 "github.com/rilldata/rill/proto/gen/rill/admin/v1"
+	"github.com/spf13/cobra"
+)
+
+func ShowCmd(cfg *config.Config) *cobra.Command {
+	var projectName string
+
+	showCmd := &cobra.Command{
+		Use:   "show <report-name>",
+		Args:  cobra.ExactArgs(1),
+		Short: "Show report",
+		RunE: func(cmd *cobra.Command, args []string) error {
+			client, err := cmdutil.Client(cfg)
+			if err != nil {
+				return err
+			}
+			defer client.Close()
+
+			res, err := client.GetReport(cmd.Context(), &adminv1.GetReportRequest{
+				Organization: cfg.Org,
+				Project:      projectName,
+				Name:         args[0],
+			})
+			if err != nil {
+				return err
+			}
+
+			cmdutil.TablePrinter(toRow(res.Report))
+			if res.Report.Options.QueryArgsJson != "" {
+				fmt.Printf("\nQuery: %s\n", res.Report.Options.QueryArgsJson)
+			}
+			if res.Report.Options.TimeRange != "" {
+				fmt.Printf("Time range: %s\n", res.Report.Options.TimeRange)
+			}
+
+			return nil
+		},
+	}
+
+	showCmd.Flags().StringVar(&projectName, "project", "", "Project Name")
+	_ = showCmd.MarkFlagRequired("project")
+
+	return showCmd
+}
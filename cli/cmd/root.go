@@ -7,12 +7,14 @@ import (
 	"strings"
 
 	"github.com/rilldata/rill/cli/cmd/admin"
+	"github.com/rilldata/rill/cli/cmd/alert"
 	"github.com/rilldata/rill/cli/cmd/auth"
 	"github.com/rilldata/rill/cli/cmd/deploy"
 	"github.com/rilldata/rill/cli/cmd/docs"
 	"github.com/rilldata/rill/cli/cmd/env"
 	"github.com/rilldata/rill/cli/cmd/org"
 	"github.com/rilldata/rill/cli/cmd/project"
+	"github.com/rilldata/rill/cli/cmd/report"
 	"github.com/rilldata/rill/cli/cmd/runtime"
 	"github.com/rilldata/rill/cli/cmd/service"
 	"github.com/rilldata/rill/cli/cmd/start"
@@ -146,6 +148,8 @@ func runCmd(ctx context.Context, ver config.Version) error {
 		auth.LogoutCmd(cfg),
 		sudo.SudoCmd(cfg),
 		service.ServiceCmd(cfg),
+		report.ReportCmd(cfg),
+		alert.AlertCmd(cfg),
 	}
 	for _, cmd := range adminCmds {
 		cmd.PersistentFlags().StringVar(&cfg.AdminURL, "api-url", cfg.AdminURL, "Base URL for the admin API")
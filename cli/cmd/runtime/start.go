@@ -24,14 +24,17 @@ import (
 
 	// Load connectors and reconcilers for runtime
 	_ "github.com/rilldata/rill/runtime/drivers/bigquery"
+	_ "github.com/rilldata/rill/runtime/drivers/clickhouse"
 	_ "github.com/rilldata/rill/runtime/drivers/druid"
 	_ "github.com/rilldata/rill/runtime/drivers/duckdb"
 	_ "github.com/rilldata/rill/runtime/drivers/file"
 	_ "github.com/rilldata/rill/runtime/drivers/gcs"
 	_ "github.com/rilldata/rill/runtime/drivers/github"
 	_ "github.com/rilldata/rill/runtime/drivers/https"
+	_ "github.com/rilldata/rill/runtime/drivers/mysql"
 	_ "github.com/rilldata/rill/runtime/drivers/postgres"
 	_ "github.com/rilldata/rill/runtime/drivers/s3"
+	_ "github.com/rilldata/rill/runtime/drivers/snowflake"
 	_ "github.com/rilldata/rill/runtime/drivers/sqlite"
 	_ "github.com/rilldata/rill/runtime/reconcilers"
 )
@@ -55,6 +58,8 @@ type Config struct {
 	SafeSourceRefresh   bool                   `default:"false" split_words:"true"`
 	ConnectionCacheSize int                    `default:"100" split_words:"true"`
 	QueryCacheSizeBytes int64                  `default:"104857600" split_words:"true"` // 100MB by default
+	// Number of controller logs retained in memory per instance
+	ControllerLogBufferCapacity int `default:"1000" split_words:"true"`
 	// AllowHostAccess controls whether instance can use host credentials and
 	// local_file sources can access directory outside repo
 	AllowHostAccess bool `default:"false" split_words:"true"`
@@ -142,11 +147,12 @@ func StartCmd(cliCfg *config.Config) *cobra.Command {
 
 			// Init runtime
 			opts := &runtime.Options{
-				ConnectionCacheSize: conf.ConnectionCacheSize,
-				MetastoreConnector:  "metastore",
-				QueryCacheSizeBytes: conf.QueryCacheSizeBytes,
-				AllowHostAccess:     conf.AllowHostAccess,
-				SafeSourceRefresh:   conf.SafeSourceRefresh,
+				ConnectionCacheSize:         conf.ConnectionCacheSize,
+				MetastoreConnector:          "metastore",
+				QueryCacheSizeBytes:         conf.QueryCacheSizeBytes,
+				AllowHostAccess:             conf.AllowHostAccess,
+				SafeSourceRefresh:           conf.SafeSourceRefresh,
+				ControllerLogBufferCapacity: conf.ControllerLogBufferCapacity,
 				SystemConnectors: []*runtimev1.Connector{
 					{
 						Type:   conf.MetastoreDriver,
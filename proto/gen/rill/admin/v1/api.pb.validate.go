@@ -2496,53 +2496,46 @@ var _ interface {
 	ErrorName() string
 } = GetProjectVariablesResponseValidationError{}
 
-// Validate checks the field values on ListServicesRequest with the rules
-// defined in the proto definition for this message. If any rules are
+// Validate checks the field values on ListPreviewDeploymentsRequest with the
+// rules defined in the proto definition for this message. If any rules are
 // violated, the first error encountered is returned, or nil if there are no violations.
-func (m *ListServicesRequest) Validate() error {
+func (m *ListPreviewDeploymentsRequest) Validate() error {
 	return m.validate(false)
 }
 
-// ValidateAll checks the field values on ListServicesRequest with the rules
-// defined in the proto definition for this message. If any rules are
-// violated, the result is a list of violation errors wrapped in
-// ListServicesRequestMultiError, or nil if none found.
-func (m *ListServicesRequest) ValidateAll() error {
+// ValidateAll checks the field values on ListPreviewDeploymentsRequest with
+// the rules defined in the proto definition for this message. If any rules
+// are violated, the result is a list of violation errors wrapped in
+// ListPreviewDeploymentsRequestMultiError, or nil if none found.
+func (m *ListPreviewDeploymentsRequest) ValidateAll() error {
 	return m.validate(true)
 }
 
-func (m *ListServicesRequest) validate(all bool) error {
+func (m *ListPreviewDeploymentsRequest) validate(all bool) error {
 	if m == nil {
 		return nil
 	}
 
 	var errors []error
 
-	if utf8.RuneCountInString(m.GetOrganizationName()) < 1 {
-		err := ListServicesRequestValidationError{
-			field:  "OrganizationName",
-			reason: "value length must be at least 1 runes",
-		}
-		if !all {
-			return err
-		}
-		errors = append(errors, err)
-	}
+	// no validation rules for OrganizationName
+
+	// no validation rules for Name
 
 	if len(errors) > 0 {
-		return ListServicesRequestMultiError(errors)
+		return ListPreviewDeploymentsRequestMultiError(errors)
 	}
 
 	return nil
 }
 
-// ListServicesRequestMultiError is an error wrapping multiple validation
-// errors returned by ListServicesRequest.ValidateAll() if the designated
-// constraints aren't met.
-type ListServicesRequestMultiError []error
+// ListPreviewDeploymentsRequestMultiError is an error wrapping multiple
+// validation errors returned by ListPreviewDeploymentsRequest.ValidateAll()
+// if the designated constraints aren't met.
+type ListPreviewDeploymentsRequestMultiError []error
 
 // Error returns a concatenation of all the error messages it wraps.
-func (m ListServicesRequestMultiError) Error() string {
+func (m ListPreviewDeploymentsRequestMultiError) Error() string {
 	var msgs []string
 	for _, err := range m {
 		msgs = append(msgs, err.Error())
@@ -2551,11 +2544,12 @@ func (m ListServicesRequestMultiError) Error() string {
 }
 
 // AllErrors returns a list of validation violation errors.
-func (m ListServicesRequestMultiError) AllErrors() []error { return m }
+func (m ListPreviewDeploymentsRequestMultiError) AllErrors() []error { return m }
 
-// ListServicesRequestValidationError is the validation error returned by
-// ListServicesRequest.Validate if the designated constraints aren't met.
-type ListServicesRequestValidationError struct {
+// ListPreviewDeploymentsRequestValidationError is the validation error
+// returned by ListPreviewDeploymentsRequest.Validate if the designated
+// constraints aren't met.
+type ListPreviewDeploymentsRequestValidationError struct {
 	field  string
 	reason string
 	cause  error
@@ -2563,24 +2557,24 @@ type ListServicesRequestValidationError struct {
 }
 
 // Field function returns field value.
-func (e ListServicesRequestValidationError) Field() string { return e.field }
+func (e ListPreviewDeploymentsRequestValidationError) Field() string { return e.field }
 
 // Reason function returns reason value.
-func (e ListServicesRequestValidationError) Reason() string { return e.reason }
+func (e ListPreviewDeploymentsRequestValidationError) Reason() string { return e.reason }
 
 // Cause function returns cause value.
-func (e ListServicesRequestValidationError) Cause() error { return e.cause }
+func (e ListPreviewDeploymentsRequestValidationError) Cause() error { return e.cause }
 
 // Key function returns key value.
-func (e ListServicesRequestValidationError) Key() bool { return e.key }
+func (e ListPreviewDeploymentsRequestValidationError) Key() bool { return e.key }
 
 // ErrorName returns error name.
-func (e ListServicesRequestValidationError) ErrorName() string {
-	return "ListServicesRequestValidationError"
+func (e ListPreviewDeploymentsRequestValidationError) ErrorName() string {
+	return "ListPreviewDeploymentsRequestValidationError"
 }
 
 // Error satisfies the builtin error interface
-func (e ListServicesRequestValidationError) Error() string {
+func (e ListPreviewDeploymentsRequestValidationError) Error() string {
 	cause := ""
 	if e.cause != nil {
 		cause = fmt.Sprintf(" | caused by: %v", e.cause)
@@ -2592,14 +2586,14 @@ func (e ListServicesRequestValidationError) Error() string {
 	}
 
 	return fmt.Sprintf(
-		"invalid %sListServicesRequest.%s: %s%s",
+		"invalid %sListPreviewDeploymentsRequest.%s: %s%s",
 		key,
 		e.field,
 		e.reason,
 		cause)
 }
 
-var _ error = ListServicesRequestValidationError{}
+var _ error = ListPreviewDeploymentsRequestValidationError{}
 
 var _ interface {
 	Field() string
@@ -2607,47 +2601,47 @@ var _ interface {
 	Key() bool
 	Cause() error
 	ErrorName() string
-} = ListServicesRequestValidationError{}
+} = ListPreviewDeploymentsRequestValidationError{}
 
-// Validate checks the field values on ListServicesResponse with the rules
-// defined in the proto definition for this message. If any rules are
+// Validate checks the field values on ListPreviewDeploymentsResponse with the
+// rules defined in the proto definition for this message. If any rules are
 // violated, the first error encountered is returned, or nil if there are no violations.
-func (m *ListServicesResponse) Validate() error {
+func (m *ListPreviewDeploymentsResponse) Validate() error {
 	return m.validate(false)
 }
 
-// ValidateAll checks the field values on ListServicesResponse with the rules
-// defined in the proto definition for this message. If any rules are
-// violated, the result is a list of violation errors wrapped in
-// ListServicesResponseMultiError, or nil if none found.
-func (m *ListServicesResponse) ValidateAll() error {
+// ValidateAll checks the field values on ListPreviewDeploymentsResponse with
+// the rules defined in the proto definition for this message. If any rules
+// are violated, the result is a list of violation errors wrapped in
+// ListPreviewDeploymentsResponseMultiError, or nil if none found.
+func (m *ListPreviewDeploymentsResponse) ValidateAll() error {
 	return m.validate(true)
 }
 
-func (m *ListServicesResponse) validate(all bool) error {
+func (m *ListPreviewDeploymentsResponse) validate(all bool) error {
 	if m == nil {
 		return nil
 	}
 
 	var errors []error
 
-	for idx, item := range m.GetServices() {
+	for idx, item := range m.GetDeployments() {
 		_, _ = idx, item
 
 		if all {
 			switch v := interface{}(item).(type) {
 			case interface{ ValidateAll() error }:
 				if err := v.ValidateAll(); err != nil {
-					errors = append(errors, ListServicesResponseValidationError{
-						field:  fmt.Sprintf("Services[%v]", idx),
+					errors = append(errors, ListPreviewDeploymentsResponseValidationError{
+						field:  fmt.Sprintf("Deployments[%v]", idx),
 						reason: "embedded message failed validation",
 						cause:  err,
 					})
 				}
 			case interface{ Validate() error }:
 				if err := v.Validate(); err != nil {
-					errors = append(errors, ListServicesResponseValidationError{
-						field:  fmt.Sprintf("Services[%v]", idx),
+					errors = append(errors, ListPreviewDeploymentsResponseValidationError{
+						field:  fmt.Sprintf("Deployments[%v]", idx),
 						reason: "embedded message failed validation",
 						cause:  err,
 					})
@@ -2655,8 +2649,8 @@ func (m *ListServicesResponse) validate(all bool) error {
 			}
 		} else if v, ok := interface{}(item).(interface{ Validate() error }); ok {
 			if err := v.Validate(); err != nil {
-				return ListServicesResponseValidationError{
-					field:  fmt.Sprintf("Services[%v]", idx),
+				return ListPreviewDeploymentsResponseValidationError{
+					field:  fmt.Sprintf("Deployments[%v]", idx),
 					reason: "embedded message failed validation",
 					cause:  err,
 				}
@@ -2666,19 +2660,19 @@ func (m *ListServicesResponse) validate(all bool) error {
 	}
 
 	if len(errors) > 0 {
-		return ListServicesResponseMultiError(errors)
+		return ListPreviewDeploymentsResponseMultiError(errors)
 	}
 
 	return nil
 }
 
-// ListServicesResponseMultiError is an error wrapping multiple validation
-// errors returned by ListServicesResponse.ValidateAll() if the designated
-// constraints aren't met.
-type ListServicesResponseMultiError []error
+// ListPreviewDeploymentsResponseMultiError is an error wrapping multiple
+// validation errors returned by ListPreviewDeploymentsResponse.ValidateAll()
+// if the designated constraints aren't met.
+type ListPreviewDeploymentsResponseMultiError []error
 
 // Error returns a concatenation of all the error messages it wraps.
-func (m ListServicesResponseMultiError) Error() string {
+func (m ListPreviewDeploymentsResponseMultiError) Error() string {
 	var msgs []string
 	for _, err := range m {
 		msgs = append(msgs, err.Error())
@@ -2687,11 +2681,12 @@ func (m ListServicesResponseMultiError) Error() string {
 }
 
 // AllErrors returns a list of validation violation errors.
-func (m ListServicesResponseMultiError) AllErrors() []error { return m }
+func (m ListPreviewDeploymentsResponseMultiError) AllErrors() []error { return m }
 
-// ListServicesResponseValidationError is the validation error returned by
-// ListServicesResponse.Validate if the designated constraints aren't met.
-type ListServicesResponseValidationError struct {
+// ListPreviewDeploymentsResponseValidationError is the validation error
+// returned by ListPreviewDeploymentsResponse.Validate if the designated
+// constraints aren't met.
+type ListPreviewDeploymentsResponseValidationError struct {
 	field  string
 	reason string
 	cause  error
@@ -2699,24 +2694,24 @@ type ListServicesResponseValidationError struct {
 }
 
 // Field function returns field value.
-func (e ListServicesResponseValidationError) Field() string { return e.field }
+func (e ListPreviewDeploymentsResponseValidationError) Field() string { return e.field }
 
 // Reason function returns reason value.
-func (e ListServicesResponseValidationError) Reason() string { return e.reason }
+func (e ListPreviewDeploymentsResponseValidationError) Reason() string { return e.reason }
 
 // Cause function returns cause value.
-func (e ListServicesResponseValidationError) Cause() error { return e.cause }
+func (e ListPreviewDeploymentsResponseValidationError) Cause() error { return e.cause }
 
 // Key function returns key value.
-func (e ListServicesResponseValidationError) Key() bool { return e.key }
+func (e ListPreviewDeploymentsResponseValidationError) Key() bool { return e.key }
 
 // ErrorName returns error name.
-func (e ListServicesResponseValidationError) ErrorName() string {
-	return "ListServicesResponseValidationError"
+func (e ListPreviewDeploymentsResponseValidationError) ErrorName() string {
+	return "ListPreviewDeploymentsResponseValidationError"
 }
 
 // Error satisfies the builtin error interface
-func (e ListServicesResponseValidationError) Error() string {
+func (e ListPreviewDeploymentsResponseValidationError) Error() string {
 	cause := ""
 	if e.cause != nil {
 		cause = fmt.Sprintf(" | caused by: %v", e.cause)
@@ -2728,14 +2723,14 @@ func (e ListServicesResponseValidationError) Error() string {
 	}
 
 	return fmt.Sprintf(
-		"invalid %sListServicesResponse.%s: %s%s",
+		"invalid %sListPreviewDeploymentsResponse.%s: %s%s",
 		key,
 		e.field,
 		e.reason,
 		cause)
 }
 
-var _ error = ListServicesResponseValidationError{}
+var _ error = ListPreviewDeploymentsResponseValidationError{}
 
 var _ interface {
 	Field() string
@@ -2743,32 +2738,43 @@ var _ interface {
 	Key() bool
 	Cause() error
 	ErrorName() string
-} = ListServicesResponseValidationError{}
+} = ListPreviewDeploymentsResponseValidationError{}
 
-// Validate checks the field values on CreateServiceRequest with the rules
-// defined in the proto definition for this message. If any rules are
+// Validate checks the field values on GetPreviewDeploymentRequest with the
+// rules defined in the proto definition for this message. If any rules are
 // violated, the first error encountered is returned, or nil if there are no violations.
-func (m *CreateServiceRequest) Validate() error {
+func (m *GetPreviewDeploymentRequest) Validate() error {
 	return m.validate(false)
 }
 
-// ValidateAll checks the field values on CreateServiceRequest with the rules
-// defined in the proto definition for this message. If any rules are
+// ValidateAll checks the field values on GetPreviewDeploymentRequest with the
+// rules defined in the proto definition for this message. If any rules are
 // violated, the result is a list of violation errors wrapped in
-// CreateServiceRequestMultiError, or nil if none found.
-func (m *CreateServiceRequest) ValidateAll() error {
+// GetPreviewDeploymentRequestMultiError, or nil if none found.
+func (m *GetPreviewDeploymentRequest) ValidateAll() error {
 	return m.validate(true)
 }
 
-func (m *CreateServiceRequest) validate(all bool) error {
+func (m *GetPreviewDeploymentRequest) validate(all bool) error {
 	if m == nil {
 		return nil
 	}
 
 	var errors []error
 
+	if utf8.RuneCountInString(m.GetOrganizationName()) < 1 {
+		err := GetPreviewDeploymentRequestValidationError{
+			field:  "OrganizationName",
+			reason: "value length must be at least 1 runes",
+		}
+		if !all {
+			return err
+		}
+		errors = append(errors, err)
+	}
+
 	if utf8.RuneCountInString(m.GetName()) < 1 {
-		err := CreateServiceRequestValidationError{
+		err := GetPreviewDeploymentRequestValidationError{
 			field:  "Name",
 			reason: "value length must be at least 1 runes",
 		}
@@ -2778,9 +2784,9 @@ func (m *CreateServiceRequest) validate(all bool) error {
 		errors = append(errors, err)
 	}
 
-	if utf8.RuneCountInString(m.GetOrganizationName()) < 1 {
-		err := CreateServiceRequestValidationError{
-			field:  "OrganizationName",
+	if utf8.RuneCountInString(m.GetBranch()) < 1 {
+		err := GetPreviewDeploymentRequestValidationError{
+			field:  "Branch",
 			reason: "value length must be at least 1 runes",
 		}
 		if !all {
@@ -2790,19 +2796,19 @@ func (m *CreateServiceRequest) validate(all bool) error {
 	}
 
 	if len(errors) > 0 {
-		return CreateServiceRequestMultiError(errors)
+		return GetPreviewDeploymentRequestMultiError(errors)
 	}
 
 	return nil
 }
 
-// CreateServiceRequestMultiError is an error wrapping multiple validation
-// errors returned by CreateServiceRequest.ValidateAll() if the designated
-// constraints aren't met.
-type CreateServiceRequestMultiError []error
+// GetPreviewDeploymentRequestMultiError is an error wrapping multiple
+// validation errors returned by GetPreviewDeploymentRequest.ValidateAll() if
+// the designated constraints aren't met.
+type GetPreviewDeploymentRequestMultiError []error
 
 // Error returns a concatenation of all the error messages it wraps.
-func (m CreateServiceRequestMultiError) Error() string {
+func (m GetPreviewDeploymentRequestMultiError) Error() string {
 	var msgs []string
 	for _, err := range m {
 		msgs = append(msgs, err.Error())
@@ -2811,11 +2817,12 @@ func (m CreateServiceRequestMultiError) Error() string {
 }
 
 // AllErrors returns a list of validation violation errors.
-func (m CreateServiceRequestMultiError) AllErrors() []error { return m }
+func (m GetPreviewDeploymentRequestMultiError) AllErrors() []error { return m }
 
-// CreateServiceRequestValidationError is the validation error returned by
-// CreateServiceRequest.Validate if the designated constraints aren't met.
-type CreateServiceRequestValidationError struct {
+// GetPreviewDeploymentRequestValidationError is the validation error returned
+// by GetPreviewDeploymentRequest.Validate if the designated constraints
+// aren't met.
+type GetPreviewDeploymentRequestValidationError struct {
 	field  string
 	reason string
 	cause  error
@@ -2823,24 +2830,24 @@ type CreateServiceRequestValidationError struct {
 }
 
 // Field function returns field value.
-func (e CreateServiceRequestValidationError) Field() string { return e.field }
+func (e GetPreviewDeploymentRequestValidationError) Field() string { return e.field }
 
 // Reason function returns reason value.
-func (e CreateServiceRequestValidationError) Reason() string { return e.reason }
+func (e GetPreviewDeploymentRequestValidationError) Reason() string { return e.reason }
 
 // Cause function returns cause value.
-func (e CreateServiceRequestValidationError) Cause() error { return e.cause }
+func (e GetPreviewDeploymentRequestValidationError) Cause() error { return e.cause }
 
 // Key function returns key value.
-func (e CreateServiceRequestValidationError) Key() bool { return e.key }
+func (e GetPreviewDeploymentRequestValidationError) Key() bool { return e.key }
 
 // ErrorName returns error name.
-func (e CreateServiceRequestValidationError) ErrorName() string {
-	return "CreateServiceRequestValidationError"
+func (e GetPreviewDeploymentRequestValidationError) ErrorName() string {
+	return "GetPreviewDeploymentRequestValidationError"
 }
 
 // Error satisfies the builtin error interface
-func (e CreateServiceRequestValidationError) Error() string {
+func (e GetPreviewDeploymentRequestValidationError) Error() string {
 	cause := ""
 	if e.cause != nil {
 		cause = fmt.Sprintf(" | caused by: %v", e.cause)
@@ -2852,14 +2859,14 @@ func (e CreateServiceRequestValidationError) Error() string {
 	}
 
 	return fmt.Sprintf(
-		"invalid %sCreateServiceRequest.%s: %s%s",
+		"invalid %sGetPreviewDeploymentRequest.%s: %s%s",
 		key,
 		e.field,
 		e.reason,
 		cause)
 }
 
-var _ error = CreateServiceRequestValidationError{}
+var _ error = GetPreviewDeploymentRequestValidationError{}
 
 var _ interface {
 	Field() string
@@ -2867,24 +2874,24 @@ var _ interface {
 	Key() bool
 	Cause() error
 	ErrorName() string
-} = CreateServiceRequestValidationError{}
+} = GetPreviewDeploymentRequestValidationError{}
 
-// Validate checks the field values on CreateServiceResponse with the rules
-// defined in the proto definition for this message. If any rules are
+// Validate checks the field values on GetPreviewDeploymentResponse with the
+// rules defined in the proto definition for this message. If any rules are
 // violated, the first error encountered is returned, or nil if there are no violations.
-func (m *CreateServiceResponse) Validate() error {
+func (m *GetPreviewDeploymentResponse) Validate() error {
 	return m.validate(false)
 }
 
-// ValidateAll checks the field values on CreateServiceResponse with the rules
-// defined in the proto definition for this message. If any rules are
+// ValidateAll checks the field values on GetPreviewDeploymentResponse with the
+// rules defined in the proto definition for this message. If any rules are
 // violated, the result is a list of violation errors wrapped in
-// CreateServiceResponseMultiError, or nil if none found.
-func (m *CreateServiceResponse) ValidateAll() error {
+// GetPreviewDeploymentResponseMultiError, or nil if none found.
+func (m *GetPreviewDeploymentResponse) ValidateAll() error {
 	return m.validate(true)
 }
 
-func (m *CreateServiceResponse) validate(all bool) error {
+func (m *GetPreviewDeploymentResponse) validate(all bool) error {
 	if m == nil {
 		return nil
 	}
@@ -2892,48 +2899,50 @@ func (m *CreateServiceResponse) validate(all bool) error {
 	var errors []error
 
 	if all {
-		switch v := interface{}(m.GetService()).(type) {
+		switch v := interface{}(m.GetDeployment()).(type) {
 		case interface{ ValidateAll() error }:
 			if err := v.ValidateAll(); err != nil {
-				errors = append(errors, CreateServiceResponseValidationError{
-					field:  "Service",
+				errors = append(errors, GetPreviewDeploymentResponseValidationError{
+					field:  "Deployment",
 					reason: "embedded message failed validation",
 					cause:  err,
 				})
 			}
 		case interface{ Validate() error }:
 			if err := v.Validate(); err != nil {
-				errors = append(errors, CreateServiceResponseValidationError{
-					field:  "Service",
+				errors = append(errors, GetPreviewDeploymentResponseValidationError{
+					field:  "Deployment",
 					reason: "embedded message failed validation",
 					cause:  err,
 				})
 			}
 		}
-	} else if v, ok := interface{}(m.GetService()).(interface{ Validate() error }); ok {
+	} else if v, ok := interface{}(m.GetDeployment()).(interface{ Validate() error }); ok {
 		if err := v.Validate(); err != nil {
-			return CreateServiceResponseValidationError{
-				field:  "Service",
+			return GetPreviewDeploymentResponseValidationError{
+				field:  "Deployment",
 				reason: "embedded message failed validation",
 				cause:  err,
 			}
 		}
 	}
 
+	// no validation rules for Jwt
+
 	if len(errors) > 0 {
-		return CreateServiceResponseMultiError(errors)
+		return GetPreviewDeploymentResponseMultiError(errors)
 	}
 
 	return nil
 }
 
-// CreateServiceResponseMultiError is an error wrapping multiple validation
-// errors returned by CreateServiceResponse.ValidateAll() if the designated
-// constraints aren't met.
-type CreateServiceResponseMultiError []error
+// GetPreviewDeploymentResponseMultiError is an error wrapping multiple
+// validation errors returned by GetPreviewDeploymentResponse.ValidateAll() if
+// the designated constraints aren't met.
+type GetPreviewDeploymentResponseMultiError []error
 
 // Error returns a concatenation of all the error messages it wraps.
-func (m CreateServiceResponseMultiError) Error() string {
+func (m GetPreviewDeploymentResponseMultiError) Error() string {
 	var msgs []string
 	for _, err := range m {
 		msgs = append(msgs, err.Error())
@@ -2942,11 +2951,12 @@ func (m CreateServiceResponseMultiError) Error() string {
 }
 
 // AllErrors returns a list of validation violation errors.
-func (m CreateServiceResponseMultiError) AllErrors() []error { return m }
+func (m GetPreviewDeploymentResponseMultiError) AllErrors() []error { return m }
 
-// CreateServiceResponseValidationError is the validation error returned by
-// CreateServiceResponse.Validate if the designated constraints aren't met.
-type CreateServiceResponseValidationError struct {
+// GetPreviewDeploymentResponseValidationError is the validation error returned
+// by GetPreviewDeploymentResponse.Validate if the designated constraints
+// aren't met.
+type GetPreviewDeploymentResponseValidationError struct {
 	field  string
 	reason string
 	cause  error
@@ -2954,24 +2964,24 @@ type CreateServiceResponseValidationError struct {
 }
 
 // Field function returns field value.
-func (e CreateServiceResponseValidationError) Field() string { return e.field }
+func (e GetPreviewDeploymentResponseValidationError) Field() string { return e.field }
 
 // Reason function returns reason value.
-func (e CreateServiceResponseValidationError) Reason() string { return e.reason }
+func (e GetPreviewDeploymentResponseValidationError) Reason() string { return e.reason }
 
 // Cause function returns cause value.
-func (e CreateServiceResponseValidationError) Cause() error { return e.cause }
+func (e GetPreviewDeploymentResponseValidationError) Cause() error { return e.cause }
 
 // Key function returns key value.
-func (e CreateServiceResponseValidationError) Key() bool { return e.key }
+func (e GetPreviewDeploymentResponseValidationError) Key() bool { return e.key }
 
 // ErrorName returns error name.
-func (e CreateServiceResponseValidationError) ErrorName() string {
-	return "CreateServiceResponseValidationError"
+func (e GetPreviewDeploymentResponseValidationError) ErrorName() string {
+	return "GetPreviewDeploymentResponseValidationError"
 }
 
 // Error satisfies the builtin error interface
-func (e CreateServiceResponseValidationError) Error() string {
+func (e GetPreviewDeploymentResponseValidationError) Error() string {
 	cause := ""
 	if e.cause != nil {
 		cause = fmt.Sprintf(" | caused by: %v", e.cause)
@@ -2983,14 +2993,14 @@ func (e CreateServiceResponseValidationError) Error() string {
 	}
 
 	return fmt.Sprintf(
-		"invalid %sCreateServiceResponse.%s: %s%s",
+		"invalid %sGetPreviewDeploymentResponse.%s: %s%s",
 		key,
 		e.field,
 		e.reason,
 		cause)
 }
 
-var _ error = CreateServiceResponseValidationError{}
+var _ error = GetPreviewDeploymentResponseValidationError{}
 
 var _ interface {
 	Field() string
@@ -2998,43 +3008,32 @@ var _ interface {
 	Key() bool
 	Cause() error
 	ErrorName() string
-} = CreateServiceResponseValidationError{}
+} = GetPreviewDeploymentResponseValidationError{}
 
-// Validate checks the field values on UpdateServiceRequest with the rules
+// Validate checks the field values on ListServicesRequest with the rules
 // defined in the proto definition for this message. If any rules are
 // violated, the first error encountered is returned, or nil if there are no violations.
-func (m *UpdateServiceRequest) Validate() error {
+func (m *ListServicesRequest) Validate() error {
 	return m.validate(false)
 }
 
-// ValidateAll checks the field values on UpdateServiceRequest with the rules
+// ValidateAll checks the field values on ListServicesRequest with the rules
 // defined in the proto definition for this message. If any rules are
 // violated, the result is a list of violation errors wrapped in
-// UpdateServiceRequestMultiError, or nil if none found.
-func (m *UpdateServiceRequest) ValidateAll() error {
+// ListServicesRequestMultiError, or nil if none found.
+func (m *ListServicesRequest) ValidateAll() error {
 	return m.validate(true)
 }
 
-func (m *UpdateServiceRequest) validate(all bool) error {
+func (m *ListServicesRequest) validate(all bool) error {
 	if m == nil {
 		return nil
 	}
 
 	var errors []error
 
-	if utf8.RuneCountInString(m.GetName()) < 1 {
-		err := UpdateServiceRequestValidationError{
-			field:  "Name",
-			reason: "value length must be at least 1 runes",
-		}
-		if !all {
-			return err
-		}
-		errors = append(errors, err)
-	}
-
 	if utf8.RuneCountInString(m.GetOrganizationName()) < 1 {
-		err := UpdateServiceRequestValidationError{
+		err := ListServicesRequestValidationError{
 			field:  "OrganizationName",
 			reason: "value length must be at least 1 runes",
 		}
@@ -3044,24 +3043,20 @@ func (m *UpdateServiceRequest) validate(all bool) error {
 		errors = append(errors, err)
 	}
 
-	if m.NewName != nil {
-		// no validation rules for NewName
-	}
-
 	if len(errors) > 0 {
-		return UpdateServiceRequestMultiError(errors)
+		return ListServicesRequestMultiError(errors)
 	}
 
 	return nil
 }
 
-// UpdateServiceRequestMultiError is an error wrapping multiple validation
-// errors returned by UpdateServiceRequest.ValidateAll() if the designated
+// ListServicesRequestMultiError is an error wrapping multiple validation
+// errors returned by ListServicesRequest.ValidateAll() if the designated
 // constraints aren't met.
-type UpdateServiceRequestMultiError []error
+type ListServicesRequestMultiError []error
 
 // Error returns a concatenation of all the error messages it wraps.
-func (m UpdateServiceRequestMultiError) Error() string {
+func (m ListServicesRequestMultiError) Error() string {
 	var msgs []string
 	for _, err := range m {
 		msgs = append(msgs, err.Error())
@@ -3070,11 +3065,11 @@ func (m UpdateServiceRequestMultiError) Error() string {
 }
 
 // AllErrors returns a list of validation violation errors.
-func (m UpdateServiceRequestMultiError) AllErrors() []error { return m }
+func (m ListServicesRequestMultiError) AllErrors() []error { return m }
 
-// UpdateServiceRequestValidationError is the validation error returned by
-// UpdateServiceRequest.Validate if the designated constraints aren't met.
-type UpdateServiceRequestValidationError struct {
+// ListServicesRequestValidationError is the validation error returned by
+// ListServicesRequest.Validate if the designated constraints aren't met.
+type ListServicesRequestValidationError struct {
 	field  string
 	reason string
 	cause  error
@@ -3082,24 +3077,24 @@ type UpdateServiceRequestValidationError struct {
 }
 
 // Field function returns field value.
-func (e UpdateServiceRequestValidationError) Field() string { return e.field }
+func (e ListServicesRequestValidationError) Field() string { return e.field }
 
 // Reason function returns reason value.
-func (e UpdateServiceRequestValidationError) Reason() string { return e.reason }
+func (e ListServicesRequestValidationError) Reason() string { return e.reason }
 
 // Cause function returns cause value.
-func (e UpdateServiceRequestValidationError) Cause() error { return e.cause }
+func (e ListServicesRequestValidationError) Cause() error { return e.cause }
 
 // Key function returns key value.
-func (e UpdateServiceRequestValidationError) Key() bool { return e.key }
+func (e ListServicesRequestValidationError) Key() bool { return e.key }
 
 // ErrorName returns error name.
-func (e UpdateServiceRequestValidationError) ErrorName() string {
-	return "UpdateServiceRequestValidationError"
+func (e ListServicesRequestValidationError) ErrorName() string {
+	return "ListServicesRequestValidationError"
 }
 
 // Error satisfies the builtin error interface
-func (e UpdateServiceRequestValidationError) Error() string {
+func (e ListServicesRequestValidationError) Error() string {
 	cause := ""
 	if e.cause != nil {
 		cause = fmt.Sprintf(" | caused by: %v", e.cause)
@@ -3111,14 +3106,14 @@ func (e UpdateServiceRequestValidationError) Error() string {
 	}
 
 	return fmt.Sprintf(
-		"invalid %sUpdateServiceRequest.%s: %s%s",
+		"invalid %sListServicesRequest.%s: %s%s",
 		key,
 		e.field,
 		e.reason,
 		cause)
 }
 
-var _ error = UpdateServiceRequestValidationError{}
+var _ error = ListServicesRequestValidationError{}
 
 var _ interface {
 	Field() string
@@ -3126,73 +3121,78 @@ var _ interface {
 	Key() bool
 	Cause() error
 	ErrorName() string
-} = UpdateServiceRequestValidationError{}
+} = ListServicesRequestValidationError{}
 
-// Validate checks the field values on UpdateServiceResponse with the rules
+// Validate checks the field values on ListServicesResponse with the rules
 // defined in the proto definition for this message. If any rules are
 // violated, the first error encountered is returned, or nil if there are no violations.
-func (m *UpdateServiceResponse) Validate() error {
+func (m *ListServicesResponse) Validate() error {
 	return m.validate(false)
 }
 
-// ValidateAll checks the field values on UpdateServiceResponse with the rules
+// ValidateAll checks the field values on ListServicesResponse with the rules
 // defined in the proto definition for this message. If any rules are
 // violated, the result is a list of violation errors wrapped in
-// UpdateServiceResponseMultiError, or nil if none found.
-func (m *UpdateServiceResponse) ValidateAll() error {
+// ListServicesResponseMultiError, or nil if none found.
+func (m *ListServicesResponse) ValidateAll() error {
 	return m.validate(true)
 }
 
-func (m *UpdateServiceResponse) validate(all bool) error {
+func (m *ListServicesResponse) validate(all bool) error {
 	if m == nil {
 		return nil
 	}
 
 	var errors []error
 
-	if all {
-		switch v := interface{}(m.GetService()).(type) {
-		case interface{ ValidateAll() error }:
-			if err := v.ValidateAll(); err != nil {
-				errors = append(errors, UpdateServiceResponseValidationError{
-					field:  "Service",
-					reason: "embedded message failed validation",
-					cause:  err,
-				})
+	for idx, item := range m.GetServices() {
+		_, _ = idx, item
+
+		if all {
+			switch v := interface{}(item).(type) {
+			case interface{ ValidateAll() error }:
+				if err := v.ValidateAll(); err != nil {
+					errors = append(errors, ListServicesResponseValidationError{
+						field:  fmt.Sprintf("Services[%v]", idx),
+						reason: "embedded message failed validation",
+						cause:  err,
+					})
+				}
+			case interface{ Validate() error }:
+				if err := v.Validate(); err != nil {
+					errors = append(errors, ListServicesResponseValidationError{
+						field:  fmt.Sprintf("Services[%v]", idx),
+						reason: "embedded message failed validation",
+						cause:  err,
+					})
+				}
 			}
-		case interface{ Validate() error }:
+		} else if v, ok := interface{}(item).(interface{ Validate() error }); ok {
 			if err := v.Validate(); err != nil {
-				errors = append(errors, UpdateServiceResponseValidationError{
-					field:  "Service",
+				return ListServicesResponseValidationError{
+					field:  fmt.Sprintf("Services[%v]", idx),
 					reason: "embedded message failed validation",
 					cause:  err,
-				})
-			}
-		}
-	} else if v, ok := interface{}(m.GetService()).(interface{ Validate() error }); ok {
-		if err := v.Validate(); err != nil {
-			return UpdateServiceResponseValidationError{
-				field:  "Service",
-				reason: "embedded message failed validation",
-				cause:  err,
+				}
 			}
 		}
+
 	}
 
 	if len(errors) > 0 {
-		return UpdateServiceResponseMultiError(errors)
+		return ListServicesResponseMultiError(errors)
 	}
 
 	return nil
 }
 
-// UpdateServiceResponseMultiError is an error wrapping multiple validation
-// errors returned by UpdateServiceResponse.ValidateAll() if the designated
+// ListServicesResponseMultiError is an error wrapping multiple validation
+// errors returned by ListServicesResponse.ValidateAll() if the designated
 // constraints aren't met.
-type UpdateServiceResponseMultiError []error
+type ListServicesResponseMultiError []error
 
 // Error returns a concatenation of all the error messages it wraps.
-func (m UpdateServiceResponseMultiError) Error() string {
+func (m ListServicesResponseMultiError) Error() string {
 	var msgs []string
 	for _, err := range m {
 		msgs = append(msgs, err.Error())
@@ -3201,11 +3201,11 @@ func (m UpdateServiceResponseMultiError) Error() string {
 }
 
 // AllErrors returns a list of validation violation errors.
-func (m UpdateServiceResponseMultiError) AllErrors() []error { return m }
+func (m ListServicesResponseMultiError) AllErrors() []error { return m }
 
-// UpdateServiceResponseValidationError is the validation error returned by
-// UpdateServiceResponse.Validate if the designated constraints aren't met.
-type UpdateServiceResponseValidationError struct {
+// ListServicesResponseValidationError is the validation error returned by
+// ListServicesResponse.Validate if the designated constraints aren't met.
+type ListServicesResponseValidationError struct {
 	field  string
 	reason string
 	cause  error
@@ -3213,24 +3213,24 @@ type UpdateServiceResponseValidationError struct {
 }
 
 // Field function returns field value.
-func (e UpdateServiceResponseValidationError) Field() string { return e.field }
+func (e ListServicesResponseValidationError) Field() string { return e.field }
 
 // Reason function returns reason value.
-func (e UpdateServiceResponseValidationError) Reason() string { return e.reason }
+func (e ListServicesResponseValidationError) Reason() string { return e.reason }
 
 // Cause function returns cause value.
-func (e UpdateServiceResponseValidationError) Cause() error { return e.cause }
+func (e ListServicesResponseValidationError) Cause() error { return e.cause }
 
 // Key function returns key value.
-func (e UpdateServiceResponseValidationError) Key() bool { return e.key }
+func (e ListServicesResponseValidationError) Key() bool { return e.key }
 
 // ErrorName returns error name.
-func (e UpdateServiceResponseValidationError) ErrorName() string {
-	return "UpdateServiceResponseValidationError"
+func (e ListServicesResponseValidationError) ErrorName() string {
+	return "ListServicesResponseValidationError"
 }
 
 // Error satisfies the builtin error interface
-func (e UpdateServiceResponseValidationError) Error() string {
+func (e ListServicesResponseValidationError) Error() string {
 	cause := ""
 	if e.cause != nil {
 		cause = fmt.Sprintf(" | caused by: %v", e.cause)
@@ -3242,14 +3242,14 @@ func (e UpdateServiceResponseValidationError) Error() string {
 	}
 
 	return fmt.Sprintf(
-		"invalid %sUpdateServiceResponse.%s: %s%s",
+		"invalid %sListServicesResponse.%s: %s%s",
 		key,
 		e.field,
 		e.reason,
 		cause)
 }
 
-var _ error = UpdateServiceResponseValidationError{}
+var _ error = ListServicesResponseValidationError{}
 
 var _ interface {
 	Field() string
@@ -3257,24 +3257,24 @@ var _ interface {
 	Key() bool
 	Cause() error
 	ErrorName() string
-} = UpdateServiceResponseValidationError{}
+} = ListServicesResponseValidationError{}
 
-// Validate checks the field values on DeleteServiceRequest with the rules
+// Validate checks the field values on CreateServiceRequest with the rules
 // defined in the proto definition for this message. If any rules are
 // violated, the first error encountered is returned, or nil if there are no violations.
-func (m *DeleteServiceRequest) Validate() error {
+func (m *CreateServiceRequest) Validate() error {
 	return m.validate(false)
 }
 
-// ValidateAll checks the field values on DeleteServiceRequest with the rules
+// ValidateAll checks the field values on CreateServiceRequest with the rules
 // defined in the proto definition for this message. If any rules are
 // violated, the result is a list of violation errors wrapped in
-// DeleteServiceRequestMultiError, or nil if none found.
-func (m *DeleteServiceRequest) ValidateAll() error {
+// CreateServiceRequestMultiError, or nil if none found.
+func (m *CreateServiceRequest) ValidateAll() error {
 	return m.validate(true)
 }
 
-func (m *DeleteServiceRequest) validate(all bool) error {
+func (m *CreateServiceRequest) validate(all bool) error {
 	if m == nil {
 		return nil
 	}
@@ -3282,7 +3282,7 @@ func (m *DeleteServiceRequest) validate(all bool) error {
 	var errors []error
 
 	if utf8.RuneCountInString(m.GetName()) < 1 {
-		err := DeleteServiceRequestValidationError{
+		err := CreateServiceRequestValidationError{
 			field:  "Name",
 			reason: "value length must be at least 1 runes",
 		}
@@ -3293,7 +3293,7 @@ func (m *DeleteServiceRequest) validate(all bool) error {
 	}
 
 	if utf8.RuneCountInString(m.GetOrganizationName()) < 1 {
-		err := DeleteServiceRequestValidationError{
+		err := CreateServiceRequestValidationError{
 			field:  "OrganizationName",
 			reason: "value length must be at least 1 runes",
 		}
@@ -3304,19 +3304,19 @@ func (m *DeleteServiceRequest) validate(all bool) error {
 	}
 
 	if len(errors) > 0 {
-		return DeleteServiceRequestMultiError(errors)
+		return CreateServiceRequestMultiError(errors)
 	}
 
 	return nil
 }
 
-// DeleteServiceRequestMultiError is an error wrapping multiple validation
-// errors returned by DeleteServiceRequest.ValidateAll() if the designated
+// CreateServiceRequestMultiError is an error wrapping multiple validation
+// errors returned by CreateServiceRequest.ValidateAll() if the designated
 // constraints aren't met.
-type DeleteServiceRequestMultiError []error
+type CreateServiceRequestMultiError []error
 
 // Error returns a concatenation of all the error messages it wraps.
-func (m DeleteServiceRequestMultiError) Error() string {
+func (m CreateServiceRequestMultiError) Error() string {
 	var msgs []string
 	for _, err := range m {
 		msgs = append(msgs, err.Error())
@@ -3325,11 +3325,11 @@ func (m DeleteServiceRequestMultiError) Error() string {
 }
 
 // AllErrors returns a list of validation violation errors.
-func (m DeleteServiceRequestMultiError) AllErrors() []error { return m }
+func (m CreateServiceRequestMultiError) AllErrors() []error { return m }
 
-// DeleteServiceRequestValidationError is the validation error returned by
-// DeleteServiceRequest.Validate if the designated constraints aren't met.
-type DeleteServiceRequestValidationError struct {
+// CreateServiceRequestValidationError is the validation error returned by
+// CreateServiceRequest.Validate if the designated constraints aren't met.
+type CreateServiceRequestValidationError struct {
 	field  string
 	reason string
 	cause  error
@@ -3337,24 +3337,24 @@ type DeleteServiceRequestValidationError struct {
 }
 
 // Field function returns field value.
-func (e DeleteServiceRequestValidationError) Field() string { return e.field }
+func (e CreateServiceRequestValidationError) Field() string { return e.field }
 
 // Reason function returns reason value.
-func (e DeleteServiceRequestValidationError) Reason() string { return e.reason }
+func (e CreateServiceRequestValidationError) Reason() string { return e.reason }
 
 // Cause function returns cause value.
-func (e DeleteServiceRequestValidationError) Cause() error { return e.cause }
+func (e CreateServiceRequestValidationError) Cause() error { return e.cause }
 
 // Key function returns key value.
-func (e DeleteServiceRequestValidationError) Key() bool { return e.key }
+func (e CreateServiceRequestValidationError) Key() bool { return e.key }
 
 // ErrorName returns error name.
-func (e DeleteServiceRequestValidationError) ErrorName() string {
-	return "DeleteServiceRequestValidationError"
+func (e CreateServiceRequestValidationError) ErrorName() string {
+	return "CreateServiceRequestValidationError"
 }
 
 // Error satisfies the builtin error interface
-func (e DeleteServiceRequestValidationError) Error() string {
+func (e CreateServiceRequestValidationError) Error() string {
 	cause := ""
 	if e.cause != nil {
 		cause = fmt.Sprintf(" | caused by: %v", e.cause)
@@ -3366,14 +3366,14 @@ func (e DeleteServiceRequestValidationError) Error() string {
 	}
 
 	return fmt.Sprintf(
-		"invalid %sDeleteServiceRequest.%s: %s%s",
+		"invalid %sCreateServiceRequest.%s: %s%s",
 		key,
 		e.field,
 		e.reason,
 		cause)
 }
 
-var _ error = DeleteServiceRequestValidationError{}
+var _ error = CreateServiceRequestValidationError{}
 
 var _ interface {
 	Field() string
@@ -3381,24 +3381,24 @@ var _ interface {
 	Key() bool
 	Cause() error
 	ErrorName() string
-} = DeleteServiceRequestValidationError{}
+} = CreateServiceRequestValidationError{}
 
-// Validate checks the field values on DeleteServiceResponse with the rules
+// Validate checks the field values on CreateServiceResponse with the rules
 // defined in the proto definition for this message. If any rules are
 // violated, the first error encountered is returned, or nil if there are no violations.
-func (m *DeleteServiceResponse) Validate() error {
+func (m *CreateServiceResponse) Validate() error {
 	return m.validate(false)
 }
 
-// ValidateAll checks the field values on DeleteServiceResponse with the rules
+// ValidateAll checks the field values on CreateServiceResponse with the rules
 // defined in the proto definition for this message. If any rules are
 // violated, the result is a list of violation errors wrapped in
-// DeleteServiceResponseMultiError, or nil if none found.
-func (m *DeleteServiceResponse) ValidateAll() error {
+// CreateServiceResponseMultiError, or nil if none found.
+func (m *CreateServiceResponse) ValidateAll() error {
 	return m.validate(true)
 }
 
-func (m *DeleteServiceResponse) validate(all bool) error {
+func (m *CreateServiceResponse) validate(all bool) error {
 	if m == nil {
 		return nil
 	}
@@ -3409,7 +3409,7 @@ func (m *DeleteServiceResponse) validate(all bool) error {
 		switch v := interface{}(m.GetService()).(type) {
 		case interface{ ValidateAll() error }:
 			if err := v.ValidateAll(); err != nil {
-				errors = append(errors, DeleteServiceResponseValidationError{
+				errors = append(errors, CreateServiceResponseValidationError{
 					field:  "Service",
 					reason: "embedded message failed validation",
 					cause:  err,
@@ -3417,7 +3417,7 @@ func (m *DeleteServiceResponse) validate(all bool) error {
 			}
 		case interface{ Validate() error }:
 			if err := v.Validate(); err != nil {
-				errors = append(errors, DeleteServiceResponseValidationError{
+				errors = append(errors, CreateServiceResponseValidationError{
 					field:  "Service",
 					reason: "embedded message failed validation",
 					cause:  err,
@@ -3426,7 +3426,7 @@ func (m *DeleteServiceResponse) validate(all bool) error {
 		}
 	} else if v, ok := interface{}(m.GetService()).(interface{ Validate() error }); ok {
 		if err := v.Validate(); err != nil {
-			return DeleteServiceResponseValidationError{
+			return CreateServiceResponseValidationError{
 				field:  "Service",
 				reason: "embedded message failed validation",
 				cause:  err,
@@ -3435,19 +3435,19 @@ func (m *DeleteServiceResponse) validate(all bool) error {
 	}
 
 	if len(errors) > 0 {
-		return DeleteServiceResponseMultiError(errors)
+		return CreateServiceResponseMultiError(errors)
 	}
 
 	return nil
 }
 
-// DeleteServiceResponseMultiError is an error wrapping multiple validation
-// errors returned by DeleteServiceResponse.ValidateAll() if the designated
+// CreateServiceResponseMultiError is an error wrapping multiple validation
+// errors returned by CreateServiceResponse.ValidateAll() if the designated
 // constraints aren't met.
-type DeleteServiceResponseMultiError []error
+type CreateServiceResponseMultiError []error
 
 // Error returns a concatenation of all the error messages it wraps.
-func (m DeleteServiceResponseMultiError) Error() string {
+func (m CreateServiceResponseMultiError) Error() string {
 	var msgs []string
 	for _, err := range m {
 		msgs = append(msgs, err.Error())
@@ -3456,11 +3456,11 @@ func (m DeleteServiceResponseMultiError) Error() string {
 }
 
 // AllErrors returns a list of validation violation errors.
-func (m DeleteServiceResponseMultiError) AllErrors() []error { return m }
+func (m CreateServiceResponseMultiError) AllErrors() []error { return m }
 
-// DeleteServiceResponseValidationError is the validation error returned by
-// DeleteServiceResponse.Validate if the designated constraints aren't met.
-type DeleteServiceResponseValidationError struct {
+// CreateServiceResponseValidationError is the validation error returned by
+// CreateServiceResponse.Validate if the designated constraints aren't met.
+type CreateServiceResponseValidationError struct {
 	field  string
 	reason string
 	cause  error
@@ -3468,24 +3468,24 @@ type DeleteServiceResponseValidationError struct {
 }
 
 // Field function returns field value.
-func (e DeleteServiceResponseValidationError) Field() string { return e.field }
+func (e CreateServiceResponseValidationError) Field() string { return e.field }
 
 // Reason function returns reason value.
-func (e DeleteServiceResponseValidationError) Reason() string { return e.reason }
+func (e CreateServiceResponseValidationError) Reason() string { return e.reason }
 
 // Cause function returns cause value.
-func (e DeleteServiceResponseValidationError) Cause() error { return e.cause }
+func (e CreateServiceResponseValidationError) Cause() error { return e.cause }
 
 // Key function returns key value.
-func (e DeleteServiceResponseValidationError) Key() bool { return e.key }
+func (e CreateServiceResponseValidationError) Key() bool { return e.key }
 
 // ErrorName returns error name.
-func (e DeleteServiceResponseValidationError) ErrorName() string {
-	return "DeleteServiceResponseValidationError"
+func (e CreateServiceResponseValidationError) ErrorName() string {
+	return "CreateServiceResponseValidationError"
 }
 
 // Error satisfies the builtin error interface
-func (e DeleteServiceResponseValidationError) Error() string {
+func (e CreateServiceResponseValidationError) Error() string {
 	cause := ""
 	if e.cause != nil {
 		cause = fmt.Sprintf(" | caused by: %v", e.cause)
@@ -3497,14 +3497,14 @@ func (e DeleteServiceResponseValidationError) Error() string {
 	}
 
 	return fmt.Sprintf(
-		"invalid %sDeleteServiceResponse.%s: %s%s",
+		"invalid %sCreateServiceResponse.%s: %s%s",
 		key,
 		e.field,
 		e.reason,
 		cause)
 }
 
-var _ error = DeleteServiceResponseValidationError{}
+var _ error = CreateServiceResponseValidationError{}
 
 var _ interface {
 	Field() string
@@ -3512,33 +3512,33 @@ var _ interface {
 	Key() bool
 	Cause() error
 	ErrorName() string
-} = DeleteServiceResponseValidationError{}
+} = CreateServiceResponseValidationError{}
 
-// Validate checks the field values on CreateProjectRequest with the rules
+// Validate checks the field values on UpdateServiceRequest with the rules
 // defined in the proto definition for this message. If any rules are
 // violated, the first error encountered is returned, or nil if there are no violations.
-func (m *CreateProjectRequest) Validate() error {
+func (m *UpdateServiceRequest) Validate() error {
 	return m.validate(false)
 }
 
-// ValidateAll checks the field values on CreateProjectRequest with the rules
+// ValidateAll checks the field values on UpdateServiceRequest with the rules
 // defined in the proto definition for this message. If any rules are
 // violated, the result is a list of violation errors wrapped in
-// CreateProjectRequestMultiError, or nil if none found.
-func (m *CreateProjectRequest) ValidateAll() error {
+// UpdateServiceRequestMultiError, or nil if none found.
+func (m *UpdateServiceRequest) ValidateAll() error {
 	return m.validate(true)
 }
 
-func (m *CreateProjectRequest) validate(all bool) error {
+func (m *UpdateServiceRequest) validate(all bool) error {
 	if m == nil {
 		return nil
 	}
 
 	var errors []error
 
-	if utf8.RuneCountInString(m.GetOrganizationName()) < 1 {
-		err := CreateProjectRequestValidationError{
-			field:  "OrganizationName",
+	if utf8.RuneCountInString(m.GetName()) < 1 {
+		err := UpdateServiceRequestValidationError{
+			field:  "Name",
 			reason: "value length must be at least 1 runes",
 		}
 		if !all {
@@ -3547,9 +3547,9 @@ func (m *CreateProjectRequest) validate(all bool) error {
 		errors = append(errors, err)
 	}
 
-	if utf8.RuneCountInString(m.GetName()) < 1 {
-		err := CreateProjectRequestValidationError{
-			field:  "Name",
+	if utf8.RuneCountInString(m.GetOrganizationName()) < 1 {
+		err := UpdateServiceRequestValidationError{
+			field:  "OrganizationName",
 			reason: "value length must be at least 1 runes",
 		}
 		if !all {
@@ -3558,40 +3558,24 @@ func (m *CreateProjectRequest) validate(all bool) error {
 		errors = append(errors, err)
 	}
 
-	// no validation rules for Description
-
-	// no validation rules for Public
-
-	// no validation rules for Region
-
-	// no validation rules for ProdOlapDriver
-
-	// no validation rules for ProdOlapDsn
-
-	// no validation rules for ProdSlots
-
-	// no validation rules for Subpath
-
-	// no validation rules for ProdBranch
-
-	// no validation rules for GithubUrl
-
-	// no validation rules for Variables
+	if m.NewName != nil {
+		// no validation rules for NewName
+	}
 
 	if len(errors) > 0 {
-		return CreateProjectRequestMultiError(errors)
+		return UpdateServiceRequestMultiError(errors)
 	}
 
 	return nil
 }
 
-// CreateProjectRequestMultiError is an error wrapping multiple validation
-// errors returned by CreateProjectRequest.ValidateAll() if the designated
+// UpdateServiceRequestMultiError is an error wrapping multiple validation
+// errors returned by UpdateServiceRequest.ValidateAll() if the designated
 // constraints aren't met.
-type CreateProjectRequestMultiError []error
+type UpdateServiceRequestMultiError []error
 
 // Error returns a concatenation of all the error messages it wraps.
-func (m CreateProjectRequestMultiError) Error() string {
+func (m UpdateServiceRequestMultiError) Error() string {
 	var msgs []string
 	for _, err := range m {
 		msgs = append(msgs, err.Error())
@@ -3600,11 +3584,11 @@ func (m CreateProjectRequestMultiError) Error() string {
 }
 
 // AllErrors returns a list of validation violation errors.
-func (m CreateProjectRequestMultiError) AllErrors() []error { return m }
+func (m UpdateServiceRequestMultiError) AllErrors() []error { return m }
 
-// CreateProjectRequestValidationError is the validation error returned by
-// CreateProjectRequest.Validate if the designated constraints aren't met.
-type CreateProjectRequestValidationError struct {
+// UpdateServiceRequestValidationError is the validation error returned by
+// UpdateServiceRequest.Validate if the designated constraints aren't met.
+type UpdateServiceRequestValidationError struct {
 	field  string
 	reason string
 	cause  error
@@ -3612,24 +3596,24 @@ type CreateProjectRequestValidationError struct {
 }
 
 // Field function returns field value.
-func (e CreateProjectRequestValidationError) Field() string { return e.field }
+func (e UpdateServiceRequestValidationError) Field() string { return e.field }
 
 // Reason function returns reason value.
-func (e CreateProjectRequestValidationError) Reason() string { return e.reason }
+func (e UpdateServiceRequestValidationError) Reason() string { return e.reason }
 
 // Cause function returns cause value.
-func (e CreateProjectRequestValidationError) Cause() error { return e.cause }
+func (e UpdateServiceRequestValidationError) Cause() error { return e.cause }
 
 // Key function returns key value.
-func (e CreateProjectRequestValidationError) Key() bool { return e.key }
+func (e UpdateServiceRequestValidationError) Key() bool { return e.key }
 
 // ErrorName returns error name.
-func (e CreateProjectRequestValidationError) ErrorName() string {
-	return "CreateProjectRequestValidationError"
+func (e UpdateServiceRequestValidationError) ErrorName() string {
+	return "UpdateServiceRequestValidationError"
 }
 
 // Error satisfies the builtin error interface
-func (e CreateProjectRequestValidationError) Error() string {
+func (e UpdateServiceRequestValidationError) Error() string {
 	cause := ""
 	if e.cause != nil {
 		cause = fmt.Sprintf(" | caused by: %v", e.cause)
@@ -3641,14 +3625,14 @@ func (e CreateProjectRequestValidationError) Error() string {
 	}
 
 	return fmt.Sprintf(
-		"invalid %sCreateProjectRequest.%s: %s%s",
+		"invalid %sUpdateServiceRequest.%s: %s%s",
 		key,
 		e.field,
 		e.reason,
 		cause)
 }
 
-var _ error = CreateProjectRequestValidationError{}
+var _ error = UpdateServiceRequestValidationError{}
 
 var _ interface {
 	Field() string
@@ -3656,24 +3640,24 @@ var _ interface {
 	Key() bool
 	Cause() error
 	ErrorName() string
-} = CreateProjectRequestValidationError{}
+} = UpdateServiceRequestValidationError{}
 
-// Validate checks the field values on CreateProjectResponse with the rules
+// Validate checks the field values on UpdateServiceResponse with the rules
 // defined in the proto definition for this message. If any rules are
 // violated, the first error encountered is returned, or nil if there are no violations.
-func (m *CreateProjectResponse) Validate() error {
+func (m *UpdateServiceResponse) Validate() error {
 	return m.validate(false)
 }
 
-// ValidateAll checks the field values on CreateProjectResponse with the rules
+// ValidateAll checks the field values on UpdateServiceResponse with the rules
 // defined in the proto definition for this message. If any rules are
 // violated, the result is a list of violation errors wrapped in
-// CreateProjectResponseMultiError, or nil if none found.
-func (m *CreateProjectResponse) ValidateAll() error {
+// UpdateServiceResponseMultiError, or nil if none found.
+func (m *UpdateServiceResponse) ValidateAll() error {
 	return m.validate(true)
 }
 
-func (m *CreateProjectResponse) validate(all bool) error {
+func (m *UpdateServiceResponse) validate(all bool) error {
 	if m == nil {
 		return nil
 	}
@@ -3681,28 +3665,28 @@ func (m *CreateProjectResponse) validate(all bool) error {
 	var errors []error
 
 	if all {
-		switch v := interface{}(m.GetProject()).(type) {
+		switch v := interface{}(m.GetService()).(type) {
 		case interface{ ValidateAll() error }:
 			if err := v.ValidateAll(); err != nil {
-				errors = append(errors, CreateProjectResponseValidationError{
-					field:  "Project",
+				errors = append(errors, UpdateServiceResponseValidationError{
+					field:  "Service",
 					reason: "embedded message failed validation",
 					cause:  err,
 				})
 			}
 		case interface{ Validate() error }:
 			if err := v.Validate(); err != nil {
-				errors = append(errors, CreateProjectResponseValidationError{
-					field:  "Project",
+				errors = append(errors, UpdateServiceResponseValidationError{
+					field:  "Service",
 					reason: "embedded message failed validation",
 					cause:  err,
 				})
 			}
 		}
-	} else if v, ok := interface{}(m.GetProject()).(interface{ Validate() error }); ok {
+	} else if v, ok := interface{}(m.GetService()).(interface{ Validate() error }); ok {
 		if err := v.Validate(); err != nil {
-			return CreateProjectResponseValidationError{
-				field:  "Project",
+			return UpdateServiceResponseValidationError{
+				field:  "Service",
 				reason: "embedded message failed validation",
 				cause:  err,
 			}
@@ -3710,19 +3694,19 @@ func (m *CreateProjectResponse) validate(all bool) error {
 	}
 
 	if len(errors) > 0 {
-		return CreateProjectResponseMultiError(errors)
+		return UpdateServiceResponseMultiError(errors)
 	}
 
 	return nil
 }
 
-// CreateProjectResponseMultiError is an error wrapping multiple validation
-// errors returned by CreateProjectResponse.ValidateAll() if the designated
+// UpdateServiceResponseMultiError is an error wrapping multiple validation
+// errors returned by UpdateServiceResponse.ValidateAll() if the designated
 // constraints aren't met.
-type CreateProjectResponseMultiError []error
+type UpdateServiceResponseMultiError []error
 
 // Error returns a concatenation of all the error messages it wraps.
-func (m CreateProjectResponseMultiError) Error() string {
+func (m UpdateServiceResponseMultiError) Error() string {
 	var msgs []string
 	for _, err := range m {
 		msgs = append(msgs, err.Error())
@@ -3731,11 +3715,11 @@ func (m CreateProjectResponseMultiError) Error() string {
 }
 
 // AllErrors returns a list of validation violation errors.
-func (m CreateProjectResponseMultiError) AllErrors() []error { return m }
+func (m UpdateServiceResponseMultiError) AllErrors() []error { return m }
 
-// CreateProjectResponseValidationError is the validation error returned by
-// CreateProjectResponse.Validate if the designated constraints aren't met.
-type CreateProjectResponseValidationError struct {
+// UpdateServiceResponseValidationError is the validation error returned by
+// UpdateServiceResponse.Validate if the designated constraints aren't met.
+type UpdateServiceResponseValidationError struct {
 	field  string
 	reason string
 	cause  error
@@ -3743,24 +3727,24 @@ type CreateProjectResponseValidationError struct {
 }
 
 // Field function returns field value.
-func (e CreateProjectResponseValidationError) Field() string { return e.field }
+func (e UpdateServiceResponseValidationError) Field() string { return e.field }
 
 // Reason function returns reason value.
-func (e CreateProjectResponseValidationError) Reason() string { return e.reason }
+func (e UpdateServiceResponseValidationError) Reason() string { return e.reason }
 
 // Cause function returns cause value.
-func (e CreateProjectResponseValidationError) Cause() error { return e.cause }
+func (e UpdateServiceResponseValidationError) Cause() error { return e.cause }
 
 // Key function returns key value.
-func (e CreateProjectResponseValidationError) Key() bool { return e.key }
+func (e UpdateServiceResponseValidationError) Key() bool { return e.key }
 
 // ErrorName returns error name.
-func (e CreateProjectResponseValidationError) ErrorName() string {
-	return "CreateProjectResponseValidationError"
+func (e UpdateServiceResponseValidationError) ErrorName() string {
+	return "UpdateServiceResponseValidationError"
 }
 
 // Error satisfies the builtin error interface
-func (e CreateProjectResponseValidationError) Error() string {
+func (e UpdateServiceResponseValidationError) Error() string {
 	cause := ""
 	if e.cause != nil {
 		cause = fmt.Sprintf(" | caused by: %v", e.cause)
@@ -3772,14 +3756,14 @@ func (e CreateProjectResponseValidationError) Error() string {
 	}
 
 	return fmt.Sprintf(
-		"invalid %sCreateProjectResponse.%s: %s%s",
+		"invalid %sUpdateServiceResponse.%s: %s%s",
 		key,
 		e.field,
 		e.reason,
 		cause)
 }
 
-var _ error = CreateProjectResponseValidationError{}
+var _ error = UpdateServiceResponseValidationError{}
 
 var _ interface {
 	Field() string
@@ -3787,48 +3771,66 @@ var _ interface {
 	Key() bool
 	Cause() error
 	ErrorName() string
-} = CreateProjectResponseValidationError{}
+} = UpdateServiceResponseValidationError{}
 
-// Validate checks the field values on DeleteProjectRequest with the rules
+// Validate checks the field values on DeleteServiceRequest with the rules
 // defined in the proto definition for this message. If any rules are
 // violated, the first error encountered is returned, or nil if there are no violations.
-func (m *DeleteProjectRequest) Validate() error {
+func (m *DeleteServiceRequest) Validate() error {
 	return m.validate(false)
 }
 
-// ValidateAll checks the field values on DeleteProjectRequest with the rules
+// ValidateAll checks the field values on DeleteServiceRequest with the rules
 // defined in the proto definition for this message. If any rules are
 // violated, the result is a list of violation errors wrapped in
-// DeleteProjectRequestMultiError, or nil if none found.
-func (m *DeleteProjectRequest) ValidateAll() error {
+// DeleteServiceRequestMultiError, or nil if none found.
+func (m *DeleteServiceRequest) ValidateAll() error {
 	return m.validate(true)
 }
 
-func (m *DeleteProjectRequest) validate(all bool) error {
+func (m *DeleteServiceRequest) validate(all bool) error {
 	if m == nil {
 		return nil
 	}
 
 	var errors []error
 
-	// no validation rules for OrganizationName
+	if utf8.RuneCountInString(m.GetName()) < 1 {
+		err := DeleteServiceRequestValidationError{
+			field:  "Name",
+			reason: "value length must be at least 1 runes",
+		}
+		if !all {
+			return err
+		}
+		errors = append(errors, err)
+	}
 
-	// no validation rules for Name
+	if utf8.RuneCountInString(m.GetOrganizationName()) < 1 {
+		err := DeleteServiceRequestValidationError{
+			field:  "OrganizationName",
+			reason: "value length must be at least 1 runes",
+		}
+		if !all {
+			return err
+		}
+		errors = append(errors, err)
+	}
 
 	if len(errors) > 0 {
-		return DeleteProjectRequestMultiError(errors)
+		return DeleteServiceRequestMultiError(errors)
 	}
 
 	return nil
 }
 
-// DeleteProjectRequestMultiError is an error wrapping multiple validation
-// errors returned by DeleteProjectRequest.ValidateAll() if the designated
+// DeleteServiceRequestMultiError is an error wrapping multiple validation
+// errors returned by DeleteServiceRequest.ValidateAll() if the designated
 // constraints aren't met.
-type DeleteProjectRequestMultiError []error
+type DeleteServiceRequestMultiError []error
 
 // Error returns a concatenation of all the error messages it wraps.
-func (m DeleteProjectRequestMultiError) Error() string {
+func (m DeleteServiceRequestMultiError) Error() string {
 	var msgs []string
 	for _, err := range m {
 		msgs = append(msgs, err.Error())
@@ -3837,11 +3839,11 @@ func (m DeleteProjectRequestMultiError) Error() string {
 }
 
 // AllErrors returns a list of validation violation errors.
-func (m DeleteProjectRequestMultiError) AllErrors() []error { return m }
+func (m DeleteServiceRequestMultiError) AllErrors() []error { return m }
 
-// DeleteProjectRequestValidationError is the validation error returned by
-// DeleteProjectRequest.Validate if the designated constraints aren't met.
-type DeleteProjectRequestValidationError struct {
+// DeleteServiceRequestValidationError is the validation error returned by
+// DeleteServiceRequest.Validate if the designated constraints aren't met.
+type DeleteServiceRequestValidationError struct {
 	field  string
 	reason string
 	cause  error
@@ -3849,24 +3851,24 @@ type DeleteProjectRequestValidationError struct {
 }
 
 // Field function returns field value.
-func (e DeleteProjectRequestValidationError) Field() string { return e.field }
+func (e DeleteServiceRequestValidationError) Field() string { return e.field }
 
 // Reason function returns reason value.
-func (e DeleteProjectRequestValidationError) Reason() string { return e.reason }
+func (e DeleteServiceRequestValidationError) Reason() string { return e.reason }
 
 // Cause function returns cause value.
-func (e DeleteProjectRequestValidationError) Cause() error { return e.cause }
+func (e DeleteServiceRequestValidationError) Cause() error { return e.cause }
 
 // Key function returns key value.
-func (e DeleteProjectRequestValidationError) Key() bool { return e.key }
+func (e DeleteServiceRequestValidationError) Key() bool { return e.key }
 
 // ErrorName returns error name.
-func (e DeleteProjectRequestValidationError) ErrorName() string {
-	return "DeleteProjectRequestValidationError"
+func (e DeleteServiceRequestValidationError) ErrorName() string {
+	return "DeleteServiceRequestValidationError"
 }
 
 // Error satisfies the builtin error interface
-func (e DeleteProjectRequestValidationError) Error() string {
+func (e DeleteServiceRequestValidationError) Error() string {
 	cause := ""
 	if e.cause != nil {
 		cause = fmt.Sprintf(" | caused by: %v", e.cause)
@@ -3878,14 +3880,14 @@ func (e DeleteProjectRequestValidationError) Error() string {
 	}
 
 	return fmt.Sprintf(
-		"invalid %sDeleteProjectRequest.%s: %s%s",
+		"invalid %sDeleteServiceRequest.%s: %s%s",
 		key,
 		e.field,
 		e.reason,
 		cause)
 }
 
-var _ error = DeleteProjectRequestValidationError{}
+var _ error = DeleteServiceRequestValidationError{}
 
 var _ interface {
 	Field() string
@@ -3893,44 +3895,73 @@ var _ interface {
 	Key() bool
 	Cause() error
 	ErrorName() string
-} = DeleteProjectRequestValidationError{}
+} = DeleteServiceRequestValidationError{}
 
-// Validate checks the field values on DeleteProjectResponse with the rules
+// Validate checks the field values on DeleteServiceResponse with the rules
 // defined in the proto definition for this message. If any rules are
 // violated, the first error encountered is returned, or nil if there are no violations.
-func (m *DeleteProjectResponse) Validate() error {
+func (m *DeleteServiceResponse) Validate() error {
 	return m.validate(false)
 }
 
-// ValidateAll checks the field values on DeleteProjectResponse with the rules
+// ValidateAll checks the field values on DeleteServiceResponse with the rules
 // defined in the proto definition for this message. If any rules are
 // violated, the result is a list of violation errors wrapped in
-// DeleteProjectResponseMultiError, or nil if none found.
-func (m *DeleteProjectResponse) ValidateAll() error {
+// DeleteServiceResponseMultiError, or nil if none found.
+func (m *DeleteServiceResponse) ValidateAll() error {
 	return m.validate(true)
 }
 
-func (m *DeleteProjectResponse) validate(all bool) error {
+func (m *DeleteServiceResponse) validate(all bool) error {
 	if m == nil {
 		return nil
 	}
 
 	var errors []error
 
+	if all {
+		switch v := interface{}(m.GetService()).(type) {
+		case interface{ ValidateAll() error }:
+			if err := v.ValidateAll(); err != nil {
+				errors = append(errors, DeleteServiceResponseValidationError{
+					field:  "Service",
+					reason: "embedded message failed validation",
+					cause:  err,
+				})
+			}
+		case interface{ Validate() error }:
+			if err := v.Validate(); err != nil {
+				errors = append(errors, DeleteServiceResponseValidationError{
+					field:  "Service",
+					reason: "embedded message failed validation",
+					cause:  err,
+				})
+			}
+		}
+	} else if v, ok := interface{}(m.GetService()).(interface{ Validate() error }); ok {
+		if err := v.Validate(); err != nil {
+			return DeleteServiceResponseValidationError{
+				field:  "Service",
+				reason: "embedded message failed validation",
+				cause:  err,
+			}
+		}
+	}
+
 	if len(errors) > 0 {
-		return DeleteProjectResponseMultiError(errors)
+		return DeleteServiceResponseMultiError(errors)
 	}
 
 	return nil
 }
 
-// DeleteProjectResponseMultiError is an error wrapping multiple validation
-// errors returned by DeleteProjectResponse.ValidateAll() if the designated
+// DeleteServiceResponseMultiError is an error wrapping multiple validation
+// errors returned by DeleteServiceResponse.ValidateAll() if the designated
 // constraints aren't met.
-type DeleteProjectResponseMultiError []error
+type DeleteServiceResponseMultiError []error
 
 // Error returns a concatenation of all the error messages it wraps.
-func (m DeleteProjectResponseMultiError) Error() string {
+func (m DeleteServiceResponseMultiError) Error() string {
 	var msgs []string
 	for _, err := range m {
 		msgs = append(msgs, err.Error())
@@ -3939,11 +3970,11 @@ func (m DeleteProjectResponseMultiError) Error() string {
 }
 
 // AllErrors returns a list of validation violation errors.
-func (m DeleteProjectResponseMultiError) AllErrors() []error { return m }
+func (m DeleteServiceResponseMultiError) AllErrors() []error { return m }
 
-// DeleteProjectResponseValidationError is the validation error returned by
-// DeleteProjectResponse.Validate if the designated constraints aren't met.
-type DeleteProjectResponseValidationError struct {
+// DeleteServiceResponseValidationError is the validation error returned by
+// DeleteServiceResponse.Validate if the designated constraints aren't met.
+type DeleteServiceResponseValidationError struct {
 	field  string
 	reason string
 	cause  error
@@ -3951,24 +3982,24 @@ type DeleteProjectResponseValidationError struct {
 }
 
 // Field function returns field value.
-func (e DeleteProjectResponseValidationError) Field() string { return e.field }
+func (e DeleteServiceResponseValidationError) Field() string { return e.field }
 
 // Reason function returns reason value.
-func (e DeleteProjectResponseValidationError) Reason() string { return e.reason }
+func (e DeleteServiceResponseValidationError) Reason() string { return e.reason }
 
 // Cause function returns cause value.
-func (e DeleteProjectResponseValidationError) Cause() error { return e.cause }
+func (e DeleteServiceResponseValidationError) Cause() error { return e.cause }
 
 // Key function returns key value.
-func (e DeleteProjectResponseValidationError) Key() bool { return e.key }
+func (e DeleteServiceResponseValidationError) Key() bool { return e.key }
 
 // ErrorName returns error name.
-func (e DeleteProjectResponseValidationError) ErrorName() string {
-	return "DeleteProjectResponseValidationError"
+func (e DeleteServiceResponseValidationError) ErrorName() string {
+	return "DeleteServiceResponseValidationError"
 }
 
 // Error satisfies the builtin error interface
-func (e DeleteProjectResponseValidationError) Error() string {
+func (e DeleteServiceResponseValidationError) Error() string {
 	cause := ""
 	if e.cause != nil {
 		cause = fmt.Sprintf(" | caused by: %v", e.cause)
@@ -3980,14 +4011,14 @@ func (e DeleteProjectResponseValidationError) Error() string {
 	}
 
 	return fmt.Sprintf(
-		"invalid %sDeleteProjectResponse.%s: %s%s",
+		"invalid %sDeleteServiceResponse.%s: %s%s",
 		key,
 		e.field,
 		e.reason,
 		cause)
 }
 
-var _ error = DeleteProjectResponseValidationError{}
+var _ error = DeleteServiceResponseValidationError{}
 
 var _ interface {
 	Field() string
@@ -3995,24 +4026,24 @@ var _ interface {
 	Key() bool
 	Cause() error
 	ErrorName() string
-} = DeleteProjectResponseValidationError{}
+} = DeleteServiceResponseValidationError{}
 
-// Validate checks the field values on UpdateProjectRequest with the rules
+// Validate checks the field values on CreateProjectRequest with the rules
 // defined in the proto definition for this message. If any rules are
 // violated, the first error encountered is returned, or nil if there are no violations.
-func (m *UpdateProjectRequest) Validate() error {
+func (m *CreateProjectRequest) Validate() error {
 	return m.validate(false)
 }
 
-// ValidateAll checks the field values on UpdateProjectRequest with the rules
+// ValidateAll checks the field values on CreateProjectRequest with the rules
 // defined in the proto definition for this message. If any rules are
 // violated, the result is a list of violation errors wrapped in
-// UpdateProjectRequestMultiError, or nil if none found.
-func (m *UpdateProjectRequest) ValidateAll() error {
+// CreateProjectRequestMultiError, or nil if none found.
+func (m *CreateProjectRequest) ValidateAll() error {
 	return m.validate(true)
 }
 
-func (m *UpdateProjectRequest) validate(all bool) error {
+func (m *CreateProjectRequest) validate(all bool) error {
 	if m == nil {
 		return nil
 	}
@@ -4020,7 +4051,7 @@ func (m *UpdateProjectRequest) validate(all bool) error {
 	var errors []error
 
 	if utf8.RuneCountInString(m.GetOrganizationName()) < 1 {
-		err := UpdateProjectRequestValidationError{
+		err := CreateProjectRequestValidationError{
 			field:  "OrganizationName",
 			reason: "value length must be at least 1 runes",
 		}
@@ -4031,7 +4062,7 @@ func (m *UpdateProjectRequest) validate(all bool) error {
 	}
 
 	if utf8.RuneCountInString(m.GetName()) < 1 {
-		err := UpdateProjectRequestValidationError{
+		err := CreateProjectRequestValidationError{
 			field:  "Name",
 			reason: "value length must be at least 1 runes",
 		}
@@ -4041,52 +4072,40 @@ func (m *UpdateProjectRequest) validate(all bool) error {
 		errors = append(errors, err)
 	}
 
-	if m.Description != nil {
-		// no validation rules for Description
-	}
+	// no validation rules for Description
 
-	if m.Public != nil {
-		// no validation rules for Public
-	}
+	// no validation rules for Public
 
-	if m.ProdBranch != nil {
-		// no validation rules for ProdBranch
-	}
+	// no validation rules for Region
 
-	if m.GithubUrl != nil {
-		// no validation rules for GithubUrl
-	}
+	// no validation rules for ProdOlapDriver
 
-	if m.ProdSlots != nil {
-		// no validation rules for ProdSlots
-	}
+	// no validation rules for ProdOlapDsn
 
-	if m.Region != nil {
-		// no validation rules for Region
-	}
+	// no validation rules for ProdSlots
 
-	if m.NewName != nil {
-		// no validation rules for NewName
-	}
+	// no validation rules for Subpath
 
-	if m.ProdTtlSeconds != nil {
-		// no validation rules for ProdTtlSeconds
-	}
+	// no validation rules for ProdBranch
+
+	// no validation rules for GithubUrl
+
+	// no validation rules for Variables
 
 	if len(errors) > 0 {
-		return UpdateProjectRequestMultiError(errors)
+		return CreateProjectRequestMultiError(errors)
 	}
 
 	return nil
 }
 
-// UpdateProjectRequestMultiError is an error wrapping multiple validation
-// errors returned by UpdateProjectRequest.ValidateAll() if the designated
+// CreateProjectRequestMultiError is an error wrapping multiple validation
+// errors returned by CreateProjectRequest.ValidateAll() if the designated
 // constraints aren't met.
-type UpdateProjectRequestMultiError []error
+type CreateProjectRequestMultiError []error
 
 // Error returns a concatenation of all the error messages it wraps.
-func (m UpdateProjectRequestMultiError) Error() string {
+func (m CreateProjectRequestMultiError) Error() string {
 	var msgs []string
 	for _, err := range m {
 		msgs = append(msgs, err.Error())
@@ -4095,11 +4114,11 @@ func (m UpdateProjectRequestMultiError) Error() string {
 }
 
 // AllErrors returns a list of validation violation errors.
-func (m UpdateProjectRequestMultiError) AllErrors() []error { return m }
+func (m CreateProjectRequestMultiError) AllErrors() []error { return m }
 
-// UpdateProjectRequestValidationError is the validation error returned by
-// UpdateProjectRequest.Validate if the designated constraints aren't met.
-type UpdateProjectRequestValidationError struct {
+// CreateProjectRequestValidationError is the validation error returned by
+// CreateProjectRequest.Validate if the designated constraints aren't met.
+type CreateProjectRequestValidationError struct {
 	field  string
 	reason string
 	cause  error
@@ -4107,24 +4126,24 @@ type UpdateProjectRequestValidationError struct {
 }
 
 // Field function returns field value.
-func (e UpdateProjectRequestValidationError) Field() string { return e.field }
+func (e CreateProjectRequestValidationError) Field() string { return e.field }
 
 // Reason function returns reason value.
-func (e UpdateProjectRequestValidationError) Reason() string { return e.reason }
+func (e CreateProjectRequestValidationError) Reason() string { return e.reason }
 
 // Cause function returns cause value.
-func (e UpdateProjectRequestValidationError) Cause() error { return e.cause }
+func (e CreateProjectRequestValidationError) Cause() error { return e.cause }
 
 // Key function returns key value.
-func (e UpdateProjectRequestValidationError) Key() bool { return e.key }
+func (e CreateProjectRequestValidationError) Key() bool { return e.key }
 
 // ErrorName returns error name.
-func (e UpdateProjectRequestValidationError) ErrorName() string {
-	return "UpdateProjectRequestValidationError"
+func (e CreateProjectRequestValidationError) ErrorName() string {
+	return "CreateProjectRequestValidationError"
 }
 
 // Error satisfies the builtin error interface
-func (e UpdateProjectRequestValidationError) Error() string {
+func (e CreateProjectRequestValidationError) Error() string {
 	cause := ""
 	if e.cause != nil {
 		cause = fmt.Sprintf(" | caused by: %v", e.cause)
@@ -4136,14 +4155,14 @@ func (e UpdateProjectRequestValidationError) Error() string {
 	}
 
 	return fmt.Sprintf(
-		"invalid %sUpdateProjectRequest.%s: %s%s",
+		"invalid %sCreateProjectRequest.%s: %s%s",
 		key,
 		e.field,
 		e.reason,
 		cause)
 }
 
-var _ error = UpdateProjectRequestValidationError{}
+var _ error = CreateProjectRequestValidationError{}
 
 var _ interface {
 	Field() string
@@ -4151,24 +4170,24 @@ var _ interface {
 	Key() bool
 	Cause() error
 	ErrorName() string
-} = UpdateProjectRequestValidationError{}
+} = CreateProjectRequestValidationError{}
 
-// Validate checks the field values on UpdateProjectResponse with the rules
+// Validate checks the field values on CreateProjectResponse with the rules
 // defined in the proto definition for this message. If any rules are
 // violated, the first error encountered is returned, or nil if there are no violations.
-func (m *UpdateProjectResponse) Validate() error {
+func (m *CreateProjectResponse) Validate() error {
 	return m.validate(false)
 }
 
-// ValidateAll checks the field values on UpdateProjectResponse with the rules
+// ValidateAll checks the field values on CreateProjectResponse with the rules
 // defined in the proto definition for this message. If any rules are
 // violated, the result is a list of violation errors wrapped in
-// UpdateProjectResponseMultiError, or nil if none found.
-func (m *UpdateProjectResponse) ValidateAll() error {
+// CreateProjectResponseMultiError, or nil if none found.
+func (m *CreateProjectResponse) ValidateAll() error {
 	return m.validate(true)
 }
 
-func (m *UpdateProjectResponse) validate(all bool) error {
+func (m *CreateProjectResponse) validate(all bool) error {
 	if m == nil {
 		return nil
 	}
@@ -4179,7 +4198,7 @@ func (m *UpdateProjectResponse) validate(all bool) error {
 		switch v := interface{}(m.GetProject()).(type) {
 		case interface{ ValidateAll() error }:
 			if err := v.ValidateAll(); err != nil {
-				errors = append(errors, UpdateProjectResponseValidationError{
+				errors = append(errors, CreateProjectResponseValidationError{
 					field:  "Project",
 					reason: "embedded message failed validation",
 					cause:  err,
@@ -4187,7 +4206,7 @@ func (m *UpdateProjectResponse) validate(all bool) error {
 			}
 		case interface{ Validate() error }:
 			if err := v.Validate(); err != nil {
-				errors = append(errors, UpdateProjectResponseValidationError{
+				errors = append(errors, CreateProjectResponseValidationError{
 					field:  "Project",
 					reason: "embedded message failed validation",
 					cause:  err,
@@ -4196,7 +4215,7 @@ func (m *UpdateProjectResponse) validate(all bool) error {
 		}
 	} else if v, ok := interface{}(m.GetProject()).(interface{ Validate() error }); ok {
 		if err := v.Validate(); err != nil {
-			return UpdateProjectResponseValidationError{
+			return CreateProjectResponseValidationError{
 				field:  "Project",
 				reason: "embedded message failed validation",
 				cause:  err,
@@ -4205,19 +4224,19 @@ func (m *UpdateProjectResponse) validate(all bool) error {
 	}
 
 	if len(errors) > 0 {
-		return UpdateProjectResponseMultiError(errors)
+		return CreateProjectResponseMultiError(errors)
 	}
 
 	return nil
 }
 
-// UpdateProjectResponseMultiError is an error wrapping multiple validation
-// errors returned by UpdateProjectResponse.ValidateAll() if the designated
+// CreateProjectResponseMultiError is an error wrapping multiple validation
+// errors returned by CreateProjectResponse.ValidateAll() if the designated
 // constraints aren't met.
-type UpdateProjectResponseMultiError []error
+type CreateProjectResponseMultiError []error
 
 // Error returns a concatenation of all the error messages it wraps.
-func (m UpdateProjectResponseMultiError) Error() string {
+func (m CreateProjectResponseMultiError) Error() string {
 	var msgs []string
 	for _, err := range m {
 		msgs = append(msgs, err.Error())
@@ -4226,11 +4245,11 @@ func (m UpdateProjectResponseMultiError) Error() string {
 }
 
 // AllErrors returns a list of validation violation errors.
-func (m UpdateProjectResponseMultiError) AllErrors() []error { return m }
+func (m CreateProjectResponseMultiError) AllErrors() []error { return m }
 
-// UpdateProjectResponseValidationError is the validation error returned by
-// UpdateProjectResponse.Validate if the designated constraints aren't met.
-type UpdateProjectResponseValidationError struct {
+// CreateProjectResponseValidationError is the validation error returned by
+// CreateProjectResponse.Validate if the designated constraints aren't met.
+type CreateProjectResponseValidationError struct {
 	field  string
 	reason string
 	cause  error
@@ -4238,24 +4257,24 @@ type UpdateProjectResponseValidationError struct {
 }
 
 // Field function returns field value.
-func (e UpdateProjectResponseValidationError) Field() string { return e.field }
+func (e CreateProjectResponseValidationError) Field() string { return e.field }
 
 // Reason function returns reason value.
-func (e UpdateProjectResponseValidationError) Reason() string { return e.reason }
+func (e CreateProjectResponseValidationError) Reason() string { return e.reason }
 
 // Cause function returns cause value.
-func (e UpdateProjectResponseValidationError) Cause() error { return e.cause }
+func (e CreateProjectResponseValidationError) Cause() error { return e.cause }
 
 // Key function returns key value.
-func (e UpdateProjectResponseValidationError) Key() bool { return e.key }
+func (e CreateProjectResponseValidationError) Key() bool { return e.key }
 
 // ErrorName returns error name.
-func (e UpdateProjectResponseValidationError) ErrorName() string {
-	return "UpdateProjectResponseValidationError"
+func (e CreateProjectResponseValidationError) ErrorName() string {
+	return "CreateProjectResponseValidationError"
 }
 
 // Error satisfies the builtin error interface
-func (e UpdateProjectResponseValidationError) Error() string {
+func (e CreateProjectResponseValidationError) Error() string {
 	cause := ""
 	if e.cause != nil {
 		cause = fmt.Sprintf(" | caused by: %v", e.cause)
@@ -4267,14 +4286,14 @@ func (e UpdateProjectResponseValidationError) Error() string {
 	}
 
 	return fmt.Sprintf(
-		"invalid %sUpdateProjectResponse.%s: %s%s",
+		"invalid %sCreateProjectResponse.%s: %s%s",
 		key,
 		e.field,
 		e.reason,
 		cause)
 }
 
-var _ error = UpdateProjectResponseValidationError{}
+var _ error = CreateProjectResponseValidationError{}
 
 var _ interface {
 	Field() string
@@ -4282,68 +4301,48 @@ var _ interface {
 	Key() bool
 	Cause() error
 	ErrorName() string
-} = UpdateProjectResponseValidationError{}
+} = CreateProjectResponseValidationError{}
 
-// Validate checks the field values on UpdateProjectVariablesRequest with the
-// rules defined in the proto definition for this message. If any rules are
+// Validate checks the field values on DeleteProjectRequest with the rules
+// defined in the proto definition for this message. If any rules are
 // violated, the first error encountered is returned, or nil if there are no violations.
-func (m *UpdateProjectVariablesRequest) Validate() error {
+func (m *DeleteProjectRequest) Validate() error {
 	return m.validate(false)
 }
 
-// ValidateAll checks the field values on UpdateProjectVariablesRequest with
-// the rules defined in the proto definition for this message. If any rules
-// are violated, the result is a list of violation errors wrapped in
-// UpdateProjectVariablesRequestMultiError, or nil if none found.
-func (m *UpdateProjectVariablesRequest) ValidateAll() error {
+// ValidateAll checks the field values on DeleteProjectRequest with the rules
+// defined in the proto definition for this message. If any rules are
+// violated, the result is a list of violation errors wrapped in
+// DeleteProjectRequestMultiError, or nil if none found.
+func (m *DeleteProjectRequest) ValidateAll() error {
 	return m.validate(true)
 }
 
-func (m *UpdateProjectVariablesRequest) validate(all bool) error {
+func (m *DeleteProjectRequest) validate(all bool) error {
 	if m == nil {
 		return nil
 	}
 
 	var errors []error
 
-	if utf8.RuneCountInString(m.GetOrganizationName()) < 1 {
-		err := UpdateProjectVariablesRequestValidationError{
-			field:  "OrganizationName",
-			reason: "value length must be at least 1 runes",
-		}
-		if !all {
-			return err
-		}
-		errors = append(errors, err)
-	}
-
-	if utf8.RuneCountInString(m.GetName()) < 1 {
-		err := UpdateProjectVariablesRequestValidationError{
-			field:  "Name",
-			reason: "value length must be at least 1 runes",
-		}
-		if !all {
-			return err
-		}
-		errors = append(errors, err)
-	}
+	// no validation rules for OrganizationName
 
-	// no validation rules for Variables
+	// no validation rules for Name
 
 	if len(errors) > 0 {
-		return UpdateProjectVariablesRequestMultiError(errors)
+		return DeleteProjectRequestMultiError(errors)
 	}
 
 	return nil
 }
 
-// UpdateProjectVariablesRequestMultiError is an error wrapping multiple
-// validation errors returned by UpdateProjectVariablesRequest.ValidateAll()
-// if the designated constraints aren't met.
-type UpdateProjectVariablesRequestMultiError []error
+// DeleteProjectRequestMultiError is an error wrapping multiple validation
+// errors returned by DeleteProjectRequest.ValidateAll() if the designated
+// constraints aren't met.
+type DeleteProjectRequestMultiError []error
 
 // Error returns a concatenation of all the error messages it wraps.
-func (m UpdateProjectVariablesRequestMultiError) Error() string {
+func (m DeleteProjectRequestMultiError) Error() string {
 	var msgs []string
 	for _, err := range m {
 		msgs = append(msgs, err.Error())
@@ -4352,12 +4351,11 @@ func (m UpdateProjectVariablesRequestMultiError) Error() string {
 }
 
 // AllErrors returns a list of validation violation errors.
-func (m UpdateProjectVariablesRequestMultiError) AllErrors() []error { return m }
+func (m DeleteProjectRequestMultiError) AllErrors() []error { return m }
 
-// UpdateProjectVariablesRequestValidationError is the validation error
-// returned by UpdateProjectVariablesRequest.Validate if the designated
-// constraints aren't met.
-type UpdateProjectVariablesRequestValidationError struct {
+// DeleteProjectRequestValidationError is the validation error returned by
+// DeleteProjectRequest.Validate if the designated constraints aren't met.
+type DeleteProjectRequestValidationError struct {
 	field  string
 	reason string
 	cause  error
@@ -4365,24 +4363,24 @@ type UpdateProjectVariablesRequestValidationError struct {
 }
 
 // Field function returns field value.
-func (e UpdateProjectVariablesRequestValidationError) Field() string { return e.field }
+func (e DeleteProjectRequestValidationError) Field() string { return e.field }
 
 // Reason function returns reason value.
-func (e UpdateProjectVariablesRequestValidationError) Reason() string { return e.reason }
+func (e DeleteProjectRequestValidationError) Reason() string { return e.reason }
 
 // Cause function returns cause value.
-func (e UpdateProjectVariablesRequestValidationError) Cause() error { return e.cause }
+func (e DeleteProjectRequestValidationError) Cause() error { return e.cause }
 
 // Key function returns key value.
-func (e UpdateProjectVariablesRequestValidationError) Key() bool { return e.key }
+func (e DeleteProjectRequestValidationError) Key() bool { return e.key }
 
 // ErrorName returns error name.
-func (e UpdateProjectVariablesRequestValidationError) ErrorName() string {
-	return "UpdateProjectVariablesRequestValidationError"
+func (e DeleteProjectRequestValidationError) ErrorName() string {
+	return "DeleteProjectRequestValidationError"
 }
 
 // Error satisfies the builtin error interface
-func (e UpdateProjectVariablesRequestValidationError) Error() string {
+func (e DeleteProjectRequestValidationError) Error() string {
 	cause := ""
 	if e.cause != nil {
 		cause = fmt.Sprintf(" | caused by: %v", e.cause)
@@ -4394,14 +4392,14 @@ func (e UpdateProjectVariablesRequestValidationError) Error() string {
 	}
 
 	return fmt.Sprintf(
-		"invalid %sUpdateProjectVariablesRequest.%s: %s%s",
+		"invalid %sDeleteProjectRequest.%s: %s%s",
 		key,
 		e.field,
 		e.reason,
 		cause)
 }
 
-var _ error = UpdateProjectVariablesRequestValidationError{}
+var _ error = DeleteProjectRequestValidationError{}
 
 var _ interface {
 	Field() string
@@ -4409,46 +4407,44 @@ var _ interface {
 	Key() bool
 	Cause() error
 	ErrorName() string
-} = UpdateProjectVariablesRequestValidationError{}
+} = DeleteProjectRequestValidationError{}
 
-// Validate checks the field values on UpdateProjectVariablesResponse with the
-// rules defined in the proto definition for this message. If any rules are
+// Validate checks the field values on DeleteProjectResponse with the rules
+// defined in the proto definition for this message. If any rules are
 // violated, the first error encountered is returned, or nil if there are no violations.
-func (m *UpdateProjectVariablesResponse) Validate() error {
+func (m *DeleteProjectResponse) Validate() error {
 	return m.validate(false)
 }
 
-// ValidateAll checks the field values on UpdateProjectVariablesResponse with
-// the rules defined in the proto definition for this message. If any rules
-// are violated, the result is a list of violation errors wrapped in
-// UpdateProjectVariablesResponseMultiError, or nil if none found.
-func (m *UpdateProjectVariablesResponse) ValidateAll() error {
+// ValidateAll checks the field values on DeleteProjectResponse with the rules
+// defined in the proto definition for this message. If any rules are
+// violated, the result is a list of violation errors wrapped in
+// DeleteProjectResponseMultiError, or nil if none found.
+func (m *DeleteProjectResponse) ValidateAll() error {
 	return m.validate(true)
 }
 
-func (m *UpdateProjectVariablesResponse) validate(all bool) error {
+func (m *DeleteProjectResponse) validate(all bool) error {
 	if m == nil {
 		return nil
 	}
 
 	var errors []error
 
-	// no validation rules for Variables
-
 	if len(errors) > 0 {
-		return UpdateProjectVariablesResponseMultiError(errors)
+		return DeleteProjectResponseMultiError(errors)
 	}
 
 	return nil
 }
 
-// UpdateProjectVariablesResponseMultiError is an error wrapping multiple
-// validation errors returned by UpdateProjectVariablesResponse.ValidateAll()
-// if the designated constraints aren't met.
-type UpdateProjectVariablesResponseMultiError []error
+// DeleteProjectResponseMultiError is an error wrapping multiple validation
+// errors returned by DeleteProjectResponse.ValidateAll() if the designated
+// constraints aren't met.
+type DeleteProjectResponseMultiError []error
 
 // Error returns a concatenation of all the error messages it wraps.
-func (m UpdateProjectVariablesResponseMultiError) Error() string {
+func (m DeleteProjectResponseMultiError) Error() string {
 	var msgs []string
 	for _, err := range m {
 		msgs = append(msgs, err.Error())
@@ -4457,12 +4453,11 @@ func (m UpdateProjectVariablesResponseMultiError) Error() string {
 }
 
 // AllErrors returns a list of validation violation errors.
-func (m UpdateProjectVariablesResponseMultiError) AllErrors() []error { return m }
+func (m DeleteProjectResponseMultiError) AllErrors() []error { return m }
 
-// UpdateProjectVariablesResponseValidationError is the validation error
-// returned by UpdateProjectVariablesResponse.Validate if the designated
-// constraints aren't met.
-type UpdateProjectVariablesResponseValidationError struct {
+// DeleteProjectResponseValidationError is the validation error returned by
+// DeleteProjectResponse.Validate if the designated constraints aren't met.
+type DeleteProjectResponseValidationError struct {
 	field  string
 	reason string
 	cause  error
@@ -4470,24 +4465,24 @@ type UpdateProjectVariablesResponseValidationError struct {
 }
 
 // Field function returns field value.
-func (e UpdateProjectVariablesResponseValidationError) Field() string { return e.field }
+func (e DeleteProjectResponseValidationError) Field() string { return e.field }
 
 // Reason function returns reason value.
-func (e UpdateProjectVariablesResponseValidationError) Reason() string { return e.reason }
+func (e DeleteProjectResponseValidationError) Reason() string { return e.reason }
 
 // Cause function returns cause value.
-func (e UpdateProjectVariablesResponseValidationError) Cause() error { return e.cause }
+func (e DeleteProjectResponseValidationError) Cause() error { return e.cause }
 
 // Key function returns key value.
-func (e UpdateProjectVariablesResponseValidationError) Key() bool { return e.key }
+func (e DeleteProjectResponseValidationError) Key() bool { return e.key }
 
 // ErrorName returns error name.
-func (e UpdateProjectVariablesResponseValidationError) ErrorName() string {
-	return "UpdateProjectVariablesResponseValidationError"
+func (e DeleteProjectResponseValidationError) ErrorName() string {
+	return "DeleteProjectResponseValidationError"
 }
 
 // Error satisfies the builtin error interface
-func (e UpdateProjectVariablesResponseValidationError) Error() string {
+func (e DeleteProjectResponseValidationError) Error() string {
 	cause := ""
 	if e.cause != nil {
 		cause = fmt.Sprintf(" | caused by: %v", e.cause)
@@ -4499,14 +4494,14 @@ func (e UpdateProjectVariablesResponseValidationError) Error() string {
 	}
 
 	return fmt.Sprintf(
-		"invalid %sUpdateProjectVariablesResponse.%s: %s%s",
+		"invalid %sDeleteProjectResponse.%s: %s%s",
 		key,
 		e.field,
 		e.reason,
 		cause)
 }
 
-var _ error = UpdateProjectVariablesResponseValidationError{}
+var _ error = DeleteProjectResponseValidationError{}
 
 var _ interface {
 	Field() string
@@ -4514,46 +4509,102 @@ var _ interface {
 	Key() bool
 	Cause() error
 	ErrorName() string
-} = UpdateProjectVariablesResponseValidationError{}
+} = DeleteProjectResponseValidationError{}
 
-// Validate checks the field values on TriggerReconcileRequest with the rules
+// Validate checks the field values on UpdateProjectRequest with the rules
 // defined in the proto definition for this message. If any rules are
 // violated, the first error encountered is returned, or nil if there are no violations.
-func (m *TriggerReconcileRequest) Validate() error {
+func (m *UpdateProjectRequest) Validate() error {
 	return m.validate(false)
 }
 
-// ValidateAll checks the field values on TriggerReconcileRequest with the
-// rules defined in the proto definition for this message. If any rules are
+// ValidateAll checks the field values on UpdateProjectRequest with the rules
+// defined in the proto definition for this message. If any rules are
 // violated, the result is a list of violation errors wrapped in
-// TriggerReconcileRequestMultiError, or nil if none found.
-func (m *TriggerReconcileRequest) ValidateAll() error {
+// UpdateProjectRequestMultiError, or nil if none found.
+func (m *UpdateProjectRequest) ValidateAll() error {
 	return m.validate(true)
 }
 
-func (m *TriggerReconcileRequest) validate(all bool) error {
+func (m *UpdateProjectRequest) validate(all bool) error {
 	if m == nil {
 		return nil
 	}
 
 	var errors []error
 
-	// no validation rules for DeploymentId
+	if utf8.RuneCountInString(m.GetOrganizationName()) < 1 {
+		err := UpdateProjectRequestValidationError{
+			field:  "OrganizationName",
+			reason: "value length must be at least 1 runes",
+		}
+		if !all {
+			return err
+		}
+		errors = append(errors, err)
+	}
+
+	if utf8.RuneCountInString(m.GetName()) < 1 {
+		err := UpdateProjectRequestValidationError{
+			field:  "Name",
+			reason: "value length must be at least 1 runes",
+		}
+		if !all {
+			return err
+		}
+		errors = append(errors, err)
+	}
+
+	if m.Description != nil {
+		// no validation rules for Description
+	}
+
+	if m.Public != nil {
+		// no validation rules for Public
+	}
+
+	if m.ProdBranch != nil {
+		// no validation rules for ProdBranch
+	}
+
+	if m.GithubUrl != nil {
+		// no validation rules for GithubUrl
+	}
+
+	if m.ProdSlots != nil {
+		// no validation rules for ProdSlots
+	}
+
+	if m.Region != nil {
+		// no validation rules for Region
+	}
+
+	if m.NewName != nil {
+		// no validation rules for NewName
+	}
+
+	if m.ProdTtlSeconds != nil {
+		// no validation rules for ProdTtlSeconds
+	}
+
+	if m.PreviewDeployments != nil {
+		// no validation rules for PreviewDeployments
+	}
 
 	if len(errors) > 0 {
-		return TriggerReconcileRequestMultiError(errors)
+		return UpdateProjectRequestMultiError(errors)
 	}
 
 	return nil
 }
 
-// TriggerReconcileRequestMultiError is an error wrapping multiple validation
-// errors returned by TriggerReconcileRequest.ValidateAll() if the designated
+// UpdateProjectRequestMultiError is an error wrapping multiple validation
+// errors returned by UpdateProjectRequest.ValidateAll() if the designated
 // constraints aren't met.
-type TriggerReconcileRequestMultiError []error
+type UpdateProjectRequestMultiError []error
 
 // Error returns a concatenation of all the error messages it wraps.
-func (m TriggerReconcileRequestMultiError) Error() string {
+func (m UpdateProjectRequestMultiError) Error() string {
 	var msgs []string
 	for _, err := range m {
 		msgs = append(msgs, err.Error())
@@ -4562,11 +4613,11 @@ func (m TriggerReconcileRequestMultiError) Error() string {
 }
 
 // AllErrors returns a list of validation violation errors.
-func (m TriggerReconcileRequestMultiError) AllErrors() []error { return m }
+func (m UpdateProjectRequestMultiError) AllErrors() []error { return m }
 
-// TriggerReconcileRequestValidationError is the validation error returned by
-// TriggerReconcileRequest.Validate if the designated constraints aren't met.
-type TriggerReconcileRequestValidationError struct {
+// UpdateProjectRequestValidationError is the validation error returned by
+// UpdateProjectRequest.Validate if the designated constraints aren't met.
+type UpdateProjectRequestValidationError struct {
 	field  string
 	reason string
 	cause  error
@@ -4574,24 +4625,24 @@ type TriggerReconcileRequestValidationError struct {
 }
 
 // Field function returns field value.
-func (e TriggerReconcileRequestValidationError) Field() string { return e.field }
+func (e UpdateProjectRequestValidationError) Field() string { return e.field }
 
 // Reason function returns reason value.
-func (e TriggerReconcileRequestValidationError) Reason() string { return e.reason }
+func (e UpdateProjectRequestValidationError) Reason() string { return e.reason }
 
 // Cause function returns cause value.
-func (e TriggerReconcileRequestValidationError) Cause() error { return e.cause }
+func (e UpdateProjectRequestValidationError) Cause() error { return e.cause }
 
 // Key function returns key value.
-func (e TriggerReconcileRequestValidationError) Key() bool { return e.key }
+func (e UpdateProjectRequestValidationError) Key() bool { return e.key }
 
 // ErrorName returns error name.
-func (e TriggerReconcileRequestValidationError) ErrorName() string {
-	return "TriggerReconcileRequestValidationError"
+func (e UpdateProjectRequestValidationError) ErrorName() string {
+	return "UpdateProjectRequestValidationError"
 }
 
 // Error satisfies the builtin error interface
-func (e TriggerReconcileRequestValidationError) Error() string {
+func (e UpdateProjectRequestValidationError) Error() string {
 	cause := ""
 	if e.cause != nil {
 		cause = fmt.Sprintf(" | caused by: %v", e.cause)
@@ -4603,14 +4654,14 @@ func (e TriggerReconcileRequestValidationError) Error() string {
 	}
 
 	return fmt.Sprintf(
-		"invalid %sTriggerReconcileRequest.%s: %s%s",
+		"invalid %sUpdateProjectRequest.%s: %s%s",
 		key,
 		e.field,
 		e.reason,
 		cause)
 }
 
-var _ error = TriggerReconcileRequestValidationError{}
+var _ error = UpdateProjectRequestValidationError{}
 
 var _ interface {
 	Field() string
@@ -4618,44 +4669,73 @@ var _ interface {
 	Key() bool
 	Cause() error
 	ErrorName() string
-} = TriggerReconcileRequestValidationError{}
+} = UpdateProjectRequestValidationError{}
 
-// Validate checks the field values on TriggerReconcileResponse with the rules
+// Validate checks the field values on UpdateProjectResponse with the rules
 // defined in the proto definition for this message. If any rules are
 // violated, the first error encountered is returned, or nil if there are no violations.
-func (m *TriggerReconcileResponse) Validate() error {
+func (m *UpdateProjectResponse) Validate() error {
 	return m.validate(false)
 }
 
-// ValidateAll checks the field values on TriggerReconcileResponse with the
-// rules defined in the proto definition for this message. If any rules are
+// ValidateAll checks the field values on UpdateProjectResponse with the rules
+// defined in the proto definition for this message. If any rules are
 // violated, the result is a list of violation errors wrapped in
-// TriggerReconcileResponseMultiError, or nil if none found.
-func (m *TriggerReconcileResponse) ValidateAll() error {
+// UpdateProjectResponseMultiError, or nil if none found.
+func (m *UpdateProjectResponse) ValidateAll() error {
 	return m.validate(true)
 }
 
-func (m *TriggerReconcileResponse) validate(all bool) error {
+func (m *UpdateProjectResponse) validate(all bool) error {
 	if m == nil {
 		return nil
 	}
 
 	var errors []error
 
+	if all {
+		switch v := interface{}(m.GetProject()).(type) {
+		case interface{ ValidateAll() error }:
+			if err := v.ValidateAll(); err != nil {
+				errors = append(errors, UpdateProjectResponseValidationError{
+					field:  "Project",
+					reason: "embedded message failed validation",
+					cause:  err,
+				})
+			}
+		case interface{ Validate() error }:
+			if err := v.Validate(); err != nil {
+				errors = append(errors, UpdateProjectResponseValidationError{
+					field:  "Project",
+					reason: "embedded message failed validation",
+					cause:  err,
+				})
+			}
+		}
+	} else if v, ok := interface{}(m.GetProject()).(interface{ Validate() error }); ok {
+		if err := v.Validate(); err != nil {
+			return UpdateProjectResponseValidationError{
+				field:  "Project",
+				reason: "embedded message failed validation",
+				cause:  err,
+			}
+		}
+	}
+
 	if len(errors) > 0 {
-		return TriggerReconcileResponseMultiError(errors)
+		return UpdateProjectResponseMultiError(errors)
 	}
 
 	return nil
 }
 
-// TriggerReconcileResponseMultiError is an error wrapping multiple validation
-// errors returned by TriggerReconcileResponse.ValidateAll() if the designated
+// UpdateProjectResponseMultiError is an error wrapping multiple validation
+// errors returned by UpdateProjectResponse.ValidateAll() if the designated
 // constraints aren't met.
-type TriggerReconcileResponseMultiError []error
+type UpdateProjectResponseMultiError []error
 
 // Error returns a concatenation of all the error messages it wraps.
-func (m TriggerReconcileResponseMultiError) Error() string {
+func (m UpdateProjectResponseMultiError) Error() string {
 	var msgs []string
 	for _, err := range m {
 		msgs = append(msgs, err.Error())
@@ -4664,11 +4744,11 @@ func (m TriggerReconcileResponseMultiError) Error() string {
 }
 
 // AllErrors returns a list of validation violation errors.
-func (m TriggerReconcileResponseMultiError) AllErrors() []error { return m }
+func (m UpdateProjectResponseMultiError) AllErrors() []error { return m }
 
-// TriggerReconcileResponseValidationError is the validation error returned by
-// TriggerReconcileResponse.Validate if the designated constraints aren't met.
-type TriggerReconcileResponseValidationError struct {
+// UpdateProjectResponseValidationError is the validation error returned by
+// UpdateProjectResponse.Validate if the designated constraints aren't met.
+type UpdateProjectResponseValidationError struct {
 	field  string
 	reason string
 	cause  error
@@ -4676,24 +4756,24 @@ type TriggerReconcileResponseValidationError struct {
 }
 
 // Field function returns field value.
-func (e TriggerReconcileResponseValidationError) Field() string { return e.field }
+func (e UpdateProjectResponseValidationError) Field() string { return e.field }
 
 // Reason function returns reason value.
-func (e TriggerReconcileResponseValidationError) Reason() string { return e.reason }
+func (e UpdateProjectResponseValidationError) Reason() string { return e.reason }
 
 // Cause function returns cause value.
-func (e TriggerReconcileResponseValidationError) Cause() error { return e.cause }
+func (e UpdateProjectResponseValidationError) Cause() error { return e.cause }
 
 // Key function returns key value.
-func (e TriggerReconcileResponseValidationError) Key() bool { return e.key }
+func (e UpdateProjectResponseValidationError) Key() bool { return e.key }
 
 // ErrorName returns error name.
-func (e TriggerReconcileResponseValidationError) ErrorName() string {
-	return "TriggerReconcileResponseValidationError"
+func (e UpdateProjectResponseValidationError) ErrorName() string {
+	return "UpdateProjectResponseValidationError"
 }
 
 // Error satisfies the builtin error interface
-func (e TriggerReconcileResponseValidationError) Error() string {
+func (e UpdateProjectResponseValidationError) Error() string {
 	cause := ""
 	if e.cause != nil {
 		cause = fmt.Sprintf(" | caused by: %v", e.cause)
@@ -4705,14 +4785,14 @@ func (e TriggerReconcileResponseValidationError) Error() string {
 	}
 
 	return fmt.Sprintf(
-		"invalid %sTriggerReconcileResponse.%s: %s%s",
+		"invalid %sUpdateProjectResponse.%s: %s%s",
 		key,
 		e.field,
 		e.reason,
 		cause)
 }
 
-var _ error = TriggerReconcileResponseValidationError{}
+var _ error = UpdateProjectResponseValidationError{}
 
 var _ interface {
 	Field() string
@@ -4720,46 +4800,68 @@ var _ interface {
 	Key() bool
 	Cause() error
 	ErrorName() string
-} = TriggerReconcileResponseValidationError{}
+} = UpdateProjectResponseValidationError{}
 
-// Validate checks the field values on TriggerRefreshSourcesRequest with the
+// Validate checks the field values on UpdateProjectVariablesRequest with the
 // rules defined in the proto definition for this message. If any rules are
 // violated, the first error encountered is returned, or nil if there are no violations.
-func (m *TriggerRefreshSourcesRequest) Validate() error {
+func (m *UpdateProjectVariablesRequest) Validate() error {
 	return m.validate(false)
 }
 
-// ValidateAll checks the field values on TriggerRefreshSourcesRequest with the
-// rules defined in the proto definition for this message. If any rules are
-// violated, the result is a list of violation errors wrapped in
-// TriggerRefreshSourcesRequestMultiError, or nil if none found.
-func (m *TriggerRefreshSourcesRequest) ValidateAll() error {
+// ValidateAll checks the field values on UpdateProjectVariablesRequest with
+// the rules defined in the proto definition for this message. If any rules
+// are violated, the result is a list of violation errors wrapped in
+// UpdateProjectVariablesRequestMultiError, or nil if none found.
+func (m *UpdateProjectVariablesRequest) ValidateAll() error {
 	return m.validate(true)
 }
 
-func (m *TriggerRefreshSourcesRequest) validate(all bool) error {
+func (m *UpdateProjectVariablesRequest) validate(all bool) error {
 	if m == nil {
 		return nil
 	}
 
 	var errors []error
 
-	// no validation rules for DeploymentId
+	if utf8.RuneCountInString(m.GetOrganizationName()) < 1 {
+		err := UpdateProjectVariablesRequestValidationError{
+			field:  "OrganizationName",
+			reason: "value length must be at least 1 runes",
+		}
+		if !all {
+			return err
+		}
+		errors = append(errors, err)
+	}
+
+	if utf8.RuneCountInString(m.GetName()) < 1 {
+		err := UpdateProjectVariablesRequestValidationError{
+			field:  "Name",
+			reason: "value length must be at least 1 runes",
+		}
+		if !all {
+			return err
+		}
+		errors = append(errors, err)
+	}
+
+	// no validation rules for Variables
 
 	if len(errors) > 0 {
-		return TriggerRefreshSourcesRequestMultiError(errors)
+		return UpdateProjectVariablesRequestMultiError(errors)
 	}
 
 	return nil
 }
 
-// TriggerRefreshSourcesRequestMultiError is an error wrapping multiple
-// validation errors returned by TriggerRefreshSourcesRequest.ValidateAll() if
-// the designated constraints aren't met.
-type TriggerRefreshSourcesRequestMultiError []error
-
-// Error returns a concatenation of all the error messages it wraps.
-func (m TriggerRefreshSourcesRequestMultiError) Error() string {
+// UpdateProjectVariablesRequestMultiError is an error wrapping multiple
+// validation errors returned by UpdateProjectVariablesRequest.ValidateAll()
+// if the designated constraints aren't met.
+type UpdateProjectVariablesRequestMultiError []error
+
+// Error returns a concatenation of all the error messages it wraps.
+func (m UpdateProjectVariablesRequestMultiError) Error() string {
 	var msgs []string
 	for _, err := range m {
 		msgs = append(msgs, err.Error())
@@ -4768,12 +4870,12 @@ func (m TriggerRefreshSourcesRequestMultiError) Error() string {
 }
 
 // AllErrors returns a list of validation violation errors.
-func (m TriggerRefreshSourcesRequestMultiError) AllErrors() []error { return m }
+func (m UpdateProjectVariablesRequestMultiError) AllErrors() []error { return m }
 
-// TriggerRefreshSourcesRequestValidationError is the validation error returned
-// by TriggerRefreshSourcesRequest.Validate if the designated constraints
-// aren't met.
-type TriggerRefreshSourcesRequestValidationError struct {
+// UpdateProjectVariablesRequestValidationError is the validation error
+// returned by UpdateProjectVariablesRequest.Validate if the designated
+// constraints aren't met.
+type UpdateProjectVariablesRequestValidationError struct {
 	field  string
 	reason string
 	cause  error
@@ -4781,24 +4883,24 @@ type TriggerRefreshSourcesRequestValidationError struct {
 }
 
 // Field function returns field value.
-func (e TriggerRefreshSourcesRequestValidationError) Field() string { return e.field }
+func (e UpdateProjectVariablesRequestValidationError) Field() string { return e.field }
 
 // Reason function returns reason value.
-func (e TriggerRefreshSourcesRequestValidationError) Reason() string { return e.reason }
+func (e UpdateProjectVariablesRequestValidationError) Reason() string { return e.reason }
 
 // Cause function returns cause value.
-func (e TriggerRefreshSourcesRequestValidationError) Cause() error { return e.cause }
+func (e UpdateProjectVariablesRequestValidationError) Cause() error { return e.cause }
 
 // Key function returns key value.
-func (e TriggerRefreshSourcesRequestValidationError) Key() bool { return e.key }
+func (e UpdateProjectVariablesRequestValidationError) Key() bool { return e.key }
 
 // ErrorName returns error name.
-func (e TriggerRefreshSourcesRequestValidationError) ErrorName() string {
-	return "TriggerRefreshSourcesRequestValidationError"
+func (e UpdateProjectVariablesRequestValidationError) ErrorName() string {
+	return "UpdateProjectVariablesRequestValidationError"
 }
 
 // Error satisfies the builtin error interface
-func (e TriggerRefreshSourcesRequestValidationError) Error() string {
+func (e UpdateProjectVariablesRequestValidationError) Error() string {
 	cause := ""
 	if e.cause != nil {
 		cause = fmt.Sprintf(" | caused by: %v", e.cause)
@@ -4810,14 +4912,14 @@ func (e TriggerRefreshSourcesRequestValidationError) Error() string {
 	}
 
 	return fmt.Sprintf(
-		"invalid %sTriggerRefreshSourcesRequest.%s: %s%s",
+		"invalid %sUpdateProjectVariablesRequest.%s: %s%s",
 		key,
 		e.field,
 		e.reason,
 		cause)
 }
 
-var _ error = TriggerRefreshSourcesRequestValidationError{}
+var _ error = UpdateProjectVariablesRequestValidationError{}
 
 var _ interface {
 	Field() string
@@ -4825,44 +4927,46 @@ var _ interface {
 	Key() bool
 	Cause() error
 	ErrorName() string
-} = TriggerRefreshSourcesRequestValidationError{}
+} = UpdateProjectVariablesRequestValidationError{}
 
-// Validate checks the field values on TriggerRefreshSourcesResponse with the
+// Validate checks the field values on UpdateProjectVariablesResponse with the
 // rules defined in the proto definition for this message. If any rules are
 // violated, the first error encountered is returned, or nil if there are no violations.
-func (m *TriggerRefreshSourcesResponse) Validate() error {
+func (m *UpdateProjectVariablesResponse) Validate() error {
 	return m.validate(false)
 }
 
-// ValidateAll checks the field values on TriggerRefreshSourcesResponse with
+// ValidateAll checks the field values on UpdateProjectVariablesResponse with
 // the rules defined in the proto definition for this message. If any rules
 // are violated, the result is a list of violation errors wrapped in
-// TriggerRefreshSourcesResponseMultiError, or nil if none found.
-func (m *TriggerRefreshSourcesResponse) ValidateAll() error {
+// UpdateProjectVariablesResponseMultiError, or nil if none found.
+func (m *UpdateProjectVariablesResponse) ValidateAll() error {
 	return m.validate(true)
 }
 
-func (m *TriggerRefreshSourcesResponse) validate(all bool) error {
+func (m *UpdateProjectVariablesResponse) validate(all bool) error {
 	if m == nil {
 		return nil
 	}
 
 	var errors []error
 
+	// no validation rules for Variables
+
 	if len(errors) > 0 {
-		return TriggerRefreshSourcesResponseMultiError(errors)
+		return UpdateProjectVariablesResponseMultiError(errors)
 	}
 
 	return nil
 }
 
-// TriggerRefreshSourcesResponseMultiError is an error wrapping multiple
-// validation errors returned by TriggerRefreshSourcesResponse.ValidateAll()
+// UpdateProjectVariablesResponseMultiError is an error wrapping multiple
+// validation errors returned by UpdateProjectVariablesResponse.ValidateAll()
 // if the designated constraints aren't met.
-type TriggerRefreshSourcesResponseMultiError []error
+type UpdateProjectVariablesResponseMultiError []error
 
 // Error returns a concatenation of all the error messages it wraps.
-func (m TriggerRefreshSourcesResponseMultiError) Error() string {
+func (m UpdateProjectVariablesResponseMultiError) Error() string {
 	var msgs []string
 	for _, err := range m {
 		msgs = append(msgs, err.Error())
@@ -4871,12 +4975,12 @@ func (m TriggerRefreshSourcesResponseMultiError) Error() string {
 }
 
 // AllErrors returns a list of validation violation errors.
-func (m TriggerRefreshSourcesResponseMultiError) AllErrors() []error { return m }
+func (m UpdateProjectVariablesResponseMultiError) AllErrors() []error { return m }
 
-// TriggerRefreshSourcesResponseValidationError is the validation error
-// returned by TriggerRefreshSourcesResponse.Validate if the designated
+// UpdateProjectVariablesResponseValidationError is the validation error
+// returned by UpdateProjectVariablesResponse.Validate if the designated
 // constraints aren't met.
-type TriggerRefreshSourcesResponseValidationError struct {
+type UpdateProjectVariablesResponseValidationError struct {
 	field  string
 	reason string
 	cause  error
@@ -4884,24 +4988,24 @@ type TriggerRefreshSourcesResponseValidationError struct {
 }
 
 // Field function returns field value.
-func (e TriggerRefreshSourcesResponseValidationError) Field() string { return e.field }
+func (e UpdateProjectVariablesResponseValidationError) Field() string { return e.field }
 
 // Reason function returns reason value.
-func (e TriggerRefreshSourcesResponseValidationError) Reason() string { return e.reason }
+func (e UpdateProjectVariablesResponseValidationError) Reason() string { return e.reason }
 
 // Cause function returns cause value.
-func (e TriggerRefreshSourcesResponseValidationError) Cause() error { return e.cause }
+func (e UpdateProjectVariablesResponseValidationError) Cause() error { return e.cause }
 
 // Key function returns key value.
-func (e TriggerRefreshSourcesResponseValidationError) Key() bool { return e.key }
+func (e UpdateProjectVariablesResponseValidationError) Key() bool { return e.key }
 
 // ErrorName returns error name.
-func (e TriggerRefreshSourcesResponseValidationError) ErrorName() string {
-	return "TriggerRefreshSourcesResponseValidationError"
+func (e UpdateProjectVariablesResponseValidationError) ErrorName() string {
+	return "UpdateProjectVariablesResponseValidationError"
 }
 
 // Error satisfies the builtin error interface
-func (e TriggerRefreshSourcesResponseValidationError) Error() string {
+func (e UpdateProjectVariablesResponseValidationError) Error() string {
 	cause := ""
 	if e.cause != nil {
 		cause = fmt.Sprintf(" | caused by: %v", e.cause)
@@ -4913,14 +5017,14 @@ func (e TriggerRefreshSourcesResponseValidationError) Error() string {
 	}
 
 	return fmt.Sprintf(
-		"invalid %sTriggerRefreshSourcesResponse.%s: %s%s",
+		"invalid %sUpdateProjectVariablesResponse.%s: %s%s",
 		key,
 		e.field,
 		e.reason,
 		cause)
 }
 
-var _ error = TriggerRefreshSourcesResponseValidationError{}
+var _ error = UpdateProjectVariablesResponseValidationError{}
 
 var _ interface {
 	Field() string
@@ -4928,50 +5032,46 @@ var _ interface {
 	Key() bool
 	Cause() error
 	ErrorName() string
-} = TriggerRefreshSourcesResponseValidationError{}
+} = UpdateProjectVariablesResponseValidationError{}
 
-// Validate checks the field values on TriggerRedeployRequest with the rules
+// Validate checks the field values on TriggerReconcileRequest with the rules
 // defined in the proto definition for this message. If any rules are
 // violated, the first error encountered is returned, or nil if there are no violations.
-func (m *TriggerRedeployRequest) Validate() error {
+func (m *TriggerReconcileRequest) Validate() error {
 	return m.validate(false)
 }
 
-// ValidateAll checks the field values on TriggerRedeployRequest with the rules
-// defined in the proto definition for this message. If any rules are
+// ValidateAll checks the field values on TriggerReconcileRequest with the
+// rules defined in the proto definition for this message. If any rules are
 // violated, the result is a list of violation errors wrapped in
-// TriggerRedeployRequestMultiError, or nil if none found.
-func (m *TriggerRedeployRequest) ValidateAll() error {
+// TriggerReconcileRequestMultiError, or nil if none found.
+func (m *TriggerReconcileRequest) ValidateAll() error {
 	return m.validate(true)
 }
 
-func (m *TriggerRedeployRequest) validate(all bool) error {
+func (m *TriggerReconcileRequest) validate(all bool) error {
 	if m == nil {
 		return nil
 	}
 
 	var errors []error
 
-	// no validation rules for Organization
-
-	// no validation rules for Project
-
 	// no validation rules for DeploymentId
 
 	if len(errors) > 0 {
-		return TriggerRedeployRequestMultiError(errors)
+		return TriggerReconcileRequestMultiError(errors)
 	}
 
 	return nil
 }
 
-// TriggerRedeployRequestMultiError is an error wrapping multiple validation
-// errors returned by TriggerRedeployRequest.ValidateAll() if the designated
+// TriggerReconcileRequestMultiError is an error wrapping multiple validation
+// errors returned by TriggerReconcileRequest.ValidateAll() if the designated
 // constraints aren't met.
-type TriggerRedeployRequestMultiError []error
+type TriggerReconcileRequestMultiError []error
 
 // Error returns a concatenation of all the error messages it wraps.
-func (m TriggerRedeployRequestMultiError) Error() string {
+func (m TriggerReconcileRequestMultiError) Error() string {
 	var msgs []string
 	for _, err := range m {
 		msgs = append(msgs, err.Error())
@@ -4980,11 +5080,11 @@ func (m TriggerRedeployRequestMultiError) Error() string {
 }
 
 // AllErrors returns a list of validation violation errors.
-func (m TriggerRedeployRequestMultiError) AllErrors() []error { return m }
+func (m TriggerReconcileRequestMultiError) AllErrors() []error { return m }
 
-// TriggerRedeployRequestValidationError is the validation error returned by
-// TriggerRedeployRequest.Validate if the designated constraints aren't met.
-type TriggerRedeployRequestValidationError struct {
+// TriggerReconcileRequestValidationError is the validation error returned by
+// TriggerReconcileRequest.Validate if the designated constraints aren't met.
+type TriggerReconcileRequestValidationError struct {
 	field  string
 	reason string
 	cause  error
@@ -4992,24 +5092,24 @@ type TriggerRedeployRequestValidationError struct {
 }
 
 // Field function returns field value.
-func (e TriggerRedeployRequestValidationError) Field() string { return e.field }
+func (e TriggerReconcileRequestValidationError) Field() string { return e.field }
 
 // Reason function returns reason value.
-func (e TriggerRedeployRequestValidationError) Reason() string { return e.reason }
+func (e TriggerReconcileRequestValidationError) Reason() string { return e.reason }
 
 // Cause function returns cause value.
-func (e TriggerRedeployRequestValidationError) Cause() error { return e.cause }
+func (e TriggerReconcileRequestValidationError) Cause() error { return e.cause }
 
 // Key function returns key value.
-func (e TriggerRedeployRequestValidationError) Key() bool { return e.key }
+func (e TriggerReconcileRequestValidationError) Key() bool { return e.key }
 
 // ErrorName returns error name.
-func (e TriggerRedeployRequestValidationError) ErrorName() string {
-	return "TriggerRedeployRequestValidationError"
+func (e TriggerReconcileRequestValidationError) ErrorName() string {
+	return "TriggerReconcileRequestValidationError"
 }
 
 // Error satisfies the builtin error interface
-func (e TriggerRedeployRequestValidationError) Error() string {
+func (e TriggerReconcileRequestValidationError) Error() string {
 	cause := ""
 	if e.cause != nil {
 		cause = fmt.Sprintf(" | caused by: %v", e.cause)
@@ -5021,14 +5121,14 @@ func (e TriggerRedeployRequestValidationError) Error() string {
 	}
 
 	return fmt.Sprintf(
-		"invalid %sTriggerRedeployRequest.%s: %s%s",
+		"invalid %sTriggerReconcileRequest.%s: %s%s",
 		key,
 		e.field,
 		e.reason,
 		cause)
 }
 
-var _ error = TriggerRedeployRequestValidationError{}
+var _ error = TriggerReconcileRequestValidationError{}
 
 var _ interface {
 	Field() string
@@ -5036,24 +5136,24 @@ var _ interface {
 	Key() bool
 	Cause() error
 	ErrorName() string
-} = TriggerRedeployRequestValidationError{}
+} = TriggerReconcileRequestValidationError{}
 
-// Validate checks the field values on TriggerRedeployResponse with the rules
+// Validate checks the field values on TriggerReconcileResponse with the rules
 // defined in the proto definition for this message. If any rules are
 // violated, the first error encountered is returned, or nil if there are no violations.
-func (m *TriggerRedeployResponse) Validate() error {
+func (m *TriggerReconcileResponse) Validate() error {
 	return m.validate(false)
 }
 
-// ValidateAll checks the field values on TriggerRedeployResponse with the
+// ValidateAll checks the field values on TriggerReconcileResponse with the
 // rules defined in the proto definition for this message. If any rules are
 // violated, the result is a list of violation errors wrapped in
-// TriggerRedeployResponseMultiError, or nil if none found.
-func (m *TriggerRedeployResponse) ValidateAll() error {
+// TriggerReconcileResponseMultiError, or nil if none found.
+func (m *TriggerReconcileResponse) ValidateAll() error {
 	return m.validate(true)
 }
 
-func (m *TriggerRedeployResponse) validate(all bool) error {
+func (m *TriggerReconcileResponse) validate(all bool) error {
 	if m == nil {
 		return nil
 	}
@@ -5061,19 +5161,19 @@ func (m *TriggerRedeployResponse) validate(all bool) error {
 	var errors []error
 
 	if len(errors) > 0 {
-		return TriggerRedeployResponseMultiError(errors)
+		return TriggerReconcileResponseMultiError(errors)
 	}
 
 	return nil
 }
 
-// TriggerRedeployResponseMultiError is an error wrapping multiple validation
-// errors returned by TriggerRedeployResponse.ValidateAll() if the designated
+// TriggerReconcileResponseMultiError is an error wrapping multiple validation
+// errors returned by TriggerReconcileResponse.ValidateAll() if the designated
 // constraints aren't met.
-type TriggerRedeployResponseMultiError []error
+type TriggerReconcileResponseMultiError []error
 
 // Error returns a concatenation of all the error messages it wraps.
-func (m TriggerRedeployResponseMultiError) Error() string {
+func (m TriggerReconcileResponseMultiError) Error() string {
 	var msgs []string
 	for _, err := range m {
 		msgs = append(msgs, err.Error())
@@ -5082,11 +5182,11 @@ func (m TriggerRedeployResponseMultiError) Error() string {
 }
 
 // AllErrors returns a list of validation violation errors.
-func (m TriggerRedeployResponseMultiError) AllErrors() []error { return m }
+func (m TriggerReconcileResponseMultiError) AllErrors() []error { return m }
 
-// TriggerRedeployResponseValidationError is the validation error returned by
-// TriggerRedeployResponse.Validate if the designated constraints aren't met.
-type TriggerRedeployResponseValidationError struct {
+// TriggerReconcileResponseValidationError is the validation error returned by
+// TriggerReconcileResponse.Validate if the designated constraints aren't met.
+type TriggerReconcileResponseValidationError struct {
 	field  string
 	reason string
 	cause  error
@@ -5094,24 +5194,24 @@ type TriggerRedeployResponseValidationError struct {
 }
 
 // Field function returns field value.
-func (e TriggerRedeployResponseValidationError) Field() string { return e.field }
+func (e TriggerReconcileResponseValidationError) Field() string { return e.field }
 
 // Reason function returns reason value.
-func (e TriggerRedeployResponseValidationError) Reason() string { return e.reason }
+func (e TriggerReconcileResponseValidationError) Reason() string { return e.reason }
 
 // Cause function returns cause value.
-func (e TriggerRedeployResponseValidationError) Cause() error { return e.cause }
+func (e TriggerReconcileResponseValidationError) Cause() error { return e.cause }
 
 // Key function returns key value.
-func (e TriggerRedeployResponseValidationError) Key() bool { return e.key }
+func (e TriggerReconcileResponseValidationError) Key() bool { return e.key }
 
 // ErrorName returns error name.
-func (e TriggerRedeployResponseValidationError) ErrorName() string {
-	return "TriggerRedeployResponseValidationError"
+func (e TriggerReconcileResponseValidationError) ErrorName() string {
+	return "TriggerReconcileResponseValidationError"
 }
 
 // Error satisfies the builtin error interface
-func (e TriggerRedeployResponseValidationError) Error() string {
+func (e TriggerReconcileResponseValidationError) Error() string {
 	cause := ""
 	if e.cause != nil {
 		cause = fmt.Sprintf(" | caused by: %v", e.cause)
@@ -5123,14 +5223,14 @@ func (e TriggerRedeployResponseValidationError) Error() string {
 	}
 
 	return fmt.Sprintf(
-		"invalid %sTriggerRedeployResponse.%s: %s%s",
+		"invalid %sTriggerReconcileResponse.%s: %s%s",
 		key,
 		e.field,
 		e.reason,
 		cause)
 }
 
-var _ error = TriggerRedeployResponseValidationError{}
+var _ error = TriggerReconcileResponseValidationError{}
 
 var _ interface {
 	Field() string
@@ -5138,63 +5238,46 @@ var _ interface {
 	Key() bool
 	Cause() error
 	ErrorName() string
-} = TriggerRedeployResponseValidationError{}
+} = TriggerReconcileResponseValidationError{}
 
-// Validate checks the field values on ListOrganizationMembersRequest with the
+// Validate checks the field values on TriggerRefreshSourcesRequest with the
 // rules defined in the proto definition for this message. If any rules are
 // violated, the first error encountered is returned, or nil if there are no violations.
-func (m *ListOrganizationMembersRequest) Validate() error {
+func (m *TriggerRefreshSourcesRequest) Validate() error {
 	return m.validate(false)
 }
 
-// ValidateAll checks the field values on ListOrganizationMembersRequest with
-// the rules defined in the proto definition for this message. If any rules
-// are violated, the result is a list of violation errors wrapped in
-// ListOrganizationMembersRequestMultiError, or nil if none found.
-func (m *ListOrganizationMembersRequest) ValidateAll() error {
+// ValidateAll checks the field values on TriggerRefreshSourcesRequest with the
+// rules defined in the proto definition for this message. If any rules are
+// violated, the result is a list of violation errors wrapped in
+// TriggerRefreshSourcesRequestMultiError, or nil if none found.
+func (m *TriggerRefreshSourcesRequest) ValidateAll() error {
 	return m.validate(true)
 }
 
-func (m *ListOrganizationMembersRequest) validate(all bool) error {
+func (m *TriggerRefreshSourcesRequest) validate(all bool) error {
 	if m == nil {
 		return nil
 	}
 
 	var errors []error
 
-	// no validation rules for Organization
-
-	if m.GetPageSize() != 0 {
-
-		if m.GetPageSize() > 1000 {
-			err := ListOrganizationMembersRequestValidationError{
-				field:  "PageSize",
-				reason: "value must be less than or equal to 1000",
-			}
-			if !all {
-				return err
-			}
-			errors = append(errors, err)
-		}
-
-	}
-
-	// no validation rules for PageToken
+	// no validation rules for DeploymentId
 
 	if len(errors) > 0 {
-		return ListOrganizationMembersRequestMultiError(errors)
+		return TriggerRefreshSourcesRequestMultiError(errors)
 	}
 
 	return nil
 }
 
-// ListOrganizationMembersRequestMultiError is an error wrapping multiple
-// validation errors returned by ListOrganizationMembersRequest.ValidateAll()
-// if the designated constraints aren't met.
-type ListOrganizationMembersRequestMultiError []error
+// TriggerRefreshSourcesRequestMultiError is an error wrapping multiple
+// validation errors returned by TriggerRefreshSourcesRequest.ValidateAll() if
+// the designated constraints aren't met.
+type TriggerRefreshSourcesRequestMultiError []error
 
 // Error returns a concatenation of all the error messages it wraps.
-func (m ListOrganizationMembersRequestMultiError) Error() string {
+func (m TriggerRefreshSourcesRequestMultiError) Error() string {
 	var msgs []string
 	for _, err := range m {
 		msgs = append(msgs, err.Error())
@@ -5203,12 +5286,12 @@ func (m ListOrganizationMembersRequestMultiError) Error() string {
 }
 
 // AllErrors returns a list of validation violation errors.
-func (m ListOrganizationMembersRequestMultiError) AllErrors() []error { return m }
+func (m TriggerRefreshSourcesRequestMultiError) AllErrors() []error { return m }
 
-// ListOrganizationMembersRequestValidationError is the validation error
-// returned by ListOrganizationMembersRequest.Validate if the designated
-// constraints aren't met.
-type ListOrganizationMembersRequestValidationError struct {
+// TriggerRefreshSourcesRequestValidationError is the validation error returned
+// by TriggerRefreshSourcesRequest.Validate if the designated constraints
+// aren't met.
+type TriggerRefreshSourcesRequestValidationError struct {
 	field  string
 	reason string
 	cause  error
@@ -5216,24 +5299,24 @@ type ListOrganizationMembersRequestValidationError struct {
 }
 
 // Field function returns field value.
-func (e ListOrganizationMembersRequestValidationError) Field() string { return e.field }
+func (e TriggerRefreshSourcesRequestValidationError) Field() string { return e.field }
 
 // Reason function returns reason value.
-func (e ListOrganizationMembersRequestValidationError) Reason() string { return e.reason }
+func (e TriggerRefreshSourcesRequestValidationError) Reason() string { return e.reason }
 
 // Cause function returns cause value.
-func (e ListOrganizationMembersRequestValidationError) Cause() error { return e.cause }
+func (e TriggerRefreshSourcesRequestValidationError) Cause() error { return e.cause }
 
 // Key function returns key value.
-func (e ListOrganizationMembersRequestValidationError) Key() bool { return e.key }
+func (e TriggerRefreshSourcesRequestValidationError) Key() bool { return e.key }
 
 // ErrorName returns error name.
-func (e ListOrganizationMembersRequestValidationError) ErrorName() string {
-	return "ListOrganizationMembersRequestValidationError"
+func (e TriggerRefreshSourcesRequestValidationError) ErrorName() string {
+	return "TriggerRefreshSourcesRequestValidationError"
 }
 
 // Error satisfies the builtin error interface
-func (e ListOrganizationMembersRequestValidationError) Error() string {
+func (e TriggerRefreshSourcesRequestValidationError) Error() string {
 	cause := ""
 	if e.cause != nil {
 		cause = fmt.Sprintf(" | caused by: %v", e.cause)
@@ -5245,14 +5328,14 @@ func (e ListOrganizationMembersRequestValidationError) Error() string {
 	}
 
 	return fmt.Sprintf(
-		"invalid %sListOrganizationMembersRequest.%s: %s%s",
+		"invalid %sTriggerRefreshSourcesRequest.%s: %s%s",
 		key,
 		e.field,
 		e.reason,
 		cause)
 }
 
-var _ error = ListOrganizationMembersRequestValidationError{}
+var _ error = TriggerRefreshSourcesRequestValidationError{}
 
 var _ interface {
 	Field() string
@@ -5260,80 +5343,44 @@ var _ interface {
 	Key() bool
 	Cause() error
 	ErrorName() string
-} = ListOrganizationMembersRequestValidationError{}
+} = TriggerRefreshSourcesRequestValidationError{}
 
-// Validate checks the field values on ListOrganizationMembersResponse with the
+// Validate checks the field values on TriggerRefreshSourcesResponse with the
 // rules defined in the proto definition for this message. If any rules are
 // violated, the first error encountered is returned, or nil if there are no violations.
-func (m *ListOrganizationMembersResponse) Validate() error {
+func (m *TriggerRefreshSourcesResponse) Validate() error {
 	return m.validate(false)
 }
 
-// ValidateAll checks the field values on ListOrganizationMembersResponse with
+// ValidateAll checks the field values on TriggerRefreshSourcesResponse with
 // the rules defined in the proto definition for this message. If any rules
 // are violated, the result is a list of violation errors wrapped in
-// ListOrganizationMembersResponseMultiError, or nil if none found.
-func (m *ListOrganizationMembersResponse) ValidateAll() error {
+// TriggerRefreshSourcesResponseMultiError, or nil if none found.
+func (m *TriggerRefreshSourcesResponse) ValidateAll() error {
 	return m.validate(true)
 }
 
-func (m *ListOrganizationMembersResponse) validate(all bool) error {
+func (m *TriggerRefreshSourcesResponse) validate(all bool) error {
 	if m == nil {
 		return nil
 	}
 
 	var errors []error
 
-	for idx, item := range m.GetMembers() {
-		_, _ = idx, item
-
-		if all {
-			switch v := interface{}(item).(type) {
-			case interface{ ValidateAll() error }:
-				if err := v.ValidateAll(); err != nil {
-					errors = append(errors, ListOrganizationMembersResponseValidationError{
-						field:  fmt.Sprintf("Members[%v]", idx),
-						reason: "embedded message failed validation",
-						cause:  err,
-					})
-				}
-			case interface{ Validate() error }:
-				if err := v.Validate(); err != nil {
-					errors = append(errors, ListOrganizationMembersResponseValidationError{
-						field:  fmt.Sprintf("Members[%v]", idx),
-						reason: "embedded message failed validation",
-						cause:  err,
-					})
-				}
-			}
-		} else if v, ok := interface{}(item).(interface{ Validate() error }); ok {
-			if err := v.Validate(); err != nil {
-				return ListOrganizationMembersResponseValidationError{
-					field:  fmt.Sprintf("Members[%v]", idx),
-					reason: "embedded message failed validation",
-					cause:  err,
-				}
-			}
-		}
-
-	}
-
-	// no validation rules for NextPageToken
-
 	if len(errors) > 0 {
-		return ListOrganizationMembersResponseMultiError(errors)
+		return TriggerRefreshSourcesResponseMultiError(errors)
 	}
 
 	return nil
 }
 
-// ListOrganizationMembersResponseMultiError is an error wrapping multiple
-// validation errors returned by ListOrganizationMembersResponse.ValidateAll()
+// TriggerRefreshSourcesResponseMultiError is an error wrapping multiple
+// validation errors returned by TriggerRefreshSourcesResponse.ValidateAll()
 // if the designated constraints aren't met.
-type ListOrganizationMembersResponseMultiError []error
+type TriggerRefreshSourcesResponseMultiError []error
 
 // Error returns a concatenation of all the error messages it wraps.
-func (m ListOrganizationMembersResponseMultiError) Error() string {
+func (m TriggerRefreshSourcesResponseMultiError) Error() string {
 	var msgs []string
 	for _, err := range m {
 		msgs = append(msgs, err.Error())
@@ -5342,12 +5389,12 @@ func (m ListOrganizationMembersResponseMultiError) Error() string {
 }
 
 // AllErrors returns a list of validation violation errors.
-func (m ListOrganizationMembersResponseMultiError) AllErrors() []error { return m }
+func (m TriggerRefreshSourcesResponseMultiError) AllErrors() []error { return m }
 
-// ListOrganizationMembersResponseValidationError is the validation error
-// returned by ListOrganizationMembersResponse.Validate if the designated
+// TriggerRefreshSourcesResponseValidationError is the validation error
+// returned by TriggerRefreshSourcesResponse.Validate if the designated
 // constraints aren't met.
-type ListOrganizationMembersResponseValidationError struct {
+type TriggerRefreshSourcesResponseValidationError struct {
 	field  string
 	reason string
 	cause  error
@@ -5355,24 +5402,24 @@ type ListOrganizationMembersResponseValidationError struct {
 }
 
 // Field function returns field value.
-func (e ListOrganizationMembersResponseValidationError) Field() string { return e.field }
+func (e TriggerRefreshSourcesResponseValidationError) Field() string { return e.field }
 
 // Reason function returns reason value.
-func (e ListOrganizationMembersResponseValidationError) Reason() string { return e.reason }
+func (e TriggerRefreshSourcesResponseValidationError) Reason() string { return e.reason }
 
 // Cause function returns cause value.
-func (e ListOrganizationMembersResponseValidationError) Cause() error { return e.cause }
+func (e TriggerRefreshSourcesResponseValidationError) Cause() error { return e.cause }
 
 // Key function returns key value.
-func (e ListOrganizationMembersResponseValidationError) Key() bool { return e.key }
+func (e TriggerRefreshSourcesResponseValidationError) Key() bool { return e.key }
 
 // ErrorName returns error name.
-func (e ListOrganizationMembersResponseValidationError) ErrorName() string {
-	return "ListOrganizationMembersResponseValidationError"
+func (e TriggerRefreshSourcesResponseValidationError) ErrorName() string {
+	return "TriggerRefreshSourcesResponseValidationError"
 }
 
 // Error satisfies the builtin error interface
-func (e ListOrganizationMembersResponseValidationError) Error() string {
+func (e TriggerRefreshSourcesResponseValidationError) Error() string {
 	cause := ""
 	if e.cause != nil {
 		cause = fmt.Sprintf(" | caused by: %v", e.cause)
@@ -5384,14 +5431,14 @@ func (e ListOrganizationMembersResponseValidationError) Error() string {
 	}
 
 	return fmt.Sprintf(
-		"invalid %sListOrganizationMembersResponse.%s: %s%s",
+		"invalid %sTriggerRefreshSourcesResponse.%s: %s%s",
 		key,
 		e.field,
 		e.reason,
 		cause)
 }
 
-var _ error = ListOrganizationMembersResponseValidationError{}
+var _ error = TriggerRefreshSourcesResponseValidationError{}
 
 var _ interface {
 	Field() string
@@ -5399,24 +5446,24 @@ var _ interface {
 	Key() bool
 	Cause() error
 	ErrorName() string
-} = ListOrganizationMembersResponseValidationError{}
+} = TriggerRefreshSourcesResponseValidationError{}
 
-// Validate checks the field values on ListOrganizationInvitesRequest with the
-// rules defined in the proto definition for this message. If any rules are
+// Validate checks the field values on TriggerRedeployRequest with the rules
+// defined in the proto definition for this message. If any rules are
 // violated, the first error encountered is returned, or nil if there are no violations.
-func (m *ListOrganizationInvitesRequest) Validate() error {
+func (m *TriggerRedeployRequest) Validate() error {
 	return m.validate(false)
 }
 
-// ValidateAll checks the field values on ListOrganizationInvitesRequest with
-// the rules defined in the proto definition for this message. If any rules
-// are violated, the result is a list of violation errors wrapped in
-// ListOrganizationInvitesRequestMultiError, or nil if none found.
-func (m *ListOrganizationInvitesRequest) ValidateAll() error {
+// ValidateAll checks the field values on TriggerRedeployRequest with the rules
+// defined in the proto definition for this message. If any rules are
+// violated, the result is a list of violation errors wrapped in
+// TriggerRedeployRequestMultiError, or nil if none found.
+func (m *TriggerRedeployRequest) ValidateAll() error {
 	return m.validate(true)
 }
 
-func (m *ListOrganizationInvitesRequest) validate(all bool) error {
+func (m *TriggerRedeployRequest) validate(all bool) error {
 	if m == nil {
 		return nil
 	}
@@ -5425,37 +5472,24 @@ func (m *ListOrganizationInvitesRequest) validate(all bool) error {
 
 	// no validation rules for Organization
 
-	if m.GetPageSize() != 0 {
-
-		if m.GetPageSize() > 1000 {
-			err := ListOrganizationInvitesRequestValidationError{
-				field:  "PageSize",
-				reason: "value must be less than or equal to 1000",
-			}
-			if !all {
-				return err
-			}
-			errors = append(errors, err)
-		}
-
-	}
+	// no validation rules for Project
 
-	// no validation rules for PageToken
+	// no validation rules for DeploymentId
 
 	if len(errors) > 0 {
-		return ListOrganizationInvitesRequestMultiError(errors)
+		return TriggerRedeployRequestMultiError(errors)
 	}
 
 	return nil
 }
 
-// ListOrganizationInvitesRequestMultiError is an error wrapping multiple
-// validation errors returned by ListOrganizationInvitesRequest.ValidateAll()
-// if the designated constraints aren't met.
-type ListOrganizationInvitesRequestMultiError []error
+// TriggerRedeployRequestMultiError is an error wrapping multiple validation
+// errors returned by TriggerRedeployRequest.ValidateAll() if the designated
+// constraints aren't met.
+type TriggerRedeployRequestMultiError []error
 
 // Error returns a concatenation of all the error messages it wraps.
-func (m ListOrganizationInvitesRequestMultiError) Error() string {
+func (m TriggerRedeployRequestMultiError) Error() string {
 	var msgs []string
 	for _, err := range m {
 		msgs = append(msgs, err.Error())
@@ -5464,12 +5498,11 @@ func (m ListOrganizationInvitesRequestMultiError) Error() string {
 }
 
 // AllErrors returns a list of validation violation errors.
-func (m ListOrganizationInvitesRequestMultiError) AllErrors() []error { return m }
+func (m TriggerRedeployRequestMultiError) AllErrors() []error { return m }
 
-// ListOrganizationInvitesRequestValidationError is the validation error
-// returned by ListOrganizationInvitesRequest.Validate if the designated
-// constraints aren't met.
-type ListOrganizationInvitesRequestValidationError struct {
+// TriggerRedeployRequestValidationError is the validation error returned by
+// TriggerRedeployRequest.Validate if the designated constraints aren't met.
+type TriggerRedeployRequestValidationError struct {
 	field  string
 	reason string
 	cause  error
@@ -5477,24 +5510,24 @@ type ListOrganizationInvitesRequestValidationError struct {
 }
 
 // Field function returns field value.
-func (e ListOrganizationInvitesRequestValidationError) Field() string { return e.field }
+func (e TriggerRedeployRequestValidationError) Field() string { return e.field }
 
 // Reason function returns reason value.
-func (e ListOrganizationInvitesRequestValidationError) Reason() string { return e.reason }
+func (e TriggerRedeployRequestValidationError) Reason() string { return e.reason }
 
 // Cause function returns cause value.
-func (e ListOrganizationInvitesRequestValidationError) Cause() error { return e.cause }
+func (e TriggerRedeployRequestValidationError) Cause() error { return e.cause }
 
 // Key function returns key value.
-func (e ListOrganizationInvitesRequestValidationError) Key() bool { return e.key }
+func (e TriggerRedeployRequestValidationError) Key() bool { return e.key }
 
 // ErrorName returns error name.
-func (e ListOrganizationInvitesRequestValidationError) ErrorName() string {
-	return "ListOrganizationInvitesRequestValidationError"
+func (e TriggerRedeployRequestValidationError) ErrorName() string {
+	return "TriggerRedeployRequestValidationError"
 }
 
 // Error satisfies the builtin error interface
-func (e ListOrganizationInvitesRequestValidationError) Error() string {
+func (e TriggerRedeployRequestValidationError) Error() string {
 	cause := ""
 	if e.cause != nil {
 		cause = fmt.Sprintf(" | caused by: %v", e.cause)
@@ -5506,14 +5539,14 @@ func (e ListOrganizationInvitesRequestValidationError) Error() string {
 	}
 
 	return fmt.Sprintf(
-		"invalid %sListOrganizationInvitesRequest.%s: %s%s",
+		"invalid %sTriggerRedeployRequest.%s: %s%s",
 		key,
 		e.field,
 		e.reason,
 		cause)
 }
 
-var _ error = ListOrganizationInvitesRequestValidationError{}
+var _ error = TriggerRedeployRequestValidationError{}
 
 var _ interface {
 	Field() string
@@ -5521,80 +5554,44 @@ var _ interface {
 	Key() bool
 	Cause() error
 	ErrorName() string
-} = ListOrganizationInvitesRequestValidationError{}
+} = TriggerRedeployRequestValidationError{}
 
-// Validate checks the field values on ListOrganizationInvitesResponse with the
-// rules defined in the proto definition for this message. If any rules are
+// Validate checks the field values on TriggerRedeployResponse with the rules
+// defined in the proto definition for this message. If any rules are
 // violated, the first error encountered is returned, or nil if there are no violations.
-func (m *ListOrganizationInvitesResponse) Validate() error {
+func (m *TriggerRedeployResponse) Validate() error {
 	return m.validate(false)
 }
 
-// ValidateAll checks the field values on ListOrganizationInvitesResponse with
-// the rules defined in the proto definition for this message. If any rules
-// are violated, the result is a list of violation errors wrapped in
-// ListOrganizationInvitesResponseMultiError, or nil if none found.
-func (m *ListOrganizationInvitesResponse) ValidateAll() error {
+// ValidateAll checks the field values on TriggerRedeployResponse with the
+// rules defined in the proto definition for this message. If any rules are
+// violated, the result is a list of violation errors wrapped in
+// TriggerRedeployResponseMultiError, or nil if none found.
+func (m *TriggerRedeployResponse) ValidateAll() error {
 	return m.validate(true)
 }
 
-func (m *ListOrganizationInvitesResponse) validate(all bool) error {
+func (m *TriggerRedeployResponse) validate(all bool) error {
 	if m == nil {
 		return nil
 	}
 
 	var errors []error
 
-	for idx, item := range m.GetInvites() {
-		_, _ = idx, item
-
-		if all {
-			switch v := interface{}(item).(type) {
-			case interface{ ValidateAll() error }:
-				if err := v.ValidateAll(); err != nil {
-					errors = append(errors, ListOrganizationInvitesResponseValidationError{
-						field:  fmt.Sprintf("Invites[%v]", idx),
-						reason: "embedded message failed validation",
-						cause:  err,
-					})
-				}
-			case interface{ Validate() error }:
-				if err := v.Validate(); err != nil {
-					errors = append(errors, ListOrganizationInvitesResponseValidationError{
-						field:  fmt.Sprintf("Invites[%v]", idx),
-						reason: "embedded message failed validation",
-						cause:  err,
-					})
-				}
-			}
-		} else if v, ok := interface{}(item).(interface{ Validate() error }); ok {
-			if err := v.Validate(); err != nil {
-				return ListOrganizationInvitesResponseValidationError{
-					field:  fmt.Sprintf("Invites[%v]", idx),
-					reason: "embedded message failed validation",
-					cause:  err,
-				}
-			}
-		}
-
-	}
-
-	// no validation rules for NextPageToken
-
 	if len(errors) > 0 {
-		return ListOrganizationInvitesResponseMultiError(errors)
+		return TriggerRedeployResponseMultiError(errors)
 	}
 
 	return nil
 }
 
-// ListOrganizationInvitesResponseMultiError is an error wrapping multiple
-// validation errors returned by ListOrganizationInvitesResponse.ValidateAll()
-// if the designated constraints aren't met.
-type ListOrganizationInvitesResponseMultiError []error
+// TriggerRedeployResponseMultiError is an error wrapping multiple validation
+// errors returned by TriggerRedeployResponse.ValidateAll() if the designated
+// constraints aren't met.
+type TriggerRedeployResponseMultiError []error
 
 // Error returns a concatenation of all the error messages it wraps.
-func (m ListOrganizationInvitesResponseMultiError) Error() string {
+func (m TriggerRedeployResponseMultiError) Error() string {
 	var msgs []string
 	for _, err := range m {
 		msgs = append(msgs, err.Error())
@@ -5603,12 +5600,11 @@ func (m ListOrganizationInvitesResponseMultiError) Error() string {
 }
 
 // AllErrors returns a list of validation violation errors.
-func (m ListOrganizationInvitesResponseMultiError) AllErrors() []error { return m }
+func (m TriggerRedeployResponseMultiError) AllErrors() []error { return m }
 
-// ListOrganizationInvitesResponseValidationError is the validation error
-// returned by ListOrganizationInvitesResponse.Validate if the designated
-// constraints aren't met.
-type ListOrganizationInvitesResponseValidationError struct {
+// TriggerRedeployResponseValidationError is the validation error returned by
+// TriggerRedeployResponse.Validate if the designated constraints aren't met.
+type TriggerRedeployResponseValidationError struct {
 	field  string
 	reason string
 	cause  error
@@ -5616,24 +5612,24 @@ type ListOrganizationInvitesResponseValidationError struct {
 }
 
 // Field function returns field value.
-func (e ListOrganizationInvitesResponseValidationError) Field() string { return e.field }
+func (e TriggerRedeployResponseValidationError) Field() string { return e.field }
 
 // Reason function returns reason value.
-func (e ListOrganizationInvitesResponseValidationError) Reason() string { return e.reason }
+func (e TriggerRedeployResponseValidationError) Reason() string { return e.reason }
 
 // Cause function returns cause value.
-func (e ListOrganizationInvitesResponseValidationError) Cause() error { return e.cause }
+func (e TriggerRedeployResponseValidationError) Cause() error { return e.cause }
 
 // Key function returns key value.
-func (e ListOrganizationInvitesResponseValidationError) Key() bool { return e.key }
+func (e TriggerRedeployResponseValidationError) Key() bool { return e.key }
 
 // ErrorName returns error name.
-func (e ListOrganizationInvitesResponseValidationError) ErrorName() string {
-	return "ListOrganizationInvitesResponseValidationError"
+func (e TriggerRedeployResponseValidationError) ErrorName() string {
+	return "TriggerRedeployResponseValidationError"
 }
 
 // Error satisfies the builtin error interface
-func (e ListOrganizationInvitesResponseValidationError) Error() string {
+func (e TriggerRedeployResponseValidationError) Error() string {
 	cause := ""
 	if e.cause != nil {
 		cause = fmt.Sprintf(" | caused by: %v", e.cause)
@@ -5645,14 +5641,14 @@ func (e ListOrganizationInvitesResponseValidationError) Error() string {
 	}
 
 	return fmt.Sprintf(
-		"invalid %sListOrganizationInvitesResponse.%s: %s%s",
+		"invalid %sTriggerRedeployResponse.%s: %s%s",
 		key,
 		e.field,
 		e.reason,
 		cause)
 }
 
-var _ error = ListOrganizationInvitesResponseValidationError{}
+var _ error = TriggerRedeployResponseValidationError{}
 
 var _ interface {
 	Field() string
@@ -5660,119 +5656,63 @@ var _ interface {
 	Key() bool
 	Cause() error
 	ErrorName() string
-} = ListOrganizationInvitesResponseValidationError{}
+} = TriggerRedeployResponseValidationError{}
 
-// Validate checks the field values on AddOrganizationMemberRequest with the
+// Validate checks the field values on ListOrganizationMembersRequest with the
 // rules defined in the proto definition for this message. If any rules are
 // violated, the first error encountered is returned, or nil if there are no violations.
-func (m *AddOrganizationMemberRequest) Validate() error {
+func (m *ListOrganizationMembersRequest) Validate() error {
 	return m.validate(false)
 }
 
-// ValidateAll checks the field values on AddOrganizationMemberRequest with the
-// rules defined in the proto definition for this message. If any rules are
-// violated, the result is a list of violation errors wrapped in
-// AddOrganizationMemberRequestMultiError, or nil if none found.
-func (m *AddOrganizationMemberRequest) ValidateAll() error {
+// ValidateAll checks the field values on ListOrganizationMembersRequest with
+// the rules defined in the proto definition for this message. If any rules
+// are violated, the result is a list of violation errors wrapped in
+// ListOrganizationMembersRequestMultiError, or nil if none found.
+func (m *ListOrganizationMembersRequest) ValidateAll() error {
 	return m.validate(true)
 }
 
-func (m *AddOrganizationMemberRequest) validate(all bool) error {
+func (m *ListOrganizationMembersRequest) validate(all bool) error {
 	if m == nil {
 		return nil
 	}
 
 	var errors []error
 
-	if utf8.RuneCountInString(m.GetOrganization()) < 1 {
-		err := AddOrganizationMemberRequestValidationError{
-			field:  "Organization",
-			reason: "value length must be at least 1 runes",
-		}
-		if !all {
-			return err
-		}
-		errors = append(errors, err)
-	}
-
-	if err := m._validateEmail(m.GetEmail()); err != nil {
-		err = AddOrganizationMemberRequestValidationError{
-			field:  "Email",
-			reason: "value must be a valid email address",
-			cause:  err,
-		}
-		if !all {
-			return err
-		}
-		errors = append(errors, err)
-	}
-
-	// no validation rules for Role
-
-	if len(errors) > 0 {
-		return AddOrganizationMemberRequestMultiError(errors)
-	}
-
-	return nil
-}
-
-func (m *AddOrganizationMemberRequest) _validateHostname(host string) error {
-	s := strings.ToLower(strings.TrimSuffix(host, "."))
-
-	if len(host) > 253 {
-		return errors.New("hostname cannot exceed 253 characters")
-	}
-
-	for _, part := range strings.Split(s, ".") {
-		if l := len(part); l == 0 || l > 63 {
-			return errors.New("hostname part must be non-empty and cannot exceed 63 characters")
-		}
-
-		if part[0] == '-' {
-			return errors.New("hostname parts cannot begin with hyphens")
-		}
+	// no validation rules for Organization
 
-		if part[len(part)-1] == '-' {
-			return errors.New("hostname parts cannot end with hyphens")
-		}
+	if m.GetPageSize() != 0 {
 
-		for _, r := range part {
-			if (r < 'a' || r > 'z') && (r < '0' || r > '9') && r != '-' {
-				return fmt.Errorf("hostname parts can only contain alphanumeric characters or hyphens, got %q", string(r))
+		if m.GetPageSize() > 1000 {
+			err := ListOrganizationMembersRequestValidationError{
+				field:  "PageSize",
+				reason: "value must be less than or equal to 1000",
+			}
+			if !all {
+				return err
 			}
+			errors = append(errors, err)
 		}
-	}
-
-	return nil
-}
-
-func (m *AddOrganizationMemberRequest) _validateEmail(addr string) error {
-	a, err := mail.ParseAddress(addr)
-	if err != nil {
-		return err
-	}
-	addr = a.Address
 
-	if len(addr) > 254 {
-		return errors.New("email addresses cannot exceed 254 characters")
 	}
 
-	parts := strings.SplitN(addr, "@", 2)
+	// no validation rules for PageToken
 
-	if len(parts[0]) > 64 {
-		return errors.New("email address local phrase cannot exceed 64 characters")
+	if len(errors) > 0 {
+		return ListOrganizationMembersRequestMultiError(errors)
 	}
 
-	return m._validateHostname(parts[1])
+	return nil
 }
 
-// AddOrganizationMemberRequestMultiError is an error wrapping multiple
-// validation errors returned by AddOrganizationMemberRequest.ValidateAll() if
-// the designated constraints aren't met.
-type AddOrganizationMemberRequestMultiError []error
+// ListOrganizationMembersRequestMultiError is an error wrapping multiple
+// validation errors returned by ListOrganizationMembersRequest.ValidateAll()
+// if the designated constraints aren't met.
+type ListOrganizationMembersRequestMultiError []error
 
 // Error returns a concatenation of all the error messages it wraps.
-func (m AddOrganizationMemberRequestMultiError) Error() string {
+func (m ListOrganizationMembersRequestMultiError) Error() string {
 	var msgs []string
 	for _, err := range m {
 		msgs = append(msgs, err.Error())
@@ -5781,12 +5721,12 @@ func (m AddOrganizationMemberRequestMultiError) Error() string {
 }
 
 // AllErrors returns a list of validation violation errors.
-func (m AddOrganizationMemberRequestMultiError) AllErrors() []error { return m }
+func (m ListOrganizationMembersRequestMultiError) AllErrors() []error { return m }
 
-// AddOrganizationMemberRequestValidationError is the validation error returned
-// by AddOrganizationMemberRequest.Validate if the designated constraints
-// aren't met.
-type AddOrganizationMemberRequestValidationError struct {
+// ListOrganizationMembersRequestValidationError is the validation error
+// returned by ListOrganizationMembersRequest.Validate if the designated
+// constraints aren't met.
+type ListOrganizationMembersRequestValidationError struct {
 	field  string
 	reason string
 	cause  error
@@ -5794,24 +5734,24 @@ type AddOrganizationMemberRequestValidationError struct {
 }
 
 // Field function returns field value.
-func (e AddOrganizationMemberRequestValidationError) Field() string { return e.field }
+func (e ListOrganizationMembersRequestValidationError) Field() string { return e.field }
 
 // Reason function returns reason value.
-func (e AddOrganizationMemberRequestValidationError) Reason() string { return e.reason }
+func (e ListOrganizationMembersRequestValidationError) Reason() string { return e.reason }
 
 // Cause function returns cause value.
-func (e AddOrganizationMemberRequestValidationError) Cause() error { return e.cause }
+func (e ListOrganizationMembersRequestValidationError) Cause() error { return e.cause }
 
 // Key function returns key value.
-func (e AddOrganizationMemberRequestValidationError) Key() bool { return e.key }
+func (e ListOrganizationMembersRequestValidationError) Key() bool { return e.key }
 
 // ErrorName returns error name.
-func (e AddOrganizationMemberRequestValidationError) ErrorName() string {
-	return "AddOrganizationMemberRequestValidationError"
+func (e ListOrganizationMembersRequestValidationError) ErrorName() string {
+	return "ListOrganizationMembersRequestValidationError"
 }
 
 // Error satisfies the builtin error interface
-func (e AddOrganizationMemberRequestValidationError) Error() string {
+func (e ListOrganizationMembersRequestValidationError) Error() string {
 	cause := ""
 	if e.cause != nil {
 		cause = fmt.Sprintf(" | caused by: %v", e.cause)
@@ -5823,14 +5763,14 @@ func (e AddOrganizationMemberRequestValidationError) Error() string {
 	}
 
 	return fmt.Sprintf(
-		"invalid %sAddOrganizationMemberRequest.%s: %s%s",
+		"invalid %sListOrganizationMembersRequest.%s: %s%s",
 		key,
 		e.field,
 		e.reason,
 		cause)
 }
 
-var _ error = AddOrganizationMemberRequestValidationError{}
+var _ error = ListOrganizationMembersRequestValidationError{}
 
 var _ interface {
 	Field() string
@@ -5838,46 +5778,80 @@ var _ interface {
 	Key() bool
 	Cause() error
 	ErrorName() string
-} = AddOrganizationMemberRequestValidationError{}
+} = ListOrganizationMembersRequestValidationError{}
 
-// Validate checks the field values on AddOrganizationMemberResponse with the
+// Validate checks the field values on ListOrganizationMembersResponse with the
 // rules defined in the proto definition for this message. If any rules are
 // violated, the first error encountered is returned, or nil if there are no violations.
-func (m *AddOrganizationMemberResponse) Validate() error {
+func (m *ListOrganizationMembersResponse) Validate() error {
 	return m.validate(false)
 }
 
-// ValidateAll checks the field values on AddOrganizationMemberResponse with
+// ValidateAll checks the field values on ListOrganizationMembersResponse with
 // the rules defined in the proto definition for this message. If any rules
 // are violated, the result is a list of violation errors wrapped in
-// AddOrganizationMemberResponseMultiError, or nil if none found.
-func (m *AddOrganizationMemberResponse) ValidateAll() error {
+// ListOrganizationMembersResponseMultiError, or nil if none found.
+func (m *ListOrganizationMembersResponse) ValidateAll() error {
 	return m.validate(true)
 }
 
-func (m *AddOrganizationMemberResponse) validate(all bool) error {
+func (m *ListOrganizationMembersResponse) validate(all bool) error {
 	if m == nil {
 		return nil
 	}
 
 	var errors []error
 
-	// no validation rules for PendingSignup
+	for idx, item := range m.GetMembers() {
+		_, _ = idx, item
+
+		if all {
+			switch v := interface{}(item).(type) {
+			case interface{ ValidateAll() error }:
+				if err := v.ValidateAll(); err != nil {
+					errors = append(errors, ListOrganizationMembersResponseValidationError{
+						field:  fmt.Sprintf("Members[%v]", idx),
+						reason: "embedded message failed validation",
+						cause:  err,
+					})
+				}
+			case interface{ Validate() error }:
+				if err := v.Validate(); err != nil {
+					errors = append(errors, ListOrganizationMembersResponseValidationError{
+						field:  fmt.Sprintf("Members[%v]", idx),
+						reason: "embedded message failed validation",
+						cause:  err,
+					})
+				}
+			}
+		} else if v, ok := interface{}(item).(interface{ Validate() error }); ok {
+			if err := v.Validate(); err != nil {
+				return ListOrganizationMembersResponseValidationError{
+					field:  fmt.Sprintf("Members[%v]", idx),
+					reason: "embedded message failed validation",
+					cause:  err,
+				}
+			}
+		}
+
+	}
+
+	// no validation rules for NextPageToken
 
 	if len(errors) > 0 {
-		return AddOrganizationMemberResponseMultiError(errors)
+		return ListOrganizationMembersResponseMultiError(errors)
 	}
 
 	return nil
 }
 
-// AddOrganizationMemberResponseMultiError is an error wrapping multiple
-// validation errors returned by AddOrganizationMemberResponse.ValidateAll()
+// ListOrganizationMembersResponseMultiError is an error wrapping multiple
+// validation errors returned by ListOrganizationMembersResponse.ValidateAll()
 // if the designated constraints aren't met.
-type AddOrganizationMemberResponseMultiError []error
+type ListOrganizationMembersResponseMultiError []error
 
 // Error returns a concatenation of all the error messages it wraps.
-func (m AddOrganizationMemberResponseMultiError) Error() string {
+func (m ListOrganizationMembersResponseMultiError) Error() string {
 	var msgs []string
 	for _, err := range m {
 		msgs = append(msgs, err.Error())
@@ -5886,12 +5860,12 @@ func (m AddOrganizationMemberResponseMultiError) Error() string {
 }
 
 // AllErrors returns a list of validation violation errors.
-func (m AddOrganizationMemberResponseMultiError) AllErrors() []error { return m }
+func (m ListOrganizationMembersResponseMultiError) AllErrors() []error { return m }
 
-// AddOrganizationMemberResponseValidationError is the validation error
-// returned by AddOrganizationMemberResponse.Validate if the designated
+// ListOrganizationMembersResponseValidationError is the validation error
+// returned by ListOrganizationMembersResponse.Validate if the designated
 // constraints aren't met.
-type AddOrganizationMemberResponseValidationError struct {
+type ListOrganizationMembersResponseValidationError struct {
 	field  string
 	reason string
 	cause  error
@@ -5899,24 +5873,24 @@ type AddOrganizationMemberResponseValidationError struct {
 }
 
 // Field function returns field value.
-func (e AddOrganizationMemberResponseValidationError) Field() string { return e.field }
+func (e ListOrganizationMembersResponseValidationError) Field() string { return e.field }
 
 // Reason function returns reason value.
-func (e AddOrganizationMemberResponseValidationError) Reason() string { return e.reason }
+func (e ListOrganizationMembersResponseValidationError) Reason() string { return e.reason }
 
 // Cause function returns cause value.
-func (e AddOrganizationMemberResponseValidationError) Cause() error { return e.cause }
+func (e ListOrganizationMembersResponseValidationError) Cause() error { return e.cause }
 
 // Key function returns key value.
-func (e AddOrganizationMemberResponseValidationError) Key() bool { return e.key }
+func (e ListOrganizationMembersResponseValidationError) Key() bool { return e.key }
 
 // ErrorName returns error name.
-func (e AddOrganizationMemberResponseValidationError) ErrorName() string {
-	return "AddOrganizationMemberResponseValidationError"
+func (e ListOrganizationMembersResponseValidationError) ErrorName() string {
+	return "ListOrganizationMembersResponseValidationError"
 }
 
 // Error satisfies the builtin error interface
-func (e AddOrganizationMemberResponseValidationError) Error() string {
+func (e ListOrganizationMembersResponseValidationError) Error() string {
 	cause := ""
 	if e.cause != nil {
 		cause = fmt.Sprintf(" | caused by: %v", e.cause)
@@ -5928,14 +5902,14 @@ func (e AddOrganizationMemberResponseValidationError) Error() string {
 	}
 
 	return fmt.Sprintf(
-		"invalid %sAddOrganizationMemberResponse.%s: %s%s",
+		"invalid %sListOrganizationMembersResponse.%s: %s%s",
 		key,
 		e.field,
 		e.reason,
 		cause)
 }
 
-var _ error = AddOrganizationMemberResponseValidationError{}
+var _ error = ListOrganizationMembersResponseValidationError{}
 
 var _ interface {
 	Field() string
@@ -5943,119 +5917,63 @@ var _ interface {
 	Key() bool
 	Cause() error
 	ErrorName() string
-} = AddOrganizationMemberResponseValidationError{}
+} = ListOrganizationMembersResponseValidationError{}
 
-// Validate checks the field values on RemoveOrganizationMemberRequest with the
+// Validate checks the field values on ListOrganizationInvitesRequest with the
 // rules defined in the proto definition for this message. If any rules are
 // violated, the first error encountered is returned, or nil if there are no violations.
-func (m *RemoveOrganizationMemberRequest) Validate() error {
+func (m *ListOrganizationInvitesRequest) Validate() error {
 	return m.validate(false)
 }
 
-// ValidateAll checks the field values on RemoveOrganizationMemberRequest with
+// ValidateAll checks the field values on ListOrganizationInvitesRequest with
 // the rules defined in the proto definition for this message. If any rules
 // are violated, the result is a list of violation errors wrapped in
-// RemoveOrganizationMemberRequestMultiError, or nil if none found.
-func (m *RemoveOrganizationMemberRequest) ValidateAll() error {
+// ListOrganizationInvitesRequestMultiError, or nil if none found.
+func (m *ListOrganizationInvitesRequest) ValidateAll() error {
 	return m.validate(true)
 }
 
-func (m *RemoveOrganizationMemberRequest) validate(all bool) error {
+func (m *ListOrganizationInvitesRequest) validate(all bool) error {
 	if m == nil {
 		return nil
 	}
 
 	var errors []error
 
-	if utf8.RuneCountInString(m.GetOrganization()) < 1 {
-		err := RemoveOrganizationMemberRequestValidationError{
-			field:  "Organization",
-			reason: "value length must be at least 1 runes",
-		}
-		if !all {
-			return err
-		}
-		errors = append(errors, err)
-	}
-
-	if err := m._validateEmail(m.GetEmail()); err != nil {
-		err = RemoveOrganizationMemberRequestValidationError{
-			field:  "Email",
-			reason: "value must be a valid email address",
-			cause:  err,
-		}
-		if !all {
-			return err
-		}
-		errors = append(errors, err)
-	}
-
-	// no validation rules for KeepProjectRoles
-
-	if len(errors) > 0 {
-		return RemoveOrganizationMemberRequestMultiError(errors)
-	}
-
-	return nil
-}
-
-func (m *RemoveOrganizationMemberRequest) _validateHostname(host string) error {
-	s := strings.ToLower(strings.TrimSuffix(host, "."))
-
-	if len(host) > 253 {
-		return errors.New("hostname cannot exceed 253 characters")
-	}
-
-	for _, part := range strings.Split(s, ".") {
-		if l := len(part); l == 0 || l > 63 {
-			return errors.New("hostname part must be non-empty and cannot exceed 63 characters")
-		}
-
-		if part[0] == '-' {
-			return errors.New("hostname parts cannot begin with hyphens")
-		}
+	// no validation rules for Organization
 
-		if part[len(part)-1] == '-' {
-			return errors.New("hostname parts cannot end with hyphens")
-		}
+	if m.GetPageSize() != 0 {
 
-		for _, r := range part {
-			if (r < 'a' || r > 'z') && (r < '0' || r > '9') && r != '-' {
-				return fmt.Errorf("hostname parts can only contain alphanumeric characters or hyphens, got %q", string(r))
+		if m.GetPageSize() > 1000 {
+			err := ListOrganizationInvitesRequestValidationError{
+				field:  "PageSize",
+				reason: "value must be less than or equal to 1000",
+			}
+			if !all {
+				return err
 			}
+			errors = append(errors, err)
 		}
-	}
 
-	return nil
-}
-
-func (m *RemoveOrganizationMemberRequest) _validateEmail(addr string) error {
-	a, err := mail.ParseAddress(addr)
-	if err != nil {
-		return err
-	}
-	addr = a.Address
-
-	if len(addr) > 254 {
-		return errors.New("email addresses cannot exceed 254 characters")
 	}
 
-	parts := strings.SplitN(addr, "@", 2)
+	// no validation rules for PageToken
 
-	if len(parts[0]) > 64 {
-		return errors.New("email address local phrase cannot exceed 64 characters")
+	if len(errors) > 0 {
+		return ListOrganizationInvitesRequestMultiError(errors)
 	}
 
-	return m._validateHostname(parts[1])
+	return nil
 }
 
-// RemoveOrganizationMemberRequestMultiError is an error wrapping multiple
-// validation errors returned by RemoveOrganizationMemberRequest.ValidateAll()
+// ListOrganizationInvitesRequestMultiError is an error wrapping multiple
+// validation errors returned by ListOrganizationInvitesRequest.ValidateAll()
 // if the designated constraints aren't met.
-type RemoveOrganizationMemberRequestMultiError []error
+type ListOrganizationInvitesRequestMultiError []error
 
 // Error returns a concatenation of all the error messages it wraps.
-func (m RemoveOrganizationMemberRequestMultiError) Error() string {
+func (m ListOrganizationInvitesRequestMultiError) Error() string {
 	var msgs []string
 	for _, err := range m {
 		msgs = append(msgs, err.Error())
@@ -6064,12 +5982,12 @@ func (m RemoveOrganizationMemberRequestMultiError) Error() string {
 }
 
 // AllErrors returns a list of validation violation errors.
-func (m RemoveOrganizationMemberRequestMultiError) AllErrors() []error { return m }
+func (m ListOrganizationInvitesRequestMultiError) AllErrors() []error { return m }
 
-// RemoveOrganizationMemberRequestValidationError is the validation error
-// returned by RemoveOrganizationMemberRequest.Validate if the designated
+// ListOrganizationInvitesRequestValidationError is the validation error
+// returned by ListOrganizationInvitesRequest.Validate if the designated
 // constraints aren't met.
-type RemoveOrganizationMemberRequestValidationError struct {
+type ListOrganizationInvitesRequestValidationError struct {
 	field  string
 	reason string
 	cause  error
@@ -6077,24 +5995,24 @@ type RemoveOrganizationMemberRequestValidationError struct {
 }
 
 // Field function returns field value.
-func (e RemoveOrganizationMemberRequestValidationError) Field() string { return e.field }
+func (e ListOrganizationInvitesRequestValidationError) Field() string { return e.field }
 
 // Reason function returns reason value.
-func (e RemoveOrganizationMemberRequestValidationError) Reason() string { return e.reason }
+func (e ListOrganizationInvitesRequestValidationError) Reason() string { return e.reason }
 
 // Cause function returns cause value.
-func (e RemoveOrganizationMemberRequestValidationError) Cause() error { return e.cause }
+func (e ListOrganizationInvitesRequestValidationError) Cause() error { return e.cause }
 
 // Key function returns key value.
-func (e RemoveOrganizationMemberRequestValidationError) Key() bool { return e.key }
+func (e ListOrganizationInvitesRequestValidationError) Key() bool { return e.key }
 
 // ErrorName returns error name.
-func (e RemoveOrganizationMemberRequestValidationError) ErrorName() string {
-	return "RemoveOrganizationMemberRequestValidationError"
+func (e ListOrganizationInvitesRequestValidationError) ErrorName() string {
+	return "ListOrganizationInvitesRequestValidationError"
 }
 
 // Error satisfies the builtin error interface
-func (e RemoveOrganizationMemberRequestValidationError) Error() string {
+func (e ListOrganizationInvitesRequestValidationError) Error() string {
 	cause := ""
 	if e.cause != nil {
 		cause = fmt.Sprintf(" | caused by: %v", e.cause)
@@ -6106,14 +6024,14 @@ func (e RemoveOrganizationMemberRequestValidationError) Error() string {
 	}
 
 	return fmt.Sprintf(
-		"invalid %sRemoveOrganizationMemberRequest.%s: %s%s",
+		"invalid %sListOrganizationInvitesRequest.%s: %s%s",
 		key,
 		e.field,
 		e.reason,
 		cause)
 }
 
-var _ error = RemoveOrganizationMemberRequestValidationError{}
+var _ error = ListOrganizationInvitesRequestValidationError{}
 
 var _ interface {
 	Field() string
@@ -6121,46 +6039,80 @@ var _ interface {
 	Key() bool
 	Cause() error
 	ErrorName() string
-} = RemoveOrganizationMemberRequestValidationError{}
+} = ListOrganizationInvitesRequestValidationError{}
 
-// Validate checks the field values on RemoveOrganizationMemberResponse with
-// the rules defined in the proto definition for this message. If any rules
-// are violated, the first error encountered is returned, or nil if there are
-// no violations.
-func (m *RemoveOrganizationMemberResponse) Validate() error {
+// Validate checks the field values on ListOrganizationInvitesResponse with the
+// rules defined in the proto definition for this message. If any rules are
+// violated, the first error encountered is returned, or nil if there are no violations.
+func (m *ListOrganizationInvitesResponse) Validate() error {
 	return m.validate(false)
 }
 
-// ValidateAll checks the field values on RemoveOrganizationMemberResponse with
+// ValidateAll checks the field values on ListOrganizationInvitesResponse with
 // the rules defined in the proto definition for this message. If any rules
 // are violated, the result is a list of violation errors wrapped in
-// RemoveOrganizationMemberResponseMultiError, or nil if none found.
-func (m *RemoveOrganizationMemberResponse) ValidateAll() error {
+// ListOrganizationInvitesResponseMultiError, or nil if none found.
+func (m *ListOrganizationInvitesResponse) ValidateAll() error {
 	return m.validate(true)
 }
 
-func (m *RemoveOrganizationMemberResponse) validate(all bool) error {
+func (m *ListOrganizationInvitesResponse) validate(all bool) error {
 	if m == nil {
 		return nil
 	}
 
 	var errors []error
 
+	for idx, item := range m.GetInvites() {
+		_, _ = idx, item
+
+		if all {
+			switch v := interface{}(item).(type) {
+			case interface{ ValidateAll() error }:
+				if err := v.ValidateAll(); err != nil {
+					errors = append(errors, ListOrganizationInvitesResponseValidationError{
+						field:  fmt.Sprintf("Invites[%v]", idx),
+						reason: "embedded message failed validation",
+						cause:  err,
+					})
+				}
+			case interface{ Validate() error }:
+				if err := v.Validate(); err != nil {
+					errors = append(errors, ListOrganizationInvitesResponseValidationError{
+						field:  fmt.Sprintf("Invites[%v]", idx),
+						reason: "embedded message failed validation",
+						cause:  err,
+					})
+				}
+			}
+		} else if v, ok := interface{}(item).(interface{ Validate() error }); ok {
+			if err := v.Validate(); err != nil {
+				return ListOrganizationInvitesResponseValidationError{
+					field:  fmt.Sprintf("Invites[%v]", idx),
+					reason: "embedded message failed validation",
+					cause:  err,
+				}
+			}
+		}
+
+	}
+
+	// no validation rules for NextPageToken
+
 	if len(errors) > 0 {
-		return RemoveOrganizationMemberResponseMultiError(errors)
+		return ListOrganizationInvitesResponseMultiError(errors)
 	}
 
 	return nil
 }
 
-// RemoveOrganizationMemberResponseMultiError is an error wrapping multiple
-// validation errors returned by
-// RemoveOrganizationMemberResponse.ValidateAll() if the designated
-// constraints aren't met.
-type RemoveOrganizationMemberResponseMultiError []error
+// ListOrganizationInvitesResponseMultiError is an error wrapping multiple
+// validation errors returned by ListOrganizationInvitesResponse.ValidateAll()
+// if the designated constraints aren't met.
+type ListOrganizationInvitesResponseMultiError []error
 
 // Error returns a concatenation of all the error messages it wraps.
-func (m RemoveOrganizationMemberResponseMultiError) Error() string {
+func (m ListOrganizationInvitesResponseMultiError) Error() string {
 	var msgs []string
 	for _, err := range m {
 		msgs = append(msgs, err.Error())
@@ -6169,12 +6121,12 @@ func (m RemoveOrganizationMemberResponseMultiError) Error() string {
 }
 
 // AllErrors returns a list of validation violation errors.
-func (m RemoveOrganizationMemberResponseMultiError) AllErrors() []error { return m }
+func (m ListOrganizationInvitesResponseMultiError) AllErrors() []error { return m }
 
-// RemoveOrganizationMemberResponseValidationError is the validation error
-// returned by RemoveOrganizationMemberResponse.Validate if the designated
+// ListOrganizationInvitesResponseValidationError is the validation error
+// returned by ListOrganizationInvitesResponse.Validate if the designated
 // constraints aren't met.
-type RemoveOrganizationMemberResponseValidationError struct {
+type ListOrganizationInvitesResponseValidationError struct {
 	field  string
 	reason string
 	cause  error
@@ -6182,24 +6134,24 @@ type RemoveOrganizationMemberResponseValidationError struct {
 }
 
 // Field function returns field value.
-func (e RemoveOrganizationMemberResponseValidationError) Field() string { return e.field }
+func (e ListOrganizationInvitesResponseValidationError) Field() string { return e.field }
 
 // Reason function returns reason value.
-func (e RemoveOrganizationMemberResponseValidationError) Reason() string { return e.reason }
+func (e ListOrganizationInvitesResponseValidationError) Reason() string { return e.reason }
 
 // Cause function returns cause value.
-func (e RemoveOrganizationMemberResponseValidationError) Cause() error { return e.cause }
+func (e ListOrganizationInvitesResponseValidationError) Cause() error { return e.cause }
 
 // Key function returns key value.
-func (e RemoveOrganizationMemberResponseValidationError) Key() bool { return e.key }
+func (e ListOrganizationInvitesResponseValidationError) Key() bool { return e.key }
 
 // ErrorName returns error name.
-func (e RemoveOrganizationMemberResponseValidationError) ErrorName() string {
-	return "RemoveOrganizationMemberResponseValidationError"
+func (e ListOrganizationInvitesResponseValidationError) ErrorName() string {
+	return "ListOrganizationInvitesResponseValidationError"
 }
 
 // Error satisfies the builtin error interface
-func (e RemoveOrganizationMemberResponseValidationError) Error() string {
+func (e ListOrganizationInvitesResponseValidationError) Error() string {
 	cause := ""
 	if e.cause != nil {
 		cause = fmt.Sprintf(" | caused by: %v", e.cause)
@@ -6211,14 +6163,14 @@ func (e RemoveOrganizationMemberResponseValidationError) Error() string {
 	}
 
 	return fmt.Sprintf(
-		"invalid %sRemoveOrganizationMemberResponse.%s: %s%s",
+		"invalid %sListOrganizationInvitesResponse.%s: %s%s",
 		key,
 		e.field,
 		e.reason,
 		cause)
 }
 
-var _ error = RemoveOrganizationMemberResponseValidationError{}
+var _ error = ListOrganizationInvitesResponseValidationError{}
 
 var _ interface {
 	Field() string
@@ -6226,24 +6178,24 @@ var _ interface {
 	Key() bool
 	Cause() error
 	ErrorName() string
-} = RemoveOrganizationMemberResponseValidationError{}
+} = ListOrganizationInvitesResponseValidationError{}
 
-// Validate checks the field values on LeaveOrganizationRequest with the rules
-// defined in the proto definition for this message. If any rules are
+// Validate checks the field values on AddOrganizationMemberRequest with the
+// rules defined in the proto definition for this message. If any rules are
 // violated, the first error encountered is returned, or nil if there are no violations.
-func (m *LeaveOrganizationRequest) Validate() error {
+func (m *AddOrganizationMemberRequest) Validate() error {
 	return m.validate(false)
 }
 
-// ValidateAll checks the field values on LeaveOrganizationRequest with the
+// ValidateAll checks the field values on AddOrganizationMemberRequest with the
 // rules defined in the proto definition for this message. If any rules are
 // violated, the result is a list of violation errors wrapped in
-// LeaveOrganizationRequestMultiError, or nil if none found.
-func (m *LeaveOrganizationRequest) ValidateAll() error {
+// AddOrganizationMemberRequestMultiError, or nil if none found.
+func (m *AddOrganizationMemberRequest) ValidateAll() error {
 	return m.validate(true)
 }
 
-func (m *LeaveOrganizationRequest) validate(all bool) error {
+func (m *AddOrganizationMemberRequest) validate(all bool) error {
 	if m == nil {
 		return nil
 	}
@@ -6251,7 +6203,7 @@ func (m *LeaveOrganizationRequest) validate(all bool) error {
 	var errors []error
 
 	if utf8.RuneCountInString(m.GetOrganization()) < 1 {
-		err := LeaveOrganizationRequestValidationError{
+		err := AddOrganizationMemberRequestValidationError{
 			field:  "Organization",
 			reason: "value length must be at least 1 runes",
 		}
@@ -6261,33 +6213,98 @@ func (m *LeaveOrganizationRequest) validate(all bool) error {
 		errors = append(errors, err)
 	}
 
+	if err := m._validateEmail(m.GetEmail()); err != nil {
+		err = AddOrganizationMemberRequestValidationError{
+			field:  "Email",
+			reason: "value must be a valid email address",
+			cause:  err,
+		}
+		if !all {
+			return err
+		}
+		errors = append(errors, err)
+	}
+
+	// no validation rules for Role
+
 	if len(errors) > 0 {
-		return LeaveOrganizationRequestMultiError(errors)
+		return AddOrganizationMemberRequestMultiError(errors)
 	}
 
 	return nil
 }
 
-// LeaveOrganizationRequestMultiError is an error wrapping multiple validation
-// errors returned by LeaveOrganizationRequest.ValidateAll() if the designated
-// constraints aren't met.
-type LeaveOrganizationRequestMultiError []error
+func (m *AddOrganizationMemberRequest) _validateHostname(host string) error {
+	s := strings.ToLower(strings.TrimSuffix(host, "."))
 
-// Error returns a concatenation of all the error messages it wraps.
-func (m LeaveOrganizationRequestMultiError) Error() string {
-	var msgs []string
-	for _, err := range m {
+	if len(host) > 253 {
+		return errors.New("hostname cannot exceed 253 characters")
+	}
+
+	for _, part := range strings.Split(s, ".") {
+		if l := len(part); l == 0 || l > 63 {
+			return errors.New("hostname part must be non-empty and cannot exceed 63 characters")
+		}
+
+		if part[0] == '-' {
+			return errors.New("hostname parts cannot begin with hyphens")
+		}
+
+		if part[len(part)-1] == '-' {
+			return errors.New("hostname parts cannot end with hyphens")
+		}
+
+		for _, r := range part {
+			if (r < 'a' || r > 'z') && (r < '0' || r > '9') && r != '-' {
+				return fmt.Errorf("hostname parts can only contain alphanumeric characters or hyphens, got %q", string(r))
+			}
+		}
+	}
+
+	return nil
+}
+
+func (m *AddOrganizationMemberRequest) _validateEmail(addr string) error {
+	a, err := mail.ParseAddress(addr)
+	if err != nil {
+		return err
+	}
+	addr = a.Address
+
+	if len(addr) > 254 {
+		return errors.New("email addresses cannot exceed 254 characters")
+	}
+
+	parts := strings.SplitN(addr, "@", 2)
+
+	if len(parts[0]) > 64 {
+		return errors.New("email address local phrase cannot exceed 64 characters")
+	}
+
+	return m._validateHostname(parts[1])
+}
+
+// AddOrganizationMemberRequestMultiError is an error wrapping multiple
+// validation errors returned by AddOrganizationMemberRequest.ValidateAll() if
+// the designated constraints aren't met.
+type AddOrganizationMemberRequestMultiError []error
+
+// Error returns a concatenation of all the error messages it wraps.
+func (m AddOrganizationMemberRequestMultiError) Error() string {
+	var msgs []string
+	for _, err := range m {
 		msgs = append(msgs, err.Error())
 	}
 	return strings.Join(msgs, "; ")
 }
 
 // AllErrors returns a list of validation violation errors.
-func (m LeaveOrganizationRequestMultiError) AllErrors() []error { return m }
+func (m AddOrganizationMemberRequestMultiError) AllErrors() []error { return m }
 
-// LeaveOrganizationRequestValidationError is the validation error returned by
-// LeaveOrganizationRequest.Validate if the designated constraints aren't met.
-type LeaveOrganizationRequestValidationError struct {
+// AddOrganizationMemberRequestValidationError is the validation error returned
+// by AddOrganizationMemberRequest.Validate if the designated constraints
+// aren't met.
+type AddOrganizationMemberRequestValidationError struct {
 	field  string
 	reason string
 	cause  error
@@ -6295,24 +6312,24 @@ type LeaveOrganizationRequestValidationError struct {
 }
 
 // Field function returns field value.
-func (e LeaveOrganizationRequestValidationError) Field() string { return e.field }
+func (e AddOrganizationMemberRequestValidationError) Field() string { return e.field }
 
 // Reason function returns reason value.
-func (e LeaveOrganizationRequestValidationError) Reason() string { return e.reason }
+func (e AddOrganizationMemberRequestValidationError) Reason() string { return e.reason }
 
 // Cause function returns cause value.
-func (e LeaveOrganizationRequestValidationError) Cause() error { return e.cause }
+func (e AddOrganizationMemberRequestValidationError) Cause() error { return e.cause }
 
 // Key function returns key value.
-func (e LeaveOrganizationRequestValidationError) Key() bool { return e.key }
+func (e AddOrganizationMemberRequestValidationError) Key() bool { return e.key }
 
 // ErrorName returns error name.
-func (e LeaveOrganizationRequestValidationError) ErrorName() string {
-	return "LeaveOrganizationRequestValidationError"
+func (e AddOrganizationMemberRequestValidationError) ErrorName() string {
+	return "AddOrganizationMemberRequestValidationError"
 }
 
 // Error satisfies the builtin error interface
-func (e LeaveOrganizationRequestValidationError) Error() string {
+func (e AddOrganizationMemberRequestValidationError) Error() string {
 	cause := ""
 	if e.cause != nil {
 		cause = fmt.Sprintf(" | caused by: %v", e.cause)
@@ -6324,14 +6341,14 @@ func (e LeaveOrganizationRequestValidationError) Error() string {
 	}
 
 	return fmt.Sprintf(
-		"invalid %sLeaveOrganizationRequest.%s: %s%s",
+		"invalid %sAddOrganizationMemberRequest.%s: %s%s",
 		key,
 		e.field,
 		e.reason,
 		cause)
 }
 
-var _ error = LeaveOrganizationRequestValidationError{}
+var _ error = AddOrganizationMemberRequestValidationError{}
 
 var _ interface {
 	Field() string
@@ -6339,44 +6356,46 @@ var _ interface {
 	Key() bool
 	Cause() error
 	ErrorName() string
-} = LeaveOrganizationRequestValidationError{}
+} = AddOrganizationMemberRequestValidationError{}
 
-// Validate checks the field values on LeaveOrganizationResponse with the rules
-// defined in the proto definition for this message. If any rules are
+// Validate checks the field values on AddOrganizationMemberResponse with the
+// rules defined in the proto definition for this message. If any rules are
 // violated, the first error encountered is returned, or nil if there are no violations.
-func (m *LeaveOrganizationResponse) Validate() error {
+func (m *AddOrganizationMemberResponse) Validate() error {
 	return m.validate(false)
 }
 
-// ValidateAll checks the field values on LeaveOrganizationResponse with the
-// rules defined in the proto definition for this message. If any rules are
-// violated, the result is a list of violation errors wrapped in
-// LeaveOrganizationResponseMultiError, or nil if none found.
-func (m *LeaveOrganizationResponse) ValidateAll() error {
+// ValidateAll checks the field values on AddOrganizationMemberResponse with
+// the rules defined in the proto definition for this message. If any rules
+// are violated, the result is a list of violation errors wrapped in
+// AddOrganizationMemberResponseMultiError, or nil if none found.
+func (m *AddOrganizationMemberResponse) ValidateAll() error {
 	return m.validate(true)
 }
 
-func (m *LeaveOrganizationResponse) validate(all bool) error {
+func (m *AddOrganizationMemberResponse) validate(all bool) error {
 	if m == nil {
 		return nil
 	}
 
 	var errors []error
 
+	// no validation rules for PendingSignup
+
 	if len(errors) > 0 {
-		return LeaveOrganizationResponseMultiError(errors)
+		return AddOrganizationMemberResponseMultiError(errors)
 	}
 
 	return nil
 }
 
-// LeaveOrganizationResponseMultiError is an error wrapping multiple validation
-// errors returned by LeaveOrganizationResponse.ValidateAll() if the
-// designated constraints aren't met.
-type LeaveOrganizationResponseMultiError []error
+// AddOrganizationMemberResponseMultiError is an error wrapping multiple
+// validation errors returned by AddOrganizationMemberResponse.ValidateAll()
+// if the designated constraints aren't met.
+type AddOrganizationMemberResponseMultiError []error
 
 // Error returns a concatenation of all the error messages it wraps.
-func (m LeaveOrganizationResponseMultiError) Error() string {
+func (m AddOrganizationMemberResponseMultiError) Error() string {
 	var msgs []string
 	for _, err := range m {
 		msgs = append(msgs, err.Error())
@@ -6385,11 +6404,12 @@ func (m LeaveOrganizationResponseMultiError) Error() string {
 }
 
 // AllErrors returns a list of validation violation errors.
-func (m LeaveOrganizationResponseMultiError) AllErrors() []error { return m }
+func (m AddOrganizationMemberResponseMultiError) AllErrors() []error { return m }
 
-// LeaveOrganizationResponseValidationError is the validation error returned by
-// LeaveOrganizationResponse.Validate if the designated constraints aren't met.
-type LeaveOrganizationResponseValidationError struct {
+// AddOrganizationMemberResponseValidationError is the validation error
+// returned by AddOrganizationMemberResponse.Validate if the designated
+// constraints aren't met.
+type AddOrganizationMemberResponseValidationError struct {
 	field  string
 	reason string
 	cause  error
@@ -6397,24 +6417,24 @@ type LeaveOrganizationResponseValidationError struct {
 }
 
 // Field function returns field value.
-func (e LeaveOrganizationResponseValidationError) Field() string { return e.field }
+func (e AddOrganizationMemberResponseValidationError) Field() string { return e.field }
 
 // Reason function returns reason value.
-func (e LeaveOrganizationResponseValidationError) Reason() string { return e.reason }
+func (e AddOrganizationMemberResponseValidationError) Reason() string { return e.reason }
 
 // Cause function returns cause value.
-func (e LeaveOrganizationResponseValidationError) Cause() error { return e.cause }
+func (e AddOrganizationMemberResponseValidationError) Cause() error { return e.cause }
 
 // Key function returns key value.
-func (e LeaveOrganizationResponseValidationError) Key() bool { return e.key }
+func (e AddOrganizationMemberResponseValidationError) Key() bool { return e.key }
 
 // ErrorName returns error name.
-func (e LeaveOrganizationResponseValidationError) ErrorName() string {
-	return "LeaveOrganizationResponseValidationError"
+func (e AddOrganizationMemberResponseValidationError) ErrorName() string {
+	return "AddOrganizationMemberResponseValidationError"
 }
 
 // Error satisfies the builtin error interface
-func (e LeaveOrganizationResponseValidationError) Error() string {
+func (e AddOrganizationMemberResponseValidationError) Error() string {
 	cause := ""
 	if e.cause != nil {
 		cause = fmt.Sprintf(" | caused by: %v", e.cause)
@@ -6426,14 +6446,14 @@ func (e LeaveOrganizationResponseValidationError) Error() string {
 	}
 
 	return fmt.Sprintf(
-		"invalid %sLeaveOrganizationResponse.%s: %s%s",
+		"invalid %sAddOrganizationMemberResponse.%s: %s%s",
 		key,
 		e.field,
 		e.reason,
 		cause)
 }
 
-var _ error = LeaveOrganizationResponseValidationError{}
+var _ error = AddOrganizationMemberResponseValidationError{}
 
 var _ interface {
 	Field() string
@@ -6441,25 +6461,24 @@ var _ interface {
 	Key() bool
 	Cause() error
 	ErrorName() string
-} = LeaveOrganizationResponseValidationError{}
+} = AddOrganizationMemberResponseValidationError{}
 
-// Validate checks the field values on SetOrganizationMemberRoleRequest with
-// the rules defined in the proto definition for this message. If any rules
-// are violated, the first error encountered is returned, or nil if there are
-// no violations.
-func (m *SetOrganizationMemberRoleRequest) Validate() error {
+// Validate checks the field values on RemoveOrganizationMemberRequest with the
+// rules defined in the proto definition for this message. If any rules are
+// violated, the first error encountered is returned, or nil if there are no violations.
+func (m *RemoveOrganizationMemberRequest) Validate() error {
 	return m.validate(false)
 }
 
-// ValidateAll checks the field values on SetOrganizationMemberRoleRequest with
+// ValidateAll checks the field values on RemoveOrganizationMemberRequest with
 // the rules defined in the proto definition for this message. If any rules
 // are violated, the result is a list of violation errors wrapped in
-// SetOrganizationMemberRoleRequestMultiError, or nil if none found.
-func (m *SetOrganizationMemberRoleRequest) ValidateAll() error {
+// RemoveOrganizationMemberRequestMultiError, or nil if none found.
+func (m *RemoveOrganizationMemberRequest) ValidateAll() error {
 	return m.validate(true)
 }
 
-func (m *SetOrganizationMemberRoleRequest) validate(all bool) error {
+func (m *RemoveOrganizationMemberRequest) validate(all bool) error {
 	if m == nil {
 		return nil
 	}
@@ -6467,7 +6486,7 @@ func (m *SetOrganizationMemberRoleRequest) validate(all bool) error {
 	var errors []error
 
 	if utf8.RuneCountInString(m.GetOrganization()) < 1 {
-		err := SetOrganizationMemberRoleRequestValidationError{
+		err := RemoveOrganizationMemberRequestValidationError{
 			field:  "Organization",
 			reason: "value length must be at least 1 runes",
 		}
@@ -6478,7 +6497,7 @@ func (m *SetOrganizationMemberRoleRequest) validate(all bool) error {
 	}
 
 	if err := m._validateEmail(m.GetEmail()); err != nil {
-		err = SetOrganizationMemberRoleRequestValidationError{
+		err = RemoveOrganizationMemberRequestValidationError{
 			field:  "Email",
 			reason: "value must be a valid email address",
 			cause:  err,
@@ -6489,25 +6508,16 @@ func (m *SetOrganizationMemberRoleRequest) validate(all bool) error {
 		errors = append(errors, err)
 	}
 
-	if utf8.RuneCountInString(m.GetRole()) < 1 {
-		err := SetOrganizationMemberRoleRequestValidationError{
-			field:  "Role",
-			reason: "value length must be at least 1 runes",
-		}
-		if !all {
-			return err
-		}
-		errors = append(errors, err)
-	}
+	// no validation rules for KeepProjectRoles
 
 	if len(errors) > 0 {
-		return SetOrganizationMemberRoleRequestMultiError(errors)
+		return RemoveOrganizationMemberRequestMultiError(errors)
 	}
 
 	return nil
 }
 
-func (m *SetOrganizationMemberRoleRequest) _validateHostname(host string) error {
+func (m *RemoveOrganizationMemberRequest) _validateHostname(host string) error {
 	s := strings.ToLower(strings.TrimSuffix(host, "."))
 
 	if len(host) > 253 {
@@ -6537,7 +6547,7 @@ func (m *SetOrganizationMemberRoleRequest) _validateHostname(host string) error
 	return nil
 }
 
-func (m *SetOrganizationMemberRoleRequest) _validateEmail(addr string) error {
+func (m *RemoveOrganizationMemberRequest) _validateEmail(addr string) error {
 	a, err := mail.ParseAddress(addr)
 	if err != nil {
 		return err
@@ -6557,14 +6567,13 @@ func (m *SetOrganizationMemberRoleRequest) _validateEmail(addr string) error {
 	return m._validateHostname(parts[1])
 }
 
-// SetOrganizationMemberRoleRequestMultiError is an error wrapping multiple
-// validation errors returned by
-// SetOrganizationMemberRoleRequest.ValidateAll() if the designated
-// constraints aren't met.
-type SetOrganizationMemberRoleRequestMultiError []error
+// RemoveOrganizationMemberRequestMultiError is an error wrapping multiple
+// validation errors returned by RemoveOrganizationMemberRequest.ValidateAll()
+// if the designated constraints aren't met.
+type RemoveOrganizationMemberRequestMultiError []error
 
 // Error returns a concatenation of all the error messages it wraps.
-func (m SetOrganizationMemberRoleRequestMultiError) Error() string {
+func (m RemoveOrganizationMemberRequestMultiError) Error() string {
 	var msgs []string
 	for _, err := range m {
 		msgs = append(msgs, err.Error())
@@ -6573,12 +6582,12 @@ func (m SetOrganizationMemberRoleRequestMultiError) Error() string {
 }
 
 // AllErrors returns a list of validation violation errors.
-func (m SetOrganizationMemberRoleRequestMultiError) AllErrors() []error { return m }
+func (m RemoveOrganizationMemberRequestMultiError) AllErrors() []error { return m }
 
-// SetOrganizationMemberRoleRequestValidationError is the validation error
-// returned by SetOrganizationMemberRoleRequest.Validate if the designated
+// RemoveOrganizationMemberRequestValidationError is the validation error
+// returned by RemoveOrganizationMemberRequest.Validate if the designated
 // constraints aren't met.
-type SetOrganizationMemberRoleRequestValidationError struct {
+type RemoveOrganizationMemberRequestValidationError struct {
 	field  string
 	reason string
 	cause  error
@@ -6586,24 +6595,24 @@ type SetOrganizationMemberRoleRequestValidationError struct {
 }
 
 // Field function returns field value.
-func (e SetOrganizationMemberRoleRequestValidationError) Field() string { return e.field }
+func (e RemoveOrganizationMemberRequestValidationError) Field() string { return e.field }
 
 // Reason function returns reason value.
-func (e SetOrganizationMemberRoleRequestValidationError) Reason() string { return e.reason }
+func (e RemoveOrganizationMemberRequestValidationError) Reason() string { return e.reason }
 
 // Cause function returns cause value.
-func (e SetOrganizationMemberRoleRequestValidationError) Cause() error { return e.cause }
+func (e RemoveOrganizationMemberRequestValidationError) Cause() error { return e.cause }
 
 // Key function returns key value.
-func (e SetOrganizationMemberRoleRequestValidationError) Key() bool { return e.key }
+func (e RemoveOrganizationMemberRequestValidationError) Key() bool { return e.key }
 
 // ErrorName returns error name.
-func (e SetOrganizationMemberRoleRequestValidationError) ErrorName() string {
-	return "SetOrganizationMemberRoleRequestValidationError"
+func (e RemoveOrganizationMemberRequestValidationError) ErrorName() string {
+	return "RemoveOrganizationMemberRequestValidationError"
 }
 
 // Error satisfies the builtin error interface
-func (e SetOrganizationMemberRoleRequestValidationError) Error() string {
+func (e RemoveOrganizationMemberRequestValidationError) Error() string {
 	cause := ""
 	if e.cause != nil {
 		cause = fmt.Sprintf(" | caused by: %v", e.cause)
@@ -6615,14 +6624,14 @@ func (e SetOrganizationMemberRoleRequestValidationError) Error() string {
 	}
 
 	return fmt.Sprintf(
-		"invalid %sSetOrganizationMemberRoleRequest.%s: %s%s",
+		"invalid %sRemoveOrganizationMemberRequest.%s: %s%s",
 		key,
 		e.field,
 		e.reason,
 		cause)
 }
 
-var _ error = SetOrganizationMemberRoleRequestValidationError{}
+var _ error = RemoveOrganizationMemberRequestValidationError{}
 
 var _ interface {
 	Field() string
@@ -6630,25 +6639,25 @@ var _ interface {
 	Key() bool
 	Cause() error
 	ErrorName() string
-} = SetOrganizationMemberRoleRequestValidationError{}
+} = RemoveOrganizationMemberRequestValidationError{}
 
-// Validate checks the field values on SetOrganizationMemberRoleResponse with
+// Validate checks the field values on RemoveOrganizationMemberResponse with
 // the rules defined in the proto definition for this message. If any rules
 // are violated, the first error encountered is returned, or nil if there are
 // no violations.
-func (m *SetOrganizationMemberRoleResponse) Validate() error {
+func (m *RemoveOrganizationMemberResponse) Validate() error {
 	return m.validate(false)
 }
 
-// ValidateAll checks the field values on SetOrganizationMemberRoleResponse
-// with the rules defined in the proto definition for this message. If any
-// rules are violated, the result is a list of violation errors wrapped in
-// SetOrganizationMemberRoleResponseMultiError, or nil if none found.
-func (m *SetOrganizationMemberRoleResponse) ValidateAll() error {
+// ValidateAll checks the field values on RemoveOrganizationMemberResponse with
+// the rules defined in the proto definition for this message. If any rules
+// are violated, the result is a list of violation errors wrapped in
+// RemoveOrganizationMemberResponseMultiError, or nil if none found.
+func (m *RemoveOrganizationMemberResponse) ValidateAll() error {
 	return m.validate(true)
 }
 
-func (m *SetOrganizationMemberRoleResponse) validate(all bool) error {
+func (m *RemoveOrganizationMemberResponse) validate(all bool) error {
 	if m == nil {
 		return nil
 	}
@@ -6656,20 +6665,20 @@ func (m *SetOrganizationMemberRoleResponse) validate(all bool) error {
 	var errors []error
 
 	if len(errors) > 0 {
-		return SetOrganizationMemberRoleResponseMultiError(errors)
+		return RemoveOrganizationMemberResponseMultiError(errors)
 	}
 
 	return nil
 }
 
-// SetOrganizationMemberRoleResponseMultiError is an error wrapping multiple
+// RemoveOrganizationMemberResponseMultiError is an error wrapping multiple
 // validation errors returned by
-// SetOrganizationMemberRoleResponse.ValidateAll() if the designated
+// RemoveOrganizationMemberResponse.ValidateAll() if the designated
 // constraints aren't met.
-type SetOrganizationMemberRoleResponseMultiError []error
+type RemoveOrganizationMemberResponseMultiError []error
 
 // Error returns a concatenation of all the error messages it wraps.
-func (m SetOrganizationMemberRoleResponseMultiError) Error() string {
+func (m RemoveOrganizationMemberResponseMultiError) Error() string {
 	var msgs []string
 	for _, err := range m {
 		msgs = append(msgs, err.Error())
@@ -6678,12 +6687,12 @@ func (m SetOrganizationMemberRoleResponseMultiError) Error() string {
 }
 
 // AllErrors returns a list of validation violation errors.
-func (m SetOrganizationMemberRoleResponseMultiError) AllErrors() []error { return m }
+func (m RemoveOrganizationMemberResponseMultiError) AllErrors() []error { return m }
 
-// SetOrganizationMemberRoleResponseValidationError is the validation error
-// returned by SetOrganizationMemberRoleResponse.Validate if the designated
+// RemoveOrganizationMemberResponseValidationError is the validation error
+// returned by RemoveOrganizationMemberResponse.Validate if the designated
 // constraints aren't met.
-type SetOrganizationMemberRoleResponseValidationError struct {
+type RemoveOrganizationMemberResponseValidationError struct {
 	field  string
 	reason string
 	cause  error
@@ -6691,24 +6700,24 @@ type SetOrganizationMemberRoleResponseValidationError struct {
 }
 
 // Field function returns field value.
-func (e SetOrganizationMemberRoleResponseValidationError) Field() string { return e.field }
+func (e RemoveOrganizationMemberResponseValidationError) Field() string { return e.field }
 
 // Reason function returns reason value.
-func (e SetOrganizationMemberRoleResponseValidationError) Reason() string { return e.reason }
+func (e RemoveOrganizationMemberResponseValidationError) Reason() string { return e.reason }
 
 // Cause function returns cause value.
-func (e SetOrganizationMemberRoleResponseValidationError) Cause() error { return e.cause }
+func (e RemoveOrganizationMemberResponseValidationError) Cause() error { return e.cause }
 
 // Key function returns key value.
-func (e SetOrganizationMemberRoleResponseValidationError) Key() bool { return e.key }
+func (e RemoveOrganizationMemberResponseValidationError) Key() bool { return e.key }
 
 // ErrorName returns error name.
-func (e SetOrganizationMemberRoleResponseValidationError) ErrorName() string {
-	return "SetOrganizationMemberRoleResponseValidationError"
+func (e RemoveOrganizationMemberResponseValidationError) ErrorName() string {
+	return "RemoveOrganizationMemberResponseValidationError"
 }
 
 // Error satisfies the builtin error interface
-func (e SetOrganizationMemberRoleResponseValidationError) Error() string {
+func (e RemoveOrganizationMemberResponseValidationError) Error() string {
 	cause := ""
 	if e.cause != nil {
 		cause = fmt.Sprintf(" | caused by: %v", e.cause)
@@ -6720,14 +6729,14 @@ func (e SetOrganizationMemberRoleResponseValidationError) Error() string {
 	}
 
 	return fmt.Sprintf(
-		"invalid %sSetOrganizationMemberRoleResponse.%s: %s%s",
+		"invalid %sRemoveOrganizationMemberResponse.%s: %s%s",
 		key,
 		e.field,
 		e.reason,
 		cause)
 }
 
-var _ error = SetOrganizationMemberRoleResponseValidationError{}
+var _ error = RemoveOrganizationMemberResponseValidationError{}
 
 var _ interface {
 	Field() string
@@ -6735,44 +6744,55 @@ var _ interface {
 	Key() bool
 	Cause() error
 	ErrorName() string
-} = SetOrganizationMemberRoleResponseValidationError{}
+} = RemoveOrganizationMemberResponseValidationError{}
 
-// Validate checks the field values on ListSuperusersRequest with the rules
+// Validate checks the field values on LeaveOrganizationRequest with the rules
 // defined in the proto definition for this message. If any rules are
 // violated, the first error encountered is returned, or nil if there are no violations.
-func (m *ListSuperusersRequest) Validate() error {
+func (m *LeaveOrganizationRequest) Validate() error {
 	return m.validate(false)
 }
 
-// ValidateAll checks the field values on ListSuperusersRequest with the rules
-// defined in the proto definition for this message. If any rules are
+// ValidateAll checks the field values on LeaveOrganizationRequest with the
+// rules defined in the proto definition for this message. If any rules are
 // violated, the result is a list of violation errors wrapped in
-// ListSuperusersRequestMultiError, or nil if none found.
-func (m *ListSuperusersRequest) ValidateAll() error {
+// LeaveOrganizationRequestMultiError, or nil if none found.
+func (m *LeaveOrganizationRequest) ValidateAll() error {
 	return m.validate(true)
 }
 
-func (m *ListSuperusersRequest) validate(all bool) error {
+func (m *LeaveOrganizationRequest) validate(all bool) error {
 	if m == nil {
 		return nil
 	}
 
 	var errors []error
 
+	if utf8.RuneCountInString(m.GetOrganization()) < 1 {
+		err := LeaveOrganizationRequestValidationError{
+			field:  "Organization",
+			reason: "value length must be at least 1 runes",
+		}
+		if !all {
+			return err
+		}
+		errors = append(errors, err)
+	}
+
 	if len(errors) > 0 {
-		return ListSuperusersRequestMultiError(errors)
+		return LeaveOrganizationRequestMultiError(errors)
 	}
 
 	return nil
 }
 
-// ListSuperusersRequestMultiError is an error wrapping multiple validation
-// errors returned by ListSuperusersRequest.ValidateAll() if the designated
+// LeaveOrganizationRequestMultiError is an error wrapping multiple validation
+// errors returned by LeaveOrganizationRequest.ValidateAll() if the designated
 // constraints aren't met.
-type ListSuperusersRequestMultiError []error
+type LeaveOrganizationRequestMultiError []error
 
 // Error returns a concatenation of all the error messages it wraps.
-func (m ListSuperusersRequestMultiError) Error() string {
+func (m LeaveOrganizationRequestMultiError) Error() string {
 	var msgs []string
 	for _, err := range m {
 		msgs = append(msgs, err.Error())
@@ -6781,11 +6801,11 @@ func (m ListSuperusersRequestMultiError) Error() string {
 }
 
 // AllErrors returns a list of validation violation errors.
-func (m ListSuperusersRequestMultiError) AllErrors() []error { return m }
+func (m LeaveOrganizationRequestMultiError) AllErrors() []error { return m }
 
-// ListSuperusersRequestValidationError is the validation error returned by
-// ListSuperusersRequest.Validate if the designated constraints aren't met.
-type ListSuperusersRequestValidationError struct {
+// LeaveOrganizationRequestValidationError is the validation error returned by
+// LeaveOrganizationRequest.Validate if the designated constraints aren't met.
+type LeaveOrganizationRequestValidationError struct {
 	field  string
 	reason string
 	cause  error
@@ -6793,24 +6813,24 @@ type ListSuperusersRequestValidationError struct {
 }
 
 // Field function returns field value.
-func (e ListSuperusersRequestValidationError) Field() string { return e.field }
+func (e LeaveOrganizationRequestValidationError) Field() string { return e.field }
 
 // Reason function returns reason value.
-func (e ListSuperusersRequestValidationError) Reason() string { return e.reason }
+func (e LeaveOrganizationRequestValidationError) Reason() string { return e.reason }
 
 // Cause function returns cause value.
-func (e ListSuperusersRequestValidationError) Cause() error { return e.cause }
+func (e LeaveOrganizationRequestValidationError) Cause() error { return e.cause }
 
 // Key function returns key value.
-func (e ListSuperusersRequestValidationError) Key() bool { return e.key }
+func (e LeaveOrganizationRequestValidationError) Key() bool { return e.key }
 
 // ErrorName returns error name.
-func (e ListSuperusersRequestValidationError) ErrorName() string {
-	return "ListSuperusersRequestValidationError"
+func (e LeaveOrganizationRequestValidationError) ErrorName() string {
+	return "LeaveOrganizationRequestValidationError"
 }
 
 // Error satisfies the builtin error interface
-func (e ListSuperusersRequestValidationError) Error() string {
+func (e LeaveOrganizationRequestValidationError) Error() string {
 	cause := ""
 	if e.cause != nil {
 		cause = fmt.Sprintf(" | caused by: %v", e.cause)
@@ -6822,14 +6842,14 @@ func (e ListSuperusersRequestValidationError) Error() string {
 	}
 
 	return fmt.Sprintf(
-		"invalid %sListSuperusersRequest.%s: %s%s",
+		"invalid %sLeaveOrganizationRequest.%s: %s%s",
 		key,
 		e.field,
 		e.reason,
 		cause)
 }
 
-var _ error = ListSuperusersRequestValidationError{}
+var _ error = LeaveOrganizationRequestValidationError{}
 
 var _ interface {
 	Field() string
@@ -6837,78 +6857,44 @@ var _ interface {
 	Key() bool
 	Cause() error
 	ErrorName() string
-} = ListSuperusersRequestValidationError{}
+} = LeaveOrganizationRequestValidationError{}
 
-// Validate checks the field values on ListSuperusersResponse with the rules
+// Validate checks the field values on LeaveOrganizationResponse with the rules
 // defined in the proto definition for this message. If any rules are
 // violated, the first error encountered is returned, or nil if there are no violations.
-func (m *ListSuperusersResponse) Validate() error {
+func (m *LeaveOrganizationResponse) Validate() error {
 	return m.validate(false)
 }
 
-// ValidateAll checks the field values on ListSuperusersResponse with the rules
-// defined in the proto definition for this message. If any rules are
+// ValidateAll checks the field values on LeaveOrganizationResponse with the
+// rules defined in the proto definition for this message. If any rules are
 // violated, the result is a list of violation errors wrapped in
-// ListSuperusersResponseMultiError, or nil if none found.
-func (m *ListSuperusersResponse) ValidateAll() error {
+// LeaveOrganizationResponseMultiError, or nil if none found.
+func (m *LeaveOrganizationResponse) ValidateAll() error {
 	return m.validate(true)
 }
 
-func (m *ListSuperusersResponse) validate(all bool) error {
+func (m *LeaveOrganizationResponse) validate(all bool) error {
 	if m == nil {
 		return nil
 	}
 
 	var errors []error
 
-	for idx, item := range m.GetUsers() {
-		_, _ = idx, item
-
-		if all {
-			switch v := interface{}(item).(type) {
-			case interface{ ValidateAll() error }:
-				if err := v.ValidateAll(); err != nil {
-					errors = append(errors, ListSuperusersResponseValidationError{
-						field:  fmt.Sprintf("Users[%v]", idx),
-						reason: "embedded message failed validation",
-						cause:  err,
-					})
-				}
-			case interface{ Validate() error }:
-				if err := v.Validate(); err != nil {
-					errors = append(errors, ListSuperusersResponseValidationError{
-						field:  fmt.Sprintf("Users[%v]", idx),
-						reason: "embedded message failed validation",
-						cause:  err,
-					})
-				}
-			}
-		} else if v, ok := interface{}(item).(interface{ Validate() error }); ok {
-			if err := v.Validate(); err != nil {
-				return ListSuperusersResponseValidationError{
-					field:  fmt.Sprintf("Users[%v]", idx),
-					reason: "embedded message failed validation",
-					cause:  err,
-				}
-			}
-		}
-
-	}
-
 	if len(errors) > 0 {
-		return ListSuperusersResponseMultiError(errors)
+		return LeaveOrganizationResponseMultiError(errors)
 	}
 
 	return nil
 }
 
-// ListSuperusersResponseMultiError is an error wrapping multiple validation
-// errors returned by ListSuperusersResponse.ValidateAll() if the designated
-// constraints aren't met.
-type ListSuperusersResponseMultiError []error
+// LeaveOrganizationResponseMultiError is an error wrapping multiple validation
+// errors returned by LeaveOrganizationResponse.ValidateAll() if the
+// designated constraints aren't met.
+type LeaveOrganizationResponseMultiError []error
 
 // Error returns a concatenation of all the error messages it wraps.
-func (m ListSuperusersResponseMultiError) Error() string {
+func (m LeaveOrganizationResponseMultiError) Error() string {
 	var msgs []string
 	for _, err := range m {
 		msgs = append(msgs, err.Error())
@@ -6917,11 +6903,11 @@ func (m ListSuperusersResponseMultiError) Error() string {
 }
 
 // AllErrors returns a list of validation violation errors.
-func (m ListSuperusersResponseMultiError) AllErrors() []error { return m }
+func (m LeaveOrganizationResponseMultiError) AllErrors() []error { return m }
 
-// ListSuperusersResponseValidationError is the validation error returned by
-// ListSuperusersResponse.Validate if the designated constraints aren't met.
-type ListSuperusersResponseValidationError struct {
+// LeaveOrganizationResponseValidationError is the validation error returned by
+// LeaveOrganizationResponse.Validate if the designated constraints aren't met.
+type LeaveOrganizationResponseValidationError struct {
 	field  string
 	reason string
 	cause  error
@@ -6929,24 +6915,24 @@ type ListSuperusersResponseValidationError struct {
 }
 
 // Field function returns field value.
-func (e ListSuperusersResponseValidationError) Field() string { return e.field }
+func (e LeaveOrganizationResponseValidationError) Field() string { return e.field }
 
 // Reason function returns reason value.
-func (e ListSuperusersResponseValidationError) Reason() string { return e.reason }
+func (e LeaveOrganizationResponseValidationError) Reason() string { return e.reason }
 
 // Cause function returns cause value.
-func (e ListSuperusersResponseValidationError) Cause() error { return e.cause }
+func (e LeaveOrganizationResponseValidationError) Cause() error { return e.cause }
 
 // Key function returns key value.
-func (e ListSuperusersResponseValidationError) Key() bool { return e.key }
+func (e LeaveOrganizationResponseValidationError) Key() bool { return e.key }
 
 // ErrorName returns error name.
-func (e ListSuperusersResponseValidationError) ErrorName() string {
-	return "ListSuperusersResponseValidationError"
+func (e LeaveOrganizationResponseValidationError) ErrorName() string {
+	return "LeaveOrganizationResponseValidationError"
 }
 
 // Error satisfies the builtin error interface
-func (e ListSuperusersResponseValidationError) Error() string {
+func (e LeaveOrganizationResponseValidationError) Error() string {
 	cause := ""
 	if e.cause != nil {
 		cause = fmt.Sprintf(" | caused by: %v", e.cause)
@@ -6958,14 +6944,14 @@ func (e ListSuperusersResponseValidationError) Error() string {
 	}
 
 	return fmt.Sprintf(
-		"invalid %sListSuperusersResponse.%s: %s%s",
+		"invalid %sLeaveOrganizationResponse.%s: %s%s",
 		key,
 		e.field,
 		e.reason,
 		cause)
 }
 
-var _ error = ListSuperusersResponseValidationError{}
+var _ error = LeaveOrganizationResponseValidationError{}
 
 var _ interface {
 	Field() string
@@ -6973,32 +6959,44 @@ var _ interface {
 	Key() bool
 	Cause() error
 	ErrorName() string
-} = ListSuperusersResponseValidationError{}
+} = LeaveOrganizationResponseValidationError{}
 
-// Validate checks the field values on SetSuperuserRequest with the rules
-// defined in the proto definition for this message. If any rules are
-// violated, the first error encountered is returned, or nil if there are no violations.
-func (m *SetSuperuserRequest) Validate() error {
+// Validate checks the field values on SetOrganizationMemberRoleRequest with
+// the rules defined in the proto definition for this message. If any rules
+// are violated, the first error encountered is returned, or nil if there are
+// no violations.
+func (m *SetOrganizationMemberRoleRequest) Validate() error {
 	return m.validate(false)
 }
 
-// ValidateAll checks the field values on SetSuperuserRequest with the rules
-// defined in the proto definition for this message. If any rules are
-// violated, the result is a list of violation errors wrapped in
-// SetSuperuserRequestMultiError, or nil if none found.
-func (m *SetSuperuserRequest) ValidateAll() error {
+// ValidateAll checks the field values on SetOrganizationMemberRoleRequest with
+// the rules defined in the proto definition for this message. If any rules
+// are violated, the result is a list of violation errors wrapped in
+// SetOrganizationMemberRoleRequestMultiError, or nil if none found.
+func (m *SetOrganizationMemberRoleRequest) ValidateAll() error {
 	return m.validate(true)
 }
 
-func (m *SetSuperuserRequest) validate(all bool) error {
+func (m *SetOrganizationMemberRoleRequest) validate(all bool) error {
 	if m == nil {
 		return nil
 	}
 
 	var errors []error
 
+	if utf8.RuneCountInString(m.GetOrganization()) < 1 {
+		err := SetOrganizationMemberRoleRequestValidationError{
+			field:  "Organization",
+			reason: "value length must be at least 1 runes",
+		}
+		if !all {
+			return err
+		}
+		errors = append(errors, err)
+	}
+
 	if err := m._validateEmail(m.GetEmail()); err != nil {
-		err = SetSuperuserRequestValidationError{
+		err = SetOrganizationMemberRoleRequestValidationError{
 			field:  "Email",
 			reason: "value must be a valid email address",
 			cause:  err,
@@ -7009,16 +7007,25 @@ func (m *SetSuperuserRequest) validate(all bool) error {
 		errors = append(errors, err)
 	}
 
-	// no validation rules for Superuser
+	if utf8.RuneCountInString(m.GetRole()) < 1 {
+		err := SetOrganizationMemberRoleRequestValidationError{
+			field:  "Role",
+			reason: "value length must be at least 1 runes",
+		}
+		if !all {
+			return err
+		}
+		errors = append(errors, err)
+	}
 
 	if len(errors) > 0 {
-		return SetSuperuserRequestMultiError(errors)
+		return SetOrganizationMemberRoleRequestMultiError(errors)
 	}
 
 	return nil
 }
 
-func (m *SetSuperuserRequest) _validateHostname(host string) error {
+func (m *SetOrganizationMemberRoleRequest) _validateHostname(host string) error {
 	s := strings.ToLower(strings.TrimSuffix(host, "."))
 
 	if len(host) > 253 {
@@ -7048,7 +7055,7 @@ func (m *SetSuperuserRequest) _validateHostname(host string) error {
 	return nil
 }
 
-func (m *SetSuperuserRequest) _validateEmail(addr string) error {
+func (m *SetOrganizationMemberRoleRequest) _validateEmail(addr string) error {
 	a, err := mail.ParseAddress(addr)
 	if err != nil {
 		return err
@@ -7068,13 +7075,14 @@ func (m *SetSuperuserRequest) _validateEmail(addr string) error {
 	return m._validateHostname(parts[1])
 }
 
-// SetSuperuserRequestMultiError is an error wrapping multiple validation
-// errors returned by SetSuperuserRequest.ValidateAll() if the designated
+// SetOrganizationMemberRoleRequestMultiError is an error wrapping multiple
+// validation errors returned by
+// SetOrganizationMemberRoleRequest.ValidateAll() if the designated
 // constraints aren't met.
-type SetSuperuserRequestMultiError []error
+type SetOrganizationMemberRoleRequestMultiError []error
 
 // Error returns a concatenation of all the error messages it wraps.
-func (m SetSuperuserRequestMultiError) Error() string {
+func (m SetOrganizationMemberRoleRequestMultiError) Error() string {
 	var msgs []string
 	for _, err := range m {
 		msgs = append(msgs, err.Error())
@@ -7083,11 +7091,12 @@ func (m SetSuperuserRequestMultiError) Error() string {
 }
 
 // AllErrors returns a list of validation violation errors.
-func (m SetSuperuserRequestMultiError) AllErrors() []error { return m }
+func (m SetOrganizationMemberRoleRequestMultiError) AllErrors() []error { return m }
 
-// SetSuperuserRequestValidationError is the validation error returned by
-// SetSuperuserRequest.Validate if the designated constraints aren't met.
-type SetSuperuserRequestValidationError struct {
+// SetOrganizationMemberRoleRequestValidationError is the validation error
+// returned by SetOrganizationMemberRoleRequest.Validate if the designated
+// constraints aren't met.
+type SetOrganizationMemberRoleRequestValidationError struct {
 	field  string
 	reason string
 	cause  error
@@ -7095,24 +7104,24 @@ type SetSuperuserRequestValidationError struct {
 }
 
 // Field function returns field value.
-func (e SetSuperuserRequestValidationError) Field() string { return e.field }
+func (e SetOrganizationMemberRoleRequestValidationError) Field() string { return e.field }
 
 // Reason function returns reason value.
-func (e SetSuperuserRequestValidationError) Reason() string { return e.reason }
+func (e SetOrganizationMemberRoleRequestValidationError) Reason() string { return e.reason }
 
 // Cause function returns cause value.
-func (e SetSuperuserRequestValidationError) Cause() error { return e.cause }
+func (e SetOrganizationMemberRoleRequestValidationError) Cause() error { return e.cause }
 
 // Key function returns key value.
-func (e SetSuperuserRequestValidationError) Key() bool { return e.key }
+func (e SetOrganizationMemberRoleRequestValidationError) Key() bool { return e.key }
 
 // ErrorName returns error name.
-func (e SetSuperuserRequestValidationError) ErrorName() string {
-	return "SetSuperuserRequestValidationError"
+func (e SetOrganizationMemberRoleRequestValidationError) ErrorName() string {
+	return "SetOrganizationMemberRoleRequestValidationError"
 }
 
 // Error satisfies the builtin error interface
-func (e SetSuperuserRequestValidationError) Error() string {
+func (e SetOrganizationMemberRoleRequestValidationError) Error() string {
 	cause := ""
 	if e.cause != nil {
 		cause = fmt.Sprintf(" | caused by: %v", e.cause)
@@ -7124,14 +7133,14 @@ func (e SetSuperuserRequestValidationError) Error() string {
 	}
 
 	return fmt.Sprintf(
-		"invalid %sSetSuperuserRequest.%s: %s%s",
+		"invalid %sSetOrganizationMemberRoleRequest.%s: %s%s",
 		key,
 		e.field,
 		e.reason,
 		cause)
 }
 
-var _ error = SetSuperuserRequestValidationError{}
+var _ error = SetOrganizationMemberRoleRequestValidationError{}
 
 var _ interface {
 	Field() string
@@ -7139,24 +7148,25 @@ var _ interface {
 	Key() bool
 	Cause() error
 	ErrorName() string
-} = SetSuperuserRequestValidationError{}
+} = SetOrganizationMemberRoleRequestValidationError{}
 
-// Validate checks the field values on SetSuperuserResponse with the rules
-// defined in the proto definition for this message. If any rules are
-// violated, the first error encountered is returned, or nil if there are no violations.
-func (m *SetSuperuserResponse) Validate() error {
+// Validate checks the field values on SetOrganizationMemberRoleResponse with
+// the rules defined in the proto definition for this message. If any rules
+// are violated, the first error encountered is returned, or nil if there are
+// no violations.
+func (m *SetOrganizationMemberRoleResponse) Validate() error {
 	return m.validate(false)
 }
 
-// ValidateAll checks the field values on SetSuperuserResponse with the rules
-// defined in the proto definition for this message. If any rules are
-// violated, the result is a list of violation errors wrapped in
-// SetSuperuserResponseMultiError, or nil if none found.
-func (m *SetSuperuserResponse) ValidateAll() error {
+// ValidateAll checks the field values on SetOrganizationMemberRoleResponse
+// with the rules defined in the proto definition for this message. If any
+// rules are violated, the result is a list of violation errors wrapped in
+// SetOrganizationMemberRoleResponseMultiError, or nil if none found.
+func (m *SetOrganizationMemberRoleResponse) ValidateAll() error {
 	return m.validate(true)
 }
 
-func (m *SetSuperuserResponse) validate(all bool) error {
+func (m *SetOrganizationMemberRoleResponse) validate(all bool) error {
 	if m == nil {
 		return nil
 	}
@@ -7164,19 +7174,20 @@ func (m *SetSuperuserResponse) validate(all bool) error {
 	var errors []error
 
 	if len(errors) > 0 {
-		return SetSuperuserResponseMultiError(errors)
+		return SetOrganizationMemberRoleResponseMultiError(errors)
 	}
 
 	return nil
 }
 
-// SetSuperuserResponseMultiError is an error wrapping multiple validation
-// errors returned by SetSuperuserResponse.ValidateAll() if the designated
+// SetOrganizationMemberRoleResponseMultiError is an error wrapping multiple
+// validation errors returned by
+// SetOrganizationMemberRoleResponse.ValidateAll() if the designated
 // constraints aren't met.
-type SetSuperuserResponseMultiError []error
+type SetOrganizationMemberRoleResponseMultiError []error
 
 // Error returns a concatenation of all the error messages it wraps.
-func (m SetSuperuserResponseMultiError) Error() string {
+func (m SetOrganizationMemberRoleResponseMultiError) Error() string {
 	var msgs []string
 	for _, err := range m {
 		msgs = append(msgs, err.Error())
@@ -7185,11 +7196,12 @@ func (m SetSuperuserResponseMultiError) Error() string {
 }
 
 // AllErrors returns a list of validation violation errors.
-func (m SetSuperuserResponseMultiError) AllErrors() []error { return m }
+func (m SetOrganizationMemberRoleResponseMultiError) AllErrors() []error { return m }
 
-// SetSuperuserResponseValidationError is the validation error returned by
-// SetSuperuserResponse.Validate if the designated constraints aren't met.
-type SetSuperuserResponseValidationError struct {
+// SetOrganizationMemberRoleResponseValidationError is the validation error
+// returned by SetOrganizationMemberRoleResponse.Validate if the designated
+// constraints aren't met.
+type SetOrganizationMemberRoleResponseValidationError struct {
 	field  string
 	reason string
 	cause  error
@@ -7197,24 +7209,24 @@ type SetSuperuserResponseValidationError struct {
 }
 
 // Field function returns field value.
-func (e SetSuperuserResponseValidationError) Field() string { return e.field }
+func (e SetOrganizationMemberRoleResponseValidationError) Field() string { return e.field }
 
 // Reason function returns reason value.
-func (e SetSuperuserResponseValidationError) Reason() string { return e.reason }
+func (e SetOrganizationMemberRoleResponseValidationError) Reason() string { return e.reason }
 
 // Cause function returns cause value.
-func (e SetSuperuserResponseValidationError) Cause() error { return e.cause }
+func (e SetOrganizationMemberRoleResponseValidationError) Cause() error { return e.cause }
 
 // Key function returns key value.
-func (e SetSuperuserResponseValidationError) Key() bool { return e.key }
+func (e SetOrganizationMemberRoleResponseValidationError) Key() bool { return e.key }
 
 // ErrorName returns error name.
-func (e SetSuperuserResponseValidationError) ErrorName() string {
-	return "SetSuperuserResponseValidationError"
+func (e SetOrganizationMemberRoleResponseValidationError) ErrorName() string {
+	return "SetOrganizationMemberRoleResponseValidationError"
 }
 
 // Error satisfies the builtin error interface
-func (e SetSuperuserResponseValidationError) Error() string {
+func (e SetOrganizationMemberRoleResponseValidationError) Error() string {
 	cause := ""
 	if e.cause != nil {
 		cause = fmt.Sprintf(" | caused by: %v", e.cause)
@@ -7226,14 +7238,14 @@ func (e SetSuperuserResponseValidationError) Error() string {
 	}
 
 	return fmt.Sprintf(
-		"invalid %sSetSuperuserResponse.%s: %s%s",
+		"invalid %sSetOrganizationMemberRoleResponse.%s: %s%s",
 		key,
 		e.field,
 		e.reason,
 		cause)
 }
 
-var _ error = SetSuperuserResponseValidationError{}
+var _ error = SetOrganizationMemberRoleResponseValidationError{}
 
 var _ interface {
 	Field() string
@@ -7241,109 +7253,44 @@ var _ interface {
 	Key() bool
 	Cause() error
 	ErrorName() string
-} = SetSuperuserResponseValidationError{}
+} = SetOrganizationMemberRoleResponseValidationError{}
 
-// Validate checks the field values on SudoGetResourceRequest with the rules
+// Validate checks the field values on ListSuperusersRequest with the rules
 // defined in the proto definition for this message. If any rules are
 // violated, the first error encountered is returned, or nil if there are no violations.
-func (m *SudoGetResourceRequest) Validate() error {
+func (m *ListSuperusersRequest) Validate() error {
 	return m.validate(false)
 }
 
-// ValidateAll checks the field values on SudoGetResourceRequest with the rules
+// ValidateAll checks the field values on ListSuperusersRequest with the rules
 // defined in the proto definition for this message. If any rules are
 // violated, the result is a list of violation errors wrapped in
-// SudoGetResourceRequestMultiError, or nil if none found.
-func (m *SudoGetResourceRequest) ValidateAll() error {
+// ListSuperusersRequestMultiError, or nil if none found.
+func (m *ListSuperusersRequest) ValidateAll() error {
 	return m.validate(true)
 }
 
-func (m *SudoGetResourceRequest) validate(all bool) error {
+func (m *ListSuperusersRequest) validate(all bool) error {
 	if m == nil {
 		return nil
 	}
 
 	var errors []error
 
-	switch v := m.Id.(type) {
-	case *SudoGetResourceRequest_UserId:
-		if v == nil {
-			err := SudoGetResourceRequestValidationError{
-				field:  "Id",
-				reason: "oneof value cannot be a typed-nil",
-			}
-			if !all {
-				return err
-			}
-			errors = append(errors, err)
-		}
-		// no validation rules for UserId
-	case *SudoGetResourceRequest_OrgId:
-		if v == nil {
-			err := SudoGetResourceRequestValidationError{
-				field:  "Id",
-				reason: "oneof value cannot be a typed-nil",
-			}
-			if !all {
-				return err
-			}
-			errors = append(errors, err)
-		}
-		// no validation rules for OrgId
-	case *SudoGetResourceRequest_ProjectId:
-		if v == nil {
-			err := SudoGetResourceRequestValidationError{
-				field:  "Id",
-				reason: "oneof value cannot be a typed-nil",
-			}
-			if !all {
-				return err
-			}
-			errors = append(errors, err)
-		}
-		// no validation rules for ProjectId
-	case *SudoGetResourceRequest_DeploymentId:
-		if v == nil {
-			err := SudoGetResourceRequestValidationError{
-				field:  "Id",
-				reason: "oneof value cannot be a typed-nil",
-			}
-			if !all {
-				return err
-			}
-			errors = append(errors, err)
-		}
-		// no validation rules for DeploymentId
-	case *SudoGetResourceRequest_InstanceId:
-		if v == nil {
-			err := SudoGetResourceRequestValidationError{
-				field:  "Id",
-				reason: "oneof value cannot be a typed-nil",
-			}
-			if !all {
-				return err
-			}
-			errors = append(errors, err)
-		}
-		// no validation rules for InstanceId
-	default:
-		_ = v // ensures v is used
-	}
-
 	if len(errors) > 0 {
-		return SudoGetResourceRequestMultiError(errors)
+		return ListSuperusersRequestMultiError(errors)
 	}
 
 	return nil
 }
 
-// SudoGetResourceRequestMultiError is an error wrapping multiple validation
-// errors returned by SudoGetResourceRequest.ValidateAll() if the designated
+// ListSuperusersRequestMultiError is an error wrapping multiple validation
+// errors returned by ListSuperusersRequest.ValidateAll() if the designated
 // constraints aren't met.
-type SudoGetResourceRequestMultiError []error
+type ListSuperusersRequestMultiError []error
 
 // Error returns a concatenation of all the error messages it wraps.
-func (m SudoGetResourceRequestMultiError) Error() string {
+func (m ListSuperusersRequestMultiError) Error() string {
 	var msgs []string
 	for _, err := range m {
 		msgs = append(msgs, err.Error())
@@ -7352,11 +7299,11 @@ func (m SudoGetResourceRequestMultiError) Error() string {
 }
 
 // AllErrors returns a list of validation violation errors.
-func (m SudoGetResourceRequestMultiError) AllErrors() []error { return m }
+func (m ListSuperusersRequestMultiError) AllErrors() []error { return m }
 
-// SudoGetResourceRequestValidationError is the validation error returned by
-// SudoGetResourceRequest.Validate if the designated constraints aren't met.
-type SudoGetResourceRequestValidationError struct {
+// ListSuperusersRequestValidationError is the validation error returned by
+// ListSuperusersRequest.Validate if the designated constraints aren't met.
+type ListSuperusersRequestValidationError struct {
 	field  string
 	reason string
 	cause  error
@@ -7364,24 +7311,24 @@ type SudoGetResourceRequestValidationError struct {
 }
 
 // Field function returns field value.
-func (e SudoGetResourceRequestValidationError) Field() string { return e.field }
+func (e ListSuperusersRequestValidationError) Field() string { return e.field }
 
 // Reason function returns reason value.
-func (e SudoGetResourceRequestValidationError) Reason() string { return e.reason }
+func (e ListSuperusersRequestValidationError) Reason() string { return e.reason }
 
 // Cause function returns cause value.
-func (e SudoGetResourceRequestValidationError) Cause() error { return e.cause }
+func (e ListSuperusersRequestValidationError) Cause() error { return e.cause }
 
 // Key function returns key value.
-func (e SudoGetResourceRequestValidationError) Key() bool { return e.key }
+func (e ListSuperusersRequestValidationError) Key() bool { return e.key }
 
 // ErrorName returns error name.
-func (e SudoGetResourceRequestValidationError) ErrorName() string {
-	return "SudoGetResourceRequestValidationError"
+func (e ListSuperusersRequestValidationError) ErrorName() string {
+	return "ListSuperusersRequestValidationError"
 }
 
 // Error satisfies the builtin error interface
-func (e SudoGetResourceRequestValidationError) Error() string {
+func (e ListSuperusersRequestValidationError) Error() string {
 	cause := ""
 	if e.cause != nil {
 		cause = fmt.Sprintf(" | caused by: %v", e.cause)
@@ -7393,14 +7340,14 @@ func (e SudoGetResourceRequestValidationError) Error() string {
 	}
 
 	return fmt.Sprintf(
-		"invalid %sSudoGetResourceRequest.%s: %s%s",
+		"invalid %sListSuperusersRequest.%s: %s%s",
 		key,
 		e.field,
 		e.reason,
 		cause)
 }
 
-var _ error = SudoGetResourceRequestValidationError{}
+var _ error = ListSuperusersRequestValidationError{}
 
 var _ interface {
 	Field() string
@@ -7408,254 +7355,5264 @@ var _ interface {
 	Key() bool
 	Cause() error
 	ErrorName() string
-} = SudoGetResourceRequestValidationError{}
+} = ListSuperusersRequestValidationError{}
 
-// Validate checks the field values on SudoGetResourceResponse with the rules
+// Validate checks the field values on ListSuperusersResponse with the rules
 // defined in the proto definition for this message. If any rules are
 // violated, the first error encountered is returned, or nil if there are no violations.
-func (m *SudoGetResourceResponse) Validate() error {
+func (m *ListSuperusersResponse) Validate() error {
 	return m.validate(false)
 }
 
-// ValidateAll checks the field values on SudoGetResourceResponse with the
-// rules defined in the proto definition for this message. If any rules are
+// ValidateAll checks the field values on ListSuperusersResponse with the rules
+// defined in the proto definition for this message. If any rules are
 // violated, the result is a list of violation errors wrapped in
-// SudoGetResourceResponseMultiError, or nil if none found.
-func (m *SudoGetResourceResponse) ValidateAll() error {
+// ListSuperusersResponseMultiError, or nil if none found.
+func (m *ListSuperusersResponse) ValidateAll() error {
 	return m.validate(true)
 }
 
-func (m *SudoGetResourceResponse) validate(all bool) error {
+func (m *ListSuperusersResponse) validate(all bool) error {
 	if m == nil {
 		return nil
 	}
 
 	var errors []error
 
-	switch v := m.Resource.(type) {
-	case *SudoGetResourceResponse_User:
-		if v == nil {
-			err := SudoGetResourceResponseValidationError{
-				field:  "Resource",
-				reason: "oneof value cannot be a typed-nil",
-			}
-			if !all {
-				return err
-			}
-			errors = append(errors, err)
-		}
+	for idx, item := range m.GetUsers() {
+		_, _ = idx, item
 
 		if all {
-			switch v := interface{}(m.GetUser()).(type) {
+			switch v := interface{}(item).(type) {
 			case interface{ ValidateAll() error }:
 				if err := v.ValidateAll(); err != nil {
-					errors = append(errors, SudoGetResourceResponseValidationError{
-						field:  "User",
+					errors = append(errors, ListSuperusersResponseValidationError{
+						field:  fmt.Sprintf("Users[%v]", idx),
 						reason: "embedded message failed validation",
 						cause:  err,
 					})
 				}
 			case interface{ Validate() error }:
 				if err := v.Validate(); err != nil {
-					errors = append(errors, SudoGetResourceResponseValidationError{
-						field:  "User",
+					errors = append(errors, ListSuperusersResponseValidationError{
+						field:  fmt.Sprintf("Users[%v]", idx),
 						reason: "embedded message failed validation",
 						cause:  err,
 					})
 				}
 			}
-		} else if v, ok := interface{}(m.GetUser()).(interface{ Validate() error }); ok {
+		} else if v, ok := interface{}(item).(interface{ Validate() error }); ok {
 			if err := v.Validate(); err != nil {
-				return SudoGetResourceResponseValidationError{
-					field:  "User",
+				return ListSuperusersResponseValidationError{
+					field:  fmt.Sprintf("Users[%v]", idx),
 					reason: "embedded message failed validation",
 					cause:  err,
 				}
 			}
 		}
 
-	case *SudoGetResourceResponse_Org:
-		if v == nil {
-			err := SudoGetResourceResponseValidationError{
-				field:  "Resource",
-				reason: "oneof value cannot be a typed-nil",
-			}
-			if !all {
-				return err
-			}
-			errors = append(errors, err)
-		}
+	}
 
-		if all {
-			switch v := interface{}(m.GetOrg()).(type) {
-			case interface{ ValidateAll() error }:
-				if err := v.ValidateAll(); err != nil {
-					errors = append(errors, SudoGetResourceResponseValidationError{
-						field:  "Org",
-						reason: "embedded message failed validation",
-						cause:  err,
-					})
-				}
-			case interface{ Validate() error }:
-				if err := v.Validate(); err != nil {
-					errors = append(errors, SudoGetResourceResponseValidationError{
-						field:  "Org",
-						reason: "embedded message failed validation",
-						cause:  err,
-					})
-				}
-			}
+	if len(errors) > 0 {
+		return ListSuperusersResponseMultiError(errors)
+	}
+
+	return nil
+}
+
+// ListSuperusersResponseMultiError is an error wrapping multiple validation
+// errors returned by ListSuperusersResponse.ValidateAll() if the designated
+// constraints aren't met.
+type ListSuperusersResponseMultiError []error
+
+// Error returns a concatenation of all the error messages it wraps.
+func (m ListSuperusersResponseMultiError) Error() string {
+	var msgs []string
+	for _, err := range m {
+		msgs = append(msgs, err.Error())
+	}
+	return strings.Join(msgs, "; ")
+}
+
+// AllErrors returns a list of validation violation errors.
+func (m ListSuperusersResponseMultiError) AllErrors() []error { return m }
+
+// ListSuperusersResponseValidationError is the validation error returned by
+// ListSuperusersResponse.Validate if the designated constraints aren't met.
+type ListSuperusersResponseValidationError struct {
+	field  string
+	reason string
+	cause  error
+	key    bool
+}
+
+// Field function returns field value.
+func (e ListSuperusersResponseValidationError) Field() string { return e.field }
+
+// Reason function returns reason value.
+func (e ListSuperusersResponseValidationError) Reason() string { return e.reason }
+
+// Cause function returns cause value.
+func (e ListSuperusersResponseValidationError) Cause() error { return e.cause }
+
+// Key function returns key value.
+func (e ListSuperusersResponseValidationError) Key() bool { return e.key }
+
+// ErrorName returns error name.
+func (e ListSuperusersResponseValidationError) ErrorName() string {
+	return "ListSuperusersResponseValidationError"
+}
+
+// Error satisfies the builtin error interface
+func (e ListSuperusersResponseValidationError) Error() string {
+	cause := ""
+	if e.cause != nil {
+		cause = fmt.Sprintf(" | caused by: %v", e.cause)
+	}
+
+	key := ""
+	if e.key {
+		key = "key for "
+	}
+
+	return fmt.Sprintf(
+		"invalid %sListSuperusersResponse.%s: %s%s",
+		key,
+		e.field,
+		e.reason,
+		cause)
+}
+
+var _ error = ListSuperusersResponseValidationError{}
+
+var _ interface {
+	Field() string
+	Reason() string
+	Key() bool
+	Cause() error
+	ErrorName() string
+} = ListSuperusersResponseValidationError{}
+
+// Validate checks the field values on SetSuperuserRequest with the rules
+// defined in the proto definition for this message. If any rules are
+// violated, the first error encountered is returned, or nil if there are no violations.
+func (m *SetSuperuserRequest) Validate() error {
+	return m.validate(false)
+}
+
+// ValidateAll checks the field values on SetSuperuserRequest with the rules
+// defined in the proto definition for this message. If any rules are
+// violated, the result is a list of violation errors wrapped in
+// SetSuperuserRequestMultiError, or nil if none found.
+func (m *SetSuperuserRequest) ValidateAll() error {
+	return m.validate(true)
+}
+
+func (m *SetSuperuserRequest) validate(all bool) error {
+	if m == nil {
+		return nil
+	}
+
+	var errors []error
+
+	if err := m._validateEmail(m.GetEmail()); err != nil {
+		err = SetSuperuserRequestValidationError{
+			field:  "Email",
+			reason: "value must be a valid email address",
+			cause:  err,
+		}
+		if !all {
+			return err
+		}
+		errors = append(errors, err)
+	}
+
+	// no validation rules for Superuser
+
+	if len(errors) > 0 {
+		return SetSuperuserRequestMultiError(errors)
+	}
+
+	return nil
+}
+
+func (m *SetSuperuserRequest) _validateHostname(host string) error {
+	s := strings.ToLower(strings.TrimSuffix(host, "."))
+
+	if len(host) > 253 {
+		return errors.New("hostname cannot exceed 253 characters")
+	}
+
+	for _, part := range strings.Split(s, ".") {
+		if l := len(part); l == 0 || l > 63 {
+			return errors.New("hostname part must be non-empty and cannot exceed 63 characters")
+		}
+
+		if part[0] == '-' {
+			return errors.New("hostname parts cannot begin with hyphens")
+		}
+
+		if part[len(part)-1] == '-' {
+			return errors.New("hostname parts cannot end with hyphens")
+		}
+
+		for _, r := range part {
+			if (r < 'a' || r > 'z') && (r < '0' || r > '9') && r != '-' {
+				return fmt.Errorf("hostname parts can only contain alphanumeric characters or hyphens, got %q", string(r))
+			}
+		}
+	}
+
+	return nil
+}
+
+func (m *SetSuperuserRequest) _validateEmail(addr string) error {
+	a, err := mail.ParseAddress(addr)
+	if err != nil {
+		return err
+	}
+	addr = a.Address
+
+	if len(addr) > 254 {
+		return errors.New("email addresses cannot exceed 254 characters")
+	}
+
+	parts := strings.SplitN(addr, "@", 2)
+
+	if len(parts[0]) > 64 {
+		return errors.New("email address local phrase cannot exceed 64 characters")
+	}
+
+	return m._validateHostname(parts[1])
+}
+
+// SetSuperuserRequestMultiError is an error wrapping multiple validation
+// errors returned by SetSuperuserRequest.ValidateAll() if the designated
+// constraints aren't met.
+type SetSuperuserRequestMultiError []error
+
+// Error returns a concatenation of all the error messages it wraps.
+func (m SetSuperuserRequestMultiError) Error() string {
+	var msgs []string
+	for _, err := range m {
+		msgs = append(msgs, err.Error())
+	}
+	return strings.Join(msgs, "; ")
+}
+
+// AllErrors returns a list of validation violation errors.
+func (m SetSuperuserRequestMultiError) AllErrors() []error { return m }
+
+// SetSuperuserRequestValidationError is the validation error returned by
+// SetSuperuserRequest.Validate if the designated constraints aren't met.
+type SetSuperuserRequestValidationError struct {
+	field  string
+	reason string
+	cause  error
+	key    bool
+}
+
+// Field function returns field value.
+func (e SetSuperuserRequestValidationError) Field() string { return e.field }
+
+// Reason function returns reason value.
+func (e SetSuperuserRequestValidationError) Reason() string { return e.reason }
+
+// Cause function returns cause value.
+func (e SetSuperuserRequestValidationError) Cause() error { return e.cause }
+
+// Key function returns key value.
+func (e SetSuperuserRequestValidationError) Key() bool { return e.key }
+
+// ErrorName returns error name.
+func (e SetSuperuserRequestValidationError) ErrorName() string {
+	return "SetSuperuserRequestValidationError"
+}
+
+// Error satisfies the builtin error interface
+func (e SetSuperuserRequestValidationError) Error() string {
+	cause := ""
+	if e.cause != nil {
+		cause = fmt.Sprintf(" | caused by: %v", e.cause)
+	}
+
+	key := ""
+	if e.key {
+		key = "key for "
+	}
+
+	return fmt.Sprintf(
+		"invalid %sSetSuperuserRequest.%s: %s%s",
+		key,
+		e.field,
+		e.reason,
+		cause)
+}
+
+var _ error = SetSuperuserRequestValidationError{}
+
+var _ interface {
+	Field() string
+	Reason() string
+	Key() bool
+	Cause() error
+	ErrorName() string
+} = SetSuperuserRequestValidationError{}
+
+// Validate checks the field values on SetSuperuserResponse with the rules
+// defined in the proto definition for this message. If any rules are
+// violated, the first error encountered is returned, or nil if there are no violations.
+func (m *SetSuperuserResponse) Validate() error {
+	return m.validate(false)
+}
+
+// ValidateAll checks the field values on SetSuperuserResponse with the rules
+// defined in the proto definition for this message. If any rules are
+// violated, the result is a list of violation errors wrapped in
+// SetSuperuserResponseMultiError, or nil if none found.
+func (m *SetSuperuserResponse) ValidateAll() error {
+	return m.validate(true)
+}
+
+func (m *SetSuperuserResponse) validate(all bool) error {
+	if m == nil {
+		return nil
+	}
+
+	var errors []error
+
+	if len(errors) > 0 {
+		return SetSuperuserResponseMultiError(errors)
+	}
+
+	return nil
+}
+
+// SetSuperuserResponseMultiError is an error wrapping multiple validation
+// errors returned by SetSuperuserResponse.ValidateAll() if the designated
+// constraints aren't met.
+type SetSuperuserResponseMultiError []error
+
+// Error returns a concatenation of all the error messages it wraps.
+func (m SetSuperuserResponseMultiError) Error() string {
+	var msgs []string
+	for _, err := range m {
+		msgs = append(msgs, err.Error())
+	}
+	return strings.Join(msgs, "; ")
+}
+
+// AllErrors returns a list of validation violation errors.
+func (m SetSuperuserResponseMultiError) AllErrors() []error { return m }
+
+// SetSuperuserResponseValidationError is the validation error returned by
+// SetSuperuserResponse.Validate if the designated constraints aren't met.
+type SetSuperuserResponseValidationError struct {
+	field  string
+	reason string
+	cause  error
+	key    bool
+}
+
+// Field function returns field value.
+func (e SetSuperuserResponseValidationError) Field() string { return e.field }
+
+// Reason function returns reason value.
+func (e SetSuperuserResponseValidationError) Reason() string { return e.reason }
+
+// Cause function returns cause value.
+func (e SetSuperuserResponseValidationError) Cause() error { return e.cause }
+
+// Key function returns key value.
+func (e SetSuperuserResponseValidationError) Key() bool { return e.key }
+
+// ErrorName returns error name.
+func (e SetSuperuserResponseValidationError) ErrorName() string {
+	return "SetSuperuserResponseValidationError"
+}
+
+// Error satisfies the builtin error interface
+func (e SetSuperuserResponseValidationError) Error() string {
+	cause := ""
+	if e.cause != nil {
+		cause = fmt.Sprintf(" | caused by: %v", e.cause)
+	}
+
+	key := ""
+	if e.key {
+		key = "key for "
+	}
+
+	return fmt.Sprintf(
+		"invalid %sSetSuperuserResponse.%s: %s%s",
+		key,
+		e.field,
+		e.reason,
+		cause)
+}
+
+var _ error = SetSuperuserResponseValidationError{}
+
+var _ interface {
+	Field() string
+	Reason() string
+	Key() bool
+	Cause() error
+	ErrorName() string
+} = SetSuperuserResponseValidationError{}
+
+// Validate checks the field values on SudoGetResourceRequest with the rules
+// defined in the proto definition for this message. If any rules are
+// violated, the first error encountered is returned, or nil if there are no violations.
+func (m *SudoGetResourceRequest) Validate() error {
+	return m.validate(false)
+}
+
+// ValidateAll checks the field values on SudoGetResourceRequest with the rules
+// defined in the proto definition for this message. If any rules are
+// violated, the result is a list of violation errors wrapped in
+// SudoGetResourceRequestMultiError, or nil if none found.
+func (m *SudoGetResourceRequest) ValidateAll() error {
+	return m.validate(true)
+}
+
+func (m *SudoGetResourceRequest) validate(all bool) error {
+	if m == nil {
+		return nil
+	}
+
+	var errors []error
+
+	switch v := m.Id.(type) {
+	case *SudoGetResourceRequest_UserId:
+		if v == nil {
+			err := SudoGetResourceRequestValidationError{
+				field:  "Id",
+				reason: "oneof value cannot be a typed-nil",
+			}
+			if !all {
+				return err
+			}
+			errors = append(errors, err)
+		}
+		// no validation rules for UserId
+	case *SudoGetResourceRequest_OrgId:
+		if v == nil {
+			err := SudoGetResourceRequestValidationError{
+				field:  "Id",
+				reason: "oneof value cannot be a typed-nil",
+			}
+			if !all {
+				return err
+			}
+			errors = append(errors, err)
+		}
+		// no validation rules for OrgId
+	case *SudoGetResourceRequest_ProjectId:
+		if v == nil {
+			err := SudoGetResourceRequestValidationError{
+				field:  "Id",
+				reason: "oneof value cannot be a typed-nil",
+			}
+			if !all {
+				return err
+			}
+			errors = append(errors, err)
+		}
+		// no validation rules for ProjectId
+	case *SudoGetResourceRequest_DeploymentId:
+		if v == nil {
+			err := SudoGetResourceRequestValidationError{
+				field:  "Id",
+				reason: "oneof value cannot be a typed-nil",
+			}
+			if !all {
+				return err
+			}
+			errors = append(errors, err)
+		}
+		// no validation rules for DeploymentId
+	case *SudoGetResourceRequest_InstanceId:
+		if v == nil {
+			err := SudoGetResourceRequestValidationError{
+				field:  "Id",
+				reason: "oneof value cannot be a typed-nil",
+			}
+			if !all {
+				return err
+			}
+			errors = append(errors, err)
+		}
+		// no validation rules for InstanceId
+	default:
+		_ = v // ensures v is used
+	}
+
+	if len(errors) > 0 {
+		return SudoGetResourceRequestMultiError(errors)
+	}
+
+	return nil
+}
+
+// SudoGetResourceRequestMultiError is an error wrapping multiple validation
+// errors returned by SudoGetResourceRequest.ValidateAll() if the designated
+// constraints aren't met.
+type SudoGetResourceRequestMultiError []error
+
+// Error returns a concatenation of all the error messages it wraps.
+func (m SudoGetResourceRequestMultiError) Error() string {
+	var msgs []string
+	for _, err := range m {
+		msgs = append(msgs, err.Error())
+	}
+	return strings.Join(msgs, "; ")
+}
+
+// AllErrors returns a list of validation violation errors.
+func (m SudoGetResourceRequestMultiError) AllErrors() []error { return m }
+
+// SudoGetResourceRequestValidationError is the validation error returned by
+// SudoGetResourceRequest.Validate if the designated constraints aren't met.
+type SudoGetResourceRequestValidationError struct {
+	field  string
+	reason string
+	cause  error
+	key    bool
+}
+
+// Field function returns field value.
+func (e SudoGetResourceRequestValidationError) Field() string { return e.field }
+
+// Reason function returns reason value.
+func (e SudoGetResourceRequestValidationError) Reason() string { return e.reason }
+
+// Cause function returns cause value.
+func (e SudoGetResourceRequestValidationError) Cause() error { return e.cause }
+
+// Key function returns key value.
+func (e SudoGetResourceRequestValidationError) Key() bool { return e.key }
+
+// ErrorName returns error name.
+func (e SudoGetResourceRequestValidationError) ErrorName() string {
+	return "SudoGetResourceRequestValidationError"
+}
+
+// Error satisfies the builtin error interface
+func (e SudoGetResourceRequestValidationError) Error() string {
+	cause := ""
+	if e.cause != nil {
+		cause = fmt.Sprintf(" | caused by: %v", e.cause)
+	}
+
+	key := ""
+	if e.key {
+		key = "key for "
+	}
+
+	return fmt.Sprintf(
+		"invalid %sSudoGetResourceRequest.%s: %s%s",
+		key,
+		e.field,
+		e.reason,
+		cause)
+}
+
+var _ error = SudoGetResourceRequestValidationError{}
+
+var _ interface {
+	Field() string
+	Reason() string
+	Key() bool
+	Cause() error
+	ErrorName() string
+} = SudoGetResourceRequestValidationError{}
+
+// Validate checks the field values on SudoGetResourceResponse with the rules
+// defined in the proto definition for this message. If any rules are
+// violated, the first error encountered is returned, or nil if there are no violations.
+func (m *SudoGetResourceResponse) Validate() error {
+	return m.validate(false)
+}
+
+// ValidateAll checks the field values on SudoGetResourceResponse with the
+// rules defined in the proto definition for this message. If any rules are
+// violated, the result is a list of violation errors wrapped in
+// SudoGetResourceResponseMultiError, or nil if none found.
+func (m *SudoGetResourceResponse) ValidateAll() error {
+	return m.validate(true)
+}
+
+func (m *SudoGetResourceResponse) validate(all bool) error {
+	if m == nil {
+		return nil
+	}
+
+	var errors []error
+
+	switch v := m.Resource.(type) {
+	case *SudoGetResourceResponse_User:
+		if v == nil {
+			err := SudoGetResourceResponseValidationError{
+				field:  "Resource",
+				reason: "oneof value cannot be a typed-nil",
+			}
+			if !all {
+				return err
+			}
+			errors = append(errors, err)
+		}
+
+		if all {
+			switch v := interface{}(m.GetUser()).(type) {
+			case interface{ ValidateAll() error }:
+				if err := v.ValidateAll(); err != nil {
+					errors = append(errors, SudoGetResourceResponseValidationError{
+						field:  "User",
+						reason: "embedded message failed validation",
+						cause:  err,
+					})
+				}
+			case interface{ Validate() error }:
+				if err := v.Validate(); err != nil {
+					errors = append(errors, SudoGetResourceResponseValidationError{
+						field:  "User",
+						reason: "embedded message failed validation",
+						cause:  err,
+					})
+				}
+			}
+		} else if v, ok := interface{}(m.GetUser()).(interface{ Validate() error }); ok {
+			if err := v.Validate(); err != nil {
+				return SudoGetResourceResponseValidationError{
+					field:  "User",
+					reason: "embedded message failed validation",
+					cause:  err,
+				}
+			}
+		}
+
+	case *SudoGetResourceResponse_Org:
+		if v == nil {
+			err := SudoGetResourceResponseValidationError{
+				field:  "Resource",
+				reason: "oneof value cannot be a typed-nil",
+			}
+			if !all {
+				return err
+			}
+			errors = append(errors, err)
+		}
+
+		if all {
+			switch v := interface{}(m.GetOrg()).(type) {
+			case interface{ ValidateAll() error }:
+				if err := v.ValidateAll(); err != nil {
+					errors = append(errors, SudoGetResourceResponseValidationError{
+						field:  "Org",
+						reason: "embedded message failed validation",
+						cause:  err,
+					})
+				}
+			case interface{ Validate() error }:
+				if err := v.Validate(); err != nil {
+					errors = append(errors, SudoGetResourceResponseValidationError{
+						field:  "Org",
+						reason: "embedded message failed validation",
+						cause:  err,
+					})
+				}
+			}
 		} else if v, ok := interface{}(m.GetOrg()).(interface{ Validate() error }); ok {
 			if err := v.Validate(); err != nil {
-				return SudoGetResourceResponseValidationError{
-					field:  "Org",
+				return SudoGetResourceResponseValidationError{
+					field:  "Org",
+					reason: "embedded message failed validation",
+					cause:  err,
+				}
+			}
+		}
+
+	case *SudoGetResourceResponse_Project:
+		if v == nil {
+			err := SudoGetResourceResponseValidationError{
+				field:  "Resource",
+				reason: "oneof value cannot be a typed-nil",
+			}
+			if !all {
+				return err
+			}
+			errors = append(errors, err)
+		}
+
+		if all {
+			switch v := interface{}(m.GetProject()).(type) {
+			case interface{ ValidateAll() error }:
+				if err := v.ValidateAll(); err != nil {
+					errors = append(errors, SudoGetResourceResponseValidationError{
+						field:  "Project",
+						reason: "embedded message failed validation",
+						cause:  err,
+					})
+				}
+			case interface{ Validate() error }:
+				if err := v.Validate(); err != nil {
+					errors = append(errors, SudoGetResourceResponseValidationError{
+						field:  "Project",
+						reason: "embedded message failed validation",
+						cause:  err,
+					})
+				}
+			}
+		} else if v, ok := interface{}(m.GetProject()).(interface{ Validate() error }); ok {
+			if err := v.Validate(); err != nil {
+				return SudoGetResourceResponseValidationError{
+					field:  "Project",
+					reason: "embedded message failed validation",
+					cause:  err,
+				}
+			}
+		}
+
+	case *SudoGetResourceResponse_Deployment:
+		if v == nil {
+			err := SudoGetResourceResponseValidationError{
+				field:  "Resource",
+				reason: "oneof value cannot be a typed-nil",
+			}
+			if !all {
+				return err
+			}
+			errors = append(errors, err)
+		}
+
+		if all {
+			switch v := interface{}(m.GetDeployment()).(type) {
+			case interface{ ValidateAll() error }:
+				if err := v.ValidateAll(); err != nil {
+					errors = append(errors, SudoGetResourceResponseValidationError{
+						field:  "Deployment",
+						reason: "embedded message failed validation",
+						cause:  err,
+					})
+				}
+			case interface{ Validate() error }:
+				if err := v.Validate(); err != nil {
+					errors = append(errors, SudoGetResourceResponseValidationError{
+						field:  "Deployment",
+						reason: "embedded message failed validation",
+						cause:  err,
+					})
+				}
+			}
+		} else if v, ok := interface{}(m.GetDeployment()).(interface{ Validate() error }); ok {
+			if err := v.Validate(); err != nil {
+				return SudoGetResourceResponseValidationError{
+					field:  "Deployment",
+					reason: "embedded message failed validation",
+					cause:  err,
+				}
+			}
+		}
+
+	case *SudoGetResourceResponse_Instance:
+		if v == nil {
+			err := SudoGetResourceResponseValidationError{
+				field:  "Resource",
+				reason: "oneof value cannot be a typed-nil",
+			}
+			if !all {
+				return err
+			}
+			errors = append(errors, err)
+		}
+
+		if all {
+			switch v := interface{}(m.GetInstance()).(type) {
+			case interface{ ValidateAll() error }:
+				if err := v.ValidateAll(); err != nil {
+					errors = append(errors, SudoGetResourceResponseValidationError{
+						field:  "Instance",
+						reason: "embedded message failed validation",
+						cause:  err,
+					})
+				}
+			case interface{ Validate() error }:
+				if err := v.Validate(); err != nil {
+					errors = append(errors, SudoGetResourceResponseValidationError{
+						field:  "Instance",
+						reason: "embedded message failed validation",
+						cause:  err,
+					})
+				}
+			}
+		} else if v, ok := interface{}(m.GetInstance()).(interface{ Validate() error }); ok {
+			if err := v.Validate(); err != nil {
+				return SudoGetResourceResponseValidationError{
+					field:  "Instance",
+					reason: "embedded message failed validation",
+					cause:  err,
+				}
+			}
+		}
+
+	default:
+		_ = v // ensures v is used
+	}
+
+	if len(errors) > 0 {
+		return SudoGetResourceResponseMultiError(errors)
+	}
+
+	return nil
+}
+
+// SudoGetResourceResponseMultiError is an error wrapping multiple validation
+// errors returned by SudoGetResourceResponse.ValidateAll() if the designated
+// constraints aren't met.
+type SudoGetResourceResponseMultiError []error
+
+// Error returns a concatenation of all the error messages it wraps.
+func (m SudoGetResourceResponseMultiError) Error() string {
+	var msgs []string
+	for _, err := range m {
+		msgs = append(msgs, err.Error())
+	}
+	return strings.Join(msgs, "; ")
+}
+
+// AllErrors returns a list of validation violation errors.
+func (m SudoGetResourceResponseMultiError) AllErrors() []error { return m }
+
+// SudoGetResourceResponseValidationError is the validation error returned by
+// SudoGetResourceResponse.Validate if the designated constraints aren't met.
+type SudoGetResourceResponseValidationError struct {
+	field  string
+	reason string
+	cause  error
+	key    bool
+}
+
+// Field function returns field value.
+func (e SudoGetResourceResponseValidationError) Field() string { return e.field }
+
+// Reason function returns reason value.
+func (e SudoGetResourceResponseValidationError) Reason() string { return e.reason }
+
+// Cause function returns cause value.
+func (e SudoGetResourceResponseValidationError) Cause() error { return e.cause }
+
+// Key function returns key value.
+func (e SudoGetResourceResponseValidationError) Key() bool { return e.key }
+
+// ErrorName returns error name.
+func (e SudoGetResourceResponseValidationError) ErrorName() string {
+	return "SudoGetResourceResponseValidationError"
+}
+
+// Error satisfies the builtin error interface
+func (e SudoGetResourceResponseValidationError) Error() string {
+	cause := ""
+	if e.cause != nil {
+		cause = fmt.Sprintf(" | caused by: %v", e.cause)
+	}
+
+	key := ""
+	if e.key {
+		key = "key for "
+	}
+
+	return fmt.Sprintf(
+		"invalid %sSudoGetResourceResponse.%s: %s%s",
+		key,
+		e.field,
+		e.reason,
+		cause)
+}
+
+var _ error = SudoGetResourceResponseValidationError{}
+
+var _ interface {
+	Field() string
+	Reason() string
+	Key() bool
+	Cause() error
+	ErrorName() string
+} = SudoGetResourceResponseValidationError{}
+
+// Validate checks the field values on SudoUpdateOrganizationQuotasRequest with
+// the rules defined in the proto definition for this message. If any rules
+// are violated, the first error encountered is returned, or nil if there are
+// no violations.
+func (m *SudoUpdateOrganizationQuotasRequest) Validate() error {
+	return m.validate(false)
+}
+
+// ValidateAll checks the field values on SudoUpdateOrganizationQuotasRequest
+// with the rules defined in the proto definition for this message. If any
+// rules are violated, the result is a list of violation errors wrapped in
+// SudoUpdateOrganizationQuotasRequestMultiError, or nil if none found.
+func (m *SudoUpdateOrganizationQuotasRequest) ValidateAll() error {
+	return m.validate(true)
+}
+
+func (m *SudoUpdateOrganizationQuotasRequest) validate(all bool) error {
+	if m == nil {
+		return nil
+	}
+
+	var errors []error
+
+	// no validation rules for OrgName
+
+	if m.Projects != nil {
+		// no validation rules for Projects
+	}
+
+	if m.Deployments != nil {
+		// no validation rules for Deployments
+	}
+
+	if m.SlotsTotal != nil {
+		// no validation rules for SlotsTotal
+	}
+
+	if m.SlotsPerDeployment != nil {
+		// no validation rules for SlotsPerDeployment
+	}
+
+	if m.OutstandingInvites != nil {
+		// no validation rules for OutstandingInvites
+	}
+
+	if len(errors) > 0 {
+		return SudoUpdateOrganizationQuotasRequestMultiError(errors)
+	}
+
+	return nil
+}
+
+// SudoUpdateOrganizationQuotasRequestMultiError is an error wrapping multiple
+// validation errors returned by
+// SudoUpdateOrganizationQuotasRequest.ValidateAll() if the designated
+// constraints aren't met.
+type SudoUpdateOrganizationQuotasRequestMultiError []error
+
+// Error returns a concatenation of all the error messages it wraps.
+func (m SudoUpdateOrganizationQuotasRequestMultiError) Error() string {
+	var msgs []string
+	for _, err := range m {
+		msgs = append(msgs, err.Error())
+	}
+	return strings.Join(msgs, "; ")
+}
+
+// AllErrors returns a list of validation violation errors.
+func (m SudoUpdateOrganizationQuotasRequestMultiError) AllErrors() []error { return m }
+
+// SudoUpdateOrganizationQuotasRequestValidationError is the validation error
+// returned by SudoUpdateOrganizationQuotasRequest.Validate if the designated
+// constraints aren't met.
+type SudoUpdateOrganizationQuotasRequestValidationError struct {
+	field  string
+	reason string
+	cause  error
+	key    bool
+}
+
+// Field function returns field value.
+func (e SudoUpdateOrganizationQuotasRequestValidationError) Field() string { return e.field }
+
+// Reason function returns reason value.
+func (e SudoUpdateOrganizationQuotasRequestValidationError) Reason() string { return e.reason }
+
+// Cause function returns cause value.
+func (e SudoUpdateOrganizationQuotasRequestValidationError) Cause() error { return e.cause }
+
+// Key function returns key value.
+func (e SudoUpdateOrganizationQuotasRequestValidationError) Key() bool { return e.key }
+
+// ErrorName returns error name.
+func (e SudoUpdateOrganizationQuotasRequestValidationError) ErrorName() string {
+	return "SudoUpdateOrganizationQuotasRequestValidationError"
+}
+
+// Error satisfies the builtin error interface
+func (e SudoUpdateOrganizationQuotasRequestValidationError) Error() string {
+	cause := ""
+	if e.cause != nil {
+		cause = fmt.Sprintf(" | caused by: %v", e.cause)
+	}
+
+	key := ""
+	if e.key {
+		key = "key for "
+	}
+
+	return fmt.Sprintf(
+		"invalid %sSudoUpdateOrganizationQuotasRequest.%s: %s%s",
+		key,
+		e.field,
+		e.reason,
+		cause)
+}
+
+var _ error = SudoUpdateOrganizationQuotasRequestValidationError{}
+
+var _ interface {
+	Field() string
+	Reason() string
+	Key() bool
+	Cause() error
+	ErrorName() string
+} = SudoUpdateOrganizationQuotasRequestValidationError{}
+
+// Validate checks the field values on SudoUpdateOrganizationQuotasResponse
+// with the rules defined in the proto definition for this message. If any
+// rules are violated, the first error encountered is returned, or nil if
+// there are no violations.
+func (m *SudoUpdateOrganizationQuotasResponse) Validate() error {
+	return m.validate(false)
+}
+
+// ValidateAll checks the field values on SudoUpdateOrganizationQuotasResponse
+// with the rules defined in the proto definition for this message. If any
+// rules are violated, the result is a list of violation errors wrapped in
+// SudoUpdateOrganizationQuotasResponseMultiError, or nil if none found.
+func (m *SudoUpdateOrganizationQuotasResponse) ValidateAll() error {
+	return m.validate(true)
+}
+
+func (m *SudoUpdateOrganizationQuotasResponse) validate(all bool) error {
+	if m == nil {
+		return nil
+	}
+
+	var errors []error
+
+	if all {
+		switch v := interface{}(m.GetOrganization()).(type) {
+		case interface{ ValidateAll() error }:
+			if err := v.ValidateAll(); err != nil {
+				errors = append(errors, SudoUpdateOrganizationQuotasResponseValidationError{
+					field:  "Organization",
+					reason: "embedded message failed validation",
+					cause:  err,
+				})
+			}
+		case interface{ Validate() error }:
+			if err := v.Validate(); err != nil {
+				errors = append(errors, SudoUpdateOrganizationQuotasResponseValidationError{
+					field:  "Organization",
+					reason: "embedded message failed validation",
+					cause:  err,
+				})
+			}
+		}
+	} else if v, ok := interface{}(m.GetOrganization()).(interface{ Validate() error }); ok {
+		if err := v.Validate(); err != nil {
+			return SudoUpdateOrganizationQuotasResponseValidationError{
+				field:  "Organization",
+				reason: "embedded message failed validation",
+				cause:  err,
+			}
+		}
+	}
+
+	if len(errors) > 0 {
+		return SudoUpdateOrganizationQuotasResponseMultiError(errors)
+	}
+
+	return nil
+}
+
+// SudoUpdateOrganizationQuotasResponseMultiError is an error wrapping multiple
+// validation errors returned by
+// SudoUpdateOrganizationQuotasResponse.ValidateAll() if the designated
+// constraints aren't met.
+type SudoUpdateOrganizationQuotasResponseMultiError []error
+
+// Error returns a concatenation of all the error messages it wraps.
+func (m SudoUpdateOrganizationQuotasResponseMultiError) Error() string {
+	var msgs []string
+	for _, err := range m {
+		msgs = append(msgs, err.Error())
+	}
+	return strings.Join(msgs, "; ")
+}
+
+// AllErrors returns a list of validation violation errors.
+func (m SudoUpdateOrganizationQuotasResponseMultiError) AllErrors() []error { return m }
+
+// SudoUpdateOrganizationQuotasResponseValidationError is the validation error
+// returned by SudoUpdateOrganizationQuotasResponse.Validate if the designated
+// constraints aren't met.
+type SudoUpdateOrganizationQuotasResponseValidationError struct {
+	field  string
+	reason string
+	cause  error
+	key    bool
+}
+
+// Field function returns field value.
+func (e SudoUpdateOrganizationQuotasResponseValidationError) Field() string { return e.field }
+
+// Reason function returns reason value.
+func (e SudoUpdateOrganizationQuotasResponseValidationError) Reason() string { return e.reason }
+
+// Cause function returns cause value.
+func (e SudoUpdateOrganizationQuotasResponseValidationError) Cause() error { return e.cause }
+
+// Key function returns key value.
+func (e SudoUpdateOrganizationQuotasResponseValidationError) Key() bool { return e.key }
+
+// ErrorName returns error name.
+func (e SudoUpdateOrganizationQuotasResponseValidationError) ErrorName() string {
+	return "SudoUpdateOrganizationQuotasResponseValidationError"
+}
+
+// Error satisfies the builtin error interface
+func (e SudoUpdateOrganizationQuotasResponseValidationError) Error() string {
+	cause := ""
+	if e.cause != nil {
+		cause = fmt.Sprintf(" | caused by: %v", e.cause)
+	}
+
+	key := ""
+	if e.key {
+		key = "key for "
+	}
+
+	return fmt.Sprintf(
+		"invalid %sSudoUpdateOrganizationQuotasResponse.%s: %s%s",
+		key,
+		e.field,
+		e.reason,
+		cause)
+}
+
+var _ error = SudoUpdateOrganizationQuotasResponseValidationError{}
+
+var _ interface {
+	Field() string
+	Reason() string
+	Key() bool
+	Cause() error
+	ErrorName() string
+} = SudoUpdateOrganizationQuotasResponseValidationError{}
+
+// Validate checks the field values on SudoUpdateUserQuotasRequest with the
+// rules defined in the proto definition for this message. If any rules are
+// violated, the first error encountered is returned, or nil if there are no violations.
+func (m *SudoUpdateUserQuotasRequest) Validate() error {
+	return m.validate(false)
+}
+
+// ValidateAll checks the field values on SudoUpdateUserQuotasRequest with the
+// rules defined in the proto definition for this message. If any rules are
+// violated, the result is a list of violation errors wrapped in
+// SudoUpdateUserQuotasRequestMultiError, or nil if none found.
+func (m *SudoUpdateUserQuotasRequest) ValidateAll() error {
+	return m.validate(true)
+}
+
+func (m *SudoUpdateUserQuotasRequest) validate(all bool) error {
+	if m == nil {
+		return nil
+	}
+
+	var errors []error
+
+	// no validation rules for Email
+
+	if m.SingleuserOrgs != nil {
+		// no validation rules for SingleuserOrgs
+	}
+
+	if len(errors) > 0 {
+		return SudoUpdateUserQuotasRequestMultiError(errors)
+	}
+
+	return nil
+}
+
+// SudoUpdateUserQuotasRequestMultiError is an error wrapping multiple
+// validation errors returned by SudoUpdateUserQuotasRequest.ValidateAll() if
+// the designated constraints aren't met.
+type SudoUpdateUserQuotasRequestMultiError []error
+
+// Error returns a concatenation of all the error messages it wraps.
+func (m SudoUpdateUserQuotasRequestMultiError) Error() string {
+	var msgs []string
+	for _, err := range m {
+		msgs = append(msgs, err.Error())
+	}
+	return strings.Join(msgs, "; ")
+}
+
+// AllErrors returns a list of validation violation errors.
+func (m SudoUpdateUserQuotasRequestMultiError) AllErrors() []error { return m }
+
+// SudoUpdateUserQuotasRequestValidationError is the validation error returned
+// by SudoUpdateUserQuotasRequest.Validate if the designated constraints
+// aren't met.
+type SudoUpdateUserQuotasRequestValidationError struct {
+	field  string
+	reason string
+	cause  error
+	key    bool
+}
+
+// Field function returns field value.
+func (e SudoUpdateUserQuotasRequestValidationError) Field() string { return e.field }
+
+// Reason function returns reason value.
+func (e SudoUpdateUserQuotasRequestValidationError) Reason() string { return e.reason }
+
+// Cause function returns cause value.
+func (e SudoUpdateUserQuotasRequestValidationError) Cause() error { return e.cause }
+
+// Key function returns key value.
+func (e SudoUpdateUserQuotasRequestValidationError) Key() bool { return e.key }
+
+// ErrorName returns error name.
+func (e SudoUpdateUserQuotasRequestValidationError) ErrorName() string {
+	return "SudoUpdateUserQuotasRequestValidationError"
+}
+
+// Error satisfies the builtin error interface
+func (e SudoUpdateUserQuotasRequestValidationError) Error() string {
+	cause := ""
+	if e.cause != nil {
+		cause = fmt.Sprintf(" | caused by: %v", e.cause)
+	}
+
+	key := ""
+	if e.key {
+		key = "key for "
+	}
+
+	return fmt.Sprintf(
+		"invalid %sSudoUpdateUserQuotasRequest.%s: %s%s",
+		key,
+		e.field,
+		e.reason,
+		cause)
+}
+
+var _ error = SudoUpdateUserQuotasRequestValidationError{}
+
+var _ interface {
+	Field() string
+	Reason() string
+	Key() bool
+	Cause() error
+	ErrorName() string
+} = SudoUpdateUserQuotasRequestValidationError{}
+
+// Validate checks the field values on SudoUpdateUserQuotasResponse with the
+// rules defined in the proto definition for this message. If any rules are
+// violated, the first error encountered is returned, or nil if there are no violations.
+func (m *SudoUpdateUserQuotasResponse) Validate() error {
+	return m.validate(false)
+}
+
+// ValidateAll checks the field values on SudoUpdateUserQuotasResponse with the
+// rules defined in the proto definition for this message. If any rules are
+// violated, the result is a list of violation errors wrapped in
+// SudoUpdateUserQuotasResponseMultiError, or nil if none found.
+func (m *SudoUpdateUserQuotasResponse) ValidateAll() error {
+	return m.validate(true)
+}
+
+func (m *SudoUpdateUserQuotasResponse) validate(all bool) error {
+	if m == nil {
+		return nil
+	}
+
+	var errors []error
+
+	if all {
+		switch v := interface{}(m.GetUser()).(type) {
+		case interface{ ValidateAll() error }:
+			if err := v.ValidateAll(); err != nil {
+				errors = append(errors, SudoUpdateUserQuotasResponseValidationError{
+					field:  "User",
+					reason: "embedded message failed validation",
+					cause:  err,
+				})
+			}
+		case interface{ Validate() error }:
+			if err := v.Validate(); err != nil {
+				errors = append(errors, SudoUpdateUserQuotasResponseValidationError{
+					field:  "User",
+					reason: "embedded message failed validation",
+					cause:  err,
+				})
+			}
+		}
+	} else if v, ok := interface{}(m.GetUser()).(interface{ Validate() error }); ok {
+		if err := v.Validate(); err != nil {
+			return SudoUpdateUserQuotasResponseValidationError{
+				field:  "User",
+				reason: "embedded message failed validation",
+				cause:  err,
+			}
+		}
+	}
+
+	if len(errors) > 0 {
+		return SudoUpdateUserQuotasResponseMultiError(errors)
+	}
+
+	return nil
+}
+
+// SudoUpdateUserQuotasResponseMultiError is an error wrapping multiple
+// validation errors returned by SudoUpdateUserQuotasResponse.ValidateAll() if
+// the designated constraints aren't met.
+type SudoUpdateUserQuotasResponseMultiError []error
+
+// Error returns a concatenation of all the error messages it wraps.
+func (m SudoUpdateUserQuotasResponseMultiError) Error() string {
+	var msgs []string
+	for _, err := range m {
+		msgs = append(msgs, err.Error())
+	}
+	return strings.Join(msgs, "; ")
+}
+
+// AllErrors returns a list of validation violation errors.
+func (m SudoUpdateUserQuotasResponseMultiError) AllErrors() []error { return m }
+
+// SudoUpdateUserQuotasResponseValidationError is the validation error returned
+// by SudoUpdateUserQuotasResponse.Validate if the designated constraints
+// aren't met.
+type SudoUpdateUserQuotasResponseValidationError struct {
+	field  string
+	reason string
+	cause  error
+	key    bool
+}
+
+// Field function returns field value.
+func (e SudoUpdateUserQuotasResponseValidationError) Field() string { return e.field }
+
+// Reason function returns reason value.
+func (e SudoUpdateUserQuotasResponseValidationError) Reason() string { return e.reason }
+
+// Cause function returns cause value.
+func (e SudoUpdateUserQuotasResponseValidationError) Cause() error { return e.cause }
+
+// Key function returns key value.
+func (e SudoUpdateUserQuotasResponseValidationError) Key() bool { return e.key }
+
+// ErrorName returns error name.
+func (e SudoUpdateUserQuotasResponseValidationError) ErrorName() string {
+	return "SudoUpdateUserQuotasResponseValidationError"
+}
+
+// Error satisfies the builtin error interface
+func (e SudoUpdateUserQuotasResponseValidationError) Error() string {
+	cause := ""
+	if e.cause != nil {
+		cause = fmt.Sprintf(" | caused by: %v", e.cause)
+	}
+
+	key := ""
+	if e.key {
+		key = "key for "
+	}
+
+	return fmt.Sprintf(
+		"invalid %sSudoUpdateUserQuotasResponse.%s: %s%s",
+		key,
+		e.field,
+		e.reason,
+		cause)
+}
+
+var _ error = SudoUpdateUserQuotasResponseValidationError{}
+
+var _ interface {
+	Field() string
+	Reason() string
+	Key() bool
+	Cause() error
+	ErrorName() string
+} = SudoUpdateUserQuotasResponseValidationError{}
+
+// Validate checks the field values on ListProjectMembersRequest with the rules
+// defined in the proto definition for this message. If any rules are
+// violated, the first error encountered is returned, or nil if there are no violations.
+func (m *ListProjectMembersRequest) Validate() error {
+	return m.validate(false)
+}
+
+// ValidateAll checks the field values on ListProjectMembersRequest with the
+// rules defined in the proto definition for this message. If any rules are
+// violated, the result is a list of violation errors wrapped in
+// ListProjectMembersRequestMultiError, or nil if none found.
+func (m *ListProjectMembersRequest) ValidateAll() error {
+	return m.validate(true)
+}
+
+func (m *ListProjectMembersRequest) validate(all bool) error {
+	if m == nil {
+		return nil
+	}
+
+	var errors []error
+
+	if utf8.RuneCountInString(m.GetOrganization()) < 1 {
+		err := ListProjectMembersRequestValidationError{
+			field:  "Organization",
+			reason: "value length must be at least 1 runes",
+		}
+		if !all {
+			return err
+		}
+		errors = append(errors, err)
+	}
+
+	if utf8.RuneCountInString(m.GetProject()) < 1 {
+		err := ListProjectMembersRequestValidationError{
+			field:  "Project",
+			reason: "value length must be at least 1 runes",
+		}
+		if !all {
+			return err
+		}
+		errors = append(errors, err)
+	}
+
+	if m.GetPageSize() != 0 {
+
+		if m.GetPageSize() > 1000 {
+			err := ListProjectMembersRequestValidationError{
+				field:  "PageSize",
+				reason: "value must be less than or equal to 1000",
+			}
+			if !all {
+				return err
+			}
+			errors = append(errors, err)
+		}
+
+	}
+
+	// no validation rules for PageToken
+
+	if len(errors) > 0 {
+		return ListProjectMembersRequestMultiError(errors)
+	}
+
+	return nil
+}
+
+// ListProjectMembersRequestMultiError is an error wrapping multiple validation
+// errors returned by ListProjectMembersRequest.ValidateAll() if the
+// designated constraints aren't met.
+type ListProjectMembersRequestMultiError []error
+
+// Error returns a concatenation of all the error messages it wraps.
+func (m ListProjectMembersRequestMultiError) Error() string {
+	var msgs []string
+	for _, err := range m {
+		msgs = append(msgs, err.Error())
+	}
+	return strings.Join(msgs, "; ")
+}
+
+// AllErrors returns a list of validation violation errors.
+func (m ListProjectMembersRequestMultiError) AllErrors() []error { return m }
+
+// ListProjectMembersRequestValidationError is the validation error returned by
+// ListProjectMembersRequest.Validate if the designated constraints aren't met.
+type ListProjectMembersRequestValidationError struct {
+	field  string
+	reason string
+	cause  error
+	key    bool
+}
+
+// Field function returns field value.
+func (e ListProjectMembersRequestValidationError) Field() string { return e.field }
+
+// Reason function returns reason value.
+func (e ListProjectMembersRequestValidationError) Reason() string { return e.reason }
+
+// Cause function returns cause value.
+func (e ListProjectMembersRequestValidationError) Cause() error { return e.cause }
+
+// Key function returns key value.
+func (e ListProjectMembersRequestValidationError) Key() bool { return e.key }
+
+// ErrorName returns error name.
+func (e ListProjectMembersRequestValidationError) ErrorName() string {
+	return "ListProjectMembersRequestValidationError"
+}
+
+// Error satisfies the builtin error interface
+func (e ListProjectMembersRequestValidationError) Error() string {
+	cause := ""
+	if e.cause != nil {
+		cause = fmt.Sprintf(" | caused by: %v", e.cause)
+	}
+
+	key := ""
+	if e.key {
+		key = "key for "
+	}
+
+	return fmt.Sprintf(
+		"invalid %sListProjectMembersRequest.%s: %s%s",
+		key,
+		e.field,
+		e.reason,
+		cause)
+}
+
+var _ error = ListProjectMembersRequestValidationError{}
+
+var _ interface {
+	Field() string
+	Reason() string
+	Key() bool
+	Cause() error
+	ErrorName() string
+} = ListProjectMembersRequestValidationError{}
+
+// Validate checks the field values on ListProjectMembersResponse with the
+// rules defined in the proto definition for this message. If any rules are
+// violated, the first error encountered is returned, or nil if there are no violations.
+func (m *ListProjectMembersResponse) Validate() error {
+	return m.validate(false)
+}
+
+// ValidateAll checks the field values on ListProjectMembersResponse with the
+// rules defined in the proto definition for this message. If any rules are
+// violated, the result is a list of violation errors wrapped in
+// ListProjectMembersResponseMultiError, or nil if none found.
+func (m *ListProjectMembersResponse) ValidateAll() error {
+	return m.validate(true)
+}
+
+func (m *ListProjectMembersResponse) validate(all bool) error {
+	if m == nil {
+		return nil
+	}
+
+	var errors []error
+
+	for idx, item := range m.GetMembers() {
+		_, _ = idx, item
+
+		if all {
+			switch v := interface{}(item).(type) {
+			case interface{ ValidateAll() error }:
+				if err := v.ValidateAll(); err != nil {
+					errors = append(errors, ListProjectMembersResponseValidationError{
+						field:  fmt.Sprintf("Members[%v]", idx),
+						reason: "embedded message failed validation",
+						cause:  err,
+					})
+				}
+			case interface{ Validate() error }:
+				if err := v.Validate(); err != nil {
+					errors = append(errors, ListProjectMembersResponseValidationError{
+						field:  fmt.Sprintf("Members[%v]", idx),
+						reason: "embedded message failed validation",
+						cause:  err,
+					})
+				}
+			}
+		} else if v, ok := interface{}(item).(interface{ Validate() error }); ok {
+			if err := v.Validate(); err != nil {
+				return ListProjectMembersResponseValidationError{
+					field:  fmt.Sprintf("Members[%v]", idx),
+					reason: "embedded message failed validation",
+					cause:  err,
+				}
+			}
+		}
+
+	}
+
+	// no validation rules for NextPageToken
+
+	if len(errors) > 0 {
+		return ListProjectMembersResponseMultiError(errors)
+	}
+
+	return nil
+}
+
+// ListProjectMembersResponseMultiError is an error wrapping multiple
+// validation errors returned by ListProjectMembersResponse.ValidateAll() if
+// the designated constraints aren't met.
+type ListProjectMembersResponseMultiError []error
+
+// Error returns a concatenation of all the error messages it wraps.
+func (m ListProjectMembersResponseMultiError) Error() string {
+	var msgs []string
+	for _, err := range m {
+		msgs = append(msgs, err.Error())
+	}
+	return strings.Join(msgs, "; ")
+}
+
+// AllErrors returns a list of validation violation errors.
+func (m ListProjectMembersResponseMultiError) AllErrors() []error { return m }
+
+// ListProjectMembersResponseValidationError is the validation error returned
+// by ListProjectMembersResponse.Validate if the designated constraints aren't met.
+type ListProjectMembersResponseValidationError struct {
+	field  string
+	reason string
+	cause  error
+	key    bool
+}
+
+// Field function returns field value.
+func (e ListProjectMembersResponseValidationError) Field() string { return e.field }
+
+// Reason function returns reason value.
+func (e ListProjectMembersResponseValidationError) Reason() string { return e.reason }
+
+// Cause function returns cause value.
+func (e ListProjectMembersResponseValidationError) Cause() error { return e.cause }
+
+// Key function returns key value.
+func (e ListProjectMembersResponseValidationError) Key() bool { return e.key }
+
+// ErrorName returns error name.
+func (e ListProjectMembersResponseValidationError) ErrorName() string {
+	return "ListProjectMembersResponseValidationError"
+}
+
+// Error satisfies the builtin error interface
+func (e ListProjectMembersResponseValidationError) Error() string {
+	cause := ""
+	if e.cause != nil {
+		cause = fmt.Sprintf(" | caused by: %v", e.cause)
+	}
+
+	key := ""
+	if e.key {
+		key = "key for "
+	}
+
+	return fmt.Sprintf(
+		"invalid %sListProjectMembersResponse.%s: %s%s",
+		key,
+		e.field,
+		e.reason,
+		cause)
+}
+
+var _ error = ListProjectMembersResponseValidationError{}
+
+var _ interface {
+	Field() string
+	Reason() string
+	Key() bool
+	Cause() error
+	ErrorName() string
+} = ListProjectMembersResponseValidationError{}
+
+// Validate checks the field values on ListProjectInvitesRequest with the rules
+// defined in the proto definition for this message. If any rules are
+// violated, the first error encountered is returned, or nil if there are no violations.
+func (m *ListProjectInvitesRequest) Validate() error {
+	return m.validate(false)
+}
+
+// ValidateAll checks the field values on ListProjectInvitesRequest with the
+// rules defined in the proto definition for this message. If any rules are
+// violated, the result is a list of violation errors wrapped in
+// ListProjectInvitesRequestMultiError, or nil if none found.
+func (m *ListProjectInvitesRequest) ValidateAll() error {
+	return m.validate(true)
+}
+
+func (m *ListProjectInvitesRequest) validate(all bool) error {
+	if m == nil {
+		return nil
+	}
+
+	var errors []error
+
+	if utf8.RuneCountInString(m.GetOrganization()) < 1 {
+		err := ListProjectInvitesRequestValidationError{
+			field:  "Organization",
+			reason: "value length must be at least 1 runes",
+		}
+		if !all {
+			return err
+		}
+		errors = append(errors, err)
+	}
+
+	if utf8.RuneCountInString(m.GetProject()) < 1 {
+		err := ListProjectInvitesRequestValidationError{
+			field:  "Project",
+			reason: "value length must be at least 1 runes",
+		}
+		if !all {
+			return err
+		}
+		errors = append(errors, err)
+	}
+
+	if m.GetPageSize() != 0 {
+
+		if m.GetPageSize() > 1000 {
+			err := ListProjectInvitesRequestValidationError{
+				field:  "PageSize",
+				reason: "value must be less than or equal to 1000",
+			}
+			if !all {
+				return err
+			}
+			errors = append(errors, err)
+		}
+
+	}
+
+	// no validation rules for PageToken
+
+	if len(errors) > 0 {
+		return ListProjectInvitesRequestMultiError(errors)
+	}
+
+	return nil
+}
+
+// ListProjectInvitesRequestMultiError is an error wrapping multiple validation
+// errors returned by ListProjectInvitesRequest.ValidateAll() if the
+// designated constraints aren't met.
+type ListProjectInvitesRequestMultiError []error
+
+// Error returns a concatenation of all the error messages it wraps.
+func (m ListProjectInvitesRequestMultiError) Error() string {
+	var msgs []string
+	for _, err := range m {
+		msgs = append(msgs, err.Error())
+	}
+	return strings.Join(msgs, "; ")
+}
+
+// AllErrors returns a list of validation violation errors.
+func (m ListProjectInvitesRequestMultiError) AllErrors() []error { return m }
+
+// ListProjectInvitesRequestValidationError is the validation error returned by
+// ListProjectInvitesRequest.Validate if the designated constraints aren't met.
+type ListProjectInvitesRequestValidationError struct {
+	field  string
+	reason string
+	cause  error
+	key    bool
+}
+
+// Field function returns field value.
+func (e ListProjectInvitesRequestValidationError) Field() string { return e.field }
+
+// Reason function returns reason value.
+func (e ListProjectInvitesRequestValidationError) Reason() string { return e.reason }
+
+// Cause function returns cause value.
+func (e ListProjectInvitesRequestValidationError) Cause() error { return e.cause }
+
+// Key function returns key value.
+func (e ListProjectInvitesRequestValidationError) Key() bool { return e.key }
+
+// ErrorName returns error name.
+func (e ListProjectInvitesRequestValidationError) ErrorName() string {
+	return "ListProjectInvitesRequestValidationError"
+}
+
+// Error satisfies the builtin error interface
+func (e ListProjectInvitesRequestValidationError) Error() string {
+	cause := ""
+	if e.cause != nil {
+		cause = fmt.Sprintf(" | caused by: %v", e.cause)
+	}
+
+	key := ""
+	if e.key {
+		key = "key for "
+	}
+
+	return fmt.Sprintf(
+		"invalid %sListProjectInvitesRequest.%s: %s%s",
+		key,
+		e.field,
+		e.reason,
+		cause)
+}
+
+var _ error = ListProjectInvitesRequestValidationError{}
+
+var _ interface {
+	Field() string
+	Reason() string
+	Key() bool
+	Cause() error
+	ErrorName() string
+} = ListProjectInvitesRequestValidationError{}
+
+// Validate checks the field values on ListProjectInvitesResponse with the
+// rules defined in the proto definition for this message. If any rules are
+// violated, the first error encountered is returned, or nil if there are no violations.
+func (m *ListProjectInvitesResponse) Validate() error {
+	return m.validate(false)
+}
+
+// ValidateAll checks the field values on ListProjectInvitesResponse with the
+// rules defined in the proto definition for this message. If any rules are
+// violated, the result is a list of violation errors wrapped in
+// ListProjectInvitesResponseMultiError, or nil if none found.
+func (m *ListProjectInvitesResponse) ValidateAll() error {
+	return m.validate(true)
+}
+
+func (m *ListProjectInvitesResponse) validate(all bool) error {
+	if m == nil {
+		return nil
+	}
+
+	var errors []error
+
+	for idx, item := range m.GetInvites() {
+		_, _ = idx, item
+
+		if all {
+			switch v := interface{}(item).(type) {
+			case interface{ ValidateAll() error }:
+				if err := v.ValidateAll(); err != nil {
+					errors = append(errors, ListProjectInvitesResponseValidationError{
+						field:  fmt.Sprintf("Invites[%v]", idx),
+						reason: "embedded message failed validation",
+						cause:  err,
+					})
+				}
+			case interface{ Validate() error }:
+				if err := v.Validate(); err != nil {
+					errors = append(errors, ListProjectInvitesResponseValidationError{
+						field:  fmt.Sprintf("Invites[%v]", idx),
+						reason: "embedded message failed validation",
+						cause:  err,
+					})
+				}
+			}
+		} else if v, ok := interface{}(item).(interface{ Validate() error }); ok {
+			if err := v.Validate(); err != nil {
+				return ListProjectInvitesResponseValidationError{
+					field:  fmt.Sprintf("Invites[%v]", idx),
+					reason: "embedded message failed validation",
+					cause:  err,
+				}
+			}
+		}
+
+	}
+
+	// no validation rules for NextPageToken
+
+	if len(errors) > 0 {
+		return ListProjectInvitesResponseMultiError(errors)
+	}
+
+	return nil
+}
+
+// ListProjectInvitesResponseMultiError is an error wrapping multiple
+// validation errors returned by ListProjectInvitesResponse.ValidateAll() if
+// the designated constraints aren't met.
+type ListProjectInvitesResponseMultiError []error
+
+// Error returns a concatenation of all the error messages it wraps.
+func (m ListProjectInvitesResponseMultiError) Error() string {
+	var msgs []string
+	for _, err := range m {
+		msgs = append(msgs, err.Error())
+	}
+	return strings.Join(msgs, "; ")
+}
+
+// AllErrors returns a list of validation violation errors.
+func (m ListProjectInvitesResponseMultiError) AllErrors() []error { return m }
+
+// ListProjectInvitesResponseValidationError is the validation error returned
+// by ListProjectInvitesResponse.Validate if the designated constraints aren't met.
+type ListProjectInvitesResponseValidationError struct {
+	field  string
+	reason string
+	cause  error
+	key    bool
+}
+
+// Field function returns field value.
+func (e ListProjectInvitesResponseValidationError) Field() string { return e.field }
+
+// Reason function returns reason value.
+func (e ListProjectInvitesResponseValidationError) Reason() string { return e.reason }
+
+// Cause function returns cause value.
+func (e ListProjectInvitesResponseValidationError) Cause() error { return e.cause }
+
+// Key function returns key value.
+func (e ListProjectInvitesResponseValidationError) Key() bool { return e.key }
+
+// ErrorName returns error name.
+func (e ListProjectInvitesResponseValidationError) ErrorName() string {
+	return "ListProjectInvitesResponseValidationError"
+}
+
+// Error satisfies the builtin error interface
+func (e ListProjectInvitesResponseValidationError) Error() string {
+	cause := ""
+	if e.cause != nil {
+		cause = fmt.Sprintf(" | caused by: %v", e.cause)
+	}
+
+	key := ""
+	if e.key {
+		key = "key for "
+	}
+
+	return fmt.Sprintf(
+		"invalid %sListProjectInvitesResponse.%s: %s%s",
+		key,
+		e.field,
+		e.reason,
+		cause)
+}
+
+var _ error = ListProjectInvitesResponseValidationError{}
+
+var _ interface {
+	Field() string
+	Reason() string
+	Key() bool
+	Cause() error
+	ErrorName() string
+} = ListProjectInvitesResponseValidationError{}
+
+// Validate checks the field values on AddProjectMemberRequest with the rules
+// defined in the proto definition for this message. If any rules are
+// violated, the first error encountered is returned, or nil if there are no violations.
+func (m *AddProjectMemberRequest) Validate() error {
+	return m.validate(false)
+}
+
+// ValidateAll checks the field values on AddProjectMemberRequest with the
+// rules defined in the proto definition for this message. If any rules are
+// violated, the result is a list of violation errors wrapped in
+// AddProjectMemberRequestMultiError, or nil if none found.
+func (m *AddProjectMemberRequest) ValidateAll() error {
+	return m.validate(true)
+}
+
+func (m *AddProjectMemberRequest) validate(all bool) error {
+	if m == nil {
+		return nil
+	}
+
+	var errors []error
+
+	if utf8.RuneCountInString(m.GetOrganization()) < 1 {
+		err := AddProjectMemberRequestValidationError{
+			field:  "Organization",
+			reason: "value length must be at least 1 runes",
+		}
+		if !all {
+			return err
+		}
+		errors = append(errors, err)
+	}
+
+	if utf8.RuneCountInString(m.GetProject()) < 1 {
+		err := AddProjectMemberRequestValidationError{
+			field:  "Project",
+			reason: "value length must be at least 1 runes",
+		}
+		if !all {
+			return err
+		}
+		errors = append(errors, err)
+	}
+
+	if err := m._validateEmail(m.GetEmail()); err != nil {
+		err = AddProjectMemberRequestValidationError{
+			field:  "Email",
+			reason: "value must be a valid email address",
+			cause:  err,
+		}
+		if !all {
+			return err
+		}
+		errors = append(errors, err)
+	}
+
+	if utf8.RuneCountInString(m.GetRole()) < 1 {
+		err := AddProjectMemberRequestValidationError{
+			field:  "Role",
+			reason: "value length must be at least 1 runes",
+		}
+		if !all {
+			return err
+		}
+		errors = append(errors, err)
+	}
+
+	if len(errors) > 0 {
+		return AddProjectMemberRequestMultiError(errors)
+	}
+
+	return nil
+}
+
+func (m *AddProjectMemberRequest) _validateHostname(host string) error {
+	s := strings.ToLower(strings.TrimSuffix(host, "."))
+
+	if len(host) > 253 {
+		return errors.New("hostname cannot exceed 253 characters")
+	}
+
+	for _, part := range strings.Split(s, ".") {
+		if l := len(part); l == 0 || l > 63 {
+			return errors.New("hostname part must be non-empty and cannot exceed 63 characters")
+		}
+
+		if part[0] == '-' {
+			return errors.New("hostname parts cannot begin with hyphens")
+		}
+
+		if part[len(part)-1] == '-' {
+			return errors.New("hostname parts cannot end with hyphens")
+		}
+
+		for _, r := range part {
+			if (r < 'a' || r > 'z') && (r < '0' || r > '9') && r != '-' {
+				return fmt.Errorf("hostname parts can only contain alphanumeric characters or hyphens, got %q", string(r))
+			}
+		}
+	}
+
+	return nil
+}
+
+func (m *AddProjectMemberRequest) _validateEmail(addr string) error {
+	a, err := mail.ParseAddress(addr)
+	if err != nil {
+		return err
+	}
+	addr = a.Address
+
+	if len(addr) > 254 {
+		return errors.New("email addresses cannot exceed 254 characters")
+	}
+
+	parts := strings.SplitN(addr, "@", 2)
+
+	if len(parts[0]) > 64 {
+		return errors.New("email address local phrase cannot exceed 64 characters")
+	}
+
+	return m._validateHostname(parts[1])
+}
+
+// AddProjectMemberRequestMultiError is an error wrapping multiple validation
+// errors returned by AddProjectMemberRequest.ValidateAll() if the designated
+// constraints aren't met.
+type AddProjectMemberRequestMultiError []error
+
+// Error returns a concatenation of all the error messages it wraps.
+func (m AddProjectMemberRequestMultiError) Error() string {
+	var msgs []string
+	for _, err := range m {
+		msgs = append(msgs, err.Error())
+	}
+	return strings.Join(msgs, "; ")
+}
+
+// AllErrors returns a list of validation violation errors.
+func (m AddProjectMemberRequestMultiError) AllErrors() []error { return m }
+
+// AddProjectMemberRequestValidationError is the validation error returned by
+// AddProjectMemberRequest.Validate if the designated constraints aren't met.
+type AddProjectMemberRequestValidationError struct {
+	field  string
+	reason string
+	cause  error
+	key    bool
+}
+
+// Field function returns field value.
+func (e AddProjectMemberRequestValidationError) Field() string { return e.field }
+
+// Reason function returns reason value.
+func (e AddProjectMemberRequestValidationError) Reason() string { return e.reason }
+
+// Cause function returns cause value.
+func (e AddProjectMemberRequestValidationError) Cause() error { return e.cause }
+
+// Key function returns key value.
+func (e AddProjectMemberRequestValidationError) Key() bool { return e.key }
+
+// ErrorName returns error name.
+func (e AddProjectMemberRequestValidationError) ErrorName() string {
+	return "AddProjectMemberRequestValidationError"
+}
+
+// Error satisfies the builtin error interface
+func (e AddProjectMemberRequestValidationError) Error() string {
+	cause := ""
+	if e.cause != nil {
+		cause = fmt.Sprintf(" | caused by: %v", e.cause)
+	}
+
+	key := ""
+	if e.key {
+		key = "key for "
+	}
+
+	return fmt.Sprintf(
+		"invalid %sAddProjectMemberRequest.%s: %s%s",
+		key,
+		e.field,
+		e.reason,
+		cause)
+}
+
+var _ error = AddProjectMemberRequestValidationError{}
+
+var _ interface {
+	Field() string
+	Reason() string
+	Key() bool
+	Cause() error
+	ErrorName() string
+} = AddProjectMemberRequestValidationError{}
+
+// Validate checks the field values on AddProjectMemberResponse with the rules
+// defined in the proto definition for this message. If any rules are
+// violated, the first error encountered is returned, or nil if there are no violations.
+func (m *AddProjectMemberResponse) Validate() error {
+	return m.validate(false)
+}
+
+// ValidateAll checks the field values on AddProjectMemberResponse with the
+// rules defined in the proto definition for this message. If any rules are
+// violated, the result is a list of violation errors wrapped in
+// AddProjectMemberResponseMultiError, or nil if none found.
+func (m *AddProjectMemberResponse) ValidateAll() error {
+	return m.validate(true)
+}
+
+func (m *AddProjectMemberResponse) validate(all bool) error {
+	if m == nil {
+		return nil
+	}
+
+	var errors []error
+
+	// no validation rules for PendingSignup
+
+	if len(errors) > 0 {
+		return AddProjectMemberResponseMultiError(errors)
+	}
+
+	return nil
+}
+
+// AddProjectMemberResponseMultiError is an error wrapping multiple validation
+// errors returned by AddProjectMemberResponse.ValidateAll() if the designated
+// constraints aren't met.
+type AddProjectMemberResponseMultiError []error
+
+// Error returns a concatenation of all the error messages it wraps.
+func (m AddProjectMemberResponseMultiError) Error() string {
+	var msgs []string
+	for _, err := range m {
+		msgs = append(msgs, err.Error())
+	}
+	return strings.Join(msgs, "; ")
+}
+
+// AllErrors returns a list of validation violation errors.
+func (m AddProjectMemberResponseMultiError) AllErrors() []error { return m }
+
+// AddProjectMemberResponseValidationError is the validation error returned by
+// AddProjectMemberResponse.Validate if the designated constraints aren't met.
+type AddProjectMemberResponseValidationError struct {
+	field  string
+	reason string
+	cause  error
+	key    bool
+}
+
+// Field function returns field value.
+func (e AddProjectMemberResponseValidationError) Field() string { return e.field }
+
+// Reason function returns reason value.
+func (e AddProjectMemberResponseValidationError) Reason() string { return e.reason }
+
+// Cause function returns cause value.
+func (e AddProjectMemberResponseValidationError) Cause() error { return e.cause }
+
+// Key function returns key value.
+func (e AddProjectMemberResponseValidationError) Key() bool { return e.key }
+
+// ErrorName returns error name.
+func (e AddProjectMemberResponseValidationError) ErrorName() string {
+	return "AddProjectMemberResponseValidationError"
+}
+
+// Error satisfies the builtin error interface
+func (e AddProjectMemberResponseValidationError) Error() string {
+	cause := ""
+	if e.cause != nil {
+		cause = fmt.Sprintf(" | caused by: %v", e.cause)
+	}
+
+	key := ""
+	if e.key {
+		key = "key for "
+	}
+
+	return fmt.Sprintf(
+		"invalid %sAddProjectMemberResponse.%s: %s%s",
+		key,
+		e.field,
+		e.reason,
+		cause)
+}
+
+var _ error = AddProjectMemberResponseValidationError{}
+
+var _ interface {
+	Field() string
+	Reason() string
+	Key() bool
+	Cause() error
+	ErrorName() string
+} = AddProjectMemberResponseValidationError{}
+
+// Validate checks the field values on RemoveProjectMemberRequest with the
+// rules defined in the proto definition for this message. If any rules are
+// violated, the first error encountered is returned, or nil if there are no violations.
+func (m *RemoveProjectMemberRequest) Validate() error {
+	return m.validate(false)
+}
+
+// ValidateAll checks the field values on RemoveProjectMemberRequest with the
+// rules defined in the proto definition for this message. If any rules are
+// violated, the result is a list of violation errors wrapped in
+// RemoveProjectMemberRequestMultiError, or nil if none found.
+func (m *RemoveProjectMemberRequest) ValidateAll() error {
+	return m.validate(true)
+}
+
+func (m *RemoveProjectMemberRequest) validate(all bool) error {
+	if m == nil {
+		return nil
+	}
+
+	var errors []error
+
+	if utf8.RuneCountInString(m.GetOrganization()) < 1 {
+		err := RemoveProjectMemberRequestValidationError{
+			field:  "Organization",
+			reason: "value length must be at least 1 runes",
+		}
+		if !all {
+			return err
+		}
+		errors = append(errors, err)
+	}
+
+	if utf8.RuneCountInString(m.GetProject()) < 1 {
+		err := RemoveProjectMemberRequestValidationError{
+			field:  "Project",
+			reason: "value length must be at least 1 runes",
+		}
+		if !all {
+			return err
+		}
+		errors = append(errors, err)
+	}
+
+	if err := m._validateEmail(m.GetEmail()); err != nil {
+		err = RemoveProjectMemberRequestValidationError{
+			field:  "Email",
+			reason: "value must be a valid email address",
+			cause:  err,
+		}
+		if !all {
+			return err
+		}
+		errors = append(errors, err)
+	}
+
+	if len(errors) > 0 {
+		return RemoveProjectMemberRequestMultiError(errors)
+	}
+
+	return nil
+}
+
+func (m *RemoveProjectMemberRequest) _validateHostname(host string) error {
+	s := strings.ToLower(strings.TrimSuffix(host, "."))
+
+	if len(host) > 253 {
+		return errors.New("hostname cannot exceed 253 characters")
+	}
+
+	for _, part := range strings.Split(s, ".") {
+		if l := len(part); l == 0 || l > 63 {
+			return errors.New("hostname part must be non-empty and cannot exceed 63 characters")
+		}
+
+		if part[0] == '-' {
+			return errors.New("hostname parts cannot begin with hyphens")
+		}
+
+		if part[len(part)-1] == '-' {
+			return errors.New("hostname parts cannot end with hyphens")
+		}
+
+		for _, r := range part {
+			if (r < 'a' || r > 'z') && (r < '0' || r > '9') && r != '-' {
+				return fmt.Errorf("hostname parts can only contain alphanumeric characters or hyphens, got %q", string(r))
+			}
+		}
+	}
+
+	return nil
+}
+
+func (m *RemoveProjectMemberRequest) _validateEmail(addr string) error {
+	a, err := mail.ParseAddress(addr)
+	if err != nil {
+		return err
+	}
+	addr = a.Address
+
+	if len(addr) > 254 {
+		return errors.New("email addresses cannot exceed 254 characters")
+	}
+
+	parts := strings.SplitN(addr, "@", 2)
+
+	if len(parts[0]) > 64 {
+		return errors.New("email address local phrase cannot exceed 64 characters")
+	}
+
+	return m._validateHostname(parts[1])
+}
+
+// RemoveProjectMemberRequestMultiError is an error wrapping multiple
+// validation errors returned by RemoveProjectMemberRequest.ValidateAll() if
+// the designated constraints aren't met.
+type RemoveProjectMemberRequestMultiError []error
+
+// Error returns a concatenation of all the error messages it wraps.
+func (m RemoveProjectMemberRequestMultiError) Error() string {
+	var msgs []string
+	for _, err := range m {
+		msgs = append(msgs, err.Error())
+	}
+	return strings.Join(msgs, "; ")
+}
+
+// AllErrors returns a list of validation violation errors.
+func (m RemoveProjectMemberRequestMultiError) AllErrors() []error { return m }
+
+// RemoveProjectMemberRequestValidationError is the validation error returned
+// by RemoveProjectMemberRequest.Validate if the designated constraints aren't met.
+type RemoveProjectMemberRequestValidationError struct {
+	field  string
+	reason string
+	cause  error
+	key    bool
+}
+
+// Field function returns field value.
+func (e RemoveProjectMemberRequestValidationError) Field() string { return e.field }
+
+// Reason function returns reason value.
+func (e RemoveProjectMemberRequestValidationError) Reason() string { return e.reason }
+
+// Cause function returns cause value.
+func (e RemoveProjectMemberRequestValidationError) Cause() error { return e.cause }
+
+// Key function returns key value.
+func (e RemoveProjectMemberRequestValidationError) Key() bool { return e.key }
+
+// ErrorName returns error name.
+func (e RemoveProjectMemberRequestValidationError) ErrorName() string {
+	return "RemoveProjectMemberRequestValidationError"
+}
+
+// Error satisfies the builtin error interface
+func (e RemoveProjectMemberRequestValidationError) Error() string {
+	cause := ""
+	if e.cause != nil {
+		cause = fmt.Sprintf(" | caused by: %v", e.cause)
+	}
+
+	key := ""
+	if e.key {
+		key = "key for "
+	}
+
+	return fmt.Sprintf(
+		"invalid %sRemoveProjectMemberRequest.%s: %s%s",
+		key,
+		e.field,
+		e.reason,
+		cause)
+}
+
+var _ error = RemoveProjectMemberRequestValidationError{}
+
+var _ interface {
+	Field() string
+	Reason() string
+	Key() bool
+	Cause() error
+	ErrorName() string
+} = RemoveProjectMemberRequestValidationError{}
+
+// Validate checks the field values on RemoveProjectMemberResponse with the
+// rules defined in the proto definition for this message. If any rules are
+// violated, the first error encountered is returned, or nil if there are no violations.
+func (m *RemoveProjectMemberResponse) Validate() error {
+	return m.validate(false)
+}
+
+// ValidateAll checks the field values on RemoveProjectMemberResponse with the
+// rules defined in the proto definition for this message. If any rules are
+// violated, the result is a list of violation errors wrapped in
+// RemoveProjectMemberResponseMultiError, or nil if none found.
+func (m *RemoveProjectMemberResponse) ValidateAll() error {
+	return m.validate(true)
+}
+
+func (m *RemoveProjectMemberResponse) validate(all bool) error {
+	if m == nil {
+		return nil
+	}
+
+	var errors []error
+
+	if len(errors) > 0 {
+		return RemoveProjectMemberResponseMultiError(errors)
+	}
+
+	return nil
+}
+
+// RemoveProjectMemberResponseMultiError is an error wrapping multiple
+// validation errors returned by RemoveProjectMemberResponse.ValidateAll() if
+// the designated constraints aren't met.
+type RemoveProjectMemberResponseMultiError []error
+
+// Error returns a concatenation of all the error messages it wraps.
+func (m RemoveProjectMemberResponseMultiError) Error() string {
+	var msgs []string
+	for _, err := range m {
+		msgs = append(msgs, err.Error())
+	}
+	return strings.Join(msgs, "; ")
+}
+
+// AllErrors returns a list of validation violation errors.
+func (m RemoveProjectMemberResponseMultiError) AllErrors() []error { return m }
+
+// RemoveProjectMemberResponseValidationError is the validation error returned
+// by RemoveProjectMemberResponse.Validate if the designated constraints
+// aren't met.
+type RemoveProjectMemberResponseValidationError struct {
+	field  string
+	reason string
+	cause  error
+	key    bool
+}
+
+// Field function returns field value.
+func (e RemoveProjectMemberResponseValidationError) Field() string { return e.field }
+
+// Reason function returns reason value.
+func (e RemoveProjectMemberResponseValidationError) Reason() string { return e.reason }
+
+// Cause function returns cause value.
+func (e RemoveProjectMemberResponseValidationError) Cause() error { return e.cause }
+
+// Key function returns key value.
+func (e RemoveProjectMemberResponseValidationError) Key() bool { return e.key }
+
+// ErrorName returns error name.
+func (e RemoveProjectMemberResponseValidationError) ErrorName() string {
+	return "RemoveProjectMemberResponseValidationError"
+}
+
+// Error satisfies the builtin error interface
+func (e RemoveProjectMemberResponseValidationError) Error() string {
+	cause := ""
+	if e.cause != nil {
+		cause = fmt.Sprintf(" | caused by: %v", e.cause)
+	}
+
+	key := ""
+	if e.key {
+		key = "key for "
+	}
+
+	return fmt.Sprintf(
+		"invalid %sRemoveProjectMemberResponse.%s: %s%s",
+		key,
+		e.field,
+		e.reason,
+		cause)
+}
+
+var _ error = RemoveProjectMemberResponseValidationError{}
+
+var _ interface {
+	Field() string
+	Reason() string
+	Key() bool
+	Cause() error
+	ErrorName() string
+} = RemoveProjectMemberResponseValidationError{}
+
+// Validate checks the field values on SetProjectMemberRoleRequest with the
+// rules defined in the proto definition for this message. If any rules are
+// violated, the first error encountered is returned, or nil if there are no violations.
+func (m *SetProjectMemberRoleRequest) Validate() error {
+	return m.validate(false)
+}
+
+// ValidateAll checks the field values on SetProjectMemberRoleRequest with the
+// rules defined in the proto definition for this message. If any rules are
+// violated, the result is a list of violation errors wrapped in
+// SetProjectMemberRoleRequestMultiError, or nil if none found.
+func (m *SetProjectMemberRoleRequest) ValidateAll() error {
+	return m.validate(true)
+}
+
+func (m *SetProjectMemberRoleRequest) validate(all bool) error {
+	if m == nil {
+		return nil
+	}
+
+	var errors []error
+
+	if utf8.RuneCountInString(m.GetOrganization()) < 1 {
+		err := SetProjectMemberRoleRequestValidationError{
+			field:  "Organization",
+			reason: "value length must be at least 1 runes",
+		}
+		if !all {
+			return err
+		}
+		errors = append(errors, err)
+	}
+
+	if utf8.RuneCountInString(m.GetProject()) < 1 {
+		err := SetProjectMemberRoleRequestValidationError{
+			field:  "Project",
+			reason: "value length must be at least 1 runes",
+		}
+		if !all {
+			return err
+		}
+		errors = append(errors, err)
+	}
+
+	if err := m._validateEmail(m.GetEmail()); err != nil {
+		err = SetProjectMemberRoleRequestValidationError{
+			field:  "Email",
+			reason: "value must be a valid email address",
+			cause:  err,
+		}
+		if !all {
+			return err
+		}
+		errors = append(errors, err)
+	}
+
+	if utf8.RuneCountInString(m.GetRole()) < 1 {
+		err := SetProjectMemberRoleRequestValidationError{
+			field:  "Role",
+			reason: "value length must be at least 1 runes",
+		}
+		if !all {
+			return err
+		}
+		errors = append(errors, err)
+	}
+
+	if len(errors) > 0 {
+		return SetProjectMemberRoleRequestMultiError(errors)
+	}
+
+	return nil
+}
+
+func (m *SetProjectMemberRoleRequest) _validateHostname(host string) error {
+	s := strings.ToLower(strings.TrimSuffix(host, "."))
+
+	if len(host) > 253 {
+		return errors.New("hostname cannot exceed 253 characters")
+	}
+
+	for _, part := range strings.Split(s, ".") {
+		if l := len(part); l == 0 || l > 63 {
+			return errors.New("hostname part must be non-empty and cannot exceed 63 characters")
+		}
+
+		if part[0] == '-' {
+			return errors.New("hostname parts cannot begin with hyphens")
+		}
+
+		if part[len(part)-1] == '-' {
+			return errors.New("hostname parts cannot end with hyphens")
+		}
+
+		for _, r := range part {
+			if (r < 'a' || r > 'z') && (r < '0' || r > '9') && r != '-' {
+				return fmt.Errorf("hostname parts can only contain alphanumeric characters or hyphens, got %q", string(r))
+			}
+		}
+	}
+
+	return nil
+}
+
+func (m *SetProjectMemberRoleRequest) _validateEmail(addr string) error {
+	a, err := mail.ParseAddress(addr)
+	if err != nil {
+		return err
+	}
+	addr = a.Address
+
+	if len(addr) > 254 {
+		return errors.New("email addresses cannot exceed 254 characters")
+	}
+
+	parts := strings.SplitN(addr, "@", 2)
+
+	if len(parts[0]) > 64 {
+		return errors.New("email address local phrase cannot exceed 64 characters")
+	}
+
+	return m._validateHostname(parts[1])
+}
+
+// SetProjectMemberRoleRequestMultiError is an error wrapping multiple
+// validation errors returned by SetProjectMemberRoleRequest.ValidateAll() if
+// the designated constraints aren't met.
+type SetProjectMemberRoleRequestMultiError []error
+
+// Error returns a concatenation of all the error messages it wraps.
+func (m SetProjectMemberRoleRequestMultiError) Error() string {
+	var msgs []string
+	for _, err := range m {
+		msgs = append(msgs, err.Error())
+	}
+	return strings.Join(msgs, "; ")
+}
+
+// AllErrors returns a list of validation violation errors.
+func (m SetProjectMemberRoleRequestMultiError) AllErrors() []error { return m }
+
+// SetProjectMemberRoleRequestValidationError is the validation error returned
+// by SetProjectMemberRoleRequest.Validate if the designated constraints
+// aren't met.
+type SetProjectMemberRoleRequestValidationError struct {
+	field  string
+	reason string
+	cause  error
+	key    bool
+}
+
+// Field function returns field value.
+func (e SetProjectMemberRoleRequestValidationError) Field() string { return e.field }
+
+// Reason function returns reason value.
+func (e SetProjectMemberRoleRequestValidationError) Reason() string { return e.reason }
+
+// Cause function returns cause value.
+func (e SetProjectMemberRoleRequestValidationError) Cause() error { return e.cause }
+
+// Key function returns key value.
+func (e SetProjectMemberRoleRequestValidationError) Key() bool { return e.key }
+
+// ErrorName returns error name.
+func (e SetProjectMemberRoleRequestValidationError) ErrorName() string {
+	return "SetProjectMemberRoleRequestValidationError"
+}
+
+// Error satisfies the builtin error interface
+func (e SetProjectMemberRoleRequestValidationError) Error() string {
+	cause := ""
+	if e.cause != nil {
+		cause = fmt.Sprintf(" | caused by: %v", e.cause)
+	}
+
+	key := ""
+	if e.key {
+		key = "key for "
+	}
+
+	return fmt.Sprintf(
+		"invalid %sSetProjectMemberRoleRequest.%s: %s%s",
+		key,
+		e.field,
+		e.reason,
+		cause)
+}
+
+var _ error = SetProjectMemberRoleRequestValidationError{}
+
+var _ interface {
+	Field() string
+	Reason() string
+	Key() bool
+	Cause() error
+	ErrorName() string
+} = SetProjectMemberRoleRequestValidationError{}
+
+// Validate checks the field values on SetProjectMemberRoleResponse with the
+// rules defined in the proto definition for this message. If any rules are
+// violated, the first error encountered is returned, or nil if there are no violations.
+func (m *SetProjectMemberRoleResponse) Validate() error {
+	return m.validate(false)
+}
+
+// ValidateAll checks the field values on SetProjectMemberRoleResponse with the
+// rules defined in the proto definition for this message. If any rules are
+// violated, the result is a list of violation errors wrapped in
+// SetProjectMemberRoleResponseMultiError, or nil if none found.
+func (m *SetProjectMemberRoleResponse) ValidateAll() error {
+	return m.validate(true)
+}
+
+func (m *SetProjectMemberRoleResponse) validate(all bool) error {
+	if m == nil {
+		return nil
+	}
+
+	var errors []error
+
+	if len(errors) > 0 {
+		return SetProjectMemberRoleResponseMultiError(errors)
+	}
+
+	return nil
+}
+
+// SetProjectMemberRoleResponseMultiError is an error wrapping multiple
+// validation errors returned by SetProjectMemberRoleResponse.ValidateAll() if
+// the designated constraints aren't met.
+type SetProjectMemberRoleResponseMultiError []error
+
+// Error returns a concatenation of all the error messages it wraps.
+func (m SetProjectMemberRoleResponseMultiError) Error() string {
+	var msgs []string
+	for _, err := range m {
+		msgs = append(msgs, err.Error())
+	}
+	return strings.Join(msgs, "; ")
+}
+
+// AllErrors returns a list of validation violation errors.
+func (m SetProjectMemberRoleResponseMultiError) AllErrors() []error { return m }
+
+// SetProjectMemberRoleResponseValidationError is the validation error returned
+// by SetProjectMemberRoleResponse.Validate if the designated constraints
+// aren't met.
+type SetProjectMemberRoleResponseValidationError struct {
+	field  string
+	reason string
+	cause  error
+	key    bool
+}
+
+// Field function returns field value.
+func (e SetProjectMemberRoleResponseValidationError) Field() string { return e.field }
+
+// Reason function returns reason value.
+func (e SetProjectMemberRoleResponseValidationError) Reason() string { return e.reason }
+
+// Cause function returns cause value.
+func (e SetProjectMemberRoleResponseValidationError) Cause() error { return e.cause }
+
+// Key function returns key value.
+func (e SetProjectMemberRoleResponseValidationError) Key() bool { return e.key }
+
+// ErrorName returns error name.
+func (e SetProjectMemberRoleResponseValidationError) ErrorName() string {
+	return "SetProjectMemberRoleResponseValidationError"
+}
+
+// Error satisfies the builtin error interface
+func (e SetProjectMemberRoleResponseValidationError) Error() string {
+	cause := ""
+	if e.cause != nil {
+		cause = fmt.Sprintf(" | caused by: %v", e.cause)
+	}
+
+	key := ""
+	if e.key {
+		key = "key for "
+	}
+
+	return fmt.Sprintf(
+		"invalid %sSetProjectMemberRoleResponse.%s: %s%s",
+		key,
+		e.field,
+		e.reason,
+		cause)
+}
+
+var _ error = SetProjectMemberRoleResponseValidationError{}
+
+var _ interface {
+	Field() string
+	Reason() string
+	Key() bool
+	Cause() error
+	ErrorName() string
+} = SetProjectMemberRoleResponseValidationError{}
+
+// Validate checks the field values on GetCurrentUserRequest with the rules
+// defined in the proto definition for this message. If any rules are
+// violated, the first error encountered is returned, or nil if there are no violations.
+func (m *GetCurrentUserRequest) Validate() error {
+	return m.validate(false)
+}
+
+// ValidateAll checks the field values on GetCurrentUserRequest with the rules
+// defined in the proto definition for this message. If any rules are
+// violated, the result is a list of violation errors wrapped in
+// GetCurrentUserRequestMultiError, or nil if none found.
+func (m *GetCurrentUserRequest) ValidateAll() error {
+	return m.validate(true)
+}
+
+func (m *GetCurrentUserRequest) validate(all bool) error {
+	if m == nil {
+		return nil
+	}
+
+	var errors []error
+
+	if len(errors) > 0 {
+		return GetCurrentUserRequestMultiError(errors)
+	}
+
+	return nil
+}
+
+// GetCurrentUserRequestMultiError is an error wrapping multiple validation
+// errors returned by GetCurrentUserRequest.ValidateAll() if the designated
+// constraints aren't met.
+type GetCurrentUserRequestMultiError []error
+
+// Error returns a concatenation of all the error messages it wraps.
+func (m GetCurrentUserRequestMultiError) Error() string {
+	var msgs []string
+	for _, err := range m {
+		msgs = append(msgs, err.Error())
+	}
+	return strings.Join(msgs, "; ")
+}
+
+// AllErrors returns a list of validation violation errors.
+func (m GetCurrentUserRequestMultiError) AllErrors() []error { return m }
+
+// GetCurrentUserRequestValidationError is the validation error returned by
+// GetCurrentUserRequest.Validate if the designated constraints aren't met.
+type GetCurrentUserRequestValidationError struct {
+	field  string
+	reason string
+	cause  error
+	key    bool
+}
+
+// Field function returns field value.
+func (e GetCurrentUserRequestValidationError) Field() string { return e.field }
+
+// Reason function returns reason value.
+func (e GetCurrentUserRequestValidationError) Reason() string { return e.reason }
+
+// Cause function returns cause value.
+func (e GetCurrentUserRequestValidationError) Cause() error { return e.cause }
+
+// Key function returns key value.
+func (e GetCurrentUserRequestValidationError) Key() bool { return e.key }
+
+// ErrorName returns error name.
+func (e GetCurrentUserRequestValidationError) ErrorName() string {
+	return "GetCurrentUserRequestValidationError"
+}
+
+// Error satisfies the builtin error interface
+func (e GetCurrentUserRequestValidationError) Error() string {
+	cause := ""
+	if e.cause != nil {
+		cause = fmt.Sprintf(" | caused by: %v", e.cause)
+	}
+
+	key := ""
+	if e.key {
+		key = "key for "
+	}
+
+	return fmt.Sprintf(
+		"invalid %sGetCurrentUserRequest.%s: %s%s",
+		key,
+		e.field,
+		e.reason,
+		cause)
+}
+
+var _ error = GetCurrentUserRequestValidationError{}
+
+var _ interface {
+	Field() string
+	Reason() string
+	Key() bool
+	Cause() error
+	ErrorName() string
+} = GetCurrentUserRequestValidationError{}
+
+// Validate checks the field values on GetCurrentUserResponse with the rules
+// defined in the proto definition for this message. If any rules are
+// violated, the first error encountered is returned, or nil if there are no violations.
+func (m *GetCurrentUserResponse) Validate() error {
+	return m.validate(false)
+}
+
+// ValidateAll checks the field values on GetCurrentUserResponse with the rules
+// defined in the proto definition for this message. If any rules are
+// violated, the result is a list of violation errors wrapped in
+// GetCurrentUserResponseMultiError, or nil if none found.
+func (m *GetCurrentUserResponse) ValidateAll() error {
+	return m.validate(true)
+}
+
+func (m *GetCurrentUserResponse) validate(all bool) error {
+	if m == nil {
+		return nil
+	}
+
+	var errors []error
+
+	if all {
+		switch v := interface{}(m.GetUser()).(type) {
+		case interface{ ValidateAll() error }:
+			if err := v.ValidateAll(); err != nil {
+				errors = append(errors, GetCurrentUserResponseValidationError{
+					field:  "User",
+					reason: "embedded message failed validation",
+					cause:  err,
+				})
+			}
+		case interface{ Validate() error }:
+			if err := v.Validate(); err != nil {
+				errors = append(errors, GetCurrentUserResponseValidationError{
+					field:  "User",
+					reason: "embedded message failed validation",
+					cause:  err,
+				})
+			}
+		}
+	} else if v, ok := interface{}(m.GetUser()).(interface{ Validate() error }); ok {
+		if err := v.Validate(); err != nil {
+			return GetCurrentUserResponseValidationError{
+				field:  "User",
+				reason: "embedded message failed validation",
+				cause:  err,
+			}
+		}
+	}
+
+	if all {
+		switch v := interface{}(m.GetPreferences()).(type) {
+		case interface{ ValidateAll() error }:
+			if err := v.ValidateAll(); err != nil {
+				errors = append(errors, GetCurrentUserResponseValidationError{
+					field:  "Preferences",
+					reason: "embedded message failed validation",
+					cause:  err,
+				})
+			}
+		case interface{ Validate() error }:
+			if err := v.Validate(); err != nil {
+				errors = append(errors, GetCurrentUserResponseValidationError{
+					field:  "Preferences",
+					reason: "embedded message failed validation",
+					cause:  err,
+				})
+			}
+		}
+	} else if v, ok := interface{}(m.GetPreferences()).(interface{ Validate() error }); ok {
+		if err := v.Validate(); err != nil {
+			return GetCurrentUserResponseValidationError{
+				field:  "Preferences",
+				reason: "embedded message failed validation",
+				cause:  err,
+			}
+		}
+	}
+
+	if len(errors) > 0 {
+		return GetCurrentUserResponseMultiError(errors)
+	}
+
+	return nil
+}
+
+// GetCurrentUserResponseMultiError is an error wrapping multiple validation
+// errors returned by GetCurrentUserResponse.ValidateAll() if the designated
+// constraints aren't met.
+type GetCurrentUserResponseMultiError []error
+
+// Error returns a concatenation of all the error messages it wraps.
+func (m GetCurrentUserResponseMultiError) Error() string {
+	var msgs []string
+	for _, err := range m {
+		msgs = append(msgs, err.Error())
+	}
+	return strings.Join(msgs, "; ")
+}
+
+// AllErrors returns a list of validation violation errors.
+func (m GetCurrentUserResponseMultiError) AllErrors() []error { return m }
+
+// GetCurrentUserResponseValidationError is the validation error returned by
+// GetCurrentUserResponse.Validate if the designated constraints aren't met.
+type GetCurrentUserResponseValidationError struct {
+	field  string
+	reason string
+	cause  error
+	key    bool
+}
+
+// Field function returns field value.
+func (e GetCurrentUserResponseValidationError) Field() string { return e.field }
+
+// Reason function returns reason value.
+func (e GetCurrentUserResponseValidationError) Reason() string { return e.reason }
+
+// Cause function returns cause value.
+func (e GetCurrentUserResponseValidationError) Cause() error { return e.cause }
+
+// Key function returns key value.
+func (e GetCurrentUserResponseValidationError) Key() bool { return e.key }
+
+// ErrorName returns error name.
+func (e GetCurrentUserResponseValidationError) ErrorName() string {
+	return "GetCurrentUserResponseValidationError"
+}
+
+// Error satisfies the builtin error interface
+func (e GetCurrentUserResponseValidationError) Error() string {
+	cause := ""
+	if e.cause != nil {
+		cause = fmt.Sprintf(" | caused by: %v", e.cause)
+	}
+
+	key := ""
+	if e.key {
+		key = "key for "
+	}
+
+	return fmt.Sprintf(
+		"invalid %sGetCurrentUserResponse.%s: %s%s",
+		key,
+		e.field,
+		e.reason,
+		cause)
+}
+
+var _ error = GetCurrentUserResponseValidationError{}
+
+var _ interface {
+	Field() string
+	Reason() string
+	Key() bool
+	Cause() error
+	ErrorName() string
+} = GetCurrentUserResponseValidationError{}
+
+// Validate checks the field values on GetUserRequest with the rules defined in
+// the proto definition for this message. If any rules are violated, the first
+// error encountered is returned, or nil if there are no violations.
+func (m *GetUserRequest) Validate() error {
+	return m.validate(false)
+}
+
+// ValidateAll checks the field values on GetUserRequest with the rules defined
+// in the proto definition for this message. If any rules are violated, the
+// result is a list of violation errors wrapped in GetUserRequestMultiError,
+// or nil if none found.
+func (m *GetUserRequest) ValidateAll() error {
+	return m.validate(true)
+}
+
+func (m *GetUserRequest) validate(all bool) error {
+	if m == nil {
+		return nil
+	}
+
+	var errors []error
+
+	if err := m._validateEmail(m.GetEmail()); err != nil {
+		err = GetUserRequestValidationError{
+			field:  "Email",
+			reason: "value must be a valid email address",
+			cause:  err,
+		}
+		if !all {
+			return err
+		}
+		errors = append(errors, err)
+	}
+
+	if len(errors) > 0 {
+		return GetUserRequestMultiError(errors)
+	}
+
+	return nil
+}
+
+func (m *GetUserRequest) _validateHostname(host string) error {
+	s := strings.ToLower(strings.TrimSuffix(host, "."))
+
+	if len(host) > 253 {
+		return errors.New("hostname cannot exceed 253 characters")
+	}
+
+	for _, part := range strings.Split(s, ".") {
+		if l := len(part); l == 0 || l > 63 {
+			return errors.New("hostname part must be non-empty and cannot exceed 63 characters")
+		}
+
+		if part[0] == '-' {
+			return errors.New("hostname parts cannot begin with hyphens")
+		}
+
+		if part[len(part)-1] == '-' {
+			return errors.New("hostname parts cannot end with hyphens")
+		}
+
+		for _, r := range part {
+			if (r < 'a' || r > 'z') && (r < '0' || r > '9') && r != '-' {
+				return fmt.Errorf("hostname parts can only contain alphanumeric characters or hyphens, got %q", string(r))
+			}
+		}
+	}
+
+	return nil
+}
+
+func (m *GetUserRequest) _validateEmail(addr string) error {
+	a, err := mail.ParseAddress(addr)
+	if err != nil {
+		return err
+	}
+	addr = a.Address
+
+	if len(addr) > 254 {
+		return errors.New("email addresses cannot exceed 254 characters")
+	}
+
+	parts := strings.SplitN(addr, "@", 2)
+
+	if len(parts[0]) > 64 {
+		return errors.New("email address local phrase cannot exceed 64 characters")
+	}
+
+	return m._validateHostname(parts[1])
+}
+
+// GetUserRequestMultiError is an error wrapping multiple validation errors
+// returned by GetUserRequest.ValidateAll() if the designated constraints
+// aren't met.
+type GetUserRequestMultiError []error
+
+// Error returns a concatenation of all the error messages it wraps.
+func (m GetUserRequestMultiError) Error() string {
+	var msgs []string
+	for _, err := range m {
+		msgs = append(msgs, err.Error())
+	}
+	return strings.Join(msgs, "; ")
+}
+
+// AllErrors returns a list of validation violation errors.
+func (m GetUserRequestMultiError) AllErrors() []error { return m }
+
+// GetUserRequestValidationError is the validation error returned by
+// GetUserRequest.Validate if the designated constraints aren't met.
+type GetUserRequestValidationError struct {
+	field  string
+	reason string
+	cause  error
+	key    bool
+}
+
+// Field function returns field value.
+func (e GetUserRequestValidationError) Field() string { return e.field }
+
+// Reason function returns reason value.
+func (e GetUserRequestValidationError) Reason() string { return e.reason }
+
+// Cause function returns cause value.
+func (e GetUserRequestValidationError) Cause() error { return e.cause }
+
+// Key function returns key value.
+func (e GetUserRequestValidationError) Key() bool { return e.key }
+
+// ErrorName returns error name.
+func (e GetUserRequestValidationError) ErrorName() string { return "GetUserRequestValidationError" }
+
+// Error satisfies the builtin error interface
+func (e GetUserRequestValidationError) Error() string {
+	cause := ""
+	if e.cause != nil {
+		cause = fmt.Sprintf(" | caused by: %v", e.cause)
+	}
+
+	key := ""
+	if e.key {
+		key = "key for "
+	}
+
+	return fmt.Sprintf(
+		"invalid %sGetUserRequest.%s: %s%s",
+		key,
+		e.field,
+		e.reason,
+		cause)
+}
+
+var _ error = GetUserRequestValidationError{}
+
+var _ interface {
+	Field() string
+	Reason() string
+	Key() bool
+	Cause() error
+	ErrorName() string
+} = GetUserRequestValidationError{}
+
+// Validate checks the field values on GetUserResponse with the rules defined
+// in the proto definition for this message. If any rules are violated, the
+// first error encountered is returned, or nil if there are no violations.
+func (m *GetUserResponse) Validate() error {
+	return m.validate(false)
+}
+
+// ValidateAll checks the field values on GetUserResponse with the rules
+// defined in the proto definition for this message. If any rules are
+// violated, the result is a list of violation errors wrapped in
+// GetUserResponseMultiError, or nil if none found.
+func (m *GetUserResponse) ValidateAll() error {
+	return m.validate(true)
+}
+
+func (m *GetUserResponse) validate(all bool) error {
+	if m == nil {
+		return nil
+	}
+
+	var errors []error
+
+	if all {
+		switch v := interface{}(m.GetUser()).(type) {
+		case interface{ ValidateAll() error }:
+			if err := v.ValidateAll(); err != nil {
+				errors = append(errors, GetUserResponseValidationError{
+					field:  "User",
+					reason: "embedded message failed validation",
+					cause:  err,
+				})
+			}
+		case interface{ Validate() error }:
+			if err := v.Validate(); err != nil {
+				errors = append(errors, GetUserResponseValidationError{
+					field:  "User",
+					reason: "embedded message failed validation",
+					cause:  err,
+				})
+			}
+		}
+	} else if v, ok := interface{}(m.GetUser()).(interface{ Validate() error }); ok {
+		if err := v.Validate(); err != nil {
+			return GetUserResponseValidationError{
+				field:  "User",
+				reason: "embedded message failed validation",
+				cause:  err,
+			}
+		}
+	}
+
+	if len(errors) > 0 {
+		return GetUserResponseMultiError(errors)
+	}
+
+	return nil
+}
+
+// GetUserResponseMultiError is an error wrapping multiple validation errors
+// returned by GetUserResponse.ValidateAll() if the designated constraints
+// aren't met.
+type GetUserResponseMultiError []error
+
+// Error returns a concatenation of all the error messages it wraps.
+func (m GetUserResponseMultiError) Error() string {
+	var msgs []string
+	for _, err := range m {
+		msgs = append(msgs, err.Error())
+	}
+	return strings.Join(msgs, "; ")
+}
+
+// AllErrors returns a list of validation violation errors.
+func (m GetUserResponseMultiError) AllErrors() []error { return m }
+
+// GetUserResponseValidationError is the validation error returned by
+// GetUserResponse.Validate if the designated constraints aren't met.
+type GetUserResponseValidationError struct {
+	field  string
+	reason string
+	cause  error
+	key    bool
+}
+
+// Field function returns field value.
+func (e GetUserResponseValidationError) Field() string { return e.field }
+
+// Reason function returns reason value.
+func (e GetUserResponseValidationError) Reason() string { return e.reason }
+
+// Cause function returns cause value.
+func (e GetUserResponseValidationError) Cause() error { return e.cause }
+
+// Key function returns key value.
+func (e GetUserResponseValidationError) Key() bool { return e.key }
+
+// ErrorName returns error name.
+func (e GetUserResponseValidationError) ErrorName() string { return "GetUserResponseValidationError" }
+
+// Error satisfies the builtin error interface
+func (e GetUserResponseValidationError) Error() string {
+	cause := ""
+	if e.cause != nil {
+		cause = fmt.Sprintf(" | caused by: %v", e.cause)
+	}
+
+	key := ""
+	if e.key {
+		key = "key for "
+	}
+
+	return fmt.Sprintf(
+		"invalid %sGetUserResponse.%s: %s%s",
+		key,
+		e.field,
+		e.reason,
+		cause)
+}
+
+var _ error = GetUserResponseValidationError{}
+
+var _ interface {
+	Field() string
+	Reason() string
+	Key() bool
+	Cause() error
+	ErrorName() string
+} = GetUserResponseValidationError{}
+
+// Validate checks the field values on UserPreferences with the rules defined
+// in the proto definition for this message. If any rules are violated, the
+// first error encountered is returned, or nil if there are no violations.
+func (m *UserPreferences) Validate() error {
+	return m.validate(false)
+}
+
+// ValidateAll checks the field values on UserPreferences with the rules
+// defined in the proto definition for this message. If any rules are
+// violated, the result is a list of violation errors wrapped in
+// UserPreferencesMultiError, or nil if none found.
+func (m *UserPreferences) ValidateAll() error {
+	return m.validate(true)
+}
+
+func (m *UserPreferences) validate(all bool) error {
+	if m == nil {
+		return nil
+	}
+
+	var errors []error
+
+	if m.TimeZone != nil {
+		// no validation rules for TimeZone
+	}
+
+	if len(errors) > 0 {
+		return UserPreferencesMultiError(errors)
+	}
+
+	return nil
+}
+
+// UserPreferencesMultiError is an error wrapping multiple validation errors
+// returned by UserPreferences.ValidateAll() if the designated constraints
+// aren't met.
+type UserPreferencesMultiError []error
+
+// Error returns a concatenation of all the error messages it wraps.
+func (m UserPreferencesMultiError) Error() string {
+	var msgs []string
+	for _, err := range m {
+		msgs = append(msgs, err.Error())
+	}
+	return strings.Join(msgs, "; ")
+}
+
+// AllErrors returns a list of validation violation errors.
+func (m UserPreferencesMultiError) AllErrors() []error { return m }
+
+// UserPreferencesValidationError is the validation error returned by
+// UserPreferences.Validate if the designated constraints aren't met.
+type UserPreferencesValidationError struct {
+	field  string
+	reason string
+	cause  error
+	key    bool
+}
+
+// Field function returns field value.
+func (e UserPreferencesValidationError) Field() string { return e.field }
+
+// Reason function returns reason value.
+func (e UserPreferencesValidationError) Reason() string { return e.reason }
+
+// Cause function returns cause value.
+func (e UserPreferencesValidationError) Cause() error { return e.cause }
+
+// Key function returns key value.
+func (e UserPreferencesValidationError) Key() bool { return e.key }
+
+// ErrorName returns error name.
+func (e UserPreferencesValidationError) ErrorName() string { return "UserPreferencesValidationError" }
+
+// Error satisfies the builtin error interface
+func (e UserPreferencesValidationError) Error() string {
+	cause := ""
+	if e.cause != nil {
+		cause = fmt.Sprintf(" | caused by: %v", e.cause)
+	}
+
+	key := ""
+	if e.key {
+		key = "key for "
+	}
+
+	return fmt.Sprintf(
+		"invalid %sUserPreferences.%s: %s%s",
+		key,
+		e.field,
+		e.reason,
+		cause)
+}
+
+var _ error = UserPreferencesValidationError{}
+
+var _ interface {
+	Field() string
+	Reason() string
+	Key() bool
+	Cause() error
+	ErrorName() string
+} = UserPreferencesValidationError{}
+
+// Validate checks the field values on UpdateUserPreferencesRequest with the
+// rules defined in the proto definition for this message. If any rules are
+// violated, the first error encountered is returned, or nil if there are no violations.
+func (m *UpdateUserPreferencesRequest) Validate() error {
+	return m.validate(false)
+}
+
+// ValidateAll checks the field values on UpdateUserPreferencesRequest with the
+// rules defined in the proto definition for this message. If any rules are
+// violated, the result is a list of violation errors wrapped in
+// UpdateUserPreferencesRequestMultiError, or nil if none found.
+func (m *UpdateUserPreferencesRequest) ValidateAll() error {
+	return m.validate(true)
+}
+
+func (m *UpdateUserPreferencesRequest) validate(all bool) error {
+	if m == nil {
+		return nil
+	}
+
+	var errors []error
+
+	if all {
+		switch v := interface{}(m.GetPreferences()).(type) {
+		case interface{ ValidateAll() error }:
+			if err := v.ValidateAll(); err != nil {
+				errors = append(errors, UpdateUserPreferencesRequestValidationError{
+					field:  "Preferences",
+					reason: "embedded message failed validation",
+					cause:  err,
+				})
+			}
+		case interface{ Validate() error }:
+			if err := v.Validate(); err != nil {
+				errors = append(errors, UpdateUserPreferencesRequestValidationError{
+					field:  "Preferences",
+					reason: "embedded message failed validation",
+					cause:  err,
+				})
+			}
+		}
+	} else if v, ok := interface{}(m.GetPreferences()).(interface{ Validate() error }); ok {
+		if err := v.Validate(); err != nil {
+			return UpdateUserPreferencesRequestValidationError{
+				field:  "Preferences",
+				reason: "embedded message failed validation",
+				cause:  err,
+			}
+		}
+	}
+
+	if len(errors) > 0 {
+		return UpdateUserPreferencesRequestMultiError(errors)
+	}
+
+	return nil
+}
+
+// UpdateUserPreferencesRequestMultiError is an error wrapping multiple
+// validation errors returned by UpdateUserPreferencesRequest.ValidateAll() if
+// the designated constraints aren't met.
+type UpdateUserPreferencesRequestMultiError []error
+
+// Error returns a concatenation of all the error messages it wraps.
+func (m UpdateUserPreferencesRequestMultiError) Error() string {
+	var msgs []string
+	for _, err := range m {
+		msgs = append(msgs, err.Error())
+	}
+	return strings.Join(msgs, "; ")
+}
+
+// AllErrors returns a list of validation violation errors.
+func (m UpdateUserPreferencesRequestMultiError) AllErrors() []error { return m }
+
+// UpdateUserPreferencesRequestValidationError is the validation error returned
+// by UpdateUserPreferencesRequest.Validate if the designated constraints
+// aren't met.
+type UpdateUserPreferencesRequestValidationError struct {
+	field  string
+	reason string
+	cause  error
+	key    bool
+}
+
+// Field function returns field value.
+func (e UpdateUserPreferencesRequestValidationError) Field() string { return e.field }
+
+// Reason function returns reason value.
+func (e UpdateUserPreferencesRequestValidationError) Reason() string { return e.reason }
+
+// Cause function returns cause value.
+func (e UpdateUserPreferencesRequestValidationError) Cause() error { return e.cause }
+
+// Key function returns key value.
+func (e UpdateUserPreferencesRequestValidationError) Key() bool { return e.key }
+
+// ErrorName returns error name.
+func (e UpdateUserPreferencesRequestValidationError) ErrorName() string {
+	return "UpdateUserPreferencesRequestValidationError"
+}
+
+// Error satisfies the builtin error interface
+func (e UpdateUserPreferencesRequestValidationError) Error() string {
+	cause := ""
+	if e.cause != nil {
+		cause = fmt.Sprintf(" | caused by: %v", e.cause)
+	}
+
+	key := ""
+	if e.key {
+		key = "key for "
+	}
+
+	return fmt.Sprintf(
+		"invalid %sUpdateUserPreferencesRequest.%s: %s%s",
+		key,
+		e.field,
+		e.reason,
+		cause)
+}
+
+var _ error = UpdateUserPreferencesRequestValidationError{}
+
+var _ interface {
+	Field() string
+	Reason() string
+	Key() bool
+	Cause() error
+	ErrorName() string
+} = UpdateUserPreferencesRequestValidationError{}
+
+// Validate checks the field values on UpdateUserPreferencesResponse with the
+// rules defined in the proto definition for this message. If any rules are
+// violated, the first error encountered is returned, or nil if there are no violations.
+func (m *UpdateUserPreferencesResponse) Validate() error {
+	return m.validate(false)
+}
+
+// ValidateAll checks the field values on UpdateUserPreferencesResponse with
+// the rules defined in the proto definition for this message. If any rules
+// are violated, the result is a list of violation errors wrapped in
+// UpdateUserPreferencesResponseMultiError, or nil if none found.
+func (m *UpdateUserPreferencesResponse) ValidateAll() error {
+	return m.validate(true)
+}
+
+func (m *UpdateUserPreferencesResponse) validate(all bool) error {
+	if m == nil {
+		return nil
+	}
+
+	var errors []error
+
+	if all {
+		switch v := interface{}(m.GetPreferences()).(type) {
+		case interface{ ValidateAll() error }:
+			if err := v.ValidateAll(); err != nil {
+				errors = append(errors, UpdateUserPreferencesResponseValidationError{
+					field:  "Preferences",
+					reason: "embedded message failed validation",
+					cause:  err,
+				})
+			}
+		case interface{ Validate() error }:
+			if err := v.Validate(); err != nil {
+				errors = append(errors, UpdateUserPreferencesResponseValidationError{
+					field:  "Preferences",
+					reason: "embedded message failed validation",
+					cause:  err,
+				})
+			}
+		}
+	} else if v, ok := interface{}(m.GetPreferences()).(interface{ Validate() error }); ok {
+		if err := v.Validate(); err != nil {
+			return UpdateUserPreferencesResponseValidationError{
+				field:  "Preferences",
+				reason: "embedded message failed validation",
+				cause:  err,
+			}
+		}
+	}
+
+	if len(errors) > 0 {
+		return UpdateUserPreferencesResponseMultiError(errors)
+	}
+
+	return nil
+}
+
+// UpdateUserPreferencesResponseMultiError is an error wrapping multiple
+// validation errors returned by UpdateUserPreferencesResponse.ValidateAll()
+// if the designated constraints aren't met.
+type UpdateUserPreferencesResponseMultiError []error
+
+// Error returns a concatenation of all the error messages it wraps.
+func (m UpdateUserPreferencesResponseMultiError) Error() string {
+	var msgs []string
+	for _, err := range m {
+		msgs = append(msgs, err.Error())
+	}
+	return strings.Join(msgs, "; ")
+}
+
+// AllErrors returns a list of validation violation errors.
+func (m UpdateUserPreferencesResponseMultiError) AllErrors() []error { return m }
+
+// UpdateUserPreferencesResponseValidationError is the validation error
+// returned by UpdateUserPreferencesResponse.Validate if the designated
+// constraints aren't met.
+type UpdateUserPreferencesResponseValidationError struct {
+	field  string
+	reason string
+	cause  error
+	key    bool
+}
+
+// Field function returns field value.
+func (e UpdateUserPreferencesResponseValidationError) Field() string { return e.field }
+
+// Reason function returns reason value.
+func (e UpdateUserPreferencesResponseValidationError) Reason() string { return e.reason }
+
+// Cause function returns cause value.
+func (e UpdateUserPreferencesResponseValidationError) Cause() error { return e.cause }
+
+// Key function returns key value.
+func (e UpdateUserPreferencesResponseValidationError) Key() bool { return e.key }
+
+// ErrorName returns error name.
+func (e UpdateUserPreferencesResponseValidationError) ErrorName() string {
+	return "UpdateUserPreferencesResponseValidationError"
+}
+
+// Error satisfies the builtin error interface
+func (e UpdateUserPreferencesResponseValidationError) Error() string {
+	cause := ""
+	if e.cause != nil {
+		cause = fmt.Sprintf(" | caused by: %v", e.cause)
+	}
+
+	key := ""
+	if e.key {
+		key = "key for "
+	}
+
+	return fmt.Sprintf(
+		"invalid %sUpdateUserPreferencesResponse.%s: %s%s",
+		key,
+		e.field,
+		e.reason,
+		cause)
+}
+
+var _ error = UpdateUserPreferencesResponseValidationError{}
+
+var _ interface {
+	Field() string
+	Reason() string
+	Key() bool
+	Cause() error
+	ErrorName() string
+} = UpdateUserPreferencesResponseValidationError{}
+
+// Validate checks the field values on ListBookmarksRequest with the rules
+// defined in the proto definition for this message. If any rules are
+// violated, the first error encountered is returned, or nil if there are no violations.
+func (m *ListBookmarksRequest) Validate() error {
+	return m.validate(false)
+}
+
+// ValidateAll checks the field values on ListBookmarksRequest with the rules
+// defined in the proto definition for this message. If any rules are
+// violated, the result is a list of violation errors wrapped in
+// ListBookmarksRequestMultiError, or nil if none found.
+func (m *ListBookmarksRequest) ValidateAll() error {
+	return m.validate(true)
+}
+
+func (m *ListBookmarksRequest) validate(all bool) error {
+	if m == nil {
+		return nil
+	}
+
+	var errors []error
+
+	// no validation rules for ProjectId
+
+	// no validation rules for DashboardName
+
+	if len(errors) > 0 {
+		return ListBookmarksRequestMultiError(errors)
+	}
+
+	return nil
+}
+
+// ListBookmarksRequestMultiError is an error wrapping multiple validation
+// errors returned by ListBookmarksRequest.ValidateAll() if the designated
+// constraints aren't met.
+type ListBookmarksRequestMultiError []error
+
+// Error returns a concatenation of all the error messages it wraps.
+func (m ListBookmarksRequestMultiError) Error() string {
+	var msgs []string
+	for _, err := range m {
+		msgs = append(msgs, err.Error())
+	}
+	return strings.Join(msgs, "; ")
+}
+
+// AllErrors returns a list of validation violation errors.
+func (m ListBookmarksRequestMultiError) AllErrors() []error { return m }
+
+// ListBookmarksRequestValidationError is the validation error returned by
+// ListBookmarksRequest.Validate if the designated constraints aren't met.
+type ListBookmarksRequestValidationError struct {
+	field  string
+	reason string
+	cause  error
+	key    bool
+}
+
+// Field function returns field value.
+func (e ListBookmarksRequestValidationError) Field() string { return e.field }
+
+// Reason function returns reason value.
+func (e ListBookmarksRequestValidationError) Reason() string { return e.reason }
+
+// Cause function returns cause value.
+func (e ListBookmarksRequestValidationError) Cause() error { return e.cause }
+
+// Key function returns key value.
+func (e ListBookmarksRequestValidationError) Key() bool { return e.key }
+
+// ErrorName returns error name.
+func (e ListBookmarksRequestValidationError) ErrorName() string {
+	return "ListBookmarksRequestValidationError"
+}
+
+// Error satisfies the builtin error interface
+func (e ListBookmarksRequestValidationError) Error() string {
+	cause := ""
+	if e.cause != nil {
+		cause = fmt.Sprintf(" | caused by: %v", e.cause)
+	}
+
+	key := ""
+	if e.key {
+		key = "key for "
+	}
+
+	return fmt.Sprintf(
+		"invalid %sListBookmarksRequest.%s: %s%s",
+		key,
+		e.field,
+		e.reason,
+		cause)
+}
+
+var _ error = ListBookmarksRequestValidationError{}
+
+var _ interface {
+	Field() string
+	Reason() string
+	Key() bool
+	Cause() error
+	ErrorName() string
+} = ListBookmarksRequestValidationError{}
+
+// Validate checks the field values on ListBookmarksResponse with the rules
+// defined in the proto definition for this message. If any rules are
+// violated, the first error encountered is returned, or nil if there are no violations.
+func (m *ListBookmarksResponse) Validate() error {
+	return m.validate(false)
+}
+
+// ValidateAll checks the field values on ListBookmarksResponse with the rules
+// defined in the proto definition for this message. If any rules are
+// violated, the result is a list of violation errors wrapped in
+// ListBookmarksResponseMultiError, or nil if none found.
+func (m *ListBookmarksResponse) ValidateAll() error {
+	return m.validate(true)
+}
+
+func (m *ListBookmarksResponse) validate(all bool) error {
+	if m == nil {
+		return nil
+	}
+
+	var errors []error
+
+	for idx, item := range m.GetBookmarks() {
+		_, _ = idx, item
+
+		if all {
+			switch v := interface{}(item).(type) {
+			case interface{ ValidateAll() error }:
+				if err := v.ValidateAll(); err != nil {
+					errors = append(errors, ListBookmarksResponseValidationError{
+						field:  fmt.Sprintf("Bookmarks[%v]", idx),
+						reason: "embedded message failed validation",
+						cause:  err,
+					})
+				}
+			case interface{ Validate() error }:
+				if err := v.Validate(); err != nil {
+					errors = append(errors, ListBookmarksResponseValidationError{
+						field:  fmt.Sprintf("Bookmarks[%v]", idx),
+						reason: "embedded message failed validation",
+						cause:  err,
+					})
+				}
+			}
+		} else if v, ok := interface{}(item).(interface{ Validate() error }); ok {
+			if err := v.Validate(); err != nil {
+				return ListBookmarksResponseValidationError{
+					field:  fmt.Sprintf("Bookmarks[%v]", idx),
+					reason: "embedded message failed validation",
+					cause:  err,
+				}
+			}
+		}
+
+	}
+
+	if len(errors) > 0 {
+		return ListBookmarksResponseMultiError(errors)
+	}
+
+	return nil
+}
+
+// ListBookmarksResponseMultiError is an error wrapping multiple validation
+// errors returned by ListBookmarksResponse.ValidateAll() if the designated
+// constraints aren't met.
+type ListBookmarksResponseMultiError []error
+
+// Error returns a concatenation of all the error messages it wraps.
+func (m ListBookmarksResponseMultiError) Error() string {
+	var msgs []string
+	for _, err := range m {
+		msgs = append(msgs, err.Error())
+	}
+	return strings.Join(msgs, "; ")
+}
+
+// AllErrors returns a list of validation violation errors.
+func (m ListBookmarksResponseMultiError) AllErrors() []error { return m }
+
+// ListBookmarksResponseValidationError is the validation error returned by
+// ListBookmarksResponse.Validate if the designated constraints aren't met.
+type ListBookmarksResponseValidationError struct {
+	field  string
+	reason string
+	cause  error
+	key    bool
+}
+
+// Field function returns field value.
+func (e ListBookmarksResponseValidationError) Field() string { return e.field }
+
+// Reason function returns reason value.
+func (e ListBookmarksResponseValidationError) Reason() string { return e.reason }
+
+// Cause function returns cause value.
+func (e ListBookmarksResponseValidationError) Cause() error { return e.cause }
+
+// Key function returns key value.
+func (e ListBookmarksResponseValidationError) Key() bool { return e.key }
+
+// ErrorName returns error name.
+func (e ListBookmarksResponseValidationError) ErrorName() string {
+	return "ListBookmarksResponseValidationError"
+}
+
+// Error satisfies the builtin error interface
+func (e ListBookmarksResponseValidationError) Error() string {
+	cause := ""
+	if e.cause != nil {
+		cause = fmt.Sprintf(" | caused by: %v", e.cause)
+	}
+
+	key := ""
+	if e.key {
+		key = "key for "
+	}
+
+	return fmt.Sprintf(
+		"invalid %sListBookmarksResponse.%s: %s%s",
+		key,
+		e.field,
+		e.reason,
+		cause)
+}
+
+var _ error = ListBookmarksResponseValidationError{}
+
+var _ interface {
+	Field() string
+	Reason() string
+	Key() bool
+	Cause() error
+	ErrorName() string
+} = ListBookmarksResponseValidationError{}
+
+// Validate checks the field values on GetBookmarkRequest with the rules
+// defined in the proto definition for this message. If any rules are
+// violated, the first error encountered is returned, or nil if there are no violations.
+func (m *GetBookmarkRequest) Validate() error {
+	return m.validate(false)
+}
+
+// ValidateAll checks the field values on GetBookmarkRequest with the rules
+// defined in the proto definition for this message. If any rules are
+// violated, the result is a list of violation errors wrapped in
+// GetBookmarkRequestMultiError, or nil if none found.
+func (m *GetBookmarkRequest) ValidateAll() error {
+	return m.validate(true)
+}
+
+func (m *GetBookmarkRequest) validate(all bool) error {
+	if m == nil {
+		return nil
+	}
+
+	var errors []error
+
+	// no validation rules for BookmarkId
+
+	if len(errors) > 0 {
+		return GetBookmarkRequestMultiError(errors)
+	}
+
+	return nil
+}
+
+// GetBookmarkRequestMultiError is an error wrapping multiple validation errors
+// returned by GetBookmarkRequest.ValidateAll() if the designated constraints
+// aren't met.
+type GetBookmarkRequestMultiError []error
+
+// Error returns a concatenation of all the error messages it wraps.
+func (m GetBookmarkRequestMultiError) Error() string {
+	var msgs []string
+	for _, err := range m {
+		msgs = append(msgs, err.Error())
+	}
+	return strings.Join(msgs, "; ")
+}
+
+// AllErrors returns a list of validation violation errors.
+func (m GetBookmarkRequestMultiError) AllErrors() []error { return m }
+
+// GetBookmarkRequestValidationError is the validation error returned by
+// GetBookmarkRequest.Validate if the designated constraints aren't met.
+type GetBookmarkRequestValidationError struct {
+	field  string
+	reason string
+	cause  error
+	key    bool
+}
+
+// Field function returns field value.
+func (e GetBookmarkRequestValidationError) Field() string { return e.field }
+
+// Reason function returns reason value.
+func (e GetBookmarkRequestValidationError) Reason() string { return e.reason }
+
+// Cause function returns cause value.
+func (e GetBookmarkRequestValidationError) Cause() error { return e.cause }
+
+// Key function returns key value.
+func (e GetBookmarkRequestValidationError) Key() bool { return e.key }
+
+// ErrorName returns error name.
+func (e GetBookmarkRequestValidationError) ErrorName() string {
+	return "GetBookmarkRequestValidationError"
+}
+
+// Error satisfies the builtin error interface
+func (e GetBookmarkRequestValidationError) Error() string {
+	cause := ""
+	if e.cause != nil {
+		cause = fmt.Sprintf(" | caused by: %v", e.cause)
+	}
+
+	key := ""
+	if e.key {
+		key = "key for "
+	}
+
+	return fmt.Sprintf(
+		"invalid %sGetBookmarkRequest.%s: %s%s",
+		key,
+		e.field,
+		e.reason,
+		cause)
+}
+
+var _ error = GetBookmarkRequestValidationError{}
+
+var _ interface {
+	Field() string
+	Reason() string
+	Key() bool
+	Cause() error
+	ErrorName() string
+} = GetBookmarkRequestValidationError{}
+
+// Validate checks the field values on GetBookmarkResponse with the rules
+// defined in the proto definition for this message. If any rules are
+// violated, the first error encountered is returned, or nil if there are no violations.
+func (m *GetBookmarkResponse) Validate() error {
+	return m.validate(false)
+}
+
+// ValidateAll checks the field values on GetBookmarkResponse with the rules
+// defined in the proto definition for this message. If any rules are
+// violated, the result is a list of violation errors wrapped in
+// GetBookmarkResponseMultiError, or nil if none found.
+func (m *GetBookmarkResponse) ValidateAll() error {
+	return m.validate(true)
+}
+
+func (m *GetBookmarkResponse) validate(all bool) error {
+	if m == nil {
+		return nil
+	}
+
+	var errors []error
+
+	if all {
+		switch v := interface{}(m.GetBookmark()).(type) {
+		case interface{ ValidateAll() error }:
+			if err := v.ValidateAll(); err != nil {
+				errors = append(errors, GetBookmarkResponseValidationError{
+					field:  "Bookmark",
+					reason: "embedded message failed validation",
+					cause:  err,
+				})
+			}
+		case interface{ Validate() error }:
+			if err := v.Validate(); err != nil {
+				errors = append(errors, GetBookmarkResponseValidationError{
+					field:  "Bookmark",
 					reason: "embedded message failed validation",
 					cause:  err,
-				}
+				})
+			}
+		}
+	} else if v, ok := interface{}(m.GetBookmark()).(interface{ Validate() error }); ok {
+		if err := v.Validate(); err != nil {
+			return GetBookmarkResponseValidationError{
+				field:  "Bookmark",
+				reason: "embedded message failed validation",
+				cause:  err,
+			}
+		}
+	}
+
+	if len(errors) > 0 {
+		return GetBookmarkResponseMultiError(errors)
+	}
+
+	return nil
+}
+
+// GetBookmarkResponseMultiError is an error wrapping multiple validation
+// errors returned by GetBookmarkResponse.ValidateAll() if the designated
+// constraints aren't met.
+type GetBookmarkResponseMultiError []error
+
+// Error returns a concatenation of all the error messages it wraps.
+func (m GetBookmarkResponseMultiError) Error() string {
+	var msgs []string
+	for _, err := range m {
+		msgs = append(msgs, err.Error())
+	}
+	return strings.Join(msgs, "; ")
+}
+
+// AllErrors returns a list of validation violation errors.
+func (m GetBookmarkResponseMultiError) AllErrors() []error { return m }
+
+// GetBookmarkResponseValidationError is the validation error returned by
+// GetBookmarkResponse.Validate if the designated constraints aren't met.
+type GetBookmarkResponseValidationError struct {
+	field  string
+	reason string
+	cause  error
+	key    bool
+}
+
+// Field function returns field value.
+func (e GetBookmarkResponseValidationError) Field() string { return e.field }
+
+// Reason function returns reason value.
+func (e GetBookmarkResponseValidationError) Reason() string { return e.reason }
+
+// Cause function returns cause value.
+func (e GetBookmarkResponseValidationError) Cause() error { return e.cause }
+
+// Key function returns key value.
+func (e GetBookmarkResponseValidationError) Key() bool { return e.key }
+
+// ErrorName returns error name.
+func (e GetBookmarkResponseValidationError) ErrorName() string {
+	return "GetBookmarkResponseValidationError"
+}
+
+// Error satisfies the builtin error interface
+func (e GetBookmarkResponseValidationError) Error() string {
+	cause := ""
+	if e.cause != nil {
+		cause = fmt.Sprintf(" | caused by: %v", e.cause)
+	}
+
+	key := ""
+	if e.key {
+		key = "key for "
+	}
+
+	return fmt.Sprintf(
+		"invalid %sGetBookmarkResponse.%s: %s%s",
+		key,
+		e.field,
+		e.reason,
+		cause)
+}
+
+var _ error = GetBookmarkResponseValidationError{}
+
+var _ interface {
+	Field() string
+	Reason() string
+	Key() bool
+	Cause() error
+	ErrorName() string
+} = GetBookmarkResponseValidationError{}
+
+// Validate checks the field values on CreateBookmarkRequest with the rules
+// defined in the proto definition for this message. If any rules are
+// violated, the first error encountered is returned, or nil if there are no violations.
+func (m *CreateBookmarkRequest) Validate() error {
+	return m.validate(false)
+}
+
+// ValidateAll checks the field values on CreateBookmarkRequest with the rules
+// defined in the proto definition for this message. If any rules are
+// violated, the result is a list of violation errors wrapped in
+// CreateBookmarkRequestMultiError, or nil if none found.
+func (m *CreateBookmarkRequest) ValidateAll() error {
+	return m.validate(true)
+}
+
+func (m *CreateBookmarkRequest) validate(all bool) error {
+	if m == nil {
+		return nil
+	}
+
+	var errors []error
+
+	// no validation rules for DisplayName
+
+	// no validation rules for Data
+
+	// no validation rules for DashboardName
+
+	// no validation rules for ProjectId
+
+	// no validation rules for Shared
+
+	// no validation rules for IsDefault
+
+	if len(errors) > 0 {
+		return CreateBookmarkRequestMultiError(errors)
+	}
+
+	return nil
+}
+
+// CreateBookmarkRequestMultiError is an error wrapping multiple validation
+// errors returned by CreateBookmarkRequest.ValidateAll() if the designated
+// constraints aren't met.
+type CreateBookmarkRequestMultiError []error
+
+// Error returns a concatenation of all the error messages it wraps.
+func (m CreateBookmarkRequestMultiError) Error() string {
+	var msgs []string
+	for _, err := range m {
+		msgs = append(msgs, err.Error())
+	}
+	return strings.Join(msgs, "; ")
+}
+
+// AllErrors returns a list of validation violation errors.
+func (m CreateBookmarkRequestMultiError) AllErrors() []error { return m }
+
+// CreateBookmarkRequestValidationError is the validation error returned by
+// CreateBookmarkRequest.Validate if the designated constraints aren't met.
+type CreateBookmarkRequestValidationError struct {
+	field  string
+	reason string
+	cause  error
+	key    bool
+}
+
+// Field function returns field value.
+func (e CreateBookmarkRequestValidationError) Field() string { return e.field }
+
+// Reason function returns reason value.
+func (e CreateBookmarkRequestValidationError) Reason() string { return e.reason }
+
+// Cause function returns cause value.
+func (e CreateBookmarkRequestValidationError) Cause() error { return e.cause }
+
+// Key function returns key value.
+func (e CreateBookmarkRequestValidationError) Key() bool { return e.key }
+
+// ErrorName returns error name.
+func (e CreateBookmarkRequestValidationError) ErrorName() string {
+	return "CreateBookmarkRequestValidationError"
+}
+
+// Error satisfies the builtin error interface
+func (e CreateBookmarkRequestValidationError) Error() string {
+	cause := ""
+	if e.cause != nil {
+		cause = fmt.Sprintf(" | caused by: %v", e.cause)
+	}
+
+	key := ""
+	if e.key {
+		key = "key for "
+	}
+
+	return fmt.Sprintf(
+		"invalid %sCreateBookmarkRequest.%s: %s%s",
+		key,
+		e.field,
+		e.reason,
+		cause)
+}
+
+var _ error = CreateBookmarkRequestValidationError{}
+
+var _ interface {
+	Field() string
+	Reason() string
+	Key() bool
+	Cause() error
+	ErrorName() string
+} = CreateBookmarkRequestValidationError{}
+
+// Validate checks the field values on CreateBookmarkResponse with the rules
+// defined in the proto definition for this message. If any rules are
+// violated, the first error encountered is returned, or nil if there are no violations.
+func (m *CreateBookmarkResponse) Validate() error {
+	return m.validate(false)
+}
+
+// ValidateAll checks the field values on CreateBookmarkResponse with the rules
+// defined in the proto definition for this message. If any rules are
+// violated, the result is a list of violation errors wrapped in
+// CreateBookmarkResponseMultiError, or nil if none found.
+func (m *CreateBookmarkResponse) ValidateAll() error {
+	return m.validate(true)
+}
+
+func (m *CreateBookmarkResponse) validate(all bool) error {
+	if m == nil {
+		return nil
+	}
+
+	var errors []error
+
+	if all {
+		switch v := interface{}(m.GetBookmark()).(type) {
+		case interface{ ValidateAll() error }:
+			if err := v.ValidateAll(); err != nil {
+				errors = append(errors, CreateBookmarkResponseValidationError{
+					field:  "Bookmark",
+					reason: "embedded message failed validation",
+					cause:  err,
+				})
+			}
+		case interface{ Validate() error }:
+			if err := v.Validate(); err != nil {
+				errors = append(errors, CreateBookmarkResponseValidationError{
+					field:  "Bookmark",
+					reason: "embedded message failed validation",
+					cause:  err,
+				})
+			}
+		}
+	} else if v, ok := interface{}(m.GetBookmark()).(interface{ Validate() error }); ok {
+		if err := v.Validate(); err != nil {
+			return CreateBookmarkResponseValidationError{
+				field:  "Bookmark",
+				reason: "embedded message failed validation",
+				cause:  err,
 			}
 		}
+	}
+
+	if len(errors) > 0 {
+		return CreateBookmarkResponseMultiError(errors)
+	}
+
+	return nil
+}
+
+// CreateBookmarkResponseMultiError is an error wrapping multiple validation
+// errors returned by CreateBookmarkResponse.ValidateAll() if the designated
+// constraints aren't met.
+type CreateBookmarkResponseMultiError []error
+
+// Error returns a concatenation of all the error messages it wraps.
+func (m CreateBookmarkResponseMultiError) Error() string {
+	var msgs []string
+	for _, err := range m {
+		msgs = append(msgs, err.Error())
+	}
+	return strings.Join(msgs, "; ")
+}
+
+// AllErrors returns a list of validation violation errors.
+func (m CreateBookmarkResponseMultiError) AllErrors() []error { return m }
+
+// CreateBookmarkResponseValidationError is the validation error returned by
+// CreateBookmarkResponse.Validate if the designated constraints aren't met.
+type CreateBookmarkResponseValidationError struct {
+	field  string
+	reason string
+	cause  error
+	key    bool
+}
+
+// Field function returns field value.
+func (e CreateBookmarkResponseValidationError) Field() string { return e.field }
+
+// Reason function returns reason value.
+func (e CreateBookmarkResponseValidationError) Reason() string { return e.reason }
+
+// Cause function returns cause value.
+func (e CreateBookmarkResponseValidationError) Cause() error { return e.cause }
+
+// Key function returns key value.
+func (e CreateBookmarkResponseValidationError) Key() bool { return e.key }
+
+// ErrorName returns error name.
+func (e CreateBookmarkResponseValidationError) ErrorName() string {
+	return "CreateBookmarkResponseValidationError"
+}
+
+// Error satisfies the builtin error interface
+func (e CreateBookmarkResponseValidationError) Error() string {
+	cause := ""
+	if e.cause != nil {
+		cause = fmt.Sprintf(" | caused by: %v", e.cause)
+	}
+
+	key := ""
+	if e.key {
+		key = "key for "
+	}
+
+	return fmt.Sprintf(
+		"invalid %sCreateBookmarkResponse.%s: %s%s",
+		key,
+		e.field,
+		e.reason,
+		cause)
+}
+
+var _ error = CreateBookmarkResponseValidationError{}
+
+var _ interface {
+	Field() string
+	Reason() string
+	Key() bool
+	Cause() error
+	ErrorName() string
+} = CreateBookmarkResponseValidationError{}
+
+// Validate checks the field values on RemoveBookmarkRequest with the rules
+// defined in the proto definition for this message. If any rules are
+// violated, the first error encountered is returned, or nil if there are no violations.
+func (m *RemoveBookmarkRequest) Validate() error {
+	return m.validate(false)
+}
+
+// ValidateAll checks the field values on RemoveBookmarkRequest with the rules
+// defined in the proto definition for this message. If any rules are
+// violated, the result is a list of violation errors wrapped in
+// RemoveBookmarkRequestMultiError, or nil if none found.
+func (m *RemoveBookmarkRequest) ValidateAll() error {
+	return m.validate(true)
+}
+
+func (m *RemoveBookmarkRequest) validate(all bool) error {
+	if m == nil {
+		return nil
+	}
+
+	var errors []error
+
+	// no validation rules for BookmarkId
+
+	if len(errors) > 0 {
+		return RemoveBookmarkRequestMultiError(errors)
+	}
+
+	return nil
+}
+
+// RemoveBookmarkRequestMultiError is an error wrapping multiple validation
+// errors returned by RemoveBookmarkRequest.ValidateAll() if the designated
+// constraints aren't met.
+type RemoveBookmarkRequestMultiError []error
+
+// Error returns a concatenation of all the error messages it wraps.
+func (m RemoveBookmarkRequestMultiError) Error() string {
+	var msgs []string
+	for _, err := range m {
+		msgs = append(msgs, err.Error())
+	}
+	return strings.Join(msgs, "; ")
+}
+
+// AllErrors returns a list of validation violation errors.
+func (m RemoveBookmarkRequestMultiError) AllErrors() []error { return m }
+
+// RemoveBookmarkRequestValidationError is the validation error returned by
+// RemoveBookmarkRequest.Validate if the designated constraints aren't met.
+type RemoveBookmarkRequestValidationError struct {
+	field  string
+	reason string
+	cause  error
+	key    bool
+}
+
+// Field function returns field value.
+func (e RemoveBookmarkRequestValidationError) Field() string { return e.field }
+
+// Reason function returns reason value.
+func (e RemoveBookmarkRequestValidationError) Reason() string { return e.reason }
+
+// Cause function returns cause value.
+func (e RemoveBookmarkRequestValidationError) Cause() error { return e.cause }
+
+// Key function returns key value.
+func (e RemoveBookmarkRequestValidationError) Key() bool { return e.key }
+
+// ErrorName returns error name.
+func (e RemoveBookmarkRequestValidationError) ErrorName() string {
+	return "RemoveBookmarkRequestValidationError"
+}
+
+// Error satisfies the builtin error interface
+func (e RemoveBookmarkRequestValidationError) Error() string {
+	cause := ""
+	if e.cause != nil {
+		cause = fmt.Sprintf(" | caused by: %v", e.cause)
+	}
+
+	key := ""
+	if e.key {
+		key = "key for "
+	}
+
+	return fmt.Sprintf(
+		"invalid %sRemoveBookmarkRequest.%s: %s%s",
+		key,
+		e.field,
+		e.reason,
+		cause)
+}
+
+var _ error = RemoveBookmarkRequestValidationError{}
+
+var _ interface {
+	Field() string
+	Reason() string
+	Key() bool
+	Cause() error
+	ErrorName() string
+} = RemoveBookmarkRequestValidationError{}
+
+// Validate checks the field values on RemoveBookmarkResponse with the rules
+// defined in the proto definition for this message. If any rules are
+// violated, the first error encountered is returned, or nil if there are no violations.
+func (m *RemoveBookmarkResponse) Validate() error {
+	return m.validate(false)
+}
+
+// ValidateAll checks the field values on RemoveBookmarkResponse with the rules
+// defined in the proto definition for this message. If any rules are
+// violated, the result is a list of violation errors wrapped in
+// RemoveBookmarkResponseMultiError, or nil if none found.
+func (m *RemoveBookmarkResponse) ValidateAll() error {
+	return m.validate(true)
+}
+
+func (m *RemoveBookmarkResponse) validate(all bool) error {
+	if m == nil {
+		return nil
+	}
+
+	var errors []error
+
+	if len(errors) > 0 {
+		return RemoveBookmarkResponseMultiError(errors)
+	}
+
+	return nil
+}
+
+// RemoveBookmarkResponseMultiError is an error wrapping multiple validation
+// errors returned by RemoveBookmarkResponse.ValidateAll() if the designated
+// constraints aren't met.
+type RemoveBookmarkResponseMultiError []error
+
+// Error returns a concatenation of all the error messages it wraps.
+func (m RemoveBookmarkResponseMultiError) Error() string {
+	var msgs []string
+	for _, err := range m {
+		msgs = append(msgs, err.Error())
+	}
+	return strings.Join(msgs, "; ")
+}
+
+// AllErrors returns a list of validation violation errors.
+func (m RemoveBookmarkResponseMultiError) AllErrors() []error { return m }
+
+// RemoveBookmarkResponseValidationError is the validation error returned by
+// RemoveBookmarkResponse.Validate if the designated constraints aren't met.
+type RemoveBookmarkResponseValidationError struct {
+	field  string
+	reason string
+	cause  error
+	key    bool
+}
+
+// Field function returns field value.
+func (e RemoveBookmarkResponseValidationError) Field() string { return e.field }
+
+// Reason function returns reason value.
+func (e RemoveBookmarkResponseValidationError) Reason() string { return e.reason }
+
+// Cause function returns cause value.
+func (e RemoveBookmarkResponseValidationError) Cause() error { return e.cause }
+
+// Key function returns key value.
+func (e RemoveBookmarkResponseValidationError) Key() bool { return e.key }
 
-	case *SudoGetResourceResponse_Project:
-		if v == nil {
-			err := SudoGetResourceResponseValidationError{
-				field:  "Resource",
-				reason: "oneof value cannot be a typed-nil",
-			}
-			if !all {
-				return err
-			}
-			errors = append(errors, err)
+// ErrorName returns error name.
+func (e RemoveBookmarkResponseValidationError) ErrorName() string {
+	return "RemoveBookmarkResponseValidationError"
+}
+
+// Error satisfies the builtin error interface
+func (e RemoveBookmarkResponseValidationError) Error() string {
+	cause := ""
+	if e.cause != nil {
+		cause = fmt.Sprintf(" | caused by: %v", e.cause)
+	}
+
+	key := ""
+	if e.key {
+		key = "key for "
+	}
+
+	return fmt.Sprintf(
+		"invalid %sRemoveBookmarkResponse.%s: %s%s",
+		key,
+		e.field,
+		e.reason,
+		cause)
+}
+
+var _ error = RemoveBookmarkResponseValidationError{}
+
+var _ interface {
+	Field() string
+	Reason() string
+	Key() bool
+	Cause() error
+	ErrorName() string
+} = RemoveBookmarkResponseValidationError{}
+
+// Validate checks the field values on UpdateBookmarkRequest with the rules
+// defined in the proto definition for this message. If any rules are
+// violated, the first error encountered is returned, or nil if there are no violations.
+func (m *UpdateBookmarkRequest) Validate() error {
+	return m.validate(false)
+}
+
+// ValidateAll checks the field values on UpdateBookmarkRequest with the rules
+// defined in the proto definition for this message. If any rules are
+// violated, the result is a list of violation errors wrapped in
+// UpdateBookmarkRequestMultiError, or nil if none found.
+func (m *UpdateBookmarkRequest) ValidateAll() error {
+	return m.validate(true)
+}
+
+func (m *UpdateBookmarkRequest) validate(all bool) error {
+	if m == nil {
+		return nil
+	}
+
+	var errors []error
+
+	if utf8.RuneCountInString(m.GetBookmarkId()) < 1 {
+		err := UpdateBookmarkRequestValidationError{
+			field:  "BookmarkId",
+			reason: "value length must be at least 1 runes",
+		}
+		if !all {
+			return err
 		}
+		errors = append(errors, err)
+	}
 
-		if all {
-			switch v := interface{}(m.GetProject()).(type) {
-			case interface{ ValidateAll() error }:
-				if err := v.ValidateAll(); err != nil {
-					errors = append(errors, SudoGetResourceResponseValidationError{
-						field:  "Project",
-						reason: "embedded message failed validation",
-						cause:  err,
-					})
-				}
-			case interface{ Validate() error }:
-				if err := v.Validate(); err != nil {
-					errors = append(errors, SudoGetResourceResponseValidationError{
-						field:  "Project",
-						reason: "embedded message failed validation",
-						cause:  err,
-					})
-				}
+	// no validation rules for DisplayName
+
+	// no validation rules for Data
+
+	// no validation rules for Shared
+
+	// no validation rules for IsDefault
+
+	if len(errors) > 0 {
+		return UpdateBookmarkRequestMultiError(errors)
+	}
+
+	return nil
+}
+
+// UpdateBookmarkRequestMultiError is an error wrapping multiple validation
+// errors returned by UpdateBookmarkRequest.ValidateAll() if the designated
+// constraints aren't met.
+type UpdateBookmarkRequestMultiError []error
+
+// Error returns a concatenation of all the error messages it wraps.
+func (m UpdateBookmarkRequestMultiError) Error() string {
+	var msgs []string
+	for _, err := range m {
+		msgs = append(msgs, err.Error())
+	}
+	return strings.Join(msgs, "; ")
+}
+
+// AllErrors returns a list of validation violation errors.
+func (m UpdateBookmarkRequestMultiError) AllErrors() []error { return m }
+
+// UpdateBookmarkRequestValidationError is the validation error returned by
+// UpdateBookmarkRequest.Validate if the designated constraints aren't met.
+type UpdateBookmarkRequestValidationError struct {
+	field  string
+	reason string
+	cause  error
+	key    bool
+}
+
+// Field function returns field value.
+func (e UpdateBookmarkRequestValidationError) Field() string { return e.field }
+
+// Reason function returns reason value.
+func (e UpdateBookmarkRequestValidationError) Reason() string { return e.reason }
+
+// Cause function returns cause value.
+func (e UpdateBookmarkRequestValidationError) Cause() error { return e.cause }
+
+// Key function returns key value.
+func (e UpdateBookmarkRequestValidationError) Key() bool { return e.key }
+
+// ErrorName returns error name.
+func (e UpdateBookmarkRequestValidationError) ErrorName() string {
+	return "UpdateBookmarkRequestValidationError"
+}
+
+// Error satisfies the builtin error interface
+func (e UpdateBookmarkRequestValidationError) Error() string {
+	cause := ""
+	if e.cause != nil {
+		cause = fmt.Sprintf(" | caused by: %v", e.cause)
+	}
+
+	key := ""
+	if e.key {
+		key = "key for "
+	}
+
+	return fmt.Sprintf(
+		"invalid %sUpdateBookmarkRequest.%s: %s%s",
+		key,
+		e.field,
+		e.reason,
+		cause)
+}
+
+var _ error = UpdateBookmarkRequestValidationError{}
+
+var _ interface {
+	Field() string
+	Reason() string
+	Key() bool
+	Cause() error
+	ErrorName() string
+} = UpdateBookmarkRequestValidationError{}
+
+// Validate checks the field values on UpdateBookmarkResponse with the rules
+// defined in the proto definition for this message. If any rules are
+// violated, the first error encountered is returned, or nil if there are no violations.
+func (m *UpdateBookmarkResponse) Validate() error {
+	return m.validate(false)
+}
+
+// ValidateAll checks the field values on UpdateBookmarkResponse with the rules
+// defined in the proto definition for this message. If any rules are
+// violated, the result is a list of violation errors wrapped in
+// UpdateBookmarkResponseMultiError, or nil if none found.
+func (m *UpdateBookmarkResponse) ValidateAll() error {
+	return m.validate(true)
+}
+
+func (m *UpdateBookmarkResponse) validate(all bool) error {
+	if m == nil {
+		return nil
+	}
+
+	var errors []error
+
+	if all {
+		switch v := interface{}(m.GetBookmark()).(type) {
+		case interface{ ValidateAll() error }:
+			if err := v.ValidateAll(); err != nil {
+				errors = append(errors, UpdateBookmarkResponseValidationError{
+					field:  "Bookmark",
+					reason: "embedded message failed validation",
+					cause:  err,
+				})
 			}
-		} else if v, ok := interface{}(m.GetProject()).(interface{ Validate() error }); ok {
+		case interface{ Validate() error }:
 			if err := v.Validate(); err != nil {
-				return SudoGetResourceResponseValidationError{
-					field:  "Project",
+				errors = append(errors, UpdateBookmarkResponseValidationError{
+					field:  "Bookmark",
 					reason: "embedded message failed validation",
 					cause:  err,
-				}
+				})
 			}
 		}
+	} else if v, ok := interface{}(m.GetBookmark()).(interface{ Validate() error }); ok {
+		if err := v.Validate(); err != nil {
+			return UpdateBookmarkResponseValidationError{
+				field:  "Bookmark",
+				reason: "embedded message failed validation",
+				cause:  err,
+			}
+		}
+	}
+
+	if len(errors) > 0 {
+		return UpdateBookmarkResponseMultiError(errors)
+	}
+
+	return nil
+}
+
+// UpdateBookmarkResponseMultiError is an error wrapping multiple validation
+// errors returned by UpdateBookmarkResponse.ValidateAll() if the designated
+// constraints aren't met.
+type UpdateBookmarkResponseMultiError []error
+
+// Error returns a concatenation of all the error messages it wraps.
+func (m UpdateBookmarkResponseMultiError) Error() string {
+	var msgs []string
+	for _, err := range m {
+		msgs = append(msgs, err.Error())
+	}
+	return strings.Join(msgs, "; ")
+}
+
+// AllErrors returns a list of validation violation errors.
+func (m UpdateBookmarkResponseMultiError) AllErrors() []error { return m }
+
+// UpdateBookmarkResponseValidationError is the validation error returned by
+// UpdateBookmarkResponse.Validate if the designated constraints aren't met.
+type UpdateBookmarkResponseValidationError struct {
+	field  string
+	reason string
+	cause  error
+	key    bool
+}
+
+// Field function returns field value.
+func (e UpdateBookmarkResponseValidationError) Field() string { return e.field }
+
+// Reason function returns reason value.
+func (e UpdateBookmarkResponseValidationError) Reason() string { return e.reason }
+
+// Cause function returns cause value.
+func (e UpdateBookmarkResponseValidationError) Cause() error { return e.cause }
+
+// Key function returns key value.
+func (e UpdateBookmarkResponseValidationError) Key() bool { return e.key }
+
+// ErrorName returns error name.
+func (e UpdateBookmarkResponseValidationError) ErrorName() string {
+	return "UpdateBookmarkResponseValidationError"
+}
+
+// Error satisfies the builtin error interface
+func (e UpdateBookmarkResponseValidationError) Error() string {
+	cause := ""
+	if e.cause != nil {
+		cause = fmt.Sprintf(" | caused by: %v", e.cause)
+	}
+
+	key := ""
+	if e.key {
+		key = "key for "
+	}
+
+	return fmt.Sprintf(
+		"invalid %sUpdateBookmarkResponse.%s: %s%s",
+		key,
+		e.field,
+		e.reason,
+		cause)
+}
+
+var _ error = UpdateBookmarkResponseValidationError{}
+
+var _ interface {
+	Field() string
+	Reason() string
+	Key() bool
+	Cause() error
+	ErrorName() string
+} = UpdateBookmarkResponseValidationError{}
+
+// Validate checks the field values on CreateBookmarkShareTokenRequest with the
+// rules defined in the proto definition for this message. If any rules are
+// violated, the first error encountered is returned, or nil if there are no violations.
+func (m *CreateBookmarkShareTokenRequest) Validate() error {
+	return m.validate(false)
+}
+
+// ValidateAll checks the field values on CreateBookmarkShareTokenRequest with
+// the rules defined in the proto definition for this message. If any rules
+// are violated, the result is a list of violation errors wrapped in
+// CreateBookmarkShareTokenRequestMultiError, or nil if none found.
+func (m *CreateBookmarkShareTokenRequest) ValidateAll() error {
+	return m.validate(true)
+}
+
+func (m *CreateBookmarkShareTokenRequest) validate(all bool) error {
+	if m == nil {
+		return nil
+	}
+
+	var errors []error
+
+	if utf8.RuneCountInString(m.GetBookmarkId()) < 1 {
+		err := CreateBookmarkShareTokenRequestValidationError{
+			field:  "BookmarkId",
+			reason: "value length must be at least 1 runes",
+		}
+		if !all {
+			return err
+		}
+		errors = append(errors, err)
+	}
 
-	case *SudoGetResourceResponse_Deployment:
-		if v == nil {
-			err := SudoGetResourceResponseValidationError{
-				field:  "Resource",
-				reason: "oneof value cannot be a typed-nil",
-			}
-			if !all {
-				return err
-			}
-			errors = append(errors, err)
+	if m.GetTtlSeconds() < 0 {
+		err := CreateBookmarkShareTokenRequestValidationError{
+			field:  "TtlSeconds",
+			reason: "value must be greater than or equal to 0",
+		}
+		if !all {
+			return err
 		}
+		errors = append(errors, err)
+	}
 
-		if all {
-			switch v := interface{}(m.GetDeployment()).(type) {
-			case interface{ ValidateAll() error }:
-				if err := v.ValidateAll(); err != nil {
-					errors = append(errors, SudoGetResourceResponseValidationError{
-						field:  "Deployment",
-						reason: "embedded message failed validation",
-						cause:  err,
-					})
-				}
-			case interface{ Validate() error }:
-				if err := v.Validate(); err != nil {
-					errors = append(errors, SudoGetResourceResponseValidationError{
-						field:  "Deployment",
-						reason: "embedded message failed validation",
-						cause:  err,
-					})
-				}
+	if len(errors) > 0 {
+		return CreateBookmarkShareTokenRequestMultiError(errors)
+	}
+
+	return nil
+}
+
+// CreateBookmarkShareTokenRequestMultiError is an error wrapping multiple
+// validation errors returned by CreateBookmarkShareTokenRequest.ValidateAll()
+// if the designated constraints aren't met.
+type CreateBookmarkShareTokenRequestMultiError []error
+
+// Error returns a concatenation of all the error messages it wraps.
+func (m CreateBookmarkShareTokenRequestMultiError) Error() string {
+	var msgs []string
+	for _, err := range m {
+		msgs = append(msgs, err.Error())
+	}
+	return strings.Join(msgs, "; ")
+}
+
+// AllErrors returns a list of validation violation errors.
+func (m CreateBookmarkShareTokenRequestMultiError) AllErrors() []error { return m }
+
+// CreateBookmarkShareTokenRequestValidationError is the validation error
+// returned by CreateBookmarkShareTokenRequest.Validate if the designated
+// constraints aren't met.
+type CreateBookmarkShareTokenRequestValidationError struct {
+	field  string
+	reason string
+	cause  error
+	key    bool
+}
+
+// Field function returns field value.
+func (e CreateBookmarkShareTokenRequestValidationError) Field() string { return e.field }
+
+// Reason function returns reason value.
+func (e CreateBookmarkShareTokenRequestValidationError) Reason() string { return e.reason }
+
+// Cause function returns cause value.
+func (e CreateBookmarkShareTokenRequestValidationError) Cause() error { return e.cause }
+
+// Key function returns key value.
+func (e CreateBookmarkShareTokenRequestValidationError) Key() bool { return e.key }
+
+// ErrorName returns error name.
+func (e CreateBookmarkShareTokenRequestValidationError) ErrorName() string {
+	return "CreateBookmarkShareTokenRequestValidationError"
+}
+
+// Error satisfies the builtin error interface
+func (e CreateBookmarkShareTokenRequestValidationError) Error() string {
+	cause := ""
+	if e.cause != nil {
+		cause = fmt.Sprintf(" | caused by: %v", e.cause)
+	}
+
+	key := ""
+	if e.key {
+		key = "key for "
+	}
+
+	return fmt.Sprintf(
+		"invalid %sCreateBookmarkShareTokenRequest.%s: %s%s",
+		key,
+		e.field,
+		e.reason,
+		cause)
+}
+
+var _ error = CreateBookmarkShareTokenRequestValidationError{}
+
+var _ interface {
+	Field() string
+	Reason() string
+	Key() bool
+	Cause() error
+	ErrorName() string
+} = CreateBookmarkShareTokenRequestValidationError{}
+
+// Validate checks the field values on CreateBookmarkShareTokenResponse with
+// the rules defined in the proto definition for this message. If any rules
+// are violated, the first error encountered is returned, or nil if there are
+// no violations.
+func (m *CreateBookmarkShareTokenResponse) Validate() error {
+	return m.validate(false)
+}
+
+// ValidateAll checks the field values on CreateBookmarkShareTokenResponse with
+// the rules defined in the proto definition for this message. If any rules
+// are violated, the result is a list of violation errors wrapped in
+// CreateBookmarkShareTokenResponseMultiError, or nil if none found.
+func (m *CreateBookmarkShareTokenResponse) ValidateAll() error {
+	return m.validate(true)
+}
+
+func (m *CreateBookmarkShareTokenResponse) validate(all bool) error {
+	if m == nil {
+		return nil
+	}
+
+	var errors []error
+
+	// no validation rules for Token
+
+	// no validation rules for TokenId
+
+	if all {
+		switch v := interface{}(m.GetExpiresOn()).(type) {
+		case interface{ ValidateAll() error }:
+			if err := v.ValidateAll(); err != nil {
+				errors = append(errors, CreateBookmarkShareTokenResponseValidationError{
+					field:  "ExpiresOn",
+					reason: "embedded message failed validation",
+					cause:  err,
+				})
 			}
-		} else if v, ok := interface{}(m.GetDeployment()).(interface{ Validate() error }); ok {
+		case interface{ Validate() error }:
 			if err := v.Validate(); err != nil {
-				return SudoGetResourceResponseValidationError{
-					field:  "Deployment",
+				errors = append(errors, CreateBookmarkShareTokenResponseValidationError{
+					field:  "ExpiresOn",
 					reason: "embedded message failed validation",
 					cause:  err,
-				}
+				})
 			}
 		}
-
-	case *SudoGetResourceResponse_Instance:
-		if v == nil {
-			err := SudoGetResourceResponseValidationError{
-				field:  "Resource",
-				reason: "oneof value cannot be a typed-nil",
-			}
-			if !all {
-				return err
+	} else if v, ok := interface{}(m.GetExpiresOn()).(interface{ Validate() error }); ok {
+		if err := v.Validate(); err != nil {
+			return CreateBookmarkShareTokenResponseValidationError{
+				field:  "ExpiresOn",
+				reason: "embedded message failed validation",
+				cause:  err,
 			}
-			errors = append(errors, err)
 		}
+	}
 
-		if all {
-			switch v := interface{}(m.GetInstance()).(type) {
-			case interface{ ValidateAll() error }:
-				if err := v.ValidateAll(); err != nil {
-					errors = append(errors, SudoGetResourceResponseValidationError{
-						field:  "Instance",
-						reason: "embedded message failed validation",
-						cause:  err,
-					})
-				}
-			case interface{ Validate() error }:
-				if err := v.Validate(); err != nil {
-					errors = append(errors, SudoGetResourceResponseValidationError{
-						field:  "Instance",
-						reason: "embedded message failed validation",
-						cause:  err,
-					})
-				}
-			}
-		} else if v, ok := interface{}(m.GetInstance()).(interface{ Validate() error }); ok {
-			if err := v.Validate(); err != nil {
-				return SudoGetResourceResponseValidationError{
-					field:  "Instance",
-					reason: "embedded message failed validation",
-					cause:  err,
-				}
-			}
+	if len(errors) > 0 {
+		return CreateBookmarkShareTokenResponseMultiError(errors)
+	}
+
+	return nil
+}
+
+// CreateBookmarkShareTokenResponseMultiError is an error wrapping multiple
+// validation errors returned by
+// CreateBookmarkShareTokenResponse.ValidateAll() if the designated
+// constraints aren't met.
+type CreateBookmarkShareTokenResponseMultiError []error
+
+// Error returns a concatenation of all the error messages it wraps.
+func (m CreateBookmarkShareTokenResponseMultiError) Error() string {
+	var msgs []string
+	for _, err := range m {
+		msgs = append(msgs, err.Error())
+	}
+	return strings.Join(msgs, "; ")
+}
+
+// AllErrors returns a list of validation violation errors.
+func (m CreateBookmarkShareTokenResponseMultiError) AllErrors() []error { return m }
+
+// CreateBookmarkShareTokenResponseValidationError is the validation error
+// returned by CreateBookmarkShareTokenResponse.Validate if the designated
+// constraints aren't met.
+type CreateBookmarkShareTokenResponseValidationError struct {
+	field  string
+	reason string
+	cause  error
+	key    bool
+}
+
+// Field function returns field value.
+func (e CreateBookmarkShareTokenResponseValidationError) Field() string { return e.field }
+
+// Reason function returns reason value.
+func (e CreateBookmarkShareTokenResponseValidationError) Reason() string { return e.reason }
+
+// Cause function returns cause value.
+func (e CreateBookmarkShareTokenResponseValidationError) Cause() error { return e.cause }
+
+// Key function returns key value.
+func (e CreateBookmarkShareTokenResponseValidationError) Key() bool { return e.key }
+
+// ErrorName returns error name.
+func (e CreateBookmarkShareTokenResponseValidationError) ErrorName() string {
+	return "CreateBookmarkShareTokenResponseValidationError"
+}
+
+// Error satisfies the builtin error interface
+func (e CreateBookmarkShareTokenResponseValidationError) Error() string {
+	cause := ""
+	if e.cause != nil {
+		cause = fmt.Sprintf(" | caused by: %v", e.cause)
+	}
+
+	key := ""
+	if e.key {
+		key = "key for "
+	}
+
+	return fmt.Sprintf(
+		"invalid %sCreateBookmarkShareTokenResponse.%s: %s%s",
+		key,
+		e.field,
+		e.reason,
+		cause)
+}
+
+var _ error = CreateBookmarkShareTokenResponseValidationError{}
+
+var _ interface {
+	Field() string
+	Reason() string
+	Key() bool
+	Cause() error
+	ErrorName() string
+} = CreateBookmarkShareTokenResponseValidationError{}
+
+// Validate checks the field values on RevokeBookmarkShareTokenRequest with the
+// rules defined in the proto definition for this message. If any rules are
+// violated, the first error encountered is returned, or nil if there are no violations.
+func (m *RevokeBookmarkShareTokenRequest) Validate() error {
+	return m.validate(false)
+}
+
+// ValidateAll checks the field values on RevokeBookmarkShareTokenRequest with
+// the rules defined in the proto definition for this message. If any rules
+// are violated, the result is a list of violation errors wrapped in
+// RevokeBookmarkShareTokenRequestMultiError, or nil if none found.
+func (m *RevokeBookmarkShareTokenRequest) ValidateAll() error {
+	return m.validate(true)
+}
+
+func (m *RevokeBookmarkShareTokenRequest) validate(all bool) error {
+	if m == nil {
+		return nil
+	}
+
+	var errors []error
+
+	if utf8.RuneCountInString(m.GetBookmarkId()) < 1 {
+		err := RevokeBookmarkShareTokenRequestValidationError{
+			field:  "BookmarkId",
+			reason: "value length must be at least 1 runes",
+		}
+		if !all {
+			return err
 		}
+		errors = append(errors, err)
+	}
 
-	default:
-		_ = v // ensures v is used
+	if utf8.RuneCountInString(m.GetTokenId()) < 1 {
+		err := RevokeBookmarkShareTokenRequestValidationError{
+			field:  "TokenId",
+			reason: "value length must be at least 1 runes",
+		}
+		if !all {
+			return err
+		}
+		errors = append(errors, err)
 	}
 
 	if len(errors) > 0 {
-		return SudoGetResourceResponseMultiError(errors)
+		return RevokeBookmarkShareTokenRequestMultiError(errors)
 	}
 
 	return nil
 }
 
-// SudoGetResourceResponseMultiError is an error wrapping multiple validation
-// errors returned by SudoGetResourceResponse.ValidateAll() if the designated
-// constraints aren't met.
-type SudoGetResourceResponseMultiError []error
+// RevokeBookmarkShareTokenRequestMultiError is an error wrapping multiple
+// validation errors returned by RevokeBookmarkShareTokenRequest.ValidateAll()
+// if the designated constraints aren't met.
+type RevokeBookmarkShareTokenRequestMultiError []error
 
 // Error returns a concatenation of all the error messages it wraps.
-func (m SudoGetResourceResponseMultiError) Error() string {
+func (m RevokeBookmarkShareTokenRequestMultiError) Error() string {
 	var msgs []string
 	for _, err := range m {
 		msgs = append(msgs, err.Error())
@@ -7664,11 +12621,12 @@ func (m SudoGetResourceResponseMultiError) Error() string {
 }
 
 // AllErrors returns a list of validation violation errors.
-func (m SudoGetResourceResponseMultiError) AllErrors() []error { return m }
+func (m RevokeBookmarkShareTokenRequestMultiError) AllErrors() []error { return m }
 
-// SudoGetResourceResponseValidationError is the validation error returned by
-// SudoGetResourceResponse.Validate if the designated constraints aren't met.
-type SudoGetResourceResponseValidationError struct {
+// RevokeBookmarkShareTokenRequestValidationError is the validation error
+// returned by RevokeBookmarkShareTokenRequest.Validate if the designated
+// constraints aren't met.
+type RevokeBookmarkShareTokenRequestValidationError struct {
 	field  string
 	reason string
 	cause  error
@@ -7676,24 +12634,24 @@ type SudoGetResourceResponseValidationError struct {
 }
 
 // Field function returns field value.
-func (e SudoGetResourceResponseValidationError) Field() string { return e.field }
+func (e RevokeBookmarkShareTokenRequestValidationError) Field() string { return e.field }
 
 // Reason function returns reason value.
-func (e SudoGetResourceResponseValidationError) Reason() string { return e.reason }
+func (e RevokeBookmarkShareTokenRequestValidationError) Reason() string { return e.reason }
 
 // Cause function returns cause value.
-func (e SudoGetResourceResponseValidationError) Cause() error { return e.cause }
+func (e RevokeBookmarkShareTokenRequestValidationError) Cause() error { return e.cause }
 
 // Key function returns key value.
-func (e SudoGetResourceResponseValidationError) Key() bool { return e.key }
+func (e RevokeBookmarkShareTokenRequestValidationError) Key() bool { return e.key }
 
 // ErrorName returns error name.
-func (e SudoGetResourceResponseValidationError) ErrorName() string {
-	return "SudoGetResourceResponseValidationError"
+func (e RevokeBookmarkShareTokenRequestValidationError) ErrorName() string {
+	return "RevokeBookmarkShareTokenRequestValidationError"
 }
 
 // Error satisfies the builtin error interface
-func (e SudoGetResourceResponseValidationError) Error() string {
+func (e RevokeBookmarkShareTokenRequestValidationError) Error() string {
 	cause := ""
 	if e.cause != nil {
 		cause = fmt.Sprintf(" | caused by: %v", e.cause)
@@ -7705,14 +12663,14 @@ func (e SudoGetResourceResponseValidationError) Error() string {
 	}
 
 	return fmt.Sprintf(
-		"invalid %sSudoGetResourceResponse.%s: %s%s",
+		"invalid %sRevokeBookmarkShareTokenRequest.%s: %s%s",
 		key,
 		e.field,
 		e.reason,
 		cause)
 }
 
-var _ error = SudoGetResourceResponseValidationError{}
+var _ error = RevokeBookmarkShareTokenRequestValidationError{}
 
 var _ interface {
 	Field() string
@@ -7720,68 +12678,160 @@ var _ interface {
 	Key() bool
 	Cause() error
 	ErrorName() string
-} = SudoGetResourceResponseValidationError{}
+} = RevokeBookmarkShareTokenRequestValidationError{}
 
-// Validate checks the field values on SudoUpdateOrganizationQuotasRequest with
+// Validate checks the field values on RevokeBookmarkShareTokenResponse with
 // the rules defined in the proto definition for this message. If any rules
 // are violated, the first error encountered is returned, or nil if there are
 // no violations.
-func (m *SudoUpdateOrganizationQuotasRequest) Validate() error {
+func (m *RevokeBookmarkShareTokenResponse) Validate() error {
 	return m.validate(false)
 }
 
-// ValidateAll checks the field values on SudoUpdateOrganizationQuotasRequest
-// with the rules defined in the proto definition for this message. If any
-// rules are violated, the result is a list of violation errors wrapped in
-// SudoUpdateOrganizationQuotasRequestMultiError, or nil if none found.
-func (m *SudoUpdateOrganizationQuotasRequest) ValidateAll() error {
+// ValidateAll checks the field values on RevokeBookmarkShareTokenResponse with
+// the rules defined in the proto definition for this message. If any rules
+// are violated, the result is a list of violation errors wrapped in
+// RevokeBookmarkShareTokenResponseMultiError, or nil if none found.
+func (m *RevokeBookmarkShareTokenResponse) ValidateAll() error {
 	return m.validate(true)
 }
 
-func (m *SudoUpdateOrganizationQuotasRequest) validate(all bool) error {
+func (m *RevokeBookmarkShareTokenResponse) validate(all bool) error {
 	if m == nil {
 		return nil
 	}
 
 	var errors []error
 
-	// no validation rules for OrgName
+	if len(errors) > 0 {
+		return RevokeBookmarkShareTokenResponseMultiError(errors)
+	}
 
-	if m.Projects != nil {
-		// no validation rules for Projects
+	return nil
+}
+
+// RevokeBookmarkShareTokenResponseMultiError is an error wrapping multiple
+// validation errors returned by
+// RevokeBookmarkShareTokenResponse.ValidateAll() if the designated
+// constraints aren't met.
+type RevokeBookmarkShareTokenResponseMultiError []error
+
+// Error returns a concatenation of all the error messages it wraps.
+func (m RevokeBookmarkShareTokenResponseMultiError) Error() string {
+	var msgs []string
+	for _, err := range m {
+		msgs = append(msgs, err.Error())
 	}
+	return strings.Join(msgs, "; ")
+}
 
-	if m.Deployments != nil {
-		// no validation rules for Deployments
+// AllErrors returns a list of validation violation errors.
+func (m RevokeBookmarkShareTokenResponseMultiError) AllErrors() []error { return m }
+
+// RevokeBookmarkShareTokenResponseValidationError is the validation error
+// returned by RevokeBookmarkShareTokenResponse.Validate if the designated
+// constraints aren't met.
+type RevokeBookmarkShareTokenResponseValidationError struct {
+	field  string
+	reason string
+	cause  error
+	key    bool
+}
+
+// Field function returns field value.
+func (e RevokeBookmarkShareTokenResponseValidationError) Field() string { return e.field }
+
+// Reason function returns reason value.
+func (e RevokeBookmarkShareTokenResponseValidationError) Reason() string { return e.reason }
+
+// Cause function returns cause value.
+func (e RevokeBookmarkShareTokenResponseValidationError) Cause() error { return e.cause }
+
+// Key function returns key value.
+func (e RevokeBookmarkShareTokenResponseValidationError) Key() bool { return e.key }
+
+// ErrorName returns error name.
+func (e RevokeBookmarkShareTokenResponseValidationError) ErrorName() string {
+	return "RevokeBookmarkShareTokenResponseValidationError"
+}
+
+// Error satisfies the builtin error interface
+func (e RevokeBookmarkShareTokenResponseValidationError) Error() string {
+	cause := ""
+	if e.cause != nil {
+		cause = fmt.Sprintf(" | caused by: %v", e.cause)
 	}
 
-	if m.SlotsTotal != nil {
-		// no validation rules for SlotsTotal
+	key := ""
+	if e.key {
+		key = "key for "
 	}
 
-	if m.SlotsPerDeployment != nil {
-		// no validation rules for SlotsPerDeployment
+	return fmt.Sprintf(
+		"invalid %sRevokeBookmarkShareTokenResponse.%s: %s%s",
+		key,
+		e.field,
+		e.reason,
+		cause)
+}
+
+var _ error = RevokeBookmarkShareTokenResponseValidationError{}
+
+var _ interface {
+	Field() string
+	Reason() string
+	Key() bool
+	Cause() error
+	ErrorName() string
+} = RevokeBookmarkShareTokenResponseValidationError{}
+
+// Validate checks the field values on GetSharedBookmarkRequest with the rules
+// defined in the proto definition for this message. If any rules are
+// violated, the first error encountered is returned, or nil if there are no violations.
+func (m *GetSharedBookmarkRequest) Validate() error {
+	return m.validate(false)
+}
+
+// ValidateAll checks the field values on GetSharedBookmarkRequest with the
+// rules defined in the proto definition for this message. If any rules are
+// violated, the result is a list of violation errors wrapped in
+// GetSharedBookmarkRequestMultiError, or nil if none found.
+func (m *GetSharedBookmarkRequest) ValidateAll() error {
+	return m.validate(true)
+}
+
+func (m *GetSharedBookmarkRequest) validate(all bool) error {
+	if m == nil {
+		return nil
 	}
 
-	if m.OutstandingInvites != nil {
-		// no validation rules for OutstandingInvites
+	var errors []error
+
+	if utf8.RuneCountInString(m.GetToken()) < 1 {
+		err := GetSharedBookmarkRequestValidationError{
+			field:  "Token",
+			reason: "value length must be at least 1 runes",
+		}
+		if !all {
+			return err
+		}
+		errors = append(errors, err)
 	}
 
 	if len(errors) > 0 {
-		return SudoUpdateOrganizationQuotasRequestMultiError(errors)
+		return GetSharedBookmarkRequestMultiError(errors)
 	}
 
 	return nil
 }
 
-// SudoUpdateOrganizationQuotasRequestMultiError is an error wrapping multiple
-// validation errors returned by
-// SudoUpdateOrganizationQuotasRequest.ValidateAll() if the designated
+// GetSharedBookmarkRequestMultiError is an error wrapping multiple validation
+// errors returned by GetSharedBookmarkRequest.ValidateAll() if the designated
 // constraints aren't met.
-type SudoUpdateOrganizationQuotasRequestMultiError []error
+type GetSharedBookmarkRequestMultiError []error
 
 // Error returns a concatenation of all the error messages it wraps.
-func (m SudoUpdateOrganizationQuotasRequestMultiError) Error() string {
+func (m GetSharedBookmarkRequestMultiError) Error() string {
 	var msgs []string
 	for _, err := range m {
 		msgs = append(msgs, err.Error())
@@ -7790,12 +12840,11 @@ func (m SudoUpdateOrganizationQuotasRequestMultiError) Error() string {
 }
 
 // AllErrors returns a list of validation violation errors.
-func (m SudoUpdateOrganizationQuotasRequestMultiError) AllErrors() []error { return m }
+func (m GetSharedBookmarkRequestMultiError) AllErrors() []error { return m }
 
-// SudoUpdateOrganizationQuotasRequestValidationError is the validation error
-// returned by SudoUpdateOrganizationQuotasRequest.Validate if the designated
-// constraints aren't met.
-type SudoUpdateOrganizationQuotasRequestValidationError struct {
+// GetSharedBookmarkRequestValidationError is the validation error returned by
+// GetSharedBookmarkRequest.Validate if the designated constraints aren't met.
+type GetSharedBookmarkRequestValidationError struct {
 	field  string
 	reason string
 	cause  error
@@ -7803,24 +12852,24 @@ type SudoUpdateOrganizationQuotasRequestValidationError struct {
 }
 
 // Field function returns field value.
-func (e SudoUpdateOrganizationQuotasRequestValidationError) Field() string { return e.field }
+func (e GetSharedBookmarkRequestValidationError) Field() string { return e.field }
 
 // Reason function returns reason value.
-func (e SudoUpdateOrganizationQuotasRequestValidationError) Reason() string { return e.reason }
+func (e GetSharedBookmarkRequestValidationError) Reason() string { return e.reason }
 
 // Cause function returns cause value.
-func (e SudoUpdateOrganizationQuotasRequestValidationError) Cause() error { return e.cause }
+func (e GetSharedBookmarkRequestValidationError) Cause() error { return e.cause }
 
 // Key function returns key value.
-func (e SudoUpdateOrganizationQuotasRequestValidationError) Key() bool { return e.key }
+func (e GetSharedBookmarkRequestValidationError) Key() bool { return e.key }
 
 // ErrorName returns error name.
-func (e SudoUpdateOrganizationQuotasRequestValidationError) ErrorName() string {
-	return "SudoUpdateOrganizationQuotasRequestValidationError"
+func (e GetSharedBookmarkRequestValidationError) ErrorName() string {
+	return "GetSharedBookmarkRequestValidationError"
 }
 
 // Error satisfies the builtin error interface
-func (e SudoUpdateOrganizationQuotasRequestValidationError) Error() string {
+func (e GetSharedBookmarkRequestValidationError) Error() string {
 	cause := ""
 	if e.cause != nil {
 		cause = fmt.Sprintf(" | caused by: %v", e.cause)
@@ -7832,14 +12881,14 @@ func (e SudoUpdateOrganizationQuotasRequestValidationError) Error() string {
 	}
 
 	return fmt.Sprintf(
-		"invalid %sSudoUpdateOrganizationQuotasRequest.%s: %s%s",
+		"invalid %sGetSharedBookmarkRequest.%s: %s%s",
 		key,
 		e.field,
 		e.reason,
 		cause)
 }
 
-var _ error = SudoUpdateOrganizationQuotasRequestValidationError{}
+var _ error = GetSharedBookmarkRequestValidationError{}
 
 var _ interface {
 	Field() string
@@ -7847,25 +12896,24 @@ var _ interface {
 	Key() bool
 	Cause() error
 	ErrorName() string
-} = SudoUpdateOrganizationQuotasRequestValidationError{}
+} = GetSharedBookmarkRequestValidationError{}
 
-// Validate checks the field values on SudoUpdateOrganizationQuotasResponse
-// with the rules defined in the proto definition for this message. If any
-// rules are violated, the first error encountered is returned, or nil if
-// there are no violations.
-func (m *SudoUpdateOrganizationQuotasResponse) Validate() error {
+// Validate checks the field values on GetSharedBookmarkResponse with the rules
+// defined in the proto definition for this message. If any rules are
+// violated, the first error encountered is returned, or nil if there are no violations.
+func (m *GetSharedBookmarkResponse) Validate() error {
 	return m.validate(false)
 }
 
-// ValidateAll checks the field values on SudoUpdateOrganizationQuotasResponse
-// with the rules defined in the proto definition for this message. If any
-// rules are violated, the result is a list of violation errors wrapped in
-// SudoUpdateOrganizationQuotasResponseMultiError, or nil if none found.
-func (m *SudoUpdateOrganizationQuotasResponse) ValidateAll() error {
+// ValidateAll checks the field values on GetSharedBookmarkResponse with the
+// rules defined in the proto definition for this message. If any rules are
+// violated, the result is a list of violation errors wrapped in
+// GetSharedBookmarkResponseMultiError, or nil if none found.
+func (m *GetSharedBookmarkResponse) ValidateAll() error {
 	return m.validate(true)
 }
 
-func (m *SudoUpdateOrganizationQuotasResponse) validate(all bool) error {
+func (m *GetSharedBookmarkResponse) validate(all bool) error {
 	if m == nil {
 		return nil
 	}
@@ -7873,28 +12921,67 @@ func (m *SudoUpdateOrganizationQuotasResponse) validate(all bool) error {
 	var errors []error
 
 	if all {
-		switch v := interface{}(m.GetOrganization()).(type) {
+		switch v := interface{}(m.GetBookmark()).(type) {
 		case interface{ ValidateAll() error }:
 			if err := v.ValidateAll(); err != nil {
-				errors = append(errors, SudoUpdateOrganizationQuotasResponseValidationError{
-					field:  "Organization",
+				errors = append(errors, GetSharedBookmarkResponseValidationError{
+					field:  "Bookmark",
 					reason: "embedded message failed validation",
 					cause:  err,
 				})
 			}
 		case interface{ Validate() error }:
 			if err := v.Validate(); err != nil {
-				errors = append(errors, SudoUpdateOrganizationQuotasResponseValidationError{
-					field:  "Organization",
+				errors = append(errors, GetSharedBookmarkResponseValidationError{
+					field:  "Bookmark",
 					reason: "embedded message failed validation",
 					cause:  err,
 				})
 			}
 		}
-	} else if v, ok := interface{}(m.GetOrganization()).(interface{ Validate() error }); ok {
+	} else if v, ok := interface{}(m.GetBookmark()).(interface{ Validate() error }); ok {
 		if err := v.Validate(); err != nil {
-			return SudoUpdateOrganizationQuotasResponseValidationError{
-				field:  "Organization",
+			return GetSharedBookmarkResponseValidationError{
+				field:  "Bookmark",
+				reason: "embedded message failed validation",
+				cause:  err,
+			}
+		}
+	}
+
+	// no validation rules for Organization
+
+	// no validation rules for Project
+
+	// no validation rules for RuntimeHost
+
+	// no validation rules for InstanceId
+
+	// no validation rules for Jwt
+
+	if all {
+		switch v := interface{}(m.GetExpiresOn()).(type) {
+		case interface{ ValidateAll() error }:
+			if err := v.ValidateAll(); err != nil {
+				errors = append(errors, GetSharedBookmarkResponseValidationError{
+					field:  "ExpiresOn",
+					reason: "embedded message failed validation",
+					cause:  err,
+				})
+			}
+		case interface{ Validate() error }:
+			if err := v.Validate(); err != nil {
+				errors = append(errors, GetSharedBookmarkResponseValidationError{
+					field:  "ExpiresOn",
+					reason: "embedded message failed validation",
+					cause:  err,
+				})
+			}
+		}
+	} else if v, ok := interface{}(m.GetExpiresOn()).(interface{ Validate() error }); ok {
+		if err := v.Validate(); err != nil {
+			return GetSharedBookmarkResponseValidationError{
+				field:  "ExpiresOn",
 				reason: "embedded message failed validation",
 				cause:  err,
 			}
@@ -7902,20 +12989,19 @@ func (m *SudoUpdateOrganizationQuotasResponse) validate(all bool) error {
 	}
 
 	if len(errors) > 0 {
-		return SudoUpdateOrganizationQuotasResponseMultiError(errors)
+		return GetSharedBookmarkResponseMultiError(errors)
 	}
 
 	return nil
 }
 
-// SudoUpdateOrganizationQuotasResponseMultiError is an error wrapping multiple
-// validation errors returned by
-// SudoUpdateOrganizationQuotasResponse.ValidateAll() if the designated
-// constraints aren't met.
-type SudoUpdateOrganizationQuotasResponseMultiError []error
+// GetSharedBookmarkResponseMultiError is an error wrapping multiple validation
+// errors returned by GetSharedBookmarkResponse.ValidateAll() if the
+// designated constraints aren't met.
+type GetSharedBookmarkResponseMultiError []error
 
 // Error returns a concatenation of all the error messages it wraps.
-func (m SudoUpdateOrganizationQuotasResponseMultiError) Error() string {
+func (m GetSharedBookmarkResponseMultiError) Error() string {
 	var msgs []string
 	for _, err := range m {
 		msgs = append(msgs, err.Error())
@@ -7924,12 +13010,11 @@ func (m SudoUpdateOrganizationQuotasResponseMultiError) Error() string {
 }
 
 // AllErrors returns a list of validation violation errors.
-func (m SudoUpdateOrganizationQuotasResponseMultiError) AllErrors() []error { return m }
+func (m GetSharedBookmarkResponseMultiError) AllErrors() []error { return m }
 
-// SudoUpdateOrganizationQuotasResponseValidationError is the validation error
-// returned by SudoUpdateOrganizationQuotasResponse.Validate if the designated
-// constraints aren't met.
-type SudoUpdateOrganizationQuotasResponseValidationError struct {
+// GetSharedBookmarkResponseValidationError is the validation error returned by
+// GetSharedBookmarkResponse.Validate if the designated constraints aren't met.
+type GetSharedBookmarkResponseValidationError struct {
 	field  string
 	reason string
 	cause  error
@@ -7937,24 +13022,24 @@ type SudoUpdateOrganizationQuotasResponseValidationError struct {
 }
 
 // Field function returns field value.
-func (e SudoUpdateOrganizationQuotasResponseValidationError) Field() string { return e.field }
+func (e GetSharedBookmarkResponseValidationError) Field() string { return e.field }
 
 // Reason function returns reason value.
-func (e SudoUpdateOrganizationQuotasResponseValidationError) Reason() string { return e.reason }
+func (e GetSharedBookmarkResponseValidationError) Reason() string { return e.reason }
 
 // Cause function returns cause value.
-func (e SudoUpdateOrganizationQuotasResponseValidationError) Cause() error { return e.cause }
+func (e GetSharedBookmarkResponseValidationError) Cause() error { return e.cause }
 
 // Key function returns key value.
-func (e SudoUpdateOrganizationQuotasResponseValidationError) Key() bool { return e.key }
+func (e GetSharedBookmarkResponseValidationError) Key() bool { return e.key }
 
 // ErrorName returns error name.
-func (e SudoUpdateOrganizationQuotasResponseValidationError) ErrorName() string {
-	return "SudoUpdateOrganizationQuotasResponseValidationError"
+func (e GetSharedBookmarkResponseValidationError) ErrorName() string {
+	return "GetSharedBookmarkResponseValidationError"
 }
 
 // Error satisfies the builtin error interface
-func (e SudoUpdateOrganizationQuotasResponseValidationError) Error() string {
+func (e GetSharedBookmarkResponseValidationError) Error() string {
 	cause := ""
 	if e.cause != nil {
 		cause = fmt.Sprintf(" | caused by: %v", e.cause)
@@ -7966,14 +13051,14 @@ func (e SudoUpdateOrganizationQuotasResponseValidationError) Error() string {
 	}
 
 	return fmt.Sprintf(
-		"invalid %sSudoUpdateOrganizationQuotasResponse.%s: %s%s",
+		"invalid %sGetSharedBookmarkResponse.%s: %s%s",
 		key,
 		e.field,
 		e.reason,
 		cause)
 }
 
-var _ error = SudoUpdateOrganizationQuotasResponseValidationError{}
+var _ error = GetSharedBookmarkResponseValidationError{}
 
 var _ interface {
 	Field() string
@@ -7981,50 +13066,66 @@ var _ interface {
 	Key() bool
 	Cause() error
 	ErrorName() string
-} = SudoUpdateOrganizationQuotasResponseValidationError{}
+} = GetSharedBookmarkResponseValidationError{}
 
-// Validate checks the field values on SudoUpdateUserQuotasRequest with the
-// rules defined in the proto definition for this message. If any rules are
+// Validate checks the field values on ListReportsRequest with the rules
+// defined in the proto definition for this message. If any rules are
 // violated, the first error encountered is returned, or nil if there are no violations.
-func (m *SudoUpdateUserQuotasRequest) Validate() error {
+func (m *ListReportsRequest) Validate() error {
 	return m.validate(false)
 }
 
-// ValidateAll checks the field values on SudoUpdateUserQuotasRequest with the
-// rules defined in the proto definition for this message. If any rules are
+// ValidateAll checks the field values on ListReportsRequest with the rules
+// defined in the proto definition for this message. If any rules are
 // violated, the result is a list of violation errors wrapped in
-// SudoUpdateUserQuotasRequestMultiError, or nil if none found.
-func (m *SudoUpdateUserQuotasRequest) ValidateAll() error {
+// ListReportsRequestMultiError, or nil if none found.
+func (m *ListReportsRequest) ValidateAll() error {
 	return m.validate(true)
 }
 
-func (m *SudoUpdateUserQuotasRequest) validate(all bool) error {
+func (m *ListReportsRequest) validate(all bool) error {
 	if m == nil {
 		return nil
 	}
 
 	var errors []error
 
-	// no validation rules for Email
+	if utf8.RuneCountInString(m.GetOrganization()) < 1 {
+		err := ListReportsRequestValidationError{
+			field:  "Organization",
+			reason: "value length must be at least 1 runes",
+		}
+		if !all {
+			return err
+		}
+		errors = append(errors, err)
+	}
 
-	if m.SingleuserOrgs != nil {
-		// no validation rules for SingleuserOrgs
+	if utf8.RuneCountInString(m.GetProject()) < 1 {
+		err := ListReportsRequestValidationError{
+			field:  "Project",
+			reason: "value length must be at least 1 runes",
+		}
+		if !all {
+			return err
+		}
+		errors = append(errors, err)
 	}
 
 	if len(errors) > 0 {
-		return SudoUpdateUserQuotasRequestMultiError(errors)
+		return ListReportsRequestMultiError(errors)
 	}
 
 	return nil
 }
 
-// SudoUpdateUserQuotasRequestMultiError is an error wrapping multiple
-// validation errors returned by SudoUpdateUserQuotasRequest.ValidateAll() if
-// the designated constraints aren't met.
-type SudoUpdateUserQuotasRequestMultiError []error
+// ListReportsRequestMultiError is an error wrapping multiple validation errors
+// returned by ListReportsRequest.ValidateAll() if the designated constraints
+// aren't met.
+type ListReportsRequestMultiError []error
 
 // Error returns a concatenation of all the error messages it wraps.
-func (m SudoUpdateUserQuotasRequestMultiError) Error() string {
+func (m ListReportsRequestMultiError) Error() string {
 	var msgs []string
 	for _, err := range m {
 		msgs = append(msgs, err.Error())
@@ -8033,12 +13134,11 @@ func (m SudoUpdateUserQuotasRequestMultiError) Error() string {
 }
 
 // AllErrors returns a list of validation violation errors.
-func (m SudoUpdateUserQuotasRequestMultiError) AllErrors() []error { return m }
+func (m ListReportsRequestMultiError) AllErrors() []error { return m }
 
-// SudoUpdateUserQuotasRequestValidationError is the validation error returned
-// by SudoUpdateUserQuotasRequest.Validate if the designated constraints
-// aren't met.
-type SudoUpdateUserQuotasRequestValidationError struct {
+// ListReportsRequestValidationError is the validation error returned by
+// ListReportsRequest.Validate if the designated constraints aren't met.
+type ListReportsRequestValidationError struct {
 	field  string
 	reason string
 	cause  error
@@ -8046,24 +13146,24 @@ type SudoUpdateUserQuotasRequestValidationError struct {
 }
 
 // Field function returns field value.
-func (e SudoUpdateUserQuotasRequestValidationError) Field() string { return e.field }
+func (e ListReportsRequestValidationError) Field() string { return e.field }
 
 // Reason function returns reason value.
-func (e SudoUpdateUserQuotasRequestValidationError) Reason() string { return e.reason }
+func (e ListReportsRequestValidationError) Reason() string { return e.reason }
 
 // Cause function returns cause value.
-func (e SudoUpdateUserQuotasRequestValidationError) Cause() error { return e.cause }
+func (e ListReportsRequestValidationError) Cause() error { return e.cause }
 
 // Key function returns key value.
-func (e SudoUpdateUserQuotasRequestValidationError) Key() bool { return e.key }
+func (e ListReportsRequestValidationError) Key() bool { return e.key }
 
 // ErrorName returns error name.
-func (e SudoUpdateUserQuotasRequestValidationError) ErrorName() string {
-	return "SudoUpdateUserQuotasRequestValidationError"
+func (e ListReportsRequestValidationError) ErrorName() string {
+	return "ListReportsRequestValidationError"
 }
 
 // Error satisfies the builtin error interface
-func (e SudoUpdateUserQuotasRequestValidationError) Error() string {
+func (e ListReportsRequestValidationError) Error() string {
 	cause := ""
 	if e.cause != nil {
 		cause = fmt.Sprintf(" | caused by: %v", e.cause)
@@ -8075,14 +13175,14 @@ func (e SudoUpdateUserQuotasRequestValidationError) Error() string {
 	}
 
 	return fmt.Sprintf(
-		"invalid %sSudoUpdateUserQuotasRequest.%s: %s%s",
+		"invalid %sListReportsRequest.%s: %s%s",
 		key,
 		e.field,
 		e.reason,
 		cause)
 }
 
-var _ error = SudoUpdateUserQuotasRequestValidationError{}
+var _ error = ListReportsRequestValidationError{}
 
 var _ interface {
 	Field() string
@@ -8090,73 +13190,78 @@ var _ interface {
 	Key() bool
 	Cause() error
 	ErrorName() string
-} = SudoUpdateUserQuotasRequestValidationError{}
+} = ListReportsRequestValidationError{}
 
-// Validate checks the field values on SudoUpdateUserQuotasResponse with the
-// rules defined in the proto definition for this message. If any rules are
+// Validate checks the field values on ListReportsResponse with the rules
+// defined in the proto definition for this message. If any rules are
 // violated, the first error encountered is returned, or nil if there are no violations.
-func (m *SudoUpdateUserQuotasResponse) Validate() error {
+func (m *ListReportsResponse) Validate() error {
 	return m.validate(false)
 }
 
-// ValidateAll checks the field values on SudoUpdateUserQuotasResponse with the
-// rules defined in the proto definition for this message. If any rules are
+// ValidateAll checks the field values on ListReportsResponse with the rules
+// defined in the proto definition for this message. If any rules are
 // violated, the result is a list of violation errors wrapped in
-// SudoUpdateUserQuotasResponseMultiError, or nil if none found.
-func (m *SudoUpdateUserQuotasResponse) ValidateAll() error {
+// ListReportsResponseMultiError, or nil if none found.
+func (m *ListReportsResponse) ValidateAll() error {
 	return m.validate(true)
 }
 
-func (m *SudoUpdateUserQuotasResponse) validate(all bool) error {
+func (m *ListReportsResponse) validate(all bool) error {
 	if m == nil {
 		return nil
 	}
 
 	var errors []error
 
-	if all {
-		switch v := interface{}(m.GetUser()).(type) {
-		case interface{ ValidateAll() error }:
-			if err := v.ValidateAll(); err != nil {
-				errors = append(errors, SudoUpdateUserQuotasResponseValidationError{
-					field:  "User",
-					reason: "embedded message failed validation",
-					cause:  err,
-				})
+	for idx, item := range m.GetReports() {
+		_, _ = idx, item
+
+		if all {
+			switch v := interface{}(item).(type) {
+			case interface{ ValidateAll() error }:
+				if err := v.ValidateAll(); err != nil {
+					errors = append(errors, ListReportsResponseValidationError{
+						field:  fmt.Sprintf("Reports[%v]", idx),
+						reason: "embedded message failed validation",
+						cause:  err,
+					})
+				}
+			case interface{ Validate() error }:
+				if err := v.Validate(); err != nil {
+					errors = append(errors, ListReportsResponseValidationError{
+						field:  fmt.Sprintf("Reports[%v]", idx),
+						reason: "embedded message failed validation",
+						cause:  err,
+					})
+				}
 			}
-		case interface{ Validate() error }:
+		} else if v, ok := interface{}(item).(interface{ Validate() error }); ok {
 			if err := v.Validate(); err != nil {
-				errors = append(errors, SudoUpdateUserQuotasResponseValidationError{
-					field:  "User",
+				return ListReportsResponseValidationError{
+					field:  fmt.Sprintf("Reports[%v]", idx),
 					reason: "embedded message failed validation",
 					cause:  err,
-				})
-			}
-		}
-	} else if v, ok := interface{}(m.GetUser()).(interface{ Validate() error }); ok {
-		if err := v.Validate(); err != nil {
-			return SudoUpdateUserQuotasResponseValidationError{
-				field:  "User",
-				reason: "embedded message failed validation",
-				cause:  err,
+				}
 			}
 		}
+
 	}
 
 	if len(errors) > 0 {
-		return SudoUpdateUserQuotasResponseMultiError(errors)
+		return ListReportsResponseMultiError(errors)
 	}
 
 	return nil
 }
 
-// SudoUpdateUserQuotasResponseMultiError is an error wrapping multiple
-// validation errors returned by SudoUpdateUserQuotasResponse.ValidateAll() if
-// the designated constraints aren't met.
-type SudoUpdateUserQuotasResponseMultiError []error
+// ListReportsResponseMultiError is an error wrapping multiple validation
+// errors returned by ListReportsResponse.ValidateAll() if the designated
+// constraints aren't met.
+type ListReportsResponseMultiError []error
 
 // Error returns a concatenation of all the error messages it wraps.
-func (m SudoUpdateUserQuotasResponseMultiError) Error() string {
+func (m ListReportsResponseMultiError) Error() string {
 	var msgs []string
 	for _, err := range m {
 		msgs = append(msgs, err.Error())
@@ -8165,12 +13270,11 @@ func (m SudoUpdateUserQuotasResponseMultiError) Error() string {
 }
 
 // AllErrors returns a list of validation violation errors.
-func (m SudoUpdateUserQuotasResponseMultiError) AllErrors() []error { return m }
+func (m ListReportsResponseMultiError) AllErrors() []error { return m }
 
-// SudoUpdateUserQuotasResponseValidationError is the validation error returned
-// by SudoUpdateUserQuotasResponse.Validate if the designated constraints
-// aren't met.
-type SudoUpdateUserQuotasResponseValidationError struct {
+// ListReportsResponseValidationError is the validation error returned by
+// ListReportsResponse.Validate if the designated constraints aren't met.
+type ListReportsResponseValidationError struct {
 	field  string
 	reason string
 	cause  error
@@ -8178,24 +13282,24 @@ type SudoUpdateUserQuotasResponseValidationError struct {
 }
 
 // Field function returns field value.
-func (e SudoUpdateUserQuotasResponseValidationError) Field() string { return e.field }
+func (e ListReportsResponseValidationError) Field() string { return e.field }
 
 // Reason function returns reason value.
-func (e SudoUpdateUserQuotasResponseValidationError) Reason() string { return e.reason }
+func (e ListReportsResponseValidationError) Reason() string { return e.reason }
 
 // Cause function returns cause value.
-func (e SudoUpdateUserQuotasResponseValidationError) Cause() error { return e.cause }
+func (e ListReportsResponseValidationError) Cause() error { return e.cause }
 
 // Key function returns key value.
-func (e SudoUpdateUserQuotasResponseValidationError) Key() bool { return e.key }
+func (e ListReportsResponseValidationError) Key() bool { return e.key }
 
 // ErrorName returns error name.
-func (e SudoUpdateUserQuotasResponseValidationError) ErrorName() string {
-	return "SudoUpdateUserQuotasResponseValidationError"
+func (e ListReportsResponseValidationError) ErrorName() string {
+	return "ListReportsResponseValidationError"
 }
 
 // Error satisfies the builtin error interface
-func (e SudoUpdateUserQuotasResponseValidationError) Error() string {
+func (e ListReportsResponseValidationError) Error() string {
 	cause := ""
 	if e.cause != nil {
 		cause = fmt.Sprintf(" | caused by: %v", e.cause)
@@ -8207,14 +13311,14 @@ func (e SudoUpdateUserQuotasResponseValidationError) Error() string {
 	}
 
 	return fmt.Sprintf(
-		"invalid %sSudoUpdateUserQuotasResponse.%s: %s%s",
+		"invalid %sListReportsResponse.%s: %s%s",
 		key,
 		e.field,
 		e.reason,
 		cause)
 }
 
-var _ error = SudoUpdateUserQuotasResponseValidationError{}
+var _ error = ListReportsResponseValidationError{}
 
 var _ interface {
 	Field() string
@@ -8222,24 +13326,24 @@ var _ interface {
 	Key() bool
 	Cause() error
 	ErrorName() string
-} = SudoUpdateUserQuotasResponseValidationError{}
+} = ListReportsResponseValidationError{}
 
-// Validate checks the field values on ListProjectMembersRequest with the rules
-// defined in the proto definition for this message. If any rules are
-// violated, the first error encountered is returned, or nil if there are no violations.
-func (m *ListProjectMembersRequest) Validate() error {
+// Validate checks the field values on GetReportRequest with the rules defined
+// in the proto definition for this message. If any rules are violated, the
+// first error encountered is returned, or nil if there are no violations.
+func (m *GetReportRequest) Validate() error {
 	return m.validate(false)
 }
 
-// ValidateAll checks the field values on ListProjectMembersRequest with the
-// rules defined in the proto definition for this message. If any rules are
+// ValidateAll checks the field values on GetReportRequest with the rules
+// defined in the proto definition for this message. If any rules are
 // violated, the result is a list of violation errors wrapped in
-// ListProjectMembersRequestMultiError, or nil if none found.
-func (m *ListProjectMembersRequest) ValidateAll() error {
+// GetReportRequestMultiError, or nil if none found.
+func (m *GetReportRequest) ValidateAll() error {
 	return m.validate(true)
 }
 
-func (m *ListProjectMembersRequest) validate(all bool) error {
+func (m *GetReportRequest) validate(all bool) error {
 	if m == nil {
 		return nil
 	}
@@ -8247,7 +13351,7 @@ func (m *ListProjectMembersRequest) validate(all bool) error {
 	var errors []error
 
 	if utf8.RuneCountInString(m.GetOrganization()) < 1 {
-		err := ListProjectMembersRequestValidationError{
+		err := GetReportRequestValidationError{
 			field:  "Organization",
 			reason: "value length must be at least 1 runes",
 		}
@@ -8258,7 +13362,7 @@ func (m *ListProjectMembersRequest) validate(all bool) error {
 	}
 
 	if utf8.RuneCountInString(m.GetProject()) < 1 {
-		err := ListProjectMembersRequestValidationError{
+		err := GetReportRequestValidationError{
 			field:  "Project",
 			reason: "value length must be at least 1 runes",
 		}
@@ -8268,37 +13372,31 @@ func (m *ListProjectMembersRequest) validate(all bool) error {
 		errors = append(errors, err)
 	}
 
-	if m.GetPageSize() != 0 {
-
-		if m.GetPageSize() > 1000 {
-			err := ListProjectMembersRequestValidationError{
-				field:  "PageSize",
-				reason: "value must be less than or equal to 1000",
-			}
-			if !all {
-				return err
-			}
-			errors = append(errors, err)
+	if utf8.RuneCountInString(m.GetName()) < 1 {
+		err := GetReportRequestValidationError{
+			field:  "Name",
+			reason: "value length must be at least 1 runes",
 		}
-
+		if !all {
+			return err
+		}
+		errors = append(errors, err)
 	}
 
-	// no validation rules for PageToken
-
 	if len(errors) > 0 {
-		return ListProjectMembersRequestMultiError(errors)
+		return GetReportRequestMultiError(errors)
 	}
 
 	return nil
 }
 
-// ListProjectMembersRequestMultiError is an error wrapping multiple validation
-// errors returned by ListProjectMembersRequest.ValidateAll() if the
-// designated constraints aren't met.
-type ListProjectMembersRequestMultiError []error
+// GetReportRequestMultiError is an error wrapping multiple validation errors
+// returned by GetReportRequest.ValidateAll() if the designated constraints
+// aren't met.
+type GetReportRequestMultiError []error
 
 // Error returns a concatenation of all the error messages it wraps.
-func (m ListProjectMembersRequestMultiError) Error() string {
+func (m GetReportRequestMultiError) Error() string {
 	var msgs []string
 	for _, err := range m {
 		msgs = append(msgs, err.Error())
@@ -8307,11 +13405,11 @@ func (m ListProjectMembersRequestMultiError) Error() string {
 }
 
 // AllErrors returns a list of validation violation errors.
-func (m ListProjectMembersRequestMultiError) AllErrors() []error { return m }
+func (m GetReportRequestMultiError) AllErrors() []error { return m }
 
-// ListProjectMembersRequestValidationError is the validation error returned by
-// ListProjectMembersRequest.Validate if the designated constraints aren't met.
-type ListProjectMembersRequestValidationError struct {
+// GetReportRequestValidationError is the validation error returned by
+// GetReportRequest.Validate if the designated constraints aren't met.
+type GetReportRequestValidationError struct {
 	field  string
 	reason string
 	cause  error
@@ -8319,24 +13417,22 @@ type ListProjectMembersRequestValidationError struct {
 }
 
 // Field function returns field value.
-func (e ListProjectMembersRequestValidationError) Field() string { return e.field }
+func (e GetReportRequestValidationError) Field() string { return e.field }
 
 // Reason function returns reason value.
-func (e ListProjectMembersRequestValidationError) Reason() string { return e.reason }
+func (e GetReportRequestValidationError) Reason() string { return e.reason }
 
 // Cause function returns cause value.
-func (e ListProjectMembersRequestValidationError) Cause() error { return e.cause }
+func (e GetReportRequestValidationError) Cause() error { return e.cause }
 
 // Key function returns key value.
-func (e ListProjectMembersRequestValidationError) Key() bool { return e.key }
+func (e GetReportRequestValidationError) Key() bool { return e.key }
 
 // ErrorName returns error name.
-func (e ListProjectMembersRequestValidationError) ErrorName() string {
-	return "ListProjectMembersRequestValidationError"
-}
+func (e GetReportRequestValidationError) ErrorName() string { return "GetReportRequestValidationError" }
 
 // Error satisfies the builtin error interface
-func (e ListProjectMembersRequestValidationError) Error() string {
+func (e GetReportRequestValidationError) Error() string {
 	cause := ""
 	if e.cause != nil {
 		cause = fmt.Sprintf(" | caused by: %v", e.cause)
@@ -8348,14 +13444,14 @@ func (e ListProjectMembersRequestValidationError) Error() string {
 	}
 
 	return fmt.Sprintf(
-		"invalid %sListProjectMembersRequest.%s: %s%s",
+		"invalid %sGetReportRequest.%s: %s%s",
 		key,
 		e.field,
 		e.reason,
 		cause)
 }
 
-var _ error = ListProjectMembersRequestValidationError{}
+var _ error = GetReportRequestValidationError{}
 
 var _ interface {
 	Field() string
@@ -8363,80 +13459,73 @@ var _ interface {
 	Key() bool
 	Cause() error
 	ErrorName() string
-} = ListProjectMembersRequestValidationError{}
+} = GetReportRequestValidationError{}
 
-// Validate checks the field values on ListProjectMembersResponse with the
-// rules defined in the proto definition for this message. If any rules are
-// violated, the first error encountered is returned, or nil if there are no violations.
-func (m *ListProjectMembersResponse) Validate() error {
+// Validate checks the field values on GetReportResponse with the rules defined
+// in the proto definition for this message. If any rules are violated, the
+// first error encountered is returned, or nil if there are no violations.
+func (m *GetReportResponse) Validate() error {
 	return m.validate(false)
 }
 
-// ValidateAll checks the field values on ListProjectMembersResponse with the
-// rules defined in the proto definition for this message. If any rules are
+// ValidateAll checks the field values on GetReportResponse with the rules
+// defined in the proto definition for this message. If any rules are
 // violated, the result is a list of violation errors wrapped in
-// ListProjectMembersResponseMultiError, or nil if none found.
-func (m *ListProjectMembersResponse) ValidateAll() error {
+// GetReportResponseMultiError, or nil if none found.
+func (m *GetReportResponse) ValidateAll() error {
 	return m.validate(true)
 }
 
-func (m *ListProjectMembersResponse) validate(all bool) error {
+func (m *GetReportResponse) validate(all bool) error {
 	if m == nil {
 		return nil
 	}
 
 	var errors []error
 
-	for idx, item := range m.GetMembers() {
-		_, _ = idx, item
-
-		if all {
-			switch v := interface{}(item).(type) {
-			case interface{ ValidateAll() error }:
-				if err := v.ValidateAll(); err != nil {
-					errors = append(errors, ListProjectMembersResponseValidationError{
-						field:  fmt.Sprintf("Members[%v]", idx),
-						reason: "embedded message failed validation",
-						cause:  err,
-					})
-				}
-			case interface{ Validate() error }:
-				if err := v.Validate(); err != nil {
-					errors = append(errors, ListProjectMembersResponseValidationError{
-						field:  fmt.Sprintf("Members[%v]", idx),
-						reason: "embedded message failed validation",
-						cause:  err,
-					})
-				}
+	if all {
+		switch v := interface{}(m.GetReport()).(type) {
+		case interface{ ValidateAll() error }:
+			if err := v.ValidateAll(); err != nil {
+				errors = append(errors, GetReportResponseValidationError{
+					field:  "Report",
+					reason: "embedded message failed validation",
+					cause:  err,
+				})
 			}
-		} else if v, ok := interface{}(item).(interface{ Validate() error }); ok {
+		case interface{ Validate() error }:
 			if err := v.Validate(); err != nil {
-				return ListProjectMembersResponseValidationError{
-					field:  fmt.Sprintf("Members[%v]", idx),
+				errors = append(errors, GetReportResponseValidationError{
+					field:  "Report",
 					reason: "embedded message failed validation",
 					cause:  err,
-				}
+				})
+			}
+		}
+	} else if v, ok := interface{}(m.GetReport()).(interface{ Validate() error }); ok {
+		if err := v.Validate(); err != nil {
+			return GetReportResponseValidationError{
+				field:  "Report",
+				reason: "embedded message failed validation",
+				cause:  err,
 			}
 		}
-
 	}
 
-	// no validation rules for NextPageToken
-
 	if len(errors) > 0 {
-		return ListProjectMembersResponseMultiError(errors)
+		return GetReportResponseMultiError(errors)
 	}
 
 	return nil
 }
 
-// ListProjectMembersResponseMultiError is an error wrapping multiple
-// validation errors returned by ListProjectMembersResponse.ValidateAll() if
-// the designated constraints aren't met.
-type ListProjectMembersResponseMultiError []error
+// GetReportResponseMultiError is an error wrapping multiple validation errors
+// returned by GetReportResponse.ValidateAll() if the designated constraints
+// aren't met.
+type GetReportResponseMultiError []error
 
 // Error returns a concatenation of all the error messages it wraps.
-func (m ListProjectMembersResponseMultiError) Error() string {
+func (m GetReportResponseMultiError) Error() string {
 	var msgs []string
 	for _, err := range m {
 		msgs = append(msgs, err.Error())
@@ -8445,11 +13534,11 @@ func (m ListProjectMembersResponseMultiError) Error() string {
 }
 
 // AllErrors returns a list of validation violation errors.
-func (m ListProjectMembersResponseMultiError) AllErrors() []error { return m }
+func (m GetReportResponseMultiError) AllErrors() []error { return m }
 
-// ListProjectMembersResponseValidationError is the validation error returned
-// by ListProjectMembersResponse.Validate if the designated constraints aren't met.
-type ListProjectMembersResponseValidationError struct {
+// GetReportResponseValidationError is the validation error returned by
+// GetReportResponse.Validate if the designated constraints aren't met.
+type GetReportResponseValidationError struct {
 	field  string
 	reason string
 	cause  error
@@ -8457,24 +13546,24 @@ type ListProjectMembersResponseValidationError struct {
 }
 
 // Field function returns field value.
-func (e ListProjectMembersResponseValidationError) Field() string { return e.field }
+func (e GetReportResponseValidationError) Field() string { return e.field }
 
 // Reason function returns reason value.
-func (e ListProjectMembersResponseValidationError) Reason() string { return e.reason }
+func (e GetReportResponseValidationError) Reason() string { return e.reason }
 
 // Cause function returns cause value.
-func (e ListProjectMembersResponseValidationError) Cause() error { return e.cause }
+func (e GetReportResponseValidationError) Cause() error { return e.cause }
 
 // Key function returns key value.
-func (e ListProjectMembersResponseValidationError) Key() bool { return e.key }
+func (e GetReportResponseValidationError) Key() bool { return e.key }
 
 // ErrorName returns error name.
-func (e ListProjectMembersResponseValidationError) ErrorName() string {
-	return "ListProjectMembersResponseValidationError"
+func (e GetReportResponseValidationError) ErrorName() string {
+	return "GetReportResponseValidationError"
 }
 
 // Error satisfies the builtin error interface
-func (e ListProjectMembersResponseValidationError) Error() string {
+func (e GetReportResponseValidationError) Error() string {
 	cause := ""
 	if e.cause != nil {
 		cause = fmt.Sprintf(" | caused by: %v", e.cause)
@@ -8486,14 +13575,14 @@ func (e ListProjectMembersResponseValidationError) Error() string {
 	}
 
 	return fmt.Sprintf(
-		"invalid %sListProjectMembersResponse.%s: %s%s",
+		"invalid %sGetReportResponse.%s: %s%s",
 		key,
 		e.field,
 		e.reason,
 		cause)
 }
 
-var _ error = ListProjectMembersResponseValidationError{}
+var _ error = GetReportResponseValidationError{}
 
 var _ interface {
 	Field() string
@@ -8501,24 +13590,24 @@ var _ interface {
 	Key() bool
 	Cause() error
 	ErrorName() string
-} = ListProjectMembersResponseValidationError{}
+} = GetReportResponseValidationError{}
 
-// Validate checks the field values on ListProjectInvitesRequest with the rules
+// Validate checks the field values on CreateReportRequest with the rules
 // defined in the proto definition for this message. If any rules are
 // violated, the first error encountered is returned, or nil if there are no violations.
-func (m *ListProjectInvitesRequest) Validate() error {
+func (m *CreateReportRequest) Validate() error {
 	return m.validate(false)
 }
 
-// ValidateAll checks the field values on ListProjectInvitesRequest with the
-// rules defined in the proto definition for this message. If any rules are
+// ValidateAll checks the field values on CreateReportRequest with the rules
+// defined in the proto definition for this message. If any rules are
 // violated, the result is a list of violation errors wrapped in
-// ListProjectInvitesRequestMultiError, or nil if none found.
-func (m *ListProjectInvitesRequest) ValidateAll() error {
+// CreateReportRequestMultiError, or nil if none found.
+func (m *CreateReportRequest) ValidateAll() error {
 	return m.validate(true)
 }
 
-func (m *ListProjectInvitesRequest) validate(all bool) error {
+func (m *CreateReportRequest) validate(all bool) error {
 	if m == nil {
 		return nil
 	}
@@ -8526,7 +13615,7 @@ func (m *ListProjectInvitesRequest) validate(all bool) error {
 	var errors []error
 
 	if utf8.RuneCountInString(m.GetOrganization()) < 1 {
-		err := ListProjectInvitesRequestValidationError{
+		err := CreateReportRequestValidationError{
 			field:  "Organization",
 			reason: "value length must be at least 1 runes",
 		}
@@ -8537,7 +13626,7 @@ func (m *ListProjectInvitesRequest) validate(all bool) error {
 	}
 
 	if utf8.RuneCountInString(m.GetProject()) < 1 {
-		err := ListProjectInvitesRequestValidationError{
+		err := CreateReportRequestValidationError{
 			field:  "Project",
 			reason: "value length must be at least 1 runes",
 		}
@@ -8547,37 +13636,71 @@ func (m *ListProjectInvitesRequest) validate(all bool) error {
 		errors = append(errors, err)
 	}
 
-	if m.GetPageSize() != 0 {
+	if utf8.RuneCountInString(m.GetName()) < 1 {
+		err := CreateReportRequestValidationError{
+			field:  "Name",
+			reason: "value length must be at least 1 runes",
+		}
+		if !all {
+			return err
+		}
+		errors = append(errors, err)
+	}
 
-		if m.GetPageSize() > 1000 {
-			err := ListProjectInvitesRequestValidationError{
-				field:  "PageSize",
-				reason: "value must be less than or equal to 1000",
+	if m.GetOptions() == nil {
+		err := CreateReportRequestValidationError{
+			field:  "Options",
+			reason: "value is required",
+		}
+		if !all {
+			return err
+		}
+		errors = append(errors, err)
+	}
+
+	if all {
+		switch v := interface{}(m.GetOptions()).(type) {
+		case interface{ ValidateAll() error }:
+			if err := v.ValidateAll(); err != nil {
+				errors = append(errors, CreateReportRequestValidationError{
+					field:  "Options",
+					reason: "embedded message failed validation",
+					cause:  err,
+				})
 			}
-			if !all {
-				return err
+		case interface{ Validate() error }:
+			if err := v.Validate(); err != nil {
+				errors = append(errors, CreateReportRequestValidationError{
+					field:  "Options",
+					reason: "embedded message failed validation",
+					cause:  err,
+				})
+			}
+		}
+	} else if v, ok := interface{}(m.GetOptions()).(interface{ Validate() error }); ok {
+		if err := v.Validate(); err != nil {
+			return CreateReportRequestValidationError{
+				field:  "Options",
+				reason: "embedded message failed validation",
+				cause:  err,
 			}
-			errors = append(errors, err)
 		}
-
 	}
 
-	// no validation rules for PageToken
-
 	if len(errors) > 0 {
-		return ListProjectInvitesRequestMultiError(errors)
+		return CreateReportRequestMultiError(errors)
 	}
 
 	return nil
 }
 
-// ListProjectInvitesRequestMultiError is an error wrapping multiple validation
-// errors returned by ListProjectInvitesRequest.ValidateAll() if the
-// designated constraints aren't met.
-type ListProjectInvitesRequestMultiError []error
+// CreateReportRequestMultiError is an error wrapping multiple validation
+// errors returned by CreateReportRequest.ValidateAll() if the designated
+// constraints aren't met.
+type CreateReportRequestMultiError []error
 
 // Error returns a concatenation of all the error messages it wraps.
-func (m ListProjectInvitesRequestMultiError) Error() string {
+func (m CreateReportRequestMultiError) Error() string {
 	var msgs []string
 	for _, err := range m {
 		msgs = append(msgs, err.Error())
@@ -8586,11 +13709,11 @@ func (m ListProjectInvitesRequestMultiError) Error() string {
 }
 
 // AllErrors returns a list of validation violation errors.
-func (m ListProjectInvitesRequestMultiError) AllErrors() []error { return m }
+func (m CreateReportRequestMultiError) AllErrors() []error { return m }
 
-// ListProjectInvitesRequestValidationError is the validation error returned by
-// ListProjectInvitesRequest.Validate if the designated constraints aren't met.
-type ListProjectInvitesRequestValidationError struct {
+// CreateReportRequestValidationError is the validation error returned by
+// CreateReportRequest.Validate if the designated constraints aren't met.
+type CreateReportRequestValidationError struct {
 	field  string
 	reason string
 	cause  error
@@ -8598,24 +13721,24 @@ type ListProjectInvitesRequestValidationError struct {
 }
 
 // Field function returns field value.
-func (e ListProjectInvitesRequestValidationError) Field() string { return e.field }
+func (e CreateReportRequestValidationError) Field() string { return e.field }
 
 // Reason function returns reason value.
-func (e ListProjectInvitesRequestValidationError) Reason() string { return e.reason }
+func (e CreateReportRequestValidationError) Reason() string { return e.reason }
 
 // Cause function returns cause value.
-func (e ListProjectInvitesRequestValidationError) Cause() error { return e.cause }
+func (e CreateReportRequestValidationError) Cause() error { return e.cause }
 
 // Key function returns key value.
-func (e ListProjectInvitesRequestValidationError) Key() bool { return e.key }
+func (e CreateReportRequestValidationError) Key() bool { return e.key }
 
 // ErrorName returns error name.
-func (e ListProjectInvitesRequestValidationError) ErrorName() string {
-	return "ListProjectInvitesRequestValidationError"
+func (e CreateReportRequestValidationError) ErrorName() string {
+	return "CreateReportRequestValidationError"
 }
 
 // Error satisfies the builtin error interface
-func (e ListProjectInvitesRequestValidationError) Error() string {
+func (e CreateReportRequestValidationError) Error() string {
 	cause := ""
 	if e.cause != nil {
 		cause = fmt.Sprintf(" | caused by: %v", e.cause)
@@ -8627,14 +13750,14 @@ func (e ListProjectInvitesRequestValidationError) Error() string {
 	}
 
 	return fmt.Sprintf(
-		"invalid %sListProjectInvitesRequest.%s: %s%s",
+		"invalid %sCreateReportRequest.%s: %s%s",
 		key,
 		e.field,
 		e.reason,
 		cause)
 }
 
-var _ error = ListProjectInvitesRequestValidationError{}
+var _ error = CreateReportRequestValidationError{}
 
 var _ interface {
 	Field() string
@@ -8642,80 +13765,73 @@ var _ interface {
 	Key() bool
 	Cause() error
 	ErrorName() string
-} = ListProjectInvitesRequestValidationError{}
+} = CreateReportRequestValidationError{}
 
-// Validate checks the field values on ListProjectInvitesResponse with the
-// rules defined in the proto definition for this message. If any rules are
+// Validate checks the field values on CreateReportResponse with the rules
+// defined in the proto definition for this message. If any rules are
 // violated, the first error encountered is returned, or nil if there are no violations.
-func (m *ListProjectInvitesResponse) Validate() error {
+func (m *CreateReportResponse) Validate() error {
 	return m.validate(false)
 }
 
-// ValidateAll checks the field values on ListProjectInvitesResponse with the
-// rules defined in the proto definition for this message. If any rules are
+// ValidateAll checks the field values on CreateReportResponse with the rules
+// defined in the proto definition for this message. If any rules are
 // violated, the result is a list of violation errors wrapped in
-// ListProjectInvitesResponseMultiError, or nil if none found.
-func (m *ListProjectInvitesResponse) ValidateAll() error {
+// CreateReportResponseMultiError, or nil if none found.
+func (m *CreateReportResponse) ValidateAll() error {
 	return m.validate(true)
 }
 
-func (m *ListProjectInvitesResponse) validate(all bool) error {
+func (m *CreateReportResponse) validate(all bool) error {
 	if m == nil {
 		return nil
 	}
 
 	var errors []error
 
-	for idx, item := range m.GetInvites() {
-		_, _ = idx, item
-
-		if all {
-			switch v := interface{}(item).(type) {
-			case interface{ ValidateAll() error }:
-				if err := v.ValidateAll(); err != nil {
-					errors = append(errors, ListProjectInvitesResponseValidationError{
-						field:  fmt.Sprintf("Invites[%v]", idx),
-						reason: "embedded message failed validation",
-						cause:  err,
-					})
-				}
-			case interface{ Validate() error }:
-				if err := v.Validate(); err != nil {
-					errors = append(errors, ListProjectInvitesResponseValidationError{
-						field:  fmt.Sprintf("Invites[%v]", idx),
-						reason: "embedded message failed validation",
-						cause:  err,
-					})
-				}
+	if all {
+		switch v := interface{}(m.GetReport()).(type) {
+		case interface{ ValidateAll() error }:
+			if err := v.ValidateAll(); err != nil {
+				errors = append(errors, CreateReportResponseValidationError{
+					field:  "Report",
+					reason: "embedded message failed validation",
+					cause:  err,
+				})
 			}
-		} else if v, ok := interface{}(item).(interface{ Validate() error }); ok {
+		case interface{ Validate() error }:
 			if err := v.Validate(); err != nil {
-				return ListProjectInvitesResponseValidationError{
-					field:  fmt.Sprintf("Invites[%v]", idx),
+				errors = append(errors, CreateReportResponseValidationError{
+					field:  "Report",
 					reason: "embedded message failed validation",
 					cause:  err,
-				}
+				})
+			}
+		}
+	} else if v, ok := interface{}(m.GetReport()).(interface{ Validate() error }); ok {
+		if err := v.Validate(); err != nil {
+			return CreateReportResponseValidationError{
+				field:  "Report",
+				reason: "embedded message failed validation",
+				cause:  err,
 			}
 		}
-
 	}
 
-	// no validation rules for NextPageToken
-
 	if len(errors) > 0 {
-		return ListProjectInvitesResponseMultiError(errors)
+		return CreateReportResponseMultiError(errors)
 	}
 
 	return nil
 }
 
-// ListProjectInvitesResponseMultiError is an error wrapping multiple
-// validation errors returned by ListProjectInvitesResponse.ValidateAll() if
-// the designated constraints aren't met.
-type ListProjectInvitesResponseMultiError []error
+// CreateReportResponseMultiError is an error wrapping multiple validation
+// errors returned by CreateReportResponse.ValidateAll() if the designated
+// constraints aren't met.
+type CreateReportResponseMultiError []error
 
 // Error returns a concatenation of all the error messages it wraps.
-func (m ListProjectInvitesResponseMultiError) Error() string {
+func (m CreateReportResponseMultiError) Error() string {
 	var msgs []string
 	for _, err := range m {
 		msgs = append(msgs, err.Error())
@@ -8724,11 +13840,11 @@ func (m ListProjectInvitesResponseMultiError) Error() string {
 }
 
 // AllErrors returns a list of validation violation errors.
-func (m ListProjectInvitesResponseMultiError) AllErrors() []error { return m }
+func (m CreateReportResponseMultiError) AllErrors() []error { return m }
 
-// ListProjectInvitesResponseValidationError is the validation error returned
-// by ListProjectInvitesResponse.Validate if the designated constraints aren't met.
-type ListProjectInvitesResponseValidationError struct {
+// CreateReportResponseValidationError is the validation error returned by
+// CreateReportResponse.Validate if the designated constraints aren't met.
+type CreateReportResponseValidationError struct {
 	field  string
 	reason string
 	cause  error
@@ -8736,24 +13852,24 @@ type ListProjectInvitesResponseValidationError struct {
 }
 
 // Field function returns field value.
-func (e ListProjectInvitesResponseValidationError) Field() string { return e.field }
+func (e CreateReportResponseValidationError) Field() string { return e.field }
 
 // Reason function returns reason value.
-func (e ListProjectInvitesResponseValidationError) Reason() string { return e.reason }
+func (e CreateReportResponseValidationError) Reason() string { return e.reason }
 
 // Cause function returns cause value.
-func (e ListProjectInvitesResponseValidationError) Cause() error { return e.cause }
+func (e CreateReportResponseValidationError) Cause() error { return e.cause }
 
 // Key function returns key value.
-func (e ListProjectInvitesResponseValidationError) Key() bool { return e.key }
+func (e CreateReportResponseValidationError) Key() bool { return e.key }
 
 // ErrorName returns error name.
-func (e ListProjectInvitesResponseValidationError) ErrorName() string {
-	return "ListProjectInvitesResponseValidationError"
+func (e CreateReportResponseValidationError) ErrorName() string {
+	return "CreateReportResponseValidationError"
 }
 
 // Error satisfies the builtin error interface
-func (e ListProjectInvitesResponseValidationError) Error() string {
+func (e CreateReportResponseValidationError) Error() string {
 	cause := ""
 	if e.cause != nil {
 		cause = fmt.Sprintf(" | caused by: %v", e.cause)
@@ -8765,14 +13881,14 @@ func (e ListProjectInvitesResponseValidationError) Error() string {
 	}
 
 	return fmt.Sprintf(
-		"invalid %sListProjectInvitesResponse.%s: %s%s",
+		"invalid %sCreateReportResponse.%s: %s%s",
 		key,
 		e.field,
 		e.reason,
 		cause)
 }
 
-var _ error = ListProjectInvitesResponseValidationError{}
+var _ error = CreateReportResponseValidationError{}
 
 var _ interface {
 	Field() string
@@ -8780,24 +13896,24 @@ var _ interface {
 	Key() bool
 	Cause() error
 	ErrorName() string
-} = ListProjectInvitesResponseValidationError{}
+} = CreateReportResponseValidationError{}
 
-// Validate checks the field values on AddProjectMemberRequest with the rules
-// defined in the proto definition for this message. If any rules are
-// violated, the first error encountered is returned, or nil if there are no violations.
-func (m *AddProjectMemberRequest) Validate() error {
+// Validate checks the field values on EditReportRequest with the rules defined
+// in the proto definition for this message. If any rules are violated, the
+// first error encountered is returned, or nil if there are no violations.
+func (m *EditReportRequest) Validate() error {
 	return m.validate(false)
 }
 
-// ValidateAll checks the field values on AddProjectMemberRequest with the
-// rules defined in the proto definition for this message. If any rules are
+// ValidateAll checks the field values on EditReportRequest with the rules
+// defined in the proto definition for this message. If any rules are
 // violated, the result is a list of violation errors wrapped in
-// AddProjectMemberRequestMultiError, or nil if none found.
-func (m *AddProjectMemberRequest) ValidateAll() error {
+// EditReportRequestMultiError, or nil if none found.
+func (m *EditReportRequest) ValidateAll() error {
 	return m.validate(true)
 }
 
-func (m *AddProjectMemberRequest) validate(all bool) error {
+func (m *EditReportRequest) validate(all bool) error {
 	if m == nil {
 		return nil
 	}
@@ -8805,7 +13921,7 @@ func (m *AddProjectMemberRequest) validate(all bool) error {
 	var errors []error
 
 	if utf8.RuneCountInString(m.GetOrganization()) < 1 {
-		err := AddProjectMemberRequestValidationError{
+		err := EditReportRequestValidationError{
 			field:  "Organization",
 			reason: "value length must be at least 1 runes",
 		}
@@ -8816,7 +13932,7 @@ func (m *AddProjectMemberRequest) validate(all bool) error {
 	}
 
 	if utf8.RuneCountInString(m.GetProject()) < 1 {
-		err := AddProjectMemberRequestValidationError{
+		err := EditReportRequestValidationError{
 			field:  "Project",
 			reason: "value length must be at least 1 runes",
 		}
@@ -8826,11 +13942,10 @@ func (m *AddProjectMemberRequest) validate(all bool) error {
 		errors = append(errors, err)
 	}
 
-	if err := m._validateEmail(m.GetEmail()); err != nil {
-		err = AddProjectMemberRequestValidationError{
-			field:  "Email",
-			reason: "value must be a valid email address",
-			cause:  err,
+	if utf8.RuneCountInString(m.GetName()) < 1 {
+		err := EditReportRequestValidationError{
+			field:  "Name",
+			reason: "value length must be at least 1 runes",
 		}
 		if !all {
 			return err
@@ -8838,10 +13953,10 @@ func (m *AddProjectMemberRequest) validate(all bool) error {
 		errors = append(errors, err)
 	}
 
-	if utf8.RuneCountInString(m.GetRole()) < 1 {
-		err := AddProjectMemberRequestValidationError{
-			field:  "Role",
-			reason: "value length must be at least 1 runes",
+	if m.GetOptions() == nil {
+		err := EditReportRequestValidationError{
+			field:  "Options",
+			reason: "value is required",
 		}
 		if !all {
 			return err
@@ -8849,70 +13964,49 @@ func (m *AddProjectMemberRequest) validate(all bool) error {
 		errors = append(errors, err)
 	}
 
-	if len(errors) > 0 {
-		return AddProjectMemberRequestMultiError(errors)
-	}
-
-	return nil
-}
-
-func (m *AddProjectMemberRequest) _validateHostname(host string) error {
-	s := strings.ToLower(strings.TrimSuffix(host, "."))
-
-	if len(host) > 253 {
-		return errors.New("hostname cannot exceed 253 characters")
-	}
-
-	for _, part := range strings.Split(s, ".") {
-		if l := len(part); l == 0 || l > 63 {
-			return errors.New("hostname part must be non-empty and cannot exceed 63 characters")
-		}
-
-		if part[0] == '-' {
-			return errors.New("hostname parts cannot begin with hyphens")
-		}
-
-		if part[len(part)-1] == '-' {
-			return errors.New("hostname parts cannot end with hyphens")
+	if all {
+		switch v := interface{}(m.GetOptions()).(type) {
+		case interface{ ValidateAll() error }:
+			if err := v.ValidateAll(); err != nil {
+				errors = append(errors, EditReportRequestValidationError{
+					field:  "Options",
+					reason: "embedded message failed validation",
+					cause:  err,
+				})
+			}
+		case interface{ Validate() error }:
+			if err := v.Validate(); err != nil {
+				errors = append(errors, EditReportRequestValidationError{
+					field:  "Options",
+					reason: "embedded message failed validation",
+					cause:  err,
+				})
+			}
 		}
-
-		for _, r := range part {
-			if (r < 'a' || r > 'z') && (r < '0' || r > '9') && r != '-' {
-				return fmt.Errorf("hostname parts can only contain alphanumeric characters or hyphens, got %q", string(r))
+	} else if v, ok := interface{}(m.GetOptions()).(interface{ Validate() error }); ok {
+		if err := v.Validate(); err != nil {
+			return EditReportRequestValidationError{
+				field:  "Options",
+				reason: "embedded message failed validation",
+				cause:  err,
 			}
 		}
 	}
 
-	return nil
-}
-
-func (m *AddProjectMemberRequest) _validateEmail(addr string) error {
-	a, err := mail.ParseAddress(addr)
-	if err != nil {
-		return err
-	}
-	addr = a.Address
-
-	if len(addr) > 254 {
-		return errors.New("email addresses cannot exceed 254 characters")
-	}
-
-	parts := strings.SplitN(addr, "@", 2)
-
-	if len(parts[0]) > 64 {
-		return errors.New("email address local phrase cannot exceed 64 characters")
+	if len(errors) > 0 {
+		return EditReportRequestMultiError(errors)
 	}
 
-	return m._validateHostname(parts[1])
+	return nil
 }
 
-// AddProjectMemberRequestMultiError is an error wrapping multiple validation
-// errors returned by AddProjectMemberRequest.ValidateAll() if the designated
-// constraints aren't met.
-type AddProjectMemberRequestMultiError []error
+// EditReportRequestMultiError is an error wrapping multiple validation errors
+// returned by EditReportRequest.ValidateAll() if the designated constraints
+// aren't met.
+type EditReportRequestMultiError []error
 
 // Error returns a concatenation of all the error messages it wraps.
-func (m AddProjectMemberRequestMultiError) Error() string {
+func (m EditReportRequestMultiError) Error() string {
 	var msgs []string
 	for _, err := range m {
 		msgs = append(msgs, err.Error())
@@ -8921,11 +14015,11 @@ func (m AddProjectMemberRequestMultiError) Error() string {
 }
 
 // AllErrors returns a list of validation violation errors.
-func (m AddProjectMemberRequestMultiError) AllErrors() []error { return m }
+func (m EditReportRequestMultiError) AllErrors() []error { return m }
 
-// AddProjectMemberRequestValidationError is the validation error returned by
-// AddProjectMemberRequest.Validate if the designated constraints aren't met.
-type AddProjectMemberRequestValidationError struct {
+// EditReportRequestValidationError is the validation error returned by
+// EditReportRequest.Validate if the designated constraints aren't met.
+type EditReportRequestValidationError struct {
 	field  string
 	reason string
 	cause  error
@@ -8933,24 +14027,24 @@ type AddProjectMemberRequestValidationError struct {
 }
 
 // Field function returns field value.
-func (e AddProjectMemberRequestValidationError) Field() string { return e.field }
+func (e EditReportRequestValidationError) Field() string { return e.field }
 
 // Reason function returns reason value.
-func (e AddProjectMemberRequestValidationError) Reason() string { return e.reason }
+func (e EditReportRequestValidationError) Reason() string { return e.reason }
 
 // Cause function returns cause value.
-func (e AddProjectMemberRequestValidationError) Cause() error { return e.cause }
+func (e EditReportRequestValidationError) Cause() error { return e.cause }
 
 // Key function returns key value.
-func (e AddProjectMemberRequestValidationError) Key() bool { return e.key }
+func (e EditReportRequestValidationError) Key() bool { return e.key }
 
 // ErrorName returns error name.
-func (e AddProjectMemberRequestValidationError) ErrorName() string {
-	return "AddProjectMemberRequestValidationError"
+func (e EditReportRequestValidationError) ErrorName() string {
+	return "EditReportRequestValidationError"
 }
 
 // Error satisfies the builtin error interface
-func (e AddProjectMemberRequestValidationError) Error() string {
+func (e EditReportRequestValidationError) Error() string {
 	cause := ""
 	if e.cause != nil {
 		cause = fmt.Sprintf(" | caused by: %v", e.cause)
@@ -8962,14 +14056,14 @@ func (e AddProjectMemberRequestValidationError) Error() string {
 	}
 
 	return fmt.Sprintf(
-		"invalid %sAddProjectMemberRequest.%s: %s%s",
+		"invalid %sEditReportRequest.%s: %s%s",
 		key,
 		e.field,
 		e.reason,
 		cause)
 }
 
-var _ error = AddProjectMemberRequestValidationError{}
+var _ error = EditReportRequestValidationError{}
 
 var _ interface {
 	Field() string
@@ -8977,46 +14071,73 @@ var _ interface {
 	Key() bool
 	Cause() error
 	ErrorName() string
-} = AddProjectMemberRequestValidationError{}
+} = EditReportRequestValidationError{}
 
-// Validate checks the field values on AddProjectMemberResponse with the rules
+// Validate checks the field values on EditReportResponse with the rules
 // defined in the proto definition for this message. If any rules are
 // violated, the first error encountered is returned, or nil if there are no violations.
-func (m *AddProjectMemberResponse) Validate() error {
+func (m *EditReportResponse) Validate() error {
 	return m.validate(false)
 }
 
-// ValidateAll checks the field values on AddProjectMemberResponse with the
-// rules defined in the proto definition for this message. If any rules are
+// ValidateAll checks the field values on EditReportResponse with the rules
+// defined in the proto definition for this message. If any rules are
 // violated, the result is a list of violation errors wrapped in
-// AddProjectMemberResponseMultiError, or nil if none found.
-func (m *AddProjectMemberResponse) ValidateAll() error {
+// EditReportResponseMultiError, or nil if none found.
+func (m *EditReportResponse) ValidateAll() error {
 	return m.validate(true)
 }
 
-func (m *AddProjectMemberResponse) validate(all bool) error {
+func (m *EditReportResponse) validate(all bool) error {
 	if m == nil {
 		return nil
 	}
 
 	var errors []error
 
-	// no validation rules for PendingSignup
+	if all {
+		switch v := interface{}(m.GetReport()).(type) {
+		case interface{ ValidateAll() error }:
+			if err := v.ValidateAll(); err != nil {
+				errors = append(errors, EditReportResponseValidationError{
+					field:  "Report",
+					reason: "embedded message failed validation",
+					cause:  err,
+				})
+			}
+		case interface{ Validate() error }:
+			if err := v.Validate(); err != nil {
+				errors = append(errors, EditReportResponseValidationError{
+					field:  "Report",
+					reason: "embedded message failed validation",
+					cause:  err,
+				})
+			}
+		}
+	} else if v, ok := interface{}(m.GetReport()).(interface{ Validate() error }); ok {
+		if err := v.Validate(); err != nil {
+			return EditReportResponseValidationError{
+				field:  "Report",
+				reason: "embedded message failed validation",
+				cause:  err,
+			}
+		}
+	}
 
 	if len(errors) > 0 {
-		return AddProjectMemberResponseMultiError(errors)
+		return EditReportResponseMultiError(errors)
 	}
 
 	return nil
 }
 
-// AddProjectMemberResponseMultiError is an error wrapping multiple validation
-// errors returned by AddProjectMemberResponse.ValidateAll() if the designated
-// constraints aren't met.
-type AddProjectMemberResponseMultiError []error
+// EditReportResponseMultiError is an error wrapping multiple validation errors
+// returned by EditReportResponse.ValidateAll() if the designated constraints
+// aren't met.
+type EditReportResponseMultiError []error
 
 // Error returns a concatenation of all the error messages it wraps.
-func (m AddProjectMemberResponseMultiError) Error() string {
+func (m EditReportResponseMultiError) Error() string {
 	var msgs []string
 	for _, err := range m {
 		msgs = append(msgs, err.Error())
@@ -9025,11 +14146,11 @@ func (m AddProjectMemberResponseMultiError) Error() string {
 }
 
 // AllErrors returns a list of validation violation errors.
-func (m AddProjectMemberResponseMultiError) AllErrors() []error { return m }
+func (m EditReportResponseMultiError) AllErrors() []error { return m }
 
-// AddProjectMemberResponseValidationError is the validation error returned by
-// AddProjectMemberResponse.Validate if the designated constraints aren't met.
-type AddProjectMemberResponseValidationError struct {
+// EditReportResponseValidationError is the validation error returned by
+// EditReportResponse.Validate if the designated constraints aren't met.
+type EditReportResponseValidationError struct {
 	field  string
 	reason string
 	cause  error
@@ -9037,24 +14158,24 @@ type AddProjectMemberResponseValidationError struct {
 }
 
 // Field function returns field value.
-func (e AddProjectMemberResponseValidationError) Field() string { return e.field }
+func (e EditReportResponseValidationError) Field() string { return e.field }
 
 // Reason function returns reason value.
-func (e AddProjectMemberResponseValidationError) Reason() string { return e.reason }
+func (e EditReportResponseValidationError) Reason() string { return e.reason }
 
 // Cause function returns cause value.
-func (e AddProjectMemberResponseValidationError) Cause() error { return e.cause }
+func (e EditReportResponseValidationError) Cause() error { return e.cause }
 
 // Key function returns key value.
-func (e AddProjectMemberResponseValidationError) Key() bool { return e.key }
+func (e EditReportResponseValidationError) Key() bool { return e.key }
 
 // ErrorName returns error name.
-func (e AddProjectMemberResponseValidationError) ErrorName() string {
-	return "AddProjectMemberResponseValidationError"
+func (e EditReportResponseValidationError) ErrorName() string {
+	return "EditReportResponseValidationError"
 }
 
 // Error satisfies the builtin error interface
-func (e AddProjectMemberResponseValidationError) Error() string {
+func (e EditReportResponseValidationError) Error() string {
 	cause := ""
 	if e.cause != nil {
 		cause = fmt.Sprintf(" | caused by: %v", e.cause)
@@ -9066,14 +14187,14 @@ func (e AddProjectMemberResponseValidationError) Error() string {
 	}
 
 	return fmt.Sprintf(
-		"invalid %sAddProjectMemberResponse.%s: %s%s",
+		"invalid %sEditReportResponse.%s: %s%s",
 		key,
 		e.field,
 		e.reason,
 		cause)
 }
 
-var _ error = AddProjectMemberResponseValidationError{}
+var _ error = EditReportResponseValidationError{}
 
 var _ interface {
 	Field() string
@@ -9081,24 +14202,24 @@ var _ interface {
 	Key() bool
 	Cause() error
 	ErrorName() string
-} = AddProjectMemberResponseValidationError{}
+} = EditReportResponseValidationError{}
 
-// Validate checks the field values on RemoveProjectMemberRequest with the
-// rules defined in the proto definition for this message. If any rules are
+// Validate checks the field values on DeleteReportRequest with the rules
+// defined in the proto definition for this message. If any rules are
 // violated, the first error encountered is returned, or nil if there are no violations.
-func (m *RemoveProjectMemberRequest) Validate() error {
+func (m *DeleteReportRequest) Validate() error {
 	return m.validate(false)
 }
 
-// ValidateAll checks the field values on RemoveProjectMemberRequest with the
-// rules defined in the proto definition for this message. If any rules are
+// ValidateAll checks the field values on DeleteReportRequest with the rules
+// defined in the proto definition for this message. If any rules are
 // violated, the result is a list of violation errors wrapped in
-// RemoveProjectMemberRequestMultiError, or nil if none found.
-func (m *RemoveProjectMemberRequest) ValidateAll() error {
+// DeleteReportRequestMultiError, or nil if none found.
+func (m *DeleteReportRequest) ValidateAll() error {
 	return m.validate(true)
 }
 
-func (m *RemoveProjectMemberRequest) validate(all bool) error {
+func (m *DeleteReportRequest) validate(all bool) error {
 	if m == nil {
 		return nil
 	}
@@ -9106,7 +14227,7 @@ func (m *RemoveProjectMemberRequest) validate(all bool) error {
 	var errors []error
 
 	if utf8.RuneCountInString(m.GetOrganization()) < 1 {
-		err := RemoveProjectMemberRequestValidationError{
+		err := DeleteReportRequestValidationError{
 			field:  "Organization",
 			reason: "value length must be at least 1 runes",
 		}
@@ -9117,7 +14238,7 @@ func (m *RemoveProjectMemberRequest) validate(all bool) error {
 	}
 
 	if utf8.RuneCountInString(m.GetProject()) < 1 {
-		err := RemoveProjectMemberRequestValidationError{
+		err := DeleteReportRequestValidationError{
 			field:  "Project",
 			reason: "value length must be at least 1 runes",
 		}
@@ -9127,11 +14248,10 @@ func (m *RemoveProjectMemberRequest) validate(all bool) error {
 		errors = append(errors, err)
 	}
 
-	if err := m._validateEmail(m.GetEmail()); err != nil {
-		err = RemoveProjectMemberRequestValidationError{
-			field:  "Email",
-			reason: "value must be a valid email address",
-			cause:  err,
+	if utf8.RuneCountInString(m.GetName()) < 1 {
+		err := DeleteReportRequestValidationError{
+			field:  "Name",
+			reason: "value length must be at least 1 runes",
 		}
 		if !all {
 			return err
@@ -9140,69 +14260,19 @@ func (m *RemoveProjectMemberRequest) validate(all bool) error {
 	}
 
 	if len(errors) > 0 {
-		return RemoveProjectMemberRequestMultiError(errors)
-	}
-
-	return nil
-}
-
-func (m *RemoveProjectMemberRequest) _validateHostname(host string) error {
-	s := strings.ToLower(strings.TrimSuffix(host, "."))
-
-	if len(host) > 253 {
-		return errors.New("hostname cannot exceed 253 characters")
-	}
-
-	for _, part := range strings.Split(s, ".") {
-		if l := len(part); l == 0 || l > 63 {
-			return errors.New("hostname part must be non-empty and cannot exceed 63 characters")
-		}
-
-		if part[0] == '-' {
-			return errors.New("hostname parts cannot begin with hyphens")
-		}
-
-		if part[len(part)-1] == '-' {
-			return errors.New("hostname parts cannot end with hyphens")
-		}
-
-		for _, r := range part {
-			if (r < 'a' || r > 'z') && (r < '0' || r > '9') && r != '-' {
-				return fmt.Errorf("hostname parts can only contain alphanumeric characters or hyphens, got %q", string(r))
-			}
-		}
+		return DeleteReportRequestMultiError(errors)
 	}
 
 	return nil
 }
 
-func (m *RemoveProjectMemberRequest) _validateEmail(addr string) error {
-	a, err := mail.ParseAddress(addr)
-	if err != nil {
-		return err
-	}
-	addr = a.Address
-
-	if len(addr) > 254 {
-		return errors.New("email addresses cannot exceed 254 characters")
-	}
-
-	parts := strings.SplitN(addr, "@", 2)
-
-	if len(parts[0]) > 64 {
-		return errors.New("email address local phrase cannot exceed 64 characters")
-	}
-
-	return m._validateHostname(parts[1])
-}
-
-// RemoveProjectMemberRequestMultiError is an error wrapping multiple
-// validation errors returned by RemoveProjectMemberRequest.ValidateAll() if
-// the designated constraints aren't met.
-type RemoveProjectMemberRequestMultiError []error
+// DeleteReportRequestMultiError is an error wrapping multiple validation
+// errors returned by DeleteReportRequest.ValidateAll() if the designated
+// constraints aren't met.
+type DeleteReportRequestMultiError []error
 
 // Error returns a concatenation of all the error messages it wraps.
-func (m RemoveProjectMemberRequestMultiError) Error() string {
+func (m DeleteReportRequestMultiError) Error() string {
 	var msgs []string
 	for _, err := range m {
 		msgs = append(msgs, err.Error())
@@ -9211,11 +14281,11 @@ func (m RemoveProjectMemberRequestMultiError) Error() string {
 }
 
 // AllErrors returns a list of validation violation errors.
-func (m RemoveProjectMemberRequestMultiError) AllErrors() []error { return m }
+func (m DeleteReportRequestMultiError) AllErrors() []error { return m }
 
-// RemoveProjectMemberRequestValidationError is the validation error returned
-// by RemoveProjectMemberRequest.Validate if the designated constraints aren't met.
-type RemoveProjectMemberRequestValidationError struct {
+// DeleteReportRequestValidationError is the validation error returned by
+// DeleteReportRequest.Validate if the designated constraints aren't met.
+type DeleteReportRequestValidationError struct {
 	field  string
 	reason string
 	cause  error
@@ -9223,24 +14293,24 @@ type RemoveProjectMemberRequestValidationError struct {
 }
 
 // Field function returns field value.
-func (e RemoveProjectMemberRequestValidationError) Field() string { return e.field }
+func (e DeleteReportRequestValidationError) Field() string { return e.field }
 
 // Reason function returns reason value.
-func (e RemoveProjectMemberRequestValidationError) Reason() string { return e.reason }
+func (e DeleteReportRequestValidationError) Reason() string { return e.reason }
 
 // Cause function returns cause value.
-func (e RemoveProjectMemberRequestValidationError) Cause() error { return e.cause }
+func (e DeleteReportRequestValidationError) Cause() error { return e.cause }
 
 // Key function returns key value.
-func (e RemoveProjectMemberRequestValidationError) Key() bool { return e.key }
+func (e DeleteReportRequestValidationError) Key() bool { return e.key }
 
 // ErrorName returns error name.
-func (e RemoveProjectMemberRequestValidationError) ErrorName() string {
-	return "RemoveProjectMemberRequestValidationError"
+func (e DeleteReportRequestValidationError) ErrorName() string {
+	return "DeleteReportRequestValidationError"
 }
 
 // Error satisfies the builtin error interface
-func (e RemoveProjectMemberRequestValidationError) Error() string {
+func (e DeleteReportRequestValidationError) Error() string {
 	cause := ""
 	if e.cause != nil {
 		cause = fmt.Sprintf(" | caused by: %v", e.cause)
@@ -9252,14 +14322,14 @@ func (e RemoveProjectMemberRequestValidationError) Error() string {
 	}
 
 	return fmt.Sprintf(
-		"invalid %sRemoveProjectMemberRequest.%s: %s%s",
+		"invalid %sDeleteReportRequest.%s: %s%s",
 		key,
 		e.field,
 		e.reason,
 		cause)
 }
 
-var _ error = RemoveProjectMemberRequestValidationError{}
+var _ error = DeleteReportRequestValidationError{}
 
 var _ interface {
 	Field() string
@@ -9267,24 +14337,24 @@ var _ interface {
 	Key() bool
 	Cause() error
 	ErrorName() string
-} = RemoveProjectMemberRequestValidationError{}
+} = DeleteReportRequestValidationError{}
 
-// Validate checks the field values on RemoveProjectMemberResponse with the
-// rules defined in the proto definition for this message. If any rules are
+// Validate checks the field values on DeleteReportResponse with the rules
+// defined in the proto definition for this message. If any rules are
 // violated, the first error encountered is returned, or nil if there are no violations.
-func (m *RemoveProjectMemberResponse) Validate() error {
+func (m *DeleteReportResponse) Validate() error {
 	return m.validate(false)
 }
 
-// ValidateAll checks the field values on RemoveProjectMemberResponse with the
-// rules defined in the proto definition for this message. If any rules are
+// ValidateAll checks the field values on DeleteReportResponse with the rules
+// defined in the proto definition for this message. If any rules are
 // violated, the result is a list of violation errors wrapped in
-// RemoveProjectMemberResponseMultiError, or nil if none found.
-func (m *RemoveProjectMemberResponse) ValidateAll() error {
+// DeleteReportResponseMultiError, or nil if none found.
+func (m *DeleteReportResponse) ValidateAll() error {
 	return m.validate(true)
 }
 
-func (m *RemoveProjectMemberResponse) validate(all bool) error {
+func (m *DeleteReportResponse) validate(all bool) error {
 	if m == nil {
 		return nil
 	}
@@ -9292,19 +14362,19 @@ func (m *RemoveProjectMemberResponse) validate(all bool) error {
 	var errors []error
 
 	if len(errors) > 0 {
-		return RemoveProjectMemberResponseMultiError(errors)
+		return DeleteReportResponseMultiError(errors)
 	}
 
 	return nil
 }
 
-// RemoveProjectMemberResponseMultiError is an error wrapping multiple
-// validation errors returned by RemoveProjectMemberResponse.ValidateAll() if
-// the designated constraints aren't met.
-type RemoveProjectMemberResponseMultiError []error
+// DeleteReportResponseMultiError is an error wrapping multiple validation
+// errors returned by DeleteReportResponse.ValidateAll() if the designated
+// constraints aren't met.
+type DeleteReportResponseMultiError []error
 
 // Error returns a concatenation of all the error messages it wraps.
-func (m RemoveProjectMemberResponseMultiError) Error() string {
+func (m DeleteReportResponseMultiError) Error() string {
 	var msgs []string
 	for _, err := range m {
 		msgs = append(msgs, err.Error())
@@ -9313,12 +14383,11 @@ func (m RemoveProjectMemberResponseMultiError) Error() string {
 }
 
 // AllErrors returns a list of validation violation errors.
-func (m RemoveProjectMemberResponseMultiError) AllErrors() []error { return m }
+func (m DeleteReportResponseMultiError) AllErrors() []error { return m }
 
-// RemoveProjectMemberResponseValidationError is the validation error returned
-// by RemoveProjectMemberResponse.Validate if the designated constraints
-// aren't met.
-type RemoveProjectMemberResponseValidationError struct {
+// DeleteReportResponseValidationError is the validation error returned by
+// DeleteReportResponse.Validate if the designated constraints aren't met.
+type DeleteReportResponseValidationError struct {
 	field  string
 	reason string
 	cause  error
@@ -9326,24 +14395,24 @@ type RemoveProjectMemberResponseValidationError struct {
 }
 
 // Field function returns field value.
-func (e RemoveProjectMemberResponseValidationError) Field() string { return e.field }
+func (e DeleteReportResponseValidationError) Field() string { return e.field }
 
 // Reason function returns reason value.
-func (e RemoveProjectMemberResponseValidationError) Reason() string { return e.reason }
+func (e DeleteReportResponseValidationError) Reason() string { return e.reason }
 
 // Cause function returns cause value.
-func (e RemoveProjectMemberResponseValidationError) Cause() error { return e.cause }
+func (e DeleteReportResponseValidationError) Cause() error { return e.cause }
 
 // Key function returns key value.
-func (e RemoveProjectMemberResponseValidationError) Key() bool { return e.key }
+func (e DeleteReportResponseValidationError) Key() bool { return e.key }
 
 // ErrorName returns error name.
-func (e RemoveProjectMemberResponseValidationError) ErrorName() string {
-	return "RemoveProjectMemberResponseValidationError"
+func (e DeleteReportResponseValidationError) ErrorName() string {
+	return "DeleteReportResponseValidationError"
 }
 
 // Error satisfies the builtin error interface
-func (e RemoveProjectMemberResponseValidationError) Error() string {
+func (e DeleteReportResponseValidationError) Error() string {
 	cause := ""
 	if e.cause != nil {
 		cause = fmt.Sprintf(" | caused by: %v", e.cause)
@@ -9355,14 +14424,14 @@ func (e RemoveProjectMemberResponseValidationError) Error() string {
 	}
 
 	return fmt.Sprintf(
-		"invalid %sRemoveProjectMemberResponse.%s: %s%s",
+		"invalid %sDeleteReportResponse.%s: %s%s",
 		key,
 		e.field,
 		e.reason,
 		cause)
 }
 
-var _ error = RemoveProjectMemberResponseValidationError{}
+var _ error = DeleteReportResponseValidationError{}
 
 var _ interface {
 	Field() string
@@ -9370,24 +14439,24 @@ var _ interface {
 	Key() bool
 	Cause() error
 	ErrorName() string
-} = RemoveProjectMemberResponseValidationError{}
+} = DeleteReportResponseValidationError{}
 
-// Validate checks the field values on SetProjectMemberRoleRequest with the
-// rules defined in the proto definition for this message. If any rules are
-// violated, the first error encountered is returned, or nil if there are no violations.
-func (m *SetProjectMemberRoleRequest) Validate() error {
+// Validate checks the field values on ListAlertsRequest with the rules defined
+// in the proto definition for this message. If any rules are violated, the
+// first error encountered is returned, or nil if there are no violations.
+func (m *ListAlertsRequest) Validate() error {
 	return m.validate(false)
 }
 
-// ValidateAll checks the field values on SetProjectMemberRoleRequest with the
-// rules defined in the proto definition for this message. If any rules are
+// ValidateAll checks the field values on ListAlertsRequest with the rules
+// defined in the proto definition for this message. If any rules are
 // violated, the result is a list of violation errors wrapped in
-// SetProjectMemberRoleRequestMultiError, or nil if none found.
-func (m *SetProjectMemberRoleRequest) ValidateAll() error {
+// ListAlertsRequestMultiError, or nil if none found.
+func (m *ListAlertsRequest) ValidateAll() error {
 	return m.validate(true)
 }
 
-func (m *SetProjectMemberRoleRequest) validate(all bool) error {
+func (m *ListAlertsRequest) validate(all bool) error {
 	if m == nil {
 		return nil
 	}
@@ -9395,7 +14464,7 @@ func (m *SetProjectMemberRoleRequest) validate(all bool) error {
 	var errors []error
 
 	if utf8.RuneCountInString(m.GetOrganization()) < 1 {
-		err := SetProjectMemberRoleRequestValidationError{
+		err := ListAlertsRequestValidationError{
 			field:  "Organization",
 			reason: "value length must be at least 1 runes",
 		}
@@ -9406,7 +14475,7 @@ func (m *SetProjectMemberRoleRequest) validate(all bool) error {
 	}
 
 	if utf8.RuneCountInString(m.GetProject()) < 1 {
-		err := SetProjectMemberRoleRequestValidationError{
+		err := ListAlertsRequestValidationError{
 			field:  "Project",
 			reason: "value length must be at least 1 runes",
 		}
@@ -9416,93 +14485,156 @@ func (m *SetProjectMemberRoleRequest) validate(all bool) error {
 		errors = append(errors, err)
 	}
 
-	if err := m._validateEmail(m.GetEmail()); err != nil {
-		err = SetProjectMemberRoleRequestValidationError{
-			field:  "Email",
-			reason: "value must be a valid email address",
-			cause:  err,
-		}
-		if !all {
-			return err
-		}
-		errors = append(errors, err)
+	if len(errors) > 0 {
+		return ListAlertsRequestMultiError(errors)
 	}
 
-	if utf8.RuneCountInString(m.GetRole()) < 1 {
-		err := SetProjectMemberRoleRequestValidationError{
-			field:  "Role",
-			reason: "value length must be at least 1 runes",
-		}
-		if !all {
-			return err
-		}
-		errors = append(errors, err)
+	return nil
+}
+
+// ListAlertsRequestMultiError is an error wrapping multiple validation errors
+// returned by ListAlertsRequest.ValidateAll() if the designated constraints
+// aren't met.
+type ListAlertsRequestMultiError []error
+
+// Error returns a concatenation of all the error messages it wraps.
+func (m ListAlertsRequestMultiError) Error() string {
+	var msgs []string
+	for _, err := range m {
+		msgs = append(msgs, err.Error())
+	}
+	return strings.Join(msgs, "; ")
+}
+
+// AllErrors returns a list of validation violation errors.
+func (m ListAlertsRequestMultiError) AllErrors() []error { return m }
+
+// ListAlertsRequestValidationError is the validation error returned by
+// ListAlertsRequest.Validate if the designated constraints aren't met.
+type ListAlertsRequestValidationError struct {
+	field  string
+	reason string
+	cause  error
+	key    bool
+}
+
+// Field function returns field value.
+func (e ListAlertsRequestValidationError) Field() string { return e.field }
+
+// Reason function returns reason value.
+func (e ListAlertsRequestValidationError) Reason() string { return e.reason }
+
+// Cause function returns cause value.
+func (e ListAlertsRequestValidationError) Cause() error { return e.cause }
+
+// Key function returns key value.
+func (e ListAlertsRequestValidationError) Key() bool { return e.key }
+
+// ErrorName returns error name.
+func (e ListAlertsRequestValidationError) ErrorName() string {
+	return "ListAlertsRequestValidationError"
+}
+
+// Error satisfies the builtin error interface
+func (e ListAlertsRequestValidationError) Error() string {
+	cause := ""
+	if e.cause != nil {
+		cause = fmt.Sprintf(" | caused by: %v", e.cause)
+	}
+
+	key := ""
+	if e.key {
+		key = "key for "
 	}
 
-	if len(errors) > 0 {
-		return SetProjectMemberRoleRequestMultiError(errors)
-	}
+	return fmt.Sprintf(
+		"invalid %sListAlertsRequest.%s: %s%s",
+		key,
+		e.field,
+		e.reason,
+		cause)
+}
+
+var _ error = ListAlertsRequestValidationError{}
 
-	return nil
+var _ interface {
+	Field() string
+	Reason() string
+	Key() bool
+	Cause() error
+	ErrorName() string
+} = ListAlertsRequestValidationError{}
+
+// Validate checks the field values on ListAlertsResponse with the rules
+// defined in the proto definition for this message. If any rules are
+// violated, the first error encountered is returned, or nil if there are no violations.
+func (m *ListAlertsResponse) Validate() error {
+	return m.validate(false)
 }
 
-func (m *SetProjectMemberRoleRequest) _validateHostname(host string) error {
-	s := strings.ToLower(strings.TrimSuffix(host, "."))
+// ValidateAll checks the field values on ListAlertsResponse with the rules
+// defined in the proto definition for this message. If any rules are
+// violated, the result is a list of violation errors wrapped in
+// ListAlertsResponseMultiError, or nil if none found.
+func (m *ListAlertsResponse) ValidateAll() error {
+	return m.validate(true)
+}
 
-	if len(host) > 253 {
-		return errors.New("hostname cannot exceed 253 characters")
+func (m *ListAlertsResponse) validate(all bool) error {
+	if m == nil {
+		return nil
 	}
 
-	for _, part := range strings.Split(s, ".") {
-		if l := len(part); l == 0 || l > 63 {
-			return errors.New("hostname part must be non-empty and cannot exceed 63 characters")
-		}
-
-		if part[0] == '-' {
-			return errors.New("hostname parts cannot begin with hyphens")
-		}
+	var errors []error
 
-		if part[len(part)-1] == '-' {
-			return errors.New("hostname parts cannot end with hyphens")
-		}
+	for idx, item := range m.GetAlerts() {
+		_, _ = idx, item
 
-		for _, r := range part {
-			if (r < 'a' || r > 'z') && (r < '0' || r > '9') && r != '-' {
-				return fmt.Errorf("hostname parts can only contain alphanumeric characters or hyphens, got %q", string(r))
+		if all {
+			switch v := interface{}(item).(type) {
+			case interface{ ValidateAll() error }:
+				if err := v.ValidateAll(); err != nil {
+					errors = append(errors, ListAlertsResponseValidationError{
+						field:  fmt.Sprintf("Alerts[%v]", idx),
+						reason: "embedded message failed validation",
+						cause:  err,
+					})
+				}
+			case interface{ Validate() error }:
+				if err := v.Validate(); err != nil {
+					errors = append(errors, ListAlertsResponseValidationError{
+						field:  fmt.Sprintf("Alerts[%v]", idx),
+						reason: "embedded message failed validation",
+						cause:  err,
+					})
+				}
+			}
+		} else if v, ok := interface{}(item).(interface{ Validate() error }); ok {
+			if err := v.Validate(); err != nil {
+				return ListAlertsResponseValidationError{
+					field:  fmt.Sprintf("Alerts[%v]", idx),
+					reason: "embedded message failed validation",
+					cause:  err,
+				}
 			}
 		}
-	}
-
-	return nil
-}
-
-func (m *SetProjectMemberRoleRequest) _validateEmail(addr string) error {
-	a, err := mail.ParseAddress(addr)
-	if err != nil {
-		return err
-	}
-	addr = a.Address
 
-	if len(addr) > 254 {
-		return errors.New("email addresses cannot exceed 254 characters")
 	}
 
-	parts := strings.SplitN(addr, "@", 2)
-
-	if len(parts[0]) > 64 {
-		return errors.New("email address local phrase cannot exceed 64 characters")
+	if len(errors) > 0 {
+		return ListAlertsResponseMultiError(errors)
 	}
 
-	return m._validateHostname(parts[1])
+	return nil
 }
 
-// SetProjectMemberRoleRequestMultiError is an error wrapping multiple
-// validation errors returned by SetProjectMemberRoleRequest.ValidateAll() if
-// the designated constraints aren't met.
-type SetProjectMemberRoleRequestMultiError []error
+// ListAlertsResponseMultiError is an error wrapping multiple validation errors
+// returned by ListAlertsResponse.ValidateAll() if the designated constraints
+// aren't met.
+type ListAlertsResponseMultiError []error
 
 // Error returns a concatenation of all the error messages it wraps.
-func (m SetProjectMemberRoleRequestMultiError) Error() string {
+func (m ListAlertsResponseMultiError) Error() string {
 	var msgs []string
 	for _, err := range m {
 		msgs = append(msgs, err.Error())
@@ -9511,12 +14643,11 @@ func (m SetProjectMemberRoleRequestMultiError) Error() string {
 }
 
 // AllErrors returns a list of validation violation errors.
-func (m SetProjectMemberRoleRequestMultiError) AllErrors() []error { return m }
+func (m ListAlertsResponseMultiError) AllErrors() []error { return m }
 
-// SetProjectMemberRoleRequestValidationError is the validation error returned
-// by SetProjectMemberRoleRequest.Validate if the designated constraints
-// aren't met.
-type SetProjectMemberRoleRequestValidationError struct {
+// ListAlertsResponseValidationError is the validation error returned by
+// ListAlertsResponse.Validate if the designated constraints aren't met.
+type ListAlertsResponseValidationError struct {
 	field  string
 	reason string
 	cause  error
@@ -9524,24 +14655,24 @@ type SetProjectMemberRoleRequestValidationError struct {
 }
 
 // Field function returns field value.
-func (e SetProjectMemberRoleRequestValidationError) Field() string { return e.field }
+func (e ListAlertsResponseValidationError) Field() string { return e.field }
 
 // Reason function returns reason value.
-func (e SetProjectMemberRoleRequestValidationError) Reason() string { return e.reason }
+func (e ListAlertsResponseValidationError) Reason() string { return e.reason }
 
 // Cause function returns cause value.
-func (e SetProjectMemberRoleRequestValidationError) Cause() error { return e.cause }
+func (e ListAlertsResponseValidationError) Cause() error { return e.cause }
 
 // Key function returns key value.
-func (e SetProjectMemberRoleRequestValidationError) Key() bool { return e.key }
+func (e ListAlertsResponseValidationError) Key() bool { return e.key }
 
 // ErrorName returns error name.
-func (e SetProjectMemberRoleRequestValidationError) ErrorName() string {
-	return "SetProjectMemberRoleRequestValidationError"
+func (e ListAlertsResponseValidationError) ErrorName() string {
+	return "ListAlertsResponseValidationError"
 }
 
 // Error satisfies the builtin error interface
-func (e SetProjectMemberRoleRequestValidationError) Error() string {
+func (e ListAlertsResponseValidationError) Error() string {
 	cause := ""
 	if e.cause != nil {
 		cause = fmt.Sprintf(" | caused by: %v", e.cause)
@@ -9553,14 +14684,14 @@ func (e SetProjectMemberRoleRequestValidationError) Error() string {
 	}
 
 	return fmt.Sprintf(
-		"invalid %sSetProjectMemberRoleRequest.%s: %s%s",
+		"invalid %sListAlertsResponse.%s: %s%s",
 		key,
 		e.field,
 		e.reason,
 		cause)
 }
 
-var _ error = SetProjectMemberRoleRequestValidationError{}
+var _ error = ListAlertsResponseValidationError{}
 
 var _ interface {
 	Field() string
@@ -9568,44 +14699,77 @@ var _ interface {
 	Key() bool
 	Cause() error
 	ErrorName() string
-} = SetProjectMemberRoleRequestValidationError{}
+} = ListAlertsResponseValidationError{}
 
-// Validate checks the field values on SetProjectMemberRoleResponse with the
-// rules defined in the proto definition for this message. If any rules are
-// violated, the first error encountered is returned, or nil if there are no violations.
-func (m *SetProjectMemberRoleResponse) Validate() error {
+// Validate checks the field values on GetAlertRequest with the rules defined
+// in the proto definition for this message. If any rules are violated, the
+// first error encountered is returned, or nil if there are no violations.
+func (m *GetAlertRequest) Validate() error {
 	return m.validate(false)
 }
 
-// ValidateAll checks the field values on SetProjectMemberRoleResponse with the
-// rules defined in the proto definition for this message. If any rules are
+// ValidateAll checks the field values on GetAlertRequest with the rules
+// defined in the proto definition for this message. If any rules are
 // violated, the result is a list of violation errors wrapped in
-// SetProjectMemberRoleResponseMultiError, or nil if none found.
-func (m *SetProjectMemberRoleResponse) ValidateAll() error {
+// GetAlertRequestMultiError, or nil if none found.
+func (m *GetAlertRequest) ValidateAll() error {
 	return m.validate(true)
 }
 
-func (m *SetProjectMemberRoleResponse) validate(all bool) error {
+func (m *GetAlertRequest) validate(all bool) error {
 	if m == nil {
 		return nil
 	}
 
 	var errors []error
 
+	if utf8.RuneCountInString(m.GetOrganization()) < 1 {
+		err := GetAlertRequestValidationError{
+			field:  "Organization",
+			reason: "value length must be at least 1 runes",
+		}
+		if !all {
+			return err
+		}
+		errors = append(errors, err)
+	}
+
+	if utf8.RuneCountInString(m.GetProject()) < 1 {
+		err := GetAlertRequestValidationError{
+			field:  "Project",
+			reason: "value length must be at least 1 runes",
+		}
+		if !all {
+			return err
+		}
+		errors = append(errors, err)
+	}
+
+	if utf8.RuneCountInString(m.GetName()) < 1 {
+		err := GetAlertRequestValidationError{
+			field:  "Name",
+			reason: "value length must be at least 1 runes",
+		}
+		if !all {
+			return err
+		}
+		errors = append(errors, err)
+	}
+
 	if len(errors) > 0 {
-		return SetProjectMemberRoleResponseMultiError(errors)
+		return GetAlertRequestMultiError(errors)
 	}
 
 	return nil
 }
 
-// SetProjectMemberRoleResponseMultiError is an error wrapping multiple
-// validation errors returned by SetProjectMemberRoleResponse.ValidateAll() if
-// the designated constraints aren't met.
-type SetProjectMemberRoleResponseMultiError []error
+// GetAlertRequestMultiError is an error wrapping multiple validation errors
+// returned by GetAlertRequest.ValidateAll() if the designated constraints
+// aren't met.
+type GetAlertRequestMultiError []error
 
 // Error returns a concatenation of all the error messages it wraps.
-func (m SetProjectMemberRoleResponseMultiError) Error() string {
+func (m GetAlertRequestMultiError) Error() string {
 	var msgs []string
 	for _, err := range m {
 		msgs = append(msgs, err.Error())
@@ -9614,12 +14778,11 @@ func (m SetProjectMemberRoleResponseMultiError) Error() string {
 }
 
 // AllErrors returns a list of validation violation errors.
-func (m SetProjectMemberRoleResponseMultiError) AllErrors() []error { return m }
+func (m GetAlertRequestMultiError) AllErrors() []error { return m }
 
-// SetProjectMemberRoleResponseValidationError is the validation error returned
-// by SetProjectMemberRoleResponse.Validate if the designated constraints
-// aren't met.
-type SetProjectMemberRoleResponseValidationError struct {
+// GetAlertRequestValidationError is the validation error returned by
+// GetAlertRequest.Validate if the designated constraints aren't met.
+type GetAlertRequestValidationError struct {
 	field  string
 	reason string
 	cause  error
@@ -9627,24 +14790,22 @@ type SetProjectMemberRoleResponseValidationError struct {
 }
 
 // Field function returns field value.
-func (e SetProjectMemberRoleResponseValidationError) Field() string { return e.field }
+func (e GetAlertRequestValidationError) Field() string { return e.field }
 
 // Reason function returns reason value.
-func (e SetProjectMemberRoleResponseValidationError) Reason() string { return e.reason }
+func (e GetAlertRequestValidationError) Reason() string { return e.reason }
 
 // Cause function returns cause value.
-func (e SetProjectMemberRoleResponseValidationError) Cause() error { return e.cause }
+func (e GetAlertRequestValidationError) Cause() error { return e.cause }
 
 // Key function returns key value.
-func (e SetProjectMemberRoleResponseValidationError) Key() bool { return e.key }
+func (e GetAlertRequestValidationError) Key() bool { return e.key }
 
 // ErrorName returns error name.
-func (e SetProjectMemberRoleResponseValidationError) ErrorName() string {
-	return "SetProjectMemberRoleResponseValidationError"
-}
+func (e GetAlertRequestValidationError) ErrorName() string { return "GetAlertRequestValidationError" }
 
 // Error satisfies the builtin error interface
-func (e SetProjectMemberRoleResponseValidationError) Error() string {
+func (e GetAlertRequestValidationError) Error() string {
 	cause := ""
 	if e.cause != nil {
 		cause = fmt.Sprintf(" | caused by: %v", e.cause)
@@ -9656,14 +14817,14 @@ func (e SetProjectMemberRoleResponseValidationError) Error() string {
 	}
 
 	return fmt.Sprintf(
-		"invalid %sSetProjectMemberRoleResponse.%s: %s%s",
+		"invalid %sGetAlertRequest.%s: %s%s",
 		key,
 		e.field,
 		e.reason,
 		cause)
 }
 
-var _ error = SetProjectMemberRoleResponseValidationError{}
+var _ error = GetAlertRequestValidationError{}
 
 var _ interface {
 	Field() string
@@ -9671,44 +14832,73 @@ var _ interface {
 	Key() bool
 	Cause() error
 	ErrorName() string
-} = SetProjectMemberRoleResponseValidationError{}
+} = GetAlertRequestValidationError{}
 
-// Validate checks the field values on GetCurrentUserRequest with the rules
-// defined in the proto definition for this message. If any rules are
-// violated, the first error encountered is returned, or nil if there are no violations.
-func (m *GetCurrentUserRequest) Validate() error {
+// Validate checks the field values on GetAlertResponse with the rules defined
+// in the proto definition for this message. If any rules are violated, the
+// first error encountered is returned, or nil if there are no violations.
+func (m *GetAlertResponse) Validate() error {
 	return m.validate(false)
 }
 
-// ValidateAll checks the field values on GetCurrentUserRequest with the rules
+// ValidateAll checks the field values on GetAlertResponse with the rules
 // defined in the proto definition for this message. If any rules are
 // violated, the result is a list of violation errors wrapped in
-// GetCurrentUserRequestMultiError, or nil if none found.
-func (m *GetCurrentUserRequest) ValidateAll() error {
+// GetAlertResponseMultiError, or nil if none found.
+func (m *GetAlertResponse) ValidateAll() error {
 	return m.validate(true)
 }
 
-func (m *GetCurrentUserRequest) validate(all bool) error {
+func (m *GetAlertResponse) validate(all bool) error {
 	if m == nil {
 		return nil
 	}
 
 	var errors []error
 
+	if all {
+		switch v := interface{}(m.GetAlert()).(type) {
+		case interface{ ValidateAll() error }:
+			if err := v.ValidateAll(); err != nil {
+				errors = append(errors, GetAlertResponseValidationError{
+					field:  "Alert",
+					reason: "embedded message failed validation",
+					cause:  err,
+				})
+			}
+		case interface{ Validate() error }:
+			if err := v.Validate(); err != nil {
+				errors = append(errors, GetAlertResponseValidationError{
+					field:  "Alert",
+					reason: "embedded message failed validation",
+					cause:  err,
+				})
+			}
+		}
+	} else if v, ok := interface{}(m.GetAlert()).(interface{ Validate() error }); ok {
+		if err := v.Validate(); err != nil {
+			return GetAlertResponseValidationError{
+				field:  "Alert",
+				reason: "embedded message failed validation",
+				cause:  err,
+			}
+		}
+	}
+
 	if len(errors) > 0 {
-		return GetCurrentUserRequestMultiError(errors)
+		return GetAlertResponseMultiError(errors)
 	}
 
 	return nil
 }
 
-// GetCurrentUserRequestMultiError is an error wrapping multiple validation
-// errors returned by GetCurrentUserRequest.ValidateAll() if the designated
-// constraints aren't met.
-type GetCurrentUserRequestMultiError []error
+// GetAlertResponseMultiError is an error wrapping multiple validation errors
+// returned by GetAlertResponse.ValidateAll() if the designated constraints
+// aren't met.
+type GetAlertResponseMultiError []error
 
 // Error returns a concatenation of all the error messages it wraps.
-func (m GetCurrentUserRequestMultiError) Error() string {
+func (m GetAlertResponseMultiError) Error() string {
 	var msgs []string
 	for _, err := range m {
 		msgs = append(msgs, err.Error())
@@ -9717,11 +14907,11 @@ func (m GetCurrentUserRequestMultiError) Error() string {
 }
 
 // AllErrors returns a list of validation violation errors.
-func (m GetCurrentUserRequestMultiError) AllErrors() []error { return m }
+func (m GetAlertResponseMultiError) AllErrors() []error { return m }
 
-// GetCurrentUserRequestValidationError is the validation error returned by
-// GetCurrentUserRequest.Validate if the designated constraints aren't met.
-type GetCurrentUserRequestValidationError struct {
+// GetAlertResponseValidationError is the validation error returned by
+// GetAlertResponse.Validate if the designated constraints aren't met.
+type GetAlertResponseValidationError struct {
 	field  string
 	reason string
 	cause  error
@@ -9729,24 +14919,22 @@ type GetCurrentUserRequestValidationError struct {
 }
 
 // Field function returns field value.
-func (e GetCurrentUserRequestValidationError) Field() string { return e.field }
+func (e GetAlertResponseValidationError) Field() string { return e.field }
 
 // Reason function returns reason value.
-func (e GetCurrentUserRequestValidationError) Reason() string { return e.reason }
+func (e GetAlertResponseValidationError) Reason() string { return e.reason }
 
 // Cause function returns cause value.
-func (e GetCurrentUserRequestValidationError) Cause() error { return e.cause }
+func (e GetAlertResponseValidationError) Cause() error { return e.cause }
 
 // Key function returns key value.
-func (e GetCurrentUserRequestValidationError) Key() bool { return e.key }
+func (e GetAlertResponseValidationError) Key() bool { return e.key }
 
 // ErrorName returns error name.
-func (e GetCurrentUserRequestValidationError) ErrorName() string {
-	return "GetCurrentUserRequestValidationError"
-}
+func (e GetAlertResponseValidationError) ErrorName() string { return "GetAlertResponseValidationError" }
 
 // Error satisfies the builtin error interface
-func (e GetCurrentUserRequestValidationError) Error() string {
+func (e GetAlertResponseValidationError) Error() string {
 	cause := ""
 	if e.cause != nil {
 		cause = fmt.Sprintf(" | caused by: %v", e.cause)
@@ -9758,14 +14946,14 @@ func (e GetCurrentUserRequestValidationError) Error() string {
 	}
 
 	return fmt.Sprintf(
-		"invalid %sGetCurrentUserRequest.%s: %s%s",
+		"invalid %sGetAlertResponse.%s: %s%s",
 		key,
 		e.field,
 		e.reason,
 		cause)
 }
 
-var _ error = GetCurrentUserRequestValidationError{}
+var _ error = GetAlertResponseValidationError{}
 
 var _ interface {
 	Field() string
@@ -9773,82 +14961,97 @@ var _ interface {
 	Key() bool
 	Cause() error
 	ErrorName() string
-} = GetCurrentUserRequestValidationError{}
+} = GetAlertResponseValidationError{}
 
-// Validate checks the field values on GetCurrentUserResponse with the rules
+// Validate checks the field values on CreateAlertRequest with the rules
 // defined in the proto definition for this message. If any rules are
 // violated, the first error encountered is returned, or nil if there are no violations.
-func (m *GetCurrentUserResponse) Validate() error {
+func (m *CreateAlertRequest) Validate() error {
 	return m.validate(false)
 }
 
-// ValidateAll checks the field values on GetCurrentUserResponse with the rules
+// ValidateAll checks the field values on CreateAlertRequest with the rules
 // defined in the proto definition for this message. If any rules are
 // violated, the result is a list of violation errors wrapped in
-// GetCurrentUserResponseMultiError, or nil if none found.
-func (m *GetCurrentUserResponse) ValidateAll() error {
+// CreateAlertRequestMultiError, or nil if none found.
+func (m *CreateAlertRequest) ValidateAll() error {
 	return m.validate(true)
 }
 
-func (m *GetCurrentUserResponse) validate(all bool) error {
+func (m *CreateAlertRequest) validate(all bool) error {
 	if m == nil {
 		return nil
 	}
 
 	var errors []error
 
-	if all {
-		switch v := interface{}(m.GetUser()).(type) {
-		case interface{ ValidateAll() error }:
-			if err := v.ValidateAll(); err != nil {
-				errors = append(errors, GetCurrentUserResponseValidationError{
-					field:  "User",
-					reason: "embedded message failed validation",
-					cause:  err,
-				})
-			}
-		case interface{ Validate() error }:
-			if err := v.Validate(); err != nil {
-				errors = append(errors, GetCurrentUserResponseValidationError{
-					field:  "User",
-					reason: "embedded message failed validation",
-					cause:  err,
-				})
-			}
+	if utf8.RuneCountInString(m.GetOrganization()) < 1 {
+		err := CreateAlertRequestValidationError{
+			field:  "Organization",
+			reason: "value length must be at least 1 runes",
+		}
+		if !all {
+			return err
+		}
+		errors = append(errors, err)
+	}
+
+	if utf8.RuneCountInString(m.GetProject()) < 1 {
+		err := CreateAlertRequestValidationError{
+			field:  "Project",
+			reason: "value length must be at least 1 runes",
+		}
+		if !all {
+			return err
+		}
+		errors = append(errors, err)
+	}
+
+	if utf8.RuneCountInString(m.GetName()) < 1 {
+		err := CreateAlertRequestValidationError{
+			field:  "Name",
+			reason: "value length must be at least 1 runes",
+		}
+		if !all {
+			return err
+		}
+		errors = append(errors, err)
+	}
+
+	if m.GetOptions() == nil {
+		err := CreateAlertRequestValidationError{
+			field:  "Options",
+			reason: "value is required",
 		}
-	} else if v, ok := interface{}(m.GetUser()).(interface{ Validate() error }); ok {
-		if err := v.Validate(); err != nil {
-			return GetCurrentUserResponseValidationError{
-				field:  "User",
-				reason: "embedded message failed validation",
-				cause:  err,
-			}
+		if !all {
+			return err
 		}
+		errors = append(errors, err)
 	}
 
 	if all {
-		switch v := interface{}(m.GetPreferences()).(type) {
+		switch v := interface{}(m.GetOptions()).(type) {
 		case interface{ ValidateAll() error }:
 			if err := v.ValidateAll(); err != nil {
-				errors = append(errors, GetCurrentUserResponseValidationError{
-					field:  "Preferences",
+				errors = append(errors, CreateAlertRequestValidationError{
+					field:  "Options",
 					reason: "embedded message failed validation",
 					cause:  err,
 				})
 			}
 		case interface{ Validate() error }:
 			if err := v.Validate(); err != nil {
-				errors = append(errors, GetCurrentUserResponseValidationError{
-					field:  "Preferences",
+				errors = append(errors, CreateAlertRequestValidationError{
+					field:  "Options",
 					reason: "embedded message failed validation",
 					cause:  err,
 				})
 			}
 		}
-	} else if v, ok := interface{}(m.GetPreferences()).(interface{ Validate() error }); ok {
+	} else if v, ok := interface{}(m.GetOptions()).(interface{ Validate() error }); ok {
 		if err := v.Validate(); err != nil {
-			return GetCurrentUserResponseValidationError{
-				field:  "Preferences",
+			return CreateAlertRequestValidationError{
+				field:  "Options",
 				reason: "embedded message failed validation",
 				cause:  err,
 			}
@@ -9856,19 +15059,19 @@ func (m *GetCurrentUserResponse) validate(all bool) error {
 	}
 
 	if len(errors) > 0 {
-		return GetCurrentUserResponseMultiError(errors)
+		return CreateAlertRequestMultiError(errors)
 	}
 
 	return nil
 }
 
-// GetCurrentUserResponseMultiError is an error wrapping multiple validation
-// errors returned by GetCurrentUserResponse.ValidateAll() if the designated
-// constraints aren't met.
-type GetCurrentUserResponseMultiError []error
+// CreateAlertRequestMultiError is an error wrapping multiple validation errors
+// returned by CreateAlertRequest.ValidateAll() if the designated constraints
+// aren't met.
+type CreateAlertRequestMultiError []error
 
 // Error returns a concatenation of all the error messages it wraps.
-func (m GetCurrentUserResponseMultiError) Error() string {
+func (m CreateAlertRequestMultiError) Error() string {
 	var msgs []string
 	for _, err := range m {
 		msgs = append(msgs, err.Error())
@@ -9877,11 +15080,11 @@ func (m GetCurrentUserResponseMultiError) Error() string {
 }
 
 // AllErrors returns a list of validation violation errors.
-func (m GetCurrentUserResponseMultiError) AllErrors() []error { return m }
+func (m CreateAlertRequestMultiError) AllErrors() []error { return m }
 
-// GetCurrentUserResponseValidationError is the validation error returned by
-// GetCurrentUserResponse.Validate if the designated constraints aren't met.
-type GetCurrentUserResponseValidationError struct {
+// CreateAlertRequestValidationError is the validation error returned by
+// CreateAlertRequest.Validate if the designated constraints aren't met.
+type CreateAlertRequestValidationError struct {
 	field  string
 	reason string
 	cause  error
@@ -9889,24 +15092,24 @@ type GetCurrentUserResponseValidationError struct {
 }
 
 // Field function returns field value.
-func (e GetCurrentUserResponseValidationError) Field() string { return e.field }
+func (e CreateAlertRequestValidationError) Field() string { return e.field }
 
 // Reason function returns reason value.
-func (e GetCurrentUserResponseValidationError) Reason() string { return e.reason }
+func (e CreateAlertRequestValidationError) Reason() string { return e.reason }
 
 // Cause function returns cause value.
-func (e GetCurrentUserResponseValidationError) Cause() error { return e.cause }
+func (e CreateAlertRequestValidationError) Cause() error { return e.cause }
 
 // Key function returns key value.
-func (e GetCurrentUserResponseValidationError) Key() bool { return e.key }
+func (e CreateAlertRequestValidationError) Key() bool { return e.key }
 
 // ErrorName returns error name.
-func (e GetCurrentUserResponseValidationError) ErrorName() string {
-	return "GetCurrentUserResponseValidationError"
+func (e CreateAlertRequestValidationError) ErrorName() string {
+	return "CreateAlertRequestValidationError"
 }
 
 // Error satisfies the builtin error interface
-func (e GetCurrentUserResponseValidationError) Error() string {
+func (e CreateAlertRequestValidationError) Error() string {
 	cause := ""
 	if e.cause != nil {
 		cause = fmt.Sprintf(" | caused by: %v", e.cause)
@@ -9918,14 +15121,14 @@ func (e GetCurrentUserResponseValidationError) Error() string {
 	}
 
 	return fmt.Sprintf(
-		"invalid %sGetCurrentUserResponse.%s: %s%s",
+		"invalid %sCreateAlertRequest.%s: %s%s",
 		key,
 		e.field,
 		e.reason,
 		cause)
 }
 
-var _ error = GetCurrentUserResponseValidationError{}
+var _ error = CreateAlertRequestValidationError{}
 
 var _ interface {
 	Field() string
@@ -9933,106 +15136,73 @@ var _ interface {
 	Key() bool
 	Cause() error
 	ErrorName() string
-} = GetCurrentUserResponseValidationError{}
+} = CreateAlertRequestValidationError{}
 
-// Validate checks the field values on GetUserRequest with the rules defined in
-// the proto definition for this message. If any rules are violated, the first
-// error encountered is returned, or nil if there are no violations.
-func (m *GetUserRequest) Validate() error {
+// Validate checks the field values on CreateAlertResponse with the rules
+// defined in the proto definition for this message. If any rules are
+// violated, the first error encountered is returned, or nil if there are no violations.
+func (m *CreateAlertResponse) Validate() error {
 	return m.validate(false)
 }
 
-// ValidateAll checks the field values on GetUserRequest with the rules defined
-// in the proto definition for this message. If any rules are violated, the
-// result is a list of violation errors wrapped in GetUserRequestMultiError,
-// or nil if none found.
-func (m *GetUserRequest) ValidateAll() error {
+// ValidateAll checks the field values on CreateAlertResponse with the rules
+// defined in the proto definition for this message. If any rules are
+// violated, the result is a list of violation errors wrapped in
+// CreateAlertResponseMultiError, or nil if none found.
+func (m *CreateAlertResponse) ValidateAll() error {
 	return m.validate(true)
 }
 
-func (m *GetUserRequest) validate(all bool) error {
+func (m *CreateAlertResponse) validate(all bool) error {
 	if m == nil {
 		return nil
 	}
 
 	var errors []error
 
-	if err := m._validateEmail(m.GetEmail()); err != nil {
-		err = GetUserRequestValidationError{
-			field:  "Email",
-			reason: "value must be a valid email address",
-			cause:  err,
+	if all {
+		switch v := interface{}(m.GetAlert()).(type) {
+		case interface{ ValidateAll() error }:
+			if err := v.ValidateAll(); err != nil {
+				errors = append(errors, CreateAlertResponseValidationError{
+					field:  "Alert",
+					reason: "embedded message failed validation",
+					cause:  err,
+				})
+			}
+		case interface{ Validate() error }:
+			if err := v.Validate(); err != nil {
+				errors = append(errors, CreateAlertResponseValidationError{
+					field:  "Alert",
+					reason: "embedded message failed validation",
+					cause:  err,
+				})
+			}
 		}
-		if !all {
-			return err
+	} else if v, ok := interface{}(m.GetAlert()).(interface{ Validate() error }); ok {
+		if err := v.Validate(); err != nil {
+			return CreateAlertResponseValidationError{
+				field:  "Alert",
+				reason: "embedded message failed validation",
+				cause:  err,
+			}
 		}
-		errors = append(errors, err)
 	}
 
 	if len(errors) > 0 {
-		return GetUserRequestMultiError(errors)
-	}
-
-	return nil
-}
-
-func (m *GetUserRequest) _validateHostname(host string) error {
-	s := strings.ToLower(strings.TrimSuffix(host, "."))
-
-	if len(host) > 253 {
-		return errors.New("hostname cannot exceed 253 characters")
-	}
-
-	for _, part := range strings.Split(s, ".") {
-		if l := len(part); l == 0 || l > 63 {
-			return errors.New("hostname part must be non-empty and cannot exceed 63 characters")
-		}
-
-		if part[0] == '-' {
-			return errors.New("hostname parts cannot begin with hyphens")
-		}
-
-		if part[len(part)-1] == '-' {
-			return errors.New("hostname parts cannot end with hyphens")
-		}
-
-		for _, r := range part {
-			if (r < 'a' || r > 'z') && (r < '0' || r > '9') && r != '-' {
-				return fmt.Errorf("hostname parts can only contain alphanumeric characters or hyphens, got %q", string(r))
-			}
-		}
+		return CreateAlertResponseMultiError(errors)
 	}
 
 	return nil
 }
 
-func (m *GetUserRequest) _validateEmail(addr string) error {
-	a, err := mail.ParseAddress(addr)
-	if err != nil {
-		return err
-	}
-	addr = a.Address
-
-	if len(addr) > 254 {
-		return errors.New("email addresses cannot exceed 254 characters")
-	}
-
-	parts := strings.SplitN(addr, "@", 2)
-
-	if len(parts[0]) > 64 {
-		return errors.New("email address local phrase cannot exceed 64 characters")
-	}
-
-	return m._validateHostname(parts[1])
-}
-
-// GetUserRequestMultiError is an error wrapping multiple validation errors
-// returned by GetUserRequest.ValidateAll() if the designated constraints
-// aren't met.
-type GetUserRequestMultiError []error
+// CreateAlertResponseMultiError is an error wrapping multiple validation
+// errors returned by CreateAlertResponse.ValidateAll() if the designated
+// constraints aren't met.
+type CreateAlertResponseMultiError []error
 
 // Error returns a concatenation of all the error messages it wraps.
-func (m GetUserRequestMultiError) Error() string {
+func (m CreateAlertResponseMultiError) Error() string {
 	var msgs []string
 	for _, err := range m {
 		msgs = append(msgs, err.Error())
@@ -10041,11 +15211,11 @@ func (m GetUserRequestMultiError) Error() string {
 }
 
 // AllErrors returns a list of validation violation errors.
-func (m GetUserRequestMultiError) AllErrors() []error { return m }
+func (m CreateAlertResponseMultiError) AllErrors() []error { return m }
 
-// GetUserRequestValidationError is the validation error returned by
-// GetUserRequest.Validate if the designated constraints aren't met.
-type GetUserRequestValidationError struct {
+// CreateAlertResponseValidationError is the validation error returned by
+// CreateAlertResponse.Validate if the designated constraints aren't met.
+type CreateAlertResponseValidationError struct {
 	field  string
 	reason string
 	cause  error
@@ -10053,22 +15223,24 @@ type GetUserRequestValidationError struct {
 }
 
 // Field function returns field value.
-func (e GetUserRequestValidationError) Field() string { return e.field }
+func (e CreateAlertResponseValidationError) Field() string { return e.field }
 
 // Reason function returns reason value.
-func (e GetUserRequestValidationError) Reason() string { return e.reason }
+func (e CreateAlertResponseValidationError) Reason() string { return e.reason }
 
 // Cause function returns cause value.
-func (e GetUserRequestValidationError) Cause() error { return e.cause }
+func (e CreateAlertResponseValidationError) Cause() error { return e.cause }
 
 // Key function returns key value.
-func (e GetUserRequestValidationError) Key() bool { return e.key }
+func (e CreateAlertResponseValidationError) Key() bool { return e.key }
 
 // ErrorName returns error name.
-func (e GetUserRequestValidationError) ErrorName() string { return "GetUserRequestValidationError" }
+func (e CreateAlertResponseValidationError) ErrorName() string {
+	return "CreateAlertResponseValidationError"
+}
 
 // Error satisfies the builtin error interface
-func (e GetUserRequestValidationError) Error() string {
+func (e CreateAlertResponseValidationError) Error() string {
 	cause := ""
 	if e.cause != nil {
 		cause = fmt.Sprintf(" | caused by: %v", e.cause)
@@ -10080,14 +15252,14 @@ func (e GetUserRequestValidationError) Error() string {
 	}
 
 	return fmt.Sprintf(
-		"invalid %sGetUserRequest.%s: %s%s",
+		"invalid %sCreateAlertResponse.%s: %s%s",
 		key,
 		e.field,
 		e.reason,
 		cause)
 }
 
-var _ error = GetUserRequestValidationError{}
+var _ error = CreateAlertResponseValidationError{}
 
 var _ interface {
 	Field() string
@@ -10095,53 +15267,97 @@ var _ interface {
 	Key() bool
 	Cause() error
 	ErrorName() string
-} = GetUserRequestValidationError{}
+} = CreateAlertResponseValidationError{}
 
-// Validate checks the field values on GetUserResponse with the rules defined
+// Validate checks the field values on EditAlertRequest with the rules defined
 // in the proto definition for this message. If any rules are violated, the
 // first error encountered is returned, or nil if there are no violations.
-func (m *GetUserResponse) Validate() error {
+func (m *EditAlertRequest) Validate() error {
 	return m.validate(false)
 }
 
-// ValidateAll checks the field values on GetUserResponse with the rules
+// ValidateAll checks the field values on EditAlertRequest with the rules
 // defined in the proto definition for this message. If any rules are
 // violated, the result is a list of violation errors wrapped in
-// GetUserResponseMultiError, or nil if none found.
-func (m *GetUserResponse) ValidateAll() error {
+// EditAlertRequestMultiError, or nil if none found.
+func (m *EditAlertRequest) ValidateAll() error {
 	return m.validate(true)
 }
 
-func (m *GetUserResponse) validate(all bool) error {
+func (m *EditAlertRequest) validate(all bool) error {
 	if m == nil {
 		return nil
 	}
 
 	var errors []error
 
+	if utf8.RuneCountInString(m.GetOrganization()) < 1 {
+		err := EditAlertRequestValidationError{
+			field:  "Organization",
+			reason: "value length must be at least 1 runes",
+		}
+		if !all {
+			return err
+		}
+		errors = append(errors, err)
+	}
+
+	if utf8.RuneCountInString(m.GetProject()) < 1 {
+		err := EditAlertRequestValidationError{
+			field:  "Project",
+			reason: "value length must be at least 1 runes",
+		}
+		if !all {
+			return err
+		}
+		errors = append(errors, err)
+	}
+
+	if utf8.RuneCountInString(m.GetName()) < 1 {
+		err := EditAlertRequestValidationError{
+			field:  "Name",
+			reason: "value length must be at least 1 runes",
+		}
+		if !all {
+			return err
+		}
+		errors = append(errors, err)
+	}
+
+	if m.GetOptions() == nil {
+		err := EditAlertRequestValidationError{
+			field:  "Options",
+			reason: "value is required",
+		}
+		if !all {
+			return err
+		}
+		errors = append(errors, err)
+	}
+
 	if all {
-		switch v := interface{}(m.GetUser()).(type) {
+		switch v := interface{}(m.GetOptions()).(type) {
 		case interface{ ValidateAll() error }:
 			if err := v.ValidateAll(); err != nil {
-				errors = append(errors, GetUserResponseValidationError{
-					field:  "User",
+				errors = append(errors, EditAlertRequestValidationError{
+					field:  "Options",
 					reason: "embedded message failed validation",
 					cause:  err,
 				})
 			}
 		case interface{ Validate() error }:
 			if err := v.Validate(); err != nil {
-				errors = append(errors, GetUserResponseValidationError{
-					field:  "User",
+				errors = append(errors, EditAlertRequestValidationError{
+					field:  "Options",
 					reason: "embedded message failed validation",
 					cause:  err,
 				})
 			}
 		}
-	} else if v, ok := interface{}(m.GetUser()).(interface{ Validate() error }); ok {
+	} else if v, ok := interface{}(m.GetOptions()).(interface{ Validate() error }); ok {
 		if err := v.Validate(); err != nil {
-			return GetUserResponseValidationError{
-				field:  "User",
+			return EditAlertRequestValidationError{
+				field:  "Options",
 				reason: "embedded message failed validation",
 				cause:  err,
 			}
@@ -10149,19 +15365,19 @@ func (m *GetUserResponse) validate(all bool) error {
 	}
 
 	if len(errors) > 0 {
-		return GetUserResponseMultiError(errors)
+		return EditAlertRequestMultiError(errors)
 	}
 
 	return nil
 }
 
-// GetUserResponseMultiError is an error wrapping multiple validation errors
-// returned by GetUserResponse.ValidateAll() if the designated constraints
+// EditAlertRequestMultiError is an error wrapping multiple validation errors
+// returned by EditAlertRequest.ValidateAll() if the designated constraints
 // aren't met.
-type GetUserResponseMultiError []error
+type EditAlertRequestMultiError []error
 
 // Error returns a concatenation of all the error messages it wraps.
-func (m GetUserResponseMultiError) Error() string {
+func (m EditAlertRequestMultiError) Error() string {
 	var msgs []string
 	for _, err := range m {
 		msgs = append(msgs, err.Error())
@@ -10170,11 +15386,11 @@ func (m GetUserResponseMultiError) Error() string {
 }
 
 // AllErrors returns a list of validation violation errors.
-func (m GetUserResponseMultiError) AllErrors() []error { return m }
+func (m EditAlertRequestMultiError) AllErrors() []error { return m }
 
-// GetUserResponseValidationError is the validation error returned by
-// GetUserResponse.Validate if the designated constraints aren't met.
-type GetUserResponseValidationError struct {
+// EditAlertRequestValidationError is the validation error returned by
+// EditAlertRequest.Validate if the designated constraints aren't met.
+type EditAlertRequestValidationError struct {
 	field  string
 	reason string
 	cause  error
@@ -10182,22 +15398,22 @@ type GetUserResponseValidationError struct {
 }
 
 // Field function returns field value.
-func (e GetUserResponseValidationError) Field() string { return e.field }
+func (e EditAlertRequestValidationError) Field() string { return e.field }
 
 // Reason function returns reason value.
-func (e GetUserResponseValidationError) Reason() string { return e.reason }
+func (e EditAlertRequestValidationError) Reason() string { return e.reason }
 
 // Cause function returns cause value.
-func (e GetUserResponseValidationError) Cause() error { return e.cause }
+func (e EditAlertRequestValidationError) Cause() error { return e.cause }
 
 // Key function returns key value.
-func (e GetUserResponseValidationError) Key() bool { return e.key }
+func (e EditAlertRequestValidationError) Key() bool { return e.key }
 
 // ErrorName returns error name.
-func (e GetUserResponseValidationError) ErrorName() string { return "GetUserResponseValidationError" }
+func (e EditAlertRequestValidationError) ErrorName() string { return "EditAlertRequestValidationError" }
 
 // Error satisfies the builtin error interface
-func (e GetUserResponseValidationError) Error() string {
+func (e EditAlertRequestValidationError) Error() string {
 	cause := ""
 	if e.cause != nil {
 		cause = fmt.Sprintf(" | caused by: %v", e.cause)
@@ -10209,14 +15425,14 @@ func (e GetUserResponseValidationError) Error() string {
 	}
 
 	return fmt.Sprintf(
-		"invalid %sGetUserResponse.%s: %s%s",
+		"invalid %sEditAlertRequest.%s: %s%s",
 		key,
 		e.field,
 		e.reason,
 		cause)
 }
 
-var _ error = GetUserResponseValidationError{}
+var _ error = EditAlertRequestValidationError{}
 
 var _ interface {
 	Field() string
@@ -10224,48 +15440,73 @@ var _ interface {
 	Key() bool
 	Cause() error
 	ErrorName() string
-} = GetUserResponseValidationError{}
+} = EditAlertRequestValidationError{}
 
-// Validate checks the field values on UserPreferences with the rules defined
+// Validate checks the field values on EditAlertResponse with the rules defined
 // in the proto definition for this message. If any rules are violated, the
 // first error encountered is returned, or nil if there are no violations.
-func (m *UserPreferences) Validate() error {
+func (m *EditAlertResponse) Validate() error {
 	return m.validate(false)
 }
 
-// ValidateAll checks the field values on UserPreferences with the rules
+// ValidateAll checks the field values on EditAlertResponse with the rules
 // defined in the proto definition for this message. If any rules are
 // violated, the result is a list of violation errors wrapped in
-// UserPreferencesMultiError, or nil if none found.
-func (m *UserPreferences) ValidateAll() error {
+// EditAlertResponseMultiError, or nil if none found.
+func (m *EditAlertResponse) ValidateAll() error {
 	return m.validate(true)
 }
 
-func (m *UserPreferences) validate(all bool) error {
+func (m *EditAlertResponse) validate(all bool) error {
 	if m == nil {
 		return nil
 	}
 
 	var errors []error
 
-	if m.TimeZone != nil {
-		// no validation rules for TimeZone
+	if all {
+		switch v := interface{}(m.GetAlert()).(type) {
+		case interface{ ValidateAll() error }:
+			if err := v.ValidateAll(); err != nil {
+				errors = append(errors, EditAlertResponseValidationError{
+					field:  "Alert",
+					reason: "embedded message failed validation",
+					cause:  err,
+				})
+			}
+		case interface{ Validate() error }:
+			if err := v.Validate(); err != nil {
+				errors = append(errors, EditAlertResponseValidationError{
+					field:  "Alert",
+					reason: "embedded message failed validation",
+					cause:  err,
+				})
+			}
+		}
+	} else if v, ok := interface{}(m.GetAlert()).(interface{ Validate() error }); ok {
+		if err := v.Validate(); err != nil {
+			return EditAlertResponseValidationError{
+				field:  "Alert",
+				reason: "embedded message failed validation",
+				cause:  err,
+			}
+		}
 	}
 
 	if len(errors) > 0 {
-		return UserPreferencesMultiError(errors)
+		return EditAlertResponseMultiError(errors)
 	}
 
 	return nil
 }
 
-// UserPreferencesMultiError is an error wrapping multiple validation errors
-// returned by UserPreferences.ValidateAll() if the designated constraints
+// EditAlertResponseMultiError is an error wrapping multiple validation errors
+// returned by EditAlertResponse.ValidateAll() if the designated constraints
 // aren't met.
-type UserPreferencesMultiError []error
+type EditAlertResponseMultiError []error
 
 // Error returns a concatenation of all the error messages it wraps.
-func (m UserPreferencesMultiError) Error() string {
+func (m EditAlertResponseMultiError) Error() string {
 	var msgs []string
 	for _, err := range m {
 		msgs = append(msgs, err.Error())
@@ -10274,11 +15515,11 @@ func (m UserPreferencesMultiError) Error() string {
 }
 
 // AllErrors returns a list of validation violation errors.
-func (m UserPreferencesMultiError) AllErrors() []error { return m }
+func (m EditAlertResponseMultiError) AllErrors() []error { return m }
 
-// UserPreferencesValidationError is the validation error returned by
-// UserPreferences.Validate if the designated constraints aren't met.
-type UserPreferencesValidationError struct {
+// EditAlertResponseValidationError is the validation error returned by
+// EditAlertResponse.Validate if the designated constraints aren't met.
+type EditAlertResponseValidationError struct {
 	field  string
 	reason string
 	cause  error
@@ -10286,22 +15527,24 @@ type UserPreferencesValidationError struct {
 }
 
 // Field function returns field value.
-func (e UserPreferencesValidationError) Field() string { return e.field }
+func (e EditAlertResponseValidationError) Field() string { return e.field }
 
 // Reason function returns reason value.
-func (e UserPreferencesValidationError) Reason() string { return e.reason }
+func (e EditAlertResponseValidationError) Reason() string { return e.reason }
 
 // Cause function returns cause value.
-func (e UserPreferencesValidationError) Cause() error { return e.cause }
+func (e EditAlertResponseValidationError) Cause() error { return e.cause }
 
 // Key function returns key value.
-func (e UserPreferencesValidationError) Key() bool { return e.key }
+func (e EditAlertResponseValidationError) Key() bool { return e.key }
 
 // ErrorName returns error name.
-func (e UserPreferencesValidationError) ErrorName() string { return "UserPreferencesValidationError" }
+func (e EditAlertResponseValidationError) ErrorName() string {
+	return "EditAlertResponseValidationError"
+}
 
 // Error satisfies the builtin error interface
-func (e UserPreferencesValidationError) Error() string {
+func (e EditAlertResponseValidationError) Error() string {
 	cause := ""
 	if e.cause != nil {
 		cause = fmt.Sprintf(" | caused by: %v", e.cause)
@@ -10313,14 +15556,14 @@ func (e UserPreferencesValidationError) Error() string {
 	}
 
 	return fmt.Sprintf(
-		"invalid %sUserPreferences.%s: %s%s",
+		"invalid %sEditAlertResponse.%s: %s%s",
 		key,
 		e.field,
 		e.reason,
 		cause)
 }
 
-var _ error = UserPreferencesValidationError{}
+var _ error = EditAlertResponseValidationError{}
 
 var _ interface {
 	Field() string
@@ -10328,73 +15571,77 @@ var _ interface {
 	Key() bool
 	Cause() error
 	ErrorName() string
-} = UserPreferencesValidationError{}
+} = EditAlertResponseValidationError{}
 
-// Validate checks the field values on UpdateUserPreferencesRequest with the
-// rules defined in the proto definition for this message. If any rules are
+// Validate checks the field values on DeleteAlertRequest with the rules
+// defined in the proto definition for this message. If any rules are
 // violated, the first error encountered is returned, or nil if there are no violations.
-func (m *UpdateUserPreferencesRequest) Validate() error {
+func (m *DeleteAlertRequest) Validate() error {
 	return m.validate(false)
 }
 
-// ValidateAll checks the field values on UpdateUserPreferencesRequest with the
-// rules defined in the proto definition for this message. If any rules are
+// ValidateAll checks the field values on DeleteAlertRequest with the rules
+// defined in the proto definition for this message. If any rules are
 // violated, the result is a list of violation errors wrapped in
-// UpdateUserPreferencesRequestMultiError, or nil if none found.
-func (m *UpdateUserPreferencesRequest) ValidateAll() error {
+// DeleteAlertRequestMultiError, or nil if none found.
+func (m *DeleteAlertRequest) ValidateAll() error {
 	return m.validate(true)
 }
 
-func (m *UpdateUserPreferencesRequest) validate(all bool) error {
+func (m *DeleteAlertRequest) validate(all bool) error {
 	if m == nil {
 		return nil
 	}
 
 	var errors []error
 
-	if all {
-		switch v := interface{}(m.GetPreferences()).(type) {
-		case interface{ ValidateAll() error }:
-			if err := v.ValidateAll(); err != nil {
-				errors = append(errors, UpdateUserPreferencesRequestValidationError{
-					field:  "Preferences",
-					reason: "embedded message failed validation",
-					cause:  err,
-				})
-			}
-		case interface{ Validate() error }:
-			if err := v.Validate(); err != nil {
-				errors = append(errors, UpdateUserPreferencesRequestValidationError{
-					field:  "Preferences",
-					reason: "embedded message failed validation",
-					cause:  err,
-				})
-			}
+	if utf8.RuneCountInString(m.GetOrganization()) < 1 {
+		err := DeleteAlertRequestValidationError{
+			field:  "Organization",
+			reason: "value length must be at least 1 runes",
 		}
-	} else if v, ok := interface{}(m.GetPreferences()).(interface{ Validate() error }); ok {
-		if err := v.Validate(); err != nil {
-			return UpdateUserPreferencesRequestValidationError{
-				field:  "Preferences",
-				reason: "embedded message failed validation",
-				cause:  err,
-			}
+		if !all {
+			return err
+		}
+		errors = append(errors, err)
+	}
+
+	if utf8.RuneCountInString(m.GetProject()) < 1 {
+		err := DeleteAlertRequestValidationError{
+			field:  "Project",
+			reason: "value length must be at least 1 runes",
+		}
+		if !all {
+			return err
+		}
+		errors = append(errors, err)
+	}
+
+	if utf8.RuneCountInString(m.GetName()) < 1 {
+		err := DeleteAlertRequestValidationError{
+			field:  "Name",
+			reason: "value length must be at least 1 runes",
 		}
+		if !all {
+			return err
+		}
+		errors = append(errors, err)
 	}
 
 	if len(errors) > 0 {
-		return UpdateUserPreferencesRequestMultiError(errors)
+		return DeleteAlertRequestMultiError(errors)
 	}
 
 	return nil
 }
 
-// UpdateUserPreferencesRequestMultiError is an error wrapping multiple
-// validation errors returned by UpdateUserPreferencesRequest.ValidateAll() if
-// the designated constraints aren't met.
-type UpdateUserPreferencesRequestMultiError []error
+// DeleteAlertRequestMultiError is an error wrapping multiple validation errors
+// returned by DeleteAlertRequest.ValidateAll() if the designated constraints
+// aren't met.
+type DeleteAlertRequestMultiError []error
 
 // Error returns a concatenation of all the error messages it wraps.
-func (m UpdateUserPreferencesRequestMultiError) Error() string {
+func (m DeleteAlertRequestMultiError) Error() string {
 	var msgs []string
 	for _, err := range m {
 		msgs = append(msgs, err.Error())
@@ -10403,12 +15650,11 @@ func (m UpdateUserPreferencesRequestMultiError) Error() string {
 }
 
 // AllErrors returns a list of validation violation errors.
-func (m UpdateUserPreferencesRequestMultiError) AllErrors() []error { return m }
+func (m DeleteAlertRequestMultiError) AllErrors() []error { return m }
 
-// UpdateUserPreferencesRequestValidationError is the validation error returned
-// by UpdateUserPreferencesRequest.Validate if the designated constraints
-// aren't met.
-type UpdateUserPreferencesRequestValidationError struct {
+// DeleteAlertRequestValidationError is the validation error returned by
+// DeleteAlertRequest.Validate if the designated constraints aren't met.
+type DeleteAlertRequestValidationError struct {
 	field  string
 	reason string
 	cause  error
@@ -10416,24 +15662,24 @@ type UpdateUserPreferencesRequestValidationError struct {
 }
 
 // Field function returns field value.
-func (e UpdateUserPreferencesRequestValidationError) Field() string { return e.field }
+func (e DeleteAlertRequestValidationError) Field() string { return e.field }
 
 // Reason function returns reason value.
-func (e UpdateUserPreferencesRequestValidationError) Reason() string { return e.reason }
+func (e DeleteAlertRequestValidationError) Reason() string { return e.reason }
 
 // Cause function returns cause value.
-func (e UpdateUserPreferencesRequestValidationError) Cause() error { return e.cause }
+func (e DeleteAlertRequestValidationError) Cause() error { return e.cause }
 
 // Key function returns key value.
-func (e UpdateUserPreferencesRequestValidationError) Key() bool { return e.key }
+func (e DeleteAlertRequestValidationError) Key() bool { return e.key }
 
 // ErrorName returns error name.
-func (e UpdateUserPreferencesRequestValidationError) ErrorName() string {
-	return "UpdateUserPreferencesRequestValidationError"
+func (e DeleteAlertRequestValidationError) ErrorName() string {
+	return "DeleteAlertRequestValidationError"
 }
 
 // Error satisfies the builtin error interface
-func (e UpdateUserPreferencesRequestValidationError) Error() string {
+func (e DeleteAlertRequestValidationError) Error() string {
 	cause := ""
 	if e.cause != nil {
 		cause = fmt.Sprintf(" | caused by: %v", e.cause)
@@ -10445,14 +15691,14 @@ func (e UpdateUserPreferencesRequestValidationError) Error() string {
 	}
 
 	return fmt.Sprintf(
-		"invalid %sUpdateUserPreferencesRequest.%s: %s%s",
+		"invalid %sDeleteAlertRequest.%s: %s%s",
 		key,
 		e.field,
 		e.reason,
 		cause)
 }
 
-var _ error = UpdateUserPreferencesRequestValidationError{}
+var _ error = DeleteAlertRequestValidationError{}
 
 var _ interface {
 	Field() string
@@ -10460,73 +15706,44 @@ var _ interface {
 	Key() bool
 	Cause() error
 	ErrorName() string
-} = UpdateUserPreferencesRequestValidationError{}
+} = DeleteAlertRequestValidationError{}
 
-// Validate checks the field values on UpdateUserPreferencesResponse with the
-// rules defined in the proto definition for this message. If any rules are
+// Validate checks the field values on DeleteAlertResponse with the rules
+// defined in the proto definition for this message. If any rules are
 // violated, the first error encountered is returned, or nil if there are no violations.
-func (m *UpdateUserPreferencesResponse) Validate() error {
+func (m *DeleteAlertResponse) Validate() error {
 	return m.validate(false)
 }
 
-// ValidateAll checks the field values on UpdateUserPreferencesResponse with
-// the rules defined in the proto definition for this message. If any rules
-// are violated, the result is a list of violation errors wrapped in
-// UpdateUserPreferencesResponseMultiError, or nil if none found.
-func (m *UpdateUserPreferencesResponse) ValidateAll() error {
+// ValidateAll checks the field values on DeleteAlertResponse with the rules
+// defined in the proto definition for this message. If any rules are
+// violated, the result is a list of violation errors wrapped in
+// DeleteAlertResponseMultiError, or nil if none found.
+func (m *DeleteAlertResponse) ValidateAll() error {
 	return m.validate(true)
 }
 
-func (m *UpdateUserPreferencesResponse) validate(all bool) error {
+func (m *DeleteAlertResponse) validate(all bool) error {
 	if m == nil {
 		return nil
 	}
 
 	var errors []error
 
-	if all {
-		switch v := interface{}(m.GetPreferences()).(type) {
-		case interface{ ValidateAll() error }:
-			if err := v.ValidateAll(); err != nil {
-				errors = append(errors, UpdateUserPreferencesResponseValidationError{
-					field:  "Preferences",
-					reason: "embedded message failed validation",
-					cause:  err,
-				})
-			}
-		case interface{ Validate() error }:
-			if err := v.Validate(); err != nil {
-				errors = append(errors, UpdateUserPreferencesResponseValidationError{
-					field:  "Preferences",
-					reason: "embedded message failed validation",
-					cause:  err,
-				})
-			}
-		}
-	} else if v, ok := interface{}(m.GetPreferences()).(interface{ Validate() error }); ok {
-		if err := v.Validate(); err != nil {
-			return UpdateUserPreferencesResponseValidationError{
-				field:  "Preferences",
-				reason: "embedded message failed validation",
-				cause:  err,
-			}
-		}
-	}
-
 	if len(errors) > 0 {
-		return UpdateUserPreferencesResponseMultiError(errors)
+		return DeleteAlertResponseMultiError(errors)
 	}
 
 	return nil
 }
 
-// UpdateUserPreferencesResponseMultiError is an error wrapping multiple
-// validation errors returned by UpdateUserPreferencesResponse.ValidateAll()
-// if the designated constraints aren't met.
-type UpdateUserPreferencesResponseMultiError []error
+// DeleteAlertResponseMultiError is an error wrapping multiple validation
+// errors returned by DeleteAlertResponse.ValidateAll() if the designated
+// constraints aren't met.
+type DeleteAlertResponseMultiError []error
 
 // Error returns a concatenation of all the error messages it wraps.
-func (m UpdateUserPreferencesResponseMultiError) Error() string {
+func (m DeleteAlertResponseMultiError) Error() string {
 	var msgs []string
 	for _, err := range m {
 		msgs = append(msgs, err.Error())
@@ -10535,12 +15752,11 @@ func (m UpdateUserPreferencesResponseMultiError) Error() string {
 }
 
 // AllErrors returns a list of validation violation errors.
-func (m UpdateUserPreferencesResponseMultiError) AllErrors() []error { return m }
+func (m DeleteAlertResponseMultiError) AllErrors() []error { return m }
 
-// UpdateUserPreferencesResponseValidationError is the validation error
-// returned by UpdateUserPreferencesResponse.Validate if the designated
-// constraints aren't met.
-type UpdateUserPreferencesResponseValidationError struct {
+// DeleteAlertResponseValidationError is the validation error returned by
+// DeleteAlertResponse.Validate if the designated constraints aren't met.
+type DeleteAlertResponseValidationError struct {
 	field  string
 	reason string
 	cause  error
@@ -10548,24 +15764,24 @@ type UpdateUserPreferencesResponseValidationError struct {
 }
 
 // Field function returns field value.
-func (e UpdateUserPreferencesResponseValidationError) Field() string { return e.field }
+func (e DeleteAlertResponseValidationError) Field() string { return e.field }
 
 // Reason function returns reason value.
-func (e UpdateUserPreferencesResponseValidationError) Reason() string { return e.reason }
+func (e DeleteAlertResponseValidationError) Reason() string { return e.reason }
 
 // Cause function returns cause value.
-func (e UpdateUserPreferencesResponseValidationError) Cause() error { return e.cause }
+func (e DeleteAlertResponseValidationError) Cause() error { return e.cause }
 
 // Key function returns key value.
-func (e UpdateUserPreferencesResponseValidationError) Key() bool { return e.key }
+func (e DeleteAlertResponseValidationError) Key() bool { return e.key }
 
 // ErrorName returns error name.
-func (e UpdateUserPreferencesResponseValidationError) ErrorName() string {
-	return "UpdateUserPreferencesResponseValidationError"
+func (e DeleteAlertResponseValidationError) ErrorName() string {
+	return "DeleteAlertResponseValidationError"
 }
 
 // Error satisfies the builtin error interface
-func (e UpdateUserPreferencesResponseValidationError) Error() string {
+func (e DeleteAlertResponseValidationError) Error() string {
 	cause := ""
 	if e.cause != nil {
 		cause = fmt.Sprintf(" | caused by: %v", e.cause)
@@ -10577,14 +15793,14 @@ func (e UpdateUserPreferencesResponseValidationError) Error() string {
 	}
 
 	return fmt.Sprintf(
-		"invalid %sUpdateUserPreferencesResponse.%s: %s%s",
+		"invalid %sDeleteAlertResponse.%s: %s%s",
 		key,
 		e.field,
 		e.reason,
 		cause)
 }
 
-var _ error = UpdateUserPreferencesResponseValidationError{}
+var _ error = DeleteAlertResponseValidationError{}
 
 var _ interface {
 	Field() string
@@ -10592,46 +15808,65 @@ var _ interface {
 	Key() bool
 	Cause() error
 	ErrorName() string
-} = UpdateUserPreferencesResponseValidationError{}
+} = DeleteAlertResponseValidationError{}
 
-// Validate checks the field values on ListBookmarksRequest with the rules
+// Validate checks the field values on ListAuditEventsRequest with the rules
 // defined in the proto definition for this message. If any rules are
 // violated, the first error encountered is returned, or nil if there are no violations.
-func (m *ListBookmarksRequest) Validate() error {
+func (m *ListAuditEventsRequest) Validate() error {
 	return m.validate(false)
 }
 
-// ValidateAll checks the field values on ListBookmarksRequest with the rules
+// ValidateAll checks the field values on ListAuditEventsRequest with the rules
 // defined in the proto definition for this message. If any rules are
 // violated, the result is a list of violation errors wrapped in
-// ListBookmarksRequestMultiError, or nil if none found.
-func (m *ListBookmarksRequest) ValidateAll() error {
+// ListAuditEventsRequestMultiError, or nil if none found.
+func (m *ListAuditEventsRequest) ValidateAll() error {
 	return m.validate(true)
 }
 
-func (m *ListBookmarksRequest) validate(all bool) error {
+func (m *ListAuditEventsRequest) validate(all bool) error {
 	if m == nil {
 		return nil
 	}
 
 	var errors []error
 
-	// no validation rules for ProjectId
+	// no validation rules for Organization
+
+	// no validation rules for Project
+
+	if m.GetPageSize() != 0 {
+
+		if m.GetPageSize() > 1000 {
+			err := ListAuditEventsRequestValidationError{
+				field:  "PageSize",
+				reason: "value must be less than or equal to 1000",
+			}
+			if !all {
+				return err
+			}
+			errors = append(errors, err)
+		}
+
+	}
+
+	// no validation rules for PageToken
 
 	if len(errors) > 0 {
-		return ListBookmarksRequestMultiError(errors)
+		return ListAuditEventsRequestMultiError(errors)
 	}
 
 	return nil
 }
 
-// ListBookmarksRequestMultiError is an error wrapping multiple validation
-// errors returned by ListBookmarksRequest.ValidateAll() if the designated
+// ListAuditEventsRequestMultiError is an error wrapping multiple validation
+// errors returned by ListAuditEventsRequest.ValidateAll() if the designated
 // constraints aren't met.
-type ListBookmarksRequestMultiError []error
+type ListAuditEventsRequestMultiError []error
 
 // Error returns a concatenation of all the error messages it wraps.
-func (m ListBookmarksRequestMultiError) Error() string {
+func (m ListAuditEventsRequestMultiError) Error() string {
 	var msgs []string
 	for _, err := range m {
 		msgs = append(msgs, err.Error())
@@ -10640,11 +15875,11 @@ func (m ListBookmarksRequestMultiError) Error() string {
 }
 
 // AllErrors returns a list of validation violation errors.
-func (m ListBookmarksRequestMultiError) AllErrors() []error { return m }
+func (m ListAuditEventsRequestMultiError) AllErrors() []error { return m }
 
-// ListBookmarksRequestValidationError is the validation error returned by
-// ListBookmarksRequest.Validate if the designated constraints aren't met.
-type ListBookmarksRequestValidationError struct {
+// ListAuditEventsRequestValidationError is the validation error returned by
+// ListAuditEventsRequest.Validate if the designated constraints aren't met.
+type ListAuditEventsRequestValidationError struct {
 	field  string
 	reason string
 	cause  error
@@ -10652,24 +15887,24 @@ type ListBookmarksRequestValidationError struct {
 }
 
 // Field function returns field value.
-func (e ListBookmarksRequestValidationError) Field() string { return e.field }
+func (e ListAuditEventsRequestValidationError) Field() string { return e.field }
 
 // Reason function returns reason value.
-func (e ListBookmarksRequestValidationError) Reason() string { return e.reason }
+func (e ListAuditEventsRequestValidationError) Reason() string { return e.reason }
 
 // Cause function returns cause value.
-func (e ListBookmarksRequestValidationError) Cause() error { return e.cause }
+func (e ListAuditEventsRequestValidationError) Cause() error { return e.cause }
 
 // Key function returns key value.
-func (e ListBookmarksRequestValidationError) Key() bool { return e.key }
+func (e ListAuditEventsRequestValidationError) Key() bool { return e.key }
 
 // ErrorName returns error name.
-func (e ListBookmarksRequestValidationError) ErrorName() string {
-	return "ListBookmarksRequestValidationError"
+func (e ListAuditEventsRequestValidationError) ErrorName() string {
+	return "ListAuditEventsRequestValidationError"
 }
 
 // Error satisfies the builtin error interface
-func (e ListBookmarksRequestValidationError) Error() string {
+func (e ListAuditEventsRequestValidationError) Error() string {
 	cause := ""
 	if e.cause != nil {
 		cause = fmt.Sprintf(" | caused by: %v", e.cause)
@@ -10681,14 +15916,14 @@ func (e ListBookmarksRequestValidationError) Error() string {
 	}
 
 	return fmt.Sprintf(
-		"invalid %sListBookmarksRequest.%s: %s%s",
+		"invalid %sListAuditEventsRequest.%s: %s%s",
 		key,
 		e.field,
 		e.reason,
 		cause)
 }
 
-var _ error = ListBookmarksRequestValidationError{}
+var _ error = ListAuditEventsRequestValidationError{}
 
 var _ interface {
 	Field() string
@@ -10696,47 +15931,47 @@ var _ interface {
 	Key() bool
 	Cause() error
 	ErrorName() string
-} = ListBookmarksRequestValidationError{}
+} = ListAuditEventsRequestValidationError{}
 
-// Validate checks the field values on ListBookmarksResponse with the rules
+// Validate checks the field values on ListAuditEventsResponse with the rules
 // defined in the proto definition for this message. If any rules are
 // violated, the first error encountered is returned, or nil if there are no violations.
-func (m *ListBookmarksResponse) Validate() error {
+func (m *ListAuditEventsResponse) Validate() error {
 	return m.validate(false)
 }
 
-// ValidateAll checks the field values on ListBookmarksResponse with the rules
-// defined in the proto definition for this message. If any rules are
+// ValidateAll checks the field values on ListAuditEventsResponse with the
+// rules defined in the proto definition for this message. If any rules are
 // violated, the result is a list of violation errors wrapped in
-// ListBookmarksResponseMultiError, or nil if none found.
-func (m *ListBookmarksResponse) ValidateAll() error {
+// ListAuditEventsResponseMultiError, or nil if none found.
+func (m *ListAuditEventsResponse) ValidateAll() error {
 	return m.validate(true)
 }
 
-func (m *ListBookmarksResponse) validate(all bool) error {
+func (m *ListAuditEventsResponse) validate(all bool) error {
 	if m == nil {
 		return nil
 	}
 
 	var errors []error
 
-	for idx, item := range m.GetBookmarks() {
+	for idx, item := range m.GetEvents() {
 		_, _ = idx, item
 
 		if all {
 			switch v := interface{}(item).(type) {
 			case interface{ ValidateAll() error }:
 				if err := v.ValidateAll(); err != nil {
-					errors = append(errors, ListBookmarksResponseValidationError{
-						field:  fmt.Sprintf("Bookmarks[%v]", idx),
+					errors = append(errors, ListAuditEventsResponseValidationError{
+						field:  fmt.Sprintf("Events[%v]", idx),
 						reason: "embedded message failed validation",
 						cause:  err,
 					})
 				}
 			case interface{ Validate() error }:
 				if err := v.Validate(); err != nil {
-					errors = append(errors, ListBookmarksResponseValidationError{
-						field:  fmt.Sprintf("Bookmarks[%v]", idx),
+					errors = append(errors, ListAuditEventsResponseValidationError{
+						field:  fmt.Sprintf("Events[%v]", idx),
 						reason: "embedded message failed validation",
 						cause:  err,
 					})
@@ -10744,8 +15979,8 @@ func (m *ListBookmarksResponse) validate(all bool) error {
 			}
 		} else if v, ok := interface{}(item).(interface{ Validate() error }); ok {
 			if err := v.Validate(); err != nil {
-				return ListBookmarksResponseValidationError{
-					field:  fmt.Sprintf("Bookmarks[%v]", idx),
+				return ListAuditEventsResponseValidationError{
+					field:  fmt.Sprintf("Events[%v]", idx),
 					reason: "embedded message failed validation",
 					cause:  err,
 				}
@@ -10754,20 +15989,22 @@ func (m *ListBookmarksResponse) validate(all bool) error {
 
 	}
 
+	// no validation rules for NextPageToken
+
 	if len(errors) > 0 {
-		return ListBookmarksResponseMultiError(errors)
+		return ListAuditEventsResponseMultiError(errors)
 	}
 
 	return nil
 }
 
-// ListBookmarksResponseMultiError is an error wrapping multiple validation
-// errors returned by ListBookmarksResponse.ValidateAll() if the designated
+// ListAuditEventsResponseMultiError is an error wrapping multiple validation
+// errors returned by ListAuditEventsResponse.ValidateAll() if the designated
 // constraints aren't met.
-type ListBookmarksResponseMultiError []error
+type ListAuditEventsResponseMultiError []error
 
 // Error returns a concatenation of all the error messages it wraps.
-func (m ListBookmarksResponseMultiError) Error() string {
+func (m ListAuditEventsResponseMultiError) Error() string {
 	var msgs []string
 	for _, err := range m {
 		msgs = append(msgs, err.Error())
@@ -10776,11 +16013,11 @@ func (m ListBookmarksResponseMultiError) Error() string {
 }
 
 // AllErrors returns a list of validation violation errors.
-func (m ListBookmarksResponseMultiError) AllErrors() []error { return m }
+func (m ListAuditEventsResponseMultiError) AllErrors() []error { return m }
 
-// ListBookmarksResponseValidationError is the validation error returned by
-// ListBookmarksResponse.Validate if the designated constraints aren't met.
-type ListBookmarksResponseValidationError struct {
+// ListAuditEventsResponseValidationError is the validation error returned by
+// ListAuditEventsResponse.Validate if the designated constraints aren't met.
+type ListAuditEventsResponseValidationError struct {
 	field  string
 	reason string
 	cause  error
@@ -10788,24 +16025,24 @@ type ListBookmarksResponseValidationError struct {
 }
 
 // Field function returns field value.
-func (e ListBookmarksResponseValidationError) Field() string { return e.field }
+func (e ListAuditEventsResponseValidationError) Field() string { return e.field }
 
 // Reason function returns reason value.
-func (e ListBookmarksResponseValidationError) Reason() string { return e.reason }
+func (e ListAuditEventsResponseValidationError) Reason() string { return e.reason }
 
 // Cause function returns cause value.
-func (e ListBookmarksResponseValidationError) Cause() error { return e.cause }
+func (e ListAuditEventsResponseValidationError) Cause() error { return e.cause }
 
 // Key function returns key value.
-func (e ListBookmarksResponseValidationError) Key() bool { return e.key }
+func (e ListAuditEventsResponseValidationError) Key() bool { return e.key }
 
 // ErrorName returns error name.
-func (e ListBookmarksResponseValidationError) ErrorName() string {
-	return "ListBookmarksResponseValidationError"
+func (e ListAuditEventsResponseValidationError) ErrorName() string {
+	return "ListAuditEventsResponseValidationError"
 }
 
 // Error satisfies the builtin error interface
-func (e ListBookmarksResponseValidationError) Error() string {
+func (e ListAuditEventsResponseValidationError) Error() string {
 	cause := ""
 	if e.cause != nil {
 		cause = fmt.Sprintf(" | caused by: %v", e.cause)
@@ -10817,14 +16054,14 @@ func (e ListBookmarksResponseValidationError) Error() string {
 	}
 
 	return fmt.Sprintf(
-		"invalid %sListBookmarksResponse.%s: %s%s",
+		"invalid %sListAuditEventsResponse.%s: %s%s",
 		key,
 		e.field,
 		e.reason,
 		cause)
 }
 
-var _ error = ListBookmarksResponseValidationError{}
+var _ error = ListAuditEventsResponseValidationError{}
 
 var _ interface {
 	Field() string
@@ -10832,46 +16069,72 @@ var _ interface {
 	Key() bool
 	Cause() error
 	ErrorName() string
-} = ListBookmarksResponseValidationError{}
+} = ListAuditEventsResponseValidationError{}
 
-// Validate checks the field values on GetBookmarkRequest with the rules
+// Validate checks the field values on SearchUsersRequest with the rules
 // defined in the proto definition for this message. If any rules are
 // violated, the first error encountered is returned, or nil if there are no violations.
-func (m *GetBookmarkRequest) Validate() error {
+func (m *SearchUsersRequest) Validate() error {
 	return m.validate(false)
 }
 
-// ValidateAll checks the field values on GetBookmarkRequest with the rules
+// ValidateAll checks the field values on SearchUsersRequest with the rules
 // defined in the proto definition for this message. If any rules are
 // violated, the result is a list of violation errors wrapped in
-// GetBookmarkRequestMultiError, or nil if none found.
-func (m *GetBookmarkRequest) ValidateAll() error {
+// SearchUsersRequestMultiError, or nil if none found.
+func (m *SearchUsersRequest) ValidateAll() error {
 	return m.validate(true)
 }
 
-func (m *GetBookmarkRequest) validate(all bool) error {
+func (m *SearchUsersRequest) validate(all bool) error {
 	if m == nil {
 		return nil
 	}
 
 	var errors []error
 
-	// no validation rules for BookmarkId
+	if utf8.RuneCountInString(m.GetEmailPattern()) < 1 {
+		err := SearchUsersRequestValidationError{
+			field:  "EmailPattern",
+			reason: "value length must be at least 1 runes",
+		}
+		if !all {
+			return err
+		}
+		errors = append(errors, err)
+	}
+
+	if m.GetPageSize() != 0 {
+
+		if m.GetPageSize() > 1000 {
+			err := SearchUsersRequestValidationError{
+				field:  "PageSize",
+				reason: "value must be less than or equal to 1000",
+			}
+			if !all {
+				return err
+			}
+			errors = append(errors, err)
+		}
+
+	}
+
+	// no validation rules for PageToken
 
 	if len(errors) > 0 {
-		return GetBookmarkRequestMultiError(errors)
+		return SearchUsersRequestMultiError(errors)
 	}
 
 	return nil
 }
 
-// GetBookmarkRequestMultiError is an error wrapping multiple validation errors
-// returned by GetBookmarkRequest.ValidateAll() if the designated constraints
+// SearchUsersRequestMultiError is an error wrapping multiple validation errors
+// returned by SearchUsersRequest.ValidateAll() if the designated constraints
 // aren't met.
-type GetBookmarkRequestMultiError []error
+type SearchUsersRequestMultiError []error
 
 // Error returns a concatenation of all the error messages it wraps.
-func (m GetBookmarkRequestMultiError) Error() string {
+func (m SearchUsersRequestMultiError) Error() string {
 	var msgs []string
 	for _, err := range m {
 		msgs = append(msgs, err.Error())
@@ -10880,11 +16143,11 @@ func (m GetBookmarkRequestMultiError) Error() string {
 }
 
 // AllErrors returns a list of validation violation errors.
-func (m GetBookmarkRequestMultiError) AllErrors() []error { return m }
+func (m SearchUsersRequestMultiError) AllErrors() []error { return m }
 
-// GetBookmarkRequestValidationError is the validation error returned by
-// GetBookmarkRequest.Validate if the designated constraints aren't met.
-type GetBookmarkRequestValidationError struct {
+// SearchUsersRequestValidationError is the validation error returned by
+// SearchUsersRequest.Validate if the designated constraints aren't met.
+type SearchUsersRequestValidationError struct {
 	field  string
 	reason string
 	cause  error
@@ -10892,24 +16155,24 @@ type GetBookmarkRequestValidationError struct {
 }
 
 // Field function returns field value.
-func (e GetBookmarkRequestValidationError) Field() string { return e.field }
+func (e SearchUsersRequestValidationError) Field() string { return e.field }
 
 // Reason function returns reason value.
-func (e GetBookmarkRequestValidationError) Reason() string { return e.reason }
+func (e SearchUsersRequestValidationError) Reason() string { return e.reason }
 
 // Cause function returns cause value.
-func (e GetBookmarkRequestValidationError) Cause() error { return e.cause }
+func (e SearchUsersRequestValidationError) Cause() error { return e.cause }
 
 // Key function returns key value.
-func (e GetBookmarkRequestValidationError) Key() bool { return e.key }
+func (e SearchUsersRequestValidationError) Key() bool { return e.key }
 
 // ErrorName returns error name.
-func (e GetBookmarkRequestValidationError) ErrorName() string {
-	return "GetBookmarkRequestValidationError"
+func (e SearchUsersRequestValidationError) ErrorName() string {
+	return "SearchUsersRequestValidationError"
 }
 
 // Error satisfies the builtin error interface
-func (e GetBookmarkRequestValidationError) Error() string {
+func (e SearchUsersRequestValidationError) Error() string {
 	cause := ""
 	if e.cause != nil {
 		cause = fmt.Sprintf(" | caused by: %v", e.cause)
@@ -10921,14 +16184,14 @@ func (e GetBookmarkRequestValidationError) Error() string {
 	}
 
 	return fmt.Sprintf(
-		"invalid %sGetBookmarkRequest.%s: %s%s",
+		"invalid %sSearchUsersRequest.%s: %s%s",
 		key,
 		e.field,
 		e.reason,
 		cause)
 }
 
-var _ error = GetBookmarkRequestValidationError{}
+var _ error = SearchUsersRequestValidationError{}
 
 var _ interface {
 	Field() string
@@ -10936,73 +16199,80 @@ var _ interface {
 	Key() bool
 	Cause() error
 	ErrorName() string
-} = GetBookmarkRequestValidationError{}
+} = SearchUsersRequestValidationError{}
 
-// Validate checks the field values on GetBookmarkResponse with the rules
+// Validate checks the field values on SearchUsersResponse with the rules
 // defined in the proto definition for this message. If any rules are
 // violated, the first error encountered is returned, or nil if there are no violations.
-func (m *GetBookmarkResponse) Validate() error {
+func (m *SearchUsersResponse) Validate() error {
 	return m.validate(false)
 }
 
-// ValidateAll checks the field values on GetBookmarkResponse with the rules
+// ValidateAll checks the field values on SearchUsersResponse with the rules
 // defined in the proto definition for this message. If any rules are
 // violated, the result is a list of violation errors wrapped in
-// GetBookmarkResponseMultiError, or nil if none found.
-func (m *GetBookmarkResponse) ValidateAll() error {
+// SearchUsersResponseMultiError, or nil if none found.
+func (m *SearchUsersResponse) ValidateAll() error {
 	return m.validate(true)
 }
 
-func (m *GetBookmarkResponse) validate(all bool) error {
+func (m *SearchUsersResponse) validate(all bool) error {
 	if m == nil {
 		return nil
 	}
 
 	var errors []error
 
-	if all {
-		switch v := interface{}(m.GetBookmark()).(type) {
-		case interface{ ValidateAll() error }:
-			if err := v.ValidateAll(); err != nil {
-				errors = append(errors, GetBookmarkResponseValidationError{
-					field:  "Bookmark",
-					reason: "embedded message failed validation",
-					cause:  err,
-				})
+	for idx, item := range m.GetUsers() {
+		_, _ = idx, item
+
+		if all {
+			switch v := interface{}(item).(type) {
+			case interface{ ValidateAll() error }:
+				if err := v.ValidateAll(); err != nil {
+					errors = append(errors, SearchUsersResponseValidationError{
+						field:  fmt.Sprintf("Users[%v]", idx),
+						reason: "embedded message failed validation",
+						cause:  err,
+					})
+				}
+			case interface{ Validate() error }:
+				if err := v.Validate(); err != nil {
+					errors = append(errors, SearchUsersResponseValidationError{
+						field:  fmt.Sprintf("Users[%v]", idx),
+						reason: "embedded message failed validation",
+						cause:  err,
+					})
+				}
 			}
-		case interface{ Validate() error }:
+		} else if v, ok := interface{}(item).(interface{ Validate() error }); ok {
 			if err := v.Validate(); err != nil {
-				errors = append(errors, GetBookmarkResponseValidationError{
-					field:  "Bookmark",
+				return SearchUsersResponseValidationError{
+					field:  fmt.Sprintf("Users[%v]", idx),
 					reason: "embedded message failed validation",
 					cause:  err,
-				})
-			}
-		}
-	} else if v, ok := interface{}(m.GetBookmark()).(interface{ Validate() error }); ok {
-		if err := v.Validate(); err != nil {
-			return GetBookmarkResponseValidationError{
-				field:  "Bookmark",
-				reason: "embedded message failed validation",
-				cause:  err,
+				}
 			}
 		}
+
 	}
 
+	// no validation rules for NextPageToken
+
 	if len(errors) > 0 {
-		return GetBookmarkResponseMultiError(errors)
+		return SearchUsersResponseMultiError(errors)
 	}
 
 	return nil
 }
 
-// GetBookmarkResponseMultiError is an error wrapping multiple validation
-// errors returned by GetBookmarkResponse.ValidateAll() if the designated
+// SearchUsersResponseMultiError is an error wrapping multiple validation
+// errors returned by SearchUsersResponse.ValidateAll() if the designated
 // constraints aren't met.
-type GetBookmarkResponseMultiError []error
+type SearchUsersResponseMultiError []error
 
 // Error returns a concatenation of all the error messages it wraps.
-func (m GetBookmarkResponseMultiError) Error() string {
+func (m SearchUsersResponseMultiError) Error() string {
 	var msgs []string
 	for _, err := range m {
 		msgs = append(msgs, err.Error())
@@ -11011,11 +16281,11 @@ func (m GetBookmarkResponseMultiError) Error() string {
 }
 
 // AllErrors returns a list of validation violation errors.
-func (m GetBookmarkResponseMultiError) AllErrors() []error { return m }
+func (m SearchUsersResponseMultiError) AllErrors() []error { return m }
 
-// GetBookmarkResponseValidationError is the validation error returned by
-// GetBookmarkResponse.Validate if the designated constraints aren't met.
-type GetBookmarkResponseValidationError struct {
+// SearchUsersResponseValidationError is the validation error returned by
+// SearchUsersResponse.Validate if the designated constraints aren't met.
+type SearchUsersResponseValidationError struct {
 	field  string
 	reason string
 	cause  error
@@ -11023,24 +16293,24 @@ type GetBookmarkResponseValidationError struct {
 }
 
 // Field function returns field value.
-func (e GetBookmarkResponseValidationError) Field() string { return e.field }
+func (e SearchUsersResponseValidationError) Field() string { return e.field }
 
 // Reason function returns reason value.
-func (e GetBookmarkResponseValidationError) Reason() string { return e.reason }
+func (e SearchUsersResponseValidationError) Reason() string { return e.reason }
 
 // Cause function returns cause value.
-func (e GetBookmarkResponseValidationError) Cause() error { return e.cause }
+func (e SearchUsersResponseValidationError) Cause() error { return e.cause }
 
 // Key function returns key value.
-func (e GetBookmarkResponseValidationError) Key() bool { return e.key }
+func (e SearchUsersResponseValidationError) Key() bool { return e.key }
 
 // ErrorName returns error name.
-func (e GetBookmarkResponseValidationError) ErrorName() string {
-	return "GetBookmarkResponseValidationError"
+func (e SearchUsersResponseValidationError) ErrorName() string {
+	return "SearchUsersResponseValidationError"
 }
 
 // Error satisfies the builtin error interface
-func (e GetBookmarkResponseValidationError) Error() string {
+func (e SearchUsersResponseValidationError) Error() string {
 	cause := ""
 	if e.cause != nil {
 		cause = fmt.Sprintf(" | caused by: %v", e.cause)
@@ -11052,14 +16322,14 @@ func (e GetBookmarkResponseValidationError) Error() string {
 	}
 
 	return fmt.Sprintf(
-		"invalid %sGetBookmarkResponse.%s: %s%s",
+		"invalid %sSearchUsersResponse.%s: %s%s",
 		key,
 		e.field,
 		e.reason,
 		cause)
 }
 
-var _ error = GetBookmarkResponseValidationError{}
+var _ error = SearchUsersResponseValidationError{}
 
 var _ interface {
 	Field() string
@@ -11067,52 +16337,44 @@ var _ interface {
 	Key() bool
 	Cause() error
 	ErrorName() string
-} = GetBookmarkResponseValidationError{}
+} = SearchUsersResponseValidationError{}
 
-// Validate checks the field values on CreateBookmarkRequest with the rules
-// defined in the proto definition for this message. If any rules are
+// Validate checks the field values on RevokeCurrentAuthTokenRequest with the
+// rules defined in the proto definition for this message. If any rules are
 // violated, the first error encountered is returned, or nil if there are no violations.
-func (m *CreateBookmarkRequest) Validate() error {
+func (m *RevokeCurrentAuthTokenRequest) Validate() error {
 	return m.validate(false)
 }
 
-// ValidateAll checks the field values on CreateBookmarkRequest with the rules
-// defined in the proto definition for this message. If any rules are
-// violated, the result is a list of violation errors wrapped in
-// CreateBookmarkRequestMultiError, or nil if none found.
-func (m *CreateBookmarkRequest) ValidateAll() error {
+// ValidateAll checks the field values on RevokeCurrentAuthTokenRequest with
+// the rules defined in the proto definition for this message. If any rules
+// are violated, the result is a list of violation errors wrapped in
+// RevokeCurrentAuthTokenRequestMultiError, or nil if none found.
+func (m *RevokeCurrentAuthTokenRequest) ValidateAll() error {
 	return m.validate(true)
 }
 
-func (m *CreateBookmarkRequest) validate(all bool) error {
+func (m *RevokeCurrentAuthTokenRequest) validate(all bool) error {
 	if m == nil {
 		return nil
 	}
 
 	var errors []error
 
-	// no validation rules for DisplayName
-
-	// no validation rules for Data
-
-	// no validation rules for DashboardName
-
-	// no validation rules for ProjectId
-
 	if len(errors) > 0 {
-		return CreateBookmarkRequestMultiError(errors)
+		return RevokeCurrentAuthTokenRequestMultiError(errors)
 	}
 
 	return nil
 }
 
-// CreateBookmarkRequestMultiError is an error wrapping multiple validation
-// errors returned by CreateBookmarkRequest.ValidateAll() if the designated
-// constraints aren't met.
-type CreateBookmarkRequestMultiError []error
+// RevokeCurrentAuthTokenRequestMultiError is an error wrapping multiple
+// validation errors returned by RevokeCurrentAuthTokenRequest.ValidateAll()
+// if the designated constraints aren't met.
+type RevokeCurrentAuthTokenRequestMultiError []error
 
 // Error returns a concatenation of all the error messages it wraps.
-func (m CreateBookmarkRequestMultiError) Error() string {
+func (m RevokeCurrentAuthTokenRequestMultiError) Error() string {
 	var msgs []string
 	for _, err := range m {
 		msgs = append(msgs, err.Error())
@@ -11121,11 +16383,12 @@ func (m CreateBookmarkRequestMultiError) Error() string {
 }
 
 // AllErrors returns a list of validation violation errors.
-func (m CreateBookmarkRequestMultiError) AllErrors() []error { return m }
+func (m RevokeCurrentAuthTokenRequestMultiError) AllErrors() []error { return m }
 
-// CreateBookmarkRequestValidationError is the validation error returned by
-// CreateBookmarkRequest.Validate if the designated constraints aren't met.
-type CreateBookmarkRequestValidationError struct {
+// RevokeCurrentAuthTokenRequestValidationError is the validation error
+// returned by RevokeCurrentAuthTokenRequest.Validate if the designated
+// constraints aren't met.
+type RevokeCurrentAuthTokenRequestValidationError struct {
 	field  string
 	reason string
 	cause  error
@@ -11133,24 +16396,24 @@ type CreateBookmarkRequestValidationError struct {
 }
 
 // Field function returns field value.
-func (e CreateBookmarkRequestValidationError) Field() string { return e.field }
+func (e RevokeCurrentAuthTokenRequestValidationError) Field() string { return e.field }
 
 // Reason function returns reason value.
-func (e CreateBookmarkRequestValidationError) Reason() string { return e.reason }
+func (e RevokeCurrentAuthTokenRequestValidationError) Reason() string { return e.reason }
 
 // Cause function returns cause value.
-func (e CreateBookmarkRequestValidationError) Cause() error { return e.cause }
+func (e RevokeCurrentAuthTokenRequestValidationError) Cause() error { return e.cause }
 
 // Key function returns key value.
-func (e CreateBookmarkRequestValidationError) Key() bool { return e.key }
+func (e RevokeCurrentAuthTokenRequestValidationError) Key() bool { return e.key }
 
 // ErrorName returns error name.
-func (e CreateBookmarkRequestValidationError) ErrorName() string {
-	return "CreateBookmarkRequestValidationError"
+func (e RevokeCurrentAuthTokenRequestValidationError) ErrorName() string {
+	return "RevokeCurrentAuthTokenRequestValidationError"
 }
 
 // Error satisfies the builtin error interface
-func (e CreateBookmarkRequestValidationError) Error() string {
+func (e RevokeCurrentAuthTokenRequestValidationError) Error() string {
 	cause := ""
 	if e.cause != nil {
 		cause = fmt.Sprintf(" | caused by: %v", e.cause)
@@ -11162,14 +16425,14 @@ func (e CreateBookmarkRequestValidationError) Error() string {
 	}
 
 	return fmt.Sprintf(
-		"invalid %sCreateBookmarkRequest.%s: %s%s",
+		"invalid %sRevokeCurrentAuthTokenRequest.%s: %s%s",
 		key,
 		e.field,
 		e.reason,
 		cause)
 }
 
-var _ error = CreateBookmarkRequestValidationError{}
+var _ error = RevokeCurrentAuthTokenRequestValidationError{}
 
 var _ interface {
 	Field() string
@@ -11177,73 +16440,46 @@ var _ interface {
 	Key() bool
 	Cause() error
 	ErrorName() string
-} = CreateBookmarkRequestValidationError{}
+} = RevokeCurrentAuthTokenRequestValidationError{}
 
-// Validate checks the field values on CreateBookmarkResponse with the rules
-// defined in the proto definition for this message. If any rules are
+// Validate checks the field values on RevokeCurrentAuthTokenResponse with the
+// rules defined in the proto definition for this message. If any rules are
 // violated, the first error encountered is returned, or nil if there are no violations.
-func (m *CreateBookmarkResponse) Validate() error {
-	return m.validate(false)
-}
-
-// ValidateAll checks the field values on CreateBookmarkResponse with the rules
-// defined in the proto definition for this message. If any rules are
-// violated, the result is a list of violation errors wrapped in
-// CreateBookmarkResponseMultiError, or nil if none found.
-func (m *CreateBookmarkResponse) ValidateAll() error {
+func (m *RevokeCurrentAuthTokenResponse) Validate() error {
+	return m.validate(false)
+}
+
+// ValidateAll checks the field values on RevokeCurrentAuthTokenResponse with
+// the rules defined in the proto definition for this message. If any rules
+// are violated, the result is a list of violation errors wrapped in
+// RevokeCurrentAuthTokenResponseMultiError, or nil if none found.
+func (m *RevokeCurrentAuthTokenResponse) ValidateAll() error {
 	return m.validate(true)
 }
 
-func (m *CreateBookmarkResponse) validate(all bool) error {
+func (m *RevokeCurrentAuthTokenResponse) validate(all bool) error {
 	if m == nil {
 		return nil
 	}
 
 	var errors []error
 
-	if all {
-		switch v := interface{}(m.GetBookmark()).(type) {
-		case interface{ ValidateAll() error }:
-			if err := v.ValidateAll(); err != nil {
-				errors = append(errors, CreateBookmarkResponseValidationError{
-					field:  "Bookmark",
-					reason: "embedded message failed validation",
-					cause:  err,
-				})
-			}
-		case interface{ Validate() error }:
-			if err := v.Validate(); err != nil {
-				errors = append(errors, CreateBookmarkResponseValidationError{
-					field:  "Bookmark",
-					reason: "embedded message failed validation",
-					cause:  err,
-				})
-			}
-		}
-	} else if v, ok := interface{}(m.GetBookmark()).(interface{ Validate() error }); ok {
-		if err := v.Validate(); err != nil {
-			return CreateBookmarkResponseValidationError{
-				field:  "Bookmark",
-				reason: "embedded message failed validation",
-				cause:  err,
-			}
-		}
-	}
+	// no validation rules for TokenId
 
 	if len(errors) > 0 {
-		return CreateBookmarkResponseMultiError(errors)
+		return RevokeCurrentAuthTokenResponseMultiError(errors)
 	}
 
 	return nil
 }
 
-// CreateBookmarkResponseMultiError is an error wrapping multiple validation
-// errors returned by CreateBookmarkResponse.ValidateAll() if the designated
-// constraints aren't met.
-type CreateBookmarkResponseMultiError []error
+// RevokeCurrentAuthTokenResponseMultiError is an error wrapping multiple
+// validation errors returned by RevokeCurrentAuthTokenResponse.ValidateAll()
+// if the designated constraints aren't met.
+type RevokeCurrentAuthTokenResponseMultiError []error
 
 // Error returns a concatenation of all the error messages it wraps.
-func (m CreateBookmarkResponseMultiError) Error() string {
+func (m RevokeCurrentAuthTokenResponseMultiError) Error() string {
 	var msgs []string
 	for _, err := range m {
 		msgs = append(msgs, err.Error())
@@ -11252,11 +16488,12 @@ func (m CreateBookmarkResponseMultiError) Error() string {
 }
 
 // AllErrors returns a list of validation violation errors.
-func (m CreateBookmarkResponseMultiError) AllErrors() []error { return m }
+func (m RevokeCurrentAuthTokenResponseMultiError) AllErrors() []error { return m }
 
-// CreateBookmarkResponseValidationError is the validation error returned by
-// CreateBookmarkResponse.Validate if the designated constraints aren't met.
-type CreateBookmarkResponseValidationError struct {
+// RevokeCurrentAuthTokenResponseValidationError is the validation error
+// returned by RevokeCurrentAuthTokenResponse.Validate if the designated
+// constraints aren't met.
+type RevokeCurrentAuthTokenResponseValidationError struct {
 	field  string
 	reason string
 	cause  error
@@ -11264,24 +16501,24 @@ type CreateBookmarkResponseValidationError struct {
 }
 
 // Field function returns field value.
-func (e CreateBookmarkResponseValidationError) Field() string { return e.field }
+func (e RevokeCurrentAuthTokenResponseValidationError) Field() string { return e.field }
 
 // Reason function returns reason value.
-func (e CreateBookmarkResponseValidationError) Reason() string { return e.reason }
+func (e RevokeCurrentAuthTokenResponseValidationError) Reason() string { return e.reason }
 
 // Cause function returns cause value.
-func (e CreateBookmarkResponseValidationError) Cause() error { return e.cause }
+func (e RevokeCurrentAuthTokenResponseValidationError) Cause() error { return e.cause }
 
 // Key function returns key value.
-func (e CreateBookmarkResponseValidationError) Key() bool { return e.key }
+func (e RevokeCurrentAuthTokenResponseValidationError) Key() bool { return e.key }
 
 // ErrorName returns error name.
-func (e CreateBookmarkResponseValidationError) ErrorName() string {
-	return "CreateBookmarkResponseValidationError"
+func (e RevokeCurrentAuthTokenResponseValidationError) ErrorName() string {
+	return "RevokeCurrentAuthTokenResponseValidationError"
 }
 
 // Error satisfies the builtin error interface
-func (e CreateBookmarkResponseValidationError) Error() string {
+func (e RevokeCurrentAuthTokenResponseValidationError) Error() string {
 	cause := ""
 	if e.cause != nil {
 		cause = fmt.Sprintf(" | caused by: %v", e.cause)
@@ -11293,14 +16530,14 @@ func (e CreateBookmarkResponseValidationError) Error() string {
 	}
 
 	return fmt.Sprintf(
-		"invalid %sCreateBookmarkResponse.%s: %s%s",
+		"invalid %sRevokeCurrentAuthTokenResponse.%s: %s%s",
 		key,
 		e.field,
 		e.reason,
 		cause)
 }
 
-var _ error = CreateBookmarkResponseValidationError{}
+var _ error = RevokeCurrentAuthTokenResponseValidationError{}
 
 var _ interface {
 	Field() string
@@ -11308,46 +16545,110 @@ var _ interface {
 	Key() bool
 	Cause() error
 	ErrorName() string
-} = CreateBookmarkResponseValidationError{}
+} = RevokeCurrentAuthTokenResponseValidationError{}
 
-// Validate checks the field values on RemoveBookmarkRequest with the rules
-// defined in the proto definition for this message. If any rules are
-// violated, the first error encountered is returned, or nil if there are no violations.
-func (m *RemoveBookmarkRequest) Validate() error {
+// Validate checks the field values on IssueRepresentativeAuthTokenRequest with
+// the rules defined in the proto definition for this message. If any rules
+// are violated, the first error encountered is returned, or nil if there are
+// no violations.
+func (m *IssueRepresentativeAuthTokenRequest) Validate() error {
 	return m.validate(false)
 }
 
-// ValidateAll checks the field values on RemoveBookmarkRequest with the rules
-// defined in the proto definition for this message. If any rules are
-// violated, the result is a list of violation errors wrapped in
-// RemoveBookmarkRequestMultiError, or nil if none found.
-func (m *RemoveBookmarkRequest) ValidateAll() error {
+// ValidateAll checks the field values on IssueRepresentativeAuthTokenRequest
+// with the rules defined in the proto definition for this message. If any
+// rules are violated, the result is a list of violation errors wrapped in
+// IssueRepresentativeAuthTokenRequestMultiError, or nil if none found.
+func (m *IssueRepresentativeAuthTokenRequest) ValidateAll() error {
 	return m.validate(true)
 }
 
-func (m *RemoveBookmarkRequest) validate(all bool) error {
+func (m *IssueRepresentativeAuthTokenRequest) validate(all bool) error {
 	if m == nil {
 		return nil
 	}
 
 	var errors []error
 
-	// no validation rules for BookmarkId
+	if err := m._validateEmail(m.GetEmail()); err != nil {
+		err = IssueRepresentativeAuthTokenRequestValidationError{
+			field:  "Email",
+			reason: "value must be a valid email address",
+			cause:  err,
+		}
+		if !all {
+			return err
+		}
+		errors = append(errors, err)
+	}
+
+	// no validation rules for TtlMinutes
 
 	if len(errors) > 0 {
-		return RemoveBookmarkRequestMultiError(errors)
+		return IssueRepresentativeAuthTokenRequestMultiError(errors)
 	}
 
 	return nil
 }
 
-// RemoveBookmarkRequestMultiError is an error wrapping multiple validation
-// errors returned by RemoveBookmarkRequest.ValidateAll() if the designated
+func (m *IssueRepresentativeAuthTokenRequest) _validateHostname(host string) error {
+	s := strings.ToLower(strings.TrimSuffix(host, "."))
+
+	if len(host) > 253 {
+		return errors.New("hostname cannot exceed 253 characters")
+	}
+
+	for _, part := range strings.Split(s, ".") {
+		if l := len(part); l == 0 || l > 63 {
+			return errors.New("hostname part must be non-empty and cannot exceed 63 characters")
+		}
+
+		if part[0] == '-' {
+			return errors.New("hostname parts cannot begin with hyphens")
+		}
+
+		if part[len(part)-1] == '-' {
+			return errors.New("hostname parts cannot end with hyphens")
+		}
+
+		for _, r := range part {
+			if (r < 'a' || r > 'z') && (r < '0' || r > '9') && r != '-' {
+				return fmt.Errorf("hostname parts can only contain alphanumeric characters or hyphens, got %q", string(r))
+			}
+		}
+	}
+
+	return nil
+}
+
+func (m *IssueRepresentativeAuthTokenRequest) _validateEmail(addr string) error {
+	a, err := mail.ParseAddress(addr)
+	if err != nil {
+		return err
+	}
+	addr = a.Address
+
+	if len(addr) > 254 {
+		return errors.New("email addresses cannot exceed 254 characters")
+	}
+
+	parts := strings.SplitN(addr, "@", 2)
+
+	if len(parts[0]) > 64 {
+		return errors.New("email address local phrase cannot exceed 64 characters")
+	}
+
+	return m._validateHostname(parts[1])
+}
+
+// IssueRepresentativeAuthTokenRequestMultiError is an error wrapping multiple
+// validation errors returned by
+// IssueRepresentativeAuthTokenRequest.ValidateAll() if the designated
 // constraints aren't met.
-type RemoveBookmarkRequestMultiError []error
+type IssueRepresentativeAuthTokenRequestMultiError []error
 
 // Error returns a concatenation of all the error messages it wraps.
-func (m RemoveBookmarkRequestMultiError) Error() string {
+func (m IssueRepresentativeAuthTokenRequestMultiError) Error() string {
 	var msgs []string
 	for _, err := range m {
 		msgs = append(msgs, err.Error())
@@ -11356,11 +16657,12 @@ func (m RemoveBookmarkRequestMultiError) Error() string {
 }
 
 // AllErrors returns a list of validation violation errors.
-func (m RemoveBookmarkRequestMultiError) AllErrors() []error { return m }
+func (m IssueRepresentativeAuthTokenRequestMultiError) AllErrors() []error { return m }
 
-// RemoveBookmarkRequestValidationError is the validation error returned by
-// RemoveBookmarkRequest.Validate if the designated constraints aren't met.
-type RemoveBookmarkRequestValidationError struct {
+// IssueRepresentativeAuthTokenRequestValidationError is the validation error
+// returned by IssueRepresentativeAuthTokenRequest.Validate if the designated
+// constraints aren't met.
+type IssueRepresentativeAuthTokenRequestValidationError struct {
 	field  string
 	reason string
 	cause  error
@@ -11368,24 +16670,24 @@ type RemoveBookmarkRequestValidationError struct {
 }
 
 // Field function returns field value.
-func (e RemoveBookmarkRequestValidationError) Field() string { return e.field }
+func (e IssueRepresentativeAuthTokenRequestValidationError) Field() string { return e.field }
 
 // Reason function returns reason value.
-func (e RemoveBookmarkRequestValidationError) Reason() string { return e.reason }
+func (e IssueRepresentativeAuthTokenRequestValidationError) Reason() string { return e.reason }
 
 // Cause function returns cause value.
-func (e RemoveBookmarkRequestValidationError) Cause() error { return e.cause }
+func (e IssueRepresentativeAuthTokenRequestValidationError) Cause() error { return e.cause }
 
 // Key function returns key value.
-func (e RemoveBookmarkRequestValidationError) Key() bool { return e.key }
+func (e IssueRepresentativeAuthTokenRequestValidationError) Key() bool { return e.key }
 
 // ErrorName returns error name.
-func (e RemoveBookmarkRequestValidationError) ErrorName() string {
-	return "RemoveBookmarkRequestValidationError"
+func (e IssueRepresentativeAuthTokenRequestValidationError) ErrorName() string {
+	return "IssueRepresentativeAuthTokenRequestValidationError"
 }
 
 // Error satisfies the builtin error interface
-func (e RemoveBookmarkRequestValidationError) Error() string {
+func (e IssueRepresentativeAuthTokenRequestValidationError) Error() string {
 	cause := ""
 	if e.cause != nil {
 		cause = fmt.Sprintf(" | caused by: %v", e.cause)
@@ -11397,14 +16699,14 @@ func (e RemoveBookmarkRequestValidationError) Error() string {
 	}
 
 	return fmt.Sprintf(
-		"invalid %sRemoveBookmarkRequest.%s: %s%s",
+		"invalid %sIssueRepresentativeAuthTokenRequest.%s: %s%s",
 		key,
 		e.field,
 		e.reason,
 		cause)
 }
 
-var _ error = RemoveBookmarkRequestValidationError{}
+var _ error = IssueRepresentativeAuthTokenRequestValidationError{}
 
 var _ interface {
 	Field() string
@@ -11412,44 +16714,48 @@ var _ interface {
 	Key() bool
 	Cause() error
 	ErrorName() string
-} = RemoveBookmarkRequestValidationError{}
+} = IssueRepresentativeAuthTokenRequestValidationError{}
 
-// Validate checks the field values on RemoveBookmarkResponse with the rules
-// defined in the proto definition for this message. If any rules are
-// violated, the first error encountered is returned, or nil if there are no violations.
-func (m *RemoveBookmarkResponse) Validate() error {
+// Validate checks the field values on IssueRepresentativeAuthTokenResponse
+// with the rules defined in the proto definition for this message. If any
+// rules are violated, the first error encountered is returned, or nil if
+// there are no violations.
+func (m *IssueRepresentativeAuthTokenResponse) Validate() error {
 	return m.validate(false)
 }
 
-// ValidateAll checks the field values on RemoveBookmarkResponse with the rules
-// defined in the proto definition for this message. If any rules are
-// violated, the result is a list of violation errors wrapped in
-// RemoveBookmarkResponseMultiError, or nil if none found.
-func (m *RemoveBookmarkResponse) ValidateAll() error {
+// ValidateAll checks the field values on IssueRepresentativeAuthTokenResponse
+// with the rules defined in the proto definition for this message. If any
+// rules are violated, the result is a list of violation errors wrapped in
+// IssueRepresentativeAuthTokenResponseMultiError, or nil if none found.
+func (m *IssueRepresentativeAuthTokenResponse) ValidateAll() error {
 	return m.validate(true)
 }
 
-func (m *RemoveBookmarkResponse) validate(all bool) error {
+func (m *IssueRepresentativeAuthTokenResponse) validate(all bool) error {
 	if m == nil {
 		return nil
 	}
 
 	var errors []error
 
+	// no validation rules for Token
+
 	if len(errors) > 0 {
-		return RemoveBookmarkResponseMultiError(errors)
+		return IssueRepresentativeAuthTokenResponseMultiError(errors)
 	}
 
 	return nil
 }
 
-// RemoveBookmarkResponseMultiError is an error wrapping multiple validation
-// errors returned by RemoveBookmarkResponse.ValidateAll() if the designated
+// IssueRepresentativeAuthTokenResponseMultiError is an error wrapping multiple
+// validation errors returned by
+// IssueRepresentativeAuthTokenResponse.ValidateAll() if the designated
 // constraints aren't met.
-type RemoveBookmarkResponseMultiError []error
+type IssueRepresentativeAuthTokenResponseMultiError []error
 
 // Error returns a concatenation of all the error messages it wraps.
-func (m RemoveBookmarkResponseMultiError) Error() string {
+func (m IssueRepresentativeAuthTokenResponseMultiError) Error() string {
 	var msgs []string
 	for _, err := range m {
 		msgs = append(msgs, err.Error())
@@ -11458,11 +16764,12 @@ func (m RemoveBookmarkResponseMultiError) Error() string {
 }
 
 // AllErrors returns a list of validation violation errors.
-func (m RemoveBookmarkResponseMultiError) AllErrors() []error { return m }
+func (m IssueRepresentativeAuthTokenResponseMultiError) AllErrors() []error { return m }
 
-// RemoveBookmarkResponseValidationError is the validation error returned by
-// RemoveBookmarkResponse.Validate if the designated constraints aren't met.
-type RemoveBookmarkResponseValidationError struct {
+// IssueRepresentativeAuthTokenResponseValidationError is the validation error
+// returned by IssueRepresentativeAuthTokenResponse.Validate if the designated
+// constraints aren't met.
+type IssueRepresentativeAuthTokenResponseValidationError struct {
 	field  string
 	reason string
 	cause  error
@@ -11470,24 +16777,24 @@ type RemoveBookmarkResponseValidationError struct {
 }
 
 // Field function returns field value.
-func (e RemoveBookmarkResponseValidationError) Field() string { return e.field }
+func (e IssueRepresentativeAuthTokenResponseValidationError) Field() string { return e.field }
 
 // Reason function returns reason value.
-func (e RemoveBookmarkResponseValidationError) Reason() string { return e.reason }
+func (e IssueRepresentativeAuthTokenResponseValidationError) Reason() string { return e.reason }
 
 // Cause function returns cause value.
-func (e RemoveBookmarkResponseValidationError) Cause() error { return e.cause }
+func (e IssueRepresentativeAuthTokenResponseValidationError) Cause() error { return e.cause }
 
 // Key function returns key value.
-func (e RemoveBookmarkResponseValidationError) Key() bool { return e.key }
+func (e IssueRepresentativeAuthTokenResponseValidationError) Key() bool { return e.key }
 
 // ErrorName returns error name.
-func (e RemoveBookmarkResponseValidationError) ErrorName() string {
-	return "RemoveBookmarkResponseValidationError"
+func (e IssueRepresentativeAuthTokenResponseValidationError) ErrorName() string {
+	return "IssueRepresentativeAuthTokenResponseValidationError"
 }
 
 // Error satisfies the builtin error interface
-func (e RemoveBookmarkResponseValidationError) Error() string {
+func (e IssueRepresentativeAuthTokenResponseValidationError) Error() string {
 	cause := ""
 	if e.cause != nil {
 		cause = fmt.Sprintf(" | caused by: %v", e.cause)
@@ -11499,14 +16806,14 @@ func (e RemoveBookmarkResponseValidationError) Error() string {
 	}
 
 	return fmt.Sprintf(
-		"invalid %sRemoveBookmarkResponse.%s: %s%s",
+		"invalid %sIssueRepresentativeAuthTokenResponse.%s: %s%s",
 		key,
 		e.field,
 		e.reason,
 		cause)
 }
 
-var _ error = RemoveBookmarkResponseValidationError{}
+var _ error = IssueRepresentativeAuthTokenResponseValidationError{}
 
 var _ interface {
 	Field() string
@@ -11514,72 +16821,46 @@ var _ interface {
 	Key() bool
 	Cause() error
 	ErrorName() string
-} = RemoveBookmarkResponseValidationError{}
+} = IssueRepresentativeAuthTokenResponseValidationError{}
 
-// Validate checks the field values on SearchUsersRequest with the rules
-// defined in the proto definition for this message. If any rules are
+// Validate checks the field values on RevokeServiceAuthTokenRequest with the
+// rules defined in the proto definition for this message. If any rules are
 // violated, the first error encountered is returned, or nil if there are no violations.
-func (m *SearchUsersRequest) Validate() error {
+func (m *RevokeServiceAuthTokenRequest) Validate() error {
 	return m.validate(false)
 }
 
-// ValidateAll checks the field values on SearchUsersRequest with the rules
-// defined in the proto definition for this message. If any rules are
-// violated, the result is a list of violation errors wrapped in
-// SearchUsersRequestMultiError, or nil if none found.
-func (m *SearchUsersRequest) ValidateAll() error {
+// ValidateAll checks the field values on RevokeServiceAuthTokenRequest with
+// the rules defined in the proto definition for this message. If any rules
+// are violated, the result is a list of violation errors wrapped in
+// RevokeServiceAuthTokenRequestMultiError, or nil if none found.
+func (m *RevokeServiceAuthTokenRequest) ValidateAll() error {
 	return m.validate(true)
 }
 
-func (m *SearchUsersRequest) validate(all bool) error {
+func (m *RevokeServiceAuthTokenRequest) validate(all bool) error {
 	if m == nil {
 		return nil
 	}
 
 	var errors []error
 
-	if utf8.RuneCountInString(m.GetEmailPattern()) < 1 {
-		err := SearchUsersRequestValidationError{
-			field:  "EmailPattern",
-			reason: "value length must be at least 1 runes",
-		}
-		if !all {
-			return err
-		}
-		errors = append(errors, err)
-	}
-
-	if m.GetPageSize() != 0 {
-
-		if m.GetPageSize() > 1000 {
-			err := SearchUsersRequestValidationError{
-				field:  "PageSize",
-				reason: "value must be less than or equal to 1000",
-			}
-			if !all {
-				return err
-			}
-			errors = append(errors, err)
-		}
-
-	}
-
-	// no validation rules for PageToken
+	// no validation rules for TokenId
 
 	if len(errors) > 0 {
-		return SearchUsersRequestMultiError(errors)
+		return RevokeServiceAuthTokenRequestMultiError(errors)
 	}
 
 	return nil
 }
 
-// SearchUsersRequestMultiError is an error wrapping multiple validation errors
-// returned by SearchUsersRequest.ValidateAll() if the designated constraints
-// aren't met.
-type SearchUsersRequestMultiError []error
+// RevokeServiceAuthTokenRequestMultiError is an error wrapping multiple
+// validation errors returned by RevokeServiceAuthTokenRequest.ValidateAll()
+// if the designated constraints aren't met.
+type RevokeServiceAuthTokenRequestMultiError []error
 
 // Error returns a concatenation of all the error messages it wraps.
-func (m SearchUsersRequestMultiError) Error() string {
+func (m RevokeServiceAuthTokenRequestMultiError) Error() string {
 	var msgs []string
 	for _, err := range m {
 		msgs = append(msgs, err.Error())
@@ -11588,11 +16869,12 @@ func (m SearchUsersRequestMultiError) Error() string {
 }
 
 // AllErrors returns a list of validation violation errors.
-func (m SearchUsersRequestMultiError) AllErrors() []error { return m }
+func (m RevokeServiceAuthTokenRequestMultiError) AllErrors() []error { return m }
 
-// SearchUsersRequestValidationError is the validation error returned by
-// SearchUsersRequest.Validate if the designated constraints aren't met.
-type SearchUsersRequestValidationError struct {
+// RevokeServiceAuthTokenRequestValidationError is the validation error
+// returned by RevokeServiceAuthTokenRequest.Validate if the designated
+// constraints aren't met.
+type RevokeServiceAuthTokenRequestValidationError struct {
 	field  string
 	reason string
 	cause  error
@@ -11600,24 +16882,24 @@ type SearchUsersRequestValidationError struct {
 }
 
 // Field function returns field value.
-func (e SearchUsersRequestValidationError) Field() string { return e.field }
+func (e RevokeServiceAuthTokenRequestValidationError) Field() string { return e.field }
 
 // Reason function returns reason value.
-func (e SearchUsersRequestValidationError) Reason() string { return e.reason }
+func (e RevokeServiceAuthTokenRequestValidationError) Reason() string { return e.reason }
 
 // Cause function returns cause value.
-func (e SearchUsersRequestValidationError) Cause() error { return e.cause }
+func (e RevokeServiceAuthTokenRequestValidationError) Cause() error { return e.cause }
 
 // Key function returns key value.
-func (e SearchUsersRequestValidationError) Key() bool { return e.key }
+func (e RevokeServiceAuthTokenRequestValidationError) Key() bool { return e.key }
 
 // ErrorName returns error name.
-func (e SearchUsersRequestValidationError) ErrorName() string {
-	return "SearchUsersRequestValidationError"
+func (e RevokeServiceAuthTokenRequestValidationError) ErrorName() string {
+	return "RevokeServiceAuthTokenRequestValidationError"
 }
 
 // Error satisfies the builtin error interface
-func (e SearchUsersRequestValidationError) Error() string {
+func (e RevokeServiceAuthTokenRequestValidationError) Error() string {
 	cause := ""
 	if e.cause != nil {
 		cause = fmt.Sprintf(" | caused by: %v", e.cause)
@@ -11629,14 +16911,14 @@ func (e SearchUsersRequestValidationError) Error() string {
 	}
 
 	return fmt.Sprintf(
-		"invalid %sSearchUsersRequest.%s: %s%s",
+		"invalid %sRevokeServiceAuthTokenRequest.%s: %s%s",
 		key,
 		e.field,
 		e.reason,
 		cause)
 }
 
-var _ error = SearchUsersRequestValidationError{}
+var _ error = RevokeServiceAuthTokenRequestValidationError{}
 
 var _ interface {
 	Field() string
@@ -11644,80 +16926,44 @@ var _ interface {
 	Key() bool
 	Cause() error
 	ErrorName() string
-} = SearchUsersRequestValidationError{}
+} = RevokeServiceAuthTokenRequestValidationError{}
 
-// Validate checks the field values on SearchUsersResponse with the rules
-// defined in the proto definition for this message. If any rules are
+// Validate checks the field values on RevokeServiceAuthTokenResponse with the
+// rules defined in the proto definition for this message. If any rules are
 // violated, the first error encountered is returned, or nil if there are no violations.
-func (m *SearchUsersResponse) Validate() error {
+func (m *RevokeServiceAuthTokenResponse) Validate() error {
 	return m.validate(false)
 }
 
-// ValidateAll checks the field values on SearchUsersResponse with the rules
-// defined in the proto definition for this message. If any rules are
-// violated, the result is a list of violation errors wrapped in
-// SearchUsersResponseMultiError, or nil if none found.
-func (m *SearchUsersResponse) ValidateAll() error {
+// ValidateAll checks the field values on RevokeServiceAuthTokenResponse with
+// the rules defined in the proto definition for this message. If any rules
+// are violated, the result is a list of violation errors wrapped in
+// RevokeServiceAuthTokenResponseMultiError, or nil if none found.
+func (m *RevokeServiceAuthTokenResponse) ValidateAll() error {
 	return m.validate(true)
 }
 
-func (m *SearchUsersResponse) validate(all bool) error {
+func (m *RevokeServiceAuthTokenResponse) validate(all bool) error {
 	if m == nil {
 		return nil
 	}
 
 	var errors []error
 
-	for idx, item := range m.GetUsers() {
-		_, _ = idx, item
-
-		if all {
-			switch v := interface{}(item).(type) {
-			case interface{ ValidateAll() error }:
-				if err := v.ValidateAll(); err != nil {
-					errors = append(errors, SearchUsersResponseValidationError{
-						field:  fmt.Sprintf("Users[%v]", idx),
-						reason: "embedded message failed validation",
-						cause:  err,
-					})
-				}
-			case interface{ Validate() error }:
-				if err := v.Validate(); err != nil {
-					errors = append(errors, SearchUsersResponseValidationError{
-						field:  fmt.Sprintf("Users[%v]", idx),
-						reason: "embedded message failed validation",
-						cause:  err,
-					})
-				}
-			}
-		} else if v, ok := interface{}(item).(interface{ Validate() error }); ok {
-			if err := v.Validate(); err != nil {
-				return SearchUsersResponseValidationError{
-					field:  fmt.Sprintf("Users[%v]", idx),
-					reason: "embedded message failed validation",
-					cause:  err,
-				}
-			}
-		}
-
-	}
-
-	// no validation rules for NextPageToken
-
 	if len(errors) > 0 {
-		return SearchUsersResponseMultiError(errors)
+		return RevokeServiceAuthTokenResponseMultiError(errors)
 	}
 
 	return nil
 }
 
-// SearchUsersResponseMultiError is an error wrapping multiple validation
-// errors returned by SearchUsersResponse.ValidateAll() if the designated
-// constraints aren't met.
-type SearchUsersResponseMultiError []error
+// RevokeServiceAuthTokenResponseMultiError is an error wrapping multiple
+// validation errors returned by RevokeServiceAuthTokenResponse.ValidateAll()
+// if the designated constraints aren't met.
+type RevokeServiceAuthTokenResponseMultiError []error
 
 // Error returns a concatenation of all the error messages it wraps.
-func (m SearchUsersResponseMultiError) Error() string {
+func (m RevokeServiceAuthTokenResponseMultiError) Error() string {
 	var msgs []string
 	for _, err := range m {
 		msgs = append(msgs, err.Error())
@@ -11726,11 +16972,12 @@ func (m SearchUsersResponseMultiError) Error() string {
 }
 
 // AllErrors returns a list of validation violation errors.
-func (m SearchUsersResponseMultiError) AllErrors() []error { return m }
+func (m RevokeServiceAuthTokenResponseMultiError) AllErrors() []error { return m }
 
-// SearchUsersResponseValidationError is the validation error returned by
-// SearchUsersResponse.Validate if the designated constraints aren't met.
-type SearchUsersResponseValidationError struct {
+// RevokeServiceAuthTokenResponseValidationError is the validation error
+// returned by RevokeServiceAuthTokenResponse.Validate if the designated
+// constraints aren't met.
+type RevokeServiceAuthTokenResponseValidationError struct {
 	field  string
 	reason string
 	cause  error
@@ -11738,24 +16985,24 @@ type SearchUsersResponseValidationError struct {
 }
 
 // Field function returns field value.
-func (e SearchUsersResponseValidationError) Field() string { return e.field }
+func (e RevokeServiceAuthTokenResponseValidationError) Field() string { return e.field }
 
 // Reason function returns reason value.
-func (e SearchUsersResponseValidationError) Reason() string { return e.reason }
+func (e RevokeServiceAuthTokenResponseValidationError) Reason() string { return e.reason }
 
 // Cause function returns cause value.
-func (e SearchUsersResponseValidationError) Cause() error { return e.cause }
+func (e RevokeServiceAuthTokenResponseValidationError) Cause() error { return e.cause }
 
 // Key function returns key value.
-func (e SearchUsersResponseValidationError) Key() bool { return e.key }
+func (e RevokeServiceAuthTokenResponseValidationError) Key() bool { return e.key }
 
 // ErrorName returns error name.
-func (e SearchUsersResponseValidationError) ErrorName() string {
-	return "SearchUsersResponseValidationError"
+func (e RevokeServiceAuthTokenResponseValidationError) ErrorName() string {
+	return "RevokeServiceAuthTokenResponseValidationError"
 }
 
 // Error satisfies the builtin error interface
-func (e SearchUsersResponseValidationError) Error() string {
+func (e RevokeServiceAuthTokenResponseValidationError) Error() string {
 	cause := ""
 	if e.cause != nil {
 		cause = fmt.Sprintf(" | caused by: %v", e.cause)
@@ -11767,14 +17014,14 @@ func (e SearchUsersResponseValidationError) Error() string {
 	}
 
 	return fmt.Sprintf(
-		"invalid %sSearchUsersResponse.%s: %s%s",
+		"invalid %sRevokeServiceAuthTokenResponse.%s: %s%s",
 		key,
 		e.field,
 		e.reason,
 		cause)
 }
 
-var _ error = SearchUsersResponseValidationError{}
+var _ error = RevokeServiceAuthTokenResponseValidationError{}
 
 var _ interface {
 	Field() string
@@ -11782,44 +17029,57 @@ var _ interface {
 	Key() bool
 	Cause() error
 	ErrorName() string
-} = SearchUsersResponseValidationError{}
+} = RevokeServiceAuthTokenResponseValidationError{}
 
-// Validate checks the field values on RevokeCurrentAuthTokenRequest with the
+// Validate checks the field values on IssueServiceAuthTokenRequest with the
 // rules defined in the proto definition for this message. If any rules are
 // violated, the first error encountered is returned, or nil if there are no violations.
-func (m *RevokeCurrentAuthTokenRequest) Validate() error {
+func (m *IssueServiceAuthTokenRequest) Validate() error {
 	return m.validate(false)
 }
 
-// ValidateAll checks the field values on RevokeCurrentAuthTokenRequest with
-// the rules defined in the proto definition for this message. If any rules
-// are violated, the result is a list of violation errors wrapped in
-// RevokeCurrentAuthTokenRequestMultiError, or nil if none found.
-func (m *RevokeCurrentAuthTokenRequest) ValidateAll() error {
+// ValidateAll checks the field values on IssueServiceAuthTokenRequest with the
+// rules defined in the proto definition for this message. If any rules are
+// violated, the result is a list of violation errors wrapped in
+// IssueServiceAuthTokenRequestMultiError, or nil if none found.
+func (m *IssueServiceAuthTokenRequest) ValidateAll() error {
 	return m.validate(true)
 }
 
-func (m *RevokeCurrentAuthTokenRequest) validate(all bool) error {
+func (m *IssueServiceAuthTokenRequest) validate(all bool) error {
 	if m == nil {
 		return nil
 	}
 
 	var errors []error
 
+	if utf8.RuneCountInString(m.GetOrganizationName()) < 1 {
+		err := IssueServiceAuthTokenRequestValidationError{
+			field:  "OrganizationName",
+			reason: "value length must be at least 1 runes",
+		}
+		if !all {
+			return err
+		}
+		errors = append(errors, err)
+	}
+
+	// no validation rules for ServiceName
+
 	if len(errors) > 0 {
-		return RevokeCurrentAuthTokenRequestMultiError(errors)
+		return IssueServiceAuthTokenRequestMultiError(errors)
 	}
 
 	return nil
 }
 
-// RevokeCurrentAuthTokenRequestMultiError is an error wrapping multiple
-// validation errors returned by RevokeCurrentAuthTokenRequest.ValidateAll()
-// if the designated constraints aren't met.
-type RevokeCurrentAuthTokenRequestMultiError []error
+// IssueServiceAuthTokenRequestMultiError is an error wrapping multiple
+// validation errors returned by IssueServiceAuthTokenRequest.ValidateAll() if
+// the designated constraints aren't met.
+type IssueServiceAuthTokenRequestMultiError []error
 
 // Error returns a concatenation of all the error messages it wraps.
-func (m RevokeCurrentAuthTokenRequestMultiError) Error() string {
+func (m IssueServiceAuthTokenRequestMultiError) Error() string {
 	var msgs []string
 	for _, err := range m {
 		msgs = append(msgs, err.Error())
@@ -11828,12 +17088,12 @@ func (m RevokeCurrentAuthTokenRequestMultiError) Error() string {
 }
 
 // AllErrors returns a list of validation violation errors.
-func (m RevokeCurrentAuthTokenRequestMultiError) AllErrors() []error { return m }
+func (m IssueServiceAuthTokenRequestMultiError) AllErrors() []error { return m }
 
-// RevokeCurrentAuthTokenRequestValidationError is the validation error
-// returned by RevokeCurrentAuthTokenRequest.Validate if the designated
-// constraints aren't met.
-type RevokeCurrentAuthTokenRequestValidationError struct {
+// IssueServiceAuthTokenRequestValidationError is the validation error returned
+// by IssueServiceAuthTokenRequest.Validate if the designated constraints
+// aren't met.
+type IssueServiceAuthTokenRequestValidationError struct {
 	field  string
 	reason string
 	cause  error
@@ -11841,24 +17101,24 @@ type RevokeCurrentAuthTokenRequestValidationError struct {
 }
 
 // Field function returns field value.
-func (e RevokeCurrentAuthTokenRequestValidationError) Field() string { return e.field }
+func (e IssueServiceAuthTokenRequestValidationError) Field() string { return e.field }
 
 // Reason function returns reason value.
-func (e RevokeCurrentAuthTokenRequestValidationError) Reason() string { return e.reason }
+func (e IssueServiceAuthTokenRequestValidationError) Reason() string { return e.reason }
 
 // Cause function returns cause value.
-func (e RevokeCurrentAuthTokenRequestValidationError) Cause() error { return e.cause }
+func (e IssueServiceAuthTokenRequestValidationError) Cause() error { return e.cause }
 
 // Key function returns key value.
-func (e RevokeCurrentAuthTokenRequestValidationError) Key() bool { return e.key }
+func (e IssueServiceAuthTokenRequestValidationError) Key() bool { return e.key }
 
 // ErrorName returns error name.
-func (e RevokeCurrentAuthTokenRequestValidationError) ErrorName() string {
-	return "RevokeCurrentAuthTokenRequestValidationError"
+func (e IssueServiceAuthTokenRequestValidationError) ErrorName() string {
+	return "IssueServiceAuthTokenRequestValidationError"
 }
 
 // Error satisfies the builtin error interface
-func (e RevokeCurrentAuthTokenRequestValidationError) Error() string {
+func (e IssueServiceAuthTokenRequestValidationError) Error() string {
 	cause := ""
 	if e.cause != nil {
 		cause = fmt.Sprintf(" | caused by: %v", e.cause)
@@ -11870,14 +17130,14 @@ func (e RevokeCurrentAuthTokenRequestValidationError) Error() string {
 	}
 
 	return fmt.Sprintf(
-		"invalid %sRevokeCurrentAuthTokenRequest.%s: %s%s",
+		"invalid %sIssueServiceAuthTokenRequest.%s: %s%s",
 		key,
 		e.field,
 		e.reason,
 		cause)
 }
 
-var _ error = RevokeCurrentAuthTokenRequestValidationError{}
+var _ error = IssueServiceAuthTokenRequestValidationError{}
 
 var _ interface {
 	Field() string
@@ -11885,46 +17145,46 @@ var _ interface {
 	Key() bool
 	Cause() error
 	ErrorName() string
-} = RevokeCurrentAuthTokenRequestValidationError{}
+} = IssueServiceAuthTokenRequestValidationError{}
 
-// Validate checks the field values on RevokeCurrentAuthTokenResponse with the
+// Validate checks the field values on IssueServiceAuthTokenResponse with the
 // rules defined in the proto definition for this message. If any rules are
 // violated, the first error encountered is returned, or nil if there are no violations.
-func (m *RevokeCurrentAuthTokenResponse) Validate() error {
+func (m *IssueServiceAuthTokenResponse) Validate() error {
 	return m.validate(false)
 }
 
-// ValidateAll checks the field values on RevokeCurrentAuthTokenResponse with
+// ValidateAll checks the field values on IssueServiceAuthTokenResponse with
 // the rules defined in the proto definition for this message. If any rules
 // are violated, the result is a list of violation errors wrapped in
-// RevokeCurrentAuthTokenResponseMultiError, or nil if none found.
-func (m *RevokeCurrentAuthTokenResponse) ValidateAll() error {
+// IssueServiceAuthTokenResponseMultiError, or nil if none found.
+func (m *IssueServiceAuthTokenResponse) ValidateAll() error {
 	return m.validate(true)
 }
 
-func (m *RevokeCurrentAuthTokenResponse) validate(all bool) error {
+func (m *IssueServiceAuthTokenResponse) validate(all bool) error {
 	if m == nil {
 		return nil
 	}
 
 	var errors []error
 
-	// no validation rules for TokenId
+	// no validation rules for Token
 
 	if len(errors) > 0 {
-		return RevokeCurrentAuthTokenResponseMultiError(errors)
+		return IssueServiceAuthTokenResponseMultiError(errors)
 	}
 
 	return nil
 }
 
-// RevokeCurrentAuthTokenResponseMultiError is an error wrapping multiple
-// validation errors returned by RevokeCurrentAuthTokenResponse.ValidateAll()
+// IssueServiceAuthTokenResponseMultiError is an error wrapping multiple
+// validation errors returned by IssueServiceAuthTokenResponse.ValidateAll()
 // if the designated constraints aren't met.
-type RevokeCurrentAuthTokenResponseMultiError []error
+type IssueServiceAuthTokenResponseMultiError []error
 
 // Error returns a concatenation of all the error messages it wraps.
-func (m RevokeCurrentAuthTokenResponseMultiError) Error() string {
+func (m IssueServiceAuthTokenResponseMultiError) Error() string {
 	var msgs []string
 	for _, err := range m {
 		msgs = append(msgs, err.Error())
@@ -11933,12 +17193,12 @@ func (m RevokeCurrentAuthTokenResponseMultiError) Error() string {
 }
 
 // AllErrors returns a list of validation violation errors.
-func (m RevokeCurrentAuthTokenResponseMultiError) AllErrors() []error { return m }
+func (m IssueServiceAuthTokenResponseMultiError) AllErrors() []error { return m }
 
-// RevokeCurrentAuthTokenResponseValidationError is the validation error
-// returned by RevokeCurrentAuthTokenResponse.Validate if the designated
+// IssueServiceAuthTokenResponseValidationError is the validation error
+// returned by IssueServiceAuthTokenResponse.Validate if the designated
 // constraints aren't met.
-type RevokeCurrentAuthTokenResponseValidationError struct {
+type IssueServiceAuthTokenResponseValidationError struct {
 	field  string
 	reason string
 	cause  error
@@ -11946,24 +17206,24 @@ type RevokeCurrentAuthTokenResponseValidationError struct {
 }
 
 // Field function returns field value.
-func (e RevokeCurrentAuthTokenResponseValidationError) Field() string { return e.field }
+func (e IssueServiceAuthTokenResponseValidationError) Field() string { return e.field }
 
 // Reason function returns reason value.
-func (e RevokeCurrentAuthTokenResponseValidationError) Reason() string { return e.reason }
+func (e IssueServiceAuthTokenResponseValidationError) Reason() string { return e.reason }
 
 // Cause function returns cause value.
-func (e RevokeCurrentAuthTokenResponseValidationError) Cause() error { return e.cause }
+func (e IssueServiceAuthTokenResponseValidationError) Cause() error { return e.cause }
 
 // Key function returns key value.
-func (e RevokeCurrentAuthTokenResponseValidationError) Key() bool { return e.key }
+func (e IssueServiceAuthTokenResponseValidationError) Key() bool { return e.key }
 
 // ErrorName returns error name.
-func (e RevokeCurrentAuthTokenResponseValidationError) ErrorName() string {
-	return "RevokeCurrentAuthTokenResponseValidationError"
+func (e IssueServiceAuthTokenResponseValidationError) ErrorName() string {
+	return "IssueServiceAuthTokenResponseValidationError"
 }
 
 // Error satisfies the builtin error interface
-func (e RevokeCurrentAuthTokenResponseValidationError) Error() string {
+func (e IssueServiceAuthTokenResponseValidationError) Error() string {
 	cause := ""
 	if e.cause != nil {
 		cause = fmt.Sprintf(" | caused by: %v", e.cause)
@@ -11975,14 +17235,14 @@ func (e RevokeCurrentAuthTokenResponseValidationError) Error() string {
 	}
 
 	return fmt.Sprintf(
-		"invalid %sRevokeCurrentAuthTokenResponse.%s: %s%s",
+		"invalid %sIssueServiceAuthTokenResponse.%s: %s%s",
 		key,
 		e.field,
 		e.reason,
 		cause)
 }
 
-var _ error = RevokeCurrentAuthTokenResponseValidationError{}
+var _ error = IssueServiceAuthTokenResponseValidationError{}
 
 var _ interface {
 	Field() string
@@ -11990,36 +17250,34 @@ var _ interface {
 	Key() bool
 	Cause() error
 	ErrorName() string
-} = RevokeCurrentAuthTokenResponseValidationError{}
+} = IssueServiceAuthTokenResponseValidationError{}
 
-// Validate checks the field values on IssueRepresentativeAuthTokenRequest with
-// the rules defined in the proto definition for this message. If any rules
-// are violated, the first error encountered is returned, or nil if there are
-// no violations.
-func (m *IssueRepresentativeAuthTokenRequest) Validate() error {
+// Validate checks the field values on ListServiceAuthTokensRequest with the
+// rules defined in the proto definition for this message. If any rules are
+// violated, the first error encountered is returned, or nil if there are no violations.
+func (m *ListServiceAuthTokensRequest) Validate() error {
 	return m.validate(false)
 }
 
-// ValidateAll checks the field values on IssueRepresentativeAuthTokenRequest
-// with the rules defined in the proto definition for this message. If any
-// rules are violated, the result is a list of violation errors wrapped in
-// IssueRepresentativeAuthTokenRequestMultiError, or nil if none found.
-func (m *IssueRepresentativeAuthTokenRequest) ValidateAll() error {
+// ValidateAll checks the field values on ListServiceAuthTokensRequest with the
+// rules defined in the proto definition for this message. If any rules are
+// violated, the result is a list of violation errors wrapped in
+// ListServiceAuthTokensRequestMultiError, or nil if none found.
+func (m *ListServiceAuthTokensRequest) ValidateAll() error {
 	return m.validate(true)
 }
 
-func (m *IssueRepresentativeAuthTokenRequest) validate(all bool) error {
+func (m *ListServiceAuthTokensRequest) validate(all bool) error {
 	if m == nil {
 		return nil
 	}
 
 	var errors []error
 
-	if err := m._validateEmail(m.GetEmail()); err != nil {
-		err = IssueRepresentativeAuthTokenRequestValidationError{
-			field:  "Email",
-			reason: "value must be a valid email address",
-			cause:  err,
+	if utf8.RuneCountInString(m.GetOrganizationName()) < 1 {
+		err := ListServiceAuthTokensRequestValidationError{
+			field:  "OrganizationName",
+			reason: "value length must be at least 1 runes",
 		}
 		if !all {
 			return err
@@ -12027,73 +17285,22 @@ func (m *IssueRepresentativeAuthTokenRequest) validate(all bool) error {
 		errors = append(errors, err)
 	}
 
-	// no validation rules for TtlMinutes
+	// no validation rules for ServiceName
 
 	if len(errors) > 0 {
-		return IssueRepresentativeAuthTokenRequestMultiError(errors)
-	}
-
-	return nil
-}
-
-func (m *IssueRepresentativeAuthTokenRequest) _validateHostname(host string) error {
-	s := strings.ToLower(strings.TrimSuffix(host, "."))
-
-	if len(host) > 253 {
-		return errors.New("hostname cannot exceed 253 characters")
-	}
-
-	for _, part := range strings.Split(s, ".") {
-		if l := len(part); l == 0 || l > 63 {
-			return errors.New("hostname part must be non-empty and cannot exceed 63 characters")
-		}
-
-		if part[0] == '-' {
-			return errors.New("hostname parts cannot begin with hyphens")
-		}
-
-		if part[len(part)-1] == '-' {
-			return errors.New("hostname parts cannot end with hyphens")
-		}
-
-		for _, r := range part {
-			if (r < 'a' || r > 'z') && (r < '0' || r > '9') && r != '-' {
-				return fmt.Errorf("hostname parts can only contain alphanumeric characters or hyphens, got %q", string(r))
-			}
-		}
+		return ListServiceAuthTokensRequestMultiError(errors)
 	}
 
 	return nil
 }
 
-func (m *IssueRepresentativeAuthTokenRequest) _validateEmail(addr string) error {
-	a, err := mail.ParseAddress(addr)
-	if err != nil {
-		return err
-	}
-	addr = a.Address
-
-	if len(addr) > 254 {
-		return errors.New("email addresses cannot exceed 254 characters")
-	}
-
-	parts := strings.SplitN(addr, "@", 2)
-
-	if len(parts[0]) > 64 {
-		return errors.New("email address local phrase cannot exceed 64 characters")
-	}
-
-	return m._validateHostname(parts[1])
-}
-
-// IssueRepresentativeAuthTokenRequestMultiError is an error wrapping multiple
-// validation errors returned by
-// IssueRepresentativeAuthTokenRequest.ValidateAll() if the designated
-// constraints aren't met.
-type IssueRepresentativeAuthTokenRequestMultiError []error
+// ListServiceAuthTokensRequestMultiError is an error wrapping multiple
+// validation errors returned by ListServiceAuthTokensRequest.ValidateAll() if
+// the designated constraints aren't met.
+type ListServiceAuthTokensRequestMultiError []error
 
 // Error returns a concatenation of all the error messages it wraps.
-func (m IssueRepresentativeAuthTokenRequestMultiError) Error() string {
+func (m ListServiceAuthTokensRequestMultiError) Error() string {
 	var msgs []string
 	for _, err := range m {
 		msgs = append(msgs, err.Error())
@@ -12102,12 +17309,12 @@ func (m IssueRepresentativeAuthTokenRequestMultiError) Error() string {
 }
 
 // AllErrors returns a list of validation violation errors.
-func (m IssueRepresentativeAuthTokenRequestMultiError) AllErrors() []error { return m }
+func (m ListServiceAuthTokensRequestMultiError) AllErrors() []error { return m }
 
-// IssueRepresentativeAuthTokenRequestValidationError is the validation error
-// returned by IssueRepresentativeAuthTokenRequest.Validate if the designated
-// constraints aren't met.
-type IssueRepresentativeAuthTokenRequestValidationError struct {
+// ListServiceAuthTokensRequestValidationError is the validation error returned
+// by ListServiceAuthTokensRequest.Validate if the designated constraints
+// aren't met.
+type ListServiceAuthTokensRequestValidationError struct {
 	field  string
 	reason string
 	cause  error
@@ -12115,24 +17322,24 @@ type IssueRepresentativeAuthTokenRequestValidationError struct {
 }
 
 // Field function returns field value.
-func (e IssueRepresentativeAuthTokenRequestValidationError) Field() string { return e.field }
+func (e ListServiceAuthTokensRequestValidationError) Field() string { return e.field }
 
 // Reason function returns reason value.
-func (e IssueRepresentativeAuthTokenRequestValidationError) Reason() string { return e.reason }
+func (e ListServiceAuthTokensRequestValidationError) Reason() string { return e.reason }
 
 // Cause function returns cause value.
-func (e IssueRepresentativeAuthTokenRequestValidationError) Cause() error { return e.cause }
+func (e ListServiceAuthTokensRequestValidationError) Cause() error { return e.cause }
 
 // Key function returns key value.
-func (e IssueRepresentativeAuthTokenRequestValidationError) Key() bool { return e.key }
+func (e ListServiceAuthTokensRequestValidationError) Key() bool { return e.key }
 
 // ErrorName returns error name.
-func (e IssueRepresentativeAuthTokenRequestValidationError) ErrorName() string {
-	return "IssueRepresentativeAuthTokenRequestValidationError"
+func (e ListServiceAuthTokensRequestValidationError) ErrorName() string {
+	return "ListServiceAuthTokensRequestValidationError"
 }
 
 // Error satisfies the builtin error interface
-func (e IssueRepresentativeAuthTokenRequestValidationError) Error() string {
+func (e ListServiceAuthTokensRequestValidationError) Error() string {
 	cause := ""
 	if e.cause != nil {
 		cause = fmt.Sprintf(" | caused by: %v", e.cause)
@@ -12144,14 +17351,14 @@ func (e IssueRepresentativeAuthTokenRequestValidationError) Error() string {
 	}
 
 	return fmt.Sprintf(
-		"invalid %sIssueRepresentativeAuthTokenRequest.%s: %s%s",
+		"invalid %sListServiceAuthTokensRequest.%s: %s%s",
 		key,
 		e.field,
 		e.reason,
 		cause)
 }
 
-var _ error = IssueRepresentativeAuthTokenRequestValidationError{}
+var _ error = ListServiceAuthTokensRequestValidationError{}
 
 var _ interface {
 	Field() string
@@ -12159,48 +17366,78 @@ var _ interface {
 	Key() bool
 	Cause() error
 	ErrorName() string
-} = IssueRepresentativeAuthTokenRequestValidationError{}
+} = ListServiceAuthTokensRequestValidationError{}
 
-// Validate checks the field values on IssueRepresentativeAuthTokenResponse
-// with the rules defined in the proto definition for this message. If any
-// rules are violated, the first error encountered is returned, or nil if
-// there are no violations.
-func (m *IssueRepresentativeAuthTokenResponse) Validate() error {
+// Validate checks the field values on ListServiceAuthTokensResponse with the
+// rules defined in the proto definition for this message. If any rules are
+// violated, the first error encountered is returned, or nil if there are no violations.
+func (m *ListServiceAuthTokensResponse) Validate() error {
 	return m.validate(false)
 }
 
-// ValidateAll checks the field values on IssueRepresentativeAuthTokenResponse
-// with the rules defined in the proto definition for this message. If any
-// rules are violated, the result is a list of violation errors wrapped in
-// IssueRepresentativeAuthTokenResponseMultiError, or nil if none found.
-func (m *IssueRepresentativeAuthTokenResponse) ValidateAll() error {
+// ValidateAll checks the field values on ListServiceAuthTokensResponse with
+// the rules defined in the proto definition for this message. If any rules
+// are violated, the result is a list of violation errors wrapped in
+// ListServiceAuthTokensResponseMultiError, or nil if none found.
+func (m *ListServiceAuthTokensResponse) ValidateAll() error {
 	return m.validate(true)
 }
 
-func (m *IssueRepresentativeAuthTokenResponse) validate(all bool) error {
+func (m *ListServiceAuthTokensResponse) validate(all bool) error {
 	if m == nil {
 		return nil
 	}
 
 	var errors []error
 
-	// no validation rules for Token
+	for idx, item := range m.GetTokens() {
+		_, _ = idx, item
+
+		if all {
+			switch v := interface{}(item).(type) {
+			case interface{ ValidateAll() error }:
+				if err := v.ValidateAll(); err != nil {
+					errors = append(errors, ListServiceAuthTokensResponseValidationError{
+						field:  fmt.Sprintf("Tokens[%v]", idx),
+						reason: "embedded message failed validation",
+						cause:  err,
+					})
+				}
+			case interface{ Validate() error }:
+				if err := v.Validate(); err != nil {
+					errors = append(errors, ListServiceAuthTokensResponseValidationError{
+						field:  fmt.Sprintf("Tokens[%v]", idx),
+						reason: "embedded message failed validation",
+						cause:  err,
+					})
+				}
+			}
+		} else if v, ok := interface{}(item).(interface{ Validate() error }); ok {
+			if err := v.Validate(); err != nil {
+				return ListServiceAuthTokensResponseValidationError{
+					field:  fmt.Sprintf("Tokens[%v]", idx),
+					reason: "embedded message failed validation",
+					cause:  err,
+				}
+			}
+		}
+
+	}
 
 	if len(errors) > 0 {
-		return IssueRepresentativeAuthTokenResponseMultiError(errors)
+		return ListServiceAuthTokensResponseMultiError(errors)
 	}
 
 	return nil
 }
 
-// IssueRepresentativeAuthTokenResponseMultiError is an error wrapping multiple
-// validation errors returned by
-// IssueRepresentativeAuthTokenResponse.ValidateAll() if the designated
-// constraints aren't met.
-type IssueRepresentativeAuthTokenResponseMultiError []error
+// ListServiceAuthTokensResponseMultiError is an error wrapping multiple
+// validation errors returned by ListServiceAuthTokensResponse.ValidateAll()
+// if the designated constraints aren't met.
+type ListServiceAuthTokensResponseMultiError []error
 
 // Error returns a concatenation of all the error messages it wraps.
-func (m IssueRepresentativeAuthTokenResponseMultiError) Error() string {
+func (m ListServiceAuthTokensResponseMultiError) Error() string {
 	var msgs []string
 	for _, err := range m {
 		msgs = append(msgs, err.Error())
@@ -12209,12 +17446,12 @@ func (m IssueRepresentativeAuthTokenResponseMultiError) Error() string {
 }
 
 // AllErrors returns a list of validation violation errors.
-func (m IssueRepresentativeAuthTokenResponseMultiError) AllErrors() []error { return m }
+func (m ListServiceAuthTokensResponseMultiError) AllErrors() []error { return m }
 
-// IssueRepresentativeAuthTokenResponseValidationError is the validation error
-// returned by IssueRepresentativeAuthTokenResponse.Validate if the designated
+// ListServiceAuthTokensResponseValidationError is the validation error
+// returned by ListServiceAuthTokensResponse.Validate if the designated
 // constraints aren't met.
-type IssueRepresentativeAuthTokenResponseValidationError struct {
+type ListServiceAuthTokensResponseValidationError struct {
 	field  string
 	reason string
 	cause  error
@@ -12222,24 +17459,24 @@ type IssueRepresentativeAuthTokenResponseValidationError struct {
 }
 
 // Field function returns field value.
-func (e IssueRepresentativeAuthTokenResponseValidationError) Field() string { return e.field }
+func (e ListServiceAuthTokensResponseValidationError) Field() string { return e.field }
 
 // Reason function returns reason value.
-func (e IssueRepresentativeAuthTokenResponseValidationError) Reason() string { return e.reason }
+func (e ListServiceAuthTokensResponseValidationError) Reason() string { return e.reason }
 
 // Cause function returns cause value.
-func (e IssueRepresentativeAuthTokenResponseValidationError) Cause() error { return e.cause }
+func (e ListServiceAuthTokensResponseValidationError) Cause() error { return e.cause }
 
 // Key function returns key value.
-func (e IssueRepresentativeAuthTokenResponseValidationError) Key() bool { return e.key }
+func (e ListServiceAuthTokensResponseValidationError) Key() bool { return e.key }
 
 // ErrorName returns error name.
-func (e IssueRepresentativeAuthTokenResponseValidationError) ErrorName() string {
-	return "IssueRepresentativeAuthTokenResponseValidationError"
+func (e ListServiceAuthTokensResponseValidationError) ErrorName() string {
+	return "ListServiceAuthTokensResponseValidationError"
 }
 
 // Error satisfies the builtin error interface
-func (e IssueRepresentativeAuthTokenResponseValidationError) Error() string {
+func (e ListServiceAuthTokensResponseValidationError) Error() string {
 	cause := ""
 	if e.cause != nil {
 		cause = fmt.Sprintf(" | caused by: %v", e.cause)
@@ -12251,14 +17488,14 @@ func (e IssueRepresentativeAuthTokenResponseValidationError) Error() string {
 	}
 
 	return fmt.Sprintf(
-		"invalid %sIssueRepresentativeAuthTokenResponse.%s: %s%s",
+		"invalid %sListServiceAuthTokensResponse.%s: %s%s",
 		key,
 		e.field,
 		e.reason,
 		cause)
 }
 
-var _ error = IssueRepresentativeAuthTokenResponseValidationError{}
+var _ error = ListServiceAuthTokensResponseValidationError{}
 
 var _ interface {
 	Field() string
@@ -12266,46 +17503,46 @@ var _ interface {
 	Key() bool
 	Cause() error
 	ErrorName() string
-} = IssueRepresentativeAuthTokenResponseValidationError{}
+} = ListServiceAuthTokensResponseValidationError{}
 
-// Validate checks the field values on RevokeServiceAuthTokenRequest with the
+// Validate checks the field values on GetGithubRepoStatusRequest with the
 // rules defined in the proto definition for this message. If any rules are
 // violated, the first error encountered is returned, or nil if there are no violations.
-func (m *RevokeServiceAuthTokenRequest) Validate() error {
+func (m *GetGithubRepoStatusRequest) Validate() error {
 	return m.validate(false)
 }
 
-// ValidateAll checks the field values on RevokeServiceAuthTokenRequest with
-// the rules defined in the proto definition for this message. If any rules
-// are violated, the result is a list of violation errors wrapped in
-// RevokeServiceAuthTokenRequestMultiError, or nil if none found.
-func (m *RevokeServiceAuthTokenRequest) ValidateAll() error {
+// ValidateAll checks the field values on GetGithubRepoStatusRequest with the
+// rules defined in the proto definition for this message. If any rules are
+// violated, the result is a list of violation errors wrapped in
+// GetGithubRepoStatusRequestMultiError, or nil if none found.
+func (m *GetGithubRepoStatusRequest) ValidateAll() error {
 	return m.validate(true)
 }
 
-func (m *RevokeServiceAuthTokenRequest) validate(all bool) error {
+func (m *GetGithubRepoStatusRequest) validate(all bool) error {
 	if m == nil {
 		return nil
 	}
 
 	var errors []error
 
-	// no validation rules for TokenId
+	// no validation rules for GithubUrl
 
 	if len(errors) > 0 {
-		return RevokeServiceAuthTokenRequestMultiError(errors)
+		return GetGithubRepoStatusRequestMultiError(errors)
 	}
 
 	return nil
 }
 
-// RevokeServiceAuthTokenRequestMultiError is an error wrapping multiple
-// validation errors returned by RevokeServiceAuthTokenRequest.ValidateAll()
-// if the designated constraints aren't met.
-type RevokeServiceAuthTokenRequestMultiError []error
+// GetGithubRepoStatusRequestMultiError is an error wrapping multiple
+// validation errors returned by GetGithubRepoStatusRequest.ValidateAll() if
+// the designated constraints aren't met.
+type GetGithubRepoStatusRequestMultiError []error
 
 // Error returns a concatenation of all the error messages it wraps.
-func (m RevokeServiceAuthTokenRequestMultiError) Error() string {
+func (m GetGithubRepoStatusRequestMultiError) Error() string {
 	var msgs []string
 	for _, err := range m {
 		msgs = append(msgs, err.Error())
@@ -12314,12 +17551,11 @@ func (m RevokeServiceAuthTokenRequestMultiError) Error() string {
 }
 
 // AllErrors returns a list of validation violation errors.
-func (m RevokeServiceAuthTokenRequestMultiError) AllErrors() []error { return m }
+func (m GetGithubRepoStatusRequestMultiError) AllErrors() []error { return m }
 
-// RevokeServiceAuthTokenRequestValidationError is the validation error
-// returned by RevokeServiceAuthTokenRequest.Validate if the designated
-// constraints aren't met.
-type RevokeServiceAuthTokenRequestValidationError struct {
+// GetGithubRepoStatusRequestValidationError is the validation error returned
+// by GetGithubRepoStatusRequest.Validate if the designated constraints aren't met.
+type GetGithubRepoStatusRequestValidationError struct {
 	field  string
 	reason string
 	cause  error
@@ -12327,24 +17563,24 @@ type RevokeServiceAuthTokenRequestValidationError struct {
 }
 
 // Field function returns field value.
-func (e RevokeServiceAuthTokenRequestValidationError) Field() string { return e.field }
+func (e GetGithubRepoStatusRequestValidationError) Field() string { return e.field }
 
 // Reason function returns reason value.
-func (e RevokeServiceAuthTokenRequestValidationError) Reason() string { return e.reason }
+func (e GetGithubRepoStatusRequestValidationError) Reason() string { return e.reason }
 
 // Cause function returns cause value.
-func (e RevokeServiceAuthTokenRequestValidationError) Cause() error { return e.cause }
+func (e GetGithubRepoStatusRequestValidationError) Cause() error { return e.cause }
 
 // Key function returns key value.
-func (e RevokeServiceAuthTokenRequestValidationError) Key() bool { return e.key }
+func (e GetGithubRepoStatusRequestValidationError) Key() bool { return e.key }
 
 // ErrorName returns error name.
-func (e RevokeServiceAuthTokenRequestValidationError) ErrorName() string {
-	return "RevokeServiceAuthTokenRequestValidationError"
+func (e GetGithubRepoStatusRequestValidationError) ErrorName() string {
+	return "GetGithubRepoStatusRequestValidationError"
 }
 
 // Error satisfies the builtin error interface
-func (e RevokeServiceAuthTokenRequestValidationError) Error() string {
+func (e GetGithubRepoStatusRequestValidationError) Error() string {
 	cause := ""
 	if e.cause != nil {
 		cause = fmt.Sprintf(" | caused by: %v", e.cause)
@@ -12356,14 +17592,14 @@ func (e RevokeServiceAuthTokenRequestValidationError) Error() string {
 	}
 
 	return fmt.Sprintf(
-		"invalid %sRevokeServiceAuthTokenRequest.%s: %s%s",
+		"invalid %sGetGithubRepoStatusRequest.%s: %s%s",
 		key,
 		e.field,
 		e.reason,
 		cause)
 }
 
-var _ error = RevokeServiceAuthTokenRequestValidationError{}
+var _ error = GetGithubRepoStatusRequestValidationError{}
 
 var _ interface {
 	Field() string
@@ -12371,44 +17607,50 @@ var _ interface {
 	Key() bool
 	Cause() error
 	ErrorName() string
-} = RevokeServiceAuthTokenRequestValidationError{}
+} = GetGithubRepoStatusRequestValidationError{}
 
-// Validate checks the field values on RevokeServiceAuthTokenResponse with the
+// Validate checks the field values on GetGithubRepoStatusResponse with the
 // rules defined in the proto definition for this message. If any rules are
 // violated, the first error encountered is returned, or nil if there are no violations.
-func (m *RevokeServiceAuthTokenResponse) Validate() error {
+func (m *GetGithubRepoStatusResponse) Validate() error {
 	return m.validate(false)
 }
 
-// ValidateAll checks the field values on RevokeServiceAuthTokenResponse with
-// the rules defined in the proto definition for this message. If any rules
-// are violated, the result is a list of violation errors wrapped in
-// RevokeServiceAuthTokenResponseMultiError, or nil if none found.
-func (m *RevokeServiceAuthTokenResponse) ValidateAll() error {
+// ValidateAll checks the field values on GetGithubRepoStatusResponse with the
+// rules defined in the proto definition for this message. If any rules are
+// violated, the result is a list of violation errors wrapped in
+// GetGithubRepoStatusResponseMultiError, or nil if none found.
+func (m *GetGithubRepoStatusResponse) ValidateAll() error {
 	return m.validate(true)
 }
 
-func (m *RevokeServiceAuthTokenResponse) validate(all bool) error {
+func (m *GetGithubRepoStatusResponse) validate(all bool) error {
 	if m == nil {
 		return nil
 	}
 
 	var errors []error
 
+	// no validation rules for HasAccess
+
+	// no validation rules for GrantAccessUrl
+
+	// no validation rules for DefaultBranch
+
 	if len(errors) > 0 {
-		return RevokeServiceAuthTokenResponseMultiError(errors)
+		return GetGithubRepoStatusResponseMultiError(errors)
 	}
 
 	return nil
 }
 
-// RevokeServiceAuthTokenResponseMultiError is an error wrapping multiple
-// validation errors returned by RevokeServiceAuthTokenResponse.ValidateAll()
-// if the designated constraints aren't met.
-type RevokeServiceAuthTokenResponseMultiError []error
+// GetGithubRepoStatusResponseMultiError is an error wrapping multiple
+// validation errors returned by GetGithubRepoStatusResponse.ValidateAll() if
+// the designated constraints aren't met.
+type GetGithubRepoStatusResponseMultiError []error
 
 // Error returns a concatenation of all the error messages it wraps.
-func (m RevokeServiceAuthTokenResponseMultiError) Error() string {
+func (m GetGithubRepoStatusResponseMultiError) Error() string {
 	var msgs []string
 	for _, err := range m {
 		msgs = append(msgs, err.Error())
@@ -12417,12 +17659,12 @@ func (m RevokeServiceAuthTokenResponseMultiError) Error() string {
 }
 
 // AllErrors returns a list of validation violation errors.
-func (m RevokeServiceAuthTokenResponseMultiError) AllErrors() []error { return m }
+func (m GetGithubRepoStatusResponseMultiError) AllErrors() []error { return m }
 
-// RevokeServiceAuthTokenResponseValidationError is the validation error
-// returned by RevokeServiceAuthTokenResponse.Validate if the designated
-// constraints aren't met.
-type RevokeServiceAuthTokenResponseValidationError struct {
+// GetGithubRepoStatusResponseValidationError is the validation error returned
+// by GetGithubRepoStatusResponse.Validate if the designated constraints
+// aren't met.
+type GetGithubRepoStatusResponseValidationError struct {
 	field  string
 	reason string
 	cause  error
@@ -12430,24 +17672,24 @@ type RevokeServiceAuthTokenResponseValidationError struct {
 }
 
 // Field function returns field value.
-func (e RevokeServiceAuthTokenResponseValidationError) Field() string { return e.field }
+func (e GetGithubRepoStatusResponseValidationError) Field() string { return e.field }
 
 // Reason function returns reason value.
-func (e RevokeServiceAuthTokenResponseValidationError) Reason() string { return e.reason }
+func (e GetGithubRepoStatusResponseValidationError) Reason() string { return e.reason }
 
 // Cause function returns cause value.
-func (e RevokeServiceAuthTokenResponseValidationError) Cause() error { return e.cause }
+func (e GetGithubRepoStatusResponseValidationError) Cause() error { return e.cause }
 
 // Key function returns key value.
-func (e RevokeServiceAuthTokenResponseValidationError) Key() bool { return e.key }
+func (e GetGithubRepoStatusResponseValidationError) Key() bool { return e.key }
 
 // ErrorName returns error name.
-func (e RevokeServiceAuthTokenResponseValidationError) ErrorName() string {
-	return "RevokeServiceAuthTokenResponseValidationError"
+func (e GetGithubRepoStatusResponseValidationError) ErrorName() string {
+	return "GetGithubRepoStatusResponseValidationError"
 }
 
 // Error satisfies the builtin error interface
-func (e RevokeServiceAuthTokenResponseValidationError) Error() string {
+func (e GetGithubRepoStatusResponseValidationError) Error() string {
 	cause := ""
 	if e.cause != nil {
 		cause = fmt.Sprintf(" | caused by: %v", e.cause)
@@ -12459,14 +17701,14 @@ func (e RevokeServiceAuthTokenResponseValidationError) Error() string {
 	}
 
 	return fmt.Sprintf(
-		"invalid %sRevokeServiceAuthTokenResponse.%s: %s%s",
+		"invalid %sGetGithubRepoStatusResponse.%s: %s%s",
 		key,
 		e.field,
 		e.reason,
 		cause)
 }
 
-var _ error = RevokeServiceAuthTokenResponseValidationError{}
+var _ error = GetGithubRepoStatusResponseValidationError{}
 
 var _ interface {
 	Field() string
@@ -12474,33 +17716,33 @@ var _ interface {
 	Key() bool
 	Cause() error
 	ErrorName() string
-} = RevokeServiceAuthTokenResponseValidationError{}
+} = GetGithubRepoStatusResponseValidationError{}
 
-// Validate checks the field values on IssueServiceAuthTokenRequest with the
-// rules defined in the proto definition for this message. If any rules are
+// Validate checks the field values on GetGitCredentialsRequest with the rules
+// defined in the proto definition for this message. If any rules are
 // violated, the first error encountered is returned, or nil if there are no violations.
-func (m *IssueServiceAuthTokenRequest) Validate() error {
+func (m *GetGitCredentialsRequest) Validate() error {
 	return m.validate(false)
 }
 
-// ValidateAll checks the field values on IssueServiceAuthTokenRequest with the
+// ValidateAll checks the field values on GetGitCredentialsRequest with the
 // rules defined in the proto definition for this message. If any rules are
 // violated, the result is a list of violation errors wrapped in
-// IssueServiceAuthTokenRequestMultiError, or nil if none found.
-func (m *IssueServiceAuthTokenRequest) ValidateAll() error {
+// GetGitCredentialsRequestMultiError, or nil if none found.
+func (m *GetGitCredentialsRequest) ValidateAll() error {
 	return m.validate(true)
 }
 
-func (m *IssueServiceAuthTokenRequest) validate(all bool) error {
+func (m *GetGitCredentialsRequest) validate(all bool) error {
 	if m == nil {
 		return nil
 	}
 
 	var errors []error
 
-	if utf8.RuneCountInString(m.GetOrganizationName()) < 1 {
-		err := IssueServiceAuthTokenRequestValidationError{
-			field:  "OrganizationName",
+	if utf8.RuneCountInString(m.GetOrganization()) < 1 {
+		err := GetGitCredentialsRequestValidationError{
+			field:  "Organization",
 			reason: "value length must be at least 1 runes",
 		}
 		if !all {
@@ -12509,22 +17751,31 @@ func (m *IssueServiceAuthTokenRequest) validate(all bool) error {
 		errors = append(errors, err)
 	}
 
-	// no validation rules for ServiceName
+	if utf8.RuneCountInString(m.GetProject()) < 1 {
+		err := GetGitCredentialsRequestValidationError{
+			field:  "Project",
+			reason: "value length must be at least 1 runes",
+		}
+		if !all {
+			return err
+		}
+		errors = append(errors, err)
+	}
 
 	if len(errors) > 0 {
-		return IssueServiceAuthTokenRequestMultiError(errors)
+		return GetGitCredentialsRequestMultiError(errors)
 	}
 
 	return nil
 }
 
-// IssueServiceAuthTokenRequestMultiError is an error wrapping multiple
-// validation errors returned by IssueServiceAuthTokenRequest.ValidateAll() if
-// the designated constraints aren't met.
-type IssueServiceAuthTokenRequestMultiError []error
+// GetGitCredentialsRequestMultiError is an error wrapping multiple validation
+// errors returned by GetGitCredentialsRequest.ValidateAll() if the designated
+// constraints aren't met.
+type GetGitCredentialsRequestMultiError []error
 
 // Error returns a concatenation of all the error messages it wraps.
-func (m IssueServiceAuthTokenRequestMultiError) Error() string {
+func (m GetGitCredentialsRequestMultiError) Error() string {
 	var msgs []string
 	for _, err := range m {
 		msgs = append(msgs, err.Error())
@@ -12533,12 +17784,11 @@ func (m IssueServiceAuthTokenRequestMultiError) Error() string {
 }
 
 // AllErrors returns a list of validation violation errors.
-func (m IssueServiceAuthTokenRequestMultiError) AllErrors() []error { return m }
+func (m GetGitCredentialsRequestMultiError) AllErrors() []error { return m }
 
-// IssueServiceAuthTokenRequestValidationError is the validation error returned
-// by IssueServiceAuthTokenRequest.Validate if the designated constraints
-// aren't met.
-type IssueServiceAuthTokenRequestValidationError struct {
+// GetGitCredentialsRequestValidationError is the validation error returned by
+// GetGitCredentialsRequest.Validate if the designated constraints aren't met.
+type GetGitCredentialsRequestValidationError struct {
 	field  string
 	reason string
 	cause  error
@@ -12546,24 +17796,24 @@ type IssueServiceAuthTokenRequestValidationError struct {
 }
 
 // Field function returns field value.
-func (e IssueServiceAuthTokenRequestValidationError) Field() string { return e.field }
+func (e GetGitCredentialsRequestValidationError) Field() string { return e.field }
 
 // Reason function returns reason value.
-func (e IssueServiceAuthTokenRequestValidationError) Reason() string { return e.reason }
+func (e GetGitCredentialsRequestValidationError) Reason() string { return e.reason }
 
 // Cause function returns cause value.
-func (e IssueServiceAuthTokenRequestValidationError) Cause() error { return e.cause }
+func (e GetGitCredentialsRequestValidationError) Cause() error { return e.cause }
 
 // Key function returns key value.
-func (e IssueServiceAuthTokenRequestValidationError) Key() bool { return e.key }
+func (e GetGitCredentialsRequestValidationError) Key() bool { return e.key }
 
 // ErrorName returns error name.
-func (e IssueServiceAuthTokenRequestValidationError) ErrorName() string {
-	return "IssueServiceAuthTokenRequestValidationError"
+func (e GetGitCredentialsRequestValidationError) ErrorName() string {
+	return "GetGitCredentialsRequestValidationError"
 }
 
 // Error satisfies the builtin error interface
-func (e IssueServiceAuthTokenRequestValidationError) Error() string {
+func (e GetGitCredentialsRequestValidationError) Error() string {
 	cause := ""
 	if e.cause != nil {
 		cause = fmt.Sprintf(" | caused by: %v", e.cause)
@@ -12575,14 +17825,14 @@ func (e IssueServiceAuthTokenRequestValidationError) Error() string {
 	}
 
 	return fmt.Sprintf(
-		"invalid %sIssueServiceAuthTokenRequest.%s: %s%s",
+		"invalid %sGetGitCredentialsRequest.%s: %s%s",
 		key,
 		e.field,
 		e.reason,
 		cause)
 }
 
-var _ error = IssueServiceAuthTokenRequestValidationError{}
+var _ error = GetGitCredentialsRequestValidationError{}
 
 var _ interface {
 	Field() string
@@ -12590,46 +17840,54 @@ var _ interface {
 	Key() bool
 	Cause() error
 	ErrorName() string
-} = IssueServiceAuthTokenRequestValidationError{}
+} = GetGitCredentialsRequestValidationError{}
 
-// Validate checks the field values on IssueServiceAuthTokenResponse with the
-// rules defined in the proto definition for this message. If any rules are
+// Validate checks the field values on GetGitCredentialsResponse with the rules
+// defined in the proto definition for this message. If any rules are
 // violated, the first error encountered is returned, or nil if there are no violations.
-func (m *IssueServiceAuthTokenResponse) Validate() error {
+func (m *GetGitCredentialsResponse) Validate() error {
 	return m.validate(false)
 }
 
-// ValidateAll checks the field values on IssueServiceAuthTokenResponse with
-// the rules defined in the proto definition for this message. If any rules
-// are violated, the result is a list of violation errors wrapped in
-// IssueServiceAuthTokenResponseMultiError, or nil if none found.
-func (m *IssueServiceAuthTokenResponse) ValidateAll() error {
+// ValidateAll checks the field values on GetGitCredentialsResponse with the
+// rules defined in the proto definition for this message. If any rules are
+// violated, the result is a list of violation errors wrapped in
+// GetGitCredentialsResponseMultiError, or nil if none found.
+func (m *GetGitCredentialsResponse) ValidateAll() error {
 	return m.validate(true)
 }
 
-func (m *IssueServiceAuthTokenResponse) validate(all bool) error {
+func (m *GetGitCredentialsResponse) validate(all bool) error {
 	if m == nil {
 		return nil
 	}
 
 	var errors []error
 
-	// no validation rules for Token
+	// no validation rules for RepoUrl
+
+	// no validation rules for Username
+
+	// no validation rules for Password
+
+	// no validation rules for Subpath
+
+	// no validation rules for ProdBranch
 
 	if len(errors) > 0 {
-		return IssueServiceAuthTokenResponseMultiError(errors)
+		return GetGitCredentialsResponseMultiError(errors)
 	}
 
 	return nil
 }
 
-// IssueServiceAuthTokenResponseMultiError is an error wrapping multiple
-// validation errors returned by IssueServiceAuthTokenResponse.ValidateAll()
-// if the designated constraints aren't met.
-type IssueServiceAuthTokenResponseMultiError []error
-
+// GetGitCredentialsResponseMultiError is an error wrapping multiple validation
+// errors returned by GetGitCredentialsResponse.ValidateAll() if the
+// designated constraints aren't met.
+type GetGitCredentialsResponseMultiError []error
+
 // Error returns a concatenation of all the error messages it wraps.
-func (m IssueServiceAuthTokenResponseMultiError) Error() string {
+func (m GetGitCredentialsResponseMultiError) Error() string {
 	var msgs []string
 	for _, err := range m {
 		msgs = append(msgs, err.Error())
@@ -12638,12 +17896,11 @@ func (m IssueServiceAuthTokenResponseMultiError) Error() string {
 }
 
 // AllErrors returns a list of validation violation errors.
-func (m IssueServiceAuthTokenResponseMultiError) AllErrors() []error { return m }
+func (m GetGitCredentialsResponseMultiError) AllErrors() []error { return m }
 
-// IssueServiceAuthTokenResponseValidationError is the validation error
-// returned by IssueServiceAuthTokenResponse.Validate if the designated
-// constraints aren't met.
-type IssueServiceAuthTokenResponseValidationError struct {
+// GetGitCredentialsResponseValidationError is the validation error returned by
+// GetGitCredentialsResponse.Validate if the designated constraints aren't met.
+type GetGitCredentialsResponseValidationError struct {
 	field  string
 	reason string
 	cause  error
@@ -12651,24 +17908,24 @@ type IssueServiceAuthTokenResponseValidationError struct {
 }
 
 // Field function returns field value.
-func (e IssueServiceAuthTokenResponseValidationError) Field() string { return e.field }
+func (e GetGitCredentialsResponseValidationError) Field() string { return e.field }
 
 // Reason function returns reason value.
-func (e IssueServiceAuthTokenResponseValidationError) Reason() string { return e.reason }
+func (e GetGitCredentialsResponseValidationError) Reason() string { return e.reason }
 
 // Cause function returns cause value.
-func (e IssueServiceAuthTokenResponseValidationError) Cause() error { return e.cause }
+func (e GetGitCredentialsResponseValidationError) Cause() error { return e.cause }
 
 // Key function returns key value.
-func (e IssueServiceAuthTokenResponseValidationError) Key() bool { return e.key }
+func (e GetGitCredentialsResponseValidationError) Key() bool { return e.key }
 
 // ErrorName returns error name.
-func (e IssueServiceAuthTokenResponseValidationError) ErrorName() string {
-	return "IssueServiceAuthTokenResponseValidationError"
+func (e GetGitCredentialsResponseValidationError) ErrorName() string {
+	return "GetGitCredentialsResponseValidationError"
 }
 
 // Error satisfies the builtin error interface
-func (e IssueServiceAuthTokenResponseValidationError) Error() string {
+func (e GetGitCredentialsResponseValidationError) Error() string {
 	cause := ""
 	if e.cause != nil {
 		cause = fmt.Sprintf(" | caused by: %v", e.cause)
@@ -12680,14 +17937,14 @@ func (e IssueServiceAuthTokenResponseValidationError) Error() string {
 	}
 
 	return fmt.Sprintf(
-		"invalid %sIssueServiceAuthTokenResponse.%s: %s%s",
+		"invalid %sGetGitCredentialsResponse.%s: %s%s",
 		key,
 		e.field,
 		e.reason,
 		cause)
 }
 
-var _ error = IssueServiceAuthTokenResponseValidationError{}
+var _ error = GetGitCredentialsResponseValidationError{}
 
 var _ interface {
 	Field() string
@@ -12695,33 +17952,33 @@ var _ interface {
 	Key() bool
 	Cause() error
 	ErrorName() string
-} = IssueServiceAuthTokenResponseValidationError{}
+} = GetGitCredentialsResponseValidationError{}
 
-// Validate checks the field values on ListServiceAuthTokensRequest with the
+// Validate checks the field values on CreateWhitelistedDomainRequest with the
 // rules defined in the proto definition for this message. If any rules are
 // violated, the first error encountered is returned, or nil if there are no violations.
-func (m *ListServiceAuthTokensRequest) Validate() error {
+func (m *CreateWhitelistedDomainRequest) Validate() error {
 	return m.validate(false)
 }
 
-// ValidateAll checks the field values on ListServiceAuthTokensRequest with the
-// rules defined in the proto definition for this message. If any rules are
-// violated, the result is a list of violation errors wrapped in
-// ListServiceAuthTokensRequestMultiError, or nil if none found.
-func (m *ListServiceAuthTokensRequest) ValidateAll() error {
+// ValidateAll checks the field values on CreateWhitelistedDomainRequest with
+// the rules defined in the proto definition for this message. If any rules
+// are violated, the result is a list of violation errors wrapped in
+// CreateWhitelistedDomainRequestMultiError, or nil if none found.
+func (m *CreateWhitelistedDomainRequest) ValidateAll() error {
 	return m.validate(true)
 }
 
-func (m *ListServiceAuthTokensRequest) validate(all bool) error {
+func (m *CreateWhitelistedDomainRequest) validate(all bool) error {
 	if m == nil {
 		return nil
 	}
 
 	var errors []error
 
-	if utf8.RuneCountInString(m.GetOrganizationName()) < 1 {
-		err := ListServiceAuthTokensRequestValidationError{
-			field:  "OrganizationName",
+	if utf8.RuneCountInString(m.GetOrganization()) < 1 {
+		err := CreateWhitelistedDomainRequestValidationError{
+			field:  "Organization",
 			reason: "value length must be at least 1 runes",
 		}
 		if !all {
@@ -12730,22 +17987,42 @@ func (m *ListServiceAuthTokensRequest) validate(all bool) error {
 		errors = append(errors, err)
 	}
 
-	// no validation rules for ServiceName
+	if utf8.RuneCountInString(m.GetDomain()) < 1 {
+		err := CreateWhitelistedDomainRequestValidationError{
+			field:  "Domain",
+			reason: "value length must be at least 1 runes",
+		}
+		if !all {
+			return err
+		}
+		errors = append(errors, err)
+	}
+
+	if utf8.RuneCountInString(m.GetRole()) < 1 {
+		err := CreateWhitelistedDomainRequestValidationError{
+			field:  "Role",
+			reason: "value length must be at least 1 runes",
+		}
+		if !all {
+			return err
+		}
+		errors = append(errors, err)
+	}
 
 	if len(errors) > 0 {
-		return ListServiceAuthTokensRequestMultiError(errors)
+		return CreateWhitelistedDomainRequestMultiError(errors)
 	}
 
 	return nil
 }
 
-// ListServiceAuthTokensRequestMultiError is an error wrapping multiple
-// validation errors returned by ListServiceAuthTokensRequest.ValidateAll() if
-// the designated constraints aren't met.
-type ListServiceAuthTokensRequestMultiError []error
+// CreateWhitelistedDomainRequestMultiError is an error wrapping multiple
+// validation errors returned by CreateWhitelistedDomainRequest.ValidateAll()
+// if the designated constraints aren't met.
+type CreateWhitelistedDomainRequestMultiError []error
 
 // Error returns a concatenation of all the error messages it wraps.
-func (m ListServiceAuthTokensRequestMultiError) Error() string {
+func (m CreateWhitelistedDomainRequestMultiError) Error() string {
 	var msgs []string
 	for _, err := range m {
 		msgs = append(msgs, err.Error())
@@ -12754,12 +18031,12 @@ func (m ListServiceAuthTokensRequestMultiError) Error() string {
 }
 
 // AllErrors returns a list of validation violation errors.
-func (m ListServiceAuthTokensRequestMultiError) AllErrors() []error { return m }
+func (m CreateWhitelistedDomainRequestMultiError) AllErrors() []error { return m }
 
-// ListServiceAuthTokensRequestValidationError is the validation error returned
-// by ListServiceAuthTokensRequest.Validate if the designated constraints
-// aren't met.
-type ListServiceAuthTokensRequestValidationError struct {
+// CreateWhitelistedDomainRequestValidationError is the validation error
+// returned by CreateWhitelistedDomainRequest.Validate if the designated
+// constraints aren't met.
+type CreateWhitelistedDomainRequestValidationError struct {
 	field  string
 	reason string
 	cause  error
@@ -12767,24 +18044,24 @@ type ListServiceAuthTokensRequestValidationError struct {
 }
 
 // Field function returns field value.
-func (e ListServiceAuthTokensRequestValidationError) Field() string { return e.field }
+func (e CreateWhitelistedDomainRequestValidationError) Field() string { return e.field }
 
 // Reason function returns reason value.
-func (e ListServiceAuthTokensRequestValidationError) Reason() string { return e.reason }
+func (e CreateWhitelistedDomainRequestValidationError) Reason() string { return e.reason }
 
 // Cause function returns cause value.
-func (e ListServiceAuthTokensRequestValidationError) Cause() error { return e.cause }
+func (e CreateWhitelistedDomainRequestValidationError) Cause() error { return e.cause }
 
 // Key function returns key value.
-func (e ListServiceAuthTokensRequestValidationError) Key() bool { return e.key }
+func (e CreateWhitelistedDomainRequestValidationError) Key() bool { return e.key }
 
 // ErrorName returns error name.
-func (e ListServiceAuthTokensRequestValidationError) ErrorName() string {
-	return "ListServiceAuthTokensRequestValidationError"
+func (e CreateWhitelistedDomainRequestValidationError) ErrorName() string {
+	return "CreateWhitelistedDomainRequestValidationError"
 }
 
 // Error satisfies the builtin error interface
-func (e ListServiceAuthTokensRequestValidationError) Error() string {
+func (e CreateWhitelistedDomainRequestValidationError) Error() string {
 	cause := ""
 	if e.cause != nil {
 		cause = fmt.Sprintf(" | caused by: %v", e.cause)
@@ -12796,14 +18073,14 @@ func (e ListServiceAuthTokensRequestValidationError) Error() string {
 	}
 
 	return fmt.Sprintf(
-		"invalid %sListServiceAuthTokensRequest.%s: %s%s",
+		"invalid %sCreateWhitelistedDomainRequest.%s: %s%s",
 		key,
 		e.field,
 		e.reason,
 		cause)
 }
 
-var _ error = ListServiceAuthTokensRequestValidationError{}
+var _ error = CreateWhitelistedDomainRequestValidationError{}
 
 var _ interface {
 	Field() string
@@ -12811,78 +18088,44 @@ var _ interface {
 	Key() bool
 	Cause() error
 	ErrorName() string
-} = ListServiceAuthTokensRequestValidationError{}
+} = CreateWhitelistedDomainRequestValidationError{}
 
-// Validate checks the field values on ListServiceAuthTokensResponse with the
+// Validate checks the field values on CreateWhitelistedDomainResponse with the
 // rules defined in the proto definition for this message. If any rules are
 // violated, the first error encountered is returned, or nil if there are no violations.
-func (m *ListServiceAuthTokensResponse) Validate() error {
+func (m *CreateWhitelistedDomainResponse) Validate() error {
 	return m.validate(false)
 }
 
-// ValidateAll checks the field values on ListServiceAuthTokensResponse with
+// ValidateAll checks the field values on CreateWhitelistedDomainResponse with
 // the rules defined in the proto definition for this message. If any rules
 // are violated, the result is a list of violation errors wrapped in
-// ListServiceAuthTokensResponseMultiError, or nil if none found.
-func (m *ListServiceAuthTokensResponse) ValidateAll() error {
+// CreateWhitelistedDomainResponseMultiError, or nil if none found.
+func (m *CreateWhitelistedDomainResponse) ValidateAll() error {
 	return m.validate(true)
 }
 
-func (m *ListServiceAuthTokensResponse) validate(all bool) error {
+func (m *CreateWhitelistedDomainResponse) validate(all bool) error {
 	if m == nil {
 		return nil
 	}
 
 	var errors []error
 
-	for idx, item := range m.GetTokens() {
-		_, _ = idx, item
-
-		if all {
-			switch v := interface{}(item).(type) {
-			case interface{ ValidateAll() error }:
-				if err := v.ValidateAll(); err != nil {
-					errors = append(errors, ListServiceAuthTokensResponseValidationError{
-						field:  fmt.Sprintf("Tokens[%v]", idx),
-						reason: "embedded message failed validation",
-						cause:  err,
-					})
-				}
-			case interface{ Validate() error }:
-				if err := v.Validate(); err != nil {
-					errors = append(errors, ListServiceAuthTokensResponseValidationError{
-						field:  fmt.Sprintf("Tokens[%v]", idx),
-						reason: "embedded message failed validation",
-						cause:  err,
-					})
-				}
-			}
-		} else if v, ok := interface{}(item).(interface{ Validate() error }); ok {
-			if err := v.Validate(); err != nil {
-				return ListServiceAuthTokensResponseValidationError{
-					field:  fmt.Sprintf("Tokens[%v]", idx),
-					reason: "embedded message failed validation",
-					cause:  err,
-				}
-			}
-		}
-
-	}
-
 	if len(errors) > 0 {
-		return ListServiceAuthTokensResponseMultiError(errors)
+		return CreateWhitelistedDomainResponseMultiError(errors)
 	}
 
 	return nil
 }
 
-// ListServiceAuthTokensResponseMultiError is an error wrapping multiple
-// validation errors returned by ListServiceAuthTokensResponse.ValidateAll()
+// CreateWhitelistedDomainResponseMultiError is an error wrapping multiple
+// validation errors returned by CreateWhitelistedDomainResponse.ValidateAll()
 // if the designated constraints aren't met.
-type ListServiceAuthTokensResponseMultiError []error
+type CreateWhitelistedDomainResponseMultiError []error
 
 // Error returns a concatenation of all the error messages it wraps.
-func (m ListServiceAuthTokensResponseMultiError) Error() string {
+func (m CreateWhitelistedDomainResponseMultiError) Error() string {
 	var msgs []string
 	for _, err := range m {
 		msgs = append(msgs, err.Error())
@@ -12891,12 +18134,12 @@ func (m ListServiceAuthTokensResponseMultiError) Error() string {
 }
 
 // AllErrors returns a list of validation violation errors.
-func (m ListServiceAuthTokensResponseMultiError) AllErrors() []error { return m }
+func (m CreateWhitelistedDomainResponseMultiError) AllErrors() []error { return m }
 
-// ListServiceAuthTokensResponseValidationError is the validation error
-// returned by ListServiceAuthTokensResponse.Validate if the designated
+// CreateWhitelistedDomainResponseValidationError is the validation error
+// returned by CreateWhitelistedDomainResponse.Validate if the designated
 // constraints aren't met.
-type ListServiceAuthTokensResponseValidationError struct {
+type CreateWhitelistedDomainResponseValidationError struct {
 	field  string
 	reason string
 	cause  error
@@ -12904,24 +18147,24 @@ type ListServiceAuthTokensResponseValidationError struct {
 }
 
 // Field function returns field value.
-func (e ListServiceAuthTokensResponseValidationError) Field() string { return e.field }
+func (e CreateWhitelistedDomainResponseValidationError) Field() string { return e.field }
 
 // Reason function returns reason value.
-func (e ListServiceAuthTokensResponseValidationError) Reason() string { return e.reason }
+func (e CreateWhitelistedDomainResponseValidationError) Reason() string { return e.reason }
 
 // Cause function returns cause value.
-func (e ListServiceAuthTokensResponseValidationError) Cause() error { return e.cause }
+func (e CreateWhitelistedDomainResponseValidationError) Cause() error { return e.cause }
 
 // Key function returns key value.
-func (e ListServiceAuthTokensResponseValidationError) Key() bool { return e.key }
+func (e CreateWhitelistedDomainResponseValidationError) Key() bool { return e.key }
 
 // ErrorName returns error name.
-func (e ListServiceAuthTokensResponseValidationError) ErrorName() string {
-	return "ListServiceAuthTokensResponseValidationError"
+func (e CreateWhitelistedDomainResponseValidationError) ErrorName() string {
+	return "CreateWhitelistedDomainResponseValidationError"
 }
 
 // Error satisfies the builtin error interface
-func (e ListServiceAuthTokensResponseValidationError) Error() string {
+func (e CreateWhitelistedDomainResponseValidationError) Error() string {
 	cause := ""
 	if e.cause != nil {
 		cause = fmt.Sprintf(" | caused by: %v", e.cause)
@@ -12933,14 +18176,14 @@ func (e ListServiceAuthTokensResponseValidationError) Error() string {
 	}
 
 	return fmt.Sprintf(
-		"invalid %sListServiceAuthTokensResponse.%s: %s%s",
+		"invalid %sCreateWhitelistedDomainResponse.%s: %s%s",
 		key,
 		e.field,
 		e.reason,
 		cause)
 }
 
-var _ error = ListServiceAuthTokensResponseValidationError{}
+var _ error = CreateWhitelistedDomainResponseValidationError{}
 
 var _ interface {
 	Field() string
@@ -12948,46 +18191,66 @@ var _ interface {
 	Key() bool
 	Cause() error
 	ErrorName() string
-} = ListServiceAuthTokensResponseValidationError{}
+} = CreateWhitelistedDomainResponseValidationError{}
 
-// Validate checks the field values on GetGithubRepoStatusRequest with the
+// Validate checks the field values on RemoveWhitelistedDomainRequest with the
 // rules defined in the proto definition for this message. If any rules are
 // violated, the first error encountered is returned, or nil if there are no violations.
-func (m *GetGithubRepoStatusRequest) Validate() error {
+func (m *RemoveWhitelistedDomainRequest) Validate() error {
 	return m.validate(false)
 }
 
-// ValidateAll checks the field values on GetGithubRepoStatusRequest with the
-// rules defined in the proto definition for this message. If any rules are
-// violated, the result is a list of violation errors wrapped in
-// GetGithubRepoStatusRequestMultiError, or nil if none found.
-func (m *GetGithubRepoStatusRequest) ValidateAll() error {
+// ValidateAll checks the field values on RemoveWhitelistedDomainRequest with
+// the rules defined in the proto definition for this message. If any rules
+// are violated, the result is a list of violation errors wrapped in
+// RemoveWhitelistedDomainRequestMultiError, or nil if none found.
+func (m *RemoveWhitelistedDomainRequest) ValidateAll() error {
 	return m.validate(true)
 }
 
-func (m *GetGithubRepoStatusRequest) validate(all bool) error {
+func (m *RemoveWhitelistedDomainRequest) validate(all bool) error {
 	if m == nil {
 		return nil
 	}
 
 	var errors []error
 
-	// no validation rules for GithubUrl
+	if utf8.RuneCountInString(m.GetOrganization()) < 1 {
+		err := RemoveWhitelistedDomainRequestValidationError{
+			field:  "Organization",
+			reason: "value length must be at least 1 runes",
+		}
+		if !all {
+			return err
+		}
+		errors = append(errors, err)
+	}
+
+	if utf8.RuneCountInString(m.GetDomain()) < 1 {
+		err := RemoveWhitelistedDomainRequestValidationError{
+			field:  "Domain",
+			reason: "value length must be at least 1 runes",
+		}
+		if !all {
+			return err
+		}
+		errors = append(errors, err)
+	}
 
 	if len(errors) > 0 {
-		return GetGithubRepoStatusRequestMultiError(errors)
+		return RemoveWhitelistedDomainRequestMultiError(errors)
 	}
 
 	return nil
 }
 
-// GetGithubRepoStatusRequestMultiError is an error wrapping multiple
-// validation errors returned by GetGithubRepoStatusRequest.ValidateAll() if
-// the designated constraints aren't met.
-type GetGithubRepoStatusRequestMultiError []error
+// RemoveWhitelistedDomainRequestMultiError is an error wrapping multiple
+// validation errors returned by RemoveWhitelistedDomainRequest.ValidateAll()
+// if the designated constraints aren't met.
+type RemoveWhitelistedDomainRequestMultiError []error
 
 // Error returns a concatenation of all the error messages it wraps.
-func (m GetGithubRepoStatusRequestMultiError) Error() string {
+func (m RemoveWhitelistedDomainRequestMultiError) Error() string {
 	var msgs []string
 	for _, err := range m {
 		msgs = append(msgs, err.Error())
@@ -12996,11 +18259,12 @@ func (m GetGithubRepoStatusRequestMultiError) Error() string {
 }
 
 // AllErrors returns a list of validation violation errors.
-func (m GetGithubRepoStatusRequestMultiError) AllErrors() []error { return m }
+func (m RemoveWhitelistedDomainRequestMultiError) AllErrors() []error { return m }
 
-// GetGithubRepoStatusRequestValidationError is the validation error returned
-// by GetGithubRepoStatusRequest.Validate if the designated constraints aren't met.
-type GetGithubRepoStatusRequestValidationError struct {
+// RemoveWhitelistedDomainRequestValidationError is the validation error
+// returned by RemoveWhitelistedDomainRequest.Validate if the designated
+// constraints aren't met.
+type RemoveWhitelistedDomainRequestValidationError struct {
 	field  string
 	reason string
 	cause  error
@@ -13008,24 +18272,24 @@ type GetGithubRepoStatusRequestValidationError struct {
 }
 
 // Field function returns field value.
-func (e GetGithubRepoStatusRequestValidationError) Field() string { return e.field }
+func (e RemoveWhitelistedDomainRequestValidationError) Field() string { return e.field }
 
 // Reason function returns reason value.
-func (e GetGithubRepoStatusRequestValidationError) Reason() string { return e.reason }
+func (e RemoveWhitelistedDomainRequestValidationError) Reason() string { return e.reason }
 
 // Cause function returns cause value.
-func (e GetGithubRepoStatusRequestValidationError) Cause() error { return e.cause }
+func (e RemoveWhitelistedDomainRequestValidationError) Cause() error { return e.cause }
 
 // Key function returns key value.
-func (e GetGithubRepoStatusRequestValidationError) Key() bool { return e.key }
+func (e RemoveWhitelistedDomainRequestValidationError) Key() bool { return e.key }
 
 // ErrorName returns error name.
-func (e GetGithubRepoStatusRequestValidationError) ErrorName() string {
-	return "GetGithubRepoStatusRequestValidationError"
+func (e RemoveWhitelistedDomainRequestValidationError) ErrorName() string {
+	return "RemoveWhitelistedDomainRequestValidationError"
 }
 
 // Error satisfies the builtin error interface
-func (e GetGithubRepoStatusRequestValidationError) Error() string {
+func (e RemoveWhitelistedDomainRequestValidationError) Error() string {
 	cause := ""
 	if e.cause != nil {
 		cause = fmt.Sprintf(" | caused by: %v", e.cause)
@@ -13037,14 +18301,14 @@ func (e GetGithubRepoStatusRequestValidationError) Error() string {
 	}
 
 	return fmt.Sprintf(
-		"invalid %sGetGithubRepoStatusRequest.%s: %s%s",
+		"invalid %sRemoveWhitelistedDomainRequest.%s: %s%s",
 		key,
 		e.field,
 		e.reason,
 		cause)
 }
 
-var _ error = GetGithubRepoStatusRequestValidationError{}
+var _ error = RemoveWhitelistedDomainRequestValidationError{}
 
 var _ interface {
 	Field() string
@@ -13052,50 +18316,44 @@ var _ interface {
 	Key() bool
 	Cause() error
 	ErrorName() string
-} = GetGithubRepoStatusRequestValidationError{}
+} = RemoveWhitelistedDomainRequestValidationError{}
 
-// Validate checks the field values on GetGithubRepoStatusResponse with the
+// Validate checks the field values on RemoveWhitelistedDomainResponse with the
 // rules defined in the proto definition for this message. If any rules are
 // violated, the first error encountered is returned, or nil if there are no violations.
-func (m *GetGithubRepoStatusResponse) Validate() error {
+func (m *RemoveWhitelistedDomainResponse) Validate() error {
 	return m.validate(false)
 }
 
-// ValidateAll checks the field values on GetGithubRepoStatusResponse with the
-// rules defined in the proto definition for this message. If any rules are
-// violated, the result is a list of violation errors wrapped in
-// GetGithubRepoStatusResponseMultiError, or nil if none found.
-func (m *GetGithubRepoStatusResponse) ValidateAll() error {
+// ValidateAll checks the field values on RemoveWhitelistedDomainResponse with
+// the rules defined in the proto definition for this message. If any rules
+// are violated, the result is a list of violation errors wrapped in
+// RemoveWhitelistedDomainResponseMultiError, or nil if none found.
+func (m *RemoveWhitelistedDomainResponse) ValidateAll() error {
 	return m.validate(true)
 }
 
-func (m *GetGithubRepoStatusResponse) validate(all bool) error {
+func (m *RemoveWhitelistedDomainResponse) validate(all bool) error {
 	if m == nil {
 		return nil
 	}
 
 	var errors []error
 
-	// no validation rules for HasAccess
-
-	// no validation rules for GrantAccessUrl
-
-	// no validation rules for DefaultBranch
-
 	if len(errors) > 0 {
-		return GetGithubRepoStatusResponseMultiError(errors)
+		return RemoveWhitelistedDomainResponseMultiError(errors)
 	}
 
 	return nil
 }
 
-// GetGithubRepoStatusResponseMultiError is an error wrapping multiple
-// validation errors returned by GetGithubRepoStatusResponse.ValidateAll() if
-// the designated constraints aren't met.
-type GetGithubRepoStatusResponseMultiError []error
+// RemoveWhitelistedDomainResponseMultiError is an error wrapping multiple
+// validation errors returned by RemoveWhitelistedDomainResponse.ValidateAll()
+// if the designated constraints aren't met.
+type RemoveWhitelistedDomainResponseMultiError []error
 
 // Error returns a concatenation of all the error messages it wraps.
-func (m GetGithubRepoStatusResponseMultiError) Error() string {
+func (m RemoveWhitelistedDomainResponseMultiError) Error() string {
 	var msgs []string
 	for _, err := range m {
 		msgs = append(msgs, err.Error())
@@ -13104,12 +18362,12 @@ func (m GetGithubRepoStatusResponseMultiError) Error() string {
 }
 
 // AllErrors returns a list of validation violation errors.
-func (m GetGithubRepoStatusResponseMultiError) AllErrors() []error { return m }
+func (m RemoveWhitelistedDomainResponseMultiError) AllErrors() []error { return m }
 
-// GetGithubRepoStatusResponseValidationError is the validation error returned
-// by GetGithubRepoStatusResponse.Validate if the designated constraints
-// aren't met.
-type GetGithubRepoStatusResponseValidationError struct {
+// RemoveWhitelistedDomainResponseValidationError is the validation error
+// returned by RemoveWhitelistedDomainResponse.Validate if the designated
+// constraints aren't met.
+type RemoveWhitelistedDomainResponseValidationError struct {
 	field  string
 	reason string
 	cause  error
@@ -13117,24 +18375,24 @@ type GetGithubRepoStatusResponseValidationError struct {
 }
 
 // Field function returns field value.
-func (e GetGithubRepoStatusResponseValidationError) Field() string { return e.field }
+func (e RemoveWhitelistedDomainResponseValidationError) Field() string { return e.field }
 
 // Reason function returns reason value.
-func (e GetGithubRepoStatusResponseValidationError) Reason() string { return e.reason }
+func (e RemoveWhitelistedDomainResponseValidationError) Reason() string { return e.reason }
 
 // Cause function returns cause value.
-func (e GetGithubRepoStatusResponseValidationError) Cause() error { return e.cause }
+func (e RemoveWhitelistedDomainResponseValidationError) Cause() error { return e.cause }
 
 // Key function returns key value.
-func (e GetGithubRepoStatusResponseValidationError) Key() bool { return e.key }
+func (e RemoveWhitelistedDomainResponseValidationError) Key() bool { return e.key }
 
 // ErrorName returns error name.
-func (e GetGithubRepoStatusResponseValidationError) ErrorName() string {
-	return "GetGithubRepoStatusResponseValidationError"
+func (e RemoveWhitelistedDomainResponseValidationError) ErrorName() string {
+	return "RemoveWhitelistedDomainResponseValidationError"
 }
 
 // Error satisfies the builtin error interface
-func (e GetGithubRepoStatusResponseValidationError) Error() string {
+func (e RemoveWhitelistedDomainResponseValidationError) Error() string {
 	cause := ""
 	if e.cause != nil {
 		cause = fmt.Sprintf(" | caused by: %v", e.cause)
@@ -13146,14 +18404,14 @@ func (e GetGithubRepoStatusResponseValidationError) Error() string {
 	}
 
 	return fmt.Sprintf(
-		"invalid %sGetGithubRepoStatusResponse.%s: %s%s",
+		"invalid %sRemoveWhitelistedDomainResponse.%s: %s%s",
 		key,
 		e.field,
 		e.reason,
 		cause)
 }
 
-var _ error = GetGithubRepoStatusResponseValidationError{}
+var _ error = RemoveWhitelistedDomainResponseValidationError{}
 
 var _ interface {
 	Field() string
@@ -13161,24 +18419,24 @@ var _ interface {
 	Key() bool
 	Cause() error
 	ErrorName() string
-} = GetGithubRepoStatusResponseValidationError{}
+} = RemoveWhitelistedDomainResponseValidationError{}
 
-// Validate checks the field values on GetGitCredentialsRequest with the rules
-// defined in the proto definition for this message. If any rules are
+// Validate checks the field values on ListWhitelistedDomainsRequest with the
+// rules defined in the proto definition for this message. If any rules are
 // violated, the first error encountered is returned, or nil if there are no violations.
-func (m *GetGitCredentialsRequest) Validate() error {
+func (m *ListWhitelistedDomainsRequest) Validate() error {
 	return m.validate(false)
 }
 
-// ValidateAll checks the field values on GetGitCredentialsRequest with the
-// rules defined in the proto definition for this message. If any rules are
-// violated, the result is a list of violation errors wrapped in
-// GetGitCredentialsRequestMultiError, or nil if none found.
-func (m *GetGitCredentialsRequest) ValidateAll() error {
+// ValidateAll checks the field values on ListWhitelistedDomainsRequest with
+// the rules defined in the proto definition for this message. If any rules
+// are violated, the result is a list of violation errors wrapped in
+// ListWhitelistedDomainsRequestMultiError, or nil if none found.
+func (m *ListWhitelistedDomainsRequest) ValidateAll() error {
 	return m.validate(true)
 }
 
-func (m *GetGitCredentialsRequest) validate(all bool) error {
+func (m *ListWhitelistedDomainsRequest) validate(all bool) error {
 	if m == nil {
 		return nil
 	}
@@ -13186,7 +18444,7 @@ func (m *GetGitCredentialsRequest) validate(all bool) error {
 	var errors []error
 
 	if utf8.RuneCountInString(m.GetOrganization()) < 1 {
-		err := GetGitCredentialsRequestValidationError{
+		err := ListWhitelistedDomainsRequestValidationError{
 			field:  "Organization",
 			reason: "value length must be at least 1 runes",
 		}
@@ -13196,31 +18454,20 @@ func (m *GetGitCredentialsRequest) validate(all bool) error {
 		errors = append(errors, err)
 	}
 
-	if utf8.RuneCountInString(m.GetProject()) < 1 {
-		err := GetGitCredentialsRequestValidationError{
-			field:  "Project",
-			reason: "value length must be at least 1 runes",
-		}
-		if !all {
-			return err
-		}
-		errors = append(errors, err)
-	}
-
 	if len(errors) > 0 {
-		return GetGitCredentialsRequestMultiError(errors)
+		return ListWhitelistedDomainsRequestMultiError(errors)
 	}
 
 	return nil
 }
 
-// GetGitCredentialsRequestMultiError is an error wrapping multiple validation
-// errors returned by GetGitCredentialsRequest.ValidateAll() if the designated
-// constraints aren't met.
-type GetGitCredentialsRequestMultiError []error
+// ListWhitelistedDomainsRequestMultiError is an error wrapping multiple
+// validation errors returned by ListWhitelistedDomainsRequest.ValidateAll()
+// if the designated constraints aren't met.
+type ListWhitelistedDomainsRequestMultiError []error
 
 // Error returns a concatenation of all the error messages it wraps.
-func (m GetGitCredentialsRequestMultiError) Error() string {
+func (m ListWhitelistedDomainsRequestMultiError) Error() string {
 	var msgs []string
 	for _, err := range m {
 		msgs = append(msgs, err.Error())
@@ -13229,11 +18476,12 @@ func (m GetGitCredentialsRequestMultiError) Error() string {
 }
 
 // AllErrors returns a list of validation violation errors.
-func (m GetGitCredentialsRequestMultiError) AllErrors() []error { return m }
+func (m ListWhitelistedDomainsRequestMultiError) AllErrors() []error { return m }
 
-// GetGitCredentialsRequestValidationError is the validation error returned by
-// GetGitCredentialsRequest.Validate if the designated constraints aren't met.
-type GetGitCredentialsRequestValidationError struct {
+// ListWhitelistedDomainsRequestValidationError is the validation error
+// returned by ListWhitelistedDomainsRequest.Validate if the designated
+// constraints aren't met.
+type ListWhitelistedDomainsRequestValidationError struct {
 	field  string
 	reason string
 	cause  error
@@ -13241,24 +18489,24 @@ type GetGitCredentialsRequestValidationError struct {
 }
 
 // Field function returns field value.
-func (e GetGitCredentialsRequestValidationError) Field() string { return e.field }
+func (e ListWhitelistedDomainsRequestValidationError) Field() string { return e.field }
 
 // Reason function returns reason value.
-func (e GetGitCredentialsRequestValidationError) Reason() string { return e.reason }
+func (e ListWhitelistedDomainsRequestValidationError) Reason() string { return e.reason }
 
 // Cause function returns cause value.
-func (e GetGitCredentialsRequestValidationError) Cause() error { return e.cause }
+func (e ListWhitelistedDomainsRequestValidationError) Cause() error { return e.cause }
 
 // Key function returns key value.
-func (e GetGitCredentialsRequestValidationError) Key() bool { return e.key }
+func (e ListWhitelistedDomainsRequestValidationError) Key() bool { return e.key }
 
 // ErrorName returns error name.
-func (e GetGitCredentialsRequestValidationError) ErrorName() string {
-	return "GetGitCredentialsRequestValidationError"
+func (e ListWhitelistedDomainsRequestValidationError) ErrorName() string {
+	return "ListWhitelistedDomainsRequestValidationError"
 }
 
 // Error satisfies the builtin error interface
-func (e GetGitCredentialsRequestValidationError) Error() string {
+func (e ListWhitelistedDomainsRequestValidationError) Error() string {
 	cause := ""
 	if e.cause != nil {
 		cause = fmt.Sprintf(" | caused by: %v", e.cause)
@@ -13270,14 +18518,14 @@ func (e GetGitCredentialsRequestValidationError) Error() string {
 	}
 
 	return fmt.Sprintf(
-		"invalid %sGetGitCredentialsRequest.%s: %s%s",
+		"invalid %sListWhitelistedDomainsRequest.%s: %s%s",
 		key,
 		e.field,
 		e.reason,
 		cause)
 }
 
-var _ error = GetGitCredentialsRequestValidationError{}
+var _ error = ListWhitelistedDomainsRequestValidationError{}
 
 var _ interface {
 	Field() string
@@ -13285,54 +18533,78 @@ var _ interface {
 	Key() bool
 	Cause() error
 	ErrorName() string
-} = GetGitCredentialsRequestValidationError{}
+} = ListWhitelistedDomainsRequestValidationError{}
 
-// Validate checks the field values on GetGitCredentialsResponse with the rules
-// defined in the proto definition for this message. If any rules are
+// Validate checks the field values on ListWhitelistedDomainsResponse with the
+// rules defined in the proto definition for this message. If any rules are
 // violated, the first error encountered is returned, or nil if there are no violations.
-func (m *GetGitCredentialsResponse) Validate() error {
+func (m *ListWhitelistedDomainsResponse) Validate() error {
 	return m.validate(false)
 }
 
-// ValidateAll checks the field values on GetGitCredentialsResponse with the
-// rules defined in the proto definition for this message. If any rules are
-// violated, the result is a list of violation errors wrapped in
-// GetGitCredentialsResponseMultiError, or nil if none found.
-func (m *GetGitCredentialsResponse) ValidateAll() error {
+// ValidateAll checks the field values on ListWhitelistedDomainsResponse with
+// the rules defined in the proto definition for this message. If any rules
+// are violated, the result is a list of violation errors wrapped in
+// ListWhitelistedDomainsResponseMultiError, or nil if none found.
+func (m *ListWhitelistedDomainsResponse) ValidateAll() error {
 	return m.validate(true)
 }
 
-func (m *GetGitCredentialsResponse) validate(all bool) error {
+func (m *ListWhitelistedDomainsResponse) validate(all bool) error {
 	if m == nil {
 		return nil
 	}
 
 	var errors []error
 
-	// no validation rules for RepoUrl
-
-	// no validation rules for Username
-
-	// no validation rules for Password
+	for idx, item := range m.GetDomains() {
+		_, _ = idx, item
 
-	// no validation rules for Subpath
+		if all {
+			switch v := interface{}(item).(type) {
+			case interface{ ValidateAll() error }:
+				if err := v.ValidateAll(); err != nil {
+					errors = append(errors, ListWhitelistedDomainsResponseValidationError{
+						field:  fmt.Sprintf("Domains[%v]", idx),
+						reason: "embedded message failed validation",
+						cause:  err,
+					})
+				}
+			case interface{ Validate() error }:
+				if err := v.Validate(); err != nil {
+					errors = append(errors, ListWhitelistedDomainsResponseValidationError{
+						field:  fmt.Sprintf("Domains[%v]", idx),
+						reason: "embedded message failed validation",
+						cause:  err,
+					})
+				}
+			}
+		} else if v, ok := interface{}(item).(interface{ Validate() error }); ok {
+			if err := v.Validate(); err != nil {
+				return ListWhitelistedDomainsResponseValidationError{
+					field:  fmt.Sprintf("Domains[%v]", idx),
+					reason: "embedded message failed validation",
+					cause:  err,
+				}
+			}
+		}
 
-	// no validation rules for ProdBranch
+	}
 
 	if len(errors) > 0 {
-		return GetGitCredentialsResponseMultiError(errors)
+		return ListWhitelistedDomainsResponseMultiError(errors)
 	}
 
 	return nil
 }
 
-// GetGitCredentialsResponseMultiError is an error wrapping multiple validation
-// errors returned by GetGitCredentialsResponse.ValidateAll() if the
-// designated constraints aren't met.
-type GetGitCredentialsResponseMultiError []error
+// ListWhitelistedDomainsResponseMultiError is an error wrapping multiple
+// validation errors returned by ListWhitelistedDomainsResponse.ValidateAll()
+// if the designated constraints aren't met.
+type ListWhitelistedDomainsResponseMultiError []error
 
 // Error returns a concatenation of all the error messages it wraps.
-func (m GetGitCredentialsResponseMultiError) Error() string {
+func (m ListWhitelistedDomainsResponseMultiError) Error() string {
 	var msgs []string
 	for _, err := range m {
 		msgs = append(msgs, err.Error())
@@ -13341,11 +18613,12 @@ func (m GetGitCredentialsResponseMultiError) Error() string {
 }
 
 // AllErrors returns a list of validation violation errors.
-func (m GetGitCredentialsResponseMultiError) AllErrors() []error { return m }
+func (m ListWhitelistedDomainsResponseMultiError) AllErrors() []error { return m }
 
-// GetGitCredentialsResponseValidationError is the validation error returned by
-// GetGitCredentialsResponse.Validate if the designated constraints aren't met.
-type GetGitCredentialsResponseValidationError struct {
+// ListWhitelistedDomainsResponseValidationError is the validation error
+// returned by ListWhitelistedDomainsResponse.Validate if the designated
+// constraints aren't met.
+type ListWhitelistedDomainsResponseValidationError struct {
 	field  string
 	reason string
 	cause  error
@@ -13353,24 +18626,24 @@ type GetGitCredentialsResponseValidationError struct {
 }
 
 // Field function returns field value.
-func (e GetGitCredentialsResponseValidationError) Field() string { return e.field }
+func (e ListWhitelistedDomainsResponseValidationError) Field() string { return e.field }
 
 // Reason function returns reason value.
-func (e GetGitCredentialsResponseValidationError) Reason() string { return e.reason }
+func (e ListWhitelistedDomainsResponseValidationError) Reason() string { return e.reason }
 
 // Cause function returns cause value.
-func (e GetGitCredentialsResponseValidationError) Cause() error { return e.cause }
+func (e ListWhitelistedDomainsResponseValidationError) Cause() error { return e.cause }
 
 // Key function returns key value.
-func (e GetGitCredentialsResponseValidationError) Key() bool { return e.key }
+func (e ListWhitelistedDomainsResponseValidationError) Key() bool { return e.key }
 
 // ErrorName returns error name.
-func (e GetGitCredentialsResponseValidationError) ErrorName() string {
-	return "GetGitCredentialsResponseValidationError"
+func (e ListWhitelistedDomainsResponseValidationError) ErrorName() string {
+	return "ListWhitelistedDomainsResponseValidationError"
 }
 
 // Error satisfies the builtin error interface
-func (e GetGitCredentialsResponseValidationError) Error() string {
+func (e ListWhitelistedDomainsResponseValidationError) Error() string {
 	cause := ""
 	if e.cause != nil {
 		cause = fmt.Sprintf(" | caused by: %v", e.cause)
@@ -13382,14 +18655,14 @@ func (e GetGitCredentialsResponseValidationError) Error() string {
 	}
 
 	return fmt.Sprintf(
-		"invalid %sGetGitCredentialsResponse.%s: %s%s",
+		"invalid %sListWhitelistedDomainsResponse.%s: %s%s",
 		key,
 		e.field,
 		e.reason,
 		cause)
 }
 
-var _ error = GetGitCredentialsResponseValidationError{}
+var _ error = ListWhitelistedDomainsResponseValidationError{}
 
 var _ interface {
 	Field() string
@@ -13397,77 +18670,137 @@ var _ interface {
 	Key() bool
 	Cause() error
 	ErrorName() string
-} = GetGitCredentialsResponseValidationError{}
+} = ListWhitelistedDomainsResponseValidationError{}
 
-// Validate checks the field values on CreateWhitelistedDomainRequest with the
-// rules defined in the proto definition for this message. If any rules are
-// violated, the first error encountered is returned, or nil if there are no violations.
-func (m *CreateWhitelistedDomainRequest) Validate() error {
+// Validate checks the field values on User with the rules defined in the proto
+// definition for this message. If any rules are violated, the first error
+// encountered is returned, or nil if there are no violations.
+func (m *User) Validate() error {
 	return m.validate(false)
 }
 
-// ValidateAll checks the field values on CreateWhitelistedDomainRequest with
-// the rules defined in the proto definition for this message. If any rules
-// are violated, the result is a list of violation errors wrapped in
-// CreateWhitelistedDomainRequestMultiError, or nil if none found.
-func (m *CreateWhitelistedDomainRequest) ValidateAll() error {
+// ValidateAll checks the field values on User with the rules defined in the
+// proto definition for this message. If any rules are violated, the result is
+// a list of violation errors wrapped in UserMultiError, or nil if none found.
+func (m *User) ValidateAll() error {
 	return m.validate(true)
 }
 
-func (m *CreateWhitelistedDomainRequest) validate(all bool) error {
+func (m *User) validate(all bool) error {
 	if m == nil {
 		return nil
 	}
 
 	var errors []error
 
-	if utf8.RuneCountInString(m.GetOrganization()) < 1 {
-		err := CreateWhitelistedDomainRequestValidationError{
-			field:  "Organization",
-			reason: "value length must be at least 1 runes",
+	// no validation rules for Id
+
+	// no validation rules for Email
+
+	// no validation rules for DisplayName
+
+	// no validation rules for PhotoUrl
+
+	if all {
+		switch v := interface{}(m.GetQuotas()).(type) {
+		case interface{ ValidateAll() error }:
+			if err := v.ValidateAll(); err != nil {
+				errors = append(errors, UserValidationError{
+					field:  "Quotas",
+					reason: "embedded message failed validation",
+					cause:  err,
+				})
+			}
+		case interface{ Validate() error }:
+			if err := v.Validate(); err != nil {
+				errors = append(errors, UserValidationError{
+					field:  "Quotas",
+					reason: "embedded message failed validation",
+					cause:  err,
+				})
+			}
 		}
-		if !all {
-			return err
+	} else if v, ok := interface{}(m.GetQuotas()).(interface{ Validate() error }); ok {
+		if err := v.Validate(); err != nil {
+			return UserValidationError{
+				field:  "Quotas",
+				reason: "embedded message failed validation",
+				cause:  err,
+			}
 		}
-		errors = append(errors, err)
 	}
 
-	if utf8.RuneCountInString(m.GetDomain()) < 1 {
-		err := CreateWhitelistedDomainRequestValidationError{
-			field:  "Domain",
-			reason: "value length must be at least 1 runes",
+	if all {
+		switch v := interface{}(m.GetCreatedOn()).(type) {
+		case interface{ ValidateAll() error }:
+			if err := v.ValidateAll(); err != nil {
+				errors = append(errors, UserValidationError{
+					field:  "CreatedOn",
+					reason: "embedded message failed validation",
+					cause:  err,
+				})
+			}
+		case interface{ Validate() error }:
+			if err := v.Validate(); err != nil {
+				errors = append(errors, UserValidationError{
+					field:  "CreatedOn",
+					reason: "embedded message failed validation",
+					cause:  err,
+				})
+			}
 		}
-		if !all {
-			return err
+	} else if v, ok := interface{}(m.GetCreatedOn()).(interface{ Validate() error }); ok {
+		if err := v.Validate(); err != nil {
+			return UserValidationError{
+				field:  "CreatedOn",
+				reason: "embedded message failed validation",
+				cause:  err,
+			}
 		}
-		errors = append(errors, err)
 	}
 
-	if utf8.RuneCountInString(m.GetRole()) < 1 {
-		err := CreateWhitelistedDomainRequestValidationError{
-			field:  "Role",
-			reason: "value length must be at least 1 runes",
+	if all {
+		switch v := interface{}(m.GetUpdatedOn()).(type) {
+		case interface{ ValidateAll() error }:
+			if err := v.ValidateAll(); err != nil {
+				errors = append(errors, UserValidationError{
+					field:  "UpdatedOn",
+					reason: "embedded message failed validation",
+					cause:  err,
+				})
+			}
+		case interface{ Validate() error }:
+			if err := v.Validate(); err != nil {
+				errors = append(errors, UserValidationError{
+					field:  "UpdatedOn",
+					reason: "embedded message failed validation",
+					cause:  err,
+				})
+			}
 		}
-		if !all {
-			return err
+	} else if v, ok := interface{}(m.GetUpdatedOn()).(interface{ Validate() error }); ok {
+		if err := v.Validate(); err != nil {
+			return UserValidationError{
+				field:  "UpdatedOn",
+				reason: "embedded message failed validation",
+				cause:  err,
+			}
 		}
-		errors = append(errors, err)
 	}
 
 	if len(errors) > 0 {
-		return CreateWhitelistedDomainRequestMultiError(errors)
+		return UserMultiError(errors)
 	}
 
 	return nil
 }
 
-// CreateWhitelistedDomainRequestMultiError is an error wrapping multiple
-// validation errors returned by CreateWhitelistedDomainRequest.ValidateAll()
-// if the designated constraints aren't met.
-type CreateWhitelistedDomainRequestMultiError []error
+// UserMultiError is an error wrapping multiple validation errors returned by
+// User.ValidateAll() if the designated constraints aren't met.
+type UserMultiError []error
 
 // Error returns a concatenation of all the error messages it wraps.
-func (m CreateWhitelistedDomainRequestMultiError) Error() string {
+func (m UserMultiError) Error() string {
 	var msgs []string
 	for _, err := range m {
 		msgs = append(msgs, err.Error())
@@ -13476,12 +18809,11 @@ func (m CreateWhitelistedDomainRequestMultiError) Error() string {
 }
 
 // AllErrors returns a list of validation violation errors.
-func (m CreateWhitelistedDomainRequestMultiError) AllErrors() []error { return m }
+func (m UserMultiError) AllErrors() []error { return m }
 
-// CreateWhitelistedDomainRequestValidationError is the validation error
-// returned by CreateWhitelistedDomainRequest.Validate if the designated
-// constraints aren't met.
-type CreateWhitelistedDomainRequestValidationError struct {
+// UserValidationError is the validation error returned by User.Validate if the
+// designated constraints aren't met.
+type UserValidationError struct {
 	field  string
 	reason string
 	cause  error
@@ -13489,24 +18821,22 @@ type CreateWhitelistedDomainRequestValidationError struct {
 }
 
 // Field function returns field value.
-func (e CreateWhitelistedDomainRequestValidationError) Field() string { return e.field }
+func (e UserValidationError) Field() string { return e.field }
 
 // Reason function returns reason value.
-func (e CreateWhitelistedDomainRequestValidationError) Reason() string { return e.reason }
+func (e UserValidationError) Reason() string { return e.reason }
 
 // Cause function returns cause value.
-func (e CreateWhitelistedDomainRequestValidationError) Cause() error { return e.cause }
+func (e UserValidationError) Cause() error { return e.cause }
 
 // Key function returns key value.
-func (e CreateWhitelistedDomainRequestValidationError) Key() bool { return e.key }
+func (e UserValidationError) Key() bool { return e.key }
 
 // ErrorName returns error name.
-func (e CreateWhitelistedDomainRequestValidationError) ErrorName() string {
-	return "CreateWhitelistedDomainRequestValidationError"
-}
+func (e UserValidationError) ErrorName() string { return "UserValidationError" }
 
 // Error satisfies the builtin error interface
-func (e CreateWhitelistedDomainRequestValidationError) Error() string {
+func (e UserValidationError) Error() string {
 	cause := ""
 	if e.cause != nil {
 		cause = fmt.Sprintf(" | caused by: %v", e.cause)
@@ -13518,14 +18848,14 @@ func (e CreateWhitelistedDomainRequestValidationError) Error() string {
 	}
 
 	return fmt.Sprintf(
-		"invalid %sCreateWhitelistedDomainRequest.%s: %s%s",
+		"invalid %sUser.%s: %s%s",
 		key,
 		e.field,
 		e.reason,
 		cause)
 }
 
-var _ error = CreateWhitelistedDomainRequestValidationError{}
+var _ error = UserValidationError{}
 
 var _ interface {
 	Field() string
@@ -13533,44 +18863,108 @@ var _ interface {
 	Key() bool
 	Cause() error
 	ErrorName() string
-} = CreateWhitelistedDomainRequestValidationError{}
+} = UserValidationError{}
 
-// Validate checks the field values on CreateWhitelistedDomainResponse with the
-// rules defined in the proto definition for this message. If any rules are
-// violated, the first error encountered is returned, or nil if there are no violations.
-func (m *CreateWhitelistedDomainResponse) Validate() error {
+// Validate checks the field values on Service with the rules defined in the
+// proto definition for this message. If any rules are violated, the first
+// error encountered is returned, or nil if there are no violations.
+func (m *Service) Validate() error {
 	return m.validate(false)
 }
 
-// ValidateAll checks the field values on CreateWhitelistedDomainResponse with
-// the rules defined in the proto definition for this message. If any rules
-// are violated, the result is a list of violation errors wrapped in
-// CreateWhitelistedDomainResponseMultiError, or nil if none found.
-func (m *CreateWhitelistedDomainResponse) ValidateAll() error {
+// ValidateAll checks the field values on Service with the rules defined in the
+// proto definition for this message. If any rules are violated, the result is
+// a list of violation errors wrapped in ServiceMultiError, or nil if none found.
+func (m *Service) ValidateAll() error {
 	return m.validate(true)
 }
 
-func (m *CreateWhitelistedDomainResponse) validate(all bool) error {
+func (m *Service) validate(all bool) error {
 	if m == nil {
 		return nil
 	}
 
-	var errors []error
-
+	var errors []error
+
+	// no validation rules for Id
+
+	// no validation rules for Name
+
+	// no validation rules for OrgId
+
+	// no validation rules for OrgName
+
+	if all {
+		switch v := interface{}(m.GetCreatedOn()).(type) {
+		case interface{ ValidateAll() error }:
+			if err := v.ValidateAll(); err != nil {
+				errors = append(errors, ServiceValidationError{
+					field:  "CreatedOn",
+					reason: "embedded message failed validation",
+					cause:  err,
+				})
+			}
+		case interface{ Validate() error }:
+			if err := v.Validate(); err != nil {
+				errors = append(errors, ServiceValidationError{
+					field:  "CreatedOn",
+					reason: "embedded message failed validation",
+					cause:  err,
+				})
+			}
+		}
+	} else if v, ok := interface{}(m.GetCreatedOn()).(interface{ Validate() error }); ok {
+		if err := v.Validate(); err != nil {
+			return ServiceValidationError{
+				field:  "CreatedOn",
+				reason: "embedded message failed validation",
+				cause:  err,
+			}
+		}
+	}
+
+	if all {
+		switch v := interface{}(m.GetUpdatedOn()).(type) {
+		case interface{ ValidateAll() error }:
+			if err := v.ValidateAll(); err != nil {
+				errors = append(errors, ServiceValidationError{
+					field:  "UpdatedOn",
+					reason: "embedded message failed validation",
+					cause:  err,
+				})
+			}
+		case interface{ Validate() error }:
+			if err := v.Validate(); err != nil {
+				errors = append(errors, ServiceValidationError{
+					field:  "UpdatedOn",
+					reason: "embedded message failed validation",
+					cause:  err,
+				})
+			}
+		}
+	} else if v, ok := interface{}(m.GetUpdatedOn()).(interface{ Validate() error }); ok {
+		if err := v.Validate(); err != nil {
+			return ServiceValidationError{
+				field:  "UpdatedOn",
+				reason: "embedded message failed validation",
+				cause:  err,
+			}
+		}
+	}
+
 	if len(errors) > 0 {
-		return CreateWhitelistedDomainResponseMultiError(errors)
+		return ServiceMultiError(errors)
 	}
 
 	return nil
 }
 
-// CreateWhitelistedDomainResponseMultiError is an error wrapping multiple
-// validation errors returned by CreateWhitelistedDomainResponse.ValidateAll()
-// if the designated constraints aren't met.
-type CreateWhitelistedDomainResponseMultiError []error
+// ServiceMultiError is an error wrapping multiple validation errors returned
+// by Service.ValidateAll() if the designated constraints aren't met.
+type ServiceMultiError []error
 
 // Error returns a concatenation of all the error messages it wraps.
-func (m CreateWhitelistedDomainResponseMultiError) Error() string {
+func (m ServiceMultiError) Error() string {
 	var msgs []string
 	for _, err := range m {
 		msgs = append(msgs, err.Error())
@@ -13579,12 +18973,11 @@ func (m CreateWhitelistedDomainResponseMultiError) Error() string {
 }
 
 // AllErrors returns a list of validation violation errors.
-func (m CreateWhitelistedDomainResponseMultiError) AllErrors() []error { return m }
+func (m ServiceMultiError) AllErrors() []error { return m }
 
-// CreateWhitelistedDomainResponseValidationError is the validation error
-// returned by CreateWhitelistedDomainResponse.Validate if the designated
-// constraints aren't met.
-type CreateWhitelistedDomainResponseValidationError struct {
+// ServiceValidationError is the validation error returned by Service.Validate
+// if the designated constraints aren't met.
+type ServiceValidationError struct {
 	field  string
 	reason string
 	cause  error
@@ -13592,24 +18985,22 @@ type CreateWhitelistedDomainResponseValidationError struct {
 }
 
 // Field function returns field value.
-func (e CreateWhitelistedDomainResponseValidationError) Field() string { return e.field }
+func (e ServiceValidationError) Field() string { return e.field }
 
 // Reason function returns reason value.
-func (e CreateWhitelistedDomainResponseValidationError) Reason() string { return e.reason }
+func (e ServiceValidationError) Reason() string { return e.reason }
 
 // Cause function returns cause value.
-func (e CreateWhitelistedDomainResponseValidationError) Cause() error { return e.cause }
+func (e ServiceValidationError) Cause() error { return e.cause }
 
 // Key function returns key value.
-func (e CreateWhitelistedDomainResponseValidationError) Key() bool { return e.key }
+func (e ServiceValidationError) Key() bool { return e.key }
 
 // ErrorName returns error name.
-func (e CreateWhitelistedDomainResponseValidationError) ErrorName() string {
-	return "CreateWhitelistedDomainResponseValidationError"
-}
+func (e ServiceValidationError) ErrorName() string { return "ServiceValidationError" }
 
 // Error satisfies the builtin error interface
-func (e CreateWhitelistedDomainResponseValidationError) Error() string {
+func (e ServiceValidationError) Error() string {
 	cause := ""
 	if e.cause != nil {
 		cause = fmt.Sprintf(" | caused by: %v", e.cause)
@@ -13621,14 +19012,14 @@ func (e CreateWhitelistedDomainResponseValidationError) Error() string {
 	}
 
 	return fmt.Sprintf(
-		"invalid %sCreateWhitelistedDomainResponse.%s: %s%s",
+		"invalid %sService.%s: %s%s",
 		key,
 		e.field,
 		e.reason,
 		cause)
 }
 
-var _ error = CreateWhitelistedDomainResponseValidationError{}
+var _ error = ServiceValidationError{}
 
 var _ interface {
 	Field() string
@@ -13636,66 +19027,136 @@ var _ interface {
 	Key() bool
 	Cause() error
 	ErrorName() string
-} = CreateWhitelistedDomainResponseValidationError{}
+} = ServiceValidationError{}
 
-// Validate checks the field values on RemoveWhitelistedDomainRequest with the
-// rules defined in the proto definition for this message. If any rules are
-// violated, the first error encountered is returned, or nil if there are no violations.
-func (m *RemoveWhitelistedDomainRequest) Validate() error {
+// Validate checks the field values on Organization with the rules defined in
+// the proto definition for this message. If any rules are violated, the first
+// error encountered is returned, or nil if there are no violations.
+func (m *Organization) Validate() error {
 	return m.validate(false)
 }
 
-// ValidateAll checks the field values on RemoveWhitelistedDomainRequest with
-// the rules defined in the proto definition for this message. If any rules
-// are violated, the result is a list of violation errors wrapped in
-// RemoveWhitelistedDomainRequestMultiError, or nil if none found.
-func (m *RemoveWhitelistedDomainRequest) ValidateAll() error {
+// ValidateAll checks the field values on Organization with the rules defined
+// in the proto definition for this message. If any rules are violated, the
+// result is a list of violation errors wrapped in OrganizationMultiError, or
+// nil if none found.
+func (m *Organization) ValidateAll() error {
 	return m.validate(true)
 }
 
-func (m *RemoveWhitelistedDomainRequest) validate(all bool) error {
+func (m *Organization) validate(all bool) error {
 	if m == nil {
 		return nil
 	}
 
 	var errors []error
 
-	if utf8.RuneCountInString(m.GetOrganization()) < 1 {
-		err := RemoveWhitelistedDomainRequestValidationError{
-			field:  "Organization",
-			reason: "value length must be at least 1 runes",
+	// no validation rules for Id
+
+	// no validation rules for Name
+
+	// no validation rules for Description
+
+	if all {
+		switch v := interface{}(m.GetQuotas()).(type) {
+		case interface{ ValidateAll() error }:
+			if err := v.ValidateAll(); err != nil {
+				errors = append(errors, OrganizationValidationError{
+					field:  "Quotas",
+					reason: "embedded message failed validation",
+					cause:  err,
+				})
+			}
+		case interface{ Validate() error }:
+			if err := v.Validate(); err != nil {
+				errors = append(errors, OrganizationValidationError{
+					field:  "Quotas",
+					reason: "embedded message failed validation",
+					cause:  err,
+				})
+			}
 		}
-		if !all {
-			return err
+	} else if v, ok := interface{}(m.GetQuotas()).(interface{ Validate() error }); ok {
+		if err := v.Validate(); err != nil {
+			return OrganizationValidationError{
+				field:  "Quotas",
+				reason: "embedded message failed validation",
+				cause:  err,
+			}
 		}
-		errors = append(errors, err)
 	}
 
-	if utf8.RuneCountInString(m.GetDomain()) < 1 {
-		err := RemoveWhitelistedDomainRequestValidationError{
-			field:  "Domain",
-			reason: "value length must be at least 1 runes",
+	if all {
+		switch v := interface{}(m.GetCreatedOn()).(type) {
+		case interface{ ValidateAll() error }:
+			if err := v.ValidateAll(); err != nil {
+				errors = append(errors, OrganizationValidationError{
+					field:  "CreatedOn",
+					reason: "embedded message failed validation",
+					cause:  err,
+				})
+			}
+		case interface{ Validate() error }:
+			if err := v.Validate(); err != nil {
+				errors = append(errors, OrganizationValidationError{
+					field:  "CreatedOn",
+					reason: "embedded message failed validation",
+					cause:  err,
+				})
+			}
 		}
-		if !all {
-			return err
+	} else if v, ok := interface{}(m.GetCreatedOn()).(interface{ Validate() error }); ok {
+		if err := v.Validate(); err != nil {
+			return OrganizationValidationError{
+				field:  "CreatedOn",
+				reason: "embedded message failed validation",
+				cause:  err,
+			}
+		}
+	}
+
+	if all {
+		switch v := interface{}(m.GetUpdatedOn()).(type) {
+		case interface{ ValidateAll() error }:
+			if err := v.ValidateAll(); err != nil {
+				errors = append(errors, OrganizationValidationError{
+					field:  "UpdatedOn",
+					reason: "embedded message failed validation",
+					cause:  err,
+				})
+			}
+		case interface{ Validate() error }:
+			if err := v.Validate(); err != nil {
+				errors = append(errors, OrganizationValidationError{
+					field:  "UpdatedOn",
+					reason: "embedded message failed validation",
+					cause:  err,
+				})
+			}
+		}
+	} else if v, ok := interface{}(m.GetUpdatedOn()).(interface{ Validate() error }); ok {
+		if err := v.Validate(); err != nil {
+			return OrganizationValidationError{
+				field:  "UpdatedOn",
+				reason: "embedded message failed validation",
+				cause:  err,
+			}
 		}
-		errors = append(errors, err)
 	}
 
 	if len(errors) > 0 {
-		return RemoveWhitelistedDomainRequestMultiError(errors)
+		return OrganizationMultiError(errors)
 	}
 
 	return nil
 }
 
-// RemoveWhitelistedDomainRequestMultiError is an error wrapping multiple
-// validation errors returned by RemoveWhitelistedDomainRequest.ValidateAll()
-// if the designated constraints aren't met.
-type RemoveWhitelistedDomainRequestMultiError []error
+// OrganizationMultiError is an error wrapping multiple validation errors
+// returned by Organization.ValidateAll() if the designated constraints aren't met.
+type OrganizationMultiError []error
 
 // Error returns a concatenation of all the error messages it wraps.
-func (m RemoveWhitelistedDomainRequestMultiError) Error() string {
+func (m OrganizationMultiError) Error() string {
 	var msgs []string
 	for _, err := range m {
 		msgs = append(msgs, err.Error())
@@ -13704,12 +19165,11 @@ func (m RemoveWhitelistedDomainRequestMultiError) Error() string {
 }
 
 // AllErrors returns a list of validation violation errors.
-func (m RemoveWhitelistedDomainRequestMultiError) AllErrors() []error { return m }
+func (m OrganizationMultiError) AllErrors() []error { return m }
 
-// RemoveWhitelistedDomainRequestValidationError is the validation error
-// returned by RemoveWhitelistedDomainRequest.Validate if the designated
-// constraints aren't met.
-type RemoveWhitelistedDomainRequestValidationError struct {
+// OrganizationValidationError is the validation error returned by
+// Organization.Validate if the designated constraints aren't met.
+type OrganizationValidationError struct {
 	field  string
 	reason string
 	cause  error
@@ -13717,24 +19177,22 @@ type RemoveWhitelistedDomainRequestValidationError struct {
 }
 
 // Field function returns field value.
-func (e RemoveWhitelistedDomainRequestValidationError) Field() string { return e.field }
+func (e OrganizationValidationError) Field() string { return e.field }
 
 // Reason function returns reason value.
-func (e RemoveWhitelistedDomainRequestValidationError) Reason() string { return e.reason }
+func (e OrganizationValidationError) Reason() string { return e.reason }
 
 // Cause function returns cause value.
-func (e RemoveWhitelistedDomainRequestValidationError) Cause() error { return e.cause }
+func (e OrganizationValidationError) Cause() error { return e.cause }
 
 // Key function returns key value.
-func (e RemoveWhitelistedDomainRequestValidationError) Key() bool { return e.key }
+func (e OrganizationValidationError) Key() bool { return e.key }
 
 // ErrorName returns error name.
-func (e RemoveWhitelistedDomainRequestValidationError) ErrorName() string {
-	return "RemoveWhitelistedDomainRequestValidationError"
-}
+func (e OrganizationValidationError) ErrorName() string { return "OrganizationValidationError" }
 
 // Error satisfies the builtin error interface
-func (e RemoveWhitelistedDomainRequestValidationError) Error() string {
+func (e OrganizationValidationError) Error() string {
 	cause := ""
 	if e.cause != nil {
 		cause = fmt.Sprintf(" | caused by: %v", e.cause)
@@ -13746,14 +19204,14 @@ func (e RemoveWhitelistedDomainRequestValidationError) Error() string {
 	}
 
 	return fmt.Sprintf(
-		"invalid %sRemoveWhitelistedDomainRequest.%s: %s%s",
+		"invalid %sOrganization.%s: %s%s",
 		key,
 		e.field,
 		e.reason,
 		cause)
 }
 
-var _ error = RemoveWhitelistedDomainRequestValidationError{}
+var _ error = OrganizationValidationError{}
 
 var _ interface {
 	Field() string
@@ -13761,44 +19219,45 @@ var _ interface {
 	Key() bool
 	Cause() error
 	ErrorName() string
-} = RemoveWhitelistedDomainRequestValidationError{}
+} = OrganizationValidationError{}
 
-// Validate checks the field values on RemoveWhitelistedDomainResponse with the
-// rules defined in the proto definition for this message. If any rules are
-// violated, the first error encountered is returned, or nil if there are no violations.
-func (m *RemoveWhitelistedDomainResponse) Validate() error {
+// Validate checks the field values on UserQuotas with the rules defined in the
+// proto definition for this message. If any rules are violated, the first
+// error encountered is returned, or nil if there are no violations.
+func (m *UserQuotas) Validate() error {
 	return m.validate(false)
 }
 
-// ValidateAll checks the field values on RemoveWhitelistedDomainResponse with
-// the rules defined in the proto definition for this message. If any rules
-// are violated, the result is a list of violation errors wrapped in
-// RemoveWhitelistedDomainResponseMultiError, or nil if none found.
-func (m *RemoveWhitelistedDomainResponse) ValidateAll() error {
+// ValidateAll checks the field values on UserQuotas with the rules defined in
+// the proto definition for this message. If any rules are violated, the
+// result is a list of violation errors wrapped in UserQuotasMultiError, or
+// nil if none found.
+func (m *UserQuotas) ValidateAll() error {
 	return m.validate(true)
 }
 
-func (m *RemoveWhitelistedDomainResponse) validate(all bool) error {
+func (m *UserQuotas) validate(all bool) error {
 	if m == nil {
 		return nil
 	}
 
 	var errors []error
 
+	// no validation rules for SingleuserOrgs
+
 	if len(errors) > 0 {
-		return RemoveWhitelistedDomainResponseMultiError(errors)
+		return UserQuotasMultiError(errors)
 	}
 
 	return nil
 }
 
-// RemoveWhitelistedDomainResponseMultiError is an error wrapping multiple
-// validation errors returned by RemoveWhitelistedDomainResponse.ValidateAll()
-// if the designated constraints aren't met.
-type RemoveWhitelistedDomainResponseMultiError []error
+// UserQuotasMultiError is an error wrapping multiple validation errors
+// returned by UserQuotas.ValidateAll() if the designated constraints aren't met.
+type UserQuotasMultiError []error
 
 // Error returns a concatenation of all the error messages it wraps.
-func (m RemoveWhitelistedDomainResponseMultiError) Error() string {
+func (m UserQuotasMultiError) Error() string {
 	var msgs []string
 	for _, err := range m {
 		msgs = append(msgs, err.Error())
@@ -13807,12 +19266,11 @@ func (m RemoveWhitelistedDomainResponseMultiError) Error() string {
 }
 
 // AllErrors returns a list of validation violation errors.
-func (m RemoveWhitelistedDomainResponseMultiError) AllErrors() []error { return m }
+func (m UserQuotasMultiError) AllErrors() []error { return m }
 
-// RemoveWhitelistedDomainResponseValidationError is the validation error
-// returned by RemoveWhitelistedDomainResponse.Validate if the designated
-// constraints aren't met.
-type RemoveWhitelistedDomainResponseValidationError struct {
+// UserQuotasValidationError is the validation error returned by
+// UserQuotas.Validate if the designated constraints aren't met.
+type UserQuotasValidationError struct {
 	field  string
 	reason string
 	cause  error
@@ -13820,24 +19278,22 @@ type RemoveWhitelistedDomainResponseValidationError struct {
 }
 
 // Field function returns field value.
-func (e RemoveWhitelistedDomainResponseValidationError) Field() string { return e.field }
+func (e UserQuotasValidationError) Field() string { return e.field }
 
 // Reason function returns reason value.
-func (e RemoveWhitelistedDomainResponseValidationError) Reason() string { return e.reason }
+func (e UserQuotasValidationError) Reason() string { return e.reason }
 
 // Cause function returns cause value.
-func (e RemoveWhitelistedDomainResponseValidationError) Cause() error { return e.cause }
+func (e UserQuotasValidationError) Cause() error { return e.cause }
 
 // Key function returns key value.
-func (e RemoveWhitelistedDomainResponseValidationError) Key() bool { return e.key }
+func (e UserQuotasValidationError) Key() bool { return e.key }
 
 // ErrorName returns error name.
-func (e RemoveWhitelistedDomainResponseValidationError) ErrorName() string {
-	return "RemoveWhitelistedDomainResponseValidationError"
-}
+func (e UserQuotasValidationError) ErrorName() string { return "UserQuotasValidationError" }
 
 // Error satisfies the builtin error interface
-func (e RemoveWhitelistedDomainResponseValidationError) Error() string {
+func (e UserQuotasValidationError) Error() string {
 	cause := ""
 	if e.cause != nil {
 		cause = fmt.Sprintf(" | caused by: %v", e.cause)
@@ -13849,14 +19305,14 @@ func (e RemoveWhitelistedDomainResponseValidationError) Error() string {
 	}
 
 	return fmt.Sprintf(
-		"invalid %sRemoveWhitelistedDomainResponse.%s: %s%s",
+		"invalid %sUserQuotas.%s: %s%s",
 		key,
 		e.field,
 		e.reason,
 		cause)
 }
 
-var _ error = RemoveWhitelistedDomainResponseValidationError{}
+var _ error = UserQuotasValidationError{}
 
 var _ interface {
 	Field() string
@@ -13864,55 +19320,54 @@ var _ interface {
 	Key() bool
 	Cause() error
 	ErrorName() string
-} = RemoveWhitelistedDomainResponseValidationError{}
+} = UserQuotasValidationError{}
 
-// Validate checks the field values on ListWhitelistedDomainsRequest with the
-// rules defined in the proto definition for this message. If any rules are
+// Validate checks the field values on OrganizationQuotas with the rules
+// defined in the proto definition for this message. If any rules are
 // violated, the first error encountered is returned, or nil if there are no violations.
-func (m *ListWhitelistedDomainsRequest) Validate() error {
+func (m *OrganizationQuotas) Validate() error {
 	return m.validate(false)
 }
 
-// ValidateAll checks the field values on ListWhitelistedDomainsRequest with
-// the rules defined in the proto definition for this message. If any rules
-// are violated, the result is a list of violation errors wrapped in
-// ListWhitelistedDomainsRequestMultiError, or nil if none found.
-func (m *ListWhitelistedDomainsRequest) ValidateAll() error {
+// ValidateAll checks the field values on OrganizationQuotas with the rules
+// defined in the proto definition for this message. If any rules are
+// violated, the result is a list of violation errors wrapped in
+// OrganizationQuotasMultiError, or nil if none found.
+func (m *OrganizationQuotas) ValidateAll() error {
 	return m.validate(true)
 }
 
-func (m *ListWhitelistedDomainsRequest) validate(all bool) error {
+func (m *OrganizationQuotas) validate(all bool) error {
 	if m == nil {
 		return nil
 	}
 
 	var errors []error
 
-	if utf8.RuneCountInString(m.GetOrganization()) < 1 {
-		err := ListWhitelistedDomainsRequestValidationError{
-			field:  "Organization",
-			reason: "value length must be at least 1 runes",
-		}
-		if !all {
-			return err
-		}
-		errors = append(errors, err)
-	}
+	// no validation rules for Projects
+
+	// no validation rules for Deployments
+
+	// no validation rules for SlotsTotal
+
+	// no validation rules for SlotsPerDeployment
+
+	// no validation rules for OutstandingInvites
 
 	if len(errors) > 0 {
-		return ListWhitelistedDomainsRequestMultiError(errors)
+		return OrganizationQuotasMultiError(errors)
 	}
 
 	return nil
 }
 
-// ListWhitelistedDomainsRequestMultiError is an error wrapping multiple
-// validation errors returned by ListWhitelistedDomainsRequest.ValidateAll()
-// if the designated constraints aren't met.
-type ListWhitelistedDomainsRequestMultiError []error
+// OrganizationQuotasMultiError is an error wrapping multiple validation errors
+// returned by OrganizationQuotas.ValidateAll() if the designated constraints
+// aren't met.
+type OrganizationQuotasMultiError []error
 
 // Error returns a concatenation of all the error messages it wraps.
-func (m ListWhitelistedDomainsRequestMultiError) Error() string {
+func (m OrganizationQuotasMultiError) Error() string {
 	var msgs []string
 	for _, err := range m {
 		msgs = append(msgs, err.Error())
@@ -13921,12 +19376,11 @@ func (m ListWhitelistedDomainsRequestMultiError) Error() string {
 }
 
 // AllErrors returns a list of validation violation errors.
-func (m ListWhitelistedDomainsRequestMultiError) AllErrors() []error { return m }
+func (m OrganizationQuotasMultiError) AllErrors() []error { return m }
 
-// ListWhitelistedDomainsRequestValidationError is the validation error
-// returned by ListWhitelistedDomainsRequest.Validate if the designated
-// constraints aren't met.
-type ListWhitelistedDomainsRequestValidationError struct {
+// OrganizationQuotasValidationError is the validation error returned by
+// OrganizationQuotas.Validate if the designated constraints aren't met.
+type OrganizationQuotasValidationError struct {
 	field  string
 	reason string
 	cause  error
@@ -13934,24 +19388,24 @@ type ListWhitelistedDomainsRequestValidationError struct {
 }
 
 // Field function returns field value.
-func (e ListWhitelistedDomainsRequestValidationError) Field() string { return e.field }
+func (e OrganizationQuotasValidationError) Field() string { return e.field }
 
 // Reason function returns reason value.
-func (e ListWhitelistedDomainsRequestValidationError) Reason() string { return e.reason }
+func (e OrganizationQuotasValidationError) Reason() string { return e.reason }
 
 // Cause function returns cause value.
-func (e ListWhitelistedDomainsRequestValidationError) Cause() error { return e.cause }
+func (e OrganizationQuotasValidationError) Cause() error { return e.cause }
 
 // Key function returns key value.
-func (e ListWhitelistedDomainsRequestValidationError) Key() bool { return e.key }
+func (e OrganizationQuotasValidationError) Key() bool { return e.key }
 
 // ErrorName returns error name.
-func (e ListWhitelistedDomainsRequestValidationError) ErrorName() string {
-	return "ListWhitelistedDomainsRequestValidationError"
+func (e OrganizationQuotasValidationError) ErrorName() string {
+	return "OrganizationQuotasValidationError"
 }
 
 // Error satisfies the builtin error interface
-func (e ListWhitelistedDomainsRequestValidationError) Error() string {
+func (e OrganizationQuotasValidationError) Error() string {
 	cause := ""
 	if e.cause != nil {
 		cause = fmt.Sprintf(" | caused by: %v", e.cause)
@@ -13963,14 +19417,14 @@ func (e ListWhitelistedDomainsRequestValidationError) Error() string {
 	}
 
 	return fmt.Sprintf(
-		"invalid %sListWhitelistedDomainsRequest.%s: %s%s",
+		"invalid %sOrganizationQuotas.%s: %s%s",
 		key,
 		e.field,
 		e.reason,
 		cause)
 }
 
-var _ error = ListWhitelistedDomainsRequestValidationError{}
+var _ error = OrganizationQuotasValidationError{}
 
 var _ interface {
 	Field() string
@@ -13978,78 +19432,134 @@ var _ interface {
 	Key() bool
 	Cause() error
 	ErrorName() string
-} = ListWhitelistedDomainsRequestValidationError{}
+} = OrganizationQuotasValidationError{}
 
-// Validate checks the field values on ListWhitelistedDomainsResponse with the
-// rules defined in the proto definition for this message. If any rules are
-// violated, the first error encountered is returned, or nil if there are no violations.
-func (m *ListWhitelistedDomainsResponse) Validate() error {
+// Validate checks the field values on Project with the rules defined in the
+// proto definition for this message. If any rules are violated, the first
+// error encountered is returned, or nil if there are no violations.
+func (m *Project) Validate() error {
 	return m.validate(false)
 }
 
-// ValidateAll checks the field values on ListWhitelistedDomainsResponse with
-// the rules defined in the proto definition for this message. If any rules
-// are violated, the result is a list of violation errors wrapped in
-// ListWhitelistedDomainsResponseMultiError, or nil if none found.
-func (m *ListWhitelistedDomainsResponse) ValidateAll() error {
+// ValidateAll checks the field values on Project with the rules defined in the
+// proto definition for this message. If any rules are violated, the result is
+// a list of violation errors wrapped in ProjectMultiError, or nil if none found.
+func (m *Project) ValidateAll() error {
 	return m.validate(true)
 }
 
-func (m *ListWhitelistedDomainsResponse) validate(all bool) error {
+func (m *Project) validate(all bool) error {
 	if m == nil {
 		return nil
 	}
 
 	var errors []error
 
-	for idx, item := range m.GetDomains() {
-		_, _ = idx, item
+	// no validation rules for Id
 
-		if all {
-			switch v := interface{}(item).(type) {
-			case interface{ ValidateAll() error }:
-				if err := v.ValidateAll(); err != nil {
-					errors = append(errors, ListWhitelistedDomainsResponseValidationError{
-						field:  fmt.Sprintf("Domains[%v]", idx),
-						reason: "embedded message failed validation",
-						cause:  err,
-					})
-				}
-			case interface{ Validate() error }:
-				if err := v.Validate(); err != nil {
-					errors = append(errors, ListWhitelistedDomainsResponseValidationError{
-						field:  fmt.Sprintf("Domains[%v]", idx),
-						reason: "embedded message failed validation",
-						cause:  err,
-					})
-				}
+	// no validation rules for Name
+
+	// no validation rules for OrgId
+
+	// no validation rules for OrgName
+
+	// no validation rules for Description
+
+	// no validation rules for Public
+
+	// no validation rules for Region
+
+	// no validation rules for GithubUrl
+
+	// no validation rules for Subpath
+
+	// no validation rules for ProdBranch
+
+	// no validation rules for ProdOlapDriver
+
+	// no validation rules for ProdOlapDsn
+
+	// no validation rules for ProdSlots
+
+	// no validation rules for ProdDeploymentId
+
+	// no validation rules for FrontendUrl
+
+	// no validation rules for ProdTtlSeconds
+
+	// no validation rules for PreviewDeployments
+
+	if all {
+		switch v := interface{}(m.GetCreatedOn()).(type) {
+		case interface{ ValidateAll() error }:
+			if err := v.ValidateAll(); err != nil {
+				errors = append(errors, ProjectValidationError{
+					field:  "CreatedOn",
+					reason: "embedded message failed validation",
+					cause:  err,
+				})
 			}
-		} else if v, ok := interface{}(item).(interface{ Validate() error }); ok {
+		case interface{ Validate() error }:
 			if err := v.Validate(); err != nil {
-				return ListWhitelistedDomainsResponseValidationError{
-					field:  fmt.Sprintf("Domains[%v]", idx),
+				errors = append(errors, ProjectValidationError{
+					field:  "CreatedOn",
 					reason: "embedded message failed validation",
 					cause:  err,
-				}
+				})
+			}
+		}
+	} else if v, ok := interface{}(m.GetCreatedOn()).(interface{ Validate() error }); ok {
+		if err := v.Validate(); err != nil {
+			return ProjectValidationError{
+				field:  "CreatedOn",
+				reason: "embedded message failed validation",
+				cause:  err,
 			}
 		}
+	}
 
+	if all {
+		switch v := interface{}(m.GetUpdatedOn()).(type) {
+		case interface{ ValidateAll() error }:
+			if err := v.ValidateAll(); err != nil {
+				errors = append(errors, ProjectValidationError{
+					field:  "UpdatedOn",
+					reason: "embedded message failed validation",
+					cause:  err,
+				})
+			}
+		case interface{ Validate() error }:
+			if err := v.Validate(); err != nil {
+				errors = append(errors, ProjectValidationError{
+					field:  "UpdatedOn",
+					reason: "embedded message failed validation",
+					cause:  err,
+				})
+			}
+		}
+	} else if v, ok := interface{}(m.GetUpdatedOn()).(interface{ Validate() error }); ok {
+		if err := v.Validate(); err != nil {
+			return ProjectValidationError{
+				field:  "UpdatedOn",
+				reason: "embedded message failed validation",
+				cause:  err,
+			}
+		}
 	}
 
 	if len(errors) > 0 {
-		return ListWhitelistedDomainsResponseMultiError(errors)
+		return ProjectMultiError(errors)
 	}
 
 	return nil
 }
 
-// ListWhitelistedDomainsResponseMultiError is an error wrapping multiple
-// validation errors returned by ListWhitelistedDomainsResponse.ValidateAll()
-// if the designated constraints aren't met.
-type ListWhitelistedDomainsResponseMultiError []error
+// ProjectMultiError is an error wrapping multiple validation errors returned
+// by Project.ValidateAll() if the designated constraints aren't met.
+type ProjectMultiError []error
 
 // Error returns a concatenation of all the error messages it wraps.
-func (m ListWhitelistedDomainsResponseMultiError) Error() string {
+func (m ProjectMultiError) Error() string {
 	var msgs []string
 	for _, err := range m {
 		msgs = append(msgs, err.Error())
@@ -14058,12 +19568,11 @@ func (m ListWhitelistedDomainsResponseMultiError) Error() string {
 }
 
 // AllErrors returns a list of validation violation errors.
-func (m ListWhitelistedDomainsResponseMultiError) AllErrors() []error { return m }
+func (m ProjectMultiError) AllErrors() []error { return m }
 
-// ListWhitelistedDomainsResponseValidationError is the validation error
-// returned by ListWhitelistedDomainsResponse.Validate if the designated
-// constraints aren't met.
-type ListWhitelistedDomainsResponseValidationError struct {
+// ProjectValidationError is the validation error returned by Project.Validate
+// if the designated constraints aren't met.
+type ProjectValidationError struct {
 	field  string
 	reason string
 	cause  error
@@ -14071,24 +19580,22 @@ type ListWhitelistedDomainsResponseValidationError struct {
 }
 
 // Field function returns field value.
-func (e ListWhitelistedDomainsResponseValidationError) Field() string { return e.field }
+func (e ProjectValidationError) Field() string { return e.field }
 
 // Reason function returns reason value.
-func (e ListWhitelistedDomainsResponseValidationError) Reason() string { return e.reason }
+func (e ProjectValidationError) Reason() string { return e.reason }
 
 // Cause function returns cause value.
-func (e ListWhitelistedDomainsResponseValidationError) Cause() error { return e.cause }
+func (e ProjectValidationError) Cause() error { return e.cause }
 
 // Key function returns key value.
-func (e ListWhitelistedDomainsResponseValidationError) Key() bool { return e.key }
+func (e ProjectValidationError) Key() bool { return e.key }
 
 // ErrorName returns error name.
-func (e ListWhitelistedDomainsResponseValidationError) ErrorName() string {
-	return "ListWhitelistedDomainsResponseValidationError"
-}
+func (e ProjectValidationError) ErrorName() string { return "ProjectValidationError" }
 
 // Error satisfies the builtin error interface
-func (e ListWhitelistedDomainsResponseValidationError) Error() string {
+func (e ProjectValidationError) Error() string {
 	cause := ""
 	if e.cause != nil {
 		cause = fmt.Sprintf(" | caused by: %v", e.cause)
@@ -14100,14 +19607,14 @@ func (e ListWhitelistedDomainsResponseValidationError) Error() string {
 	}
 
 	return fmt.Sprintf(
-		"invalid %sListWhitelistedDomainsResponse.%s: %s%s",
+		"invalid %sProject.%s: %s%s",
 		key,
 		e.field,
 		e.reason,
 		cause)
 }
 
-var _ error = ListWhitelistedDomainsResponseValidationError{}
+var _ error = ProjectValidationError{}
 
 var _ interface {
 	Field() string
@@ -14115,23 +19622,24 @@ var _ interface {
 	Key() bool
 	Cause() error
 	ErrorName() string
-} = ListWhitelistedDomainsResponseValidationError{}
+} = ProjectValidationError{}
 
-// Validate checks the field values on User with the rules defined in the proto
-// definition for this message. If any rules are violated, the first error
-// encountered is returned, or nil if there are no violations.
-func (m *User) Validate() error {
+// Validate checks the field values on Deployment with the rules defined in the
+// proto definition for this message. If any rules are violated, the first
+// error encountered is returned, or nil if there are no violations.
+func (m *Deployment) Validate() error {
 	return m.validate(false)
 }
 
-// ValidateAll checks the field values on User with the rules defined in the
-// proto definition for this message. If any rules are violated, the result is
-// a list of violation errors wrapped in UserMultiError, or nil if none found.
-func (m *User) ValidateAll() error {
+// ValidateAll checks the field values on Deployment with the rules defined in
+// the proto definition for this message. If any rules are violated, the
+// result is a list of violation errors wrapped in DeploymentMultiError, or
+// nil if none found.
+func (m *Deployment) ValidateAll() error {
 	return m.validate(true)
 }
 
-func (m *User) validate(all bool) error {
+func (m *Deployment) validate(all bool) error {
 	if m == nil {
 		return nil
 	}
@@ -14140,46 +19648,25 @@ func (m *User) validate(all bool) error {
 
 	// no validation rules for Id
 
-	// no validation rules for Email
+	// no validation rules for ProjectId
 
-	// no validation rules for DisplayName
+	// no validation rules for Slots
 
-	// no validation rules for PhotoUrl
+	// no validation rules for Branch
 
-	if all {
-		switch v := interface{}(m.GetQuotas()).(type) {
-		case interface{ ValidateAll() error }:
-			if err := v.ValidateAll(); err != nil {
-				errors = append(errors, UserValidationError{
-					field:  "Quotas",
-					reason: "embedded message failed validation",
-					cause:  err,
-				})
-			}
-		case interface{ Validate() error }:
-			if err := v.Validate(); err != nil {
-				errors = append(errors, UserValidationError{
-					field:  "Quotas",
-					reason: "embedded message failed validation",
-					cause:  err,
-				})
-			}
-		}
-	} else if v, ok := interface{}(m.GetQuotas()).(interface{ Validate() error }); ok {
-		if err := v.Validate(); err != nil {
-			return UserValidationError{
-				field:  "Quotas",
-				reason: "embedded message failed validation",
-				cause:  err,
-			}
-		}
-	}
+	// no validation rules for RuntimeHost
+
+	// no validation rules for RuntimeInstanceId
+
+	// no validation rules for Status
+
+	// no validation rules for Logs
 
 	if all {
 		switch v := interface{}(m.GetCreatedOn()).(type) {
 		case interface{ ValidateAll() error }:
 			if err := v.ValidateAll(); err != nil {
-				errors = append(errors, UserValidationError{
+				errors = append(errors, DeploymentValidationError{
 					field:  "CreatedOn",
 					reason: "embedded message failed validation",
 					cause:  err,
@@ -14187,7 +19674,7 @@ func (m *User) validate(all bool) error {
 			}
 		case interface{ Validate() error }:
 			if err := v.Validate(); err != nil {
-				errors = append(errors, UserValidationError{
+				errors = append(errors, DeploymentValidationError{
 					field:  "CreatedOn",
 					reason: "embedded message failed validation",
 					cause:  err,
@@ -14196,7 +19683,7 @@ func (m *User) validate(all bool) error {
 		}
 	} else if v, ok := interface{}(m.GetCreatedOn()).(interface{ Validate() error }); ok {
 		if err := v.Validate(); err != nil {
-			return UserValidationError{
+			return DeploymentValidationError{
 				field:  "CreatedOn",
 				reason: "embedded message failed validation",
 				cause:  err,
@@ -14208,7 +19695,7 @@ func (m *User) validate(all bool) error {
 		switch v := interface{}(m.GetUpdatedOn()).(type) {
 		case interface{ ValidateAll() error }:
 			if err := v.ValidateAll(); err != nil {
-				errors = append(errors, UserValidationError{
+				errors = append(errors, DeploymentValidationError{
 					field:  "UpdatedOn",
 					reason: "embedded message failed validation",
 					cause:  err,
@@ -14216,7 +19703,7 @@ func (m *User) validate(all bool) error {
 			}
 		case interface{ Validate() error }:
 			if err := v.Validate(); err != nil {
-				errors = append(errors, UserValidationError{
+				errors = append(errors, DeploymentValidationError{
 					field:  "UpdatedOn",
 					reason: "embedded message failed validation",
 					cause:  err,
@@ -14225,7 +19712,7 @@ func (m *User) validate(all bool) error {
 		}
 	} else if v, ok := interface{}(m.GetUpdatedOn()).(interface{ Validate() error }); ok {
 		if err := v.Validate(); err != nil {
-			return UserValidationError{
+			return DeploymentValidationError{
 				field:  "UpdatedOn",
 				reason: "embedded message failed validation",
 				cause:  err,
@@ -14233,19 +19720,23 @@ func (m *User) validate(all bool) error {
 		}
 	}
 
+	// no validation rules for Preview
+
+	// no validation rules for PullRequestNumber
+
 	if len(errors) > 0 {
-		return UserMultiError(errors)
+		return DeploymentMultiError(errors)
 	}
 
 	return nil
 }
 
-// UserMultiError is an error wrapping multiple validation errors returned by
-// User.ValidateAll() if the designated constraints aren't met.
-type UserMultiError []error
+// DeploymentMultiError is an error wrapping multiple validation errors
+// returned by Deployment.ValidateAll() if the designated constraints aren't met.
+type DeploymentMultiError []error
 
 // Error returns a concatenation of all the error messages it wraps.
-func (m UserMultiError) Error() string {
+func (m DeploymentMultiError) Error() string {
 	var msgs []string
 	for _, err := range m {
 		msgs = append(msgs, err.Error())
@@ -14254,11 +19745,11 @@ func (m UserMultiError) Error() string {
 }
 
 // AllErrors returns a list of validation violation errors.
-func (m UserMultiError) AllErrors() []error { return m }
+func (m DeploymentMultiError) AllErrors() []error { return m }
 
-// UserValidationError is the validation error returned by User.Validate if the
-// designated constraints aren't met.
-type UserValidationError struct {
+// DeploymentValidationError is the validation error returned by
+// Deployment.Validate if the designated constraints aren't met.
+type DeploymentValidationError struct {
 	field  string
 	reason string
 	cause  error
@@ -14266,22 +19757,22 @@ type UserValidationError struct {
 }
 
 // Field function returns field value.
-func (e UserValidationError) Field() string { return e.field }
+func (e DeploymentValidationError) Field() string { return e.field }
 
 // Reason function returns reason value.
-func (e UserValidationError) Reason() string { return e.reason }
+func (e DeploymentValidationError) Reason() string { return e.reason }
 
 // Cause function returns cause value.
-func (e UserValidationError) Cause() error { return e.cause }
+func (e DeploymentValidationError) Cause() error { return e.cause }
 
 // Key function returns key value.
-func (e UserValidationError) Key() bool { return e.key }
+func (e DeploymentValidationError) Key() bool { return e.key }
 
 // ErrorName returns error name.
-func (e UserValidationError) ErrorName() string { return "UserValidationError" }
+func (e DeploymentValidationError) ErrorName() string { return "DeploymentValidationError" }
 
 // Error satisfies the builtin error interface
-func (e UserValidationError) Error() string {
+func (e DeploymentValidationError) Error() string {
 	cause := ""
 	if e.cause != nil {
 		cause = fmt.Sprintf(" | caused by: %v", e.cause)
@@ -14293,14 +19784,14 @@ func (e UserValidationError) Error() string {
 	}
 
 	return fmt.Sprintf(
-		"invalid %sUser.%s: %s%s",
+		"invalid %sDeployment.%s: %s%s",
 		key,
 		e.field,
 		e.reason,
 		cause)
 }
 
-var _ error = UserValidationError{}
+var _ error = DeploymentValidationError{}
 
 var _ interface {
 	Field() string
@@ -14308,108 +19799,180 @@ var _ interface {
 	Key() bool
 	Cause() error
 	ErrorName() string
-} = UserValidationError{}
+} = DeploymentValidationError{}
 
-// Validate checks the field values on Service with the rules defined in the
-// proto definition for this message. If any rules are violated, the first
-// error encountered is returned, or nil if there are no violations.
-func (m *Service) Validate() error {
+// Validate checks the field values on OrganizationPermissions with the rules
+// defined in the proto definition for this message. If any rules are
+// violated, the first error encountered is returned, or nil if there are no violations.
+func (m *OrganizationPermissions) Validate() error {
 	return m.validate(false)
 }
 
-// ValidateAll checks the field values on Service with the rules defined in the
-// proto definition for this message. If any rules are violated, the result is
-// a list of violation errors wrapped in ServiceMultiError, or nil if none found.
-func (m *Service) ValidateAll() error {
+// ValidateAll checks the field values on OrganizationPermissions with the
+// rules defined in the proto definition for this message. If any rules are
+// violated, the result is a list of violation errors wrapped in
+// OrganizationPermissionsMultiError, or nil if none found.
+func (m *OrganizationPermissions) ValidateAll() error {
 	return m.validate(true)
 }
 
-func (m *Service) validate(all bool) error {
+func (m *OrganizationPermissions) validate(all bool) error {
 	if m == nil {
 		return nil
 	}
 
 	var errors []error
 
-	// no validation rules for Id
+	// no validation rules for ReadOrg
 
-	// no validation rules for Name
+	// no validation rules for ManageOrg
 
-	// no validation rules for OrgId
+	// no validation rules for ReadProjects
 
-	// no validation rules for OrgName
+	// no validation rules for CreateProjects
 
-	if all {
-		switch v := interface{}(m.GetCreatedOn()).(type) {
-		case interface{ ValidateAll() error }:
-			if err := v.ValidateAll(); err != nil {
-				errors = append(errors, ServiceValidationError{
-					field:  "CreatedOn",
-					reason: "embedded message failed validation",
-					cause:  err,
-				})
-			}
-		case interface{ Validate() error }:
-			if err := v.Validate(); err != nil {
-				errors = append(errors, ServiceValidationError{
-					field:  "CreatedOn",
-					reason: "embedded message failed validation",
-					cause:  err,
-				})
-			}
-		}
-	} else if v, ok := interface{}(m.GetCreatedOn()).(interface{ Validate() error }); ok {
-		if err := v.Validate(); err != nil {
-			return ServiceValidationError{
-				field:  "CreatedOn",
-				reason: "embedded message failed validation",
-				cause:  err,
-			}
-		}
+	// no validation rules for ManageProjects
+
+	// no validation rules for ReadOrgMembers
+
+	// no validation rules for ManageOrgMembers
+
+	if len(errors) > 0 {
+		return OrganizationPermissionsMultiError(errors)
+	}
+
+	return nil
+}
+
+// OrganizationPermissionsMultiError is an error wrapping multiple validation
+// errors returned by OrganizationPermissions.ValidateAll() if the designated
+// constraints aren't met.
+type OrganizationPermissionsMultiError []error
+
+// Error returns a concatenation of all the error messages it wraps.
+func (m OrganizationPermissionsMultiError) Error() string {
+	var msgs []string
+	for _, err := range m {
+		msgs = append(msgs, err.Error())
+	}
+	return strings.Join(msgs, "; ")
+}
+
+// AllErrors returns a list of validation violation errors.
+func (m OrganizationPermissionsMultiError) AllErrors() []error { return m }
+
+// OrganizationPermissionsValidationError is the validation error returned by
+// OrganizationPermissions.Validate if the designated constraints aren't met.
+type OrganizationPermissionsValidationError struct {
+	field  string
+	reason string
+	cause  error
+	key    bool
+}
+
+// Field function returns field value.
+func (e OrganizationPermissionsValidationError) Field() string { return e.field }
+
+// Reason function returns reason value.
+func (e OrganizationPermissionsValidationError) Reason() string { return e.reason }
+
+// Cause function returns cause value.
+func (e OrganizationPermissionsValidationError) Cause() error { return e.cause }
+
+// Key function returns key value.
+func (e OrganizationPermissionsValidationError) Key() bool { return e.key }
+
+// ErrorName returns error name.
+func (e OrganizationPermissionsValidationError) ErrorName() string {
+	return "OrganizationPermissionsValidationError"
+}
+
+// Error satisfies the builtin error interface
+func (e OrganizationPermissionsValidationError) Error() string {
+	cause := ""
+	if e.cause != nil {
+		cause = fmt.Sprintf(" | caused by: %v", e.cause)
+	}
+
+	key := ""
+	if e.key {
+		key = "key for "
 	}
 
-	if all {
-		switch v := interface{}(m.GetUpdatedOn()).(type) {
-		case interface{ ValidateAll() error }:
-			if err := v.ValidateAll(); err != nil {
-				errors = append(errors, ServiceValidationError{
-					field:  "UpdatedOn",
-					reason: "embedded message failed validation",
-					cause:  err,
-				})
-			}
-		case interface{ Validate() error }:
-			if err := v.Validate(); err != nil {
-				errors = append(errors, ServiceValidationError{
-					field:  "UpdatedOn",
-					reason: "embedded message failed validation",
-					cause:  err,
-				})
-			}
-		}
-	} else if v, ok := interface{}(m.GetUpdatedOn()).(interface{ Validate() error }); ok {
-		if err := v.Validate(); err != nil {
-			return ServiceValidationError{
-				field:  "UpdatedOn",
-				reason: "embedded message failed validation",
-				cause:  err,
-			}
-		}
+	return fmt.Sprintf(
+		"invalid %sOrganizationPermissions.%s: %s%s",
+		key,
+		e.field,
+		e.reason,
+		cause)
+}
+
+var _ error = OrganizationPermissionsValidationError{}
+
+var _ interface {
+	Field() string
+	Reason() string
+	Key() bool
+	Cause() error
+	ErrorName() string
+} = OrganizationPermissionsValidationError{}
+
+// Validate checks the field values on ProjectPermissions with the rules
+// defined in the proto definition for this message. If any rules are
+// violated, the first error encountered is returned, or nil if there are no violations.
+func (m *ProjectPermissions) Validate() error {
+	return m.validate(false)
+}
+
+// ValidateAll checks the field values on ProjectPermissions with the rules
+// defined in the proto definition for this message. If any rules are
+// violated, the result is a list of violation errors wrapped in
+// ProjectPermissionsMultiError, or nil if none found.
+func (m *ProjectPermissions) ValidateAll() error {
+	return m.validate(true)
+}
+
+func (m *ProjectPermissions) validate(all bool) error {
+	if m == nil {
+		return nil
 	}
 
+	var errors []error
+
+	// no validation rules for ReadProject
+
+	// no validation rules for ManageProject
+
+	// no validation rules for ReadProd
+
+	// no validation rules for ReadProdStatus
+
+	// no validation rules for ManageProd
+
+	// no validation rules for ReadDev
+
+	// no validation rules for ReadDevStatus
+
+	// no validation rules for ManageDev
+
+	// no validation rules for ReadProjectMembers
+
+	// no validation rules for ManageProjectMembers
+
 	if len(errors) > 0 {
-		return ServiceMultiError(errors)
+		return ProjectPermissionsMultiError(errors)
 	}
 
 	return nil
 }
 
-// ServiceMultiError is an error wrapping multiple validation errors returned
-// by Service.ValidateAll() if the designated constraints aren't met.
-type ServiceMultiError []error
+// ProjectPermissionsMultiError is an error wrapping multiple validation errors
+// returned by ProjectPermissions.ValidateAll() if the designated constraints
+// aren't met.
+type ProjectPermissionsMultiError []error
 
 // Error returns a concatenation of all the error messages it wraps.
-func (m ServiceMultiError) Error() string {
+func (m ProjectPermissionsMultiError) Error() string {
 	var msgs []string
 	for _, err := range m {
 		msgs = append(msgs, err.Error())
@@ -14418,11 +19981,11 @@ func (m ServiceMultiError) Error() string {
 }
 
 // AllErrors returns a list of validation violation errors.
-func (m ServiceMultiError) AllErrors() []error { return m }
+func (m ProjectPermissionsMultiError) AllErrors() []error { return m }
 
-// ServiceValidationError is the validation error returned by Service.Validate
-// if the designated constraints aren't met.
-type ServiceValidationError struct {
+// ProjectPermissionsValidationError is the validation error returned by
+// ProjectPermissions.Validate if the designated constraints aren't met.
+type ProjectPermissionsValidationError struct {
 	field  string
 	reason string
 	cause  error
@@ -14430,22 +19993,24 @@ type ServiceValidationError struct {
 }
 
 // Field function returns field value.
-func (e ServiceValidationError) Field() string { return e.field }
+func (e ProjectPermissionsValidationError) Field() string { return e.field }
 
 // Reason function returns reason value.
-func (e ServiceValidationError) Reason() string { return e.reason }
+func (e ProjectPermissionsValidationError) Reason() string { return e.reason }
 
 // Cause function returns cause value.
-func (e ServiceValidationError) Cause() error { return e.cause }
+func (e ProjectPermissionsValidationError) Cause() error { return e.cause }
 
 // Key function returns key value.
-func (e ServiceValidationError) Key() bool { return e.key }
+func (e ProjectPermissionsValidationError) Key() bool { return e.key }
 
 // ErrorName returns error name.
-func (e ServiceValidationError) ErrorName() string { return "ServiceValidationError" }
+func (e ProjectPermissionsValidationError) ErrorName() string {
+	return "ProjectPermissionsValidationError"
+}
 
 // Error satisfies the builtin error interface
-func (e ServiceValidationError) Error() string {
+func (e ProjectPermissionsValidationError) Error() string {
 	cause := ""
 	if e.cause != nil {
 		cause = fmt.Sprintf(" | caused by: %v", e.cause)
@@ -14457,14 +20022,14 @@ func (e ServiceValidationError) Error() string {
 	}
 
 	return fmt.Sprintf(
-		"invalid %sService.%s: %s%s",
+		"invalid %sProjectPermissions.%s: %s%s",
 		key,
 		e.field,
 		e.reason,
 		cause)
 }
 
-var _ error = ServiceValidationError{}
+var _ error = ProjectPermissionsValidationError{}
 
 var _ interface {
 	Field() string
@@ -14472,70 +20037,42 @@ var _ interface {
 	Key() bool
 	Cause() error
 	ErrorName() string
-} = ServiceValidationError{}
+} = ProjectPermissionsValidationError{}
 
-// Validate checks the field values on Organization with the rules defined in
-// the proto definition for this message. If any rules are violated, the first
+// Validate checks the field values on Member with the rules defined in the
+// proto definition for this message. If any rules are violated, the first
 // error encountered is returned, or nil if there are no violations.
-func (m *Organization) Validate() error {
+func (m *Member) Validate() error {
 	return m.validate(false)
 }
 
-// ValidateAll checks the field values on Organization with the rules defined
-// in the proto definition for this message. If any rules are violated, the
-// result is a list of violation errors wrapped in OrganizationMultiError, or
-// nil if none found.
-func (m *Organization) ValidateAll() error {
+// ValidateAll checks the field values on Member with the rules defined in the
+// proto definition for this message. If any rules are violated, the result is
+// a list of violation errors wrapped in MemberMultiError, or nil if none found.
+func (m *Member) ValidateAll() error {
 	return m.validate(true)
 }
 
-func (m *Organization) validate(all bool) error {
+func (m *Member) validate(all bool) error {
 	if m == nil {
 		return nil
 	}
 
 	var errors []error
 
-	// no validation rules for Id
+	// no validation rules for UserId
 
-	// no validation rules for Name
+	// no validation rules for UserEmail
 
-	// no validation rules for Description
+	// no validation rules for UserName
 
-	if all {
-		switch v := interface{}(m.GetQuotas()).(type) {
-		case interface{ ValidateAll() error }:
-			if err := v.ValidateAll(); err != nil {
-				errors = append(errors, OrganizationValidationError{
-					field:  "Quotas",
-					reason: "embedded message failed validation",
-					cause:  err,
-				})
-			}
-		case interface{ Validate() error }:
-			if err := v.Validate(); err != nil {
-				errors = append(errors, OrganizationValidationError{
-					field:  "Quotas",
-					reason: "embedded message failed validation",
-					cause:  err,
-				})
-			}
-		}
-	} else if v, ok := interface{}(m.GetQuotas()).(interface{ Validate() error }); ok {
-		if err := v.Validate(); err != nil {
-			return OrganizationValidationError{
-				field:  "Quotas",
-				reason: "embedded message failed validation",
-				cause:  err,
-			}
-		}
-	}
+	// no validation rules for RoleName
 
 	if all {
 		switch v := interface{}(m.GetCreatedOn()).(type) {
 		case interface{ ValidateAll() error }:
 			if err := v.ValidateAll(); err != nil {
-				errors = append(errors, OrganizationValidationError{
+				errors = append(errors, MemberValidationError{
 					field:  "CreatedOn",
 					reason: "embedded message failed validation",
 					cause:  err,
@@ -14543,7 +20080,7 @@ func (m *Organization) validate(all bool) error {
 			}
 		case interface{ Validate() error }:
 			if err := v.Validate(); err != nil {
-				errors = append(errors, OrganizationValidationError{
+				errors = append(errors, MemberValidationError{
 					field:  "CreatedOn",
 					reason: "embedded message failed validation",
 					cause:  err,
@@ -14552,7 +20089,7 @@ func (m *Organization) validate(all bool) error {
 		}
 	} else if v, ok := interface{}(m.GetCreatedOn()).(interface{ Validate() error }); ok {
 		if err := v.Validate(); err != nil {
-			return OrganizationValidationError{
+			return MemberValidationError{
 				field:  "CreatedOn",
 				reason: "embedded message failed validation",
 				cause:  err,
@@ -14564,7 +20101,7 @@ func (m *Organization) validate(all bool) error {
 		switch v := interface{}(m.GetUpdatedOn()).(type) {
 		case interface{ ValidateAll() error }:
 			if err := v.ValidateAll(); err != nil {
-				errors = append(errors, OrganizationValidationError{
+				errors = append(errors, MemberValidationError{
 					field:  "UpdatedOn",
 					reason: "embedded message failed validation",
 					cause:  err,
@@ -14572,7 +20109,7 @@ func (m *Organization) validate(all bool) error {
 			}
 		case interface{ Validate() error }:
 			if err := v.Validate(); err != nil {
-				errors = append(errors, OrganizationValidationError{
+				errors = append(errors, MemberValidationError{
 					field:  "UpdatedOn",
 					reason: "embedded message failed validation",
 					cause:  err,
@@ -14581,7 +20118,7 @@ func (m *Organization) validate(all bool) error {
 		}
 	} else if v, ok := interface{}(m.GetUpdatedOn()).(interface{ Validate() error }); ok {
 		if err := v.Validate(); err != nil {
-			return OrganizationValidationError{
+			return MemberValidationError{
 				field:  "UpdatedOn",
 				reason: "embedded message failed validation",
 				cause:  err,
@@ -14590,119 +20127,18 @@ func (m *Organization) validate(all bool) error {
 	}
 
 	if len(errors) > 0 {
-		return OrganizationMultiError(errors)
-	}
-
-	return nil
-}
-
-// OrganizationMultiError is an error wrapping multiple validation errors
-// returned by Organization.ValidateAll() if the designated constraints aren't met.
-type OrganizationMultiError []error
-
-// Error returns a concatenation of all the error messages it wraps.
-func (m OrganizationMultiError) Error() string {
-	var msgs []string
-	for _, err := range m {
-		msgs = append(msgs, err.Error())
-	}
-	return strings.Join(msgs, "; ")
-}
-
-// AllErrors returns a list of validation violation errors.
-func (m OrganizationMultiError) AllErrors() []error { return m }
-
-// OrganizationValidationError is the validation error returned by
-// Organization.Validate if the designated constraints aren't met.
-type OrganizationValidationError struct {
-	field  string
-	reason string
-	cause  error
-	key    bool
-}
-
-// Field function returns field value.
-func (e OrganizationValidationError) Field() string { return e.field }
-
-// Reason function returns reason value.
-func (e OrganizationValidationError) Reason() string { return e.reason }
-
-// Cause function returns cause value.
-func (e OrganizationValidationError) Cause() error { return e.cause }
-
-// Key function returns key value.
-func (e OrganizationValidationError) Key() bool { return e.key }
-
-// ErrorName returns error name.
-func (e OrganizationValidationError) ErrorName() string { return "OrganizationValidationError" }
-
-// Error satisfies the builtin error interface
-func (e OrganizationValidationError) Error() string {
-	cause := ""
-	if e.cause != nil {
-		cause = fmt.Sprintf(" | caused by: %v", e.cause)
-	}
-
-	key := ""
-	if e.key {
-		key = "key for "
-	}
-
-	return fmt.Sprintf(
-		"invalid %sOrganization.%s: %s%s",
-		key,
-		e.field,
-		e.reason,
-		cause)
-}
-
-var _ error = OrganizationValidationError{}
-
-var _ interface {
-	Field() string
-	Reason() string
-	Key() bool
-	Cause() error
-	ErrorName() string
-} = OrganizationValidationError{}
-
-// Validate checks the field values on UserQuotas with the rules defined in the
-// proto definition for this message. If any rules are violated, the first
-// error encountered is returned, or nil if there are no violations.
-func (m *UserQuotas) Validate() error {
-	return m.validate(false)
-}
-
-// ValidateAll checks the field values on UserQuotas with the rules defined in
-// the proto definition for this message. If any rules are violated, the
-// result is a list of violation errors wrapped in UserQuotasMultiError, or
-// nil if none found.
-func (m *UserQuotas) ValidateAll() error {
-	return m.validate(true)
-}
-
-func (m *UserQuotas) validate(all bool) error {
-	if m == nil {
-		return nil
-	}
-
-	var errors []error
-
-	// no validation rules for SingleuserOrgs
-
-	if len(errors) > 0 {
-		return UserQuotasMultiError(errors)
+		return MemberMultiError(errors)
 	}
 
 	return nil
 }
 
-// UserQuotasMultiError is an error wrapping multiple validation errors
-// returned by UserQuotas.ValidateAll() if the designated constraints aren't met.
-type UserQuotasMultiError []error
+// MemberMultiError is an error wrapping multiple validation errors returned by
+// Member.ValidateAll() if the designated constraints aren't met.
+type MemberMultiError []error
 
 // Error returns a concatenation of all the error messages it wraps.
-func (m UserQuotasMultiError) Error() string {
+func (m MemberMultiError) Error() string {
 	var msgs []string
 	for _, err := range m {
 		msgs = append(msgs, err.Error())
@@ -14711,11 +20147,11 @@ func (m UserQuotasMultiError) Error() string {
 }
 
 // AllErrors returns a list of validation violation errors.
-func (m UserQuotasMultiError) AllErrors() []error { return m }
+func (m MemberMultiError) AllErrors() []error { return m }
 
-// UserQuotasValidationError is the validation error returned by
-// UserQuotas.Validate if the designated constraints aren't met.
-type UserQuotasValidationError struct {
+// MemberValidationError is the validation error returned by Member.Validate if
+// the designated constraints aren't met.
+type MemberValidationError struct {
 	field  string
 	reason string
 	cause  error
@@ -14723,22 +20159,22 @@ type UserQuotasValidationError struct {
 }
 
 // Field function returns field value.
-func (e UserQuotasValidationError) Field() string { return e.field }
+func (e MemberValidationError) Field() string { return e.field }
 
 // Reason function returns reason value.
-func (e UserQuotasValidationError) Reason() string { return e.reason }
+func (e MemberValidationError) Reason() string { return e.reason }
 
 // Cause function returns cause value.
-func (e UserQuotasValidationError) Cause() error { return e.cause }
+func (e MemberValidationError) Cause() error { return e.cause }
 
 // Key function returns key value.
-func (e UserQuotasValidationError) Key() bool { return e.key }
+func (e MemberValidationError) Key() bool { return e.key }
 
 // ErrorName returns error name.
-func (e UserQuotasValidationError) ErrorName() string { return "UserQuotasValidationError" }
+func (e MemberValidationError) ErrorName() string { return "MemberValidationError" }
 
 // Error satisfies the builtin error interface
-func (e UserQuotasValidationError) Error() string {
+func (e MemberValidationError) Error() string {
 	cause := ""
 	if e.cause != nil {
 		cause = fmt.Sprintf(" | caused by: %v", e.cause)
@@ -14750,14 +20186,14 @@ func (e UserQuotasValidationError) Error() string {
 	}
 
 	return fmt.Sprintf(
-		"invalid %sUserQuotas.%s: %s%s",
+		"invalid %sMember.%s: %s%s",
 		key,
 		e.field,
 		e.reason,
 		cause)
 }
 
-var _ error = UserQuotasValidationError{}
+var _ error = MemberValidationError{}
 
 var _ interface {
 	Field() string
@@ -14765,54 +20201,49 @@ var _ interface {
 	Key() bool
 	Cause() error
 	ErrorName() string
-} = UserQuotasValidationError{}
+} = MemberValidationError{}
 
-// Validate checks the field values on OrganizationQuotas with the rules
-// defined in the proto definition for this message. If any rules are
-// violated, the first error encountered is returned, or nil if there are no violations.
-func (m *OrganizationQuotas) Validate() error {
+// Validate checks the field values on UserInvite with the rules defined in the
+// proto definition for this message. If any rules are violated, the first
+// error encountered is returned, or nil if there are no violations.
+func (m *UserInvite) Validate() error {
 	return m.validate(false)
 }
 
-// ValidateAll checks the field values on OrganizationQuotas with the rules
-// defined in the proto definition for this message. If any rules are
-// violated, the result is a list of violation errors wrapped in
-// OrganizationQuotasMultiError, or nil if none found.
-func (m *OrganizationQuotas) ValidateAll() error {
+// ValidateAll checks the field values on UserInvite with the rules defined in
+// the proto definition for this message. If any rules are violated, the
+// result is a list of violation errors wrapped in UserInviteMultiError, or
+// nil if none found.
+func (m *UserInvite) ValidateAll() error {
 	return m.validate(true)
 }
 
-func (m *OrganizationQuotas) validate(all bool) error {
+func (m *UserInvite) validate(all bool) error {
 	if m == nil {
 		return nil
 	}
 
 	var errors []error
 
-	// no validation rules for Projects
-
-	// no validation rules for Deployments
-
-	// no validation rules for SlotsTotal
+	// no validation rules for Email
 
-	// no validation rules for SlotsPerDeployment
+	// no validation rules for Role
 
-	// no validation rules for OutstandingInvites
+	// no validation rules for InvitedBy
 
 	if len(errors) > 0 {
-		return OrganizationQuotasMultiError(errors)
+		return UserInviteMultiError(errors)
 	}
 
 	return nil
 }
 
-// OrganizationQuotasMultiError is an error wrapping multiple validation errors
-// returned by OrganizationQuotas.ValidateAll() if the designated constraints
-// aren't met.
-type OrganizationQuotasMultiError []error
+// UserInviteMultiError is an error wrapping multiple validation errors
+// returned by UserInvite.ValidateAll() if the designated constraints aren't met.
+type UserInviteMultiError []error
 
 // Error returns a concatenation of all the error messages it wraps.
-func (m OrganizationQuotasMultiError) Error() string {
+func (m UserInviteMultiError) Error() string {
 	var msgs []string
 	for _, err := range m {
 		msgs = append(msgs, err.Error())
@@ -14821,11 +20252,11 @@ func (m OrganizationQuotasMultiError) Error() string {
 }
 
 // AllErrors returns a list of validation violation errors.
-func (m OrganizationQuotasMultiError) AllErrors() []error { return m }
+func (m UserInviteMultiError) AllErrors() []error { return m }
 
-// OrganizationQuotasValidationError is the validation error returned by
-// OrganizationQuotas.Validate if the designated constraints aren't met.
-type OrganizationQuotasValidationError struct {
+// UserInviteValidationError is the validation error returned by
+// UserInvite.Validate if the designated constraints aren't met.
+type UserInviteValidationError struct {
 	field  string
 	reason string
 	cause  error
@@ -14833,24 +20264,22 @@ type OrganizationQuotasValidationError struct {
 }
 
 // Field function returns field value.
-func (e OrganizationQuotasValidationError) Field() string { return e.field }
+func (e UserInviteValidationError) Field() string { return e.field }
 
 // Reason function returns reason value.
-func (e OrganizationQuotasValidationError) Reason() string { return e.reason }
+func (e UserInviteValidationError) Reason() string { return e.reason }
 
 // Cause function returns cause value.
-func (e OrganizationQuotasValidationError) Cause() error { return e.cause }
+func (e UserInviteValidationError) Cause() error { return e.cause }
 
 // Key function returns key value.
-func (e OrganizationQuotasValidationError) Key() bool { return e.key }
+func (e UserInviteValidationError) Key() bool { return e.key }
 
 // ErrorName returns error name.
-func (e OrganizationQuotasValidationError) ErrorName() string {
-	return "OrganizationQuotasValidationError"
-}
+func (e UserInviteValidationError) ErrorName() string { return "UserInviteValidationError" }
 
 // Error satisfies the builtin error interface
-func (e OrganizationQuotasValidationError) Error() string {
+func (e UserInviteValidationError) Error() string {
 	cause := ""
 	if e.cause != nil {
 		cause = fmt.Sprintf(" | caused by: %v", e.cause)
@@ -14862,14 +20291,14 @@ func (e OrganizationQuotasValidationError) Error() string {
 	}
 
 	return fmt.Sprintf(
-		"invalid %sOrganizationQuotas.%s: %s%s",
+		"invalid %sUserInvite.%s: %s%s",
 		key,
 		e.field,
 		e.reason,
 		cause)
 }
 
-var _ error = OrganizationQuotasValidationError{}
+var _ error = UserInviteValidationError{}
 
 var _ interface {
 	Field() string
@@ -14877,132 +20306,48 @@ var _ interface {
 	Key() bool
 	Cause() error
 	ErrorName() string
-} = OrganizationQuotasValidationError{}
+} = UserInviteValidationError{}
 
-// Validate checks the field values on Project with the rules defined in the
-// proto definition for this message. If any rules are violated, the first
-// error encountered is returned, or nil if there are no violations.
-func (m *Project) Validate() error {
+// Validate checks the field values on WhitelistedDomain with the rules defined
+// in the proto definition for this message. If any rules are violated, the
+// first error encountered is returned, or nil if there are no violations.
+func (m *WhitelistedDomain) Validate() error {
 	return m.validate(false)
 }
 
-// ValidateAll checks the field values on Project with the rules defined in the
-// proto definition for this message. If any rules are violated, the result is
-// a list of violation errors wrapped in ProjectMultiError, or nil if none found.
-func (m *Project) ValidateAll() error {
+// ValidateAll checks the field values on WhitelistedDomain with the rules
+// defined in the proto definition for this message. If any rules are
+// violated, the result is a list of violation errors wrapped in
+// WhitelistedDomainMultiError, or nil if none found.
+func (m *WhitelistedDomain) ValidateAll() error {
 	return m.validate(true)
 }
 
-func (m *Project) validate(all bool) error {
+func (m *WhitelistedDomain) validate(all bool) error {
 	if m == nil {
 		return nil
 	}
 
 	var errors []error
 
-	// no validation rules for Id
-
-	// no validation rules for Name
-
-	// no validation rules for OrgId
-
-	// no validation rules for OrgName
-
-	// no validation rules for Description
-
-	// no validation rules for Public
-
-	// no validation rules for Region
-
-	// no validation rules for GithubUrl
-
-	// no validation rules for Subpath
-
-	// no validation rules for ProdBranch
-
-	// no validation rules for ProdOlapDriver
-
-	// no validation rules for ProdOlapDsn
-
-	// no validation rules for ProdSlots
-
-	// no validation rules for ProdDeploymentId
-
-	// no validation rules for FrontendUrl
-
-	// no validation rules for ProdTtlSeconds
-
-	if all {
-		switch v := interface{}(m.GetCreatedOn()).(type) {
-		case interface{ ValidateAll() error }:
-			if err := v.ValidateAll(); err != nil {
-				errors = append(errors, ProjectValidationError{
-					field:  "CreatedOn",
-					reason: "embedded message failed validation",
-					cause:  err,
-				})
-			}
-		case interface{ Validate() error }:
-			if err := v.Validate(); err != nil {
-				errors = append(errors, ProjectValidationError{
-					field:  "CreatedOn",
-					reason: "embedded message failed validation",
-					cause:  err,
-				})
-			}
-		}
-	} else if v, ok := interface{}(m.GetCreatedOn()).(interface{ Validate() error }); ok {
-		if err := v.Validate(); err != nil {
-			return ProjectValidationError{
-				field:  "CreatedOn",
-				reason: "embedded message failed validation",
-				cause:  err,
-			}
-		}
-	}
+	// no validation rules for Domain
 
-	if all {
-		switch v := interface{}(m.GetUpdatedOn()).(type) {
-		case interface{ ValidateAll() error }:
-			if err := v.ValidateAll(); err != nil {
-				errors = append(errors, ProjectValidationError{
-					field:  "UpdatedOn",
-					reason: "embedded message failed validation",
-					cause:  err,
-				})
-			}
-		case interface{ Validate() error }:
-			if err := v.Validate(); err != nil {
-				errors = append(errors, ProjectValidationError{
-					field:  "UpdatedOn",
-					reason: "embedded message failed validation",
-					cause:  err,
-				})
-			}
-		}
-	} else if v, ok := interface{}(m.GetUpdatedOn()).(interface{ Validate() error }); ok {
-		if err := v.Validate(); err != nil {
-			return ProjectValidationError{
-				field:  "UpdatedOn",
-				reason: "embedded message failed validation",
-				cause:  err,
-			}
-		}
-	}
+	// no validation rules for Role
 
 	if len(errors) > 0 {
-		return ProjectMultiError(errors)
+		return WhitelistedDomainMultiError(errors)
 	}
 
 	return nil
 }
 
-// ProjectMultiError is an error wrapping multiple validation errors returned
-// by Project.ValidateAll() if the designated constraints aren't met.
-type ProjectMultiError []error
+// WhitelistedDomainMultiError is an error wrapping multiple validation errors
+// returned by WhitelistedDomain.ValidateAll() if the designated constraints
+// aren't met.
+type WhitelistedDomainMultiError []error
 
 // Error returns a concatenation of all the error messages it wraps.
-func (m ProjectMultiError) Error() string {
+func (m WhitelistedDomainMultiError) Error() string {
 	var msgs []string
 	for _, err := range m {
 		msgs = append(msgs, err.Error())
@@ -15011,11 +20356,11 @@ func (m ProjectMultiError) Error() string {
 }
 
 // AllErrors returns a list of validation violation errors.
-func (m ProjectMultiError) AllErrors() []error { return m }
+func (m WhitelistedDomainMultiError) AllErrors() []error { return m }
 
-// ProjectValidationError is the validation error returned by Project.Validate
-// if the designated constraints aren't met.
-type ProjectValidationError struct {
+// WhitelistedDomainValidationError is the validation error returned by
+// WhitelistedDomain.Validate if the designated constraints aren't met.
+type WhitelistedDomainValidationError struct {
 	field  string
 	reason string
 	cause  error
@@ -15023,22 +20368,24 @@ type ProjectValidationError struct {
 }
 
 // Field function returns field value.
-func (e ProjectValidationError) Field() string { return e.field }
+func (e WhitelistedDomainValidationError) Field() string { return e.field }
 
 // Reason function returns reason value.
-func (e ProjectValidationError) Reason() string { return e.reason }
+func (e WhitelistedDomainValidationError) Reason() string { return e.reason }
 
 // Cause function returns cause value.
-func (e ProjectValidationError) Cause() error { return e.cause }
+func (e WhitelistedDomainValidationError) Cause() error { return e.cause }
 
 // Key function returns key value.
-func (e ProjectValidationError) Key() bool { return e.key }
+func (e WhitelistedDomainValidationError) Key() bool { return e.key }
 
 // ErrorName returns error name.
-func (e ProjectValidationError) ErrorName() string { return "ProjectValidationError" }
+func (e WhitelistedDomainValidationError) ErrorName() string {
+	return "WhitelistedDomainValidationError"
+}
 
 // Error satisfies the builtin error interface
-func (e ProjectValidationError) Error() string {
+func (e WhitelistedDomainValidationError) Error() string {
 	cause := ""
 	if e.cause != nil {
 		cause = fmt.Sprintf(" | caused by: %v", e.cause)
@@ -15050,14 +20397,14 @@ func (e ProjectValidationError) Error() string {
 	}
 
 	return fmt.Sprintf(
-		"invalid %sProject.%s: %s%s",
+		"invalid %sWhitelistedDomain.%s: %s%s",
 		key,
 		e.field,
 		e.reason,
 		cause)
 }
 
-var _ error = ProjectValidationError{}
+var _ error = WhitelistedDomainValidationError{}
 
 var _ interface {
 	Field() string
@@ -15065,24 +20412,24 @@ var _ interface {
 	Key() bool
 	Cause() error
 	ErrorName() string
-} = ProjectValidationError{}
+} = WhitelistedDomainValidationError{}
 
-// Validate checks the field values on Deployment with the rules defined in the
+// Validate checks the field values on Bookmark with the rules defined in the
 // proto definition for this message. If any rules are violated, the first
 // error encountered is returned, or nil if there are no violations.
-func (m *Deployment) Validate() error {
+func (m *Bookmark) Validate() error {
 	return m.validate(false)
 }
 
-// ValidateAll checks the field values on Deployment with the rules defined in
+// ValidateAll checks the field values on Bookmark with the rules defined in
 // the proto definition for this message. If any rules are violated, the
-// result is a list of violation errors wrapped in DeploymentMultiError, or
-// nil if none found.
-func (m *Deployment) ValidateAll() error {
+// result is a list of violation errors wrapped in BookmarkMultiError, or nil
+// if none found.
+func (m *Bookmark) ValidateAll() error {
 	return m.validate(true)
 }
 
-func (m *Deployment) validate(all bool) error {
+func (m *Bookmark) validate(all bool) error {
 	if m == nil {
 		return nil
 	}
@@ -15091,25 +20438,21 @@ func (m *Deployment) validate(all bool) error {
 
 	// no validation rules for Id
 
-	// no validation rules for ProjectId
-
-	// no validation rules for Slots
-
-	// no validation rules for Branch
+	// no validation rules for DisplayName
 
-	// no validation rules for RuntimeHost
+	// no validation rules for Data
 
-	// no validation rules for RuntimeInstanceId
+	// no validation rules for DashboardName
 
-	// no validation rules for Status
+	// no validation rules for ProjectId
 
-	// no validation rules for Logs
+	// no validation rules for UserId
 
 	if all {
 		switch v := interface{}(m.GetCreatedOn()).(type) {
 		case interface{ ValidateAll() error }:
 			if err := v.ValidateAll(); err != nil {
-				errors = append(errors, DeploymentValidationError{
+				errors = append(errors, BookmarkValidationError{
 					field:  "CreatedOn",
 					reason: "embedded message failed validation",
 					cause:  err,
@@ -15117,7 +20460,7 @@ func (m *Deployment) validate(all bool) error {
 			}
 		case interface{ Validate() error }:
 			if err := v.Validate(); err != nil {
-				errors = append(errors, DeploymentValidationError{
+				errors = append(errors, BookmarkValidationError{
 					field:  "CreatedOn",
 					reason: "embedded message failed validation",
 					cause:  err,
@@ -15126,7 +20469,7 @@ func (m *Deployment) validate(all bool) error {
 		}
 	} else if v, ok := interface{}(m.GetCreatedOn()).(interface{ Validate() error }); ok {
 		if err := v.Validate(); err != nil {
-			return DeploymentValidationError{
+			return BookmarkValidationError{
 				field:  "CreatedOn",
 				reason: "embedded message failed validation",
 				cause:  err,
@@ -15138,7 +20481,7 @@ func (m *Deployment) validate(all bool) error {
 		switch v := interface{}(m.GetUpdatedOn()).(type) {
 		case interface{ ValidateAll() error }:
 			if err := v.ValidateAll(); err != nil {
-				errors = append(errors, DeploymentValidationError{
+				errors = append(errors, BookmarkValidationError{
 					field:  "UpdatedOn",
 					reason: "embedded message failed validation",
 					cause:  err,
@@ -15146,7 +20489,7 @@ func (m *Deployment) validate(all bool) error {
 			}
 		case interface{ Validate() error }:
 			if err := v.Validate(); err != nil {
-				errors = append(errors, DeploymentValidationError{
+				errors = append(errors, BookmarkValidationError{
 					field:  "UpdatedOn",
 					reason: "embedded message failed validation",
 					cause:  err,
@@ -15155,7 +20498,7 @@ func (m *Deployment) validate(all bool) error {
 		}
 	} else if v, ok := interface{}(m.GetUpdatedOn()).(interface{ Validate() error }); ok {
 		if err := v.Validate(); err != nil {
-			return DeploymentValidationError{
+			return BookmarkValidationError{
 				field:  "UpdatedOn",
 				reason: "embedded message failed validation",
 				cause:  err,
@@ -15163,19 +20506,23 @@ func (m *Deployment) validate(all bool) error {
 		}
 	}
 
+	// no validation rules for Shared
+
+	// no validation rules for IsDefault
+
 	if len(errors) > 0 {
-		return DeploymentMultiError(errors)
+		return BookmarkMultiError(errors)
 	}
 
 	return nil
 }
 
-// DeploymentMultiError is an error wrapping multiple validation errors
-// returned by Deployment.ValidateAll() if the designated constraints aren't met.
-type DeploymentMultiError []error
+// BookmarkMultiError is an error wrapping multiple validation errors returned
+// by Bookmark.ValidateAll() if the designated constraints aren't met.
+type BookmarkMultiError []error
 
 // Error returns a concatenation of all the error messages it wraps.
-func (m DeploymentMultiError) Error() string {
+func (m BookmarkMultiError) Error() string {
 	var msgs []string
 	for _, err := range m {
 		msgs = append(msgs, err.Error())
@@ -15184,11 +20531,11 @@ func (m DeploymentMultiError) Error() string {
 }
 
 // AllErrors returns a list of validation violation errors.
-func (m DeploymentMultiError) AllErrors() []error { return m }
+func (m BookmarkMultiError) AllErrors() []error { return m }
 
-// DeploymentValidationError is the validation error returned by
-// Deployment.Validate if the designated constraints aren't met.
-type DeploymentValidationError struct {
+// BookmarkValidationError is the validation error returned by
+// Bookmark.Validate if the designated constraints aren't met.
+type BookmarkValidationError struct {
 	field  string
 	reason string
 	cause  error
@@ -15196,22 +20543,22 @@ type DeploymentValidationError struct {
 }
 
 // Field function returns field value.
-func (e DeploymentValidationError) Field() string { return e.field }
+func (e BookmarkValidationError) Field() string { return e.field }
 
 // Reason function returns reason value.
-func (e DeploymentValidationError) Reason() string { return e.reason }
+func (e BookmarkValidationError) Reason() string { return e.reason }
 
 // Cause function returns cause value.
-func (e DeploymentValidationError) Cause() error { return e.cause }
+func (e BookmarkValidationError) Cause() error { return e.cause }
 
 // Key function returns key value.
-func (e DeploymentValidationError) Key() bool { return e.key }
+func (e BookmarkValidationError) Key() bool { return e.key }
 
 // ErrorName returns error name.
-func (e DeploymentValidationError) ErrorName() string { return "DeploymentValidationError" }
+func (e BookmarkValidationError) ErrorName() string { return "BookmarkValidationError" }
 
 // Error satisfies the builtin error interface
-func (e DeploymentValidationError) Error() string {
+func (e BookmarkValidationError) Error() string {
 	cause := ""
 	if e.cause != nil {
 		cause = fmt.Sprintf(" | caused by: %v", e.cause)
@@ -15223,14 +20570,14 @@ func (e DeploymentValidationError) Error() string {
 	}
 
 	return fmt.Sprintf(
-		"invalid %sDeployment.%s: %s%s",
+		"invalid %sBookmark.%s: %s%s",
 		key,
 		e.field,
 		e.reason,
 		cause)
 }
 
-var _ error = DeploymentValidationError{}
+var _ error = BookmarkValidationError{}
 
 var _ interface {
 	Field() string
@@ -15238,180 +20585,183 @@ var _ interface {
 	Key() bool
 	Cause() error
 	ErrorName() string
-} = DeploymentValidationError{}
+} = BookmarkValidationError{}
 
-// Validate checks the field values on OrganizationPermissions with the rules
-// defined in the proto definition for this message. If any rules are
-// violated, the first error encountered is returned, or nil if there are no violations.
-func (m *OrganizationPermissions) Validate() error {
+// Validate checks the field values on ReportOptions with the rules defined in
+// the proto definition for this message. If any rules are violated, the first
+// error encountered is returned, or nil if there are no violations.
+func (m *ReportOptions) Validate() error {
 	return m.validate(false)
 }
 
-// ValidateAll checks the field values on OrganizationPermissions with the
-// rules defined in the proto definition for this message. If any rules are
-// violated, the result is a list of violation errors wrapped in
-// OrganizationPermissionsMultiError, or nil if none found.
-func (m *OrganizationPermissions) ValidateAll() error {
+// ValidateAll checks the field values on ReportOptions with the rules defined
+// in the proto definition for this message. If any rules are violated, the
+// result is a list of violation errors wrapped in ReportOptionsMultiError, or
+// nil if none found.
+func (m *ReportOptions) ValidateAll() error {
 	return m.validate(true)
 }
 
-func (m *OrganizationPermissions) validate(all bool) error {
+func (m *ReportOptions) validate(all bool) error {
 	if m == nil {
 		return nil
 	}
 
 	var errors []error
 
-	// no validation rules for ReadOrg
-
-	// no validation rules for ManageOrg
-
-	// no validation rules for ReadProjects
-
-	// no validation rules for CreateProjects
-
-	// no validation rules for ManageProjects
-
-	// no validation rules for ReadOrgMembers
-
-	// no validation rules for ManageOrgMembers
-
-	if len(errors) > 0 {
-		return OrganizationPermissionsMultiError(errors)
+	if utf8.RuneCountInString(m.GetSchedule()) < 1 {
+		err := ReportOptionsValidationError{
+			field:  "Schedule",
+			reason: "value length must be at least 1 runes",
+		}
+		if !all {
+			return err
+		}
+		errors = append(errors, err)
 	}
 
-	return nil
-}
-
-// OrganizationPermissionsMultiError is an error wrapping multiple validation
-// errors returned by OrganizationPermissions.ValidateAll() if the designated
-// constraints aren't met.
-type OrganizationPermissionsMultiError []error
+	// no validation rules for TimeZone
 
-// Error returns a concatenation of all the error messages it wraps.
-func (m OrganizationPermissionsMultiError) Error() string {
-	var msgs []string
-	for _, err := range m {
-		msgs = append(msgs, err.Error())
+	if len(m.GetRecipients()) < 1 {
+		err := ReportOptionsValidationError{
+			field:  "Recipients",
+			reason: "value must contain at least 1 item(s)",
+		}
+		if !all {
+			return err
+		}
+		errors = append(errors, err)
 	}
-	return strings.Join(msgs, "; ")
-}
-
-// AllErrors returns a list of validation violation errors.
-func (m OrganizationPermissionsMultiError) AllErrors() []error { return m }
 
-// OrganizationPermissionsValidationError is the validation error returned by
-// OrganizationPermissions.Validate if the designated constraints aren't met.
-type OrganizationPermissionsValidationError struct {
-	field  string
-	reason string
-	cause  error
-	key    bool
-}
+	for idx, item := range m.GetRecipients() {
+		_, _ = idx, item
 
-// Field function returns field value.
-func (e OrganizationPermissionsValidationError) Field() string { return e.field }
+		if err := m._validateEmail(item); err != nil {
+			err = ReportOptionsValidationError{
+				field:  fmt.Sprintf("Recipients[%v]", idx),
+				reason: "value must be a valid email address",
+				cause:  err,
+			}
+			if !all {
+				return err
+			}
+			errors = append(errors, err)
+		}
 
-// Reason function returns reason value.
-func (e OrganizationPermissionsValidationError) Reason() string { return e.reason }
+	}
 
-// Cause function returns cause value.
-func (e OrganizationPermissionsValidationError) Cause() error { return e.cause }
+	if utf8.RuneCountInString(m.GetMetricsViewName()) < 1 {
+		err := ReportOptionsValidationError{
+			field:  "MetricsViewName",
+			reason: "value length must be at least 1 runes",
+		}
+		if !all {
+			return err
+		}
+		errors = append(errors, err)
+	}
 
-// Key function returns key value.
-func (e OrganizationPermissionsValidationError) Key() bool { return e.key }
+	// no validation rules for QueryArgsJson
 
-// ErrorName returns error name.
-func (e OrganizationPermissionsValidationError) ErrorName() string {
-	return "OrganizationPermissionsValidationError"
-}
+	// no validation rules for TimeRange
 
-// Error satisfies the builtin error interface
-func (e OrganizationPermissionsValidationError) Error() string {
-	cause := ""
-	if e.cause != nil {
-		cause = fmt.Sprintf(" | caused by: %v", e.cause)
+	if _, ok := _ReportOptions_ExportFormat_NotInLookup[m.GetExportFormat()]; ok {
+		err := ReportOptionsValidationError{
+			field:  "ExportFormat",
+			reason: "value must not be in list [EXPORT_FORMAT_UNSPECIFIED]",
+		}
+		if !all {
+			return err
+		}
+		errors = append(errors, err)
 	}
 
-	key := ""
-	if e.key {
-		key = "key for "
+	if _, ok := ExportFormat_name[int32(m.GetExportFormat())]; !ok {
+		err := ReportOptionsValidationError{
+			field:  "ExportFormat",
+			reason: "value must be one of the defined enum values",
+		}
+		if !all {
+			return err
+		}
+		errors = append(errors, err)
 	}
 
-	return fmt.Sprintf(
-		"invalid %sOrganizationPermissions.%s: %s%s",
-		key,
-		e.field,
-		e.reason,
-		cause)
-}
-
-var _ error = OrganizationPermissionsValidationError{}
+	if m.GetExportLimit() < 0 {
+		err := ReportOptionsValidationError{
+			field:  "ExportLimit",
+			reason: "value must be greater than or equal to 0",
+		}
+		if !all {
+			return err
+		}
+		errors = append(errors, err)
+	}
 
-var _ interface {
-	Field() string
-	Reason() string
-	Key() bool
-	Cause() error
-	ErrorName() string
-} = OrganizationPermissionsValidationError{}
+	if len(errors) > 0 {
+		return ReportOptionsMultiError(errors)
+	}
 
-// Validate checks the field values on ProjectPermissions with the rules
-// defined in the proto definition for this message. If any rules are
-// violated, the first error encountered is returned, or nil if there are no violations.
-func (m *ProjectPermissions) Validate() error {
-	return m.validate(false)
+	return nil
 }
 
-// ValidateAll checks the field values on ProjectPermissions with the rules
-// defined in the proto definition for this message. If any rules are
-// violated, the result is a list of violation errors wrapped in
-// ProjectPermissionsMultiError, or nil if none found.
-func (m *ProjectPermissions) ValidateAll() error {
-	return m.validate(true)
-}
+func (m *ReportOptions) _validateHostname(host string) error {
+	s := strings.ToLower(strings.TrimSuffix(host, "."))
 
-func (m *ProjectPermissions) validate(all bool) error {
-	if m == nil {
-		return nil
+	if len(host) > 253 {
+		return errors.New("hostname cannot exceed 253 characters")
 	}
 
-	var errors []error
-
-	// no validation rules for ReadProject
-
-	// no validation rules for ManageProject
-
-	// no validation rules for ReadProd
+	for _, part := range strings.Split(s, ".") {
+		if l := len(part); l == 0 || l > 63 {
+			return errors.New("hostname part must be non-empty and cannot exceed 63 characters")
+		}
 
-	// no validation rules for ReadProdStatus
+		if part[0] == '-' {
+			return errors.New("hostname parts cannot begin with hyphens")
+		}
 
-	// no validation rules for ManageProd
+		if part[len(part)-1] == '-' {
+			return errors.New("hostname parts cannot end with hyphens")
+		}
 
-	// no validation rules for ReadDev
+		for _, r := range part {
+			if (r < 'a' || r > 'z') && (r < '0' || r > '9') && r != '-' {
+				return fmt.Errorf("hostname parts can only contain alphanumeric characters or hyphens, got %q", string(r))
+			}
+		}
+	}
 
-	// no validation rules for ReadDevStatus
+	return nil
+}
 
-	// no validation rules for ManageDev
+func (m *ReportOptions) _validateEmail(addr string) error {
+	a, err := mail.ParseAddress(addr)
+	if err != nil {
+		return err
+	}
+	addr = a.Address
 
-	// no validation rules for ReadProjectMembers
+	if len(addr) > 254 {
+		return errors.New("email addresses cannot exceed 254 characters")
+	}
 
-	// no validation rules for ManageProjectMembers
+	parts := strings.SplitN(addr, "@", 2)
 
-	if len(errors) > 0 {
-		return ProjectPermissionsMultiError(errors)
+	if len(parts[0]) > 64 {
+		return errors.New("email address local phrase cannot exceed 64 characters")
 	}
 
-	return nil
+	return m._validateHostname(parts[1])
 }
 
-// ProjectPermissionsMultiError is an error wrapping multiple validation errors
-// returned by ProjectPermissions.ValidateAll() if the designated constraints
+// ReportOptionsMultiError is an error wrapping multiple validation errors
+// returned by ReportOptions.ValidateAll() if the designated constraints
 // aren't met.
-type ProjectPermissionsMultiError []error
+type ReportOptionsMultiError []error
 
 // Error returns a concatenation of all the error messages it wraps.
-func (m ProjectPermissionsMultiError) Error() string {
+func (m ReportOptionsMultiError) Error() string {
 	var msgs []string
 	for _, err := range m {
 		msgs = append(msgs, err.Error())
@@ -15420,11 +20770,11 @@ func (m ProjectPermissionsMultiError) Error() string {
 }
 
 // AllErrors returns a list of validation violation errors.
-func (m ProjectPermissionsMultiError) AllErrors() []error { return m }
+func (m ReportOptionsMultiError) AllErrors() []error { return m }
 
-// ProjectPermissionsValidationError is the validation error returned by
-// ProjectPermissions.Validate if the designated constraints aren't met.
-type ProjectPermissionsValidationError struct {
+// ReportOptionsValidationError is the validation error returned by
+// ReportOptions.Validate if the designated constraints aren't met.
+type ReportOptionsValidationError struct {
 	field  string
 	reason string
 	cause  error
@@ -15432,24 +20782,22 @@ type ProjectPermissionsValidationError struct {
 }
 
 // Field function returns field value.
-func (e ProjectPermissionsValidationError) Field() string { return e.field }
+func (e ReportOptionsValidationError) Field() string { return e.field }
 
 // Reason function returns reason value.
-func (e ProjectPermissionsValidationError) Reason() string { return e.reason }
+func (e ReportOptionsValidationError) Reason() string { return e.reason }
 
 // Cause function returns cause value.
-func (e ProjectPermissionsValidationError) Cause() error { return e.cause }
+func (e ReportOptionsValidationError) Cause() error { return e.cause }
 
 // Key function returns key value.
-func (e ProjectPermissionsValidationError) Key() bool { return e.key }
+func (e ReportOptionsValidationError) Key() bool { return e.key }
 
 // ErrorName returns error name.
-func (e ProjectPermissionsValidationError) ErrorName() string {
-	return "ProjectPermissionsValidationError"
-}
+func (e ReportOptionsValidationError) ErrorName() string { return "ReportOptionsValidationError" }
 
 // Error satisfies the builtin error interface
-func (e ProjectPermissionsValidationError) Error() string {
+func (e ReportOptionsValidationError) Error() string {
 	cause := ""
 	if e.cause != nil {
 		cause = fmt.Sprintf(" | caused by: %v", e.cause)
@@ -15461,14 +20809,14 @@ func (e ProjectPermissionsValidationError) Error() string {
 	}
 
 	return fmt.Sprintf(
-		"invalid %sProjectPermissions.%s: %s%s",
+		"invalid %sReportOptions.%s: %s%s",
 		key,
 		e.field,
 		e.reason,
 		cause)
 }
 
-var _ error = ProjectPermissionsValidationError{}
+var _ error = ReportOptionsValidationError{}
 
 var _ interface {
 	Field() string
@@ -15476,42 +20824,135 @@ var _ interface {
 	Key() bool
 	Cause() error
 	ErrorName() string
-} = ProjectPermissionsValidationError{}
+} = ReportOptionsValidationError{}
 
-// Validate checks the field values on Member with the rules defined in the
+var _ReportOptions_ExportFormat_NotInLookup = map[ExportFormat]struct{}{
+	0: {},
+}
+
+// Validate checks the field values on Report with the rules defined in the
 // proto definition for this message. If any rules are violated, the first
 // error encountered is returned, or nil if there are no violations.
-func (m *Member) Validate() error {
+func (m *Report) Validate() error {
 	return m.validate(false)
 }
 
-// ValidateAll checks the field values on Member with the rules defined in the
+// ValidateAll checks the field values on Report with the rules defined in the
 // proto definition for this message. If any rules are violated, the result is
-// a list of violation errors wrapped in MemberMultiError, or nil if none found.
-func (m *Member) ValidateAll() error {
+// a list of violation errors wrapped in ReportMultiError, or nil if none found.
+func (m *Report) ValidateAll() error {
 	return m.validate(true)
 }
 
-func (m *Member) validate(all bool) error {
+func (m *Report) validate(all bool) error {
 	if m == nil {
 		return nil
 	}
 
 	var errors []error
 
-	// no validation rules for UserId
+	// no validation rules for Id
 
-	// no validation rules for UserEmail
+	// no validation rules for ProjectId
 
-	// no validation rules for UserName
+	// no validation rules for Name
 
-	// no validation rules for RoleName
+	if all {
+		switch v := interface{}(m.GetOptions()).(type) {
+		case interface{ ValidateAll() error }:
+			if err := v.ValidateAll(); err != nil {
+				errors = append(errors, ReportValidationError{
+					field:  "Options",
+					reason: "embedded message failed validation",
+					cause:  err,
+				})
+			}
+		case interface{ Validate() error }:
+			if err := v.Validate(); err != nil {
+				errors = append(errors, ReportValidationError{
+					field:  "Options",
+					reason: "embedded message failed validation",
+					cause:  err,
+				})
+			}
+		}
+	} else if v, ok := interface{}(m.GetOptions()).(interface{ Validate() error }); ok {
+		if err := v.Validate(); err != nil {
+			return ReportValidationError{
+				field:  "Options",
+				reason: "embedded message failed validation",
+				cause:  err,
+			}
+		}
+	}
+
+	// no validation rules for OwnerUserId
+
+	if all {
+		switch v := interface{}(m.GetNextRunOn()).(type) {
+		case interface{ ValidateAll() error }:
+			if err := v.ValidateAll(); err != nil {
+				errors = append(errors, ReportValidationError{
+					field:  "NextRunOn",
+					reason: "embedded message failed validation",
+					cause:  err,
+				})
+			}
+		case interface{ Validate() error }:
+			if err := v.Validate(); err != nil {
+				errors = append(errors, ReportValidationError{
+					field:  "NextRunOn",
+					reason: "embedded message failed validation",
+					cause:  err,
+				})
+			}
+		}
+	} else if v, ok := interface{}(m.GetNextRunOn()).(interface{ Validate() error }); ok {
+		if err := v.Validate(); err != nil {
+			return ReportValidationError{
+				field:  "NextRunOn",
+				reason: "embedded message failed validation",
+				cause:  err,
+			}
+		}
+	}
+
+	if all {
+		switch v := interface{}(m.GetLastRunOn()).(type) {
+		case interface{ ValidateAll() error }:
+			if err := v.ValidateAll(); err != nil {
+				errors = append(errors, ReportValidationError{
+					field:  "LastRunOn",
+					reason: "embedded message failed validation",
+					cause:  err,
+				})
+			}
+		case interface{ Validate() error }:
+			if err := v.Validate(); err != nil {
+				errors = append(errors, ReportValidationError{
+					field:  "LastRunOn",
+					reason: "embedded message failed validation",
+					cause:  err,
+				})
+			}
+		}
+	} else if v, ok := interface{}(m.GetLastRunOn()).(interface{ Validate() error }); ok {
+		if err := v.Validate(); err != nil {
+			return ReportValidationError{
+				field:  "LastRunOn",
+				reason: "embedded message failed validation",
+				cause:  err,
+			}
+		}
+	}
+
+	// no validation rules for LastRunError
 
 	if all {
 		switch v := interface{}(m.GetCreatedOn()).(type) {
 		case interface{ ValidateAll() error }:
 			if err := v.ValidateAll(); err != nil {
-				errors = append(errors, MemberValidationError{
+				errors = append(errors, ReportValidationError{
 					field:  "CreatedOn",
 					reason: "embedded message failed validation",
 					cause:  err,
@@ -15519,7 +20960,7 @@ func (m *Member) validate(all bool) error {
 			}
 		case interface{ Validate() error }:
 			if err := v.Validate(); err != nil {
-				errors = append(errors, MemberValidationError{
+				errors = append(errors, ReportValidationError{
 					field:  "CreatedOn",
 					reason: "embedded message failed validation",
 					cause:  err,
@@ -15528,7 +20969,7 @@ func (m *Member) validate(all bool) error {
 		}
 	} else if v, ok := interface{}(m.GetCreatedOn()).(interface{ Validate() error }); ok {
 		if err := v.Validate(); err != nil {
-			return MemberValidationError{
+			return ReportValidationError{
 				field:  "CreatedOn",
 				reason: "embedded message failed validation",
 				cause:  err,
@@ -15540,7 +20981,7 @@ func (m *Member) validate(all bool) error {
 		switch v := interface{}(m.GetUpdatedOn()).(type) {
 		case interface{ ValidateAll() error }:
 			if err := v.ValidateAll(); err != nil {
-				errors = append(errors, MemberValidationError{
+				errors = append(errors, ReportValidationError{
 					field:  "UpdatedOn",
 					reason: "embedded message failed validation",
 					cause:  err,
@@ -15548,7 +20989,7 @@ func (m *Member) validate(all bool) error {
 			}
 		case interface{ Validate() error }:
 			if err := v.Validate(); err != nil {
-				errors = append(errors, MemberValidationError{
+				errors = append(errors, ReportValidationError{
 					field:  "UpdatedOn",
 					reason: "embedded message failed validation",
 					cause:  err,
@@ -15557,7 +20998,7 @@ func (m *Member) validate(all bool) error {
 		}
 	} else if v, ok := interface{}(m.GetUpdatedOn()).(interface{ Validate() error }); ok {
 		if err := v.Validate(); err != nil {
-			return MemberValidationError{
+			return ReportValidationError{
 				field:  "UpdatedOn",
 				reason: "embedded message failed validation",
 				cause:  err,
@@ -15566,18 +21007,18 @@ func (m *Member) validate(all bool) error {
 	}
 
 	if len(errors) > 0 {
-		return MemberMultiError(errors)
+		return ReportMultiError(errors)
 	}
 
 	return nil
 }
 
-// MemberMultiError is an error wrapping multiple validation errors returned by
-// Member.ValidateAll() if the designated constraints aren't met.
-type MemberMultiError []error
+// ReportMultiError is an error wrapping multiple validation errors returned by
+// Report.ValidateAll() if the designated constraints aren't met.
+type ReportMultiError []error
 
 // Error returns a concatenation of all the error messages it wraps.
-func (m MemberMultiError) Error() string {
+func (m ReportMultiError) Error() string {
 	var msgs []string
 	for _, err := range m {
 		msgs = append(msgs, err.Error())
@@ -15586,11 +21027,11 @@ func (m MemberMultiError) Error() string {
 }
 
 // AllErrors returns a list of validation violation errors.
-func (m MemberMultiError) AllErrors() []error { return m }
+func (m ReportMultiError) AllErrors() []error { return m }
 
-// MemberValidationError is the validation error returned by Member.Validate if
+// ReportValidationError is the validation error returned by Report.Validate if
 // the designated constraints aren't met.
-type MemberValidationError struct {
+type ReportValidationError struct {
 	field  string
 	reason string
 	cause  error
@@ -15598,22 +21039,22 @@ type MemberValidationError struct {
 }
 
 // Field function returns field value.
-func (e MemberValidationError) Field() string { return e.field }
+func (e ReportValidationError) Field() string { return e.field }
 
 // Reason function returns reason value.
-func (e MemberValidationError) Reason() string { return e.reason }
+func (e ReportValidationError) Reason() string { return e.reason }
 
 // Cause function returns cause value.
-func (e MemberValidationError) Cause() error { return e.cause }
+func (e ReportValidationError) Cause() error { return e.cause }
 
 // Key function returns key value.
-func (e MemberValidationError) Key() bool { return e.key }
+func (e ReportValidationError) Key() bool { return e.key }
 
 // ErrorName returns error name.
-func (e MemberValidationError) ErrorName() string { return "MemberValidationError" }
+func (e ReportValidationError) ErrorName() string { return "ReportValidationError" }
 
 // Error satisfies the builtin error interface
-func (e MemberValidationError) Error() string {
+func (e ReportValidationError) Error() string {
 	cause := ""
 	if e.cause != nil {
 		cause = fmt.Sprintf(" | caused by: %v", e.cause)
@@ -15625,14 +21066,14 @@ func (e MemberValidationError) Error() string {
 	}
 
 	return fmt.Sprintf(
-		"invalid %sMember.%s: %s%s",
+		"invalid %sReport.%s: %s%s",
 		key,
 		e.field,
 		e.reason,
 		cause)
 }
 
-var _ error = MemberValidationError{}
+var _ error = ReportValidationError{}
 
 var _ interface {
 	Field() string
@@ -15640,49 +21081,188 @@ var _ interface {
 	Key() bool
 	Cause() error
 	ErrorName() string
-} = MemberValidationError{}
+} = ReportValidationError{}
 
-// Validate checks the field values on UserInvite with the rules defined in the
-// proto definition for this message. If any rules are violated, the first
+// Validate checks the field values on AlertOptions with the rules defined in
+// the proto definition for this message. If any rules are violated, the first
 // error encountered is returned, or nil if there are no violations.
-func (m *UserInvite) Validate() error {
+func (m *AlertOptions) Validate() error {
 	return m.validate(false)
 }
 
-// ValidateAll checks the field values on UserInvite with the rules defined in
-// the proto definition for this message. If any rules are violated, the
-// result is a list of violation errors wrapped in UserInviteMultiError, or
+// ValidateAll checks the field values on AlertOptions with the rules defined
+// in the proto definition for this message. If any rules are violated, the
+// result is a list of violation errors wrapped in AlertOptionsMultiError, or
 // nil if none found.
-func (m *UserInvite) ValidateAll() error {
+func (m *AlertOptions) ValidateAll() error {
 	return m.validate(true)
 }
 
-func (m *UserInvite) validate(all bool) error {
+func (m *AlertOptions) validate(all bool) error {
 	if m == nil {
 		return nil
 	}
 
 	var errors []error
 
-	// no validation rules for Email
+	if utf8.RuneCountInString(m.GetSchedule()) < 1 {
+		err := AlertOptionsValidationError{
+			field:  "Schedule",
+			reason: "value length must be at least 1 runes",
+		}
+		if !all {
+			return err
+		}
+		errors = append(errors, err)
+	}
+
+	// no validation rules for TimeZone
+
+	if utf8.RuneCountInString(m.GetMetricsViewName()) < 1 {
+		err := AlertOptionsValidationError{
+			field:  "MetricsViewName",
+			reason: "value length must be at least 1 runes",
+		}
+		if !all {
+			return err
+		}
+		errors = append(errors, err)
+	}
+
+	if utf8.RuneCountInString(m.GetMeasureName()) < 1 {
+		err := AlertOptionsValidationError{
+			field:  "MeasureName",
+			reason: "value length must be at least 1 runes",
+		}
+		if !all {
+			return err
+		}
+		errors = append(errors, err)
+	}
+
+	if _, ok := _AlertOptions_Comparison_NotInLookup[m.GetComparison()]; ok {
+		err := AlertOptionsValidationError{
+			field:  "Comparison",
+			reason: "value must not be in list [ALERT_COMPARISON_UNSPECIFIED]",
+		}
+		if !all {
+			return err
+		}
+		errors = append(errors, err)
+	}
+
+	if _, ok := AlertComparison_name[int32(m.GetComparison())]; !ok {
+		err := AlertOptionsValidationError{
+			field:  "Comparison",
+			reason: "value must be one of the defined enum values",
+		}
+		if !all {
+			return err
+		}
+		errors = append(errors, err)
+	}
+
+	// no validation rules for Threshold
+
+	// no validation rules for SplitByDimension
+
+	// no validation rules for WhereJson
+
+	// no validation rules for TimeRange
+
+	for idx, item := range m.GetRecipients() {
+		_, _ = idx, item
+
+		if err := m._validateEmail(item); err != nil {
+			err = AlertOptionsValidationError{
+				field:  fmt.Sprintf("Recipients[%v]", idx),
+				reason: "value must be a valid email address",
+				cause:  err,
+			}
+			if !all {
+				return err
+			}
+			errors = append(errors, err)
+		}
+
+	}
+
+	// no validation rules for WebhookUrl
+
+	if m.GetRenotifyAfterSeconds() < 0 {
+		err := AlertOptionsValidationError{
+			field:  "RenotifyAfterSeconds",
+			reason: "value must be greater than or equal to 0",
+		}
+		if !all {
+			return err
+		}
+		errors = append(errors, err)
+	}
+
+	if len(errors) > 0 {
+		return AlertOptionsMultiError(errors)
+	}
+
+	return nil
+}
+
+func (m *AlertOptions) _validateHostname(host string) error {
+	s := strings.ToLower(strings.TrimSuffix(host, "."))
+
+	if len(host) > 253 {
+		return errors.New("hostname cannot exceed 253 characters")
+	}
+
+	for _, part := range strings.Split(s, ".") {
+		if l := len(part); l == 0 || l > 63 {
+			return errors.New("hostname part must be non-empty and cannot exceed 63 characters")
+		}
+
+		if part[0] == '-' {
+			return errors.New("hostname parts cannot begin with hyphens")
+		}
+
+		if part[len(part)-1] == '-' {
+			return errors.New("hostname parts cannot end with hyphens")
+		}
+
+		for _, r := range part {
+			if (r < 'a' || r > 'z') && (r < '0' || r > '9') && r != '-' {
+				return fmt.Errorf("hostname parts can only contain alphanumeric characters or hyphens, got %q", string(r))
+			}
+		}
+	}
+
+	return nil
+}
+
+func (m *AlertOptions) _validateEmail(addr string) error {
+	a, err := mail.ParseAddress(addr)
+	if err != nil {
+		return err
+	}
+	addr = a.Address
 
-	// no validation rules for Role
+	if len(addr) > 254 {
+		return errors.New("email addresses cannot exceed 254 characters")
+	}
 
-	// no validation rules for InvitedBy
+	parts := strings.SplitN(addr, "@", 2)
 
-	if len(errors) > 0 {
-		return UserInviteMultiError(errors)
+	if len(parts[0]) > 64 {
+		return errors.New("email address local phrase cannot exceed 64 characters")
 	}
 
-	return nil
+	return m._validateHostname(parts[1])
 }
 
-// UserInviteMultiError is an error wrapping multiple validation errors
-// returned by UserInvite.ValidateAll() if the designated constraints aren't met.
-type UserInviteMultiError []error
+// AlertOptionsMultiError is an error wrapping multiple validation errors
+// returned by AlertOptions.ValidateAll() if the designated constraints aren't met.
+type AlertOptionsMultiError []error
 
 // Error returns a concatenation of all the error messages it wraps.
-func (m UserInviteMultiError) Error() string {
+func (m AlertOptionsMultiError) Error() string {
 	var msgs []string
 	for _, err := range m {
 		msgs = append(msgs, err.Error())
@@ -15691,11 +21271,11 @@ func (m UserInviteMultiError) Error() string {
 }
 
 // AllErrors returns a list of validation violation errors.
-func (m UserInviteMultiError) AllErrors() []error { return m }
+func (m AlertOptionsMultiError) AllErrors() []error { return m }
 
-// UserInviteValidationError is the validation error returned by
-// UserInvite.Validate if the designated constraints aren't met.
-type UserInviteValidationError struct {
+// AlertOptionsValidationError is the validation error returned by
+// AlertOptions.Validate if the designated constraints aren't met.
+type AlertOptionsValidationError struct {
 	field  string
 	reason string
 	cause  error
@@ -15703,22 +21283,22 @@ type UserInviteValidationError struct {
 }
 
 // Field function returns field value.
-func (e UserInviteValidationError) Field() string { return e.field }
+func (e AlertOptionsValidationError) Field() string { return e.field }
 
 // Reason function returns reason value.
-func (e UserInviteValidationError) Reason() string { return e.reason }
+func (e AlertOptionsValidationError) Reason() string { return e.reason }
 
 // Cause function returns cause value.
-func (e UserInviteValidationError) Cause() error { return e.cause }
+func (e AlertOptionsValidationError) Cause() error { return e.cause }
 
 // Key function returns key value.
-func (e UserInviteValidationError) Key() bool { return e.key }
+func (e AlertOptionsValidationError) Key() bool { return e.key }
 
 // ErrorName returns error name.
-func (e UserInviteValidationError) ErrorName() string { return "UserInviteValidationError" }
+func (e AlertOptionsValidationError) ErrorName() string { return "AlertOptionsValidationError" }
 
 // Error satisfies the builtin error interface
-func (e UserInviteValidationError) Error() string {
+func (e AlertOptionsValidationError) Error() string {
 	cause := ""
 	if e.cause != nil {
 		cause = fmt.Sprintf(" | caused by: %v", e.cause)
@@ -15730,14 +21310,14 @@ func (e UserInviteValidationError) Error() string {
 	}
 
 	return fmt.Sprintf(
-		"invalid %sUserInvite.%s: %s%s",
+		"invalid %sAlertOptions.%s: %s%s",
 		key,
 		e.field,
 		e.reason,
 		cause)
 }
 
-var _ error = UserInviteValidationError{}
+var _ error = AlertOptionsValidationError{}
 
 var _ interface {
 	Field() string
@@ -15745,48 +21325,201 @@ var _ interface {
 	Key() bool
 	Cause() error
 	ErrorName() string
-} = UserInviteValidationError{}
+} = AlertOptionsValidationError{}
 
-// Validate checks the field values on WhitelistedDomain with the rules defined
-// in the proto definition for this message. If any rules are violated, the
-// first error encountered is returned, or nil if there are no violations.
-func (m *WhitelistedDomain) Validate() error {
+var _AlertOptions_Comparison_NotInLookup = map[AlertComparison]struct{}{
+	0: {},
+}
+
+// Validate checks the field values on Alert with the rules defined in the
+// proto definition for this message. If any rules are violated, the first
+// error encountered is returned, or nil if there are no violations.
+func (m *Alert) Validate() error {
 	return m.validate(false)
 }
 
-// ValidateAll checks the field values on WhitelistedDomain with the rules
-// defined in the proto definition for this message. If any rules are
-// violated, the result is a list of violation errors wrapped in
-// WhitelistedDomainMultiError, or nil if none found.
-func (m *WhitelistedDomain) ValidateAll() error {
+// ValidateAll checks the field values on Alert with the rules defined in the
+// proto definition for this message. If any rules are violated, the result is
+// a list of violation errors wrapped in AlertMultiError, or nil if none found.
+func (m *Alert) ValidateAll() error {
 	return m.validate(true)
 }
 
-func (m *WhitelistedDomain) validate(all bool) error {
+func (m *Alert) validate(all bool) error {
 	if m == nil {
 		return nil
 	}
 
 	var errors []error
 
-	// no validation rules for Domain
+	// no validation rules for Id
 
-	// no validation rules for Role
+	// no validation rules for ProjectId
+
+	// no validation rules for Name
+
+	if all {
+		switch v := interface{}(m.GetOptions()).(type) {
+		case interface{ ValidateAll() error }:
+			if err := v.ValidateAll(); err != nil {
+				errors = append(errors, AlertValidationError{
+					field:  "Options",
+					reason: "embedded message failed validation",
+					cause:  err,
+				})
+			}
+		case interface{ Validate() error }:
+			if err := v.Validate(); err != nil {
+				errors = append(errors, AlertValidationError{
+					field:  "Options",
+					reason: "embedded message failed validation",
+					cause:  err,
+				})
+			}
+		}
+	} else if v, ok := interface{}(m.GetOptions()).(interface{ Validate() error }); ok {
+		if err := v.Validate(); err != nil {
+			return AlertValidationError{
+				field:  "Options",
+				reason: "embedded message failed validation",
+				cause:  err,
+			}
+		}
+	}
+
+	// no validation rules for OwnerUserId
+
+	if all {
+		switch v := interface{}(m.GetNextRunOn()).(type) {
+		case interface{ ValidateAll() error }:
+			if err := v.ValidateAll(); err != nil {
+				errors = append(errors, AlertValidationError{
+					field:  "NextRunOn",
+					reason: "embedded message failed validation",
+					cause:  err,
+				})
+			}
+		case interface{ Validate() error }:
+			if err := v.Validate(); err != nil {
+				errors = append(errors, AlertValidationError{
+					field:  "NextRunOn",
+					reason: "embedded message failed validation",
+					cause:  err,
+				})
+			}
+		}
+	} else if v, ok := interface{}(m.GetNextRunOn()).(interface{ Validate() error }); ok {
+		if err := v.Validate(); err != nil {
+			return AlertValidationError{
+				field:  "NextRunOn",
+				reason: "embedded message failed validation",
+				cause:  err,
+			}
+		}
+	}
+
+	if all {
+		switch v := interface{}(m.GetLastRunOn()).(type) {
+		case interface{ ValidateAll() error }:
+			if err := v.ValidateAll(); err != nil {
+				errors = append(errors, AlertValidationError{
+					field:  "LastRunOn",
+					reason: "embedded message failed validation",
+					cause:  err,
+				})
+			}
+		case interface{ Validate() error }:
+			if err := v.Validate(); err != nil {
+				errors = append(errors, AlertValidationError{
+					field:  "LastRunOn",
+					reason: "embedded message failed validation",
+					cause:  err,
+				})
+			}
+		}
+	} else if v, ok := interface{}(m.GetLastRunOn()).(interface{ Validate() error }); ok {
+		if err := v.Validate(); err != nil {
+			return AlertValidationError{
+				field:  "LastRunOn",
+				reason: "embedded message failed validation",
+				cause:  err,
+			}
+		}
+	}
+
+	// no validation rules for LastRunError
+
+	if all {
+		switch v := interface{}(m.GetCreatedOn()).(type) {
+		case interface{ ValidateAll() error }:
+			if err := v.ValidateAll(); err != nil {
+				errors = append(errors, AlertValidationError{
+					field:  "CreatedOn",
+					reason: "embedded message failed validation",
+					cause:  err,
+				})
+			}
+		case interface{ Validate() error }:
+			if err := v.Validate(); err != nil {
+				errors = append(errors, AlertValidationError{
+					field:  "CreatedOn",
+					reason: "embedded message failed validation",
+					cause:  err,
+				})
+			}
+		}
+	} else if v, ok := interface{}(m.GetCreatedOn()).(interface{ Validate() error }); ok {
+		if err := v.Validate(); err != nil {
+			return AlertValidationError{
+				field:  "CreatedOn",
+				reason: "embedded message failed validation",
+				cause:  err,
+			}
+		}
+	}
+
+	if all {
+		switch v := interface{}(m.GetUpdatedOn()).(type) {
+		case interface{ ValidateAll() error }:
+			if err := v.ValidateAll(); err != nil {
+				errors = append(errors, AlertValidationError{
+					field:  "UpdatedOn",
+					reason: "embedded message failed validation",
+					cause:  err,
+				})
+			}
+		case interface{ Validate() error }:
+			if err := v.Validate(); err != nil {
+				errors = append(errors, AlertValidationError{
+					field:  "UpdatedOn",
+					reason: "embedded message failed validation",
+					cause:  err,
+				})
+			}
+		}
+	} else if v, ok := interface{}(m.GetUpdatedOn()).(interface{ Validate() error }); ok {
+		if err := v.Validate(); err != nil {
+			return AlertValidationError{
+				field:  "UpdatedOn",
+				reason: "embedded message failed validation",
+				cause:  err,
+			}
+		}
+	}
 
 	if len(errors) > 0 {
-		return WhitelistedDomainMultiError(errors)
+		return AlertMultiError(errors)
 	}
 
 	return nil
 }
 
-// WhitelistedDomainMultiError is an error wrapping multiple validation errors
-// returned by WhitelistedDomain.ValidateAll() if the designated constraints
-// aren't met.
-type WhitelistedDomainMultiError []error
+// AlertMultiError is an error wrapping multiple validation errors returned by
+// Alert.ValidateAll() if the designated constraints aren't met.
+type AlertMultiError []error
 
 // Error returns a concatenation of all the error messages it wraps.
-func (m WhitelistedDomainMultiError) Error() string {
+func (m AlertMultiError) Error() string {
 	var msgs []string
 	for _, err := range m {
 		msgs = append(msgs, err.Error())
@@ -15795,11 +21528,11 @@ func (m WhitelistedDomainMultiError) Error() string {
 }
 
 // AllErrors returns a list of validation violation errors.
-func (m WhitelistedDomainMultiError) AllErrors() []error { return m }
+func (m AlertMultiError) AllErrors() []error { return m }
 
-// WhitelistedDomainValidationError is the validation error returned by
-// WhitelistedDomain.Validate if the designated constraints aren't met.
-type WhitelistedDomainValidationError struct {
+// AlertValidationError is the validation error returned by Alert.Validate if
+// the designated constraints aren't met.
+type AlertValidationError struct {
 	field  string
 	reason string
 	cause  error
@@ -15807,24 +21540,22 @@ type WhitelistedDomainValidationError struct {
 }
 
 // Field function returns field value.
-func (e WhitelistedDomainValidationError) Field() string { return e.field }
+func (e AlertValidationError) Field() string { return e.field }
 
 // Reason function returns reason value.
-func (e WhitelistedDomainValidationError) Reason() string { return e.reason }
+func (e AlertValidationError) Reason() string { return e.reason }
 
 // Cause function returns cause value.
-func (e WhitelistedDomainValidationError) Cause() error { return e.cause }
+func (e AlertValidationError) Cause() error { return e.cause }
 
 // Key function returns key value.
-func (e WhitelistedDomainValidationError) Key() bool { return e.key }
+func (e AlertValidationError) Key() bool { return e.key }
 
 // ErrorName returns error name.
-func (e WhitelistedDomainValidationError) ErrorName() string {
-	return "WhitelistedDomainValidationError"
-}
+func (e AlertValidationError) ErrorName() string { return "AlertValidationError" }
 
 // Error satisfies the builtin error interface
-func (e WhitelistedDomainValidationError) Error() string {
+func (e AlertValidationError) Error() string {
 	cause := ""
 	if e.cause != nil {
 		cause = fmt.Sprintf(" | caused by: %v", e.cause)
@@ -15836,14 +21567,14 @@ func (e WhitelistedDomainValidationError) Error() string {
 	}
 
 	return fmt.Sprintf(
-		"invalid %sWhitelistedDomain.%s: %s%s",
+		"invalid %sAlert.%s: %s%s",
 		key,
 		e.field,
 		e.reason,
 		cause)
 }
 
-var _ error = WhitelistedDomainValidationError{}
+var _ error = AlertValidationError{}
 
 var _ interface {
 	Field() string
@@ -15851,24 +21582,24 @@ var _ interface {
 	Key() bool
 	Cause() error
 	ErrorName() string
-} = WhitelistedDomainValidationError{}
+} = AlertValidationError{}
 
-// Validate checks the field values on Bookmark with the rules defined in the
+// Validate checks the field values on AuditEvent with the rules defined in the
 // proto definition for this message. If any rules are violated, the first
 // error encountered is returned, or nil if there are no violations.
-func (m *Bookmark) Validate() error {
+func (m *AuditEvent) Validate() error {
 	return m.validate(false)
 }
 
-// ValidateAll checks the field values on Bookmark with the rules defined in
+// ValidateAll checks the field values on AuditEvent with the rules defined in
 // the proto definition for this message. If any rules are violated, the
-// result is a list of violation errors wrapped in BookmarkMultiError, or nil
-// if none found.
-func (m *Bookmark) ValidateAll() error {
+// result is a list of violation errors wrapped in AuditEventMultiError, or
+// nil if none found.
+func (m *AuditEvent) ValidateAll() error {
 	return m.validate(true)
 }
 
-func (m *Bookmark) validate(all bool) error {
+func (m *AuditEvent) validate(all bool) error {
 	if m == nil {
 		return nil
 	}
@@ -15877,21 +21608,27 @@ func (m *Bookmark) validate(all bool) error {
 
 	// no validation rules for Id
 
-	// no validation rules for DisplayName
+	// no validation rules for ProjectId
 
-	// no validation rules for Data
+	// no validation rules for ProjectName
 
-	// no validation rules for DashboardName
+	// no validation rules for ActorType
 
-	// no validation rules for ProjectId
+	// no validation rules for ActorId
 
-	// no validation rules for UserId
+	// no validation rules for ActorName
+
+	// no validation rules for Action
+
+	// no validation rules for Target
+
+	// no validation rules for Summary
 
 	if all {
 		switch v := interface{}(m.GetCreatedOn()).(type) {
 		case interface{ ValidateAll() error }:
 			if err := v.ValidateAll(); err != nil {
-				errors = append(errors, BookmarkValidationError{
+				errors = append(errors, AuditEventValidationError{
 					field:  "CreatedOn",
 					reason: "embedded message failed validation",
 					cause:  err,
@@ -15899,7 +21636,7 @@ func (m *Bookmark) validate(all bool) error {
 			}
 		case interface{ Validate() error }:
 			if err := v.Validate(); err != nil {
-				errors = append(errors, BookmarkValidationError{
+				errors = append(errors, AuditEventValidationError{
 					field:  "CreatedOn",
 					reason: "embedded message failed validation",
 					cause:  err,
@@ -15908,7 +21645,7 @@ func (m *Bookmark) validate(all bool) error {
 		}
 	} else if v, ok := interface{}(m.GetCreatedOn()).(interface{ Validate() error }); ok {
 		if err := v.Validate(); err != nil {
-			return BookmarkValidationError{
+			return AuditEventValidationError{
 				field:  "CreatedOn",
 				reason: "embedded message failed validation",
 				cause:  err,
@@ -15916,48 +21653,19 @@ func (m *Bookmark) validate(all bool) error {
 		}
 	}
 
-	if all {
-		switch v := interface{}(m.GetUpdatedOn()).(type) {
-		case interface{ ValidateAll() error }:
-			if err := v.ValidateAll(); err != nil {
-				errors = append(errors, BookmarkValidationError{
-					field:  "UpdatedOn",
-					reason: "embedded message failed validation",
-					cause:  err,
-				})
-			}
-		case interface{ Validate() error }:
-			if err := v.Validate(); err != nil {
-				errors = append(errors, BookmarkValidationError{
-					field:  "UpdatedOn",
-					reason: "embedded message failed validation",
-					cause:  err,
-				})
-			}
-		}
-	} else if v, ok := interface{}(m.GetUpdatedOn()).(interface{ Validate() error }); ok {
-		if err := v.Validate(); err != nil {
-			return BookmarkValidationError{
-				field:  "UpdatedOn",
-				reason: "embedded message failed validation",
-				cause:  err,
-			}
-		}
-	}
-
 	if len(errors) > 0 {
-		return BookmarkMultiError(errors)
+		return AuditEventMultiError(errors)
 	}
 
 	return nil
 }
 
-// BookmarkMultiError is an error wrapping multiple validation errors returned
-// by Bookmark.ValidateAll() if the designated constraints aren't met.
-type BookmarkMultiError []error
+// AuditEventMultiError is an error wrapping multiple validation errors
+// returned by AuditEvent.ValidateAll() if the designated constraints aren't met.
+type AuditEventMultiError []error
 
 // Error returns a concatenation of all the error messages it wraps.
-func (m BookmarkMultiError) Error() string {
+func (m AuditEventMultiError) Error() string {
 	var msgs []string
 	for _, err := range m {
 		msgs = append(msgs, err.Error())
@@ -15966,11 +21674,11 @@ func (m BookmarkMultiError) Error() string {
 }
 
 // AllErrors returns a list of validation violation errors.
-func (m BookmarkMultiError) AllErrors() []error { return m }
+func (m AuditEventMultiError) AllErrors() []error { return m }
 
-// BookmarkValidationError is the validation error returned by
-// Bookmark.Validate if the designated constraints aren't met.
-type BookmarkValidationError struct {
+// AuditEventValidationError is the validation error returned by
+// AuditEvent.Validate if the designated constraints aren't met.
+type AuditEventValidationError struct {
 	field  string
 	reason string
 	cause  error
@@ -15978,22 +21686,22 @@ type BookmarkValidationError struct {
 }
 
 // Field function returns field value.
-func (e BookmarkValidationError) Field() string { return e.field }
+func (e AuditEventValidationError) Field() string { return e.field }
 
 // Reason function returns reason value.
-func (e BookmarkValidationError) Reason() string { return e.reason }
+func (e AuditEventValidationError) Reason() string { return e.reason }
 
 // Cause function returns cause value.
-func (e BookmarkValidationError) Cause() error { return e.cause }
+func (e AuditEventValidationError) Cause() error { return e.cause }
 
 // Key function returns key value.
-func (e BookmarkValidationError) Key() bool { return e.key }
+func (e AuditEventValidationError) Key() bool { return e.key }
 
 // ErrorName returns error name.
-func (e BookmarkValidationError) ErrorName() string { return "BookmarkValidationError" }
+func (e AuditEventValidationError) ErrorName() string { return "AuditEventValidationError" }
 
 // Error satisfies the builtin error interface
-func (e BookmarkValidationError) Error() string {
+func (e AuditEventValidationError) Error() string {
 	cause := ""
 	if e.cause != nil {
 		cause = fmt.Sprintf(" | caused by: %v", e.cause)
@@ -16005,14 +21713,14 @@ func (e BookmarkValidationError) Error() string {
 	}
 
 	return fmt.Sprintf(
-		"invalid %sBookmark.%s: %s%s",
+		"invalid %sAuditEvent.%s: %s%s",
 		key,
 		e.field,
 		e.reason,
 		cause)
 }
 
-var _ error = BookmarkValidationError{}
+var _ error = AuditEventValidationError{}
 
 var _ interface {
 	Field() string
@@ -16020,7 +21728,7 @@ var _ interface {
 	Key() bool
 	Cause() error
 	ErrorName() string
-} = BookmarkValidationError{}
+} = AuditEventValidationError{}
 
 // Validate checks the field values on ServiceToken with the rules defined in
 // the proto definition for this message. If any rules are violated, the first
@@ -29,6 +29,8 @@ const (
 	AdminService_GetProject_FullMethodName                   = "/rill.admin.v1.AdminService/GetProject"
 	AdminService_SearchProjectNames_FullMethodName           = "/rill.admin.v1.AdminService/SearchProjectNames"
 	AdminService_GetProjectVariables_FullMethodName          = "/rill.admin.v1.AdminService/GetProjectVariables"
+	AdminService_ListPreviewDeployments_FullMethodName       = "/rill.admin.v1.AdminService/ListPreviewDeployments"
+	AdminService_GetPreviewDeployment_FullMethodName         = "/rill.admin.v1.AdminService/GetPreviewDeployment"
 	AdminService_CreateProject_FullMethodName                = "/rill.admin.v1.AdminService/CreateProject"
 	AdminService_DeleteProject_FullMethodName                = "/rill.admin.v1.AdminService/DeleteProject"
 	AdminService_UpdateProject_FullMethodName                = "/rill.admin.v1.AdminService/UpdateProject"
@@ -73,7 +75,22 @@ const (
 	AdminService_ListBookmarks_FullMethodName                = "/rill.admin.v1.AdminService/ListBookmarks"
 	AdminService_GetBookmark_FullMethodName                  = "/rill.admin.v1.AdminService/GetBookmark"
 	AdminService_CreateBookmark_FullMethodName               = "/rill.admin.v1.AdminService/CreateBookmark"
+	AdminService_UpdateBookmark_FullMethodName               = "/rill.admin.v1.AdminService/UpdateBookmark"
 	AdminService_RemoveBookmark_FullMethodName               = "/rill.admin.v1.AdminService/RemoveBookmark"
+	AdminService_CreateBookmarkShareToken_FullMethodName     = "/rill.admin.v1.AdminService/CreateBookmarkShareToken"
+	AdminService_RevokeBookmarkShareToken_FullMethodName     = "/rill.admin.v1.AdminService/RevokeBookmarkShareToken"
+	AdminService_GetSharedBookmark_FullMethodName            = "/rill.admin.v1.AdminService/GetSharedBookmark"
+	AdminService_ListReports_FullMethodName                  = "/rill.admin.v1.AdminService/ListReports"
+	AdminService_GetReport_FullMethodName                    = "/rill.admin.v1.AdminService/GetReport"
+	AdminService_CreateReport_FullMethodName                 = "/rill.admin.v1.AdminService/CreateReport"
+	AdminService_EditReport_FullMethodName                   = "/rill.admin.v1.AdminService/EditReport"
+	AdminService_DeleteReport_FullMethodName                 = "/rill.admin.v1.AdminService/DeleteReport"
+	AdminService_ListAlerts_FullMethodName                   = "/rill.admin.v1.AdminService/ListAlerts"
+	AdminService_GetAlert_FullMethodName                     = "/rill.admin.v1.AdminService/GetAlert"
+	AdminService_CreateAlert_FullMethodName                  = "/rill.admin.v1.AdminService/CreateAlert"
+	AdminService_EditAlert_FullMethodName                    = "/rill.admin.v1.AdminService/EditAlert"
+	AdminService_DeleteAlert_FullMethodName                  = "/rill.admin.v1.AdminService/DeleteAlert"
+	AdminService_ListAuditEvents_FullMethodName              = "/rill.admin.v1.AdminService/ListAuditEvents"
 )
 
 // AdminServiceClient is the client API for AdminService service.
@@ -100,6 +117,11 @@ type AdminServiceClient interface {
 	SearchProjectNames(ctx context.Context, in *SearchProjectNamesRequest, opts ...grpc.CallOption) (*SearchProjectNamesResponse, error)
 	// GetProjectVariables returns project variables. NOTE: Get project API doesn't return variables.
 	GetProjectVariables(ctx context.Context, in *GetProjectVariablesRequest, opts ...grpc.CallOption) (*GetProjectVariablesResponse, error)
+	// ListPreviewDeployments lists the preview deployments of a project.
+	// Preview deployments are created for the project's non-prod branches and pull requests.
+	ListPreviewDeployments(ctx context.Context, in *ListPreviewDeploymentsRequest, opts ...grpc.CallOption) (*ListPreviewDeploymentsResponse, error)
+	// GetPreviewDeployment returns a project's preview deployment for a branch and a JWT for accessing it.
+	GetPreviewDeployment(ctx context.Context, in *GetPreviewDeploymentRequest, opts ...grpc.CallOption) (*GetPreviewDeploymentResponse, error)
 	// CreateProject creates a new project
 	CreateProject(ctx context.Context, in *CreateProjectRequest, opts ...grpc.CallOption) (*CreateProjectResponse, error)
 	// DeleteProject deletes an project
@@ -189,8 +211,38 @@ type AdminServiceClient interface {
 	GetBookmark(ctx context.Context, in *GetBookmarkRequest, opts ...grpc.CallOption) (*GetBookmarkResponse, error)
 	// CreateBookmark creates a bookmark for the given user for the given project
 	CreateBookmark(ctx context.Context, in *CreateBookmarkRequest, opts ...grpc.CallOption) (*CreateBookmarkResponse, error)
+	// UpdateBookmark updates a bookmark. Shared bookmarks can also be updated by project admins.
+	UpdateBookmark(ctx context.Context, in *UpdateBookmarkRequest, opts ...grpc.CallOption) (*UpdateBookmarkResponse, error)
 	// RemoveBookmark removes the bookmark for the given user for the given project
 	RemoveBookmark(ctx context.Context, in *RemoveBookmarkRequest, opts ...grpc.CallOption) (*RemoveBookmarkResponse, error)
+	// CreateBookmarkShareToken creates an expiring token that grants public, read-only access to a bookmark's dashboard state
+	CreateBookmarkShareToken(ctx context.Context, in *CreateBookmarkShareTokenRequest, opts ...grpc.CallOption) (*CreateBookmarkShareTokenResponse, error)
+	// RevokeBookmarkShareToken revokes a bookmark share token before it expires
+	RevokeBookmarkShareToken(ctx context.Context, in *RevokeBookmarkShareTokenRequest, opts ...grpc.CallOption) (*RevokeBookmarkShareTokenResponse, error)
+	// GetSharedBookmark returns a shared bookmark and a runtime JWT scoped to its dashboard. It does not require authentication.
+	GetSharedBookmark(ctx context.Context, in *GetSharedBookmarkRequest, opts ...grpc.CallOption) (*GetSharedBookmarkResponse, error)
+	// ListReports lists the scheduled reports of a project
+	ListReports(ctx context.Context, in *ListReportsRequest, opts ...grpc.CallOption) (*ListReportsResponse, error)
+	// GetReport returns a scheduled report
+	GetReport(ctx context.Context, in *GetReportRequest, opts ...grpc.CallOption) (*GetReportResponse, error)
+	// CreateReport creates a scheduled report that emails an export of a metrics view query to a list of recipients
+	CreateReport(ctx context.Context, in *CreateReportRequest, opts ...grpc.CallOption) (*CreateReportResponse, error)
+	// EditReport updates a scheduled report
+	EditReport(ctx context.Context, in *EditReportRequest, opts ...grpc.CallOption) (*EditReportResponse, error)
+	// DeleteReport deletes a scheduled report
+	DeleteReport(ctx context.Context, in *DeleteReportRequest, opts ...grpc.CallOption) (*DeleteReportResponse, error)
+	// ListAlerts lists the threshold alerts of a project
+	ListAlerts(ctx context.Context, in *ListAlertsRequest, opts ...grpc.CallOption) (*ListAlertsResponse, error)
+	// GetAlert returns a threshold alert
+	GetAlert(ctx context.Context, in *GetAlertRequest, opts ...grpc.CallOption) (*GetAlertResponse, error)
+	// CreateAlert creates an alert that notifies recipients and a webhook when a metrics view measure crosses a threshold
+	CreateAlert(ctx context.Context, in *CreateAlertRequest, opts ...grpc.CallOption) (*CreateAlertResponse, error)
+	// EditAlert updates a threshold alert
+	EditAlert(ctx context.Context, in *EditAlertRequest, opts ...grpc.CallOption) (*EditAlertResponse, error)
+	// DeleteAlert deletes a threshold alert
+	DeleteAlert(ctx context.Context, in *DeleteAlertRequest, opts ...grpc.CallOption) (*DeleteAlertResponse, error)
+	// ListAuditEvents lists the changes made to an organization and its projects, from newest to oldest
+	ListAuditEvents(ctx context.Context, in *ListAuditEventsRequest, opts ...grpc.CallOption) (*ListAuditEventsResponse, error)
 }
 
 type adminServiceClient struct {
@@ -291,6 +343,24 @@ func (c *adminServiceClient) GetProjectVariables(ctx context.Context, in *GetPro
 	return out, nil
 }
 
+func (c *adminServiceClient) ListPreviewDeployments(ctx context.Context, in *ListPreviewDeploymentsRequest, opts ...grpc.CallOption) (*ListPreviewDeploymentsResponse, error) {
+	out := new(ListPreviewDeploymentsResponse)
+	err := c.cc.Invoke(ctx, AdminService_ListPreviewDeployments_FullMethodName, in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *adminServiceClient) GetPreviewDeployment(ctx context.Context, in *GetPreviewDeploymentRequest, opts ...grpc.CallOption) (*GetPreviewDeploymentResponse, error) {
+	out := new(GetPreviewDeploymentResponse)
+	err := c.cc.Invoke(ctx, AdminService_GetPreviewDeployment_FullMethodName, in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
 func (c *adminServiceClient) CreateProject(ctx context.Context, in *CreateProjectRequest, opts ...grpc.CallOption) (*CreateProjectResponse, error) {
 	out := new(CreateProjectResponse)
 	err := c.cc.Invoke(ctx, AdminService_CreateProject_FullMethodName, in, out, opts...)
@@ -687,6 +757,15 @@ func (c *adminServiceClient) CreateBookmark(ctx context.Context, in *CreateBookm
 	return out, nil
 }
 
+func (c *adminServiceClient) UpdateBookmark(ctx context.Context, in *UpdateBookmarkRequest, opts ...grpc.CallOption) (*UpdateBookmarkResponse, error) {
+	out := new(UpdateBookmarkResponse)
+	err := c.cc.Invoke(ctx, AdminService_UpdateBookmark_FullMethodName, in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
 func (c *adminServiceClient) RemoveBookmark(ctx context.Context, in *RemoveBookmarkRequest, opts ...grpc.CallOption) (*RemoveBookmarkResponse, error) {
 	out := new(RemoveBookmarkResponse)
 	err := c.cc.Invoke(ctx, AdminService_RemoveBookmark_FullMethodName, in, out, opts...)
@@ -696,6 +775,132 @@ func (c *adminServiceClient) RemoveBookmark(ctx context.Context, in *RemoveBookm
 	return out, nil
 }
 
+func (c *adminServiceClient) CreateBookmarkShareToken(ctx context.Context, in *CreateBookmarkShareTokenRequest, opts ...grpc.CallOption) (*CreateBookmarkShareTokenResponse, error) {
+	out := new(CreateBookmarkShareTokenResponse)
+	err := c.cc.Invoke(ctx, AdminService_CreateBookmarkShareToken_FullMethodName, in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *adminServiceClient) RevokeBookmarkShareToken(ctx context.Context, in *RevokeBookmarkShareTokenRequest, opts ...grpc.CallOption) (*RevokeBookmarkShareTokenResponse, error) {
+	out := new(RevokeBookmarkShareTokenResponse)
+	err := c.cc.Invoke(ctx, AdminService_RevokeBookmarkShareToken_FullMethodName, in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *adminServiceClient) GetSharedBookmark(ctx context.Context, in *GetSharedBookmarkRequest, opts ...grpc.CallOption) (*GetSharedBookmarkResponse, error) {
+	out := new(GetSharedBookmarkResponse)
+	err := c.cc.Invoke(ctx, AdminService_GetSharedBookmark_FullMethodName, in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *adminServiceClient) ListReports(ctx context.Context, in *ListReportsRequest, opts ...grpc.CallOption) (*ListReportsResponse, error) {
+	out := new(ListReportsResponse)
+	err := c.cc.Invoke(ctx, AdminService_ListReports_FullMethodName, in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *adminServiceClient) GetReport(ctx context.Context, in *GetReportRequest, opts ...grpc.CallOption) (*GetReportResponse, error) {
+	out := new(GetReportResponse)
+	err := c.cc.Invoke(ctx, AdminService_GetReport_FullMethodName, in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *adminServiceClient) CreateReport(ctx context.Context, in *CreateReportRequest, opts ...grpc.CallOption) (*CreateReportResponse, error) {
+	out := new(CreateReportResponse)
+	err := c.cc.Invoke(ctx, AdminService_CreateReport_FullMethodName, in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *adminServiceClient) EditReport(ctx context.Context, in *EditReportRequest, opts ...grpc.CallOption) (*EditReportResponse, error) {
+	out := new(EditReportResponse)
+	err := c.cc.Invoke(ctx, AdminService_EditReport_FullMethodName, in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *adminServiceClient) DeleteReport(ctx context.Context, in *DeleteReportRequest, opts ...grpc.CallOption) (*DeleteReportResponse, error) {
+	out := new(DeleteReportResponse)
+	err := c.cc.Invoke(ctx, AdminService_DeleteReport_FullMethodName, in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *adminServiceClient) ListAlerts(ctx context.Context, in *ListAlertsRequest, opts ...grpc.CallOption) (*ListAlertsResponse, error) {
+	out := new(ListAlertsResponse)
+	err := c.cc.Invoke(ctx, AdminService_ListAlerts_FullMethodName, in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *adminServiceClient) GetAlert(ctx context.Context, in *GetAlertRequest, opts ...grpc.CallOption) (*GetAlertResponse, error) {
+	out := new(GetAlertResponse)
+	err := c.cc.Invoke(ctx, AdminService_GetAlert_FullMethodName, in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *adminServiceClient) CreateAlert(ctx context.Context, in *CreateAlertRequest, opts ...grpc.CallOption) (*CreateAlertResponse, error) {
+	out := new(CreateAlertResponse)
+	err := c.cc.Invoke(ctx, AdminService_CreateAlert_FullMethodName, in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *adminServiceClient) EditAlert(ctx context.Context, in *EditAlertRequest, opts ...grpc.CallOption) (*EditAlertResponse, error) {
+	out := new(EditAlertResponse)
+	err := c.cc.Invoke(ctx, AdminService_EditAlert_FullMethodName, in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *adminServiceClient) DeleteAlert(ctx context.Context, in *DeleteAlertRequest, opts ...grpc.CallOption) (*DeleteAlertResponse, error) {
+	out := new(DeleteAlertResponse)
+	err := c.cc.Invoke(ctx, AdminService_DeleteAlert_FullMethodName, in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *adminServiceClient) ListAuditEvents(ctx context.Context, in *ListAuditEventsRequest, opts ...grpc.CallOption) (*ListAuditEventsResponse, error) {
+	out := new(ListAuditEventsResponse)
+	err := c.cc.Invoke(ctx, AdminService_ListAuditEvents_FullMethodName, in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
 // AdminServiceServer is the server API for AdminService service.
 // All implementations must embed UnimplementedAdminServiceServer
 // for forward compatibility
@@ -720,6 +925,11 @@ type AdminServiceServer interface {
 	SearchProjectNames(context.Context, *SearchProjectNamesRequest) (*SearchProjectNamesResponse, error)
 	// GetProjectVariables returns project variables. NOTE: Get project API doesn't return variables.
 	GetProjectVariables(context.Context, *GetProjectVariablesRequest) (*GetProjectVariablesResponse, error)
+	// ListPreviewDeployments lists the preview deployments of a project.
+	// Preview deployments are created for the project's non-prod branches and pull requests.
+	ListPreviewDeployments(context.Context, *ListPreviewDeploymentsRequest) (*ListPreviewDeploymentsResponse, error)
+	// GetPreviewDeployment returns a project's preview deployment for a branch and a JWT for accessing it.
+	GetPreviewDeployment(context.Context, *GetPreviewDeploymentRequest) (*GetPreviewDeploymentResponse, error)
 	// CreateProject creates a new project
 	CreateProject(context.Context, *CreateProjectRequest) (*CreateProjectResponse, error)
 	// DeleteProject deletes an project
@@ -809,8 +1019,38 @@ type AdminServiceServer interface {
 	GetBookmark(context.Context, *GetBookmarkRequest) (*GetBookmarkResponse, error)
 	// CreateBookmark creates a bookmark for the given user for the given project
 	CreateBookmark(context.Context, *CreateBookmarkRequest) (*CreateBookmarkResponse, error)
+	// UpdateBookmark updates a bookmark. Shared bookmarks can also be updated by project admins.
+	UpdateBookmark(context.Context, *UpdateBookmarkRequest) (*UpdateBookmarkResponse, error)
 	// RemoveBookmark removes the bookmark for the given user for the given project
 	RemoveBookmark(context.Context, *RemoveBookmarkRequest) (*RemoveBookmarkResponse, error)
+	// CreateBookmarkShareToken creates an expiring token that grants public, read-only access to a bookmark's dashboard state
+	CreateBookmarkShareToken(context.Context, *CreateBookmarkShareTokenRequest) (*CreateBookmarkShareTokenResponse, error)
+	// RevokeBookmarkShareToken revokes a bookmark share token before it expires
+	RevokeBookmarkShareToken(context.Context, *RevokeBookmarkShareTokenRequest) (*RevokeBookmarkShareTokenResponse, error)
+	// GetSharedBookmark returns a shared bookmark and a runtime JWT scoped to its dashboard. It does not require authentication.
+	GetSharedBookmark(context.Context, *GetSharedBookmarkRequest) (*GetSharedBookmarkResponse, error)
+	// ListReports lists the scheduled reports of a project
+	ListReports(context.Context, *ListReportsRequest) (*ListReportsResponse, error)
+	// GetReport returns a scheduled report
+	GetReport(context.Context, *GetReportRequest) (*GetReportResponse, error)
+	// CreateReport creates a scheduled report that emails an export of a metrics view query to a list of recipients
+	CreateReport(context.Context, *CreateReportRequest) (*CreateReportResponse, error)
+	// EditReport updates a scheduled report
+	EditReport(context.Context, *EditReportRequest) (*EditReportResponse, error)
+	// DeleteReport deletes a scheduled report
+	DeleteReport(context.Context, *DeleteReportRequest) (*DeleteReportResponse, error)
+	// ListAlerts lists the threshold alerts of a project
+	ListAlerts(context.Context, *ListAlertsRequest) (*ListAlertsResponse, error)
+	// GetAlert returns a threshold alert
+	GetAlert(context.Context, *GetAlertRequest) (*GetAlertResponse, error)
+	// CreateAlert creates an alert that notifies recipients and a webhook when a metrics view measure crosses a threshold
+	CreateAlert(context.Context, *CreateAlertRequest) (*CreateAlertResponse, error)
+	// EditAlert updates a threshold alert
+	EditAlert(context.Context, *EditAlertRequest) (*EditAlertResponse, error)
+	// DeleteAlert deletes a threshold alert
+	DeleteAlert(context.Context, *DeleteAlertRequest) (*DeleteAlertResponse, error)
+	// ListAuditEvents lists the changes made to an organization and its projects, from newest to oldest
+	ListAuditEvents(context.Context, *ListAuditEventsRequest) (*ListAuditEventsResponse, error)
 	mustEmbedUnimplementedAdminServiceServer()
 }
 
@@ -848,6 +1088,12 @@ func (UnimplementedAdminServiceServer) SearchProjectNames(context.Context, *Sear
 func (UnimplementedAdminServiceServer) GetProjectVariables(context.Context, *GetProjectVariablesRequest) (*GetProjectVariablesResponse, error) {
 	return nil, status.Errorf(codes.Unimplemented, "method GetProjectVariables not implemented")
 }
+func (UnimplementedAdminServiceServer) ListPreviewDeployments(context.Context, *ListPreviewDeploymentsRequest) (*ListPreviewDeploymentsResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method ListPreviewDeployments not implemented")
+}
+func (UnimplementedAdminServiceServer) GetPreviewDeployment(context.Context, *GetPreviewDeploymentRequest) (*GetPreviewDeploymentResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method GetPreviewDeployment not implemented")
+}
 func (UnimplementedAdminServiceServer) CreateProject(context.Context, *CreateProjectRequest) (*CreateProjectResponse, error) {
 	return nil, status.Errorf(codes.Unimplemented, "method CreateProject not implemented")
 }
@@ -980,9 +1226,54 @@ func (UnimplementedAdminServiceServer) GetBookmark(context.Context, *GetBookmark
 func (UnimplementedAdminServiceServer) CreateBookmark(context.Context, *CreateBookmarkRequest) (*CreateBookmarkResponse, error) {
 	return nil, status.Errorf(codes.Unimplemented, "method CreateBookmark not implemented")
 }
+func (UnimplementedAdminServiceServer) UpdateBookmark(context.Context, *UpdateBookmarkRequest) (*UpdateBookmarkResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method UpdateBookmark not implemented")
+}
 func (UnimplementedAdminServiceServer) RemoveBookmark(context.Context, *RemoveBookmarkRequest) (*RemoveBookmarkResponse, error) {
 	return nil, status.Errorf(codes.Unimplemented, "method RemoveBookmark not implemented")
 }
+func (UnimplementedAdminServiceServer) CreateBookmarkShareToken(context.Context, *CreateBookmarkShareTokenRequest) (*CreateBookmarkShareTokenResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method CreateBookmarkShareToken not implemented")
+}
+func (UnimplementedAdminServiceServer) RevokeBookmarkShareToken(context.Context, *RevokeBookmarkShareTokenRequest) (*RevokeBookmarkShareTokenResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method RevokeBookmarkShareToken not implemented")
+}
+func (UnimplementedAdminServiceServer) GetSharedBookmark(context.Context, *GetSharedBookmarkRequest) (*GetSharedBookmarkResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method GetSharedBookmark not implemented")
+}
+func (UnimplementedAdminServiceServer) ListReports(context.Context, *ListReportsRequest) (*ListReportsResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method ListReports not implemented")
+}
+func (UnimplementedAdminServiceServer) GetReport(context.Context, *GetReportRequest) (*GetReportResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method GetReport not implemented")
+}
+func (UnimplementedAdminServiceServer) CreateReport(context.Context, *CreateReportRequest) (*CreateReportResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method CreateReport not implemented")
+}
+func (UnimplementedAdminServiceServer) EditReport(context.Context, *EditReportRequest) (*EditReportResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method EditReport not implemented")
+}
+func (UnimplementedAdminServiceServer) DeleteReport(context.Context, *DeleteReportRequest) (*DeleteReportResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method DeleteReport not implemented")
+}
+func (UnimplementedAdminServiceServer) ListAlerts(context.Context, *ListAlertsRequest) (*ListAlertsResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method ListAlerts not implemented")
+}
+func (UnimplementedAdminServiceServer) GetAlert(context.Context, *GetAlertRequest) (*GetAlertResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method GetAlert not implemented")
+}
+func (UnimplementedAdminServiceServer) CreateAlert(context.Context, *CreateAlertRequest) (*CreateAlertResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method CreateAlert not implemented")
+}
+func (UnimplementedAdminServiceServer) EditAlert(context.Context, *EditAlertRequest) (*EditAlertResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method EditAlert not implemented")
+}
+func (UnimplementedAdminServiceServer) DeleteAlert(context.Context, *DeleteAlertRequest) (*DeleteAlertResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method DeleteAlert not implemented")
+}
+func (UnimplementedAdminServiceServer) ListAuditEvents(context.Context, *ListAuditEventsRequest) (*ListAuditEventsResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method ListAuditEvents not implemented")
+}
 func (UnimplementedAdminServiceServer) mustEmbedUnimplementedAdminServiceServer() {}
 
 // UnsafeAdminServiceServer may be embedded to opt out of forward compatibility for this service.
@@ -1176,6 +1467,42 @@ func _AdminService_GetProjectVariables_Handler(srv interface{}, ctx context.Cont
 	return interceptor(ctx, in, info, handler)
 }
 
+func _AdminService_ListPreviewDeployments_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(ListPreviewDeploymentsRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(AdminServiceServer).ListPreviewDeployments(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: AdminService_ListPreviewDeployments_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(AdminServiceServer).ListPreviewDeployments(ctx, req.(*ListPreviewDeploymentsRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _AdminService_GetPreviewDeployment_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(GetPreviewDeploymentRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(AdminServiceServer).GetPreviewDeployment(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: AdminService_GetPreviewDeployment_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(AdminServiceServer).GetPreviewDeployment(ctx, req.(*GetPreviewDeploymentRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
 func _AdminService_CreateProject_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
 	in := new(CreateProjectRequest)
 	if err := dec(in); err != nil {
@@ -1968,6 +2295,24 @@ func _AdminService_CreateBookmark_Handler(srv interface{}, ctx context.Context,
 	return interceptor(ctx, in, info, handler)
 }
 
+func _AdminService_UpdateBookmark_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(UpdateBookmarkRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(AdminServiceServer).UpdateBookmark(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: AdminService_UpdateBookmark_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(AdminServiceServer).UpdateBookmark(ctx, req.(*UpdateBookmarkRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
 func _AdminService_RemoveBookmark_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
 	in := new(RemoveBookmarkRequest)
 	if err := dec(in); err != nil {
@@ -1986,6 +2331,258 @@ func _AdminService_RemoveBookmark_Handler(srv interface{}, ctx context.Context,
 	return interceptor(ctx, in, info, handler)
 }
 
+func _AdminService_CreateBookmarkShareToken_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(CreateBookmarkShareTokenRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(AdminServiceServer).CreateBookmarkShareToken(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: AdminService_CreateBookmarkShareToken_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(AdminServiceServer).CreateBookmarkShareToken(ctx, req.(*CreateBookmarkShareTokenRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _AdminService_RevokeBookmarkShareToken_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(RevokeBookmarkShareTokenRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(AdminServiceServer).RevokeBookmarkShareToken(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: AdminService_RevokeBookmarkShareToken_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(AdminServiceServer).RevokeBookmarkShareToken(ctx, req.(*RevokeBookmarkShareTokenRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _AdminService_GetSharedBookmark_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(GetSharedBookmarkRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(AdminServiceServer).GetSharedBookmark(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: AdminService_GetSharedBookmark_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(AdminServiceServer).GetSharedBookmark(ctx, req.(*GetSharedBookmarkRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _AdminService_ListReports_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(ListReportsRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(AdminServiceServer).ListReports(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: AdminService_ListReports_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(AdminServiceServer).ListReports(ctx, req.(*ListReportsRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _AdminService_GetReport_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(GetReportRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(AdminServiceServer).GetReport(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: AdminService_GetReport_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(AdminServiceServer).GetReport(ctx, req.(*GetReportRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _AdminService_CreateReport_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(CreateReportRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(AdminServiceServer).CreateReport(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: AdminService_CreateReport_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(AdminServiceServer).CreateReport(ctx, req.(*CreateReportRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _AdminService_EditReport_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(EditReportRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(AdminServiceServer).EditReport(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: AdminService_EditReport_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(AdminServiceServer).EditReport(ctx, req.(*EditReportRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _AdminService_DeleteReport_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(DeleteReportRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(AdminServiceServer).DeleteReport(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: AdminService_DeleteReport_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(AdminServiceServer).DeleteReport(ctx, req.(*DeleteReportRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _AdminService_ListAlerts_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(ListAlertsRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(AdminServiceServer).ListAlerts(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: AdminService_ListAlerts_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(AdminServiceServer).ListAlerts(ctx, req.(*ListAlertsRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _AdminService_GetAlert_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(GetAlertRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(AdminServiceServer).GetAlert(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: AdminService_GetAlert_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(AdminServiceServer).GetAlert(ctx, req.(*GetAlertRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _AdminService_CreateAlert_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(CreateAlertRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(AdminServiceServer).CreateAlert(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: AdminService_CreateAlert_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(AdminServiceServer).CreateAlert(ctx, req.(*CreateAlertRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _AdminService_EditAlert_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(EditAlertRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(AdminServiceServer).EditAlert(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: AdminService_EditAlert_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(AdminServiceServer).EditAlert(ctx, req.(*EditAlertRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _AdminService_DeleteAlert_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(DeleteAlertRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(AdminServiceServer).DeleteAlert(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: AdminService_DeleteAlert_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(AdminServiceServer).DeleteAlert(ctx, req.(*DeleteAlertRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _AdminService_ListAuditEvents_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(ListAuditEventsRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(AdminServiceServer).ListAuditEvents(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: AdminService_ListAuditEvents_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(AdminServiceServer).ListAuditEvents(ctx, req.(*ListAuditEventsRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
 // AdminService_ServiceDesc is the grpc.ServiceDesc for AdminService service.
 // It's only intended for direct use with grpc.RegisterService,
 // and not to be introspected or modified (even as a copy)
@@ -2033,6 +2630,14 @@ var AdminService_ServiceDesc = grpc.ServiceDesc{
 			MethodName: "GetProjectVariables",
 			Handler:    _AdminService_GetProjectVariables_Handler,
 		},
+		{
+			MethodName: "ListPreviewDeployments",
+			Handler:    _AdminService_ListPreviewDeployments_Handler,
+		},
+		{
+			MethodName: "GetPreviewDeployment",
+			Handler:    _AdminService_GetPreviewDeployment_Handler,
+		},
 		{
 			MethodName: "CreateProject",
 			Handler:    _AdminService_CreateProject_Handler,
@@ -2209,10 +2814,70 @@ var AdminService_ServiceDesc = grpc.ServiceDesc{
 			MethodName: "CreateBookmark",
 			Handler:    _AdminService_CreateBookmark_Handler,
 		},
+		{
+			MethodName: "UpdateBookmark",
+			Handler:    _AdminService_UpdateBookmark_Handler,
+		},
 		{
 			MethodName: "RemoveBookmark",
 			Handler:    _AdminService_RemoveBookmark_Handler,
 		},
+		{
+			MethodName: "CreateBookmarkShareToken",
+			Handler:    _AdminService_CreateBookmarkShareToken_Handler,
+		},
+		{
+			MethodName: "RevokeBookmarkShareToken",
+			Handler:    _AdminService_RevokeBookmarkShareToken_Handler,
+		},
+		{
+			MethodName: "GetSharedBookmark",
+			Handler:    _AdminService_GetSharedBookmark_Handler,
+		},
+		{
+			MethodName: "ListReports",
+			Handler:    _AdminService_ListReports_Handler,
+		},
+		{
+			MethodName: "GetReport",
+			Handler:    _AdminService_GetReport_Handler,
+		},
+		{
+			MethodName: "CreateReport",
+			Handler:    _AdminService_CreateReport_Handler,
+		},
+		{
+			MethodName: "EditReport",
+			Handler:    _AdminService_EditReport_Handler,
+		},
+		{
+			MethodName: "DeleteReport",
+			Handler:    _AdminService_DeleteReport_Handler,
+		},
+		{
+			MethodName: "ListAlerts",
+			Handler:    _AdminService_ListAlerts_Handler,
+		},
+		{
+			MethodName: "GetAlert",
+			Handler:    _AdminService_GetAlert_Handler,
+		},
+		{
+			MethodName: "CreateAlert",
+			Handler:    _AdminService_CreateAlert_Handler,
+		},
+		{
+			MethodName: "EditAlert",
+			Handler:    _AdminService_EditAlert_Handler,
+		},
+		{
+			MethodName: "DeleteAlert",
+			Handler:    _AdminService_DeleteAlert_Handler,
+		},
+		{
+			MethodName: "ListAuditEvents",
+			Handler:    _AdminService_ListAuditEvents_Handler,
+		},
 	},
 	Streams:  []grpc.StreamDesc{},
 	Metadata: "rill/admin/v1/api.proto",
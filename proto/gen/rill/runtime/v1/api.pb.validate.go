@@ -343,6 +343,10 @@ func (m *Instance) validate(all bool) error {
 
 	}
 
+	// no validation rules for ExportLimitRows
+
+	// no validation rules for ExportLimitBytes
+
 	if len(errors) > 0 {
 		return InstanceMultiError(errors)
 	}
@@ -1100,6 +1104,10 @@ func (m *CreateInstanceRequest) validate(all bool) error {
 
 	}
 
+	// no validation rules for ExportLimitRows
+
+	// no validation rules for ExportLimitBytes
+
 	if len(errors) > 0 {
 		return CreateInstanceRequestMultiError(errors)
 	}
@@ -1532,6 +1540,448 @@ var _ interface {
 	ErrorName() string
 } = DeleteInstanceResponseValidationError{}
 
+// Validate checks the field values on GetQueryCacheStatsRequest with the rules
+// defined in the proto definition for this message. If any rules are
+// violated, the first error encountered is returned, or nil if there are no violations.
+func (m *GetQueryCacheStatsRequest) Validate() error {
+	return m.validate(false)
+}
+
+// ValidateAll checks the field values on GetQueryCacheStatsRequest with the
+// rules defined in the proto definition for this message. If any rules are
+// violated, the result is a list of violation errors wrapped in
+// GetQueryCacheStatsRequestMultiError, or nil if none found.
+func (m *GetQueryCacheStatsRequest) ValidateAll() error {
+	return m.validate(true)
+}
+
+func (m *GetQueryCacheStatsRequest) validate(all bool) error {
+	if m == nil {
+		return nil
+	}
+
+	var errors []error
+
+	if !_GetQueryCacheStatsRequest_InstanceId_Pattern.MatchString(m.GetInstanceId()) {
+		err := GetQueryCacheStatsRequestValidationError{
+			field:  "InstanceId",
+			reason: "value does not match regex pattern \"^[_\\\\-a-zA-Z0-9]+$\"",
+		}
+		if !all {
+			return err
+		}
+		errors = append(errors, err)
+	}
+
+	if len(errors) > 0 {
+		return GetQueryCacheStatsRequestMultiError(errors)
+	}
+
+	return nil
+}
+
+// GetQueryCacheStatsRequestMultiError is an error wrapping multiple validation
+// errors returned by GetQueryCacheStatsRequest.ValidateAll() if the
+// designated constraints aren't met.
+type GetQueryCacheStatsRequestMultiError []error
+
+// Error returns a concatenation of all the error messages it wraps.
+func (m GetQueryCacheStatsRequestMultiError) Error() string {
+	var msgs []string
+	for _, err := range m {
+		msgs = append(msgs, err.Error())
+	}
+	return strings.Join(msgs, "; ")
+}
+
+// AllErrors returns a list of validation violation errors.
+func (m GetQueryCacheStatsRequestMultiError) AllErrors() []error { return m }
+
+// GetQueryCacheStatsRequestValidationError is the validation error returned by
+// GetQueryCacheStatsRequest.Validate if the designated constraints aren't met.
+type GetQueryCacheStatsRequestValidationError struct {
+	field  string
+	reason string
+	cause  error
+	key    bool
+}
+
+// Field function returns field value.
+func (e GetQueryCacheStatsRequestValidationError) Field() string { return e.field }
+
+// Reason function returns reason value.
+func (e GetQueryCacheStatsRequestValidationError) Reason() string { return e.reason }
+
+// Cause function returns cause value.
+func (e GetQueryCacheStatsRequestValidationError) Cause() error { return e.cause }
+
+// Key function returns key value.
+func (e GetQueryCacheStatsRequestValidationError) Key() bool { return e.key }
+
+// ErrorName returns error name.
+func (e GetQueryCacheStatsRequestValidationError) ErrorName() string {
+	return "GetQueryCacheStatsRequestValidationError"
+}
+
+// Error satisfies the builtin error interface
+func (e GetQueryCacheStatsRequestValidationError) Error() string {
+	cause := ""
+	if e.cause != nil {
+		cause = fmt.Sprintf(" | caused by: %v", e.cause)
+	}
+
+	key := ""
+	if e.key {
+		key = "key for "
+	}
+
+	return fmt.Sprintf(
+		"invalid %sGetQueryCacheStatsRequest.%s: %s%s",
+		key,
+		e.field,
+		e.reason,
+		cause)
+}
+
+var _ error = GetQueryCacheStatsRequestValidationError{}
+
+var _ interface {
+	Field() string
+	Reason() string
+	Key() bool
+	Cause() error
+	ErrorName() string
+} = GetQueryCacheStatsRequestValidationError{}
+
+var _GetQueryCacheStatsRequest_InstanceId_Pattern = regexp.MustCompile("^[_\\-a-zA-Z0-9]+$")
+
+// Validate checks the field values on GetQueryCacheStatsResponse with the
+// rules defined in the proto definition for this message. If any rules are
+// violated, the first error encountered is returned, or nil if there are no violations.
+func (m *GetQueryCacheStatsResponse) Validate() error {
+	return m.validate(false)
+}
+
+// ValidateAll checks the field values on GetQueryCacheStatsResponse with the
+// rules defined in the proto definition for this message. If any rules are
+// violated, the result is a list of violation errors wrapped in
+// GetQueryCacheStatsResponseMultiError, or nil if none found.
+func (m *GetQueryCacheStatsResponse) ValidateAll() error {
+	return m.validate(true)
+}
+
+func (m *GetQueryCacheStatsResponse) validate(all bool) error {
+	if m == nil {
+		return nil
+	}
+
+	var errors []error
+
+	// no validation rules for Hits
+
+	// no validation rules for Misses
+
+	// no validation rules for Items
+
+	// no validation rules for SizeBytes
+
+	if len(errors) > 0 {
+		return GetQueryCacheStatsResponseMultiError(errors)
+	}
+
+	return nil
+}
+
+// GetQueryCacheStatsResponseMultiError is an error wrapping multiple
+// validation errors returned by GetQueryCacheStatsResponse.ValidateAll() if
+// the designated constraints aren't met.
+type GetQueryCacheStatsResponseMultiError []error
+
+// Error returns a concatenation of all the error messages it wraps.
+func (m GetQueryCacheStatsResponseMultiError) Error() string {
+	var msgs []string
+	for _, err := range m {
+		msgs = append(msgs, err.Error())
+	}
+	return strings.Join(msgs, "; ")
+}
+
+// AllErrors returns a list of validation violation errors.
+func (m GetQueryCacheStatsResponseMultiError) AllErrors() []error { return m }
+
+// GetQueryCacheStatsResponseValidationError is the validation error returned
+// by GetQueryCacheStatsResponse.Validate if the designated constraints aren't met.
+type GetQueryCacheStatsResponseValidationError struct {
+	field  string
+	reason string
+	cause  error
+	key    bool
+}
+
+// Field function returns field value.
+func (e GetQueryCacheStatsResponseValidationError) Field() string { return e.field }
+
+// Reason function returns reason value.
+func (e GetQueryCacheStatsResponseValidationError) Reason() string { return e.reason }
+
+// Cause function returns cause value.
+func (e GetQueryCacheStatsResponseValidationError) Cause() error { return e.cause }
+
+// Key function returns key value.
+func (e GetQueryCacheStatsResponseValidationError) Key() bool { return e.key }
+
+// ErrorName returns error name.
+func (e GetQueryCacheStatsResponseValidationError) ErrorName() string {
+	return "GetQueryCacheStatsResponseValidationError"
+}
+
+// Error satisfies the builtin error interface
+func (e GetQueryCacheStatsResponseValidationError) Error() string {
+	cause := ""
+	if e.cause != nil {
+		cause = fmt.Sprintf(" | caused by: %v", e.cause)
+	}
+
+	key := ""
+	if e.key {
+		key = "key for "
+	}
+
+	return fmt.Sprintf(
+		"invalid %sGetQueryCacheStatsResponse.%s: %s%s",
+		key,
+		e.field,
+		e.reason,
+		cause)
+}
+
+var _ error = GetQueryCacheStatsResponseValidationError{}
+
+var _ interface {
+	Field() string
+	Reason() string
+	Key() bool
+	Cause() error
+	ErrorName() string
+} = GetQueryCacheStatsResponseValidationError{}
+
+// Validate checks the field values on ClearQueryCacheRequest with the rules
+// defined in the proto definition for this message. If any rules are
+// violated, the first error encountered is returned, or nil if there are no violations.
+func (m *ClearQueryCacheRequest) Validate() error {
+	return m.validate(false)
+}
+
+// ValidateAll checks the field values on ClearQueryCacheRequest with the rules
+// defined in the proto definition for this message. If any rules are
+// violated, the result is a list of violation errors wrapped in
+// ClearQueryCacheRequestMultiError, or nil if none found.
+func (m *ClearQueryCacheRequest) ValidateAll() error {
+	return m.validate(true)
+}
+
+func (m *ClearQueryCacheRequest) validate(all bool) error {
+	if m == nil {
+		return nil
+	}
+
+	var errors []error
+
+	if !_ClearQueryCacheRequest_InstanceId_Pattern.MatchString(m.GetInstanceId()) {
+		err := ClearQueryCacheRequestValidationError{
+			field:  "InstanceId",
+			reason: "value does not match regex pattern \"^[_\\\\-a-zA-Z0-9]+$\"",
+		}
+		if !all {
+			return err
+		}
+		errors = append(errors, err)
+	}
+
+	if len(errors) > 0 {
+		return ClearQueryCacheRequestMultiError(errors)
+	}
+
+	return nil
+}
+
+// ClearQueryCacheRequestMultiError is an error wrapping multiple validation
+// errors returned by ClearQueryCacheRequest.ValidateAll() if the designated
+// constraints aren't met.
+type ClearQueryCacheRequestMultiError []error
+
+// Error returns a concatenation of all the error messages it wraps.
+func (m ClearQueryCacheRequestMultiError) Error() string {
+	var msgs []string
+	for _, err := range m {
+		msgs = append(msgs, err.Error())
+	}
+	return strings.Join(msgs, "; ")
+}
+
+// AllErrors returns a list of validation violation errors.
+func (m ClearQueryCacheRequestMultiError) AllErrors() []error { return m }
+
+// ClearQueryCacheRequestValidationError is the validation error returned by
+// ClearQueryCacheRequest.Validate if the designated constraints aren't met.
+type ClearQueryCacheRequestValidationError struct {
+	field  string
+	reason string
+	cause  error
+	key    bool
+}
+
+// Field function returns field value.
+func (e ClearQueryCacheRequestValidationError) Field() string { return e.field }
+
+// Reason function returns reason value.
+func (e ClearQueryCacheRequestValidationError) Reason() string { return e.reason }
+
+// Cause function returns cause value.
+func (e ClearQueryCacheRequestValidationError) Cause() error { return e.cause }
+
+// Key function returns key value.
+func (e ClearQueryCacheRequestValidationError) Key() bool { return e.key }
+
+// ErrorName returns error name.
+func (e ClearQueryCacheRequestValidationError) ErrorName() string {
+	return "ClearQueryCacheRequestValidationError"
+}
+
+// Error satisfies the builtin error interface
+func (e ClearQueryCacheRequestValidationError) Error() string {
+	cause := ""
+	if e.cause != nil {
+		cause = fmt.Sprintf(" | caused by: %v", e.cause)
+	}
+
+	key := ""
+	if e.key {
+		key = "key for "
+	}
+
+	return fmt.Sprintf(
+		"invalid %sClearQueryCacheRequest.%s: %s%s",
+		key,
+		e.field,
+		e.reason,
+		cause)
+}
+
+var _ error = ClearQueryCacheRequestValidationError{}
+
+var _ interface {
+	Field() string
+	Reason() string
+	Key() bool
+	Cause() error
+	ErrorName() string
+} = ClearQueryCacheRequestValidationError{}
+
+var _ClearQueryCacheRequest_InstanceId_Pattern = regexp.MustCompile("^[_\\-a-zA-Z0-9]+$")
+
+// Validate checks the field values on ClearQueryCacheResponse with the rules
+// defined in the proto definition for this message. If any rules are
+// violated, the first error encountered is returned, or nil if there are no violations.
+func (m *ClearQueryCacheResponse) Validate() error {
+	return m.validate(false)
+}
+
+// ValidateAll checks the field values on ClearQueryCacheResponse with the
+// rules defined in the proto definition for this message. If any rules are
+// violated, the result is a list of violation errors wrapped in
+// ClearQueryCacheResponseMultiError, or nil if none found.
+func (m *ClearQueryCacheResponse) ValidateAll() error {
+	return m.validate(true)
+}
+
+func (m *ClearQueryCacheResponse) validate(all bool) error {
+	if m == nil {
+		return nil
+	}
+
+	var errors []error
+
+	if len(errors) > 0 {
+		return ClearQueryCacheResponseMultiError(errors)
+	}
+
+	return nil
+}
+
+// ClearQueryCacheResponseMultiError is an error wrapping multiple validation
+// errors returned by ClearQueryCacheResponse.ValidateAll() if the designated
+// constraints aren't met.
+type ClearQueryCacheResponseMultiError []error
+
+// Error returns a concatenation of all the error messages it wraps.
+func (m ClearQueryCacheResponseMultiError) Error() string {
+	var msgs []string
+	for _, err := range m {
+		msgs = append(msgs, err.Error())
+	}
+	return strings.Join(msgs, "; ")
+}
+
+// AllErrors returns a list of validation violation errors.
+func (m ClearQueryCacheResponseMultiError) AllErrors() []error { return m }
+
+// ClearQueryCacheResponseValidationError is the validation error returned by
+// ClearQueryCacheResponse.Validate if the designated constraints aren't met.
+type ClearQueryCacheResponseValidationError struct {
+	field  string
+	reason string
+	cause  error
+	key    bool
+}
+
+// Field function returns field value.
+func (e ClearQueryCacheResponseValidationError) Field() string { return e.field }
+
+// Reason function returns reason value.
+func (e ClearQueryCacheResponseValidationError) Reason() string { return e.reason }
+
+// Cause function returns cause value.
+func (e ClearQueryCacheResponseValidationError) Cause() error { return e.cause }
+
+// Key function returns key value.
+func (e ClearQueryCacheResponseValidationError) Key() bool { return e.key }
+
+// ErrorName returns error name.
+func (e ClearQueryCacheResponseValidationError) ErrorName() string {
+	return "ClearQueryCacheResponseValidationError"
+}
+
+// Error satisfies the builtin error interface
+func (e ClearQueryCacheResponseValidationError) Error() string {
+	cause := ""
+	if e.cause != nil {
+		cause = fmt.Sprintf(" | caused by: %v", e.cause)
+	}
+
+	key := ""
+	if e.key {
+		key = "key for "
+	}
+
+	return fmt.Sprintf(
+		"invalid %sClearQueryCacheResponse.%s: %s%s",
+		key,
+		e.field,
+		e.reason,
+		cause)
+}
+
+var _ error = ClearQueryCacheResponseValidationError{}
+
+var _ interface {
+	Field() string
+	Reason() string
+	Key() bool
+	Cause() error
+	ErrorName() string
+} = ClearQueryCacheResponseValidationError{}
+
 // Validate checks the field values on EditInstanceRequest with the rules
 // defined in the proto definition for this message. If any rules are
 // violated, the first error encountered is returned, or nil if there are no violations.
@@ -1617,6 +2067,14 @@ func (m *EditInstanceRequest) validate(all bool) error {
 		// no validation rules for IngestionLimitBytes
 	}
 
+	if m.ExportLimitRows != nil {
+		// no validation rules for ExportLimitRows
+	}
+
+	if m.ExportLimitBytes != nil {
+		// no validation rules for ExportLimitBytes
+	}
+
 	if len(errors) > 0 {
 		return EditInstanceRequestMultiError(errors)
 	}
@@ -4703,6 +5161,19 @@ func (m *GetLogsRequest) validate(all bool) error {
 
 	// no validation rules for Ascending
 
+	// no validation rules for Level
+
+	if m.GetLimit() < 0 {
+		err := GetLogsRequestValidationError{
+			field:  "Limit",
+			reason: "value must be greater than or equal to 0",
+		}
+		if !all {
+			return err
+		}
+		errors = append(errors, err)
+	}
+
 	if len(errors) > 0 {
 		return GetLogsRequestMultiError(errors)
 	}
@@ -4941,6 +5412,19 @@ func (m *WatchLogsRequest) validate(all bool) error {
 
 	// no validation rules for Replay
 
+	// no validation rules for Level
+
+	if m.GetReplayLimit() < 0 {
+		err := WatchLogsRequestValidationError{
+			field:  "ReplayLimit",
+			reason: "value must be greater than or equal to 0",
+		}
+		if !all {
+			return err
+		}
+		errors = append(errors, err)
+	}
+
 	if len(errors) > 0 {
 		return WatchLogsRequestMultiError(errors)
 	}
@@ -27,6 +27,8 @@ const (
 	RuntimeService_EditInstanceVariables_FullMethodName   = "/rill.runtime.v1.RuntimeService/EditInstanceVariables"
 	RuntimeService_EditInstanceAnnotations_FullMethodName = "/rill.runtime.v1.RuntimeService/EditInstanceAnnotations"
 	RuntimeService_DeleteInstance_FullMethodName          = "/rill.runtime.v1.RuntimeService/DeleteInstance"
+	RuntimeService_GetQueryCacheStats_FullMethodName      = "/rill.runtime.v1.RuntimeService/GetQueryCacheStats"
+	RuntimeService_ClearQueryCache_FullMethodName         = "/rill.runtime.v1.RuntimeService/ClearQueryCache"
 	RuntimeService_ListFiles_FullMethodName               = "/rill.runtime.v1.RuntimeService/ListFiles"
 	RuntimeService_WatchFiles_FullMethodName              = "/rill.runtime.v1.RuntimeService/WatchFiles"
 	RuntimeService_GetFile_FullMethodName                 = "/rill.runtime.v1.RuntimeService/GetFile"
@@ -74,6 +76,10 @@ type RuntimeServiceClient interface {
 	EditInstanceAnnotations(ctx context.Context, in *EditInstanceAnnotationsRequest, opts ...grpc.CallOption) (*EditInstanceAnnotationsResponse, error)
 	// DeleteInstance deletes an instance
 	DeleteInstance(ctx context.Context, in *DeleteInstanceRequest, opts ...grpc.CallOption) (*DeleteInstanceResponse, error)
+	// GetQueryCacheStats returns statistics about the cached query results of an instance
+	GetQueryCacheStats(ctx context.Context, in *GetQueryCacheStatsRequest, opts ...grpc.CallOption) (*GetQueryCacheStatsResponse, error)
+	// ClearQueryCache clears the cached query results of an instance
+	ClearQueryCache(ctx context.Context, in *ClearQueryCacheRequest, opts ...grpc.CallOption) (*ClearQueryCacheResponse, error)
 	// ListFiles lists all the files matching a glob in a repo.
 	// The files are sorted by their full path.
 	ListFiles(ctx context.Context, in *ListFilesRequest, opts ...grpc.CallOption) (*ListFilesResponse, error)
@@ -215,6 +221,24 @@ func (c *runtimeServiceClient) DeleteInstance(ctx context.Context, in *DeleteIns
 	return out, nil
 }
 
+func (c *runtimeServiceClient) GetQueryCacheStats(ctx context.Context, in *GetQueryCacheStatsRequest, opts ...grpc.CallOption) (*GetQueryCacheStatsResponse, error) {
+	out := new(GetQueryCacheStatsResponse)
+	err := c.cc.Invoke(ctx, RuntimeService_GetQueryCacheStats_FullMethodName, in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *runtimeServiceClient) ClearQueryCache(ctx context.Context, in *ClearQueryCacheRequest, opts ...grpc.CallOption) (*ClearQueryCacheResponse, error) {
+	out := new(ClearQueryCacheResponse)
+	err := c.cc.Invoke(ctx, RuntimeService_ClearQueryCache_FullMethodName, in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
 func (c *runtimeServiceClient) ListFiles(ctx context.Context, in *ListFilesRequest, opts ...grpc.CallOption) (*ListFilesResponse, error) {
 	out := new(ListFilesResponse)
 	err := c.cc.Invoke(ctx, RuntimeService_ListFiles_FullMethodName, in, out, opts...)
@@ -529,6 +553,10 @@ type RuntimeServiceServer interface {
 	EditInstanceAnnotations(context.Context, *EditInstanceAnnotationsRequest) (*EditInstanceAnnotationsResponse, error)
 	// DeleteInstance deletes an instance
 	DeleteInstance(context.Context, *DeleteInstanceRequest) (*DeleteInstanceResponse, error)
+	// GetQueryCacheStats returns statistics about the cached query results of an instance
+	GetQueryCacheStats(context.Context, *GetQueryCacheStatsRequest) (*GetQueryCacheStatsResponse, error)
+	// ClearQueryCache clears the cached query results of an instance
+	ClearQueryCache(context.Context, *ClearQueryCacheRequest) (*ClearQueryCacheResponse, error)
 	// ListFiles lists all the files matching a glob in a repo.
 	// The files are sorted by their full path.
 	ListFiles(context.Context, *ListFilesRequest) (*ListFilesResponse, error)
@@ -619,6 +647,12 @@ func (UnimplementedRuntimeServiceServer) EditInstanceAnnotations(context.Context
 func (UnimplementedRuntimeServiceServer) DeleteInstance(context.Context, *DeleteInstanceRequest) (*DeleteInstanceResponse, error) {
 	return nil, status.Errorf(codes.Unimplemented, "method DeleteInstance not implemented")
 }
+func (UnimplementedRuntimeServiceServer) GetQueryCacheStats(context.Context, *GetQueryCacheStatsRequest) (*GetQueryCacheStatsResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method GetQueryCacheStats not implemented")
+}
+func (UnimplementedRuntimeServiceServer) ClearQueryCache(context.Context, *ClearQueryCacheRequest) (*ClearQueryCacheResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method ClearQueryCache not implemented")
+}
 func (UnimplementedRuntimeServiceServer) ListFiles(context.Context, *ListFilesRequest) (*ListFilesResponse, error) {
 	return nil, status.Errorf(codes.Unimplemented, "method ListFiles not implemented")
 }
@@ -851,6 +885,42 @@ func _RuntimeService_DeleteInstance_Handler(srv interface{}, ctx context.Context
 	return interceptor(ctx, in, info, handler)
 }
 
+func _RuntimeService_GetQueryCacheStats_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(GetQueryCacheStatsRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(RuntimeServiceServer).GetQueryCacheStats(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: RuntimeService_GetQueryCacheStats_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(RuntimeServiceServer).GetQueryCacheStats(ctx, req.(*GetQueryCacheStatsRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _RuntimeService_ClearQueryCache_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(ClearQueryCacheRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(RuntimeServiceServer).ClearQueryCache(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: RuntimeService_ClearQueryCache_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(RuntimeServiceServer).ClearQueryCache(ctx, req.(*ClearQueryCacheRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
 func _RuntimeService_ListFiles_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
 	in := new(ListFilesRequest)
 	if err := dec(in); err != nil {
@@ -1349,6 +1419,14 @@ var RuntimeService_ServiceDesc = grpc.ServiceDesc{
 			MethodName: "DeleteInstance",
 			Handler:    _RuntimeService_DeleteInstance_Handler,
 		},
+		{
+			MethodName: "GetQueryCacheStats",
+			Handler:    _RuntimeService_GetQueryCacheStats_Handler,
+		},
+		{
+			MethodName: "ClearQueryCache",
+			Handler:    _RuntimeService_ClearQueryCache_Handler,
+		},
 		{
 			MethodName: "ListFiles",
 			Handler:    _RuntimeService_ListFiles_Handler,
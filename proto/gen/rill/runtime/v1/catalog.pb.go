@@ -173,6 +173,136 @@ func (Model_Dialect) EnumDescriptor() ([]byte, []int) {
 	return file_rill_runtime_v1_catalog_proto_rawDescGZIP(), []int{2, 0}
 }
 
+type MetricsView_Measure_Kind int32
+
+const (
+	MetricsView_Measure_KIND_UNSPECIFIED MetricsView_Measure_Kind = 0
+	// Number of distinct values of the column
+	MetricsView_Measure_KIND_COUNT_DISTINCT MetricsView_Measure_Kind = 1
+	// Percentile of the values of the column
+	MetricsView_Measure_KIND_PERCENTILE MetricsView_Measure_Kind = 2
+	// Median of the values of the column
+	MetricsView_Measure_KIND_MEDIAN MetricsView_Measure_Kind = 3
+	// Smallest value of the column
+	MetricsView_Measure_KIND_MIN MetricsView_Measure_Kind = 4
+	// Largest value of the column
+	MetricsView_Measure_KIND_MAX MetricsView_Measure_Kind = 5
+)
+
+// Enum value maps for MetricsView_Measure_Kind.
+var (
+	MetricsView_Measure_Kind_name = map[int32]string{
+		0: "KIND_UNSPECIFIED",
+		1: "KIND_COUNT_DISTINCT",
+		2: "KIND_PERCENTILE",
+		3: "KIND_MEDIAN",
+		4: "KIND_MIN",
+		5: "KIND_MAX",
+	}
+	MetricsView_Measure_Kind_value = map[string]int32{
+		"KIND_UNSPECIFIED":    0,
+		"KIND_COUNT_DISTINCT": 1,
+		"KIND_PERCENTILE":     2,
+		"KIND_MEDIAN":         3,
+		"KIND_MIN":            4,
+		"KIND_MAX":            5,
+	}
+)
+
+func (x MetricsView_Measure_Kind) Enum() *MetricsView_Measure_Kind {
+	p := new(MetricsView_Measure_Kind)
+	*p = x
+	return p
+}
+
+func (x MetricsView_Measure_Kind) String() string {
+	return protoimpl.X.EnumStringOf(x.Descriptor(), protoreflect.EnumNumber(x))
+}
+
+func (MetricsView_Measure_Kind) Descriptor() protoreflect.EnumDescriptor {
+	return file_rill_runtime_v1_catalog_proto_enumTypes[3].Descriptor()
+}
+
+func (MetricsView_Measure_Kind) Type() protoreflect.EnumType {
+	return &file_rill_runtime_v1_catalog_proto_enumTypes[3]
+}
+
+func (x MetricsView_Measure_Kind) Number() protoreflect.EnumNumber {
+	return protoreflect.EnumNumber(x)
+}
+
+// Deprecated: Use MetricsView_Measure_Kind.Descriptor instead.
+func (MetricsView_Measure_Kind) EnumDescriptor() ([]byte, []int) {
+	return file_rill_runtime_v1_catalog_proto_rawDescGZIP(), []int{3, 1, 0}
+}
+
+type MetricsView_MeasureWindow_Type int32
+
+const (
+	MetricsView_MeasureWindow_TYPE_UNSPECIFIED MetricsView_MeasureWindow_Type = 0
+	// Sum of the last size time buckets
+	MetricsView_MeasureWindow_TYPE_ROLLING_SUM MetricsView_MeasureWindow_Type = 1
+	// Average of the last size time buckets
+	MetricsView_MeasureWindow_TYPE_ROLLING_AVG MetricsView_MeasureWindow_Type = 2
+	// Running total from the start of the requested time range
+	MetricsView_MeasureWindow_TYPE_CUMULATIVE_SUM MetricsView_MeasureWindow_Type = 3
+	// Value size time buckets earlier
+	MetricsView_MeasureWindow_TYPE_LAG MetricsView_MeasureWindow_Type = 4
+	// Difference to the value size time buckets earlier
+	MetricsView_MeasureWindow_TYPE_DELTA_ABS MetricsView_MeasureWindow_Type = 5
+	// Relative difference to the value size time buckets earlier
+	MetricsView_MeasureWindow_TYPE_DELTA_REL MetricsView_MeasureWindow_Type = 6
+)
+
+// Enum value maps for MetricsView_MeasureWindow_Type.
+var (
+	MetricsView_MeasureWindow_Type_name = map[int32]string{
+		0: "TYPE_UNSPECIFIED",
+		1: "TYPE_ROLLING_SUM",
+		2: "TYPE_ROLLING_AVG",
+		3: "TYPE_CUMULATIVE_SUM",
+		4: "TYPE_LAG",
+		5: "TYPE_DELTA_ABS",
+		6: "TYPE_DELTA_REL",
+	}
+	MetricsView_MeasureWindow_Type_value = map[string]int32{
+		"TYPE_UNSPECIFIED":    0,
+		"TYPE_ROLLING_SUM":    1,
+		"TYPE_ROLLING_AVG":    2,
+		"TYPE_CUMULATIVE_SUM": 3,
+		"TYPE_LAG":            4,
+		"TYPE_DELTA_ABS":      5,
+		"TYPE_DELTA_REL":      6,
+	}
+)
+
+func (x MetricsView_MeasureWindow_Type) Enum() *MetricsView_MeasureWindow_Type {
+	p := new(MetricsView_MeasureWindow_Type)
+	*p = x
+	return p
+}
+
+func (x MetricsView_MeasureWindow_Type) String() string {
+	return protoimpl.X.EnumStringOf(x.Descriptor(), protoreflect.EnumNumber(x))
+}
+
+func (MetricsView_MeasureWindow_Type) Descriptor() protoreflect.EnumDescriptor {
+	return file_rill_runtime_v1_catalog_proto_enumTypes[4].Descriptor()
+}
+
+func (MetricsView_MeasureWindow_Type) Type() protoreflect.EnumType {
+	return &file_rill_runtime_v1_catalog_proto_enumTypes[4]
+}
+
+func (x MetricsView_MeasureWindow_Type) Number() protoreflect.EnumNumber {
+	return protoreflect.EnumNumber(x)
+}
+
+// Deprecated: Use MetricsView_MeasureWindow_Type.Descriptor instead.
+func (MetricsView_MeasureWindow_Type) EnumDescriptor() ([]byte, []int) {
+	return file_rill_runtime_v1_catalog_proto_rawDescGZIP(), []int{3, 2, 0}
+}
+
 // Table represents a table in the OLAP database. These include pre-existing tables discovered by periodically
 // scanning the database's information schema when the instance is created with exposed=true. Pre-existing tables
 // have managed = false.
@@ -448,6 +578,12 @@ type MetricsView struct {
 	DefaultTimeRange string `protobuf:"bytes,10,opt,name=default_time_range,json=defaultTimeRange,proto3" json:"default_time_range,omitempty"`
 	// Available time zones list preferred time zones using IANA location identifiers.
 	AvailableTimeZones []string `protobuf:"bytes,11,rep,name=available_time_zones,json=availableTimeZones,proto3" json:"available_time_zones,omitempty"`
+	// Security policy for the metrics view
+	Security *MetricsView_Security `protobuf:"bytes,12,opt,name=security,proto3" json:"security,omitempty"`
+	// ISO day of the week that weeks start on (1 = Monday, 7 = Sunday). Defaults to Monday if unset.
+	FirstDayOfWeek uint32 `protobuf:"varint,13,opt,name=first_day_of_week,json=firstDayOfWeek,proto3" json:"first_day_of_week,omitempty"`
+	// Month that years and quarters start on (1 = January, 12 = December). Defaults to January if unset.
+	FirstMonthOfYear uint32 `protobuf:"varint,14,opt,name=first_month_of_year,json=firstMonthOfYear,proto3" json:"first_month_of_year,omitempty"`
 }
 
 func (x *MetricsView) Reset() {
@@ -552,6 +688,27 @@ func (x *MetricsView) GetAvailableTimeZones() []string {
 	return nil
 }
 
+func (x *MetricsView) GetSecurity() *MetricsView_Security {
+	if x != nil {
+		return x.Security
+	}
+	return nil
+}
+
+func (x *MetricsView) GetFirstDayOfWeek() uint32 {
+	if x != nil {
+		return x.FirstDayOfWeek
+	}
+	return 0
+}
+
+func (x *MetricsView) GetFirstMonthOfYear() uint32 {
+	if x != nil {
+		return x.FirstMonthOfYear
+	}
+	return 0
+}
+
 // Extract policy for glob connectors
 type Source_ExtractPolicy struct {
 	state         protoimpl.MessageState
@@ -713,6 +870,21 @@ type MetricsView_Measure struct {
 	Description         string `protobuf:"bytes,4,opt,name=description,proto3" json:"description,omitempty"`
 	Format              string `protobuf:"bytes,5,opt,name=format,proto3" json:"format,omitempty"`
 	ValidPercentOfTotal bool   `protobuf:"varint,6,opt,name=valid_percent_of_total,json=validPercentOfTotal,proto3" json:"valid_percent_of_total,omitempty"`
+	// Names of other measures referenced in the expression.
+	// The references are expanded to the referenced measures' expressions at query time.
+	Requires []string `protobuf:"bytes,7,rep,name=requires,proto3" json:"requires,omitempty"`
+	// Window is set for measures computed from another measure across adjacent time buckets.
+	// Window measures don't have an expression and are only available in time series queries.
+	Window *MetricsView_MeasureWindow `protobuf:"bytes,8,opt,name=window,proto3" json:"window,omitempty"`
+	// Kind is set for typed measures, which aggregate a column with a function compiled to the SQL dialect of the OLAP store.
+	// Typed measures don't have an expression.
+	Kind MetricsView_Measure_Kind `protobuf:"varint,9,opt,name=kind,proto3,enum=rill.runtime.v1.MetricsView_Measure_Kind" json:"kind,omitempty"`
+	// Column aggregated by a typed measure
+	Column string `protobuf:"bytes,10,opt,name=column,proto3" json:"column,omitempty"`
+	// Approximate selects the approximate variant of the aggregation (e.g. sketches) where the dialect supports it
+	Approximate bool `protobuf:"varint,11,opt,name=approximate,proto3" json:"approximate,omitempty"`
+	// Percentile computed by a percentile measure, between 0 and 1
+	Percentile float64 `protobuf:"fixed64,12,opt,name=percentile,proto3" json:"percentile,omitempty"`
 }
 
 func (x *MetricsView_Measure) Reset() {
@@ -789,6 +961,249 @@ func (x *MetricsView_Measure) GetValidPercentOfTotal() bool {
 	return false
 }
 
+func (x *MetricsView_Measure) GetRequires() []string {
+	if x != nil {
+		return x.Requires
+	}
+	return nil
+}
+
+func (x *MetricsView_Measure) GetWindow() *MetricsView_MeasureWindow {
+	if x != nil {
+		return x.Window
+	}
+	return nil
+}
+
+func (x *MetricsView_Measure) GetKind() MetricsView_Measure_Kind {
+	if x != nil {
+		return x.Kind
+	}
+	return MetricsView_Measure_KIND_UNSPECIFIED
+}
+
+func (x *MetricsView_Measure) GetColumn() string {
+	if x != nil {
+		return x.Column
+	}
+	return ""
+}
+
+func (x *MetricsView_Measure) GetApproximate() bool {
+	if x != nil {
+		return x.Approximate
+	}
+	return false
+}
+
+func (x *MetricsView_Measure) GetPercentile() float64 {
+	if x != nil {
+		return x.Percentile
+	}
+	return 0
+}
+
+// Window function applied to a measure in time series queries
+type MetricsView_MeasureWindow struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	// Name of the measure the window is computed over
+	Measure string                         `protobuf:"bytes,1,opt,name=measure,proto3" json:"measure,omitempty"`
+	Type    MetricsView_MeasureWindow_Type `protobuf:"varint,2,opt,name=type,proto3,enum=rill.runtime.v1.MetricsView_MeasureWindow_Type" json:"type,omitempty"`
+	// Number of time buckets (of the requested time grain) in the window or to look back
+	Size uint32 `protobuf:"varint,3,opt,name=size,proto3" json:"size,omitempty"`
+}
+
+func (x *MetricsView_MeasureWindow) Reset() {
+	*x = MetricsView_MeasureWindow{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_rill_runtime_v1_catalog_proto_msgTypes[7]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *MetricsView_MeasureWindow) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*MetricsView_MeasureWindow) ProtoMessage() {}
+
+func (x *MetricsView_MeasureWindow) ProtoReflect() protoreflect.Message {
+	mi := &file_rill_runtime_v1_catalog_proto_msgTypes[7]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use MetricsView_MeasureWindow.ProtoReflect.Descriptor instead.
+func (*MetricsView_MeasureWindow) Descriptor() ([]byte, []int) {
+	return file_rill_runtime_v1_catalog_proto_rawDescGZIP(), []int{3, 2}
+}
+
+func (x *MetricsView_MeasureWindow) GetMeasure() string {
+	if x != nil {
+		return x.Measure
+	}
+	return ""
+}
+
+func (x *MetricsView_MeasureWindow) GetType() MetricsView_MeasureWindow_Type {
+	if x != nil {
+		return x.Type
+	}
+	return MetricsView_MeasureWindow_TYPE_UNSPECIFIED
+}
+
+func (x *MetricsView_MeasureWindow) GetSize() uint32 {
+	if x != nil {
+		return x.Size
+	}
+	return 0
+}
+
+// Security policy for the metrics view.
+// The conditions are templates resolved against the requester's claims.
+type MetricsView_Security struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	// Dashboard level access condition. It must resolve to a boolean.
+	Access string `protobuf:"bytes,1,opt,name=access,proto3" json:"access,omitempty"`
+	// Row level filter expression injected into the WHERE clause of every query
+	RowFilter string `protobuf:"bytes,2,opt,name=row_filter,json=rowFilter,proto3" json:"row_filter,omitempty"`
+	// Dimensions and measures to include (if any include conditions are set, other fields are excluded)
+	Include []*MetricsView_FieldCondition `protobuf:"bytes,3,rep,name=include,proto3" json:"include,omitempty"`
+	// Dimensions and measures to exclude
+	Exclude []*MetricsView_FieldCondition `protobuf:"bytes,4,rep,name=exclude,proto3" json:"exclude,omitempty"`
+}
+
+func (x *MetricsView_Security) Reset() {
+	*x = MetricsView_Security{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_rill_runtime_v1_catalog_proto_msgTypes[8]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *MetricsView_Security) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*MetricsView_Security) ProtoMessage() {}
+
+func (x *MetricsView_Security) ProtoReflect() protoreflect.Message {
+	mi := &file_rill_runtime_v1_catalog_proto_msgTypes[8]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use MetricsView_Security.ProtoReflect.Descriptor instead.
+func (*MetricsView_Security) Descriptor() ([]byte, []int) {
+	return file_rill_runtime_v1_catalog_proto_rawDescGZIP(), []int{3, 3}
+}
+
+func (x *MetricsView_Security) GetAccess() string {
+	if x != nil {
+		return x.Access
+	}
+	return ""
+}
+
+func (x *MetricsView_Security) GetRowFilter() string {
+	if x != nil {
+		return x.RowFilter
+	}
+	return ""
+}
+
+func (x *MetricsView_Security) GetInclude() []*MetricsView_FieldCondition {
+	if x != nil {
+		return x.Include
+	}
+	return nil
+}
+
+func (x *MetricsView_Security) GetExclude() []*MetricsView_FieldCondition {
+	if x != nil {
+		return x.Exclude
+	}
+	return nil
+}
+
+// Condition for including or excluding dimensions and measures.
+type MetricsView_FieldCondition struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	// Condition that must resolve to a boolean
+	Condition string `protobuf:"bytes,1,opt,name=condition,proto3" json:"condition,omitempty"`
+	// Names of the dimensions and measures the condition applies to
+	Names []string `protobuf:"bytes,2,rep,name=names,proto3" json:"names,omitempty"`
+}
+
+func (x *MetricsView_FieldCondition) Reset() {
+	*x = MetricsView_FieldCondition{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_rill_runtime_v1_catalog_proto_msgTypes[9]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *MetricsView_FieldCondition) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*MetricsView_FieldCondition) ProtoMessage() {}
+
+func (x *MetricsView_FieldCondition) ProtoReflect() protoreflect.Message {
+	mi := &file_rill_runtime_v1_catalog_proto_msgTypes[9]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use MetricsView_FieldCondition.ProtoReflect.Descriptor instead.
+func (*MetricsView_FieldCondition) Descriptor() ([]byte, []int) {
+	return file_rill_runtime_v1_catalog_proto_rawDescGZIP(), []int{3, 4}
+}
+
+func (x *MetricsView_FieldCondition) GetCondition() string {
+	if x != nil {
+		return x.Condition
+	}
+	return ""
+}
+
+func (x *MetricsView_FieldCondition) GetNames() []string {
+	if x != nil {
+		return x.Names
+	}
+	return nil
+}
+
 var File_rill_runtime_v1_catalog_proto protoreflect.FileDescriptor
 
 var file_rill_runtime_v1_catalog_proto_rawDesc = []byte{
@@ -861,7 +1276,7 @@ var file_rill_runtime_v1_catalog_proto_rawDesc = []byte{
 	0x69, 0x7a, 0x65, 0x22, 0x36, 0x0a, 0x07, 0x44, 0x69, 0x61, 0x6c, 0x65, 0x63, 0x74, 0x12, 0x17,
 	0x0a, 0x13, 0x44, 0x49, 0x41, 0x4c, 0x45, 0x43, 0x54, 0x5f, 0x55, 0x4e, 0x53, 0x50, 0x45, 0x43,
 	0x49, 0x46, 0x49, 0x45, 0x44, 0x10, 0x00, 0x12, 0x12, 0x0a, 0x0e, 0x44, 0x49, 0x41, 0x4c, 0x45,
-	0x43, 0x54, 0x5f, 0x44, 0x55, 0x43, 0x4b, 0x44, 0x42, 0x10, 0x01, 0x22, 0x82, 0x06, 0x0a, 0x0b,
+	0x43, 0x54, 0x5f, 0x44, 0x55, 0x43, 0x4b, 0x44, 0x42, 0x10, 0x01, 0x22, 0xc8, 0x0e, 0x0a, 0x0b,
 	0x4d, 0x65, 0x74, 0x72, 0x69, 0x63, 0x73, 0x56, 0x69, 0x65, 0x77, 0x12, 0x12, 0x0a, 0x04, 0x6e,
 	0x61, 0x6d, 0x65, 0x18, 0x01, 0x20, 0x01, 0x28, 0x09, 0x52, 0x04, 0x6e, 0x61, 0x6d, 0x65, 0x12,
 	0x14, 0x0a, 0x05, 0x6d, 0x6f, 0x64, 0x65, 0x6c, 0x18, 0x02, 0x20, 0x01, 0x28, 0x09, 0x52, 0x05,
@@ -890,47 +1305,116 @@ var file_rill_runtime_v1_catalog_proto_rawDesc = []byte{
 	0x69, 0x6d, 0x65, 0x52, 0x61, 0x6e, 0x67, 0x65, 0x12, 0x30, 0x0a, 0x14, 0x61, 0x76, 0x61, 0x69,
 	0x6c, 0x61, 0x62, 0x6c, 0x65, 0x5f, 0x74, 0x69, 0x6d, 0x65, 0x5f, 0x7a, 0x6f, 0x6e, 0x65, 0x73,
 	0x18, 0x0b, 0x20, 0x03, 0x28, 0x09, 0x52, 0x12, 0x61, 0x76, 0x61, 0x69, 0x6c, 0x61, 0x62, 0x6c,
-	0x65, 0x54, 0x69, 0x6d, 0x65, 0x5a, 0x6f, 0x6e, 0x65, 0x73, 0x1a, 0x6f, 0x0a, 0x09, 0x44, 0x69,
-	0x6d, 0x65, 0x6e, 0x73, 0x69, 0x6f, 0x6e, 0x12, 0x12, 0x0a, 0x04, 0x6e, 0x61, 0x6d, 0x65, 0x18,
-	0x01, 0x20, 0x01, 0x28, 0x09, 0x52, 0x04, 0x6e, 0x61, 0x6d, 0x65, 0x12, 0x14, 0x0a, 0x05, 0x6c,
-	0x61, 0x62, 0x65, 0x6c, 0x18, 0x02, 0x20, 0x01, 0x28, 0x09, 0x52, 0x05, 0x6c, 0x61, 0x62, 0x65,
-	0x6c, 0x12, 0x20, 0x0a, 0x0b, 0x64, 0x65, 0x73, 0x63, 0x72, 0x69, 0x70, 0x74, 0x69, 0x6f, 0x6e,
-	0x18, 0x03, 0x20, 0x01, 0x28, 0x09, 0x52, 0x0b, 0x64, 0x65, 0x73, 0x63, 0x72, 0x69, 0x70, 0x74,
-	0x69, 0x6f, 0x6e, 0x12, 0x16, 0x0a, 0x06, 0x63, 0x6f, 0x6c, 0x75, 0x6d, 0x6e, 0x18, 0x04, 0x20,
-	0x01, 0x28, 0x09, 0x52, 0x06, 0x63, 0x6f, 0x6c, 0x75, 0x6d, 0x6e, 0x1a, 0xc2, 0x01, 0x0a, 0x07,
-	0x4d, 0x65, 0x61, 0x73, 0x75, 0x72, 0x65, 0x12, 0x12, 0x0a, 0x04, 0x6e, 0x61, 0x6d, 0x65, 0x18,
-	0x01, 0x20, 0x01, 0x28, 0x09, 0x52, 0x04, 0x6e, 0x61, 0x6d, 0x65, 0x12, 0x14, 0x0a, 0x05, 0x6c,
-	0x61, 0x62, 0x65, 0x6c, 0x18, 0x02, 0x20, 0x01, 0x28, 0x09, 0x52, 0x05, 0x6c, 0x61, 0x62, 0x65,
-	0x6c, 0x12, 0x1e, 0x0a, 0x0a, 0x65, 0x78, 0x70, 0x72, 0x65, 0x73, 0x73, 0x69, 0x6f, 0x6e, 0x18,
-	0x03, 0x20, 0x01, 0x28, 0x09, 0x52, 0x0a, 0x65, 0x78, 0x70, 0x72, 0x65, 0x73, 0x73, 0x69, 0x6f,
-	0x6e, 0x12, 0x20, 0x0a, 0x0b, 0x64, 0x65, 0x73, 0x63, 0x72, 0x69, 0x70, 0x74, 0x69, 0x6f, 0x6e,
-	0x18, 0x04, 0x20, 0x01, 0x28, 0x09, 0x52, 0x0b, 0x64, 0x65, 0x73, 0x63, 0x72, 0x69, 0x70, 0x74,
-	0x69, 0x6f, 0x6e, 0x12, 0x16, 0x0a, 0x06, 0x66, 0x6f, 0x72, 0x6d, 0x61, 0x74, 0x18, 0x05, 0x20,
-	0x01, 0x28, 0x09, 0x52, 0x06, 0x66, 0x6f, 0x72, 0x6d, 0x61, 0x74, 0x12, 0x33, 0x0a, 0x16, 0x76,
-	0x61, 0x6c, 0x69, 0x64, 0x5f, 0x70, 0x65, 0x72, 0x63, 0x65, 0x6e, 0x74, 0x5f, 0x6f, 0x66, 0x5f,
-	0x74, 0x6f, 0x74, 0x61, 0x6c, 0x18, 0x06, 0x20, 0x01, 0x28, 0x08, 0x52, 0x13, 0x76, 0x61, 0x6c,
-	0x69, 0x64, 0x50, 0x65, 0x72, 0x63, 0x65, 0x6e, 0x74, 0x4f, 0x66, 0x54, 0x6f, 0x74, 0x61, 0x6c,
-	0x2a, 0x8d, 0x01, 0x0a, 0x0a, 0x4f, 0x62, 0x6a, 0x65, 0x63, 0x74, 0x54, 0x79, 0x70, 0x65, 0x12,
-	0x1b, 0x0a, 0x17, 0x4f, 0x42, 0x4a, 0x45, 0x43, 0x54, 0x5f, 0x54, 0x59, 0x50, 0x45, 0x5f, 0x55,
-	0x4e, 0x53, 0x50, 0x45, 0x43, 0x49, 0x46, 0x49, 0x45, 0x44, 0x10, 0x00, 0x12, 0x15, 0x0a, 0x11,
-	0x4f, 0x42, 0x4a, 0x45, 0x43, 0x54, 0x5f, 0x54, 0x59, 0x50, 0x45, 0x5f, 0x54, 0x41, 0x42, 0x4c,
-	0x45, 0x10, 0x01, 0x12, 0x16, 0x0a, 0x12, 0x4f, 0x42, 0x4a, 0x45, 0x43, 0x54, 0x5f, 0x54, 0x59,
-	0x50, 0x45, 0x5f, 0x53, 0x4f, 0x55, 0x52, 0x43, 0x45, 0x10, 0x02, 0x12, 0x15, 0x0a, 0x11, 0x4f,
-	0x42, 0x4a, 0x45, 0x43, 0x54, 0x5f, 0x54, 0x59, 0x50, 0x45, 0x5f, 0x4d, 0x4f, 0x44, 0x45, 0x4c,
-	0x10, 0x03, 0x12, 0x1c, 0x0a, 0x18, 0x4f, 0x42, 0x4a, 0x45, 0x43, 0x54, 0x5f, 0x54, 0x59, 0x50,
-	0x45, 0x5f, 0x4d, 0x45, 0x54, 0x52, 0x49, 0x43, 0x53, 0x5f, 0x56, 0x49, 0x45, 0x57, 0x10, 0x04,
-	0x42, 0xb5, 0x01, 0x0a, 0x13, 0x63, 0x6f, 0x6d, 0x2e, 0x72, 0x69, 0x6c, 0x6c, 0x2e, 0x72, 0x75,
-	0x6e, 0x74, 0x69, 0x6d, 0x65, 0x2e, 0x76, 0x31, 0x42, 0x0c, 0x43, 0x61, 0x74, 0x61, 0x6c, 0x6f,
-	0x67, 0x50, 0x72, 0x6f, 0x74, 0x6f, 0x50, 0x01, 0x5a, 0x32, 0x67, 0x69, 0x74, 0x68, 0x75, 0x62,
-	0x2e, 0x63, 0x6f, 0x6d, 0x2f, 0x72, 0x69, 0x6c, 0x6c, 0x64, 0x61, 0x74, 0x61, 0x2f, 0x72, 0x69,
-	0x6c, 0x6c, 0x2f, 0x72, 0x69, 0x6c, 0x6c, 0x2f, 0x72, 0x75, 0x6e, 0x74, 0x69, 0x6d, 0x65, 0x2f,
-	0x76, 0x31, 0x3b, 0x72, 0x75, 0x6e, 0x74, 0x69, 0x6d, 0x65, 0x76, 0x31, 0xa2, 0x02, 0x03, 0x52,
-	0x52, 0x58, 0xaa, 0x02, 0x0f, 0x52, 0x69, 0x6c, 0x6c, 0x2e, 0x52, 0x75, 0x6e, 0x74, 0x69, 0x6d,
-	0x65, 0x2e, 0x56, 0x31, 0xca, 0x02, 0x0f, 0x52, 0x69, 0x6c, 0x6c, 0x5c, 0x52, 0x75, 0x6e, 0x74,
-	0x69, 0x6d, 0x65, 0x5c, 0x56, 0x31, 0xe2, 0x02, 0x1b, 0x52, 0x69, 0x6c, 0x6c, 0x5c, 0x52, 0x75,
-	0x6e, 0x74, 0x69, 0x6d, 0x65, 0x5c, 0x56, 0x31, 0x5c, 0x47, 0x50, 0x42, 0x4d, 0x65, 0x74, 0x61,
-	0x64, 0x61, 0x74, 0x61, 0xea, 0x02, 0x11, 0x52, 0x69, 0x6c, 0x6c, 0x3a, 0x3a, 0x52, 0x75, 0x6e,
-	0x74, 0x69, 0x6d, 0x65, 0x3a, 0x3a, 0x56, 0x31, 0x62, 0x06, 0x70, 0x72, 0x6f, 0x74, 0x6f, 0x33,
+	0x65, 0x54, 0x69, 0x6d, 0x65, 0x5a, 0x6f, 0x6e, 0x65, 0x73, 0x12, 0x41, 0x0a, 0x08, 0x73, 0x65,
+	0x63, 0x75, 0x72, 0x69, 0x74, 0x79, 0x18, 0x0c, 0x20, 0x01, 0x28, 0x0b, 0x32, 0x25, 0x2e, 0x72,
+	0x69, 0x6c, 0x6c, 0x2e, 0x72, 0x75, 0x6e, 0x74, 0x69, 0x6d, 0x65, 0x2e, 0x76, 0x31, 0x2e, 0x4d,
+	0x65, 0x74, 0x72, 0x69, 0x63, 0x73, 0x56, 0x69, 0x65, 0x77, 0x2e, 0x53, 0x65, 0x63, 0x75, 0x72,
+	0x69, 0x74, 0x79, 0x52, 0x08, 0x73, 0x65, 0x63, 0x75, 0x72, 0x69, 0x74, 0x79, 0x12, 0x29, 0x0a,
+	0x11, 0x66, 0x69, 0x72, 0x73, 0x74, 0x5f, 0x64, 0x61, 0x79, 0x5f, 0x6f, 0x66, 0x5f, 0x77, 0x65,
+	0x65, 0x6b, 0x18, 0x0d, 0x20, 0x01, 0x28, 0x0d, 0x52, 0x0e, 0x66, 0x69, 0x72, 0x73, 0x74, 0x44,
+	0x61, 0x79, 0x4f, 0x66, 0x57, 0x65, 0x65, 0x6b, 0x12, 0x2d, 0x0a, 0x13, 0x66, 0x69, 0x72, 0x73,
+	0x74, 0x5f, 0x6d, 0x6f, 0x6e, 0x74, 0x68, 0x5f, 0x6f, 0x66, 0x5f, 0x79, 0x65, 0x61, 0x72, 0x18,
+	0x0e, 0x20, 0x01, 0x28, 0x0d, 0x52, 0x10, 0x66, 0x69, 0x72, 0x73, 0x74, 0x4d, 0x6f, 0x6e, 0x74,
+	0x68, 0x4f, 0x66, 0x59, 0x65, 0x61, 0x72, 0x1a, 0x6f, 0x0a, 0x09, 0x44, 0x69, 0x6d, 0x65, 0x6e,
+	0x73, 0x69, 0x6f, 0x6e, 0x12, 0x12, 0x0a, 0x04, 0x6e, 0x61, 0x6d, 0x65, 0x18, 0x01, 0x20, 0x01,
+	0x28, 0x09, 0x52, 0x04, 0x6e, 0x61, 0x6d, 0x65, 0x12, 0x14, 0x0a, 0x05, 0x6c, 0x61, 0x62, 0x65,
+	0x6c, 0x18, 0x02, 0x20, 0x01, 0x28, 0x09, 0x52, 0x05, 0x6c, 0x61, 0x62, 0x65, 0x6c, 0x12, 0x20,
+	0x0a, 0x0b, 0x64, 0x65, 0x73, 0x63, 0x72, 0x69, 0x70, 0x74, 0x69, 0x6f, 0x6e, 0x18, 0x03, 0x20,
+	0x01, 0x28, 0x09, 0x52, 0x0b, 0x64, 0x65, 0x73, 0x63, 0x72, 0x69, 0x70, 0x74, 0x69, 0x6f, 0x6e,
+	0x12, 0x16, 0x0a, 0x06, 0x63, 0x6f, 0x6c, 0x75, 0x6d, 0x6e, 0x18, 0x04, 0x20, 0x01, 0x28, 0x09,
+	0x52, 0x06, 0x63, 0x6f, 0x6c, 0x75, 0x6d, 0x6e, 0x1a, 0xb4, 0x04, 0x0a, 0x07, 0x4d, 0x65, 0x61,
+	0x73, 0x75, 0x72, 0x65, 0x12, 0x12, 0x0a, 0x04, 0x6e, 0x61, 0x6d, 0x65, 0x18, 0x01, 0x20, 0x01,
+	0x28, 0x09, 0x52, 0x04, 0x6e, 0x61, 0x6d, 0x65, 0x12, 0x14, 0x0a, 0x05, 0x6c, 0x61, 0x62, 0x65,
+	0x6c, 0x18, 0x02, 0x20, 0x01, 0x28, 0x09, 0x52, 0x05, 0x6c, 0x61, 0x62, 0x65, 0x6c, 0x12, 0x1e,
+	0x0a, 0x0a, 0x65, 0x78, 0x70, 0x72, 0x65, 0x73, 0x73, 0x69, 0x6f, 0x6e, 0x18, 0x03, 0x20, 0x01,
+	0x28, 0x09, 0x52, 0x0a, 0x65, 0x78, 0x70, 0x72, 0x65, 0x73, 0x73, 0x69, 0x6f, 0x6e, 0x12, 0x20,
+	0x0a, 0x0b, 0x64, 0x65, 0x73, 0x63, 0x72, 0x69, 0x70, 0x74, 0x69, 0x6f, 0x6e, 0x18, 0x04, 0x20,
+	0x01, 0x28, 0x09, 0x52, 0x0b, 0x64, 0x65, 0x73, 0x63, 0x72, 0x69, 0x70, 0x74, 0x69, 0x6f, 0x6e,
+	0x12, 0x16, 0x0a, 0x06, 0x66, 0x6f, 0x72, 0x6d, 0x61, 0x74, 0x18, 0x05, 0x20, 0x01, 0x28, 0x09,
+	0x52, 0x06, 0x66, 0x6f, 0x72, 0x6d, 0x61, 0x74, 0x12, 0x33, 0x0a, 0x16, 0x76, 0x61, 0x6c, 0x69,
+	0x64, 0x5f, 0x70, 0x65, 0x72, 0x63, 0x65, 0x6e, 0x74, 0x5f, 0x6f, 0x66, 0x5f, 0x74, 0x6f, 0x74,
+	0x61, 0x6c, 0x18, 0x06, 0x20, 0x01, 0x28, 0x08, 0x52, 0x13, 0x76, 0x61, 0x6c, 0x69, 0x64, 0x50,
+	0x65, 0x72, 0x63, 0x65, 0x6e, 0x74, 0x4f, 0x66, 0x54, 0x6f, 0x74, 0x61, 0x6c, 0x12, 0x1a, 0x0a,
+	0x08, 0x72, 0x65, 0x71, 0x75, 0x69, 0x72, 0x65, 0x73, 0x18, 0x07, 0x20, 0x03, 0x28, 0x09, 0x52,
+	0x08, 0x72, 0x65, 0x71, 0x75, 0x69, 0x72, 0x65, 0x73, 0x12, 0x42, 0x0a, 0x06, 0x77, 0x69, 0x6e,
+	0x64, 0x6f, 0x77, 0x18, 0x08, 0x20, 0x01, 0x28, 0x0b, 0x32, 0x2a, 0x2e, 0x72, 0x69, 0x6c, 0x6c,
+	0x2e, 0x72, 0x75, 0x6e, 0x74, 0x69, 0x6d, 0x65, 0x2e, 0x76, 0x31, 0x2e, 0x4d, 0x65, 0x74, 0x72,
+	0x69, 0x63, 0x73, 0x56, 0x69, 0x65, 0x77, 0x2e, 0x4d, 0x65, 0x61, 0x73, 0x75, 0x72, 0x65, 0x57,
+	0x69, 0x6e, 0x64, 0x6f, 0x77, 0x52, 0x06, 0x77, 0x69, 0x6e, 0x64, 0x6f, 0x77, 0x12, 0x3d, 0x0a,
+	0x04, 0x6b, 0x69, 0x6e, 0x64, 0x18, 0x09, 0x20, 0x01, 0x28, 0x0e, 0x32, 0x29, 0x2e, 0x72, 0x69,
+	0x6c, 0x6c, 0x2e, 0x72, 0x75, 0x6e, 0x74, 0x69, 0x6d, 0x65, 0x2e, 0x76, 0x31, 0x2e, 0x4d, 0x65,
+	0x74, 0x72, 0x69, 0x63, 0x73, 0x56, 0x69, 0x65, 0x77, 0x2e, 0x4d, 0x65, 0x61, 0x73, 0x75, 0x72,
+	0x65, 0x2e, 0x4b, 0x69, 0x6e, 0x64, 0x52, 0x04, 0x6b, 0x69, 0x6e, 0x64, 0x12, 0x16, 0x0a, 0x06,
+	0x63, 0x6f, 0x6c, 0x75, 0x6d, 0x6e, 0x18, 0x0a, 0x20, 0x01, 0x28, 0x09, 0x52, 0x06, 0x63, 0x6f,
+	0x6c, 0x75, 0x6d, 0x6e, 0x12, 0x20, 0x0a, 0x0b, 0x61, 0x70, 0x70, 0x72, 0x6f, 0x78, 0x69, 0x6d,
+	0x61, 0x74, 0x65, 0x18, 0x0b, 0x20, 0x01, 0x28, 0x08, 0x52, 0x0b, 0x61, 0x70, 0x70, 0x72, 0x6f,
+	0x78, 0x69, 0x6d, 0x61, 0x74, 0x65, 0x12, 0x1e, 0x0a, 0x0a, 0x70, 0x65, 0x72, 0x63, 0x65, 0x6e,
+	0x74, 0x69, 0x6c, 0x65, 0x18, 0x0c, 0x20, 0x01, 0x28, 0x01, 0x52, 0x0a, 0x70, 0x65, 0x72, 0x63,
+	0x65, 0x6e, 0x74, 0x69, 0x6c, 0x65, 0x22, 0x77, 0x0a, 0x04, 0x4b, 0x69, 0x6e, 0x64, 0x12, 0x14,
+	0x0a, 0x10, 0x4b, 0x49, 0x4e, 0x44, 0x5f, 0x55, 0x4e, 0x53, 0x50, 0x45, 0x43, 0x49, 0x46, 0x49,
+	0x45, 0x44, 0x10, 0x00, 0x12, 0x17, 0x0a, 0x13, 0x4b, 0x49, 0x4e, 0x44, 0x5f, 0x43, 0x4f, 0x55,
+	0x4e, 0x54, 0x5f, 0x44, 0x49, 0x53, 0x54, 0x49, 0x4e, 0x43, 0x54, 0x10, 0x01, 0x12, 0x13, 0x0a,
+	0x0f, 0x4b, 0x49, 0x4e, 0x44, 0x5f, 0x50, 0x45, 0x52, 0x43, 0x45, 0x4e, 0x54, 0x49, 0x4c, 0x45,
+	0x10, 0x02, 0x12, 0x0f, 0x0a, 0x0b, 0x4b, 0x49, 0x4e, 0x44, 0x5f, 0x4d, 0x45, 0x44, 0x49, 0x41,
+	0x4e, 0x10, 0x03, 0x12, 0x0c, 0x0a, 0x08, 0x4b, 0x49, 0x4e, 0x44, 0x5f, 0x4d, 0x49, 0x4e, 0x10,
+	0x04, 0x12, 0x0c, 0x0a, 0x08, 0x4b, 0x49, 0x4e, 0x44, 0x5f, 0x4d, 0x41, 0x58, 0x10, 0x05, 0x1a,
+	0x9c, 0x02, 0x0a, 0x0d, 0x4d, 0x65, 0x61, 0x73, 0x75, 0x72, 0x65, 0x57, 0x69, 0x6e, 0x64, 0x6f,
+	0x77, 0x12, 0x18, 0x0a, 0x07, 0x6d, 0x65, 0x61, 0x73, 0x75, 0x72, 0x65, 0x18, 0x01, 0x20, 0x01,
+	0x28, 0x09, 0x52, 0x07, 0x6d, 0x65, 0x61, 0x73, 0x75, 0x72, 0x65, 0x12, 0x43, 0x0a, 0x04, 0x74,
+	0x79, 0x70, 0x65, 0x18, 0x02, 0x20, 0x01, 0x28, 0x0e, 0x32, 0x2f, 0x2e, 0x72, 0x69, 0x6c, 0x6c,
+	0x2e, 0x72, 0x75, 0x6e, 0x74, 0x69, 0x6d, 0x65, 0x2e, 0x76, 0x31, 0x2e, 0x4d, 0x65, 0x74, 0x72,
+	0x69, 0x63, 0x73, 0x56, 0x69, 0x65, 0x77, 0x2e, 0x4d, 0x65, 0x61, 0x73, 0x75, 0x72, 0x65, 0x57,
+	0x69, 0x6e, 0x64, 0x6f, 0x77, 0x2e, 0x54, 0x79, 0x70, 0x65, 0x52, 0x04, 0x74, 0x79, 0x70, 0x65,
+	0x12, 0x12, 0x0a, 0x04, 0x73, 0x69, 0x7a, 0x65, 0x18, 0x03, 0x20, 0x01, 0x28, 0x0d, 0x52, 0x04,
+	0x73, 0x69, 0x7a, 0x65, 0x22, 0x97, 0x01, 0x0a, 0x04, 0x54, 0x79, 0x70, 0x65, 0x12, 0x14, 0x0a,
+	0x10, 0x54, 0x59, 0x50, 0x45, 0x5f, 0x55, 0x4e, 0x53, 0x50, 0x45, 0x43, 0x49, 0x46, 0x49, 0x45,
+	0x44, 0x10, 0x00, 0x12, 0x14, 0x0a, 0x10, 0x54, 0x59, 0x50, 0x45, 0x5f, 0x52, 0x4f, 0x4c, 0x4c,
+	0x49, 0x4e, 0x47, 0x5f, 0x53, 0x55, 0x4d, 0x10, 0x01, 0x12, 0x14, 0x0a, 0x10, 0x54, 0x59, 0x50,
+	0x45, 0x5f, 0x52, 0x4f, 0x4c, 0x4c, 0x49, 0x4e, 0x47, 0x5f, 0x41, 0x56, 0x47, 0x10, 0x02, 0x12,
+	0x17, 0x0a, 0x13, 0x54, 0x59, 0x50, 0x45, 0x5f, 0x43, 0x55, 0x4d, 0x55, 0x4c, 0x41, 0x54, 0x49,
+	0x56, 0x45, 0x5f, 0x53, 0x55, 0x4d, 0x10, 0x03, 0x12, 0x0c, 0x0a, 0x08, 0x54, 0x59, 0x50, 0x45,
+	0x5f, 0x4c, 0x41, 0x47, 0x10, 0x04, 0x12, 0x12, 0x0a, 0x0e, 0x54, 0x59, 0x50, 0x45, 0x5f, 0x44,
+	0x45, 0x4c, 0x54, 0x41, 0x5f, 0x41, 0x42, 0x53, 0x10, 0x05, 0x12, 0x12, 0x0a, 0x0e, 0x54, 0x59,
+	0x50, 0x45, 0x5f, 0x44, 0x45, 0x4c, 0x54, 0x41, 0x5f, 0x52, 0x45, 0x4c, 0x10, 0x06, 0x1a, 0xcf,
+	0x01, 0x0a, 0x08, 0x53, 0x65, 0x63, 0x75, 0x72, 0x69, 0x74, 0x79, 0x12, 0x16, 0x0a, 0x06, 0x61,
+	0x63, 0x63, 0x65, 0x73, 0x73, 0x18, 0x01, 0x20, 0x01, 0x28, 0x09, 0x52, 0x06, 0x61, 0x63, 0x63,
+	0x65, 0x73, 0x73, 0x12, 0x1d, 0x0a, 0x0a, 0x72, 0x6f, 0x77, 0x5f, 0x66, 0x69, 0x6c, 0x74, 0x65,
+	0x72, 0x18, 0x02, 0x20, 0x01, 0x28, 0x09, 0x52, 0x09, 0x72, 0x6f, 0x77, 0x46, 0x69, 0x6c, 0x74,
+	0x65, 0x72, 0x12, 0x45, 0x0a, 0x07, 0x69, 0x6e, 0x63, 0x6c, 0x75, 0x64, 0x65, 0x18, 0x03, 0x20,
+	0x03, 0x28, 0x0b, 0x32, 0x2b, 0x2e, 0x72, 0x69, 0x6c, 0x6c, 0x2e, 0x72, 0x75, 0x6e, 0x74, 0x69,
+	0x6d, 0x65, 0x2e, 0x76, 0x31, 0x2e, 0x4d, 0x65, 0x74, 0x72, 0x69, 0x63, 0x73, 0x56, 0x69, 0x65,
+	0x77, 0x2e, 0x46, 0x69, 0x65, 0x6c, 0x64, 0x43, 0x6f, 0x6e, 0x64, 0x69, 0x74, 0x69, 0x6f, 0x6e,
+	0x52, 0x07, 0x69, 0x6e, 0x63, 0x6c, 0x75, 0x64, 0x65, 0x12, 0x45, 0x0a, 0x07, 0x65, 0x78, 0x63,
+	0x6c, 0x75, 0x64, 0x65, 0x18, 0x04, 0x20, 0x03, 0x28, 0x0b, 0x32, 0x2b, 0x2e, 0x72, 0x69, 0x6c,
+	0x6c, 0x2e, 0x72, 0x75, 0x6e, 0x74, 0x69, 0x6d, 0x65, 0x2e, 0x76, 0x31, 0x2e, 0x4d, 0x65, 0x74,
+	0x72, 0x69, 0x63, 0x73, 0x56, 0x69, 0x65, 0x77, 0x2e, 0x46, 0x69, 0x65, 0x6c, 0x64, 0x43, 0x6f,
+	0x6e, 0x64, 0x69, 0x74, 0x69, 0x6f, 0x6e, 0x52, 0x07, 0x65, 0x78, 0x63, 0x6c, 0x75, 0x64, 0x65,
+	0x1a, 0x44, 0x0a, 0x0e, 0x46, 0x69, 0x65, 0x6c, 0x64, 0x43, 0x6f, 0x6e, 0x64, 0x69, 0x74, 0x69,
+	0x6f, 0x6e, 0x12, 0x1c, 0x0a, 0x09, 0x63, 0x6f, 0x6e, 0x64, 0x69, 0x74, 0x69, 0x6f, 0x6e, 0x18,
+	0x01, 0x20, 0x01, 0x28, 0x09, 0x52, 0x09, 0x63, 0x6f, 0x6e, 0x64, 0x69, 0x74, 0x69, 0x6f, 0x6e,
+	0x12, 0x14, 0x0a, 0x05, 0x6e, 0x61, 0x6d, 0x65, 0x73, 0x18, 0x02, 0x20, 0x03, 0x28, 0x09, 0x52,
+	0x05, 0x6e, 0x61, 0x6d, 0x65, 0x73, 0x2a, 0x8d, 0x01, 0x0a, 0x0a, 0x4f, 0x62, 0x6a, 0x65, 0x63,
+	0x74, 0x54, 0x79, 0x70, 0x65, 0x12, 0x1b, 0x0a, 0x17, 0x4f, 0x42, 0x4a, 0x45, 0x43, 0x54, 0x5f,
+	0x54, 0x59, 0x50, 0x45, 0x5f, 0x55, 0x4e, 0x53, 0x50, 0x45, 0x43, 0x49, 0x46, 0x49, 0x45, 0x44,
+	0x10, 0x00, 0x12, 0x15, 0x0a, 0x11, 0x4f, 0x42, 0x4a, 0x45, 0x43, 0x54, 0x5f, 0x54, 0x59, 0x50,
+	0x45, 0x5f, 0x54, 0x41, 0x42, 0x4c, 0x45, 0x10, 0x01, 0x12, 0x16, 0x0a, 0x12, 0x4f, 0x42, 0x4a,
+	0x45, 0x43, 0x54, 0x5f, 0x54, 0x59, 0x50, 0x45, 0x5f, 0x53, 0x4f, 0x55, 0x52, 0x43, 0x45, 0x10,
+	0x02, 0x12, 0x15, 0x0a, 0x11, 0x4f, 0x42, 0x4a, 0x45, 0x43, 0x54, 0x5f, 0x54, 0x59, 0x50, 0x45,
+	0x5f, 0x4d, 0x4f, 0x44, 0x45, 0x4c, 0x10, 0x03, 0x12, 0x1c, 0x0a, 0x18, 0x4f, 0x42, 0x4a, 0x45,
+	0x43, 0x54, 0x5f, 0x54, 0x59, 0x50, 0x45, 0x5f, 0x4d, 0x45, 0x54, 0x52, 0x49, 0x43, 0x53, 0x5f,
+	0x56, 0x49, 0x45, 0x57, 0x10, 0x04, 0x42, 0xb5, 0x01, 0x0a, 0x13, 0x63, 0x6f, 0x6d, 0x2e, 0x72,
+	0x69, 0x6c, 0x6c, 0x2e, 0x72, 0x75, 0x6e, 0x74, 0x69, 0x6d, 0x65, 0x2e, 0x76, 0x31, 0x42, 0x0c,
+	0x43, 0x61, 0x74, 0x61, 0x6c, 0x6f, 0x67, 0x50, 0x72, 0x6f, 0x74, 0x6f, 0x50, 0x01, 0x5a, 0x32,
+	0x67, 0x69, 0x74, 0x68, 0x75, 0x62, 0x2e, 0x63, 0x6f, 0x6d, 0x2f, 0x72, 0x69, 0x6c, 0x6c, 0x64,
+	0x61, 0x74, 0x61, 0x2f, 0x72, 0x69, 0x6c, 0x6c, 0x2f, 0x72, 0x69, 0x6c, 0x6c, 0x2f, 0x72, 0x75,
+	0x6e, 0x74, 0x69, 0x6d, 0x65, 0x2f, 0x76, 0x31, 0x3b, 0x72, 0x75, 0x6e, 0x74, 0x69, 0x6d, 0x65,
+	0x76, 0x31, 0xa2, 0x02, 0x03, 0x52, 0x52, 0x58, 0xaa, 0x02, 0x0f, 0x52, 0x69, 0x6c, 0x6c, 0x2e,
+	0x52, 0x75, 0x6e, 0x74, 0x69, 0x6d, 0x65, 0x2e, 0x56, 0x31, 0xca, 0x02, 0x0f, 0x52, 0x69, 0x6c,
+	0x6c, 0x5c, 0x52, 0x75, 0x6e, 0x74, 0x69, 0x6d, 0x65, 0x5c, 0x56, 0x31, 0xe2, 0x02, 0x1b, 0x52,
+	0x69, 0x6c, 0x6c, 0x5c, 0x52, 0x75, 0x6e, 0x74, 0x69, 0x6d, 0x65, 0x5c, 0x56, 0x31, 0x5c, 0x47,
+	0x50, 0x42, 0x4d, 0x65, 0x74, 0x61, 0x64, 0x61, 0x74, 0x61, 0xea, 0x02, 0x11, 0x52, 0x69, 0x6c,
+	0x6c, 0x3a, 0x3a, 0x52, 0x75, 0x6e, 0x74, 0x69, 0x6d, 0x65, 0x3a, 0x3a, 0x56, 0x31, 0x62, 0x06,
+	0x70, 0x72, 0x6f, 0x74, 0x6f, 0x33,
 }
 
 var (
@@ -945,40 +1429,51 @@ func file_rill_runtime_v1_catalog_proto_rawDescGZIP() []byte {
 	return file_rill_runtime_v1_catalog_proto_rawDescData
 }
 
-var file_rill_runtime_v1_catalog_proto_enumTypes = make([]protoimpl.EnumInfo, 3)
-var file_rill_runtime_v1_catalog_proto_msgTypes = make([]protoimpl.MessageInfo, 7)
+var file_rill_runtime_v1_catalog_proto_enumTypes = make([]protoimpl.EnumInfo, 5)
+var file_rill_runtime_v1_catalog_proto_msgTypes = make([]protoimpl.MessageInfo, 10)
 var file_rill_runtime_v1_catalog_proto_goTypes = []interface{}{
-	(ObjectType)(0),                    // 0: rill.runtime.v1.ObjectType
-	(Source_ExtractPolicy_Strategy)(0), // 1: rill.runtime.v1.Source.ExtractPolicy.Strategy
-	(Model_Dialect)(0),                 // 2: rill.runtime.v1.Model.Dialect
-	(*Table)(nil),                      // 3: rill.runtime.v1.Table
-	(*Source)(nil),                     // 4: rill.runtime.v1.Source
-	(*Model)(nil),                      // 5: rill.runtime.v1.Model
-	(*MetricsView)(nil),                // 6: rill.runtime.v1.MetricsView
-	(*Source_ExtractPolicy)(nil),       // 7: rill.runtime.v1.Source.ExtractPolicy
-	(*MetricsView_Dimension)(nil),      // 8: rill.runtime.v1.MetricsView.Dimension
-	(*MetricsView_Measure)(nil),        // 9: rill.runtime.v1.MetricsView.Measure
-	(*StructType)(nil),                 // 10: rill.runtime.v1.StructType
-	(*structpb.Struct)(nil),            // 11: google.protobuf.Struct
-	(TimeGrain)(0),                     // 12: rill.runtime.v1.TimeGrain
+	(ObjectType)(0),                     // 0: rill.runtime.v1.ObjectType
+	(Source_ExtractPolicy_Strategy)(0),  // 1: rill.runtime.v1.Source.ExtractPolicy.Strategy
+	(Model_Dialect)(0),                  // 2: rill.runtime.v1.Model.Dialect
+	(MetricsView_Measure_Kind)(0),       // 3: rill.runtime.v1.MetricsView.Measure.Kind
+	(MetricsView_MeasureWindow_Type)(0), // 4: rill.runtime.v1.MetricsView.MeasureWindow.Type
+	(*Table)(nil),                       // 5: rill.runtime.v1.Table
+	(*Source)(nil),                      // 6: rill.runtime.v1.Source
+	(*Model)(nil),                       // 7: rill.runtime.v1.Model
+	(*MetricsView)(nil),                 // 8: rill.runtime.v1.MetricsView
+	(*Source_ExtractPolicy)(nil),        // 9: rill.runtime.v1.Source.ExtractPolicy
+	(*MetricsView_Dimension)(nil),       // 10: rill.runtime.v1.MetricsView.Dimension
+	(*MetricsView_Measure)(nil),         // 11: rill.runtime.v1.MetricsView.Measure
+	(*MetricsView_MeasureWindow)(nil),   // 12: rill.runtime.v1.MetricsView.MeasureWindow
+	(*MetricsView_Security)(nil),        // 13: rill.runtime.v1.MetricsView.Security
+	(*MetricsView_FieldCondition)(nil),  // 14: rill.runtime.v1.MetricsView.FieldCondition
+	(*StructType)(nil),                  // 15: rill.runtime.v1.StructType
+	(*structpb.Struct)(nil),             // 16: google.protobuf.Struct
+	(TimeGrain)(0),                      // 17: rill.runtime.v1.TimeGrain
 }
 var file_rill_runtime_v1_catalog_proto_depIdxs = []int32{
-	10, // 0: rill.runtime.v1.Table.schema:type_name -> rill.runtime.v1.StructType
-	11, // 1: rill.runtime.v1.Source.properties:type_name -> google.protobuf.Struct
-	10, // 2: rill.runtime.v1.Source.schema:type_name -> rill.runtime.v1.StructType
-	7,  // 3: rill.runtime.v1.Source.policy:type_name -> rill.runtime.v1.Source.ExtractPolicy
+	15, // 0: rill.runtime.v1.Table.schema:type_name -> rill.runtime.v1.StructType
+	16, // 1: rill.runtime.v1.Source.properties:type_name -> google.protobuf.Struct
+	15, // 2: rill.runtime.v1.Source.schema:type_name -> rill.runtime.v1.StructType
+	9,  // 3: rill.runtime.v1.Source.policy:type_name -> rill.runtime.v1.Source.ExtractPolicy
 	2,  // 4: rill.runtime.v1.Model.dialect:type_name -> rill.runtime.v1.Model.Dialect
-	10, // 5: rill.runtime.v1.Model.schema:type_name -> rill.runtime.v1.StructType
-	8,  // 6: rill.runtime.v1.MetricsView.dimensions:type_name -> rill.runtime.v1.MetricsView.Dimension
-	9,  // 7: rill.runtime.v1.MetricsView.measures:type_name -> rill.runtime.v1.MetricsView.Measure
-	12, // 8: rill.runtime.v1.MetricsView.smallest_time_grain:type_name -> rill.runtime.v1.TimeGrain
-	1,  // 9: rill.runtime.v1.Source.ExtractPolicy.rows_strategy:type_name -> rill.runtime.v1.Source.ExtractPolicy.Strategy
-	1,  // 10: rill.runtime.v1.Source.ExtractPolicy.files_strategy:type_name -> rill.runtime.v1.Source.ExtractPolicy.Strategy
-	11, // [11:11] is the sub-list for method output_type
-	11, // [11:11] is the sub-list for method input_type
-	11, // [11:11] is the sub-list for extension type_name
-	11, // [11:11] is the sub-list for extension extendee
-	0,  // [0:11] is the sub-list for field type_name
+	15, // 5: rill.runtime.v1.Model.schema:type_name -> rill.runtime.v1.StructType
+	10, // 6: rill.runtime.v1.MetricsView.dimensions:type_name -> rill.runtime.v1.MetricsView.Dimension
+	11, // 7: rill.runtime.v1.MetricsView.measures:type_name -> rill.runtime.v1.MetricsView.Measure
+	17, // 8: rill.runtime.v1.MetricsView.smallest_time_grain:type_name -> rill.runtime.v1.TimeGrain
+	13, // 9: rill.runtime.v1.MetricsView.security:type_name -> rill.runtime.v1.MetricsView.Security
+	1,  // 10: rill.runtime.v1.Source.ExtractPolicy.rows_strategy:type_name -> rill.runtime.v1.Source.ExtractPolicy.Strategy
+	1,  // 11: rill.runtime.v1.Source.ExtractPolicy.files_strategy:type_name -> rill.runtime.v1.Source.ExtractPolicy.Strategy
+	12, // 12: rill.runtime.v1.MetricsView.Measure.window:type_name -> rill.runtime.v1.MetricsView.MeasureWindow
+	3,  // 13: rill.runtime.v1.MetricsView.Measure.kind:type_name -> rill.runtime.v1.MetricsView.Measure.Kind
+	4,  // 14: rill.runtime.v1.MetricsView.MeasureWindow.type:type_name -> rill.runtime.v1.MetricsView.MeasureWindow.Type
+	14, // 15: rill.runtime.v1.MetricsView.Security.include:type_name -> rill.runtime.v1.MetricsView.FieldCondition
+	14, // 16: rill.runtime.v1.MetricsView.Security.exclude:type_name -> rill.runtime.v1.MetricsView.FieldCondition
+	17, // [17:17] is the sub-list for method output_type
+	17, // [17:17] is the sub-list for method input_type
+	17, // [17:17] is the sub-list for extension type_name
+	17, // [17:17] is the sub-list for extension extendee
+	0,  // [0:17] is the sub-list for field type_name
 }
 
 func init() { file_rill_runtime_v1_catalog_proto_init() }
@@ -1073,14 +1568,50 @@ func file_rill_runtime_v1_catalog_proto_init() {
 				return nil
 			}
 		}
+		file_rill_runtime_v1_catalog_proto_msgTypes[7].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*MetricsView_MeasureWindow); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
+		file_rill_runtime_v1_catalog_proto_msgTypes[8].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*MetricsView_Security); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
+		file_rill_runtime_v1_catalog_proto_msgTypes[9].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*MetricsView_FieldCondition); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
 	}
 	type x struct{}
 	out := protoimpl.TypeBuilder{
 		File: protoimpl.DescBuilder{
 			GoPackagePath: reflect.TypeOf(x{}).PkgPath(),
 			RawDescriptor: file_rill_runtime_v1_catalog_proto_rawDesc,
-			NumEnums:      3,
-			NumMessages:   7,
+			NumEnums:      5,
+			NumMessages:   10,
 			NumExtensions: 0,
 			NumServices:   0,
 		},
@@ -596,6 +596,39 @@ func (m *MetricsView) validate(all bool) error {
 
 	// no validation rules for DefaultTimeRange
 
+	if all {
+		switch v := interface{}(m.GetSecurity()).(type) {
+		case interface{ ValidateAll() error }:
+			if err := v.ValidateAll(); err != nil {
+				errors = append(errors, MetricsViewValidationError{
+					field:  "Security",
+					reason: "embedded message failed validation",
+					cause:  err,
+				})
+			}
+		case interface{ Validate() error }:
+			if err := v.Validate(); err != nil {
+				errors = append(errors, MetricsViewValidationError{
+					field:  "Security",
+					reason: "embedded message failed validation",
+					cause:  err,
+				})
+			}
+		}
+	} else if v, ok := interface{}(m.GetSecurity()).(interface{ Validate() error }); ok {
+		if err := v.Validate(); err != nil {
+			return MetricsViewValidationError{
+				field:  "Security",
+				reason: "embedded message failed validation",
+				cause:  err,
+			}
+		}
+	}
+
+	// no validation rules for FirstDayOfWeek
+
+	// no validation rules for FirstMonthOfYear
+
 	if len(errors) > 0 {
 		return MetricsViewMultiError(errors)
 	}
@@ -927,6 +960,43 @@ func (m *MetricsView_Measure) validate(all bool) error {
 
 	// no validation rules for ValidPercentOfTotal
 
+	if all {
+		switch v := interface{}(m.GetWindow()).(type) {
+		case interface{ ValidateAll() error }:
+			if err := v.ValidateAll(); err != nil {
+				errors = append(errors, MetricsView_MeasureValidationError{
+					field:  "Window",
+					reason: "embedded message failed validation",
+					cause:  err,
+				})
+			}
+		case interface{ Validate() error }:
+			if err := v.Validate(); err != nil {
+				errors = append(errors, MetricsView_MeasureValidationError{
+					field:  "Window",
+					reason: "embedded message failed validation",
+					cause:  err,
+				})
+			}
+		}
+	} else if v, ok := interface{}(m.GetWindow()).(interface{ Validate() error }); ok {
+		if err := v.Validate(); err != nil {
+			return MetricsView_MeasureValidationError{
+				field:  "Window",
+				reason: "embedded message failed validation",
+				cause:  err,
+			}
+		}
+	}
+
+	// no validation rules for Kind
+
+	// no validation rules for Column
+
+	// no validation rules for Approximate
+
+	// no validation rules for Percentile
+
 	if len(errors) > 0 {
 		return MetricsView_MeasureMultiError(errors)
 	}
@@ -1006,3 +1076,389 @@ var _ interface {
 	Cause() error
 	ErrorName() string
 } = MetricsView_MeasureValidationError{}
+
+// Validate checks the field values on MetricsView_MeasureWindow with the rules
+// defined in the proto definition for this message. If any rules are
+// violated, the first error encountered is returned, or nil if there are no violations.
+func (m *MetricsView_MeasureWindow) Validate() error {
+	return m.validate(false)
+}
+
+// ValidateAll checks the field values on MetricsView_MeasureWindow with the
+// rules defined in the proto definition for this message. If any rules are
+// violated, the result is a list of violation errors wrapped in
+// MetricsView_MeasureWindowMultiError, or nil if none found.
+func (m *MetricsView_MeasureWindow) ValidateAll() error {
+	return m.validate(true)
+}
+
+func (m *MetricsView_MeasureWindow) validate(all bool) error {
+	if m == nil {
+		return nil
+	}
+
+	var errors []error
+
+	// no validation rules for Measure
+
+	// no validation rules for Type
+
+	// no validation rules for Size
+
+	if len(errors) > 0 {
+		return MetricsView_MeasureWindowMultiError(errors)
+	}
+
+	return nil
+}
+
+// MetricsView_MeasureWindowMultiError is an error wrapping multiple validation
+// errors returned by MetricsView_MeasureWindow.ValidateAll() if the
+// designated constraints aren't met.
+type MetricsView_MeasureWindowMultiError []error
+
+// Error returns a concatenation of all the error messages it wraps.
+func (m MetricsView_MeasureWindowMultiError) Error() string {
+	var msgs []string
+	for _, err := range m {
+		msgs = append(msgs, err.Error())
+	}
+	return strings.Join(msgs, "; ")
+}
+
+// AllErrors returns a list of validation violation errors.
+func (m MetricsView_MeasureWindowMultiError) AllErrors() []error { return m }
+
+// MetricsView_MeasureWindowValidationError is the validation error returned by
+// MetricsView_MeasureWindow.Validate if the designated constraints aren't met.
+type MetricsView_MeasureWindowValidationError struct {
+	field  string
+	reason string
+	cause  error
+	key    bool
+}
+
+// Field function returns field value.
+func (e MetricsView_MeasureWindowValidationError) Field() string { return e.field }
+
+// Reason function returns reason value.
+func (e MetricsView_MeasureWindowValidationError) Reason() string { return e.reason }
+
+// Cause function returns cause value.
+func (e MetricsView_MeasureWindowValidationError) Cause() error { return e.cause }
+
+// Key function returns key value.
+func (e MetricsView_MeasureWindowValidationError) Key() bool { return e.key }
+
+// ErrorName returns error name.
+func (e MetricsView_MeasureWindowValidationError) ErrorName() string {
+	return "MetricsView_MeasureWindowValidationError"
+}
+
+// Error satisfies the builtin error interface
+func (e MetricsView_MeasureWindowValidationError) Error() string {
+	cause := ""
+	if e.cause != nil {
+		cause = fmt.Sprintf(" | caused by: %v", e.cause)
+	}
+
+	key := ""
+	if e.key {
+		key = "key for "
+	}
+
+	return fmt.Sprintf(
+		"invalid %sMetricsView_MeasureWindow.%s: %s%s",
+		key,
+		e.field,
+		e.reason,
+		cause)
+}
+
+var _ error = MetricsView_MeasureWindowValidationError{}
+
+var _ interface {
+	Field() string
+	Reason() string
+	Key() bool
+	Cause() error
+	ErrorName() string
+} = MetricsView_MeasureWindowValidationError{}
+
+// Validate checks the field values on MetricsView_Security with the rules
+// defined in the proto definition for this message. If any rules are
+// violated, the first error encountered is returned, or nil if there are no violations.
+func (m *MetricsView_Security) Validate() error {
+	return m.validate(false)
+}
+
+// ValidateAll checks the field values on MetricsView_Security with the rules
+// defined in the proto definition for this message. If any rules are
+// violated, the result is a list of violation errors wrapped in
+// MetricsView_SecurityMultiError, or nil if none found.
+func (m *MetricsView_Security) ValidateAll() error {
+	return m.validate(true)
+}
+
+func (m *MetricsView_Security) validate(all bool) error {
+	if m == nil {
+		return nil
+	}
+
+	var errors []error
+
+	// no validation rules for Access
+
+	// no validation rules for RowFilter
+
+	for idx, item := range m.GetInclude() {
+		_, _ = idx, item
+
+		if all {
+			switch v := interface{}(item).(type) {
+			case interface{ ValidateAll() error }:
+				if err := v.ValidateAll(); err != nil {
+					errors = append(errors, MetricsView_SecurityValidationError{
+						field:  fmt.Sprintf("Include[%v]", idx),
+						reason: "embedded message failed validation",
+						cause:  err,
+					})
+				}
+			case interface{ Validate() error }:
+				if err := v.Validate(); err != nil {
+					errors = append(errors, MetricsView_SecurityValidationError{
+						field:  fmt.Sprintf("Include[%v]", idx),
+						reason: "embedded message failed validation",
+						cause:  err,
+					})
+				}
+			}
+		} else if v, ok := interface{}(item).(interface{ Validate() error }); ok {
+			if err := v.Validate(); err != nil {
+				return MetricsView_SecurityValidationError{
+					field:  fmt.Sprintf("Include[%v]", idx),
+					reason: "embedded message failed validation",
+					cause:  err,
+				}
+			}
+		}
+
+	}
+
+	for idx, item := range m.GetExclude() {
+		_, _ = idx, item
+
+		if all {
+			switch v := interface{}(item).(type) {
+			case interface{ ValidateAll() error }:
+				if err := v.ValidateAll(); err != nil {
+					errors = append(errors, MetricsView_SecurityValidationError{
+						field:  fmt.Sprintf("Exclude[%v]", idx),
+						reason: "embedded message failed validation",
+						cause:  err,
+					})
+				}
+			case interface{ Validate() error }:
+				if err := v.Validate(); err != nil {
+					errors = append(errors, MetricsView_SecurityValidationError{
+						field:  fmt.Sprintf("Exclude[%v]", idx),
+						reason: "embedded message failed validation",
+						cause:  err,
+					})
+				}
+			}
+		} else if v, ok := interface{}(item).(interface{ Validate() error }); ok {
+			if err := v.Validate(); err != nil {
+				return MetricsView_SecurityValidationError{
+					field:  fmt.Sprintf("Exclude[%v]", idx),
+					reason: "embedded message failed validation",
+					cause:  err,
+				}
+			}
+		}
+
+	}
+
+	if len(errors) > 0 {
+		return MetricsView_SecurityMultiError(errors)
+	}
+
+	return nil
+}
+
+// MetricsView_SecurityMultiError is an error wrapping multiple validation
+// errors returned by MetricsView_Security.ValidateAll() if the designated
+// constraints aren't met.
+type MetricsView_SecurityMultiError []error
+
+// Error returns a concatenation of all the error messages it wraps.
+func (m MetricsView_SecurityMultiError) Error() string {
+	var msgs []string
+	for _, err := range m {
+		msgs = append(msgs, err.Error())
+	}
+	return strings.Join(msgs, "; ")
+}
+
+// AllErrors returns a list of validation violation errors.
+func (m MetricsView_SecurityMultiError) AllErrors() []error { return m }
+
+// MetricsView_SecurityValidationError is the validation error returned by
+// MetricsView_Security.Validate if the designated constraints aren't met.
+type MetricsView_SecurityValidationError struct {
+	field  string
+	reason string
+	cause  error
+	key    bool
+}
+
+// Field function returns field value.
+func (e MetricsView_SecurityValidationError) Field() string { return e.field }
+
+// Reason function returns reason value.
+func (e MetricsView_SecurityValidationError) Reason() string { return e.reason }
+
+// Cause function returns cause value.
+func (e MetricsView_SecurityValidationError) Cause() error { return e.cause }
+
+// Key function returns key value.
+func (e MetricsView_SecurityValidationError) Key() bool { return e.key }
+
+// ErrorName returns error name.
+func (e MetricsView_SecurityValidationError) ErrorName() string {
+	return "MetricsView_SecurityValidationError"
+}
+
+// Error satisfies the builtin error interface
+func (e MetricsView_SecurityValidationError) Error() string {
+	cause := ""
+	if e.cause != nil {
+		cause = fmt.Sprintf(" | caused by: %v", e.cause)
+	}
+
+	key := ""
+	if e.key {
+		key = "key for "
+	}
+
+	return fmt.Sprintf(
+		"invalid %sMetricsView_Security.%s: %s%s",
+		key,
+		e.field,
+		e.reason,
+		cause)
+}
+
+var _ error = MetricsView_SecurityValidationError{}
+
+var _ interface {
+	Field() string
+	Reason() string
+	Key() bool
+	Cause() error
+	ErrorName() string
+} = MetricsView_SecurityValidationError{}
+
+// Validate checks the field values on MetricsView_FieldCondition with the
+// rules defined in the proto definition for this message. If any rules are
+// violated, the first error encountered is returned, or nil if there are no violations.
+func (m *MetricsView_FieldCondition) Validate() error {
+	return m.validate(false)
+}
+
+// ValidateAll checks the field values on MetricsView_FieldCondition with the
+// rules defined in the proto definition for this message. If any rules are
+// violated, the result is a list of violation errors wrapped in
+// MetricsView_FieldConditionMultiError, or nil if none found.
+func (m *MetricsView_FieldCondition) ValidateAll() error {
+	return m.validate(true)
+}
+
+func (m *MetricsView_FieldCondition) validate(all bool) error {
+	if m == nil {
+		return nil
+	}
+
+	var errors []error
+
+	// no validation rules for Condition
+
+	if len(errors) > 0 {
+		return MetricsView_FieldConditionMultiError(errors)
+	}
+
+	return nil
+}
+
+// MetricsView_FieldConditionMultiError is an error wrapping multiple
+// validation errors returned by MetricsView_FieldCondition.ValidateAll() if
+// the designated constraints aren't met.
+type MetricsView_FieldConditionMultiError []error
+
+// Error returns a concatenation of all the error messages it wraps.
+func (m MetricsView_FieldConditionMultiError) Error() string {
+	var msgs []string
+	for _, err := range m {
+		msgs = append(msgs, err.Error())
+	}
+	return strings.Join(msgs, "; ")
+}
+
+// AllErrors returns a list of validation violation errors.
+func (m MetricsView_FieldConditionMultiError) AllErrors() []error { return m }
+
+// MetricsView_FieldConditionValidationError is the validation error returned
+// by MetricsView_FieldCondition.Validate if the designated constraints aren't met.
+type MetricsView_FieldConditionValidationError struct {
+	field  string
+	reason string
+	cause  error
+	key    bool
+}
+
+// Field function returns field value.
+func (e MetricsView_FieldConditionValidationError) Field() string { return e.field }
+
+// Reason function returns reason value.
+func (e MetricsView_FieldConditionValidationError) Reason() string { return e.reason }
+
+// Cause function returns cause value.
+func (e MetricsView_FieldConditionValidationError) Cause() error { return e.cause }
+
+// Key function returns key value.
+func (e MetricsView_FieldConditionValidationError) Key() bool { return e.key }
+
+// ErrorName returns error name.
+func (e MetricsView_FieldConditionValidationError) ErrorName() string {
+	return "MetricsView_FieldConditionValidationError"
+}
+
+// Error satisfies the builtin error interface
+func (e MetricsView_FieldConditionValidationError) Error() string {
+	cause := ""
+	if e.cause != nil {
+		cause = fmt.Sprintf(" | caused by: %v", e.cause)
+	}
+
+	key := ""
+	if e.key {
+		key = "key for "
+	}
+
+	return fmt.Sprintf(
+		"invalid %sMetricsView_FieldCondition.%s: %s%s",
+		key,
+		e.field,
+		e.reason,
+		cause)
+}
+
+var _ error = MetricsView_FieldConditionValidationError{}
+
+var _ interface {
+	Field() string
+	Reason() string
+	Key() bool
+	Cause() error
+	ErrorName() string
+} = MetricsView_FieldConditionValidationError{}
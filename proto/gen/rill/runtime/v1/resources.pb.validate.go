@@ -1612,12 +1612,20 @@ func (m *SourceSpec) validate(all bool) error {
 
 	// no validation rules for TimeoutSeconds
 
+	// no validation rules for Incremental
+
+	// no validation rules for CursorColumn
+
+	// no validation rules for DeleteRemovedPartitions
+
 	// no validation rules for StageChanges
 
 	// no validation rules for StreamIngestion
 
 	// no validation rules for Trigger
 
+	// no validation rules for TriggerFull
+
 	if len(errors) > 0 {
 		return SourceSpecMultiError(errors)
 	}
@@ -1752,6 +1760,37 @@ func (m *SourceState) validate(all bool) error {
 		}
 	}
 
+	if all {
+		switch v := interface{}(m.GetCursor()).(type) {
+		case interface{ ValidateAll() error }:
+			if err := v.ValidateAll(); err != nil {
+				errors = append(errors, SourceStateValidationError{
+					field:  "Cursor",
+					reason: "embedded message failed validation",
+					cause:  err,
+				})
+			}
+		case interface{ Validate() error }:
+			if err := v.Validate(); err != nil {
+				errors = append(errors, SourceStateValidationError{
+					field:  "Cursor",
+					reason: "embedded message failed validation",
+					cause:  err,
+				})
+			}
+		}
+	} else if v, ok := interface{}(m.GetCursor()).(interface{ Validate() error }); ok {
+		if err := v.Validate(); err != nil {
+			return SourceStateValidationError{
+				field:  "Cursor",
+				reason: "embedded message failed validation",
+				cause:  err,
+			}
+		}
+	}
+
+	// no validation rules for IngestedObjects
+
 	if len(errors) > 0 {
 		return SourceStateMultiError(errors)
 	}
@@ -2044,12 +2083,18 @@ func (m *ModelSpec) validate(all bool) error {
 
 	// no validation rules for UsesTemplating
 
+	// no validation rules for Incremental
+
+	// no validation rules for IncrementalStateResolver
+
 	// no validation rules for StageChanges
 
 	// no validation rules for MaterializeDelaySeconds
 
 	// no validation rules for Trigger
 
+	// no validation rules for TriggerFull
+
 	if m.Materialize != nil {
 		// no validation rules for Materialize
 	}
@@ -2188,6 +2233,35 @@ func (m *ModelState) validate(all bool) error {
 		}
 	}
 
+	if all {
+		switch v := interface{}(m.GetIncrementalState()).(type) {
+		case interface{ ValidateAll() error }:
+			if err := v.ValidateAll(); err != nil {
+				errors = append(errors, ModelStateValidationError{
+					field:  "IncrementalState",
+					reason: "embedded message failed validation",
+					cause:  err,
+				})
+			}
+		case interface{ Validate() error }:
+			if err := v.Validate(); err != nil {
+				errors = append(errors, ModelStateValidationError{
+					field:  "IncrementalState",
+					reason: "embedded message failed validation",
+					cause:  err,
+				})
+			}
+		}
+	} else if v, ok := interface{}(m.GetIncrementalState()).(interface{ Validate() error }); ok {
+		if err := v.Validate(); err != nil {
+			return ModelStateValidationError{
+				field:  "IncrementalState",
+				reason: "embedded message failed validation",
+				cause:  err,
+			}
+		}
+	}
+
 	if len(errors) > 0 {
 		return ModelStateMultiError(errors)
 	}
@@ -2527,6 +2601,39 @@ func (m *MetricsViewSpec) validate(all bool) error {
 
 	// no validation rules for DefaultTimeRange
 
+	if all {
+		switch v := interface{}(m.GetSecurity()).(type) {
+		case interface{ ValidateAll() error }:
+			if err := v.ValidateAll(); err != nil {
+				errors = append(errors, MetricsViewSpecValidationError{
+					field:  "Security",
+					reason: "embedded message failed validation",
+					cause:  err,
+				})
+			}
+		case interface{ Validate() error }:
+			if err := v.Validate(); err != nil {
+				errors = append(errors, MetricsViewSpecValidationError{
+					field:  "Security",
+					reason: "embedded message failed validation",
+					cause:  err,
+				})
+			}
+		}
+	} else if v, ok := interface{}(m.GetSecurity()).(interface{ Validate() error }); ok {
+		if err := v.Validate(); err != nil {
+			return MetricsViewSpecValidationError{
+				field:  "Security",
+				reason: "embedded message failed validation",
+				cause:  err,
+			}
+		}
+	}
+
+	// no validation rules for FirstDayOfWeek
+
+	// no validation rules for FirstMonthOfYear
+
 	if len(errors) > 0 {
 		return MetricsViewSpecMultiError(errors)
 	}
@@ -3670,6 +3777,8 @@ func (m *RefreshTriggerSpec) validate(all bool) error {
 
 	}
 
+	// no validation rules for Full
+
 	if len(errors) > 0 {
 		return RefreshTriggerSpecMultiError(errors)
 	}
@@ -5144,6 +5253,43 @@ func (m *MetricsViewSpec_MeasureV2) validate(all bool) error {
 
 	// no validation rules for ValidPercentOfTotal
 
+	if all {
+		switch v := interface{}(m.GetWindow()).(type) {
+		case interface{ ValidateAll() error }:
+			if err := v.ValidateAll(); err != nil {
+				errors = append(errors, MetricsViewSpec_MeasureV2ValidationError{
+					field:  "Window",
+					reason: "embedded message failed validation",
+					cause:  err,
+				})
+			}
+		case interface{ Validate() error }:
+			if err := v.Validate(); err != nil {
+				errors = append(errors, MetricsViewSpec_MeasureV2ValidationError{
+					field:  "Window",
+					reason: "embedded message failed validation",
+					cause:  err,
+				})
+			}
+		}
+	} else if v, ok := interface{}(m.GetWindow()).(interface{ Validate() error }); ok {
+		if err := v.Validate(); err != nil {
+			return MetricsViewSpec_MeasureV2ValidationError{
+				field:  "Window",
+				reason: "embedded message failed validation",
+				cause:  err,
+			}
+		}
+	}
+
+	// no validation rules for Kind
+
+	// no validation rules for Column
+
+	// no validation rules for Approximate
+
+	// no validation rules for Percentile
+
 	if len(errors) > 0 {
 		return MetricsViewSpec_MeasureV2MultiError(errors)
 	}
@@ -5223,3 +5369,393 @@ var _ interface {
 	Cause() error
 	ErrorName() string
 } = MetricsViewSpec_MeasureV2ValidationError{}
+
+// Validate checks the field values on MetricsViewSpec_MeasureWindowV2 with the
+// rules defined in the proto definition for this message. If any rules are
+// violated, the first error encountered is returned, or nil if there are no violations.
+func (m *MetricsViewSpec_MeasureWindowV2) Validate() error {
+	return m.validate(false)
+}
+
+// ValidateAll checks the field values on MetricsViewSpec_MeasureWindowV2 with
+// the rules defined in the proto definition for this message. If any rules
+// are violated, the result is a list of violation errors wrapped in
+// MetricsViewSpec_MeasureWindowV2MultiError, or nil if none found.
+func (m *MetricsViewSpec_MeasureWindowV2) ValidateAll() error {
+	return m.validate(true)
+}
+
+func (m *MetricsViewSpec_MeasureWindowV2) validate(all bool) error {
+	if m == nil {
+		return nil
+	}
+
+	var errors []error
+
+	// no validation rules for Measure
+
+	// no validation rules for Type
+
+	// no validation rules for Size
+
+	if len(errors) > 0 {
+		return MetricsViewSpec_MeasureWindowV2MultiError(errors)
+	}
+
+	return nil
+}
+
+// MetricsViewSpec_MeasureWindowV2MultiError is an error wrapping multiple
+// validation errors returned by MetricsViewSpec_MeasureWindowV2.ValidateAll()
+// if the designated constraints aren't met.
+type MetricsViewSpec_MeasureWindowV2MultiError []error
+
+// Error returns a concatenation of all the error messages it wraps.
+func (m MetricsViewSpec_MeasureWindowV2MultiError) Error() string {
+	var msgs []string
+	for _, err := range m {
+		msgs = append(msgs, err.Error())
+	}
+	return strings.Join(msgs, "; ")
+}
+
+// AllErrors returns a list of validation violation errors.
+func (m MetricsViewSpec_MeasureWindowV2MultiError) AllErrors() []error { return m }
+
+// MetricsViewSpec_MeasureWindowV2ValidationError is the validation error
+// returned by MetricsViewSpec_MeasureWindowV2.Validate if the designated
+// constraints aren't met.
+type MetricsViewSpec_MeasureWindowV2ValidationError struct {
+	field  string
+	reason string
+	cause  error
+	key    bool
+}
+
+// Field function returns field value.
+func (e MetricsViewSpec_MeasureWindowV2ValidationError) Field() string { return e.field }
+
+// Reason function returns reason value.
+func (e MetricsViewSpec_MeasureWindowV2ValidationError) Reason() string { return e.reason }
+
+// Cause function returns cause value.
+func (e MetricsViewSpec_MeasureWindowV2ValidationError) Cause() error { return e.cause }
+
+// Key function returns key value.
+func (e MetricsViewSpec_MeasureWindowV2ValidationError) Key() bool { return e.key }
+
+// ErrorName returns error name.
+func (e MetricsViewSpec_MeasureWindowV2ValidationError) ErrorName() string {
+	return "MetricsViewSpec_MeasureWindowV2ValidationError"
+}
+
+// Error satisfies the builtin error interface
+func (e MetricsViewSpec_MeasureWindowV2ValidationError) Error() string {
+	cause := ""
+	if e.cause != nil {
+		cause = fmt.Sprintf(" | caused by: %v", e.cause)
+	}
+
+	key := ""
+	if e.key {
+		key = "key for "
+	}
+
+	return fmt.Sprintf(
+		"invalid %sMetricsViewSpec_MeasureWindowV2.%s: %s%s",
+		key,
+		e.field,
+		e.reason,
+		cause)
+}
+
+var _ error = MetricsViewSpec_MeasureWindowV2ValidationError{}
+
+var _ interface {
+	Field() string
+	Reason() string
+	Key() bool
+	Cause() error
+	ErrorName() string
+} = MetricsViewSpec_MeasureWindowV2ValidationError{}
+
+// Validate checks the field values on MetricsViewSpec_SecurityV2 with the
+// rules defined in the proto definition for this message. If any rules are
+// violated, the first error encountered is returned, or nil if there are no violations.
+func (m *MetricsViewSpec_SecurityV2) Validate() error {
+	return m.validate(false)
+}
+
+// ValidateAll checks the field values on MetricsViewSpec_SecurityV2 with the
+// rules defined in the proto definition for this message. If any rules are
+// violated, the result is a list of violation errors wrapped in
+// MetricsViewSpec_SecurityV2MultiError, or nil if none found.
+func (m *MetricsViewSpec_SecurityV2) ValidateAll() error {
+	return m.validate(true)
+}
+
+func (m *MetricsViewSpec_SecurityV2) validate(all bool) error {
+	if m == nil {
+		return nil
+	}
+
+	var errors []error
+
+	// no validation rules for Access
+
+	// no validation rules for RowFilter
+
+	for idx, item := range m.GetInclude() {
+		_, _ = idx, item
+
+		if all {
+			switch v := interface{}(item).(type) {
+			case interface{ ValidateAll() error }:
+				if err := v.ValidateAll(); err != nil {
+					errors = append(errors, MetricsViewSpec_SecurityV2ValidationError{
+						field:  fmt.Sprintf("Include[%v]", idx),
+						reason: "embedded message failed validation",
+						cause:  err,
+					})
+				}
+			case interface{ Validate() error }:
+				if err := v.Validate(); err != nil {
+					errors = append(errors, MetricsViewSpec_SecurityV2ValidationError{
+						field:  fmt.Sprintf("Include[%v]", idx),
+						reason: "embedded message failed validation",
+						cause:  err,
+					})
+				}
+			}
+		} else if v, ok := interface{}(item).(interface{ Validate() error }); ok {
+			if err := v.Validate(); err != nil {
+				return MetricsViewSpec_SecurityV2ValidationError{
+					field:  fmt.Sprintf("Include[%v]", idx),
+					reason: "embedded message failed validation",
+					cause:  err,
+				}
+			}
+		}
+
+	}
+
+	for idx, item := range m.GetExclude() {
+		_, _ = idx, item
+
+		if all {
+			switch v := interface{}(item).(type) {
+			case interface{ ValidateAll() error }:
+				if err := v.ValidateAll(); err != nil {
+					errors = append(errors, MetricsViewSpec_SecurityV2ValidationError{
+						field:  fmt.Sprintf("Exclude[%v]", idx),
+						reason: "embedded message failed validation",
+						cause:  err,
+					})
+				}
+			case interface{ Validate() error }:
+				if err := v.Validate(); err != nil {
+					errors = append(errors, MetricsViewSpec_SecurityV2ValidationError{
+						field:  fmt.Sprintf("Exclude[%v]", idx),
+						reason: "embedded message failed validation",
+						cause:  err,
+					})
+				}
+			}
+		} else if v, ok := interface{}(item).(interface{ Validate() error }); ok {
+			if err := v.Validate(); err != nil {
+				return MetricsViewSpec_SecurityV2ValidationError{
+					field:  fmt.Sprintf("Exclude[%v]", idx),
+					reason: "embedded message failed validation",
+					cause:  err,
+				}
+			}
+		}
+
+	}
+
+	if len(errors) > 0 {
+		return MetricsViewSpec_SecurityV2MultiError(errors)
+	}
+
+	return nil
+}
+
+// MetricsViewSpec_SecurityV2MultiError is an error wrapping multiple
+// validation errors returned by MetricsViewSpec_SecurityV2.ValidateAll() if
+// the designated constraints aren't met.
+type MetricsViewSpec_SecurityV2MultiError []error
+
+// Error returns a concatenation of all the error messages it wraps.
+func (m MetricsViewSpec_SecurityV2MultiError) Error() string {
+	var msgs []string
+	for _, err := range m {
+		msgs = append(msgs, err.Error())
+	}
+	return strings.Join(msgs, "; ")
+}
+
+// AllErrors returns a list of validation violation errors.
+func (m MetricsViewSpec_SecurityV2MultiError) AllErrors() []error { return m }
+
+// MetricsViewSpec_SecurityV2ValidationError is the validation error returned
+// by MetricsViewSpec_SecurityV2.Validate if the designated constraints aren't met.
+type MetricsViewSpec_SecurityV2ValidationError struct {
+	field  string
+	reason string
+	cause  error
+	key    bool
+}
+
+// Field function returns field value.
+func (e MetricsViewSpec_SecurityV2ValidationError) Field() string { return e.field }
+
+// Reason function returns reason value.
+func (e MetricsViewSpec_SecurityV2ValidationError) Reason() string { return e.reason }
+
+// Cause function returns cause value.
+func (e MetricsViewSpec_SecurityV2ValidationError) Cause() error { return e.cause }
+
+// Key function returns key value.
+func (e MetricsViewSpec_SecurityV2ValidationError) Key() bool { return e.key }
+
+// ErrorName returns error name.
+func (e MetricsViewSpec_SecurityV2ValidationError) ErrorName() string {
+	return "MetricsViewSpec_SecurityV2ValidationError"
+}
+
+// Error satisfies the builtin error interface
+func (e MetricsViewSpec_SecurityV2ValidationError) Error() string {
+	cause := ""
+	if e.cause != nil {
+		cause = fmt.Sprintf(" | caused by: %v", e.cause)
+	}
+
+	key := ""
+	if e.key {
+		key = "key for "
+	}
+
+	return fmt.Sprintf(
+		"invalid %sMetricsViewSpec_SecurityV2.%s: %s%s",
+		key,
+		e.field,
+		e.reason,
+		cause)
+}
+
+var _ error = MetricsViewSpec_SecurityV2ValidationError{}
+
+var _ interface {
+	Field() string
+	Reason() string
+	Key() bool
+	Cause() error
+	ErrorName() string
+} = MetricsViewSpec_SecurityV2ValidationError{}
+
+// Validate checks the field values on MetricsViewSpec_FieldConditionV2 with
+// the rules defined in the proto definition for this message. If any rules
+// are violated, the first error encountered is returned, or nil if there are
+// no violations.
+func (m *MetricsViewSpec_FieldConditionV2) Validate() error {
+	return m.validate(false)
+}
+
+// ValidateAll checks the field values on MetricsViewSpec_FieldConditionV2 with
+// the rules defined in the proto definition for this message. If any rules
+// are violated, the result is a list of violation errors wrapped in
+// MetricsViewSpec_FieldConditionV2MultiError, or nil if none found.
+func (m *MetricsViewSpec_FieldConditionV2) ValidateAll() error {
+	return m.validate(true)
+}
+
+func (m *MetricsViewSpec_FieldConditionV2) validate(all bool) error {
+	if m == nil {
+		return nil
+	}
+
+	var errors []error
+
+	// no validation rules for Condition
+
+	if len(errors) > 0 {
+		return MetricsViewSpec_FieldConditionV2MultiError(errors)
+	}
+
+	return nil
+}
+
+// MetricsViewSpec_FieldConditionV2MultiError is an error wrapping multiple
+// validation errors returned by
+// MetricsViewSpec_FieldConditionV2.ValidateAll() if the designated
+// constraints aren't met.
+type MetricsViewSpec_FieldConditionV2MultiError []error
+
+// Error returns a concatenation of all the error messages it wraps.
+func (m MetricsViewSpec_FieldConditionV2MultiError) Error() string {
+	var msgs []string
+	for _, err := range m {
+		msgs = append(msgs, err.Error())
+	}
+	return strings.Join(msgs, "; ")
+}
+
+// AllErrors returns a list of validation violation errors.
+func (m MetricsViewSpec_FieldConditionV2MultiError) AllErrors() []error { return m }
+
+// MetricsViewSpec_FieldConditionV2ValidationError is the validation error
+// returned by MetricsViewSpec_FieldConditionV2.Validate if the designated
+// constraints aren't met.
+type MetricsViewSpec_FieldConditionV2ValidationError struct {
+	field  string
+	reason string
+	cause  error
+	key    bool
+}
+
+// Field function returns field value.
+func (e MetricsViewSpec_FieldConditionV2ValidationError) Field() string { return e.field }
+
+// Reason function returns reason value.
+func (e MetricsViewSpec_FieldConditionV2ValidationError) Reason() string { return e.reason }
+
+// Cause function returns cause value.
+func (e MetricsViewSpec_FieldConditionV2ValidationError) Cause() error { return e.cause }
+
+// Key function returns key value.
+func (e MetricsViewSpec_FieldConditionV2ValidationError) Key() bool { return e.key }
+
+// ErrorName returns error name.
+func (e MetricsViewSpec_FieldConditionV2ValidationError) ErrorName() string {
+	return "MetricsViewSpec_FieldConditionV2ValidationError"
+}
+
+// Error satisfies the builtin error interface
+func (e MetricsViewSpec_FieldConditionV2ValidationError) Error() string {
+	cause := ""
+	if e.cause != nil {
+		cause = fmt.Sprintf(" | caused by: %v", e.cause)
+	}
+
+	key := ""
+	if e.key {
+		key = "key for "
+	}
+
+	return fmt.Sprintf(
+		"invalid %sMetricsViewSpec_FieldConditionV2.%s: %s%s",
+		key,
+		e.field,
+		e.reason,
+		cause)
+}
+
+var _ error = MetricsViewSpec_FieldConditionV2ValidationError{}
+
+var _ interface {
+	Field() string
+	Reason() string
+	Key() bool
+	Cause() error
+	ErrorName() string
+} = MetricsViewSpec_FieldConditionV2ValidationError{}
@@ -158,3 +158,59 @@ func TestConnectionCacheMultipleConfigs(t *testing.T) {
 	require.Equal(t, 0, len(c.cache))
 	require.Equal(t, 1, c.lruCache.Len())
 }
+
+func TestQueryCache(t *testing.T) {
+	c := newQueryCache(10 * 1024 * 1024)
+	defer c.close()
+
+	s1 := c.instanceStats("i1")
+	c.set("a", s1, &QueryResult{Value: "foo", Bytes: 100})
+	c.set("b", s1, &QueryResult{Value: "bar", Bytes: 200})
+	c.cache.Wait()
+
+	val, ok := c.get("a")
+	require.True(t, ok)
+	require.Equal(t, "foo", val)
+	require.Equal(t, QueryCacheStats{Items: 2, SizeBytes: 300}, s1.snapshot())
+
+	// Replacing an entry doesn't count it twice
+	c.set("b", s1, &QueryResult{Value: "baz", Bytes: 50})
+	c.cache.Wait()
+	require.Equal(t, QueryCacheStats{Items: 2, SizeBytes: 150}, s1.snapshot())
+
+	// Other instances have separate stats
+	s2 := c.instanceStats("i2")
+	require.Equal(t, QueryCacheStats{}, s2.snapshot())
+	require.True(t, s1 == c.instanceStats("i1"))
+
+	// Clearing deletes the entries and removes the stats
+	c.set("c", s2, &QueryResult{Value: "qux", Bytes: 10})
+	c.cache.Wait()
+	c.clear("i1")
+	c.cache.Wait()
+	_, ok = c.get("a")
+	require.False(t, ok)
+	_, ok = c.get("b")
+	require.False(t, ok)
+	require.Equal(t, QueryCacheStats{}, s1.snapshot())
+	require.Equal(t, QueryCacheStats{}, c.snapshot("i1"))
+	require.NotContains(t, c.stats, "i1")
+
+	// Other instances are not affected by the clear
+	val, ok = c.get("c")
+	require.True(t, ok)
+	require.Equal(t, "qux", val)
+	require.Equal(t, QueryCacheStats{Items: 1, SizeBytes: 10}, c.snapshot("i2"))
+
+	// Results of queries that were running during the clear are not cached
+	c.set("d", s1, &QueryResult{Value: "quux", Bytes: 10})
+	c.cache.Wait()
+	_, ok = c.get("d")
+	require.False(t, ok)
+
+	// New stats get a new generation used in cache keys
+	s3 := c.instanceStats("i1")
+	require.Equal(t, QueryCacheStats{}, s3.snapshot())
+	require.NotEqual(t, s1.generation, s3.generation)
+	require.NotEqual(t, s2.generation, s3.generation)
+}
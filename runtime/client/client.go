@@ -3,7 +3,10 @@ package client
 import (
 	"context"
 	"fmt"
+	"io"
+	"net/http"
 	"net/url"
+	"strings"
 
 	runtimev1 "github.com/rilldata/rill/proto/gen/rill/runtime/v1"
 	"go.opentelemetry.io/contrib/instrumentation/google.golang.org/grpc/otelgrpc"
@@ -13,10 +16,12 @@ import (
 )
 
 // Client connects to a runtime server.
-// It's a thin wrapper around the generated gRPC client for proto/rill/runtime/v1.
+// It's a thin wrapper around the generated gRPC clients for proto/rill/runtime/v1.
 type Client struct {
 	runtimev1.RuntimeServiceClient
-	conn *grpc.ClientConn
+	runtimev1.QueryServiceClient
+	conn        *grpc.ClientConn
+	bearerToken string
 }
 
 // New creates a new Client and opens a connection. You must call Close() when done with the client.
@@ -53,10 +58,37 @@ func New(runtimeHost, bearerToken string) (*Client, error) {
 
 	return &Client{
 		RuntimeServiceClient: runtimev1.NewRuntimeServiceClient(conn),
+		QueryServiceClient:   runtimev1.NewQueryServiceClient(conn),
 		conn:                 conn,
+		bearerToken:          bearerToken,
 	}, nil
 }
 
+// Download fetches a file from the runtime's HTTP API, such as a download URL returned by QueryService.Export.
+// It passes the client's bearer token. The caller must close the returned body.
+func (c *Client) Download(ctx context.Context, downloadURL string) (io.ReadCloser, error) {
+	req, err := http.NewRequestWithContext(ctx, http.MethodGet, downloadURL, http.NoBody)
+	if err != nil {
+		return nil, err
+	}
+	if c.bearerToken != "" {
+		req.Header.Set("Authorization", "Bearer "+c.bearerToken)
+	}
+
+	res, err := http.DefaultClient.Do(req)
+	if err != nil {
+		return nil, err
+	}
+
+	if res.StatusCode != http.StatusOK {
+		msg, _ := io.ReadAll(io.LimitReader(res.Body, 1024))
+		res.Body.Close()
+		return nil, fmt.Errorf("download failed with status %d: %s", res.StatusCode, strings.TrimSpace(string(msg)))
+	}
+
+	return res.Body, nil
+}
+
 // Close closes the client connection.
 func (c *Client) Close() error {
 	return c.conn.Close()
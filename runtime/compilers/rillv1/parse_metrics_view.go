@@ -8,6 +8,7 @@ import (
 
 	runtimev1 "github.com/rilldata/rill/proto/gen/rill/runtime/v1"
 	"github.com/rilldata/rill/runtime/pkg/duration"
+	"github.com/rilldata/rill/runtime/pkg/measures"
 	"gopkg.in/yaml.v3"
 )
 
@@ -23,6 +24,8 @@ type metricsViewYAML struct {
 	SmallestTimeGrain  string           `yaml:"smallest_time_grain"`
 	DefaultTimeRange   string           `yaml:"default_time_range"`
 	AvailableTimeZones []string         `yaml:"available_time_zones"`
+	FirstDayOfWeek     uint32           `yaml:"first_day_of_week"`
+	FirstMonthOfYear   uint32           `yaml:"first_month_of_year"`
 	Dimensions         []*struct {
 		Name        string
 		Label       string
@@ -36,13 +39,35 @@ type metricsViewYAML struct {
 		Label               string
 		Expression          string
 		Description         string
-		Format              string `yaml:"format_preset"`
-		Ignore              bool   `yaml:"ignore"`
-		ValidPercentOfTotal bool   `yaml:"valid_percent_of_total"`
+		Format              string   `yaml:"format_preset"`
+		Ignore              bool     `yaml:"ignore"`
+		ValidPercentOfTotal bool     `yaml:"valid_percent_of_total"`
+		Requires            []string `yaml:"requires"`
+		Window              *struct {
+			Measure string `yaml:"measure"`
+			Type    string `yaml:"type"`
+			Size    uint32 `yaml:"size"`
+		} `yaml:"window"`
+		Kind        string  `yaml:"kind"`
+		Column      string  `yaml:"column"`
+		Approximate bool    `yaml:"approximate"`
+		Percentile  float64 `yaml:"percentile"`
 	}
+	Security *struct {
+		Access    string                `yaml:"access"`
+		RowFilter string                `yaml:"row_filter"`
+		Include   []*fieldConditionYAML `yaml:"include"`
+		Exclude   []*fieldConditionYAML `yaml:"exclude"`
+	} `yaml:"security"`
 	// ExtraProps map[string]any `yaml:",inline"`
 }
 
+// fieldConditionYAML is the raw structure of a condition for including or excluding dimensions and measures
+type fieldConditionYAML struct {
+	Condition string   `yaml:"if"`
+	Names     []string `yaml:"names"`
+}
+
 // parseMetricsView parses a metrics view (dashboard) definition and adds the resulting resource to p.Resources.
 func (p *Parser) parseMetricsView(ctx context.Context, node *Node) error {
 	// Parse YAML
@@ -92,6 +117,21 @@ func (p *Parser) parseMetricsView(ctx context.Context, node *Node) error {
 		}
 	}
 
+	err = validateCalendar(tmp.FirstDayOfWeek, tmp.FirstMonthOfYear)
+	if err != nil {
+		return err
+	}
+
+	// Fall back to the project defaults in rill.yaml
+	if p.RillYAML != nil && p.RillYAML.Dashboards != nil {
+		if tmp.FirstDayOfWeek == 0 {
+			tmp.FirstDayOfWeek = p.RillYAML.Dashboards.FirstDayOfWeek
+		}
+		if tmp.FirstMonthOfYear == 0 {
+			tmp.FirstMonthOfYear = p.RillYAML.Dashboards.FirstMonthOfYear
+		}
+	}
+
 	names := make(map[string]bool)
 	columns := make(map[string]bool)
 	for i, dim := range tmp.Dimensions {
@@ -153,6 +193,70 @@ func (p *Parser) parseMetricsView(ctx context.Context, node *Node) error {
 		return fmt.Errorf("must define at least one measure")
 	}
 
+	var measureSpecs []*runtimev1.MetricsViewSpec_MeasureV2
+	for _, measure := range tmp.Measures {
+		if measure.Ignore {
+			continue
+		}
+
+		measureSpec := &runtimev1.MetricsViewSpec_MeasureV2{
+			Name:                measure.Name,
+			Expression:          measure.Expression,
+			Label:               measure.Label,
+			Description:         measure.Description,
+			Format:              measure.Format,
+			ValidPercentOfTotal: measure.ValidPercentOfTotal,
+			Requires:            measure.Requires,
+		}
+
+		if measure.Window != nil {
+			typ, err := parseMeasureWindowType(measure.Window.Type)
+			if err != nil {
+				return fmt.Errorf("invalid window for measure %q: %w", measure.Name, err)
+			}
+			measureSpec.Window = &runtimev1.MetricsViewSpec_MeasureWindowV2{
+				Measure: measure.Window.Measure,
+				Type:    typ,
+				Size:    measure.Window.Size,
+			}
+		}
+
+		if err := parseMeasureKind(measureSpec, measure.Kind, measure.Column, measure.Approximate, measure.Percentile); err != nil {
+			return fmt.Errorf("invalid measure %q: %w", measure.Name, err)
+		}
+
+		measureSpecs = append(measureSpecs, measureSpec)
+	}
+
+	// Check that derived measures only reference existing measures and don't have cyclic dependencies
+	if err := measures.Validate(measureSpecs); err != nil {
+		return err
+	}
+
+	if err := validateMeasureWindows(measureSpecs); err != nil {
+		return err
+	}
+
+	if tmp.Security != nil {
+		// The security conditions are resolved per request, so we only check that they are valid templates here
+		if _, err := AnalyzeTemplate(tmp.Security.Access); err != nil {
+			return fmt.Errorf(`invalid "security.access": %w`, err)
+		}
+		if _, err := AnalyzeTemplate(tmp.Security.RowFilter); err != nil {
+			return fmt.Errorf(`invalid "security.row_filter": %w`, err)
+		}
+		for _, cond := range tmp.Security.Include {
+			if err := validateFieldCondition(cond, names); err != nil {
+				return fmt.Errorf(`invalid "security.include": %w`, err)
+			}
+		}
+		for _, cond := range tmp.Security.Exclude {
+			if err := validateFieldCondition(cond, names); err != nil {
+				return fmt.Errorf(`invalid "security.exclude": %w`, err)
+			}
+		}
+	}
+
 	node.Refs = append(node.Refs, ResourceName{Name: table})
 
 	// NOTE: After calling upsertResource, an error must not be returned. Any validation should be done before calling it.
@@ -167,6 +271,8 @@ func (p *Parser) parseMetricsView(ctx context.Context, node *Node) error {
 	spec.SmallestTimeGrain = smallestTimeGrain
 	spec.DefaultTimeRange = tmp.DefaultTimeRange
 	spec.AvailableTimeZones = tmp.AvailableTimeZones
+	spec.FirstDayOfWeek = tmp.FirstDayOfWeek
+	spec.FirstMonthOfYear = tmp.FirstMonthOfYear
 
 	for _, dim := range tmp.Dimensions {
 		if dim.Ignore {
@@ -181,21 +287,149 @@ func (p *Parser) parseMetricsView(ctx context.Context, node *Node) error {
 		})
 	}
 
-	for _, measure := range tmp.Measures {
-		if measure.Ignore {
+	spec.Measures = measureSpecs
+
+	if tmp.Security != nil {
+		spec.Security = &runtimev1.MetricsViewSpec_SecurityV2{
+			Access:    tmp.Security.Access,
+			RowFilter: tmp.Security.RowFilter,
+		}
+		for _, cond := range tmp.Security.Include {
+			spec.Security.Include = append(spec.Security.Include, &runtimev1.MetricsViewSpec_FieldConditionV2{
+				Condition: cond.Condition,
+				Names:     cond.Names,
+			})
+		}
+		for _, cond := range tmp.Security.Exclude {
+			spec.Security.Exclude = append(spec.Security.Exclude, &runtimev1.MetricsViewSpec_FieldConditionV2{
+				Condition: cond.Condition,
+				Names:     cond.Names,
+			})
+		}
+	}
+
+	return nil
+}
+
+// parseMeasureWindowType parses the window type of a window measure.
+func parseMeasureWindowType(s string) (runtimev1.MetricsViewSpec_MeasureWindowV2_Type, error) {
+	switch strings.ToLower(s) {
+	case "rolling_sum":
+		return runtimev1.MetricsViewSpec_MeasureWindowV2_TYPE_ROLLING_SUM, nil
+	case "rolling_avg":
+		return runtimev1.MetricsViewSpec_MeasureWindowV2_TYPE_ROLLING_AVG, nil
+	case "cumulative_sum":
+		return runtimev1.MetricsViewSpec_MeasureWindowV2_TYPE_CUMULATIVE_SUM, nil
+	case "lag":
+		return runtimev1.MetricsViewSpec_MeasureWindowV2_TYPE_LAG, nil
+	case "delta_abs":
+		return runtimev1.MetricsViewSpec_MeasureWindowV2_TYPE_DELTA_ABS, nil
+	case "delta_rel":
+		return runtimev1.MetricsViewSpec_MeasureWindowV2_TYPE_DELTA_REL, nil
+	default:
+		return runtimev1.MetricsViewSpec_MeasureWindowV2_TYPE_UNSPECIFIED, fmt.Errorf("invalid window type %q", s)
+	}
+}
+
+// validateMeasureWindows checks that window measures are computed over a regular measure and have a valid size.
+// It normalizes the window's measure name to the case of the referenced measure and defaults the size of lag and delta windows to 1.
+func validateMeasureWindows(ms []*runtimev1.MetricsViewSpec_MeasureV2) error {
+	byName := make(map[string]*runtimev1.MetricsViewSpec_MeasureV2, len(ms))
+	for _, m := range ms {
+		byName[strings.ToLower(m.Name)] = m
+	}
+
+	for _, m := range ms {
+		for _, r := range m.Requires {
+			if dep := byName[strings.ToLower(r)]; dep != nil && dep.Window != nil {
+				return fmt.Errorf("measure %q can't require window measure %q", m.Name, dep.Name)
+			}
+		}
+
+		w := m.Window
+		if w == nil {
 			continue
 		}
+		if m.Expression != "" {
+			return fmt.Errorf("window measure %q can't have an expression", m.Name)
+		}
+		base, ok := byName[strings.ToLower(w.Measure)]
+		if !ok {
+			return fmt.Errorf("window measure %q is computed over measure %q, which does not exist", m.Name, w.Measure)
+		}
+		if base.Window != nil {
+			return fmt.Errorf("window measure %q can't be computed over window measure %q", m.Name, base.Name)
+		}
+		w.Measure = base.Name
 
-		spec.Measures = append(spec.Measures, &runtimev1.MetricsViewSpec_MeasureV2{
-			Name:                measure.Name,
-			Expression:          measure.Expression,
-			Label:               measure.Label,
-			Description:         measure.Description,
-			Format:              measure.Format,
-			ValidPercentOfTotal: measure.ValidPercentOfTotal,
-		})
+		switch w.Type {
+		case runtimev1.MetricsViewSpec_MeasureWindowV2_TYPE_ROLLING_SUM, runtimev1.MetricsViewSpec_MeasureWindowV2_TYPE_ROLLING_AVG:
+			if w.Size == 0 {
+				return fmt.Errorf("window measure %q must have a size", m.Name)
+			}
+		case runtimev1.MetricsViewSpec_MeasureWindowV2_TYPE_LAG, runtimev1.MetricsViewSpec_MeasureWindowV2_TYPE_DELTA_ABS, runtimev1.MetricsViewSpec_MeasureWindowV2_TYPE_DELTA_REL:
+			if w.Size == 0 {
+				w.Size = 1
+			}
+		}
+	}
+
+	return nil
+}
+
+// validateCalendar checks the first day of week (1 = Monday, 7 = Sunday) and first month of year (1 = January, 12 = December). Zero values are allowed and mean unset.
+func validateCalendar(firstDayOfWeek, firstMonthOfYear uint32) error {
+	if firstDayOfWeek > 7 {
+		return fmt.Errorf(`invalid "first_day_of_week": must be between 1 (Monday) and 7 (Sunday)`)
+	}
+	if firstMonthOfYear > 12 {
+		return fmt.Errorf(`invalid "first_month_of_year": must be between 1 (January) and 12 (December)`)
+	}
+	return nil
+}
+
+// parseMeasureKind sets the kind of a typed measure. Typed measures aggregate a column and don't have an expression.
+func parseMeasureKind(m *runtimev1.MetricsViewSpec_MeasureV2, kind, column string, approximate bool, percentile float64) error {
+	k, err := measures.ParseKind(kind)
+	if err != nil {
+		return err
+	}
+	if k == measures.KindUnspecified {
+		if column != "" || approximate || percentile != 0 {
+			return fmt.Errorf(`"column", "approximate" and "percentile" can only be set for measures with a "kind"`)
+		}
+		return nil
 	}
+	if m.Expression != "" || m.Window != nil || len(m.Requires) > 0 {
+		return fmt.Errorf(`a measure with a "kind" can't have an "expression", "requires" or "window"`)
+	}
+	if err := measures.ValidateKind(k, column, approximate, percentile); err != nil {
+		return err
+	}
+	m.Kind = runtimev1.MetricsViewSpec_MeasureV2_Kind(k)
+	m.Column = column
+	m.Approximate = approximate
+	m.Percentile = percentile
+	return nil
+}
 
+// validateFieldCondition validates a security condition for including or excluding dimensions and measures.
+// The names map must contain the lowercase names of the metrics view's dimensions and measures.
+func validateFieldCondition(cond *fieldConditionYAML, names map[string]bool) error {
+	if cond == nil {
+		return fmt.Errorf("condition is empty")
+	}
+	if _, err := AnalyzeTemplate(cond.Condition); err != nil {
+		return fmt.Errorf(`invalid "if": %w`, err)
+	}
+	if len(cond.Names) == 0 {
+		return fmt.Errorf(`must set at least one value in "names"`)
+	}
+	for _, n := range cond.Names {
+		if !names[strings.ToLower(n)] {
+			return fmt.Errorf("dimension or measure %q not found", n)
+		}
+	}
 	return nil
 }
 
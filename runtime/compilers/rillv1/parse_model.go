@@ -18,9 +18,13 @@ import (
 
 // modelYAML is the raw structure of a Model resource defined in YAML (does not include common fields)
 type modelYAML struct {
-	Materialize  *bool         `yaml:"materialize" mapstructure:"materialize"`
-	Timeout      string        `yaml:"timeout" mapstructure:"timeout"`
-	Refresh      *scheduleYAML `yaml:"refresh" mapstructure:"refresh"`
+	Materialize *bool         `yaml:"materialize" mapstructure:"materialize"`
+	Timeout     string        `yaml:"timeout" mapstructure:"timeout"`
+	Refresh     *scheduleYAML `yaml:"refresh" mapstructure:"refresh"`
+	Incremental bool          `yaml:"incremental" mapstructure:"incremental"`
+	State       *struct {
+		SQL string `yaml:"sql" mapstructure:"sql"`
+	} `yaml:"state" mapstructure:"state"`
 	ParserConfig struct {
 		DuckDB struct {
 			InferRefs      *bool `yaml:"infer_refs" mapstructure:"infer_refs"`
@@ -60,6 +64,25 @@ func (p *Parser) parseModel(ctx context.Context, node *Node) error {
 		return err
 	}
 
+	// Validate incremental config
+	var incrementalStateResolver string
+	if tmp.State != nil {
+		if !tmp.Incremental {
+			return fmt.Errorf(`the "state" property can only be set for incremental models`)
+		}
+		incrementalStateResolver = strings.TrimSpace(tmp.State.SQL)
+		if incrementalStateResolver == "" {
+			return fmt.Errorf(`the "state" property must set "sql"`)
+		}
+	}
+	if tmp.Incremental {
+		if tmp.Materialize != nil && !*tmp.Materialize {
+			return fmt.Errorf("incremental models must be materialized")
+		}
+		b := true
+		tmp.Materialize = &b
+	}
+
 	// If the connector is a DuckDB connector, extract info using DuckDB SQL parsing. This also supports rewriting embedded sources.
 	// (If templating was used, we skip DuckDB inference because the DuckDB parser may not be able to parse the templated code.)
 	isDuckDB := false
@@ -161,6 +184,10 @@ func (p *Parser) parseModel(ctx context.Context, node *Node) error {
 	if schedule != nil {
 		r.ModelSpec.RefreshSchedule = schedule
 	}
+	if tmp.Incremental {
+		r.ModelSpec.Incremental = true
+		r.ModelSpec.IncrementalStateResolver = incrementalStateResolver
+	}
 
 	// parseSource calls parseModel for SQL sources without a connector. Materialize such models if they don't use embedded sources.
 	if node.Kind == ResourceKindSource && r.ModelSpec.Materialize == nil && len(embeddedSources) == 0 {
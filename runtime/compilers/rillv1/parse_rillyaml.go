@@ -15,6 +15,7 @@ type RillYAML struct {
 	Description string
 	Connectors  []*ConnectorDef
 	Variables   []*VariableDef
+	Dashboards  *DashboardDefaults
 }
 
 // ConnectorDef is a subtype of RillYAML, defining connectors required by the project
@@ -30,6 +31,12 @@ type VariableDef struct {
 	Default string
 }
 
+// DashboardDefaults is a subtype of RillYAML, defining defaults for the project's dashboards (metrics views)
+type DashboardDefaults struct {
+	FirstDayOfWeek   uint32
+	FirstMonthOfYear uint32
+}
+
 // rillYAML is the raw YAML structure of rill.yaml
 type rillYAML struct {
 	Title       string            `yaml:"title"`
@@ -40,6 +47,10 @@ type rillYAML struct {
 		Name     string            `yaml:"name"`
 		Defaults map[string]string `yaml:"defaults"`
 	} `yaml:"connectors"`
+	Dashboards struct {
+		FirstDayOfWeek   uint32 `yaml:"first_day_of_week"`
+		FirstMonthOfYear uint32 `yaml:"first_month_of_year"`
+	} `yaml:"dashboards"`
 }
 
 // parseRillYAML parses rill.yaml
@@ -54,11 +65,20 @@ func (p *Parser) parseRillYAML(ctx context.Context, path string) error {
 		return newYAMLError(err)
 	}
 
+	err = validateCalendar(tmp.Dashboards.FirstDayOfWeek, tmp.Dashboards.FirstMonthOfYear)
+	if err != nil {
+		return fmt.Errorf("invalid dashboard defaults in %q: %w", path, err)
+	}
+
 	res := &RillYAML{
 		Title:       tmp.Title,
 		Description: tmp.Description,
 		Connectors:  make([]*ConnectorDef, len(tmp.Connectors)),
 		Variables:   make([]*VariableDef, len(tmp.Env)),
+		Dashboards: &DashboardDefaults{
+			FirstDayOfWeek:   tmp.Dashboards.FirstDayOfWeek,
+			FirstMonthOfYear: tmp.Dashboards.FirstMonthOfYear,
+		},
 	}
 
 	for i, c := range tmp.Connectors {
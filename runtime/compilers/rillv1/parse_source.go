@@ -14,11 +14,14 @@ import (
 
 // sourceYAML is the raw structure of a Source resource defined in YAML (does not include common fields)
 type sourceYAML struct {
-	commonYAML `yaml:",inline" mapstructure:",squash"` // Only to avoid loading common fields into Properties
-	Type       string                                  `yaml:"type"` // Backwards compatibility
-	Timeout    string                                  `yaml:"timeout"`
-	Refresh    *scheduleYAML                           `yaml:"refresh"`
-	Properties map[string]any                          `yaml:",inline" mapstructure:",remain"`
+	commonYAML              `yaml:",inline" mapstructure:",squash"` // Only to avoid loading common fields into Properties
+	Type                    string                                  `yaml:"type"` // Backwards compatibility
+	Timeout                 string                                  `yaml:"timeout"`
+	Refresh                 *scheduleYAML                           `yaml:"refresh"`
+	Incremental             bool                                    `yaml:"incremental"`
+	CursorColumn            string                                  `yaml:"cursor_column" mapstructure:"cursor_column"`
+	DeleteRemovedPartitions bool                                    `yaml:"delete_removed_partitions" mapstructure:"delete_removed_partitions"`
+	Properties              map[string]any                          `yaml:",inline" mapstructure:",remain"`
 }
 
 // parseSource parses a source definition and adds the resulting resource to p.Resources.
@@ -75,6 +78,15 @@ func (p *Parser) parseSource(ctx context.Context, node *Node) error {
 		return fmt.Errorf("must specify a connector")
 	}
 
+	// Validate incremental config.
+	// Incremental SQL store sources must set a cursor column, but that's validated during ingestion since it depends on the connector.
+	if !tmp.Incremental && tmp.CursorColumn != "" {
+		return fmt.Errorf(`"cursor_column" can only be set for incremental sources`)
+	}
+	if !tmp.Incremental && tmp.DeleteRemovedPartitions {
+		return fmt.Errorf(`"delete_removed_partitions" can only be set for incremental sources`)
+	}
+
 	props, err := structpb.NewStruct(tmp.Properties)
 	if err != nil {
 		return fmt.Errorf("encountered invalid property type: %w", err)
@@ -93,6 +105,11 @@ func (p *Parser) parseSource(ctx context.Context, node *Node) error {
 	if schedule != nil {
 		r.SourceSpec.RefreshSchedule = schedule
 	}
+	if tmp.Incremental {
+		r.SourceSpec.Incremental = true
+		r.SourceSpec.CursorColumn = tmp.CursorColumn
+		r.SourceSpec.DeleteRemovedPartitions = tmp.DeleteRemovedPartitions
+	}
 
 	return nil
 }
@@ -234,6 +234,13 @@ func (p *Parser) Reparse(ctx context.Context, paths []string) (*Diff, error) {
 		// Check if path is rill.yaml and clear it (so we can re-parse it)
 		if path == "/rill.yaml" || path == "/rill.yml" {
 			p.RillYAML = nil
+
+			// Metrics views inherit defaults from rill.yaml, so they must be re-parsed as well
+			for _, r := range p.Resources {
+				if r.Name.Kind == ResourceKindMetricsView {
+					checkPaths = append(checkPaths, r.Paths...)
+				}
+			}
 		}
 
 		// Since .sql and .yaml files provide context for each other, if one was modified, we need to reparse both.
@@ -356,17 +363,24 @@ func (p *Parser) parsePaths(ctx context.Context, paths []string) error {
 		return fmt.Errorf("project exceeds file limit of %d", maxFiles)
 	}
 
+	// Handle rill.yaml separately (if parsing of rill.yaml fails, we exit early instead of adding a ParseError).
+	// It's parsed before the other paths since it provides defaults for other resources.
+	for _, path := range paths {
+		if path == "/rill.yaml" || path == "/rill.yml" {
+			err := p.parseRillYAML(ctx, path)
+			if err != nil {
+				return err
+			}
+		}
+	}
+
 	// Sort paths such that we align files with the same name but different extensions next to each other.
 	// Then iterate over the sorted paths, processing all paths with the same stem at once (stem = path without extension).
 	slices.Sort(paths)
 	for i := 0; i < len(paths); {
-		// Handle rill.yaml separately (if parsing of rill.yaml fails, we exit early instead of adding a ParseError)
+		// Skip rill.yaml, which was parsed above
 		path := paths[i]
 		if path == "/rill.yaml" || path == "/rill.yml" {
-			err := p.parseRillYAML(ctx, path)
-			if err != nil {
-				return err
-			}
 			i++
 			continue
 		}
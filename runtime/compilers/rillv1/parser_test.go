@@ -582,6 +582,343 @@ SELECT * FROM m2
 	require.ElementsMatch(t, []ResourceName{m1.Name}, diff.Deleted)
 }
 
+func TestMetricsViewSecurity(t *testing.T) {
+	// Expected
+	files := map[string]string{
+		`rill.yaml`: ``,
+		`dashboards/d1.yaml`: `
+table: t1
+dimensions:
+  - name: domain
+    column: domain
+measures:
+  - name: revenue
+    expression: sum(revenue)
+security:
+  access: "{{ .claims.admin }}"
+  row_filter: "domain = '{{ .claims.domain }}'"
+  exclude:
+    - if: "{{ not .claims.admin }}"
+      names: [revenue]
+`,
+		`dashboards/d2.yaml`: `
+table: t1
+measures:
+  - name: revenue
+    expression: sum(revenue)
+security:
+  include:
+    - if: "true"
+      names: [margin]
+`,
+	}
+	d1 := &Resource{
+		Name:  ResourceName{Kind: ResourceKindMetricsView, Name: "d1"},
+		Paths: []string{"/dashboards/d1.yaml"},
+		MetricsViewSpec: &runtimev1.MetricsViewSpec{
+			Table: "t1",
+			Dimensions: []*runtimev1.MetricsViewSpec_DimensionV2{
+				{Name: "domain", Column: "domain"},
+			},
+			Measures: []*runtimev1.MetricsViewSpec_MeasureV2{
+				{Name: "revenue", Expression: "sum(revenue)"},
+			},
+			Security: &runtimev1.MetricsViewSpec_SecurityV2{
+				Access:    "{{ .claims.admin }}",
+				RowFilter: "domain = '{{ .claims.domain }}'",
+				Exclude: []*runtimev1.MetricsViewSpec_FieldConditionV2{
+					{Condition: "{{ not .claims.admin }}", Names: []string{"revenue"}},
+				},
+			},
+		},
+	}
+	errs := []*runtimev1.ParseError{
+		{
+			Message:  `dimension or measure "margin" not found`,
+			FilePath: "/dashboards/d2.yaml",
+		},
+	}
+
+	// Parse
+	ctx := context.Background()
+	repo := makeRepo(t, files)
+	p, err := Parse(ctx, repo, "", []string{""})
+	require.NoError(t, err)
+	requireResourcesAndErrors(t, p, []*Resource{d1}, errs)
+}
+
+func TestMetricsViewDerivedMeasures(t *testing.T) {
+	files := map[string]string{
+		`rill.yaml`: ``,
+		`dashboards/d1.yaml`: `
+table: t1
+measures:
+  - name: clicks
+    expression: sum(clicks)
+  - name: impressions
+    expression: sum(impressions)
+  - name: ctr
+    expression: clicks / impressions
+    requires: [clicks, impressions]
+`,
+		`dashboards/d2.yaml`: `
+table: t1
+measures:
+  - name: a
+    expression: b + 1
+    requires: [b]
+  - name: b
+    expression: a + 1
+    requires: [a]
+`,
+		`dashboards/d3.yaml`: `
+table: t1
+measures:
+  - name: clicks
+    expression: sum(clicks)
+    ignore: true
+  - name: ctr
+    expression: clicks / count(*)
+    requires: [clicks]
+`,
+	}
+	d1 := &Resource{
+		Name:  ResourceName{Kind: ResourceKindMetricsView, Name: "d1"},
+		Paths: []string{"/dashboards/d1.yaml"},
+		MetricsViewSpec: &runtimev1.MetricsViewSpec{
+			Table: "t1",
+			Measures: []*runtimev1.MetricsViewSpec_MeasureV2{
+				{Name: "clicks", Expression: "sum(clicks)"},
+				{Name: "impressions", Expression: "sum(impressions)"},
+				{Name: "ctr", Expression: "clicks / impressions", Requires: []string{"clicks", "impressions"}},
+			},
+		},
+	}
+	errs := []*runtimev1.ParseError{
+		{
+			Message:  `measure "a" has a cyclic dependency: a -> b -> a`,
+			FilePath: "/dashboards/d2.yaml",
+		},
+		{
+			Message:  `measure "ctr" requires measure "clicks", which does not exist`,
+			FilePath: "/dashboards/d3.yaml",
+		},
+	}
+
+	ctx := context.Background()
+	repo := makeRepo(t, files)
+	p, err := Parse(ctx, repo, "", []string{""})
+	require.NoError(t, err)
+	requireResourcesAndErrors(t, p, []*Resource{d1}, errs)
+}
+
+func TestMetricsViewWindowMeasures(t *testing.T) {
+	files := map[string]string{
+		`rill.yaml`: ``,
+		`dashboards/d1.yaml`: `
+table: t1
+timeseries: t
+measures:
+  - name: clicks
+    expression: sum(clicks)
+  - name: clicks_7d_avg
+    window:
+      measure: Clicks
+      type: rolling_avg
+      size: 7
+  - name: clicks_dod
+    window:
+      measure: clicks
+      type: delta_abs
+`,
+		`dashboards/d2.yaml`: `
+table: t1
+measures:
+  - name: clicks
+    expression: sum(clicks)
+  - name: clicks_7d_avg
+    window:
+      measure: clicks
+      type: rolling_avg
+`,
+		`dashboards/d3.yaml`: `
+table: t1
+measures:
+  - name: clicks
+    expression: sum(clicks)
+  - name: clicks_lag
+    window:
+      measure: clicks
+      type: lag
+  - name: clicks_lag_lag
+    window:
+      measure: clicks_lag
+      type: lag
+`,
+	}
+	d1 := &Resource{
+		Name:  ResourceName{Kind: ResourceKindMetricsView, Name: "d1"},
+		Paths: []string{"/dashboards/d1.yaml"},
+		MetricsViewSpec: &runtimev1.MetricsViewSpec{
+			Table:         "t1",
+			TimeDimension: "t",
+			Measures: []*runtimev1.MetricsViewSpec_MeasureV2{
+				{Name: "clicks", Expression: "sum(clicks)"},
+				{Name: "clicks_7d_avg", Window: &runtimev1.MetricsViewSpec_MeasureWindowV2{Measure: "clicks", Type: runtimev1.MetricsViewSpec_MeasureWindowV2_TYPE_ROLLING_AVG, Size: 7}},
+				{Name: "clicks_dod", Window: &runtimev1.MetricsViewSpec_MeasureWindowV2{Measure: "clicks", Type: runtimev1.MetricsViewSpec_MeasureWindowV2_TYPE_DELTA_ABS, Size: 1}},
+			},
+		},
+	}
+	errs := []*runtimev1.ParseError{
+		{
+			Message:  `window measure "clicks_7d_avg" must have a size`,
+			FilePath: "/dashboards/d2.yaml",
+		},
+		{
+			Message:  `window measure "clicks_lag_lag" can't be computed over window measure "clicks_lag"`,
+			FilePath: "/dashboards/d3.yaml",
+		},
+	}
+
+	ctx := context.Background()
+	repo := makeRepo(t, files)
+	p, err := Parse(ctx, repo, "", []string{""})
+	require.NoError(t, err)
+	requireResourcesAndErrors(t, p, []*Resource{d1}, errs)
+}
+
+func TestMetricsViewCalendar(t *testing.T) {
+	ctx := context.Background()
+	repo := makeRepo(t, map[string]string{
+		`rill.yaml`: `
+dashboards:
+  first_day_of_week: 7
+  first_month_of_year: 2
+`,
+		`dashboards/d1.yaml`: `
+table: t1
+measures:
+  - name: clicks
+    expression: sum(clicks)
+`,
+		`dashboards/d2.yaml`: `
+table: t1
+first_day_of_week: 1
+measures:
+  - name: clicks
+    expression: sum(clicks)
+`,
+		`dashboards/d3.yaml`: `
+table: t1
+first_month_of_year: 13
+measures:
+  - name: clicks
+    expression: sum(clicks)
+`,
+	})
+	mv := func(name string, firstDayOfWeek, firstMonthOfYear uint32) *Resource {
+		return &Resource{
+			Name:  ResourceName{Kind: ResourceKindMetricsView, Name: name},
+			Paths: []string{fmt.Sprintf("/dashboards/%s.yaml", name)},
+			MetricsViewSpec: &runtimev1.MetricsViewSpec{
+				Table:            "t1",
+				Measures:         []*runtimev1.MetricsViewSpec_MeasureV2{{Name: "clicks", Expression: "sum(clicks)"}},
+				FirstDayOfWeek:   firstDayOfWeek,
+				FirstMonthOfYear: firstMonthOfYear,
+			},
+		}
+	}
+	errs := []*runtimev1.ParseError{
+		{
+			Message:  `invalid "first_month_of_year"`,
+			FilePath: "/dashboards/d3.yaml",
+		},
+	}
+
+	p, err := Parse(ctx, repo, "", []string{""})
+	require.NoError(t, err)
+	requireResourcesAndErrors(t, p, []*Resource{mv("d1", 7, 2), mv("d2", 1, 2)}, errs)
+
+	// Changing rill.yaml updates the defaults of the metrics views
+	putRepo(t, repo, map[string]string{
+		`rill.yaml`: `
+dashboards:
+  first_month_of_year: 4
+`,
+	})
+	diff, err := p.Reparse(ctx, []string{"/rill.yaml"})
+	require.NoError(t, err)
+	require.True(t, diff.ModifiedRillYAML)
+	require.ElementsMatch(t, []ResourceName{{Kind: ResourceKindMetricsView, Name: "d1"}, {Kind: ResourceKindMetricsView, Name: "d2"}}, diff.Modified)
+	requireResourcesAndErrors(t, p, []*Resource{mv("d1", 0, 4), mv("d2", 1, 4)}, errs)
+}
+
+func TestMetricsViewMeasureKinds(t *testing.T) {
+	ctx := context.Background()
+	repo := makeRepo(t, map[string]string{
+		`rill.yaml`: ``,
+		`dashboards/d1.yaml`: `
+table: t1
+measures:
+  - name: users
+    kind: count_distinct
+    column: user_id
+    approximate: true
+  - name: p95_latency
+    kind: percentile
+    column: latency
+    percentile: 0.95
+  - name: users_per_day
+    expression: users / 7
+    requires: [users]
+`,
+		`dashboards/d2.yaml`: `
+table: t1
+measures:
+  - name: users
+    kind: count_distinct
+    expression: count(distinct user_id)
+    column: user_id
+`,
+		`dashboards/d3.yaml`: `
+table: t1
+measures:
+  - name: p95_latency
+    kind: percentile
+    column: latency
+`,
+	})
+
+	resources := []*Resource{
+		{
+			Name:  ResourceName{Kind: ResourceKindMetricsView, Name: "d1"},
+			Paths: []string{"/dashboards/d1.yaml"},
+			MetricsViewSpec: &runtimev1.MetricsViewSpec{
+				Table: "t1",
+				Measures: []*runtimev1.MetricsViewSpec_MeasureV2{
+					{Name: "users", Kind: runtimev1.MetricsViewSpec_MeasureV2_KIND_COUNT_DISTINCT, Column: "user_id", Approximate: true},
+					{Name: "p95_latency", Kind: runtimev1.MetricsViewSpec_MeasureV2_KIND_PERCENTILE, Column: "latency", Percentile: 0.95},
+					{Name: "users_per_day", Expression: "users / 7", Requires: []string{"users"}},
+				},
+			},
+		},
+	}
+	errs := []*runtimev1.ParseError{
+		{
+			Message:  `a measure with a "kind" can't have an "expression"`,
+			FilePath: "/dashboards/d2.yaml",
+		},
+		{
+			Message:  "percentile must be between 0 and 1",
+			FilePath: "/dashboards/d3.yaml",
+		},
+	}
+
+	p, err := Parse(ctx, repo, "", []string{""})
+	require.NoError(t, err)
+	requireResourcesAndErrors(t, p, resources, errs)
+}
+
 func requireResourcesAndErrors(t testing.TB, p *Parser, wantResources []*Resource, wantErrors []*runtimev1.ParseError) {
 	// Check resources
 	gotResources := maps.Clone(p.Resources)
@@ -667,3 +1004,118 @@ func must[T any](v T, err error) T {
 	}
 	return v
 }
+
+func TestIncrementalModel(t *testing.T) {
+	truth := true
+	files := map[string]string{
+		`rill.yaml`: ``,
+		`models/m1.yaml`: `
+incremental: true
+state:
+  sql: SELECT MAX(time) AS max_time FROM m1
+`,
+		`models/m1.sql`: `
+SELECT * FROM events {{ if .incremental }} WHERE time > '{{ .state.max_time }}' {{ end }}
+`,
+		`models/m2.sql`: `
+-- @materialize: false
+-- @incremental: true
+SELECT 1 {{ if .incremental }} WHERE false {{ end }}
+`,
+		`models/m3.sql`: `
+-- @state.sql: SELECT 1
+SELECT 1 {{ if .incremental }} WHERE false {{ end }}
+`,
+	}
+	m1 := &Resource{
+		Name:  ResourceName{Kind: ResourceKindModel, Name: "m1"},
+		Paths: []string{"/models/m1.yaml", "/models/m1.sql"},
+		ModelSpec: &runtimev1.ModelSpec{
+			Sql:                      strings.TrimSpace(files["models/m1.sql"]),
+			Materialize:              &truth,
+			UsesTemplating:           true,
+			Incremental:              true,
+			IncrementalStateResolver: "SELECT MAX(time) AS max_time FROM m1",
+		},
+	}
+	errs := []*runtimev1.ParseError{
+		{
+			Message:  "incremental models must be materialized",
+			FilePath: "/models/m2.sql",
+		},
+		{
+			Message:  `the "state" property can only be set for incremental models`,
+			FilePath: "/models/m3.sql",
+		},
+	}
+
+	ctx := context.Background()
+	repo := makeRepo(t, files)
+	p, err := Parse(ctx, repo, "", []string{""})
+	require.NoError(t, err)
+	requireResourcesAndErrors(t, p, []*Resource{m1}, errs)
+}
+
+func TestIncrementalSource(t *testing.T) {
+	files := map[string]string{
+		`rill.yaml`: ``,
+		`sources/s1.yaml`: `
+connector: bigquery
+sql: SELECT * FROM events
+incremental: true
+cursor_column: updated_at
+`,
+		`sources/s2.yaml`: `
+connector: s3
+path: s3://bucket/events/**/*.parquet
+incremental: true
+delete_removed_partitions: true
+`,
+		`sources/s3.yaml`: `
+connector: bigquery
+sql: SELECT * FROM events
+cursor_column: updated_at
+`,
+		`sources/s4.yaml`: `
+connector: s3
+path: s3://bucket/events/**/*.parquet
+delete_removed_partitions: true
+`,
+	}
+	s1 := &Resource{
+		Name:  ResourceName{Kind: ResourceKindSource, Name: "s1"},
+		Paths: []string{"/sources/s1.yaml"},
+		SourceSpec: &runtimev1.SourceSpec{
+			SourceConnector: "bigquery",
+			Properties:      must(structpb.NewStruct(map[string]any{"sql": "SELECT * FROM events"})),
+			Incremental:     true,
+			CursorColumn:    "updated_at",
+		},
+	}
+	s2 := &Resource{
+		Name:  ResourceName{Kind: ResourceKindSource, Name: "s2"},
+		Paths: []string{"/sources/s2.yaml"},
+		SourceSpec: &runtimev1.SourceSpec{
+			SourceConnector:         "s3",
+			Properties:              must(structpb.NewStruct(map[string]any{"path": "s3://bucket/events/**/*.parquet"})),
+			Incremental:             true,
+			DeleteRemovedPartitions: true,
+		},
+	}
+	errs := []*runtimev1.ParseError{
+		{
+			Message:  `"cursor_column" can only be set for incremental sources`,
+			FilePath: "/sources/s3.yaml",
+		},
+		{
+			Message:  `"delete_removed_partitions" can only be set for incremental sources`,
+			FilePath: "/sources/s4.yaml",
+		},
+	}
+
+	ctx := context.Background()
+	repo := makeRepo(t, files)
+	p, err := Parse(ctx, repo, "", []string{""})
+	require.NoError(t, err)
+	requireResourcesAndErrors(t, p, []*Resource{s1, s2}, errs)
+}
@@ -36,6 +36,7 @@ import (
 //     .meta: access the current resource's metadata (resolve time)
 //     .spec: access the current resource's spec (resolve time)
 //     .state: access the current resource's state (resolve time)
+//     .incremental: true if an incremental model is being refreshed incrementally (resolve time, models only)
 //     (All functions from Sprig except OS functions. See http://masterminds.github.io/sprig/ for details.)
 //
 
@@ -47,6 +48,8 @@ type TemplateData struct {
 	Self       TemplateResource
 	Resolve    func(ref ResourceName) (string, error)
 	Lookup     func(name ResourceName) (TemplateResource, error)
+	// Funcs optionally adds template functions (or overrides the default ones).
+	Funcs template.FuncMap
 }
 
 // TemplateResource contains data for a resource for injection into a template.
@@ -130,11 +133,12 @@ func AnalyzeTemplate(tmpl string) (*TemplateMetadata, error) {
 
 	// Build template data
 	dataMap := map[string]interface{}{
-		"claims": map[string]any{},
-		"env":    map[string]any{},
-		"meta":   map[string]any{},
-		"spec":   map[string]any{},
-		"state":  map[string]any{},
+		"claims":      map[string]any{},
+		"env":         map[string]any{},
+		"meta":        map[string]any{},
+		"spec":        map[string]any{},
+		"state":       map[string]any{},
+		"incremental": false,
 	}
 
 	// Resolve template
@@ -204,6 +208,11 @@ func ResolveTemplate(tmpl string, data TemplateData) (string, error) {
 		}, nil
 	}
 
+	// Add custom funcs
+	for k, v := range data.Funcs {
+		funcMap[k] = v
+	}
+
 	// Parse template (error on missing keys)
 	// TODO: missingkey=error may be problematic for claims.
 	t, err := template.New("").Funcs(funcMap).Option("missingkey=error").Parse(tmpl)
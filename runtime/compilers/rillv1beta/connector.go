@@ -184,6 +184,10 @@ func source(connector string, src *runtimev1.Source) drivers.Source {
 		return &drivers.DatabaseSource{
 			Props: props,
 		}
+	case "postgres", "mysql", "snowflake":
+		return &drivers.DatabaseSource{
+			Props: props,
+		}
 	default:
 		return nil
 	}
@@ -12,4 +12,11 @@ type ProjectConfig struct {
 	Variables   map[string]string `yaml:"env,omitempty"`
 	Title       string            `yaml:"title,omitempty"`
 	Description string            `yaml:"description,omitempty"`
+	// Defaults for the project's dashboards
+	Dashboards *DashboardDefaults `yaml:"dashboards,omitempty"`
+}
+
+type DashboardDefaults struct {
+	FirstDayOfWeek   uint32 `yaml:"first_day_of_week,omitempty"`
+	FirstMonthOfYear uint32 `yaml:"first_month_of_year,omitempty"`
 }
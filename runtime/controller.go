@@ -4,12 +4,21 @@ import (
 	"context"
 	"errors"
 	"fmt"
+	"runtime/debug"
+	"strings"
+	"sync"
 	"time"
 
 	runtimev1 "github.com/rilldata/rill/proto/gen/rill/runtime/v1"
 	"github.com/rilldata/rill/runtime/drivers"
+	"github.com/rilldata/rill/runtime/pkg/dag"
 	"go.uber.org/zap"
+	"golang.org/x/exp/maps"
+	"golang.org/x/exp/slices"
 	"golang.org/x/exp/slog"
+	"google.golang.org/protobuf/proto"
+	"google.golang.org/protobuf/reflect/protoreflect"
+	"google.golang.org/protobuf/types/known/timestamppb"
 )
 
 // Built-in resource kinds
@@ -71,84 +80,259 @@ type ControllerOptions struct {
 
 // Controller manages the catalog for a single instance and runs reconcilers to migrate the catalog (and related resources in external databases) into the desired state.
 type Controller struct {
-	Runtime     *Runtime
-	InstanceID  string
+	Runtime    *Runtime
+	InstanceID string
+	// Logger should be used with its *Ctx methods and the ctx passed to Reconcile, which tags the logs with the resource being reconciled.
 	Logger      *slog.Logger
 	opts        *ControllerOptions
 	reconcilers map[string]Reconciler
-	// TODO: Add version for tracking controller version
-	// TODO: Add catalog cache
-	// TODO: Add in-memory log buffer
+	version     int64
+
+	// mu protects the catalog cache and the scheduling state below.
+	mu         sync.Mutex
+	resources  map[string]*controllerResource
+	dag        *dag.DAG
+	queue      map[*controllerResource]bool
+	running    map[*controllerResource]*invocation
+	retriggers map[*controllerResource]time.Time
+	removed    []*runtimev1.ResourceName
+
+	// lockMu is held by Lock/Unlock to delay scheduling of new reconciles.
+	lockMu sync.Mutex
+
+	queueUpdated chan struct{}
+	completed    chan *invocation
 }
 
+// controllerResource is a resource in the controller's in-memory catalog cache.
+type controllerResource struct {
+	r *runtimev1.Resource
+	// persistedName is the name the resource is stored under in the catalog store. It's nil if the resource has not been persisted yet.
+	persistedName *runtimev1.ResourceName
+	// dirty is true if the resource has changes that have not been flushed to the catalog store.
+	dirty bool
+	// cyclic is true if the resource's refs create a cycle in the DAG.
+	cyclic bool
+}
+
+// invocation represents a running call to Reconcile for a resource.
+type invocation struct {
+	res       *controllerResource
+	name      *runtimev1.ResourceName
+	deleted   bool
+	cancel    context.CancelFunc
+	cancelled bool
+	result    ReconcileResult
+}
+
+// invocationCtxKey is used to tag the ctx passed to Reconcile with the invocation.
+// It enables the catalog functions to detect when a resource edits itself.
+type invocationCtxKey struct{}
+
 // NewController creates a new Controller
 func NewController(ctx context.Context, rt *Runtime, instanceID string, logger *zap.Logger, opts *ControllerOptions) *Controller {
+	if opts == nil {
+		opts = &ControllerOptions{}
+	}
+
 	c := &Controller{
-		Runtime:     rt,
-		InstanceID:  instanceID,
-		opts:        opts,
-		reconcilers: make(map[string]Reconciler),
+		Runtime:      rt,
+		InstanceID:   instanceID,
+		opts:         opts,
+		reconcilers:  make(map[string]Reconciler),
+		resources:    make(map[string]*controllerResource),
+		dag:          dag.NewDAG(),
+		queue:        make(map[*controllerResource]bool),
+		running:      make(map[*controllerResource]*invocation),
+		retriggers:   make(map[*controllerResource]time.Time),
+		queueUpdated: make(chan struct{}, 1),
+		completed:    make(chan *invocation),
 	}
 
-	// TODO: Create c.Logger that duplicates logs to a) the Zap logger, b) an in-memory buffer for exposing logs over the API
+	c.Logger = slog.New(newZapHandler(logger.With(zap.String("instance_id", instanceID)), rt.InstanceLogs(instanceID)))
 
 	return c
 }
 
 // Run starts and runs the controller's event loop. It returns when ctx is cancelled or an unrecoverable error occurs.
+//
+// Semantics:
+//   - It runs at most one reconcile per resource name at a time.
+//   - A resource is reconciled when it's created, when its meta or spec is updated by another resource, when it's deleted,
+//     when its refs finish reconciling, and when it's retriggered.
+//   - If a resource is triggered while it's being reconciled, the running reconcile is cancelled and a new one is started.
+//   - Edits a resource makes to itself while reconciling do not trigger a new reconcile.
+//   - A resource is not reconciled while any of its (transitive) refs are pending or running.
 func (c *Controller) Run(ctx context.Context) error {
-	// TODO: Increment controller version in DB and store in c.Version
-
-	// TODO: Load all resources and build DAG of refs
-
-	// TODO: Run event loop: build schedule, linearize and trigger reconcilers on changes
-
-	// Semantics: Run one reconcile per resource name at a time. On new trigger, cancel the old one then invoke new call.
-	// - Only needs catalog locking for non-self resources
-	// - If A is running
-	// 	- And edits itself: no retrigger
-	// 	- And is edited by other: retrigger
-	// 	- And ref is updated: retrigger
-	// - Must handle panics in reconcilers
+	// Increment the controller version and load all resources
+	err := c.load(ctx)
+	if err != nil {
+		return err
+	}
 
-	// Catalog Notes:
-	// - Cache resources for catalog calls in-memory – make workload write-heavy.
-	// - Allow configurable disk reads/flushes. Enables lightweight/ephemeral state updates, like progress info.
-	// - Reads/writes to DB should transactionally check versions of BOTH the controller and resource. Return ErrInconsistentVersion on failure. (Indicates split-brain.)
+	// Close all reconcilers on exit
+	defer func() {
+		for kind, reconciler := range c.reconcilers {
+			err := reconciler.Close(context.Background())
+			if err != nil {
+				c.Logger.Error("failed to close reconciler", slog.String("kind", kind), slog.String("err", err.Error()))
+			}
+		}
+	}()
+
+	// Run the event loop
+	var loopErr error
+	for loopErr == nil {
+		c.schedule(ctx)
+
+		err := c.Flush(ctx)
+		if err != nil {
+			if errors.Is(err, ErrInconsistentControllerVersion) {
+				loopErr = err
+				break
+			}
+			c.Logger.Error("failed to flush catalog", slog.String("err", err.Error()))
+		}
+
+		var timer *time.Timer
+		var timerC <-chan time.Time
+		if t, ok := c.nextRetrigger(); ok {
+			timer = time.NewTimer(time.Until(t))
+			timerC = timer.C
+		}
+
+		select {
+		case <-ctx.Done():
+			loopErr = ctx.Err()
+		case <-c.queueUpdated:
+		case inv := <-c.completed:
+			c.processCompleted(inv)
+		case <-timerC:
+			c.processRetriggers()
+		}
+
+		if timer != nil {
+			timer.Stop()
+		}
+	}
 
-	// TODO: Add handling for rapid deletion and creation?
+	// Cancel running reconciles and wait for them to return
+	c.mu.Lock()
+	for _, inv := range c.running {
+		inv.cancelled = true
+		inv.cancel()
+	}
+	n := len(c.running)
+	c.mu.Unlock()
+	for ; n > 0; n-- {
+		inv := <-c.completed
+		c.mu.Lock()
+		delete(c.running, inv.res)
+		c.mu.Unlock()
+	}
 
-	// TODO: Close all reconcilers
-	// defer func() {
-	// 	var err error
-	// 	for _, reconciler := range c.reconcilers {
-	// 		err = errors.Join(err, reconciler.Close(ctx))
-	// 	}
-	// }()
+	// Flush remaining changes (using a new ctx since ctx is probably cancelled)
+	if !errors.Is(loopErr, ErrInconsistentControllerVersion) {
+		err = c.Flush(context.Background())
+		if err != nil {
+			c.Logger.Error("failed to flush catalog", slog.String("err", err.Error()))
+		}
+	}
 
-	panic("not implemented")
+	return loopErr
 }
 
+// Lock delays the controller from scheduling new reconciles until Unlock is called.
+// It enables a reconciler to make multiple catalog changes without triggering reconciles until all the changes have been made.
+// It does not block calls to the catalog functions.
 func (c *Controller) Lock() {
-	panic("not implemented")
+	c.lockMu.Lock()
 }
 
+// Unlock releases a lock acquired with Lock.
 func (c *Controller) Unlock() {
-	panic("not implemented")
+	c.lockMu.Unlock()
 }
 
+// Get returns a copy of a resource from the catalog.
 func (c *Controller) Get(ctx context.Context, name *runtimev1.ResourceName) (*runtimev1.Resource, error) {
-	panic("not implemented")
+	c.mu.Lock()
+	defer c.mu.Unlock()
+
+	res, ok := c.resources[nameKey(name)]
+	if !ok {
+		return nil, ErrResourceNotFound
+	}
+
+	return proto.Clone(res.r).(*runtimev1.Resource), nil
 }
 
+// List returns copies of all resources in the catalog, ordered by kind and name.
 func (c *Controller) List(ctx context.Context) ([]*runtimev1.Resource, error) {
-	panic("not implemented")
+	c.mu.Lock()
+	defer c.mu.Unlock()
+
+	keys := maps.Keys(c.resources)
+	slices.Sort(keys)
+
+	res := make([]*runtimev1.Resource, len(keys))
+	for i, k := range keys {
+		res[i] = proto.Clone(c.resources[k].r).(*runtimev1.Resource)
+	}
+
+	return res, nil
 }
 
+// Create creates a resource and triggers a reconcile for it.
+// The resource's meta is set from the provided arguments (r.Meta is ignored).
 func (c *Controller) Create(ctx context.Context, name *runtimev1.ResourceName, refs []*runtimev1.ResourceName, owner *runtimev1.ResourceName, paths []string, r *runtimev1.Resource) error {
-	panic("not implemented")
+	c.mu.Lock()
+	defer c.mu.Unlock()
+
+	key := nameKey(name)
+	if existing, ok := c.resources[key]; ok {
+		if !existing.r.Meta.Deleted {
+			return fmt.Errorf("controller: resource %s/%s already exists", name.Kind, name.Name)
+		}
+		// The existing resource is pending deletion. We replace it with the new resource.
+		// If its reconcile is running, it's cancelled and the new resource is not reconciled until it returns (see runningLocked).
+		c.removeLocked(existing)
+		if inv := c.running[existing]; inv != nil {
+			inv.cancelled = true
+			inv.cancel()
+		}
+	}
+
+	r = proto.Clone(r).(*runtimev1.Resource)
+	err := initResourceBody(r)
+	if err != nil {
+		return err
+	}
+
+	now := timestamppb.Now()
+	r.Meta = &runtimev1.ResourceMeta{
+		Name:           proto.Clone(name).(*runtimev1.ResourceName),
+		Refs:           refs,
+		Owner:          owner,
+		FilePaths:      paths,
+		Version:        1,
+		MetaVersion:    1,
+		SpecVersion:    1,
+		StateVersion:   1,
+		CreatedOn:      now,
+		SpecUpdatedOn:  now,
+		StateUpdatedOn: now,
+	}
+
+	res := &controllerResource{r: r, dirty: true}
+	c.resources[key] = res
+	c.addToDAGLocked(res)
+	c.enqueueLocked(res, true)
+
+	return nil
 }
 
+// UpdateMetaOptions contains the meta fields to set in Controller.UpdateMeta.
 type UpdateMetaOptions struct {
 	NewName *runtimev1.ResourceName
 	Refs    []*runtimev1.ResourceName
@@ -156,37 +340,253 @@ type UpdateMetaOptions struct {
 	Paths   []string
 }
 
+// UpdateMeta updates a resource's meta and triggers a reconcile for it.
+// If opts.NewName is set, the resource is renamed and RenamedFrom is set in its meta until it has been reconciled.
 func (c *Controller) UpdateMeta(ctx context.Context, name *runtimev1.ResourceName, opts *UpdateMetaOptions) error {
-	panic("not implemented")
+	c.mu.Lock()
+	defer c.mu.Unlock()
+
+	res, ok := c.resources[nameKey(name)]
+	if !ok {
+		return ErrResourceNotFound
+	}
+	meta := res.r.Meta
+
+	if opts.NewName != nil && nameKey(opts.NewName) != nameKey(meta.Name) {
+		newKey := nameKey(opts.NewName)
+		if _, ok := c.resources[newKey]; ok {
+			return fmt.Errorf("controller: cannot rename to %s/%s: resource already exists", opts.NewName.Kind, opts.NewName.Name)
+		}
+
+		oldKey := nameKey(meta.Name)
+		delete(c.resources, oldKey)
+		c.dag.Delete(oldKey)
+		c.resources[newKey] = res
+
+		if meta.RenamedFrom == nil {
+			meta.RenamedFrom = meta.Name
+		}
+		meta.Name = proto.Clone(opts.NewName).(*runtimev1.ResourceName)
+	} else if opts.NewName != nil {
+		// Only the casing changed
+		meta.Name = proto.Clone(opts.NewName).(*runtimev1.ResourceName)
+	}
+
+	meta.Refs = opts.Refs
+	meta.Owner = opts.Owner
+	meta.FilePaths = opts.Paths
+	meta.MetaVersion++
+	meta.Version++
+	res.dirty = true
+
+	c.addToDAGLocked(res)
+	c.enqueueLocked(res, !c.isSelf(ctx, res))
+
+	return nil
 }
 
+// UpdateSpec updates a resource's meta and spec (taken from r).
+// It triggers a reconcile for the resource, unless it's called by the resource's own reconciler.
 func (c *Controller) UpdateSpec(ctx context.Context, name *runtimev1.ResourceName, refs []*runtimev1.ResourceName, owner *runtimev1.ResourceName, paths []string, r *runtimev1.Resource) error {
-	panic("not implemented")
+	c.mu.Lock()
+	defer c.mu.Unlock()
+
+	res, ok := c.resources[nameKey(name)]
+	if !ok {
+		return ErrResourceNotFound
+	}
+
+	err := copyResourceField(res.r, r, "spec")
+	if err != nil {
+		return err
+	}
+
+	meta := res.r.Meta
+	meta.Refs = refs
+	meta.Owner = owner
+	meta.FilePaths = paths
+	meta.SpecVersion++
+	meta.Version++
+	meta.SpecUpdatedOn = timestamppb.Now()
+	res.dirty = true
+
+	c.addToDAGLocked(res)
+	self := c.isSelf(ctx, res)
+	if !self {
+		c.enqueueLocked(res, true)
+	}
+
+	return nil
 }
 
+// UpdateState updates a resource's state (taken from r). It does not trigger a reconcile.
+// It is usually called by the resource's own reconciler, and it works even if ctx has been cancelled.
 func (c *Controller) UpdateState(ctx context.Context, name *runtimev1.ResourceName, r *runtimev1.Resource) error {
-	// Need to accept a cancelled ctx if it's tagged for the resource
-	panic("not implemented")
+	c.mu.Lock()
+	defer c.mu.Unlock()
+
+	res, ok := c.resources[nameKey(name)]
+	if !ok {
+		return ErrResourceNotFound
+	}
+
+	err := copyResourceField(res.r, r, "state")
+	if err != nil {
+		return err
+	}
+
+	meta := res.r.Meta
+	meta.StateVersion++
+	meta.Version++
+	meta.StateUpdatedOn = timestamppb.Now()
+	res.dirty = true
+
+	return nil
 }
 
+// UpdateError sets the reconcile error of a resource. Passing a nil error clears it.
+// The error is also cleared when a new reconcile starts, and set when Reconcile returns an error.
 func (c *Controller) UpdateError(ctx context.Context, name *runtimev1.ResourceName, err error) error {
-	panic("not implemented")
+	c.mu.Lock()
+	defer c.mu.Unlock()
+
+	res, ok := c.resources[nameKey(name)]
+	if !ok {
+		return ErrResourceNotFound
+	}
+
+	var msg string
+	if err != nil {
+		msg = err.Error()
+	}
+	if res.r.Meta.ReconcileError != msg {
+		res.r.Meta.ReconcileError = msg
+		res.r.Meta.Version++
+		res.dirty = true
+	}
+
+	return nil
 }
 
+// Delete marks resources as deleted and triggers a reconcile for them.
+// The resources are removed from the catalog after their reconciler has run with Meta.Deleted set to true.
 func (c *Controller) Delete(ctx context.Context, names ...*runtimev1.ResourceName) error {
-	panic("not implemented")
+	c.mu.Lock()
+	defer c.mu.Unlock()
+
+	for _, name := range names {
+		res, ok := c.resources[nameKey(name)]
+		if !ok {
+			return ErrResourceNotFound
+		}
+
+		meta := res.r.Meta
+		if meta.Deleted {
+			continue
+		}
+
+		meta.Deleted = true
+		meta.DeletedOn = timestamppb.Now()
+		meta.Version++
+		res.dirty = true
+
+		// Unlike for spec updates, a resource deleting itself must be reconciled again (but without cancelling the current reconcile).
+		c.enqueueLocked(res, !c.isSelf(ctx, res))
+	}
+
+	return nil
 }
 
+// Flush persists changes in the in-memory catalog cache to the catalog store.
 func (c *Controller) Flush(ctx context.Context) error {
-	panic("not implemented")
+	c.mu.Lock()
+	defer c.mu.Unlock()
+
+	var dirty []*controllerResource
+	for _, res := range c.resources {
+		if res.dirty {
+			dirty = append(dirty, res)
+		}
+	}
+	if len(dirty) == 0 && len(c.removed) == 0 {
+		return nil
+	}
+
+	store, release, err := c.catalogStore(ctx)
+	if err != nil {
+		return err
+	}
+	defer release()
+
+	for len(c.removed) > 0 {
+		n := c.removed[0]
+		err := store.DeleteResource(ctx, c.version, n.Kind, n.Name)
+		if err != nil {
+			return c.checkStoreErr(err)
+		}
+		c.removed = c.removed[1:]
+	}
+
+	for _, res := range dirty {
+		// Handle renames by deleting the resource under the old name
+		if res.persistedName != nil && nameKey(res.persistedName) != nameKey(res.r.Meta.Name) {
+			err := store.DeleteResource(ctx, c.version, res.persistedName.Kind, res.persistedName.Name)
+			if err != nil {
+				return c.checkStoreErr(err)
+			}
+			res.persistedName = nil
+		}
+
+		data, err := proto.Marshal(res.r)
+		if err != nil {
+			return err
+		}
+
+		dr := drivers.Resource{
+			Kind: res.r.Meta.Name.Kind,
+			Name: res.r.Meta.Name.Name,
+			Data: data,
+		}
+		if res.persistedName == nil {
+			err = store.CreateResource(ctx, c.version, dr)
+		} else {
+			err = store.UpdateResource(ctx, c.version, dr)
+		}
+		if err != nil {
+			return c.checkStoreErr(err)
+		}
+
+		res.persistedName = proto.Clone(res.r.Meta.Name).(*runtimev1.ResourceName)
+		res.dirty = false
+	}
+
+	return nil
 }
 
+// Retrigger triggers a reconcile for a resource at time t.
+// If t is zero or in the past, the resource is triggered immediately (cancelling any running reconcile for it).
 func (c *Controller) Retrigger(ctx context.Context, name *runtimev1.ResourceName, t time.Time) error {
-	panic("not implemented")
+	c.mu.Lock()
+	defer c.mu.Unlock()
+
+	res, ok := c.resources[nameKey(name)]
+	if !ok {
+		return ErrResourceNotFound
+	}
+
+	if t.IsZero() || !t.After(time.Now()) {
+		c.enqueueLocked(res, !c.isSelf(ctx, res))
+		return nil
+	}
+
+	c.retriggers[res] = t
+	c.signalLocked()
+	return nil
 }
 
+// AcquireConn returns a handle for a connector in the controller's instance.
 func (c *Controller) AcquireConn(ctx context.Context, connector string) (drivers.Handle, func(), error) {
-	panic("not implemented")
+	return c.Runtime.AcquireHandle(ctx, c.InstanceID, connector)
 }
 
 func (c *Controller) AcquireOLAP(ctx context.Context, connector string) (drivers.OLAPStore, func(), error) {
@@ -204,20 +604,406 @@ func (c *Controller) AcquireOLAP(ctx context.Context, connector string) (drivers
 	return olap, release, nil
 }
 
+// load increments the controller version and loads all resources from the catalog store into the in-memory cache.
+// All loaded resources are queued for reconciliation.
+func (c *Controller) load(ctx context.Context) error {
+	store, release, err := c.catalogStore(ctx)
+	if err != nil {
+		return err
+	}
+	defer release()
+
+	c.version, err = store.NextControllerVersion(ctx)
+	if err != nil {
+		return fmt.Errorf("controller: failed to increment version: %w", err)
+	}
+
+	drs, err := store.FindResources(ctx)
+	if err != nil {
+		return fmt.Errorf("controller: failed to load resources: %w", err)
+	}
+
+	c.mu.Lock()
+	defer c.mu.Unlock()
+
+	for _, dr := range drs {
+		r := &runtimev1.Resource{}
+		err := proto.Unmarshal(dr.Data, r)
+		if err != nil {
+			return fmt.Errorf("controller: failed to unmarshal resource %s/%s: %w", dr.Kind, dr.Name, err)
+		}
+
+		res := &controllerResource{r: r, persistedName: proto.Clone(r.Meta.Name).(*runtimev1.ResourceName)}
+		c.resources[nameKey(r.Meta.Name)] = res
+	}
+
+	// Build the DAG after loading all resources and reconcile everything on startup
+	for _, res := range c.resources {
+		c.addToDAGLocked(res)
+		c.queue[res] = true
+	}
+
+	return nil
+}
+
+// schedule starts reconciles for queued resources that are not running and whose refs are not pending.
+// It waits for any lock acquired with Lock to be released.
+func (c *Controller) schedule(ctx context.Context) {
+	c.lockMu.Lock()
+	defer c.lockMu.Unlock()
+
+	c.mu.Lock()
+	defer c.mu.Unlock()
+
+	for res := range c.queue {
+		if c.runningLocked(res) || c.waitingForRefsLocked(res) {
+			continue
+		}
+
+		delete(c.queue, res)
+		delete(c.retriggers, res)
+
+		if res.cyclic {
+			if res.r.Meta.ReconcileError != "cyclic dependency" {
+				res.r.Meta.ReconcileError = "cyclic dependency"
+				res.r.Meta.Version++
+				res.dirty = true
+			}
+			continue
+		}
+
+		c.invokeLocked(ctx, res)
+	}
+}
+
+// invokeLocked starts a reconcile for a resource in a new goroutine.
+// When it completes, the invocation is sent to c.completed.
+func (c *Controller) invokeLocked(ctx context.Context, res *controllerResource) {
+	name := proto.Clone(res.r.Meta.Name).(*runtimev1.ResourceName)
+
+	reconciler, err := c.reconciler(name.Kind)
+	if err != nil {
+		res.r.Meta.ReconcileError = err.Error()
+		res.r.Meta.Version++
+		res.dirty = true
+		return
+	}
+
+	// Clear the error from the previous reconcile
+	if res.r.Meta.ReconcileError != "" {
+		res.r.Meta.ReconcileError = ""
+		res.r.Meta.Version++
+		res.dirty = true
+	}
+
+	ctx, cancel := context.WithCancel(ctx)
+	inv := &invocation{
+		res:     res,
+		name:    name,
+		deleted: res.r.Meta.Deleted,
+		cancel:  cancel,
+	}
+	ctx = context.WithValue(ctx, invocationCtxKey{}, inv)
+	c.running[res] = inv
+
+	go func() {
+		defer func() {
+			if r := recover(); r != nil {
+				c.Logger.ErrorCtx(ctx, "reconciler panicked", slog.Any("panic", r), slog.String("stack", string(debug.Stack())))
+				inv.result = ReconcileResult{Err: fmt.Errorf("panic: %v", r)}
+			}
+			cancel()
+			c.completed <- inv
+		}()
+
+		inv.result = reconciler.Reconcile(ctx, name)
+	}()
+}
+
+// processCompleted updates the catalog after a reconcile has completed.
+func (c *Controller) processCompleted(inv *invocation) {
+	c.mu.Lock()
+	defer c.mu.Unlock()
+
+	res := inv.res
+	delete(c.running, res)
+
+	// Nothing more to do if the resource was replaced or removed while running
+	key := nameKey(res.r.Meta.Name)
+	if c.resources[key] != res {
+		return
+	}
+
+	// If the reconcile was cancelled to be restarted, it's already in the queue
+	if inv.cancelled {
+		return
+	}
+
+	err := inv.result.Err
+	if err != nil {
+		res.r.Meta.ReconcileError = err.Error()
+		res.r.Meta.Version++
+		res.dirty = true
+		if !errors.Is(err, context.Canceled) {
+			c.Logger.Warn("reconcile failed", slog.String("kind", inv.name.Kind), slog.String("name", inv.name.Name), slog.String("err", err.Error()))
+		}
+	}
+
+	// Resources are removed after they have been reconciled with Meta.Deleted set
+	children := c.dag.GetChildren(key)
+	if inv.deleted {
+		c.removeLocked(res)
+	} else {
+		if err == nil && res.r.Meta.RenamedFrom != nil {
+			res.r.Meta.RenamedFrom = nil
+			res.r.Meta.Version++
+			res.dirty = true
+		}
+
+		if !inv.result.Retrigger.IsZero() {
+			c.retriggers[res] = inv.result.Retrigger
+		}
+	}
+
+	// Trigger resources that reference the completed resource
+	for _, ck := range children {
+		if child, ok := c.resources[ck]; ok {
+			c.enqueueLocked(child, true)
+		}
+	}
+}
+
+// processRetriggers queues resources whose retrigger time has passed.
+func (c *Controller) processRetriggers() {
+	c.mu.Lock()
+	defer c.mu.Unlock()
+
+	now := time.Now()
+	for res, t := range c.retriggers {
+		if t.After(now) {
+			continue
+		}
+		delete(c.retriggers, res)
+
+		// If it's running, it stays in the queue until the current reconcile completes.
+		c.queue[res] = true
+	}
+}
+
+// nextRetrigger returns the earliest retrigger time.
+func (c *Controller) nextRetrigger() (time.Time, bool) {
+	c.mu.Lock()
+	defer c.mu.Unlock()
+
+	var next time.Time
+	for _, t := range c.retriggers {
+		if next.IsZero() || t.Before(next) {
+			next = t
+		}
+	}
+	return next, !next.IsZero()
+}
+
+// enqueueLocked queues a resource for reconciliation.
+// If cancelRunning is true and a reconcile is running for the resource, it is cancelled and restarted.
+func (c *Controller) enqueueLocked(res *controllerResource, cancelRunning bool) {
+	c.queue[res] = true
+
+	if cancelRunning {
+		if inv := c.running[res]; inv != nil && !inv.cancelled {
+			inv.cancelled = true
+			inv.cancel()
+		}
+	}
+
+	c.signalLocked()
+}
+
+// signalLocked wakes up the event loop.
+func (c *Controller) signalLocked() {
+	select {
+	case c.queueUpdated <- struct{}{}:
+	default:
+	}
+}
+
+// removeLocked removes a resource from the in-memory catalog and marks it for deletion from the catalog store.
+func (c *Controller) removeLocked(res *controllerResource) {
+	key := nameKey(res.r.Meta.Name)
+	if c.resources[key] == res {
+		delete(c.resources, key)
+		c.dag.Delete(key)
+	}
+	delete(c.queue, res)
+	delete(c.retriggers, res)
+	if res.persistedName != nil {
+		c.removed = append(c.removed, res.persistedName)
+		res.persistedName = nil
+	}
+}
+
+// addToDAGLocked adds or updates a resource's node in the DAG.
+func (c *Controller) addToDAGLocked(res *controllerResource) {
+	key := nameKey(res.r.Meta.Name)
+
+	refs := make([]string, 0, len(res.r.Meta.Refs))
+	for _, ref := range res.r.Meta.Refs {
+		refs = append(refs, nameKey(ref))
+	}
+
+	_, err := c.dag.Add(key, refs)
+	res.cyclic = err != nil
+	if err != nil {
+		// Add without refs to keep the DAG acyclic. The resource will not be reconciled until its refs are fixed.
+		_, _ = c.dag.Add(key, nil)
+	}
+}
+
+// waitingForRefsLocked returns true if any of a resource's transitive refs are queued or running.
+func (c *Controller) waitingForRefsLocked(res *controllerResource) bool {
+	visited := make(map[string]bool)
+	stack := []string{nameKey(res.r.Meta.Name)}
+	for len(stack) > 0 {
+		key := stack[len(stack)-1]
+		stack = stack[:len(stack)-1]
+
+		for _, pk := range c.dag.GetParents(key) {
+			if visited[pk] {
+				continue
+			}
+			visited[pk] = true
+
+			if parent, ok := c.resources[pk]; ok && (c.queue[parent] || c.running[parent] != nil) {
+				return true
+			}
+			stack = append(stack, pk)
+		}
+	}
+	return false
+}
+
+// runningLocked returns true if a reconcile is running for the resource or for another resource with the same name.
+// The latter happens when a resource pending deletion is replaced while it's being reconciled.
+func (c *Controller) runningLocked(res *controllerResource) bool {
+	if c.running[res] != nil {
+		return true
+	}
+	key := nameKey(res.r.Meta.Name)
+	for other, inv := range c.running {
+		if nameKey(other.r.Meta.Name) == key || (inv.name != nil && nameKey(inv.name) == key) {
+			return true
+		}
+	}
+	return false
+}
+
+// isSelf returns true if ctx belongs to a running reconcile for res.
+func (c *Controller) isSelf(ctx context.Context, res *controllerResource) bool {
+	inv, ok := ctx.Value(invocationCtxKey{}).(*invocation)
+	return ok && inv.res == res
+}
+
 // reconciler gets or lazily initializes a reconciler
-// func (c *Controller) reconciler(resourceKind string) Reconciler {
-// 	reconciler := c.reconcilers[resourceKind]
-// 	if reconciler != nil {
-// 		return reconciler
-// 	}
-
-// 	initializer := ReconcilerInitializers[resourceKind]
-// 	if initializer == nil {
-// 		panic(fmt.Errorf("no reconciler registered for resource kind %q", resourceKind))
-// 	}
-
-// 	reconciler = initializer(c)
-// 	c.reconcilers[resourceKind] = reconciler
-
-// 	return reconciler
-// }
+func (c *Controller) reconciler(resourceKind string) (Reconciler, error) {
+	reconciler := c.reconcilers[resourceKind]
+	if reconciler != nil {
+		return reconciler, nil
+	}
+
+	initializer := ReconcilerInitializers[resourceKind]
+	if initializer == nil {
+		return nil, fmt.Errorf("no reconciler registered for resource kind %q", resourceKind)
+	}
+
+	reconciler = initializer(c)
+	c.reconcilers[resourceKind] = reconciler
+
+	return reconciler, nil
+}
+
+// catalogStore returns the catalog store for the controller's resources.
+func (c *Controller) catalogStore(ctx context.Context) (drivers.CatalogStore, func(), error) {
+	if c.opts.EmbedCatalogInConnector == "" {
+		return c.Runtime.Catalog(ctx, c.InstanceID)
+	}
+
+	conn, release, err := c.AcquireConn(ctx, c.opts.EmbedCatalogInConnector)
+	if err != nil {
+		return nil, nil, err
+	}
+
+	store, ok := conn.AsCatalogStore(c.InstanceID)
+	if !ok {
+		release()
+		return nil, nil, fmt.Errorf("connector %q cannot serve as catalog", c.opts.EmbedCatalogInConnector)
+	}
+
+	return store, release, nil
+}
+
+// checkStoreErr maps errors from the catalog store to controller errors.
+func (c *Controller) checkStoreErr(err error) error {
+	if errors.Is(err, drivers.ErrInconsistentControllerVersion) {
+		return ErrInconsistentControllerVersion
+	}
+	return err
+}
+
+// nameKey returns a case-insensitive map key for a resource name.
+func nameKey(n *runtimev1.ResourceName) string {
+	return fmt.Sprintf("%s/%s", n.Kind, strings.ToLower(n.Name))
+}
+
+// resourceBody returns the message set in the resource's oneof (e.g. the *runtimev1.SourceV2 for a source).
+func resourceBody(r *runtimev1.Resource) (protoreflect.Message, error) {
+	m := r.ProtoReflect()
+	fd := m.WhichOneof(m.Descriptor().Oneofs().ByName("resource"))
+	if fd == nil {
+		return nil, fmt.Errorf("controller: resource has no body")
+	}
+	return m.Mutable(fd).Message(), nil
+}
+
+// initResourceBody ensures the spec and state of a resource are not nil.
+func initResourceBody(r *runtimev1.Resource) error {
+	body, err := resourceBody(r)
+	if err != nil {
+		return err
+	}
+
+	for _, name := range []protoreflect.Name{"spec", "state"} {
+		fd := body.Descriptor().Fields().ByName(name)
+		if fd != nil {
+			body.Mutable(fd)
+		}
+	}
+
+	return nil
+}
+
+// copyResourceField copies a field (e.g. "spec" or "state") from the body of src to the body of dst.
+// The resources must be of the same kind.
+func copyResourceField(dst, src *runtimev1.Resource, field protoreflect.Name) error {
+	dstBody, err := resourceBody(dst)
+	if err != nil {
+		return err
+	}
+	srcBody, err := resourceBody(src)
+	if err != nil {
+		return err
+	}
+
+	if dstBody.Descriptor().FullName() != srcBody.Descriptor().FullName() {
+		return fmt.Errorf("controller: cannot update %s with a %s", dstBody.Descriptor().FullName(), srcBody.Descriptor().FullName())
+	}
+
+	fd := srcBody.Descriptor().Fields().ByName(field)
+	if fd == nil {
+		return fmt.Errorf("controller: resource %s has no field %q", srcBody.Descriptor().FullName(), field)
+	}
+
+	val := proto.Clone(srcBody.Get(fd).Message().Interface())
+	dstBody.Set(fd, protoreflect.ValueOfMessage(val.ProtoReflect()))
+
+	return nil
+}
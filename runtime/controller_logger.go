@@ -0,0 +1,178 @@
+package runtime
+
+import (
+	"context"
+	"fmt"
+	"time"
+
+	runtimev1 "github.com/rilldata/rill/proto/gen/rill/runtime/v1"
+	"github.com/rilldata/rill/runtime/pkg/logbuffer"
+	"go.uber.org/zap"
+	"go.uber.org/zap/zapcore"
+	"golang.org/x/exp/slog"
+	"google.golang.org/protobuf/types/known/structpb"
+	"google.golang.org/protobuf/types/known/timestamppb"
+)
+
+// zapHandler is a slog.Handler that writes log records to a zap.Logger.
+// It is used to give controllers and reconcilers a slog.Logger that writes to the runtime's zap.Logger.
+// Records logged with a ctx passed to Reconcile are tagged with the kind and name of the resource being reconciled.
+// If buffer is non-nil, it also tees all log records into buffer.
+type zapHandler struct {
+	logger *zap.Logger
+	buffer *logbuffer.Buffer
+	attrs  []slog.Attr
+	group  string
+}
+
+var _ slog.Handler = (*zapHandler)(nil)
+
+func newZapHandler(logger *zap.Logger, buffer *logbuffer.Buffer) *zapHandler {
+	return &zapHandler{logger: logger, buffer: buffer}
+}
+
+func (h *zapHandler) Enabled(ctx context.Context, lvl slog.Level) bool {
+	return h.buffer != nil || h.logger.Core().Enabled(zapLevel(lvl))
+}
+
+func (h *zapHandler) Handle(ctx context.Context, r slog.Record) error {
+	if h.buffer != nil {
+		h.buffer.Add(h.bufferLog(ctx, r))
+	}
+
+	ce := h.logger.Check(zapLevel(r.Level), r.Message)
+	if ce == nil {
+		return nil
+	}
+
+	fields := make([]zap.Field, 0, r.NumAttrs()+2)
+	if inv, ok := ctx.Value(invocationCtxKey{}).(*invocation); ok {
+		fields = append(fields, zap.String("kind", inv.name.Kind), zap.String("name", inv.name.Name))
+	}
+	r.Attrs(func(a slog.Attr) bool {
+		fields = append(fields, h.field(a))
+		return true
+	})
+
+	ce.Time = r.Time
+	ce.Write(fields...)
+	return nil
+}
+
+func (h *zapHandler) WithAttrs(attrs []slog.Attr) slog.Handler {
+	fields := make([]zap.Field, len(attrs))
+	bufferAttrs := make([]slog.Attr, 0, len(h.attrs)+len(attrs))
+	bufferAttrs = append(bufferAttrs, h.attrs...)
+	for i, a := range attrs {
+		fields[i] = h.field(a)
+		bufferAttrs = append(bufferAttrs, slog.Attr{Key: h.key(a), Value: a.Value})
+	}
+	return &zapHandler{logger: h.logger.With(fields...), buffer: h.buffer, attrs: bufferAttrs, group: h.group}
+}
+
+func (h *zapHandler) WithGroup(name string) slog.Handler {
+	if name == "" {
+		return h
+	}
+	group := name
+	if h.group != "" {
+		group = h.group + "." + name
+	}
+	return &zapHandler{logger: h.logger, buffer: h.buffer, attrs: h.attrs, group: group}
+}
+
+func (h *zapHandler) field(a slog.Attr) zap.Field {
+	return zap.Any(h.key(a), a.Value.Resolve().Any())
+}
+
+func (h *zapHandler) key(a slog.Attr) string {
+	if h.group != "" {
+		return h.group + "." + a.Key
+	}
+	return a.Key
+}
+
+// bufferLog converts a log record to a log entry for the log buffer.
+// If ctx belongs to a reconcile invocation, the entry's payload is tagged with the resource's kind and name.
+func (h *zapHandler) bufferLog(ctx context.Context, r slog.Record) *runtimev1.Log {
+	payload := make(map[string]*structpb.Value, len(h.attrs)+r.NumAttrs()+2)
+	for _, a := range h.attrs {
+		payload[a.Key] = slogValueToPB(a.Value)
+	}
+	r.Attrs(func(a slog.Attr) bool {
+		payload[h.key(a)] = slogValueToPB(a.Value)
+		return true
+	})
+	if inv, ok := ctx.Value(invocationCtxKey{}).(*invocation); ok {
+		payload["kind"] = structpb.NewStringValue(inv.name.Kind)
+		payload["name"] = structpb.NewStringValue(inv.name.Name)
+	}
+
+	return &runtimev1.Log{
+		Level:   logLevel(r.Level),
+		Time:    timestamppb.New(r.Time),
+		Message: r.Message,
+		Payload: &structpb.Struct{Fields: payload},
+	}
+}
+
+// slogValueToPB converts a slog value to a protobuf value.
+// Values that do not have a natural protobuf representation are converted to strings.
+func slogValueToPB(v slog.Value) *structpb.Value {
+	v = v.Resolve()
+	switch v.Kind() {
+	case slog.KindString:
+		return structpb.NewStringValue(v.String())
+	case slog.KindInt64:
+		return structpb.NewNumberValue(float64(v.Int64()))
+	case slog.KindUint64:
+		return structpb.NewNumberValue(float64(v.Uint64()))
+	case slog.KindFloat64:
+		return structpb.NewNumberValue(v.Float64())
+	case slog.KindBool:
+		return structpb.NewBoolValue(v.Bool())
+	case slog.KindDuration:
+		return structpb.NewStringValue(v.Duration().String())
+	case slog.KindTime:
+		return structpb.NewStringValue(v.Time().Format(time.RFC3339Nano))
+	case slog.KindGroup:
+		fields := make(map[string]*structpb.Value)
+		for _, a := range v.Group() {
+			fields[a.Key] = slogValueToPB(a.Value)
+		}
+		return structpb.NewStructValue(&structpb.Struct{Fields: fields})
+	default:
+		if err, ok := v.Any().(error); ok {
+			return structpb.NewStringValue(err.Error())
+		}
+		return structpb.NewStringValue(fmt.Sprint(v.Any()))
+	}
+}
+
+// zapLevel maps a slog level to a zap level.
+func zapLevel(lvl slog.Level) zapcore.Level {
+	switch {
+	case lvl >= slog.LevelError:
+		return zapcore.ErrorLevel
+	case lvl >= slog.LevelWarn:
+		return zapcore.WarnLevel
+	case lvl >= slog.LevelInfo:
+		return zapcore.InfoLevel
+	default:
+		return zapcore.DebugLevel
+	}
+}
+
+// logLevel maps a slog level to a runtimev1.LogLevel.
+func logLevel(lvl slog.Level) runtimev1.LogLevel {
+	switch {
+	case lvl >= slog.LevelError:
+		return runtimev1.LogLevel_LOG_LEVEL_ERROR
+	case lvl >= slog.LevelWarn:
+		return runtimev1.LogLevel_LOG_LEVEL_WARN
+	case lvl >= slog.LevelInfo:
+		return runtimev1.LogLevel_LOG_LEVEL_INFO
+	default:
+		return runtimev1.LogLevel_LOG_LEVEL_DEBUG
+	}
+}
@@ -0,0 +1,36 @@
+package runtime
+
+import (
+	"context"
+	"testing"
+
+	runtimev1 "github.com/rilldata/rill/proto/gen/rill/runtime/v1"
+	"github.com/rilldata/rill/runtime/pkg/logbuffer"
+	"github.com/stretchr/testify/require"
+	"go.uber.org/zap"
+	"go.uber.org/zap/zapcore"
+	"go.uber.org/zap/zaptest/observer"
+	"golang.org/x/exp/slog"
+)
+
+func TestZapHandler_TagsResource(t *testing.T) {
+	core, observed := observer.New(zapcore.InfoLevel)
+	buf := logbuffer.New(10)
+	logger := slog.New(newZapHandler(zap.New(core), buf))
+
+	inv := &invocation{name: &runtimev1.ResourceName{Kind: ResourceKindModel, Name: "orders"}}
+	ctx := context.WithValue(context.Background(), invocationCtxKey{}, inv)
+	logger.InfoCtx(ctx, "created model", slog.String("table", "orders"))
+	logger.Info("failed to flush catalog")
+
+	entries := observed.All()
+	require.Len(t, entries, 2)
+	require.Equal(t, map[string]any{"kind": ResourceKindModel, "name": "orders", "table": "orders"}, entries[0].ContextMap())
+	require.Empty(t, entries[1].ContextMap())
+
+	logs := buf.GetLogs(true, 0, runtimev1.LogLevel_LOG_LEVEL_INFO)
+	require.Len(t, logs, 2)
+	require.Equal(t, "orders", logs[0].Payload.Fields["name"].GetStringValue())
+	require.Equal(t, ResourceKindModel, logs[0].Payload.Fields["kind"].GetStringValue())
+	require.NotContains(t, logs[1].Payload.Fields, "name")
+}
@@ -0,0 +1,281 @@
+package runtime
+
+import (
+	"context"
+	"errors"
+	"sync"
+	"testing"
+	"time"
+
+	runtimev1 "github.com/rilldata/rill/proto/gen/rill/runtime/v1"
+	"github.com/rilldata/rill/runtime/drivers"
+	"github.com/rilldata/rill/runtime/pkg/dag"
+	"github.com/stretchr/testify/require"
+	"go.uber.org/zap"
+)
+
+// testReconciler is a reconciler for BucketPlanner resources used to test the controller.
+// It sets the resource's state region to "done" and records the order of invocations.
+type testReconciler struct {
+	C *Controller
+}
+
+var (
+	testReconcilerMu    sync.Mutex
+	testReconcilerCalls []string
+)
+
+func init() {
+	RegisterReconcilerInitializer(ResourceKindBucketPlanner, func(c *Controller) Reconciler {
+		return &testReconciler{C: c}
+	})
+}
+
+func (r *testReconciler) Close(ctx context.Context) error {
+	return nil
+}
+
+func (r *testReconciler) Reconcile(ctx context.Context, n *runtimev1.ResourceName) ReconcileResult {
+	testReconcilerMu.Lock()
+	testReconcilerCalls = append(testReconcilerCalls, n.Name)
+	testReconcilerMu.Unlock()
+
+	if n.Name == "panic" {
+		panic("oops")
+	}
+
+	self, err := r.C.Get(ctx, n)
+	if err != nil {
+		return ReconcileResult{Err: err}
+	}
+	if self.Meta.Deleted {
+		return ReconcileResult{}
+	}
+
+	if n.Name == "fail" {
+		return ReconcileResult{Err: errors.New("failed")}
+	}
+
+	self.GetBucketPlanner().State.Region = "done"
+	err = r.C.UpdateState(ctx, n, self)
+	if err != nil {
+		return ReconcileResult{Err: err}
+	}
+
+	return ReconcileResult{}
+}
+
+func TestController(t *testing.T) {
+	ctx, cancel := context.WithCancel(context.Background())
+	defer cancel()
+
+	rt := NewTestRunTime(t)
+	inst := &drivers.Instance{
+		ID:         "controller_test",
+		OLAPDriver: "olap",
+		RepoDriver: "repo",
+		Connectors: []*runtimev1.Connector{
+			{Type: "file", Name: "repo", Config: map[string]string{"dsn": t.TempDir()}},
+			{Type: "duckdb", Name: "olap", Config: map[string]string{"dsn": ""}},
+		},
+	}
+	require.NoError(t, rt.CreateInstance(ctx, inst))
+
+	c := NewController(ctx, rt, inst.ID, zap.NewNop(), nil)
+	done := make(chan error)
+	go func() { done <- c.Run(ctx) }()
+
+	a := &runtimev1.ResourceName{Kind: ResourceKindBucketPlanner, Name: "a"}
+	b := &runtimev1.ResourceName{Kind: ResourceKindBucketPlanner, Name: "b"}
+	p := &runtimev1.ResourceName{Kind: ResourceKindBucketPlanner, Name: "panic"}
+	f := &runtimev1.ResourceName{Kind: ResourceKindBucketPlanner, Name: "fail"}
+
+	// Create b before a to check that refs are reconciled first
+	c.Lock()
+	require.NoError(t, c.Create(ctx, b, []*runtimev1.ResourceName{a}, nil, nil, newTestResource()))
+	require.NoError(t, c.Create(ctx, a, nil, nil, nil, newTestResource()))
+	require.NoError(t, c.Create(ctx, p, nil, nil, nil, newTestResource()))
+	require.NoError(t, c.Create(ctx, f, nil, nil, nil, newTestResource()))
+	require.Error(t, c.Create(ctx, a, nil, nil, nil, newTestResource()))
+	c.Unlock()
+
+	requireRegion(t, c, a, "done")
+	requireRegion(t, c, b, "done")
+	testReconcilerMu.Lock()
+	require.Less(t, indexOf(testReconcilerCalls, "a"), indexOf(testReconcilerCalls, "b"))
+	testReconcilerMu.Unlock()
+
+	// Check errors and panics are recorded
+	require.Eventually(t, func() bool {
+		r, err := c.Get(ctx, p)
+		return err == nil && r.Meta.ReconcileError == "panic: oops"
+	}, 5*time.Second, 10*time.Millisecond)
+	require.Eventually(t, func() bool {
+		r, err := c.Get(ctx, f)
+		return err == nil && r.Meta.ReconcileError == "failed"
+	}, 5*time.Second, 10*time.Millisecond)
+
+	// Check the failure was logged to the instance's log buffer
+	logs := rt.InstanceLogs(inst.ID).GetLogs(true, 0, runtimev1.LogLevel_LOG_LEVEL_WARN)
+	require.Condition(t, func() bool {
+		for _, l := range logs {
+			if l.Message == "reconcile failed" && l.Payload.Fields["name"].GetStringValue() == "fail" {
+				return true
+			}
+		}
+		return false
+	})
+
+	// Rename a
+	a2 := &runtimev1.ResourceName{Kind: ResourceKindBucketPlanner, Name: "a2"}
+	require.NoError(t, c.UpdateMeta(ctx, a, &UpdateMetaOptions{NewName: a2}))
+	_, err := c.Get(ctx, a)
+	require.ErrorIs(t, err, ErrResourceNotFound)
+	require.Eventually(t, func() bool {
+		r, err := c.Get(ctx, a2)
+		return err == nil && r.Meta.RenamedFrom == nil
+	}, 5*time.Second, 10*time.Millisecond)
+
+	// Delete b
+	require.NoError(t, c.Delete(ctx, b))
+	require.Eventually(t, func() bool {
+		_, err := c.Get(ctx, b)
+		return errors.Is(err, ErrResourceNotFound)
+	}, 5*time.Second, 10*time.Millisecond)
+
+	// Stop the controller
+	cancel()
+	require.ErrorIs(t, <-done, context.Canceled)
+
+	// Start a new controller and check the resources were persisted
+	ctx, cancel = context.WithCancel(context.Background())
+	defer cancel()
+	c = NewController(ctx, rt, inst.ID, zap.NewNop(), nil)
+	go func() { done <- c.Run(ctx) }()
+	requireRegion(t, c, a2, "done")
+	rs, err := c.List(ctx)
+	require.NoError(t, err)
+	require.Len(t, rs, 3)
+	cancel()
+	require.ErrorIs(t, <-done, context.Canceled)
+}
+
+func TestController_RetriggerWhileRunning(t *testing.T) {
+	c := newBareTestController()
+	res := newTestControllerResource("a")
+	c.resources[nameKey(res.r.Meta.Name)] = res
+	c.running[res] = &invocation{res: res}
+	c.retriggers[res] = time.Now().Add(-time.Second)
+
+	// The retrigger is kept in the queue until the running reconcile completes
+	c.processRetriggers()
+	require.True(t, c.queue[res])
+	require.Empty(t, c.retriggers)
+
+	c.schedule(context.Background())
+	require.True(t, c.queue[res])
+}
+
+func TestController_ReplaceDeletedWhileRunning(t *testing.T) {
+	ctx := context.Background()
+	c := newBareTestController()
+	c.reconcilers[ResourceKindBucketPlanner] = noopReconciler{}
+
+	old := newTestControllerResource("a")
+	old.r.Meta.Deleted = true
+	c.resources[nameKey(old.r.Meta.Name)] = old
+	c.addToDAGLocked(old)
+	inv := &invocation{res: old, name: old.r.Meta.Name, deleted: true, cancel: func() {}}
+	c.running[old] = inv
+
+	// Replacing the resource cancels the running delete, but the new resource waits for it to return
+	name := &runtimev1.ResourceName{Kind: ResourceKindBucketPlanner, Name: "A"}
+	err := c.Create(ctx, name, nil, nil, nil, newTestResource())
+	require.NoError(t, err)
+	require.True(t, inv.cancelled)
+
+	res := c.resources[nameKey(name)]
+	c.schedule(ctx)
+	require.True(t, c.queue[res])
+	require.Nil(t, c.running[res])
+
+	c.processCompleted(inv)
+	c.schedule(ctx)
+	require.False(t, c.queue[res])
+	require.NotNil(t, c.running[res])
+	<-c.completed
+}
+
+func TestController_CyclicDependency(t *testing.T) {
+	c := newBareTestController()
+	res := newTestControllerResource("a")
+	res.cyclic = true
+	c.resources[nameKey(res.r.Meta.Name)] = res
+	c.queue[res] = true
+
+	c.schedule(context.Background())
+	require.Equal(t, "cyclic dependency", res.r.Meta.ReconcileError)
+	require.Equal(t, int64(1), res.r.Meta.Version)
+	require.True(t, res.dirty)
+	require.Empty(t, c.queue)
+
+	// The version is only bumped when the error changes
+	res.dirty = false
+	c.queue[res] = true
+	c.schedule(context.Background())
+	require.Equal(t, int64(1), res.r.Meta.Version)
+	require.False(t, res.dirty)
+}
+
+// noopReconciler is a reconciler that returns immediately.
+type noopReconciler struct{}
+
+func (noopReconciler) Close(ctx context.Context) error {
+	return nil
+}
+
+func (noopReconciler) Reconcile(ctx context.Context, n *runtimev1.ResourceName) ReconcileResult {
+	return ReconcileResult{}
+}
+
+// newBareTestController creates a controller that's not connected to a runtime, for testing its scheduling.
+func newBareTestController() *Controller {
+	return &Controller{
+		reconcilers:  make(map[string]Reconciler),
+		resources:    make(map[string]*controllerResource),
+		dag:          dag.NewDAG(),
+		queue:        make(map[*controllerResource]bool),
+		running:      make(map[*controllerResource]*invocation),
+		retriggers:   make(map[*controllerResource]time.Time),
+		queueUpdated: make(chan struct{}, 1),
+		completed:    make(chan *invocation),
+	}
+}
+
+func newTestControllerResource(name string) *controllerResource {
+	r := newTestResource()
+	r.Meta = &runtimev1.ResourceMeta{Name: &runtimev1.ResourceName{Kind: ResourceKindBucketPlanner, Name: name}}
+	return &controllerResource{r: r}
+}
+
+func newTestResource() *runtimev1.Resource {
+	return &runtimev1.Resource{Resource: &runtimev1.Resource_BucketPlanner{BucketPlanner: &runtimev1.BucketPlanner{
+		Spec: &runtimev1.BucketPlannerSpec{},
+	}}}
+}
+
+func requireRegion(t *testing.T, c *Controller, n *runtimev1.ResourceName, region string) {
+	require.Eventually(t, func() bool {
+		r, err := c.Get(context.Background(), n)
+		return err == nil && r.GetBucketPlanner().State.Region == region
+	}, 5*time.Second, 10*time.Millisecond)
+}
+
+func indexOf(s []string, v string) int {
+	for i, x := range s {
+		if x == v {
+			return i
+		}
+	}
+	return -1
+}
@@ -50,9 +50,14 @@ type blobIterator struct {
 	// data is already fetched during planning stage itself for single file cases
 	// TODO :: refactor this to return a different iterator maybe ?
 	nextPaths []string
+	// versions of all matched objects keyed by object key
+	versions map[string]string
+	// set for incremental ingestion (see Options.PreviousObjects)
+	full            bool
+	stalePartitions []map[string]string
 }
 
-var _ drivers.FileIterator = &blobIterator{}
+var _ drivers.IncrementalFileIterator = &blobIterator{}
 
 type Options struct {
 	GlobMaxTotalSize      int64
@@ -67,6 +72,11 @@ type Options struct {
 	StorageLimitInBytes int64
 	// Retain files and only delete during close
 	KeepFilesUntilClose bool
+	// PreviousObjects contains object versions from a previous ingestion.
+	// If non-nil, the iterator only downloads objects needed to incrementally update the previous ingestion.
+	PreviousObjects map[string]string
+	// DeleteRemovedPartitions marks hive partitions with removed objects as stale (only used with PreviousObjects)
+	DeleteRemovedPartitions bool
 }
 
 // sets defaults if not set by user
@@ -107,10 +117,12 @@ func NewIterator(ctx context.Context, bucket *blob.Bucket, opts Options, l *zap.
 	opts.validate()
 
 	it := &blobIterator{
-		ctx:    ctx,
-		bucket: bucket,
-		opts:   &opts,
-		logger: l,
+		ctx:      ctx,
+		bucket:   bucket,
+		opts:     &opts,
+		logger:   l,
+		versions: make(map[string]string),
+		full:     true,
 	}
 
 	tempDir, err := os.MkdirTemp(os.TempDir(), "blob_ingestion")
@@ -126,7 +138,17 @@ func NewIterator(ctx context.Context, bucket *blob.Bucket, opts Options, l *zap.
 		return nil, err
 	}
 	it.objects = objects
-	if len(objects) == 1 {
+
+	// Only download objects that are new or in stale partitions
+	if opts.PreviousObjects != nil {
+		plan := planIncremental(objects, it.versions, opts.PreviousObjects, opts.DeleteRemovedPartitions)
+		it.objects = plan.objects
+		it.full = plan.full
+		it.stalePartitions = plan.stalePartitions
+		l.Info("planned incremental ingestion", zap.Int("matched", len(objects)), zap.Int("download", len(plan.objects)), zap.Bool("full", plan.full), zap.Int("stale_partitions", len(plan.stalePartitions)), observability.ZapCtx(ctx))
+	}
+
+	if len(it.objects) == 1 {
 		it.nextPaths, err = it.NextBatch(1)
 		it.index = 0
 		if err != nil {
@@ -278,6 +300,21 @@ func (it *blobIterator) KeepFilesUntilClose(keepFilesUntilClose bool) {
 	it.opts.KeepFilesUntilClose = keepFilesUntilClose
 }
 
+// Objects implements drivers.IncrementalFileIterator
+func (it *blobIterator) Objects() map[string]string {
+	return it.versions
+}
+
+// Full implements drivers.IncrementalFileIterator
+func (it *blobIterator) Full() bool {
+	return it.full
+}
+
+// StalePartitions implements drivers.IncrementalFileIterator
+func (it *blobIterator) StalePartitions() []map[string]string {
+	return it.stalePartitions
+}
+
 // todo :: ideally planner should take ownership of the bucket and return an iterator with next returning objectWithPlan
 func (it *blobIterator) plan() ([]*objectWithPlan, error) {
 	var (
@@ -299,6 +336,7 @@ func (it *blobIterator) plan() ([]*objectWithPlan, error) {
 			it.logger.Info("failed to fetch attributes of the object", zap.Error(err))
 		} else {
 			size = attr.Size
+			it.versions[it.opts.GlobPattern] = attributesVersion(attr)
 		}
 
 		planner.add(&blob.ListObject{Key: it.opts.GlobPattern, Size: size})
@@ -321,6 +359,7 @@ func (it *blobIterator) plan() ([]*objectWithPlan, error) {
 			if matched, _ := doublestar.Match(it.opts.GlobPattern, obj.Key); matched {
 				size += obj.Size
 				matchCount++
+				it.versions[obj.Key] = objectVersion(obj)
 				if !planner.add(obj) {
 					break
 				}
@@ -346,8 +385,8 @@ func listOptions(globPattern string) (*blob.ListOptions, bool) {
 		// Access storage.Query via q here.
 		var q *storage.Query
 		if as(&q) {
-			// we only need name and size, adding only required attributes to reduce data fetched
-			_ = q.SetAttrSelection([]string{"Name", "Size"})
+			// we only need name, size and version info, adding only required attributes to reduce data fetched
+			_ = q.SetAttrSelection([]string{"Name", "Size", "Generation", "Updated", "MD5"})
 		}
 		return nil
 	}}
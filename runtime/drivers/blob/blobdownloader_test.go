@@ -3,9 +3,11 @@ package blob
 import (
 	"context"
 	"os"
+	"sort"
 	"testing"
 
 	runtimev1 "github.com/rilldata/rill/proto/gen/rill/runtime/v1"
+	"github.com/rilldata/rill/runtime/drivers"
 	"github.com/rilldata/rill/runtime/pkg/fileutil"
 	"github.com/stretchr/testify/require"
 	"go.uber.org/zap"
@@ -188,3 +190,85 @@ func prepareBucket(t *testing.T) *blob.Bucket {
 	}
 	return bucket
 }
+
+func TestIncrementalIterator(t *testing.T) {
+	ctx := context.Background()
+	files := map[string][]byte{
+		"events/day=01/a.txt": []byte("a"),
+		"events/day=01/b.txt": []byte("b"),
+		"events/day=02/c.txt": []byte("c"),
+		"events/day=03/d.txt": []byte("d"),
+	}
+
+	// Initial ingestion downloads all objects and records their versions
+	it, err := NewIterator(ctx, prepareBucketWithFiles(t, files), Options{GlobPattern: "events/**/*.txt", StorageLimitInBytes: TenGB}, zap.NewNop())
+	require.NoError(t, err)
+	incr := it.(drivers.IncrementalFileIterator)
+	require.True(t, incr.Full())
+	require.Equal(t, []string{"a", "b", "c", "d"}, readAll(t, it))
+	previous := incr.Objects()
+	require.Len(t, previous, 4)
+	require.NoError(t, it.Close())
+
+	// Change an object in day=01, remove the object in day=03 and add an object in day=04
+	files["events/day=01/b.txt"] = []byte("bb")
+	delete(files, "events/day=03/d.txt")
+	files["events/day=04/e.txt"] = []byte("e")
+
+	// Changed partitions are re-ingested and new objects are appended
+	opts := Options{GlobPattern: "events/**/*.txt", StorageLimitInBytes: TenGB, PreviousObjects: previous}
+	it, err = NewIterator(ctx, prepareBucketWithFiles(t, files), opts, zap.NewNop())
+	require.NoError(t, err)
+	incr = it.(drivers.IncrementalFileIterator)
+	require.False(t, incr.Full())
+	require.Equal(t, []map[string]string{{"day": "01"}}, incr.StalePartitions())
+	require.Equal(t, []string{"a", "bb", "e"}, readAll(t, it))
+	require.Len(t, incr.Objects(), 4)
+	require.NoError(t, it.Close())
+
+	// Partitions with removed objects are stale if DeleteRemovedPartitions is set
+	opts.DeleteRemovedPartitions = true
+	it, err = NewIterator(ctx, prepareBucketWithFiles(t, files), opts, zap.NewNop())
+	require.NoError(t, err)
+	incr = it.(drivers.IncrementalFileIterator)
+	require.False(t, incr.Full())
+	require.Equal(t, []map[string]string{{"day": "01"}, {"day": "03"}}, incr.StalePartitions())
+	require.Equal(t, []string{"a", "bb", "e"}, readAll(t, it))
+	require.NoError(t, it.Close())
+
+	// Changes to objects outside a hive partition require a full ingestion
+	files["events/f.txt"] = []byte("f")
+	previous["events/f.txt"] = "outdated"
+	it, err = NewIterator(ctx, prepareBucketWithFiles(t, files), opts, zap.NewNop())
+	require.NoError(t, err)
+	incr = it.(drivers.IncrementalFileIterator)
+	require.True(t, incr.Full())
+	require.Len(t, readAll(t, it), 5)
+	require.NoError(t, it.Close())
+}
+
+func prepareBucketWithFiles(t *testing.T, files map[string][]byte) *blob.Bucket {
+	ctx := context.Background()
+	bucket, err := blob.OpenBucket(ctx, "mem://")
+	require.NoError(t, err)
+
+	for key, value := range files {
+		require.NoError(t, bucket.WriteAll(ctx, key, value, nil))
+	}
+	return bucket
+}
+
+func readAll(t *testing.T, it drivers.FileIterator) []string {
+	var res []string
+	for it.HasNext() {
+		paths, err := it.NextBatch(8)
+		require.NoError(t, err)
+		for _, path := range paths {
+			data, err := os.ReadFile(path)
+			require.NoError(t, err)
+			res = append(res, string(data))
+		}
+	}
+	sort.Strings(res)
+	return res
+}
@@ -0,0 +1,129 @@
+package blob
+
+import (
+	"encoding/hex"
+	"fmt"
+	"path"
+	"sort"
+	"strings"
+
+	"cloud.google.com/go/storage"
+	"github.com/aws/aws-sdk-go/service/s3"
+	"gocloud.dev/blob"
+)
+
+// objectVersion returns a string that changes when the contents of obj change.
+// It uses the generation number for GCS and the ETag for S3, falling back to the MD5 hash or modification time and size.
+func objectVersion(obj *blob.ListObject) string {
+	var attrs storage.ObjectAttrs
+	if obj.As(&attrs) && attrs.Generation != 0 {
+		return fmt.Sprintf("%d", attrs.Generation)
+	}
+
+	var s3obj s3.Object
+	if obj.As(&s3obj) && s3obj.ETag != nil {
+		return strings.Trim(*s3obj.ETag, `"`)
+	}
+
+	if len(obj.MD5) > 0 {
+		return hex.EncodeToString(obj.MD5)
+	}
+
+	return fmt.Sprintf("%d-%d", obj.ModTime.UnixNano(), obj.Size)
+}
+
+// attributesVersion is similar to objectVersion, but for the attributes of a single object.
+func attributesVersion(attrs *blob.Attributes) string {
+	if attrs.ETag != "" {
+		return strings.Trim(attrs.ETag, `"`)
+	}
+
+	if len(attrs.MD5) > 0 {
+		return hex.EncodeToString(attrs.MD5)
+	}
+
+	return fmt.Sprintf("%d-%d", attrs.ModTime.UnixNano(), attrs.Size)
+}
+
+// partitionPath returns the hive partition segments of an object key, e.g. "year=2023/month=01" for "data/year=2023/month=01/file.parquet".
+// It returns an empty string if the key is not in a hive partition.
+func partitionPath(key string) string {
+	var segments []string
+	for _, segment := range strings.Split(path.Dir(key), "/") {
+		if strings.Contains(segment, "=") {
+			segments = append(segments, segment)
+		}
+	}
+	return strings.Join(segments, "/")
+}
+
+// parsePartitionPath parses a path returned from partitionPath to a map of partition keys to values.
+func parsePartitionPath(p string) map[string]string {
+	res := make(map[string]string)
+	for _, segment := range strings.Split(p, "/") {
+		k, v, _ := strings.Cut(segment, "=")
+		res[k] = v
+	}
+	return res
+}
+
+// incrementalPlan contains the objects to download to incrementally update a previous ingestion.
+type incrementalPlan struct {
+	objects         []*objectWithPlan
+	stalePartitions []map[string]string
+	full            bool
+}
+
+// planIncremental determines the objects to download to update a previous ingestion of previous (keyed by object key) to the current objects.
+//
+// New objects are downloaded and appended. If an object has changed, its entire hive partition is stale and re-ingested.
+// If deleteRemoved is true, hive partitions with removed objects are also stale (and re-ingested if they still contain other objects).
+// If a changed or removed object isn't in a hive partition, there's no way to delete its rows, so it falls back to a full ingestion.
+func planIncremental(objects []*objectWithPlan, versions, previous map[string]string, deleteRemoved bool) *incrementalPlan {
+	full := &incrementalPlan{objects: objects, full: true}
+
+	stale := make(map[string]bool)
+	for _, obj := range objects {
+		prev, ok := previous[obj.obj.Key]
+		if !ok || prev == versions[obj.obj.Key] {
+			continue
+		}
+		p := partitionPath(obj.obj.Key)
+		if p == "" {
+			return full
+		}
+		stale[p] = true
+	}
+
+	if deleteRemoved {
+		for key := range previous {
+			if _, ok := versions[key]; ok {
+				continue
+			}
+			p := partitionPath(key)
+			if p == "" {
+				return full
+			}
+			stale[p] = true
+		}
+	}
+
+	res := &incrementalPlan{}
+	for _, obj := range objects {
+		_, ok := previous[obj.obj.Key]
+		if !ok || stale[partitionPath(obj.obj.Key)] {
+			res.objects = append(res.objects, obj)
+		}
+	}
+
+	paths := make([]string, 0, len(stale))
+	for p := range stale {
+		paths = append(paths, p)
+	}
+	sort.Strings(paths)
+	for _, p := range paths {
+		res.stalePartitions = append(res.stalePartitions, parsePartitionPath(p))
+	}
+
+	return res
+}
@@ -2,6 +2,7 @@ package drivers
 
 import (
 	"context"
+	"errors"
 	"fmt"
 	"time"
 
@@ -28,6 +29,26 @@ type CatalogStore interface {
 	UpdateEntry(ctx context.Context, entry *CatalogEntry) error
 	DeleteEntry(ctx context.Context, name string) error
 	DeleteEntries(ctx context.Context) error
+
+	NextControllerVersion(ctx context.Context) (int64, error)
+	CheckControllerVersion(ctx context.Context, v int64) error
+	FindResources(ctx context.Context) ([]Resource, error)
+	CreateResource(ctx context.Context, v int64, r Resource) error
+	UpdateResource(ctx context.Context, v int64, r Resource) error
+	DeleteResource(ctx context.Context, v int64, k, n string) error
+	DeleteResources(ctx context.Context) error
+}
+
+// ErrInconsistentControllerVersion is returned from CatalogStore when the provided controller version doesn't match the current version.
+// It will only be returned if multiple controllers are running simultaneously for the same catalog.
+var ErrInconsistentControllerVersion = errors.New("catalog: inconsistent controller version")
+
+// Resource is an entry in a catalog store for the controller.
+// The Data field contains the serialized resource.
+type Resource struct {
+	Kind string
+	Name string
+	Data []byte
 }
 
 // CatalogEntry represents one object in the catalog, such as a source.
@@ -89,4 +89,49 @@ func testCatalog(t *testing.T, catalog drivers.CatalogStore) {
 	obj, err = catalog.FindEntry(ctx, "bar")
 	require.ErrorIs(t, err, drivers.ErrNotFound)
 	require.Nil(t, obj)
+
+	testCatalogResources(t, catalog)
+}
+
+func testCatalogResources(t *testing.T, catalog drivers.CatalogStore) {
+	ctx := context.Background()
+
+	v1, err := catalog.NextControllerVersion(ctx)
+	require.NoError(t, err)
+	v2, err := catalog.NextControllerVersion(ctx)
+	require.NoError(t, err)
+	require.Greater(t, v2, v1)
+	require.ErrorIs(t, catalog.CheckControllerVersion(ctx, v1), drivers.ErrInconsistentControllerVersion)
+	require.NoError(t, catalog.CheckControllerVersion(ctx, v2))
+
+	rs, err := catalog.FindResources(ctx)
+	require.NoError(t, err)
+	require.Len(t, rs, 0)
+
+	r1 := drivers.Resource{Kind: "kind", Name: "Foo", Data: []byte("hello")}
+	r2 := drivers.Resource{Kind: "kind", Name: "bar", Data: []byte("world")}
+
+	require.ErrorIs(t, catalog.CreateResource(ctx, v1, r1), drivers.ErrInconsistentControllerVersion)
+	require.NoError(t, catalog.CreateResource(ctx, v2, r1))
+	require.Error(t, catalog.CreateResource(ctx, v2, drivers.Resource{Kind: "kind", Name: "foo", Data: []byte("conflict")}))
+	require.NoError(t, catalog.CreateResource(ctx, v2, r2))
+
+	rs, err = catalog.FindResources(ctx)
+	require.NoError(t, err)
+	require.Equal(t, []drivers.Resource{r2, r1}, rs)
+
+	r1.Data = []byte("updated")
+	require.ErrorIs(t, catalog.UpdateResource(ctx, v1, r1), drivers.ErrInconsistentControllerVersion)
+	require.NoError(t, catalog.UpdateResource(ctx, v2, r1))
+	require.ErrorIs(t, catalog.DeleteResource(ctx, v1, r2.Kind, r2.Name), drivers.ErrInconsistentControllerVersion)
+	require.NoError(t, catalog.DeleteResource(ctx, v2, r2.Kind, r2.Name))
+
+	rs, err = catalog.FindResources(ctx)
+	require.NoError(t, err)
+	require.Equal(t, []drivers.Resource{r1}, rs)
+
+	require.NoError(t, catalog.DeleteResources(ctx))
+	rs, err = catalog.FindResources(ctx)
+	require.NoError(t, err)
+	require.Len(t, rs, 0)
 }
@@ -0,0 +1,363 @@
+package clickhouse
+
+import (
+	"context"
+	"fmt"
+	"strings"
+	"time"
+
+	"github.com/jmoiron/sqlx"
+	runtimev1 "github.com/rilldata/rill/proto/gen/rill/runtime/v1"
+	"github.com/rilldata/rill/runtime/drivers"
+)
+
+// estimateSizeTimeout bounds the query used to estimate the database size
+const estimateSizeTimeout = 10 * time.Second
+
+func (c *connection) Dialect() drivers.Dialect {
+	return drivers.DialectClickHouse
+}
+
+// WithConnection runs fn with a dedicated connection, which is needed for session state like temporary tables.
+// Statements executed with the ctx passed to fn use the connection without being queued again.
+func (c *connection) WithConnection(ctx context.Context, priority int, fn drivers.WithConnectionFunc) error {
+	// Check not nested
+	if connFromContext(ctx) != nil {
+		panic("nested WithConnection")
+	}
+
+	// Queue the connection by priority
+	err := c.sem.Acquire(ctx, priority)
+	if err != nil {
+		return err
+	}
+	defer c.sem.Release()
+
+	conn, err := c.db.Connx(ctx)
+	if err != nil {
+		return err
+	}
+	defer conn.Close()
+
+	// Call fn with connection embedded in context
+	wrappedCtx := contextWithConn(ctx, conn)
+	ensuredCtx := contextWithConn(context.Background(), conn)
+	return fn(wrappedCtx, ensuredCtx, conn.Conn)
+}
+
+func (c *connection) Exec(ctx context.Context, stmt *drivers.Statement) error {
+	res, err := c.Execute(ctx, stmt)
+	if err != nil {
+		return err
+	}
+	if stmt.DryRun {
+		return nil
+	}
+	return res.Close()
+}
+
+func (c *connection) Execute(ctx context.Context, stmt *drivers.Statement) (*drivers.Result, error) {
+	// Use the connection from WithConnection if there is one. It has already been admitted by the semaphore.
+	var q sqlx.QueryerContext = c.db
+	conn := connFromContext(ctx)
+	if conn != nil {
+		q = conn
+	}
+
+	if stmt.DryRun {
+		// TODO: Find way to validate with args
+		rows, err := q.QueryxContext(ctx, "EXPLAIN "+stmt.Query)
+		if err != nil {
+			return nil, err
+		}
+		return nil, rows.Close()
+	}
+
+	// Queue the query by priority.
+	// NOTE: The semaphore is only released when the result is closed.
+	if conn == nil {
+		err := c.sem.Acquire(ctx, stmt.Priority)
+		if err != nil {
+			return nil, err
+		}
+	}
+
+	var cancelFunc context.CancelFunc
+	if stmt.ExecutionTimeout != 0 {
+		ctx, cancelFunc = context.WithTimeout(ctx, stmt.ExecutionTimeout)
+	}
+
+	release := func() {
+		if cancelFunc != nil {
+			cancelFunc()
+		}
+		if conn == nil {
+			c.sem.Release()
+		}
+	}
+
+	rows, err := q.QueryxContext(ctx, stmt.Query, stmt.Args...)
+	if err != nil {
+		release()
+		return nil, err
+	}
+
+	schema, err := rowsToSchema(rows)
+	if err != nil {
+		_ = rows.Close()
+		release()
+		return nil, err
+	}
+
+	r := &drivers.Result{Rows: rows, Schema: schema}
+	r.SetCleanupFunc(func() error {
+		release()
+		return nil
+	})
+
+	return r, nil
+}
+
+// EstimateSize returns the compressed size of the active parts of all tables in the current database.
+func (c *connection) EstimateSize() (int64, bool) {
+	ctx, cancel := context.WithTimeout(context.Background(), estimateSizeTimeout)
+	defer cancel()
+
+	var size int64
+	err := c.db.QueryRowxContext(ctx, "SELECT toInt64(sum(bytes_on_disk)) FROM system.parts WHERE active AND database = currentDatabase()").Scan(&size)
+	if err != nil {
+		return 0, false
+	}
+	return size, true
+}
+
+func (c *connection) DropDB() error {
+	return fmt.Errorf("dropping database not supported")
+}
+
+func rowsToSchema(r *sqlx.Rows) (*runtimev1.StructType, error) {
+	if r == nil {
+		return nil, nil
+	}
+
+	cts, err := r.ColumnTypes()
+	if err != nil {
+		return nil, err
+	}
+
+	fields := make([]*runtimev1.StructType_Field, len(cts))
+	for i, ct := range cts {
+		t, err := databaseTypeToPB(ct.DatabaseTypeName())
+		if err != nil {
+			return nil, err
+		}
+
+		fields[i] = &runtimev1.StructType_Field{
+			Name: ct.Name(),
+			Type: t,
+		}
+	}
+
+	return &runtimev1.StructType{Fields: fields}, nil
+}
+
+type informationSchema struct {
+	c *connection
+}
+
+func (c *connection) InformationSchema() drivers.InformationSchema {
+	return informationSchema{c: c}
+}
+
+func (i informationSchema) All(ctx context.Context) ([]*drivers.Table, error) {
+	q := `
+		SELECT
+			T.database AS DATABASE,
+			T.name AS NAME,
+			T.engine IN ('View', 'MaterializedView') AS IS_VIEW,
+			C.name AS COLUMN_NAME,
+			C.type AS COLUMN_TYPE
+		FROM system.tables T
+		JOIN system.columns C ON T.database = C.database AND T.name = C.table
+		WHERE T.database = currentDatabase() AND NOT T.is_temporary
+		ORDER BY DATABASE, NAME, C.position
+	`
+
+	rows, err := i.c.db.QueryxContext(ctx, q)
+	if err != nil {
+		return nil, err
+	}
+	defer rows.Close()
+
+	tables, err := i.scanTables(rows)
+	if err != nil {
+		return nil, err
+	}
+
+	return tables, nil
+}
+
+func (i informationSchema) Lookup(ctx context.Context, name string) (*drivers.Table, error) {
+	q := `
+		SELECT
+			T.database AS DATABASE,
+			T.name AS NAME,
+			T.engine IN ('View', 'MaterializedView') AS IS_VIEW,
+			C.name AS COLUMN_NAME,
+			C.type AS COLUMN_TYPE
+		FROM system.tables T
+		JOIN system.columns C ON T.database = C.database AND T.name = C.table
+		WHERE T.database = currentDatabase() AND NOT T.is_temporary AND T.name = ?
+		ORDER BY DATABASE, NAME, C.position
+	`
+
+	rows, err := i.c.db.QueryxContext(ctx, q, name)
+	if err != nil {
+		return nil, err
+	}
+	defer rows.Close()
+
+	tables, err := i.scanTables(rows)
+	if err != nil {
+		return nil, err
+	}
+
+	if len(tables) == 0 {
+		return nil, drivers.ErrNotFound
+	}
+
+	return tables[0], nil
+}
+
+func (i informationSchema) scanTables(rows *sqlx.Rows) ([]*drivers.Table, error) {
+	var res []*drivers.Table
+
+	for rows.Next() {
+		var database string
+		var name string
+		var view bool
+		var columnName string
+		var columnType string
+
+		err := rows.Scan(&database, &name, &view, &columnName, &columnType)
+		if err != nil {
+			return nil, err
+		}
+
+		// set t to res[len(res)-1] if it's the same table, else set t to a new table and append it
+		var t *drivers.Table
+		if len(res) > 0 {
+			t = res[len(res)-1]
+			if !(t.Database == database && t.Name == name) {
+				t = nil
+			}
+		}
+		if t == nil {
+			t = &drivers.Table{
+				Database: database,
+				Name:     name,
+				View:     view,
+				Schema:   &runtimev1.StructType{},
+			}
+			res = append(res, t)
+		}
+
+		// parse column type
+		colType, err := databaseTypeToPB(columnType)
+		if err != nil {
+			return nil, err
+		}
+
+		// append column
+		t.Schema.Fields = append(t.Schema.Fields, &runtimev1.StructType_Field{
+			Name: columnName,
+			Type: colType,
+		})
+	}
+
+	if err := rows.Err(); err != nil {
+		return nil, err
+	}
+
+	return res, nil
+}
+
+// databaseTypeToPB converts a ClickHouse type name, such as "Nullable(DateTime64(3, 'UTC'))", to a runtimev1.Type.
+func databaseTypeToPB(dbt string) (*runtimev1.Type, error) {
+	t := &runtimev1.Type{}
+
+	// Unwrap type modifiers
+	for {
+		if inner, ok := unwrapType(dbt, "Nullable"); ok {
+			t.Nullable = true
+			dbt = inner
+			continue
+		}
+		if inner, ok := unwrapType(dbt, "LowCardinality"); ok {
+			dbt = inner
+			continue
+		}
+		break
+	}
+
+	// Strip type parameters, e.g. "Decimal(18, 2)" or "DateTime('UTC')"
+	base := dbt
+	if idx := strings.IndexByte(dbt, '('); idx >= 0 {
+		base = dbt[:idx]
+	}
+
+	switch base {
+	case "Bool":
+		t.Code = runtimev1.Type_CODE_BOOL
+	case "Int8":
+		t.Code = runtimev1.Type_CODE_INT8
+	case "Int16":
+		t.Code = runtimev1.Type_CODE_INT16
+	case "Int32":
+		t.Code = runtimev1.Type_CODE_INT32
+	case "Int64":
+		t.Code = runtimev1.Type_CODE_INT64
+	case "Int128":
+		t.Code = runtimev1.Type_CODE_INT128
+	case "UInt8":
+		t.Code = runtimev1.Type_CODE_UINT8
+	case "UInt16":
+		t.Code = runtimev1.Type_CODE_UINT16
+	case "UInt32":
+		t.Code = runtimev1.Type_CODE_UINT32
+	case "UInt64":
+		t.Code = runtimev1.Type_CODE_UINT64
+	case "Float32":
+		t.Code = runtimev1.Type_CODE_FLOAT32
+	case "Float64":
+		t.Code = runtimev1.Type_CODE_FLOAT64
+	case "Decimal", "Decimal32", "Decimal64", "Decimal128", "Decimal256":
+		t.Code = runtimev1.Type_CODE_DECIMAL
+	case "String", "FixedString", "Enum8", "Enum16", "IPv4", "IPv6":
+		t.Code = runtimev1.Type_CODE_STRING
+	case "UUID":
+		t.Code = runtimev1.Type_CODE_UUID
+	case "Date", "Date32":
+		t.Code = runtimev1.Type_CODE_DATE
+	case "DateTime", "DateTime64":
+		t.Code = runtimev1.Type_CODE_TIMESTAMP
+	case "JSON", "Object":
+		t.Code = runtimev1.Type_CODE_JSON
+	case "Array":
+		t.Code = runtimev1.Type_CODE_ARRAY
+	case "Map":
+		t.Code = runtimev1.Type_CODE_MAP
+	case "Tuple":
+		t.Code = runtimev1.Type_CODE_STRUCT
+	}
+
+	return t, nil
+}
+
+// unwrapType returns the inner type of a type with the given wrapper, e.g. "Nullable(String)" returns "String".
+func unwrapType(dbt, wrapper string) (string, bool) {
+	if strings.HasPrefix(dbt, wrapper+"(") && strings.HasSuffix(dbt, ")") {
+		return dbt[len(wrapper)+1 : len(dbt)-1], true
+	}
+	return "", false
+}
@@ -0,0 +1,36 @@
+package clickhouse
+
+import (
+	"testing"
+
+	runtimev1 "github.com/rilldata/rill/proto/gen/rill/runtime/v1"
+	"github.com/stretchr/testify/require"
+)
+
+func TestDatabaseTypeToPB(t *testing.T) {
+	cases := []struct {
+		dbt      string
+		code     runtimev1.Type_Code
+		nullable bool
+	}{
+		{"UInt64", runtimev1.Type_CODE_UINT64, false},
+		{"Nullable(Float64)", runtimev1.Type_CODE_FLOAT64, true},
+		{"LowCardinality(String)", runtimev1.Type_CODE_STRING, false},
+		{"LowCardinality(Nullable(String))", runtimev1.Type_CODE_STRING, true},
+		{"DateTime64(3, 'UTC')", runtimev1.Type_CODE_TIMESTAMP, false},
+		{"Nullable(DateTime('Asia/Kathmandu'))", runtimev1.Type_CODE_TIMESTAMP, true},
+		{"Date32", runtimev1.Type_CODE_DATE, false},
+		{"Decimal(18, 2)", runtimev1.Type_CODE_DECIMAL, false},
+		{"Array(Nullable(String))", runtimev1.Type_CODE_ARRAY, false},
+		{"Map(String, UInt64)", runtimev1.Type_CODE_MAP, false},
+		{"AggregateFunction(uniq, String)", runtimev1.Type_CODE_UNSPECIFIED, false},
+	}
+	for _, tt := range cases {
+		t.Run(tt.dbt, func(t *testing.T) {
+			typ, err := databaseTypeToPB(tt.dbt)
+			require.NoError(t, err)
+			require.Equal(t, tt.code, typ.Code)
+			require.Equal(t, tt.nullable, typ.Nullable)
+		})
+	}
+}
@@ -2,9 +2,13 @@ package duckdb
 
 import (
 	"context"
+	"database/sql"
+	"errors"
 	"fmt"
+	"strings"
 	"time"
 
+	"github.com/jmoiron/sqlx"
 	runtimev1 "github.com/rilldata/rill/proto/gen/rill/runtime/v1"
 	"github.com/rilldata/rill/runtime/drivers"
 	"google.golang.org/protobuf/proto"
@@ -185,3 +189,175 @@ func (c *connection) DeleteEntries(ctx context.Context) error {
 	_, err = conn.ExecContext(ctx, "DELETE FROM rill.catalog")
 	return c.checkErr(err)
 }
+
+func (c *connection) NextControllerVersion(ctx context.Context) (int64, error) {
+	conn, release, err := c.acquireMetaConn(ctx)
+	if err != nil {
+		return 0, err
+	}
+	defer func() { _ = release() }()
+
+	var version int64
+	err = conn.QueryRowxContext(ctx, "SELECT version FROM rill.controller_version").Scan(&version)
+	if err != nil && !errors.Is(err, sql.ErrNoRows) {
+		return 0, c.checkErr(err)
+	}
+
+	if errors.Is(err, sql.ErrNoRows) {
+		_, err = conn.ExecContext(ctx, "INSERT INTO rill.controller_version(version) VALUES (1)")
+		if err != nil {
+			return 0, c.checkErr(err)
+		}
+		return 1, nil
+	}
+
+	version++
+	_, err = conn.ExecContext(ctx, "UPDATE rill.controller_version SET version = ?", version)
+	if err != nil {
+		return 0, c.checkErr(err)
+	}
+
+	return version, nil
+}
+
+func (c *connection) CheckControllerVersion(ctx context.Context, v int64) error {
+	conn, release, err := c.acquireMetaConn(ctx)
+	if err != nil {
+		return err
+	}
+	defer func() { _ = release() }()
+
+	var version int64
+	err = conn.QueryRowxContext(ctx, "SELECT version FROM rill.controller_version").Scan(&version)
+	if err != nil {
+		return c.checkErr(err)
+	}
+
+	if version != v {
+		return drivers.ErrInconsistentControllerVersion
+	}
+	return nil
+}
+
+// withControllerVersion runs fn in a transaction that fails with drivers.ErrInconsistentControllerVersion if the controller version is not v.
+// The version is checked with an update, which conflicts with concurrent version changes until fn's writes are committed,
+// so a superseded controller can't write to the catalog.
+func (c *connection) withControllerVersion(ctx context.Context, v int64, fn func(tx *sqlx.Tx) error) error {
+	conn, release, err := c.acquireMetaConn(ctx)
+	if err != nil {
+		return err
+	}
+	defer func() { _ = release() }()
+
+	tx, err := conn.BeginTxx(ctx, nil)
+	if err != nil {
+		return c.checkErr(err)
+	}
+	defer func() { _ = tx.Rollback() }()
+
+	res, err := tx.ExecContext(ctx, "UPDATE rill.controller_version SET version = version WHERE version = ?", v)
+	if err != nil {
+		return c.checkErr(err)
+	}
+	n, err := res.RowsAffected()
+	if err != nil {
+		return c.checkErr(err)
+	}
+	if n == 0 {
+		return drivers.ErrInconsistentControllerVersion
+	}
+
+	err = fn(tx)
+	if err != nil {
+		return c.checkErr(err)
+	}
+
+	return c.checkErr(tx.Commit())
+}
+
+func (c *connection) FindResources(ctx context.Context) ([]drivers.Resource, error) {
+	conn, release, err := c.acquireMetaConn(ctx)
+	if err != nil {
+		return nil, err
+	}
+	defer func() { _ = release() }()
+
+	rows, err := conn.QueryxContext(ctx, "SELECT kind, name, data FROM rill.catalogv2 ORDER BY kind, lower_name")
+	if err != nil {
+		return nil, c.checkErr(err)
+	}
+	defer rows.Close()
+
+	var res []drivers.Resource
+	for rows.Next() {
+		r := drivers.Resource{}
+		err := rows.Scan(&r.Kind, &r.Name, &r.Data)
+		if err != nil {
+			return nil, c.checkErr(err)
+		}
+		res = append(res, r)
+	}
+
+	return res, c.checkErr(rows.Err())
+}
+
+func (c *connection) CreateResource(ctx context.Context, v int64, r drivers.Resource) error {
+	return c.withControllerVersion(ctx, v, func(tx *sqlx.Tx) error {
+		// Adding an application side check instead of a unique index because of DuckDB's limitations on indexes.
+		var present bool
+		err := tx.QueryRowContext(ctx, "SELECT COUNT(*) > 0 AS present FROM rill.catalogv2 WHERE kind = ? AND lower_name = ?", r.Kind, strings.ToLower(r.Name)).Scan(&present)
+		if err != nil {
+			return err
+		}
+		if present {
+			return fmt.Errorf("catalog resource %s/%s already exists", r.Kind, r.Name)
+		}
+
+		now := time.Now()
+		_, err = tx.ExecContext(
+			ctx,
+			"INSERT INTO rill.catalogv2(kind, lower_name, name, data, created_on, updated_on) VALUES (?, ?, ?, ?, ?, ?)",
+			r.Kind,
+			strings.ToLower(r.Name),
+			r.Name,
+			r.Data,
+			now,
+			now,
+		)
+		return err
+	})
+}
+
+func (c *connection) UpdateResource(ctx context.Context, v int64, r drivers.Resource) error {
+	return c.withControllerVersion(ctx, v, func(tx *sqlx.Tx) error {
+		_, err := tx.ExecContext(
+			ctx,
+			"UPDATE rill.catalogv2 SET name = ?, data = ?, updated_on = ? WHERE kind = ? AND lower_name = ?",
+			r.Name,
+			r.Data,
+			time.Now(),
+			r.Kind,
+			strings.ToLower(r.Name),
+		)
+		return err
+	})
+}
+
+func (c *connection) DeleteResource(ctx context.Context, v int64, k, n string) error {
+	return c.withControllerVersion(ctx, v, func(tx *sqlx.Tx) error {
+		_, err := tx.ExecContext(ctx, "DELETE FROM rill.catalogv2 WHERE kind = ? AND lower_name = ?", k, strings.ToLower(n))
+		return err
+	})
+}
+
+// DeleteResources deletes the entire catalogv2 table.
+func (c *connection) DeleteResources(ctx context.Context) error {
+	conn, release, err := c.acquireMetaConn(ctx)
+	if err != nil {
+		return err
+	}
+	defer func() { _ = release() }()
+
+	_, err = conn.ExecContext(ctx, "DELETE FROM rill.catalogv2")
+	return c.checkErr(err)
+}
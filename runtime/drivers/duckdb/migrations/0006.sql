@@ -0,0 +1,12 @@
+CREATE TABLE rill.catalogv2 (
+	kind TEXT NOT NULL,
+	lower_name TEXT NOT NULL,
+	name TEXT NOT NULL,
+	data BLOB NOT NULL,
+	created_on TIMESTAMPTZ NOT NULL,
+	updated_on TIMESTAMPTZ NOT NULL
+);
+
+CREATE TABLE rill.controller_version (
+	version BIGINT NOT NULL
+);
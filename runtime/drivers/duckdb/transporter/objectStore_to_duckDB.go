@@ -4,6 +4,7 @@ import (
 	"context"
 	"errors"
 	"fmt"
+	"sort"
 	"strings"
 	"time"
 
@@ -54,11 +55,26 @@ func (t *objectStoreToDuckDB) Transfer(ctx context.Context, source drivers.Sourc
 	sql, hasSQL := src.Properties["sql"].(string)
 	// if sql is specified use ast rewrite to fill in the downloaded files
 	if hasSQL {
+		if src.PreviousObjects != nil {
+			return fmt.Errorf("incremental ingestion is not supported for sources with sql")
+		}
 		return t.ingestDuckDBSQL(ctx, sql, iterator, dbSink, opts, p)
 	}
 
-	p.Target(size, drivers.ProgressUnitByte)
+	// for incremental ingestion, delete rows for stale partitions and append the downloaded files to the existing table
 	appendToTable := false
+	incr, isIncremental := iterator.(drivers.IncrementalFileIterator)
+	if isIncremental && src.PreviousObjects != nil && !incr.Full() {
+		for _, partition := range incr.StalePartitions() {
+			query := fmt.Sprintf("DELETE FROM %s WHERE %s", safeName(dbSink.Table), partitionFilter(partition))
+			if err := t.to.Exec(ctx, &drivers.Statement{Query: query, Priority: 1}); err != nil {
+				return fmt.Errorf("failed to delete rows for stale partition: %w", err)
+			}
+		}
+		appendToTable = true
+	}
+
+	p.Target(size, drivers.ProgressUnitByte)
 	var format string
 	val, formatDefined := src.Properties["format"].(string)
 	if formatDefined {
@@ -117,9 +133,25 @@ func (t *objectStoreToDuckDB) Transfer(ctx context.Context, source drivers.Sourc
 		p.Observe(size, drivers.ProgressUnitByte)
 		appendToTable = true
 	}
+
+	if isIncremental {
+		src.IngestedObjects = incr.Objects()
+	}
 	return nil
 }
 
+// partitionFilter returns a SQL expression that matches rows in the given hive partition.
+// Hive partition values are compared as strings since their inferred types may vary.
+func partitionFilter(partition map[string]string) string {
+	cols := keys(partition)
+	sort.Strings(cols)
+	exprs := make([]string, len(cols))
+	for i, col := range cols {
+		exprs[i] = fmt.Sprintf("CAST(%s AS VARCHAR) = '%s'", safeName(col), strings.ReplaceAll(partition[col], "'", "''"))
+	}
+	return strings.Join(exprs, " AND ")
+}
+
 type appender struct {
 	to                    drivers.OLAPStore
 	sink                  *drivers.DatabaseSink
@@ -41,7 +41,12 @@ func (s *sqlStoreToDuckDB) Transfer(ctx context.Context, source drivers.Source,
 		return fmt.Errorf("type of source should `drivers.DatabaseSink`")
 	}
 
-	iter, err := s.from.Query(ctx, src.Props, src.SQL)
+	qry, err := src.IncrementalSQL()
+	if err != nil {
+		return err
+	}
+
+	iter, err := s.from.Query(ctx, src.Props, qry)
 	if err != nil {
 		return err
 	}
@@ -50,6 +55,10 @@ func (s *sqlStoreToDuckDB) Transfer(ctx context.Context, source drivers.Source,
 	schema, err := iter.Schema(ctx)
 	if err != nil {
 		if errors.Is(err, drivers.ErrIteratorDone) {
+			// Nothing new to append
+			if dbSink.Append {
+				return nil
+			}
 			return fmt.Errorf("no results found for the query")
 		}
 		return err
@@ -59,8 +68,8 @@ func (s *sqlStoreToDuckDB) Transfer(ctx context.Context, source drivers.Source,
 		s.logger.Info("records to be ingested", zap.Uint64("rows", total))
 		p.Target(int64(total), drivers.ProgressUnitRecord)
 	}
-	// create table
-	qry, err := createTableQuery(schema, dbSink.Table)
+	// create table (or keep the existing table if appending)
+	qry, err = createTableQuery(schema, dbSink.Table, dbSink.Append)
 	if err != nil {
 		return err
 	}
@@ -113,8 +122,13 @@ func (s *sqlStoreToDuckDB) Transfer(ctx context.Context, source drivers.Source,
 	})
 }
 
-func createTableQuery(schema *runtimev1.StructType, name string) (string, error) {
-	query := fmt.Sprintf("CREATE OR REPLACE TABLE %s(", safeName(name))
+func createTableQuery(schema *runtimev1.StructType, name string, ifNotExists bool) (string, error) {
+	var query string
+	if ifNotExists {
+		query = fmt.Sprintf("CREATE TABLE IF NOT EXISTS %s(", safeName(name))
+	} else {
+		query = fmt.Sprintf("CREATE OR REPLACE TABLE %s(", safeName(name))
+	}
 	for i, s := range schema.Fields {
 		i++
 		duckDBType, err := pbTypeToDuckDB(s.Type.Code)
@@ -175,7 +189,8 @@ func pbTypeToDuckDB(code runtimev1.Type_Code) (string, error) {
 	case runtimev1.Type_CODE_MAP:
 		return "", fmt.Errorf("map is not supported")
 	case runtimev1.Type_CODE_DECIMAL:
-		return "DECIMAL", nil
+		// DECIMAL defaults to DECIMAL(18,3), which would round values with a larger scale
+		return "DECIMAL(38,9)", nil
 	case runtimev1.Type_CODE_JSON:
 		return "JSON", nil
 	default:
@@ -12,9 +12,12 @@ import (
 )
 
 // Mock SQLStore implementation for testing
-type mockSQLStore struct{}
+type mockSQLStore struct {
+	lastQuery string
+}
 
 func (m *mockSQLStore) Query(ctx context.Context, props map[string]any, qry string) (drivers.RowIterator, error) {
+	m.lastQuery = qry
 	// Return a mock iterator
 	return &mockRowIterator{}, nil
 }
@@ -102,3 +105,71 @@ func TestTransfer(t *testing.T) {
 	require.Equal(t, 1, count)
 	require.NoError(t, rows.Close())
 }
+
+func TestTransferAppend(t *testing.T) {
+	fromStore := &mockSQLStore{}
+	olap := runOLAPStore(t)
+	transporter := transporter.NewSQLStoreToDuckDB(fromStore, olap, zap.NewNop())
+	ctx := context.Background()
+
+	// Initial full ingestion
+	source := &drivers.DatabaseSource{SQL: "SELECT * FROM events", CursorColumn: "col2"}
+	err := transporter.Transfer(ctx, source, &drivers.DatabaseSink{Table: "test_table"}, &drivers.TransferOpts{}, &mockProgress{})
+	require.NoError(t, err)
+	require.Equal(t, "SELECT * FROM events", fromStore.lastQuery)
+
+	// Incremental ingestion appends to the existing table
+	source.Cursor = int64(10)
+	err = transporter.Transfer(ctx, source, &drivers.DatabaseSink{Table: "test_table", Append: true}, &drivers.TransferOpts{}, &mockProgress{})
+	require.NoError(t, err)
+	require.Equal(t, "SELECT * FROM (SELECT * FROM events) AS rill_incremental WHERE \"col2\" >= 10", fromStore.lastQuery)
+
+	rows, err := olap.Execute(ctx, &drivers.Statement{Query: "SELECT count(*) FROM test_table"})
+	require.NoError(t, err)
+	require.True(t, rows.Next())
+	var count int
+	require.NoError(t, rows.Scan(&count))
+	require.Equal(t, 20, count)
+	require.NoError(t, rows.Close())
+}
+
+func TestIncrementalSQL(t *testing.T) {
+	src := &drivers.DatabaseSource{SQL: "SELECT * FROM events", CursorColumn: "id"}
+	qry, err := src.IncrementalSQL()
+	require.NoError(t, err)
+	require.Equal(t, "SELECT * FROM events", qry)
+
+	tests := []struct {
+		name string
+		src  *drivers.DatabaseSource
+		want string
+	}{
+		{
+			name: "postgres",
+			src:  &drivers.DatabaseSource{SQL: "SELECT * FROM events", CursorColumn: "id", Cursor: int64(9007199254740993)},
+			want: `SELECT * FROM (SELECT * FROM events) AS rill_incremental WHERE "id" >= 9007199254740993`,
+		},
+		{
+			name: "mysql",
+			src:  &drivers.DatabaseSource{SQL: "SELECT * FROM events;", CursorColumn: "updated`at", Cursor: "2023-01-01", IdentifierQuote: '`'},
+			want: "SELECT * FROM (SELECT * FROM events) AS rill_incremental WHERE `updated``at` >= '2023-01-01'",
+		},
+		{
+			name: "bigquery",
+			src:  &drivers.DatabaseSource{SQL: "SELECT * FROM `project.dataset.events`", CursorColumn: "id", Cursor: int64(10), IdentifierQuote: '`'},
+			want: "SELECT * FROM (SELECT * FROM `project.dataset.events`) AS rill_incremental WHERE `id` >= 10",
+		},
+		{
+			name: "snowflake",
+			src:  &drivers.DatabaseSource{SQL: "select * from table; \n", CursorColumn: "ID", Cursor: "a'b"},
+			want: `SELECT * FROM (select * from table) AS rill_incremental WHERE "ID" >= 'a''b'`,
+		},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			qry, err := tt.src.IncrementalSQL()
+			require.NoError(t, err)
+			require.Equal(t, tt.want, qry)
+		})
+	}
+}
@@ -268,12 +268,14 @@ func (c *Connection) DownloadFiles(ctx context.Context, source *drivers.BucketSo
 
 	// prepare fetch configs
 	opts := rillblob.Options{
-		GlobMaxTotalSize:      conf.GlobMaxTotalSize,
-		GlobMaxObjectsMatched: conf.GlobMaxObjectsMatched,
-		GlobMaxObjectsListed:  conf.GlobMaxObjectsListed,
-		GlobPageSize:          conf.GlobPageSize,
-		GlobPattern:           conf.url.Path,
-		ExtractPolicy:         source.ExtractPolicy,
+		GlobMaxTotalSize:        conf.GlobMaxTotalSize,
+		GlobMaxObjectsMatched:   conf.GlobMaxObjectsMatched,
+		GlobMaxObjectsListed:    conf.GlobMaxObjectsListed,
+		GlobPageSize:            conf.GlobPageSize,
+		GlobPattern:             conf.url.Path,
+		ExtractPolicy:           source.ExtractPolicy,
+		PreviousObjects:         source.PreviousObjects,
+		DeleteRemovedPartitions: source.DeleteRemovedPartitions,
 	}
 
 	iter, err := rillblob.NewIterator(ctx, bucketObj, opts, c.logger)
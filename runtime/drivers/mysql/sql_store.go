@@ -0,0 +1,233 @@
+package mysql
+
+import (
+	"context"
+	"database/sql"
+	"fmt"
+	"strconv"
+	"strings"
+	"time"
+
+	"github.com/go-sql-driver/mysql"
+	runtimev1 "github.com/rilldata/rill/proto/gen/rill/runtime/v1"
+	"github.com/rilldata/rill/runtime/drivers"
+)
+
+// Query implements drivers.SQLStore.
+// The results are streamed from a dedicated connection, which is closed when the iterator is closed.
+// The DSN is a secret, so it's only read from the connector config and not from the source properties.
+func (c *connection) Query(ctx context.Context, props map[string]any, query string) (drivers.RowIterator, error) {
+	if _, ok := props["dsn"]; ok {
+		return nil, fmt.Errorf("the property 'dsn' can't be set in the source YAML. Pass '--env connector.mysql.dsn=...' to 'rill start' instead")
+	}
+
+	dsn := c.config.DSN
+	if dsn == "" {
+		return nil, fmt.Errorf("the connector variable 'dsn' is required for MySQL. Pass '--env connector.mysql.dsn=...' to 'rill start'")
+	}
+
+	cfg, err := mysql.ParseDSN(dsn)
+	if err != nil {
+		return nil, fmt.Errorf("invalid mysql dsn: %w", err)
+	}
+	// Values are parsed from their text representation (see convert)
+	cfg.ParseTime = false
+
+	connector, err := mysql.NewConnector(cfg)
+	if err != nil {
+		return nil, err
+	}
+	db := sql.OpenDB(connector)
+
+	rows, err := db.QueryContext(ctx, query)
+	if err != nil {
+		db.Close()
+		return nil, err
+	}
+
+	schema, err := rowsSchema(rows)
+	if err != nil {
+		rows.Close()
+		db.Close()
+		return nil, err
+	}
+
+	return &rowIterator{
+		db:     db,
+		rows:   rows,
+		schema: schema,
+	}, nil
+}
+
+type rowIterator struct {
+	db     *sql.DB
+	rows   *sql.Rows
+	schema *runtimev1.StructType
+	// fetched is true if a row has been fetched ahead of Next (see Schema)
+	fetched bool
+}
+
+var _ drivers.RowIterator = &rowIterator{}
+
+// Schema implements drivers.RowIterator.
+// It returns drivers.ErrIteratorDone if the query returned no rows, which is consistent with other SQL stores.
+func (r *rowIterator) Schema(ctx context.Context) (*runtimev1.StructType, error) {
+	if !r.fetched {
+		if !r.rows.Next() {
+			if err := r.rows.Err(); err != nil {
+				return nil, err
+			}
+			return nil, drivers.ErrIteratorDone
+		}
+		r.fetched = true
+	}
+	return r.schema, nil
+}
+
+// Next implements drivers.RowIterator.
+func (r *rowIterator) Next(ctx context.Context) ([]any, error) {
+	if r.fetched {
+		r.fetched = false
+	} else if !r.rows.Next() {
+		if err := r.rows.Err(); err != nil {
+			return nil, err
+		}
+		return nil, drivers.ErrIteratorDone
+	}
+
+	raw := make([]sql.NullString, len(r.schema.Fields))
+	dest := make([]any, len(raw))
+	for i := range raw {
+		dest[i] = &raw[i]
+	}
+	if err := r.rows.Scan(dest...); err != nil {
+		return nil, err
+	}
+
+	vals := make([]any, len(raw))
+	for i, v := range raw {
+		if !v.Valid {
+			continue
+		}
+		var err error
+		vals[i], err = convert(v.String, r.schema.Fields[i].Type.Code)
+		if err != nil {
+			return nil, fmt.Errorf("column %q: %w", r.schema.Fields[i].Name, err)
+		}
+	}
+	return vals, nil
+}
+
+// Close implements drivers.RowIterator.
+func (r *rowIterator) Close() error {
+	err := r.rows.Close()
+	if dbErr := r.db.Close(); dbErr != nil {
+		return dbErr
+	}
+	return err
+}
+
+// Size implements drivers.RowIterator.
+func (r *rowIterator) Size(unit drivers.ProgressUnit) (uint64, bool) {
+	return 0, false
+}
+
+func rowsSchema(rows *sql.Rows) (*runtimev1.StructType, error) {
+	cts, err := rows.ColumnTypes()
+	if err != nil {
+		return nil, err
+	}
+
+	fields := make([]*runtimev1.StructType_Field, len(cts))
+	for i, ct := range cts {
+		nullable, ok := ct.Nullable()
+		t := &runtimev1.Type{Code: databaseTypeToPB(ct.DatabaseTypeName()), Nullable: nullable || !ok}
+		fields[i] = &runtimev1.StructType_Field{Name: ct.Name(), Type: t}
+	}
+	return &runtimev1.StructType{Fields: fields}, nil
+}
+
+// type conversion table for mysql types
+func databaseTypeToPB(dbt string) runtimev1.Type_Code {
+	switch dbt {
+	case "TINYINT":
+		return runtimev1.Type_CODE_INT8
+	case "SMALLINT", "YEAR":
+		return runtimev1.Type_CODE_INT16
+	case "MEDIUMINT", "INT":
+		return runtimev1.Type_CODE_INT32
+	case "BIGINT":
+		return runtimev1.Type_CODE_INT64
+	case "UNSIGNED TINYINT":
+		return runtimev1.Type_CODE_UINT8
+	case "UNSIGNED SMALLINT":
+		return runtimev1.Type_CODE_UINT16
+	case "UNSIGNED MEDIUMINT", "UNSIGNED INT":
+		return runtimev1.Type_CODE_UINT32
+	case "UNSIGNED BIGINT":
+		return runtimev1.Type_CODE_UINT64
+	case "FLOAT":
+		return runtimev1.Type_CODE_FLOAT32
+	case "DOUBLE":
+		return runtimev1.Type_CODE_FLOAT64
+	case "DATE":
+		return runtimev1.Type_CODE_DATE
+	case "DATETIME", "TIMESTAMP":
+		return runtimev1.Type_CODE_TIMESTAMP
+	case "JSON":
+		return runtimev1.Type_CODE_JSON
+	case "BIT", "BINARY", "VARBINARY", "TINYBLOB", "BLOB", "MEDIUMBLOB", "LONGBLOB", "GEOMETRY":
+		return runtimev1.Type_CODE_BYTES
+	// decimals can have a larger width than supported by DECIMAL type in duckdb and times can exceed 24 hours,
+	// so we ingest them as strings along with text types
+	default:
+		return runtimev1.Type_CODE_STRING
+	}
+}
+
+// convert parses the text representation of a mysql value to a value that can be appended to duckdb
+func convert(v string, code runtimev1.Type_Code) (any, error) {
+	switch code {
+	case runtimev1.Type_CODE_INT8:
+		i, err := strconv.ParseInt(v, 10, 8)
+		return int8(i), err
+	case runtimev1.Type_CODE_INT16:
+		i, err := strconv.ParseInt(v, 10, 16)
+		return int16(i), err
+	case runtimev1.Type_CODE_INT32:
+		i, err := strconv.ParseInt(v, 10, 32)
+		return int32(i), err
+	case runtimev1.Type_CODE_INT64:
+		return strconv.ParseInt(v, 10, 64)
+	case runtimev1.Type_CODE_UINT8:
+		i, err := strconv.ParseUint(v, 10, 8)
+		return uint8(i), err
+	case runtimev1.Type_CODE_UINT16:
+		i, err := strconv.ParseUint(v, 10, 16)
+		return uint16(i), err
+	case runtimev1.Type_CODE_UINT32:
+		i, err := strconv.ParseUint(v, 10, 32)
+		return uint32(i), err
+	case runtimev1.Type_CODE_UINT64:
+		return strconv.ParseUint(v, 10, 64)
+	case runtimev1.Type_CODE_FLOAT32:
+		f, err := strconv.ParseFloat(v, 32)
+		return float32(f), err
+	case runtimev1.Type_CODE_FLOAT64:
+		return strconv.ParseFloat(v, 64)
+	case runtimev1.Type_CODE_DATE, runtimev1.Type_CODE_TIMESTAMP:
+		// mysql allows zero dates, which can't be represented in duckdb
+		if strings.HasPrefix(v, "0000-00-00") {
+			return nil, nil
+		}
+		// fractional seconds are parsed even though they're not in the layout
+		if len(v) == len(time.DateOnly) {
+			return time.Parse(time.DateOnly, v)
+		}
+		return time.Parse(time.DateTime, v)
+	case runtimev1.Type_CODE_BYTES:
+		return []byte(v), nil
+	default:
+		return v, nil
+	}
+}
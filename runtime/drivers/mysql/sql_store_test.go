@@ -0,0 +1,39 @@
+package mysql
+
+import (
+	"testing"
+	"time"
+
+	runtimev1 "github.com/rilldata/rill/proto/gen/rill/runtime/v1"
+	"github.com/stretchr/testify/require"
+)
+
+func TestConvert(t *testing.T) {
+	tests := []struct {
+		dbt  string
+		val  string
+		want any
+	}{
+		{"TINYINT", "-12", int8(-12)},
+		{"INT", "123", int32(123)},
+		{"UNSIGNED BIGINT", "18446744073709551615", uint64(18446744073709551615)},
+		{"DOUBLE", "1.5", float64(1.5)},
+		{"DECIMAL", "12345678901234567890.123", "12345678901234567890.123"},
+		{"DATE", "2023-01-02", time.Date(2023, 1, 2, 0, 0, 0, 0, time.UTC)},
+		{"DATETIME", "2023-01-02 03:04:05.123", time.Date(2023, 1, 2, 3, 4, 5, 123000000, time.UTC)},
+		{"TIMESTAMP", "0000-00-00 00:00:00", nil},
+		{"TIME", "838:59:59", "838:59:59"},
+		{"VARBINARY", "abc", []byte("abc")},
+		{"VARCHAR", "hello", "hello"},
+	}
+	for _, tt := range tests {
+		t.Run(tt.dbt, func(t *testing.T) {
+			got, err := convert(tt.val, databaseTypeToPB(tt.dbt))
+			require.NoError(t, err)
+			require.Equal(t, tt.want, got)
+		})
+	}
+
+	_, err := convert("abc", runtimev1.Type_CODE_INT32)
+	require.Error(t, err)
+}
@@ -24,3 +24,16 @@ type FileIterator interface {
 	// This is used when the entire list of files is used at once in certain cases.
 	KeepFilesUntilClose(keepFilesUntilClose bool)
 }
+
+// IncrementalFileIterator is implemented by file iterators that support incremental ingestion (see BucketSource.PreviousObjects).
+// When created with previous objects, the iterator only downloads new or changed objects and all objects in stale partitions.
+type IncrementalFileIterator interface {
+	FileIterator
+	// Objects returns the versions of all objects matched by the source, keyed by object key.
+	Objects() map[string]string
+	// Full returns true if the changes can't be applied incrementally.
+	// In that case, the iterator downloads all objects and the sink should be replaced.
+	Full() bool
+	// StalePartitions returns hive partitions (as maps of partition keys to values) whose existing rows must be deleted before appending the downloaded files.
+	StalePartitions() []map[string]string
+}
@@ -4,6 +4,8 @@ import (
 	"context"
 	"database/sql"
 	"errors"
+	"fmt"
+	"strings"
 	"time"
 
 	"github.com/jmoiron/sqlx"
@@ -92,6 +94,7 @@ const (
 	DialectUnspecified Dialect = iota
 	DialectDuckDB
 	DialectDruid
+	DialectClickHouse
 )
 
 func (d Dialect) String() string {
@@ -102,11 +105,23 @@ func (d Dialect) String() string {
 		return "duckdb"
 	case DialectDruid:
 		return "druid"
+	case DialectClickHouse:
+		return "clickhouse"
 	default:
 		panic("not implemented")
 	}
 }
 
+// EscapeStringValue returns a quoted SQL string literal for the value.
+func (d Dialect) EscapeStringValue(s string) string {
+	s = strings.ReplaceAll(s, "'", "''")
+	if d == DialectClickHouse {
+		// ClickHouse also treats backslashes as escape characters in string literals
+		s = strings.ReplaceAll(s, `\`, `\\`)
+	}
+	return fmt.Sprintf("'%s'", s)
+}
+
 // IngestionSummary is details about ingestion
 type IngestionSummary struct {
 	BytesIngested int64
@@ -24,6 +24,11 @@ var migrationVersionTable = "runtime_migration_version"
 // Migrate for Postgres is safe for concurrent invocations.
 // Adapted from: https://github.com/jackc/tern
 func (c *connection) Migrate(ctx context.Context) (err error) {
+	// Nothing to migrate for connections used as source connectors
+	if c.db == nil {
+		return nil
+	}
+
 	// Acquire advisory lock
 	_, err = c.db.ExecContext(ctx, "select pg_advisory_lock($1)", migrationLockNumber)
 	if err != nil {
@@ -129,6 +134,10 @@ func (c *connection) migrateSingle(ctx context.Context, name string, sql []byte,
 
 // MigrationStatus implements drivers.Connection.
 func (c *connection) MigrationStatus(ctx context.Context) (current, desired int, err error) {
+	if c.db == nil {
+		return 0, 0, nil
+	}
+
 	// Get current version
 	err = c.db.QueryRowxContext(ctx, fmt.Sprintf("select version from %s", migrationVersionTable)).Scan(&current)
 	if err != nil {
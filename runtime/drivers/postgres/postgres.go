@@ -5,6 +5,7 @@ import (
 	"fmt"
 
 	"github.com/jmoiron/sqlx"
+	"github.com/mitchellh/mapstructure"
 	"github.com/rilldata/rill/runtime/drivers"
 	"go.uber.org/zap"
 
@@ -14,23 +15,68 @@ import (
 
 func init() {
 	drivers.Register("postgres", driver{})
+	drivers.RegisterAsConnector("postgres", driver{})
+}
+
+// spec for postgres connector
+var spec = drivers.Spec{
+	DisplayName: "Postgres",
+	Description: "Import data from Postgres.",
+	SourceProperties: []drivers.PropertySchema{
+		{
+			Key:         "sql",
+			Type:        drivers.StringPropertyType,
+			Required:    true,
+			DisplayName: "SQL",
+			Description: "Query to extract data from Postgres.",
+			Placeholder: "select * from table;",
+		},
+	},
+	ConfigProperties: []drivers.PropertySchema{
+		{
+			Key:    "database_url",
+			Secret: true,
+		},
+	},
 }
 
 type driver struct{}
 
+type configProperties struct {
+	// DSN is used when the driver is used as a catalog or registry store.
+	DSN string `mapstructure:"dsn"`
+	// DatabaseURL is used when the driver is used as a source connector.
+	DatabaseURL string `mapstructure:"database_url"`
+}
+
 func (d driver) Open(config map[string]any, shared bool, logger *zap.Logger) (drivers.Handle, error) {
-	dsn, ok := config["dsn"].(string)
-	if !ok {
-		return nil, fmt.Errorf("require dsn to open sqlite connection")
+	conf := &configProperties{}
+	err := mapstructure.WeakDecode(config, conf)
+	if err != nil {
+		return nil, err
+	}
+
+	// Connections used as source connectors don't have a dsn and connect on demand in Query
+	if conf.DSN == "" {
+		if shared {
+			return nil, fmt.Errorf("postgres connector can't be shared")
+		}
+		return &connection{
+			config:     config,
+			properties: conf,
+			logger:     logger,
+		}, nil
 	}
 
-	db, err := sqlx.Connect("pgx", dsn)
+	db, err := sqlx.Connect("pgx", conf.DSN)
 	if err != nil {
 		return nil, err
 	}
 	return &connection{
-		db:     db,
-		config: config,
+		db:         db,
+		config:     config,
+		properties: conf,
+		logger:     logger,
 	}, nil
 }
 
@@ -39,18 +85,24 @@ func (d driver) Drop(config map[string]any, logger *zap.Logger) error {
 }
 
 func (d driver) Spec() drivers.Spec {
-	return drivers.Spec{}
+	return spec
 }
 
 func (d driver) HasAnonymousSourceAccess(ctx context.Context, src drivers.Source, logger *zap.Logger) (bool, error) {
-	return false, fmt.Errorf("not implemented")
+	return false, nil
 }
 
 type connection struct {
-	db     *sqlx.DB
-	config map[string]any
+	db         *sqlx.DB
+	config     map[string]any
+	properties *configProperties
+	logger     *zap.Logger
 }
 
+var _ drivers.Handle = &connection{}
+
+var _ drivers.SQLStore = &connection{}
+
 // Driver implements drivers.Connection.
 func (c *connection) Driver() string {
 	return "postgres"
@@ -63,6 +115,9 @@ func (c *connection) Config() map[string]any {
 
 // Close implements drivers.Connection.
 func (c *connection) Close() error {
+	if c.db == nil {
+		return nil
+	}
 	return c.db.Close()
 }
 
@@ -103,5 +158,5 @@ func (c *connection) AsFileStore() (drivers.FileStore, bool) {
 
 // AsSQLStore implements drivers.Connection.
 func (c *connection) AsSQLStore() (drivers.SQLStore, bool) {
-	return nil, false
+	return c, true
 }
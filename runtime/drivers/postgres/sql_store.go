@@ -0,0 +1,227 @@
+package postgres
+
+import (
+	"context"
+	"encoding/json"
+	"fmt"
+	"time"
+
+	"github.com/google/uuid"
+	"github.com/jackc/pgtype"
+	"github.com/jackc/pgx/v4"
+	runtimev1 "github.com/rilldata/rill/proto/gen/rill/runtime/v1"
+	"github.com/rilldata/rill/runtime/drivers"
+)
+
+// Query implements drivers.SQLStore.
+// The results are streamed from a dedicated connection, which is closed when the iterator is closed.
+// The database URL is a secret, so it's only read from the connector config and not from the source properties.
+func (c *connection) Query(ctx context.Context, props map[string]any, sql string) (drivers.RowIterator, error) {
+	if _, ok := props["database_url"]; ok {
+		return nil, fmt.Errorf("the property 'database_url' can't be set in the source YAML. Pass '--env connector.postgres.database_url=...' to 'rill start' instead")
+	}
+
+	dsn := c.properties.DatabaseURL
+	if dsn == "" {
+		return nil, fmt.Errorf("the connector variable 'database_url' is required for Postgres. Pass '--env connector.postgres.database_url=...' to 'rill start'")
+	}
+
+	conn, err := pgx.Connect(ctx, dsn)
+	if err != nil {
+		return nil, fmt.Errorf("failed to connect to postgres: %w", err)
+	}
+
+	rows, err := conn.Query(ctx, sql)
+	if err != nil {
+		_ = conn.Close(ctx)
+		return nil, err
+	}
+
+	schema, err := rowsSchema(rows)
+	if err != nil {
+		rows.Close()
+		_ = conn.Close(ctx)
+		return nil, err
+	}
+
+	return &rowIterator{
+		conn:   conn,
+		rows:   rows,
+		schema: schema,
+	}, nil
+}
+
+type rowIterator struct {
+	conn   *pgx.Conn
+	rows   pgx.Rows
+	schema *runtimev1.StructType
+	// fetched is true if a row has been fetched ahead of Next (see Schema)
+	fetched bool
+}
+
+var _ drivers.RowIterator = &rowIterator{}
+
+// Schema implements drivers.RowIterator.
+// It returns drivers.ErrIteratorDone if the query returned no rows, which is consistent with other SQL stores.
+func (r *rowIterator) Schema(ctx context.Context) (*runtimev1.StructType, error) {
+	if !r.fetched {
+		if !r.rows.Next() {
+			if err := r.rows.Err(); err != nil {
+				return nil, err
+			}
+			return nil, drivers.ErrIteratorDone
+		}
+		r.fetched = true
+	}
+	return r.schema, nil
+}
+
+// Next implements drivers.RowIterator.
+func (r *rowIterator) Next(ctx context.Context) ([]any, error) {
+	if r.fetched {
+		r.fetched = false
+	} else if !r.rows.Next() {
+		if err := r.rows.Err(); err != nil {
+			return nil, err
+		}
+		return nil, drivers.ErrIteratorDone
+	}
+
+	vals, err := r.rows.Values()
+	if err != nil {
+		return nil, err
+	}
+
+	for i, v := range vals {
+		vals[i], err = convert(v, r.schema.Fields[i].Type.Code)
+		if err != nil {
+			return nil, err
+		}
+	}
+	return vals, nil
+}
+
+// Close implements drivers.RowIterator.
+func (r *rowIterator) Close() error {
+	r.rows.Close()
+	return r.conn.Close(context.Background())
+}
+
+// Size implements drivers.RowIterator.
+func (r *rowIterator) Size(unit drivers.ProgressUnit) (uint64, bool) {
+	return 0, false
+}
+
+func rowsSchema(rows pgx.Rows) (*runtimev1.StructType, error) {
+	fds := rows.FieldDescriptions()
+	fields := make([]*runtimev1.StructType_Field, len(fds))
+	for i, fd := range fds {
+		t, err := oidToPB(fd.DataTypeOID)
+		if err != nil {
+			return nil, fmt.Errorf("column %q: %w", string(fd.Name), err)
+		}
+		fields[i] = &runtimev1.StructType_Field{Name: string(fd.Name), Type: t}
+	}
+	return &runtimev1.StructType{Fields: fields}, nil
+}
+
+// type conversion table for postgres types
+// Types without a native equivalent (e.g. enums, arrays and geometric types) are ingested as strings (see convert).
+func oidToPB(oid uint32) (*runtimev1.Type, error) {
+	t := &runtimev1.Type{Nullable: true}
+	switch oid {
+	case pgtype.BoolOID:
+		t.Code = runtimev1.Type_CODE_BOOL
+	case pgtype.Int2OID:
+		t.Code = runtimev1.Type_CODE_INT16
+	case pgtype.Int4OID:
+		t.Code = runtimev1.Type_CODE_INT32
+	case pgtype.Int8OID:
+		t.Code = runtimev1.Type_CODE_INT64
+	case pgtype.Float4OID:
+		t.Code = runtimev1.Type_CODE_FLOAT32
+	case pgtype.Float8OID:
+		t.Code = runtimev1.Type_CODE_FLOAT64
+	// numeric can have a larger width than supported by DECIMAL type in duckdb, so we ingest it as a string
+	case pgtype.NumericOID:
+		t.Code = runtimev1.Type_CODE_STRING
+	case pgtype.TextOID, pgtype.VarcharOID, pgtype.BPCharOID, pgtype.NameOID, pgtype.UUIDOID:
+		t.Code = runtimev1.Type_CODE_STRING
+	case pgtype.JSONOID, pgtype.JSONBOID:
+		t.Code = runtimev1.Type_CODE_JSON
+	case pgtype.ByteaOID:
+		t.Code = runtimev1.Type_CODE_BYTES
+	case pgtype.DateOID:
+		t.Code = runtimev1.Type_CODE_DATE
+	case pgtype.TimestampOID, pgtype.TimestamptzOID:
+		t.Code = runtimev1.Type_CODE_TIMESTAMP
+	case pgtype.TimeOID:
+		t.Code = runtimev1.Type_CODE_TIME
+	case pgtype.IntervalOID, pgtype.InetOID, pgtype.CIDROID:
+		t.Code = runtimev1.Type_CODE_STRING
+	default:
+		t.Code = runtimev1.Type_CODE_STRING
+	}
+	return t, nil
+}
+
+// convert converts a value returned by pgx.Rows.Values to a value that can be appended to duckdb
+func convert(v any, code runtimev1.Type_Code) (any, error) {
+	if v == nil {
+		return nil, nil
+	}
+
+	switch val := v.(type) {
+	case [16]byte:
+		return uuid.UUID(val).String(), nil
+	case pgtype.Time:
+		if val.Status != pgtype.Present {
+			return nil, nil
+		}
+		return time.Time{}.Add(time.Duration(val.Microseconds) * time.Microsecond), nil
+	case pgtype.InfinityModifier:
+		// infinite timestamps and numerics
+		if code == runtimev1.Type_CODE_STRING {
+			return val.String(), nil
+		}
+		return nil, nil
+	case pgtype.TextEncoder:
+		if code != runtimev1.Type_CODE_STRING {
+			break
+		}
+		// numeric, interval, etc.
+		buf, err := val.EncodeText(nil, nil)
+		if err != nil {
+			return nil, err
+		}
+		if buf == nil {
+			return nil, nil
+		}
+		return string(buf), nil
+	}
+
+	if code == runtimev1.Type_CODE_JSON {
+		res, err := json.Marshal(v)
+		if err != nil {
+			return nil, err
+		}
+		return string(res), nil
+	}
+
+	if code == runtimev1.Type_CODE_STRING {
+		switch val := v.(type) {
+		case string:
+			return val, nil
+		case []byte:
+			// types unknown to pgx are returned in their text format
+			return string(val), nil
+		case fmt.Stringer:
+			// inet, cidr, etc.
+			return val.String(), nil
+		default:
+			return fmt.Sprint(val), nil
+		}
+	}
+
+	return v, nil
+}
@@ -0,0 +1,99 @@
+package postgres
+
+import (
+	"context"
+	"testing"
+
+	"github.com/jackc/pgtype"
+	"github.com/rilldata/rill/admin/pkg/pgtestcontainer"
+	runtimev1 "github.com/rilldata/rill/proto/gen/rill/runtime/v1"
+	"github.com/rilldata/rill/runtime/drivers"
+	"github.com/stretchr/testify/require"
+	"go.uber.org/zap"
+)
+
+func TestOIDToPB(t *testing.T) {
+	tests := []struct {
+		oid  uint32
+		want runtimev1.Type_Code
+	}{
+		{pgtype.Int4OID, runtimev1.Type_CODE_INT32},
+		{pgtype.NumericOID, runtimev1.Type_CODE_STRING},
+		{pgtype.JSONBOID, runtimev1.Type_CODE_JSON},
+		{pgtype.TimestamptzOID, runtimev1.Type_CODE_TIMESTAMP},
+		// unsupported types fall back to strings
+		{pgtype.Int4ArrayOID, runtimev1.Type_CODE_STRING},
+		{pgtype.PointOID, runtimev1.Type_CODE_STRING},
+		{123456, runtimev1.Type_CODE_STRING},
+	}
+	for _, tt := range tests {
+		got, err := oidToPB(tt.oid)
+		require.NoError(t, err)
+		require.Equal(t, tt.want, got.Code, "oid %d", tt.oid)
+	}
+}
+
+func TestConvert(t *testing.T) {
+	tests := []struct {
+		name string
+		val  any
+		code runtimev1.Type_Code
+		want any
+	}{
+		{"nil", nil, runtimev1.Type_CODE_STRING, nil},
+		{"int", int32(12), runtimev1.Type_CODE_INT32, int32(12)},
+		{"uuid", [16]byte{1}, runtimev1.Type_CODE_STRING, "01000000-0000-0000-0000-000000000000"},
+		{"json", map[string]any{"a": 1}, runtimev1.Type_CODE_JSON, `{"a":1}`},
+		{"text format", []byte("happy"), runtimev1.Type_CODE_STRING, "happy"},
+		{"array", []any{int32(1), int32(2)}, runtimev1.Type_CODE_STRING, "[1 2]"},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			got, err := convert(tt.val, tt.code)
+			require.NoError(t, err)
+			require.Equal(t, tt.want, got)
+		})
+	}
+}
+
+func TestQuery(t *testing.T) {
+	pg := pgtestcontainer.New(t)
+	defer pg.Terminate(t)
+
+	ctx := context.Background()
+	conn, err := driver{}.Open(map[string]any{"database_url": pg.DatabaseURL}, false, zap.NewNop())
+	require.NoError(t, err)
+	defer conn.Close()
+
+	store, ok := conn.AsSQLStore()
+	require.True(t, ok)
+
+	// the database URL can't be set in the source properties
+	_, err = store.Query(ctx, map[string]any{"database_url": pg.DatabaseURL}, "SELECT 1")
+	require.Error(t, err)
+
+	iter, err := store.Query(ctx, map[string]any{}, `
+		SELECT 1::INT AS id, 'hello'::TEXT AS name, 12.50::NUMERIC AS amount, ARRAY[1, 2] AS ids, POINT(1, 2) AS location
+	`)
+	require.NoError(t, err)
+	defer iter.Close()
+
+	schema, err := iter.Schema(ctx)
+	require.NoError(t, err)
+	require.Len(t, schema.Fields, 5)
+	require.Equal(t, runtimev1.Type_CODE_INT32, schema.Fields[0].Type.Code)
+	require.Equal(t, runtimev1.Type_CODE_STRING, schema.Fields[2].Type.Code)
+	require.Equal(t, runtimev1.Type_CODE_STRING, schema.Fields[3].Type.Code)
+	require.Equal(t, runtimev1.Type_CODE_STRING, schema.Fields[4].Type.Code)
+
+	row, err := iter.Next(ctx)
+	require.NoError(t, err)
+	require.Equal(t, int32(1), row[0])
+	require.Equal(t, "hello", row[1])
+	require.Equal(t, "12.50", row[2])
+	require.IsType(t, "", row[3])
+	require.IsType(t, "", row[4])
+
+	_, err = iter.Next(ctx)
+	require.ErrorIs(t, err, drivers.ErrIteratorDone)
+}
@@ -44,6 +44,12 @@ type Instance struct {
 	// IngestionLimitBytes is total data allowed to ingest across all sources
 	// 0 means there is no limit
 	IngestionLimitBytes int64 `db:"ingestion_limit_bytes"`
+	// ExportLimitRows is the maximum number of rows allowed in a data export
+	// 0 means there is no limit
+	ExportLimitRows int64 `db:"export_limit_rows"`
+	// ExportLimitBytes is the maximum size allowed for a data export
+	// 0 means there is no limit
+	ExportLimitBytes int64 `db:"export_limit_bytes"`
 	// Annotations to enrich activity events (like usage tracking)
 	Annotations map[string]string
 }
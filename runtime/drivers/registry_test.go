@@ -18,6 +18,8 @@ func testRegistry(t *testing.T, reg drivers.RegistryStore) {
 		RepoDriver:          "repo",
 		EmbedCatalog:        true,
 		IngestionLimitBytes: 102345,
+		ExportLimitRows:     1000,
+		ExportLimitBytes:    2048,
 		Connectors: []*runtimev1.Connector{
 			{
 				Type:   "file",
@@ -48,6 +50,8 @@ func testRegistry(t *testing.T, reg drivers.RegistryStore) {
 	require.Equal(t, inst.RepoDriver, res.RepoDriver)
 	require.Equal(t, inst.EmbedCatalog, res.EmbedCatalog)
 	require.Equal(t, inst.IngestionLimitBytes, res.IngestionLimitBytes)
+	require.Equal(t, inst.ExportLimitRows, res.ExportLimitRows)
+	require.Equal(t, inst.ExportLimitBytes, res.ExportLimitBytes)
 	require.ElementsMatch(t, inst.Connectors, res.Connectors)
 
 	err = reg.CreateInstance(ctx, &drivers.Instance{OLAPDriver: "druid"})
@@ -271,12 +271,14 @@ func (c *Connection) DownloadFiles(ctx context.Context, src *drivers.BucketSourc
 
 	// prepare fetch configs
 	opts := rillblob.Options{
-		GlobMaxTotalSize:      conf.GlobMaxTotalSize,
-		GlobMaxObjectsMatched: conf.GlobMaxObjectsMatched,
-		GlobMaxObjectsListed:  conf.GlobMaxObjectsListed,
-		GlobPageSize:          conf.GlobPageSize,
-		GlobPattern:           conf.url.Path,
-		ExtractPolicy:         src.ExtractPolicy,
+		GlobMaxTotalSize:        conf.GlobMaxTotalSize,
+		GlobMaxObjectsMatched:   conf.GlobMaxObjectsMatched,
+		GlobMaxObjectsListed:    conf.GlobMaxObjectsListed,
+		GlobPageSize:            conf.GlobPageSize,
+		GlobPattern:             conf.url.Path,
+		ExtractPolicy:           src.ExtractPolicy,
+		PreviousObjects:         src.PreviousObjects,
+		DeleteRemovedPartitions: src.DeleteRemovedPartitions,
 	}
 
 	it, err := rillblob.NewIterator(ctx, bucketObj, opts, c.logger)
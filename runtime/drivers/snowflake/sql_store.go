@@ -0,0 +1,318 @@
+package snowflake
+
+import (
+	"context"
+	"database/sql"
+	sqldriver "database/sql/driver"
+	"fmt"
+	"strings"
+	"time"
+
+	"github.com/apache/arrow/go/v12/arrow"
+	"github.com/apache/arrow/go/v12/arrow/array"
+	runtimev1 "github.com/rilldata/rill/proto/gen/rill/runtime/v1"
+	"github.com/rilldata/rill/runtime/drivers"
+	sf "github.com/snowflakedb/gosnowflake"
+)
+
+// Query implements drivers.SQLStore.
+// The results are fetched as Arrow record batches, which are downloaded one at a time as the iterator advances.
+// Since gosnowflake converts fixed point numbers with a scale to float64 in Arrow batches, which loses digits,
+// the query is described first and such columns are cast to strings by Snowflake (see decimalsAsText).
+// The DSN is a secret, so it's only read from the connector config and not from the source properties.
+func (c *connection) Query(ctx context.Context, props map[string]any, query string) (drivers.RowIterator, error) {
+	if _, ok := props["dsn"]; ok {
+		return nil, fmt.Errorf("the property 'dsn' can't be set in the source YAML. Pass '--env connector.snowflake.dsn=...' to 'rill start' instead")
+	}
+
+	dsn := c.config.DSN
+	if dsn == "" {
+		return nil, fmt.Errorf("the connector variable 'dsn' is required for Snowflake. Pass '--env connector.snowflake.dsn=...' to 'rill start'")
+	}
+
+	cfg, err := sf.ParseDSN(dsn)
+	if err != nil {
+		return nil, fmt.Errorf("invalid snowflake dsn: %w", err)
+	}
+
+	db := sql.OpenDB(sf.NewConnector(sf.SnowflakeDriver{}, *cfg))
+	conn, err := db.Conn(ctx)
+	if err != nil {
+		db.Close()
+		return nil, err
+	}
+
+	schema, err := describeQuery(ctx, conn, query)
+	if err != nil {
+		conn.Close()
+		db.Close()
+		return nil, err
+	}
+	for _, f := range schema.Fields {
+		if f.Type.Code == runtimev1.Type_CODE_DECIMAL {
+			query = decimalsAsText(query, schema)
+			break
+		}
+	}
+
+	// Run the query on the raw connection to get access to the Arrow batches
+	var rows sqldriver.Rows
+	err = conn.Raw(func(x any) error {
+		rows, err = x.(sqldriver.QueryerContext).QueryContext(sf.WithArrowBatches(ctx), query, nil)
+		return err
+	})
+	if err != nil {
+		conn.Close()
+		db.Close()
+		return nil, err
+	}
+
+	// The schema is taken from the description since the decimals are strings in the results
+	it := &rowIterator{
+		db:     db,
+		conn:   conn,
+		rows:   rows,
+		schema: schema,
+	}
+
+	it.batches, err = rows.(sf.SnowflakeRows).GetArrowBatches()
+	if err != nil {
+		it.Close()
+		return nil, err
+	}
+
+	return it, nil
+}
+
+type rowIterator struct {
+	db      *sql.DB
+	conn    *sql.Conn
+	rows    sqldriver.Rows
+	schema  *runtimev1.StructType
+	batches []*sf.ArrowBatch
+	// records of the current batch
+	records []arrow.Record
+	// index of the next row in records[0]
+	rowIdx int
+	// next is set if a row has been fetched ahead of Next (see Schema)
+	next []any
+}
+
+var _ drivers.RowIterator = &rowIterator{}
+
+// Schema implements drivers.RowIterator.
+// It returns drivers.ErrIteratorDone if the query returned no rows, which is consistent with other SQL stores.
+func (r *rowIterator) Schema(ctx context.Context) (*runtimev1.StructType, error) {
+	if r.next == nil {
+		next, err := r.Next(ctx)
+		if err != nil {
+			return nil, err
+		}
+		r.next = next
+	}
+	return r.schema, nil
+}
+
+// Next implements drivers.RowIterator.
+func (r *rowIterator) Next(ctx context.Context) ([]any, error) {
+	if r.next != nil {
+		next := r.next
+		r.next = nil
+		return next, nil
+	}
+
+	// Advance to the next non-empty record, downloading the next batch if necessary
+	for len(r.records) == 0 || r.rowIdx >= int(r.records[0].NumRows()) {
+		if len(r.records) > 0 {
+			r.records[0].Release()
+			r.records = r.records[1:]
+			r.rowIdx = 0
+			continue
+		}
+
+		if len(r.batches) == 0 {
+			return nil, drivers.ErrIteratorDone
+		}
+
+		if err := ctx.Err(); err != nil {
+			return nil, err
+		}
+
+		records, err := r.batches[0].Fetch()
+		if err != nil {
+			return nil, fmt.Errorf("failed to fetch arrow batch: %w", err)
+		}
+		r.batches = r.batches[1:]
+		if records != nil {
+			r.records = *records
+		}
+	}
+
+	rec := r.records[0]
+	row := make([]any, rec.NumCols())
+	for i, col := range rec.Columns() {
+		val, err := arrowValue(col, r.rowIdx)
+		if err != nil {
+			return nil, fmt.Errorf("column %q: %w", rec.ColumnName(i), err)
+		}
+		row[i] = val
+	}
+	r.rowIdx++
+
+	return row, nil
+}
+
+// Close implements drivers.RowIterator.
+func (r *rowIterator) Close() error {
+	for _, rec := range r.records {
+		rec.Release()
+	}
+	r.records = nil
+
+	var err error
+	if r.rows != nil {
+		err = r.rows.Close()
+	}
+	if connErr := r.conn.Close(); connErr != nil && err == nil {
+		err = connErr
+	}
+	if dbErr := r.db.Close(); dbErr != nil && err == nil {
+		err = dbErr
+	}
+	return err
+}
+
+// Size implements drivers.RowIterator.
+func (r *rowIterator) Size(unit drivers.ProgressUnit) (uint64, bool) {
+	return 0, false
+}
+
+// describeQuery returns the schema of the query's results without executing it
+func describeQuery(ctx context.Context, conn *sql.Conn, query string) (*runtimev1.StructType, error) {
+	var schema *runtimev1.StructType
+	err := conn.Raw(func(x any) error {
+		rows, err := x.(sqldriver.QueryerContext).QueryContext(sf.WithDescribeOnly(ctx), query, nil)
+		if err != nil {
+			return err
+		}
+		defer rows.Close()
+		schema, err = rowsSchema(rows)
+		return err
+	})
+	return schema, err
+}
+
+// decimalsAsText wraps the query to cast columns with a DECIMAL type in schema to strings, which keeps all their digits.
+// The columns are referenced by position since their names may not be unique.
+func decimalsAsText(query string, schema *runtimev1.StructType) string {
+	cols := make([]string, len(schema.Fields))
+	for i, f := range schema.Fields {
+		col := fmt.Sprintf("$%d", i+1)
+		if f.Type.Code == runtimev1.Type_CODE_DECIMAL {
+			col = fmt.Sprintf("TO_VARCHAR(%s)", col)
+		}
+		cols[i] = fmt.Sprintf("%s AS %s", col, safeName(f.Name))
+	}
+	query = strings.TrimRight(query, "; \t\r\n")
+	return fmt.Sprintf("SELECT %s FROM (%s)", strings.Join(cols, ", "), query)
+}
+
+func safeName(name string) string {
+	return fmt.Sprintf("\"%s\"", strings.ReplaceAll(name, "\"", "\"\""))
+}
+
+func rowsSchema(rows sqldriver.Rows) (*runtimev1.StructType, error) {
+	typeNames, ok := rows.(sqldriver.RowsColumnTypeDatabaseTypeName)
+	if !ok {
+		return nil, fmt.Errorf("snowflake rows don't expose column types")
+	}
+	precisionScales, _ := rows.(sqldriver.RowsColumnTypePrecisionScale)
+
+	cols := rows.Columns()
+	fields := make([]*runtimev1.StructType_Field, len(cols))
+	for i, name := range cols {
+		var scale int64
+		if precisionScales != nil {
+			_, scale, _ = precisionScales.ColumnTypePrecisionScale(i)
+		}
+		t, err := databaseTypeToPB(typeNames.ColumnTypeDatabaseTypeName(i), scale)
+		if err != nil {
+			return nil, fmt.Errorf("column %q: %w", name, err)
+		}
+		fields[i] = &runtimev1.StructType_Field{Name: name, Type: t}
+	}
+	return &runtimev1.StructType{Fields: fields}, nil
+}
+
+// type conversion table for snowflake types.
+// It must be consistent with the types that gosnowflake converts Arrow batches to.
+func databaseTypeToPB(dbt string, scale int64) (*runtimev1.Type, error) {
+	t := &runtimev1.Type{Nullable: true}
+	switch dbt {
+	case "FIXED":
+		// fixed point numbers without a scale are converted to int64, others are cast to strings (see decimalsAsText)
+		if scale == 0 {
+			t.Code = runtimev1.Type_CODE_INT64
+		} else {
+			t.Code = runtimev1.Type_CODE_DECIMAL
+		}
+	case "REAL":
+		t.Code = runtimev1.Type_CODE_FLOAT64
+	case "TEXT":
+		t.Code = runtimev1.Type_CODE_STRING
+	case "BOOLEAN":
+		t.Code = runtimev1.Type_CODE_BOOL
+	case "BINARY":
+		t.Code = runtimev1.Type_CODE_BYTES
+	case "DATE":
+		t.Code = runtimev1.Type_CODE_DATE
+	case "TIME":
+		t.Code = runtimev1.Type_CODE_TIME
+	case "TIMESTAMP_NTZ", "TIMESTAMP_LTZ", "TIMESTAMP_TZ":
+		t.Code = runtimev1.Type_CODE_TIMESTAMP
+	case "VARIANT", "OBJECT", "ARRAY":
+		t.Code = runtimev1.Type_CODE_JSON
+	default:
+		return nil, fmt.Errorf("type %s not supported", dbt)
+	}
+	return t, nil
+}
+
+// arrowValue returns the value at index i of an Arrow array as a value that can be appended to duckdb
+func arrowValue(col arrow.Array, i int) (any, error) {
+	if col.IsNull(i) {
+		return nil, nil
+	}
+
+	switch col := col.(type) {
+	case *array.Boolean:
+		return col.Value(i), nil
+	case *array.Int8:
+		return int64(col.Value(i)), nil
+	case *array.Int16:
+		return int64(col.Value(i)), nil
+	case *array.Int32:
+		return int64(col.Value(i)), nil
+	case *array.Int64:
+		return col.Value(i), nil
+	case *array.Float32:
+		return float64(col.Value(i)), nil
+	case *array.Float64:
+		return col.Value(i), nil
+	case *array.String:
+		return col.Value(i), nil
+	case *array.Binary:
+		// copy since the underlying buffer is released with the record
+		return append([]byte(nil), col.Value(i)...), nil
+	case *array.Date32:
+		return col.Value(i).ToTime(), nil
+	case *array.Time64:
+		unit := col.DataType().(*arrow.Time64Type).Unit
+		return time.Time{}.Add(time.Duration(col.Value(i)) * unit.Multiplier()), nil
+	case *array.Timestamp:
+		unit := col.DataType().(*arrow.TimestampType).Unit
+		return col.Value(i).ToTime(unit), nil
+	default:
+		return nil, fmt.Errorf("arrow type %s not supported", col.DataType())
+	}
+}
@@ -0,0 +1,192 @@
+package snowflake
+
+import (
+	"bytes"
+	"context"
+	"encoding/base64"
+	"encoding/json"
+	"net/http"
+	"net/http/httptest"
+	"net/url"
+	"strings"
+	"testing"
+
+	"github.com/apache/arrow/go/v12/arrow"
+	"github.com/apache/arrow/go/v12/arrow/array"
+	"github.com/apache/arrow/go/v12/arrow/ipc"
+	"github.com/apache/arrow/go/v12/arrow/memory"
+	runtimev1 "github.com/rilldata/rill/proto/gen/rill/runtime/v1"
+	"github.com/rilldata/rill/runtime/drivers"
+	"github.com/stretchr/testify/require"
+	"go.uber.org/zap"
+)
+
+func TestQuery(t *testing.T) {
+	srv := newStubServer(t)
+	defer srv.Close()
+
+	u, err := url.Parse(srv.URL)
+	require.NoError(t, err)
+	dsn := "user:pass@" + u.Host + "/db/schema?account=test&protocol=http&loginTimeout=5"
+
+	conn, err := driver{}.Open(map[string]any{"dsn": dsn}, false, zap.NewNop())
+	require.NoError(t, err)
+	store, ok := conn.AsSQLStore()
+	require.True(t, ok)
+
+	// the DSN can't be set in the source properties
+	_, err = store.Query(context.Background(), map[string]any{"sql": "select * from events", "dsn": dsn}, "select * from events")
+	require.Error(t, err)
+
+	iter, err := store.Query(context.Background(), map[string]any{"sql": "select * from events"}, "select * from events")
+	require.NoError(t, err)
+	defer iter.Close()
+
+	schema, err := iter.Schema(context.Background())
+	require.NoError(t, err)
+	require.Len(t, schema.Fields, 3)
+	require.Equal(t, "ID", schema.Fields[0].Name)
+	require.Equal(t, runtimev1.Type_CODE_INT64, schema.Fields[0].Type.Code)
+	require.Equal(t, "NAME", schema.Fields[1].Name)
+	require.Equal(t, runtimev1.Type_CODE_STRING, schema.Fields[1].Type.Code)
+	require.Equal(t, "AMOUNT", schema.Fields[2].Name)
+	require.Equal(t, runtimev1.Type_CODE_DECIMAL, schema.Fields[2].Type.Code)
+
+	var rows [][]any
+	for {
+		row, err := iter.Next(context.Background())
+		if err == drivers.ErrIteratorDone {
+			break
+		}
+		require.NoError(t, err)
+		rows = append(rows, row)
+	}
+	require.Equal(t, [][]any{
+		{int64(1), "a", "12345678901234567890.1234567890"},
+		{int64(2), nil, "-0.0000000005"},
+		{int64(3), "c", "100.0000000000"},
+	}, rows)
+}
+
+// newStubServer returns a server that implements the parts of the Snowflake HTTP API used by the driver.
+// It returns the first batch of results inline and the second batch as a chunk download.
+// Amounts are fixed point numbers with a scale of 10, which are only returned as such when the query is described.
+// The handlers respond with an internal server error if they fail, which makes the client fail.
+func newStubServer(t *testing.T) *httptest.Server {
+	firstBatch := arrowBatch(t, []int64{1, 2}, []string{"a", ""}, []string{"12345678901234567890.1234567890", "-0.0000000005"})
+	secondBatch := arrowBatch(t, []int64{3}, []string{"c"}, []string{"100.0000000000"})
+
+	mux := http.NewServeMux()
+	srv := httptest.NewServer(mux)
+
+	mux.HandleFunc("/session/v1/login-request", func(w http.ResponseWriter, r *http.Request) {
+		writeJSON(w, map[string]any{
+			"success": true,
+			"data": map[string]any{
+				"token":       "token",
+				"masterToken": "master-token",
+				"sessionInfo": map[string]any{"databaseName": "DB", "schemaName": "SCHEMA"},
+			},
+		})
+	})
+
+	mux.HandleFunc("/queries/v1/query-request", func(w http.ResponseWriter, r *http.Request) {
+		var req struct {
+			SQLText      string `json:"sqlText"`
+			DescribeOnly bool   `json:"describeOnly"`
+		}
+		if err := json.NewDecoder(r.Body).Decode(&req); err != nil {
+			http.Error(w, err.Error(), http.StatusInternalServerError)
+			return
+		}
+
+		if req.DescribeOnly {
+			writeJSON(w, map[string]any{
+				"success": true,
+				"data": map[string]any{
+					"queryId":           "describe-id",
+					"queryResultFormat": "json",
+					"rowtype": []map[string]any{
+						{"name": "ID", "type": "fixed", "precision": 38, "scale": 0, "nullable": false},
+						{"name": "NAME", "type": "text", "length": 16, "nullable": true},
+						{"name": "AMOUNT", "type": "fixed", "precision": 38, "scale": 10, "nullable": false},
+					},
+					"rowset":   [][]any{},
+					"total":    0,
+					"returned": 0,
+				},
+			})
+			return
+		}
+
+		// the amounts must be cast to strings by the query
+		if !strings.Contains(req.SQLText, `TO_VARCHAR($3) AS "AMOUNT"`) {
+			http.Error(w, "amounts are not cast to strings: "+req.SQLText, http.StatusInternalServerError)
+			return
+		}
+
+		writeJSON(w, map[string]any{
+			"success": true,
+			"data": map[string]any{
+				"queryId":           "query-id",
+				"queryResultFormat": "arrow",
+				"rowtype": []map[string]any{
+					{"name": "ID", "type": "fixed", "precision": 38, "scale": 0, "nullable": false},
+					{"name": "NAME", "type": "text", "length": 16, "nullable": true},
+					{"name": "AMOUNT", "type": "text", "length": 64, "nullable": false},
+				},
+				"rowsetbase64": base64.StdEncoding.EncodeToString(firstBatch),
+				"total":        3,
+				"returned":     3,
+				"chunks":       []map[string]any{{"url": srv.URL + "/chunks/1", "rowCount": 1}},
+			},
+		})
+	})
+
+	mux.HandleFunc("/chunks/1", func(w http.ResponseWriter, r *http.Request) {
+		_, _ = w.Write(secondBatch)
+	})
+
+	mux.HandleFunc("/session", func(w http.ResponseWriter, r *http.Request) {
+		writeJSON(w, map[string]any{"success": true})
+	})
+
+	return srv
+}
+
+// arrowBatch returns an Arrow IPC stream with a single record. Empty names are encoded as nulls.
+func arrowBatch(t *testing.T, ids []int64, names, amounts []string) []byte {
+	schema := arrow.NewSchema([]arrow.Field{
+		{Name: "ID", Type: arrow.PrimitiveTypes.Int64},
+		{Name: "NAME", Type: arrow.BinaryTypes.String, Nullable: true},
+		{Name: "AMOUNT", Type: arrow.BinaryTypes.String},
+	}, nil)
+
+	b := array.NewRecordBuilder(memory.DefaultAllocator, schema)
+	defer b.Release()
+	b.Field(0).(*array.Int64Builder).AppendValues(ids, nil)
+	valid := make([]bool, len(names))
+	for i, n := range names {
+		valid[i] = n != ""
+	}
+	b.Field(1).(*array.StringBuilder).AppendValues(names, valid)
+	b.Field(2).(*array.StringBuilder).AppendValues(amounts, nil)
+	rec := b.NewRecord()
+	defer rec.Release()
+
+	var buf bytes.Buffer
+	w := ipc.NewWriter(&buf, ipc.WithSchema(schema))
+	require.NoError(t, w.Write(rec))
+	require.NoError(t, w.Close())
+	return buf.Bytes()
+}
+
+func writeJSON(w http.ResponseWriter, v any) {
+	data, err := json.Marshal(v)
+	if err != nil {
+		http.Error(w, err.Error(), http.StatusInternalServerError)
+		return
+	}
+	w.Header().Set("Content-Type", "application/json")
+	_, _ = w.Write(data)
+}
@@ -3,8 +3,10 @@ package sqlite
 import (
 	"context"
 	"fmt"
+	"strings"
 	"time"
 
+	"github.com/jmoiron/sqlx"
 	runtimev1 "github.com/rilldata/rill/proto/gen/rill/runtime/v1"
 	"github.com/rilldata/rill/runtime/drivers"
 	"google.golang.org/protobuf/proto"
@@ -163,3 +165,157 @@ func (c *catalogStore) DeleteEntries(_ context.Context) error {
 	_, err := c.db.ExecContext(ctx, "DELETE FROM catalog WHERE instance_id = ?", c.instanceID)
 	return err
 }
+
+func (c *catalogStore) NextControllerVersion(_ context.Context) (int64, error) {
+	// Override ctx because sqlite sometimes segfaults on context cancellation
+	ctx := context.Background()
+
+	var version int64
+	err := c.db.QueryRowxContext(
+		ctx,
+		"INSERT INTO controller_version(instance_id, version) VALUES (?, 1) ON CONFLICT(instance_id) DO UPDATE SET version = version + 1 RETURNING version",
+		c.instanceID,
+	).Scan(&version)
+	if err != nil {
+		return 0, err
+	}
+
+	return version, nil
+}
+
+func (c *catalogStore) CheckControllerVersion(_ context.Context, v int64) error {
+	// Override ctx because sqlite sometimes segfaults on context cancellation
+	ctx := context.Background()
+
+	var version int64
+	err := c.db.QueryRowxContext(ctx, "SELECT version FROM controller_version WHERE instance_id = ?", c.instanceID).Scan(&version)
+	if err != nil {
+		return err
+	}
+
+	if version != v {
+		return drivers.ErrInconsistentControllerVersion
+	}
+	return nil
+}
+
+// withControllerVersion runs fn in a transaction that fails with drivers.ErrInconsistentControllerVersion if the controller version is not v.
+// The version is checked with an update, which locks it until fn's writes are committed, so a superseded controller can't write to the catalog.
+func (c *catalogStore) withControllerVersion(ctx context.Context, v int64, fn func(tx *sqlx.Tx) error) error {
+	tx, err := c.db.BeginTxx(ctx, nil)
+	if err != nil {
+		return err
+	}
+	defer func() { _ = tx.Rollback() }()
+
+	res, err := tx.ExecContext(ctx, "UPDATE controller_version SET version = version WHERE instance_id = ? AND version = ?", c.instanceID, v)
+	if err != nil {
+		return err
+	}
+	n, err := res.RowsAffected()
+	if err != nil {
+		return err
+	}
+	if n == 0 {
+		return drivers.ErrInconsistentControllerVersion
+	}
+
+	err = fn(tx)
+	if err != nil {
+		return err
+	}
+
+	return tx.Commit()
+}
+
+func (c *catalogStore) FindResources(_ context.Context) ([]drivers.Resource, error) {
+	// Override ctx because sqlite sometimes segfaults on context cancellation
+	ctx := context.Background()
+
+	rows, err := c.db.QueryxContext(ctx, "SELECT kind, name, data FROM catalogv2 WHERE instance_id = ? ORDER BY kind, lower_name", c.instanceID)
+	if err != nil {
+		return nil, err
+	}
+	defer rows.Close()
+
+	var res []drivers.Resource
+	for rows.Next() {
+		r := drivers.Resource{}
+		err := rows.Scan(&r.Kind, &r.Name, &r.Data)
+		if err != nil {
+			return nil, err
+		}
+		res = append(res, r)
+	}
+
+	return res, rows.Err()
+}
+
+func (c *catalogStore) CreateResource(_ context.Context, v int64, r drivers.Resource) error {
+	// Override ctx because sqlite sometimes segfaults on context cancellation
+	ctx := context.Background()
+
+	return c.withControllerVersion(ctx, v, func(tx *sqlx.Tx) error {
+		now := time.Now()
+		_, err := tx.ExecContext(
+			ctx,
+			"INSERT INTO catalogv2(instance_id, kind, lower_name, name, data, created_on, updated_on) VALUES (?, ?, ?, ?, ?, ?, ?)",
+			c.instanceID,
+			r.Kind,
+			strings.ToLower(r.Name),
+			r.Name,
+			r.Data,
+			now,
+			now,
+		)
+		return err
+	})
+}
+
+func (c *catalogStore) UpdateResource(_ context.Context, v int64, r drivers.Resource) error {
+	// Override ctx because sqlite sometimes segfaults on context cancellation
+	ctx := context.Background()
+
+	return c.withControllerVersion(ctx, v, func(tx *sqlx.Tx) error {
+		res, err := tx.ExecContext(
+			ctx,
+			"UPDATE catalogv2 SET name = ?, data = ?, updated_on = ? WHERE instance_id = ? AND kind = ? AND lower_name = ?",
+			r.Name,
+			r.Data,
+			time.Now(),
+			c.instanceID,
+			r.Kind,
+			strings.ToLower(r.Name),
+		)
+		if err != nil {
+			return err
+		}
+
+		n, err := res.RowsAffected()
+		if err != nil {
+			return err
+		}
+		if n == 0 {
+			return drivers.ErrNotFound
+		}
+		return nil
+	})
+}
+
+func (c *catalogStore) DeleteResource(_ context.Context, v int64, k, n string) error {
+	// Override ctx because sqlite sometimes segfaults on context cancellation
+	ctx := context.Background()
+
+	return c.withControllerVersion(ctx, v, func(tx *sqlx.Tx) error {
+		_, err := tx.ExecContext(ctx, "DELETE FROM catalogv2 WHERE instance_id = ? AND kind = ? AND lower_name = ?", c.instanceID, k, strings.ToLower(n))
+		return err
+	})
+}
+
+func (c *catalogStore) DeleteResources(_ context.Context) error {
+	// Override ctx because sqlite sometimes segfaults on context cancellation
+	ctx := context.Background()
+
+	_, err := c.db.ExecContext(ctx, "DELETE FROM catalogv2 WHERE instance_id = ?", c.instanceID)
+	return err
+}
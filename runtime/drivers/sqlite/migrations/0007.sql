@@ -0,0 +1,15 @@
+CREATE TABLE catalogv2 (
+	instance_id TEXT NOT NULL,
+	kind TEXT NOT NULL,
+	lower_name TEXT NOT NULL,
+	name TEXT NOT NULL,
+	data BLOB NOT NULL,
+	created_on TIMESTAMP NOT NULL,
+	updated_on TIMESTAMP NOT NULL,
+	PRIMARY KEY (instance_id, kind, lower_name)
+);
+
+CREATE TABLE controller_version (
+	instance_id TEXT PRIMARY KEY,
+	version INTEGER NOT NULL
+);
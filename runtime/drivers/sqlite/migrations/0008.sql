@@ -0,0 +1,2 @@
+ALTER TABLE instances ADD COLUMN export_limit_rows integer default 0 NOT NULL;
+ALTER TABLE instances ADD COLUMN export_limit_bytes integer default 0 NOT NULL;
@@ -2,7 +2,11 @@ package drivers
 
 import (
 	"context"
+	"fmt"
 	"math"
+	"strconv"
+	"strings"
+	"time"
 
 	runtimev1 "github.com/rilldata/rill/proto/gen/rill/runtime/v1"
 )
@@ -62,6 +66,14 @@ type Sink interface {
 type BucketSource struct {
 	ExtractPolicy *runtimev1.Source_ExtractPolicy
 	Properties    map[string]any
+	// PreviousObjects contains the versions of objects ingested by a previous ingestion, keyed by object key.
+	// If non-nil, only new or changed objects are ingested and appended to the sink.
+	PreviousObjects map[string]string
+	// DeleteRemovedPartitions deletes rows for hive partitions whose objects have been removed since the previous ingestion.
+	DeleteRemovedPartitions bool
+	// IngestedObjects is set by the transporter to the versions of all objects matched by the source, keyed by object key.
+	// It is only set for object stores that support incremental ingestion.
+	IngestedObjects map[string]string
 }
 
 var _ Source = &BucketSource{}
@@ -101,6 +113,13 @@ type DatabaseSource struct {
 	Database string
 	Limit    int
 	Props    map[string]any
+	// CursorColumn and Cursor are used for incremental ingestion.
+	// If Cursor is non-nil, only rows where CursorColumn is greater than or equal to Cursor should be returned.
+	CursorColumn string
+	Cursor       any
+	// IdentifierQuote is the character used to quote CursorColumn in the source's SQL dialect.
+	// It defaults to a double quote.
+	IdentifierQuote rune
 }
 
 var _ Source = &DatabaseSource{}
@@ -117,8 +136,34 @@ func (d *DatabaseSource) FileSource() (*FileSource, bool) {
 	return nil, false
 }
 
+// IncrementalSQL returns SQL filtered to rows where CursorColumn is greater than or equal to Cursor.
+// Rows equal to Cursor are included since more rows with the same cursor value may have been added after the previous ingestion.
+// The SQL is wrapped in an aliased subquery (which MySQL and Postgres require) after trimming trailing semicolons.
+// It returns SQL unchanged if Cursor is nil.
+func (d *DatabaseSource) IncrementalSQL() (string, error) {
+	if d.Cursor == nil {
+		return d.SQL, nil
+	}
+	if d.CursorColumn == "" {
+		return "", fmt.Errorf("cursor column is required for incremental ingestion")
+	}
+	lit, err := SQLLiteral(d.Cursor)
+	if err != nil {
+		return "", err
+	}
+	quote := d.IdentifierQuote
+	if quote == 0 {
+		quote = '"'
+	}
+	q := string(quote)
+	col := q + strings.ReplaceAll(d.CursorColumn, q, q+q) + q
+	sql := strings.TrimRight(d.SQL, "; \t\r\n")
+	return fmt.Sprintf("SELECT * FROM (%s) AS rill_incremental WHERE %s >= %s", sql, col, lit), nil
+}
+
 type DatabaseSink struct {
-	Table  string
+	Table string
+	// Append inserts into Table if it already exists instead of replacing it
 	Append bool
 }
 
@@ -151,6 +196,25 @@ func (f *FileSource) FileSource() (*FileSource, bool) {
 	return f, true
 }
 
+// SQLLiteral formats a value as a SQL literal that can be compared against a column in most SQL dialects.
+// Strings and times are quoted and rely on the database's implicit casts.
+func SQLLiteral(v any) (string, error) {
+	switch v := v.(type) {
+	case string:
+		return "'" + strings.ReplaceAll(v, "'", "''") + "'", nil
+	case time.Time:
+		return "'" + v.UTC().Format(time.RFC3339Nano) + "'", nil
+	case int, int8, int16, int32, int64, uint, uint8, uint16, uint32, uint64:
+		return fmt.Sprintf("%d", v), nil
+	case float32:
+		return strconv.FormatFloat(float64(v), 'f', -1, 32), nil
+	case float64:
+		return strconv.FormatFloat(v, 'f', -1, 64), nil
+	default:
+		return "", fmt.Errorf("unsupported cursor type %T", v)
+	}
+}
+
 // Progress is an interface for communicating progress info
 type Progress interface {
 	Target(val int64, unit ProgressUnit)
@@ -0,0 +1,150 @@
+package logbuffer
+
+import (
+	"context"
+	"sync"
+
+	runtimev1 "github.com/rilldata/rill/proto/gen/rill/runtime/v1"
+)
+
+// watchBufferSize is the number of logs that can be queued for a watcher before new logs are dropped for it.
+const watchBufferSize = 1000
+
+// Buffer is a bounded in-memory ring buffer of logs.
+// When the buffer is full, the oldest logs are overwritten.
+// It is safe for concurrent use.
+type Buffer struct {
+	mu       sync.Mutex
+	logs     []*runtimev1.Log
+	next     int
+	full     bool
+	watchers map[*watcher]bool
+}
+
+type watcher struct {
+	level runtimev1.LogLevel
+	ch    chan *runtimev1.Log
+}
+
+// New returns a new buffer that holds at most capacity logs.
+func New(capacity int) *Buffer {
+	if capacity <= 0 {
+		capacity = 1
+	}
+	return &Buffer{
+		logs:     make([]*runtimev1.Log, capacity),
+		watchers: make(map[*watcher]bool),
+	}
+}
+
+// Add adds a log to the buffer and sends it to active watchers.
+// Slow watchers miss logs instead of blocking Add.
+func (b *Buffer) Add(l *runtimev1.Log) {
+	b.mu.Lock()
+	defer b.mu.Unlock()
+
+	b.logs[b.next] = l
+	b.next++
+	if b.next == len(b.logs) {
+		b.next = 0
+		b.full = true
+	}
+
+	for w := range b.watchers {
+		if l.Level < w.level {
+			continue
+		}
+		select {
+		case w.ch <- l:
+		default:
+		}
+	}
+}
+
+// GetLogs returns logs with a level greater than or equal to level.
+// If limit is greater than zero, only the most recent limit logs are returned.
+// The logs are ordered by time, in ascending order if ascending is true and otherwise in descending order.
+func (b *Buffer) GetLogs(ascending bool, limit int, level runtimev1.LogLevel) []*runtimev1.Log {
+	b.mu.Lock()
+	defer b.mu.Unlock()
+	return b.getLogsLocked(ascending, limit, level)
+}
+
+// getLogsLocked implements GetLogs. It must be called while holding b.mu.
+func (b *Buffer) getLogsLocked(ascending bool, limit int, level runtimev1.LogLevel) []*runtimev1.Log {
+	var res []*runtimev1.Log
+	n := b.len()
+	for i := 0; i < n && (limit <= 0 || len(res) < limit); i++ {
+		// Iterate from newest to oldest
+		l := b.logs[(b.next-1-i+len(b.logs))%len(b.logs)]
+		if l.Level >= level {
+			res = append(res, l)
+		}
+	}
+
+	if ascending {
+		for i, j := 0, len(res)-1; i < j; i, j = i+1, j-1 {
+			res[i], res[j] = res[j], res[i]
+		}
+	}
+
+	return res
+}
+
+// Watch calls fn for every new log with a level greater than or equal to level.
+// If replayLimit is greater than zero, it first calls fn for the most recent replayLimit logs in the buffer.
+// If replayLimit is negative, it first calls fn for all logs in the buffer.
+// Watch blocks until ctx is cancelled or fn returns an error, which it returns.
+func (b *Buffer) Watch(ctx context.Context, level runtimev1.LogLevel, replayLimit int, fn func(l *runtimev1.Log) error) error {
+	w := &watcher{
+		level: level,
+		ch:    make(chan *runtimev1.Log, watchBufferSize),
+	}
+
+	// Get logs to replay and register the watcher atomically, so no logs are missed or duplicated
+	var replay []*runtimev1.Log
+	b.mu.Lock()
+	if replayLimit != 0 {
+		replay = b.getLogsLocked(true, max(replayLimit, 0), level)
+	}
+	b.watchers[w] = true
+	b.mu.Unlock()
+
+	defer func() {
+		b.mu.Lock()
+		delete(b.watchers, w)
+		b.mu.Unlock()
+	}()
+
+	for _, l := range replay {
+		if err := fn(l); err != nil {
+			return err
+		}
+	}
+
+	for {
+		select {
+		case <-ctx.Done():
+			return ctx.Err()
+		case l := <-w.ch:
+			if err := fn(l); err != nil {
+				return err
+			}
+		}
+	}
+}
+
+// len returns the number of logs in the buffer. It must be called while holding b.mu.
+func (b *Buffer) len() int {
+	if b.full {
+		return len(b.logs)
+	}
+	return b.next
+}
+
+func max(a, b int) int {
+	if a > b {
+		return a
+	}
+	return b
+}
@@ -0,0 +1,77 @@
+package logbuffer
+
+import (
+	"context"
+	"errors"
+	"fmt"
+	"testing"
+	"time"
+
+	runtimev1 "github.com/rilldata/rill/proto/gen/rill/runtime/v1"
+	"github.com/stretchr/testify/require"
+)
+
+func TestGetLogs(t *testing.T) {
+	b := New(4)
+	require.Empty(t, b.GetLogs(true, 0, runtimev1.LogLevel_LOG_LEVEL_UNSPECIFIED))
+
+	for i := 0; i < 6; i++ {
+		level := runtimev1.LogLevel_LOG_LEVEL_INFO
+		if i%2 == 1 {
+			level = runtimev1.LogLevel_LOG_LEVEL_ERROR
+		}
+		b.Add(newLog(level, i))
+	}
+
+	// Only the last 4 logs are retained
+	require.Equal(t, []string{"2", "3", "4", "5"}, messages(b.GetLogs(true, 0, runtimev1.LogLevel_LOG_LEVEL_UNSPECIFIED)))
+	require.Equal(t, []string{"5", "4", "3", "2"}, messages(b.GetLogs(false, 0, runtimev1.LogLevel_LOG_LEVEL_UNSPECIFIED)))
+	require.Equal(t, []string{"4", "5"}, messages(b.GetLogs(true, 2, runtimev1.LogLevel_LOG_LEVEL_UNSPECIFIED)))
+	require.Equal(t, []string{"3", "5"}, messages(b.GetLogs(true, 0, runtimev1.LogLevel_LOG_LEVEL_ERROR)))
+	require.Equal(t, []string{"5"}, messages(b.GetLogs(false, 1, runtimev1.LogLevel_LOG_LEVEL_ERROR)))
+}
+
+func TestWatch(t *testing.T) {
+	b := New(10)
+	b.Add(newLog(runtimev1.LogLevel_LOG_LEVEL_INFO, 0))
+	b.Add(newLog(runtimev1.LogLevel_LOG_LEVEL_WARN, 1))
+
+	ctx, cancel := context.WithTimeout(context.Background(), 5*time.Second)
+	defer cancel()
+
+	ch := make(chan string, 10)
+	done := make(chan error)
+	go func() {
+		done <- b.Watch(ctx, runtimev1.LogLevel_LOG_LEVEL_WARN, -1, func(l *runtimev1.Log) error {
+			ch <- l.Message
+			return nil
+		})
+	}()
+	require.Equal(t, "1", <-ch)
+
+	b.Add(newLog(runtimev1.LogLevel_LOG_LEVEL_DEBUG, 2))
+	b.Add(newLog(runtimev1.LogLevel_LOG_LEVEL_ERROR, 3))
+	require.Equal(t, "3", <-ch)
+
+	cancel()
+	require.ErrorIs(t, <-done, context.Canceled)
+
+	// Errors returned by the callback stop the watcher
+	errSend := errors.New("send failed")
+	err := b.Watch(context.Background(), runtimev1.LogLevel_LOG_LEVEL_WARN, -1, func(l *runtimev1.Log) error {
+		return errSend
+	})
+	require.ErrorIs(t, err, errSend)
+}
+
+func newLog(level runtimev1.LogLevel, i int) *runtimev1.Log {
+	return &runtimev1.Log{Level: level, Message: fmt.Sprintf("%d", i)}
+}
+
+func messages(logs []*runtimev1.Log) []string {
+	res := make([]string, len(logs))
+	for i, l := range logs {
+		res[i] = l.Message
+	}
+	return res
+}
@@ -0,0 +1,169 @@
+package measures
+
+import (
+	"errors"
+	"fmt"
+	"strings"
+
+	"github.com/rilldata/rill/runtime/drivers"
+)
+
+// Kind enumerates typed measures. Typed measures aggregate a column with a function that's compiled to the SQL dialect of the OLAP store,
+// so the same measure definition works across dialects.
+// The values match the Kind enums of the metrics view measure protos, so they can be converted with Kind(m.Kind).
+type Kind int32
+
+const (
+	KindUnspecified Kind = iota
+	KindCountDistinct
+	KindPercentile
+	KindMedian
+	KindMin
+	KindMax
+)
+
+// ParseKind parses the kind of a typed measure as written in YAML (e.g. "count_distinct").
+func ParseKind(s string) (Kind, error) {
+	switch strings.ToLower(strings.TrimSpace(s)) {
+	case "":
+		return KindUnspecified, nil
+	case "count_distinct":
+		return KindCountDistinct, nil
+	case "percentile":
+		return KindPercentile, nil
+	case "median":
+		return KindMedian, nil
+	case "min":
+		return KindMin, nil
+	case "max":
+		return KindMax, nil
+	default:
+		return KindUnspecified, fmt.Errorf("invalid measure kind %q (options: count_distinct, percentile, median, min, max)", s)
+	}
+}
+
+// String returns the YAML representation of the kind.
+func (k Kind) String() string {
+	switch k {
+	case KindCountDistinct:
+		return "count_distinct"
+	case KindPercentile:
+		return "percentile"
+	case KindMedian:
+		return "median"
+	case KindMin:
+		return "min"
+	case KindMax:
+		return "max"
+	default:
+		return ""
+	}
+}
+
+// ValidateKind checks the properties of a typed measure.
+func ValidateKind(k Kind, column string, approximate bool, percentile float64) error {
+	if column == "" {
+		return fmt.Errorf("a %s measure must have a column", k)
+	}
+	if k == KindPercentile {
+		if percentile <= 0 || percentile >= 1 {
+			return fmt.Errorf("percentile must be between 0 and 1 (exclusive)")
+		}
+	} else if percentile != 0 {
+		return fmt.Errorf("percentile can only be set for percentile measures")
+	}
+	if approximate && (k == KindMin || k == KindMax) {
+		return fmt.Errorf("approximate can't be set for %s measures", k)
+	}
+	return nil
+}
+
+// Compile returns the SQL expression of a typed measure for the dialect.
+func Compile(dialect drivers.Dialect, k Kind, column string, approximate bool, percentile float64) (string, error) {
+	col := quoteIdentifier(column)
+	if k == KindMedian {
+		percentile = 0.5
+	}
+
+	switch k {
+	case KindMin:
+		return fmt.Sprintf("MIN(%s)", col), nil
+	case KindMax:
+		return fmt.Sprintf("MAX(%s)", col), nil
+	case KindCountDistinct, KindPercentile, KindMedian:
+	default:
+		return "", fmt.Errorf("unsupported measure kind %d", k)
+	}
+
+	switch dialect {
+	case drivers.DialectDuckDB:
+		switch {
+		case k == KindCountDistinct && approximate:
+			return fmt.Sprintf("approx_count_distinct(%s)", col), nil
+		case k == KindCountDistinct:
+			return fmt.Sprintf("COUNT(DISTINCT %s)", col), nil
+		case approximate:
+			return fmt.Sprintf("approx_quantile(%s, %v)", col, percentile), nil
+		default:
+			return fmt.Sprintf("quantile_cont(%s, %v)", col, percentile), nil
+		}
+	case drivers.DialectDruid:
+		// Druid only supports approximate quantiles, which are computed with the quantiles sketches of the DataSketches extension.
+		// It also approximates COUNT(DISTINCT) unless useApproximateCountDistinct is disabled in the query context, so exact distinct counts aren't supported either.
+		switch {
+		case k == KindCountDistinct && approximate:
+			return fmt.Sprintf("APPROX_COUNT_DISTINCT_DS_HLL(%s)", col), nil
+		case approximate:
+			return fmt.Sprintf("APPROX_QUANTILE_DS(%s, %v)", col, percentile), nil
+		default:
+			return "", fmt.Errorf("druid does not support exact %s measures, set approximate: true", k)
+		}
+	case drivers.DialectClickHouse:
+		switch {
+		case k == KindCountDistinct && approximate:
+			return fmt.Sprintf("uniq(%s)", col), nil
+		case k == KindCountDistinct:
+			return fmt.Sprintf("uniqExact(%s)", col), nil
+		case approximate:
+			return fmt.Sprintf("quantile(%v)(%s)", percentile, col), nil
+		default:
+			return fmt.Sprintf("quantileExact(%v)(%s)", percentile, col), nil
+		}
+	default:
+		return "", fmt.Errorf("%s measures are not supported for dialect %s", k, dialect)
+	}
+}
+
+// Typed is implemented by the metrics view measure types that support typed measures. K is the proto's Kind enum.
+type Typed[K ~int32] interface {
+	Measure
+	GetKind() K
+	GetColumn() string
+	GetApproximate() bool
+	GetPercentile() float64
+}
+
+// CompileTyped returns a copy of the measures where typed measures are replaced by measures with their SQL expression for the dialect,
+// which lets them be expanded and queried like any other measure. The replacements are created with withExpression, which must not modify its argument.
+// Typed measures that don't compile are left as they are, and their errors are returned together with the copy.
+func CompileTyped[K ~int32, M Typed[K]](dialect drivers.Dialect, ms []M, withExpression func(m M, expr string) M) ([]M, error) {
+	res := make([]M, len(ms))
+	var errs []error
+	for i, m := range ms {
+		res[i] = m
+		if m.GetKind() == 0 {
+			continue
+		}
+		expr, err := Compile(dialect, Kind(m.GetKind()), m.GetColumn(), m.GetApproximate(), m.GetPercentile())
+		if err != nil {
+			errs = append(errs, fmt.Errorf("measure %q: %w", m.GetName(), err))
+			continue
+		}
+		res[i] = withExpression(m, expr)
+	}
+	return res, errors.Join(errs...)
+}
+
+func quoteIdentifier(name string) string {
+	return `"` + strings.ReplaceAll(name, `"`, `""`) + `"`
+}
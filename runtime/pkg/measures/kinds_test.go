@@ -0,0 +1,75 @@
+package measures
+
+import (
+	"testing"
+
+	runtimev1 "github.com/rilldata/rill/proto/gen/rill/runtime/v1"
+	"github.com/rilldata/rill/runtime/drivers"
+	"github.com/stretchr/testify/require"
+)
+
+func TestCompile(t *testing.T) {
+	cases := []struct {
+		dialect     drivers.Dialect
+		kind        Kind
+		approximate bool
+		percentile  float64
+		want        string
+	}{
+		{drivers.DialectDuckDB, KindCountDistinct, false, 0, `COUNT(DISTINCT "user_id")`},
+		{drivers.DialectDuckDB, KindCountDistinct, true, 0, `approx_count_distinct("user_id")`},
+		{drivers.DialectDuckDB, KindPercentile, false, 0.95, `quantile_cont("user_id", 0.95)`},
+		{drivers.DialectDuckDB, KindMedian, true, 0, `approx_quantile("user_id", 0.5)`},
+		{drivers.DialectDruid, KindCountDistinct, true, 0, `APPROX_COUNT_DISTINCT_DS_HLL("user_id")`},
+		{drivers.DialectDruid, KindPercentile, true, 0.9, `APPROX_QUANTILE_DS("user_id", 0.9)`},
+		{drivers.DialectClickHouse, KindCountDistinct, false, 0, `uniqExact("user_id")`},
+		{drivers.DialectClickHouse, KindPercentile, true, 0.99, `quantile(0.99)("user_id")`},
+		{drivers.DialectClickHouse, KindMin, false, 0, `MIN("user_id")`},
+	}
+	for _, tt := range cases {
+		got, err := Compile(tt.dialect, tt.kind, "user_id", tt.approximate, tt.percentile)
+		require.NoError(t, err)
+		require.Equal(t, tt.want, got)
+	}
+
+	// Druid doesn't support exact quantiles or distinct counts
+	_, err := Compile(drivers.DialectDruid, KindMedian, "user_id", false, 0)
+	require.ErrorContains(t, err, "druid does not support exact median measures")
+	_, err = Compile(drivers.DialectDruid, KindCountDistinct, "user_id", false, 0)
+	require.ErrorContains(t, err, "druid does not support exact count_distinct measures, set approximate: true")
+}
+
+func TestCompileTyped(t *testing.T) {
+	ms := []*runtimev1.MetricsView_Measure{
+		{Name: "users", Kind: runtimev1.MetricsView_Measure_KIND_COUNT_DISTINCT, Column: "user_id", Approximate: true},
+		{Name: "latency_p50", Kind: runtimev1.MetricsView_Measure_KIND_MEDIAN, Column: "latency"},
+		{Name: "clicks", Expression: "sum(clicks)"},
+	}
+	withExpression := func(m *runtimev1.MetricsView_Measure, expr string) *runtimev1.MetricsView_Measure {
+		return &runtimev1.MetricsView_Measure{Name: m.Name, Expression: expr}
+	}
+
+	res, err := CompileTyped[runtimev1.MetricsView_Measure_Kind](drivers.DialectDuckDB, ms, withExpression)
+	require.NoError(t, err)
+	require.Equal(t, `approx_count_distinct("user_id")`, res[0].Expression)
+	require.Equal(t, `quantile_cont("latency", 0.5)`, res[1].Expression)
+	require.True(t, ms[2] == res[2])
+	require.Empty(t, ms[0].Expression)
+
+	// Measures that don't compile are left as they are
+	res, err = CompileTyped[runtimev1.MetricsView_Measure_Kind](drivers.DialectDruid, ms, withExpression)
+	require.ErrorContains(t, err, `measure "latency_p50": druid does not support exact median measures`)
+	require.Equal(t, `APPROX_COUNT_DISTINCT_DS_HLL("user_id")`, res[0].Expression)
+	require.True(t, ms[1] == res[1])
+}
+
+func TestValidateKind(t *testing.T) {
+	require.NoError(t, ValidateKind(KindPercentile, "latency", true, 0.95))
+	require.ErrorContains(t, ValidateKind(KindCountDistinct, "", false, 0), "must have a column")
+	require.ErrorContains(t, ValidateKind(KindPercentile, "latency", false, 1.5), "percentile must be between 0 and 1")
+	require.ErrorContains(t, ValidateKind(KindMedian, "latency", false, 0.5), "percentile can only be set for percentile measures")
+	require.ErrorContains(t, ValidateKind(KindMax, "latency", true, 0), "approximate can't be set for max measures")
+
+	_, err := ParseKind("sum")
+	require.ErrorContains(t, err, `invalid measure kind "sum"`)
+}
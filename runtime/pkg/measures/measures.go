@@ -0,0 +1,200 @@
+// Package measures expands derived measures into plain SQL expressions.
+//
+// A derived measure lists the measures it depends on in "requires" and references them by name in its expression,
+// either as a bare identifier (e.g. "clicks / impressions") or as a double-quoted identifier (e.g. "clicks" / "impressions").
+// Expanding a derived measure replaces each reference with the parenthesized (and recursively expanded) expression of the required measure.
+package measures
+
+import (
+	"fmt"
+	"strings"
+)
+
+// Measure is implemented by the metrics view measure types (both the catalog and the resource spec variants).
+type Measure interface {
+	GetName() string
+	GetExpression() string
+	GetRequires() []string
+}
+
+// Validate checks that the measures required by derived measures exist, are referenced in their expressions, and do not form a cycle.
+func Validate[M Measure](ms []M) error {
+	idx := index(ms)
+	for _, m := range ms {
+		if _, err := expand(idx, m, nil); err != nil {
+			return err
+		}
+	}
+	return nil
+}
+
+// Expand returns the SQL expression for the measure with the given name.
+// References to required measures are replaced with their expanded expressions.
+func Expand[M Measure](ms []M, name string) (string, error) {
+	idx := index(ms)
+	m, ok := idx[strings.ToLower(name)]
+	if !ok {
+		return "", fmt.Errorf("measure %q not found", name)
+	}
+	return expand(idx, m, nil)
+}
+
+func index[M Measure](ms []M) map[string]M {
+	idx := make(map[string]M, len(ms))
+	for _, m := range ms {
+		idx[strings.ToLower(m.GetName())] = m
+	}
+	return idx
+}
+
+// expand recursively expands m. The path contains the names of the measures currently being expanded and is used for cycle detection.
+func expand[M Measure](idx map[string]M, m M, path []string) (string, error) {
+	if len(m.GetRequires()) == 0 {
+		return m.GetExpression(), nil
+	}
+
+	for i, n := range path {
+		if strings.EqualFold(n, m.GetName()) {
+			cycle := append(path[i:len(path):len(path)], m.GetName())
+			return "", fmt.Errorf("measure %q has a cyclic dependency: %s", m.GetName(), strings.Join(cycle, " -> "))
+		}
+	}
+	path = append(path[:len(path):len(path)], m.GetName())
+
+	replacements := make(map[string]string, len(m.GetRequires()))
+	for _, r := range m.GetRequires() {
+		dep, ok := idx[strings.ToLower(r)]
+		if !ok {
+			return "", fmt.Errorf("measure %q requires measure %q, which does not exist", m.GetName(), r)
+		}
+		expr, err := expand(idx, dep, path)
+		if err != nil {
+			return "", err
+		}
+		replacements[strings.ToLower(r)] = "(" + expr + ")"
+	}
+
+	res, used := replaceIdentifiers(m.GetExpression(), replacements)
+	for _, r := range m.GetRequires() {
+		if !used[strings.ToLower(r)] {
+			return "", fmt.Errorf("measure %q requires measure %q, but does not reference it in its expression", m.GetName(), r)
+		}
+	}
+
+	return res, nil
+}
+
+// replaceIdentifiers replaces the identifiers in the SQL expression that match a key in replacements (compared case insensitively).
+// String literals, comments, qualified names (e.g. "t.clicks") and function names are left untouched.
+// It returns the rewritten expression and the set of replaced identifiers.
+func replaceIdentifiers(expr string, replacements map[string]string) (string, map[string]bool) {
+	var b strings.Builder
+	used := make(map[string]bool)
+
+	n := len(expr)
+	for i := 0; i < n; {
+		c := expr[i]
+		switch {
+		case c == '\'':
+			j := scanQuoted(expr, i, '\'')
+			b.WriteString(expr[i:j])
+			i = j
+		case c == '-' && i+1 < n && expr[i+1] == '-':
+			j := strings.IndexByte(expr[i:], '\n')
+			if j < 0 {
+				j = n
+			} else {
+				j += i
+			}
+			b.WriteString(expr[i:j])
+			i = j
+		case c == '/' && i+1 < n && expr[i+1] == '*':
+			j := strings.Index(expr[i+2:], "*/")
+			if j < 0 {
+				j = n
+			} else {
+				j += i + 4
+			}
+			b.WriteString(expr[i:j])
+			i = j
+		case c == '"':
+			j := scanQuoted(expr, i, '"')
+			ident := strings.ReplaceAll(strings.TrimSuffix(expr[i+1:j], `"`), `""`, `"`)
+			if r, ok := lookupReplacement(expr, i, j, ident, replacements); ok {
+				b.WriteString(r)
+				used[strings.ToLower(ident)] = true
+			} else {
+				b.WriteString(expr[i:j])
+			}
+			i = j
+		case isIdentStart(c):
+			j := i + 1
+			for j < n && isIdentPart(expr[j]) {
+				j++
+			}
+			ident := expr[i:j]
+			if r, ok := lookupReplacement(expr, i, j, ident, replacements); ok && !isFunctionCall(expr, j) {
+				b.WriteString(r)
+				used[strings.ToLower(ident)] = true
+			} else {
+				b.WriteString(ident)
+			}
+			i = j
+		default:
+			b.WriteByte(c)
+			i++
+		}
+	}
+
+	return b.String(), used
+}
+
+// lookupReplacement returns the replacement for the identifier at expr[start:end] unless it is part of a qualified name or a number.
+func lookupReplacement(expr string, start, end int, ident string, replacements map[string]string) (string, bool) {
+	if start > 0 && (expr[start-1] == '.' || isIdentPart(expr[start-1])) {
+		return "", false
+	}
+	if end < len(expr) && expr[end] == '.' {
+		return "", false
+	}
+	r, ok := replacements[strings.ToLower(ident)]
+	return r, ok
+}
+
+// scanQuoted returns the index after the closing quote of the quoted token starting at expr[start].
+// Doubled quotes are treated as escapes. If the token is not terminated, it returns len(expr).
+func scanQuoted(expr string, start int, quote byte) int {
+	for i := start + 1; i < len(expr); i++ {
+		if expr[i] != quote {
+			continue
+		}
+		if i+1 < len(expr) && expr[i+1] == quote {
+			i++
+			continue
+		}
+		return i + 1
+	}
+	return len(expr)
+}
+
+func isFunctionCall(expr string, end int) bool {
+	for i := end; i < len(expr); i++ {
+		switch expr[i] {
+		case ' ', '\t', '\n', '\r':
+			continue
+		case '(':
+			return true
+		default:
+			return false
+		}
+	}
+	return false
+}
+
+func isIdentStart(c byte) bool {
+	return c == '_' || (c >= 'a' && c <= 'z') || (c >= 'A' && c <= 'Z')
+}
+
+func isIdentPart(c byte) bool {
+	return isIdentStart(c) || c == '$' || (c >= '0' && c <= '9')
+}
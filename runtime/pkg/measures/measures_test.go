@@ -0,0 +1,70 @@
+package measures
+
+import (
+	"testing"
+
+	runtimev1 "github.com/rilldata/rill/proto/gen/rill/runtime/v1"
+	"github.com/stretchr/testify/require"
+)
+
+func TestExpand(t *testing.T) {
+	ms := []*runtimev1.MetricsView_Measure{
+		{Name: "clicks", Expression: "sum(clicks)"},
+		{Name: "impressions", Expression: "sum(impressions)"},
+		{Name: "ctr", Expression: `clicks / "impressions"`, Requires: []string{"clicks", "impressions"}},
+		{Name: "ctr_pct", Expression: "CTR * 100", Requires: []string{"ctr"}},
+		{Name: "labelled", Expression: "max(t.clicks) + clicks + length('clicks') -- clicks", Requires: []string{"clicks"}},
+	}
+
+	cases := []struct {
+		name string
+		want string
+	}{
+		{"clicks", "sum(clicks)"},
+		{"ctr", "(sum(clicks)) / (sum(impressions))"},
+		{"ctr_pct", "((sum(clicks)) / (sum(impressions))) * 100"},
+		{"labelled", "max(t.clicks) + (sum(clicks)) + length('clicks') -- clicks"},
+	}
+	for _, tt := range cases {
+		t.Run(tt.name, func(t *testing.T) {
+			got, err := Expand(ms, tt.name)
+			require.NoError(t, err)
+			require.Equal(t, tt.want, got)
+		})
+	}
+
+	_, err := Expand(ms, "missing")
+	require.ErrorContains(t, err, `measure "missing" not found`)
+}
+
+func TestValidate(t *testing.T) {
+	err := Validate([]*runtimev1.MetricsView_Measure{
+		{Name: "a", Expression: "sum(a)"},
+		{Name: "b", Expression: "a * 2", Requires: []string{"a"}},
+	})
+	require.NoError(t, err)
+
+	err = Validate([]*runtimev1.MetricsView_Measure{
+		{Name: "a", Expression: "b + c", Requires: []string{"b", "c"}},
+		{Name: "b", Expression: "sum(b)"},
+		{Name: "c", Expression: "a * 2", Requires: []string{"a"}},
+	})
+	require.ErrorContains(t, err, "cyclic dependency: a -> c -> a")
+
+	err = Validate([]*runtimev1.MetricsView_Measure{
+		{Name: "a", Expression: "sum(a)"},
+		{Name: "b", Expression: "b * 2", Requires: []string{"b"}},
+	})
+	require.ErrorContains(t, err, "cyclic dependency: b -> b")
+
+	err = Validate([]*runtimev1.MetricsView_Measure{
+		{Name: "a", Expression: "sum(a)"},
+		{Name: "b", Expression: "count(*)", Requires: []string{"a"}},
+	})
+	require.ErrorContains(t, err, `measure "b" requires measure "a", but does not reference it`)
+
+	err = Validate([]*runtimev1.MetricsView_Measure{
+		{Name: "b", Expression: "x * 2", Requires: []string{"x"}},
+	})
+	require.ErrorContains(t, err, `measure "b" requires measure "x", which does not exist`)
+}
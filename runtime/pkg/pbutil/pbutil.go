@@ -4,6 +4,7 @@ import (
 	"fmt"
 	"math"
 	"math/big"
+	"reflect"
 	"time"
 	"unicode/utf8"
 
@@ -106,6 +107,13 @@ func ToValue(v any, t *runtimev1.Type) (*structpb.Value, error) {
 		}
 		return structpb.NewValue(v)
 	default:
+		// Dereference pointers, which some drivers (e.g. ClickHouse) use for nullable values
+		if rv := reflect.ValueOf(v); rv.Kind() == reflect.Pointer {
+			if rv.IsNil() {
+				return structpb.NewNullValue(), nil
+			}
+			return ToValue(rv.Elem().Interface(), t)
+		}
 		// Default handling for basic types (ints, string, etc.)
 		return structpb.NewValue(v)
 	}
@@ -31,3 +31,15 @@ func TestToStructCoerceKeys(t *testing.T) {
 		require.True(t, proto.Equal(expected, actual))
 	}
 }
+
+func TestToValuePointer(t *testing.T) {
+	s := "hello"
+	v, err := ToValue(&s, nil)
+	require.NoError(t, err)
+	require.True(t, proto.Equal(structpb.NewStringValue("hello"), v))
+
+	var f *float64
+	v, err = ToValue(f, nil)
+	require.NoError(t, err)
+	require.True(t, proto.Equal(structpb.NewNullValue(), v))
+}
@@ -50,12 +50,11 @@ func (q *ColumnCardinality) Resolve(ctx context.Context, rt *runtime.Runtime, in
 	}
 	defer release()
 
-	if olap.Dialect() != drivers.DialectDuckDB {
-		return fmt.Errorf("not available for dialect '%s'", olap.Dialect())
+	requestSQL, err := columnCardinalitySQL(olap.Dialect(), q.TableName, q.ColumnName)
+	if err != nil {
+		return err
 	}
 
-	requestSQL := fmt.Sprintf("SELECT approx_count_distinct(%s) as count from %s", safeName(q.ColumnName), safeName(q.TableName))
-
 	rows, err := olap.Execute(ctx, &drivers.Statement{
 		Query:            requestSQL,
 		Priority:         priority,
@@ -87,3 +86,15 @@ func (q *ColumnCardinality) Resolve(ctx context.Context, rt *runtime.Runtime, in
 func (q *ColumnCardinality) Export(ctx context.Context, rt *runtime.Runtime, instanceID string, w io.Writer, opts *runtime.ExportOptions) error {
 	return ErrExportNotSupported
 }
+
+// columnCardinalitySQL returns a query that approximates the number of distinct values in a column.
+func columnCardinalitySQL(dialect drivers.Dialect, tableName, columnName string) (string, error) {
+	switch dialect {
+	case drivers.DialectDuckDB:
+		return fmt.Sprintf("SELECT approx_count_distinct(%s) as count from %s", safeName(columnName), safeName(tableName)), nil
+	case drivers.DialectClickHouse:
+		return fmt.Sprintf("SELECT uniq(%s) as count from %s", safeName(columnName), safeName(tableName)), nil
+	default:
+		return "", fmt.Errorf("not available for dialect '%s'", dialect)
+	}
+}
@@ -50,29 +50,43 @@ func (q *ColumnDescriptiveStatistics) Resolve(ctx context.Context, rt *runtime.R
 	}
 	defer release()
 
-	if olap.Dialect() != drivers.DialectDuckDB {
+	sanitizedColumnName := safeName(q.ColumnName)
+	var descriptiveStatisticsSQL string
+	switch olap.Dialect() {
+	case drivers.DialectDuckDB:
+		descriptiveStatisticsSQL = fmt.Sprintf("SELECT "+
+			"min(%s)::DOUBLE as min, "+
+			"approx_quantile(%s, 0.25)::DOUBLE as q25, "+
+			"approx_quantile(%s, 0.5)::DOUBLE as q50, "+
+			"approx_quantile(%s, 0.75)::DOUBLE as q75, "+
+			"max(%s)::DOUBLE as max, "+
+			"avg(%s)::DOUBLE as mean, "+
+			"stddev_pop(%s)::DOUBLE as sd "+
+			"FROM %s",
+			sanitizedColumnName,
+			sanitizedColumnName,
+			sanitizedColumnName,
+			sanitizedColumnName,
+			sanitizedColumnName,
+			sanitizedColumnName,
+			sanitizedColumnName,
+			safeName(q.TableName))
+	case drivers.DialectClickHouse:
+		descriptiveStatisticsSQL = fmt.Sprintf("SELECT "+
+			"toFloat64(min(%[1]s)) as min, "+
+			"toFloat64(quantile(0.25)(%[1]s)) as q25, "+
+			"toFloat64(quantile(0.5)(%[1]s)) as q50, "+
+			"toFloat64(quantile(0.75)(%[1]s)) as q75, "+
+			"toFloat64(max(%[1]s)) as max, "+
+			"toFloat64(avg(%[1]s)) as mean, "+
+			"toFloat64(stddevPop(%[1]s)) as sd "+
+			"FROM %[2]s",
+			sanitizedColumnName,
+			safeName(q.TableName))
+	default:
 		return fmt.Errorf("not available for dialect '%s'", olap.Dialect())
 	}
 
-	sanitizedColumnName := safeName(q.ColumnName)
-	descriptiveStatisticsSQL := fmt.Sprintf("SELECT "+
-		"min(%s)::DOUBLE as min, "+
-		"approx_quantile(%s, 0.25)::DOUBLE as q25, "+
-		"approx_quantile(%s, 0.5)::DOUBLE as q50, "+
-		"approx_quantile(%s, 0.75)::DOUBLE as q75, "+
-		"max(%s)::DOUBLE as max, "+
-		"avg(%s)::DOUBLE as mean, "+
-		"stddev_pop(%s)::DOUBLE as sd "+
-		"FROM %s",
-		sanitizedColumnName,
-		sanitizedColumnName,
-		sanitizedColumnName,
-		sanitizedColumnName,
-		sanitizedColumnName,
-		sanitizedColumnName,
-		sanitizedColumnName,
-		safeName(q.TableName))
-
 	rows, err := olap.Execute(ctx, &drivers.Statement{
 		Query:            descriptiveStatisticsSQL,
 		Priority:         priority,
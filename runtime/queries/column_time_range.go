@@ -57,7 +57,7 @@ func (q *ColumnTimeRange) Resolve(ctx context.Context, rt *runtime.Runtime, inst
 	switch olap.Dialect() {
 	case drivers.DialectDuckDB:
 		return q.resolveDuckDB(ctx, olap, priority)
-	case drivers.DialectDruid:
+	case drivers.DialectDruid, drivers.DialectClickHouse:
 		return q.resolveDruid(ctx, olap, priority)
 	default:
 		return fmt.Errorf("not available for dialect '%s'", olap.Dialect())
@@ -40,11 +40,18 @@ type ColumnTimeseries struct {
 	Pixels              int32                                             `json:"pixels"`
 	SampleSize          int32                                             `json:"sample_size"`
 	TimeZone            string                                            `json:"time_zone,omitempty"`
+	FirstDayOfWeek      uint32                                            `json:"first_day_of_week,omitempty"`
+	FirstMonthOfYear    uint32                                            `json:"first_month_of_year,omitempty"`
 	Result              *ColumnTimeseriesResult                           `json:"-"`
 
 	// MetricsView-related fields. These can be removed when MetricsViewTimeSeries is refactored to a standalone implementation.
-	MetricsView       *runtimev1.MetricsView       `json:"-"`
-	MetricsViewFilter *runtimev1.MetricsViewFilter `json:"filters"`
+	MetricsView         *runtimev1.MetricsView               `json:"-"`
+	MetricsViewFilter   *runtimev1.MetricsViewFilter         `json:"filters"`
+	MetricsViewWhere    *runtimev1.Expression                `json:"where,omitempty"`
+	MetricsViewSecurity *runtime.ResolvedMetricsViewSecurity `json:"security"`
+	// Window measures computed over the measures, and the names of measures only used as window inputs.
+	MetricsViewWindows []*runtimev1.MetricsView_Measure `json:"windows,omitempty"`
+	MetricsViewHidden  []string                         `json:"hidden,omitempty"`
 }
 
 var _ runtime.Query = &ColumnTimeseries{}
@@ -84,7 +91,7 @@ func (q *ColumnTimeseries) Resolve(ctx context.Context, rt *runtime.Runtime, ins
 	}
 	defer release()
 
-	if olap.Dialect() != drivers.DialectDuckDB {
+	if olap.Dialect() != drivers.DialectDuckDB && olap.Dialect() != drivers.DialectClickHouse {
 		return fmt.Errorf("not available for dialect '%s'", olap.Dialect())
 	}
 
@@ -98,8 +105,12 @@ func (q *ColumnTimeseries) Resolve(ctx context.Context, rt *runtime.Runtime, ins
 		return nil
 	}
 
+	if olap.Dialect() == drivers.DialectClickHouse {
+		return q.resolveWithTimeFloor(ctx, olap, timeRange, priority)
+	}
+
 	return olap.WithConnection(ctx, priority, func(ctx context.Context, ensuredCtx context.Context, _ *sql.Conn) error {
-		filter, args, err := buildFilterClauseForMetricsViewFilter(q.MetricsView, q.MetricsViewFilter, olap.Dialect())
+		filter, args, err := buildFilterClauseForMetricsViewFilter(q.MetricsView, q.MetricsViewFilter, q.MetricsViewWhere, olap.Dialect(), q.MetricsViewSecurity)
 		if err != nil {
 			return err
 		}
@@ -109,6 +120,7 @@ func (q *ColumnTimeseries) Resolve(ctx context.Context, rt *runtime.Runtime, ins
 
 		measures := normaliseMeasures(q.Measures, q.Pixels != 0)
 		dateTruncSpecifier := convertToDateTruncSpecifier(timeRange.Interval)
+		cal := calendar{FirstDayOfWeek: q.FirstDayOfWeek, FirstMonthOfYear: q.FirstMonthOfYear}
 		tsAlias := tempName("_ts_")
 		temporaryTableName := tempName("_timeseries_")
 
@@ -125,26 +137,34 @@ func (q *ColumnTimeseries) Resolve(ctx context.Context, rt *runtime.Runtime, ins
 		args = append([]any{timezone, timeRange.Start.AsTime(), timezone, timeRange.End.AsTime(), timezone}, args...)
 		args = append(args, timezone)
 
-		querySQL := `CREATE TEMPORARY TABLE ` + temporaryTableName + ` AS (
+		// window measures need the buckets before the start of the time range
+		rangeStart := dateTruncExpr(`timezone(?, ?::TIMESTAMPTZ)`, timeRange.Interval, cal)
+		if lookback := windowLookback(q.MetricsViewWindows); lookback > 0 {
+			rangeStart += fmt.Sprintf(" - INTERVAL '%d %s'", lookback, dateTruncSpecifier)
+		}
+
+		querySQL := `
 			-- generate a time series column that has the intended range
 			WITH template as (
 				SELECT
 					range as ` + tsAlias + `
 				FROM
 					range(
-					date_trunc('` + dateTruncSpecifier + `', timezone(?, ?::TIMESTAMPTZ)),
-					date_trunc('` + dateTruncSpecifier + `', timezone(?, ?::TIMESTAMPTZ)),
+					` + rangeStart + `,
+					` + dateTruncExpr(`timezone(?, ?::TIMESTAMPTZ)`, timeRange.Interval, cal) + `,
 					INTERVAL '1 ` + dateTruncSpecifier + `')
 			),
 			-- transform the original data, and optionally sample it.
 			series AS (
 			SELECT
-				date_trunc('` + dateTruncSpecifier + `', timezone(?, ` + safeName(q.TimestampColumnName) + `::TIMESTAMPTZ)) as ` + tsAlias + `,` + getExpressionColumnsFromMeasures(measures) + `
+				` + dateTruncExpr(`timezone(?, `+safeName(q.TimestampColumnName)+`::TIMESTAMPTZ)`, timeRange.Interval, cal) + ` as ` + tsAlias + `,` + getExpressionColumnsFromMeasures(measures) + `
 			FROM ` + safeName(q.TableName) + ` ` + filter + `
 			GROUP BY ` + tsAlias + ` ORDER BY ` + tsAlias + `
 			)
-			-- an additional grouping is required for time zone DST (see unit tests for examples)
-			SELECT ` + tsAlias + `,` + getCoalesceStatementsMeasuresLast(measures) + ` FROM (
+			`
+
+		// an additional grouping is required for time zone DST (see unit tests for examples)
+		selectSQL := `SELECT ` + tsAlias + `,` + getCoalesceStatementsMeasuresLast(measures) + ` FROM (
 				-- join the transformed data with the generated time series column,
 				-- coalescing the first value to get the 0-default when the rolled up data
 				-- does not have that value.
@@ -153,8 +173,18 @@ func (q *ColumnTimeseries) Resolve(ctx context.Context, rt *runtime.Runtime, ins
 				timezone(?, template.` + tsAlias + `) as ` + tsAlias + ` from template
 				LEFT OUTER JOIN series ON template.` + tsAlias + ` = series.` + tsAlias + `
 				ORDER BY template.` + tsAlias + `
-			) GROUP BY 1 ORDER BY 1
-		)`
+			) GROUP BY 1 ORDER BY 1`
+
+		if len(q.MetricsViewWindows) > 0 {
+			windowSQL, windowArgs, err := buildWindowMeasuresSQL(selectSQL, tsAlias, measures, q.MetricsViewWindows, q.MetricsViewHidden, timeRange.Interval, cal, timezone, timeRange.Start)
+			if err != nil {
+				return err
+			}
+			selectSQL = windowSQL
+			args = append(args, windowArgs...)
+		}
+
+		querySQL = `CREATE TEMPORARY TABLE ` + temporaryTableName + ` AS (` + querySQL + selectSQL + `)`
 
 		err = olap.Exec(ctx, &drivers.Statement{
 			Query:            querySQL,
@@ -250,6 +280,119 @@ func (q *ColumnTimeseries) Resolve(ctx context.Context, rt *runtime.Runtime, ins
 	})
 }
 
+// resolveWithTimeFloor resolves the time series with a single query that groups by the truncated timestamp column.
+// Unlike the DuckDB implementation, it doesn't fill in missing time buckets, compute window measures or compute the spark line.
+func (q *ColumnTimeseries) resolveWithTimeFloor(ctx context.Context, olap drivers.OLAPStore, timeRange *runtimev1.TimeSeriesTimeRange, priority int) error {
+	if len(q.MetricsViewWindows) > 0 {
+		return fmt.Errorf("window measures are not available for dialect '%s'", olap.Dialect())
+	}
+
+	sql, tsAlias, args, err := q.buildTimeFloorSQL(olap.Dialect(), timeRange)
+	if err != nil {
+		return err
+	}
+
+	rows, err := olap.Execute(ctx, &drivers.Statement{
+		Query:            sql,
+		Args:             args,
+		Priority:         priority,
+		ExecutionTimeout: defaultExecutionTimeout,
+	})
+	if err != nil {
+		return err
+	}
+	defer rows.Close()
+
+	// Omit the time value from the result schema
+	schema := rows.Schema
+	if schema != nil {
+		for i, f := range schema.Fields {
+			if f.Name == tsAlias {
+				schema.Fields = slices.Delete(schema.Fields, i, i+1)
+				break
+			}
+		}
+	}
+
+	var data []*runtimev1.TimeSeriesValue
+	for rows.Next() {
+		rowMap := make(map[string]any)
+		err := rows.MapScan(rowMap)
+		if err != nil {
+			return err
+		}
+
+		t, ok := rowMap[tsAlias].(time.Time)
+		if !ok {
+			return fmt.Errorf("unexpected type for timestamp column: %T", rowMap[tsAlias])
+		}
+		delete(rowMap, tsAlias)
+
+		records, err := pbutil.ToStruct(rowMap, schema)
+		if err != nil {
+			return err
+		}
+
+		data = append(data, &runtimev1.TimeSeriesValue{
+			Ts:      timestamppb.New(t),
+			Records: records,
+		})
+	}
+	if err := rows.Err(); err != nil {
+		return err
+	}
+
+	q.Result = &ColumnTimeseriesResult{
+		Meta:    structTypeToMetricsViewColumn(rows.Schema),
+		Results: data,
+	}
+	return nil
+}
+
+// buildTimeFloorSQL builds the query used by resolveWithTimeFloor. It returns the SQL, the alias of the time column and the args.
+func (q *ColumnTimeseries) buildTimeFloorSQL(dialect drivers.Dialect, timeRange *runtimev1.TimeSeriesTimeRange) (string, string, []any, error) {
+	timezone := "UTC"
+	if q.TimeZone != "" {
+		timezone = q.TimeZone
+	}
+	_, err := time.LoadLocation(timezone)
+	if err != nil {
+		return "", "", nil, err
+	}
+
+	tsCol := safeName(q.TimestampColumnName)
+	tsAlias := tempName("_ts_")
+	cal := calendar{FirstDayOfWeek: q.FirstDayOfWeek, FirstMonthOfYear: q.FirstMonthOfYear}
+	timeFloor, args, err := timeFloorExpr(dialect, tsCol, timeRange.Interval, timezone, cal)
+	if err != nil {
+		return "", "", nil, err
+	}
+	args = append(args, timeRange.Start.AsTime(), timeRange.End.AsTime())
+
+	filter, filterArgs, err := buildFilterClauseForMetricsViewFilter(q.MetricsView, q.MetricsViewFilter, q.MetricsViewWhere, dialect, q.MetricsViewSecurity)
+	if err != nil {
+		return "", "", nil, err
+	}
+	args = append(args, filterArgs...)
+	if filter != "" {
+		filter = " " + filter
+	}
+
+	sql := fmt.Sprintf(
+		"SELECT %s AS %s, %s FROM %s WHERE %s >= ? AND %s < ?%s GROUP BY %s ORDER BY %s",
+		timeFloor,
+		safeName(tsAlias),
+		getExpressionColumnsFromMeasures(normaliseMeasures(q.Measures, false)),
+		safeName(q.TableName),
+		tsCol,
+		tsCol,
+		filter,
+		safeName(tsAlias),
+		safeName(tsAlias),
+	)
+	return sql, tsAlias, args, nil
+}
+
 func (q *ColumnTimeseries) Export(ctx context.Context, rt *runtime.Runtime, instanceID string, w io.Writer, opts *runtime.ExportOptions) error {
 	return ErrExportNotSupported
 }
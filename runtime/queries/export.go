@@ -0,0 +1,651 @@
+package queries
+
+import (
+	"bufio"
+	"compress/gzip"
+	"context"
+	"encoding/csv"
+	"encoding/json"
+	"errors"
+	"fmt"
+	"io"
+	"os"
+	"path/filepath"
+
+	"github.com/apache/arrow/go/v11/arrow"
+	"github.com/apache/arrow/go/v11/arrow/array"
+	"github.com/apache/arrow/go/v11/arrow/memory"
+	"github.com/apache/arrow/go/v11/parquet/pqarrow"
+	"github.com/google/uuid"
+	runtimev1 "github.com/rilldata/rill/proto/gen/rill/runtime/v1"
+	"github.com/rilldata/rill/runtime"
+	"github.com/rilldata/rill/runtime/drivers"
+	"github.com/rilldata/rill/runtime/pkg/pbutil"
+	"github.com/xuri/excelize/v2"
+	"google.golang.org/grpc/codes"
+	"google.golang.org/grpc/status"
+	"google.golang.org/protobuf/encoding/protojson"
+	"google.golang.org/protobuf/types/known/structpb"
+)
+
+// ErrExportLimitExceeded is returned when an export exceeds the export row or byte limit of the instance.
+var ErrExportLimitExceeded = errors.New("export limit exceeded")
+
+// parquetExportBatchSize is the number of rows buffered in memory before they're written to a Parquet export as a row group.
+const parquetExportBatchSize = 100000
+
+// exportWriter serializes the rows of an export one at a time, so exports don't need to be held in memory.
+type exportWriter interface {
+	// Write serializes a row.
+	Write(row *structpb.Struct) error
+	// Close flushes buffered output. It must be called after the last row has been written.
+	Close() error
+}
+
+// newExportWriter returns an exportWriter that serializes rows with the given columns to w in the given format.
+func newExportWriter(format runtimev1.ExportFormat, meta []*runtimev1.MetricsViewColumn, w io.Writer) (exportWriter, error) {
+	switch format {
+	case runtimev1.ExportFormat_EXPORT_FORMAT_UNSPECIFIED:
+		return nil, fmt.Errorf("unspecified format")
+	case runtimev1.ExportFormat_EXPORT_FORMAT_CSV:
+		return newCSVExportWriter(meta, w, false)
+	case runtimev1.ExportFormat_EXPORT_FORMAT_CSV_GZIP:
+		return newCSVExportWriter(meta, w, true)
+	case runtimev1.ExportFormat_EXPORT_FORMAT_XLSX:
+		return newXLSXExportWriter(meta, w)
+	case runtimev1.ExportFormat_EXPORT_FORMAT_PARQUET:
+		return newParquetExportWriter(meta, w)
+	case runtimev1.ExportFormat_EXPORT_FORMAT_NDJSON:
+		return newNDJSONExportWriter(meta, w), nil
+	default:
+		return nil, fmt.Errorf("unsupported format %q", format.String())
+	}
+}
+
+// exportQuery executes a query and streams the result rows to w.
+// It enforces the instance's export limits and calls opts.PreWriteHook before anything is written to w.
+func exportQuery(ctx context.Context, rt *runtime.Runtime, instanceID string, olap drivers.OLAPStore, w io.Writer, opts *runtime.ExportOptions, filename, sql string, args []any) error {
+	limits, err := lookupExportLimits(ctx, rt, instanceID)
+	if err != nil {
+		return err
+	}
+
+	rows, err := olap.Execute(ctx, &drivers.Statement{
+		Query:            sql,
+		Args:             args,
+		Priority:         opts.Priority,
+		ExecutionTimeout: defaultExportTimeout,
+	})
+	if err != nil {
+		return status.Error(codes.InvalidArgument, err.Error())
+	}
+	defer rows.Close()
+
+	if opts.PreWriteHook != nil {
+		err = opts.PreWriteHook(filename)
+		if err != nil {
+			return err
+		}
+	}
+
+	ew, err := limits.newExportWriter(opts.Format, structTypeToMetricsViewColumn(rows.Schema), w)
+	if err != nil {
+		return err
+	}
+
+	for rows.Next() {
+		rowMap := make(map[string]any)
+		err := rows.MapScan(rowMap)
+		if err != nil {
+			return err
+		}
+
+		row, err := pbutil.ToStruct(rowMap, rows.Schema)
+		if err != nil {
+			return err
+		}
+
+		err = ew.Write(row)
+		if err != nil {
+			return err
+		}
+	}
+
+	err = rows.Err()
+	if err != nil {
+		return err
+	}
+
+	return ew.Close()
+}
+
+// exportData writes rows that have already been resolved to w.
+// It's used for queries that post-process results in memory, such as pivots and time series.
+// It enforces the instance's export limits and calls opts.PreWriteHook before anything is written to w.
+func exportData(ctx context.Context, rt *runtime.Runtime, instanceID string, w io.Writer, opts *runtime.ExportOptions, filename string, meta []*runtimev1.MetricsViewColumn, data []*structpb.Struct) error {
+	limits, err := lookupExportLimits(ctx, rt, instanceID)
+	if err != nil {
+		return err
+	}
+
+	// Fail before writing anything if we already know the export is too large
+	err = limits.checkRows(int64(len(data)))
+	if err != nil {
+		return err
+	}
+
+	if opts.PreWriteHook != nil {
+		err = opts.PreWriteHook(filename)
+		if err != nil {
+			return err
+		}
+	}
+
+	ew, err := limits.newExportWriter(opts.Format, meta, w)
+	if err != nil {
+		return err
+	}
+
+	return writeRows(ew, data)
+}
+
+func duckDBCopyExport(ctx context.Context, rt *runtime.Runtime, instanceID string, w io.Writer, opts *runtime.ExportOptions, sql string, args []any, filename string, olap drivers.OLAPStore, mv *runtimev1.MetricsView, exportFormat runtimev1.ExportFormat) error {
+	limits, err := lookupExportLimits(ctx, rt, instanceID)
+	if err != nil {
+		return err
+	}
+
+	var extension string
+	switch exportFormat {
+	case runtimev1.ExportFormat_EXPORT_FORMAT_PARQUET:
+		extension = "parquet"
+	case runtimev1.ExportFormat_EXPORT_FORMAT_CSV:
+		extension = "csv"
+	}
+
+	tmpPath := fmt.Sprintf("export_%s.%s", uuid.New().String(), extension)
+	tmpPath = filepath.Join(os.TempDir(), tmpPath)
+	defer os.Remove(tmpPath)
+
+	sql = fmt.Sprintf("COPY (%s) TO '%s'", sql, tmpPath)
+
+	rows, err := olap.Execute(ctx, &drivers.Statement{
+		Query:            sql,
+		Args:             args,
+		Priority:         opts.Priority,
+		ExecutionTimeout: defaultExportTimeout,
+	})
+	if err != nil {
+		return err
+	}
+	defer rows.Close()
+
+	// COPY returns the number of exported rows
+	if limits.rows > 0 && rows.Next() {
+		var n int64
+		if err := rows.Scan(&n); err != nil {
+			return err
+		}
+		err = limits.checkRows(n)
+		if err != nil {
+			return err
+		}
+	}
+
+	f, err := os.Open(tmpPath)
+	if err != nil {
+		return err
+	}
+	defer f.Close()
+
+	if limits.bytes > 0 {
+		fi, err := f.Stat()
+		if err != nil {
+			return err
+		}
+		err = limits.checkBytes(fi.Size())
+		if err != nil {
+			return err
+		}
+	}
+
+	if opts.PreWriteHook != nil {
+		err = opts.PreWriteHook(filename)
+		if err != nil {
+			return err
+		}
+	}
+
+	_, err = io.Copy(w, f)
+	return err
+}
+
+// exportLimits are the export ceilings configured for an instance. Zero values mean there is no limit.
+type exportLimits struct {
+	rows  int64
+	bytes int64
+}
+
+func lookupExportLimits(ctx context.Context, rt *runtime.Runtime, instanceID string) (exportLimits, error) {
+	inst, err := rt.FindInstance(ctx, instanceID)
+	if err != nil {
+		return exportLimits{}, err
+	}
+	return exportLimits{rows: inst.ExportLimitRows, bytes: inst.ExportLimitBytes}, nil
+}
+
+func (l exportLimits) checkRows(n int64) error {
+	if l.rows > 0 && n > l.rows {
+		return fmt.Errorf("%w: the export has more than %d rows, which is the maximum allowed for this instance (add filters or a lower limit to export less data)", ErrExportLimitExceeded, l.rows)
+	}
+	return nil
+}
+
+func (l exportLimits) checkBytes(n int64) error {
+	if l.bytes > 0 && n > l.bytes {
+		return fmt.Errorf("%w: the export is larger than %d bytes, which is the maximum allowed for this instance (add filters or a lower limit to export less data)", ErrExportLimitExceeded, l.bytes)
+	}
+	return nil
+}
+
+// newExportWriter returns an exportWriter that fails when the export exceeds the limits.
+func (l exportLimits) newExportWriter(format runtimev1.ExportFormat, meta []*runtimev1.MetricsViewColumn, w io.Writer) (exportWriter, error) {
+	if l.bytes > 0 {
+		w = &limitedWriter{w: w, limits: l}
+	}
+
+	ew, err := newExportWriter(format, meta, w)
+	if err != nil {
+		return nil, err
+	}
+
+	if l.rows > 0 {
+		ew = &limitedExportWriter{exportWriter: ew, limits: l}
+	}
+
+	return ew, nil
+}
+
+// limitedExportWriter is an exportWriter that fails when more rows than the row limit are written.
+type limitedExportWriter struct {
+	exportWriter
+	limits exportLimits
+	n      int64
+}
+
+func (w *limitedExportWriter) Write(row *structpb.Struct) error {
+	w.n++
+	err := w.limits.checkRows(w.n)
+	if err != nil {
+		return err
+	}
+	return w.exportWriter.Write(row)
+}
+
+// limitedWriter is an io.Writer that fails when more bytes than the byte limit are written.
+type limitedWriter struct {
+	w      io.Writer
+	limits exportLimits
+	n      int64
+}
+
+func (w *limitedWriter) Write(p []byte) (int, error) {
+	err := w.limits.checkBytes(w.n + int64(len(p)))
+	if err != nil {
+		return 0, err
+	}
+	n, err := w.w.Write(p)
+	w.n += int64(n)
+	return n, err
+}
+
+func writeRows(ew exportWriter, data []*structpb.Struct) error {
+	for _, row := range data {
+		if err := ew.Write(row); err != nil {
+			return err
+		}
+	}
+	return ew.Close()
+}
+
+func writeCSV(meta []*runtimev1.MetricsViewColumn, data []*structpb.Struct, writer io.Writer) error {
+	ew, err := newCSVExportWriter(meta, writer, false)
+	if err != nil {
+		return err
+	}
+	return writeRows(ew, data)
+}
+
+func writeXLSX(meta []*runtimev1.MetricsViewColumn, data []*structpb.Struct, writer io.Writer) error {
+	ew, err := newXLSXExportWriter(meta, writer)
+	if err != nil {
+		return err
+	}
+	return writeRows(ew, data)
+}
+
+func writeParquet(meta []*runtimev1.MetricsViewColumn, data []*structpb.Struct, ioWriter io.Writer) error {
+	ew, err := newParquetExportWriter(meta, ioWriter)
+	if err != nil {
+		return err
+	}
+	return writeRows(ew, data)
+}
+
+type csvExportWriter struct {
+	meta   []*runtimev1.MetricsViewColumn
+	csv    *csv.Writer
+	gzip   *gzip.Writer // nil if the output isn't compressed
+	record []string
+}
+
+func newCSVExportWriter(meta []*runtimev1.MetricsViewColumn, writer io.Writer, compress bool) (*csvExportWriter, error) {
+	w := &csvExportWriter{
+		meta:   meta,
+		record: make([]string, 0, len(meta)),
+	}
+
+	if compress {
+		w.gzip = gzip.NewWriter(writer)
+		writer = w.gzip
+	}
+	w.csv = csv.NewWriter(writer)
+
+	for _, field := range meta {
+		w.record = append(w.record, field.Name)
+	}
+	if err := w.csv.Write(w.record); err != nil {
+		return nil, err
+	}
+
+	return w, nil
+}
+
+func (w *csvExportWriter) Write(row *structpb.Struct) error {
+	w.record = w.record[:0]
+	for _, field := range w.meta {
+		str, err := convertToString(row.Fields[field.Name])
+		if err != nil {
+			return err
+		}
+
+		w.record = append(w.record, str)
+	}
+
+	return w.csv.Write(w.record)
+}
+
+func (w *csvExportWriter) Close() error {
+	w.csv.Flush()
+	if err := w.csv.Error(); err != nil {
+		return err
+	}
+
+	if w.gzip != nil {
+		return w.gzip.Close()
+	}
+
+	return nil
+}
+
+type xlsxExportWriter struct {
+	meta []*runtimev1.MetricsViewColumn
+	file *excelize.File
+	sw   *excelize.StreamWriter
+	out  io.Writer
+	row  []interface{}
+	n    int
+}
+
+func newXLSXExportWriter(meta []*runtimev1.MetricsViewColumn, writer io.Writer) (*xlsxExportWriter, error) {
+	f := excelize.NewFile()
+
+	sw, err := f.NewStreamWriter("Sheet1")
+	if err != nil {
+		_ = f.Close()
+		return nil, err
+	}
+
+	headers := make([]interface{}, 0, len(meta))
+	for _, v := range meta {
+		headers = append(headers, v.Name)
+	}
+
+	if err := sw.SetRow("A1", headers, excelize.RowOpts{Height: 45, Hidden: false}); err != nil {
+		_ = f.Close()
+		return nil, err
+	}
+
+	return &xlsxExportWriter{
+		meta: meta,
+		file: f,
+		sw:   sw,
+		out:  writer,
+		row:  make([]interface{}, 0, len(meta)),
+	}, nil
+}
+
+func (w *xlsxExportWriter) Write(row *structpb.Struct) error {
+	w.row = w.row[:0]
+	for _, field := range w.meta {
+		value, err := convertToXLSXValue(row.Fields[field.Name])
+		if err != nil {
+			return err
+		}
+
+		w.row = append(w.row, value)
+	}
+
+	cell, err := excelize.CoordinatesToCellName(1, w.n+2) // 1-based, and +1 for headers
+	if err != nil {
+		return err
+	}
+	w.n++
+
+	return w.sw.SetRow(cell, w.row)
+}
+
+func (w *xlsxExportWriter) Close() error {
+	defer func() {
+		_ = w.file.Close()
+	}()
+
+	if err := w.sw.Flush(); err != nil {
+		return err
+	}
+
+	return w.file.Write(w.out)
+}
+
+type parquetExportWriter struct {
+	meta    []*runtimev1.MetricsViewColumn
+	schema  *arrow.Schema
+	builder *array.RecordBuilder
+	writer  *pqarrow.FileWriter
+	pending int
+	flushed bool
+}
+
+func newParquetExportWriter(meta []*runtimev1.MetricsViewColumn, ioWriter io.Writer) (*parquetExportWriter, error) {
+	fields := make([]arrow.Field, 0, len(meta))
+	for _, f := range meta {
+		arrowField := arrow.Field{}
+		arrowField.Name = f.Name
+		typeCode := runtimev1.Type_Code(runtimev1.Type_Code_value[f.Type])
+		switch typeCode {
+		case runtimev1.Type_CODE_BOOL:
+			arrowField.Type = arrow.FixedWidthTypes.Boolean
+		case runtimev1.Type_CODE_INT8:
+			arrowField.Type = arrow.PrimitiveTypes.Int8
+		case runtimev1.Type_CODE_INT16:
+			arrowField.Type = arrow.PrimitiveTypes.Int16
+		case runtimev1.Type_CODE_INT32:
+			arrowField.Type = arrow.PrimitiveTypes.Int32
+		case runtimev1.Type_CODE_INT64:
+			arrowField.Type = arrow.PrimitiveTypes.Int64
+		case runtimev1.Type_CODE_INT128:
+			arrowField.Type = arrow.PrimitiveTypes.Float64
+		case runtimev1.Type_CODE_UINT8:
+			arrowField.Type = arrow.PrimitiveTypes.Uint8
+		case runtimev1.Type_CODE_UINT16:
+			arrowField.Type = arrow.PrimitiveTypes.Uint16
+		case runtimev1.Type_CODE_UINT32:
+			arrowField.Type = arrow.PrimitiveTypes.Uint32
+		case runtimev1.Type_CODE_UINT64:
+			arrowField.Type = arrow.PrimitiveTypes.Uint64
+		case runtimev1.Type_CODE_DECIMAL:
+			arrowField.Type = arrow.PrimitiveTypes.Float64
+		case runtimev1.Type_CODE_FLOAT32:
+			arrowField.Type = arrow.PrimitiveTypes.Float32
+		case runtimev1.Type_CODE_FLOAT64:
+			arrowField.Type = arrow.PrimitiveTypes.Float64
+		case runtimev1.Type_CODE_STRUCT, runtimev1.Type_CODE_UUID, runtimev1.Type_CODE_ARRAY, runtimev1.Type_CODE_STRING, runtimev1.Type_CODE_MAP:
+			arrowField.Type = arrow.BinaryTypes.String
+		case runtimev1.Type_CODE_TIMESTAMP, runtimev1.Type_CODE_DATE, runtimev1.Type_CODE_TIME:
+			arrowField.Type = arrow.FixedWidthTypes.Timestamp_us
+		case runtimev1.Type_CODE_BYTES:
+			arrowField.Type = arrow.BinaryTypes.Binary
+		}
+		fields = append(fields, arrowField)
+	}
+	schema := arrow.NewSchema(fields, nil)
+
+	parquetwriter, err := pqarrow.NewFileWriter(schema, ioWriter, nil, pqarrow.ArrowWriterProperties{})
+	if err != nil {
+		return nil, err
+	}
+
+	mem := memory.NewGoAllocator()
+	return &parquetExportWriter{
+		meta:    meta,
+		schema:  schema,
+		builder: array.NewRecordBuilder(mem, schema),
+		writer:  parquetwriter,
+	}, nil
+}
+
+func (w *parquetExportWriter) Write(row *structpb.Struct) error {
+	for idx, t := range w.meta {
+		v := row.Fields[t.Name]
+		typeCode := runtimev1.Type_Code(runtimev1.Type_Code_value[t.Type])
+		switch typeCode {
+		case runtimev1.Type_CODE_BOOL:
+			w.builder.Field(idx).(*array.BooleanBuilder).Append(v.GetBoolValue())
+		case runtimev1.Type_CODE_INT8:
+			w.builder.Field(idx).(*array.Int8Builder).Append(int8(v.GetNumberValue()))
+		case runtimev1.Type_CODE_INT16:
+			w.builder.Field(idx).(*array.Int16Builder).Append(int16(v.GetNumberValue()))
+		case runtimev1.Type_CODE_INT32:
+			w.builder.Field(idx).(*array.Int32Builder).Append(int32(v.GetNumberValue()))
+		case runtimev1.Type_CODE_INT64:
+			w.builder.Field(idx).(*array.Int64Builder).Append(int64(v.GetNumberValue()))
+		case runtimev1.Type_CODE_UINT8:
+			w.builder.Field(idx).(*array.Uint8Builder).Append(uint8(v.GetNumberValue()))
+		case runtimev1.Type_CODE_UINT16:
+			w.builder.Field(idx).(*array.Uint16Builder).Append(uint16(v.GetNumberValue()))
+		case runtimev1.Type_CODE_UINT32:
+			w.builder.Field(idx).(*array.Uint32Builder).Append(uint32(v.GetNumberValue()))
+		case runtimev1.Type_CODE_UINT64:
+			w.builder.Field(idx).(*array.Uint64Builder).Append(uint64(v.GetNumberValue()))
+		case runtimev1.Type_CODE_INT128:
+			w.builder.Field(idx).(*array.Float64Builder).Append((v.GetNumberValue()))
+		case runtimev1.Type_CODE_FLOAT32:
+			w.builder.Field(idx).(*array.Float32Builder).Append(float32(v.GetNumberValue()))
+		case runtimev1.Type_CODE_FLOAT64, runtimev1.Type_CODE_DECIMAL:
+			w.builder.Field(idx).(*array.Float64Builder).Append(v.GetNumberValue())
+		case runtimev1.Type_CODE_STRING, runtimev1.Type_CODE_UUID:
+			w.builder.Field(idx).(*array.StringBuilder).Append(v.GetStringValue())
+		case runtimev1.Type_CODE_TIMESTAMP, runtimev1.Type_CODE_DATE, runtimev1.Type_CODE_TIME:
+			tmp, err := arrow.TimestampFromString(v.GetStringValue(), arrow.Microsecond)
+			if err != nil {
+				return err
+			}
+
+			w.builder.Field(idx).(*array.TimestampBuilder).Append(tmp)
+		case runtimev1.Type_CODE_ARRAY, runtimev1.Type_CODE_MAP, runtimev1.Type_CODE_STRUCT:
+			bts, err := protojson.Marshal(v)
+			if err != nil {
+				return err
+			}
+
+			w.builder.Field(idx).(*array.StringBuilder).Append(string(bts))
+		}
+	}
+
+	w.pending++
+	if w.pending >= parquetExportBatchSize {
+		return w.flush()
+	}
+	return nil
+}
+
+// flush writes the buffered rows to the output as a row group.
+func (w *parquetExportWriter) flush() error {
+	rec := w.builder.NewRecord()
+	defer rec.Release()
+
+	w.pending = 0
+	w.flushed = true
+	return w.writer.Write(rec)
+}
+
+func (w *parquetExportWriter) Close() error {
+	defer w.builder.Release()
+
+	// Always write at least one (possibly empty) row group
+	if w.pending > 0 || !w.flushed {
+		if err := w.flush(); err != nil {
+			return err
+		}
+	}
+
+	return w.writer.Close()
+}
+
+type ndjsonExportWriter struct {
+	meta []*runtimev1.MetricsViewColumn
+	w    *bufio.Writer
+	keys [][]byte
+	buf  []byte
+}
+
+func newNDJSONExportWriter(meta []*runtimev1.MetricsViewColumn, writer io.Writer) *ndjsonExportWriter {
+	// Pre-encode the keys, which are the same for every row
+	keys := make([][]byte, len(meta))
+	for i, field := range meta {
+		keys[i], _ = json.Marshal(field.Name) // Marshalling a string can't fail
+	}
+
+	return &ndjsonExportWriter{
+		meta: meta,
+		w:    bufio.NewWriter(writer),
+		keys: keys,
+	}
+}
+
+// Write writes the row as a JSON object with the keys in the order of the columns.
+func (w *ndjsonExportWriter) Write(row *structpb.Struct) error {
+	w.buf = append(w.buf[:0], '{')
+	for i, field := range w.meta {
+		if i > 0 {
+			w.buf = append(w.buf, ',')
+		}
+		w.buf = append(w.buf, w.keys[i]...)
+		w.buf = append(w.buf, ':')
+
+		// AsInterface converts NaN and infinite numbers to strings, so the value can always be marshalled
+		bts, err := json.Marshal(row.Fields[field.Name].AsInterface())
+		if err != nil {
+			return err
+		}
+		w.buf = append(w.buf, bts...)
+	}
+	w.buf = append(w.buf, '}', '\n')
+
+	_, err := w.w.Write(w.buf)
+	return err
+}
+
+func (w *ndjsonExportWriter) Close() error {
+	return w.w.Flush()
+}
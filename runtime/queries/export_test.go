@@ -0,0 +1,98 @@
+package queries
+
+import (
+	"bytes"
+	"compress/gzip"
+	"io"
+	"testing"
+
+	"github.com/apache/arrow/go/v11/parquet/file"
+	runtimev1 "github.com/rilldata/rill/proto/gen/rill/runtime/v1"
+	"github.com/stretchr/testify/require"
+	"google.golang.org/protobuf/types/known/structpb"
+)
+
+func Test_writeCSVGzip(t *testing.T) {
+	meta := []*runtimev1.MetricsViewColumn{{Name: "a"}, {Name: "b"}}
+	data := []*structpb.Struct{
+		{Fields: map[string]*structpb.Value{"a": structpb.NewStringValue("x"), "b": structpb.NewNumberValue(1)}},
+		{Fields: map[string]*structpb.Value{"a": structpb.NewStringValue("y"), "b": structpb.NewNullValue()}},
+	}
+
+	var buf bytes.Buffer
+	ew, err := newExportWriter(runtimev1.ExportFormat_EXPORT_FORMAT_CSV_GZIP, meta, &buf)
+	require.NoError(t, err)
+	require.NoError(t, writeRows(ew, data))
+
+	r, err := gzip.NewReader(&buf)
+	require.NoError(t, err)
+	res, err := io.ReadAll(r)
+	require.NoError(t, err)
+	require.Equal(t, "a,b\nx,1\ny,\n", string(res))
+}
+
+func Test_writeNDJSON(t *testing.T) {
+	meta := []*runtimev1.MetricsViewColumn{{Name: "b"}, {Name: "a"}, {Name: "c"}}
+	nested, err := structpb.NewStruct(map[string]any{"k": "v"})
+	require.NoError(t, err)
+	data := []*structpb.Struct{
+		{Fields: map[string]*structpb.Value{"a": structpb.NewStringValue("x\"y"), "b": structpb.NewNumberValue(2.5), "c": structpb.NewStructValue(nested)}},
+		{Fields: map[string]*structpb.Value{"a": structpb.NewNullValue(), "b": structpb.NewBoolValue(true), "c": structpb.NewNullValue()}},
+	}
+
+	var buf bytes.Buffer
+	ew, err := newExportWriter(runtimev1.ExportFormat_EXPORT_FORMAT_NDJSON, meta, &buf)
+	require.NoError(t, err)
+	require.NoError(t, writeRows(ew, data))
+	require.Equal(t, "{\"b\":2.5,\"a\":\"x\\\"y\",\"c\":{\"k\":\"v\"}}\n{\"b\":true,\"a\":null,\"c\":null}\n", buf.String())
+}
+
+func Test_writeParquet_batches(t *testing.T) {
+	meta := []*runtimev1.MetricsViewColumn{{Name: "n", Type: runtimev1.Type_CODE_INT64.String()}}
+	n := parquetExportBatchSize + 10
+	data := make([]*structpb.Struct, n)
+	for i := range data {
+		data[i] = &structpb.Struct{Fields: map[string]*structpb.Value{"n": structpb.NewNumberValue(float64(i))}}
+	}
+
+	var buf bytes.Buffer
+	require.NoError(t, writeParquet(meta, data, &buf))
+
+	r, err := file.NewParquetReader(bytes.NewReader(buf.Bytes()))
+	require.NoError(t, err)
+	defer r.Close()
+	require.Equal(t, int64(n), r.NumRows())
+	require.Equal(t, 2, r.NumRowGroups())
+}
+
+func Test_exportLimits(t *testing.T) {
+	meta := []*runtimev1.MetricsViewColumn{{Name: "a"}}
+	data := []*structpb.Struct{
+		{Fields: map[string]*structpb.Value{"a": structpb.NewStringValue("x")}},
+		{Fields: map[string]*structpb.Value{"a": structpb.NewStringValue("y")}},
+	}
+
+	// Within the limits
+	var buf bytes.Buffer
+	ew, err := exportLimits{rows: 2, bytes: 100}.newExportWriter(runtimev1.ExportFormat_EXPORT_FORMAT_CSV, meta, &buf)
+	require.NoError(t, err)
+	require.NoError(t, writeRows(ew, data))
+	require.Equal(t, "a\nx\ny\n", buf.String())
+
+	// Too many rows
+	buf.Reset()
+	ew, err = exportLimits{rows: 1}.newExportWriter(runtimev1.ExportFormat_EXPORT_FORMAT_CSV, meta, &buf)
+	require.NoError(t, err)
+	err = writeRows(ew, data)
+	require.ErrorIs(t, err, ErrExportLimitExceeded)
+	require.ErrorContains(t, err, "more than 1 rows")
+
+	// Too many bytes
+	buf.Reset()
+	ew, err = exportLimits{bytes: 4}.newExportWriter(runtimev1.ExportFormat_EXPORT_FORMAT_NDJSON, meta, &buf)
+	require.NoError(t, err)
+	err = writeRows(ew, data)
+	require.ErrorIs(t, err, ErrExportLimitExceeded)
+	require.ErrorContains(t, err, "larger than 4 bytes")
+	require.Empty(t, buf.String())
+}
@@ -2,26 +2,18 @@ package queries
 
 import (
 	"context"
-	"encoding/csv"
 	"fmt"
-	"io"
-	"os"
-	"path/filepath"
 	"strings"
 
-	"github.com/apache/arrow/go/v11/arrow"
-	"github.com/apache/arrow/go/v11/arrow/array"
-	"github.com/apache/arrow/go/v11/arrow/memory"
-	"github.com/apache/arrow/go/v11/parquet/pqarrow"
-	"github.com/google/uuid"
 	runtimev1 "github.com/rilldata/rill/proto/gen/rill/runtime/v1"
 	"github.com/rilldata/rill/runtime"
 	"github.com/rilldata/rill/runtime/drivers"
+	"github.com/rilldata/rill/runtime/pkg/measures"
 	"github.com/rilldata/rill/runtime/pkg/pbutil"
-	"github.com/xuri/excelize/v2"
 	"google.golang.org/grpc/codes"
 	"google.golang.org/grpc/status"
 	"google.golang.org/protobuf/encoding/protojson"
+	"google.golang.org/protobuf/proto"
 	"google.golang.org/protobuf/types/known/structpb"
 )
 
@@ -35,10 +27,45 @@ func lookupMetricsView(ctx context.Context, rt *runtime.Runtime, instanceID, nam
 		return nil, status.Errorf(codes.NotFound, "object named '%s' is not a metrics view", name)
 	}
 
-	return obj.GetMetricsView(), nil
+	return compileTypedMeasures(ctx, rt, instanceID, obj.GetMetricsView())
 }
 
-// resolveMeasures returns the selected measures
+// compileTypedMeasures returns a copy of the metrics view where the expressions of typed measures are set to their SQL for the dialect of the OLAP store.
+// It lets queries treat typed measures like any other measure.
+func compileTypedMeasures(ctx context.Context, rt *runtime.Runtime, instanceID string, mv *runtimev1.MetricsView) (*runtimev1.MetricsView, error) {
+	typed := false
+	for _, m := range mv.Measures {
+		if m.Kind != runtimev1.MetricsView_Measure_KIND_UNSPECIFIED {
+			typed = true
+			break
+		}
+	}
+	if !typed {
+		return mv, nil
+	}
+
+	olap, release, err := rt.OLAP(ctx, instanceID)
+	if err != nil {
+		return nil, err
+	}
+	dialect := olap.Dialect()
+	release()
+
+	ms, err := measures.CompileTyped[runtimev1.MetricsView_Measure_Kind](dialect, mv.Measures, func(m *runtimev1.MetricsView_Measure, expr string) *runtimev1.MetricsView_Measure {
+		m = proto.Clone(m).(*runtimev1.MetricsView_Measure)
+		m.Expression = expr
+		return m
+	})
+	if err != nil {
+		return nil, status.Error(codes.InvalidArgument, err.Error())
+	}
+
+	mv = proto.Clone(mv).(*runtimev1.MetricsView)
+	mv.Measures = ms
+	return mv, nil
+}
+
+// resolveMeasures returns the selected measures. The expressions of derived measures are expanded into plain SQL.
 func resolveMeasures(mv *runtimev1.MetricsView, inlines []*runtimev1.InlineMeasure, selectedNames []string) ([]*runtimev1.MetricsView_Measure, error) {
 	// Build combined measures
 	ms := make([]*runtimev1.MetricsView_Measure, len(selectedNames))
@@ -66,6 +93,24 @@ func resolveMeasures(mv *runtimev1.MetricsView, inlines []*runtimev1.InlineMeasu
 				break
 			}
 		}
+		if found && ms[i].Window != nil {
+			return nil, fmt.Errorf("measure '%s' is a window measure, which is only available in time series queries", n)
+		}
+		// Expand references to other measures in derived measures
+		if found && len(ms[i].Requires) > 0 {
+			expr, err := measures.Expand(mv.Measures, n)
+			if err != nil {
+				return nil, err
+			}
+			ms[i] = &runtimev1.MetricsView_Measure{
+				Name:                ms[i].Name,
+				Label:               ms[i].Label,
+				Expression:          expr,
+				Description:         ms[i].Description,
+				Format:              ms[i].Format,
+				ValidPercentOfTotal: ms[i].ValidPercentOfTotal,
+			}
+		}
 		if !found {
 			return nil, fmt.Errorf("measure does not exist: '%s'", n)
 		}
@@ -131,142 +176,464 @@ func structTypeToMetricsViewColumn(v *runtimev1.StructType) []*runtimev1.Metrics
 	return res
 }
 
+// checkFieldAccess returns an error if the policy denies access to any of the given dimensions or measures,
+// or to any of the dimensions referenced in the filter or where expression.
+// Access to a derived or window measure is denied if access to any of the measures it's computed from is denied.
+// Since an inline measure's expression can reference any column of the underlying model, inline measures other than counts are denied when the policy excludes fields.
+func checkFieldAccess(mv *runtimev1.MetricsView, policy *runtime.ResolvedMetricsViewSecurity, filter *runtimev1.MetricsViewFilter, where *runtimev1.Expression, inlines []*runtimev1.InlineMeasure, names ...string) error {
+	if policy == nil || len(policy.Exclude) == 0 {
+		return nil
+	}
+
+	if filter != nil {
+		for _, cond := range filter.Include {
+			names = append(names, cond.Name)
+		}
+		for _, cond := range filter.Exclude {
+			names = append(names, cond.Name)
+		}
+	}
+
+	names = appendExpressionIdentifiers(names, where)
+
+	for _, m := range inlines {
+		if !isCountExpression(m.Expression) {
+			return status.Errorf(codes.PermissionDenied, "inline measure '%s' is not allowed", m.Name)
+		}
+		names = append(names, m.Name)
+	}
+
+	for _, n := range names {
+		if !policy.CanAccessField(n) {
+			return status.Errorf(codes.PermissionDenied, "access to field '%s' is not allowed", n)
+		}
+		for _, dep := range measureDependencies(mv, n) {
+			if !policy.CanAccessField(dep) {
+				return status.Errorf(codes.PermissionDenied, "access to field '%s' is not allowed", n)
+			}
+		}
+	}
+
+	return nil
+}
+
+// measureDependencies returns the names of the measures that the measure with the given name is computed from,
+// which are the measures it requires and the base measure of a window, including indirect dependencies.
+func measureDependencies(mv *runtimev1.MetricsView, name string) []string {
+	var deps []string
+	seen := map[string]bool{strings.ToLower(name): true}
+	var visit func(name string)
+	visit = func(name string) {
+		for _, m := range mv.Measures {
+			if !strings.EqualFold(m.Name, name) {
+				continue
+			}
+			refs := m.Requires
+			if m.Window != nil {
+				refs = append(refs[:len(refs):len(refs)], m.Window.Measure)
+			}
+			for _, r := range refs {
+				if seen[strings.ToLower(r)] {
+					continue
+				}
+				seen[strings.ToLower(r)] = true
+				deps = append(deps, r)
+				visit(r)
+			}
+		}
+	}
+	visit(name)
+	return deps
+}
+
+// isCountExpression returns true if expr counts the rows of the underlying model, which doesn't reference any column.
+func isCountExpression(expr string) bool {
+	expr = strings.ToLower(strings.Join(strings.Fields(expr), ""))
+	return expr == "count(*)"
+}
+
+// appendExpressionIdentifiers appends the identifiers referenced in expr to names
+func appendExpressionIdentifiers(names []string, expr *runtimev1.Expression) []string {
+	if expr == nil {
+		return names
+	}
+	switch e := expr.Expression.(type) {
+	case *runtimev1.Expression_Ident:
+		names = append(names, e.Ident)
+	case *runtimev1.Expression_Cond:
+		for _, sub := range e.Cond.GetExprs() {
+			names = appendExpressionIdentifiers(names, sub)
+		}
+	}
+	return names
+}
+
 // buildFilterClauseForMetricsViewFilter builds a SQL string of conditions joined with AND.
 // Unless the result is empty, it is prefixed with "AND".
 // I.e. it has the format "AND (...) AND (...) ...".
-func buildFilterClauseForMetricsViewFilter(mv *runtimev1.MetricsView, filter *runtimev1.MetricsViewFilter, dialect drivers.Dialect) (string, []any, error) {
+// The legacy filter is converted to an expression and combined with the where expression.
+// If policy is not nil, its row filter is added as one of the conditions.
+func buildFilterClauseForMetricsViewFilter(mv *runtimev1.MetricsView, filter *runtimev1.MetricsViewFilter, where *runtimev1.Expression, dialect drivers.Dialect, policy *runtime.ResolvedMetricsViewSecurity) (string, []any, error) {
 	var clauses []string
 	var args []any
 
-	if filter != nil && filter.Include != nil {
-		clause, clauseArgs, err := buildFilterClauseForConditions(mv, filter.Include, false, dialect)
-		if err != nil {
-			return "", nil, err
-		}
-		clauses = append(clauses, clause)
-		args = append(args, clauseArgs...)
+	if policy != nil && policy.RowFilter != "" {
+		clauses = append(clauses, fmt.Sprintf("AND (%s)", policy.RowFilter))
 	}
 
-	if filter != nil && filter.Exclude != nil {
-		clause, clauseArgs, err := buildFilterClauseForConditions(mv, filter.Exclude, true, dialect)
+	for _, expr := range []*runtimev1.Expression{convertFilterToExpression(filter), where} {
+		if expr == nil {
+			continue
+		}
+		clause, clauseArgs, err := buildExpression(mv, expr, dialect)
 		if err != nil {
 			return "", nil, err
 		}
-		clauses = append(clauses, clause)
+		clauses = append(clauses, fmt.Sprintf("AND (%s)", clause))
 		args = append(args, clauseArgs...)
 	}
 
 	return strings.Join(clauses, " "), args, nil
 }
 
-// buildFilterClauseForConditions returns a string with the format "AND (...) AND (...) ..."
-func buildFilterClauseForConditions(mv *runtimev1.MetricsView, conds []*runtimev1.MetricsViewFilter_Cond, exclude bool, dialect drivers.Dialect) (string, []any, error) {
-	var clauses []string
-	var args []any
+// convertFilterToExpression converts a legacy MetricsViewFilter to an equivalent expression.
+// It returns nil if the filter doesn't contain any conditions.
+func convertFilterToExpression(filter *runtimev1.MetricsViewFilter) *runtimev1.Expression {
+	if filter == nil {
+		return nil
+	}
 
-	for _, cond := range conds {
-		condClause, condArgs, err := buildFilterClauseForCondition(mv, cond, exclude, dialect)
-		if err != nil {
-			return "", nil, err
+	var exprs []*runtimev1.Expression
+	for _, cond := range filter.Include {
+		if expr := convertFilterCondToExpression(cond, false); expr != nil {
+			exprs = append(exprs, expr)
 		}
-		if condClause == "" {
-			continue
+	}
+	for _, cond := range filter.Exclude {
+		if expr := convertFilterCondToExpression(cond, true); expr != nil {
+			exprs = append(exprs, expr)
 		}
-		clauses = append(clauses, condClause)
-		args = append(args, condArgs...)
 	}
 
-	return strings.Join(clauses, " "), args, nil
+	switch len(exprs) {
+	case 0:
+		return nil
+	case 1:
+		return exprs[0]
+	default:
+		return condExpression(runtimev1.Operation_OPERATION_AND, exprs...)
+	}
 }
 
-// buildFilterClauseForCondition returns a string with the format "AND (...)"
-func buildFilterClauseForCondition(mv *runtimev1.MetricsView, cond *runtimev1.MetricsViewFilter_Cond, exclude bool, dialect drivers.Dialect) (string, []any, error) {
-	var clauses []string
-	var args []any
+// convertFilterCondToExpression converts a condition of a legacy MetricsViewFilter to an expression.
+// An included condition matches any of its values and patterns. An excluded condition matches none of them.
+func convertFilterCondToExpression(cond *runtimev1.MetricsViewFilter_Cond, exclude bool) *runtimev1.Expression {
+	inOp, likeOp, joinOp := runtimev1.Operation_OPERATION_IN, runtimev1.Operation_OPERATION_LIKE, runtimev1.Operation_OPERATION_OR
+	if exclude {
+		inOp, likeOp, joinOp = runtimev1.Operation_OPERATION_NIN, runtimev1.Operation_OPERATION_NLIKE, runtimev1.Operation_OPERATION_AND
+	}
 
-	// NOTE: Looking up for dimension like this will lead to O(nm).
-	//       Ideal way would be to create a map, but we need to find a clean solution down the line
-	name, err := metricsViewDimensionToSafeColumn(mv, cond.Name)
-	if err != nil {
-		return "", nil, err
+	var exprs []*runtimev1.Expression
+	if len(cond.In) > 0 {
+		inExprs := make([]*runtimev1.Expression, 0, len(cond.In)+1)
+		inExprs = append(inExprs, identExpression(cond.Name))
+		for _, val := range cond.In {
+			inExprs = append(inExprs, valExpression(val))
+		}
+		exprs = append(exprs, condExpression(inOp, inExprs...))
+	}
+	for _, val := range cond.Like {
+		exprs = append(exprs, condExpression(likeOp, identExpression(cond.Name), valExpression(structpb.NewStringValue(val))))
 	}
 
-	notKeyword := ""
-	if exclude {
-		notKeyword = "NOT"
+	switch len(exprs) {
+	case 0:
+		return nil
+	case 1:
+		return exprs[0]
+	default:
+		return condExpression(joinOp, exprs...)
 	}
+}
 
-	// Tracks if we found NULL(s) in cond.In
-	inHasNull := false
+func identExpression(name string) *runtimev1.Expression {
+	return &runtimev1.Expression{Expression: &runtimev1.Expression_Ident{Ident: name}}
+}
 
-	// Build "dim [NOT] IN (?, ?, ...)" clause
-	if len(cond.In) > 0 {
-		// Add to args, skipping nulls
-		for _, val := range cond.In {
-			if _, ok := val.Kind.(*structpb.Value_NullValue); ok {
-				inHasNull = true
-				continue // Handled later using "dim IS [NOT] NULL" clause
-			}
-			arg, err := pbutil.FromValue(val)
-			if err != nil {
-				return "", nil, fmt.Errorf("filter error: %w", err)
-			}
-			args = append(args, arg)
+func valExpression(val *structpb.Value) *runtimev1.Expression {
+	return &runtimev1.Expression{Expression: &runtimev1.Expression_Val{Val: val}}
+}
+
+func condExpression(op runtimev1.Operation, exprs ...*runtimev1.Expression) *runtimev1.Expression {
+	return &runtimev1.Expression{Expression: &runtimev1.Expression_Cond{Cond: &runtimev1.Condition{Op: op, Exprs: exprs}}}
+}
+
+// buildHavingExpression compiles an expression on aggregated measures to SQL.
+// Identifiers are resolved to the expressions of the metrics view's measures or the inline measures.
+func buildHavingExpression(mv *runtimev1.MetricsView, inlines []*runtimev1.InlineMeasure, expr *runtimev1.Expression, dialect drivers.Dialect) (string, []any, error) {
+	return compileExpression(expr, dialect, func(name string) (string, error) {
+		ms, err := resolveMeasures(mv, inlines, []string{name})
+		if err != nil {
+			return "", err
 		}
+		return fmt.Sprintf("(%s)", ms[0].Expression), nil
+	})
+}
+
+// identResolver resolves an identifier in an expression to a SQL expression
+type identResolver func(name string) (string, error)
 
-		// If there were non-null args, add a "dim [NOT] IN (...)" clause
-		if len(args) > 0 {
-			questionMarks := strings.Join(repeatString("?", len(args)), ",")
-			clause := fmt.Sprintf("%s %s IN (%s)", name, notKeyword, questionMarks)
-			clauses = append(clauses, clause)
+// buildExpression compiles an expression to SQL. Identifiers are resolved to the columns of the metrics view's dimensions.
+func buildExpression(mv *runtimev1.MetricsView, expr *runtimev1.Expression, dialect drivers.Dialect) (string, []any, error) {
+	return compileExpression(expr, dialect, func(name string) (string, error) {
+		return metricsViewFilterColumn(mv, name)
+	})
+}
+
+// compileExpression compiles an expression to SQL, using resolve to resolve identifiers
+func compileExpression(expr *runtimev1.Expression, dialect drivers.Dialect, resolve identResolver) (string, []any, error) {
+	switch e := expr.GetExpression().(type) {
+	case *runtimev1.Expression_Ident:
+		col, err := resolve(e.Ident)
+		if err != nil {
+			return "", nil, err
+		}
+		return col, nil, nil
+	case *runtimev1.Expression_Val:
+		if isNullExpression(expr) {
+			return "NULL", nil, nil
+		}
+		arg, err := pbutil.FromValue(e.Val)
+		if err != nil {
+			return "", nil, fmt.Errorf("filter error: %w", err)
 		}
+		return "?", []any{arg}, nil
+	case *runtimev1.Expression_Cond:
+		return compileCondition(e.Cond, dialect, resolve)
+	default:
+		return "", nil, fmt.Errorf("filter error: empty expression")
 	}
+}
+
+// compileCondition compiles a condition to SQL
+func compileCondition(cond *runtimev1.Condition, dialect drivers.Dialect, resolve identResolver) (string, []any, error) {
+	switch cond.Op {
+	case runtimev1.Operation_OPERATION_AND, runtimev1.Operation_OPERATION_OR:
+		if len(cond.Exprs) == 0 {
+			return "", nil, fmt.Errorf("filter error: %s requires at least one expression", cond.Op)
+		}
+		clauses, args, err := compileOperands(cond.Exprs, dialect, resolve)
+		if err != nil {
+			return "", nil, err
+		}
+		joiner := " AND "
+		if cond.Op == runtimev1.Operation_OPERATION_OR {
+			joiner = " OR "
+		}
+		return strings.Join(clauses, joiner), args, nil
 
-	// Build "dim [NOT] ILIKE ?"
-	if len(cond.Like) > 0 {
-		for _, val := range cond.Like {
-			var clause string
-			if dialect == drivers.DialectDruid {
-				// Druid does not support ILIKE
-				clause = fmt.Sprintf("LOWER(%s) %s LIKE LOWER(?)", name, notKeyword)
-			} else {
-				clause = fmt.Sprintf("%s %s ILIKE ?", name, notKeyword)
+	case runtimev1.Operation_OPERATION_NOT:
+		if len(cond.Exprs) != 1 {
+			return "", nil, fmt.Errorf("filter error: %s requires exactly one expression", cond.Op)
+		}
+		clause, args, err := compileExpression(cond.Exprs[0], dialect, resolve)
+		if err != nil {
+			return "", nil, err
+		}
+		return fmt.Sprintf("NOT (%s)", clause), args, nil
+
+	case runtimev1.Operation_OPERATION_EQ, runtimev1.Operation_OPERATION_NEQ, runtimev1.Operation_OPERATION_LT, runtimev1.Operation_OPERATION_LTE, runtimev1.Operation_OPERATION_GT, runtimev1.Operation_OPERATION_GTE:
+		if len(cond.Exprs) != 2 {
+			return "", nil, fmt.Errorf("filter error: %s requires exactly two expressions", cond.Op)
+		}
+
+		// NULL never compares equal, so comparisons with NULL must use "IS [NOT] NULL"
+		if cond.Op == runtimev1.Operation_OPERATION_EQ || cond.Op == runtimev1.Operation_OPERATION_NEQ {
+			other := -1
+			if isNullExpression(cond.Exprs[1]) {
+				other = 0
+			} else if isNullExpression(cond.Exprs[0]) {
+				other = 1
+			}
+			if other != -1 {
+				clauses, args, err := compileOperands(cond.Exprs[other:other+1], dialect, resolve)
+				if err != nil {
+					return "", nil, err
+				}
+				if cond.Op == runtimev1.Operation_OPERATION_EQ {
+					return fmt.Sprintf("%s IS NULL", clauses[0]), args, nil
+				}
+				return fmt.Sprintf("%s IS NOT NULL", clauses[0]), args, nil
 			}
+		}
+
+		clauses, args, err := compileOperands(cond.Exprs, dialect, resolve)
+		if err != nil {
+			return "", nil, err
+		}
+		return fmt.Sprintf("%s %s %s", clauses[0], comparisonOperator(cond.Op), clauses[1]), args, nil
 
-			args = append(args, val)
-			clauses = append(clauses, clause)
+	case runtimev1.Operation_OPERATION_IN, runtimev1.Operation_OPERATION_NIN:
+		if len(cond.Exprs) < 2 {
+			return "", nil, fmt.Errorf("filter error: %s requires at least two expressions", cond.Op)
 		}
+		return compileInCondition(cond, dialect, resolve)
+
+	case runtimev1.Operation_OPERATION_LIKE, runtimev1.Operation_OPERATION_NLIKE:
+		if len(cond.Exprs) != 2 {
+			return "", nil, fmt.Errorf("filter error: %s requires exactly two expressions", cond.Op)
+		}
+		leftClauses, leftArgs, err := compileOperands(cond.Exprs[:1], dialect, resolve)
+		if err != nil {
+			return "", nil, err
+		}
+		rightClauses, rightArgs, err := compileOperands(cond.Exprs[1:], dialect, resolve)
+		if err != nil {
+			return "", nil, err
+		}
+		left, right := leftClauses[0], rightClauses[0]
+		args := append(append([]any{}, leftArgs...), rightArgs...)
+
+		notKeyword := ""
+		if cond.Op == runtimev1.Operation_OPERATION_NLIKE {
+			notKeyword = "NOT"
+		}
+
+		var clause string
+		if dialect == drivers.DialectDruid {
+			// Druid does not support ILIKE
+			clause = fmt.Sprintf("LOWER(%s) %s LIKE LOWER(%s)", left, notKeyword, right)
+		} else {
+			clause = fmt.Sprintf("%s %s ILIKE %s", left, notKeyword, right)
+		}
+
+		// "NOT LIKE" never matches NULL values, but they don't match the pattern, so we need to explicitly include them
+		if cond.Op == runtimev1.Operation_OPERATION_NLIKE {
+			clause = fmt.Sprintf("%s OR %s IS NULL", clause, left)
+			args = append(args, leftArgs...)
+		}
+		return clause, args, nil
+
+	default:
+		return "", nil, fmt.Errorf("filter error: unsupported operation %s", cond.Op)
 	}
+}
 
-	// Add null check
-	// NOTE: DuckDB doesn't handle NULL values in an "IN" expression. They must be checked with a "dim IS [NOT] NULL" clause.
-	if inHasNull {
-		clauses = append(clauses, fmt.Sprintf("%s IS %s NULL", name, notKeyword))
+// compileInCondition compiles an IN or NIN condition, where the first expression is compared to the remaining expressions
+func compileInCondition(cond *runtimev1.Condition, dialect drivers.Dialect, resolve identResolver) (string, []any, error) {
+	clauses, leftArgs, err := compileOperands(cond.Exprs[:1], dialect, resolve)
+	if err != nil {
+		return "", nil, err
 	}
+	left := clauses[0]
 
-	// If no checks were added, exit
-	if len(clauses) == 0 {
-		return "", nil, nil
+	// NOTE: DuckDB doesn't handle NULL values in an "IN" expression. They must be checked with a "dim IS [NOT] NULL" clause.
+	var vals []*runtimev1.Expression
+	hasNull := false
+	for _, expr := range cond.Exprs[1:] {
+		if isNullExpression(expr) {
+			hasNull = true
+			continue
+		}
+		vals = append(vals, expr)
 	}
 
-	// Join conditions
-	var condJoiner string
+	exclude := cond.Op == runtimev1.Operation_OPERATION_NIN
+	notKeyword := ""
 	if exclude {
-		condJoiner = " AND "
-	} else {
-		condJoiner = " OR "
+		notKeyword = "NOT"
+	}
+
+	var args []any
+	var clause string
+	if len(vals) > 0 {
+		valClauses, valArgs, err := compileOperands(vals, dialect, resolve)
+		if err != nil {
+			return "", nil, err
+		}
+		clause = fmt.Sprintf("%s %s IN (%s)", left, notKeyword, strings.Join(valClauses, ","))
+		args = append(append(args, leftArgs...), valArgs...)
 	}
-	condsClause := strings.Join(clauses, condJoiner)
 
 	// When you have "dim NOT IN (a, b, ...)", then NULL values are always excluded, even if NULL is not in the list.
 	// E.g. this returns zero rows: "select * from (select 1 as a union select null as a) where a not in (1)"
-	// We need to explicitly include it.
-	if exclude && !inHasNull && len(condsClause) > 0 {
-		condsClause += fmt.Sprintf(" OR %s IS NULL", name)
+	// So unless NULL is in the list, we need to explicitly include it.
+	var nullClause string
+	switch {
+	case hasNull && exclude:
+		nullClause = fmt.Sprintf("%s IS NOT NULL", left)
+	case hasNull || exclude:
+		nullClause = fmt.Sprintf("%s IS NULL", left)
+	}
+	if nullClause == "" {
+		return clause, args, nil
+	}
+	if clause == "" {
+		return nullClause, leftArgs, nil
 	}
+	args = append(args, leftArgs...)
+	if hasNull && exclude {
+		return fmt.Sprintf("%s AND %s", clause, nullClause), args, nil
+	}
+	return fmt.Sprintf("%s OR %s", clause, nullClause), args, nil
+}
 
-	// Done
-	return fmt.Sprintf("AND (%s) ", condsClause), args, nil
+// compileOperands compiles the given expressions, wrapping conditions in parentheses
+func compileOperands(exprs []*runtimev1.Expression, dialect drivers.Dialect, resolve identResolver) ([]string, []any, error) {
+	clauses := make([]string, len(exprs))
+	var args []any
+	for i, expr := range exprs {
+		clause, clauseArgs, err := compileExpression(expr, dialect, resolve)
+		if err != nil {
+			return nil, nil, err
+		}
+		if expr.GetCond() != nil {
+			clause = fmt.Sprintf("(%s)", clause)
+		}
+		clauses[i] = clause
+		args = append(args, clauseArgs...)
+	}
+	return clauses, args, nil
+}
+
+func comparisonOperator(op runtimev1.Operation) string {
+	switch op {
+	case runtimev1.Operation_OPERATION_EQ:
+		return "="
+	case runtimev1.Operation_OPERATION_NEQ:
+		return "!="
+	case runtimev1.Operation_OPERATION_LT:
+		return "<"
+	case runtimev1.Operation_OPERATION_LTE:
+		return "<="
+	case runtimev1.Operation_OPERATION_GT:
+		return ">"
+	case runtimev1.Operation_OPERATION_GTE:
+		return ">="
+	}
+	panic(fmt.Errorf("not a comparison operation: %v", op))
+}
+
+// isNullExpression returns true if expr is a null literal
+func isNullExpression(expr *runtimev1.Expression) bool {
+	e, ok := expr.GetExpression().(*runtimev1.Expression_Val)
+	if !ok {
+		return false
+	}
+	switch e.Val.GetKind().(type) {
+	case *structpb.Value_NullValue, nil:
+		return true
+	default:
+		return false
+	}
+}
+
+// metricsViewFilterColumn returns the column to filter on for a dimension name, which may also be the time dimension
+func metricsViewFilterColumn(mv *runtimev1.MetricsView, name string) (string, error) {
+	if mv.TimeDimension != "" && strings.EqualFold(name, mv.TimeDimension) {
+		return safeName(mv.TimeDimension), nil
+	}
+	return metricsViewDimensionToSafeColumn(mv, name)
 }
 
 func repeatString(val string, n int) []string {
@@ -324,248 +691,9 @@ func metricsViewDimensionToSafeColumn(mv *runtimev1.MetricsView, dimName string)
 	return "", fmt.Errorf("dimension %s not found", dimName)
 }
 
-func writeCSV(meta []*runtimev1.MetricsViewColumn, data []*structpb.Struct, writer io.Writer) error {
-	w := csv.NewWriter(writer)
-
-	record := make([]string, 0, len(meta))
-	for _, field := range meta {
-		record = append(record, field.Name)
-	}
-	if err := w.Write(record); err != nil {
-		return err
-	}
-	record = record[:0]
-
-	for _, structs := range data {
-		for _, field := range meta {
-			pbvalue := structs.Fields[field.Name]
-			str, err := convertToString(pbvalue)
-			if err != nil {
-				return err
-			}
-
-			record = append(record, str)
-		}
-
-		if err := w.Write(record); err != nil {
-			return err
-		}
-
-		record = record[:0]
-	}
-
-	w.Flush()
-
-	return nil
-}
-
-func writeXLSX(meta []*runtimev1.MetricsViewColumn, data []*structpb.Struct, writer io.Writer) error {
-	f := excelize.NewFile()
-	defer func() {
-		_ = f.Close()
-	}()
-
-	sw, err := f.NewStreamWriter("Sheet1")
-	if err != nil {
-		return err
-	}
-
-	headers := make([]interface{}, 0, len(meta))
-	for _, v := range meta {
-		headers = append(headers, v.Name)
-	}
-
-	if err := sw.SetRow("A1", headers, excelize.RowOpts{Height: 45, Hidden: false}); err != nil {
-		return err
-	}
-
-	row := make([]interface{}, 0, len(meta))
-	for i, structs := range data {
-		for _, field := range meta {
-			pbvalue := structs.Fields[field.Name]
-			value, err := convertToXLSXValue(pbvalue)
-			if err != nil {
-				return err
-			}
-
-			row = append(row, value)
-		}
-
-		cell, err := excelize.CoordinatesToCellName(1, i+2) // 1-based, and +1 for headers
-		if err != nil {
-			return err
-		}
-
-		if err := sw.SetRow(cell, row); err != nil {
-			return err
-		}
-
-		row = row[:0]
-	}
-
-	if err := sw.Flush(); err != nil {
-		return err
-	}
-
-	err = f.Write(writer)
-
-	return err
-}
-
-func writeParquet(meta []*runtimev1.MetricsViewColumn, data []*structpb.Struct, ioWriter io.Writer) error {
-	fields := make([]arrow.Field, 0, len(meta))
-	for _, f := range meta {
-		arrowField := arrow.Field{}
-		arrowField.Name = f.Name
-		typeCode := runtimev1.Type_Code(runtimev1.Type_Code_value[f.Type])
-		switch typeCode {
-		case runtimev1.Type_CODE_BOOL:
-			arrowField.Type = arrow.FixedWidthTypes.Boolean
-		case runtimev1.Type_CODE_INT8:
-			arrowField.Type = arrow.PrimitiveTypes.Int8
-		case runtimev1.Type_CODE_INT16:
-			arrowField.Type = arrow.PrimitiveTypes.Int16
-		case runtimev1.Type_CODE_INT32:
-			arrowField.Type = arrow.PrimitiveTypes.Int32
-		case runtimev1.Type_CODE_INT64:
-			arrowField.Type = arrow.PrimitiveTypes.Int64
-		case runtimev1.Type_CODE_INT128:
-			arrowField.Type = arrow.PrimitiveTypes.Float64
-		case runtimev1.Type_CODE_UINT8:
-			arrowField.Type = arrow.PrimitiveTypes.Uint8
-		case runtimev1.Type_CODE_UINT16:
-			arrowField.Type = arrow.PrimitiveTypes.Uint16
-		case runtimev1.Type_CODE_UINT32:
-			arrowField.Type = arrow.PrimitiveTypes.Uint32
-		case runtimev1.Type_CODE_UINT64:
-			arrowField.Type = arrow.PrimitiveTypes.Uint64
-		case runtimev1.Type_CODE_DECIMAL:
-			arrowField.Type = arrow.PrimitiveTypes.Float64
-		case runtimev1.Type_CODE_FLOAT32:
-			arrowField.Type = arrow.PrimitiveTypes.Float32
-		case runtimev1.Type_CODE_FLOAT64:
-			arrowField.Type = arrow.PrimitiveTypes.Float64
-		case runtimev1.Type_CODE_STRUCT, runtimev1.Type_CODE_UUID, runtimev1.Type_CODE_ARRAY, runtimev1.Type_CODE_STRING, runtimev1.Type_CODE_MAP:
-			arrowField.Type = arrow.BinaryTypes.String
-		case runtimev1.Type_CODE_TIMESTAMP, runtimev1.Type_CODE_DATE, runtimev1.Type_CODE_TIME:
-			arrowField.Type = arrow.FixedWidthTypes.Timestamp_us
-		case runtimev1.Type_CODE_BYTES:
-			arrowField.Type = arrow.BinaryTypes.Binary
-		}
-		fields = append(fields, arrowField)
-	}
-	schema := arrow.NewSchema(fields, nil)
-
-	mem := memory.NewCheckedAllocator(memory.NewGoAllocator())
-	recordBuilder := array.NewRecordBuilder(mem, schema)
-	defer recordBuilder.Release()
-	for _, s := range data {
-		for idx, t := range meta {
-			v := s.Fields[t.Name]
-			typeCode := runtimev1.Type_Code(runtimev1.Type_Code_value[t.Type])
-			switch typeCode {
-			case runtimev1.Type_CODE_BOOL:
-				recordBuilder.Field(idx).(*array.BooleanBuilder).Append(v.GetBoolValue())
-			case runtimev1.Type_CODE_INT8:
-				recordBuilder.Field(idx).(*array.Int8Builder).Append(int8(v.GetNumberValue()))
-			case runtimev1.Type_CODE_INT16:
-				recordBuilder.Field(idx).(*array.Int16Builder).Append(int16(v.GetNumberValue()))
-			case runtimev1.Type_CODE_INT32:
-				recordBuilder.Field(idx).(*array.Int32Builder).Append(int32(v.GetNumberValue()))
-			case runtimev1.Type_CODE_INT64:
-				recordBuilder.Field(idx).(*array.Int64Builder).Append(int64(v.GetNumberValue()))
-			case runtimev1.Type_CODE_UINT8:
-				recordBuilder.Field(idx).(*array.Uint8Builder).Append(uint8(v.GetNumberValue()))
-			case runtimev1.Type_CODE_UINT16:
-				recordBuilder.Field(idx).(*array.Uint16Builder).Append(uint16(v.GetNumberValue()))
-			case runtimev1.Type_CODE_UINT32:
-				recordBuilder.Field(idx).(*array.Uint32Builder).Append(uint32(v.GetNumberValue()))
-			case runtimev1.Type_CODE_UINT64:
-				recordBuilder.Field(idx).(*array.Uint64Builder).Append(uint64(v.GetNumberValue()))
-			case runtimev1.Type_CODE_INT128:
-				recordBuilder.Field(idx).(*array.Float64Builder).Append((v.GetNumberValue()))
-			case runtimev1.Type_CODE_FLOAT32:
-				recordBuilder.Field(idx).(*array.Float32Builder).Append(float32(v.GetNumberValue()))
-			case runtimev1.Type_CODE_FLOAT64, runtimev1.Type_CODE_DECIMAL:
-				recordBuilder.Field(idx).(*array.Float64Builder).Append(v.GetNumberValue())
-			case runtimev1.Type_CODE_STRING, runtimev1.Type_CODE_UUID:
-				recordBuilder.Field(idx).(*array.StringBuilder).Append(v.GetStringValue())
-			case runtimev1.Type_CODE_TIMESTAMP, runtimev1.Type_CODE_DATE, runtimev1.Type_CODE_TIME:
-				tmp, err := arrow.TimestampFromString(v.GetStringValue(), arrow.Microsecond)
-				if err != nil {
-					return err
-				}
-
-				recordBuilder.Field(idx).(*array.TimestampBuilder).Append(tmp)
-			case runtimev1.Type_CODE_ARRAY, runtimev1.Type_CODE_MAP, runtimev1.Type_CODE_STRUCT:
-				bts, err := protojson.Marshal(v)
-				if err != nil {
-					return err
-				}
-
-				recordBuilder.Field(idx).(*array.StringBuilder).Append(string(bts))
-			}
-		}
-	}
-
-	parquetwriter, err := pqarrow.NewFileWriter(schema, ioWriter, nil, pqarrow.ArrowWriterProperties{})
-	if err != nil {
-		return err
-	}
-
-	defer parquetwriter.Close()
-
-	rec := recordBuilder.NewRecord()
-	err = parquetwriter.Write(rec)
-	return err
-}
-
-func duckDBCopyExport(ctx context.Context, rt *runtime.Runtime, instanceID string, w io.Writer, opts *runtime.ExportOptions, sql string, args []any, filename string, olap drivers.OLAPStore, mv *runtimev1.MetricsView, exportFormat runtimev1.ExportFormat) error {
-	var extension string
-	switch exportFormat {
-	case runtimev1.ExportFormat_EXPORT_FORMAT_PARQUET:
-		extension = "parquet"
-	case runtimev1.ExportFormat_EXPORT_FORMAT_CSV:
-		extension = "csv"
-	}
-
-	tmpPath := fmt.Sprintf("export_%s.%s", uuid.New().String(), extension)
-	tmpPath = filepath.Join(os.TempDir(), tmpPath)
-	defer os.Remove(tmpPath)
-
-	sql = fmt.Sprintf("COPY (%s) TO '%s'", sql, tmpPath)
-
-	rows, err := olap.Execute(ctx, &drivers.Statement{
-		Query:            sql,
-		Args:             args,
-		Priority:         opts.Priority,
-		ExecutionTimeout: defaultExecutionTimeout,
-	})
-	if err != nil {
-		return err
-	}
-	defer rows.Close()
-
-	if opts.PreWriteHook != nil {
-		err = opts.PreWriteHook(filename)
-		if err != nil {
-			return err
-		}
-	}
-
-	f, err := os.Open(tmpPath)
-	if err != nil {
-		return err
-	}
-	defer f.Close()
-
-	_, err = io.Copy(w, f)
-	return err
-}
-
 func (q *MetricsViewRows) generateFilename(mv *runtimev1.MetricsView) string {
 	filename := strings.ReplaceAll(mv.Model, `"`, `_`)
-	if q.TimeStart != nil || q.TimeEnd != nil || q.Filter != nil && (len(q.Filter.Include) > 0 || len(q.Filter.Exclude) > 0) {
+	if q.TimeStart != nil || q.TimeEnd != nil || q.Where != nil || q.Filter != nil && (len(q.Filter.Include) > 0 || len(q.Filter.Exclude) > 0) {
 		filename += "_filtered"
 	}
 	return filename
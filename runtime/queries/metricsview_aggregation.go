@@ -0,0 +1,507 @@
+package queries
+
+import (
+	"context"
+	"encoding/json"
+	"fmt"
+	"io"
+	"sort"
+	"strings"
+
+	runtimev1 "github.com/rilldata/rill/proto/gen/rill/runtime/v1"
+	"github.com/rilldata/rill/runtime"
+	"github.com/rilldata/rill/runtime/drivers"
+	"google.golang.org/protobuf/types/known/structpb"
+	"google.golang.org/protobuf/types/known/timestamppb"
+)
+
+// maxPivotInputRows is the maximum number of rows that are pivoted in memory
+const maxPivotInputRows = 100000
+
+// maxPivotColumns is the maximum number of columns created by a pivot
+const maxPivotColumns = 1000
+
+type MetricsViewAggregation struct {
+	MetricsViewName     string                                       `json:"metrics_view_name,omitempty"`
+	Dimensions          []*runtimev1.MetricsViewAggregationDimension `json:"dimensions,omitempty"`
+	MeasureNames        []string                                     `json:"measure_names,omitempty"`
+	InlineMeasures      []*runtimev1.InlineMeasure                   `json:"inline_measures,omitempty"`
+	Sort                []*runtimev1.MetricsViewAggregationSort      `json:"sort,omitempty"`
+	TimeStart           *timestamppb.Timestamp                       `json:"time_start,omitempty"`
+	TimeEnd             *timestamppb.Timestamp                       `json:"time_end,omitempty"`
+	Filter              *runtimev1.MetricsViewFilter                 `json:"filter,omitempty"`
+	Where               *runtimev1.Expression                        `json:"where,omitempty"`
+	Limit               *int64                                       `json:"limit,omitempty"`
+	Offset              int64                                        `json:"offset,omitempty"`
+	PivotOn             string                                       `json:"pivot_on,omitempty"`
+	TimeZone            string                                       `json:"time_zone,omitempty"`
+	MetricsViewSecurity *runtime.ResolvedMetricsViewSecurity         `json:"security,omitempty"`
+
+	Result *runtimev1.MetricsViewAggregationResponse `json:"-"`
+}
+
+var _ runtime.Query = &MetricsViewAggregation{}
+
+func (q *MetricsViewAggregation) Key() string {
+	r, err := json.Marshal(q)
+	if err != nil {
+		panic(err)
+	}
+	return fmt.Sprintf("MetricsViewAggregation:%s", string(r))
+}
+
+func (q *MetricsViewAggregation) Deps() []string {
+	return []string{q.MetricsViewName}
+}
+
+func (q *MetricsViewAggregation) MarshalResult() *runtime.QueryResult {
+	return &runtime.QueryResult{
+		Value: q.Result,
+		Bytes: sizeProtoMessage(q.Result),
+	}
+}
+
+func (q *MetricsViewAggregation) UnmarshalResult(v any) error {
+	res, ok := v.(*runtimev1.MetricsViewAggregationResponse)
+	if !ok {
+		return fmt.Errorf("MetricsViewAggregation: mismatched unmarshal input")
+	}
+	q.Result = res
+	return nil
+}
+
+func (q *MetricsViewAggregation) Resolve(ctx context.Context, rt *runtime.Runtime, instanceID string, priority int) error {
+	olap, release, err := rt.OLAP(ctx, instanceID)
+	if err != nil {
+		return err
+	}
+	defer release()
+
+	if olap.Dialect() != drivers.DialectDuckDB && olap.Dialect() != drivers.DialectDruid && olap.Dialect() != drivers.DialectClickHouse {
+		return fmt.Errorf("not available for dialect '%s'", olap.Dialect())
+	}
+
+	mv, err := lookupMetricsView(ctx, rt, instanceID, q.MetricsViewName)
+	if err != nil {
+		return err
+	}
+
+	if mv.TimeDimension == "" && (q.TimeStart != nil || q.TimeEnd != nil) {
+		return fmt.Errorf("metrics view '%s' does not have a time dimension", q.MetricsViewName)
+	}
+
+	if q.PivotOn != "" {
+		return q.resolvePivot(ctx, olap, mv, priority)
+	}
+
+	sql, args, err := q.buildMetricsAggregationSQL(mv, olap.Dialect(), false)
+	if err != nil {
+		return fmt.Errorf("error building query: %w", err)
+	}
+
+	meta, data, err := metricsQuery(ctx, olap, priority, sql, args)
+	if err != nil {
+		return err
+	}
+
+	q.Result = &runtimev1.MetricsViewAggregationResponse{
+		Meta: meta,
+		Data: data,
+	}
+
+	return nil
+}
+
+func (q *MetricsViewAggregation) Export(ctx context.Context, rt *runtime.Runtime, instanceID string, w io.Writer, opts *runtime.ExportOptions) error {
+	olap, release, err := rt.OLAP(ctx, instanceID)
+	if err != nil {
+		return err
+	}
+	defer release()
+
+	if olap.Dialect() != drivers.DialectDuckDB && olap.Dialect() != drivers.DialectDruid && olap.Dialect() != drivers.DialectClickHouse {
+		return fmt.Errorf("not available for dialect '%s'", olap.Dialect())
+	}
+
+	mv, err := lookupMetricsView(ctx, rt, instanceID, q.MetricsViewName)
+	if err != nil {
+		return err
+	}
+
+	if mv.TimeDimension == "" && (q.TimeStart != nil || q.TimeEnd != nil) {
+		return fmt.Errorf("metrics view '%s' does not have a time dimension", q.MetricsViewName)
+	}
+
+	filename := q.generateFilename(mv)
+
+	// Pivots are computed in memory, so they can't be streamed
+	if q.PivotOn != "" {
+		err := q.resolvePivot(ctx, olap, mv, opts.Priority)
+		if err != nil {
+			return err
+		}
+		return exportData(ctx, rt, instanceID, w, opts, filename, q.Result.Meta, q.Result.Data)
+	}
+
+	sql, args, err := q.buildMetricsAggregationSQL(mv, olap.Dialect(), false)
+	if err != nil {
+		return err
+	}
+
+	// DuckDB can write CSV and Parquet files natively
+	if olap.Dialect() == drivers.DialectDuckDB && (opts.Format == runtimev1.ExportFormat_EXPORT_FORMAT_CSV || opts.Format == runtimev1.ExportFormat_EXPORT_FORMAT_PARQUET) {
+		return duckDBCopyExport(ctx, rt, instanceID, w, opts, sql, args, filename, olap, mv, opts.Format)
+	}
+
+	return exportQuery(ctx, rt, instanceID, olap, w, opts, filename, sql, args)
+}
+
+func (q *MetricsViewAggregation) generateFilename(mv *runtimev1.MetricsView) string {
+	filename := strings.ReplaceAll(mv.Model, `"`, `_`)
+	if q.TimeStart != nil || q.TimeEnd != nil || q.Where != nil || q.Filter != nil && (len(q.Filter.Include) > 0 || len(q.Filter.Exclude) > 0) {
+		filename += "_filtered"
+	}
+	return filename
+}
+
+// resolvePivot runs the aggregation grouped by all dimensions (including the pivot dimension), and then pivots the result in memory.
+// The limit and offset are applied to the pivoted rows.
+func (q *MetricsViewAggregation) resolvePivot(ctx context.Context, olap drivers.OLAPStore, mv *runtimev1.MetricsView, priority int) error {
+	pivotIdx := -1
+	for i, d := range q.Dimensions {
+		if dimensionAlias(d) == q.PivotOn {
+			pivotIdx = i
+			break
+		}
+	}
+	if pivotIdx == -1 {
+		return fmt.Errorf("pivot dimension '%s' is not one of the requested dimensions", q.PivotOn)
+	}
+
+	for _, s := range q.Sort {
+		if s.Name == q.PivotOn || !q.hasDimension(s.Name) {
+			return fmt.Errorf("can't sort by '%s': pivot queries can only be sorted by the dimensions that are not pivoted", s.Name)
+		}
+	}
+
+	sql, args, err := q.buildMetricsAggregationSQL(mv, olap.Dialect(), true)
+	if err != nil {
+		return fmt.Errorf("error building query: %w", err)
+	}
+
+	meta, data, err := metricsQuery(ctx, olap, priority, sql, args)
+	if err != nil {
+		return err
+	}
+
+	if len(data) > maxPivotInputRows {
+		return fmt.Errorf("pivot query exceeds the limit of %d rows, add filters or remove dimensions to reduce its size", maxPivotInputRows)
+	}
+
+	res, err := pivot(meta, data, len(q.Dimensions), pivotIdx)
+	if err != nil {
+		return err
+	}
+
+	// Apply offset and limit to the pivoted rows
+	if q.Offset > 0 {
+		if q.Offset >= int64(len(res.Data)) {
+			res.Data = nil
+		} else {
+			res.Data = res.Data[q.Offset:]
+		}
+	}
+	if q.Limit != nil {
+		if *q.Limit == 0 {
+			*q.Limit = 100
+		}
+		if *q.Limit < int64(len(res.Data)) {
+			res.Data = res.Data[:*q.Limit]
+		}
+	}
+
+	q.Result = res
+	return nil
+}
+
+// pivot turns the values of the dimension at pivotIdx into columns.
+// It expects the first numDims columns of meta to be the dimensions, and the remaining columns to be measures.
+// The pivoted columns are named "{value}_{measure}" and sorted by value.
+func pivot(meta []*runtimev1.MetricsViewColumn, data []*structpb.Struct, numDims, pivotIdx int) (*runtimev1.MetricsViewAggregationResponse, error) {
+	pivotName := meta[pivotIdx].Name
+	var dims []*runtimev1.MetricsViewColumn
+	for i := 0; i < numDims; i++ {
+		if i != pivotIdx {
+			dims = append(dims, meta[i])
+		}
+	}
+	measures := meta[numDims:]
+
+	// Group rows by the values of the dimensions that are not pivoted, keeping the order of the rows
+	var groups []*structpb.Struct
+	groupIdx := make(map[string]int)
+	pivotValues := make(map[string]bool)
+	for _, row := range data {
+		key, err := pivotGroupKey(row, dims)
+		if err != nil {
+			return nil, err
+		}
+
+		idx, ok := groupIdx[key]
+		if !ok {
+			group := &structpb.Struct{Fields: make(map[string]*structpb.Value, len(dims))}
+			for _, d := range dims {
+				group.Fields[d.Name] = row.Fields[d.Name]
+			}
+			idx = len(groups)
+			groupIdx[key] = idx
+			groups = append(groups, group)
+		}
+
+		value, err := pivotValueName(row.Fields[pivotName])
+		if err != nil {
+			return nil, err
+		}
+		if !pivotValues[value] {
+			pivotValues[value] = true
+			if len(pivotValues)*len(measures) > maxPivotColumns {
+				return nil, fmt.Errorf("pivot exceeds the limit of %d columns", maxPivotColumns)
+			}
+		}
+
+		for _, m := range measures {
+			groups[idx].Fields[value+"_"+m.Name] = row.Fields[m.Name]
+		}
+	}
+
+	values := make([]string, 0, len(pivotValues))
+	for v := range pivotValues {
+		values = append(values, v)
+	}
+	sort.Strings(values)
+
+	resMeta := dims
+	for _, v := range values {
+		for _, m := range measures {
+			resMeta = append(resMeta, &runtimev1.MetricsViewColumn{
+				Name:     v + "_" + m.Name,
+				Type:     m.Type,
+				Nullable: true,
+			})
+		}
+	}
+
+	// Fill the cells that had no value with nulls
+	for _, group := range groups {
+		for _, col := range resMeta[len(dims):] {
+			if _, ok := group.Fields[col.Name]; !ok {
+				group.Fields[col.Name] = structpb.NewNullValue()
+			}
+		}
+	}
+
+	return &runtimev1.MetricsViewAggregationResponse{
+		Meta: resMeta,
+		Data: groups,
+	}, nil
+}
+
+func pivotGroupKey(row *structpb.Struct, dims []*runtimev1.MetricsViewColumn) (string, error) {
+	vals := make([]any, len(dims))
+	for i, d := range dims {
+		vals[i] = row.Fields[d.Name].AsInterface()
+	}
+	key, err := json.Marshal(vals)
+	if err != nil {
+		return "", err
+	}
+	return string(key), nil
+}
+
+func pivotValueName(v *structpb.Value) (string, error) {
+	if v == nil {
+		return "null", nil
+	}
+	if _, ok := v.Kind.(*structpb.Value_NullValue); ok {
+		return "null", nil
+	}
+	return convertToString(v)
+}
+
+func (q *MetricsViewAggregation) hasDimension(name string) bool {
+	for _, d := range q.Dimensions {
+		if dimensionAlias(d) == name {
+			return true
+		}
+	}
+	return false
+}
+
+func dimensionAlias(d *runtimev1.MetricsViewAggregationDimension) string {
+	if d.Alias != "" {
+		return d.Alias
+	}
+	return d.Name
+}
+
+// buildMetricsAggregationSQL builds a query that groups the measures by the requested dimensions.
+// If pivot is true, the limit and offset are omitted since they apply to the pivoted rows,
+// and the pivot dimension is added to the sort to make the output deterministic.
+func (q *MetricsViewAggregation) buildMetricsAggregationSQL(mv *runtimev1.MetricsView, dialect drivers.Dialect, pivot bool) (string, []any, error) {
+	if len(q.Dimensions) == 0 && len(q.MeasureNames) == 0 {
+		return "", nil, fmt.Errorf("no dimensions or measures specified")
+	}
+
+	ms, err := resolveMeasures(mv, q.InlineMeasures, q.MeasureNames)
+	if err != nil {
+		return "", nil, err
+	}
+
+	names := make([]string, 0, len(q.Dimensions)+len(q.MeasureNames)+len(q.Sort))
+	aliases := make(map[string]string, len(q.Dimensions))
+	for _, d := range q.Dimensions {
+		names = append(names, d.Name)
+		if d.Alias != "" {
+			aliases[d.Alias] = d.Name
+		}
+	}
+	names = append(names, q.MeasureNames...)
+	for _, s := range q.Sort {
+		// Sorts may reference a dimension by its alias
+		if name, ok := aliases[s.Name]; ok {
+			names = append(names, name)
+			continue
+		}
+		names = append(names, s.Name)
+	}
+	err = checkFieldAccess(mv, q.MetricsViewSecurity, q.Filter, q.Where, q.InlineMeasures, names...)
+	if err != nil {
+		return "", nil, err
+	}
+
+	timezone := "UTC"
+	if q.TimeZone != "" {
+		timezone = q.TimeZone
+	}
+
+	selectCols := make([]string, 0, len(q.Dimensions)+len(ms))
+	groupCols := make([]string, 0, len(q.Dimensions))
+	outputs := make(map[string]bool)
+	args := []any{}
+	for i, d := range q.Dimensions {
+		alias := dimensionAlias(d)
+		if outputs[alias] {
+			return "", nil, fmt.Errorf("dimension '%s' is requested more than once, set an alias to disambiguate", alias)
+		}
+		outputs[alias] = true
+
+		var expr string
+		isTimeDimension := mv.TimeDimension != "" && strings.EqualFold(d.Name, mv.TimeDimension)
+		switch {
+		case d.TimeGrain != runtimev1.TimeGrain_TIME_GRAIN_UNSPECIFIED:
+			if !isTimeDimension {
+				return "", nil, fmt.Errorf("time grain can only be set for the time dimension '%s'", mv.TimeDimension)
+			}
+			var exprArgs []any
+			expr, exprArgs, err = timeFloorExpr(dialect, safeName(mv.TimeDimension), d.TimeGrain, timezone, metricsViewCalendar(mv))
+			if err != nil {
+				return "", nil, err
+			}
+			args = append(args, exprArgs...)
+		case isTimeDimension:
+			expr = safeName(mv.TimeDimension)
+		default:
+			expr, err = metricsViewDimensionToSafeColumn(mv, d.Name)
+			if err != nil {
+				return "", nil, err
+			}
+		}
+
+		selectCols = append(selectCols, fmt.Sprintf("%s as %s", expr, safeName(alias)))
+		groupCols = append(groupCols, fmt.Sprint(i+1))
+	}
+
+	for _, m := range ms {
+		if outputs[m.Name] {
+			return "", nil, fmt.Errorf("measure '%s' has the same name as a dimension", m.Name)
+		}
+		outputs[m.Name] = true
+		selectCols = append(selectCols, fmt.Sprintf("%s as %s", m.Expression, safeName(m.Name)))
+	}
+
+	whereClause := "1=1"
+	if mv.TimeDimension != "" {
+		if q.TimeStart != nil {
+			whereClause += fmt.Sprintf(" AND %s >= ?", safeName(mv.TimeDimension))
+			args = append(args, q.TimeStart.AsTime())
+		}
+		if q.TimeEnd != nil {
+			whereClause += fmt.Sprintf(" AND %s < ?", safeName(mv.TimeDimension))
+			args = append(args, q.TimeEnd.AsTime())
+		}
+	}
+
+	if q.Filter != nil || q.Where != nil || q.MetricsViewSecurity != nil {
+		clause, clauseArgs, err := buildFilterClauseForMetricsViewFilter(mv, q.Filter, q.Where, dialect, q.MetricsViewSecurity)
+		if err != nil {
+			return "", nil, err
+		}
+		whereClause += " " + clause
+		args = append(args, clauseArgs...)
+	}
+
+	groupClause := ""
+	if len(groupCols) > 0 {
+		groupClause = "GROUP BY " + strings.Join(groupCols, ", ")
+	}
+
+	sorts := q.Sort
+	if pivot {
+		sorts = append(sorts, &runtimev1.MetricsViewAggregationSort{Name: q.PivotOn})
+	}
+	sortingCriteria := make([]string, 0, len(sorts))
+	for _, s := range sorts {
+		if !outputs[s.Name] {
+			return "", nil, fmt.Errorf("can't sort by '%s': it's not one of the requested dimensions or measures", s.Name)
+		}
+		sortCriterion := safeName(s.Name)
+		if s.Desc {
+			sortCriterion += " DESC"
+		}
+		if dialect == drivers.DialectDuckDB || dialect == drivers.DialectClickHouse {
+			sortCriterion += " NULLS LAST"
+		}
+		sortingCriteria = append(sortingCriteria, sortCriterion)
+	}
+	orderClause := ""
+	if len(sortingCriteria) > 0 {
+		orderClause = "ORDER BY " + strings.Join(sortingCriteria, ", ")
+	}
+
+	var limitClause string
+	if pivot {
+		// Fetch one row more than the max to detect when it's exceeded
+		limitClause = fmt.Sprintf("LIMIT %d", maxPivotInputRows+1)
+	} else {
+		if q.Limit != nil {
+			if *q.Limit == 0 {
+				*q.Limit = 100
+			}
+			limitClause = fmt.Sprintf("LIMIT %d", *q.Limit)
+		}
+		if q.Offset != 0 {
+			limitClause += fmt.Sprintf(" OFFSET %d", q.Offset)
+		}
+	}
+
+	sql := fmt.Sprintf("SELECT %s FROM %s WHERE %s %s %s %s",
+		strings.Join(selectCols, ", "),
+		safeName(mv.Model),
+		whereClause,
+		groupClause,
+		orderClause,
+		limitClause,
+	)
+
+	return sql, args, nil
+}
@@ -6,6 +6,7 @@ import (
 	"fmt"
 	"io"
 	"strings"
+	"time"
 
 	runtimev1 "github.com/rilldata/rill/proto/gen/rill/runtime/v1"
 	"github.com/rilldata/rill/runtime"
@@ -24,6 +25,9 @@ type MetricsViewComparisonToplist struct {
 	Offset              int64                                  `json:"offset,omitempty"`
 	Sort                []*runtimev1.MetricsViewComparisonSort `json:"sort,omitempty"`
 	Filter              *runtimev1.MetricsViewFilter           `json:"filter,omitempty"`
+	Where               *runtimev1.Expression                  `json:"where,omitempty"`
+	Having              *runtimev1.Expression                  `json:"having,omitempty"`
+	MetricsViewSecurity *runtime.ResolvedMetricsViewSecurity   `json:"security,omitempty"`
 
 	Result *runtimev1.MetricsViewComparisonToplistResponse `json:"-"`
 }
@@ -65,7 +69,7 @@ func (q *MetricsViewComparisonToplist) Resolve(ctx context.Context, rt *runtime.
 	}
 	defer release()
 
-	if olap.Dialect() != drivers.DialectDuckDB && olap.Dialect() != drivers.DialectDruid {
+	if olap.Dialect() != drivers.DialectDuckDB && olap.Dialect() != drivers.DialectDruid && olap.Dialect() != drivers.DialectClickHouse {
 		return fmt.Errorf("not available for dialect '%s'", olap.Dialect())
 	}
 
@@ -211,23 +215,40 @@ func (q *MetricsViewComparisonToplist) executeComparisonToplist(ctx context.Cont
 	return nil
 }
 
-func timeRangeClause(timeRange *runtimev1.TimeRange, td string, args *[]any) string {
+// timeRangeClause returns a filter clause for the time range. If the time range has a round_to_grain,
+// its start and end are rounded down to the start of their buckets in the metrics view's calendar.
+func timeRangeClause(timeRange *runtimev1.TimeRange, cal calendar, td string, args *[]any) (string, error) {
 	var clause string
 	if timeRange == nil {
-		return clause
+		return clause, nil
+	}
+
+	loc := time.UTC
+	if timeRange.TimeZone != "" {
+		var err error
+		loc, err = time.LoadLocation(timeRange.TimeZone)
+		if err != nil {
+			return "", err
+		}
+	}
+	round := func(t time.Time) time.Time {
+		if timeRange.RoundToGrain == runtimev1.TimeGrain_TIME_GRAIN_UNSPECIFIED {
+			return t
+		}
+		return truncateTime(t, timeRange.RoundToGrain, loc, cal)
 	}
 
 	if timeRange.Start != nil {
 		clause += fmt.Sprintf(" AND %s >= ?", td)
-		*args = append(*args, timeRange.Start.AsTime())
+		*args = append(*args, round(timeRange.Start.AsTime()))
 	}
 
 	if timeRange.End != nil {
 		clause += fmt.Sprintf(" AND %s < ?", td)
-		*args = append(*args, timeRange.End.AsTime())
+		*args = append(*args, round(timeRange.End.AsTime()))
 	}
 
-	return clause
+	return clause, nil
 }
 
 func (q *MetricsViewComparisonToplist) buildMetricsTopListSQL(mv *runtimev1.MetricsView, dialect drivers.Dialect) (string, []any, error) {
@@ -236,6 +257,11 @@ func (q *MetricsViewComparisonToplist) buildMetricsTopListSQL(mv *runtimev1.Metr
 		return "", nil, err
 	}
 
+	err = checkFieldAccess(mv, q.MetricsViewSecurity, q.Filter, q.Where, q.InlineMeasures, q.fieldNames(mv)...)
+	if err != nil {
+		return "", nil, err
+	}
+
 	colName, err := metricsViewDimensionToSafeColumn(mv, q.DimensionName)
 	if err != nil {
 		return "", nil, err
@@ -253,9 +279,13 @@ func (q *MetricsViewComparisonToplist) buildMetricsTopListSQL(mv *runtimev1.Metr
 	args := []any{}
 	td := safeName(mv.TimeDimension)
 
-	baseWhereClause += timeRangeClause(q.BaseTimeRange, td, &args)
-	if q.Filter != nil {
-		clause, clauseArgs, err := buildFilterClauseForMetricsViewFilter(mv, q.Filter, dialect)
+	clause, err := timeRangeClause(q.BaseTimeRange, metricsViewCalendar(mv), td, &args)
+	if err != nil {
+		return "", nil, err
+	}
+	baseWhereClause += clause
+	if q.Filter != nil || q.Where != nil || q.MetricsViewSecurity != nil {
+		clause, clauseArgs, err := buildFilterClauseForMetricsViewFilter(mv, q.Filter, q.Where, dialect, q.MetricsViewSecurity)
 		if err != nil {
 			return "", nil, err
 		}
@@ -264,6 +294,16 @@ func (q *MetricsViewComparisonToplist) buildMetricsTopListSQL(mv *runtimev1.Metr
 		args = append(args, clauseArgs...)
 	}
 
+	havingClause := ""
+	if q.Having != nil {
+		clause, clauseArgs, err := buildHavingExpression(mv, q.InlineMeasures, q.Having, dialect)
+		if err != nil {
+			return "", nil, err
+		}
+		havingClause = "HAVING " + clause
+		args = append(args, clauseArgs...)
+	}
+
 	orderClause := "true"
 	for _, s := range q.Sort {
 		orderClause += ", "
@@ -271,7 +311,7 @@ func (q *MetricsViewComparisonToplist) buildMetricsTopListSQL(mv *runtimev1.Metr
 		if !s.Ascending {
 			orderClause += " DESC"
 		}
-		if dialect == drivers.DialectDuckDB {
+		if dialect == drivers.DialectDuckDB || dialect == drivers.DialectClickHouse {
 			orderClause += " NULLS LAST"
 		}
 	}
@@ -281,7 +321,7 @@ func (q *MetricsViewComparisonToplist) buildMetricsTopListSQL(mv *runtimev1.Metr
 	}
 
 	sql := fmt.Sprintf(
-		`SELECT %[1]s FROM %[3]q WHERE %[4]s GROUP BY %[2]s ORDER BY %[5]s LIMIT %[6]d OFFSET %[7]d`,
+		`SELECT %[1]s FROM %[3]q WHERE %[4]s GROUP BY %[2]s %[8]s ORDER BY %[5]s LIMIT %[6]d OFFSET %[7]d`,
 		selectClause,    // 1
 		colName,         // 2
 		mv.Model,        // 3
@@ -289,6 +329,7 @@ func (q *MetricsViewComparisonToplist) buildMetricsTopListSQL(mv *runtimev1.Metr
 		orderClause,     // 5
 		q.Limit,         // 6
 		q.Offset,        // 7
+		havingClause,    // 8
 	)
 
 	return sql, args, nil
@@ -300,6 +341,11 @@ func (q *MetricsViewComparisonToplist) buildMetricsComparisonTopListSQL(mv *runt
 		return "", nil, err
 	}
 
+	err = checkFieldAccess(mv, q.MetricsViewSecurity, q.Filter, q.Where, q.InlineMeasures, q.fieldNames(mv)...)
+	if err != nil {
+		return "", nil, err
+	}
+
 	colName, err := metricsViewDimensionToSafeColumn(mv, q.DimensionName)
 	if err != nil {
 		return "", nil, err
@@ -313,24 +359,36 @@ func (q *MetricsViewComparisonToplist) buildMetricsComparisonTopListSQL(mv *runt
 		measureMap[m.Name] = i
 		expr := fmt.Sprintf(`%s as %s`, m.Expression, safeName(m.Name))
 		selectCols = append(selectCols, expr)
-		var columnsTuple string
-		if dialect != drivers.DialectDruid {
-			columnsTuple = fmt.Sprintf(
-				"base.%[1]s, comparison.%[1]s, comparison.%[1]s - base.%[1]s, (comparison.%[1]s - base.%[1]s)/base.%[1]s::DOUBLE",
-				safeName(m.Name),
-			)
-		} else {
-			columnsTuple = fmt.Sprintf(
-				"ANY_VALUE(base.%[1]s), ANY_VALUE(comparison.%[1]s), ANY_VALUE(comparison.%[1]s - base.%[1]s), ANY_VALUE(SAFE_DIVIDE(comparison.%[1]s - base.%[1]s, CAST(base.%[1]s AS FLOAT))",
-				safeName(m.Name),
-			)
+		columns := comparisonMeasureColumns(dialect, m.Name)
+		if dialect == drivers.DialectDruid {
+			for j, c := range columns {
+				columns[j] = fmt.Sprintf("ANY_VALUE(%s)", c)
+			}
 		}
 		finalSelectCols = append(
 			finalSelectCols,
-			columnsTuple,
+			strings.Join(columns, ", "),
 		)
 	}
 
+	// Measures that are only referenced in the having expression must also be computed in the subqueries
+	havingOnly := make(map[string]bool)
+	for _, ident := range appendExpressionIdentifiers(nil, q.Having) {
+		name, _, err := parseComparisonMeasureIdent(mv, q.InlineMeasures, ident)
+		if err != nil {
+			return "", nil, err
+		}
+		if _, ok := measureMap[name]; ok || havingOnly[name] {
+			continue
+		}
+		extra, err := resolveMeasures(mv, q.InlineMeasures, []string{name})
+		if err != nil {
+			return "", nil, err
+		}
+		havingOnly[name] = true
+		selectCols = append(selectCols, fmt.Sprintf(`%s as %s`, extra[0].Expression, safeName(name)))
+	}
+
 	subSelectClause := strings.Join(selectCols, ", ")
 	finalSelectClause := strings.Join(finalSelectCols, ", ")
 
@@ -344,9 +402,13 @@ func (q *MetricsViewComparisonToplist) buildMetricsComparisonTopListSQL(mv *runt
 
 	td := safeName(mv.TimeDimension)
 
-	baseWhereClause += timeRangeClause(q.BaseTimeRange, td, &args)
-	if q.Filter != nil {
-		clause, clauseArgs, err := buildFilterClauseForMetricsViewFilter(mv, q.Filter, dialect)
+	clause, err := timeRangeClause(q.BaseTimeRange, metricsViewCalendar(mv), td, &args)
+	if err != nil {
+		return "", nil, err
+	}
+	baseWhereClause += clause
+	if q.Filter != nil || q.Where != nil || q.MetricsViewSecurity != nil {
+		clause, clauseArgs, err := buildFilterClauseForMetricsViewFilter(mv, q.Filter, q.Where, dialect, q.MetricsViewSecurity)
 		if err != nil {
 			return "", nil, err
 		}
@@ -355,9 +417,13 @@ func (q *MetricsViewComparisonToplist) buildMetricsComparisonTopListSQL(mv *runt
 		args = append(args, clauseArgs...)
 	}
 
-	comparisonWhereClause += timeRangeClause(q.ComparisonTimeRange, td, &args)
-	if q.Filter != nil {
-		clause, clauseArgs, err := buildFilterClauseForMetricsViewFilter(mv, q.Filter, dialect)
+	clause, err = timeRangeClause(q.ComparisonTimeRange, metricsViewCalendar(mv), td, &args)
+	if err != nil {
+		return "", nil, err
+	}
+	comparisonWhereClause += clause
+	if q.Filter != nil || q.Where != nil || q.MetricsViewSecurity != nil {
+		clause, clauseArgs, err := buildFilterClauseForMetricsViewFilter(mv, q.Filter, q.Where, dialect, q.MetricsViewSecurity)
 		if err != nil {
 			return "", nil, err
 		}
@@ -366,6 +432,23 @@ func (q *MetricsViewComparisonToplist) buildMetricsComparisonTopListSQL(mv *runt
 		args = append(args, clauseArgs...)
 	}
 
+	havingClause := ""
+	if q.Having != nil {
+		clause, clauseArgs, err := compileExpression(q.Having, dialect, func(ident string) (string, error) {
+			name, typ, err := parseComparisonMeasureIdent(mv, q.InlineMeasures, ident)
+			if err != nil {
+				return "", err
+			}
+			columns := comparisonMeasureColumns(dialect, name)
+			return fmt.Sprintf("(%s)", columns[typ-runtimev1.MetricsViewComparisonSortType_METRICS_VIEW_COMPARISON_SORT_TYPE_BASE_VALUE]), nil
+		})
+		if err != nil {
+			return "", nil, err
+		}
+		havingClause = "WHERE " + clause
+		args = append(args, clauseArgs...)
+	}
+
 	err = validateSort(q.Sort)
 	if err != nil {
 		return "", nil, err
@@ -398,7 +481,7 @@ func (q *MetricsViewComparisonToplist) buildMetricsComparisonTopListSQL(mv *runt
 		if !s.Ascending {
 			orderClause += " DESC"
 		}
-		if dialect == drivers.DialectDuckDB {
+		if dialect == drivers.DialectDuckDB || dialect == drivers.DialectClickHouse {
 			orderClause += " NULLS LAST"
 		}
 	}
@@ -409,6 +492,12 @@ func (q *MetricsViewComparisonToplist) buildMetricsComparisonTopListSQL(mv *runt
 
 	var sql string
 	if dialect != drivers.DialectDruid {
+		// ClickHouse fills unmatched rows of a FULL JOIN with default values instead of NULLs unless join_use_nulls is set,
+		// and it only supports ordering by column position if enable_positional_arguments is set (default since 22.7)
+		settingsClause := ""
+		if dialect == drivers.DialectClickHouse {
+			settingsClause = "SETTINGS join_use_nulls = 1, enable_positional_arguments = 1"
+		}
 		sql = fmt.Sprintf(`
 		SELECT COALESCE(base.%[2]s, comparison.%[2]s), %[9]s FROM 
 			(
@@ -420,12 +509,14 @@ func (q *MetricsViewComparisonToplist) buildMetricsComparisonTopListSQL(mv *runt
 			) comparison
 		ON
 				base.%[2]s = comparison.%[2]s
+		%[11]s
 		ORDER BY
 			%[6]s
 		LIMIT
 			%[7]d
 		OFFSET
 			%[8]d
+		%[10]s
 		`,
 			subSelectClause,       // 1
 			colName,               // 2
@@ -436,6 +527,8 @@ func (q *MetricsViewComparisonToplist) buildMetricsComparisonTopListSQL(mv *runt
 			q.Limit,               // 7
 			q.Offset,              // 8
 			finalSelectClause,     // 9
+			settingsClause,        // 10
+			havingClause,          // 11
 		)
 	} else {
 		/*
@@ -467,7 +560,8 @@ func (q *MetricsViewComparisonToplist) buildMetricsComparisonTopListSQL(mv *runt
 			LIMIT 10
 		*/
 		// Apache Druid requires that one part of the JOIN fits in memory, that can be achieved by pushing down the limit clause to a subquery (works only if the sorting is based entirely on a single subquery result)
-		if q.Sort[0].Type == runtimev1.MetricsViewComparisonSortType_METRICS_VIEW_COMPARISON_SORT_TYPE_COMPARISON_VALUE || q.Sort[0].Type == runtimev1.MetricsViewComparisonSortType_METRICS_VIEW_COMPARISON_SORT_TYPE_BASE_VALUE {
+		// The limit can't be pushed down if rows are filtered after the JOIN.
+		if q.Having == nil && (q.Sort[0].Type == runtimev1.MetricsViewComparisonSortType_METRICS_VIEW_COMPARISON_SORT_TYPE_COMPARISON_VALUE || q.Sort[0].Type == runtimev1.MetricsViewComparisonSortType_METRICS_VIEW_COMPARISON_SORT_TYPE_BASE_VALUE) {
 			leftSubQueryAlias := "base"
 			rightSubQueryAlias := "comparison"
 			leftWhereClause := baseWhereClause
@@ -524,6 +618,7 @@ func (q *MetricsViewComparisonToplist) buildMetricsComparisonTopListSQL(mv *runt
 					) comparison
 				ON
 						base.%[2]s = comparison.%[2]s
+				%[10]s
 				ORDER BY
 					%[6]s
 				LIMIT
@@ -540,6 +635,7 @@ func (q *MetricsViewComparisonToplist) buildMetricsComparisonTopListSQL(mv *runt
 				q.Limit,               // 7
 				q.Offset,              // 8
 				finalSelectClause,     // 9
+				havingClause,          // 10
 			)
 		}
 	}
@@ -551,6 +647,78 @@ func (q *MetricsViewComparisonToplist) Export(ctx context.Context, rt *runtime.R
 	return ErrExportNotSupported
 }
 
+// fieldNames returns the dimension and measures referenced by the query
+func (q *MetricsViewComparisonToplist) fieldNames(mv *runtimev1.MetricsView) []string {
+	names := append([]string{q.DimensionName}, q.MeasureNames...)
+	for _, s := range q.Sort {
+		names = append(names, s.MeasureName)
+	}
+	for _, ident := range appendExpressionIdentifiers(nil, q.Having) {
+		if name, _, err := parseComparisonMeasureIdent(mv, q.InlineMeasures, ident); err == nil {
+			ident = name
+		}
+		names = append(names, ident)
+	}
+	return names
+}
+
+const (
+	comparisonValueSuffix = "__comparison"
+	deltaAbsSuffix        = "__delta_abs"
+	deltaRelSuffix        = "__delta_rel"
+)
+
+// parseComparisonMeasureIdent splits an identifier in a having expression into a measure name and the value it references.
+// The identifier is either a measure name, which references its base value, or a measure name with one of the comparison suffixes.
+func parseComparisonMeasureIdent(mv *runtimev1.MetricsView, inlines []*runtimev1.InlineMeasure, ident string) (string, runtimev1.MetricsViewComparisonSortType, error) {
+	if _, err := resolveMeasures(mv, inlines, []string{ident}); err == nil {
+		return ident, runtimev1.MetricsViewComparisonSortType_METRICS_VIEW_COMPARISON_SORT_TYPE_BASE_VALUE, nil
+	}
+
+	suffixes := []struct {
+		suffix string
+		typ    runtimev1.MetricsViewComparisonSortType
+	}{
+		{comparisonValueSuffix, runtimev1.MetricsViewComparisonSortType_METRICS_VIEW_COMPARISON_SORT_TYPE_COMPARISON_VALUE},
+		{deltaAbsSuffix, runtimev1.MetricsViewComparisonSortType_METRICS_VIEW_COMPARISON_SORT_TYPE_ABS_DELTA},
+		{deltaRelSuffix, runtimev1.MetricsViewComparisonSortType_METRICS_VIEW_COMPARISON_SORT_TYPE_REL_DELTA},
+	}
+	for _, s := range suffixes {
+		name, ok := strings.CutSuffix(ident, s.suffix)
+		if !ok {
+			continue
+		}
+		if _, err := resolveMeasures(mv, inlines, []string{name}); err != nil {
+			return "", 0, err
+		}
+		return name, s.typ, nil
+	}
+
+	return "", 0, fmt.Errorf("measure does not exist: '%s'", ident)
+}
+
+// comparisonMeasureColumns returns the expressions for a measure's base value, comparison value, absolute delta and relative delta
+// in the query that joins the base and comparison subqueries.
+// The deltas are the comparison value minus the base value, and that difference relative to the base value.
+func comparisonMeasureColumns(dialect drivers.Dialect, name string) []string {
+	var rel string
+	switch dialect {
+	case drivers.DialectDuckDB:
+		rel = "(comparison.%[1]s - base.%[1]s)/base.%[1]s::DOUBLE"
+	case drivers.DialectClickHouse:
+		// ClickHouse returns inf or nan on division by zero, which are converted to null in the response
+		rel = "(comparison.%[1]s - base.%[1]s)/toFloat64(base.%[1]s)"
+	default:
+		rel = "SAFE_DIVIDE(comparison.%[1]s - base.%[1]s, CAST(base.%[1]s AS FLOAT))"
+	}
+	return []string{
+		fmt.Sprintf("base.%[1]s", safeName(name)),
+		fmt.Sprintf("comparison.%[1]s", safeName(name)),
+		fmt.Sprintf("comparison.%[1]s - base.%[1]s", safeName(name)),
+		fmt.Sprintf(rel, safeName(name)),
+	}
+}
+
 func validateSort(sorts []*runtimev1.MetricsViewComparisonSort) error {
 	if len(sorts) == 0 {
 		return fmt.Errorf("sorting is required")
@@ -0,0 +1,97 @@
+package queries
+
+import (
+	"testing"
+
+	runtimev1 "github.com/rilldata/rill/proto/gen/rill/runtime/v1"
+	"github.com/rilldata/rill/runtime/drivers"
+	"github.com/stretchr/testify/require"
+	"google.golang.org/protobuf/types/known/structpb"
+	"google.golang.org/protobuf/types/known/timestamppb"
+)
+
+func Test_parseComparisonMeasureIdent(t *testing.T) {
+	mv := &runtimev1.MetricsView{
+		Measures: []*runtimev1.MetricsView_Measure{
+			{Name: "bids", Expression: "count(*)"},
+		},
+	}
+	inlines := []*runtimev1.InlineMeasure{
+		{Name: "total__delta_abs", Expression: "sum(x)"},
+	}
+
+	cases := []struct {
+		ident string
+		name  string
+		typ   runtimev1.MetricsViewComparisonSortType
+	}{
+		{"bids", "bids", runtimev1.MetricsViewComparisonSortType_METRICS_VIEW_COMPARISON_SORT_TYPE_BASE_VALUE},
+		{"bids__comparison", "bids", runtimev1.MetricsViewComparisonSortType_METRICS_VIEW_COMPARISON_SORT_TYPE_COMPARISON_VALUE},
+		{"bids__delta_abs", "bids", runtimev1.MetricsViewComparisonSortType_METRICS_VIEW_COMPARISON_SORT_TYPE_ABS_DELTA},
+		{"bids__delta_rel", "bids", runtimev1.MetricsViewComparisonSortType_METRICS_VIEW_COMPARISON_SORT_TYPE_REL_DELTA},
+		// measure names take precedence over suffixes
+		{"total__delta_abs", "total__delta_abs", runtimev1.MetricsViewComparisonSortType_METRICS_VIEW_COMPARISON_SORT_TYPE_BASE_VALUE},
+	}
+	for _, tt := range cases {
+		name, typ, err := parseComparisonMeasureIdent(mv, inlines, tt.ident)
+		require.NoError(t, err)
+		require.Equal(t, tt.name, name)
+		require.Equal(t, tt.typ, typ)
+	}
+
+	_, _, err := parseComparisonMeasureIdent(mv, inlines, "clicks__delta_abs")
+	require.Error(t, err)
+	_, _, err = parseComparisonMeasureIdent(mv, inlines, "bids__delta")
+	require.Error(t, err)
+}
+
+func Test_buildMetricsComparisonTopListSQL_having(t *testing.T) {
+	mv := &runtimev1.MetricsView{
+		Model:         "ad_bids",
+		TimeDimension: "timestamp",
+		Dimensions: []*runtimev1.MetricsView_Dimension{
+			{Name: "domain", Column: "domain"},
+		},
+		Measures: []*runtimev1.MetricsView_Measure{
+			{Name: "bids", Expression: "count(*)"},
+			{Name: "impressions", Expression: "sum(impressions)"},
+		},
+	}
+
+	q := &MetricsViewComparisonToplist{
+		MetricsViewName:     "ad_bids_metrics",
+		DimensionName:       "domain",
+		MeasureNames:        []string{"bids"},
+		BaseTimeRange:       &runtimev1.TimeRange{Start: timestamppb.Now()},
+		ComparisonTimeRange: &runtimev1.TimeRange{Start: timestamppb.Now()},
+		Sort: []*runtimev1.MetricsViewComparisonSort{
+			{MeasureName: "bids", Type: runtimev1.MetricsViewComparisonSortType_METRICS_VIEW_COMPARISON_SORT_TYPE_BASE_VALUE},
+		},
+		Having: &runtimev1.Expression{
+			Expression: &runtimev1.Expression_Cond{
+				Cond: &runtimev1.Condition{
+					Op: runtimev1.Operation_OPERATION_GT,
+					Exprs: []*runtimev1.Expression{
+						{Expression: &runtimev1.Expression_Ident{Ident: "impressions__delta_rel"}},
+						{Expression: &runtimev1.Expression_Val{Val: structpb.NewNumberValue(0.5)}},
+					},
+				},
+			},
+		},
+	}
+
+	// Druid pushes the limit down to a subquery when sorting by base or comparison values, which must not happen with a having filter
+	havingClauses := map[drivers.Dialect]string{
+		drivers.DialectDuckDB: `WHERE ((comparison."impressions" - base."impressions")/base."impressions"::DOUBLE) > ?`,
+		drivers.DialectDruid:  `WHERE (SAFE_DIVIDE(comparison."impressions" - base."impressions", CAST(base."impressions" AS FLOAT))) > ?`,
+	}
+	for dialect, having := range havingClauses {
+		sql, args, err := q.buildMetricsComparisonTopListSQL(mv, dialect)
+		require.NoError(t, err)
+		require.Contains(t, sql, `SELECT "domain", count(*) as "bids", sum(impressions) as "impressions" FROM "ad_bids"`)
+		require.Contains(t, sql, having, dialect.String())
+		require.NotContains(t, sql, `base."impressions", comparison."impressions"`)
+		require.Equal(t, 3, len(args))
+		require.Equal(t, 0.5, args[2])
+	}
+}
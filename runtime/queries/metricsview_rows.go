@@ -11,19 +11,23 @@ import (
 	runtimev1 "github.com/rilldata/rill/proto/gen/rill/runtime/v1"
 	"github.com/rilldata/rill/runtime"
 	"github.com/rilldata/rill/runtime/drivers"
+	"google.golang.org/grpc/codes"
+	"google.golang.org/grpc/status"
 	"google.golang.org/protobuf/types/known/timestamppb"
 )
 
 type MetricsViewRows struct {
-	MetricsViewName string                       `json:"metrics_view_name,omitempty"`
-	TimeStart       *timestamppb.Timestamp       `json:"time_start,omitempty"`
-	TimeEnd         *timestamppb.Timestamp       `json:"time_end,omitempty"`
-	TimeGranularity runtimev1.TimeGrain          `json:"time_granularity,omitempty"`
-	Filter          *runtimev1.MetricsViewFilter `json:"filter,omitempty"`
-	Sort            []*runtimev1.MetricsViewSort `json:"sort,omitempty"`
-	Limit           *int64                       `json:"limit,omitempty"`
-	Offset          int64                        `json:"offset,omitempty"`
-	TimeZone        string                       `json:"time_zone,omitempty"`
+	MetricsViewName     string                               `json:"metrics_view_name,omitempty"`
+	TimeStart           *timestamppb.Timestamp               `json:"time_start,omitempty"`
+	TimeEnd             *timestamppb.Timestamp               `json:"time_end,omitempty"`
+	TimeGranularity     runtimev1.TimeGrain                  `json:"time_granularity,omitempty"`
+	Filter              *runtimev1.MetricsViewFilter         `json:"filter,omitempty"`
+	Where               *runtimev1.Expression                `json:"where,omitempty"`
+	Sort                []*runtimev1.MetricsViewSort         `json:"sort,omitempty"`
+	Limit               *int64                               `json:"limit,omitempty"`
+	Offset              int64                                `json:"offset,omitempty"`
+	TimeZone            string                               `json:"time_zone,omitempty"`
+	MetricsViewSecurity *runtime.ResolvedMetricsViewSecurity `json:"security,omitempty"`
 
 	Result *runtimev1.MetricsViewRowsResponse `json:"-"`
 }
@@ -65,7 +69,7 @@ func (q *MetricsViewRows) Resolve(ctx context.Context, rt *runtime.Runtime, inst
 	}
 	defer release()
 
-	if olap.Dialect() != drivers.DialectDuckDB && olap.Dialect() != drivers.DialectDruid {
+	if olap.Dialect() != drivers.DialectDuckDB && olap.Dialect() != drivers.DialectDruid && olap.Dialect() != drivers.DialectClickHouse {
 		return fmt.Errorf("not available for dialect '%s'", olap.Dialect())
 	}
 
@@ -108,73 +112,37 @@ func (q *MetricsViewRows) Export(ctx context.Context, rt *runtime.Runtime, insta
 	}
 	defer release()
 
+	if olap.Dialect() != drivers.DialectDuckDB && olap.Dialect() != drivers.DialectDruid && olap.Dialect() != drivers.DialectClickHouse {
+		return fmt.Errorf("not available for dialect '%s'", olap.Dialect())
+	}
+
 	mv, err := lookupMetricsView(ctx, rt, instanceID, q.MetricsViewName)
 	if err != nil {
 		return err
 	}
 
-	switch olap.Dialect() {
-	case drivers.DialectDuckDB:
-		if opts.Format == runtimev1.ExportFormat_EXPORT_FORMAT_CSV || opts.Format == runtimev1.ExportFormat_EXPORT_FORMAT_PARQUET {
-			if mv.TimeDimension == "" && (q.TimeStart != nil || q.TimeEnd != nil) {
-				return fmt.Errorf("metrics view '%s' does not have a time dimension", q.MetricsViewName)
-			}
-
-			timeRollupColumnName, err := q.resolveTimeRollupColumnName(ctx, rt, instanceID, opts.Priority, mv)
-			if err != nil {
-				return err
-			}
-
-			sql, args, err := q.buildMetricsRowsSQL(mv, olap.Dialect(), timeRollupColumnName)
-			if err != nil {
-				return err
-			}
-
-			filename := q.generateFilename(mv)
-			if err := duckDBCopyExport(ctx, rt, instanceID, w, opts, sql, args, filename, olap, mv, opts.Format); err != nil {
-				return err
-			}
-		} else {
-			if err := q.generalExport(ctx, rt, instanceID, w, opts, olap, mv); err != nil {
-				return err
-			}
-		}
-	case drivers.DialectDruid:
-		if err := q.generalExport(ctx, rt, instanceID, w, opts, olap, mv); err != nil {
-			return err
-		}
-	default:
-		return fmt.Errorf("not available for dialect '%s'", olap.Dialect())
+	if mv.TimeDimension == "" && (q.TimeStart != nil || q.TimeEnd != nil) {
+		return fmt.Errorf("metrics view '%s' does not have a time dimension", q.MetricsViewName)
 	}
 
-	return nil
-}
-
-func (q *MetricsViewRows) generalExport(ctx context.Context, rt *runtime.Runtime, instanceID string, w io.Writer, opts *runtime.ExportOptions, olap drivers.OLAPStore, mv *runtimev1.MetricsView) error {
-	err := q.Resolve(ctx, rt, instanceID, opts.Priority)
+	timeRollupColumnName, err := q.resolveTimeRollupColumnName(ctx, rt, instanceID, opts.Priority, mv)
 	if err != nil {
 		return err
 	}
 
-	if opts.PreWriteHook != nil {
-		err = opts.PreWriteHook(q.generateFilename(mv))
-		if err != nil {
-			return err
-		}
+	sql, args, err := q.buildMetricsRowsSQL(mv, olap.Dialect(), timeRollupColumnName)
+	if err != nil {
+		return err
 	}
 
-	switch opts.Format {
-	case runtimev1.ExportFormat_EXPORT_FORMAT_UNSPECIFIED:
-		return fmt.Errorf("unspecified format")
-	case runtimev1.ExportFormat_EXPORT_FORMAT_CSV:
-		return writeCSV(q.Result.Meta, q.Result.Data, w)
-	case runtimev1.ExportFormat_EXPORT_FORMAT_XLSX:
-		return writeXLSX(q.Result.Meta, q.Result.Data, w)
-	case runtimev1.ExportFormat_EXPORT_FORMAT_PARQUET:
-		return writeParquet(q.Result.Meta, q.Result.Data, w)
+	filename := q.generateFilename(mv)
+
+	// DuckDB can write CSV and Parquet files natively
+	if olap.Dialect() == drivers.DialectDuckDB && (opts.Format == runtimev1.ExportFormat_EXPORT_FORMAT_CSV || opts.Format == runtimev1.ExportFormat_EXPORT_FORMAT_PARQUET) {
+		return duckDBCopyExport(ctx, rt, instanceID, w, opts, sql, args, filename, olap, mv, opts.Format)
 	}
 
-	return nil
+	return exportQuery(ctx, rt, instanceID, olap, w, opts, filename, sql, args)
 }
 
 // resolveTimeRollupColumnName infers a column name for time rollup values.
@@ -238,8 +206,17 @@ func (q *MetricsViewRows) buildMetricsRowsSQL(mv *runtimev1.MetricsView, dialect
 		}
 	}
 
-	if q.Filter != nil {
-		clause, clauseArgs, err := buildFilterClauseForMetricsViewFilter(mv, q.Filter, dialect)
+	sortNames := make([]string, len(q.Sort))
+	for i, s := range q.Sort {
+		sortNames[i] = s.Name
+	}
+	err := checkFieldAccess(mv, q.MetricsViewSecurity, q.Filter, q.Where, nil, sortNames...)
+	if err != nil {
+		return "", nil, err
+	}
+
+	if q.Filter != nil || q.Where != nil || q.MetricsViewSecurity != nil {
+		clause, clauseArgs, err := buildFilterClauseForMetricsViewFilter(mv, q.Filter, q.Where, dialect, q.MetricsViewSecurity)
 		if err != nil {
 			return "", nil, err
 		}
@@ -253,7 +230,7 @@ func (q *MetricsViewRows) buildMetricsRowsSQL(mv *runtimev1.MetricsView, dialect
 		if !s.Ascending {
 			sortCriterion += " DESC"
 		}
-		if dialect == drivers.DialectDuckDB {
+		if dialect == drivers.DialectDuckDB || dialect == drivers.DialectClickHouse {
 			sortCriterion += " NULLS LAST"
 		}
 		sortingCriteria = append(sortingCriteria, sortCriterion)
@@ -273,6 +250,35 @@ func (q *MetricsViewRows) buildMetricsRowsSQL(mv *runtimev1.MetricsView, dialect
 
 	selectColumns := []string{"*"}
 
+	// If the policy excludes some dimensions, only select the time dimension and the allowed dimensions
+	if q.MetricsViewSecurity != nil && len(q.MetricsViewSecurity.Exclude) > 0 {
+		allowed := make(map[string]bool)
+		selectColumns = nil
+		if mv.TimeDimension != "" {
+			allowed[mv.TimeDimension] = true
+			selectColumns = append(selectColumns, safeName(mv.TimeDimension))
+		}
+		for _, dim := range mv.Dimensions {
+			col := dim.Column
+			if col == "" {
+				col = dim.Name
+			}
+			if allowed[col] || !q.MetricsViewSecurity.CanAccessField(dim.Name) {
+				continue
+			}
+			allowed[col] = true
+			selectColumns = append(selectColumns, safeName(col))
+		}
+		if len(selectColumns) == 0 {
+			return "", nil, status.Errorf(codes.PermissionDenied, "access to all fields is not allowed")
+		}
+		for _, s := range q.Sort {
+			if !allowed[s.Name] {
+				return "", nil, status.Errorf(codes.PermissionDenied, "access to field '%s' is not allowed", s.Name)
+			}
+		}
+	}
+
 	if timeRollupColumnName != "" {
 		if mv.TimeDimension == "" || q.TimeGranularity == runtimev1.TimeGrain_TIME_GRAIN_UNSPECIFIED {
 			panic("timeRollupColumnName is set, but time dimension info is missing")
@@ -282,8 +288,15 @@ func (q *MetricsViewRows) buildMetricsRowsSQL(mv *runtimev1.MetricsView, dialect
 		if q.TimeZone != "" {
 			timezone = q.TimeZone
 		}
-		args = append([]any{timezone, timezone}, args...)
-		rollup := fmt.Sprintf("timezone(?, date_trunc('%s', timezone(?, %s::TIMESTAMPTZ))) AS %s", convertToDateTruncSpecifier(q.TimeGranularity), safeName(mv.TimeDimension), safeName(timeRollupColumnName))
+		var rollup string
+		if dialect == drivers.DialectClickHouse {
+			args = append([]any{timezone, "UTC"}, args...)
+			rollup = fmt.Sprintf("%s AS %s", clickHouseTimeFloor(safeName(mv.TimeDimension), q.TimeGranularity, metricsViewCalendar(mv)), safeName(timeRollupColumnName))
+		} else {
+			args = append([]any{timezone, timezone}, args...)
+			truncExpr := dateTruncExpr(fmt.Sprintf("timezone(?, %s::TIMESTAMPTZ)", safeName(mv.TimeDimension)), q.TimeGranularity, metricsViewCalendar(mv))
+			rollup = fmt.Sprintf("timezone(?, %s) AS %s", truncExpr, safeName(timeRollupColumnName))
+		}
 
 		// Prepend the rollup column
 		selectColumns = append([]string{rollup}, selectColumns...)
@@ -5,9 +5,14 @@ import (
 	"fmt"
 
 	runtimev1 "github.com/rilldata/rill/proto/gen/rill/runtime/v1"
+	"github.com/rilldata/rill/runtime"
+	"github.com/rilldata/rill/runtime/drivers"
 	"github.com/stretchr/testify/require"
 	"github.com/xuri/excelize/v2"
+	"google.golang.org/grpc/codes"
+	"google.golang.org/grpc/status"
 	"google.golang.org/protobuf/types/known/structpb"
+	"google.golang.org/protobuf/types/known/timestamppb"
 
 	"testing"
 )
@@ -455,3 +460,280 @@ func Test_writeXLSX_quotes(t *testing.T) {
 	require.NoError(t, err)
 	require.Equal(t, "a\"", v)
 }
+
+func Test_pivot(t *testing.T) {
+	meta := []*runtimev1.MetricsViewColumn{
+		{Name: "device"},
+		{Name: "domain"},
+		{Name: "bids", Type: "INTEGER"},
+	}
+	row := func(device, domain *structpb.Value, bids float64) *structpb.Struct {
+		return &structpb.Struct{Fields: map[string]*structpb.Value{
+			"device": device,
+			"domain": domain,
+			"bids":   structpb.NewNumberValue(bids),
+		}}
+	}
+	data := []*structpb.Struct{
+		row(structpb.NewStringValue("iphone"), structpb.NewStringValue("yahoo.com"), 1),
+		row(structpb.NewStringValue("android"), structpb.NewStringValue("msn.com"), 2),
+		row(structpb.NewStringValue("iphone"), structpb.NewNullValue(), 3),
+	}
+
+	res, err := pivot(meta, data, 2, 1)
+	require.NoError(t, err)
+
+	require.Equal(t, 4, len(res.Meta))
+	require.Equal(t, "device", res.Meta[0].Name)
+	require.Equal(t, "msn.com_bids", res.Meta[1].Name)
+	require.Equal(t, "null_bids", res.Meta[2].Name)
+	require.Equal(t, "yahoo.com_bids", res.Meta[3].Name)
+	require.Equal(t, "INTEGER", res.Meta[3].Type)
+	require.True(t, res.Meta[3].Nullable)
+
+	require.Equal(t, 2, len(res.Data))
+	require.Equal(t, "iphone", res.Data[0].Fields["device"].GetStringValue())
+	require.Equal(t, 1.0, res.Data[0].Fields["yahoo.com_bids"].GetNumberValue())
+	require.Equal(t, 3.0, res.Data[0].Fields["null_bids"].GetNumberValue())
+	require.Equal(t, structpb.NullValue_NULL_VALUE, res.Data[0].Fields["msn.com_bids"].GetNullValue())
+	require.Equal(t, "android", res.Data[1].Fields["device"].GetStringValue())
+	require.Equal(t, 2.0, res.Data[1].Fields["msn.com_bids"].GetNumberValue())
+}
+
+func Test_buildExpression(t *testing.T) {
+	mv := &runtimev1.MetricsView{
+		TimeDimension: "ts",
+		Dimensions: []*runtimev1.MetricsView_Dimension{
+			{Name: "country", Column: "country"},
+			{Name: "tier", Column: "revenue_tier"},
+			{Name: "device", Column: "device"},
+		},
+	}
+
+	// (country = 'US' OR tier = 'gold') AND NOT device LIKE 'bot%'
+	expr := condExpression(runtimev1.Operation_OPERATION_AND,
+		condExpression(runtimev1.Operation_OPERATION_OR,
+			condExpression(runtimev1.Operation_OPERATION_EQ, identExpression("country"), valExpression(structpb.NewStringValue("US"))),
+			condExpression(runtimev1.Operation_OPERATION_EQ, identExpression("tier"), valExpression(structpb.NewStringValue("gold"))),
+		),
+		condExpression(runtimev1.Operation_OPERATION_NOT,
+			condExpression(runtimev1.Operation_OPERATION_LIKE, identExpression("device"), valExpression(structpb.NewStringValue("bot%"))),
+		),
+	)
+	sql, args, err := buildExpression(mv, expr, drivers.DialectDuckDB)
+	require.NoError(t, err)
+	require.Equal(t, `(("country" = ?) OR ("revenue_tier" = ?)) AND (NOT ("device"  ILIKE ?))`, sql)
+	require.Equal(t, []any{"US", "gold", "bot%"}, args)
+
+	sql, _, err = buildExpression(mv, expr, drivers.DialectDruid)
+	require.NoError(t, err)
+	require.Equal(t, `(("country" = ?) OR ("revenue_tier" = ?)) AND (NOT (LOWER("device")  LIKE LOWER(?)))`, sql)
+
+	sql, args, err = buildExpression(mv, condExpression(runtimev1.Operation_OPERATION_NEQ, identExpression("country"), valExpression(structpb.NewNullValue())), drivers.DialectDuckDB)
+	require.NoError(t, err)
+	require.Equal(t, `"country" IS NOT NULL`, sql)
+	require.Empty(t, args)
+
+	sql, args, err = buildExpression(mv, condExpression(runtimev1.Operation_OPERATION_GTE, identExpression("ts"), valExpression(structpb.NewStringValue("2023-01-01"))), drivers.DialectDuckDB)
+	require.NoError(t, err)
+	require.Equal(t, `"ts" >= ?`, sql)
+	require.Equal(t, []any{"2023-01-01"}, args)
+
+	_, _, err = buildExpression(mv, condExpression(runtimev1.Operation_OPERATION_EQ, identExpression("unknown"), valExpression(structpb.NewStringValue("x"))), drivers.DialectDuckDB)
+	require.Error(t, err)
+
+	_, _, err = buildExpression(mv, condExpression(runtimev1.Operation_OPERATION_NOT), drivers.DialectDuckDB)
+	require.Error(t, err)
+}
+
+func Test_buildExpression_in(t *testing.T) {
+	mv := &runtimev1.MetricsView{
+		Dimensions: []*runtimev1.MetricsView_Dimension{
+			{Name: "country", Column: "country"},
+		},
+	}
+	in := func(op runtimev1.Operation, vals ...*structpb.Value) *runtimev1.Expression {
+		exprs := []*runtimev1.Expression{identExpression("country")}
+		for _, v := range vals {
+			exprs = append(exprs, valExpression(v))
+		}
+		return condExpression(op, exprs...)
+	}
+
+	cases := []struct {
+		expr *runtimev1.Expression
+		sql  string
+		args []any
+	}{
+		{in(runtimev1.Operation_OPERATION_IN, structpb.NewStringValue("US"), structpb.NewStringValue("DK")), `"country"  IN (?,?)`, []any{"US", "DK"}},
+		{in(runtimev1.Operation_OPERATION_IN, structpb.NewStringValue("US"), structpb.NewNullValue()), `"country"  IN (?) OR "country" IS NULL`, []any{"US"}},
+		{in(runtimev1.Operation_OPERATION_IN, structpb.NewNullValue()), `"country" IS NULL`, nil},
+		{in(runtimev1.Operation_OPERATION_NIN, structpb.NewStringValue("US")), `"country" NOT IN (?) OR "country" IS NULL`, []any{"US"}},
+		{in(runtimev1.Operation_OPERATION_NIN, structpb.NewStringValue("US"), structpb.NewNullValue()), `"country" NOT IN (?) AND "country" IS NOT NULL`, []any{"US"}},
+		{in(runtimev1.Operation_OPERATION_NIN, structpb.NewNullValue()), `"country" IS NOT NULL`, nil},
+	}
+	for _, tt := range cases {
+		sql, args, err := buildExpression(mv, tt.expr, drivers.DialectDuckDB)
+		require.NoError(t, err)
+		require.Equal(t, tt.sql, sql)
+		require.Equal(t, tt.args, args)
+	}
+}
+
+func Test_convertFilterToExpression(t *testing.T) {
+	require.Nil(t, convertFilterToExpression(nil))
+	require.Nil(t, convertFilterToExpression(&runtimev1.MetricsViewFilter{Include: []*runtimev1.MetricsViewFilter_Cond{{Name: "country"}}}))
+
+	mv := &runtimev1.MetricsView{
+		Dimensions: []*runtimev1.MetricsView_Dimension{
+			{Name: "country", Column: "country"},
+			{Name: "device", Column: "device"},
+		},
+	}
+	filter := &runtimev1.MetricsViewFilter{
+		Include: []*runtimev1.MetricsViewFilter_Cond{
+			{Name: "country", In: []*structpb.Value{structpb.NewStringValue("US")}, Like: []string{"D%"}},
+		},
+		Exclude: []*runtimev1.MetricsViewFilter_Cond{
+			{Name: "device", Like: []string{"bot%"}},
+		},
+	}
+	sql, args, err := buildExpression(mv, convertFilterToExpression(filter), drivers.DialectDuckDB)
+	require.NoError(t, err)
+	require.Equal(t, `(("country"  IN (?)) OR ("country"  ILIKE ?)) AND ("device" NOT ILIKE ? OR "device" IS NULL)`, sql)
+	require.Equal(t, []any{"US", "D%", "bot%"}, args)
+}
+
+func Test_checkFieldAccess_queries(t *testing.T) {
+	mv := &runtimev1.MetricsView{
+		Model:         "ad_bids",
+		TimeDimension: "timestamp",
+		Dimensions: []*runtimev1.MetricsView_Dimension{
+			{Name: "domain", Column: "domain"},
+			{Name: "publisher", Column: "publisher"},
+		},
+		Measures: []*runtimev1.MetricsView_Measure{
+			{Name: "bids", Expression: "count(*)"},
+			{Name: "bid_price", Expression: "avg(bid_price)"},
+			{Name: "bid_price_per_bid", Expression: "bid_price / bids", Requires: []string{"bid_price", "bids"}},
+			{Name: "bid_price_per_bid_7d", Window: &runtimev1.MetricsView_MeasureWindow{Measure: "bid_price_per_bid", Type: runtimev1.MetricsView_MeasureWindow_TYPE_ROLLING_AVG, Size: 7}},
+		},
+	}
+	policy := &runtime.ResolvedMetricsViewSecurity{Access: true, Exclude: []string{"publisher", "bid_price"}}
+	now := timestamppb.Now()
+
+	tests := []struct {
+		name  string
+		build func() error
+		field string
+	}{
+		{
+			name: "toplist sort",
+			build: func() error {
+				q := &MetricsViewToplist{DimensionName: "domain", MeasureNames: []string{"bids"}, Sort: []*runtimev1.MetricsViewSort{{Name: "bid_price"}}, MetricsViewSecurity: policy}
+				_, _, err := q.buildMetricsTopListSQL(mv, drivers.DialectDuckDB)
+				return err
+			},
+			field: "bid_price",
+		},
+		{
+			name: "toplist inline measure",
+			build: func() error {
+				q := &MetricsViewToplist{DimensionName: "domain", MeasureNames: []string{"bids"}, InlineMeasures: []*runtimev1.InlineMeasure{{Name: "bids", Expression: "max(bid_price)"}}, MetricsViewSecurity: policy}
+				_, _, err := q.buildMetricsTopListSQL(mv, drivers.DialectDuckDB)
+				return err
+			},
+			field: "bids",
+		},
+		{
+			name: "comparison toplist sort",
+			build: func() error {
+				q := &MetricsViewComparisonToplist{
+					DimensionName:       "domain",
+					MeasureNames:        []string{"bids"},
+					BaseTimeRange:       &runtimev1.TimeRange{Start: now},
+					ComparisonTimeRange: &runtimev1.TimeRange{Start: now},
+					Sort:                []*runtimev1.MetricsViewComparisonSort{{MeasureName: "bid_price"}},
+					MetricsViewSecurity: policy,
+				}
+				_, _, err := q.buildMetricsComparisonTopListSQL(mv, drivers.DialectDuckDB)
+				return err
+			},
+			field: "bid_price",
+		},
+		{
+			name: "totals inline measure",
+			build: func() error {
+				q := &MetricsViewTotals{MeasureNames: []string{"total"}, InlineMeasures: []*runtimev1.InlineMeasure{{Name: "total", Expression: "sum(bid_price)"}}, MetricsViewSecurity: policy}
+				_, _, err := q.buildMetricsTotalsSQL(mv, drivers.DialectDuckDB)
+				return err
+			},
+			field: "total",
+		},
+		{
+			name: "totals derived measure",
+			build: func() error {
+				q := &MetricsViewTotals{MeasureNames: []string{"bid_price_per_bid"}, MetricsViewSecurity: policy}
+				_, _, err := q.buildMetricsTotalsSQL(mv, drivers.DialectDuckDB)
+				return err
+			},
+			field: "bid_price_per_bid",
+		},
+		{
+			name: "timeseries window measure",
+			build: func() error {
+				q := &MetricsViewTimeSeries{MeasureNames: []string{"bid_price_per_bid_7d"}, TimeGranularity: runtimev1.TimeGrain_TIME_GRAIN_DAY, MetricsViewSecurity: policy}
+				names, _, hidden := splitWindowMeasures(mv, nil, q.MeasureNames)
+				_, _, _, err := q.buildMetricsTimeseriesSQL(mv, drivers.DialectDuckDB, names, hidden, nil)
+				return err
+			},
+			field: "bid_price_per_bid_7d",
+		},
+		{
+			name: "aggregation sort by alias",
+			build: func() error {
+				q := &MetricsViewAggregation{
+					Dimensions:          []*runtimev1.MetricsViewAggregationDimension{{Name: "publisher", Alias: "pub"}},
+					MeasureNames:        []string{"bids"},
+					Sort:                []*runtimev1.MetricsViewAggregationSort{{Name: "pub"}},
+					MetricsViewSecurity: policy,
+				}
+				_, _, err := q.buildMetricsAggregationSQL(mv, drivers.DialectDuckDB, false)
+				return err
+			},
+			field: "publisher",
+		},
+		{
+			name: "rows sort",
+			build: func() error {
+				q := &MetricsViewRows{Sort: []*runtimev1.MetricsViewSort{{Name: "publisher"}}, MetricsViewSecurity: policy}
+				_, _, err := q.buildMetricsRowsSQL(mv, drivers.DialectDuckDB, "")
+				return err
+			},
+			field: "publisher",
+		},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			err := tt.build()
+			require.Error(t, err)
+			require.Equal(t, codes.PermissionDenied, status.Code(err))
+			require.Contains(t, err.Error(), fmt.Sprintf("'%s'", tt.field))
+		})
+	}
+
+	// Counts don't reference any column, so they're allowed as inline measures
+	q := &MetricsViewToplist{DimensionName: "domain", MeasureNames: []string{"count"}, InlineMeasures: []*runtimev1.InlineMeasure{{Name: "count", Expression: "COUNT(*)"}}, Sort: []*runtimev1.MetricsViewSort{{Name: "count"}}, MetricsViewSecurity: policy}
+	_, _, err := q.buildMetricsTopListSQL(mv, drivers.DialectDuckDB)
+	require.NoError(t, err)
+
+	// Aliases of allowed dimensions can be sorted by
+	agg := &MetricsViewAggregation{
+		Dimensions:          []*runtimev1.MetricsViewAggregationDimension{{Name: "domain", Alias: "publisher"}},
+		MeasureNames:        []string{"bids"},
+		Sort:                []*runtimev1.MetricsViewAggregationSort{{Name: "publisher"}},
+		MetricsViewSecurity: policy,
+	}
+	_, _, err = agg.buildMetricsAggregationSQL(mv, drivers.DialectDuckDB, false)
+	require.NoError(t, err)
+}
@@ -2,21 +2,29 @@ package queries
 
 import (
 	"context"
+	"errors"
 	"fmt"
 	"io"
+	"time"
 
 	runtimev1 "github.com/rilldata/rill/proto/gen/rill/runtime/v1"
 	"github.com/rilldata/rill/runtime"
+	"github.com/rilldata/rill/runtime/drivers"
+	"google.golang.org/protobuf/types/known/timestamppb"
 )
 
 type MetricsViewTimeRange struct {
-	MetricsViewName string
-	Result          *runtimev1.MetricsViewTimeRangeResponse
+	MetricsViewName     string
+	MetricsViewSecurity *runtime.ResolvedMetricsViewSecurity
+	Result              *runtimev1.MetricsViewTimeRangeResponse
 }
 
 var _ runtime.Query = &MetricsViewTimeRange{}
 
 func (q *MetricsViewTimeRange) Key() string {
+	if q.MetricsViewSecurity != nil && q.MetricsViewSecurity.RowFilter != "" {
+		return fmt.Sprintf("MetricsViewTimeRange:%s:%s", q.MetricsViewName, q.MetricsViewSecurity.RowFilter)
+	}
 	return fmt.Sprintf("MetricsViewTimeRange:%s", q.MetricsViewName)
 }
 
@@ -50,6 +58,11 @@ func (q *MetricsViewTimeRange) Resolve(ctx context.Context, rt *runtime.Runtime,
 		return fmt.Errorf("metrics view '%s' does not have a time dimension", q.MetricsViewName)
 	}
 
+	// The time range of a metrics view with a row filter must be computed over the filtered rows
+	if q.MetricsViewSecurity != nil && q.MetricsViewSecurity.RowFilter != "" {
+		return q.resolveWithRowFilter(ctx, rt, instanceID, mv, priority)
+	}
+
 	ctr := &ColumnTimeRange{
 		TableName:  mv.Model,
 		ColumnName: mv.TimeDimension,
@@ -62,6 +75,78 @@ func (q *MetricsViewTimeRange) Resolve(ctx context.Context, rt *runtime.Runtime,
 	q.Result = &runtimev1.MetricsViewTimeRangeResponse{
 		TimeRangeSummary: ctr.Result,
 	}
+	q.setCalendar(mv)
+
+	return nil
+}
+
+// setCalendar adds the metrics view's calendar to the result, so clients can compute time ranges (such as year to date) that align with the time series buckets.
+func (q *MetricsViewTimeRange) setCalendar(mv *runtimev1.MetricsView) {
+	q.Result.FirstDayOfWeek = 1
+	if mv.FirstDayOfWeek != 0 {
+		q.Result.FirstDayOfWeek = mv.FirstDayOfWeek
+	}
+	q.Result.FirstMonthOfYear = 1
+	if mv.FirstMonthOfYear != 0 {
+		q.Result.FirstMonthOfYear = mv.FirstMonthOfYear
+	}
+}
+
+func (q *MetricsViewTimeRange) resolveWithRowFilter(ctx context.Context, rt *runtime.Runtime, instanceID string, mv *runtimev1.MetricsView, priority int) error {
+	olap, release, err := rt.OLAP(ctx, instanceID)
+	if err != nil {
+		return err
+	}
+	defer release()
+
+	if olap.Dialect() != drivers.DialectDuckDB && olap.Dialect() != drivers.DialectDruid && olap.Dialect() != drivers.DialectClickHouse {
+		return fmt.Errorf("not available for dialect '%s'", olap.Dialect())
+	}
+
+	rangeSQL := fmt.Sprintf(
+		"SELECT min(%[1]s) as \"min\", max(%[1]s) as \"max\" FROM %[2]s WHERE %[3]s",
+		safeName(mv.TimeDimension),
+		safeName(mv.Model),
+		q.MetricsViewSecurity.RowFilter,
+	)
+
+	rows, err := olap.Execute(ctx, &drivers.Statement{
+		Query:            rangeSQL,
+		Priority:         priority,
+		ExecutionTimeout: defaultExecutionTimeout,
+	})
+	if err != nil {
+		return err
+	}
+	defer rows.Close()
+
+	if !rows.Next() {
+		err = rows.Err()
+		if err != nil {
+			return err
+		}
+		return errors.New("no rows returned")
+	}
+
+	var minTime, maxTime *time.Time
+	err = rows.Scan(&minTime, &maxTime)
+	if err != nil {
+		return err
+	}
+
+	summary := &runtimev1.TimeRangeSummary{}
+	if minTime != nil && maxTime != nil {
+		summary.Min = timestamppb.New(*minTime)
+		summary.Max = timestamppb.New(*maxTime)
+		summary.Interval = &runtimev1.TimeRangeSummary_Interval{
+			Micros: maxTime.Sub(*minTime).Microseconds(),
+		}
+	}
+
+	q.Result = &runtimev1.MetricsViewTimeRangeResponse{
+		TimeRangeSummary: summary,
+	}
+	q.setCalendar(mv)
 
 	return nil
 }
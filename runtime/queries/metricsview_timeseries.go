@@ -13,22 +13,26 @@ import (
 	"github.com/rilldata/rill/runtime/drivers"
 	"github.com/rilldata/rill/runtime/pkg/pbutil"
 	"golang.org/x/exp/slices"
+	"google.golang.org/protobuf/proto"
 	"google.golang.org/protobuf/types/known/structpb"
 	"google.golang.org/protobuf/types/known/timestamppb"
 )
 
 type MetricsViewTimeSeries struct {
-	MetricsViewName string                       `json:"metrics_view_name,omitempty"`
-	MeasureNames    []string                     `json:"measure_names,omitempty"`
-	InlineMeasures  []*runtimev1.InlineMeasure   `json:"inline_measures,omitempty"`
-	TimeStart       *timestamppb.Timestamp       `json:"time_start,omitempty"`
-	TimeEnd         *timestamppb.Timestamp       `json:"time_end,omitempty"`
-	Limit           int64                        `json:"limit,omitempty"`
-	Offset          int64                        `json:"offset,omitempty"`
-	Sort            []*runtimev1.MetricsViewSort `json:"sort,omitempty"`
-	Filter          *runtimev1.MetricsViewFilter `json:"filter,omitempty"`
-	TimeGranularity runtimev1.TimeGrain          `json:"time_granularity,omitempty"`
-	TimeZone        string                       `json:"time_zone,omitempty"`
+	MetricsViewName     string                               `json:"metrics_view_name,omitempty"`
+	MeasureNames        []string                             `json:"measure_names,omitempty"`
+	InlineMeasures      []*runtimev1.InlineMeasure           `json:"inline_measures,omitempty"`
+	TimeStart           *timestamppb.Timestamp               `json:"time_start,omitempty"`
+	TimeEnd             *timestamppb.Timestamp               `json:"time_end,omitempty"`
+	Limit               int64                                `json:"limit,omitempty"`
+	Offset              int64                                `json:"offset,omitempty"`
+	Sort                []*runtimev1.MetricsViewSort         `json:"sort,omitempty"`
+	Filter              *runtimev1.MetricsViewFilter         `json:"filter,omitempty"`
+	Where               *runtimev1.Expression                `json:"where,omitempty"`
+	TimeGranularity     runtimev1.TimeGrain                  `json:"time_granularity,omitempty"`
+	TimeZone            string                               `json:"time_zone,omitempty"`
+	ComparisonTimeRange *runtimev1.TimeRange                 `json:"comparison_time_range,omitempty"`
+	MetricsViewSecurity *runtime.ResolvedMetricsViewSecurity `json:"security,omitempty"`
 
 	Result *runtimev1.MetricsViewTimeSeriesResponse `json:"-"`
 }
@@ -73,6 +77,15 @@ func (q *MetricsViewTimeSeries) Resolve(ctx context.Context, rt *runtime.Runtime
 		return fmt.Errorf("metrics view '%s' does not have a time dimension", q.MetricsViewName)
 	}
 
+	if q.ComparisonTimeRange != nil {
+		if q.TimeStart == nil || q.TimeEnd == nil || q.ComparisonTimeRange.Start == nil || q.ComparisonTimeRange.End == nil {
+			return fmt.Errorf("comparison time series require a start and end for both time ranges")
+		}
+		if q.TimeGranularity == runtimev1.TimeGrain_TIME_GRAIN_UNSPECIFIED {
+			return fmt.Errorf("comparison time series require a time granularity")
+		}
+	}
+
 	olap, release, err := rt.OLAP(ctx, instanceID)
 	if err != nil {
 		return err
@@ -81,12 +94,21 @@ func (q *MetricsViewTimeSeries) Resolve(ctx context.Context, rt *runtime.Runtime
 
 	switch olap.Dialect() {
 	case drivers.DialectDuckDB:
-		return q.resolveDuckDB(ctx, rt, instanceID, mv, priority)
-	case drivers.DialectDruid:
-		return q.resolveDruid(ctx, olap, mv, priority)
+		err = q.resolveDuckDB(ctx, rt, instanceID, mv, priority)
+	case drivers.DialectDruid, drivers.DialectClickHouse:
+		err = q.resolveWithTimeFloor(ctx, olap, mv, priority)
 	default:
 		return fmt.Errorf("not available for dialect '%s'", olap.Dialect())
 	}
+	if err != nil {
+		return err
+	}
+
+	if q.ComparisonTimeRange != nil {
+		return q.resolveComparison(ctx, rt, instanceID, mv, priority)
+	}
+
+	return nil
 }
 
 func (q *MetricsViewTimeSeries) Export(ctx context.Context, rt *runtime.Runtime, instanceID string, w io.Writer, opts *runtime.ExportOptions) error {
@@ -100,13 +122,6 @@ func (q *MetricsViewTimeSeries) Export(ctx context.Context, rt *runtime.Runtime,
 		return err
 	}
 
-	if opts.PreWriteHook != nil {
-		err = opts.PreWriteHook(q.generateFilename(mv))
-		if err != nil {
-			return err
-		}
-	}
-
 	tmp := make([]*structpb.Struct, 0, len(q.Result.Data))
 	meta := append([]*runtimev1.MetricsViewColumn{{
 		Name: mv.TimeDimension,
@@ -116,30 +131,25 @@ func (q *MetricsViewTimeSeries) Export(ctx context.Context, rt *runtime.Runtime,
 		tmp = append(tmp, dt.Records)
 	}
 
-	switch opts.Format {
-	case runtimev1.ExportFormat_EXPORT_FORMAT_UNSPECIFIED:
-		return fmt.Errorf("unspecified format")
-	case runtimev1.ExportFormat_EXPORT_FORMAT_CSV:
-		return writeCSV(meta, tmp, w)
-	case runtimev1.ExportFormat_EXPORT_FORMAT_XLSX:
-		return writeXLSX(meta, tmp, w)
-	case runtimev1.ExportFormat_EXPORT_FORMAT_PARQUET:
-		return writeParquet(meta, tmp, w)
-	}
-
-	return nil
+	return exportData(ctx, rt, instanceID, w, opts, q.generateFilename(mv), meta, tmp)
 }
 
 func (q *MetricsViewTimeSeries) generateFilename(mv *runtimev1.MetricsView) string {
 	filename := strings.ReplaceAll(mv.Model, `"`, `_`)
-	if q.TimeStart != nil || q.TimeEnd != nil || q.Filter != nil && (len(q.Filter.Include) > 0 || len(q.Filter.Exclude) > 0) {
+	if q.TimeStart != nil || q.TimeEnd != nil || q.Where != nil || q.Filter != nil && (len(q.Filter.Include) > 0 || len(q.Filter.Exclude) > 0) {
 		filename += "_filtered"
 	}
 	return filename
 }
 
 func (q *MetricsViewTimeSeries) resolveDuckDB(ctx context.Context, rt *runtime.Runtime, instanceID string, mv *runtimev1.MetricsView, priority int) error {
-	ms, err := resolveMeasures(mv, q.InlineMeasures, q.MeasureNames)
+	names, windows, hidden := splitWindowMeasures(mv, q.InlineMeasures, q.MeasureNames)
+	ms, err := resolveMeasures(mv, q.InlineMeasures, names)
+	if err != nil {
+		return err
+	}
+
+	err = checkFieldAccess(mv, q.MetricsViewSecurity, q.Filter, q.Where, q.InlineMeasures, append(slices.Clone(q.MeasureNames), hidden...)...)
 	if err != nil {
 		return err
 	}
@@ -157,10 +167,16 @@ func (q *MetricsViewTimeSeries) resolveDuckDB(ctx context.Context, rt *runtime.R
 			End:      q.TimeEnd,
 			Interval: q.TimeGranularity,
 		},
-		Measures:          measures,
-		MetricsView:       mv,
-		MetricsViewFilter: q.Filter,
-		TimeZone:          q.TimeZone,
+		Measures:            measures,
+		MetricsView:         mv,
+		MetricsViewFilter:   q.Filter,
+		MetricsViewWhere:    q.Where,
+		MetricsViewSecurity: q.MetricsViewSecurity,
+		MetricsViewWindows:  windows,
+		MetricsViewHidden:   hidden,
+		TimeZone:            q.TimeZone,
+		FirstDayOfWeek:      mv.FirstDayOfWeek,
+		FirstMonthOfYear:    mv.FirstMonthOfYear,
 	}
 	err = rt.Query(ctx, instanceID, tsq, priority)
 	if err != nil {
@@ -177,6 +193,171 @@ func (q *MetricsViewTimeSeries) resolveDuckDB(ctx context.Context, rt *runtime.R
 	return nil
 }
 
+// resolveComparison queries the time series for the comparison time range and adds the comparison values and deltas of each measure to the resolved base time series.
+// Buckets are aligned by their position in the time range, so the n-th bucket of the base range is compared with the n-th bucket of the comparison range.
+func (q *MetricsViewTimeSeries) resolveComparison(ctx context.Context, rt *runtime.Runtime, instanceID string, mv *runtimev1.MetricsView, priority int) error {
+	cq := &MetricsViewTimeSeries{
+		MetricsViewName:     q.MetricsViewName,
+		MeasureNames:        q.MeasureNames,
+		InlineMeasures:      q.InlineMeasures,
+		TimeStart:           q.ComparisonTimeRange.Start,
+		TimeEnd:             q.ComparisonTimeRange.End,
+		Filter:              q.Filter,
+		Where:               q.Where,
+		TimeGranularity:     q.TimeGranularity,
+		TimeZone:            q.TimeZone,
+		MetricsViewSecurity: q.MetricsViewSecurity,
+	}
+	err := rt.Query(ctx, instanceID, cq, priority)
+	if err != nil {
+		return err
+	}
+
+	loc, err := time.LoadLocation(q.TimeZone)
+	if err != nil {
+		return err
+	}
+
+	cal := metricsViewCalendar(mv)
+	baseStart := truncateTime(q.TimeStart.AsTime(), q.TimeGranularity, loc, cal)
+	comparisonStart := truncateTime(q.ComparisonTimeRange.Start.AsTime(), q.TimeGranularity, loc, cal)
+	align := func(ts time.Time) time.Time {
+		return alignComparisonTime(ts, baseStart, comparisonStart, q.TimeGranularity, loc)
+	}
+
+	q.Result = mergeComparisonTimeSeries(q.Result, cq.Result, align, q.ComparisonTimeRange.End.AsTime())
+	return nil
+}
+
+// mergeComparisonTimeSeries adds the comparison values and deltas of each measure to the base time series.
+// The align function maps a base bucket to the aligned bucket in the comparison time series; buckets aligned at or after comparisonEnd have no comparison values.
+func mergeComparisonTimeSeries(base, comparison *runtimev1.MetricsViewTimeSeriesResponse, align func(time.Time) time.Time, comparisonEnd time.Time) *runtimev1.MetricsViewTimeSeriesResponse {
+	comparisonData := make(map[int64]*structpb.Struct, len(comparison.Data))
+	for _, v := range comparison.Data {
+		comparisonData[v.Ts.AsTime().UnixNano()] = v.Records
+	}
+
+	// The base data may be shared with cached query results, so the records are copied before adding the comparison values
+	data := make([]*runtimev1.TimeSeriesValue, len(base.Data))
+	for i, v := range base.Data {
+		records := proto.Clone(v.Records).(*structpb.Struct)
+		if records.Fields == nil {
+			records.Fields = make(map[string]*structpb.Value)
+		}
+		res := &runtimev1.TimeSeriesValue{
+			Ts:      v.Ts,
+			Bin:     v.Bin,
+			Records: records,
+		}
+
+		var cmpRecords *structpb.Struct
+		cts := align(v.Ts.AsTime())
+		if cts.Before(comparisonEnd) {
+			res.ComparisonTs = timestamppb.New(cts)
+			cmpRecords = comparisonData[cts.UnixNano()]
+		}
+
+		for _, c := range base.Meta {
+			cmp := cmpRecords.GetFields()[c.Name]
+			if cmp == nil {
+				cmp = structpb.NewNullValue()
+			}
+			records.Fields[c.Name+comparisonValueSuffix] = cmp
+			records.Fields[c.Name+deltaAbsSuffix] = structpb.NewNullValue()
+			records.Fields[c.Name+deltaRelSuffix] = structpb.NewNullValue()
+
+			// The deltas follow the convention of the comparison toplist (see comparisonMeasureColumns)
+			b, ok1 := records.Fields[c.Name].GetKind().(*structpb.Value_NumberValue)
+			p, ok2 := cmp.GetKind().(*structpb.Value_NumberValue)
+			if ok1 && ok2 {
+				records.Fields[c.Name+deltaAbsSuffix] = structpb.NewNumberValue(p.NumberValue - b.NumberValue)
+				if b.NumberValue != 0 {
+					records.Fields[c.Name+deltaRelSuffix] = structpb.NewNumberValue((p.NumberValue - b.NumberValue) / b.NumberValue)
+				}
+			}
+		}
+
+		data[i] = res
+	}
+
+	meta := make([]*runtimev1.MetricsViewColumn, 0, len(base.Meta)*4)
+	meta = append(meta, base.Meta...)
+	for _, c := range base.Meta {
+		meta = append(meta,
+			&runtimev1.MetricsViewColumn{Name: c.Name + comparisonValueSuffix, Type: c.Type, Nullable: true},
+			&runtimev1.MetricsViewColumn{Name: c.Name + deltaAbsSuffix, Type: runtimev1.Type_CODE_FLOAT64.String(), Nullable: true},
+			&runtimev1.MetricsViewColumn{Name: c.Name + deltaRelSuffix, Type: runtimev1.Type_CODE_FLOAT64.String(), Nullable: true},
+		)
+	}
+
+	return &runtimev1.MetricsViewTimeSeriesResponse{
+		Meta: meta,
+		Data: data,
+	}
+}
+
+// truncateTime returns the start of the time grain bucket containing t in the given location.
+// Weeks, quarters and years start on the calendar's first day of week and first month of year.
+func truncateTime(t time.Time, grain runtimev1.TimeGrain, loc *time.Location, cal calendar) time.Time {
+	t = t.In(loc)
+	switch grain {
+	case runtimev1.TimeGrain_TIME_GRAIN_MILLISECOND:
+		return t.Truncate(time.Millisecond)
+	case runtimev1.TimeGrain_TIME_GRAIN_SECOND:
+		return t.Truncate(time.Second)
+	case runtimev1.TimeGrain_TIME_GRAIN_MINUTE:
+		return time.Date(t.Year(), t.Month(), t.Day(), t.Hour(), t.Minute(), 0, 0, loc)
+	case runtimev1.TimeGrain_TIME_GRAIN_HOUR:
+		return time.Date(t.Year(), t.Month(), t.Day(), t.Hour(), 0, 0, 0, loc)
+	case runtimev1.TimeGrain_TIME_GRAIN_DAY:
+		return time.Date(t.Year(), t.Month(), t.Day(), 0, 0, 0, 0, loc)
+	case runtimev1.TimeGrain_TIME_GRAIN_WEEK:
+		// days since the start of the week (Weekday counts from Sunday, the calendar shift from Monday)
+		offset := (int(t.Weekday()) + 6 - cal.shift(grain) + 7) % 7
+		return time.Date(t.Year(), t.Month(), t.Day()-offset, 0, 0, 0, 0, loc)
+	case runtimev1.TimeGrain_TIME_GRAIN_MONTH:
+		return time.Date(t.Year(), t.Month(), 1, 0, 0, 0, 0, loc)
+	case runtimev1.TimeGrain_TIME_GRAIN_QUARTER:
+		offset := (int(t.Month()) - 1 - cal.shift(grain) + 12) % 3
+		return time.Date(t.Year(), t.Month()-time.Month(offset), 1, 0, 0, 0, 0, loc)
+	case runtimev1.TimeGrain_TIME_GRAIN_YEAR:
+		offset := (int(t.Month()) - 1 - cal.shift(grain) + 12) % 12
+		return time.Date(t.Year(), t.Month()-time.Month(offset), 1, 0, 0, 0, 0, loc)
+	}
+	return t
+}
+
+// alignComparisonTime returns the start of the bucket in the comparison time range at the same position as the bucket starting at ts in the base time range.
+// Grains shorter than a day have a fixed duration, so the bucket is offset by the difference between the range starts.
+// Longer grains are counted in the calendar of the time zone, so e.g. a day is aligned with a day even if one of them is 23 hours due to DST.
+func alignComparisonTime(ts, baseStart, comparisonStart time.Time, grain runtimev1.TimeGrain, loc *time.Location) time.Time {
+	ts = ts.In(loc)
+	baseStart = baseStart.In(loc)
+	comparisonStart = comparisonStart.In(loc)
+
+	// calendar dates, which are not affected by DST
+	date := func(t time.Time) time.Time {
+		return time.Date(t.Year(), t.Month(), t.Day(), 0, 0, 0, 0, time.UTC)
+	}
+	days := int(date(ts).Sub(date(baseStart)).Hours() / 24)
+	months := (ts.Year()-baseStart.Year())*12 + int(ts.Month()-baseStart.Month())
+
+	switch grain {
+	case runtimev1.TimeGrain_TIME_GRAIN_DAY:
+		return shiftTime(comparisonStart, grain, days)
+	case runtimev1.TimeGrain_TIME_GRAIN_WEEK:
+		return shiftTime(comparisonStart, grain, days/7)
+	case runtimev1.TimeGrain_TIME_GRAIN_MONTH:
+		return shiftTime(comparisonStart, grain, months)
+	case runtimev1.TimeGrain_TIME_GRAIN_QUARTER:
+		return shiftTime(comparisonStart, grain, months/3)
+	case runtimev1.TimeGrain_TIME_GRAIN_YEAR:
+		return shiftTime(comparisonStart, grain, ts.Year()-baseStart.Year())
+	default:
+		return comparisonStart.Add(ts.Sub(baseStart))
+	}
+}
+
 func toColumnTimeseriesMeasures(measures []*runtimev1.MetricsView_Measure) ([]*runtimev1.ColumnTimeSeriesRequest_BasicMeasure, error) {
 	res := make([]*runtimev1.ColumnTimeSeriesRequest_BasicMeasure, len(measures))
 	for i, m := range measures {
@@ -188,8 +369,31 @@ func toColumnTimeseriesMeasures(measures []*runtimev1.MetricsView_Measure) ([]*r
 	return res, nil
 }
 
-func (q *MetricsViewTimeSeries) resolveDruid(ctx context.Context, olap drivers.OLAPStore, mv *runtimev1.MetricsView, priority int) error {
-	sql, tsAlias, args, err := q.buildDruidMetricsTimeseriesSQL(mv)
+// resolveWithTimeFloor resolves the time series with a single query that groups by the truncated time dimension.
+// Unlike resolveDuckDB, it doesn't fill in missing time buckets.
+// Window measures are computed in-memory from the aggregated buckets, which are queried from far enough back to fill the windows.
+func (q *MetricsViewTimeSeries) resolveWithTimeFloor(ctx context.Context, olap drivers.OLAPStore, mv *runtimev1.MetricsView, priority int) error {
+	names, windows, hidden := splitWindowMeasures(mv, q.InlineMeasures, q.MeasureNames)
+
+	timeStart := q.TimeStart
+	loc := time.UTC
+	if len(windows) > 0 {
+		if q.TimeGranularity == runtimev1.TimeGrain_TIME_GRAIN_UNSPECIFIED {
+			return fmt.Errorf("window measures require a time granularity")
+		}
+
+		var err error
+		loc, err = time.LoadLocation(q.TimeZone)
+		if err != nil {
+			return err
+		}
+
+		if q.TimeStart != nil {
+			timeStart = timestamppb.New(shiftTime(q.TimeStart.AsTime().In(loc), q.TimeGranularity, -windowLookback(windows)))
+		}
+	}
+
+	sql, tsAlias, args, err := q.buildMetricsTimeseriesSQL(mv, olap.Dialect(), names, hidden, timeStart)
 	if err != nil {
 		return fmt.Errorf("error building query: %w", err)
 	}
@@ -246,6 +450,14 @@ func (q *MetricsViewTimeSeries) resolveDruid(ctx context.Context, olap drivers.O
 
 	meta := structTypeToMetricsViewColumn(rows.Schema)
 
+	if len(windows) > 0 {
+		data, err = applyWindowMeasures(data, windows, hidden, q.TimeGranularity, loc, q.TimeStart)
+		if err != nil {
+			return err
+		}
+		meta = windowMeasuresMeta(meta, windows, hidden)
+	}
+
 	q.Result = &runtimev1.MetricsViewTimeSeriesResponse{
 		Meta: meta,
 		Data: data,
@@ -254,8 +466,15 @@ func (q *MetricsViewTimeSeries) resolveDruid(ctx context.Context, olap drivers.O
 	return nil
 }
 
-func (q *MetricsViewTimeSeries) buildDruidMetricsTimeseriesSQL(mv *runtimev1.MetricsView) (string, string, []any, error) {
-	ms, err := resolveMeasures(mv, q.InlineMeasures, q.MeasureNames)
+// buildMetricsTimeseriesSQL builds a query for the measures with the given names.
+// The hidden measures are only used as inputs to window measures, but subject to the same access checks as the selected measures.
+func (q *MetricsViewTimeSeries) buildMetricsTimeseriesSQL(mv *runtimev1.MetricsView, dialect drivers.Dialect, names, hidden []string, timeStart *timestamppb.Timestamp) (string, string, []any, error) {
+	ms, err := resolveMeasures(mv, q.InlineMeasures, names)
+	if err != nil {
+		return "", "", nil, err
+	}
+
+	err = checkFieldAccess(mv, q.MetricsViewSecurity, q.Filter, q.Where, q.InlineMeasures, append(slices.Clone(q.MeasureNames), hidden...)...)
 	if err != nil {
 		return "", "", nil, err
 	}
@@ -268,17 +487,17 @@ func (q *MetricsViewTimeSeries) buildDruidMetricsTimeseriesSQL(mv *runtimev1.Met
 
 	whereClause := "1=1"
 	args := []any{}
-	if q.TimeStart != nil {
+	if timeStart != nil {
 		whereClause += fmt.Sprintf(" AND %s >= ?", safeName(mv.TimeDimension))
-		args = append(args, q.TimeStart.AsTime())
+		args = append(args, timeStart.AsTime())
 	}
 	if q.TimeEnd != nil {
 		whereClause += fmt.Sprintf(" AND %s < ?", safeName(mv.TimeDimension))
 		args = append(args, q.TimeEnd.AsTime())
 	}
 
-	if q.Filter != nil {
-		clause, clauseArgs, err := buildFilterClauseForMetricsViewFilter(mv, q.Filter, drivers.DialectDruid)
+	if q.Filter != nil || q.Where != nil || q.MetricsViewSecurity != nil {
+		clause, clauseArgs, err := buildFilterClauseForMetricsViewFilter(mv, q.Filter, q.Where, dialect, q.MetricsViewSecurity)
 		if err != nil {
 			return "", "", nil, err
 		}
@@ -287,17 +506,25 @@ func (q *MetricsViewTimeSeries) buildDruidMetricsTimeseriesSQL(mv *runtimev1.Met
 	}
 
 	tsAlias := tempName("_ts_")
-	tsSpecifier := convertToDruidTimeFloorSpecifier(q.TimeGranularity)
 
 	timezone := "UTC"
 	if q.TimeZone != "" {
 		timezone = q.TimeZone
 	}
-	args = append([]any{timezone}, args...)
+
+	cal := metricsViewCalendar(mv)
+	var timeFloor string
+	if dialect == drivers.DialectClickHouse {
+		timeFloor = clickHouseTimeFloor(safeName(mv.TimeDimension), q.TimeGranularity, cal)
+		args = append([]any{timezone, "UTC"}, args...)
+	} else {
+		timeFloor = druidTimeFloor(safeName(mv.TimeDimension), q.TimeGranularity, cal)
+		args = append(druidTimeFloorArgs(q.TimeGranularity, cal, timezone), args...)
+	}
+
 	sql := fmt.Sprintf(
-		`SELECT time_floor(%s, '%s', null, CAST(? AS VARCHAR)) AS %s, %s FROM %q WHERE %s GROUP BY 1 ORDER BY 1`,
-		safeName(mv.TimeDimension),
-		tsSpecifier,
+		`SELECT %s AS %s, %s FROM %q WHERE %s GROUP BY 1 ORDER BY 1`,
+		timeFloor,
 		tsAlias,
 		strings.Join(selectCols, ", "),
 		mv.Model,
@@ -0,0 +1,175 @@
+package queries
+
+import (
+	"testing"
+	"time"
+
+	runtimev1 "github.com/rilldata/rill/proto/gen/rill/runtime/v1"
+	"github.com/rilldata/rill/runtime/drivers"
+	"github.com/stretchr/testify/require"
+	"google.golang.org/protobuf/types/known/structpb"
+	"google.golang.org/protobuf/types/known/timestamppb"
+)
+
+func Test_truncateTime(t *testing.T) {
+	ny, err := time.LoadLocation("America/New_York")
+	require.NoError(t, err)
+
+	ts := time.Date(2023, 8, 17, 3, 30, 15, 0, time.UTC) // Aug 16 23:30:15 in New York
+	cases := []struct {
+		grain runtimev1.TimeGrain
+		loc   *time.Location
+		want  time.Time
+	}{
+		{runtimev1.TimeGrain_TIME_GRAIN_HOUR, time.UTC, time.Date(2023, 8, 17, 3, 0, 0, 0, time.UTC)},
+		{runtimev1.TimeGrain_TIME_GRAIN_DAY, time.UTC, time.Date(2023, 8, 17, 0, 0, 0, 0, time.UTC)},
+		{runtimev1.TimeGrain_TIME_GRAIN_DAY, ny, time.Date(2023, 8, 16, 0, 0, 0, 0, ny)},
+		{runtimev1.TimeGrain_TIME_GRAIN_WEEK, time.UTC, time.Date(2023, 8, 14, 0, 0, 0, 0, time.UTC)},
+		{runtimev1.TimeGrain_TIME_GRAIN_MONTH, ny, time.Date(2023, 8, 1, 0, 0, 0, 0, ny)},
+		{runtimev1.TimeGrain_TIME_GRAIN_QUARTER, time.UTC, time.Date(2023, 7, 1, 0, 0, 0, 0, time.UTC)},
+		{runtimev1.TimeGrain_TIME_GRAIN_YEAR, time.UTC, time.Date(2023, 1, 1, 0, 0, 0, 0, time.UTC)},
+	}
+	for _, tt := range cases {
+		require.True(t, tt.want.Equal(truncateTime(ts, tt.grain, tt.loc, calendar{})), "%s in %s", tt.grain, tt.loc)
+	}
+}
+
+func Test_truncateTime_calendar(t *testing.T) {
+	// Fiscal years starting in February, weeks starting on Sunday
+	cal := calendar{FirstDayOfWeek: 7, FirstMonthOfYear: 2}
+	cases := []struct {
+		ts    time.Time
+		grain runtimev1.TimeGrain
+		want  time.Time
+	}{
+		{time.Date(2023, 8, 19, 12, 0, 0, 0, time.UTC), runtimev1.TimeGrain_TIME_GRAIN_WEEK, time.Date(2023, 8, 13, 0, 0, 0, 0, time.UTC)},
+		{time.Date(2023, 8, 13, 12, 0, 0, 0, time.UTC), runtimev1.TimeGrain_TIME_GRAIN_WEEK, time.Date(2023, 8, 13, 0, 0, 0, 0, time.UTC)},
+		{time.Date(2023, 8, 17, 0, 0, 0, 0, time.UTC), runtimev1.TimeGrain_TIME_GRAIN_QUARTER, time.Date(2023, 8, 1, 0, 0, 0, 0, time.UTC)},
+		{time.Date(2023, 1, 17, 0, 0, 0, 0, time.UTC), runtimev1.TimeGrain_TIME_GRAIN_QUARTER, time.Date(2022, 11, 1, 0, 0, 0, 0, time.UTC)},
+		{time.Date(2023, 1, 17, 0, 0, 0, 0, time.UTC), runtimev1.TimeGrain_TIME_GRAIN_YEAR, time.Date(2022, 2, 1, 0, 0, 0, 0, time.UTC)},
+		{time.Date(2023, 2, 1, 0, 0, 0, 0, time.UTC), runtimev1.TimeGrain_TIME_GRAIN_YEAR, time.Date(2023, 2, 1, 0, 0, 0, 0, time.UTC)},
+	}
+	for _, tt := range cases {
+		require.Equal(t, tt.want, truncateTime(tt.ts, tt.grain, time.UTC, cal), "%s of %s", tt.grain, tt.ts)
+	}
+}
+
+func Test_timeFloorExpr_calendar(t *testing.T) {
+	cal := calendar{FirstDayOfWeek: 7, FirstMonthOfYear: 2}
+
+	expr, args, err := timeFloorExpr(drivers.DialectDuckDB, `"t"`, runtimev1.TimeGrain_TIME_GRAIN_YEAR, "UTC", cal)
+	require.NoError(t, err)
+	require.Equal(t, `timezone(?, (date_trunc('YEAR', timezone(?, "t"::TIMESTAMPTZ) - INTERVAL 1 MONTH) + INTERVAL 1 MONTH))`, expr)
+	require.Len(t, args, 2)
+
+	expr, args, err = timeFloorExpr(drivers.DialectDruid, `"t"`, runtimev1.TimeGrain_TIME_GRAIN_WEEK, "UTC", cal)
+	require.NoError(t, err)
+	require.Equal(t, `TIME_SHIFT(time_floor(TIME_SHIFT("t", 'P1D', -6, CAST(? AS VARCHAR)), 'P1W', null, CAST(? AS VARCHAR)), 'P1D', 6, CAST(? AS VARCHAR))`, expr)
+	require.Len(t, args, 3)
+
+	expr, _, err = timeFloorExpr(drivers.DialectClickHouse, `"t"`, runtimev1.TimeGrain_TIME_GRAIN_QUARTER, "UTC", cal)
+	require.NoError(t, err)
+	require.Equal(t, `toDateTime64(addMonths(toStartOfInterval(subtractMonths(toDateTime64("t", 3, ?), 1), INTERVAL 1 QUARTER), 1), 3, ?)`, expr)
+
+	// Months are not affected by the calendar
+	expr, _, err = timeFloorExpr(drivers.DialectDuckDB, `"t"`, runtimev1.TimeGrain_TIME_GRAIN_MONTH, "UTC", cal)
+	require.NoError(t, err)
+	require.Equal(t, `timezone(?, date_trunc('MONTH', timezone(?, "t"::TIMESTAMPTZ)))`, expr)
+}
+
+func Test_alignComparisonTime(t *testing.T) {
+	ny, err := time.LoadLocation("America/New_York")
+	require.NoError(t, err)
+
+	// Days across the DST change on 2023-03-12 are compared with the days of the previous week
+	baseStart := time.Date(2023, 3, 11, 0, 0, 0, 0, ny)
+	comparisonStart := time.Date(2023, 3, 4, 0, 0, 0, 0, ny)
+	for d := 0; d < 3; d++ {
+		ts := time.Date(2023, 3, 11+d, 0, 0, 0, 0, ny)
+		got := alignComparisonTime(ts, baseStart, comparisonStart, runtimev1.TimeGrain_TIME_GRAIN_DAY, ny)
+		require.True(t, time.Date(2023, 3, 4+d, 0, 0, 0, 0, ny).Equal(got), got)
+	}
+
+	// Months of different lengths
+	baseStart = time.Date(2023, 1, 1, 0, 0, 0, 0, time.UTC)
+	comparisonStart = time.Date(2022, 1, 1, 0, 0, 0, 0, time.UTC)
+	got := alignComparisonTime(time.Date(2023, 3, 1, 0, 0, 0, 0, time.UTC), baseStart, comparisonStart, runtimev1.TimeGrain_TIME_GRAIN_MONTH, time.UTC)
+	require.Equal(t, time.Date(2022, 3, 1, 0, 0, 0, 0, time.UTC), got)
+
+	// Hours are offset by the difference between the range starts
+	baseStart = time.Date(2023, 1, 2, 0, 0, 0, 0, time.UTC)
+	comparisonStart = time.Date(2023, 1, 1, 0, 0, 0, 0, time.UTC)
+	got = alignComparisonTime(time.Date(2023, 1, 2, 5, 0, 0, 0, time.UTC), baseStart, comparisonStart, runtimev1.TimeGrain_TIME_GRAIN_HOUR, time.UTC)
+	require.Equal(t, time.Date(2023, 1, 1, 5, 0, 0, 0, time.UTC), got)
+}
+
+func Test_mergeComparisonTimeSeries(t *testing.T) {
+	day := func(d int) *timestamppb.Timestamp {
+		return timestamppb.New(time.Date(2023, 1, d, 0, 0, 0, 0, time.UTC))
+	}
+	row := func(d int, clicks float64) *runtimev1.TimeSeriesValue {
+		return &runtimev1.TimeSeriesValue{
+			Ts:      day(d),
+			Records: &structpb.Struct{Fields: map[string]*structpb.Value{"clicks": structpb.NewNumberValue(clicks)}},
+		}
+	}
+	meta := []*runtimev1.MetricsViewColumn{{Name: "clicks", Type: runtimev1.Type_CODE_FLOAT64.String()}}
+
+	base := &runtimev1.MetricsViewTimeSeriesResponse{
+		Meta: meta,
+		Data: []*runtimev1.TimeSeriesValue{row(8, 10), row(9, 5), row(10, 3)},
+	}
+	// Jan 2 is missing and the comparison range ends before the last base bucket is aligned
+	comparison := &runtimev1.MetricsViewTimeSeriesResponse{
+		Meta: meta,
+		Data: []*runtimev1.TimeSeriesValue{row(1, 0)},
+	}
+	align := func(ts time.Time) time.Time {
+		return ts.AddDate(0, 0, -7)
+	}
+
+	res := mergeComparisonTimeSeries(base, comparison, align, day(3).AsTime())
+	require.Len(t, res.Meta, 4)
+	require.Equal(t, "clicks__comparison", res.Meta[1].Name)
+	require.Len(t, res.Data, 3)
+
+	r := res.Data[0].Records.Fields
+	require.Equal(t, day(1).AsTime(), res.Data[0].ComparisonTs.AsTime())
+	require.Equal(t, 0.0, r["clicks__comparison"].GetNumberValue())
+	require.Equal(t, -10.0, r["clicks__delta_abs"].GetNumberValue())
+	require.Equal(t, -1.0, r["clicks__delta_rel"].GetNumberValue())
+
+	r = res.Data[1].Records.Fields
+	require.Equal(t, day(2).AsTime(), res.Data[1].ComparisonTs.AsTime())
+	require.IsType(t, &structpb.Value_NullValue{}, r["clicks__comparison"].Kind)
+	require.IsType(t, &structpb.Value_NullValue{}, r["clicks__delta_abs"].Kind)
+
+	require.Nil(t, res.Data[2].ComparisonTs)
+
+	// The base records are not modified
+	require.Len(t, base.Data[0].Records.Fields, 1)
+}
+
+func Test_mergeComparisonTimeSeries_deltaSign(t *testing.T) {
+	ts := timestamppb.New(time.Date(2023, 1, 8, 0, 0, 0, 0, time.UTC))
+	meta := []*runtimev1.MetricsViewColumn{{Name: "clicks", Type: runtimev1.Type_CODE_FLOAT64.String()}}
+	base := &runtimev1.MetricsViewTimeSeriesResponse{
+		Meta: meta,
+		Data: []*runtimev1.TimeSeriesValue{{Ts: ts, Records: &structpb.Struct{Fields: map[string]*structpb.Value{"clicks": structpb.NewNumberValue(4)}}}},
+	}
+	comparison := &runtimev1.MetricsViewTimeSeriesResponse{
+		Meta: meta,
+		Data: []*runtimev1.TimeSeriesValue{{Ts: ts, Records: &structpb.Struct{Fields: map[string]*structpb.Value{"clicks": structpb.NewNumberValue(10)}}}},
+	}
+	align := func(ts time.Time) time.Time { return ts }
+
+	res := mergeComparisonTimeSeries(base, comparison, align, ts.AsTime().Add(time.Hour))
+	r := res.Data[0].Records.Fields
+	require.Equal(t, 6.0, r["clicks__delta_abs"].GetNumberValue())
+	require.Equal(t, 1.5, r["clicks__delta_rel"].GetNumberValue())
+
+	// The comparison toplist (and its having filter) uses the same convention
+	cols := comparisonMeasureColumns(drivers.DialectDuckDB, "clicks")
+	require.Equal(t, `comparison."clicks" - base."clicks"`, cols[2])
+	require.Equal(t, `(comparison."clicks" - base."clicks")/base."clicks"::DOUBLE`, cols[3])
+}
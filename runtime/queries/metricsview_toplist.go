@@ -14,16 +14,19 @@ import (
 )
 
 type MetricsViewToplist struct {
-	MetricsViewName string                       `json:"metrics_view_name,omitempty"`
-	DimensionName   string                       `json:"dimension_name,omitempty"`
-	MeasureNames    []string                     `json:"measure_names,omitempty"`
-	InlineMeasures  []*runtimev1.InlineMeasure   `json:"inline_measures,omitempty"`
-	TimeStart       *timestamppb.Timestamp       `json:"time_start,omitempty"`
-	TimeEnd         *timestamppb.Timestamp       `json:"time_end,omitempty"`
-	Limit           *int64                       `json:"limit,omitempty"`
-	Offset          int64                        `json:"offset,omitempty"`
-	Sort            []*runtimev1.MetricsViewSort `json:"sort,omitempty"`
-	Filter          *runtimev1.MetricsViewFilter `json:"filter,omitempty"`
+	MetricsViewName     string                               `json:"metrics_view_name,omitempty"`
+	DimensionName       string                               `json:"dimension_name,omitempty"`
+	MeasureNames        []string                             `json:"measure_names,omitempty"`
+	InlineMeasures      []*runtimev1.InlineMeasure           `json:"inline_measures,omitempty"`
+	TimeStart           *timestamppb.Timestamp               `json:"time_start,omitempty"`
+	TimeEnd             *timestamppb.Timestamp               `json:"time_end,omitempty"`
+	Limit               *int64                               `json:"limit,omitempty"`
+	Offset              int64                                `json:"offset,omitempty"`
+	Sort                []*runtimev1.MetricsViewSort         `json:"sort,omitempty"`
+	Filter              *runtimev1.MetricsViewFilter         `json:"filter,omitempty"`
+	Where               *runtimev1.Expression                `json:"where,omitempty"`
+	Having              *runtimev1.Expression                `json:"having,omitempty"`
+	MetricsViewSecurity *runtime.ResolvedMetricsViewSecurity `json:"security,omitempty"`
 
 	Result *runtimev1.MetricsViewToplistResponse `json:"-"`
 }
@@ -65,7 +68,7 @@ func (q *MetricsViewToplist) Resolve(ctx context.Context, rt *runtime.Runtime, i
 	}
 	defer release()
 
-	if olap.Dialect() != drivers.DialectDuckDB && olap.Dialect() != drivers.DialectDruid {
+	if olap.Dialect() != drivers.DialectDuckDB && olap.Dialect() != drivers.DialectDruid && olap.Dialect() != drivers.DialectClickHouse {
 		return fmt.Errorf("not available for dialect '%s'", olap.Dialect())
 	}
 
@@ -105,73 +108,37 @@ func (q *MetricsViewToplist) Export(ctx context.Context, rt *runtime.Runtime, in
 	}
 	defer release()
 
+	if olap.Dialect() != drivers.DialectDuckDB && olap.Dialect() != drivers.DialectDruid && olap.Dialect() != drivers.DialectClickHouse {
+		return fmt.Errorf("not available for dialect '%s'", olap.Dialect())
+	}
+
 	mv, err := lookupMetricsView(ctx, rt, instanceID, q.MetricsViewName)
 	if err != nil {
 		return err
 	}
 
-	switch olap.Dialect() {
-	case drivers.DialectDuckDB:
-		if opts.Format == runtimev1.ExportFormat_EXPORT_FORMAT_CSV || opts.Format == runtimev1.ExportFormat_EXPORT_FORMAT_PARQUET {
-			if mv.TimeDimension == "" && (q.TimeStart != nil || q.TimeEnd != nil) {
-				return fmt.Errorf("metrics view '%s' does not have a time dimension", q.MetricsViewName)
-			}
-
-			sql, args, err := q.buildMetricsTopListSQL(mv, olap.Dialect())
-			if err != nil {
-				return err
-			}
-
-			filename := q.generateFilename(mv)
-			if err := duckDBCopyExport(ctx, rt, instanceID, w, opts, sql, args, filename, olap, mv, opts.Format); err != nil {
-				return err
-			}
-		} else {
-			if err := q.generalExport(ctx, rt, instanceID, w, opts, olap, mv); err != nil {
-				return err
-			}
-		}
-	case drivers.DialectDruid:
-		if err := q.generalExport(ctx, rt, instanceID, w, opts, olap, mv); err != nil {
-			return err
-		}
-	default:
-		return fmt.Errorf("not available for dialect '%s'", olap.Dialect())
+	if mv.TimeDimension == "" && (q.TimeStart != nil || q.TimeEnd != nil) {
+		return fmt.Errorf("metrics view '%s' does not have a time dimension", q.MetricsViewName)
 	}
 
-	return nil
-}
-
-func (q *MetricsViewToplist) generalExport(ctx context.Context, rt *runtime.Runtime, instanceID string, w io.Writer, opts *runtime.ExportOptions, olap drivers.OLAPStore, mv *runtimev1.MetricsView) error {
-	err := q.Resolve(ctx, rt, instanceID, opts.Priority)
+	sql, args, err := q.buildMetricsTopListSQL(mv, olap.Dialect())
 	if err != nil {
 		return err
 	}
 
-	if opts.PreWriteHook != nil {
-		err = opts.PreWriteHook(q.generateFilename(mv))
-		if err != nil {
-			return err
-		}
-	}
+	filename := q.generateFilename(mv)
 
-	switch opts.Format {
-	case runtimev1.ExportFormat_EXPORT_FORMAT_UNSPECIFIED:
-		return fmt.Errorf("unspecified format")
-	case runtimev1.ExportFormat_EXPORT_FORMAT_CSV:
-		return writeCSV(q.Result.Meta, q.Result.Data, w)
-	case runtimev1.ExportFormat_EXPORT_FORMAT_XLSX:
-		return writeXLSX(q.Result.Meta, q.Result.Data, w)
-	case runtimev1.ExportFormat_EXPORT_FORMAT_PARQUET:
-		return writeParquet(q.Result.Meta, q.Result.Data, w)
+	// DuckDB can write CSV and Parquet files natively
+	if olap.Dialect() == drivers.DialectDuckDB && (opts.Format == runtimev1.ExportFormat_EXPORT_FORMAT_CSV || opts.Format == runtimev1.ExportFormat_EXPORT_FORMAT_PARQUET) {
+		return duckDBCopyExport(ctx, rt, instanceID, w, opts, sql, args, filename, olap, mv, opts.Format)
 	}
 
-	return nil
+	return exportQuery(ctx, rt, instanceID, olap, w, opts, filename, sql, args)
 }
 
 func (q *MetricsViewToplist) generateFilename(mv *runtimev1.MetricsView) string {
 	filename := strings.ReplaceAll(mv.Model, `"`, `_`)
-	if q.TimeStart != nil || q.TimeEnd != nil || q.Filter != nil && (len(q.Filter.Include) > 0 || len(q.Filter.Exclude) > 0) {
+	if q.TimeStart != nil || q.TimeEnd != nil || q.Where != nil || q.Filter != nil && (len(q.Filter.Include) > 0 || len(q.Filter.Exclude) > 0) {
 		filename += "_filtered"
 	}
 	return filename
@@ -183,6 +150,15 @@ func (q *MetricsViewToplist) buildMetricsTopListSQL(mv *runtimev1.MetricsView, d
 		return "", nil, err
 	}
 
+	names := appendExpressionIdentifiers(append([]string{q.DimensionName}, q.MeasureNames...), q.Having)
+	for _, s := range q.Sort {
+		names = append(names, s.Name)
+	}
+	err = checkFieldAccess(mv, q.MetricsViewSecurity, q.Filter, q.Where, q.InlineMeasures, names...)
+	if err != nil {
+		return "", nil, err
+	}
+
 	colName, err := metricsViewDimensionToSafeColumn(mv, q.DimensionName)
 	if err != nil {
 		return "", nil, err
@@ -207,8 +183,8 @@ func (q *MetricsViewToplist) buildMetricsTopListSQL(mv *runtimev1.MetricsView, d
 		}
 	}
 
-	if q.Filter != nil {
-		clause, clauseArgs, err := buildFilterClauseForMetricsViewFilter(mv, q.Filter, dialect)
+	if q.Filter != nil || q.Where != nil || q.MetricsViewSecurity != nil {
+		clause, clauseArgs, err := buildFilterClauseForMetricsViewFilter(mv, q.Filter, q.Where, dialect, q.MetricsViewSecurity)
 		if err != nil {
 			return "", nil, err
 		}
@@ -216,13 +192,23 @@ func (q *MetricsViewToplist) buildMetricsTopListSQL(mv *runtimev1.MetricsView, d
 		args = append(args, clauseArgs...)
 	}
 
+	havingClause := ""
+	if q.Having != nil {
+		clause, clauseArgs, err := buildHavingExpression(mv, q.InlineMeasures, q.Having, dialect)
+		if err != nil {
+			return "", nil, err
+		}
+		havingClause = "HAVING " + clause
+		args = append(args, clauseArgs...)
+	}
+
 	sortingCriteria := make([]string, 0, len(q.Sort))
 	for _, s := range q.Sort {
 		sortCriterion := safeName(s.Name)
 		if !s.Ascending {
 			sortCriterion += " DESC"
 		}
-		if dialect == drivers.DialectDuckDB {
+		if dialect == drivers.DialectDuckDB || dialect == drivers.DialectClickHouse {
 			sortCriterion += " NULLS LAST"
 		}
 		sortingCriteria = append(sortingCriteria, sortCriterion)
@@ -240,11 +226,12 @@ func (q *MetricsViewToplist) buildMetricsTopListSQL(mv *runtimev1.MetricsView, d
 		limitClause = fmt.Sprintf("LIMIT %d", *q.Limit)
 	}
 
-	sql := fmt.Sprintf("SELECT %s FROM %q WHERE %s GROUP BY %s %s %s OFFSET %d",
+	sql := fmt.Sprintf("SELECT %s FROM %q WHERE %s GROUP BY %s %s %s %s OFFSET %d",
 		strings.Join(selectCols, ", "),
 		mv.Model,
 		whereClause,
 		colName,
+		havingClause,
 		orderClause,
 		limitClause,
 		q.Offset,
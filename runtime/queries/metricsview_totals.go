@@ -14,12 +14,14 @@ import (
 )
 
 type MetricsViewTotals struct {
-	MetricsViewName string                       `json:"metrics_view_name,omitempty"`
-	MeasureNames    []string                     `json:"measure_names,omitempty"`
-	InlineMeasures  []*runtimev1.InlineMeasure   `json:"inline_measures,omitempty"`
-	TimeStart       *timestamppb.Timestamp       `json:"time_start,omitempty"`
-	TimeEnd         *timestamppb.Timestamp       `json:"time_end,omitempty"`
-	Filter          *runtimev1.MetricsViewFilter `json:"filter,omitempty"`
+	MetricsViewName     string                               `json:"metrics_view_name,omitempty"`
+	MeasureNames        []string                             `json:"measure_names,omitempty"`
+	InlineMeasures      []*runtimev1.InlineMeasure           `json:"inline_measures,omitempty"`
+	TimeStart           *timestamppb.Timestamp               `json:"time_start,omitempty"`
+	TimeEnd             *timestamppb.Timestamp               `json:"time_end,omitempty"`
+	Filter              *runtimev1.MetricsViewFilter         `json:"filter,omitempty"`
+	Where               *runtimev1.Expression                `json:"where,omitempty"`
+	MetricsViewSecurity *runtime.ResolvedMetricsViewSecurity `json:"security,omitempty"`
 
 	Result *runtimev1.MetricsViewTotalsResponse `json:"-"`
 }
@@ -61,7 +63,7 @@ func (q *MetricsViewTotals) Resolve(ctx context.Context, rt *runtime.Runtime, in
 	}
 	defer release()
 
-	if olap.Dialect() != drivers.DialectDuckDB && olap.Dialect() != drivers.DialectDruid {
+	if olap.Dialect() != drivers.DialectDuckDB && olap.Dialect() != drivers.DialectDruid && olap.Dialect() != drivers.DialectClickHouse {
 		return fmt.Errorf("not available for dialect '%s'", olap.Dialect())
 	}
 
@@ -106,6 +108,11 @@ func (q *MetricsViewTotals) buildMetricsTotalsSQL(mv *runtimev1.MetricsView, dia
 		return "", nil, err
 	}
 
+	err = checkFieldAccess(mv, q.MetricsViewSecurity, q.Filter, q.Where, q.InlineMeasures, q.MeasureNames...)
+	if err != nil {
+		return "", nil, err
+	}
+
 	selectCols := []string{}
 	for _, m := range ms {
 		expr := fmt.Sprintf(`%s as "%s"`, m.Expression, m.Name)
@@ -125,8 +132,8 @@ func (q *MetricsViewTotals) buildMetricsTotalsSQL(mv *runtimev1.MetricsView, dia
 		}
 	}
 
-	if q.Filter != nil {
-		clause, clauseArgs, err := buildFilterClauseForMetricsViewFilter(mv, q.Filter, dialect)
+	if q.Filter != nil || q.Where != nil || q.MetricsViewSecurity != nil {
+		clause, clauseArgs, err := buildFilterClauseForMetricsViewFilter(mv, q.Filter, q.Where, dialect, q.MetricsViewSecurity)
 		if err != nil {
 			return "", nil, err
 		}
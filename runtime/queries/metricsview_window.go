@@ -0,0 +1,269 @@
+package queries
+
+import (
+	"fmt"
+	"strings"
+	"time"
+
+	runtimev1 "github.com/rilldata/rill/proto/gen/rill/runtime/v1"
+	"golang.org/x/exp/slices"
+	"google.golang.org/protobuf/types/known/structpb"
+	"google.golang.org/protobuf/types/known/timestamppb"
+)
+
+// splitWindowMeasures separates the window measures from the selected measure names.
+// It returns the names of the measures to aggregate (including the base measures of the window measures),
+// the selected window measures, and the base measures that were not selected and must be omitted from the result.
+func splitWindowMeasures(mv *runtimev1.MetricsView, inlines []*runtimev1.InlineMeasure, names []string) ([]string, []*runtimev1.MetricsView_Measure, []string) {
+	var aggNames []string
+	var windows []*runtimev1.MetricsView_Measure
+	for _, n := range names {
+		if m := lookupWindowMeasure(mv, inlines, n); m != nil {
+			windows = append(windows, m)
+			continue
+		}
+		aggNames = append(aggNames, n)
+	}
+
+	var hidden []string
+	for _, m := range windows {
+		if !slices.Contains(aggNames, m.Window.Measure) {
+			aggNames = append(aggNames, m.Window.Measure)
+			hidden = append(hidden, m.Window.Measure)
+		}
+	}
+
+	return aggNames, windows, hidden
+}
+
+// lookupWindowMeasure returns the window measure with the given name or nil if it isn't a window measure.
+// Inline measures take precedence and are never window measures.
+func lookupWindowMeasure(mv *runtimev1.MetricsView, inlines []*runtimev1.InlineMeasure, name string) *runtimev1.MetricsView_Measure {
+	for _, m := range inlines {
+		if m.Name == name {
+			return nil
+		}
+	}
+	for _, m := range mv.Measures {
+		if m.Name == name && m.Window != nil {
+			return m
+		}
+	}
+	return nil
+}
+
+// windowLookback returns the number of time buckets before the requested time range needed to compute the window measures.
+func windowLookback(windows []*runtimev1.MetricsView_Measure) int {
+	lookback := 0
+	for _, m := range windows {
+		n := 0
+		switch m.Window.Type {
+		case runtimev1.MetricsView_MeasureWindow_TYPE_ROLLING_SUM, runtimev1.MetricsView_MeasureWindow_TYPE_ROLLING_AVG:
+			n = int(m.Window.Size) - 1
+		case runtimev1.MetricsView_MeasureWindow_TYPE_LAG, runtimev1.MetricsView_MeasureWindow_TYPE_DELTA_ABS, runtimev1.MetricsView_MeasureWindow_TYPE_DELTA_REL:
+			n = int(m.Window.Size)
+		}
+		if n > lookback {
+			lookback = n
+		}
+	}
+	return lookback
+}
+
+// windowMeasureSQL returns the window function expression for a window measure over a time series ordered by tsAlias.
+// Cumulative sums must be evaluated after the lookback buckets have been filtered out.
+func windowMeasureSQL(w *runtimev1.MetricsView_MeasureWindow, tsAlias string) (string, error) {
+	col := safeName(w.Measure)
+	over := fmt.Sprintf("OVER (ORDER BY %s)", tsAlias)
+	lag := fmt.Sprintf("LAG(%s, %d) %s", col, w.Size, over)
+	switch w.Type {
+	case runtimev1.MetricsView_MeasureWindow_TYPE_ROLLING_SUM:
+		return fmt.Sprintf("SUM(%s) OVER (ORDER BY %s ROWS BETWEEN %d PRECEDING AND CURRENT ROW)", col, tsAlias, w.Size-1), nil
+	case runtimev1.MetricsView_MeasureWindow_TYPE_ROLLING_AVG:
+		return fmt.Sprintf("AVG(%s) OVER (ORDER BY %s ROWS BETWEEN %d PRECEDING AND CURRENT ROW)", col, tsAlias, w.Size-1), nil
+	case runtimev1.MetricsView_MeasureWindow_TYPE_CUMULATIVE_SUM:
+		return fmt.Sprintf("SUM(%s) OVER (ORDER BY %s ROWS BETWEEN UNBOUNDED PRECEDING AND CURRENT ROW)", col, tsAlias), nil
+	case runtimev1.MetricsView_MeasureWindow_TYPE_LAG:
+		return lag, nil
+	case runtimev1.MetricsView_MeasureWindow_TYPE_DELTA_ABS:
+		return fmt.Sprintf("%s - %s", col, lag), nil
+	case runtimev1.MetricsView_MeasureWindow_TYPE_DELTA_REL:
+		return fmt.Sprintf("(%s - %s)/NULLIF(%s, 0)::DOUBLE", col, lag, lag), nil
+	default:
+		return "", fmt.Errorf("unsupported window type %q", w.Type)
+	}
+}
+
+// buildWindowMeasuresSQL wraps a time series query with one row per time bucket (including the lookback buckets) and computes the window measures.
+// The lookback buckets before the start of the time range and the hidden base measures are omitted from the result.
+func buildWindowMeasuresSQL(inner, tsAlias string, measures []*runtimev1.ColumnTimeSeriesRequest_BasicMeasure, windows []*runtimev1.MetricsView_Measure, hidden []string, grain runtimev1.TimeGrain, cal calendar, timezone string, start *timestamppb.Timestamp) (string, []any, error) {
+	innerCols := []string{tsAlias}
+	outerCols := []string{tsAlias}
+	for _, m := range measures {
+		innerCols = append(innerCols, safeName(m.SqlName))
+		if !slices.Contains(hidden, m.SqlName) {
+			outerCols = append(outerCols, safeName(m.SqlName))
+		}
+	}
+
+	for _, m := range windows {
+		expr, err := windowMeasureSQL(m.Window, tsAlias)
+		if err != nil {
+			return "", nil, err
+		}
+		if m.Window.Type == runtimev1.MetricsView_MeasureWindow_TYPE_CUMULATIVE_SUM {
+			outerCols = append(outerCols, fmt.Sprintf("%s AS %s", expr, safeName(m.Name)))
+		} else {
+			innerCols = append(innerCols, fmt.Sprintf("%s AS %s", expr, safeName(m.Name)))
+			outerCols = append(outerCols, safeName(m.Name))
+		}
+	}
+
+	sql := fmt.Sprintf(
+		`SELECT %s FROM (SELECT %s FROM (%s)) WHERE %s >= timezone(?, %s) ORDER BY %s`,
+		strings.Join(outerCols, ", "),
+		strings.Join(innerCols, ", "),
+		inner,
+		tsAlias,
+		dateTruncExpr("timezone(?, ?::TIMESTAMPTZ)", grain, cal),
+		tsAlias,
+	)
+	return sql, []any{timezone, timezone, start.AsTime()}, nil
+}
+
+// applyWindowMeasures computes window measures in-memory over time series data that includes the lookback buckets.
+// It's used for OLAP stores that don't fill in missing time buckets, so windows are computed over bucket timestamps instead of rows.
+// Buckets ending before start and the hidden base measures are omitted from the result.
+func applyWindowMeasures(data []*runtimev1.TimeSeriesValue, windows []*runtimev1.MetricsView_Measure, hidden []string, grain runtimev1.TimeGrain, loc *time.Location, start *timestamppb.Timestamp) ([]*runtimev1.TimeSeriesValue, error) {
+	idx := make(map[int64]int, len(data))
+	for i, v := range data {
+		idx[v.Ts.AsTime().UnixNano()] = i
+	}
+
+	value := func(i int, name string) (float64, bool) {
+		v, ok := data[i].Records.Fields[name].GetKind().(*structpb.Value_NumberValue)
+		if !ok {
+			return 0, false
+		}
+		return v.NumberValue, true
+	}
+	lookup := func(t time.Time, n int, name string) (float64, bool) {
+		i, ok := idx[shiftTime(t.In(loc), grain, -n).UnixNano()]
+		if !ok {
+			return 0, false
+		}
+		return value(i, name)
+	}
+
+	var res []*runtimev1.TimeSeriesValue
+	cumulative := make(map[string]float64)
+	for i, v := range data {
+		t := v.Ts.AsTime()
+		if start != nil && !shiftTime(t.In(loc), grain, 1).After(start.AsTime()) {
+			continue
+		}
+
+		fields := make(map[string]*structpb.Value, len(windows))
+		for _, m := range windows {
+			w := m.Window
+			size := int(w.Size)
+			val := structpb.NewNullValue()
+			switch w.Type {
+			case runtimev1.MetricsView_MeasureWindow_TYPE_ROLLING_SUM, runtimev1.MetricsView_MeasureWindow_TYPE_ROLLING_AVG:
+				var sum float64
+				var n int
+				for k := 0; k < size; k++ {
+					if x, ok := lookup(t, k, w.Measure); ok {
+						sum += x
+						n++
+					}
+				}
+				if n > 0 && w.Type == runtimev1.MetricsView_MeasureWindow_TYPE_ROLLING_SUM {
+					val = structpb.NewNumberValue(sum)
+				} else if n > 0 {
+					val = structpb.NewNumberValue(sum / float64(n))
+				}
+			case runtimev1.MetricsView_MeasureWindow_TYPE_CUMULATIVE_SUM:
+				if x, ok := value(i, w.Measure); ok {
+					cumulative[m.Name] += x
+				}
+				val = structpb.NewNumberValue(cumulative[m.Name])
+			case runtimev1.MetricsView_MeasureWindow_TYPE_LAG:
+				if prev, ok := lookup(t, size, w.Measure); ok {
+					val = structpb.NewNumberValue(prev)
+				}
+			case runtimev1.MetricsView_MeasureWindow_TYPE_DELTA_ABS:
+				cur, ok1 := value(i, w.Measure)
+				prev, ok2 := lookup(t, size, w.Measure)
+				if ok1 && ok2 {
+					val = structpb.NewNumberValue(cur - prev)
+				}
+			case runtimev1.MetricsView_MeasureWindow_TYPE_DELTA_REL:
+				cur, ok1 := value(i, w.Measure)
+				prev, ok2 := lookup(t, size, w.Measure)
+				if ok1 && ok2 && prev != 0 {
+					val = structpb.NewNumberValue((cur - prev) / prev)
+				}
+			default:
+				return nil, fmt.Errorf("unsupported window type %q", w.Type)
+			}
+			fields[m.Name] = val
+		}
+
+		for name, val := range fields {
+			v.Records.Fields[name] = val
+		}
+		res = append(res, v)
+	}
+
+	for _, v := range res {
+		for _, name := range hidden {
+			delete(v.Records.Fields, name)
+		}
+	}
+
+	return res, nil
+}
+
+// windowMeasuresMeta adds the window measures to the result schema and removes the hidden base measures.
+func windowMeasuresMeta(meta []*runtimev1.MetricsViewColumn, windows []*runtimev1.MetricsView_Measure, hidden []string) []*runtimev1.MetricsViewColumn {
+	res := make([]*runtimev1.MetricsViewColumn, 0, len(meta)+len(windows))
+	for _, c := range meta {
+		if !slices.Contains(hidden, c.Name) {
+			res = append(res, c)
+		}
+	}
+	for _, m := range windows {
+		res = append(res, &runtimev1.MetricsViewColumn{
+			Name:     m.Name,
+			Type:     runtimev1.Type_CODE_FLOAT64.String(),
+			Nullable: true,
+		})
+	}
+	return res
+}
+
+// shiftTime moves t by n time grains. For grains of a day or longer, t should be in the time zone of the time series.
+func shiftTime(t time.Time, grain runtimev1.TimeGrain, n int) time.Time {
+	switch grain {
+	case runtimev1.TimeGrain_TIME_GRAIN_MILLISECOND:
+		return t.Add(time.Duration(n) * time.Millisecond)
+	case runtimev1.TimeGrain_TIME_GRAIN_SECOND:
+		return t.Add(time.Duration(n) * time.Second)
+	case runtimev1.TimeGrain_TIME_GRAIN_MINUTE:
+		return t.Add(time.Duration(n) * time.Minute)
+	case runtimev1.TimeGrain_TIME_GRAIN_HOUR:
+		return t.Add(time.Duration(n) * time.Hour)
+	case runtimev1.TimeGrain_TIME_GRAIN_DAY:
+		return t.AddDate(0, 0, n)
+	case runtimev1.TimeGrain_TIME_GRAIN_WEEK:
+		return t.AddDate(0, 0, 7*n)
+	case runtimev1.TimeGrain_TIME_GRAIN_MONTH:
+		return t.AddDate(0, n, 0)
+	case runtimev1.TimeGrain_TIME_GRAIN_QUARTER:
+		return t.AddDate(0, 3*n, 0)
+	case runtimev1.TimeGrain_TIME_GRAIN_YEAR:
+		return t.AddDate(n, 0, 0)
+	}
+	return t
+}
@@ -0,0 +1,82 @@
+package queries
+
+import (
+	"testing"
+	"time"
+
+	runtimev1 "github.com/rilldata/rill/proto/gen/rill/runtime/v1"
+	"github.com/stretchr/testify/require"
+	"google.golang.org/protobuf/types/known/structpb"
+	"google.golang.org/protobuf/types/known/timestamppb"
+)
+
+func Test_splitWindowMeasures(t *testing.T) {
+	mv := &runtimev1.MetricsView{
+		Measures: []*runtimev1.MetricsView_Measure{
+			{Name: "clicks", Expression: "sum(clicks)"},
+			{Name: "impressions", Expression: "sum(impressions)"},
+			{Name: "clicks_7d", Window: &runtimev1.MetricsView_MeasureWindow{Measure: "clicks", Type: runtimev1.MetricsView_MeasureWindow_TYPE_ROLLING_AVG, Size: 7}},
+			{Name: "impressions_wow", Window: &runtimev1.MetricsView_MeasureWindow{Measure: "impressions", Type: runtimev1.MetricsView_MeasureWindow_TYPE_DELTA_REL, Size: 7}},
+		},
+	}
+
+	names, windows, hidden := splitWindowMeasures(mv, nil, []string{"clicks", "clicks_7d", "impressions_wow"})
+	require.Equal(t, []string{"clicks", "impressions"}, names)
+	require.Len(t, windows, 2)
+	require.Equal(t, []string{"impressions"}, hidden)
+	require.Equal(t, 7, windowLookback(windows))
+}
+
+func Test_buildWindowMeasuresSQL(t *testing.T) {
+	measures := []*runtimev1.ColumnTimeSeriesRequest_BasicMeasure{
+		{SqlName: "clicks", Expression: "sum(clicks)"},
+	}
+	windows := []*runtimev1.MetricsView_Measure{
+		{Name: "clicks_3d", Window: &runtimev1.MetricsView_MeasureWindow{Measure: "clicks", Type: runtimev1.MetricsView_MeasureWindow_TYPE_ROLLING_SUM, Size: 3}},
+		{Name: "clicks_total", Window: &runtimev1.MetricsView_MeasureWindow{Measure: "clicks", Type: runtimev1.MetricsView_MeasureWindow_TYPE_CUMULATIVE_SUM}},
+	}
+
+	sql, args, err := buildWindowMeasuresSQL("SELECT 1", "ts", measures, windows, []string{"clicks"}, runtimev1.TimeGrain_TIME_GRAIN_DAY, calendar{}, "UTC", timestamppb.Now())
+	require.NoError(t, err)
+	require.Equal(t, `SELECT ts, "clicks_3d", SUM("clicks") OVER (ORDER BY ts ROWS BETWEEN UNBOUNDED PRECEDING AND CURRENT ROW) AS "clicks_total" FROM (SELECT ts, "clicks", SUM("clicks") OVER (ORDER BY ts ROWS BETWEEN 2 PRECEDING AND CURRENT ROW) AS "clicks_3d" FROM (SELECT 1)) WHERE ts >= timezone(?, date_trunc('DAY', timezone(?, ?::TIMESTAMPTZ))) ORDER BY ts`, sql)
+	require.Len(t, args, 3)
+}
+
+func Test_applyWindowMeasures(t *testing.T) {
+	day := func(d int) *timestamppb.Timestamp {
+		return timestamppb.New(time.Date(2023, 1, d, 0, 0, 0, 0, time.UTC))
+	}
+	row := func(d int, clicks float64) *runtimev1.TimeSeriesValue {
+		return &runtimev1.TimeSeriesValue{
+			Ts:      day(d),
+			Records: &structpb.Struct{Fields: map[string]*structpb.Value{"clicks": structpb.NewNumberValue(clicks)}},
+		}
+	}
+
+	// Jan 3 is missing, Jan 1 is lookback data
+	data := []*runtimev1.TimeSeriesValue{row(1, 1), row(2, 2), row(4, 4), row(5, 5)}
+	windows := []*runtimev1.MetricsView_Measure{
+		{Name: "rolling", Window: &runtimev1.MetricsView_MeasureWindow{Measure: "clicks", Type: runtimev1.MetricsView_MeasureWindow_TYPE_ROLLING_SUM, Size: 2}},
+		{Name: "cumulative", Window: &runtimev1.MetricsView_MeasureWindow{Measure: "clicks", Type: runtimev1.MetricsView_MeasureWindow_TYPE_CUMULATIVE_SUM}},
+		{Name: "delta", Window: &runtimev1.MetricsView_MeasureWindow{Measure: "clicks", Type: runtimev1.MetricsView_MeasureWindow_TYPE_DELTA_ABS, Size: 1}},
+	}
+
+	res, err := applyWindowMeasures(data, windows, []string{"clicks"}, runtimev1.TimeGrain_TIME_GRAIN_DAY, time.UTC, day(2))
+	require.NoError(t, err)
+	require.Len(t, res, 3)
+
+	require.Equal(t, day(2).AsTime(), res[0].Ts.AsTime())
+	require.Nil(t, res[0].Records.Fields["clicks"])
+	require.Equal(t, 3.0, res[0].Records.Fields["rolling"].GetNumberValue())
+	require.Equal(t, 2.0, res[0].Records.Fields["cumulative"].GetNumberValue())
+	require.Equal(t, 1.0, res[0].Records.Fields["delta"].GetNumberValue())
+
+	require.Equal(t, day(4).AsTime(), res[1].Ts.AsTime())
+	require.Equal(t, 4.0, res[1].Records.Fields["rolling"].GetNumberValue())
+	require.Equal(t, 6.0, res[1].Records.Fields["cumulative"].GetNumberValue())
+	require.IsType(t, &structpb.Value_NullValue{}, res[1].Records.Fields["delta"].Kind)
+
+	require.Equal(t, 9.0, res[2].Records.Fields["rolling"].GetNumberValue())
+	require.Equal(t, 11.0, res[2].Records.Fields["cumulative"].GetNumberValue())
+	require.Equal(t, 1.0, res[2].Records.Fields["delta"].GetNumberValue())
+}
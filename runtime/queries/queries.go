@@ -3,3 +3,6 @@ package queries
 import "time"
 
 const defaultExecutionTimeout = time.Minute * 2
+
+// defaultExportTimeout is longer than defaultExecutionTimeout because exports stream large results to the client.
+const defaultExportTimeout = time.Minute * 10
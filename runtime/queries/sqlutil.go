@@ -7,6 +7,7 @@ import (
 
 	"github.com/google/uuid"
 	runtimev1 "github.com/rilldata/rill/proto/gen/rill/runtime/v1"
+	"github.com/rilldata/rill/runtime/drivers"
 )
 
 var ErrExportNotSupported = fmt.Errorf("exporting is not supported")
@@ -78,6 +79,124 @@ func convertToDruidTimeFloorSpecifier(specifier runtimev1.TimeGrain) string {
 	panic(fmt.Errorf("unconvertable time grain specifier: %v", specifier))
 }
 
+// calendar configures the first day of the week and the first month of the year used to truncate timestamps to weeks, quarters and years.
+// Zero values mean ISO weeks (starting on Monday) and calendar years (starting in January).
+type calendar struct {
+	FirstDayOfWeek   uint32 `json:"first_day_of_week,omitempty"`
+	FirstMonthOfYear uint32 `json:"first_month_of_year,omitempty"`
+}
+
+// metricsViewCalendar returns the calendar configured for the metrics view.
+func metricsViewCalendar(mv *runtimev1.MetricsView) calendar {
+	return calendar{
+		FirstDayOfWeek:   mv.FirstDayOfWeek,
+		FirstMonthOfYear: mv.FirstMonthOfYear,
+	}
+}
+
+// shift returns the offset of the calendar's buckets for the grain relative to ISO weeks and calendar years.
+// The offset is in days for weeks and in months for quarters and years. A zero offset means the buckets are not shifted.
+func (c calendar) shift(grain runtimev1.TimeGrain) int {
+	switch grain {
+	case runtimev1.TimeGrain_TIME_GRAIN_WEEK:
+		if c.FirstDayOfWeek > 1 && c.FirstDayOfWeek <= 7 {
+			return int(c.FirstDayOfWeek) - 1
+		}
+	case runtimev1.TimeGrain_TIME_GRAIN_QUARTER:
+		if c.FirstMonthOfYear > 1 && c.FirstMonthOfYear <= 12 {
+			return int(c.FirstMonthOfYear-1) % 3
+		}
+	case runtimev1.TimeGrain_TIME_GRAIN_YEAR:
+		if c.FirstMonthOfYear > 1 && c.FirstMonthOfYear <= 12 {
+			return int(c.FirstMonthOfYear) - 1
+		}
+	}
+	return 0
+}
+
+// shiftUnit returns the unit of the offset returned by shift.
+func shiftUnit(grain runtimev1.TimeGrain) string {
+	if grain == runtimev1.TimeGrain_TIME_GRAIN_WEEK {
+		return "DAY"
+	}
+	return "MONTH"
+}
+
+// dateTruncExpr returns a DuckDB expression that truncates the timestamp expression expr to the given time grain.
+// Buckets of weeks, quarters and years are shifted to start on the calendar's first day of week and first month of year.
+func dateTruncExpr(expr string, grain runtimev1.TimeGrain, cal calendar) string {
+	spec := convertToDateTruncSpecifier(grain)
+	n := cal.shift(grain)
+	if n == 0 {
+		return fmt.Sprintf("date_trunc('%s', %s)", spec, expr)
+	}
+	unit := shiftUnit(grain)
+	return fmt.Sprintf("(date_trunc('%s', %s - INTERVAL %d %s) + INTERVAL %d %s)", spec, expr, n, unit, n, unit)
+}
+
+// druidTimeFloor returns a Druid expression that truncates col to the given time grain.
+// It takes the time zone to truncate in as an arg once, or three times if the calendar shifts the buckets of the grain.
+func druidTimeFloor(col string, grain runtimev1.TimeGrain, cal calendar) string {
+	n := cal.shift(grain)
+	if n == 0 {
+		return fmt.Sprintf("time_floor(%s, '%s', null, CAST(? AS VARCHAR))", col, convertToDruidTimeFloorSpecifier(grain))
+	}
+	period := "P1D"
+	if shiftUnit(grain) == "MONTH" {
+		period = "P1M"
+	}
+	return fmt.Sprintf(
+		"TIME_SHIFT(time_floor(TIME_SHIFT(%s, '%s', %d, CAST(? AS VARCHAR)), '%s', null, CAST(? AS VARCHAR)), '%s', %d, CAST(? AS VARCHAR))",
+		col, period, -n, convertToDruidTimeFloorSpecifier(grain), period, n,
+	)
+}
+
+// druidTimeFloorArgs returns the args for druidTimeFloor.
+func druidTimeFloorArgs(grain runtimev1.TimeGrain, cal calendar, tz string) []any {
+	if cal.shift(grain) == 0 {
+		return []any{tz}
+	}
+	return []any{tz, tz, tz}
+}
+
+// clickHouseTimeFloor returns a ClickHouse expression that truncates col to the given time grain.
+// It takes two time zone args: the first is the time zone to truncate in, the second is the time zone of the result.
+func clickHouseTimeFloor(col string, specifier runtimev1.TimeGrain, cal calendar) string {
+	n := cal.shift(specifier)
+	if n == 0 {
+		return fmt.Sprintf(
+			"toDateTime64(toStartOfInterval(toDateTime64(%s, 3, ?), INTERVAL 1 %s), 3, ?)",
+			col,
+			convertToDateTruncSpecifier(specifier),
+		)
+	}
+	unit := "Days"
+	if shiftUnit(specifier) == "MONTH" {
+		unit = "Months"
+	}
+	return fmt.Sprintf(
+		"toDateTime64(add%[3]s(toStartOfInterval(subtract%[3]s(toDateTime64(%[1]s, 3, ?), %[4]d), INTERVAL 1 %[2]s), %[4]d), 3, ?)",
+		col,
+		convertToDateTruncSpecifier(specifier),
+		unit,
+		n,
+	)
+}
+
+// timeFloorExpr returns an expression that truncates col to the given time grain in the given time zone, along with its args.
+func timeFloorExpr(dialect drivers.Dialect, col string, grain runtimev1.TimeGrain, tz string, cal calendar) (string, []any, error) {
+	switch dialect {
+	case drivers.DialectDuckDB:
+		return fmt.Sprintf("timezone(?, %s)", dateTruncExpr(fmt.Sprintf("timezone(?, %s::TIMESTAMPTZ)", col), grain, cal)), []any{tz, tz}, nil
+	case drivers.DialectDruid:
+		return druidTimeFloor(col, grain, cal), druidTimeFloorArgs(grain, cal, tz), nil
+	case drivers.DialectClickHouse:
+		return clickHouseTimeFloor(col, grain, cal), []any{tz, "UTC"}, nil
+	default:
+		return "", nil, fmt.Errorf("time grains are not supported for dialect '%s'", dialect)
+	}
+}
+
 func toTimeGrain(val string) runtimev1.TimeGrain {
 	switch strings.ToUpper(val) {
 	case "MILLISECOND":
@@ -0,0 +1,66 @@
+package queries
+
+import (
+	"strings"
+	"testing"
+	"time"
+
+	runtimev1 "github.com/rilldata/rill/proto/gen/rill/runtime/v1"
+	"github.com/rilldata/rill/runtime/drivers"
+	"github.com/stretchr/testify/require"
+	"google.golang.org/protobuf/types/known/timestamppb"
+)
+
+func Test_timeFloorExpr_clickHouse(t *testing.T) {
+	expr, args, err := timeFloorExpr(drivers.DialectClickHouse, `"t"`, runtimev1.TimeGrain_TIME_GRAIN_DAY, "Asia/Kathmandu", calendar{})
+	require.NoError(t, err)
+	require.Equal(t, `toDateTime64(toStartOfInterval(toDateTime64("t", 3, ?), INTERVAL 1 DAY), 3, ?)`, expr)
+	require.Equal(t, []any{"Asia/Kathmandu", "UTC"}, args)
+
+	// weeks starting on Sunday are shifted from ClickHouse's Monday weeks
+	expr, _, err = timeFloorExpr(drivers.DialectClickHouse, `"t"`, runtimev1.TimeGrain_TIME_GRAIN_WEEK, "UTC", calendar{FirstDayOfWeek: 7})
+	require.NoError(t, err)
+	require.Equal(t, `toDateTime64(addDays(toStartOfInterval(subtractDays(toDateTime64("t", 3, ?), 6), INTERVAL 1 WEEK), 6), 3, ?)`, expr)
+}
+
+func Test_columnCardinalitySQL(t *testing.T) {
+	sql, err := columnCardinalitySQL(drivers.DialectClickHouse, "events", "user_id")
+	require.NoError(t, err)
+	require.Equal(t, `SELECT uniq("user_id") as count from "events"`, sql)
+
+	sql, err = columnCardinalitySQL(drivers.DialectDuckDB, "events", "user_id")
+	require.NoError(t, err)
+	require.Equal(t, `SELECT approx_count_distinct("user_id") as count from "events"`, sql)
+
+	_, err = columnCardinalitySQL(drivers.DialectDruid, "events", "user_id")
+	require.Error(t, err)
+}
+
+func Test_ColumnTimeseries_buildTimeFloorSQL_clickHouse(t *testing.T) {
+	start := time.Date(2023, 1, 1, 0, 0, 0, 0, time.UTC)
+	end := time.Date(2023, 1, 2, 0, 0, 0, 0, time.UTC)
+	q := &ColumnTimeseries{
+		TableName:           "events",
+		TimestampColumnName: `event "time"`,
+		Measures:            []*runtimev1.ColumnTimeSeriesRequest_BasicMeasure{{Expression: "uniq(user_id)", SqlName: "users"}},
+		TimeZone:            "America/New_York",
+	}
+
+	sql, tsAlias, args, err := q.buildTimeFloorSQL(drivers.DialectClickHouse, &runtimev1.TimeSeriesTimeRange{
+		Interval: runtimev1.TimeGrain_TIME_GRAIN_HOUR,
+		Start:    timestamppb.New(start),
+		End:      timestamppb.New(end),
+	})
+	require.NoError(t, err)
+
+	// identifiers are quoted with double quotes, which are escaped by doubling them
+	sql = strings.ReplaceAll(sql, tsAlias, "ts")
+	require.Equal(t, `SELECT toDateTime64(toStartOfInterval(toDateTime64("event ""time""", 3, ?), INTERVAL 1 HOUR), 3, ?) AS "ts", uniq(user_id) as "users" FROM "events" WHERE "event ""time""" >= ? AND "event ""time""" < ? GROUP BY "ts" ORDER BY "ts"`, sql)
+	require.Equal(t, []any{"America/New_York", "UTC", start, end}, args)
+}
+
+func Test_EscapeStringValue_clickHouse(t *testing.T) {
+	// ClickHouse treats backslashes as escape characters in string literals
+	require.Equal(t, `'it''s a \\ test'`, drivers.DialectClickHouse.EscapeStringValue(`it's a \ test`))
+	require.Equal(t, `'it''s a \ test'`, drivers.DialectDuckDB.EscapeStringValue(`it's a \ test`))
+}
@@ -2,17 +2,25 @@ package runtime
 
 import (
 	"context"
+	"crypto/md5"
+	"encoding/hex"
 	"fmt"
 	"io"
+	"strconv"
 	"strings"
+	"sync"
+	"sync/atomic"
 
 	"github.com/dgraph-io/ristretto"
 	runtimev1 "github.com/rilldata/rill/proto/gen/rill/runtime/v1"
+	"github.com/rilldata/rill/runtime/drivers"
 	"github.com/rilldata/rill/runtime/pkg/observability"
 	"github.com/rilldata/rill/runtime/pkg/singleflight"
 	"go.opentelemetry.io/otel"
 	"go.opentelemetry.io/otel/attribute"
 	"go.opentelemetry.io/otel/metric"
+	"golang.org/x/exp/slices"
+	"google.golang.org/protobuf/proto"
 )
 
 var (
@@ -66,7 +74,7 @@ func (r *Runtime) Query(ctx context.Context, instanceID string, query Query, pri
 		return err
 	}
 	c, _ := r.connectorDef(inst, inst.OLAPDriver)
-	if c.Type == "druid" {
+	if c.Type == "druid" || c.Type == "clickhouse" {
 		return query.Resolve(ctx, r, instanceID, priority)
 	}
 
@@ -86,7 +94,11 @@ func (r *Runtime) Query(ctx context.Context, instanceID string, query Query, pri
 			}
 			return fmt.Errorf("query dependency %q not found", dep)
 		}
-		depKeys[i] = entry.Name + ":" + entry.RefreshedOn.String()
+		version, err := r.dataVersion(ctx, instanceID, entry, nil)
+		if err != nil {
+			return err
+		}
+		depKeys[i] = entry.Name + ":" + version
 	}
 
 	// If there were no known dependencies, skip caching
@@ -95,25 +107,29 @@ func (r *Runtime) Query(ctx context.Context, instanceID string, query Query, pri
 	}
 
 	// Build cache key
+	stats := r.queryCache.instanceStats(instanceID)
 	depKey := strings.Join(depKeys, ";")
 	key := queryCacheKey{
 		instanceID:    instanceID,
+		generation:    stats.generation,
 		queryKey:      query.Key(),
 		dependencyKey: depKey,
 	}.String()
 
 	// Try to get from cache
-	if val, ok := r.queryCache.cache.Get(key); ok {
+	if val, ok := r.queryCache.get(key); ok {
+		stats.hits.Add(1)
 		observability.AddRequestAttributes(ctx, attribute.Bool("query.cache_hit", true))
 		return query.UnmarshalResult(val)
 	}
+	stats.misses.Add(1)
 	observability.AddRequestAttributes(ctx, attribute.Bool("query.cache_hit", false))
 
 	// Load with singleflight
 	owner := false
 	val, err := r.queryCache.singleflight.Do(ctx, key, func(ctx context.Context) (any, error) {
 		// Try cache again
-		if val, ok := r.queryCache.cache.Get(key); ok {
+		if val, ok := r.queryCache.get(key); ok {
 			return val, nil
 		}
 
@@ -125,7 +141,7 @@ func (r *Runtime) Query(ctx context.Context, instanceID string, query Query, pri
 
 		owner = true
 		res := query.MarshalResult()
-		r.queryCache.cache.Set(key, res.Value, res.Bytes)
+		r.queryCache.set(key, stats, res)
 		queryCacheEntrySizeHistogram.Record(ctx, res.Bytes, metric.WithAttributes(attribute.String("query", queryName(query))))
 		return res.Value, nil
 	})
@@ -139,26 +155,144 @@ func (r *Runtime) Query(ctx context.Context, instanceID string, query Query, pri
 	return nil
 }
 
+// QueryCacheStats contains statistics about the cached query results of an instance.
+// The statistics are reset when the instance's cache is cleared.
+type QueryCacheStats struct {
+	Hits      int64
+	Misses    int64
+	Items     int64
+	SizeBytes int64
+}
+
+// QueryCacheStats returns statistics about the cached query results of an instance.
+func (r *Runtime) QueryCacheStats(instanceID string) QueryCacheStats {
+	return r.queryCache.snapshot(instanceID)
+}
+
+// ClearQueryCache removes the cached query results of an instance.
+func (r *Runtime) ClearQueryCache(instanceID string) {
+	r.queryCache.clear(instanceID)
+}
+
+// dataVersion returns a version of the data served by a catalog object. It changes when the underlying data is refreshed or the object's definition changes.
+// The path contains the names of the objects currently being versioned and is used to guard against cycles.
+func (r *Runtime) dataVersion(ctx context.Context, instanceID string, entry *drivers.CatalogEntry, path []string) (string, error) {
+	if slices.Contains(path, entry.Name) {
+		return "", fmt.Errorf("cyclic dependency on %q", entry.Name)
+	}
+	path = append(path, entry.Name)
+
+	switch entry.Type {
+	case drivers.ObjectTypeMetricsView:
+		// Metrics views don't have data of their own, so use the definition combined with the version of the model
+		mv := entry.GetMetricsView()
+		hash, err := specHash(mv)
+		if err != nil {
+			return "", err
+		}
+		model, err := r.GetCatalogEntry(ctx, instanceID, mv.Model)
+		if err != nil {
+			// The model may be a table created in the OLAP DB directly
+			return hash, nil
+		}
+		version, err := r.dataVersion(ctx, instanceID, model, path)
+		if err != nil {
+			return "", err
+		}
+		return hash + "@" + version, nil
+	case drivers.ObjectTypeModel:
+		// Models that are not materialized are views, so their data also changes when a parent is refreshed
+		versions := []string{strconv.FormatInt(entry.RefreshedOn.UnixNano(), 10)}
+		for _, name := range entry.Parents {
+			parent, err := r.GetCatalogEntry(ctx, instanceID, name)
+			if err != nil {
+				continue
+			}
+			version, err := r.dataVersion(ctx, instanceID, parent, path)
+			if err != nil {
+				return "", err
+			}
+			versions = append(versions, version)
+		}
+		return strings.Join(versions, ","), nil
+	default:
+		// Sources and tables are refreshed in place
+		return strconv.FormatInt(entry.RefreshedOn.UnixNano(), 10), nil
+	}
+}
+
+// specHash computes a hash of a catalog object's definition.
+func specHash(m proto.Message) (string, error) {
+	data, err := proto.MarshalOptions{Deterministic: true}.Marshal(m)
+	if err != nil {
+		return "", err
+	}
+	hash := md5.Sum(data)
+	return hex.EncodeToString(hash[:]), nil
+}
+
 type queryCacheKey struct {
 	instanceID    string
+	generation    int64
 	queryKey      string
 	dependencyKey string
 }
 
 func (k queryCacheKey) String() string {
-	return fmt.Sprintf("inst:%s deps:%s qry:%s", k.instanceID, k.dependencyKey, k.queryKey)
+	return fmt.Sprintf("inst:%s gen:%d deps:%s qry:%s", k.instanceID, k.generation, k.dependencyKey, k.queryKey)
 }
 
 type queryCache struct {
 	cache        *ristretto.Cache
 	singleflight *singleflight.Group[string, any]
 	metrics      metric.Registration
+
+	// The stats of an instance track the keys of its entries, which are deleted from the cache when it's cleared.
+	// The stats are replaced when the cache is cleared and removed when the instance is deleted.
+	// Each replacement gets a new generation, which is part of the cache keys, so results of queries that were running during the clear are never read.
+	mu          sync.Mutex
+	stats       map[string]*queryCacheStats
+	generations int64
+}
+
+type queryCacheStats struct {
+	generation int64
+	hits       atomic.Int64
+	misses     atomic.Int64
+	items      atomic.Int64
+	bytes      atomic.Int64
+	// keys and cleared are guarded by queryCache.mu
+	keys    map[string]*queryCacheEntry
+	cleared bool
+}
+
+func (s *queryCacheStats) snapshot() QueryCacheStats {
+	return QueryCacheStats{
+		Hits:      s.hits.Load(),
+		Misses:    s.misses.Load(),
+		Items:     s.items.Load(),
+		SizeBytes: s.bytes.Load(),
+	}
+}
+
+// queryCacheEntry is the value stored in the cache. It tracks the stats it's counted in, so they can be updated when it's evicted.
+type queryCacheEntry struct {
+	key   string
+	value any
+	bytes int64
+	stats *queryCacheStats
 }
 
 func newQueryCache(sizeInBytes int64) *queryCache {
 	if sizeInBytes <= 100 {
 		panic(fmt.Sprintf("invalid cache size should be greater than 100: %v", sizeInBytes))
 	}
+
+	c := &queryCache{
+		singleflight: &singleflight.Group[string, any]{},
+		stats:        make(map[string]*queryCacheStats),
+	}
+
 	cache, err := ristretto.NewCache(&ristretto.Config{
 		// Use 5% of cache memory for storing counters. Each counter takes roughly 3 bytes.
 		// Recommended value is 10x the number of items in cache when full.
@@ -166,24 +300,115 @@ func newQueryCache(sizeInBytes int64) *queryCache {
 		NumCounters: int64(float64(sizeInBytes) * 0.05 / 3),
 		MaxCost:     int64(float64(sizeInBytes) * 0.95),
 		BufferItems: 64,
-		Metrics:     true,
+		// Undo the stats of entries that are evicted, rejected or replaced
+		OnExit: func(val any) {
+			c.uncount(val.(*queryCacheEntry))
+		},
 	})
 	if err != nil {
 		panic(err)
 	}
+	c.cache = cache
 
-	metrics := observability.Must(meter.RegisterCallback(func(ctx context.Context, observer metric.Observer) error {
-		observer.ObserveInt64(queryCacheHitsCounter, int64(cache.Metrics.Hits()))
-		observer.ObserveInt64(queryCacheMissesCounter, int64(cache.Metrics.Misses()))
-		observer.ObserveInt64(queryCacheItemCountGauge, int64(cache.Metrics.KeysAdded()-cache.Metrics.KeysEvicted()))
-		observer.ObserveInt64(queryCacheSizeBytesGauge, int64(cache.Metrics.CostAdded()-cache.Metrics.CostEvicted()))
+	c.metrics = observability.Must(meter.RegisterCallback(func(ctx context.Context, observer metric.Observer) error {
+		c.mu.Lock()
+		defer c.mu.Unlock()
+		for instanceID, s := range c.stats {
+			opt := metric.WithAttributes(attribute.String("instance_id", instanceID))
+			observer.ObserveInt64(queryCacheHitsCounter, s.hits.Load(), opt)
+			observer.ObserveInt64(queryCacheMissesCounter, s.misses.Load(), opt)
+			observer.ObserveInt64(queryCacheItemCountGauge, s.items.Load(), opt)
+			observer.ObserveInt64(queryCacheSizeBytesGauge, s.bytes.Load(), opt)
+		}
 		return nil
 	}, queryCacheHitsCounter, queryCacheMissesCounter, queryCacheItemCountGauge, queryCacheSizeBytesGauge))
 
-	return &queryCache{
-		cache:        cache,
-		singleflight: &singleflight.Group[string, any]{},
-		metrics:      metrics,
+	return c
+}
+
+func (c *queryCache) get(key string) (any, bool) {
+	val, ok := c.cache.Get(key)
+	if !ok {
+		return nil, false
+	}
+	return val.(*queryCacheEntry).value, true
+}
+
+func (c *queryCache) set(key string, stats *queryCacheStats, res *QueryResult) {
+	e := &queryCacheEntry{key: key, value: res.Value, bytes: res.Bytes, stats: stats}
+
+	c.mu.Lock()
+	if stats.cleared {
+		// The instance's cache was cleared while the query was running
+		c.mu.Unlock()
+		return
+	}
+	stats.keys[key] = e
+	c.mu.Unlock()
+
+	stats.items.Add(1)
+	stats.bytes.Add(e.bytes)
+	if !c.cache.Set(key, e, e.bytes) {
+		// The set was dropped
+		c.uncount(e)
+	}
+}
+
+func (c *queryCache) uncount(e *queryCacheEntry) {
+	c.mu.Lock()
+	// The key may have been taken over by an entry that replaced this one
+	if e.stats.keys[e.key] == e {
+		delete(e.stats.keys, e.key)
+	}
+	c.mu.Unlock()
+
+	e.stats.items.Add(-1)
+	e.stats.bytes.Add(-e.bytes)
+}
+
+// instanceStats returns the current stats of an instance
+func (c *queryCache) instanceStats(instanceID string) *queryCacheStats {
+	c.mu.Lock()
+	defer c.mu.Unlock()
+	s, ok := c.stats[instanceID]
+	if !ok {
+		c.generations++
+		s = &queryCacheStats{generation: c.generations, keys: make(map[string]*queryCacheEntry)}
+		c.stats[instanceID] = s
+	}
+	return s
+}
+
+// snapshot returns the current stats of an instance without tracking it if it has no cached results
+func (c *queryCache) snapshot(instanceID string) QueryCacheStats {
+	c.mu.Lock()
+	s, ok := c.stats[instanceID]
+	c.mu.Unlock()
+	if !ok {
+		return QueryCacheStats{}
+	}
+	return s.snapshot()
+}
+
+// clear deletes the results cached for an instance and removes its stats
+func (c *queryCache) clear(instanceID string) {
+	c.mu.Lock()
+	s, ok := c.stats[instanceID]
+	if !ok {
+		c.mu.Unlock()
+		return
+	}
+	delete(c.stats, instanceID)
+	s.cleared = true
+	keys := make([]string, 0, len(s.keys))
+	for key := range s.keys {
+		keys = append(keys, key)
+	}
+	c.mu.Unlock()
+
+	// Deleting calls OnExit, which locks c.mu, so it's done after unlocking
+	for _, key := range keys {
+		c.cache.Del(key)
 	}
 }
 
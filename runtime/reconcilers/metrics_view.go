@@ -9,6 +9,7 @@ import (
 	runtimev1 "github.com/rilldata/rill/proto/gen/rill/runtime/v1"
 	"github.com/rilldata/rill/runtime"
 	"github.com/rilldata/rill/runtime/drivers"
+	"github.com/rilldata/rill/runtime/pkg/measures"
 )
 
 func init() {
@@ -92,9 +93,18 @@ func (r *MetricsViewReconciler) validate(ctx context.Context, mv *runtimev1.Metr
 		}
 	}
 
-	// Check measure expressions are valid
+	// Check derived measures reference existing measures without cycles
+	if err := measures.Validate(mv.Measures); err != nil {
+		errs = append(errs, err)
+		return errors.Join(errs...)
+	}
+
+	// Check measure expressions are valid (window measures don't have an expression)
 	for _, d := range mv.Measures {
-		err := validateMeasure(ctx, olap, t, d)
+		if d.Window != nil {
+			continue
+		}
+		err := validateMeasure(ctx, olap, t, mv.Measures, d)
 		if err != nil {
 			errs = append(errs, fmt.Errorf("invalid expression for measure %q: %w", d.Name, err))
 		}
@@ -103,9 +113,22 @@ func (r *MetricsViewReconciler) validate(ctx context.Context, mv *runtimev1.Metr
 	return errors.Join(errs...)
 }
 
-func validateMeasure(ctx context.Context, olap drivers.OLAPStore, t *drivers.Table, m *runtimev1.MetricsViewSpec_MeasureV2) error {
-	err := olap.Exec(ctx, &drivers.Statement{
-		Query:  fmt.Sprintf("SELECT %s from %s", m.Expression, safeSQLName(t.Name)),
+func validateMeasure(ctx context.Context, olap drivers.OLAPStore, t *drivers.Table, ms []*runtimev1.MetricsViewSpec_MeasureV2, m *runtimev1.MetricsViewSpec_MeasureV2) error {
+	if m.Kind != runtimev1.MetricsViewSpec_MeasureV2_KIND_UNSPECIFIED {
+		if _, err := measures.Compile(olap.Dialect(), measures.Kind(m.Kind), m.Column, m.Approximate, m.Percentile); err != nil {
+			return err
+		}
+	}
+	// Errors of other typed measures are reported when validating them
+	ms, _ = measures.CompileTyped[runtimev1.MetricsViewSpec_MeasureV2_Kind](olap.Dialect(), ms, func(typed *runtimev1.MetricsViewSpec_MeasureV2, expr string) *runtimev1.MetricsViewSpec_MeasureV2 {
+		return &runtimev1.MetricsViewSpec_MeasureV2{Name: typed.Name, Expression: expr}
+	})
+	expr, err := measures.Expand(ms, m.Name)
+	if err != nil {
+		return err
+	}
+	err = olap.Exec(ctx, &drivers.Statement{
+		Query:  fmt.Sprintf("SELECT %s from %s", expr, safeSQLName(t.Name)),
 		DryRun: true,
 	})
 	return err
@@ -8,6 +8,7 @@ import (
 	"github.com/rilldata/rill/runtime"
 	compilerv1 "github.com/rilldata/rill/runtime/compilers/rillv1"
 	"github.com/rilldata/rill/runtime/drivers"
+	"golang.org/x/exp/slog"
 )
 
 func init() {
@@ -54,6 +55,7 @@ func (r *MigrationReconciler) Reconcile(ctx context.Context, n *runtimev1.Resour
 		if err != nil {
 			return runtime.ReconcileResult{Err: err}
 		}
+		r.C.Logger.InfoCtx(ctx, "executed migration", slog.Int("version", int(v)))
 	}
 
 	return runtime.ReconcileResult{}
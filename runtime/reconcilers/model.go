@@ -12,6 +12,9 @@ import (
 	"github.com/rilldata/rill/runtime"
 	compilerv1 "github.com/rilldata/rill/runtime/compilers/rillv1"
 	"github.com/rilldata/rill/runtime/drivers"
+	"github.com/rilldata/rill/runtime/pkg/pbutil"
+	"golang.org/x/exp/slog"
+	"google.golang.org/protobuf/types/known/structpb"
 	"google.golang.org/protobuf/types/known/timestamppb"
 )
 
@@ -106,7 +109,7 @@ func (r *ModelReconciler) Reconcile(ctx context.Context, n *runtimev1.ResourceNa
 	t, exists := olapTableInfo(ctx, r.C, model.State.Connector, model.State.Table)
 
 	// Decide if we should trigger an update
-	trigger := model.Spec.Trigger
+	trigger := model.Spec.Trigger || model.Spec.TriggerFull
 	trigger = trigger || model.State.Table == ""
 	trigger = trigger || model.State.RefreshedOn == nil
 	trigger = trigger || model.State.SpecHash != hash
@@ -140,8 +143,11 @@ func (r *ModelReconciler) Reconcile(ctx context.Context, n *runtimev1.ResourceNa
 		}
 	}
 
-	// Always stage changes if running a delayed materialization
-	stage := model.Spec.StageChanges || delayedMaterialize
+	// Determine if we can append to the existing table instead of rebuilding the model from scratch
+	incremental := r.incrementalRefresh(model, hash, t, exists)
+
+	// Always stage changes if running a delayed materialization (incremental refreshes write directly to the main table)
+	stage := (model.Spec.StageChanges || delayedMaterialize) && !incremental
 	stagingTableName := tableName
 	if stage {
 		stagingTableName = r.stagingTableName(tableName)
@@ -161,14 +167,20 @@ func (r *ModelReconciler) Reconcile(ctx context.Context, n *runtimev1.ResourceNa
 
 	// Drop the staging table if it exists
 	connector := model.Spec.Connector
-	if t, ok := olapTableInfo(ctx, r.C, connector, stagingTableName); ok {
-		olapDropTableIfExists(ctx, r.C, connector, t.Name, t.View)
+	if !incremental {
+		if t, ok := olapTableInfo(ctx, r.C, connector, stagingTableName); ok {
+			olapDropTableIfExists(ctx, r.C, connector, t.Name, t.View)
+		}
 	}
 
 	// Create the model
-	createErr := r.createModel(ctx, self, stagingTableName, !materialize)
+	r.C.Logger.InfoCtx(ctx, "creating model", slog.Bool("materialize", materialize), slog.Bool("incremental", incremental))
+	start := time.Now()
+	createErr := r.createModel(ctx, self, stagingTableName, !materialize, incremental)
 	if createErr != nil {
 		createErr = fmt.Errorf("failed to create model: %w", createErr)
+	} else {
+		r.C.Logger.InfoCtx(ctx, "created model", slog.String("table", tableName), slog.Duration("elapsed", time.Since(start)))
 	}
 	if createErr == nil && stage {
 		// Drop the main view/table
@@ -185,6 +197,7 @@ func (r *ModelReconciler) Reconcile(ctx context.Context, n *runtimev1.ResourceNa
 	// How we handle ingestErr depends on several things:
 	// If ctx was cancelled, we cleanup and exit
 	// If StageChanges is true, we retain the existing table, but still return the error.
+	// If it was an incremental refresh, we retain the existing table and state, but still return the error.
 	// If StageChanges is false, we clear the existing table and return the error.
 
 	// ctx will only be cancelled in cases where the Controller guarantees a new call to Reconcile.
@@ -205,6 +218,15 @@ func (r *ModelReconciler) Reconcile(ctx context.Context, n *runtimev1.ResourceNa
 		model.State.Table = tableName
 		model.State.SpecHash = hash
 		model.State.RefreshedOn = timestamppb.Now()
+
+		// Resolve the incremental state for the next refresh.
+		// If it fails, the state is cleared, which causes the next refresh to rebuild the model from scratch.
+		model.State.IncrementalState, createErr = r.resolveIncrementalState(ctx, model.Spec)
+		if createErr != nil {
+			createErr = fmt.Errorf("failed to resolve incremental state: %w", createErr)
+		}
+	} else if incremental {
+		// Failed incremental refresh. Nothing to clean up.
 	} else if model.Spec.StageChanges {
 		// Failed ingestion to staging table
 		olapDropTableIfExists(cleanupCtx, r.C, connector, stagingTableName, !materialize)
@@ -216,6 +238,7 @@ func (r *ModelReconciler) Reconcile(ctx context.Context, n *runtimev1.ResourceNa
 		model.State.Table = ""
 		model.State.SpecHash = ""
 		model.State.RefreshedOn = nil
+		model.State.IncrementalState = nil
 	}
 	if update {
 		err = r.C.UpdateState(ctx, self.Meta.Name, self)
@@ -229,9 +252,10 @@ func (r *ModelReconciler) Reconcile(ctx context.Context, n *runtimev1.ResourceNa
 		return runtime.ReconcileResult{Err: createErr}
 	}
 
-	// Reset spec.Trigger
-	if model.Spec.Trigger {
+	// Reset spec.Trigger and spec.TriggerFull
+	if model.Spec.Trigger || model.Spec.TriggerFull {
 		model.Spec.Trigger = false
+		model.Spec.TriggerFull = false
 		err = r.C.UpdateSpec(ctx, self.Meta.Name, self.Meta.Refs, self.Meta.Owner, self.Meta.FilePaths, self)
 		if err != nil {
 			return runtime.ReconcileResult{Err: err}
@@ -298,11 +322,92 @@ func (r *ModelReconciler) executionSpecHash(spec *runtimev1.ModelSpec) (string,
 		return "", err
 	}
 
+	err = binary.Write(hash, binary.BigEndian, spec.Incremental)
+	if err != nil {
+		return "", err
+	}
+
+	_, err = hash.Write([]byte(spec.IncrementalStateResolver))
+	if err != nil {
+		return "", err
+	}
+
 	return hex.EncodeToString(hash.Sum(nil)), nil
 }
 
+// incrementalRefresh returns true if the model can be refreshed by inserting new rows into its existing table.
+// It returns false if the model must be rebuilt from scratch.
+func (r *ModelReconciler) incrementalRefresh(model *runtimev1.ModelV2, hash string, t *drivers.Table, exists bool) bool {
+	if !model.Spec.Incremental || model.Spec.TriggerFull {
+		return false
+	}
+
+	// The existing table must have been created by a refresh with the same spec
+	if !exists || t.View || model.State.SpecHash != hash || model.State.Connector != model.Spec.Connector {
+		return false
+	}
+
+	// If the last incremental state failed to resolve, we can't tell which rows have already been inserted
+	if model.Spec.IncrementalStateResolver != "" && model.State.IncrementalState == nil {
+		return false
+	}
+
+	return true
+}
+
+// resolveIncrementalState runs the incremental state resolver (if any) for a model and returns the resulting state.
+func (r *ModelReconciler) resolveIncrementalState(ctx context.Context, spec *runtimev1.ModelSpec) (*structpb.Struct, error) {
+	if !spec.Incremental || spec.IncrementalStateResolver == "" {
+		return nil, nil
+	}
+
+	olap, release, err := r.C.AcquireOLAP(ctx, spec.Connector)
+	if err != nil {
+		return nil, err
+	}
+	defer release()
+
+	res, err := olap.Execute(ctx, &drivers.Statement{
+		Query:    spec.IncrementalStateResolver,
+		Priority: 100,
+	})
+	if err != nil {
+		return nil, err
+	}
+	defer res.Close()
+
+	row := make(map[string]any)
+	if res.Next() {
+		err = res.MapScan(row)
+		if err != nil {
+			return nil, err
+		}
+		if res.Next() {
+			return nil, fmt.Errorf("state resolver returned more than one row")
+		}
+	}
+	if res.Err() != nil {
+		return nil, res.Err()
+	}
+
+	return pbutil.ToStruct(row, res.Schema)
+}
+
+// modelTemplateState returns the state exposed to a model's SQL template.
+// For incremental models, it's the incremental state, which is empty when the model is fully rebuilt.
+func modelTemplateState(spec *runtimev1.ModelSpec, state *runtimev1.ModelState, incremental bool) any {
+	if !spec.Incremental {
+		return state
+	}
+	if !incremental {
+		return map[string]any{}
+	}
+	return state.IncrementalState.AsMap()
+}
+
 // createModel creates or updates the model in the OLAP connector.
-func (r *ModelReconciler) createModel(ctx context.Context, self *runtimev1.Resource, tableName string, view bool) error {
+// If incremental is true, it inserts the model's result into the existing table instead of replacing it.
+func (r *ModelReconciler) createModel(ctx context.Context, self *runtimev1.Resource, tableName string, view, incremental bool) error {
 	inst, err := r.C.Runtime.FindInstance(ctx, r.C.InstanceID)
 	if err != nil {
 		return err
@@ -316,10 +421,13 @@ func (r *ModelReconciler) createModel(ctx context.Context, self *runtimev1.Resou
 		sql, err = compilerv1.ResolveTemplate(spec.Sql, compilerv1.TemplateData{
 			Claims:    map[string]interface{}{},
 			Variables: inst.ResolveVariables(),
+			ExtraProps: map[string]any{
+				"incremental": incremental,
+			},
 			Self: compilerv1.TemplateResource{
 				Meta:  self.Meta,
 				Spec:  spec,
-				State: state,
+				State: modelTemplateState(spec, state, incremental),
 			},
 			Resolve: func(ref compilerv1.ResourceName) (string, error) {
 				return safeSQLName(ref.Name), nil
@@ -363,6 +471,13 @@ func (r *ModelReconciler) createModel(ctx context.Context, self *runtimev1.Resou
 		defer cancel()
 	}
 
+	if incremental {
+		return olap.Exec(ctx, &drivers.Statement{
+			Query:    fmt.Sprintf("INSERT INTO %s SELECT * FROM (%s)", safeSQLName(tableName), sql),
+			Priority: 100,
+		})
+	}
+
 	var typ string
 	if view {
 		typ = "VIEW"
@@ -0,0 +1,28 @@
+package reconcilers
+
+import (
+	"testing"
+
+	runtimev1 "github.com/rilldata/rill/proto/gen/rill/runtime/v1"
+	"github.com/stretchr/testify/require"
+	"google.golang.org/protobuf/types/known/structpb"
+)
+
+func TestModelTemplateState(t *testing.T) {
+	incrementalState, err := structpb.NewStruct(map[string]any{"max_ts": "2023-01-01"})
+	require.NoError(t, err)
+	state := &runtimev1.ModelState{IncrementalState: incrementalState}
+
+	// Models that are not incremental get the full state
+	require.Equal(t, state, modelTemplateState(&runtimev1.ModelSpec{}, state, false))
+
+	// Incremental models get the incremental state on incremental runs
+	spec := &runtimev1.ModelSpec{Incremental: true}
+	require.Equal(t, map[string]any{"max_ts": "2023-01-01"}, modelTemplateState(spec, state, true))
+
+	// Incremental models get an empty state on full rebuilds
+	require.Equal(t, map[string]any{}, modelTemplateState(spec, state, false))
+
+	// Incremental models without a state get an empty state
+	require.Equal(t, map[string]any{}, modelTemplateState(spec, &runtimev1.ModelState{}, true))
+}
@@ -88,7 +88,7 @@ func (r *ProjectParserReconciler) Reconcile(ctx context.Context, n *runtimev1.Re
 	hash, err := repo.CommitHash(ctx)
 	if err != nil {
 		// Not worth failing the reconcile for this. On error, it'll just set CurrentCommitSha to "".
-		r.C.Logger.Error("failed to get commit hash", slog.String("err", err.Error()))
+		r.C.Logger.ErrorCtx(ctx, "failed to get commit hash", slog.String("err", err.Error()))
 	}
 	if pp.State.CurrentCommitSha != hash {
 		pp.State.CurrentCommitSha = hash
@@ -109,6 +109,7 @@ func (r *ProjectParserReconciler) Reconcile(ctx context.Context, n *runtimev1.Re
 	if err != nil {
 		return runtime.ReconcileResult{Err: err}
 	}
+	r.C.Logger.InfoCtx(ctx, "parsed project", slog.Int("resources", len(parser.Resources)), slog.Int("parse_errors", len(parser.Errors)))
 
 	// Exit if not watching
 	if !pp.Spec.Watch {
@@ -135,6 +136,9 @@ func (r *ProjectParserReconciler) Reconcile(ctx context.Context, n *runtimev1.Re
 		if err == nil {
 			err = r.reconcileParser(ctx, owner, parser, diff)
 		}
+		if err == nil {
+			r.C.Logger.InfoCtx(ctx, "re-parsed project", slog.Int("changed_paths", len(changedPaths)), slog.Int("parse_errors", len(parser.Errors)))
+		}
 		if err != nil {
 			reparseErr = err
 			cancel()
@@ -153,7 +157,7 @@ func (r *ProjectParserReconciler) Reconcile(ctx context.Context, n *runtimev1.Re
 
 	// If the watch failed, we return without rescheduling.
 	// TODO: Should we have some kind of retry?
-	r.C.Logger.Error("stopped watching for file changes", slog.String("err", err.Error()))
+	r.C.Logger.ErrorCtx(ctx, "stopped watching for file changes", slog.String("err", err.Error()))
 	return runtime.ReconcileResult{Err: err}
 }
 
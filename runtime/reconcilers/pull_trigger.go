@@ -41,6 +41,7 @@ func (r *PullTriggerReconciler) Reconcile(ctx context.Context, n *runtimev1.Reso
 	if err != nil {
 		return runtime.ReconcileResult{Err: err}
 	}
+	r.C.Logger.InfoCtx(ctx, "triggered pull of the project files")
 
 	err = r.C.Delete(ctx, n)
 	if err != nil {
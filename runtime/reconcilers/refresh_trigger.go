@@ -5,6 +5,7 @@ import (
 
 	runtimev1 "github.com/rilldata/rill/proto/gen/rill/runtime/v1"
 	"github.com/rilldata/rill/runtime"
+	"golang.org/x/exp/slog"
 	"google.golang.org/protobuf/proto"
 )
 
@@ -14,6 +15,7 @@ func init() {
 
 // RefreshTriggerReconciler reconciles a RefreshTrigger.
 // When a RefreshTrigger is created, the reconciler will refresh source and model by setting Trigger=true in their specs.
+// If Full is set, incremental sources and models are also refreshed from scratch by setting TriggerFull=true.
 // After that, it will delete the RefreshTrigger resource.
 type RefreshTriggerReconciler struct {
 	C *runtime.Controller
@@ -62,9 +64,15 @@ func (r *RefreshTriggerReconciler) Reconcile(ctx context.Context, n *runtimev1.R
 		case runtime.ResourceKindSource:
 			source := res.GetSource()
 			source.Spec.Trigger = true
+			if trigger.Spec.Full && source.Spec.Incremental {
+				source.Spec.TriggerFull = true
+			}
 		case runtime.ResourceKindModel:
 			model := res.GetModel()
 			model.Spec.Trigger = true
+			if trigger.Spec.Full && model.Spec.Incremental {
+				model.Spec.TriggerFull = true
+			}
 		default:
 			updated = false
 		}
@@ -73,6 +81,7 @@ func (r *RefreshTriggerReconciler) Reconcile(ctx context.Context, n *runtimev1.R
 			if err != nil {
 				return runtime.ReconcileResult{Err: err}
 			}
+			r.C.Logger.InfoCtx(ctx, "triggered refresh", slog.String("resource_kind", res.Meta.Name.Kind), slog.String("resource_name", res.Meta.Name.Name), slog.Bool("full", trigger.Spec.Full))
 		}
 	}
 
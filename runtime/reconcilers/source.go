@@ -3,15 +3,18 @@ package reconcilers
 import (
 	"context"
 	"crypto/md5"
+	"database/sql"
 	"encoding/binary"
 	"encoding/hex"
 	"fmt"
+	"strconv"
 	"time"
 
 	runtimev1 "github.com/rilldata/rill/proto/gen/rill/runtime/v1"
 	"github.com/rilldata/rill/runtime"
 	"github.com/rilldata/rill/runtime/drivers"
 	"github.com/rilldata/rill/runtime/pkg/pbutil"
+	"golang.org/x/exp/slog"
 	"google.golang.org/protobuf/types/known/structpb"
 	"google.golang.org/protobuf/types/known/timestamppb"
 )
@@ -102,7 +105,7 @@ func (r *SourceReconciler) Reconcile(ctx context.Context, n *runtimev1.ResourceN
 	}
 
 	// Decide if we should trigger a refresh
-	trigger := src.Spec.Trigger                                             // If Trigger is set
+	trigger := src.Spec.Trigger || src.Spec.TriggerFull                     // If Trigger or TriggerFull is set
 	trigger = trigger || src.State.Table == ""                              // If table is missing
 	trigger = trigger || src.State.RefreshedOn == nil                       // If never refreshed
 	trigger = trigger || src.State.SpecHash != hash                         // If the spec has changed
@@ -120,24 +123,58 @@ func (r *SourceReconciler) Reconcile(ctx context.Context, n *runtimev1.ResourceN
 		olapDropTableIfExists(ctx, r.C, src.State.Connector, r.stagingTableName(src.State.Table), false)
 	}
 
+	// Determine if we can ingest only new rows into the existing table (incremental ingestions are not staged, except for cursors, see below)
+	incremental := r.incrementalIngestion(src, hash, tableName, tableExists)
+	stage := src.Spec.StageChanges && !incremental
+
 	// Prepare for ingestion
 	stagingTableName := tableName
 	connector := src.Spec.SinkConnector
-	if src.Spec.StageChanges {
+	if stage {
 		stagingTableName = r.stagingTableName(tableName)
 	}
 
+	// Rows at or after the cursor are re-ingested into a staging table.
+	// After a successful ingestion, they replace the rows at or after the cursor in the existing table (see replaceFromCursor).
+	var cursor *structpb.Value
+	var previousObjects map[string]string
+	if incremental {
+		cursor = src.State.Cursor
+		previousObjects = src.State.IngestedObjects
+		if cursor != nil {
+			stagingTableName = r.stagingTableName(tableName)
+		}
+	}
+
 	// Should never happen, but if somehow the staging table was corrupted into a view, drop it
 	if t, ok := olapTableInfo(ctx, r.C, connector, stagingTableName); ok && t.View {
 		olapDropTableIfExists(ctx, r.C, connector, stagingTableName, t.View)
 	}
 
+	// The staging table for a cursor is created with the schema of the existing table, which the new rows are appended to
+	if cursor != nil {
+		err = olapCreateEmptyTableLike(ctx, r.C, connector, stagingTableName, tableName)
+		if err != nil {
+			return runtime.ReconcileResult{Err: fmt.Errorf("failed to prepare incremental ingestion: %w", err)}
+		}
+	}
+
 	// Execute ingestion
-	ingestErr := r.ingestSource(ctx, src.Spec, stagingTableName)
+	r.C.Logger.InfoCtx(ctx, "ingesting source", slog.String("connector", src.Spec.SourceConnector), slog.Bool("incremental", incremental))
+	start := time.Now()
+	ingestedObjects, ingestErr := r.ingestSource(ctx, src.Spec, stagingTableName, cursor, previousObjects)
 	if ingestErr != nil {
 		ingestErr = fmt.Errorf("failed to ingest source: %w", ingestErr)
+	} else {
+		r.C.Logger.InfoCtx(ctx, "ingested source", slog.String("table", tableName), slog.Duration("elapsed", time.Since(start)))
+	}
+	if ingestErr == nil && cursor != nil {
+		err = r.replaceFromCursor(ctx, connector, tableName, stagingTableName, src.Spec.CursorColumn, cursor)
+		if err != nil {
+			ingestErr = fmt.Errorf("failed to append incremental rows: %w", err)
+		}
 	}
-	if ingestErr == nil && src.Spec.StageChanges {
+	if ingestErr == nil && stage {
 		// Drop the main table name
 		if t, ok := olapTableInfo(ctx, r.C, connector, tableName); ok {
 			olapDropTableIfExists(ctx, r.C, connector, tableName, t.View)
@@ -152,6 +189,7 @@ func (r *SourceReconciler) Reconcile(ctx context.Context, n *runtimev1.ResourceN
 	// How we handle ingestErr depends on several things:
 	// If ctx was cancelled, we cleanup and exit
 	// If StageChanges is true, we retain the existing table, but still return the error.
+	// If it was an incremental ingestion, we retain the existing table and cursor, but still return the error.
 	// If StageChanges is false, we clear the existing table and return the error.
 
 	// ctx will only be cancelled in cases where the Controller guarantees a new call to Reconcile.
@@ -163,6 +201,11 @@ func (r *SourceReconciler) Reconcile(ctx context.Context, n *runtimev1.ResourceN
 		defer cancel()
 	}
 
+	// The staging table for a cursor is not needed after the rows have been appended (or the ingestion failed)
+	if cursor != nil {
+		olapDropTableIfExists(cleanupCtx, r.C, connector, stagingTableName, false)
+	}
+
 	// Update state
 	update := false
 	if ingestErr == nil {
@@ -172,7 +215,23 @@ func (r *SourceReconciler) Reconcile(ctx context.Context, n *runtimev1.ResourceN
 		src.State.Table = tableName
 		src.State.SpecHash = hash
 		src.State.RefreshedOn = timestamppb.Now()
-	} else if src.Spec.StageChanges {
+		src.State.IngestedObjects = ingestedObjects
+
+		// Persist the cursor for the next incremental ingestion.
+		// If it fails, the cursor is cleared, which causes the next ingestion to re-ingest the full source.
+		src.State.Cursor, ingestErr = r.resolveCursor(ctx, connector, tableName, src.Spec)
+		if ingestErr != nil {
+			ingestErr = fmt.Errorf("failed to resolve incremental cursor: %w", ingestErr)
+		}
+	} else if incremental {
+		r.C.Logger.WarnCtx(ctx, "incremental ingestion failed, keeping the existing table", slog.String("table", tableName))
+		// Failed incremental ingestion. For cursors, the existing table is unchanged since the new rows were ingested into a staging table.
+		// For object stores, we can't tell which objects were appended, so we clear the ingested objects to force a full ingestion next time.
+		if previousObjects != nil {
+			update = true
+			src.State.IngestedObjects = nil
+		}
+	} else if stage {
 		// Failed ingestion to staging table
 		olapDropTableIfExists(cleanupCtx, r.C, connector, stagingTableName, false)
 	} else {
@@ -183,6 +242,8 @@ func (r *SourceReconciler) Reconcile(ctx context.Context, n *runtimev1.ResourceN
 		src.State.Table = ""
 		src.State.SpecHash = ""
 		src.State.RefreshedOn = nil
+		src.State.Cursor = nil
+		src.State.IngestedObjects = nil
 	}
 	if update {
 		err = r.C.UpdateState(ctx, self.Meta.Name, self)
@@ -196,9 +257,10 @@ func (r *SourceReconciler) Reconcile(ctx context.Context, n *runtimev1.ResourceN
 		return runtime.ReconcileResult{Err: ingestErr}
 	}
 
-	// Reset spec.Trigger
-	if src.Spec.Trigger {
+	// Reset spec.Trigger and spec.TriggerFull
+	if src.Spec.Trigger || src.Spec.TriggerFull {
 		src.Spec.Trigger = false
+		src.Spec.TriggerFull = false
 		err = r.C.UpdateSpec(ctx, self.Meta.Name, self.Meta.Refs, self.Meta.Owner, self.Meta.FilePaths, self)
 		if err != nil {
 			return runtime.ReconcileResult{Err: err}
@@ -238,9 +300,151 @@ func (r *SourceReconciler) ingestionSpecHash(spec *runtimev1.SourceSpec) (string
 		return "", err
 	}
 
+	err = binary.Write(hash, binary.BigEndian, spec.Incremental)
+	if err != nil {
+		return "", err
+	}
+
+	err = binary.Write(hash, binary.BigEndian, spec.DeleteRemovedPartitions)
+	if err != nil {
+		return "", err
+	}
+
+	_, err = hash.Write([]byte(spec.CursorColumn))
+	if err != nil {
+		return "", err
+	}
+
 	return hex.EncodeToString(hash.Sum(nil)), nil
 }
 
+// incrementalIngestion returns true if only new rows should be ingested into the source's existing table.
+// It returns false if the source must be ingested from scratch.
+func (r *SourceReconciler) incrementalIngestion(src *runtimev1.SourceV2, hash, tableName string, tableExists bool) bool {
+	if !src.Spec.Incremental || src.Spec.TriggerFull {
+		return false
+	}
+
+	// The existing table must have been ingested with the same spec
+	if !tableExists || src.State.Table != tableName || src.State.SpecHash != hash || src.State.Connector != src.Spec.SinkConnector {
+		return false
+	}
+
+	// The cursor is nil if the table was empty or the cursor failed to resolve.
+	// The ingested objects are empty if the source is not an object store or the previous ingestion failed.
+	return src.State.Cursor != nil || len(src.State.IngestedObjects) > 0
+}
+
+// resolveCursor returns the max value of the source's cursor column in the ingested table.
+// It returns nil if the source doesn't have a cursor column or the table is empty.
+func (r *SourceReconciler) resolveCursor(ctx context.Context, connector, tableName string, spec *runtimev1.SourceSpec) (*structpb.Value, error) {
+	if !spec.Incremental || spec.CursorColumn == "" {
+		return nil, nil
+	}
+
+	olap, release, err := r.C.AcquireOLAP(ctx, connector)
+	if err != nil {
+		return nil, err
+	}
+	defer release()
+
+	res, err := olap.Execute(ctx, &drivers.Statement{
+		Query:    fmt.Sprintf("SELECT MAX(%s) FROM %s", safeSQLName(spec.CursorColumn), safeSQLName(tableName)),
+		Priority: 100,
+	})
+	if err != nil {
+		return nil, err
+	}
+	defer res.Close()
+
+	var cursor any
+	if res.Next() {
+		err = res.Scan(&cursor)
+		if err != nil {
+			return nil, err
+		}
+	}
+	if res.Err() != nil {
+		return nil, res.Err()
+	}
+	if cursor == nil {
+		return nil, nil
+	}
+	return cursorToPB(cursor)
+}
+
+// replaceFromCursor replaces the rows at or after the cursor in tableName with the rows in stagingTableName.
+// The rows are deleted and appended in one transaction, so the table is unchanged if it fails.
+func (r *SourceReconciler) replaceFromCursor(ctx context.Context, connector, tableName, stagingTableName, cursorColumn string, cursor *structpb.Value) error {
+	v, err := cursorFromPB(cursor)
+	if err != nil {
+		return err
+	}
+	lit, err := drivers.SQLLiteral(v)
+	if err != nil {
+		return err
+	}
+
+	olap, release, err := r.C.AcquireOLAP(ctx, connector)
+	if err != nil {
+		return err
+	}
+	defer release()
+
+	return olap.WithConnection(ctx, 100, func(ctx, ensuredCtx context.Context, conn *sql.Conn) error {
+		tx, err := conn.BeginTx(ctx, nil)
+		if err != nil {
+			return err
+		}
+		defer func() { _ = tx.Rollback() }()
+
+		_, err = tx.ExecContext(ctx, fmt.Sprintf("DELETE FROM %s WHERE %s >= %s", safeSQLName(tableName), safeSQLName(cursorColumn), lit))
+		if err != nil {
+			return err
+		}
+
+		_, err = tx.ExecContext(ctx, fmt.Sprintf("INSERT INTO %s SELECT * FROM %s", safeSQLName(tableName), safeSQLName(stagingTableName)))
+		if err != nil {
+			return err
+		}
+
+		return tx.Commit()
+	})
+}
+
+// cursorToPB converts a cursor value to a protobuf value for storage in the source's state.
+// Integers are stored as decimal strings in a struct since protobuf numbers are float64s, which lose precision for large integers.
+func cursorToPB(v any) (*structpb.Value, error) {
+	switch v := v.(type) {
+	case int, int8, int16, int32, int64, uint, uint8, uint16, uint32, uint64:
+		return structpb.NewStructValue(&structpb.Struct{
+			Fields: map[string]*structpb.Value{"int": structpb.NewStringValue(fmt.Sprintf("%d", v))},
+		}), nil
+	default:
+		return pbutil.ToValue(v, nil)
+	}
+}
+
+// cursorFromPB converts a cursor stored by cursorToPB back to a Go value.
+func cursorFromPB(v *structpb.Value) (any, error) {
+	if v == nil {
+		return nil, nil
+	}
+	s := v.GetStructValue()
+	if s == nil {
+		return v.AsInterface(), nil
+	}
+	str := s.Fields["int"].GetStringValue()
+	if i, err := strconv.ParseInt(str, 10, 64); err == nil {
+		return i, nil
+	}
+	u, err := strconv.ParseUint(str, 10, 64)
+	if err != nil {
+		return nil, fmt.Errorf("invalid integer cursor %q", str)
+	}
+	return u, nil
+}
+
 // stagingTableName returns a stable temporary table name for a destination table.
 // By using a stable temporary table name, we can ensure proper garbage collection without managing additional state.
 func (r *SourceReconciler) stagingTableName(table string) string {
@@ -248,36 +452,51 @@ func (r *SourceReconciler) stagingTableName(table string) string {
 }
 
 // ingestSource ingests the source into a table with tableName.
+// If cursor is non-nil, it only ingests rows at or after cursor and appends them to the existing table (which is a staging table, see Reconcile).
+// If previousObjects is non-nil, it only ingests new or changed objects and appends them to the existing table.
+// It returns the versions of the ingested objects for object store sources.
 // It does NOT drop the table if ingestion fails after the table has been created.
 // It will return an error if the sink connector is not an OLAP.
-func (r *SourceReconciler) ingestSource(ctx context.Context, src *runtimev1.SourceSpec, tableName string) error {
+func (r *SourceReconciler) ingestSource(ctx context.Context, src *runtimev1.SourceSpec, tableName string, cursor *structpb.Value, previousObjects map[string]string) (map[string]string, error) {
 	// Get connections and transporter
 	srcConn, release1, err := r.C.AcquireConn(ctx, src.SourceConnector)
 	if err != nil {
-		return err
+		return nil, err
 	}
 	defer release1()
 	sinkConn, release2, err := r.C.AcquireConn(ctx, src.SinkConnector)
 	if err != nil {
-		return err
+		return nil, err
 	}
 	defer release2()
 	t, ok := sinkConn.AsTransporter(srcConn, sinkConn)
 	if !ok {
 		t, ok = srcConn.AsTransporter(srcConn, sinkConn)
 		if !ok {
-			return fmt.Errorf("cannot transfer data between connectors %q and %q", src.SourceConnector, src.SinkConnector)
+			return nil, fmt.Errorf("cannot transfer data between connectors %q and %q", src.SourceConnector, src.SinkConnector)
+		}
+	}
+
+	// Incremental ingestion is currently only supported for SQL stores (with a cursor column) and object stores
+	if src.Incremental {
+		_, isSQLStore := srcConn.AsSQLStore()
+		_, isObjectStore := srcConn.AsObjectStore()
+		if isSQLStore && src.CursorColumn == "" {
+			return nil, fmt.Errorf("incremental sources for connector %q must set a cursor column", src.SourceConnector)
+		}
+		if !isSQLStore && !isObjectStore {
+			return nil, fmt.Errorf("connector %q does not support incremental ingestion", src.SourceConnector)
 		}
 	}
 
 	// Get source and sink configs
-	srcConfig, err := driversSource(srcConn, src.Properties)
+	srcConfig, err := driversSource(srcConn, src, cursor, previousObjects)
 	if err != nil {
-		return err
+		return nil, err
 	}
-	sinkConfig, err := driversSink(sinkConn, tableName)
+	sinkConfig, err := driversSink(sinkConn, tableName, cursor != nil || previousObjects != nil)
 	if err != nil {
-		return err
+		return nil, err
 	}
 
 	// Set timeout on ctx
@@ -296,7 +515,7 @@ func (r *SourceReconciler) ingestSource(ctx context.Context, src *runtimev1.Sour
 		// Get storage limit
 		inst, err := r.C.Runtime.FindInstance(ctx, r.C.InstanceID)
 		if err != nil {
-			return err
+			return nil, err
 		}
 		storageLimit := inst.IngestionLimitBytes
 
@@ -307,7 +526,7 @@ func (r *SourceReconciler) ingestSource(ctx context.Context, src *runtimev1.Sour
 			if ok {
 				n := storageLimit - bytes
 				if n <= 0 {
-					return drivers.ErrIngestionLimitExceeded
+					return nil, drivers.ErrIngestionLimitExceeded
 				}
 				ingestionLimit = &n
 
@@ -338,23 +557,38 @@ func (r *SourceReconciler) ingestSource(ctx context.Context, src *runtimev1.Sour
 	}
 	err = t.Transfer(ctx, srcConfig, sinkConfig, opts, drivers.NoOpProgress{})
 	if limitExceeded {
-		return drivers.ErrIngestionLimitExceeded
+		return nil, drivers.ErrIngestionLimitExceeded
+	}
+	if err != nil {
+		return nil, err
 	}
-	return err
+
+	if bucketSrc, ok := srcConfig.BucketSource(); ok {
+		return bucketSrc.IngestedObjects, nil
+	}
+	return nil, nil
 }
 
-func driversSource(conn drivers.Handle, propsPB *structpb.Struct) (drivers.Source, error) {
-	props := propsPB.AsMap()
+func driversSource(conn drivers.Handle, spec *runtimev1.SourceSpec, cursorPB *structpb.Value, previousObjects map[string]string) (drivers.Source, error) {
+	props := spec.Properties.AsMap()
+	cursor, err := cursorFromPB(cursorPB)
+	if err != nil {
+		return nil, err
+	}
 	switch conn.Driver() {
 	case "s3":
 		return &drivers.BucketSource{
 			// ExtractPolicy: src.Policy, // TODO: Add
-			Properties: props,
+			Properties:              props,
+			PreviousObjects:         previousObjects,
+			DeleteRemovedPartitions: spec.DeleteRemovedPartitions,
 		}, nil
 	case "gcs":
 		return &drivers.BucketSource{
 			// ExtractPolicy: src.Policy, // TODO: Add
-			Properties: props,
+			Properties:              props,
+			PreviousObjects:         previousObjects,
+			DeleteRemovedPartitions: spec.DeleteRemovedPartitions,
 		}, nil
 	case "https":
 		return &drivers.FileSource{
@@ -392,19 +626,59 @@ func driversSource(conn drivers.Handle, propsPB *structpb.Struct) (drivers.Sourc
 			return nil, fmt.Errorf("property \"sql\" is mandatory for connector \"bigquery\"")
 		}
 		return &drivers.DatabaseSource{
-			SQL:   query,
-			Props: props,
+			SQL:          query,
+			Props:        props,
+			CursorColumn: spec.CursorColumn,
+			Cursor:       cursor,
+			// BigQuery quotes identifiers with backticks
+			IdentifierQuote: '`',
+		}, nil
+	case "postgres":
+		query, ok := props["sql"].(string)
+		if !ok {
+			return nil, fmt.Errorf("property \"sql\" is mandatory for connector \"postgres\"")
+		}
+		return &drivers.DatabaseSource{
+			SQL:          query,
+			Props:        props,
+			CursorColumn: spec.CursorColumn,
+			Cursor:       cursor,
+		}, nil
+	case "mysql":
+		query, ok := props["sql"].(string)
+		if !ok {
+			return nil, fmt.Errorf("property \"sql\" is mandatory for connector \"mysql\"")
+		}
+		return &drivers.DatabaseSource{
+			SQL:          query,
+			Props:        props,
+			CursorColumn: spec.CursorColumn,
+			Cursor:       cursor,
+			// MySQL quotes identifiers with backticks
+			IdentifierQuote: '`',
+		}, nil
+	case "snowflake":
+		query, ok := props["sql"].(string)
+		if !ok {
+			return nil, fmt.Errorf("property \"sql\" is mandatory for connector \"snowflake\"")
+		}
+		return &drivers.DatabaseSource{
+			SQL:          query,
+			Props:        props,
+			CursorColumn: spec.CursorColumn,
+			Cursor:       cursor,
 		}, nil
 	default:
 		return nil, fmt.Errorf("source connector %q not supported", conn.Driver())
 	}
 }
 
-func driversSink(conn drivers.Handle, tableName string) (drivers.Sink, error) {
+func driversSink(conn drivers.Handle, tableName string, appendRows bool) (drivers.Sink, error) {
 	switch conn.Driver() {
 	case "duckdb":
 		return &drivers.DatabaseSink{
-			Table: tableName,
+			Table:  tableName,
+			Append: appendRows,
 		}, nil
 	default:
 		return nil, fmt.Errorf("sink connector %q not supported", conn.Driver())
@@ -91,6 +91,20 @@ func olapDropTableIfExists(ctx context.Context, c *runtime.Controller, connector
 	})
 }
 
+// olapCreateEmptyTableLike creates (or replaces) an empty table with the same columns as an existing table in an OLAP connector.
+func olapCreateEmptyTableLike(ctx context.Context, c *runtime.Controller, connector, table, like string) error {
+	olap, release, err := c.AcquireOLAP(ctx, connector)
+	if err != nil {
+		return err
+	}
+	defer release()
+
+	return olap.Exec(ctx, &drivers.Statement{
+		Query:    fmt.Sprintf("CREATE OR REPLACE TABLE %s AS SELECT * FROM %s LIMIT 0", safeSQLName(table), safeSQLName(like)),
+		Priority: 100,
+	})
+}
+
 // olapRenameTable renames the table from oldName to newName in the OLAP connector.
 // oldName must exist and newName must not exist.
 func olapRenameTable(ctx context.Context, c *runtime.Controller, connector, oldName, newName string, view bool) error {
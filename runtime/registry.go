@@ -59,7 +59,7 @@ func (r *Runtime) DeleteInstance(ctx context.Context, instanceID string, dropDB
 	if !inst.EmbedCatalog {
 		catalog, release, err := r.Catalog(ctx, instanceID)
 		if err == nil {
-			err = catalog.DeleteEntries(ctx)
+			err = errors.Join(catalog.DeleteEntries(ctx), catalog.DeleteResources(ctx))
 			release()
 		}
 		if err != nil {
@@ -69,6 +69,7 @@ func (r *Runtime) DeleteInstance(ctx context.Context, instanceID string, dropDB
 
 	// Evict cached data and connections for the instance
 	r.evictCaches(ctx, inst)
+	r.evictInstanceLogs(instanceID)
 
 	// Drop the underlying data store
 	if dropDB {
@@ -121,7 +122,8 @@ func (r *Runtime) evictCaches(ctx context.Context, inst *drivers.Instance) {
 	_ = r.EvictHandle(ctx, inst.ID, inst.RepoDriver, false)
 	// evict catalog cache
 	r.migrationMetaCache.evict(ctx, inst.ID)
-	// query cache can't be evicted since key is a combination of instance ID and other parameters
+	// evict query cache
+	r.queryCache.clear(inst.ID)
 }
 
 // GetInstanceAttributes fetches an instance and converts its annotations to attributes
@@ -5,19 +5,26 @@ import (
 	"errors"
 	"fmt"
 	"math"
+	"sync"
 
 	runtimev1 "github.com/rilldata/rill/proto/gen/rill/runtime/v1"
 	"github.com/rilldata/rill/runtime/drivers"
 	"github.com/rilldata/rill/runtime/pkg/activity"
+	"github.com/rilldata/rill/runtime/pkg/logbuffer"
 	"go.uber.org/zap"
 )
 
+// defaultControllerLogBufferCapacity is used when Options.ControllerLogBufferCapacity is not set
+const defaultControllerLogBufferCapacity = 1000
+
 type Options struct {
 	ConnectionCacheSize int
 	MetastoreConnector  string
 	QueryCacheSizeBytes int64
 	AllowHostAccess     bool
 	SafeSourceRefresh   bool
+	// ControllerLogBufferCapacity is the number of controller logs retained in memory per instance
+	ControllerLogBufferCapacity int
 	// SystemConnectors are drivers whose handles are shared with all instances
 	SystemConnectors []*runtimev1.Connector
 }
@@ -28,6 +35,8 @@ type Runtime struct {
 	connCache          *connectionCache
 	migrationMetaCache *migrationMetaCache
 	queryCache         *queryCache
+	instanceLogsMu     sync.Mutex
+	instanceLogs       map[string]*logbuffer.Buffer
 }
 
 func New(opts *Options, logger *zap.Logger, client activity.Client) (*Runtime, error) {
@@ -37,6 +46,7 @@ func New(opts *Options, logger *zap.Logger, client activity.Client) (*Runtime, e
 		connCache:          newConnectionCache(opts.ConnectionCacheSize, logger, client),
 		migrationMetaCache: newMigrationMetaCache(math.MaxInt),
 		queryCache:         newQueryCache(opts.QueryCacheSizeBytes),
+		instanceLogs:       make(map[string]*logbuffer.Buffer),
 	}
 	store, _, err := rt.AcquireSystemHandle(context.Background(), opts.MetastoreConnector)
 	if err != nil {
@@ -56,6 +66,30 @@ func (r *Runtime) AllowHostAccess() bool {
 	return r.opts.AllowHostAccess
 }
 
+// InstanceLogs returns the in-memory buffer of controller logs for an instance.
+// The buffer is created lazily and retained until the instance is deleted.
+func (r *Runtime) InstanceLogs(instanceID string) *logbuffer.Buffer {
+	r.instanceLogsMu.Lock()
+	defer r.instanceLogsMu.Unlock()
+
+	buf, ok := r.instanceLogs[instanceID]
+	if !ok {
+		capacity := r.opts.ControllerLogBufferCapacity
+		if capacity <= 0 {
+			capacity = defaultControllerLogBufferCapacity
+		}
+		buf = logbuffer.New(capacity)
+		r.instanceLogs[instanceID] = buf
+	}
+	return buf
+}
+
+func (r *Runtime) evictInstanceLogs(instanceID string) {
+	r.instanceLogsMu.Lock()
+	defer r.instanceLogsMu.Unlock()
+	delete(r.instanceLogs, instanceID)
+}
+
 func (r *Runtime) Close() error {
 	return errors.Join(
 		r.metastore.Close(),
@@ -0,0 +1,290 @@
+package runtime
+
+import (
+	"context"
+	"fmt"
+	"sort"
+	"strconv"
+	"strings"
+	"text/template"
+	"text/template/parse"
+
+	runtimev1 "github.com/rilldata/rill/proto/gen/rill/runtime/v1"
+	compilerv1 "github.com/rilldata/rill/runtime/compilers/rillv1"
+	"github.com/rilldata/rill/runtime/drivers"
+)
+
+// ResolvedMetricsViewSecurity is a metrics view's security policy resolved for a specific requester.
+// It's passed to queries, so it must be JSON serializable (and is part of the queries' cache keys).
+type ResolvedMetricsViewSecurity struct {
+	Access    bool     `json:"access"`
+	RowFilter string   `json:"row_filter,omitempty"`
+	Exclude   []string `json:"exclude,omitempty"`
+}
+
+// CanAccessField returns true if the requester is allowed to access the dimension or measure with the given name.
+func (s *ResolvedMetricsViewSecurity) CanAccessField(name string) bool {
+	if s == nil {
+		return true
+	}
+	for _, n := range s.Exclude {
+		if strings.EqualFold(n, name) {
+			return false
+		}
+	}
+	return true
+}
+
+// ResolveMetricsViewSecurity resolves the security policy of a metrics view for a requester with the given attributes.
+// It returns nil if the metrics view doesn't have a security policy, or if attributes is nil (which means auth is disabled).
+// The policy's conditions are templates, which have access to the attributes through ".claims" and to the instance's variables through ".env".
+// Since attributes may be controlled by the requester (e.g. a user's name), the row filter must pass them through "quote" to render them as SQL literals.
+func (r *Runtime) ResolveMetricsViewSecurity(ctx context.Context, attributes map[string]any, instanceID string, mv *runtimev1.MetricsView) (*ResolvedMetricsViewSecurity, error) {
+	if attributes == nil || mv.Security == nil {
+		return nil, nil
+	}
+
+	inst, err := r.FindInstance(ctx, instanceID)
+	if err != nil {
+		return nil, err
+	}
+
+	olap, release, err := r.OLAP(ctx, instanceID)
+	if err != nil {
+		return nil, err
+	}
+	dialect := olap.Dialect()
+	release()
+
+	td := compilerv1.TemplateData{
+		Claims:    attributes,
+		Variables: inst.ResolveVariables(),
+		Self: compilerv1.TemplateResource{
+			Spec: mv,
+		},
+		Resolve: func(ref compilerv1.ResourceName) (string, error) {
+			return safeSQLName(ref.Name), nil
+		},
+		Lookup: func(name compilerv1.ResourceName) (compilerv1.TemplateResource, error) {
+			return compilerv1.TemplateResource{}, fmt.Errorf("lookup is not supported in security policies")
+		},
+		Funcs: template.FuncMap{
+			"quote": func(v any) (string, error) {
+				return sqlLiteral(dialect, v)
+			},
+		},
+	}
+
+	res := &ResolvedMetricsViewSecurity{Access: true}
+
+	if mv.Security.Access != "" {
+		res.Access, err = resolveTemplatedBool(mv.Security.Access, td)
+		if err != nil {
+			return nil, fmt.Errorf("failed to resolve access condition: %w", err)
+		}
+	}
+
+	if mv.Security.RowFilter != "" {
+		err := checkQuotedClaims(mv.Security.RowFilter)
+		if err != nil {
+			return nil, fmt.Errorf("invalid row filter: %w", err)
+		}
+
+		rowFilter, err := compilerv1.ResolveTemplate(mv.Security.RowFilter, td)
+		if err != nil {
+			return nil, fmt.Errorf("failed to resolve row filter: %w", err)
+		}
+		res.RowFilter = strings.TrimSpace(rowFilter)
+	}
+
+	// If there are include conditions, all fields that are not explicitly included are excluded
+	excluded := make(map[string]bool)
+	if len(mv.Security.Include) > 0 {
+		included := make(map[string]bool)
+		for _, inc := range mv.Security.Include {
+			ok, err := resolveTemplatedBool(inc.Condition, td)
+			if err != nil {
+				return nil, fmt.Errorf("failed to resolve include condition: %w", err)
+			}
+			if ok {
+				for _, n := range inc.Names {
+					included[strings.ToLower(n)] = true
+				}
+			}
+		}
+		for _, d := range mv.Dimensions {
+			if !included[strings.ToLower(d.Name)] {
+				excluded[strings.ToLower(d.Name)] = true
+			}
+		}
+		for _, m := range mv.Measures {
+			if !included[strings.ToLower(m.Name)] {
+				excluded[strings.ToLower(m.Name)] = true
+			}
+		}
+	}
+	for _, exc := range mv.Security.Exclude {
+		ok, err := resolveTemplatedBool(exc.Condition, td)
+		if err != nil {
+			return nil, fmt.Errorf("failed to resolve exclude condition: %w", err)
+		}
+		if ok {
+			for _, n := range exc.Names {
+				excluded[strings.ToLower(n)] = true
+			}
+		}
+	}
+	for n := range excluded {
+		res.Exclude = append(res.Exclude, n)
+	}
+	// Sort for a deterministic cache key
+	sort.Strings(res.Exclude)
+
+	return res, nil
+}
+
+// resolveTemplatedBool resolves a template that must evaluate to a boolean.
+// An empty template resolves to true.
+func resolveTemplatedBool(tmpl string, td compilerv1.TemplateData) (bool, error) {
+	if tmpl == "" {
+		return true, nil
+	}
+
+	v, err := compilerv1.ResolveTemplate(tmpl, td)
+	if err != nil {
+		return false, err
+	}
+
+	b, err := strconv.ParseBool(strings.TrimSpace(v))
+	if err != nil {
+		return false, fmt.Errorf("condition must resolve to a boolean, got %q", v)
+	}
+
+	return b, nil
+}
+
+// sqlLiteral renders a value as a SQL literal in the given dialect.
+// Lists are rendered as comma-separated literals for use in IN expressions.
+func sqlLiteral(dialect drivers.Dialect, v any) (string, error) {
+	switch v := v.(type) {
+	case nil:
+		return "NULL", nil
+	case string:
+		return dialect.EscapeStringValue(v), nil
+	case bool:
+		return strconv.FormatBool(v), nil
+	case int, int32, int64, float32, float64:
+		return fmt.Sprint(v), nil
+	case []any:
+		res := make([]string, len(v))
+		for i, e := range v {
+			s, err := sqlLiteral(dialect, e)
+			if err != nil {
+				return "", err
+			}
+			res[i] = s
+		}
+		return strings.Join(res, ", "), nil
+	case []string:
+		res := make([]string, len(v))
+		for i, e := range v {
+			res[i] = dialect.EscapeStringValue(e)
+		}
+		return strings.Join(res, ", "), nil
+	default:
+		return "", fmt.Errorf("cannot quote value of type %T", v)
+	}
+}
+
+// checkQuotedClaims checks that a template only outputs claims when they are passed through "quote".
+// Any output that may contain claims counts, including variables and the scope of "with" and "range" blocks over claims.
+func checkQuotedClaims(tmpl string) error {
+	t := parse.New("")
+	t.Mode = parse.SkipFuncCheck
+	_, err := t.Parse(tmpl, "", "", map[string]*parse.Tree{})
+	if err != nil {
+		return err
+	}
+	return checkQuotedClaimsNode(t.Root, false)
+}
+
+func checkQuotedClaimsNode(node parse.Node, scoped bool) error {
+	switch n := node.(type) {
+	case *parse.ListNode:
+		if n == nil {
+			return nil
+		}
+		for _, c := range n.Nodes {
+			if err := checkQuotedClaimsNode(c, scoped); err != nil {
+				return err
+			}
+		}
+	case *parse.ActionNode:
+		// Declarations and assignments don't output anything
+		if len(n.Pipe.Decl) > 0 || !referencesClaims(n.Pipe, scoped) {
+			return nil
+		}
+		last := n.Pipe.Cmds[len(n.Pipe.Cmds)-1]
+		if id, ok := last.Args[0].(*parse.IdentifierNode); ok && id.Ident == "quote" {
+			return nil
+		}
+		return fmt.Errorf(`%s outputs claims without quoting them (pipe them to "quote")`, n)
+	case *parse.IfNode:
+		return checkQuotedClaimsBranch(&n.BranchNode, scoped, scoped)
+	case *parse.WithNode:
+		return checkQuotedClaimsBranch(&n.BranchNode, scoped || referencesClaims(n.Pipe, scoped), scoped)
+	case *parse.RangeNode:
+		return checkQuotedClaimsBranch(&n.BranchNode, scoped || referencesClaims(n.Pipe, scoped), scoped)
+	case *parse.TemplateNode:
+		return fmt.Errorf("templates are not supported")
+	}
+	return nil
+}
+
+func checkQuotedClaimsBranch(n *parse.BranchNode, scoped, elseScoped bool) error {
+	if err := checkQuotedClaimsNode(n.List, scoped); err != nil {
+		return err
+	}
+	return checkQuotedClaimsNode(n.ElseList, elseScoped)
+}
+
+// referencesClaims returns true if the output of a pipeline may contain claims.
+// When scoped is true, the pipeline is in a "with" or "range" block over claims, so the dot may contain claims.
+func referencesClaims(node parse.Node, scoped bool) bool {
+	switch n := node.(type) {
+	case *parse.PipeNode:
+		if n == nil {
+			return false
+		}
+		for _, c := range n.Cmds {
+			if referencesClaims(c, scoped) {
+				return true
+			}
+		}
+	case *parse.CommandNode:
+		for _, a := range n.Args {
+			if referencesClaims(a, scoped) {
+				return true
+			}
+		}
+	case *parse.DotNode:
+		// The root data contains the claims
+		return true
+	case *parse.FieldNode:
+		return scoped || n.Ident[0] == "claims"
+	case *parse.VariableNode:
+		// Only "$.env" and the like are safe, since other variables may have been assigned claims
+		return n.Ident[0] != "$" || len(n.Ident) == 1 || n.Ident[1] == "claims"
+	case *parse.ChainNode:
+		return referencesClaims(n.Node, scoped)
+	}
+	return false
+}
+
+// safeSQLName returns a quoted SQL identifier.
+func safeSQLName(name string) string {
+	if name == "" {
+		return name
+	}
+	return fmt.Sprintf("\"%s\"", strings.ReplaceAll(name, "\"", "\"\""))
+}
@@ -0,0 +1,59 @@
+package runtime
+
+import (
+	"testing"
+
+	"github.com/rilldata/rill/runtime/drivers"
+	"github.com/stretchr/testify/require"
+)
+
+func TestCheckQuotedClaims(t *testing.T) {
+	tests := []struct {
+		tmpl    string
+		wantErr bool
+	}{
+		{`domain = {{ .claims.domain | quote }}`, false},
+		{`domain = {{ quote .claims.domain }}`, false},
+		{`domain IN ({{ .claims.groups | quote }})`, false},
+		{`{{ if eq .claims.domain "msn.com" }}true{{ else }}false{{ end }}`, false},
+		{`region = '{{ .env.region }}'`, false},
+		{`region = '{{ $.env.region }}'`, false},
+		{`domain = '{{ .claims.domain }}'`, true},
+		{`domain = '{{ .claims.domain | lower }}'`, true},
+		{`domain = '{{ printf "%s" .claims.domain }}'`, true},
+		{`domain = '{{ $.claims.domain }}'`, true},
+		{`{{ $d := .claims.domain }}domain = '{{ $d }}'`, true},
+		{`{{ with .claims.domain }}domain = '{{ . }}'{{ end }}`, true},
+		{`{{ with .claims }}domain = '{{ .domain }}'{{ end }}`, true},
+		{`{{ with .claims }}domain = {{ .domain | quote }}{{ end }}`, false},
+		{`{{ range .claims.groups }}'{{ . }}'{{ end }}`, true},
+		{`{{ . }}`, true},
+	}
+	for _, tt := range tests {
+		t.Run(tt.tmpl, func(t *testing.T) {
+			err := checkQuotedClaims(tt.tmpl)
+			if tt.wantErr {
+				require.Error(t, err)
+			} else {
+				require.NoError(t, err)
+			}
+		})
+	}
+}
+
+func TestSQLLiteral(t *testing.T) {
+	s, err := sqlLiteral(drivers.DialectDuckDB, "msn.com' OR 1=1 --")
+	require.NoError(t, err)
+	require.Equal(t, `'msn.com'' OR 1=1 --'`, s)
+
+	s, err = sqlLiteral(drivers.DialectClickHouse, `msn.com\' OR 1=1 --`)
+	require.NoError(t, err)
+	require.Equal(t, `'msn.com\\'' OR 1=1 --'`, s)
+
+	s, err = sqlLiteral(drivers.DialectDuckDB, []any{"a", "b'", 1.0, true})
+	require.NoError(t, err)
+	require.Equal(t, `'a', 'b''', 1, true`, s)
+
+	_, err = sqlLiteral(drivers.DialectDuckDB, map[string]any{"a": 1})
+	require.Error(t, err)
+}
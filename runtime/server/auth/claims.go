@@ -1,6 +1,8 @@
 package auth
 
 import (
+	"strings"
+
 	"github.com/golang-jwt/jwt/v4"
 )
 
@@ -12,13 +14,24 @@ type Claims interface {
 	Can(p Permission) bool
 	// CanInstance resolves instance-level permissions.
 	CanInstance(instanceID string, p Permission) bool
+	// CanMetricsView resolves if the requester can query the metrics view with the given name.
+	// Tokens may be scoped to specific metrics views, e.g. when issued for a public share link.
+	CanMetricsView(name string) bool
+	// Scoped returns true if the requester is restricted to specific metrics views (see CanMetricsView).
+	// Scoped requesters may only read those metrics views, not other objects or instance-wide data such as logs.
+	Scoped() bool
+	// Attributes returns user attributes used to evaluate security policies.
+	// It returns nil if security policies should not be enforced (i.e. when auth is disabled).
+	Attributes() map[string]any
 }
 
 // jwtClaims implements Claims and resolve permissions based on a JWT payload.
 type jwtClaims struct {
 	jwt.RegisteredClaims
-	System    []Permission            `json:"sys,omitempty"`
-	Instances map[string][]Permission `json:"ins,omitempty"`
+	System       []Permission            `json:"sys,omitempty"`
+	Instances    map[string][]Permission `json:"ins,omitempty"`
+	MetricsViews []string                `json:"mvs,omitempty"`
+	Attrs        map[string]any          `json:"attr,omitempty"`
 }
 
 func (c *jwtClaims) Subject() string {
@@ -43,6 +56,31 @@ func (c *jwtClaims) CanInstance(instanceID string, p Permission) bool {
 	return c.Can(p)
 }
 
+func (c *jwtClaims) CanMetricsView(name string) bool {
+	// No restrictions if the token is not scoped to specific metrics views
+	if len(c.MetricsViews) == 0 {
+		return true
+	}
+	for _, mv := range c.MetricsViews {
+		if strings.EqualFold(mv, name) {
+			return true
+		}
+	}
+	return false
+}
+
+func (c *jwtClaims) Scoped() bool {
+	return len(c.MetricsViews) > 0
+}
+
+func (c *jwtClaims) Attributes() map[string]any {
+	// Never return nil for a JWT, since nil disables enforcement of security policies
+	if c.Attrs == nil {
+		return map[string]any{}
+	}
+	return c.Attrs
+}
+
 // openClaims implements Claims and allows all actions.
 // It is used for servers with auth disabled.
 type openClaims struct{}
@@ -59,6 +97,18 @@ func (c openClaims) CanInstance(instanceID string, p Permission) bool {
 	return true
 }
 
+func (c openClaims) CanMetricsView(name string) bool {
+	return true
+}
+
+func (c openClaims) Scoped() bool {
+	return false
+}
+
+func (c openClaims) Attributes() map[string]any {
+	return nil
+}
+
 // anonClaims imeplements Claims with no permissions.
 // It is used for unauthorized requests when auth is enabled.
 type anonClaims struct{}
@@ -74,3 +124,15 @@ func (c anonClaims) Can(p Permission) bool {
 func (c anonClaims) CanInstance(instanceID string, p Permission) bool {
 	return false
 }
+
+func (c anonClaims) CanMetricsView(name string) bool {
+	return false
+}
+
+func (c anonClaims) Scoped() bool {
+	return false
+}
+
+func (c anonClaims) Attributes() map[string]any {
+	return map[string]any{}
+}
@@ -111,6 +111,9 @@ type TokenOptions struct {
 	TTL                 time.Duration
 	SystemPermissions   []Permission
 	InstancePermissions map[string][]Permission
+	// MetricsViews optionally restricts metrics queries to the metrics views with the given names.
+	MetricsViews []string
+	Attributes   map[string]any
 }
 
 // NewToken issues a new JWT based on the provided options.
@@ -125,8 +128,10 @@ func (i *Issuer) NewToken(opts TokenOptions) (string, error) {
 			Subject:   opts.Subject,
 			Audience:  []string{opts.AudienceURL},
 		},
-		System:    opts.SystemPermissions,
-		Instances: opts.InstancePermissions,
+		System:       opts.SystemPermissions,
+		Instances:    opts.InstancePermissions,
+		MetricsViews: opts.MetricsViews,
+		Attrs:        opts.Attributes,
 	}
 
 	token := jwt.NewWithClaims(jwt.GetSigningMethod(i.signingKey.Algorithm), claims)
@@ -34,6 +34,61 @@ func TestTokens(t *testing.T) {
 		require.False(t, claims.CanInstance("unknown", ReadOLAP))
 	})
 
+	t.Run("Attributes", func(t *testing.T) {
+		token, err := iss.NewToken(TokenOptions{
+			AudienceURL:         aud.audienceURL,
+			Subject:             "alice",
+			TTL:                 time.Duration(time.Hour),
+			InstancePermissions: map[string][]Permission{"example": {ReadMetrics}},
+			Attributes:          map[string]any{"email": "alice@example.org", "admin": true},
+		})
+		require.NoError(t, err)
+
+		claims, err := aud.ParseAndValidate(token)
+		require.NoError(t, err)
+		require.Equal(t, "alice@example.org", claims.Attributes()["email"])
+		require.Equal(t, true, claims.Attributes()["admin"])
+
+		token, err = iss.NewToken(TokenOptions{
+			AudienceURL: aud.audienceURL,
+			Subject:     "bob",
+			TTL:         time.Duration(time.Hour),
+		})
+		require.NoError(t, err)
+
+		claims, err = aud.ParseAndValidate(token)
+		require.NoError(t, err)
+		require.NotNil(t, claims.Attributes())
+		require.Empty(t, claims.Attributes())
+	})
+
+	t.Run("MetricsViews", func(t *testing.T) {
+		token, err := iss.NewToken(TokenOptions{
+			AudienceURL:         aud.audienceURL,
+			TTL:                 time.Duration(time.Hour),
+			InstancePermissions: map[string][]Permission{"example": {ReadMetrics}},
+			MetricsViews:        []string{"ad_bids"},
+		})
+		require.NoError(t, err)
+
+		claims, err := aud.ParseAndValidate(token)
+		require.NoError(t, err)
+		require.True(t, claims.CanMetricsView("ad_bids"))
+		require.True(t, claims.CanMetricsView("AD_BIDS"))
+		require.False(t, claims.CanMetricsView("other"))
+
+		token, err = iss.NewToken(TokenOptions{
+			AudienceURL:         aud.audienceURL,
+			TTL:                 time.Duration(time.Hour),
+			InstancePermissions: map[string][]Permission{"example": {ReadMetrics}},
+		})
+		require.NoError(t, err)
+
+		claims, err = aud.ParseAndValidate(token)
+		require.NoError(t, err)
+		require.True(t, claims.CanMetricsView("other"))
+	})
+
 	t.Run("Expired", func(t *testing.T) {
 		token, err := iss.NewToken(TokenOptions{
 			AudienceURL:         aud.audienceURL,
@@ -24,7 +24,8 @@ func (s *Server) ListCatalogEntries(ctx context.Context, req *runtimev1.ListCata
 
 	s.addInstanceRequestAttributes(ctx, req.InstanceId)
 
-	if !auth.GetClaims(ctx).CanInstance(req.InstanceId, auth.ReadObjects) {
+	claims := auth.GetClaims(ctx)
+	if !claims.CanInstance(req.InstanceId, auth.ReadObjects) {
 		return nil, ErrForbidden
 	}
 
@@ -33,13 +34,17 @@ func (s *Server) ListCatalogEntries(ctx context.Context, req *runtimev1.ListCata
 		return nil, status.Error(codes.Unknown, err.Error())
 	}
 
-	pbs := make([]*runtimev1.CatalogEntry, len(entries))
-	for i, obj := range entries {
-		var err error
-		pbs[i], err = catalogObjectToPB(obj)
+	pbs := make([]*runtimev1.CatalogEntry, 0, len(entries))
+	for _, obj := range entries {
+		if !canReadCatalogEntry(claims, obj) {
+			continue
+		}
+
+		pb, err := catalogObjectToPB(obj)
 		if err != nil {
 			return nil, status.Error(codes.Unknown, err.Error())
 		}
+		pbs = append(pbs, pb)
 	}
 
 	return &runtimev1.ListCatalogEntriesResponse{Entries: pbs}, nil
@@ -54,15 +59,24 @@ func (s *Server) GetCatalogEntry(ctx context.Context, req *runtimev1.GetCatalogE
 
 	s.addInstanceRequestAttributes(ctx, req.InstanceId)
 
-	if !auth.GetClaims(ctx).CanInstance(req.InstanceId, auth.ReadObjects) {
+	claims := auth.GetClaims(ctx)
+	if !claims.CanInstance(req.InstanceId, auth.ReadObjects) {
 		return nil, ErrForbidden
 	}
 
 	entry, err := s.runtime.GetCatalogEntry(ctx, req.InstanceId, req.Name)
 	if err != nil {
+		if claims.Scoped() {
+			// Don't reveal which objects exist to scoped requesters
+			return nil, ErrForbidden
+		}
 		return nil, status.Error(codes.Unknown, err.Error())
 	}
 
+	if !canReadCatalogEntry(claims, entry) {
+		return nil, ErrForbidden
+	}
+
 	pb, err := catalogObjectToPB(entry)
 	if err != nil {
 		return nil, status.Error(codes.Unknown, err.Error())
@@ -262,6 +276,15 @@ func pbToObjectType(in runtimev1.ObjectType) drivers.ObjectType {
 	panic(fmt.Errorf("unhandled object type %s", in))
 }
 
+// canReadCatalogEntry returns true if the requester can read the catalog entry.
+// Requesters scoped to specific metrics views can only read the entries of those metrics views.
+func canReadCatalogEntry(claims auth.Claims, entry *drivers.CatalogEntry) bool {
+	if !claims.Scoped() {
+		return true
+	}
+	return entry.Type == drivers.ObjectTypeMetricsView && claims.CanMetricsView(entry.Name)
+}
+
 func catalogObjectToPB(obj *drivers.CatalogEntry) (*runtimev1.CatalogEntry, error) {
 	catalog := &runtimev1.CatalogEntry{
 		Name:        obj.Name,
@@ -8,6 +8,7 @@ import (
 
 	runtimev1 "github.com/rilldata/rill/proto/gen/rill/runtime/v1"
 	"github.com/rilldata/rill/runtime/pkg/activity"
+	"github.com/rilldata/rill/runtime/server/auth"
 	"github.com/rilldata/rill/runtime/services/catalog/testutils"
 	"github.com/rilldata/rill/runtime/testruntime"
 	"github.com/stretchr/testify/require"
@@ -78,3 +79,35 @@ func TestServer_PutFileAndReconcile(t *testing.T) {
 	testutils.AssertTableAbsence(t, cat, "ad_bids_source")
 	testutils.AssertTableAbsence(t, cat, "ad_bids_new")
 }
+
+func TestServer_CatalogScopedToMetricsView(t *testing.T) {
+	t.Parallel()
+	server, instanceID := getMetricsTestServer(t, "ad_bids_2rows")
+
+	// Claims like those of a public share link for a single dashboard
+	ctx := testClaimsCtx([]auth.Permission{auth.ReadObjects, auth.ReadMetrics}, []string{"ad_bids_metrics"}, nil)
+
+	res, err := server.GetCatalogEntry(ctx, &runtimev1.GetCatalogEntryRequest{InstanceId: instanceID, Name: "ad_bids_metrics"})
+	require.NoError(t, err)
+	require.Equal(t, "ad_bids_metrics", res.Entry.Name)
+
+	for _, name := range []string{"ad_bids_metrics_garbled", "ad_bids", "ad_bids_source", "does_not_exist"} {
+		_, err = server.GetCatalogEntry(ctx, &runtimev1.GetCatalogEntryRequest{InstanceId: instanceID, Name: name})
+		require.ErrorIs(t, err, ErrForbidden, name)
+	}
+
+	list, err := server.ListCatalogEntries(ctx, &runtimev1.ListCatalogEntriesRequest{InstanceId: instanceID})
+	require.NoError(t, err)
+	require.Len(t, list.Entries, 1)
+	require.Equal(t, "ad_bids_metrics", list.Entries[0].Name)
+
+	_, err = server.MetricsViewTotals(ctx, &runtimev1.MetricsViewTotalsRequest{
+		InstanceId:      instanceID,
+		MetricsViewName: "ad_bids_metrics_garbled",
+		MeasureNames:    []string{"measure_0"},
+	})
+	require.ErrorIs(t, err, ErrForbidden)
+
+	_, err = server.GetLogs(ctx, &runtimev1.GetLogsRequest{InstanceId: instanceID})
+	require.ErrorIs(t, err, ErrForbidden)
+}
@@ -2,18 +2,75 @@ package server
 
 import (
 	"context"
+	"errors"
 
 	runtimev1 "github.com/rilldata/rill/proto/gen/rill/runtime/v1"
+	"github.com/rilldata/rill/runtime/pkg/observability"
+	"github.com/rilldata/rill/runtime/server/auth"
+	"go.opentelemetry.io/otel/attribute"
 )
 
 // GetLogs implements runtimev1.RuntimeServiceServer
 func (s *Server) GetLogs(ctx context.Context, req *runtimev1.GetLogsRequest) (*runtimev1.GetLogsResponse, error) {
-	panic("not implemented")
+	observability.AddRequestAttributes(ctx,
+		attribute.String("args.instance_id", req.InstanceId),
+		attribute.Bool("args.ascending", req.Ascending),
+		attribute.String("args.level", req.Level.String()),
+		attribute.Int("args.limit", int(req.Limit)),
+	)
+
+	// Logs are instance-wide, so requesters scoped to specific metrics views can't read them
+	claims := auth.GetClaims(ctx)
+	if !claims.CanInstance(req.InstanceId, auth.ReadObjects) || claims.Scoped() {
+		return nil, ErrForbidden
+	}
+
+	_, err := s.runtime.FindInstance(ctx, req.InstanceId)
+	if err != nil {
+		return nil, err
+	}
+
+	logs := s.runtime.InstanceLogs(req.InstanceId).GetLogs(req.Ascending, int(req.Limit), req.Level)
+	return &runtimev1.GetLogsResponse{Logs: logs}, nil
 }
 
 // WatchLogs implements runtimev1.RuntimeServiceServer
 func (s *Server) WatchLogs(req *runtimev1.WatchLogsRequest, srv runtimev1.RuntimeService_WatchLogsServer) error {
-	panic("not implemented")
+	ctx := srv.Context()
+	observability.AddRequestAttributes(ctx,
+		attribute.String("args.instance_id", req.InstanceId),
+		attribute.Bool("args.replay", req.Replay),
+		attribute.String("args.level", req.Level.String()),
+		attribute.Int("args.replay_limit", int(req.ReplayLimit)),
+	)
+
+	// Logs are instance-wide, so requesters scoped to specific metrics views can't read them
+	claims := auth.GetClaims(ctx)
+	if !claims.CanInstance(req.InstanceId, auth.ReadObjects) || claims.Scoped() {
+		return ErrForbidden
+	}
+
+	_, err := s.runtime.FindInstance(ctx, req.InstanceId)
+	if err != nil {
+		return err
+	}
+
+	// Replay all logs if replay is set without a limit
+	replayLimit := 0
+	if req.Replay {
+		replayLimit = -1
+		if req.ReplayLimit > 0 {
+			replayLimit = int(req.ReplayLimit)
+		}
+	}
+
+	err = s.runtime.InstanceLogs(req.InstanceId).Watch(ctx, req.Level, replayLimit, func(l *runtimev1.Log) error {
+		return srv.Send(&runtimev1.WatchLogsResponse{Logs: []*runtimev1.Log{l}})
+	})
+	if errors.Is(err, context.Canceled) {
+		return nil
+	}
+	return err
 }
 
 // ListResources implements runtimev1.RuntimeServiceServer
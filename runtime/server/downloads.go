@@ -3,11 +3,13 @@ package server
 import (
 	"context"
 	"encoding/base64"
+	"errors"
 	"fmt"
 	"net/http"
 	"reflect"
 	"time"
 
+	gateway "github.com/grpc-ecosystem/grpc-gateway/v2/runtime"
 	runtimev1 "github.com/rilldata/rill/proto/gen/rill/runtime/v1"
 	"github.com/rilldata/rill/runtime"
 	"github.com/rilldata/rill/runtime/queries"
@@ -76,34 +78,78 @@ func (s *Server) downloadHandler(w http.ResponseWriter, req *http.Request) {
 			http.Error(w, err.Error(), http.StatusInternalServerError)
 			return
 		}
+		policy, err := s.resolveMetricsViewSecurity(req.Context(), request.InstanceId, r.MetricsViewName)
+		if err != nil {
+			http.Error(w, err.Error(), gateway.HTTPStatusFromCode(status.Code(err)))
+			return
+		}
 		q = &queries.MetricsViewToplist{
-			MetricsViewName: r.MetricsViewName,
-			DimensionName:   r.DimensionName,
-			MeasureNames:    r.MeasureNames,
-			InlineMeasures:  r.InlineMeasures,
-			TimeStart:       r.TimeStart,
-			TimeEnd:         r.TimeEnd,
-			Sort:            r.Sort,
-			Filter:          r.Filter,
-			Limit:           request.Limit,
+			MetricsViewName:     r.MetricsViewName,
+			DimensionName:       r.DimensionName,
+			MeasureNames:        r.MeasureNames,
+			InlineMeasures:      r.InlineMeasures,
+			TimeStart:           r.TimeStart,
+			TimeEnd:             r.TimeEnd,
+			Sort:                r.Sort,
+			Filter:              r.Filter,
+			Where:               r.Where,
+			Having:              r.Having,
+			Limit:               request.Limit,
+			MetricsViewSecurity: policy,
+		}
+	case *runtimev1.ExportRequest_MetricsViewAggregationRequest:
+		r := v.MetricsViewAggregationRequest
+		err := validateInlineMeasures(r.InlineMeasures)
+		if err != nil {
+			http.Error(w, err.Error(), http.StatusInternalServerError)
+			return
+		}
+		policy, err := s.resolveMetricsViewSecurity(req.Context(), request.InstanceId, r.MetricsViewName)
+		if err != nil {
+			http.Error(w, err.Error(), gateway.HTTPStatusFromCode(status.Code(err)))
+			return
+		}
+		q = &queries.MetricsViewAggregation{
+			MetricsViewName:     r.MetricsViewName,
+			Dimensions:          r.Dimensions,
+			MeasureNames:        r.MeasureNames,
+			InlineMeasures:      r.InlineMeasures,
+			Sort:                r.Sort,
+			TimeStart:           r.TimeStart,
+			TimeEnd:             r.TimeEnd,
+			Filter:              r.Filter,
+			Where:               r.Where,
+			Limit:               request.Limit,
+			Offset:              r.Offset,
+			PivotOn:             r.PivotOn,
+			TimeZone:            r.TimeZone,
+			MetricsViewSecurity: policy,
 		}
 	case *runtimev1.ExportRequest_MetricsViewRowsRequest:
 		r := v.MetricsViewRowsRequest
+		policy, err := s.resolveMetricsViewSecurity(req.Context(), request.InstanceId, r.MetricsViewName)
+		if err != nil {
+			http.Error(w, err.Error(), gateway.HTTPStatusFromCode(status.Code(err)))
+			return
+		}
 		q = &queries.MetricsViewRows{
-			MetricsViewName: r.MetricsViewName,
-			TimeStart:       r.TimeStart,
-			TimeEnd:         r.TimeEnd,
-			Filter:          r.Filter,
-			Sort:            r.Sort,
-			Limit:           request.Limit,
-			TimeZone:        r.TimeZone,
+			MetricsViewName:     r.MetricsViewName,
+			TimeStart:           r.TimeStart,
+			TimeEnd:             r.TimeEnd,
+			Filter:              r.Filter,
+			Where:               r.Where,
+			Sort:                r.Sort,
+			Limit:               request.Limit,
+			TimeZone:            r.TimeZone,
+			MetricsViewSecurity: policy,
 		}
 	default:
 		http.Error(w, fmt.Sprintf("unsupported request type: %s", reflect.TypeOf(v).Name()), http.StatusBadRequest)
 		return
 	}
 
-	err = q.Export(req.Context(), s.runtime, request.InstanceId, w, &runtime.ExportOptions{
+	ew := &exportResponseWriter{ResponseWriter: w}
+	err = q.Export(req.Context(), s.runtime, request.InstanceId, ew, &runtime.ExportOptions{
 		Format: request.Format,
 		PreWriteHook: func(filename string) error {
 			// Add timestamp to filename
@@ -121,6 +167,12 @@ func (s *Server) downloadHandler(w http.ResponseWriter, req *http.Request) {
 			case runtimev1.ExportFormat_EXPORT_FORMAT_PARQUET:
 				w.Header().Set("Content-Type", "application/octet-stream")
 				w.Header().Set("Content-Disposition", fmt.Sprintf("attachment; filename=\"%s.parquet\"", filename))
+			case runtimev1.ExportFormat_EXPORT_FORMAT_CSV_GZIP:
+				w.Header().Set("Content-Type", "application/gzip")
+				w.Header().Set("Content-Disposition", fmt.Sprintf("attachment; filename=\"%s.csv.gz\"", filename))
+			case runtimev1.ExportFormat_EXPORT_FORMAT_NDJSON:
+				w.Header().Set("Content-Type", "application/x-ndjson")
+				w.Header().Set("Content-Disposition", fmt.Sprintf("attachment; filename=\"%s.ndjson\"", filename))
 			default:
 				return fmt.Errorf("unsupported format %q", request.Format.String())
 			}
@@ -128,7 +180,29 @@ func (s *Server) downloadHandler(w http.ResponseWriter, req *http.Request) {
 		},
 	})
 	if err != nil {
+		// Exports are streamed, so the error may happen after part of the file has been sent.
+		// In that case, we abort the response so the client doesn't mistake the partial file for a complete download.
+		if ew.written {
+			panic(http.ErrAbortHandler)
+		}
+
+		w.Header().Del("Content-Disposition")
+		if errors.Is(err, queries.ErrExportLimitExceeded) {
+			http.Error(w, err.Error(), http.StatusBadRequest)
+			return
+		}
 		http.Error(w, err.Error(), http.StatusInternalServerError)
 		return
 	}
 }
+
+// exportResponseWriter is a http.ResponseWriter that tracks if anything has been written to the response body.
+type exportResponseWriter struct {
+	http.ResponseWriter
+	written bool
+}
+
+func (w *exportResponseWriter) Write(p []byte) (int, error) {
+	w.written = true
+	return w.ResponseWriter.Write(p)
+}
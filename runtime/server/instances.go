@@ -78,6 +78,8 @@ func (s *Server) CreateInstance(ctx context.Context, req *runtimev1.CreateInstan
 		EmbedCatalog:        req.EmbedCatalog,
 		Variables:           req.Variables,
 		IngestionLimitBytes: req.IngestionLimitBytes,
+		ExportLimitRows:     req.ExportLimitRows,
+		ExportLimitBytes:    req.ExportLimitBytes,
 		Annotations:         req.Annotations,
 		Connectors:          req.Connectors,
 	}
@@ -125,6 +127,8 @@ func (s *Server) EditInstance(ctx context.Context, req *runtimev1.EditInstanceRe
 		EmbedCatalog:        valOrDefault(req.EmbedCatalog, oldInst.EmbedCatalog),
 		Variables:           oldInst.Variables,
 		IngestionLimitBytes: valOrDefault(req.IngestionLimitBytes, oldInst.IngestionLimitBytes),
+		ExportLimitRows:     valOrDefault(req.ExportLimitRows, oldInst.ExportLimitRows),
+		ExportLimitBytes:    valOrDefault(req.ExportLimitBytes, oldInst.ExportLimitBytes),
 		Annotations:         annotations,
 	}
 	if len(req.Connectors) == 0 {
@@ -164,6 +168,8 @@ func (s *Server) EditInstanceVariables(ctx context.Context, req *runtimev1.EditI
 		RepoDriver:          oldInst.RepoDriver,
 		EmbedCatalog:        oldInst.EmbedCatalog,
 		IngestionLimitBytes: oldInst.IngestionLimitBytes,
+		ExportLimitRows:     oldInst.ExportLimitRows,
+		ExportLimitBytes:    oldInst.ExportLimitBytes,
 		Variables:           req.Variables,
 		Annotations:         oldInst.Annotations,
 		Connectors:          oldInst.Connectors,
@@ -200,6 +206,8 @@ func (s *Server) EditInstanceAnnotations(ctx context.Context, req *runtimev1.Edi
 		RepoDriver:          oldInst.RepoDriver,
 		EmbedCatalog:        oldInst.EmbedCatalog,
 		IngestionLimitBytes: oldInst.IngestionLimitBytes,
+		ExportLimitRows:     oldInst.ExportLimitRows,
+		ExportLimitBytes:    oldInst.ExportLimitBytes,
 		Variables:           oldInst.Variables,
 		Annotations:         req.Annotations,
 	}
@@ -244,6 +252,8 @@ func instanceToPB(inst *drivers.Instance) *runtimev1.Instance {
 		Variables:           inst.Variables,
 		ProjectVariables:    inst.ProjectVariables,
 		IngestionLimitBytes: inst.IngestionLimitBytes,
+		ExportLimitRows:     inst.ExportLimitRows,
+		ExportLimitBytes:    inst.ExportLimitBytes,
 		Connectors:          inst.Connectors,
 	}
 }
@@ -55,6 +55,22 @@ func marshalMetricsViewSort(ms []*runtimev1.MetricsViewSort) []string {
 	return nil
 }
 
+func marshalMetricsViewAggregationDimension(ds []*runtimev1.MetricsViewAggregationDimension) []string {
+	names := make([]string, len(ds))
+	for i, d := range ds {
+		names[i] = d.Name
+	}
+	return names
+}
+
+func marshalMetricsViewAggregationSort(ss []*runtimev1.MetricsViewAggregationSort) []string {
+	names := make([]string, len(ss))
+	for i, s := range ss {
+		names[i] = s.Name
+	}
+	return names
+}
+
 func marshalColumnTimeSeriesRequestBasicMeasure(m []*runtimev1.ColumnTimeSeriesRequest_BasicMeasure) []string {
 	if len(m) == 0 {
 		return make([]string, 0)
@@ -6,10 +6,13 @@ import (
 	"strings"
 
 	runtimev1 "github.com/rilldata/rill/proto/gen/rill/runtime/v1"
+	"github.com/rilldata/rill/runtime"
 	"github.com/rilldata/rill/runtime/pkg/observability"
 	"github.com/rilldata/rill/runtime/queries"
 	"github.com/rilldata/rill/runtime/server/auth"
 	"go.opentelemetry.io/otel/attribute"
+	"google.golang.org/grpc/codes"
+	"google.golang.org/grpc/status"
 )
 
 // MetricsViewToplist implements QueryService.
@@ -40,17 +43,84 @@ func (s *Server) MetricsViewToplist(ctx context.Context, req *runtimev1.MetricsV
 		return nil, err
 	}
 
+	policy, err := s.resolveMetricsViewSecurity(ctx, req.InstanceId, req.MetricsViewName)
+	if err != nil {
+		return nil, err
+	}
+
 	q := &queries.MetricsViewToplist{
-		MetricsViewName: req.MetricsViewName,
-		DimensionName:   req.DimensionName,
-		MeasureNames:    req.MeasureNames,
-		InlineMeasures:  req.InlineMeasures,
-		TimeStart:       req.TimeStart,
-		TimeEnd:         req.TimeEnd,
-		Limit:           &req.Limit,
-		Offset:          req.Offset,
-		Sort:            req.Sort,
-		Filter:          req.Filter,
+		MetricsViewName:     req.MetricsViewName,
+		DimensionName:       req.DimensionName,
+		MeasureNames:        req.MeasureNames,
+		InlineMeasures:      req.InlineMeasures,
+		TimeStart:           req.TimeStart,
+		TimeEnd:             req.TimeEnd,
+		Limit:               &req.Limit,
+		Offset:              req.Offset,
+		Sort:                req.Sort,
+		Filter:              req.Filter,
+		Where:               req.Where,
+		Having:              req.Having,
+		MetricsViewSecurity: policy,
+	}
+	err = s.runtime.Query(ctx, req.InstanceId, q, int(req.Priority))
+	if err != nil {
+		return nil, err
+	}
+
+	return q.Result, nil
+}
+
+// MetricsViewAggregation implements QueryService.
+func (s *Server) MetricsViewAggregation(ctx context.Context, req *runtimev1.MetricsViewAggregationRequest) (*runtimev1.MetricsViewAggregationResponse, error) {
+	observability.AddRequestAttributes(ctx,
+		attribute.String("args.instance_id", req.InstanceId),
+		attribute.String("args.metric_view", req.MetricsViewName),
+		attribute.StringSlice("args.dimensions", marshalMetricsViewAggregationDimension(req.Dimensions)),
+		attribute.StringSlice("args.measures", req.MeasureNames),
+		attribute.StringSlice("args.inline_measures", marshalInlineMeasure(req.InlineMeasures)),
+		attribute.StringSlice("args.sort.names", marshalMetricsViewAggregationSort(req.Sort)),
+		attribute.String("args.time_start", safeTimeStr(req.TimeStart)),
+		attribute.String("args.time_end", safeTimeStr(req.TimeEnd)),
+		attribute.Int64("args.limit", req.Limit),
+		attribute.Int64("args.offset", req.Offset),
+		attribute.String("args.pivot_on", req.PivotOn),
+		attribute.String("args.time_zone", req.TimeZone),
+		attribute.Int("args.priority", int(req.Priority)),
+		attribute.Int("args.filter_count", filterCount(req.Filter)),
+	)
+
+	s.addInstanceRequestAttributes(ctx, req.InstanceId)
+
+	if !auth.GetClaims(ctx).CanInstance(req.InstanceId, auth.ReadMetrics) {
+		return nil, ErrForbidden
+	}
+
+	err := validateInlineMeasures(req.InlineMeasures)
+	if err != nil {
+		return nil, err
+	}
+
+	policy, err := s.resolveMetricsViewSecurity(ctx, req.InstanceId, req.MetricsViewName)
+	if err != nil {
+		return nil, err
+	}
+
+	q := &queries.MetricsViewAggregation{
+		MetricsViewName:     req.MetricsViewName,
+		Dimensions:          req.Dimensions,
+		MeasureNames:        req.MeasureNames,
+		InlineMeasures:      req.InlineMeasures,
+		Sort:                req.Sort,
+		TimeStart:           req.TimeStart,
+		TimeEnd:             req.TimeEnd,
+		Filter:              req.Filter,
+		Where:               req.Where,
+		Limit:               &req.Limit,
+		Offset:              req.Offset,
+		PivotOn:             req.PivotOn,
+		TimeZone:            req.TimeZone,
+		MetricsViewSecurity: policy,
 	}
 	err = s.runtime.Query(ctx, req.InstanceId, q, int(req.Priority))
 	if err != nil {
@@ -95,6 +165,11 @@ func (s *Server) MetricsViewComparisonToplist(ctx context.Context, req *runtimev
 		return nil, err
 	}
 
+	policy, err := s.resolveMetricsViewSecurity(ctx, req.InstanceId, req.MetricsViewName)
+	if err != nil {
+		return nil, err
+	}
+
 	q := &queries.MetricsViewComparisonToplist{
 		MetricsViewName:     req.MetricsViewName,
 		DimensionName:       req.DimensionName,
@@ -106,6 +181,9 @@ func (s *Server) MetricsViewComparisonToplist(ctx context.Context, req *runtimev
 		Offset:              req.Offset,
 		Sort:                req.Sort,
 		Filter:              req.Filter,
+		Where:               req.Where,
+		Having:              req.Having,
+		MetricsViewSecurity: policy,
 	}
 	err = s.runtime.Query(ctx, req.InstanceId, q, int(req.Priority))
 	if err != nil {
@@ -128,6 +206,10 @@ func (s *Server) MetricsViewTimeSeries(ctx context.Context, req *runtimev1.Metri
 		attribute.Int("args.filter_count", filterCount(req.Filter)),
 		attribute.Int("args.priority", int(req.Priority)),
 	)
+	if req.ComparisonTimeRange != nil {
+		observability.AddRequestAttributes(ctx, attribute.String("args.comparison_time_range.start", safeTimeStr(req.ComparisonTimeRange.Start)))
+		observability.AddRequestAttributes(ctx, attribute.String("args.comparison_time_range.end", safeTimeStr(req.ComparisonTimeRange.End)))
+	}
 
 	s.addInstanceRequestAttributes(ctx, req.InstanceId)
 
@@ -140,15 +222,23 @@ func (s *Server) MetricsViewTimeSeries(ctx context.Context, req *runtimev1.Metri
 		return nil, err
 	}
 
+	policy, err := s.resolveMetricsViewSecurity(ctx, req.InstanceId, req.MetricsViewName)
+	if err != nil {
+		return nil, err
+	}
+
 	q := &queries.MetricsViewTimeSeries{
-		MetricsViewName: req.MetricsViewName,
-		MeasureNames:    req.MeasureNames,
-		InlineMeasures:  req.InlineMeasures,
-		TimeStart:       req.TimeStart,
-		TimeEnd:         req.TimeEnd,
-		TimeGranularity: req.TimeGranularity,
-		Filter:          req.Filter,
-		TimeZone:        req.TimeZone,
+		MetricsViewName:     req.MetricsViewName,
+		MeasureNames:        req.MeasureNames,
+		InlineMeasures:      req.InlineMeasures,
+		TimeStart:           req.TimeStart,
+		TimeEnd:             req.TimeEnd,
+		TimeGranularity:     req.TimeGranularity,
+		Filter:              req.Filter,
+		Where:               req.Where,
+		TimeZone:            req.TimeZone,
+		ComparisonTimeRange: req.ComparisonTimeRange,
+		MetricsViewSecurity: policy,
 	}
 	err = s.runtime.Query(ctx, req.InstanceId, q, int(req.Priority))
 	if err != nil {
@@ -181,13 +271,20 @@ func (s *Server) MetricsViewTotals(ctx context.Context, req *runtimev1.MetricsVi
 		return nil, err
 	}
 
+	policy, err := s.resolveMetricsViewSecurity(ctx, req.InstanceId, req.MetricsViewName)
+	if err != nil {
+		return nil, err
+	}
+
 	q := &queries.MetricsViewTotals{
-		MetricsViewName: req.MetricsViewName,
-		MeasureNames:    req.MeasureNames,
-		InlineMeasures:  req.InlineMeasures,
-		TimeStart:       req.TimeStart,
-		TimeEnd:         req.TimeEnd,
-		Filter:          req.Filter,
+		MetricsViewName:     req.MetricsViewName,
+		MeasureNames:        req.MeasureNames,
+		InlineMeasures:      req.InlineMeasures,
+		TimeStart:           req.TimeStart,
+		TimeEnd:             req.TimeEnd,
+		Filter:              req.Filter,
+		Where:               req.Where,
+		MetricsViewSecurity: policy,
 	}
 	err = s.runtime.Query(ctx, req.InstanceId, q, int(req.Priority))
 	if err != nil {
@@ -217,20 +314,27 @@ func (s *Server) MetricsViewRows(ctx context.Context, req *runtimev1.MetricsView
 		return nil, ErrForbidden
 	}
 
+	policy, err := s.resolveMetricsViewSecurity(ctx, req.InstanceId, req.MetricsViewName)
+	if err != nil {
+		return nil, err
+	}
+
 	limit := int64(req.Limit)
 
 	q := &queries.MetricsViewRows{
-		MetricsViewName: req.MetricsViewName,
-		TimeStart:       req.TimeStart,
-		TimeEnd:         req.TimeEnd,
-		TimeGranularity: req.TimeGranularity,
-		Filter:          req.Filter,
-		Sort:            req.Sort,
-		Limit:           &limit,
-		Offset:          req.Offset,
-		TimeZone:        req.TimeZone,
-	}
-	err := s.runtime.Query(ctx, req.InstanceId, q, int(req.Priority))
+		MetricsViewName:     req.MetricsViewName,
+		TimeStart:           req.TimeStart,
+		TimeEnd:             req.TimeEnd,
+		TimeGranularity:     req.TimeGranularity,
+		Filter:              req.Filter,
+		Where:               req.Where,
+		Sort:                req.Sort,
+		Limit:               &limit,
+		Offset:              req.Offset,
+		TimeZone:            req.TimeZone,
+		MetricsViewSecurity: policy,
+	}
+	err = s.runtime.Query(ctx, req.InstanceId, q, int(req.Priority))
 	if err != nil {
 		return nil, err
 	}
@@ -252,10 +356,16 @@ func (s *Server) MetricsViewTimeRange(ctx context.Context, req *runtimev1.Metric
 		return nil, ErrForbidden
 	}
 
+	policy, err := s.resolveMetricsViewSecurity(ctx, req.InstanceId, req.MetricsViewName)
+	if err != nil {
+		return nil, err
+	}
+
 	q := &queries.MetricsViewTimeRange{
-		MetricsViewName: req.MetricsViewName,
+		MetricsViewName:     req.MetricsViewName,
+		MetricsViewSecurity: policy,
 	}
-	err := s.runtime.Query(ctx, req.InstanceId, q, int(req.Priority))
+	err = s.runtime.Query(ctx, req.InstanceId, q, int(req.Priority))
 	if err != nil {
 		return nil, err
 	}
@@ -263,6 +373,36 @@ func (s *Server) MetricsViewTimeRange(ctx context.Context, req *runtimev1.Metric
 	return q.Result, nil
 }
 
+// resolveMetricsViewSecurity resolves the security policy of a metrics view for the current requester.
+// It returns ErrForbidden if the requester's token is scoped to other metrics views or if the policy denies the requester access to the metrics view.
+func (s *Server) resolveMetricsViewSecurity(ctx context.Context, instanceID, metricsViewName string) (*runtime.ResolvedMetricsViewSecurity, error) {
+	claims := auth.GetClaims(ctx)
+	if !claims.CanMetricsView(metricsViewName) {
+		return nil, ErrForbidden
+	}
+
+	entry, err := s.runtime.GetCatalogEntry(ctx, instanceID, metricsViewName)
+	if err != nil {
+		return nil, status.Error(codes.InvalidArgument, err.Error())
+	}
+
+	mv := entry.GetMetricsView()
+	if mv == nil {
+		return nil, status.Errorf(codes.NotFound, "object named '%s' is not a metrics view", metricsViewName)
+	}
+
+	policy, err := s.runtime.ResolveMetricsViewSecurity(ctx, claims.Attributes(), instanceID, mv)
+	if err != nil {
+		return nil, status.Error(codes.InvalidArgument, err.Error())
+	}
+
+	if policy != nil && !policy.Access {
+		return nil, ErrForbidden
+	}
+
+	return policy, nil
+}
+
 // validateInlineMeasures checks that the inline measures are allowed.
 // This is to prevent injection of arbitrary SQL from clients with only ReadMetrics access.
 // In the future, we should consider allowing arbitrary expressions from people with wider access.
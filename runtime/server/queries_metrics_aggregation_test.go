@@ -0,0 +1,121 @@
+package server
+
+import (
+	"testing"
+
+	runtimev1 "github.com/rilldata/rill/proto/gen/rill/runtime/v1"
+	"github.com/stretchr/testify/require"
+)
+
+func TestServer_MetricsViewAggregation(t *testing.T) {
+	t.Parallel()
+	server, instanceId := getMetricsTestServer(t, "ad_bids_2rows")
+
+	tr, err := server.MetricsViewAggregation(testCtx(), &runtimev1.MetricsViewAggregationRequest{
+		InstanceId:      instanceId,
+		MetricsViewName: "ad_bids_metrics",
+		Dimensions: []*runtimev1.MetricsViewAggregationDimension{
+			{Name: "domain"},
+			{Name: "timestamp", TimeGrain: runtimev1.TimeGrain_TIME_GRAIN_DAY, Alias: "day"},
+		},
+		MeasureNames: []string{"measure_0", "measure_2"},
+		Sort: []*runtimev1.MetricsViewAggregationSort{
+			{Name: "domain", Desc: true},
+		},
+	})
+	require.NoError(t, err)
+	require.Equal(t, 4, len(tr.Meta))
+	require.Equal(t, 2, len(tr.Data))
+
+	require.Equal(t, "yahoo.com", tr.Data[0].Fields["domain"].GetStringValue())
+	require.Equal(t, "2022-01-02T00:00:00Z", tr.Data[0].Fields["day"].GetStringValue())
+	require.Equal(t, 1.0, tr.Data[0].Fields["measure_0"].GetNumberValue())
+	require.Equal(t, 1.0, tr.Data[0].Fields["measure_2"].GetNumberValue())
+
+	require.Equal(t, "msn.com", tr.Data[1].Fields["domain"].GetStringValue())
+	require.Equal(t, "2022-01-01T00:00:00Z", tr.Data[1].Fields["day"].GetStringValue())
+	require.Equal(t, 2.0, tr.Data[1].Fields["measure_2"].GetNumberValue())
+}
+
+func TestServer_MetricsViewAggregation_measure_sort_limit(t *testing.T) {
+	t.Parallel()
+	server, instanceId := getMetricsTestServer(t, "ad_bids_2rows")
+
+	tr, err := server.MetricsViewAggregation(testCtx(), &runtimev1.MetricsViewAggregationRequest{
+		InstanceId:      instanceId,
+		MetricsViewName: "ad_bids_metrics",
+		Dimensions: []*runtimev1.MetricsViewAggregationDimension{
+			{Name: "domain"},
+		},
+		MeasureNames: []string{"measure_2"},
+		Sort: []*runtimev1.MetricsViewAggregationSort{
+			{Name: "measure_2", Desc: true},
+		},
+		Limit: 1,
+	})
+	require.NoError(t, err)
+	require.Equal(t, 1, len(tr.Data))
+	require.Equal(t, "msn.com", tr.Data[0].Fields["domain"].GetStringValue())
+	require.Equal(t, 2.0, tr.Data[0].Fields["measure_2"].GetNumberValue())
+}
+
+func TestServer_MetricsViewAggregation_pivot(t *testing.T) {
+	t.Parallel()
+	server, instanceId := getMetricsTestServer(t, "ad_bids_2rows")
+
+	tr, err := server.MetricsViewAggregation(testCtx(), &runtimev1.MetricsViewAggregationRequest{
+		InstanceId:      instanceId,
+		MetricsViewName: "ad_bids_metrics",
+		Dimensions: []*runtimev1.MetricsViewAggregationDimension{
+			{Name: "ad words"},
+			{Name: "domain"},
+		},
+		MeasureNames: []string{"measure_2"},
+		PivotOn:      "domain",
+	})
+	require.NoError(t, err)
+	require.Equal(t, 3, len(tr.Meta))
+	require.Equal(t, "ad words", tr.Meta[0].Name)
+	require.Equal(t, "msn.com_measure_2", tr.Meta[1].Name)
+	require.Equal(t, "yahoo.com_measure_2", tr.Meta[2].Name)
+
+	require.Equal(t, 1, len(tr.Data))
+	require.Equal(t, "cars", tr.Data[0].Fields["ad words"].GetStringValue())
+	require.Equal(t, 2.0, tr.Data[0].Fields["msn.com_measure_2"].GetNumberValue())
+	require.Equal(t, 1.0, tr.Data[0].Fields["yahoo.com_measure_2"].GetNumberValue())
+}
+
+func TestServer_MetricsViewAggregation_pivot_sort_on_pivot(t *testing.T) {
+	t.Parallel()
+	server, instanceId := getMetricsTestServer(t, "ad_bids_2rows")
+
+	_, err := server.MetricsViewAggregation(testCtx(), &runtimev1.MetricsViewAggregationRequest{
+		InstanceId:      instanceId,
+		MetricsViewName: "ad_bids_metrics",
+		Dimensions: []*runtimev1.MetricsViewAggregationDimension{
+			{Name: "ad words"},
+			{Name: "domain"},
+		},
+		MeasureNames: []string{"measure_2"},
+		Sort: []*runtimev1.MetricsViewAggregationSort{
+			{Name: "domain"},
+		},
+		PivotOn: "domain",
+	})
+	require.ErrorContains(t, err, "can't sort by 'domain'")
+}
+
+func TestServer_MetricsViewAggregation_time_grain_on_dimension(t *testing.T) {
+	t.Parallel()
+	server, instanceId := getMetricsTestServer(t, "ad_bids_2rows")
+
+	_, err := server.MetricsViewAggregation(testCtx(), &runtimev1.MetricsViewAggregationRequest{
+		InstanceId:      instanceId,
+		MetricsViewName: "ad_bids_metrics",
+		Dimensions: []*runtimev1.MetricsViewAggregationDimension{
+			{Name: "domain", TimeGrain: runtimev1.TimeGrain_TIME_GRAIN_DAY},
+		},
+		MeasureNames: []string{"measure_2"},
+	})
+	require.ErrorContains(t, err, "time grain can only be set for the time dimension")
+}
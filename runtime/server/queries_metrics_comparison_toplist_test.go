@@ -926,3 +926,44 @@ func TestServer_MetricsViewComparisonToplist_no_comparison_complete_source_sanit
 	require.True(t, len(tr.Rows) > 1)
 	require.Equal(t, 1, len(tr.Rows[0].MeasureValues))
 }
+
+func TestServer_MetricsViewComparisonToplist_having_comparison_value(t *testing.T) {
+	t.Parallel()
+	server, instanceId := getMetricsTestServer(t, "ad_bids_2rows")
+
+	tr, err := server.MetricsViewComparisonToplist(testCtx(), &runtimev1.MetricsViewComparisonToplistRequest{
+		InstanceId:      instanceId,
+		MetricsViewName: "ad_bids_metrics",
+		DimensionName:   "domain",
+		MeasureNames:    []string{"measure_0"},
+		BaseTimeRange: &runtimev1.TimeRange{
+			Start: parseTimeToProtoTimeStamps(t, "2022-01-01T00:00:00Z"),
+			End:   parseTimeToProtoTimeStamps(t, "2022-01-01T23:59:00Z"),
+		},
+		ComparisonTimeRange: &runtimev1.TimeRange{
+			Start: parseTimeToProtoTimeStamps(t, "2022-01-02T00:00:00Z"),
+			End:   parseTimeToProtoTimeStamps(t, "2022-01-02T23:59:00Z"),
+		},
+		Sort: []*runtimev1.MetricsViewComparisonSort{
+			{
+				MeasureName: "measure_0",
+				Type:        runtimev1.MetricsViewComparisonSortType_METRICS_VIEW_COMPARISON_SORT_TYPE_BASE_VALUE,
+			},
+		},
+		Having: &runtimev1.Expression{
+			Expression: &runtimev1.Expression_Cond{
+				Cond: &runtimev1.Condition{
+					Op: runtimev1.Operation_OPERATION_GT,
+					Exprs: []*runtimev1.Expression{
+						{Expression: &runtimev1.Expression_Ident{Ident: "measure_2__comparison"}},
+						{Expression: &runtimev1.Expression_Val{Val: structpb.NewNumberValue(0)}},
+					},
+				},
+			},
+		},
+	})
+	require.NoError(t, err)
+	require.Equal(t, 1, len(tr.Rows))
+	require.Equal(t, "yahoo.com", tr.Rows[0].DimensionValue.GetStringValue())
+	require.Equal(t, 1.0, tr.Rows[0].MeasureValues[0].ComparisonValue.GetNumberValue())
+}
@@ -0,0 +1,61 @@
+package server
+
+import (
+	"testing"
+
+	runtimev1 "github.com/rilldata/rill/proto/gen/rill/runtime/v1"
+	"github.com/rilldata/rill/runtime/server/auth"
+	"github.com/stretchr/testify/require"
+)
+
+func TestServer_MetricsViewSecurity_RowFilter(t *testing.T) {
+	t.Parallel()
+	server, instanceID := getMetricsTestServer(t, "ad_bids_2rows")
+
+	toplist := func(attrs map[string]any) (*runtimev1.MetricsViewToplistResponse, error) {
+		ctx := testClaimsCtx([]auth.Permission{auth.ReadMetrics}, nil, attrs)
+		return server.MetricsViewToplist(ctx, &runtimev1.MetricsViewToplistRequest{
+			InstanceId:      instanceID,
+			MetricsViewName: "ad_bids_secure_metrics",
+			DimensionName:   "domain",
+			MeasureNames:    []string{"measure_0"},
+			Sort:            []*runtimev1.MetricsViewSort{{Name: "measure_0"}},
+		})
+	}
+
+	tr, err := toplist(map[string]any{"email": "alice@example.org", "domain": "msn.com"})
+	require.NoError(t, err)
+	require.Len(t, tr.Data, 1)
+	require.Equal(t, "msn.com", tr.Data[0].Fields["domain"].GetStringValue())
+
+	// Attributes are quoted, so they can't escape the row filter
+	tr, err = toplist(map[string]any{"email": "alice@example.org", "domain": "msn.com' OR 1=1 --"})
+	require.NoError(t, err)
+	require.Len(t, tr.Data, 0)
+
+	// The access condition denies requesters outside the domain
+	_, err = toplist(map[string]any{"email": "mallory@example.com", "domain": "msn.com"})
+	require.ErrorIs(t, err, ErrForbidden)
+}
+
+func TestServer_MetricsViewSecurity_Totals(t *testing.T) {
+	t.Parallel()
+	server, instanceID := getMetricsTestServer(t, "ad_bids_2rows")
+
+	ctx := testClaimsCtx([]auth.Permission{auth.ReadMetrics}, nil, map[string]any{"email": "alice@example.org", "domain": "yahoo.com"})
+	tr, err := server.MetricsViewTotals(ctx, &runtimev1.MetricsViewTotalsRequest{
+		InstanceId:      instanceID,
+		MetricsViewName: "ad_bids_secure_metrics",
+		MeasureNames:    []string{"measure_0"},
+	})
+	require.NoError(t, err)
+	require.Equal(t, 1.0, tr.Data.Fields["measure_0"].GetNumberValue())
+
+	ctx = testClaimsCtx([]auth.Permission{auth.ReadMetrics}, nil, map[string]any{"email": "mallory@example.com", "domain": "yahoo.com"})
+	_, err = server.MetricsViewTotals(ctx, &runtimev1.MetricsViewTotalsRequest{
+		InstanceId:      instanceID,
+		MetricsViewName: "ad_bids_secure_metrics",
+		MeasureNames:    []string{"measure_0"},
+	})
+	require.ErrorIs(t, err, ErrForbidden)
+}
@@ -379,3 +379,40 @@ func TestServer_MetricsViewToplist_DimensionsByName(t *testing.T) {
 	require.True(t, len(tr.Data) > 1)
 	require.Equal(t, 2, len(tr.Data[0].Fields))
 }
+
+func TestServer_MetricsViewToplist_having(t *testing.T) {
+	t.Parallel()
+	server, instanceId := getMetricsTestServer(t, "ad_bids_2rows")
+
+	tr, err := server.MetricsViewToplist(testCtx(), &runtimev1.MetricsViewToplistRequest{
+		InstanceId:      instanceId,
+		MetricsViewName: "ad_bids_metrics",
+		DimensionName:   "domain",
+		MeasureNames:    []string{"measure_0"},
+		InlineMeasures: []*runtimev1.InlineMeasure{
+			{
+				Name:       "tmp_measure",
+				Expression: "sum(impressions)",
+			},
+		},
+		Sort: []*runtimev1.MetricsViewSort{
+			{
+				Name: "measure_0",
+			},
+		},
+		Having: &runtimev1.Expression{
+			Expression: &runtimev1.Expression_Cond{
+				Cond: &runtimev1.Condition{
+					Op: runtimev1.Operation_OPERATION_GT,
+					Exprs: []*runtimev1.Expression{
+						{Expression: &runtimev1.Expression_Ident{Ident: "tmp_measure"}},
+						{Expression: &runtimev1.Expression_Val{Val: structpb.NewNumberValue(1)}},
+					},
+				},
+			},
+		},
+	})
+	require.NoError(t, err)
+	require.Equal(t, 1, len(tr.Data))
+	require.Equal(t, "msn.com", tr.Data[0].Fields["domain"].GetStringValue())
+}
@@ -0,0 +1,67 @@
+package server
+
+import (
+	"context"
+	"errors"
+
+	runtimev1 "github.com/rilldata/rill/proto/gen/rill/runtime/v1"
+	"github.com/rilldata/rill/runtime/drivers"
+	"github.com/rilldata/rill/runtime/pkg/observability"
+	"github.com/rilldata/rill/runtime/server/auth"
+	"go.opentelemetry.io/otel/attribute"
+	"google.golang.org/grpc/codes"
+	"google.golang.org/grpc/status"
+)
+
+// GetQueryCacheStats implements RuntimeService.
+func (s *Server) GetQueryCacheStats(ctx context.Context, req *runtimev1.GetQueryCacheStatsRequest) (*runtimev1.GetQueryCacheStatsResponse, error) {
+	observability.AddRequestAttributes(ctx,
+		attribute.String("args.instance_id", req.InstanceId),
+	)
+
+	s.addInstanceRequestAttributes(ctx, req.InstanceId)
+
+	if !auth.GetClaims(ctx).Can(auth.ManageInstances) {
+		return nil, ErrForbidden
+	}
+
+	_, err := s.runtime.FindInstance(ctx, req.InstanceId)
+	if err != nil {
+		if errors.Is(err, drivers.ErrNotFound) {
+			return nil, status.Error(codes.InvalidArgument, "instance not found")
+		}
+		return nil, status.Error(codes.InvalidArgument, err.Error())
+	}
+
+	stats := s.runtime.QueryCacheStats(req.InstanceId)
+	return &runtimev1.GetQueryCacheStatsResponse{
+		Hits:      stats.Hits,
+		Misses:    stats.Misses,
+		Items:     stats.Items,
+		SizeBytes: stats.SizeBytes,
+	}, nil
+}
+
+// ClearQueryCache implements RuntimeService.
+func (s *Server) ClearQueryCache(ctx context.Context, req *runtimev1.ClearQueryCacheRequest) (*runtimev1.ClearQueryCacheResponse, error) {
+	observability.AddRequestAttributes(ctx,
+		attribute.String("args.instance_id", req.InstanceId),
+	)
+
+	s.addInstanceRequestAttributes(ctx, req.InstanceId)
+
+	if !auth.GetClaims(ctx).Can(auth.ManageInstances) {
+		return nil, ErrForbidden
+	}
+
+	_, err := s.runtime.FindInstance(ctx, req.InstanceId)
+	if err != nil {
+		if errors.Is(err, drivers.ErrNotFound) {
+			return nil, status.Error(codes.InvalidArgument, "instance not found")
+		}
+		return nil, status.Error(codes.InvalidArgument, err.Error())
+	}
+
+	s.runtime.ClearQueryCache(req.InstanceId)
+	return &runtimev1.ClearQueryCacheResponse{}, nil
+}
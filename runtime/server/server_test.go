@@ -23,3 +23,55 @@ func getTestServer(t *testing.T) (*Server, string) {
 func testCtx() context.Context {
 	return auth.WithOpen(context.Background())
 }
+
+// testClaimsCtx returns a context with claims that allow the given instance permissions on all instances.
+// If metricsViews is not empty, metrics queries are restricted to the metrics views with the given names.
+func testClaimsCtx(perms []auth.Permission, metricsViews []string, attrs map[string]any) context.Context {
+	return auth.WithClaims(context.Background(), &testClaims{perms: perms, metricsViews: metricsViews, attrs: attrs})
+}
+
+type testClaims struct {
+	perms        []auth.Permission
+	metricsViews []string
+	attrs        map[string]any
+}
+
+func (c *testClaims) Subject() string {
+	return "test"
+}
+
+func (c *testClaims) Can(p auth.Permission) bool {
+	return false
+}
+
+func (c *testClaims) CanInstance(instanceID string, p auth.Permission) bool {
+	for _, p2 := range c.perms {
+		if p2 == p {
+			return true
+		}
+	}
+	return false
+}
+
+func (c *testClaims) CanMetricsView(name string) bool {
+	if len(c.metricsViews) == 0 {
+		return true
+	}
+	for _, mv := range c.metricsViews {
+		if mv == name {
+			return true
+		}
+	}
+	return false
+}
+
+func (c *testClaims) Scoped() bool {
+	return len(c.metricsViews) > 0
+}
+
+func (c *testClaims) Attributes() map[string]any {
+	if c.attrs == nil {
+		return map[string]any{}
+	}
+	return c.attrs
+}
@@ -5,6 +5,7 @@ import (
 	"context"
 	"errors"
 	"fmt"
+	"path/filepath"
 	"regexp"
 	"strconv"
 	"strings"
@@ -55,27 +56,17 @@ func Read(ctx context.Context, repoStore drivers.RepoStore, registryStore driver
 	// Hacky way to set a materialization default
 	materializeDefault, _ := strconv.ParseBool(instance.Variables["__materialize_default"])
 
-	// this is required in order to be able to use .env.KEY and not .KEY in template placeholders
-	env := map[string]map[string]string{"env": instance.ResolveVariables()}
-
-	// Add Sprig template functions (removing functions that leak host info)
-	// Derived from Helm: https://github.com/helm/helm/blob/main/pkg/engine/funcs.go
-	funcMap := sprig.TxtFuncMap()
-	delete(funcMap, "env")
-	delete(funcMap, "expandenv")
-
-	// convert templatised artifact
-	t, err := template.New("source").Funcs(funcMap).Option("missingkey=error").Parse(blob)
-	if err != nil {
-		return nil, err
+	// Security policies of dashboards contain templates that are resolved against the claims of each request, so we defer their resolution
+	if filepath.Base(filepath.Dir(filePath)) == "dashboards" {
+		blob = escapeSecurityTemplates(blob)
 	}
 
-	bw := new(bytes.Buffer)
-	if err := t.Execute(bw, env); err != nil {
+	blob, err = resolveTemplate(blob, instance.ResolveVariables())
+	if err != nil {
 		return nil, err
 	}
 
-	catalog, err := artifact.DeSerialise(ctx, filePath, bw.String(), materializeDefault)
+	catalog, err := artifact.DeSerialise(ctx, filePath, blob, materializeDefault)
 	if err != nil {
 		return nil, err
 	}
@@ -103,6 +94,53 @@ func Write(ctx context.Context, repoStore drivers.RepoStore, instID string, cata
 	return repoStore.Put(ctx, catalog.Path, strings.NewReader(blob))
 }
 
+// resolveTemplate resolves a templatised artifact using the instance's variables
+func resolveTemplate(blob string, vars map[string]string) (string, error) {
+	// this is required in order to be able to use .env.KEY and not .KEY in template placeholders
+	env := map[string]map[string]string{"env": vars}
+
+	// Add Sprig template functions (removing functions that leak host info)
+	// Derived from Helm: https://github.com/helm/helm/blob/main/pkg/engine/funcs.go
+	funcMap := sprig.TxtFuncMap()
+	delete(funcMap, "env")
+	delete(funcMap, "expandenv")
+
+	// convert templatised artifact
+	t, err := template.New("source").Funcs(funcMap).Option("missingkey=error").Parse(blob)
+	if err != nil {
+		return "", err
+	}
+
+	bw := new(bytes.Buffer)
+	if err := t.Execute(bw, env); err != nil {
+		return "", err
+	}
+
+	return bw.String(), nil
+}
+
+var templateDelimsReplacer = strings.NewReplacer("{{", `{{"{{"}}`, "}}", `{{"}}"}}`)
+
+// escapeSecurityTemplates escapes the template delimiters in the top-level "security" block of a YAML artifact.
+// After resolveTemplate, the block contains the original (unresolved) templates.
+func escapeSecurityTemplates(blob string) string {
+	lines := strings.SplitAfter(blob, "\n")
+	inBlock := false
+	for i, line := range lines {
+		trimmed := strings.TrimSpace(line)
+		if strings.HasPrefix(line, "security:") {
+			inBlock = true
+		} else if trimmed != "" && !strings.HasPrefix(trimmed, "#") && !strings.HasPrefix(line, " ") && !strings.HasPrefix(line, "\t") && !strings.HasPrefix(line, "-") {
+			// Another top-level key
+			inBlock = false
+		}
+		if inBlock {
+			lines[i] = templateDelimsReplacer.Replace(line)
+		}
+	}
+	return strings.Join(lines, "")
+}
+
 var regex = regexp.MustCompile("^[a-zA-Z_][a-zA-Z0-9_]*$")
 
 func IsValidName(itemName string) bool {
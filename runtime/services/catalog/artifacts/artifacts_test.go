@@ -129,9 +129,28 @@ region: us-east-2
 							Description: "Mea1_D",
 							Format:      "humanise",
 						},
+						{
+							Name:  "measure_0_7d",
+							Label: "Mea0_7D",
+							Window: &runtimev1.MetricsView_MeasureWindow{
+								Measure: "measure_0",
+								Type:    runtimev1.MetricsView_MeasureWindow_TYPE_ROLLING_AVG,
+								Size:    7,
+							},
+						},
 					},
 					Label:       "dashboard name",
 					Description: "long description for dashboard",
+					Security: &runtimev1.MetricsView_Security{
+						Access:    "{{ .claims.admin }}",
+						RowFilter: "domain = {{ .claims.domain | quote }}",
+						Exclude: []*runtimev1.MetricsView_FieldCondition{
+							{
+								Condition: "{{ not .claims.admin }}",
+								Names:     []string{"avg_measure"},
+							},
+						},
+					},
 				},
 			},
 			`title: dashboard name
@@ -161,6 +180,21 @@ measures:
       expression: avg(c1)
       description: Mea1_D
       format_preset: humanise
+    - label: Mea0_7D
+      name: measure_0_7d
+      expression: ""
+      description: ""
+      format_preset: ""
+      window:
+        measure: measure_0
+        type: rolling_avg
+        size: 7
+security:
+    access: '{{ .claims.admin }}'
+    row_filter: domain = {{ .claims.domain | quote }}
+    exclude:
+        - if: '{{ not .claims.admin }}'
+          names: [avg_measure]
 `,
 		},
 	}
@@ -633,6 +667,34 @@ region: {{.env.region}}
 	}
 }
 
+func TestReadDashboardWithEnvVariables(t *testing.T) {
+	repoStore := repoStore(t)
+	content := `model: Model
+dimensions:
+    - name: region
+      column: region
+measures:
+    - name: measure_0
+      expression: count(*) filter (where region = '{{ .env.region }}')
+security:
+    access: '{{ .claims.admin }}'
+    row_filter: domain = {{ .claims.domain | quote }}
+    exclude:
+        - if: '{{ not .claims.admin }}'
+          names: [measure_0]
+`
+	require.NoError(t, repoStore.Put(context.Background(), "dashboards/Dashboard.yaml", bytes.NewReader([]byte(content))))
+
+	got, err := artifacts.Read(context.Background(), repoStore, registryStore(t), "test", "dashboards/Dashboard.yaml")
+	require.NoError(t, err)
+
+	mv := got.GetMetricsView()
+	require.Equal(t, "count(*) filter (where region = 'us-east-2')", mv.Measures[0].Expression)
+	require.Equal(t, "{{ .claims.admin }}", mv.Security.Access)
+	require.Equal(t, "domain = {{ .claims.domain | quote }}", mv.Security.RowFilter)
+	require.Equal(t, "{{ not .claims.admin }}", mv.Security.Exclude[0].Condition)
+}
+
 func TestMetricsViewAvailableTimeZones(t *testing.T) {
 	repoStore := repoStore(t)
 	registryStore := registryStore(t)
@@ -688,6 +750,134 @@ available_time_zones:
 	}
 }
 
+func TestMetricsViewCalendar(t *testing.T) {
+	repoStore := repoStore(t)
+	registryStore := registryStore(t)
+	tests := []struct {
+		name     string
+		filePath string
+		content  string
+		want     *drivers.CatalogEntry
+		wantErr  bool
+	}{
+		{
+			name:     "fiscal calendar",
+			filePath: "dashboards/dashboard.yaml",
+			content: `
+first_day_of_week: 7
+first_month_of_year: 2
+`,
+			want: &drivers.CatalogEntry{
+				Name: "dashboard",
+				Path: "dashboards/dashboard.yaml",
+				Type: drivers.ObjectTypeMetricsView,
+				Object: &runtimev1.MetricsView{
+					Name:             "dashboard",
+					FirstDayOfWeek:   7,
+					FirstMonthOfYear: 2,
+				},
+			},
+			wantErr: false,
+		},
+		{
+			name:     "invalid first day of week",
+			filePath: "dashboards/dashboard.yaml",
+			content: `
+first_day_of_week: 8
+`,
+			want:    nil,
+			wantErr: true,
+		},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			require.NoError(t, repoStore.Put(context.Background(), tt.filePath, bytes.NewReader([]byte(tt.content))))
+			got, err := artifacts.Read(context.Background(), repoStore, registryStore, "test", tt.filePath)
+			if (err != nil) != tt.wantErr {
+				t.Errorf("Read() error = %v, wantErr %v", err, tt.wantErr)
+				return
+			}
+			if !reflect.DeepEqual(got, tt.want) {
+				t.Errorf("Read() = %v, want %v", got, tt.want)
+			}
+		})
+	}
+}
+
+func TestMetricsViewMeasureKinds(t *testing.T) {
+	repoStore := repoStore(t)
+	registryStore := registryStore(t)
+	tests := []struct {
+		name     string
+		filePath string
+		content  string
+		want     *drivers.CatalogEntry
+		wantErr  bool
+	}{
+		{
+			name:     "count distinct",
+			filePath: "dashboards/dashboard.yaml",
+			content: `
+measures:
+  - name: users
+    kind: count_distinct
+    column: user_id
+    approximate: true
+`,
+			want: &drivers.CatalogEntry{
+				Name: "dashboard",
+				Path: "dashboards/dashboard.yaml",
+				Type: drivers.ObjectTypeMetricsView,
+				Object: &runtimev1.MetricsView{
+					Name: "dashboard",
+					Measures: []*runtimev1.MetricsView_Measure{
+						{Name: "users", Kind: runtimev1.MetricsView_Measure_KIND_COUNT_DISTINCT, Column: "user_id", Approximate: true},
+					},
+				},
+			},
+			wantErr: false,
+		},
+		{
+			name:     "kind with expression",
+			filePath: "dashboards/dashboard.yaml",
+			content: `
+measures:
+  - name: users
+    kind: count_distinct
+    column: user_id
+    expression: count(distinct user_id)
+`,
+			want:    nil,
+			wantErr: true,
+		},
+		{
+			name:     "percentile without kind",
+			filePath: "dashboards/dashboard.yaml",
+			content: `
+measures:
+  - name: latency
+    expression: avg(latency)
+    percentile: 0.9
+`,
+			want:    nil,
+			wantErr: true,
+		},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			require.NoError(t, repoStore.Put(context.Background(), tt.filePath, bytes.NewReader([]byte(tt.content))))
+			got, err := artifacts.Read(context.Background(), repoStore, registryStore, "test", tt.filePath)
+			if (err != nil) != tt.wantErr {
+				t.Errorf("Read() error = %v, wantErr %v", err, tt.wantErr)
+				return
+			}
+			if !reflect.DeepEqual(got, tt.want) {
+				t.Errorf("Read() = %v, want %v", got, tt.want)
+			}
+		})
+	}
+}
+
 func repoStore(t *testing.T) drivers.RepoStore {
 	dir := t.TempDir()
 	fileStore, err := drivers.Open("file", map[string]any{"dsn": dir}, false, zap.NewNop())
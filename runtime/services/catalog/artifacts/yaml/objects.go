@@ -13,6 +13,7 @@ import (
 	"github.com/rilldata/rill/runtime/drivers"
 	"github.com/rilldata/rill/runtime/pkg/duration"
 	"github.com/rilldata/rill/runtime/pkg/fileutil"
+	"github.com/rilldata/rill/runtime/pkg/measures"
 	"google.golang.org/protobuf/types/known/structpb"
 
 	// Load IANA time zone data
@@ -64,8 +65,11 @@ type MetricsView struct {
 	SmallestTimeGrain  string   `yaml:"smallest_time_grain"`
 	DefaultTimeRange   string   `yaml:"default_time_range"`
 	AvailableTimeZones []string `yaml:"available_time_zones,omitempty"`
+	FirstDayOfWeek     uint32   `yaml:"first_day_of_week,omitempty"`
+	FirstMonthOfYear   uint32   `yaml:"first_month_of_year,omitempty"`
 	Dimensions         []*Dimension
 	Measures           []*Measure
+	Security           *Security `yaml:"security,omitempty" copier:"-"`
 }
 
 type Measure struct {
@@ -73,9 +77,33 @@ type Measure struct {
 	Name                string
 	Expression          string
 	Description         string
-	Format              string `yaml:"format_preset"`
-	Ignore              bool   `yaml:"ignore,omitempty"`
-	ValidPercentOfTotal bool   `yaml:"valid_percent_of_total,omitempty"`
+	Format              string         `yaml:"format_preset"`
+	Ignore              bool           `yaml:"ignore,omitempty"`
+	ValidPercentOfTotal bool           `yaml:"valid_percent_of_total,omitempty"`
+	Requires            []string       `yaml:"requires,omitempty"`
+	Window              *MeasureWindow `yaml:"window,omitempty" copier:"-"`
+	Kind                string         `yaml:"kind,omitempty" copier:"-"`
+	Column              string         `yaml:"column,omitempty"`
+	Approximate         bool           `yaml:"approximate,omitempty"`
+	Percentile          float64        `yaml:"percentile,omitempty"`
+}
+
+type MeasureWindow struct {
+	Measure string `yaml:"measure"`
+	Type    string `yaml:"type"`
+	Size    uint32 `yaml:"size,omitempty"`
+}
+
+type Security struct {
+	Access    string            `yaml:"access,omitempty"`
+	RowFilter string            `yaml:"row_filter,omitempty"`
+	Include   []*FieldCondition `yaml:"include,omitempty"`
+	Exclude   []*FieldCondition `yaml:"exclude,omitempty"`
+}
+
+type FieldCondition struct {
+	Condition string   `yaml:"if"`
+	Names     []string `yaml:"names,flow"`
 }
 
 type Dimension struct {
@@ -146,6 +174,36 @@ func toMetricsViewArtifact(catalog *drivers.CatalogEntry) (*MetricsView, error)
 		return nil, err
 	}
 
+	for i, measure := range catalog.GetMetricsView().Measures {
+		metricsArtifact.Measures[i].Kind = getMeasureKindString(measure.Kind)
+		if measure.Window != nil {
+			metricsArtifact.Measures[i].Window = &MeasureWindow{
+				Measure: measure.Window.Measure,
+				Type:    getMeasureWindowTypeString(measure.Window.Type),
+				Size:    measure.Window.Size,
+			}
+		}
+	}
+
+	if security := catalog.GetMetricsView().Security; security != nil {
+		metricsArtifact.Security = &Security{
+			Access:    security.Access,
+			RowFilter: security.RowFilter,
+		}
+		for _, cond := range security.Include {
+			metricsArtifact.Security.Include = append(metricsArtifact.Security.Include, &FieldCondition{
+				Condition: cond.Condition,
+				Names:     cond.Names,
+			})
+		}
+		for _, cond := range security.Exclude {
+			metricsArtifact.Security.Exclude = append(metricsArtifact.Security.Exclude, &FieldCondition{
+				Condition: cond.Condition,
+				Names:     cond.Names,
+			})
+		}
+	}
+
 	return metricsArtifact, nil
 }
 
@@ -371,6 +429,14 @@ func fromMetricsViewArtifact(metrics *MetricsView, path string) (*drivers.Catalo
 		}
 	}
 
+	// validate calendar
+	if metrics.FirstDayOfWeek > 7 {
+		return nil, fmt.Errorf("invalid first_day_of_week: must be between 1 (Monday) and 7 (Sunday)")
+	}
+	if metrics.FirstMonthOfYear > 12 {
+		return nil, fmt.Errorf("invalid first_month_of_year: must be between 1 (January) and 12 (December)")
+	}
+
 	err := copier.Copy(apiMetrics, metrics)
 	if err != nil {
 		return nil, err
@@ -383,6 +449,42 @@ func fromMetricsViewArtifact(metrics *MetricsView, path string) (*drivers.Catalo
 		}
 	}
 
+	for i, measure := range metrics.Measures {
+		if measure.Window == nil {
+			continue
+		}
+		windowType, err := getMeasureWindowTypeEnum(measure.Window.Type)
+		if err != nil {
+			return nil, err
+		}
+		size := measure.Window.Size
+		if size == 0 && windowType != runtimev1.MetricsView_MeasureWindow_TYPE_ROLLING_SUM && windowType != runtimev1.MetricsView_MeasureWindow_TYPE_ROLLING_AVG {
+			// lag and delta windows default to the previous time bucket
+			size = 1
+		}
+		// measure names are case insensitive, but queries look up measures by their exact name
+		base := measure.Window.Measure
+		for _, m := range apiMetrics.Measures {
+			if strings.EqualFold(m.Name, base) {
+				base = m.Name
+				break
+			}
+		}
+		apiMetrics.Measures[i].Window = &runtimev1.MetricsView_MeasureWindow{
+			Measure: base,
+			Type:    windowType,
+			Size:    size,
+		}
+	}
+
+	for i, measure := range metrics.Measures {
+		kind, err := getMeasureKindEnum(measure)
+		if err != nil {
+			return nil, fmt.Errorf("measure %q: %w", apiMetrics.Measures[i].Name, err)
+		}
+		apiMetrics.Measures[i].Kind = kind
+	}
+
 	// backwards compatibility where name was used as property
 	for i, dimension := range apiMetrics.Dimensions {
 		if dimension.Name == "" {
@@ -402,6 +504,25 @@ func fromMetricsViewArtifact(metrics *MetricsView, path string) (*drivers.Catalo
 	}
 	apiMetrics.SmallestTimeGrain = timeGrainEnum
 
+	if metrics.Security != nil {
+		apiMetrics.Security = &runtimev1.MetricsView_Security{
+			Access:    metrics.Security.Access,
+			RowFilter: metrics.Security.RowFilter,
+		}
+		for _, cond := range metrics.Security.Include {
+			apiMetrics.Security.Include = append(apiMetrics.Security.Include, &runtimev1.MetricsView_FieldCondition{
+				Condition: cond.Condition,
+				Names:     cond.Names,
+			})
+		}
+		for _, cond := range metrics.Security.Exclude {
+			apiMetrics.Security.Exclude = append(apiMetrics.Security.Exclude, &runtimev1.MetricsView_FieldCondition{
+				Condition: cond.Condition,
+				Names:     cond.Names,
+			})
+		}
+	}
+
 	name := fileutil.Stem(path)
 	apiMetrics.Name = name
 	return &drivers.CatalogEntry{
@@ -465,3 +586,69 @@ func getTimeGrainString(timeGrain runtimev1.TimeGrain) string {
 		return ""
 	}
 }
+
+// Get Measure kind enum from the measure's kind string and validate the typed measure properties
+func getMeasureKindEnum(measure *Measure) (runtimev1.MetricsView_Measure_Kind, error) {
+	k, err := measures.ParseKind(measure.Kind)
+	if err != nil {
+		return runtimev1.MetricsView_Measure_KIND_UNSPECIFIED, err
+	}
+	if k == measures.KindUnspecified {
+		if measure.Column != "" || measure.Approximate || measure.Percentile != 0 {
+			return runtimev1.MetricsView_Measure_KIND_UNSPECIFIED, fmt.Errorf("column, approximate and percentile can only be set for measures with a kind")
+		}
+		return runtimev1.MetricsView_Measure_KIND_UNSPECIFIED, nil
+	}
+	if measure.Expression != "" || measure.Window != nil || len(measure.Requires) > 0 {
+		return runtimev1.MetricsView_Measure_KIND_UNSPECIFIED, fmt.Errorf("a measure with a kind can't have an expression, requires or window")
+	}
+	if err := measures.ValidateKind(k, measure.Column, measure.Approximate, measure.Percentile); err != nil {
+		return runtimev1.MetricsView_Measure_KIND_UNSPECIFIED, err
+	}
+	return runtimev1.MetricsView_Measure_Kind(k), nil
+}
+
+// Get Measure kind string from enum
+func getMeasureKindString(kind runtimev1.MetricsView_Measure_Kind) string {
+	return measures.Kind(kind).String()
+}
+
+// Get MeasureWindow type enum from string
+func getMeasureWindowTypeEnum(windowType string) (runtimev1.MetricsView_MeasureWindow_Type, error) {
+	switch strings.ToLower(windowType) {
+	case "rolling_sum":
+		return runtimev1.MetricsView_MeasureWindow_TYPE_ROLLING_SUM, nil
+	case "rolling_avg":
+		return runtimev1.MetricsView_MeasureWindow_TYPE_ROLLING_AVG, nil
+	case "cumulative_sum":
+		return runtimev1.MetricsView_MeasureWindow_TYPE_CUMULATIVE_SUM, nil
+	case "lag":
+		return runtimev1.MetricsView_MeasureWindow_TYPE_LAG, nil
+	case "delta_abs":
+		return runtimev1.MetricsView_MeasureWindow_TYPE_DELTA_ABS, nil
+	case "delta_rel":
+		return runtimev1.MetricsView_MeasureWindow_TYPE_DELTA_REL, nil
+	default:
+		return runtimev1.MetricsView_MeasureWindow_TYPE_UNSPECIFIED, fmt.Errorf("invalid window type: %s", windowType)
+	}
+}
+
+// Get MeasureWindow type string from enum
+func getMeasureWindowTypeString(windowType runtimev1.MetricsView_MeasureWindow_Type) string {
+	switch windowType {
+	case runtimev1.MetricsView_MeasureWindow_TYPE_ROLLING_SUM:
+		return "rolling_sum"
+	case runtimev1.MetricsView_MeasureWindow_TYPE_ROLLING_AVG:
+		return "rolling_avg"
+	case runtimev1.MetricsView_MeasureWindow_TYPE_CUMULATIVE_SUM:
+		return "cumulative_sum"
+	case runtimev1.MetricsView_MeasureWindow_TYPE_LAG:
+		return "lag"
+	case runtimev1.MetricsView_MeasureWindow_TYPE_DELTA_ABS:
+		return "delta_abs"
+	case runtimev1.MetricsView_MeasureWindow_TYPE_DELTA_REL:
+		return "delta_rel"
+	default:
+		return ""
+	}
+}
@@ -6,6 +6,7 @@ import (
 	"strings"
 
 	runtimev1 "github.com/rilldata/rill/proto/gen/rill/runtime/v1"
+	"github.com/rilldata/rill/runtime/compilers/rillv1beta"
 	"github.com/rilldata/rill/runtime/drivers"
 	"github.com/rilldata/rill/runtime/pkg/fileutil"
 	"github.com/rilldata/rill/runtime/services/catalog/artifacts"
@@ -58,6 +59,9 @@ func (s *Service) getMigrationItems(
 	hasFileObject := false
 
 	catalog, err := artifacts.Read(ctx, s.Repo, s.RegistryStore, s.InstID, repoPath)
+	if err == nil {
+		err = s.applyDashboardDefaults(ctx, catalog)
+	}
 	if err != nil {
 		item = s.newMigrationItemFromError(repoPath, err)
 		items = []*MigrationItem{item}
@@ -128,6 +132,30 @@ func (s *Service) getMigrationItems(
 	return items
 }
 
+// applyDashboardDefaults sets the defaults from rill.yaml for the options that are not set in a dashboard.
+func (s *Service) applyDashboardDefaults(ctx context.Context, catalog *drivers.CatalogEntry) error {
+	mv := catalog.GetMetricsView()
+	if mv == nil || (mv.FirstDayOfWeek != 0 && mv.FirstMonthOfYear != 0) {
+		return nil
+	}
+
+	proj, err := rillv1beta.New(s.Repo, s.InstID).ProjectConfig(ctx)
+	if err != nil {
+		return err
+	}
+	if proj.Dashboards == nil {
+		return nil
+	}
+
+	if mv.FirstDayOfWeek == 0 {
+		mv.FirstDayOfWeek = proj.Dashboards.FirstDayOfWeek
+	}
+	if mv.FirstMonthOfYear == 0 {
+		mv.FirstMonthOfYear = proj.Dashboards.FirstMonthOfYear
+	}
+	return nil
+}
+
 func (s *Service) newMigrationItemFromError(repoPath string, err error) *MigrationItem {
 	item := &MigrationItem{
 		Type: MigrationNoChange,
@@ -106,6 +106,20 @@ func (s *Service) Reconcile(ctx context.Context, conf ReconcileConfig) (*Reconci
 		if err := s.setProjectConnectorsAndVariables(ctx); err != nil {
 			return nil, err
 		}
+
+		// dashboards inherit defaults from rill.yaml, so they need to be updated when it changes
+		if len(conf.ChangedPaths) > 0 {
+			paths, err := s.Repo.ListRecursive(ctx, "dashboards/*.{yaml,yml}")
+			if err != nil {
+				return nil, err
+			}
+			for _, path := range paths {
+				if !slices.Contains(conf.ChangedPaths, path) {
+					conf.ChangedPaths = append(conf.ChangedPaths, path)
+				}
+				conf.ForcedPaths = append(conf.ForcedPaths, path)
+			}
+		}
 	}
 
 	// collect repos and create migration items
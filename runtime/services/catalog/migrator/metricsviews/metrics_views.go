@@ -9,6 +9,7 @@ import (
 
 	runtimev1 "github.com/rilldata/rill/proto/gen/rill/runtime/v1"
 	"github.com/rilldata/rill/runtime/drivers"
+	"github.com/rilldata/rill/runtime/pkg/measures"
 	"github.com/rilldata/rill/runtime/services/catalog/migrator"
 	"go.uber.org/zap"
 )
@@ -138,7 +139,12 @@ func (m *metricsViewMigrator) Validate(ctx context.Context, olap drivers.OLAPSto
 			continue
 		}
 
-		err := validateMeasure(ctx, olap, model, measure)
+		var err error
+		if measure.Window != nil {
+			err = validateMeasureWindow(mv.Measures, measure)
+		} else {
+			err = validateMeasure(ctx, olap, model, mv.Measures, measure)
+		}
 		if err != nil {
 			validationErrors = append(validationErrors, &runtimev1.ReconcileError{
 				Code:         runtimev1.ReconcileError_CODE_VALIDATION,
@@ -169,10 +175,61 @@ func (m *metricsViewMigrator) ExistsInOlap(ctx context.Context, olap drivers.OLA
 	return true, nil
 }
 
-func validateMeasure(ctx context.Context, olap drivers.OLAPStore, model *drivers.Table, measure *runtimev1.MetricsView_Measure) error {
-	err := olap.Exec(ctx, &drivers.Statement{
-		Query:  fmt.Sprintf("SELECT %s from \"%s\"", measure.Expression, model.Name),
+// validateMeasure dry runs the measure's expression. Derived measures are expanded first, which also checks their dependencies.
+func validateMeasure(ctx context.Context, olap drivers.OLAPStore, model *drivers.Table, ms []*runtimev1.MetricsView_Measure, measure *runtimev1.MetricsView_Measure) error {
+	for _, r := range measure.Requires {
+		if dep := lookupMeasure(ms, r); dep != nil && dep.Window != nil {
+			return fmt.Errorf("measure %q can't require window measure %q", measure.Name, dep.Name)
+		}
+	}
+	if measure.Kind != runtimev1.MetricsView_Measure_KIND_UNSPECIFIED {
+		if _, err := measures.Compile(olap.Dialect(), measures.Kind(measure.Kind), measure.Column, measure.Approximate, measure.Percentile); err != nil {
+			return err
+		}
+	}
+	// Errors of other typed measures are reported when validating them
+	ms, _ = measures.CompileTyped[runtimev1.MetricsView_Measure_Kind](olap.Dialect(), ms, func(typed *runtimev1.MetricsView_Measure, expr string) *runtimev1.MetricsView_Measure {
+		return &runtimev1.MetricsView_Measure{Name: typed.Name, Expression: expr}
+	})
+	expr, err := measures.Expand(ms, measure.Name)
+	if err != nil {
+		return err
+	}
+	err = olap.Exec(ctx, &drivers.Statement{
+		Query:  fmt.Sprintf("SELECT %s from \"%s\"", expr, model.Name),
 		DryRun: true,
 	})
 	return err
 }
+
+// validateMeasureWindow checks that a window measure is computed over a regular measure and has a valid size.
+func validateMeasureWindow(ms []*runtimev1.MetricsView_Measure, measure *runtimev1.MetricsView_Measure) error {
+	if measure.Expression != "" {
+		return fmt.Errorf("window measure %q can't have an expression", measure.Name)
+	}
+	base := lookupMeasure(ms, measure.Window.Measure)
+	if base == nil {
+		return fmt.Errorf("window measure %q is computed over measure %q, which does not exist", measure.Name, measure.Window.Measure)
+	}
+	if base.Window != nil {
+		return fmt.Errorf("window measure %q can't be computed over window measure %q", measure.Name, base.Name)
+	}
+	switch measure.Window.Type {
+	case runtimev1.MetricsView_MeasureWindow_TYPE_ROLLING_SUM, runtimev1.MetricsView_MeasureWindow_TYPE_ROLLING_AVG:
+		if measure.Window.Size == 0 {
+			return fmt.Errorf("window measure %q must have a size", measure.Name)
+		}
+	case runtimev1.MetricsView_MeasureWindow_TYPE_UNSPECIFIED:
+		return fmt.Errorf("window measure %q must have a type", measure.Name)
+	}
+	return nil
+}
+
+func lookupMeasure(ms []*runtimev1.MetricsView_Measure, name string) *runtimev1.MetricsView_Measure {
+	for _, m := range ms {
+		if strings.EqualFold(m.Name, name) {
+			return m
+		}
+	}
+	return nil
+}
@@ -29,6 +29,8 @@ import type {
   V1DeleteOrganizationResponse,
   V1UpdateOrganizationResponse,
   AdminServiceUpdateOrganizationBody,
+  V1ListAuditEventsResponse,
+  AdminServiceListAuditEventsParams,
   V1ListOrganizationInvitesResponse,
   AdminServiceListOrganizationInvitesParams,
   V1ListOrganizationMembersResponse,
@@ -40,6 +42,13 @@ import type {
   V1SetOrganizationMemberRoleResponse,
   AdminServiceSetOrganizationMemberRoleBodyBody,
   V1LeaveOrganizationResponse,
+  V1ListAlertsResponse,
+  V1CreateAlertResponse,
+  AdminServiceCreateAlertBody,
+  V1GetAlertResponse,
+  V1DeleteAlertResponse,
+  V1EditAlertResponse,
+  AdminServiceEditAlertBody,
   V1GetGitCredentialsResponse,
   V1ListProjectInvitesResponse,
   AdminServiceListProjectInvitesParams,
@@ -48,6 +57,13 @@ import type {
   V1AddProjectMemberResponse,
   V1RemoveProjectMemberResponse,
   V1SetProjectMemberRoleResponse,
+  V1ListReportsResponse,
+  V1CreateReportResponse,
+  AdminServiceCreateReportBody,
+  V1GetReportResponse,
+  V1DeleteReportResponse,
+  V1EditReportResponse,
+  AdminServiceEditReportBody,
   V1ListWhitelistedDomainsResponse,
   V1CreateWhitelistedDomainResponse,
   AdminServiceCreateWhitelistedDomainBody,
@@ -60,6 +76,8 @@ import type {
   V1DeleteProjectResponse,
   V1UpdateProjectResponse,
   AdminServiceUpdateProjectBody,
+  V1ListPreviewDeploymentsResponse,
+  V1GetPreviewDeploymentResponse,
   V1GetProjectVariablesResponse,
   V1UpdateProjectVariablesResponse,
   AdminServiceUpdateProjectVariablesBody,
@@ -75,6 +93,7 @@ import type {
   V1TriggerRedeployResponse,
   V1TriggerRedeployRequest,
   V1RevokeServiceAuthTokenResponse,
+  V1GetSharedBookmarkResponse,
   V1ListSuperusersResponse,
   V1SetSuperuserResponse,
   V1SetSuperuserRequest,
@@ -97,6 +116,11 @@ import type {
   V1CreateBookmarkRequest,
   V1GetBookmarkResponse,
   V1RemoveBookmarkResponse,
+  V1UpdateBookmarkResponse,
+  AdminServiceUpdateBookmarkBody,
+  V1CreateBookmarkShareTokenResponse,
+  AdminServiceCreateBookmarkShareTokenBody,
+  V1RevokeBookmarkShareTokenResponse,
   V1GetCurrentUserResponse,
   V1UpdateUserPreferencesResponse,
   V1UpdateUserPreferencesRequest,
@@ -706,6 +730,100 @@ export const createAdminServiceUpdateOrganization = <
 
   return createMutation(mutationOptions);
 };
+/**
+ * @summary ListAuditEvents lists the changes made to an organization and its projects, from newest to oldest
+ */
+export const adminServiceListAuditEvents = (
+  organization: string,
+  params?: AdminServiceListAuditEventsParams,
+  signal?: AbortSignal
+) => {
+  return httpClient<V1ListAuditEventsResponse>({
+    url: `/v1/organizations/${organization}/audit-events`,
+    method: "get",
+    params,
+    signal,
+  });
+};
+
+export const getAdminServiceListAuditEventsQueryKey = (
+  organization: string,
+  params?: AdminServiceListAuditEventsParams
+) =>
+  [
+    `/v1/organizations/${organization}/audit-events`,
+    ...(params ? [params] : []),
+  ] as const;
+
+export const getAdminServiceListAuditEventsQueryOptions = <
+  TData = Awaited<ReturnType<typeof adminServiceListAuditEvents>>,
+  TError = RpcStatus
+>(
+  organization: string,
+  params?: AdminServiceListAuditEventsParams,
+  options?: {
+    query?: CreateQueryOptions<
+      Awaited<ReturnType<typeof adminServiceListAuditEvents>>,
+      TError,
+      TData
+    >;
+  }
+): CreateQueryOptions<
+  Awaited<ReturnType<typeof adminServiceListAuditEvents>>,
+  TError,
+  TData
+> & { queryKey: QueryKey } => {
+  const { query: queryOptions } = options ?? {};
+
+  const queryKey =
+    queryOptions?.queryKey ??
+    getAdminServiceListAuditEventsQueryKey(organization, params);
+
+  const queryFn: QueryFunction<
+    Awaited<ReturnType<typeof adminServiceListAuditEvents>>
+  > = ({ signal }) => adminServiceListAuditEvents(organization, params, signal);
+
+  return { queryKey, queryFn, enabled: !!organization, ...queryOptions };
+};
+
+export type AdminServiceListAuditEventsQueryResult = NonNullable<
+  Awaited<ReturnType<typeof adminServiceListAuditEvents>>
+>;
+export type AdminServiceListAuditEventsQueryError = RpcStatus;
+
+/**
+ * @summary ListAuditEvents lists the changes made to an organization and its projects, from newest to oldest
+ */
+export const createAdminServiceListAuditEvents = <
+  TData = Awaited<ReturnType<typeof adminServiceListAuditEvents>>,
+  TError = RpcStatus
+>(
+  organization: string,
+  params?: AdminServiceListAuditEventsParams,
+  options?: {
+    query?: CreateQueryOptions<
+      Awaited<ReturnType<typeof adminServiceListAuditEvents>>,
+      TError,
+      TData
+    >;
+  }
+): CreateQueryResult<TData, TError> & { queryKey: QueryKey } => {
+  const queryOptions = getAdminServiceListAuditEventsQueryOptions(
+    organization,
+    params,
+    options
+  );
+
+  const query = createQuery(queryOptions) as CreateQueryResult<
+    TData,
+    TError
+  > & { queryKey: QueryKey };
+
+  query.queryKey = queryOptions.queryKey;
+
+  return query;
+};
+
 /**
  * @summary ListOrganizationInvites lists all the org invites
  */
@@ -1207,43 +1325,40 @@ export const createAdminServiceLeaveOrganization = <
   return createMutation(mutationOptions);
 };
 /**
- * @summary GetGitCredentials returns credentials and other details for a project's Git repository.
+ * @summary ListAlerts lists the threshold alerts of a project
  */
-export const adminServiceGetGitCredentials = (
+export const adminServiceListAlerts = (
   organization: string,
   project: string,
   signal?: AbortSignal
 ) => {
-  return httpClient<V1GetGitCredentialsResponse>({
-    url: `/v1/organizations/${organization}/projects/${project}/git-credentials`,
+  return httpClient<V1ListAlertsResponse>({
+    url: `/v1/organizations/${organization}/projects/${project}/alerts`,
     method: "get",
     signal,
   });
 };
 
-export const getAdminServiceGetGitCredentialsQueryKey = (
+export const getAdminServiceListAlertsQueryKey = (
   organization: string,
   project: string
-) =>
-  [
-    `/v1/organizations/${organization}/projects/${project}/git-credentials`,
-  ] as const;
+) => [`/v1/organizations/${organization}/projects/${project}/alerts`] as const;
 
-export const getAdminServiceGetGitCredentialsQueryOptions = <
-  TData = Awaited<ReturnType<typeof adminServiceGetGitCredentials>>,
+export const getAdminServiceListAlertsQueryOptions = <
+  TData = Awaited<ReturnType<typeof adminServiceListAlerts>>,
   TError = RpcStatus
 >(
   organization: string,
   project: string,
   options?: {
     query?: CreateQueryOptions<
-      Awaited<ReturnType<typeof adminServiceGetGitCredentials>>,
+      Awaited<ReturnType<typeof adminServiceListAlerts>>,
       TError,
       TData
     >;
   }
 ): CreateQueryOptions<
-  Awaited<ReturnType<typeof adminServiceGetGitCredentials>>,
+  Awaited<ReturnType<typeof adminServiceListAlerts>>,
   TError,
   TData
 > & { queryKey: QueryKey } => {
@@ -1251,12 +1366,11 @@ export const getAdminServiceGetGitCredentialsQueryOptions = <
 
   const queryKey =
     queryOptions?.queryKey ??
-    getAdminServiceGetGitCredentialsQueryKey(organization, project);
+    getAdminServiceListAlertsQueryKey(organization, project);
 
   const queryFn: QueryFunction<
-    Awaited<ReturnType<typeof adminServiceGetGitCredentials>>
-  > = ({ signal }) =>
-    adminServiceGetGitCredentials(organization, project, signal);
+    Awaited<ReturnType<typeof adminServiceListAlerts>>
+  > = ({ signal }) => adminServiceListAlerts(organization, project, signal);
 
   return {
     queryKey,
@@ -1266,29 +1380,29 @@ export const getAdminServiceGetGitCredentialsQueryOptions = <
   };
 };
 
-export type AdminServiceGetGitCredentialsQueryResult = NonNullable<
-  Awaited<ReturnType<typeof adminServiceGetGitCredentials>>
+export type AdminServiceListAlertsQueryResult = NonNullable<
+  Awaited<ReturnType<typeof adminServiceListAlerts>>
 >;
-export type AdminServiceGetGitCredentialsQueryError = RpcStatus;
+export type AdminServiceListAlertsQueryError = RpcStatus;
 
 /**
- * @summary GetGitCredentials returns credentials and other details for a project's Git repository.
+ * @summary ListAlerts lists the threshold alerts of a project
  */
-export const createAdminServiceGetGitCredentials = <
-  TData = Awaited<ReturnType<typeof adminServiceGetGitCredentials>>,
+export const createAdminServiceListAlerts = <
+  TData = Awaited<ReturnType<typeof adminServiceListAlerts>>,
   TError = RpcStatus
 >(
   organization: string,
   project: string,
   options?: {
     query?: CreateQueryOptions<
-      Awaited<ReturnType<typeof adminServiceGetGitCredentials>>,
+      Awaited<ReturnType<typeof adminServiceListAlerts>>,
       TError,
       TData
     >;
   }
 ): CreateQueryResult<TData, TError> & { queryKey: QueryKey } => {
-  const queryOptions = getAdminServiceGetGitCredentialsQueryOptions(
+  const queryOptions = getAdminServiceListAlertsQueryOptions(
     organization,
     project,
     options
@@ -1305,153 +1419,128 @@ export const createAdminServiceGetGitCredentials = <
 };
 
 /**
- * @summary ListProjectInvites lists all the project invites
+ * @summary CreateAlert creates an alert that notifies recipients and a webhook when a metrics view measure crosses a threshold
  */
-export const adminServiceListProjectInvites = (
+export const adminServiceCreateAlert = (
   organization: string,
   project: string,
-  params?: AdminServiceListProjectInvitesParams,
-  signal?: AbortSignal
+  adminServiceCreateAlertBody: AdminServiceCreateAlertBody
 ) => {
-  return httpClient<V1ListProjectInvitesResponse>({
-    url: `/v1/organizations/${organization}/projects/${project}/invites`,
-    method: "get",
-    params,
-    signal,
+  return httpClient<V1CreateAlertResponse>({
+    url: `/v1/organizations/${organization}/projects/${project}/alerts`,
+    method: "post",
+    headers: { "Content-Type": "application/json" },
+    data: adminServiceCreateAlertBody,
   });
 };
 
-export const getAdminServiceListProjectInvitesQueryKey = (
-  organization: string,
-  project: string,
-  params?: AdminServiceListProjectInvitesParams
-) =>
-  [
-    `/v1/organizations/${organization}/projects/${project}/invites`,
-    ...(params ? [params] : []),
-  ] as const;
-
-export const getAdminServiceListProjectInvitesQueryOptions = <
-  TData = Awaited<ReturnType<typeof adminServiceListProjectInvites>>,
-  TError = RpcStatus
->(
-  organization: string,
-  project: string,
-  params?: AdminServiceListProjectInvitesParams,
-  options?: {
-    query?: CreateQueryOptions<
-      Awaited<ReturnType<typeof adminServiceListProjectInvites>>,
-      TError,
-      TData
-    >;
-  }
-): CreateQueryOptions<
-  Awaited<ReturnType<typeof adminServiceListProjectInvites>>,
+export const getAdminServiceCreateAlertMutationOptions = <
+  TError = RpcStatus,
+  TContext = unknown
+>(options?: {
+  mutation?: CreateMutationOptions<
+    Awaited<ReturnType<typeof adminServiceCreateAlert>>,
+    TError,
+    {
+      organization: string;
+      project: string;
+      data: AdminServiceCreateAlertBody;
+    },
+    TContext
+  >;
+}): CreateMutationOptions<
+  Awaited<ReturnType<typeof adminServiceCreateAlert>>,
   TError,
-  TData
-> & { queryKey: QueryKey } => {
-  const { query: queryOptions } = options ?? {};
-
-  const queryKey =
-    queryOptions?.queryKey ??
-    getAdminServiceListProjectInvitesQueryKey(organization, project, params);
+  { organization: string; project: string; data: AdminServiceCreateAlertBody },
+  TContext
+> => {
+  const { mutation: mutationOptions } = options ?? {};
 
-  const queryFn: QueryFunction<
-    Awaited<ReturnType<typeof adminServiceListProjectInvites>>
-  > = ({ signal }) =>
-    adminServiceListProjectInvites(organization, project, params, signal);
+  const mutationFn: MutationFunction<
+    Awaited<ReturnType<typeof adminServiceCreateAlert>>,
+    {
+      organization: string;
+      project: string;
+      data: AdminServiceCreateAlertBody;
+    }
+  > = (props) => {
+    const { organization, project, data } = props ?? {};
 
-  return {
-    queryKey,
-    queryFn,
-    enabled: !!(organization && project),
-    ...queryOptions,
+    return adminServiceCreateAlert(organization, project, data);
   };
+
+  return { mutationFn, ...mutationOptions };
 };
 
-export type AdminServiceListProjectInvitesQueryResult = NonNullable<
-  Awaited<ReturnType<typeof adminServiceListProjectInvites>>
+export type AdminServiceCreateAlertMutationResult = NonNullable<
+  Awaited<ReturnType<typeof adminServiceCreateAlert>>
 >;
-export type AdminServiceListProjectInvitesQueryError = RpcStatus;
+export type AdminServiceCreateAlertMutationBody = AdminServiceCreateAlertBody;
+export type AdminServiceCreateAlertMutationError = RpcStatus;
 
 /**
- * @summary ListProjectInvites lists all the project invites
+ * @summary CreateAlert creates an alert that notifies recipients and a webhook when a metrics view measure crosses a threshold
  */
-export const createAdminServiceListProjectInvites = <
-  TData = Awaited<ReturnType<typeof adminServiceListProjectInvites>>,
-  TError = RpcStatus
->(
-  organization: string,
-  project: string,
-  params?: AdminServiceListProjectInvitesParams,
-  options?: {
-    query?: CreateQueryOptions<
-      Awaited<ReturnType<typeof adminServiceListProjectInvites>>,
-      TError,
-      TData
-    >;
-  }
-): CreateQueryResult<TData, TError> & { queryKey: QueryKey } => {
-  const queryOptions = getAdminServiceListProjectInvitesQueryOptions(
-    organization,
-    project,
-    params,
-    options
-  );
-
-  const query = createQuery(queryOptions) as CreateQueryResult<
-    TData,
-    TError
-  > & { queryKey: QueryKey };
-
-  query.queryKey = queryOptions.queryKey;
+export const createAdminServiceCreateAlert = <
+  TError = RpcStatus,
+  TContext = unknown
+>(options?: {
+  mutation?: CreateMutationOptions<
+    Awaited<ReturnType<typeof adminServiceCreateAlert>>,
+    TError,
+    {
+      organization: string;
+      project: string;
+      data: AdminServiceCreateAlertBody;
+    },
+    TContext
+  >;
+}) => {
+  const mutationOptions = getAdminServiceCreateAlertMutationOptions(options);
 
-  return query;
+  return createMutation(mutationOptions);
 };
-
 /**
- * @summary ListProjectMembers lists all the project members
+ * @summary GetAlert returns a threshold alert
  */
-export const adminServiceListProjectMembers = (
+export const adminServiceGetAlert = (
   organization: string,
   project: string,
-  params?: AdminServiceListProjectMembersParams,
+  name: string,
   signal?: AbortSignal
 ) => {
-  return httpClient<V1ListProjectMembersResponse>({
-    url: `/v1/organizations/${organization}/projects/${project}/members`,
+  return httpClient<V1GetAlertResponse>({
+    url: `/v1/organizations/${organization}/projects/${project}/alerts/${name}`,
     method: "get",
-    params,
     signal,
   });
 };
 
-export const getAdminServiceListProjectMembersQueryKey = (
+export const getAdminServiceGetAlertQueryKey = (
   organization: string,
   project: string,
-  params?: AdminServiceListProjectMembersParams
+  name: string
 ) =>
   [
-    `/v1/organizations/${organization}/projects/${project}/members`,
-    ...(params ? [params] : []),
+    `/v1/organizations/${organization}/projects/${project}/alerts/${name}`,
   ] as const;
 
-export const getAdminServiceListProjectMembersQueryOptions = <
-  TData = Awaited<ReturnType<typeof adminServiceListProjectMembers>>,
+export const getAdminServiceGetAlertQueryOptions = <
+  TData = Awaited<ReturnType<typeof adminServiceGetAlert>>,
   TError = RpcStatus
 >(
   organization: string,
   project: string,
-  params?: AdminServiceListProjectMembersParams,
+  name: string,
   options?: {
     query?: CreateQueryOptions<
-      Awaited<ReturnType<typeof adminServiceListProjectMembers>>,
+      Awaited<ReturnType<typeof adminServiceGetAlert>>,
       TError,
       TData
     >;
   }
 ): CreateQueryOptions<
-  Awaited<ReturnType<typeof adminServiceListProjectMembers>>,
+  Awaited<ReturnType<typeof adminServiceGetAlert>>,
   TError,
   TData
 > & { queryKey: QueryKey } => {
@@ -1459,48 +1548,47 @@ export const getAdminServiceListProjectMembersQueryOptions = <
 
   const queryKey =
     queryOptions?.queryKey ??
-    getAdminServiceListProjectMembersQueryKey(organization, project, params);
+    getAdminServiceGetAlertQueryKey(organization, project, name);
 
   const queryFn: QueryFunction<
-    Awaited<ReturnType<typeof adminServiceListProjectMembers>>
-  > = ({ signal }) =>
-    adminServiceListProjectMembers(organization, project, params, signal);
+    Awaited<ReturnType<typeof adminServiceGetAlert>>
+  > = ({ signal }) => adminServiceGetAlert(organization, project, name, signal);
 
   return {
     queryKey,
     queryFn,
-    enabled: !!(organization && project),
+    enabled: !!(organization && project && name),
     ...queryOptions,
   };
 };
 
-export type AdminServiceListProjectMembersQueryResult = NonNullable<
-  Awaited<ReturnType<typeof adminServiceListProjectMembers>>
+export type AdminServiceGetAlertQueryResult = NonNullable<
+  Awaited<ReturnType<typeof adminServiceGetAlert>>
 >;
-export type AdminServiceListProjectMembersQueryError = RpcStatus;
+export type AdminServiceGetAlertQueryError = RpcStatus;
 
 /**
- * @summary ListProjectMembers lists all the project members
+ * @summary GetAlert returns a threshold alert
  */
-export const createAdminServiceListProjectMembers = <
-  TData = Awaited<ReturnType<typeof adminServiceListProjectMembers>>,
+export const createAdminServiceGetAlert = <
+  TData = Awaited<ReturnType<typeof adminServiceGetAlert>>,
   TError = RpcStatus
 >(
   organization: string,
   project: string,
-  params?: AdminServiceListProjectMembersParams,
+  name: string,
   options?: {
     query?: CreateQueryOptions<
-      Awaited<ReturnType<typeof adminServiceListProjectMembers>>,
+      Awaited<ReturnType<typeof adminServiceGetAlert>>,
       TError,
       TData
     >;
   }
 ): CreateQueryResult<TData, TError> & { queryKey: QueryKey } => {
-  const queryOptions = getAdminServiceListProjectMembersQueryOptions(
+  const queryOptions = getAdminServiceGetAlertQueryOptions(
     organization,
     project,
-    params,
+    name,
     options
   );
 
@@ -1515,252 +1603,1157 @@ export const createAdminServiceListProjectMembers = <
 };
 
 /**
- * @summary AddProjectMember adds a member to the project
+ * @summary DeleteAlert deletes a threshold alert
  */
-export const adminServiceAddProjectMember = (
+export const adminServiceDeleteAlert = (
   organization: string,
   project: string,
-  adminServiceAddOrganizationMemberBodyBody: AdminServiceAddOrganizationMemberBodyBody
+  name: string
+) => {
+  return httpClient<V1DeleteAlertResponse>({
+    url: `/v1/organizations/${organization}/projects/${project}/alerts/${name}`,
+    method: "delete",
+  });
+};
+
+export const getAdminServiceDeleteAlertMutationOptions = <
+  TError = RpcStatus,
+  TContext = unknown
+>(options?: {
+  mutation?: CreateMutationOptions<
+    Awaited<ReturnType<typeof adminServiceDeleteAlert>>,
+    TError,
+    { organization: string; project: string; name: string },
+    TContext
+  >;
+}): CreateMutationOptions<
+  Awaited<ReturnType<typeof adminServiceDeleteAlert>>,
+  TError,
+  { organization: string; project: string; name: string },
+  TContext
+> => {
+  const { mutation: mutationOptions } = options ?? {};
+
+  const mutationFn: MutationFunction<
+    Awaited<ReturnType<typeof adminServiceDeleteAlert>>,
+    { organization: string; project: string; name: string }
+  > = (props) => {
+    const { organization, project, name } = props ?? {};
+
+    return adminServiceDeleteAlert(organization, project, name);
+  };
+
+  return { mutationFn, ...mutationOptions };
+};
+
+export type AdminServiceDeleteAlertMutationResult = NonNullable<
+  Awaited<ReturnType<typeof adminServiceDeleteAlert>>
+>;
+
+export type AdminServiceDeleteAlertMutationError = RpcStatus;
+
+/**
+ * @summary DeleteAlert deletes a threshold alert
+ */
+export const createAdminServiceDeleteAlert = <
+  TError = RpcStatus,
+  TContext = unknown
+>(options?: {
+  mutation?: CreateMutationOptions<
+    Awaited<ReturnType<typeof adminServiceDeleteAlert>>,
+    TError,
+    { organization: string; project: string; name: string },
+    TContext
+  >;
+}) => {
+  const mutationOptions = getAdminServiceDeleteAlertMutationOptions(options);
+
+  return createMutation(mutationOptions);
+};
+/**
+ * @summary EditAlert updates a threshold alert
+ */
+export const adminServiceEditAlert = (
+  organization: string,
+  project: string,
+  name: string,
+  adminServiceEditAlertBody: AdminServiceEditAlertBody
+) => {
+  return httpClient<V1EditAlertResponse>({
+    url: `/v1/organizations/${organization}/projects/${project}/alerts/${name}`,
+    method: "put",
+    headers: { "Content-Type": "application/json" },
+    data: adminServiceEditAlertBody,
+  });
+};
+
+export const getAdminServiceEditAlertMutationOptions = <
+  TError = RpcStatus,
+  TContext = unknown
+>(options?: {
+  mutation?: CreateMutationOptions<
+    Awaited<ReturnType<typeof adminServiceEditAlert>>,
+    TError,
+    {
+      organization: string;
+      project: string;
+      name: string;
+      data: AdminServiceEditAlertBody;
+    },
+    TContext
+  >;
+}): CreateMutationOptions<
+  Awaited<ReturnType<typeof adminServiceEditAlert>>,
+  TError,
+  {
+    organization: string;
+    project: string;
+    name: string;
+    data: AdminServiceEditAlertBody;
+  },
+  TContext
+> => {
+  const { mutation: mutationOptions } = options ?? {};
+
+  const mutationFn: MutationFunction<
+    Awaited<ReturnType<typeof adminServiceEditAlert>>,
+    {
+      organization: string;
+      project: string;
+      name: string;
+      data: AdminServiceEditAlertBody;
+    }
+  > = (props) => {
+    const { organization, project, name, data } = props ?? {};
+
+    return adminServiceEditAlert(organization, project, name, data);
+  };
+
+  return { mutationFn, ...mutationOptions };
+};
+
+export type AdminServiceEditAlertMutationResult = NonNullable<
+  Awaited<ReturnType<typeof adminServiceEditAlert>>
+>;
+export type AdminServiceEditAlertMutationBody = AdminServiceEditAlertBody;
+export type AdminServiceEditAlertMutationError = RpcStatus;
+
+/**
+ * @summary EditAlert updates a threshold alert
+ */
+export const createAdminServiceEditAlert = <
+  TError = RpcStatus,
+  TContext = unknown
+>(options?: {
+  mutation?: CreateMutationOptions<
+    Awaited<ReturnType<typeof adminServiceEditAlert>>,
+    TError,
+    {
+      organization: string;
+      project: string;
+      name: string;
+      data: AdminServiceEditAlertBody;
+    },
+    TContext
+  >;
+}) => {
+  const mutationOptions = getAdminServiceEditAlertMutationOptions(options);
+
+  return createMutation(mutationOptions);
+};
+/**
+ * @summary GetGitCredentials returns credentials and other details for a project's Git repository.
+ */
+export const adminServiceGetGitCredentials = (
+  organization: string,
+  project: string,
+  signal?: AbortSignal
+) => {
+  return httpClient<V1GetGitCredentialsResponse>({
+    url: `/v1/organizations/${organization}/projects/${project}/git-credentials`,
+    method: "get",
+    signal,
+  });
+};
+
+export const getAdminServiceGetGitCredentialsQueryKey = (
+  organization: string,
+  project: string
+) =>
+  [
+    `/v1/organizations/${organization}/projects/${project}/git-credentials`,
+  ] as const;
+
+export const getAdminServiceGetGitCredentialsQueryOptions = <
+  TData = Awaited<ReturnType<typeof adminServiceGetGitCredentials>>,
+  TError = RpcStatus
+>(
+  organization: string,
+  project: string,
+  options?: {
+    query?: CreateQueryOptions<
+      Awaited<ReturnType<typeof adminServiceGetGitCredentials>>,
+      TError,
+      TData
+    >;
+  }
+): CreateQueryOptions<
+  Awaited<ReturnType<typeof adminServiceGetGitCredentials>>,
+  TError,
+  TData
+> & { queryKey: QueryKey } => {
+  const { query: queryOptions } = options ?? {};
+
+  const queryKey =
+    queryOptions?.queryKey ??
+    getAdminServiceGetGitCredentialsQueryKey(organization, project);
+
+  const queryFn: QueryFunction<
+    Awaited<ReturnType<typeof adminServiceGetGitCredentials>>
+  > = ({ signal }) =>
+    adminServiceGetGitCredentials(organization, project, signal);
+
+  return {
+    queryKey,
+    queryFn,
+    enabled: !!(organization && project),
+    ...queryOptions,
+  };
+};
+
+export type AdminServiceGetGitCredentialsQueryResult = NonNullable<
+  Awaited<ReturnType<typeof adminServiceGetGitCredentials>>
+>;
+export type AdminServiceGetGitCredentialsQueryError = RpcStatus;
+
+/**
+ * @summary GetGitCredentials returns credentials and other details for a project's Git repository.
+ */
+export const createAdminServiceGetGitCredentials = <
+  TData = Awaited<ReturnType<typeof adminServiceGetGitCredentials>>,
+  TError = RpcStatus
+>(
+  organization: string,
+  project: string,
+  options?: {
+    query?: CreateQueryOptions<
+      Awaited<ReturnType<typeof adminServiceGetGitCredentials>>,
+      TError,
+      TData
+    >;
+  }
+): CreateQueryResult<TData, TError> & { queryKey: QueryKey } => {
+  const queryOptions = getAdminServiceGetGitCredentialsQueryOptions(
+    organization,
+    project,
+    options
+  );
+
+  const query = createQuery(queryOptions) as CreateQueryResult<
+    TData,
+    TError
+  > & { queryKey: QueryKey };
+
+  query.queryKey = queryOptions.queryKey;
+
+  return query;
+};
+
+/**
+ * @summary ListProjectInvites lists all the project invites
+ */
+export const adminServiceListProjectInvites = (
+  organization: string,
+  project: string,
+  params?: AdminServiceListProjectInvitesParams,
+  signal?: AbortSignal
+) => {
+  return httpClient<V1ListProjectInvitesResponse>({
+    url: `/v1/organizations/${organization}/projects/${project}/invites`,
+    method: "get",
+    params,
+    signal,
+  });
+};
+
+export const getAdminServiceListProjectInvitesQueryKey = (
+  organization: string,
+  project: string,
+  params?: AdminServiceListProjectInvitesParams
+) =>
+  [
+    `/v1/organizations/${organization}/projects/${project}/invites`,
+    ...(params ? [params] : []),
+  ] as const;
+
+export const getAdminServiceListProjectInvitesQueryOptions = <
+  TData = Awaited<ReturnType<typeof adminServiceListProjectInvites>>,
+  TError = RpcStatus
+>(
+  organization: string,
+  project: string,
+  params?: AdminServiceListProjectInvitesParams,
+  options?: {
+    query?: CreateQueryOptions<
+      Awaited<ReturnType<typeof adminServiceListProjectInvites>>,
+      TError,
+      TData
+    >;
+  }
+): CreateQueryOptions<
+  Awaited<ReturnType<typeof adminServiceListProjectInvites>>,
+  TError,
+  TData
+> & { queryKey: QueryKey } => {
+  const { query: queryOptions } = options ?? {};
+
+  const queryKey =
+    queryOptions?.queryKey ??
+    getAdminServiceListProjectInvitesQueryKey(organization, project, params);
+
+  const queryFn: QueryFunction<
+    Awaited<ReturnType<typeof adminServiceListProjectInvites>>
+  > = ({ signal }) =>
+    adminServiceListProjectInvites(organization, project, params, signal);
+
+  return {
+    queryKey,
+    queryFn,
+    enabled: !!(organization && project),
+    ...queryOptions,
+  };
+};
+
+export type AdminServiceListProjectInvitesQueryResult = NonNullable<
+  Awaited<ReturnType<typeof adminServiceListProjectInvites>>
+>;
+export type AdminServiceListProjectInvitesQueryError = RpcStatus;
+
+/**
+ * @summary ListProjectInvites lists all the project invites
+ */
+export const createAdminServiceListProjectInvites = <
+  TData = Awaited<ReturnType<typeof adminServiceListProjectInvites>>,
+  TError = RpcStatus
+>(
+  organization: string,
+  project: string,
+  params?: AdminServiceListProjectInvitesParams,
+  options?: {
+    query?: CreateQueryOptions<
+      Awaited<ReturnType<typeof adminServiceListProjectInvites>>,
+      TError,
+      TData
+    >;
+  }
+): CreateQueryResult<TData, TError> & { queryKey: QueryKey } => {
+  const queryOptions = getAdminServiceListProjectInvitesQueryOptions(
+    organization,
+    project,
+    params,
+    options
+  );
+
+  const query = createQuery(queryOptions) as CreateQueryResult<
+    TData,
+    TError
+  > & { queryKey: QueryKey };
+
+  query.queryKey = queryOptions.queryKey;
+
+  return query;
+};
+
+/**
+ * @summary ListProjectMembers lists all the project members
+ */
+export const adminServiceListProjectMembers = (
+  organization: string,
+  project: string,
+  params?: AdminServiceListProjectMembersParams,
+  signal?: AbortSignal
+) => {
+  return httpClient<V1ListProjectMembersResponse>({
+    url: `/v1/organizations/${organization}/projects/${project}/members`,
+    method: "get",
+    params,
+    signal,
+  });
+};
+
+export const getAdminServiceListProjectMembersQueryKey = (
+  organization: string,
+  project: string,
+  params?: AdminServiceListProjectMembersParams
+) =>
+  [
+    `/v1/organizations/${organization}/projects/${project}/members`,
+    ...(params ? [params] : []),
+  ] as const;
+
+export const getAdminServiceListProjectMembersQueryOptions = <
+  TData = Awaited<ReturnType<typeof adminServiceListProjectMembers>>,
+  TError = RpcStatus
+>(
+  organization: string,
+  project: string,
+  params?: AdminServiceListProjectMembersParams,
+  options?: {
+    query?: CreateQueryOptions<
+      Awaited<ReturnType<typeof adminServiceListProjectMembers>>,
+      TError,
+      TData
+    >;
+  }
+): CreateQueryOptions<
+  Awaited<ReturnType<typeof adminServiceListProjectMembers>>,
+  TError,
+  TData
+> & { queryKey: QueryKey } => {
+  const { query: queryOptions } = options ?? {};
+
+  const queryKey =
+    queryOptions?.queryKey ??
+    getAdminServiceListProjectMembersQueryKey(organization, project, params);
+
+  const queryFn: QueryFunction<
+    Awaited<ReturnType<typeof adminServiceListProjectMembers>>
+  > = ({ signal }) =>
+    adminServiceListProjectMembers(organization, project, params, signal);
+
+  return {
+    queryKey,
+    queryFn,
+    enabled: !!(organization && project),
+    ...queryOptions,
+  };
+};
+
+export type AdminServiceListProjectMembersQueryResult = NonNullable<
+  Awaited<ReturnType<typeof adminServiceListProjectMembers>>
+>;
+export type AdminServiceListProjectMembersQueryError = RpcStatus;
+
+/**
+ * @summary ListProjectMembers lists all the project members
+ */
+export const createAdminServiceListProjectMembers = <
+  TData = Awaited<ReturnType<typeof adminServiceListProjectMembers>>,
+  TError = RpcStatus
+>(
+  organization: string,
+  project: string,
+  params?: AdminServiceListProjectMembersParams,
+  options?: {
+    query?: CreateQueryOptions<
+      Awaited<ReturnType<typeof adminServiceListProjectMembers>>,
+      TError,
+      TData
+    >;
+  }
+): CreateQueryResult<TData, TError> & { queryKey: QueryKey } => {
+  const queryOptions = getAdminServiceListProjectMembersQueryOptions(
+    organization,
+    project,
+    params,
+    options
+  );
+
+  const query = createQuery(queryOptions) as CreateQueryResult<
+    TData,
+    TError
+  > & { queryKey: QueryKey };
+
+  query.queryKey = queryOptions.queryKey;
+
+  return query;
+};
+
+/**
+ * @summary AddProjectMember adds a member to the project
+ */
+export const adminServiceAddProjectMember = (
+  organization: string,
+  project: string,
+  adminServiceAddOrganizationMemberBodyBody: AdminServiceAddOrganizationMemberBodyBody
+) => {
+  return httpClient<V1AddProjectMemberResponse>({
+    url: `/v1/organizations/${organization}/projects/${project}/members`,
+    method: "post",
+    headers: { "Content-Type": "application/json" },
+    data: adminServiceAddOrganizationMemberBodyBody,
+  });
+};
+
+export const getAdminServiceAddProjectMemberMutationOptions = <
+  TError = RpcStatus,
+  TContext = unknown
+>(options?: {
+  mutation?: CreateMutationOptions<
+    Awaited<ReturnType<typeof adminServiceAddProjectMember>>,
+    TError,
+    {
+      organization: string;
+      project: string;
+      data: AdminServiceAddOrganizationMemberBodyBody;
+    },
+    TContext
+  >;
+}): CreateMutationOptions<
+  Awaited<ReturnType<typeof adminServiceAddProjectMember>>,
+  TError,
+  {
+    organization: string;
+    project: string;
+    data: AdminServiceAddOrganizationMemberBodyBody;
+  },
+  TContext
+> => {
+  const { mutation: mutationOptions } = options ?? {};
+
+  const mutationFn: MutationFunction<
+    Awaited<ReturnType<typeof adminServiceAddProjectMember>>,
+    {
+      organization: string;
+      project: string;
+      data: AdminServiceAddOrganizationMemberBodyBody;
+    }
+  > = (props) => {
+    const { organization, project, data } = props ?? {};
+
+    return adminServiceAddProjectMember(organization, project, data);
+  };
+
+  return { mutationFn, ...mutationOptions };
+};
+
+export type AdminServiceAddProjectMemberMutationResult = NonNullable<
+  Awaited<ReturnType<typeof adminServiceAddProjectMember>>
+>;
+export type AdminServiceAddProjectMemberMutationBody =
+  AdminServiceAddOrganizationMemberBodyBody;
+export type AdminServiceAddProjectMemberMutationError = RpcStatus;
+
+/**
+ * @summary AddProjectMember adds a member to the project
+ */
+export const createAdminServiceAddProjectMember = <
+  TError = RpcStatus,
+  TContext = unknown
+>(options?: {
+  mutation?: CreateMutationOptions<
+    Awaited<ReturnType<typeof adminServiceAddProjectMember>>,
+    TError,
+    {
+      organization: string;
+      project: string;
+      data: AdminServiceAddOrganizationMemberBodyBody;
+    },
+    TContext
+  >;
+}) => {
+  const mutationOptions =
+    getAdminServiceAddProjectMemberMutationOptions(options);
+
+  return createMutation(mutationOptions);
+};
+/**
+ * @summary RemoveProjectMember removes member from the project
+ */
+export const adminServiceRemoveProjectMember = (
+  organization: string,
+  project: string,
+  email: string
+) => {
+  return httpClient<V1RemoveProjectMemberResponse>({
+    url: `/v1/organizations/${organization}/projects/${project}/members/${email}`,
+    method: "delete",
+  });
+};
+
+export const getAdminServiceRemoveProjectMemberMutationOptions = <
+  TError = RpcStatus,
+  TContext = unknown
+>(options?: {
+  mutation?: CreateMutationOptions<
+    Awaited<ReturnType<typeof adminServiceRemoveProjectMember>>,
+    TError,
+    { organization: string; project: string; email: string },
+    TContext
+  >;
+}): CreateMutationOptions<
+  Awaited<ReturnType<typeof adminServiceRemoveProjectMember>>,
+  TError,
+  { organization: string; project: string; email: string },
+  TContext
+> => {
+  const { mutation: mutationOptions } = options ?? {};
+
+  const mutationFn: MutationFunction<
+    Awaited<ReturnType<typeof adminServiceRemoveProjectMember>>,
+    { organization: string; project: string; email: string }
+  > = (props) => {
+    const { organization, project, email } = props ?? {};
+
+    return adminServiceRemoveProjectMember(organization, project, email);
+  };
+
+  return { mutationFn, ...mutationOptions };
+};
+
+export type AdminServiceRemoveProjectMemberMutationResult = NonNullable<
+  Awaited<ReturnType<typeof adminServiceRemoveProjectMember>>
+>;
+
+export type AdminServiceRemoveProjectMemberMutationError = RpcStatus;
+
+/**
+ * @summary RemoveProjectMember removes member from the project
+ */
+export const createAdminServiceRemoveProjectMember = <
+  TError = RpcStatus,
+  TContext = unknown
+>(options?: {
+  mutation?: CreateMutationOptions<
+    Awaited<ReturnType<typeof adminServiceRemoveProjectMember>>,
+    TError,
+    { organization: string; project: string; email: string },
+    TContext
+  >;
+}) => {
+  const mutationOptions =
+    getAdminServiceRemoveProjectMemberMutationOptions(options);
+
+  return createMutation(mutationOptions);
+};
+/**
+ * @summary SetProjectMemberRole sets the role for the member
+ */
+export const adminServiceSetProjectMemberRole = (
+  organization: string,
+  project: string,
+  email: string,
+  adminServiceSetOrganizationMemberRoleBodyBody: AdminServiceSetOrganizationMemberRoleBodyBody
 ) => {
-  return httpClient<V1AddProjectMemberResponse>({
-    url: `/v1/organizations/${organization}/projects/${project}/members`,
-    method: "post",
+  return httpClient<V1SetProjectMemberRoleResponse>({
+    url: `/v1/organizations/${organization}/projects/${project}/members/${email}`,
+    method: "put",
     headers: { "Content-Type": "application/json" },
-    data: adminServiceAddOrganizationMemberBodyBody,
+    data: adminServiceSetOrganizationMemberRoleBodyBody,
   });
 };
 
-export const getAdminServiceAddProjectMemberMutationOptions = <
+export const getAdminServiceSetProjectMemberRoleMutationOptions = <
   TError = RpcStatus,
   TContext = unknown
 >(options?: {
   mutation?: CreateMutationOptions<
-    Awaited<ReturnType<typeof adminServiceAddProjectMember>>,
+    Awaited<ReturnType<typeof adminServiceSetProjectMemberRole>>,
     TError,
     {
       organization: string;
       project: string;
-      data: AdminServiceAddOrganizationMemberBodyBody;
+      email: string;
+      data: AdminServiceSetOrganizationMemberRoleBodyBody;
     },
     TContext
   >;
 }): CreateMutationOptions<
-  Awaited<ReturnType<typeof adminServiceAddProjectMember>>,
+  Awaited<ReturnType<typeof adminServiceSetProjectMemberRole>>,
   TError,
   {
     organization: string;
     project: string;
-    data: AdminServiceAddOrganizationMemberBodyBody;
+    email: string;
+    data: AdminServiceSetOrganizationMemberRoleBodyBody;
   },
   TContext
 > => {
   const { mutation: mutationOptions } = options ?? {};
 
   const mutationFn: MutationFunction<
-    Awaited<ReturnType<typeof adminServiceAddProjectMember>>,
+    Awaited<ReturnType<typeof adminServiceSetProjectMemberRole>>,
     {
       organization: string;
       project: string;
-      data: AdminServiceAddOrganizationMemberBodyBody;
+      email: string;
+      data: AdminServiceSetOrganizationMemberRoleBodyBody;
+    }
+  > = (props) => {
+    const { organization, project, email, data } = props ?? {};
+
+    return adminServiceSetProjectMemberRole(organization, project, email, data);
+  };
+
+  return { mutationFn, ...mutationOptions };
+};
+
+export type AdminServiceSetProjectMemberRoleMutationResult = NonNullable<
+  Awaited<ReturnType<typeof adminServiceSetProjectMemberRole>>
+>;
+export type AdminServiceSetProjectMemberRoleMutationBody =
+  AdminServiceSetOrganizationMemberRoleBodyBody;
+export type AdminServiceSetProjectMemberRoleMutationError = RpcStatus;
+
+/**
+ * @summary SetProjectMemberRole sets the role for the member
+ */
+export const createAdminServiceSetProjectMemberRole = <
+  TError = RpcStatus,
+  TContext = unknown
+>(options?: {
+  mutation?: CreateMutationOptions<
+    Awaited<ReturnType<typeof adminServiceSetProjectMemberRole>>,
+    TError,
+    {
+      organization: string;
+      project: string;
+      email: string;
+      data: AdminServiceSetOrganizationMemberRoleBodyBody;
+    },
+    TContext
+  >;
+}) => {
+  const mutationOptions =
+    getAdminServiceSetProjectMemberRoleMutationOptions(options);
+
+  return createMutation(mutationOptions);
+};
+/**
+ * @summary ListReports lists the scheduled reports of a project
+ */
+export const adminServiceListReports = (
+  organization: string,
+  project: string,
+  signal?: AbortSignal
+) => {
+  return httpClient<V1ListReportsResponse>({
+    url: `/v1/organizations/${organization}/projects/${project}/reports`,
+    method: "get",
+    signal,
+  });
+};
+
+export const getAdminServiceListReportsQueryKey = (
+  organization: string,
+  project: string
+) => [`/v1/organizations/${organization}/projects/${project}/reports`] as const;
+
+export const getAdminServiceListReportsQueryOptions = <
+  TData = Awaited<ReturnType<typeof adminServiceListReports>>,
+  TError = RpcStatus
+>(
+  organization: string,
+  project: string,
+  options?: {
+    query?: CreateQueryOptions<
+      Awaited<ReturnType<typeof adminServiceListReports>>,
+      TError,
+      TData
+    >;
+  }
+): CreateQueryOptions<
+  Awaited<ReturnType<typeof adminServiceListReports>>,
+  TError,
+  TData
+> & { queryKey: QueryKey } => {
+  const { query: queryOptions } = options ?? {};
+
+  const queryKey =
+    queryOptions?.queryKey ??
+    getAdminServiceListReportsQueryKey(organization, project);
+
+  const queryFn: QueryFunction<
+    Awaited<ReturnType<typeof adminServiceListReports>>
+  > = ({ signal }) => adminServiceListReports(organization, project, signal);
+
+  return {
+    queryKey,
+    queryFn,
+    enabled: !!(organization && project),
+    ...queryOptions,
+  };
+};
+
+export type AdminServiceListReportsQueryResult = NonNullable<
+  Awaited<ReturnType<typeof adminServiceListReports>>
+>;
+export type AdminServiceListReportsQueryError = RpcStatus;
+
+/**
+ * @summary ListReports lists the scheduled reports of a project
+ */
+export const createAdminServiceListReports = <
+  TData = Awaited<ReturnType<typeof adminServiceListReports>>,
+  TError = RpcStatus
+>(
+  organization: string,
+  project: string,
+  options?: {
+    query?: CreateQueryOptions<
+      Awaited<ReturnType<typeof adminServiceListReports>>,
+      TError,
+      TData
+    >;
+  }
+): CreateQueryResult<TData, TError> & { queryKey: QueryKey } => {
+  const queryOptions = getAdminServiceListReportsQueryOptions(
+    organization,
+    project,
+    options
+  );
+
+  const query = createQuery(queryOptions) as CreateQueryResult<
+    TData,
+    TError
+  > & { queryKey: QueryKey };
+
+  query.queryKey = queryOptions.queryKey;
+
+  return query;
+};
+
+/**
+ * @summary CreateReport creates a scheduled report that emails an export of a metrics view query to a list of recipients
+ */
+export const adminServiceCreateReport = (
+  organization: string,
+  project: string,
+  adminServiceCreateReportBody: AdminServiceCreateReportBody
+) => {
+  return httpClient<V1CreateReportResponse>({
+    url: `/v1/organizations/${organization}/projects/${project}/reports`,
+    method: "post",
+    headers: { "Content-Type": "application/json" },
+    data: adminServiceCreateReportBody,
+  });
+};
+
+export const getAdminServiceCreateReportMutationOptions = <
+  TError = RpcStatus,
+  TContext = unknown
+>(options?: {
+  mutation?: CreateMutationOptions<
+    Awaited<ReturnType<typeof adminServiceCreateReport>>,
+    TError,
+    {
+      organization: string;
+      project: string;
+      data: AdminServiceCreateReportBody;
+    },
+    TContext
+  >;
+}): CreateMutationOptions<
+  Awaited<ReturnType<typeof adminServiceCreateReport>>,
+  TError,
+  { organization: string; project: string; data: AdminServiceCreateReportBody },
+  TContext
+> => {
+  const { mutation: mutationOptions } = options ?? {};
+
+  const mutationFn: MutationFunction<
+    Awaited<ReturnType<typeof adminServiceCreateReport>>,
+    {
+      organization: string;
+      project: string;
+      data: AdminServiceCreateReportBody;
     }
   > = (props) => {
     const { organization, project, data } = props ?? {};
 
-    return adminServiceAddProjectMember(organization, project, data);
+    return adminServiceCreateReport(organization, project, data);
+  };
+
+  return { mutationFn, ...mutationOptions };
+};
+
+export type AdminServiceCreateReportMutationResult = NonNullable<
+  Awaited<ReturnType<typeof adminServiceCreateReport>>
+>;
+export type AdminServiceCreateReportMutationBody = AdminServiceCreateReportBody;
+export type AdminServiceCreateReportMutationError = RpcStatus;
+
+/**
+ * @summary CreateReport creates a scheduled report that emails an export of a metrics view query to a list of recipients
+ */
+export const createAdminServiceCreateReport = <
+  TError = RpcStatus,
+  TContext = unknown
+>(options?: {
+  mutation?: CreateMutationOptions<
+    Awaited<ReturnType<typeof adminServiceCreateReport>>,
+    TError,
+    {
+      organization: string;
+      project: string;
+      data: AdminServiceCreateReportBody;
+    },
+    TContext
+  >;
+}) => {
+  const mutationOptions = getAdminServiceCreateReportMutationOptions(options);
+
+  return createMutation(mutationOptions);
+};
+/**
+ * @summary GetReport returns a scheduled report
+ */
+export const adminServiceGetReport = (
+  organization: string,
+  project: string,
+  name: string,
+  signal?: AbortSignal
+) => {
+  return httpClient<V1GetReportResponse>({
+    url: `/v1/organizations/${organization}/projects/${project}/reports/${name}`,
+    method: "get",
+    signal,
+  });
+};
+
+export const getAdminServiceGetReportQueryKey = (
+  organization: string,
+  project: string,
+  name: string
+) =>
+  [
+    `/v1/organizations/${organization}/projects/${project}/reports/${name}`,
+  ] as const;
+
+export const getAdminServiceGetReportQueryOptions = <
+  TData = Awaited<ReturnType<typeof adminServiceGetReport>>,
+  TError = RpcStatus
+>(
+  organization: string,
+  project: string,
+  name: string,
+  options?: {
+    query?: CreateQueryOptions<
+      Awaited<ReturnType<typeof adminServiceGetReport>>,
+      TError,
+      TData
+    >;
+  }
+): CreateQueryOptions<
+  Awaited<ReturnType<typeof adminServiceGetReport>>,
+  TError,
+  TData
+> & { queryKey: QueryKey } => {
+  const { query: queryOptions } = options ?? {};
+
+  const queryKey =
+    queryOptions?.queryKey ??
+    getAdminServiceGetReportQueryKey(organization, project, name);
+
+  const queryFn: QueryFunction<
+    Awaited<ReturnType<typeof adminServiceGetReport>>
+  > = ({ signal }) =>
+    adminServiceGetReport(organization, project, name, signal);
+
+  return {
+    queryKey,
+    queryFn,
+    enabled: !!(organization && project && name),
+    ...queryOptions,
   };
-
-  return { mutationFn, ...mutationOptions };
 };
 
-export type AdminServiceAddProjectMemberMutationResult = NonNullable<
-  Awaited<ReturnType<typeof adminServiceAddProjectMember>>
+export type AdminServiceGetReportQueryResult = NonNullable<
+  Awaited<ReturnType<typeof adminServiceGetReport>>
 >;
-export type AdminServiceAddProjectMemberMutationBody =
-  AdminServiceAddOrganizationMemberBodyBody;
-export type AdminServiceAddProjectMemberMutationError = RpcStatus;
+export type AdminServiceGetReportQueryError = RpcStatus;
 
 /**
- * @summary AddProjectMember adds a member to the project
+ * @summary GetReport returns a scheduled report
  */
-export const createAdminServiceAddProjectMember = <
-  TError = RpcStatus,
-  TContext = unknown
->(options?: {
-  mutation?: CreateMutationOptions<
-    Awaited<ReturnType<typeof adminServiceAddProjectMember>>,
-    TError,
-    {
-      organization: string;
-      project: string;
-      data: AdminServiceAddOrganizationMemberBodyBody;
-    },
-    TContext
-  >;
-}) => {
-  const mutationOptions =
-    getAdminServiceAddProjectMemberMutationOptions(options);
+export const createAdminServiceGetReport = <
+  TData = Awaited<ReturnType<typeof adminServiceGetReport>>,
+  TError = RpcStatus
+>(
+  organization: string,
+  project: string,
+  name: string,
+  options?: {
+    query?: CreateQueryOptions<
+      Awaited<ReturnType<typeof adminServiceGetReport>>,
+      TError,
+      TData
+    >;
+  }
+): CreateQueryResult<TData, TError> & { queryKey: QueryKey } => {
+  const queryOptions = getAdminServiceGetReportQueryOptions(
+    organization,
+    project,
+    name,
+    options
+  );
 
-  return createMutation(mutationOptions);
+  const query = createQuery(queryOptions) as CreateQueryResult<
+    TData,
+    TError
+  > & { queryKey: QueryKey };
+
+  query.queryKey = queryOptions.queryKey;
+
+  return query;
 };
+
 /**
- * @summary RemoveProjectMember removes member from the project
+ * @summary DeleteReport deletes a scheduled report
  */
-export const adminServiceRemoveProjectMember = (
+export const adminServiceDeleteReport = (
   organization: string,
   project: string,
-  email: string
+  name: string
 ) => {
-  return httpClient<V1RemoveProjectMemberResponse>({
-    url: `/v1/organizations/${organization}/projects/${project}/members/${email}`,
+  return httpClient<V1DeleteReportResponse>({
+    url: `/v1/organizations/${organization}/projects/${project}/reports/${name}`,
     method: "delete",
   });
 };
 
-export const getAdminServiceRemoveProjectMemberMutationOptions = <
+export const getAdminServiceDeleteReportMutationOptions = <
   TError = RpcStatus,
   TContext = unknown
 >(options?: {
   mutation?: CreateMutationOptions<
-    Awaited<ReturnType<typeof adminServiceRemoveProjectMember>>,
+    Awaited<ReturnType<typeof adminServiceDeleteReport>>,
     TError,
-    { organization: string; project: string; email: string },
+    { organization: string; project: string; name: string },
     TContext
   >;
 }): CreateMutationOptions<
-  Awaited<ReturnType<typeof adminServiceRemoveProjectMember>>,
+  Awaited<ReturnType<typeof adminServiceDeleteReport>>,
   TError,
-  { organization: string; project: string; email: string },
+  { organization: string; project: string; name: string },
   TContext
 > => {
   const { mutation: mutationOptions } = options ?? {};
 
   const mutationFn: MutationFunction<
-    Awaited<ReturnType<typeof adminServiceRemoveProjectMember>>,
-    { organization: string; project: string; email: string }
+    Awaited<ReturnType<typeof adminServiceDeleteReport>>,
+    { organization: string; project: string; name: string }
   > = (props) => {
-    const { organization, project, email } = props ?? {};
+    const { organization, project, name } = props ?? {};
 
-    return adminServiceRemoveProjectMember(organization, project, email);
+    return adminServiceDeleteReport(organization, project, name);
   };
 
   return { mutationFn, ...mutationOptions };
 };
 
-export type AdminServiceRemoveProjectMemberMutationResult = NonNullable<
-  Awaited<ReturnType<typeof adminServiceRemoveProjectMember>>
+export type AdminServiceDeleteReportMutationResult = NonNullable<
+  Awaited<ReturnType<typeof adminServiceDeleteReport>>
 >;
 
-export type AdminServiceRemoveProjectMemberMutationError = RpcStatus;
+export type AdminServiceDeleteReportMutationError = RpcStatus;
 
 /**
- * @summary RemoveProjectMember removes member from the project
+ * @summary DeleteReport deletes a scheduled report
  */
-export const createAdminServiceRemoveProjectMember = <
+export const createAdminServiceDeleteReport = <
   TError = RpcStatus,
   TContext = unknown
 >(options?: {
   mutation?: CreateMutationOptions<
-    Awaited<ReturnType<typeof adminServiceRemoveProjectMember>>,
+    Awaited<ReturnType<typeof adminServiceDeleteReport>>,
     TError,
-    { organization: string; project: string; email: string },
+    { organization: string; project: string; name: string },
     TContext
   >;
 }) => {
-  const mutationOptions =
-    getAdminServiceRemoveProjectMemberMutationOptions(options);
+  const mutationOptions = getAdminServiceDeleteReportMutationOptions(options);
 
   return createMutation(mutationOptions);
 };
 /**
- * @summary SetProjectMemberRole sets the role for the member
+ * @summary EditReport updates a scheduled report
  */
-export const adminServiceSetProjectMemberRole = (
+export const adminServiceEditReport = (
   organization: string,
   project: string,
-  email: string,
-  adminServiceSetOrganizationMemberRoleBodyBody: AdminServiceSetOrganizationMemberRoleBodyBody
+  name: string,
+  adminServiceEditReportBody: AdminServiceEditReportBody
 ) => {
-  return httpClient<V1SetProjectMemberRoleResponse>({
-    url: `/v1/organizations/${organization}/projects/${project}/members/${email}`,
+  return httpClient<V1EditReportResponse>({
+    url: `/v1/organizations/${organization}/projects/${project}/reports/${name}`,
     method: "put",
     headers: { "Content-Type": "application/json" },
-    data: adminServiceSetOrganizationMemberRoleBodyBody,
+    data: adminServiceEditReportBody,
   });
 };
 
-export const getAdminServiceSetProjectMemberRoleMutationOptions = <
+export const getAdminServiceEditReportMutationOptions = <
   TError = RpcStatus,
   TContext = unknown
 >(options?: {
   mutation?: CreateMutationOptions<
-    Awaited<ReturnType<typeof adminServiceSetProjectMemberRole>>,
+    Awaited<ReturnType<typeof adminServiceEditReport>>,
     TError,
     {
       organization: string;
       project: string;
-      email: string;
-      data: AdminServiceSetOrganizationMemberRoleBodyBody;
+      name: string;
+      data: AdminServiceEditReportBody;
     },
     TContext
   >;
 }): CreateMutationOptions<
-  Awaited<ReturnType<typeof adminServiceSetProjectMemberRole>>,
+  Awaited<ReturnType<typeof adminServiceEditReport>>,
   TError,
   {
     organization: string;
     project: string;
-    email: string;
-    data: AdminServiceSetOrganizationMemberRoleBodyBody;
+    name: string;
+    data: AdminServiceEditReportBody;
   },
   TContext
 > => {
   const { mutation: mutationOptions } = options ?? {};
 
   const mutationFn: MutationFunction<
-    Awaited<ReturnType<typeof adminServiceSetProjectMemberRole>>,
+    Awaited<ReturnType<typeof adminServiceEditReport>>,
     {
       organization: string;
       project: string;
-      email: string;
-      data: AdminServiceSetOrganizationMemberRoleBodyBody;
+      name: string;
+      data: AdminServiceEditReportBody;
     }
   > = (props) => {
-    const { organization, project, email, data } = props ?? {};
+    const { organization, project, name, data } = props ?? {};
 
-    return adminServiceSetProjectMemberRole(organization, project, email, data);
+    return adminServiceEditReport(organization, project, name, data);
   };
 
   return { mutationFn, ...mutationOptions };
 };
 
-export type AdminServiceSetProjectMemberRoleMutationResult = NonNullable<
-  Awaited<ReturnType<typeof adminServiceSetProjectMemberRole>>
+export type AdminServiceEditReportMutationResult = NonNullable<
+  Awaited<ReturnType<typeof adminServiceEditReport>>
 >;
-export type AdminServiceSetProjectMemberRoleMutationBody =
-  AdminServiceSetOrganizationMemberRoleBodyBody;
-export type AdminServiceSetProjectMemberRoleMutationError = RpcStatus;
+export type AdminServiceEditReportMutationBody = AdminServiceEditReportBody;
+export type AdminServiceEditReportMutationError = RpcStatus;
 
 /**
- * @summary SetProjectMemberRole sets the role for the member
+ * @summary EditReport updates a scheduled report
  */
-export const createAdminServiceSetProjectMemberRole = <
+export const createAdminServiceEditReport = <
   TError = RpcStatus,
   TContext = unknown
 >(options?: {
   mutation?: CreateMutationOptions<
-    Awaited<ReturnType<typeof adminServiceSetProjectMemberRole>>,
+    Awaited<ReturnType<typeof adminServiceEditReport>>,
     TError,
     {
       organization: string;
       project: string;
-      email: string;
-      data: AdminServiceSetOrganizationMemberRoleBodyBody;
+      name: string;
+      data: AdminServiceEditReportBody;
     },
     TContext
   >;
 }) => {
-  const mutationOptions =
-    getAdminServiceSetProjectMemberRoleMutationOptions(options);
+  const mutationOptions = getAdminServiceEditReportMutationOptions(options);
 
   return createMutation(mutationOptions);
 };
@@ -2368,41 +3361,242 @@ export const getAdminServiceUpdateProjectMutationOptions = <
   > = (props) => {
     const { organizationName, name, data } = props ?? {};
 
-    return adminServiceUpdateProject(organizationName, name, data);
-  };
+    return adminServiceUpdateProject(organizationName, name, data);
+  };
+
+  return { mutationFn, ...mutationOptions };
+};
+
+export type AdminServiceUpdateProjectMutationResult = NonNullable<
+  Awaited<ReturnType<typeof adminServiceUpdateProject>>
+>;
+export type AdminServiceUpdateProjectMutationBody =
+  AdminServiceUpdateProjectBody;
+export type AdminServiceUpdateProjectMutationError = RpcStatus;
+
+/**
+ * @summary UpdateProject updates a project
+ */
+export const createAdminServiceUpdateProject = <
+  TError = RpcStatus,
+  TContext = unknown
+>(options?: {
+  mutation?: CreateMutationOptions<
+    Awaited<ReturnType<typeof adminServiceUpdateProject>>,
+    TError,
+    {
+      organizationName: string;
+      name: string;
+      data: AdminServiceUpdateProjectBody;
+    },
+    TContext
+  >;
+}) => {
+  const mutationOptions = getAdminServiceUpdateProjectMutationOptions(options);
+
+  return createMutation(mutationOptions);
+};
+/**
+ * @summary ListPreviewDeployments lists the preview deployments of a project.
+Preview deployments are created for the project's non-prod branches and pull requests.
+ */
+export const adminServiceListPreviewDeployments = (
+  organizationName: string,
+  name: string,
+  signal?: AbortSignal
+) => {
+  return httpClient<V1ListPreviewDeploymentsResponse>({
+    url: `/v1/organizations/${organizationName}/projects/${name}/previews`,
+    method: "get",
+    signal,
+  });
+};
+
+export const getAdminServiceListPreviewDeploymentsQueryKey = (
+  organizationName: string,
+  name: string
+) =>
+  [`/v1/organizations/${organizationName}/projects/${name}/previews`] as const;
+
+export const getAdminServiceListPreviewDeploymentsQueryOptions = <
+  TData = Awaited<ReturnType<typeof adminServiceListPreviewDeployments>>,
+  TError = RpcStatus
+>(
+  organizationName: string,
+  name: string,
+  options?: {
+    query?: CreateQueryOptions<
+      Awaited<ReturnType<typeof adminServiceListPreviewDeployments>>,
+      TError,
+      TData
+    >;
+  }
+): CreateQueryOptions<
+  Awaited<ReturnType<typeof adminServiceListPreviewDeployments>>,
+  TError,
+  TData
+> & { queryKey: QueryKey } => {
+  const { query: queryOptions } = options ?? {};
+
+  const queryKey =
+    queryOptions?.queryKey ??
+    getAdminServiceListPreviewDeploymentsQueryKey(organizationName, name);
+
+  const queryFn: QueryFunction<
+    Awaited<ReturnType<typeof adminServiceListPreviewDeployments>>
+  > = ({ signal }) =>
+    adminServiceListPreviewDeployments(organizationName, name, signal);
+
+  return {
+    queryKey,
+    queryFn,
+    enabled: !!(organizationName && name),
+    ...queryOptions,
+  };
+};
+
+export type AdminServiceListPreviewDeploymentsQueryResult = NonNullable<
+  Awaited<ReturnType<typeof adminServiceListPreviewDeployments>>
+>;
+export type AdminServiceListPreviewDeploymentsQueryError = RpcStatus;
+
+/**
+ * @summary ListPreviewDeployments lists the preview deployments of a project.
+Preview deployments are created for the project's non-prod branches and pull requests.
+ */
+export const createAdminServiceListPreviewDeployments = <
+  TData = Awaited<ReturnType<typeof adminServiceListPreviewDeployments>>,
+  TError = RpcStatus
+>(
+  organizationName: string,
+  name: string,
+  options?: {
+    query?: CreateQueryOptions<
+      Awaited<ReturnType<typeof adminServiceListPreviewDeployments>>,
+      TError,
+      TData
+    >;
+  }
+): CreateQueryResult<TData, TError> & { queryKey: QueryKey } => {
+  const queryOptions = getAdminServiceListPreviewDeploymentsQueryOptions(
+    organizationName,
+    name,
+    options
+  );
+
+  const query = createQuery(queryOptions) as CreateQueryResult<
+    TData,
+    TError
+  > & { queryKey: QueryKey };
+
+  query.queryKey = queryOptions.queryKey;
+
+  return query;
+};
+
+/**
+ * @summary GetPreviewDeployment returns a project's preview deployment for a branch and a JWT for accessing it.
+ */
+export const adminServiceGetPreviewDeployment = (
+  organizationName: string,
+  name: string,
+  branch: string,
+  signal?: AbortSignal
+) => {
+  return httpClient<V1GetPreviewDeploymentResponse>({
+    url: `/v1/organizations/${organizationName}/projects/${name}/previews/${branch}`,
+    method: "get",
+    signal,
+  });
+};
+
+export const getAdminServiceGetPreviewDeploymentQueryKey = (
+  organizationName: string,
+  name: string,
+  branch: string
+) =>
+  [
+    `/v1/organizations/${organizationName}/projects/${name}/previews/${branch}`,
+  ] as const;
+
+export const getAdminServiceGetPreviewDeploymentQueryOptions = <
+  TData = Awaited<ReturnType<typeof adminServiceGetPreviewDeployment>>,
+  TError = RpcStatus
+>(
+  organizationName: string,
+  name: string,
+  branch: string,
+  options?: {
+    query?: CreateQueryOptions<
+      Awaited<ReturnType<typeof adminServiceGetPreviewDeployment>>,
+      TError,
+      TData
+    >;
+  }
+): CreateQueryOptions<
+  Awaited<ReturnType<typeof adminServiceGetPreviewDeployment>>,
+  TError,
+  TData
+> & { queryKey: QueryKey } => {
+  const { query: queryOptions } = options ?? {};
+
+  const queryKey =
+    queryOptions?.queryKey ??
+    getAdminServiceGetPreviewDeploymentQueryKey(organizationName, name, branch);
 
-  return { mutationFn, ...mutationOptions };
+  const queryFn: QueryFunction<
+    Awaited<ReturnType<typeof adminServiceGetPreviewDeployment>>
+  > = ({ signal }) =>
+    adminServiceGetPreviewDeployment(organizationName, name, branch, signal);
+
+  return {
+    queryKey,
+    queryFn,
+    enabled: !!(organizationName && name && branch),
+    ...queryOptions,
+  };
 };
 
-export type AdminServiceUpdateProjectMutationResult = NonNullable<
-  Awaited<ReturnType<typeof adminServiceUpdateProject>>
+export type AdminServiceGetPreviewDeploymentQueryResult = NonNullable<
+  Awaited<ReturnType<typeof adminServiceGetPreviewDeployment>>
 >;
-export type AdminServiceUpdateProjectMutationBody =
-  AdminServiceUpdateProjectBody;
-export type AdminServiceUpdateProjectMutationError = RpcStatus;
+export type AdminServiceGetPreviewDeploymentQueryError = RpcStatus;
 
 /**
- * @summary UpdateProject updates a project
+ * @summary GetPreviewDeployment returns a project's preview deployment for a branch and a JWT for accessing it.
  */
-export const createAdminServiceUpdateProject = <
-  TError = RpcStatus,
-  TContext = unknown
->(options?: {
-  mutation?: CreateMutationOptions<
-    Awaited<ReturnType<typeof adminServiceUpdateProject>>,
-    TError,
-    {
-      organizationName: string;
-      name: string;
-      data: AdminServiceUpdateProjectBody;
-    },
-    TContext
-  >;
-}) => {
-  const mutationOptions = getAdminServiceUpdateProjectMutationOptions(options);
+export const createAdminServiceGetPreviewDeployment = <
+  TData = Awaited<ReturnType<typeof adminServiceGetPreviewDeployment>>,
+  TError = RpcStatus
+>(
+  organizationName: string,
+  name: string,
+  branch: string,
+  options?: {
+    query?: CreateQueryOptions<
+      Awaited<ReturnType<typeof adminServiceGetPreviewDeployment>>,
+      TError,
+      TData
+    >;
+  }
+): CreateQueryResult<TData, TError> & { queryKey: QueryKey } => {
+  const queryOptions = getAdminServiceGetPreviewDeploymentQueryOptions(
+    organizationName,
+    name,
+    branch,
+    options
+  );
 
-  return createMutation(mutationOptions);
+  const query = createQuery(queryOptions) as CreateQueryResult<
+    TData,
+    TError
+  > & { queryKey: QueryKey };
+
+  query.queryKey = queryOptions.queryKey;
+
+  return query;
 };
+
 /**
  * @summary GetProjectVariables returns project variables. NOTE: Get project API doesn't return variables.
  */
@@ -3280,6 +4474,88 @@ export const createAdminServiceRevokeServiceAuthToken = <
 
   return createMutation(mutationOptions);
 };
+/**
+ * @summary GetSharedBookmark returns a shared bookmark and a runtime JWT scoped to its dashboard. It does not require authentication.
+ */
+export const adminServiceGetSharedBookmark = (
+  token: string,
+  signal?: AbortSignal
+) => {
+  return httpClient<V1GetSharedBookmarkResponse>({
+    url: `/v1/shared-bookmarks/${token}`,
+    method: "get",
+    signal,
+  });
+};
+
+export const getAdminServiceGetSharedBookmarkQueryKey = (token: string) =>
+  [`/v1/shared-bookmarks/${token}`] as const;
+
+export const getAdminServiceGetSharedBookmarkQueryOptions = <
+  TData = Awaited<ReturnType<typeof adminServiceGetSharedBookmark>>,
+  TError = RpcStatus
+>(
+  token: string,
+  options?: {
+    query?: CreateQueryOptions<
+      Awaited<ReturnType<typeof adminServiceGetSharedBookmark>>,
+      TError,
+      TData
+    >;
+  }
+): CreateQueryOptions<
+  Awaited<ReturnType<typeof adminServiceGetSharedBookmark>>,
+  TError,
+  TData
+> & { queryKey: QueryKey } => {
+  const { query: queryOptions } = options ?? {};
+
+  const queryKey =
+    queryOptions?.queryKey ?? getAdminServiceGetSharedBookmarkQueryKey(token);
+
+  const queryFn: QueryFunction<
+    Awaited<ReturnType<typeof adminServiceGetSharedBookmark>>
+  > = ({ signal }) => adminServiceGetSharedBookmark(token, signal);
+
+  return { queryKey, queryFn, enabled: !!token, ...queryOptions };
+};
+
+export type AdminServiceGetSharedBookmarkQueryResult = NonNullable<
+  Awaited<ReturnType<typeof adminServiceGetSharedBookmark>>
+>;
+export type AdminServiceGetSharedBookmarkQueryError = RpcStatus;
+
+/**
+ * @summary GetSharedBookmark returns a shared bookmark and a runtime JWT scoped to its dashboard. It does not require authentication.
+ */
+export const createAdminServiceGetSharedBookmark = <
+  TData = Awaited<ReturnType<typeof adminServiceGetSharedBookmark>>,
+  TError = RpcStatus
+>(
+  token: string,
+  options?: {
+    query?: CreateQueryOptions<
+      Awaited<ReturnType<typeof adminServiceGetSharedBookmark>>,
+      TError,
+      TData
+    >;
+  }
+): CreateQueryResult<TData, TError> & { queryKey: QueryKey } => {
+  const queryOptions = getAdminServiceGetSharedBookmarkQueryOptions(
+    token,
+    options
+  );
+
+  const query = createQuery(queryOptions) as CreateQueryResult<
+    TData,
+    TError
+  > & { queryKey: QueryKey };
+
+  query.queryKey = queryOptions.queryKey;
+
+  return query;
+};
+
 /**
  * @summary ListSuperusers lists all the superusers
  */
@@ -4242,6 +5518,215 @@ export const createAdminServiceRemoveBookmark = <
 
   return createMutation(mutationOptions);
 };
+/**
+ * @summary UpdateBookmark updates a bookmark. Shared bookmarks can also be updated by project admins.
+ */
+export const adminServiceUpdateBookmark = (
+  bookmarkId: string,
+  adminServiceUpdateBookmarkBody: AdminServiceUpdateBookmarkBody
+) => {
+  return httpClient<V1UpdateBookmarkResponse>({
+    url: `/v1/users/bookmarks/${bookmarkId}`,
+    method: "put",
+    headers: { "Content-Type": "application/json" },
+    data: adminServiceUpdateBookmarkBody,
+  });
+};
+
+export const getAdminServiceUpdateBookmarkMutationOptions = <
+  TError = RpcStatus,
+  TContext = unknown
+>(options?: {
+  mutation?: CreateMutationOptions<
+    Awaited<ReturnType<typeof adminServiceUpdateBookmark>>,
+    TError,
+    { bookmarkId: string; data: AdminServiceUpdateBookmarkBody },
+    TContext
+  >;
+}): CreateMutationOptions<
+  Awaited<ReturnType<typeof adminServiceUpdateBookmark>>,
+  TError,
+  { bookmarkId: string; data: AdminServiceUpdateBookmarkBody },
+  TContext
+> => {
+  const { mutation: mutationOptions } = options ?? {};
+
+  const mutationFn: MutationFunction<
+    Awaited<ReturnType<typeof adminServiceUpdateBookmark>>,
+    { bookmarkId: string; data: AdminServiceUpdateBookmarkBody }
+  > = (props) => {
+    const { bookmarkId, data } = props ?? {};
+
+    return adminServiceUpdateBookmark(bookmarkId, data);
+  };
+
+  return { mutationFn, ...mutationOptions };
+};
+
+export type AdminServiceUpdateBookmarkMutationResult = NonNullable<
+  Awaited<ReturnType<typeof adminServiceUpdateBookmark>>
+>;
+export type AdminServiceUpdateBookmarkMutationBody =
+  AdminServiceUpdateBookmarkBody;
+export type AdminServiceUpdateBookmarkMutationError = RpcStatus;
+
+/**
+ * @summary UpdateBookmark updates a bookmark. Shared bookmarks can also be updated by project admins.
+ */
+export const createAdminServiceUpdateBookmark = <
+  TError = RpcStatus,
+  TContext = unknown
+>(options?: {
+  mutation?: CreateMutationOptions<
+    Awaited<ReturnType<typeof adminServiceUpdateBookmark>>,
+    TError,
+    { bookmarkId: string; data: AdminServiceUpdateBookmarkBody },
+    TContext
+  >;
+}) => {
+  const mutationOptions = getAdminServiceUpdateBookmarkMutationOptions(options);
+
+  return createMutation(mutationOptions);
+};
+/**
+ * @summary CreateBookmarkShareToken creates an expiring token that grants public, read-only access to a bookmark's dashboard state
+ */
+export const adminServiceCreateBookmarkShareToken = (
+  bookmarkId: string,
+  adminServiceCreateBookmarkShareTokenBody: AdminServiceCreateBookmarkShareTokenBody
+) => {
+  return httpClient<V1CreateBookmarkShareTokenResponse>({
+    url: `/v1/users/bookmarks/${bookmarkId}/share-tokens`,
+    method: "post",
+    headers: { "Content-Type": "application/json" },
+    data: adminServiceCreateBookmarkShareTokenBody,
+  });
+};
+
+export const getAdminServiceCreateBookmarkShareTokenMutationOptions = <
+  TError = RpcStatus,
+  TContext = unknown
+>(options?: {
+  mutation?: CreateMutationOptions<
+    Awaited<ReturnType<typeof adminServiceCreateBookmarkShareToken>>,
+    TError,
+    { bookmarkId: string; data: AdminServiceCreateBookmarkShareTokenBody },
+    TContext
+  >;
+}): CreateMutationOptions<
+  Awaited<ReturnType<typeof adminServiceCreateBookmarkShareToken>>,
+  TError,
+  { bookmarkId: string; data: AdminServiceCreateBookmarkShareTokenBody },
+  TContext
+> => {
+  const { mutation: mutationOptions } = options ?? {};
+
+  const mutationFn: MutationFunction<
+    Awaited<ReturnType<typeof adminServiceCreateBookmarkShareToken>>,
+    { bookmarkId: string; data: AdminServiceCreateBookmarkShareTokenBody }
+  > = (props) => {
+    const { bookmarkId, data } = props ?? {};
+
+    return adminServiceCreateBookmarkShareToken(bookmarkId, data);
+  };
+
+  return { mutationFn, ...mutationOptions };
+};
+
+export type AdminServiceCreateBookmarkShareTokenMutationResult = NonNullable<
+  Awaited<ReturnType<typeof adminServiceCreateBookmarkShareToken>>
+>;
+export type AdminServiceCreateBookmarkShareTokenMutationBody =
+  AdminServiceCreateBookmarkShareTokenBody;
+export type AdminServiceCreateBookmarkShareTokenMutationError = RpcStatus;
+
+/**
+ * @summary CreateBookmarkShareToken creates an expiring token that grants public, read-only access to a bookmark's dashboard state
+ */
+export const createAdminServiceCreateBookmarkShareToken = <
+  TError = RpcStatus,
+  TContext = unknown
+>(options?: {
+  mutation?: CreateMutationOptions<
+    Awaited<ReturnType<typeof adminServiceCreateBookmarkShareToken>>,
+    TError,
+    { bookmarkId: string; data: AdminServiceCreateBookmarkShareTokenBody },
+    TContext
+  >;
+}) => {
+  const mutationOptions =
+    getAdminServiceCreateBookmarkShareTokenMutationOptions(options);
+
+  return createMutation(mutationOptions);
+};
+/**
+ * @summary RevokeBookmarkShareToken revokes a bookmark share token before it expires
+ */
+export const adminServiceRevokeBookmarkShareToken = (
+  bookmarkId: string,
+  tokenId: string
+) => {
+  return httpClient<V1RevokeBookmarkShareTokenResponse>({
+    url: `/v1/users/bookmarks/${bookmarkId}/share-tokens/${tokenId}`,
+    method: "delete",
+  });
+};
+
+export const getAdminServiceRevokeBookmarkShareTokenMutationOptions = <
+  TError = RpcStatus,
+  TContext = unknown
+>(options?: {
+  mutation?: CreateMutationOptions<
+    Awaited<ReturnType<typeof adminServiceRevokeBookmarkShareToken>>,
+    TError,
+    { bookmarkId: string; tokenId: string },
+    TContext
+  >;
+}): CreateMutationOptions<
+  Awaited<ReturnType<typeof adminServiceRevokeBookmarkShareToken>>,
+  TError,
+  { bookmarkId: string; tokenId: string },
+  TContext
+> => {
+  const { mutation: mutationOptions } = options ?? {};
+
+  const mutationFn: MutationFunction<
+    Awaited<ReturnType<typeof adminServiceRevokeBookmarkShareToken>>,
+    { bookmarkId: string; tokenId: string }
+  > = (props) => {
+    const { bookmarkId, tokenId } = props ?? {};
+
+    return adminServiceRevokeBookmarkShareToken(bookmarkId, tokenId);
+  };
+
+  return { mutationFn, ...mutationOptions };
+};
+
+export type AdminServiceRevokeBookmarkShareTokenMutationResult = NonNullable<
+  Awaited<ReturnType<typeof adminServiceRevokeBookmarkShareToken>>
+>;
+
+export type AdminServiceRevokeBookmarkShareTokenMutationError = RpcStatus;
+
+/**
+ * @summary RevokeBookmarkShareToken revokes a bookmark share token before it expires
+ */
+export const createAdminServiceRevokeBookmarkShareToken = <
+  TError = RpcStatus,
+  TContext = unknown
+>(options?: {
+  mutation?: CreateMutationOptions<
+    Awaited<ReturnType<typeof adminServiceRevokeBookmarkShareToken>>,
+    TError,
+    { bookmarkId: string; tokenId: string },
+    TContext
+  >;
+}) => {
+  const mutationOptions =
+    getAdminServiceRevokeBookmarkShareTokenMutationOptions(options);
+
+  return createMutation(mutationOptions);
+};
 /**
  * @summary GetCurrentUser returns the currently authenticated user (if any)
  */
@@ -10,8 +10,24 @@ export type AdminServiceSearchUsersParams = {
   pageToken?: string;
 };
 
+export type AdminServiceCreateBookmarkShareTokenBody = {
+  /** Seconds until the token expires. Defaults to 7 days. */
+  ttlSeconds?: string;
+};
+
+export type AdminServiceUpdateBookmarkBody = {
+  displayName?: string;
+  data?: string;
+  shared?: boolean;
+  isDefault?: boolean;
+};
+
 export type AdminServiceListBookmarksParams = {
   projectId?: string;
+  /**
+   * Optionally only list the bookmarks of this dashboard
+   */
+  dashboardName?: string;
 };
 
 export type AdminServiceGetUserParams = {
@@ -57,6 +73,7 @@ export type AdminServiceUpdateProjectBody = {
   region?: string;
   newName?: string;
   prodTtlSeconds?: string;
+  previewDeployments?: boolean;
 };
 
 export type AdminServiceCreateProjectBodyVariables = { [key: string]: string };
@@ -85,6 +102,15 @@ export type AdminServiceCreateWhitelistedDomainBody = {
   role?: string;
 };
 
+export type AdminServiceEditReportBody = {
+  options?: V1ReportOptions;
+};
+
+export type AdminServiceCreateReportBody = {
+  name?: string;
+  options?: V1ReportOptions;
+};
+
 export type AdminServiceListProjectMembersParams = {
   pageSize?: number;
   pageToken?: string;
@@ -95,6 +121,15 @@ export type AdminServiceListProjectInvitesParams = {
   pageToken?: string;
 };
 
+export type AdminServiceEditAlertBody = {
+  options?: V1AlertOptions;
+};
+
+export type AdminServiceCreateAlertBody = {
+  name?: string;
+  options?: V1AlertOptions;
+};
+
 export type AdminServiceRemoveOrganizationMemberParams = {
   keepProjectRoles?: boolean;
 };
@@ -109,6 +144,15 @@ export type AdminServiceListOrganizationInvitesParams = {
   pageToken?: string;
 };
 
+export type AdminServiceListAuditEventsParams = {
+  /**
+   * Optionally only list the events of this project
+   */
+  project?: string;
+  pageSize?: number;
+  pageToken?: string;
+};
+
 export type AdminServiceUpdateOrganizationBody = {
   description?: string;
   newName?: string;
@@ -195,6 +239,10 @@ export interface V1UpdateOrganizationResponse {
   organization?: V1Organization;
 }
 
+export interface V1UpdateBookmarkResponse {
+  bookmark?: V1Bookmark;
+}
+
 export interface V1TriggerRefreshSourcesResponse {
   [key: string]: any;
 }
@@ -293,6 +341,40 @@ export interface V1RevokeCurrentAuthTokenResponse {
   tokenId?: string;
 }
 
+export interface V1RevokeBookmarkShareTokenResponse {
+  [key: string]: any;
+}
+
+export interface V1ReportOptions {
+  schedule?: string;
+  /** IANA time zone to evaluate the schedule in. Defaults to UTC. */
+  timeZone?: string;
+  recipients?: string[];
+  metricsViewName?: string;
+  /** JSON-encoded rill.runtime.v1.MetricsViewAggregationRequest with the query to export.
+The instance ID and metrics view name are set automatically. */
+  queryArgsJson?: string;
+  /** ISO 8601 duration of the time range to export, ending at the time the report runs (e.g. "P7D").
+If set, it overrides time_start and time_end in query_args_json. */
+  timeRange?: string;
+  exportFormat?: V1ExportFormat;
+  /** Maximum number of rows to export. Zero means the runtime's default limit. */
+  exportLimit?: string;
+}
+
+export interface V1Report {
+  id?: string;
+  projectId?: string;
+  name?: string;
+  options?: V1ReportOptions;
+  ownerUserId?: string;
+  nextRunOn?: string;
+  lastRunOn?: string;
+  lastRunError?: string;
+  createdOn?: string;
+  updatedOn?: string;
+}
+
 export interface V1RemoveWhitelistedDomainResponse {
   [key: string]: any;
 }
@@ -339,6 +421,7 @@ export interface V1Project {
   prodDeploymentId?: string;
   frontendUrl?: string;
   prodTtlSeconds?: string;
+  previewDeployments?: boolean;
   createdOn?: string;
   updatedOn?: string;
 }
@@ -400,6 +483,10 @@ export interface V1ListServiceAuthTokensResponse {
   tokens?: V1ServiceToken[];
 }
 
+export interface V1ListReportsResponse {
+  reports?: V1Report[];
+}
+
 export interface V1ListProjectsForOrganizationResponse {
   projects?: V1Project[];
   nextPageToken?: string;
@@ -415,6 +502,15 @@ export interface V1ListProjectInvitesResponse {
   nextPageToken?: string;
 }
 
+export interface V1ListPreviewDeploymentsResponse {
+  deployments?: V1Deployment[];
+}
+
+export interface V1GetPreviewDeploymentResponse {
+  deployment?: V1Deployment;
+  jwt?: string;
+}
+
 export interface V1ListOrganizationsResponse {
   organizations?: V1Organization[];
   nextPageToken?: string;
@@ -434,6 +530,15 @@ export interface V1ListBookmarksResponse {
   bookmarks?: V1Bookmark[];
 }
 
+export interface V1ListAuditEventsResponse {
+  events?: V1AuditEvent[];
+  nextPageToken?: string;
+}
+
+export interface V1ListAlertsResponse {
+  alerts?: V1Alert[];
+}
+
 export interface V1LeaveOrganizationResponse {
   [key: string]: any;
 }
@@ -455,6 +560,20 @@ export interface V1GetUserResponse {
   user?: V1User;
 }
 
+export interface V1GetSharedBookmarkResponse {
+  bookmark?: V1Bookmark;
+  organization?: string;
+  project?: string;
+  runtimeHost?: string;
+  instanceId?: string;
+  jwt?: string;
+  expiresOn?: string;
+}
+
+export interface V1GetReportResponse {
+  report?: V1Report;
+}
+
 export type V1GetProjectVariablesResponseVariables = { [key: string]: string };
 
 export interface V1GetProjectVariablesResponse {
@@ -496,6 +615,28 @@ export interface V1GetBookmarkResponse {
   bookmark?: V1Bookmark;
 }
 
+export interface V1GetAlertResponse {
+  alert?: V1Alert;
+}
+
+export type V1ExportFormat =
+  (typeof V1ExportFormat)[keyof typeof V1ExportFormat];
+
+// eslint-disable-next-line @typescript-eslint/no-redeclare
+export const V1ExportFormat = {
+  EXPORT_FORMAT_UNSPECIFIED: "EXPORT_FORMAT_UNSPECIFIED",
+  EXPORT_FORMAT_CSV: "EXPORT_FORMAT_CSV",
+  EXPORT_FORMAT_XLSX: "EXPORT_FORMAT_XLSX",
+} as const;
+
+export interface V1EditReportResponse {
+  report?: V1Report;
+}
+
+export interface V1EditAlertResponse {
+  alert?: V1Alert;
+}
+
 export type V1DeploymentStatus =
   (typeof V1DeploymentStatus)[keyof typeof V1DeploymentStatus];
 
@@ -519,12 +660,18 @@ export interface V1Deployment {
   logs?: string;
   createdOn?: string;
   updatedOn?: string;
+  preview?: boolean;
+  pullRequestNumber?: string;
 }
 
 export interface V1DeleteServiceResponse {
   service?: V1Service;
 }
 
+export interface V1DeleteReportResponse {
+  [key: string]: any;
+}
+
 export interface V1DeleteProjectResponse {
   [key: string]: any;
 }
@@ -533,6 +680,10 @@ export interface V1DeleteOrganizationResponse {
   [key: string]: any;
 }
 
+export interface V1DeleteAlertResponse {
+  [key: string]: any;
+}
+
 export interface V1CreateWhitelistedDomainResponse {
   [key: string]: any;
 }
@@ -541,6 +692,10 @@ export interface V1CreateServiceResponse {
   service?: V1Service;
 }
 
+export interface V1CreateReportResponse {
+  report?: V1Report;
+}
+
 export interface V1CreateProjectResponse {
   project?: V1Project;
 }
@@ -554,11 +709,20 @@ export interface V1CreateOrganizationRequest {
   description?: string;
 }
 
+export interface V1CreateBookmarkShareTokenResponse {
+  token?: string;
+  tokenId?: string;
+  expiresOn?: string;
+}
+
 export interface V1CreateBookmarkRequest {
   displayName?: string;
   data?: string;
   dashboardName?: string;
   projectId?: string;
+  shared?: boolean;
+  /** Make the bookmark the dashboard's initial state for everyone. Requires project admin permissions. Implies shared. */
+  isDefault?: boolean;
 }
 
 export interface V1Bookmark {
@@ -570,12 +734,31 @@ export interface V1Bookmark {
   userId?: string;
   createdOn?: string;
   updatedOn?: string;
+  shared?: boolean;
+  isDefault?: boolean;
 }
 
 export interface V1CreateBookmarkResponse {
   bookmark?: V1Bookmark;
 }
 
+export interface V1CreateAlertResponse {
+  alert?: V1Alert;
+}
+
+export interface V1AuditEvent {
+  id?: string;
+  projectId?: string;
+  projectName?: string;
+  actorType?: string;
+  actorId?: string;
+  actorName?: string;
+  action?: string;
+  target?: string;
+  summary?: string;
+  createdOn?: string;
+}
+
 export interface V1AddProjectMemberResponse {
   pendingSignup?: boolean;
 }
@@ -584,6 +767,53 @@ export interface V1AddOrganizationMemberResponse {
   pendingSignup?: boolean;
 }
 
+export interface V1AlertOptions {
+  schedule?: string;
+  /** IANA time zone to evaluate the schedule in. Defaults to UTC. */
+  timeZone?: string;
+  metricsViewName?: string;
+  measureName?: string;
+  comparison?: V1AlertComparison;
+  threshold?: number;
+  /** Dimension to evaluate the measure for separately, triggering the alert for each dimension value that crosses the threshold.
+If empty, the measure's total is evaluated. */
+  splitByDimension?: string;
+  /** JSON-encoded rill.runtime.v1.Expression to filter the metrics view by before evaluating the measure. */
+  whereJson?: string;
+  /** ISO 8601 duration of the time range to evaluate, ending at the time the alert is evaluated (e.g. "PT1H").
+If empty, the measure is evaluated across all time. */
+  timeRange?: string;
+  recipients?: string[];
+  webhookUrl?: string;
+  /** Seconds after which an ongoing breach is notified again. Zero means each breach is only notified once. */
+  renotifyAfterSeconds?: string;
+}
+
+export type V1AlertComparison =
+  (typeof V1AlertComparison)[keyof typeof V1AlertComparison];
+
+// eslint-disable-next-line @typescript-eslint/no-redeclare
+export const V1AlertComparison = {
+  ALERT_COMPARISON_UNSPECIFIED: "ALERT_COMPARISON_UNSPECIFIED",
+  ALERT_COMPARISON_GT: "ALERT_COMPARISON_GT",
+  ALERT_COMPARISON_GTE: "ALERT_COMPARISON_GTE",
+  ALERT_COMPARISON_LT: "ALERT_COMPARISON_LT",
+  ALERT_COMPARISON_LTE: "ALERT_COMPARISON_LTE",
+} as const;
+
+export interface V1Alert {
+  id?: string;
+  projectId?: string;
+  name?: string;
+  options?: V1AlertOptions;
+  ownerUserId?: string;
+  nextRunOn?: string;
+  lastRunOn?: string;
+  lastRunError?: string;
+  createdOn?: string;
+  updatedOn?: string;
+}
+
 export interface ProtobufAny {
   "@type"?: string;
   [key: string]: unknown;
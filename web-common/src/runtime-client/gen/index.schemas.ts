@@ -172,6 +172,22 @@ export type QueryServiceColumnNullCountParams = {
   priority?: number;
 };
 
+export type QueryServiceMetricsViewAggregationBody = {
+  dimensions?: V1MetricsViewAggregationDimension[];
+  measureNames?: string[];
+  inlineMeasures?: V1InlineMeasure[];
+  sort?: V1MetricsViewAggregationSort[];
+  timeStart?: string;
+  timeEnd?: string;
+  filter?: V1MetricsViewFilter;
+  limit?: string;
+  offset?: string;
+  pivotOn?: string;
+  timeZone?: string;
+  priority?: number;
+  where?: V1Expression;
+};
+
 export type QueryServiceMetricsViewTotalsBody = {
   measureNames?: string[];
   inlineMeasures?: V1InlineMeasure[];
@@ -179,6 +195,7 @@ export type QueryServiceMetricsViewTotalsBody = {
   timeEnd?: string;
   filter?: V1MetricsViewFilter;
   priority?: number;
+  where?: V1Expression;
 };
 
 export type QueryServiceMetricsViewToplistBody = {
@@ -192,6 +209,8 @@ export type QueryServiceMetricsViewToplistBody = {
   sort?: V1MetricsViewSort[];
   filter?: V1MetricsViewFilter;
   priority?: number;
+  where?: V1Expression;
+  having?: V1Expression;
 };
 
 export type QueryServiceMetricsViewTimeSeriesBody = {
@@ -203,6 +222,13 @@ export type QueryServiceMetricsViewTimeSeriesBody = {
   filter?: V1MetricsViewFilter;
   timeZone?: string;
   priority?: number;
+  where?: V1Expression;
+  /** Optional time range to compare the time series against. Requires time_start, time_end and time_granularity.
+The buckets of both ranges are aligned by position in the time zone's calendar, and the records of each bucket
+get the comparison value, absolute delta and relative delta of every measure with the suffixes
+"__comparison", "__delta_abs" and "__delta_rel".
+As in MetricsViewComparison, the deltas are the comparison value minus the base value, and that difference relative to the base value. */
+  comparisonTimeRange?: V1TimeRange;
 };
 
 export type QueryServiceMetricsViewTimeRangeBody = {
@@ -219,6 +245,7 @@ export type QueryServiceMetricsViewRowsBody = {
   offset?: string;
   priority?: number;
   timeZone?: string;
+  where?: V1Expression;
 };
 
 export type QueryServiceMetricsViewComparisonToplistBody = {
@@ -232,6 +259,8 @@ export type QueryServiceMetricsViewComparisonToplistBody = {
   limit?: string;
   offset?: string;
   priority?: number;
+  where?: V1Expression;
+  having?: V1Expression;
 };
 
 export type QueryServiceExportBody = {
@@ -239,6 +268,7 @@ export type QueryServiceExportBody = {
   format?: V1ExportFormat;
   metricsViewToplistRequest?: V1MetricsViewToplistRequest;
   metricsViewRowsRequest?: V1MetricsViewRowsRequest;
+  metricsViewAggregationRequest?: V1MetricsViewAggregationRequest;
 };
 
 export type QueryServiceColumnDescriptiveStatisticsParams = {
@@ -262,12 +292,40 @@ export type RuntimeServiceWatchLogs200 = {
 
 export type RuntimeServiceWatchLogsParams = {
   replay?: boolean;
+  level?: RuntimeServiceWatchLogsLevel;
+  replayLimit?: number;
 };
 
+export type RuntimeServiceWatchLogsLevel =
+  (typeof RuntimeServiceWatchLogsLevel)[keyof typeof RuntimeServiceWatchLogsLevel];
+
+// eslint-disable-next-line @typescript-eslint/no-redeclare
+export const RuntimeServiceWatchLogsLevel = {
+  LOG_LEVEL_UNSPECIFIED: "LOG_LEVEL_UNSPECIFIED",
+  LOG_LEVEL_DEBUG: "LOG_LEVEL_DEBUG",
+  LOG_LEVEL_INFO: "LOG_LEVEL_INFO",
+  LOG_LEVEL_WARN: "LOG_LEVEL_WARN",
+  LOG_LEVEL_ERROR: "LOG_LEVEL_ERROR",
+} as const;
+
 export type RuntimeServiceGetLogsParams = {
   ascending?: boolean;
+  level?: RuntimeServiceGetLogsLevel;
+  limit?: number;
 };
 
+export type RuntimeServiceGetLogsLevel =
+  (typeof RuntimeServiceGetLogsLevel)[keyof typeof RuntimeServiceGetLogsLevel];
+
+// eslint-disable-next-line @typescript-eslint/no-redeclare
+export const RuntimeServiceGetLogsLevel = {
+  LOG_LEVEL_UNSPECIFIED: "LOG_LEVEL_UNSPECIFIED",
+  LOG_LEVEL_DEBUG: "LOG_LEVEL_DEBUG",
+  LOG_LEVEL_INFO: "LOG_LEVEL_INFO",
+  LOG_LEVEL_WARN: "LOG_LEVEL_WARN",
+  LOG_LEVEL_ERROR: "LOG_LEVEL_ERROR",
+} as const;
+
 export type RuntimeServiceWatchFiles200 = {
   result?: V1WatchFilesResponse;
   error?: RpcStatus;
@@ -346,6 +404,8 @@ export type RuntimeServiceEditInstanceBody = {
   ingestionLimitBytes?: string;
   connectors?: V1Connector[];
   annotations?: RuntimeServiceEditInstanceBodyAnnotations;
+  exportLimitRows?: string;
+  exportLimitBytes?: string;
 };
 
 export type RuntimeServiceEditInstanceVariablesBodyVariables = {
@@ -480,6 +540,8 @@ export interface V1TimeSeriesValue {
   ts?: string;
   bin?: number;
   records?: V1TimeSeriesValueRecords;
+  /** Start of the aligned bucket in the comparison time range (only set for comparison time series) */
+  comparisonTs?: string;
 }
 
 export interface V1TimeSeriesResponse {
@@ -497,6 +559,9 @@ export interface V1TimeRangeSummary {
 export interface V1TimeRange {
   start?: string;
   end?: string;
+  roundToGrain?: V1TimeGrain;
+  /** Time zone to round in. Defaults to UTC. */
+  timeZone?: string;
 }
 
 export type V1TimeGrain = (typeof V1TimeGrain)[keyof typeof V1TimeGrain];
@@ -576,11 +641,15 @@ scanning the database's information schema. */
   managed?: boolean;
 }
 
+export type V1SourceStateIngestedObjects = { [key: string]: string };
+
 export interface V1SourceState {
   connector?: string;
   table?: string;
   specHash?: string;
   refreshedOn?: string;
+  cursor?: unknown;
+  ingestedObjects?: V1SourceStateIngestedObjects;
 }
 
 export interface V1SourceV2 {
@@ -596,9 +665,13 @@ export interface V1SourceSpec {
   properties?: V1SourceSpecProperties;
   refreshSchedule?: V1Schedule;
   timeoutSeconds?: number;
+  incremental?: boolean;
+  cursorColumn?: string;
+  deleteRemovedPartitions?: boolean;
   stageChanges?: boolean;
   streamIngestion?: boolean;
   trigger?: boolean;
+  triggerFull?: boolean;
 }
 
 export type V1SourceProperties = { [key: string]: any };
@@ -719,6 +792,7 @@ export interface V1RefreshTriggerState {
 
 export interface V1RefreshTriggerSpec {
   onlyNames?: V1ResourceName[];
+  full?: boolean;
 }
 
 export interface V1RefreshTrigger {
@@ -929,6 +1003,26 @@ export interface V1ProjectParserState {
   changedPaths?: string[];
 }
 
+export type V1Operation = (typeof V1Operation)[keyof typeof V1Operation];
+
+// eslint-disable-next-line @typescript-eslint/no-redeclare
+export const V1Operation = {
+  OPERATION_UNSPECIFIED: "OPERATION_UNSPECIFIED",
+  OPERATION_EQ: "OPERATION_EQ",
+  OPERATION_NEQ: "OPERATION_NEQ",
+  OPERATION_LT: "OPERATION_LT",
+  OPERATION_LTE: "OPERATION_LTE",
+  OPERATION_GT: "OPERATION_GT",
+  OPERATION_GTE: "OPERATION_GTE",
+  OPERATION_OR: "OPERATION_OR",
+  OPERATION_AND: "OPERATION_AND",
+  OPERATION_NOT: "OPERATION_NOT",
+  OPERATION_IN: "OPERATION_IN",
+  OPERATION_NIN: "OPERATION_NIN",
+  OPERATION_LIKE: "OPERATION_LIKE",
+  OPERATION_NLIKE: "OPERATION_NLIKE",
+} as const;
+
 export type V1ObjectType = (typeof V1ObjectType)[keyof typeof V1ObjectType];
 
 // eslint-disable-next-line @typescript-eslint/no-redeclare
@@ -972,11 +1066,14 @@ export interface V1NumericSummary {
   numericOutliers?: V1NumericOutliers;
 }
 
+export type V1ModelStateIncrementalState = { [key: string]: any };
+
 export interface V1ModelState {
   connector?: string;
   table?: string;
   specHash?: string;
   refreshedOn?: string;
+  incrementalState?: V1ModelStateIncrementalState;
 }
 
 export interface V1ModelSpec {
@@ -986,9 +1083,12 @@ export interface V1ModelSpec {
   refreshSchedule?: V1Schedule;
   timeoutSeconds?: number;
   usesTemplating?: boolean;
+  incremental?: boolean;
+  incrementalStateResolver?: string;
   stageChanges?: boolean;
   materializeDelaySeconds?: number;
   trigger?: boolean;
+  triggerFull?: boolean;
 }
 
 export interface V1ModelV2 {
@@ -1024,6 +1124,42 @@ export interface V1MetricsViewV2 {
   state?: V1MetricsViewState;
 }
 
+export type V1MetricsViewAggregationResponseDataItem = { [key: string]: any };
+
+export interface V1MetricsViewAggregationResponse {
+  meta?: V1MetricsViewColumn[];
+  data?: V1MetricsViewAggregationResponseDataItem[];
+}
+
+export interface V1MetricsViewAggregationSort {
+  name?: string;
+  desc?: boolean;
+}
+
+export interface V1MetricsViewAggregationDimension {
+  name?: string;
+  timeGrain?: V1TimeGrain;
+  alias?: string;
+}
+
+export interface V1MetricsViewAggregationRequest {
+  instanceId?: string;
+  metricsViewName?: string;
+  dimensions?: V1MetricsViewAggregationDimension[];
+  measureNames?: string[];
+  inlineMeasures?: V1InlineMeasure[];
+  sort?: V1MetricsViewAggregationSort[];
+  timeStart?: string;
+  timeEnd?: string;
+  filter?: V1MetricsViewFilter;
+  limit?: string;
+  offset?: string;
+  pivotOn?: string;
+  timeZone?: string;
+  priority?: number;
+  where?: V1Expression;
+}
+
 export type V1MetricsViewTotalsResponseData = { [key: string]: any };
 
 export interface V1MetricsViewTotalsResponse {
@@ -1040,6 +1176,7 @@ export interface V1MetricsViewTotalsRequest {
   timeEnd?: string;
   filter?: V1MetricsViewFilter;
   priority?: number;
+  where?: V1Expression;
 }
 
 export type V1MetricsViewToplistResponseDataItem = { [key: string]: any };
@@ -1062,6 +1199,8 @@ export interface V1MetricsViewToplistRequest {
   sort?: V1MetricsViewSort[];
   filter?: V1MetricsViewFilter;
   priority?: number;
+  where?: V1Expression;
+  having?: V1Expression;
 }
 
 export interface V1MetricsViewTimeSeriesResponse {
@@ -1071,6 +1210,10 @@ export interface V1MetricsViewTimeSeriesResponse {
 
 export interface V1MetricsViewTimeRangeResponse {
   timeRangeSummary?: V1TimeRangeSummary;
+  /** Day of the week that weeks start on in the metrics view (1 = Monday, 7 = Sunday) */
+  firstDayOfWeek?: number;
+  /** Month that years and quarters start on in the metrics view (1 = January, 12 = December) */
+  firstMonthOfYear?: number;
 }
 
 export interface V1MetricsViewSpec {
@@ -1085,6 +1228,10 @@ export interface V1MetricsViewSpec {
   /** Default time range for the dashboard. It should be a valid ISO 8601 duration string. */
   defaultTimeRange?: string;
   availableTimeZones?: string[];
+  /** ISO day of the week that weeks start on (1 = Monday, 7 = Sunday). Defaults to Monday if unset. */
+  firstDayOfWeek?: number;
+  /** Month that years and quarters start on (1 = January, 12 = December). Defaults to January if unset. */
+  firstMonthOfYear?: number;
 }
 
 export interface V1MetricsViewState {
@@ -1119,6 +1266,13 @@ export interface V1MetricsViewTimeSeriesRequest {
   filter?: V1MetricsViewFilter;
   timeZone?: string;
   priority?: number;
+  where?: V1Expression;
+  /** Optional time range to compare the time series against. Requires time_start, time_end and time_granularity.
+The buckets of both ranges are aligned by position in the time zone's calendar, and the records of each bucket
+get the comparison value, absolute delta and relative delta of every measure with the suffixes
+"__comparison", "__delta_abs" and "__delta_rel".
+As in MetricsViewComparison, the deltas are the comparison value minus the base value, and that difference relative to the base value. */
+  comparisonTimeRange?: V1TimeRange;
 }
 
 export interface V1MetricsViewRowsRequest {
@@ -1133,6 +1287,7 @@ export interface V1MetricsViewRowsRequest {
   offset?: string;
   priority?: number;
   timeZone?: string;
+  where?: V1Expression;
 }
 
 export interface V1MetricsViewComparisonValue {
@@ -1179,6 +1334,8 @@ export interface V1MetricsViewComparisonToplistRequest {
   limit?: string;
   offset?: string;
   priority?: number;
+  where?: V1Expression;
+  having?: V1Expression;
 }
 
 export interface V1MetricsViewComparisonRow {
@@ -1209,6 +1366,10 @@ export interface V1MetricsView {
   defaultTimeRange?: string;
   /** Available time zones list preferred time zones using IANA location identifiers. */
   availableTimeZones?: string[];
+  /** ISO day of the week that weeks start on (1 = Monday, 7 = Sunday). Defaults to Monday if unset. */
+  firstDayOfWeek?: number;
+  /** Month that years and quarters start on (1 = January, 12 = December). Defaults to January if unset. */
+  firstMonthOfYear?: number;
 }
 
 export interface V1MapType {
@@ -1287,6 +1448,8 @@ of in the runtime's metadata store. Currently only supported for the duckdb driv
   ingestionLimitBytes?: string;
   /** bare minimum connectors required by the instance. */
   connectors?: V1Connector[];
+  exportLimitRows?: string;
+  exportLimitBytes?: string;
 }
 
 export interface V1InlineMeasure {
@@ -1308,6 +1471,17 @@ export interface V1GetResourceResponse {
   resource?: V1Resource;
 }
 
+export interface V1GetQueryCacheStatsResponse {
+  /** Number of queries served from the cache */
+  hits?: string;
+  /** Number of queries that were not in the cache */
+  misses?: string;
+  /** Number of cached query results */
+  items?: string;
+  /** Estimated size of the cached query results */
+  sizeBytes?: string;
+}
+
 export interface V1GetLogsResponse {
   logs?: V1Log[];
 }
@@ -1359,6 +1533,12 @@ export const V1FileEvent = {
   FILE_EVENT_DELETE: "FILE_EVENT_DELETE",
 } as const;
 
+export interface V1Expression {
+  ident?: string;
+  val?: unknown;
+  cond?: V1Condition;
+}
+
 export interface V1ExportResponse {
   downloadUrlPath?: string;
 }
@@ -1372,6 +1552,8 @@ export const V1ExportFormat = {
   EXPORT_FORMAT_CSV: "EXPORT_FORMAT_CSV",
   EXPORT_FORMAT_XLSX: "EXPORT_FORMAT_XLSX",
   EXPORT_FORMAT_PARQUET: "EXPORT_FORMAT_PARQUET",
+  EXPORT_FORMAT_CSV_GZIP: "EXPORT_FORMAT_CSV_GZIP",
+  EXPORT_FORMAT_NDJSON: "EXPORT_FORMAT_NDJSON",
 } as const;
 
 /**
@@ -1446,6 +1628,8 @@ export interface V1CreateInstanceRequest {
   ingestionLimitBytes?: string;
   annotations?: V1CreateInstanceRequestAnnotations;
   connectors?: V1Connector[];
+  exportLimitRows?: string;
+  exportLimitBytes?: string;
 }
 
 /**
@@ -1468,6 +1652,11 @@ export interface V1Connector {
   config?: V1ConnectorConfig;
 }
 
+export interface V1Condition {
+  op?: V1Operation;
+  exprs?: V1Expression[];
+}
+
 export interface V1ColumnTopKResponse {
   categoricalSummary?: V1CategoricalSummary;
 }
@@ -1594,6 +1783,10 @@ export interface V1ColumnCardinalityRequest {
 /**
  * Response for QueryService.ColumnTopK and QueryService.ColumnCardinality. Message will have either topK or cardinality set.
  */
+export interface V1ClearQueryCacheResponse {
+  [key: string]: any;
+}
+
 export interface V1CategoricalSummary {
   topK?: V1TopK;
   cardinality?: number;
@@ -1751,6 +1944,41 @@ export const ModelDialect = {
   DIALECT_DUCKDB: "DIALECT_DUCKDB",
 } as const;
 
+export type MetricsViewSpecMeasureWindowV2Type =
+  (typeof MetricsViewSpecMeasureWindowV2Type)[keyof typeof MetricsViewSpecMeasureWindowV2Type];
+
+// eslint-disable-next-line @typescript-eslint/no-redeclare
+export const MetricsViewSpecMeasureWindowV2Type = {
+  TYPE_UNSPECIFIED: "TYPE_UNSPECIFIED",
+  TYPE_ROLLING_SUM: "TYPE_ROLLING_SUM",
+  TYPE_ROLLING_AVG: "TYPE_ROLLING_AVG",
+  TYPE_CUMULATIVE_SUM: "TYPE_CUMULATIVE_SUM",
+  TYPE_LAG: "TYPE_LAG",
+  TYPE_DELTA_ABS: "TYPE_DELTA_ABS",
+  TYPE_DELTA_REL: "TYPE_DELTA_REL",
+} as const;
+
+export interface MetricsViewSpecMeasureWindowV2 {
+  /** Name of the measure the window is computed over */
+  measure?: string;
+  type?: MetricsViewSpecMeasureWindowV2Type;
+  /** Number of time buckets (of the requested time grain) in the window or to look back */
+  size?: number;
+}
+
+export type MetricsViewSpecMeasureV2Kind =
+  (typeof MetricsViewSpecMeasureV2Kind)[keyof typeof MetricsViewSpecMeasureV2Kind];
+
+// eslint-disable-next-line @typescript-eslint/no-redeclare
+export const MetricsViewSpecMeasureV2Kind = {
+  KIND_UNSPECIFIED: "KIND_UNSPECIFIED",
+  KIND_COUNT_DISTINCT: "KIND_COUNT_DISTINCT",
+  KIND_PERCENTILE: "KIND_PERCENTILE",
+  KIND_MEDIAN: "KIND_MEDIAN",
+  KIND_MIN: "KIND_MIN",
+  KIND_MAX: "KIND_MAX",
+} as const;
+
 export interface MetricsViewSpecMeasureV2 {
   name?: string;
   expression?: string;
@@ -1758,6 +1986,21 @@ export interface MetricsViewSpecMeasureV2 {
   description?: string;
   format?: string;
   validPercentOfTotal?: boolean;
+  /** Names of other measures referenced in the expression.
+The references are expanded to the referenced measures' expressions at query time. */
+  requires?: string[];
+  /** Window is set for measures computed from another measure across adjacent time buckets.
+Window measures don't have an expression and are only available in time series queries. */
+  window?: MetricsViewSpecMeasureWindowV2;
+  /** Kind is set for typed measures, which aggregate a column with a function compiled to the SQL dialect of the OLAP store.
+Typed measures don't have an expression. */
+  kind?: MetricsViewSpecMeasureV2Kind;
+  /** Column aggregated by a typed measure */
+  column?: string;
+  /** Approximate selects the approximate variant of the aggregation (e.g. sketches) where the dialect supports it */
+  approximate?: boolean;
+  /** Percentile computed by a percentile measure, between 0 and 1 */
+  percentile?: number;
 }
 
 export interface MetricsViewSpecDimensionV2 {
@@ -1767,6 +2010,41 @@ export interface MetricsViewSpecDimensionV2 {
   description?: string;
 }
 
+export type MetricsViewMeasureWindowType =
+  (typeof MetricsViewMeasureWindowType)[keyof typeof MetricsViewMeasureWindowType];
+
+// eslint-disable-next-line @typescript-eslint/no-redeclare
+export const MetricsViewMeasureWindowType = {
+  TYPE_UNSPECIFIED: "TYPE_UNSPECIFIED",
+  TYPE_ROLLING_SUM: "TYPE_ROLLING_SUM",
+  TYPE_ROLLING_AVG: "TYPE_ROLLING_AVG",
+  TYPE_CUMULATIVE_SUM: "TYPE_CUMULATIVE_SUM",
+  TYPE_LAG: "TYPE_LAG",
+  TYPE_DELTA_ABS: "TYPE_DELTA_ABS",
+  TYPE_DELTA_REL: "TYPE_DELTA_REL",
+} as const;
+
+export interface MetricsViewMeasureWindow {
+  /** Name of the measure the window is computed over */
+  measure?: string;
+  type?: MetricsViewMeasureWindowType;
+  /** Number of time buckets (of the requested time grain) in the window or to look back */
+  size?: number;
+}
+
+export type MetricsViewMeasureKind =
+  (typeof MetricsViewMeasureKind)[keyof typeof MetricsViewMeasureKind];
+
+// eslint-disable-next-line @typescript-eslint/no-redeclare
+export const MetricsViewMeasureKind = {
+  KIND_UNSPECIFIED: "KIND_UNSPECIFIED",
+  KIND_COUNT_DISTINCT: "KIND_COUNT_DISTINCT",
+  KIND_PERCENTILE: "KIND_PERCENTILE",
+  KIND_MEDIAN: "KIND_MEDIAN",
+  KIND_MIN: "KIND_MIN",
+  KIND_MAX: "KIND_MAX",
+} as const;
+
 export interface MetricsViewMeasure {
   name?: string;
   label?: string;
@@ -1774,6 +2052,21 @@ export interface MetricsViewMeasure {
   description?: string;
   format?: string;
   validPercentOfTotal?: boolean;
+  /** Names of other measures referenced in the expression.
+The references are expanded to the referenced measures' expressions at query time. */
+  requires?: string[];
+  /** Window is set for measures computed from another measure across adjacent time buckets.
+Window measures don't have an expression and are only available in time series queries. */
+  window?: MetricsViewMeasureWindow;
+  /** Kind is set for typed measures, which aggregate a column with a function compiled to the SQL dialect of the OLAP store.
+Typed measures don't have an expression. */
+  kind?: MetricsViewMeasureKind;
+  /** Column aggregated by a typed measure */
+  column?: string;
+  /** Approximate selects the approximate variant of the aggregation (e.g. sketches) where the dialect supports it */
+  approximate?: boolean;
+  /** Percentile computed by a percentile measure, between 0 and 1 */
+  percentile?: number;
 }
 
 export interface MetricsViewFilterCond {
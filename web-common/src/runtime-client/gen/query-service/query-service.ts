@@ -35,6 +35,8 @@ import type {
   QueryServiceMetricsViewToplistBody,
   V1MetricsViewTotalsResponse,
   QueryServiceMetricsViewTotalsBody,
+  V1MetricsViewAggregationResponse,
+  QueryServiceMetricsViewAggregationBody,
   V1ColumnNullCountResponse,
   QueryServiceColumnNullCountParams,
   V1ColumnNumericHistogramResponse,
@@ -539,6 +541,120 @@ export const createQueryServiceMetricsViewComparisonToplist = <
 
   return createMutation(mutationOptions);
 };
+/**
+ * @summary MetricsViewAggregation is a generic API for aggregating the measures of a metrics view by any number of dimensions.
+It also supports bucketing the time dimension by a time grain and pivoting a dimension into columns.
+ */
+export const queryServiceMetricsViewAggregation = (
+  instanceId: string,
+  metricsViewName: string,
+  queryServiceMetricsViewAggregationBody: QueryServiceMetricsViewAggregationBody
+) => {
+  return httpClient<V1MetricsViewAggregationResponse>({
+    url: `/v1/instances/${instanceId}/queries/metrics-views/${metricsViewName}/aggregation`,
+    method: "post",
+    headers: { "Content-Type": "application/json" },
+    data: queryServiceMetricsViewAggregationBody,
+  });
+};
+
+export const getQueryServiceMetricsViewAggregationQueryKey = (
+  instanceId: string,
+  metricsViewName: string,
+  queryServiceMetricsViewAggregationBody: QueryServiceMetricsViewAggregationBody
+) =>
+  [
+    `/v1/instances/${instanceId}/queries/metrics-views/${metricsViewName}/aggregation`,
+    queryServiceMetricsViewAggregationBody,
+  ] as const;
+
+export const getQueryServiceMetricsViewAggregationQueryOptions = <
+  TData = Awaited<ReturnType<typeof queryServiceMetricsViewAggregation>>,
+  TError = RpcStatus
+>(
+  instanceId: string,
+  metricsViewName: string,
+  queryServiceMetricsViewAggregationBody: QueryServiceMetricsViewAggregationBody,
+  options?: {
+    query?: CreateQueryOptions<
+      Awaited<ReturnType<typeof queryServiceMetricsViewAggregation>>,
+      TError,
+      TData
+    >;
+  }
+): CreateQueryOptions<
+  Awaited<ReturnType<typeof queryServiceMetricsViewAggregation>>,
+  TError,
+  TData
+> & { queryKey: QueryKey } => {
+  const { query: queryOptions } = options ?? {};
+
+  const queryKey =
+    queryOptions?.queryKey ??
+    getQueryServiceMetricsViewAggregationQueryKey(
+      instanceId,
+      metricsViewName,
+      queryServiceMetricsViewAggregationBody
+    );
+
+  const queryFn: QueryFunction<
+    Awaited<ReturnType<typeof queryServiceMetricsViewAggregation>>
+  > = () =>
+    queryServiceMetricsViewAggregation(
+      instanceId,
+      metricsViewName,
+      queryServiceMetricsViewAggregationBody
+    );
+
+  return {
+    queryKey,
+    queryFn,
+    enabled: !!(instanceId && metricsViewName),
+    ...queryOptions,
+  };
+};
+
+export type QueryServiceMetricsViewAggregationQueryResult = NonNullable<
+  Awaited<ReturnType<typeof queryServiceMetricsViewAggregation>>
+>;
+export type QueryServiceMetricsViewAggregationQueryError = RpcStatus;
+
+/**
+ * @summary MetricsViewAggregation is a generic API for aggregating the measures of a metrics view by any number of dimensions.
+It also supports bucketing the time dimension by a time grain and pivoting a dimension into columns.
+ */
+export const createQueryServiceMetricsViewAggregation = <
+  TData = Awaited<ReturnType<typeof queryServiceMetricsViewAggregation>>,
+  TError = RpcStatus
+>(
+  instanceId: string,
+  metricsViewName: string,
+  queryServiceMetricsViewAggregationBody: QueryServiceMetricsViewAggregationBody,
+  options?: {
+    query?: CreateQueryOptions<
+      Awaited<ReturnType<typeof queryServiceMetricsViewAggregation>>,
+      TError,
+      TData
+    >;
+  }
+): CreateQueryResult<TData, TError> & { queryKey: QueryKey } => {
+  const queryOptions = getQueryServiceMetricsViewAggregationQueryOptions(
+    instanceId,
+    metricsViewName,
+    queryServiceMetricsViewAggregationBody,
+    options
+  );
+
+  const query = createQuery(queryOptions) as CreateQueryResult<
+    TData,
+    TError
+  > & { queryKey: QueryKey };
+
+  query.queryKey = queryOptions.queryKey;
+
+  return query;
+};
+
 /**
  * @summary MetricsViewRows returns the underlying model rows matching a metrics view time range and filter(s).
  */
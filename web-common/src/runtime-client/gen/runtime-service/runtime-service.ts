@@ -54,6 +54,8 @@ import type {
   RuntimeServiceGetLogsParams,
   RuntimeServiceWatchLogs200,
   RuntimeServiceWatchLogsParams,
+  V1ClearQueryCacheResponse,
+  V1GetQueryCacheStatsResponse,
   V1ReconcileResponse,
   RuntimeServiceReconcileBody,
   V1GetResourceResponse,
@@ -1872,6 +1874,155 @@ export const createRuntimeServiceWatchLogs = <
   return query;
 };
 
+/**
+ * @summary GetQueryCacheStats returns statistics about the cached query results of an instance
+ */
+export const runtimeServiceGetQueryCacheStats = (
+  instanceId: string,
+  signal?: AbortSignal
+) => {
+  return httpClient<V1GetQueryCacheStatsResponse>({
+    url: `/v1/instances/${instanceId}/query-cache`,
+    method: "get",
+    signal,
+  });
+};
+
+export const getRuntimeServiceGetQueryCacheStatsQueryKey = (
+  instanceId: string
+) => [`/v1/instances/${instanceId}/query-cache`] as const;
+
+export const getRuntimeServiceGetQueryCacheStatsQueryOptions = <
+  TData = Awaited<ReturnType<typeof runtimeServiceGetQueryCacheStats>>,
+  TError = RpcStatus
+>(
+  instanceId: string,
+  options?: {
+    query?: CreateQueryOptions<
+      Awaited<ReturnType<typeof runtimeServiceGetQueryCacheStats>>,
+      TError,
+      TData
+    >;
+  }
+): CreateQueryOptions<
+  Awaited<ReturnType<typeof runtimeServiceGetQueryCacheStats>>,
+  TError,
+  TData
+> & { queryKey: QueryKey } => {
+  const { query: queryOptions } = options ?? {};
+
+  const queryKey =
+    queryOptions?.queryKey ??
+    getRuntimeServiceGetQueryCacheStatsQueryKey(instanceId);
+
+  const queryFn: QueryFunction<
+    Awaited<ReturnType<typeof runtimeServiceGetQueryCacheStats>>
+  > = ({ signal }) => runtimeServiceGetQueryCacheStats(instanceId, signal);
+
+  return { queryKey, queryFn, enabled: !!instanceId, ...queryOptions };
+};
+
+export type RuntimeServiceGetQueryCacheStatsQueryResult = NonNullable<
+  Awaited<ReturnType<typeof runtimeServiceGetQueryCacheStats>>
+>;
+export type RuntimeServiceGetQueryCacheStatsQueryError = RpcStatus;
+
+/**
+ * @summary GetQueryCacheStats returns statistics about the cached query results of an instance
+ */
+export const createRuntimeServiceGetQueryCacheStats = <
+  TData = Awaited<ReturnType<typeof runtimeServiceGetQueryCacheStats>>,
+  TError = RpcStatus
+>(
+  instanceId: string,
+  options?: {
+    query?: CreateQueryOptions<
+      Awaited<ReturnType<typeof runtimeServiceGetQueryCacheStats>>,
+      TError,
+      TData
+    >;
+  }
+): CreateQueryResult<TData, TError> & { queryKey: QueryKey } => {
+  const queryOptions = getRuntimeServiceGetQueryCacheStatsQueryOptions(
+    instanceId,
+    options
+  );
+
+  const query = createQuery(queryOptions) as CreateQueryResult<
+    TData,
+    TError
+  > & { queryKey: QueryKey };
+
+  query.queryKey = queryOptions.queryKey;
+
+  return query;
+};
+
+/**
+ * @summary ClearQueryCache clears the cached query results of an instance
+ */
+export const runtimeServiceClearQueryCache = (instanceId: string) => {
+  return httpClient<V1ClearQueryCacheResponse>({
+    url: `/v1/instances/${instanceId}/query-cache/clear`,
+    method: "post",
+  });
+};
+
+export const getRuntimeServiceClearQueryCacheMutationOptions = <
+  TError = RpcStatus,
+  TContext = unknown
+>(options?: {
+  mutation?: CreateMutationOptions<
+    Awaited<ReturnType<typeof runtimeServiceClearQueryCache>>,
+    TError,
+    { instanceId: string },
+    TContext
+  >;
+}): CreateMutationOptions<
+  Awaited<ReturnType<typeof runtimeServiceClearQueryCache>>,
+  TError,
+  { instanceId: string },
+  TContext
+> => {
+  const { mutation: mutationOptions } = options ?? {};
+
+  const mutationFn: MutationFunction<
+    Awaited<ReturnType<typeof runtimeServiceClearQueryCache>>,
+    { instanceId: string }
+  > = (props) => {
+    const { instanceId } = props ?? {};
+
+    return runtimeServiceClearQueryCache(instanceId);
+  };
+
+  return { mutationFn, ...mutationOptions };
+};
+
+export type RuntimeServiceClearQueryCacheMutationResult = NonNullable<
+  Awaited<ReturnType<typeof runtimeServiceClearQueryCache>>
+>;
+
+export type RuntimeServiceClearQueryCacheMutationError = RpcStatus;
+
+/**
+ * @summary ClearQueryCache clears the cached query results of an instance
+ */
+export const createRuntimeServiceClearQueryCache = <
+  TError = RpcStatus,
+  TContext = unknown
+>(options?: {
+  mutation?: CreateMutationOptions<
+    Awaited<ReturnType<typeof runtimeServiceClearQueryCache>>,
+    TError,
+    { instanceId: string },
+    TContext
+  >;
+}) => {
+  const mutationOptions =
+    getRuntimeServiceClearQueryCacheMutationOptions(options);
+
+  return createMutation(mutationOptions);
+};
 /**
  * @summary Reconcile applies a full set of artifacts from a repo to the catalog and infra.
 It attempts to infer a minimal number of migrations to apply to reconcile the current state with